@@ -27,6 +27,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/components/bknotice"
 	componentratelimit "github.com/TencentBlueKing/bk-bscp/internal/components/ratelimit"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/tracer"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -71,17 +72,17 @@ func (as *apiServer) prepare(opt *options.Option) error {
 		strconv.Itoa(int(cc.ApiServer().Network.HttpPort))))
 	metrics.Register().MustRegister(metrics.BSCPServerHandledTotal)
 
-	if err := componentratelimit.Setup(cc.APIServerName); err != nil {
-		return fmt.Errorf("setup component rate limit failed, err: %v", err)
+	// init tracing
+	if err := tracer.Setup(cc.APIServerName); err != nil {
+		return fmt.Errorf("setup tracing failed, err: %v", err)
 	}
 
-	etcdOpt, err := cc.ApiServer().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
+	if err := componentratelimit.Setup(cc.APIServerName); err != nil {
+		return fmt.Errorf("setup component rate limit failed, err: %v", err)
 	}
 
 	// new discovery client.
-	dis, err := serviced.NewDiscovery(etcdOpt)
+	dis, err := serviced.NewDiscoveryWithConf(cc.ApiServer().Service)
 	if err != nil {
 		return fmt.Errorf("new discovery faield, err: %v", err)
 	}
@@ -0,0 +1,297 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
+	"github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// bundlePassphraseHeaderKey 服务包中密钥值的加密口令, 通过请求头传递避免记录在访问日志中
+const bundlePassphraseHeaderKey = "X-Bscp-Bundle-Passphrase"
+
+func newAppBundleService(settings cc.Repository, authorizer auth.Authorizer,
+	cfgClient pbcs.ConfigClient) (*appBundle, error) {
+	provider, err := repository.NewProvider(settings)
+	if err != nil {
+		return nil, err
+	}
+	return &appBundle{
+		authorizer: authorizer,
+		provider:   provider,
+		cfgClient:  cfgClient,
+	}, nil
+}
+
+type appBundle struct {
+	authorizer auth.Authorizer
+	provider   repository.Provider
+	cfgClient  pbcs.ConfigClient
+}
+
+// Export 导出服务包, 压缩包中包含服务包清单和配置项的文件内容
+func (a *appBundle) Export(w http.ResponseWriter, r *http.Request) {
+	kt := kit.MustGetKit(r.Context())
+	appID, _ := strconv.Atoi(chi.URLParam(r, "app_id"))
+	if appID == 0 {
+		_ = render.Render(w, r, rest.BadRequest(errors.New("validation parameter fail")))
+		return
+	}
+	kt.AppID = uint32(appID)
+
+	includeSecrets, _ := strconv.ParseBool(r.URL.Query().Get("include_secrets"))
+	resp, err := a.cfgClient.ExportAppBundle(kt.RpcCtx(), &pbcs.ExportAppBundleReq{
+		BizId:          kt.BizID,
+		AppId:          kt.AppID,
+		IncludeSecrets: includeSecrets,
+		Passphrase:     r.Header.Get(bundlePassphraseHeaderKey),
+	})
+	if err != nil {
+		_ = render.Render(w, r, rest.GRPCErr(err))
+		return
+	}
+
+	bundle := new(types.AppBundle)
+	if err = json.Unmarshal(resp.Manifest, bundle); err != nil {
+		_ = render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+
+	fileName := fmt.Sprintf("%s_bundle_%s.zip", bundle.App.Spec.Name, types.AppBundleVersion)
+	w.Header().Set("Content-Disposition", "attachment; filename="+fileName)
+	w.Header().Set("Content-Type", "application/zip")
+	w.WriteHeader(http.StatusOK)
+
+	zipWriter := zip.NewWriter(w)
+	defer func() { _ = zipWriter.Close() }()
+
+	writer, err := zipWriter.Create(types.AppBundleManifestName)
+	if err == nil {
+		_, err = writer.Write(resp.Manifest)
+	}
+	if err != nil {
+		logs.Errorf("write app bundle manifest failed, err: %v, rid: %s", err, kt.Rid)
+		return
+	}
+
+	written := make(map[string]bool)
+	for _, ci := range bundle.ConfigItems {
+		sign := ci.Content.Signature
+		if written[sign] {
+			continue
+		}
+		written[sign] = true
+		// 响应头已经写入, 出错时只能中断压缩包的写入
+		if err = a.downloadContentToZip(kt, sign, zipWriter); err != nil {
+			logs.Errorf("write content %s of app bundle failed, err: %v, rid: %s", sign, err, kt.Rid)
+			return
+		}
+	}
+}
+
+func (a *appBundle) downloadContentToZip(kt *kit.Kit, sign string, zipWriter *zip.Writer) error {
+	body, contentLength, err := a.provider.Download(kt, sign)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	writer, err := zipWriter.Create(path.Join(types.AppBundleContentDir, sign))
+	if err != nil {
+		return err
+	}
+
+	n, err := io.Copy(writer, body)
+	if err != nil {
+		return err
+	}
+	if n != contentLength {
+		return errors.New("download failed file missing")
+	}
+
+	return nil
+}
+
+// Import 导入服务包, 先上传配置项的文件内容, 再导入服务包清单
+func (a *appBundle) Import(w http.ResponseWriter, r *http.Request) {
+	kt := kit.MustGetKit(r.Context())
+	defer r.Body.Close()
+
+	query := r.URL.Query()
+	dryRun, _ := strconv.ParseBool(query.Get("dry_run"))
+	req := &pbcs.ImportAppBundleReq{
+		BizId:          kt.BizID,
+		DryRun:         true,
+		ConflictPolicy: query.Get("conflict_policy"),
+		AppName:        query.Get("app_name"),
+		Passphrase:     r.Header.Get(bundlePassphraseHeaderKey),
+	}
+
+	maxSize, _ := getUploadConfig(kt.BizID)
+	if err := checkUploadSize(kt, r, maxSize); err != nil {
+		_ = render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+
+	dirPath := filepath.Join(os.TempDir(), constant.UploadTemporaryDirectory)
+	if err := createTemporaryDirectory(dirPath); err != nil {
+		_ = render.Render(w, r, rest.BadRequest(errors.New(i18n.T(kt, "create directory failed, err: %v", err))))
+		return
+	}
+	tmpFile, err := os.CreateTemp(dirPath, "appBundle-")
+	if err != nil {
+		_ = render.Render(w, r, rest.BadRequest(errors.New(i18n.T(kt, "create temporary file failed, err: %v", err))))
+		return
+	}
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	size, err := io.Copy(tmpFile, http.MaxBytesReader(w, r.Body, maxSize*constant.MB))
+	if err != nil {
+		_ = render.Render(w, r, rest.BadRequest(errors.New(i18n.T(kt, "read file failed, err: %v", err))))
+		return
+	}
+
+	zipReader, err := zip.NewReader(tmpFile, size)
+	if err != nil {
+		_ = render.Render(w, r, rest.BadRequest(errors.New(i18n.T(kt, "app bundle is not a valid zip file"))))
+		return
+	}
+
+	files := make(map[string]*zip.File, len(zipReader.File))
+	for _, f := range zipReader.File {
+		files[f.Name] = f
+	}
+	manifest, ok := files[types.AppBundleManifestName]
+	if !ok {
+		_ = render.Render(w, r, rest.BadRequest(errors.New(i18n.T(kt, "the manifest of app bundle is not found"))))
+		return
+	}
+	if req.Manifest, err = readZipFile(manifest, maxSize*constant.MB); err != nil {
+		_ = render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+
+	// 试运行会校验服务包和权限, 通过后才上传文件内容
+	resp, err := a.cfgClient.ImportAppBundle(kt.RpcCtx(), req)
+	if err != nil {
+		_ = render.Render(w, r, rest.GRPCErr(err))
+		return
+	}
+
+	if !dryRun {
+		if err = a.uploadContents(kt, req.Manifest, files); err != nil {
+			_ = render.Render(w, r, rest.BadRequest(err))
+			return
+		}
+
+		req.DryRun = false
+		if resp, err = a.cfgClient.ImportAppBundle(kt.RpcCtx(), req); err != nil {
+			_ = render.Render(w, r, rest.GRPCErr(err))
+			return
+		}
+	}
+
+	_ = render.Render(w, r, rest.OKRender(resp))
+}
+
+// uploadContents 校验并上传服务包中配置项的文件内容, 已存在的文件内容不重复上传
+func (a *appBundle) uploadContents(kt *kit.Kit, manifest []byte, files map[string]*zip.File) error {
+	_, maxFileSize := getUploadConfig(kt.BizID)
+	maxSize := maxFileSize * constant.MB
+	bundle := new(types.AppBundle)
+	if err := json.Unmarshal(manifest, bundle); err != nil {
+		return err
+	}
+
+	uploaded := make(map[string]bool)
+	for _, ci := range bundle.ConfigItems {
+		sign := ci.Content.Signature
+		if uploaded[sign] {
+			continue
+		}
+		uploaded[sign] = true
+
+		_, err := a.provider.Metadata(kt, sign)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, errf.ErrFileContentNotFound) {
+			return err
+		}
+
+		f, ok := files[path.Join(types.AppBundleContentDir, sign)]
+		if !ok {
+			return errors.New(i18n.T(kt, "content of config item %s is not found in the app bundle",
+				path.Join(ci.Spec.Path, ci.Spec.Name)))
+		}
+		content, err := readZipFile(f, maxSize)
+		if err != nil {
+			return err
+		}
+		hash := sha256.Sum256(content)
+		if hex.EncodeToString(hash[:]) != sign {
+			return errors.New(i18n.T(kt, "content of config item %s does not match its signature",
+				path.Join(ci.Spec.Path, ci.Spec.Name)))
+		}
+
+		if _, err = a.provider.Upload(kt, sign, bytes.NewReader(content)); err != nil {
+			return fmt.Errorf("upload content of config item %s failed, err: %v",
+				path.Join(ci.Spec.Path, ci.Spec.Name), err)
+		}
+	}
+
+	return nil
+}
+
+// readZipFile 读取压缩包中的文件, 限制解压后的大小避免压缩炸弹
+func readZipFile(f *zip.File, maxSize int64) ([]byte, error) {
+	if f.UncompressedSize64 > uint64(maxSize) {
+		return nil, fmt.Errorf("file %s in app bundle exceeds the size limit", f.Name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(io.LimitReader(rc, maxSize))
+}
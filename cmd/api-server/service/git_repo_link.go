@@ -0,0 +1,79 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
+)
+
+// gitRepoWebhookTokenHeaders are the headers which carry the webhook token, the gitlab's secret token
+// header is supported so that the gitlab webhook can be used directly.
+var gitRepoWebhookTokenHeaders = []string{"X-Bscp-Webhook-Token", "X-Gitlab-Token"}
+
+// GitRepoLinkWebhook triggers the sync of the git repository link by the webhook of the git server,
+// the request is verified by the webhook token of the link instead of the user's authentication.
+func (p *proxy) GitRepoLinkWebhook(w http.ResponseWriter, r *http.Request) {
+	bizID, err := strconv.ParseUint(chi.URLParam(r, "biz_id"), 10, 32)
+	if err != nil {
+		_ = render.Render(w, r, rest.BadRequest(errors.New("invalid biz id")))
+		return
+	}
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		_ = render.Render(w, r, rest.BadRequest(errors.New("invalid git repo link id")))
+		return
+	}
+
+	token := ""
+	for _, header := range gitRepoWebhookTokenHeaders {
+		if token = r.Header.Get(header); token != "" {
+			break
+		}
+	}
+	if token == "" {
+		_ = render.Render(w, r, rest.Unauthorized(errors.New("webhook token is required")))
+		return
+	}
+
+	// the git server can not set the tenant header, so the tenant can also be set in the url query
+	tenantID := r.Header.Get(constant.BkTenantID)
+	if tenantID == "" {
+		tenantID = r.URL.Query().Get("tenant_id")
+	}
+	kt := kit.NewWithTenant(tenantID)
+	kt.User = constant.BKSystemUser
+	kt.BizID = uint32(bizID)
+	resp, err := p.cfgClient.SyncGitRepoLink(kt.RpcCtx(), &pbcs.SyncGitRepoLinkReq{
+		Id:           uint32(id),
+		BizId:        uint32(bizID),
+		WebhookToken: token,
+	})
+	if err != nil {
+		logs.Errorf("sync git repo link %d by webhook failed, err: %v, rid: %s", id, err, kt.Rid)
+		_ = render.Render(w, r, rest.GRPCErr(err))
+		return
+	}
+
+	_ = render.Render(w, r, rest.OKRender(resp))
+}
@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/grpcgw"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
@@ -44,6 +45,7 @@ type proxy struct {
 	cfgClient           pbcs.ConfigClient
 	configImportService *configImport
 	configExportService *configExport
+	appBundleService    *appBundle
 	kvService           *kvService
 	varService          *variableService
 	mc                  *metric
@@ -98,6 +100,11 @@ func newProxy(dis serviced.Discover) (*proxy, error) {
 		return nil, err
 	}
 
+	appBundleService, err := newAppBundleService(cc.ApiServer().Repo, authorizer, cfgClient)
+	if err != nil {
+		return nil, err
+	}
+
 	kv := newKvService(authorizer, cfgClient)
 	variable := newVariableService(cfgClient)
 
@@ -107,6 +114,7 @@ func newProxy(dis serviced.Discover) (*proxy, error) {
 		bkNotice:            bkNotice,
 		configImportService: configImportService,
 		configExportService: configExportService,
+		appBundleService:    appBundleService,
 		state:               state,
 		authorizer:          authorizer,
 		authSvrMux:          authSvrMux,
@@ -194,6 +202,9 @@ func newCfgClient(dis serviced.Discover) (pbcs.ConfigClient, error) {
 func newGrpcDialOption(dis serviced.Discover, tls cc.TLSConfig) ([]grpc.DialOption, error) {
 	opts := make([]grpc.DialOption, 0)
 
+	// add tracing interceptors.
+	opts = append(opts, brpc.TraceDialOptions()...)
+
 	// add dial load balancer.
 	opts = append(opts, dis.LBRoundRobin())
 
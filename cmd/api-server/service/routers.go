@@ -22,6 +22,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/audit"
 	"github.com/TencentBlueKing/bk-bscp/internal/rest/view"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/handler"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/tracer"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 )
 
@@ -37,6 +38,7 @@ const (
 func (p *proxy) routers() http.Handler {
 	r := chi.NewRouter()
 	r.Use(handler.RequestID)
+	r.Use(tracer.HTTPMiddleware)
 	r.Use(middleware.RealIP)
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
@@ -217,6 +219,28 @@ func (p *proxy) routers() http.Handler {
 		r.Get("/", p.configExportService.ConfigFileExport)
 	})
 
+	// 导出服务包
+	r.Route("/api/v1/config/biz/{biz_id}/apps/{app_id}/bundle/export", func(r chi.Router) {
+		r.Use(p.authorizer.UnifiedAuthentication)
+		r.Use(p.authorizer.BizVerified)
+		r.Use(p.HttpServerHandledTotal("", "AppBundleExport"))
+		r.Get("/", p.appBundleService.Export)
+	})
+
+	// 导入服务包
+	r.Route("/api/v1/config/biz/{biz_id}/apps/bundle/import", func(r chi.Router) {
+		r.Use(p.authorizer.UnifiedAuthentication)
+		r.Use(p.authorizer.BizVerified)
+		r.Use(p.HttpServerHandledTotal("", "AppBundleImport"))
+		r.Post("/", p.appBundleService.Import)
+	})
+
+	// git 仓库 webhook 触发同步, 通过关联的 webhook 令牌校验
+	r.Route("/api/v1/biz/{biz_id}/git_repo_links/{id}/webhook", func(r chi.Router) {
+		r.Use(p.HttpServerHandledTotal("", "GitRepoLinkWebhook"))
+		r.Post("/", p.GitRepoLinkWebhook)
+	})
+
 	// 获取通知中心通知列表
 	r.Route("/api/v1/announcements", func(r chi.Router) {
 		r.Get("/", p.bkNotice.GetCurrentAnnouncements)
@@ -97,11 +97,6 @@ func (as *authService) prepare(opt *options.Option) error {
 		return fmt.Errorf("setup component rate limit failed, err: %v", err)
 	}
 
-	etcdOpt, err := cc.AuthServer().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
-	}
-
 	// register auth server.
 	svcOpt := serviced.ServiceOption{
 		Name: cc.AuthServerName,
@@ -109,7 +104,7 @@ func (as *authService) prepare(opt *options.Option) error {
 		Port: cc.AuthServer().Network.RpcPort,
 		Uid:  uuid.UUID(),
 	}
-	sd, err := serviced.NewServiceD(etcdOpt, svcOpt)
+	sd, err := serviced.NewServiceDWithConf(cc.AuthServer().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service discovery faield, err: %v", err)
 	}
@@ -38,6 +38,8 @@ func AdaptAuthOptions(a *meta.ResourceAttribute) (client.ActionID, []client.Reso
 	switch a.Type {
 	case meta.Biz:
 		return genBizResource(a)
+	case meta.Hook:
+		return genBizResource(hookBizAttribute(a))
 	case meta.App:
 		return genAppResource(a)
 	case meta.Credential:
@@ -88,6 +90,8 @@ func AdaptIAMResourceOptions(a *meta.ResourceAttribute) (*bkiam.Request, error)
 	switch a.Type {
 	case meta.Biz:
 		return genBizIAMResource(a)
+	case meta.Hook:
+		return genBizIAMResource(hookBizAttribute(a))
 	case meta.App:
 		return genAppIAMResource(a)
 	case meta.Credential:
@@ -107,7 +111,10 @@ func AdaptIAMApplicationOptions(as []*meta.ResourceAttribute) (*bkiam.Applicatio
 	actions := make([]bkiam.ApplicationAction, 0)
 	for _, a := range as {
 		switch a.Type {
-		case meta.Biz:
+		case meta.Biz, meta.Hook:
+			if a.Type == meta.Hook {
+				a = hookBizAttribute(a)
+			}
 			action, err := genBizIAMApplication(a)
 			if err != nil {
 				return nil, err
@@ -57,6 +57,20 @@ func (i *Iam) WithTenant(tenantID string) *bkiam.IAM {
 // IAMClientGetter 定义函数来获取带有租户信息的 IAM 客户端
 type IAMClientGetter func(tenantID string) *bkiam.IAM
 
+// LocalAuthorizer authorizes the resources without iam, e.g. the built-in rbac policy engine.
+type LocalAuthorizer interface {
+	// Authorize returns the authorization decisions of the resources for the user.
+	Authorize(kt *kit.Kit, user string, resources []*meta.ResourceAttribute) ([]*meta.Decision, error)
+	// PermissionToApply returns the permissions which the user should be granted to access the resources.
+	PermissionToApply(resources []*meta.ResourceAttribute) *meta.IamPermission
+}
+
+// FreezeChecker checks the write operations with the change freeze windows of the biz.
+type FreezeChecker interface {
+	// Check returns error if the write operations of the resources are frozen for the user.
+	Check(kt *kit.Kit, user string, resources []*meta.ResourceAttribute) error
+}
+
 // Auth related operate.
 type Auth struct {
 	// auth related operate.
@@ -71,11 +85,16 @@ type Auth struct {
 	// spaceMgr defines space manager
 	spaceMgr *space.Manager
 	iamCli   IAMClientGetter
+	// local authorizes the resources instead of iam if it is set
+	local LocalAuthorizer
+	// freeze checks the write operations with the change freeze windows if it is set
+	freeze FreezeChecker
 }
 
 // NewAuth new auth.
 func NewAuth(auth auth.Authorizer, ds pbds.DataClient, disableAuth bool, iamCli IAMClientGetter,
-	disableWriteOpt *options.DisableWriteOption, spaceMgr *space.Manager) (*Auth, error) {
+	disableWriteOpt *options.DisableWriteOption, spaceMgr *space.Manager, local LocalAuthorizer,
+	freeze FreezeChecker) (*Auth, error) {
 
 	if auth == nil {
 		return nil, errf.New(errf.InvalidParameter, "auth is nil")
@@ -96,6 +115,8 @@ func NewAuth(auth auth.Authorizer, ds pbds.DataClient, disableAuth bool, iamCli
 		disableWriteOpt: disableWriteOpt,
 		spaceMgr:        spaceMgr,
 		iamCli:          iamCli,
+		local:           local,
+		freeze:          freeze,
 	}
 
 	return i, nil
@@ -112,7 +133,7 @@ func (a *Auth) AuthorizeBatch(ctx context.Context, req *pbas.AuthorizeBatchReq)
 	}
 
 	// if write operations are disabled, returns corresponding error
-	if err := a.isWriteOperationDisabled(kt, req.Resources); err != nil {
+	if err := a.isWriteOperationDisabled(kt, req.User.GetUserName(), req.Resources); err != nil {
 		return nil, err
 	}
 
@@ -125,8 +146,17 @@ func (a *Auth) AuthorizeBatch(ctx context.Context, req *pbas.AuthorizeBatchReq)
 	// 	return resp, nil
 	// }
 
-	// parse bscp resource to iam resource
 	resources := pbas.ResourceAttributes(req.Resources)
+	if a.local != nil {
+		decisions, err := a.local.Authorize(kt, req.User.UserInfo().UserName, resources)
+		if err != nil {
+			return nil, err
+		}
+		resp.Decisions = pbas.PbDecisions(decisions)
+		return resp, nil
+	}
+
+	// parse bscp resource to iam resource
 	opts, decisions, err := parseAttributesToBatchOptions(kt, req.User.UserInfo(), resources...)
 	if err != nil {
 		return nil, err
@@ -165,9 +195,9 @@ func (a *Auth) AuthorizeBatch(ctx context.Context, req *pbas.AuthorizeBatchReq)
 	return resp, nil
 }
 
-func (a *Auth) isWriteOperationDisabled(kt *kit.Kit, resources []*pbas.ResourceAttribute) error {
+func (a *Auth) isWriteOperationDisabled(kt *kit.Kit, user string, resources []*pbas.ResourceAttribute) error {
 	if !a.disableWriteOpt.IsDisabled {
-		return nil
+		return a.checkChangeFreeze(kt, user, resources)
 	}
 
 	for _, resource := range resources {
@@ -188,7 +218,16 @@ func (a *Auth) isWriteOperationDisabled(kt *kit.Kit, resources []*pbas.ResourceA
 		}
 	}
 
-	return nil
+	return a.checkChangeFreeze(kt, user, resources)
+}
+
+// checkChangeFreeze 变更冻结窗口内仅允许紧急变更人员进行写操作
+func (a *Auth) checkChangeFreeze(kt *kit.Kit, user string, resources []*pbas.ResourceAttribute) error {
+	if a.freeze == nil {
+		return nil
+	}
+
+	return a.freeze.Check(kt, user, pbas.ResourceAttributes(resources))
 }
 
 // parseAttributesToBatchOptions parse auth attributes to authorize batch options
@@ -252,6 +291,12 @@ func (a *Auth) GetPermissionToApply(ctx context.Context, req *pbas.GetPermission
 	kt := kit.FromGrpcContext(ctx)
 	resp := new(pbas.GetPermissionToApplyResp)
 
+	// 内置权限引擎没有权限申请链接，返回需要授予的角色权限
+	if a.local != nil {
+		resp.Permission = pbas.PbIamPermission(a.local.PermissionToApply(pbas.ResourceAttributes(req.Resources)))
+		return resp, nil
+	}
+
 	permission, err := a.getPermissionToApply(kt, pbas.ResourceAttributes(req.Resources))
 	if err != nil {
 		return nil, err
@@ -448,5 +493,10 @@ func (a *Auth) getInstIDNameMap(kt *kit.Kit, resTypeIDsMap map[client.TypeID][]s
 
 // GrantResourceCreatorAction grant resource creator action.
 func (a *Auth) GrantResourceCreatorAction(ctx context.Context, opts *client.GrantResourceCreatorActionOption) error {
+	// 内置权限引擎的权限都通过角色绑定授予，不需要授予创建者权限
+	if a.local != nil {
+		return nil
+	}
+
 	return a.auth.GrantResourceCreatorAction(ctx, opts)
 }
@@ -51,11 +51,25 @@ func genBizResource(a *meta.ResourceAttribute) (client.ActionID, []client.Resour
 	case meta.FindBusinessResource:
 		// create app is related to cmdb business resource
 		return sys.BusinessViewResource, []client.Resource{bizRes}, nil
+	case meta.Manage:
+		return sys.BusinessManage, []client.Resource{bizRes}, nil
 	default:
 		return "", nil, fmt.Errorf("unsupported bscp action: %s", a.Action)
 	}
 }
 
+// hookBizAttribute hooks are not registered in iam, viewing the hooks requires the business view permission,
+// and the other operations of the hooks, such as testing the hook scripts on the server, require the business
+// manage permission.
+func hookBizAttribute(a *meta.ResourceAttribute) *meta.ResourceAttribute {
+	action := meta.Manage
+	if a.Action == meta.Find || a.Action == meta.View || a.Action == meta.FindBusinessResource {
+		action = meta.FindBusinessResource
+	}
+
+	return &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.Biz, Action: action}, BizID: a.BizID}
+}
+
 func genBizIAMResource(a *meta.ResourceAttribute) (*bkiam.Request, error) {
 	iamReq := bkiam.Request{
 		System:  sys.SystemIDBSCP,
@@ -74,6 +88,8 @@ func genBizIAMResource(a *meta.ResourceAttribute) (*bkiam.Request, error) {
 	case meta.FindBusinessResource:
 		// create app is related to cmdb business resource
 		iamReq.Action = bkiam.NewAction(string(sys.BusinessViewResource))
+	case meta.Manage:
+		iamReq.Action = bkiam.NewAction(string(sys.BusinessManage))
 	default:
 		return nil, fmt.Errorf("unsupported bscp action: %s", a.Action)
 	}
@@ -160,6 +176,8 @@ func genBizIAMApplication(a *meta.ResourceAttribute) (bkiam.ApplicationAction, e
 	case meta.FindBusinessResource:
 		// create app is related to cmdb business resource
 		action.ID = string(sys.BusinessViewResource)
+	case meta.Manage:
+		action.ID = string(sys.BusinessManage)
 	default:
 		return action, fmt.Errorf("unsupported bscp action: %s", a.Action)
 	}
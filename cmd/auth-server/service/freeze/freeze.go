@@ -0,0 +1,179 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package freeze checks the write operations with the change freeze windows of the biz, only the break-glass
+// users of the active windows can still write, and their overrides are recorded in the audits.
+package freeze
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bluele/gcache"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcf "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/change-freeze"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+const (
+	// freezeCacheSize the max number of biz's change freeze windows cached
+	freezeCacheSize = 10000
+	// freezeCacheTTL the changes of the windows take effect after the cache is expired at most
+	freezeCacheTTL = 10 * time.Second
+)
+
+// readActions 只读操作不受变更冻结窗口限制，FindBusinessResource 同样用于分组、脚本、模版空间、
+// 模版变量等业务级资源的写操作，这些写操作由 data service 按接口检查业务级变更冻结窗口
+var readActions = map[meta.Action]struct{}{
+	meta.Find: {}, meta.FindBusinessResource: {}, meta.View: {}, meta.Download: {}, meta.Access: {},
+	meta.SkipAction: {},
+}
+
+// auditedByPublish 上线操作的强制变更由 data service 的上线流程记录，避免重复记录
+var auditedByPublish = map[meta.Action]struct{}{
+	meta.Publish: {}, meta.GenerateRelease: {},
+}
+
+// Checker checks the write operations with the change freeze windows.
+type Checker struct {
+	ds pbds.DataClient
+	// cache caches the enabled change freeze windows of the biz, key is tenant/biz
+	cache gcache.Cache
+}
+
+// New create the change freeze checker.
+func New(ds pbds.DataClient) *Checker {
+	return &Checker{
+		ds:    ds,
+		cache: gcache.New(freezeCacheSize).LRU().Expiration(freezeCacheTTL).Build(),
+	}
+}
+
+// target is the biz or the app which the write operations are on.
+type target struct {
+	bizID   uint32
+	appID   uint32
+	actions []string
+	audit   bool
+}
+
+// Check returns error if the write operations of the resources are frozen for the user, app id of the
+// resource is used to match the windows of the app if the resource is an app, otherwise only the windows
+// of the whole biz are matched.
+func (c *Checker) Check(kt *kit.Kit, user string, resources []*meta.ResourceAttribute) error {
+	targets := make(map[string]*target)
+	keys := make([]string, 0)
+	for _, res := range resources {
+		if _, ok := readActions[res.Action]; ok || res.BizID == 0 {
+			continue
+		}
+
+		var appID uint32
+		if res.Type == meta.App {
+			appID = res.ResourceID
+		}
+		key := fmt.Sprintf("%d/%d", res.BizID, appID)
+		one, ok := targets[key]
+		if !ok {
+			one = &target{bizID: res.BizID, appID: appID}
+			targets[key] = one
+			keys = append(keys, key)
+		}
+		one.actions = append(one.actions, fmt.Sprintf("%s %s", res.Action, res.Type))
+		if _, ok := auditedByPublish[res.Action]; !ok {
+			one.audit = true
+		}
+	}
+	sort.Strings(keys)
+
+	now := time.Now()
+	for _, key := range keys {
+		one := targets[key]
+		freezes, err := c.listFreezes(kt, one.bizID)
+		if err != nil {
+			return err
+		}
+
+		frozen, overridden := table.CheckChangeFreeze(freezes, one.appID, user, now)
+		if frozen != nil {
+			logs.Errorf("%s of biz %d app %d is frozen by change freeze %d, user: %s, rid: %s",
+				strings.Join(one.actions, ", "), one.bizID, one.appID, frozen.ID, user, kt.Rid)
+			return errf.New(errf.ChangeFrozen, fmt.Sprintf("in change freeze %s, write operation is not allowed, "+
+				"please contact the break-glass users: %s", frozen.Spec.Name,
+				strings.Join(frozen.Spec.BreakGlassUsers, ", ")))
+		}
+
+		if len(overridden) == 0 || !one.audit {
+			continue
+		}
+		if err := c.recordBreakGlass(kt, user, one, overridden); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listFreezes list the enabled change freeze windows of the biz, the cached windows are used first.
+func (c *Checker) listFreezes(kt *kit.Kit, bizID uint32) ([]*table.ChangeFreeze, error) {
+	key := fmt.Sprintf("%s/%d", kt.TenantID, bizID)
+	if cached, err := c.cache.Get(key); err == nil {
+		return cached.([]*table.ChangeFreeze), nil
+	}
+
+	resp, err := c.ds.ListChangeFreezes(kt.RpcCtx(), &pbds.ListChangeFreezesReq{BizId: bizID, EnabledOnly: true})
+	if err != nil {
+		logs.Errorf("list change freezes of biz %d failed, err: %v, rid: %s", bizID, err, kt.Rid)
+		return nil, err
+	}
+
+	freezes := pbcf.ChangeFreezes(resp.Details)
+	if err := c.cache.Set(key, freezes); err != nil {
+		logs.Errorf("cache change freezes of biz %d failed, err: %v, rid: %s", bizID, err, kt.Rid)
+	}
+
+	return freezes, nil
+}
+
+// recordBreakGlass record the audits of the windows overridden by the break-glass user.
+func (c *Checker) recordBreakGlass(kt *kit.Kit, user string, one *target, overridden []*table.ChangeFreeze) error {
+	ids := make([]uint32, 0, len(overridden))
+	for _, freeze := range overridden {
+		ids = append(ids, freeze.ID)
+	}
+
+	// the audit operator is the break-glass user instead of the caller service
+	operator := kt.Clone()
+	operator.User = user
+	operator.OperateWay = kt.OperateWay
+	_, err := c.ds.RecordChangeFreezeBreakGlass(operator.RpcCtx(), &pbds.RecordChangeFreezeBreakGlassReq{
+		BizId:  one.bizID,
+		AppId:  one.appID,
+		Ids:    ids,
+		Detail: strings.Join(one.actions, ", "),
+	})
+	if err != nil {
+		logs.Errorf("record change freeze break glass of biz %d failed, err: %v, rid: %s", one.bizID, err, kt.Rid)
+		return err
+	}
+	logs.Infof("%s of biz %d app %d overrides change freezes %v by break-glass user %s, rid: %s",
+		strings.Join(one.actions, ", "), one.bizID, one.appID, ids, user, kt.Rid)
+
+	return nil
+}
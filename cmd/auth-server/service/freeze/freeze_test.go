@@ -0,0 +1,102 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package freeze
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbcf "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/change-freeze"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+type fakeDS struct {
+	pbds.DataClient
+	freezes  []*pbcf.ChangeFreeze
+	listed   int
+	recorded []*pbds.RecordChangeFreezeBreakGlassReq
+}
+
+func (f *fakeDS) ListChangeFreezes(_ context.Context, _ *pbds.ListChangeFreezesReq, _ ...grpc.CallOption) (
+	*pbds.ListChangeFreezesResp, error) {
+	f.listed++
+	return &pbds.ListChangeFreezesResp{Details: f.freezes}, nil
+}
+
+func (f *fakeDS) RecordChangeFreezeBreakGlass(_ context.Context, req *pbds.RecordChangeFreezeBreakGlassReq,
+	_ ...grpc.CallOption) (*pbbase.EmptyResp, error) {
+	f.recorded = append(f.recorded, req)
+	return new(pbbase.EmptyResp), nil
+}
+
+func allDayFreeze(id, appID uint32, users ...string) *pbcf.ChangeFreeze {
+	return &pbcf.ChangeFreeze{
+		Id: id,
+		Spec: &pbcf.ChangeFreezeSpec{Name: "release freeze", Kind: "recurring", StartTime: "00:00",
+			EndTime: "00:00", BreakGlassUsers: users, Enabled: true},
+		Attachment: &pbcf.ChangeFreezeAttachment{BizId: 1, AppId: appID},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	ds := &fakeDS{freezes: []*pbcf.ChangeFreeze{allDayFreeze(1, 2, "alice")}}
+	c := New(ds)
+	kt := kit.New()
+
+	update := func(appID uint32) *meta.ResourceAttribute {
+		return &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: appID},
+			BizID: 1}
+	}
+
+	// read operations are not frozen
+	view := &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: 2}, BizID: 1}
+	if err := c.Check(kt, "bob", []*meta.ResourceAttribute{view}); err != nil {
+		t.Fatalf("expect view allowed, got err: %v", err)
+	}
+
+	err := c.Check(kt, "bob", []*meta.ResourceAttribute{update(2)})
+	if err == nil || errf.Error(err).Code != errf.ChangeFrozen {
+		t.Fatalf("expect update frozen, got err: %v", err)
+	}
+
+	if err = c.Check(kt, "bob", []*meta.ResourceAttribute{update(3)}); err != nil {
+		t.Fatalf("expect update of other app allowed, got err: %v", err)
+	}
+
+	if err = c.Check(kt, "alice", []*meta.ResourceAttribute{update(2)}); err != nil {
+		t.Fatalf("expect break-glass user allowed, got err: %v", err)
+	}
+	if len(ds.recorded) != 1 || ds.recorded[0].AppId != 2 || ds.recorded[0].Ids[0] != 1 {
+		t.Fatalf("expect break glass recorded, got %v", ds.recorded)
+	}
+
+	// publish overrides are recorded by the publish process of data service
+	publish := &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: 2},
+		BizID: 1}
+	if err = c.Check(kt, "alice", []*meta.ResourceAttribute{publish}); err != nil {
+		t.Fatalf("expect break-glass user allowed, got err: %v", err)
+	}
+	if len(ds.recorded) != 1 {
+		t.Fatalf("expect publish not recorded by auth server, got %d records", len(ds.recorded))
+	}
+
+	if ds.listed != 1 {
+		t.Fatalf("expect change freezes cached, got listed %d times", ds.listed)
+	}
+}
@@ -0,0 +1,245 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rbac is the built-in role based access control policy engine, it authorizes the resources
+// with the roles and role bindings stored in data service, so that bscp can be deployed without BK-IAM.
+package rbac
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bluele/gcache"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbrole "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/role"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+const (
+	// policyCacheSize the max number of user's biz policies cached
+	policyCacheSize = 10000
+	// policyCacheTTL role bindings take effect after the cache is expired at most
+	policyCacheTTL = 10 * time.Second
+)
+
+// skipTypes 与 iam 鉴权保持一致，这些资源类型不单独鉴权，由其所属服务的权限控制
+var skipTypes = map[meta.ResourceType]struct{}{
+	meta.Commit: {}, meta.ConfigItem: {}, meta.Content: {}, meta.CRInstance: {}, meta.Release: {},
+	meta.ReleasedCI: {}, meta.Strategy: {}, meta.StrategySet: {}, meta.PSH: {}, meta.Repo: {}, meta.Sidecar: {},
+}
+
+// RBAC is the built-in rbac policy engine.
+type RBAC struct {
+	ds     pbds.DataClient
+	admins map[string]struct{}
+	// cache caches the role policies of the user in the biz, key is tenant/user/biz
+	cache gcache.Cache
+}
+
+// New create the built-in rbac policy engine.
+func New(ds pbds.DataClient, admins []string) *RBAC {
+	r := &RBAC{
+		ds:     ds,
+		admins: make(map[string]struct{}, len(admins)),
+		cache:  gcache.New(policyCacheSize).LRU().Expiration(policyCacheTTL).Build(),
+	}
+	for _, one := range admins {
+		r.admins[one] = struct{}{}
+	}
+
+	return r
+}
+
+// Authorize returns the authorization decisions of the resources for the user.
+func (r *RBAC) Authorize(kt *kit.Kit, user string, resources []*meta.ResourceAttribute) ([]*meta.Decision, error) {
+	decisions := make([]*meta.Decision, len(resources))
+	bizIDs := make([]uint32, 0)
+	for idx, res := range resources {
+		decisions[idx] = &meta.Decision{Resource: res}
+		if r.isAdmin(user) || isSkipped(res) {
+			decisions[idx].Authorized = true
+			continue
+		}
+		bizIDs = append(bizIDs, res.BizID)
+	}
+	if len(bizIDs) == 0 {
+		return decisions, nil
+	}
+
+	policies, err := r.listPolicies(kt, user, bizIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range decisions {
+		if !d.Authorized {
+			d.Authorized = Allowed(policies, d.Resource)
+		}
+	}
+
+	return decisions, nil
+}
+
+// PermissionToApply returns the permissions which the user should be granted by role bindings to access the
+// resources, the action id is the role permission, e.g. app:view.
+func (r *RBAC) PermissionToApply(resources []*meta.ResourceAttribute) *meta.IamPermission {
+	permission := &meta.IamPermission{Actions: make([]*meta.IamAction, 0, len(resources))}
+	for _, res := range resources {
+		if isSkipped(res) {
+			continue
+		}
+
+		id := res.ResourceID
+		if id == 0 {
+			id = res.BizID
+		}
+		action := rolePermission(string(res.Type), string(res.Action))
+		permission.Actions = append(permission.Actions, &meta.IamAction{
+			ID:   action,
+			Name: action,
+			RelatedResourceTypes: []*meta.IamResourceType{{
+				Type:     string(res.Type),
+				TypeName: string(res.Type),
+				Instances: [][]*meta.IamResourceInstance{{{
+					Type:     string(res.Type),
+					TypeName: string(res.Type),
+					ID:       strconv.FormatUint(uint64(id), 10),
+				}}},
+			}},
+		})
+	}
+
+	return permission
+}
+
+// Allowed returns whether the resource is allowed by the role policies.
+func Allowed(policies []*pbrole.RolePolicy, res *meta.ResourceAttribute) bool {
+	for _, p := range policies {
+		if p.BizId != res.BizID {
+			continue
+		}
+
+		// 在业务下有任意角色即可查看业务下的资源，具体资源的操作权限由角色权限控制
+		if res.Action == meta.FindBusinessResource || res.Action == meta.Find {
+			return true
+		}
+
+		if !inScope(p, res) {
+			continue
+		}
+
+		for _, one := range p.Permissions {
+			if matchPermission(one, res) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// inScope returns whether the resource is in the scope of the role binding.
+func inScope(p *pbrole.RolePolicy, res *meta.ResourceAttribute) bool {
+	switch table.RoleScopeType(p.ScopeType) {
+	case table.RoleScopeBiz:
+		return true
+	case table.RoleScopeApp:
+		return res.Type == meta.App && res.ResourceID == p.ScopeId
+	case table.RoleScopeTemplateSpace:
+		return res.Type == meta.TemplateSpace && res.ResourceID == p.ScopeId
+	default:
+		return false
+	}
+}
+
+// matchPermission returns whether the role permission like app:view matches the resource.
+func matchPermission(permission string, res *meta.ResourceAttribute) bool {
+	resType, action, ok := strings.Cut(permission, ":")
+	if !ok {
+		return false
+	}
+
+	return (resType == table.RolePermissionWildcard || resType == string(res.Type)) &&
+		(action == table.RolePermissionWildcard || action == string(res.Action))
+}
+
+func rolePermission(resType, action string) string {
+	return resType + ":" + action
+}
+
+func isSkipped(res *meta.ResourceAttribute) bool {
+	if res.Action == meta.SkipAction {
+		return true
+	}
+	_, ok := skipTypes[res.Type]
+	return ok
+}
+
+func (r *RBAC) isAdmin(user string) bool {
+	_, ok := r.admins[user]
+	return ok
+}
+
+// listPolicies list the role policies of the user in the bizs, the cached policies are used first.
+func (r *RBAC) listPolicies(kt *kit.Kit, user string, bizIDs []uint32) ([]*pbrole.RolePolicy, error) {
+	policies := make([]*pbrole.RolePolicy, 0)
+	missed := make([]uint32, 0)
+	seen := make(map[uint32]struct{})
+	for _, bizID := range bizIDs {
+		if _, ok := seen[bizID]; ok {
+			continue
+		}
+		seen[bizID] = struct{}{}
+
+		cached, err := r.cache.Get(policyCacheKey(kt.TenantID, user, bizID))
+		if err != nil {
+			missed = append(missed, bizID)
+			continue
+		}
+		policies = append(policies, cached.([]*pbrole.RolePolicy)...)
+	}
+	if len(missed) == 0 {
+		return policies, nil
+	}
+
+	resp, err := r.ds.ListRolePolicies(kt.RpcCtx(), &pbds.ListRolePoliciesReq{Subject: user, BizIds: missed})
+	if err != nil {
+		logs.Errorf("list role policies of %s failed, err: %v, rid: %s", user, err, kt.Rid)
+		return nil, err
+	}
+
+	byBiz := make(map[uint32][]*pbrole.RolePolicy, len(missed))
+	for _, bizID := range missed {
+		byBiz[bizID] = make([]*pbrole.RolePolicy, 0)
+	}
+	for _, p := range resp.Details {
+		byBiz[p.BizId] = append(byBiz[p.BizId], p)
+	}
+	for bizID, one := range byBiz {
+		if err := r.cache.Set(policyCacheKey(kt.TenantID, user, bizID), one); err != nil {
+			logs.Errorf("cache role policies of %s failed, err: %v, rid: %s", user, err, kt.Rid)
+		}
+		policies = append(policies, one...)
+	}
+
+	return policies, nil
+}
+
+func policyCacheKey(tenantID, user string, bizID uint32) string {
+	return fmt.Sprintf("%s/%s/%d", tenantID, user, bizID)
+}
@@ -0,0 +1,194 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbas "github.com/TencentBlueKing/bk-bscp/pkg/protocol/auth-server"
+	pbrole "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/role"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateRole create role of the built-in rbac policy engine.
+func (s *Service) CreateRole(ctx context.Context, req *pbas.CreateRoleReq) (*pbas.CreateRoleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+	if err := s.authorizeRole(kt, req.BizId, meta.Manage); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateRole(kt.RpcCtx(), &pbds.CreateRoleReq{
+		BizId: req.BizId,
+		Spec: &pbrole.RoleSpec{
+			Name:        req.Name,
+			Permissions: req.Permissions,
+			Memo:        req.Memo,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbas.CreateRoleResp{Id: rp.Id}, nil
+}
+
+// UpdateRole update role of the built-in rbac policy engine.
+func (s *Service) UpdateRole(ctx context.Context, req *pbas.UpdateRoleReq) (*pbas.UpdateRoleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+	if err := s.authorizeRole(kt, req.BizId, meta.Manage); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateRole(kt.RpcCtx(), &pbds.UpdateRoleReq{
+		Id:    req.RoleId,
+		BizId: req.BizId,
+		Spec: &pbrole.RoleSpec{
+			Name:        req.Name,
+			Permissions: req.Permissions,
+			Memo:        req.Memo,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbas.UpdateRoleResp{}, nil
+}
+
+// DeleteRole delete role of the built-in rbac policy engine.
+func (s *Service) DeleteRole(ctx context.Context, req *pbas.DeleteRoleReq) (*pbas.DeleteRoleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+	if err := s.authorizeRole(kt, req.BizId, meta.Manage); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.DeleteRole(kt.RpcCtx(), &pbds.DeleteRoleReq{Id: req.RoleId, BizId: req.BizId}); err != nil {
+		return nil, err
+	}
+
+	return &pbas.DeleteRoleResp{}, nil
+}
+
+// ListRoles list roles of the built-in rbac policy engine.
+func (s *Service) ListRoles(ctx context.Context, req *pbas.ListRolesReq) (*pbas.ListRolesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+	if err := s.authorizeRole(kt, req.BizId, meta.FindBusinessResource); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListRoles(kt.RpcCtx(), &pbds.ListRolesReq{
+		BizId: req.BizId,
+		Start: req.Start,
+		Limit: req.Limit,
+		All:   req.All,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbas.ListRolesResp{Count: rp.Count, Details: rp.Details}, nil
+}
+
+// CreateRoleBinding bind the role to the user in the scope of biz, app or template space.
+func (s *Service) CreateRoleBinding(ctx context.Context, req *pbas.CreateRoleBindingReq) (
+	*pbas.CreateRoleBindingResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+	if err := s.authorizeRole(kt, req.BizId, meta.Manage); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateRoleBinding(kt.RpcCtx(), &pbds.CreateRoleBindingReq{
+		BizId: req.BizId,
+		Spec: &pbrole.RoleBindingSpec{
+			RoleId:    req.RoleId,
+			Subject:   req.Subject,
+			ScopeType: req.ScopeType,
+			ScopeId:   req.ScopeId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbas.CreateRoleBindingResp{Id: rp.Id}, nil
+}
+
+// DeleteRoleBinding delete role binding.
+func (s *Service) DeleteRoleBinding(ctx context.Context, req *pbas.DeleteRoleBindingReq) (
+	*pbas.DeleteRoleBindingResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+	if err := s.authorizeRole(kt, req.BizId, meta.Manage); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteRoleBinding(kt.RpcCtx(), &pbds.DeleteRoleBindingReq{
+		Id:    req.BindingId,
+		BizId: req.BizId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbas.DeleteRoleBindingResp{}, nil
+}
+
+// ListRoleBindings list role bindings of the biz.
+func (s *Service) ListRoleBindings(ctx context.Context, req *pbas.ListRoleBindingsReq) (
+	*pbas.ListRoleBindingsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+	if err := s.authorizeRole(kt, req.BizId, meta.FindBusinessResource); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListRoleBindings(kt.RpcCtx(), &pbds.ListRoleBindingsReq{
+		BizId:   req.BizId,
+		RoleId:  req.RoleId,
+		Subject: req.Subject,
+		Start:   req.Start,
+		Limit:   req.Limit,
+		All:     req.All,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbas.ListRoleBindingsResp{Count: rp.Count, Details: rp.Details}, nil
+}
+
+// authorizeRole authorize the user to view or manage the roles of the biz, managing roles requires the biz:manage
+// permission which is usually granted to the biz administrators by the platform administrators.
+func (s *Service) authorizeRole(kt *kit.Kit, bizID uint32, action meta.Action) error {
+	if s.rbac == nil {
+		return errf.New(errf.InvalidParameter, "the built-in rbac policy engine is not enabled")
+	}
+
+	if bizID == 0 {
+		return errf.New(errf.InvalidParameter, "biz id is required")
+	}
+
+	res := &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.Biz, Action: action, ResourceID: bizID}, BizID: bizID}
+	decisions, err := s.rbac.Authorize(kt, kt.User, []*meta.ResourceAttribute{res})
+	if err != nil {
+		logs.Errorf("authorize role operation failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	if !decisions[0].Authorized {
+		return errf.New(errf.PermissionDenied, "no permission to "+string(action)+" the roles of the biz")
+	}
+
+	return nil
+}
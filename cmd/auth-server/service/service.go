@@ -36,8 +36,10 @@ import (
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/auth-server/options"
 	"github.com/TencentBlueKing/bk-bscp/cmd/auth-server/service/auth"
+	"github.com/TencentBlueKing/bk-bscp/cmd/auth-server/service/freeze"
 	"github.com/TencentBlueKing/bk-bscp/cmd/auth-server/service/iam"
 	"github.com/TencentBlueKing/bk-bscp/cmd/auth-server/service/initial"
+	"github.com/TencentBlueKing/bk-bscp/cmd/auth-server/service/rbac"
 	confsvc "github.com/TencentBlueKing/bk-bscp/cmd/config-server/service"
 	"github.com/TencentBlueKing/bk-bscp/internal/components/bkcmdb"
 	"github.com/TencentBlueKing/bk-bscp/internal/components/bkpaas"
@@ -81,6 +83,10 @@ type Service struct {
 	auth     *auth.Auth
 	spaceMgr *space.Manager
 	pubKey   string
+	// rbac is the built-in rbac policy engine, it is nil when iam is used.
+	rbac *rbac.RBAC
+	// authLoginClient is shared by requests, so the oidc discovery and jwks are cached.
+	authLoginClient bkpaas.AuthLoginClient
 }
 
 // NewService create a service instance.
@@ -106,6 +112,7 @@ func NewService(sd serviced.Discover, iamSettings cc.IAM, disableAuth bool,
 		return nil, errors.Wrap(err, "init space mgr")
 	}
 
+	loginConf := cc.AuthServer().LoginAuth
 	s := &Service{
 		client:          client,
 		gateway:         gateway,
@@ -113,6 +120,7 @@ func NewService(sd serviced.Discover, iamSettings cc.IAM, disableAuth bool,
 		disableWriteOpt: disableWriteOpt,
 		iamSettings:     iamSettings,
 		spaceMgr:        spaceMgr,
+		authLoginClient: bkpaas.NewAuthLoginClient(&loginConf),
 	}
 
 	if errH := s.handlerAutoRegister(); errH != nil {
@@ -303,6 +311,7 @@ func (s *Service) GetAuthConf(_ context.Context,
 			Provider:  cc.AuthServer().LoginAuth.Provider,
 			GwPubkey:  s.pubKey,
 			UseEsb:    false,
+			Oidc:      pbOIDC(cc.AuthServer().LoginAuth),
 		},
 		Esb: &pbas.ESB{
 			Endpoints: cc.AuthServer().Esb.Endpoints,
@@ -431,11 +440,20 @@ func (s *Service) initLogicModule() error {
 		return err
 	}
 
+	var local auth.LocalAuthorizer
+	if cc.AuthServer().Authorization.IsRBAC() {
+		s.rbac = rbac.New(s.client.DS, cc.AuthServer().Authorization.Admins)
+		local = s.rbac
+		logs.Infof("authorize with the built-in rbac policy engine.")
+	}
+
 	s.auth, err = auth.NewAuth(s.client.auth, s.client.DS, s.disableAuth, func(tenantID string) *bkiam.IAM {
 		return s.client.iam.WithTenant(tenantID)
 	},
 		s.disableWriteOpt,
-		s.spaceMgr)
+		s.spaceMgr,
+		local,
+		freeze.New(s.client.DS))
 	if err != nil {
 		return err
 	}
@@ -443,6 +461,24 @@ func (s *Service) initLogicModule() error {
 	return nil
 }
 
+// pbOIDC returns the oidc login settings, which is only set when login with oidc provider.
+func pbOIDC(conf cc.LoginAuthSettings) *pbas.OIDC {
+	if !conf.IsOIDC() {
+		return nil
+	}
+
+	return &pbas.OIDC{
+		Issuer:        conf.OIDC.Issuer,
+		ClientId:      conf.OIDC.ClientID,
+		ClientSecret:  conf.OIDC.ClientSecret,
+		RedirectUrl:   conf.OIDC.RedirectURL,
+		Scopes:        conf.OIDC.Scopes,
+		UsernameClaim: conf.OIDC.UsernameClaim,
+		TenantClaim:   conf.OIDC.TenantClaim,
+		CookieDomain:  conf.OIDC.CookieDomain,
+	}
+}
+
 // GetUserInfo 获取用户信息
 func (s *Service) GetUserInfo(ctx context.Context, req *pbas.UserCredentialReq) (*pbas.UserInfoResp, error) {
 	token := req.GetToken()
@@ -450,8 +486,7 @@ func (s *Service) GetUserInfo(ctx context.Context, req *pbas.UserCredentialReq)
 		return nil, errors.New("token not provided")
 	}
 
-	conf := cc.AuthServer().LoginAuth
-	authLoginClient := bkpaas.NewAuthLoginClient(&conf)
+	authLoginClient := s.authLoginClient
 
 	// 多租户模式
 	if cc.AuthServer().FeatureFlags.EnableMultiTenantMode {
@@ -485,7 +520,8 @@ func (s *Service) GetUserInfo(ctx context.Context, req *pbas.UserCredentialReq)
 		err      error
 	)
 
-	if cc.AuthServer().LoginAuth.UseESB && cc.AuthServer().LoginAuth.Provider != bkpaas.BKLoginProvider {
+	loginAuth := cc.AuthServer().LoginAuth
+	if loginAuth.UseESB && loginAuth.Provider != bkpaas.BKLoginProvider && !loginAuth.IsOIDC() {
 		username, err = s.client.Esb.BKLogin().IsLogin(ctx, token)
 	} else {
 		username, err = authLoginClient.GetUserInfoByToken(ctx, host, req.GetUid(), token)
@@ -35,6 +35,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl/cmd"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/tracer"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
@@ -95,9 +96,9 @@ func (cs *cacheService) prepare(opt *options.Option) error {
 	metrics.InitMetrics(net.JoinHostPort(cc.CacheService().Network.BindIP,
 		strconv.Itoa(int(cc.CacheService().Network.RpcPort))))
 
-	etcdOpt, err := cc.CacheService().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
+	// init tracing
+	if err := tracer.Setup(cc.CacheServiceName); err != nil {
+		return fmt.Errorf("setup tracing failed, err: %v", err)
 	}
 
 	// register cache service.
@@ -107,7 +108,7 @@ func (cs *cacheService) prepare(opt *options.Option) error {
 		Port: cc.CacheService().Network.RpcPort,
 		Uid:  uuid.UUID(),
 	}
-	sd, err := serviced.NewServiceD(etcdOpt, svcOpt)
+	sd, err := serviced.NewServiceDWithConf(cc.CacheService().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service discovery faield, err: %v", err)
 	}
@@ -131,6 +132,9 @@ func (cs *cacheService) prepare(opt *options.Option) error {
 
 	tls := cc.CacheService().Network.TLS
 	opts := make([]grpc.DialOption, 0)
+
+	// add tracing interceptors.
+	opts = append(opts, brpc.TraceDialOptions()...)
 	opts = append(opts, sd.LBRoundRobin())
 
 	if !tls.Enable() {
@@ -179,12 +183,14 @@ func (cs *cacheService) listenAndServe() error {
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(4 * 1024 * 1024),
 		// add bscp unary interceptor and standard grpc server metrics interceptor.
 		grpc.ChainUnaryInterceptor(
+			brpc.TraceUnaryServerInterceptor(),
 			brpc.LogUnaryServerInterceptor(),
 			brpc.TenantUnaryServerInterceptor(),
 			grpcMetrics.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
 		),
 		grpc.ChainStreamInterceptor(
+			brpc.TraceStreamServerInterceptor(),
 			grpcMetrics.StreamServerInterceptor(),
 			brpc.TenantStreamServerInterceptor(),
 			grpc_recovery.StreamServerInterceptor(recoveryOpt),
@@ -24,6 +24,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/cache-service"
 	pbclient "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
 	pbce "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-event"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
@@ -45,6 +46,8 @@ type Interface interface {
 	BatchUpsertClientMetrics(kt *kit.Kit, clientData []*pbclient.Client, clientEventData []*pbce.ClientEvent) error
 	SetAppLastConsumedTime(kt *kit.Kit, bizID uint32, appIDs []uint32) error
 	BatchUpdateLastConsumedTime(kt *kit.Kit, appIDs []uint32) error
+	SetCredentialUsage(kt *kit.Kit, bizID uint32, usages []*pbcs.CredentialUsage) error
+	BatchUpdateCredentialUsage(kt *kit.Kit, usages []*pbds.CredentialUsage) error
 	GetPublishTime(kt *kit.Kit, publishTime int64) (map[uint32]PublishInfo, error)
 	SetPublishTime(kt *kit.Kit, bizID, appID, strategyID uint32, publishTime int64) (int64, error)
 	GetTenantIDByBiz(kit *kit.Kit, bizID uint32, refresh bool) (string, error)
@@ -172,3 +175,35 @@ func (c *client) BatchUpdateLastConsumedTime(kit *kit.Kit, appIDs []uint32) erro
 
 	return nil
 }
+
+// SetCredentialUsage 缓存凭证使用记录，由定时任务批量写入 DB
+func (c *client) SetCredentialUsage(kit *kit.Kit, bizID uint32, usages []*pbcs.CredentialUsage) error {
+	if len(usages) == 0 {
+		return nil
+	}
+	records := make([]*pbds.CredentialUsage, 0, len(usages))
+	for _, one := range usages {
+		records = append(records, &pbds.CredentialUsage{
+			BizId:        bizID,
+			CredentialId: one.CredentialId,
+			ClientUid:    one.ClientUid,
+			UsedAt:       one.UsedAt,
+		})
+	}
+	value, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	return c.bds.RPush(kit.Ctx, keys.Key.CredentialUsageKey(bizID), value)
+}
+
+// BatchUpdateCredentialUsage 批量更新凭证使用记录
+func (c *client) BatchUpdateCredentialUsage(kit *kit.Kit, usages []*pbds.CredentialUsage) error {
+	if _, err := c.db.BatchUpdateCredentialUsage(kit.Ctx, &pbds.BatchUpdateCredentialUsageReq{
+		Usages: usages,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -111,14 +111,7 @@ func (c *client) queryCredentialFromCahce(kt *kit.Kit, bizID uint32, credential
 		}
 		return "", 0, err
 	}
-	scope := make([]string, 0, len(details))
-	for _, detail := range details {
-		scope = append(scope, string(detail.Spec.CredentialScope))
-	}
-	credentialCache := &types.CredentialCache{
-		Enabled: cred.Spec.Enable,
-		Scope:   scope,
-	}
+	credentialCache := types.NewCredentialCache(cred, details)
 	b, err := jsoni.Marshal(credentialCache)
 	if err != nil {
 		logs.Errorf("marshal credential: %d-%s,failed, err: %v", bizID, credential, err)
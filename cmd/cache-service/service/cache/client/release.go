@@ -136,7 +136,14 @@ func (c *client) refreshReleasedCICache(kt *kit.Kit, bizID uint32, releaseID uin
 		return "", fmt.Errorf("no release detail found for id %d", releaseID)
 	}
 
-	js, err := jsoni.Marshal(types.ReleaseCICaches(releasedCIs, releases[0].Spec.Name))
+	groupCIs, err := c.op.ReleasedGroupCI().ListAllByReleaseIDs(kt, []uint32{releaseID}, bizID)
+	if err != nil {
+		logs.Errorf("get biz: %d release: %d group CI from db failed, err: %v, rid: %s", bizID, releaseID, err,
+			kt.Rid)
+		return "", err
+	}
+
+	js, err := jsoni.Marshal(types.ReleaseCICaches(releasedCIs, groupCIs, releases[0].Spec.Name))
 	if err != nil {
 		return "", err
 	}
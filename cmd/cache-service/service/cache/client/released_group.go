@@ -22,6 +22,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbrg "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/released-group"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
 	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 )
@@ -126,7 +127,15 @@ func (c *client) queryAppReleasedGroups(kt *kit.Kit, bizID uint32, appID uint32)
 		return "", 0, err
 	}
 
-	b, err := jsoni.Marshal(groups)
+	list := pbrg.CacheReleasedGroups(groups)
+	releases, err := c.op.Release().ListAllByIDs(kt, pbrg.CacheReleaseIDs(list), bizID)
+	if err != nil {
+		logs.Errorf("list app: %d, released group releases failed, err: %v", appID, err)
+		return "", 0, err
+	}
+	pbrg.FillCacheReleaseTags(list, releases)
+
+	b, err := jsoni.Marshal(list)
 	if err != nil {
 		logs.Errorf("marshal app: %d, released group list failed, err: %v", appID, err)
 		return "", 0, err
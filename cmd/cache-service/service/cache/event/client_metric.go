@@ -86,6 +86,7 @@ func (cm *ClientMetric) Run() {
 				}
 				cm.consumeClientMetricData(kt)
 				cm.consumeAppLastConsumedTime(kt)
+				cm.consumeCredentialUsage(kt)
 			}
 		}
 	}()
@@ -24,6 +24,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbrg "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/released-group"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
 	"github.com/TencentBlueKing/bk-bscp/pkg/types"
 )
@@ -339,6 +340,17 @@ func (c *consumer) cacheReleasedCI(kt *kit.Kit, releaseBizID map[uint32]uint32,
 				err, kt.Rid)
 			return err
 		}
+		releasedGroupCI, err := c.op.ReleasedGroupCI().ListAllByReleaseIDs(bizKit, releaseIDs, bizID)
+		if err != nil {
+			logs.Errorf("list released group ci failed, bizID: %d, releaseIDs: %v, err: %v, rid: %s", bizID,
+				releaseIDs, err, kt.Rid)
+			return err
+		}
+		groupCIList := make(map[uint32][]*table.ReleasedGroupConfigItem)
+		for _, one := range releasedGroupCI {
+			groupCIList[one.Attachment.ReleaseID] = append(groupCIList[one.Attachment.ReleaseID], one)
+		}
+
 		var releaseDetail = make(map[uint32]*table.Release)
 		for _, r := range releases {
 			releaseDetail[r.ID] = r
@@ -361,7 +373,7 @@ func (c *consumer) cacheReleasedCI(kt *kit.Kit, releaseBizID map[uint32]uint32,
 				logs.Errorf("get release detail for id %d failed, rid: %s", list[0].ReleaseID, kt.Rid)
 				continue
 			}
-			js, err = json.Marshal(types.ReleaseCICaches(list, release.Spec.Name))
+			js, err = json.Marshal(types.ReleaseCICaches(list, groupCIList[release.ID], release.Spec.Name))
 			if err != nil {
 				logs.Errorf("marshal ci list failed, skip, list: %+v, err: %v, rid: %s", list, err, kt.Rid)
 				continue
@@ -490,14 +502,24 @@ func (c *consumer) cacheOneReleasedGroup(kt *kit.Kit, bizID, appID uint32, tenan
 		logs.Errorf("get biz: %d, app: %d all the released groups failed, err: %v, rid: %s", bizID, appID, err, kt.Rid)
 		return nil, err
 	}
+	list := pbrg.CacheReleasedGroups(groups)
+	releaseIDs := pbrg.CacheReleaseIDs(list)
 	releaseBizID := make(map[uint32]uint32, 0)
-	for _, one := range groups {
-		// record published release id, these will be used to add released config item cache.
-		releaseBizID[one.ReleaseID] = bizID
+	for _, id := range releaseIDs {
+		// record published and pinned release id, these will be used to add released config item cache.
+		releaseBizID[id] = bizID
 	}
 
+	releases, err := c.op.Release().ListAllByIDs(bizKit, releaseIDs, bizID)
+	if err != nil {
+		logs.Errorf("list biz: %d, app: %d released group releases failed, err: %v, rid: %s", bizID, appID, err,
+			kt.Rid)
+		return nil, err
+	}
+	pbrg.FillCacheReleaseTags(list, releases)
+
 	var b []byte
-	b, err = jsoni.Marshal(groups)
+	b, err = jsoni.Marshal(list)
 	if err != nil {
 		logs.Errorf("marshal app: %d, released group list failed, err: %v", appID, err)
 		return nil, err
@@ -594,14 +616,7 @@ func (c *consumer) refreshCredentialCache(kt *kit.Kit, events []*table.Event) er
 			}
 			return err
 		}
-		scope := make([]string, 0, len(details))
-		for _, detail := range details {
-			scope = append(scope, string(detail.Spec.CredentialScope))
-		}
-		credentialCache := &types.CredentialCache{
-			Enabled: cred.Spec.Enable,
-			Scope:   scope,
-		}
+		credentialCache := types.NewCredentialCache(cred, details)
 		b, err := jsoni.Marshal(credentialCache)
 		if err != nil {
 			logs.Errorf("marshal credential: %d-%s,failed, err: %v", cred.Attachment.BizID, event.Spec.ResourceUid, err)
@@ -0,0 +1,108 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package event
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+const (
+	credentialUsagePattern = `*bscp:credential-usage:*`
+)
+
+// consumeCredentialUsage 消费队列中的凭证使用记录
+func (cm *ClientMetric) consumeCredentialUsage(kt *kit.Kit) {
+	keys, err := cm.bds.Keys(kt.Ctx, credentialUsagePattern)
+	if err != nil {
+		logs.Errorf("the KEY is not matched, err: %s, rid: %s", err.Error(), kt.Rid)
+		return
+	}
+	if len(keys) == 0 {
+		logs.V(2).Infof("there is no matching credential usage KEY, rid: %s", kt.Rid)
+		return
+	}
+	for _, key := range keys {
+		lLen, err := cm.bds.LLen(kt.Ctx, key)
+		if err != nil {
+			logs.Errorf("get key: %s list length failed, err: %s", key, err.Error())
+			continue
+		}
+		if lLen != 0 {
+			cm.getCredentialUsageList(kt, key, lLen)
+		}
+	}
+}
+
+func (cm *ClientMetric) getCredentialUsageList(kt *kit.Kit, key string, listLen int64) {
+	batchSize := 1000
+	for i := 0; i < int(listLen); i += batchSize {
+		startIndex := int64(i)
+		endIndex := int64(i + batchSize - 1)
+		if endIndex >= listLen {
+			endIndex = listLen - 1
+		}
+		list, err := cm.bds.LRange(kt.Ctx, key, startIndex, endIndex)
+		if err != nil {
+			logs.Errorf("get key: %s %v to %v credential usage failed, rid: %s, err: %s", key,
+				startIndex, endIndex, kt.Rid, err.Error())
+			continue
+		}
+		usages := parseCredentialUsage(list)
+		if len(usages) != 0 {
+			if errB := cm.op.BatchUpdateCredentialUsage(kt, usages); errB != nil {
+				logs.Errorf("batch update credential usage failed, rid: %s, err: %s", kt.Rid, errB.Error())
+				continue
+			}
+		}
+
+		_, err = cm.bds.LTrim(kt.Ctx, key, endIndex+1, -1)
+		if err != nil {
+			logs.Errorf("delete the Specify keys values data failed, key: %s, rid: %s, err: %s", key, kt.Rid, err.Error())
+			continue
+		}
+	}
+}
+
+// parseCredentialUsage 解析并合并使用记录，同一凭证同一客户端只保留最近一次
+func parseCredentialUsage(list []string) []*pbds.CredentialUsage {
+	type usageKey struct {
+		credentialID uint32
+		clientUID    string
+	}
+	merged := make(map[usageKey]*pbds.CredentialUsage)
+	result := make([]*pbds.CredentialUsage, 0)
+	for _, item := range list {
+		usages := make([]*pbds.CredentialUsage, 0)
+		if err := json.Unmarshal([]byte(item), &usages); err != nil {
+			logs.Errorf("parse credential usage %s failed, err: %v", item, err)
+			continue
+		}
+		for _, one := range usages {
+			k := usageKey{credentialID: one.CredentialId, clientUID: one.ClientUid}
+			if exist, ok := merged[k]; ok {
+				if one.UsedAt > exist.UsedAt {
+					exist.UsedAt = one.UsedAt
+				}
+				continue
+			}
+			merged[k] = one
+			result = append(result, one)
+		}
+	}
+
+	return result
+}
@@ -51,6 +51,7 @@ const (
 	clientMetric        namespace = "client-metric"
 	publish             namespace = "publish"
 	appLastConsumedTime namespace = "app-last-consumed-time"
+	credentialUsage     namespace = "credential-usage"
 	tenantID            namespace = "tenant-id"
 )
 
@@ -93,6 +94,15 @@ func (k keyGenerator) LastConsumedTimeKey(bizID uint32) string {
 	}.String()
 }
 
+// CredentialUsageKey generate the credential usage cache key.
+func (k keyGenerator) CredentialUsageKey(bizID uint32) string {
+	return element{
+		biz: bizID,
+		ns:  credentialUsage,
+		key: "credential-usage",
+	}.String()
+}
+
 // ReleasedGroup generate a release's released group cache key to save all the released groups under this release
 func (k keyGenerator) ReleasedGroup(bizID uint32, appID uint32) string {
 	return element{
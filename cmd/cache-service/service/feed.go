@@ -266,6 +266,17 @@ func (s *Service) SetAppLastConsumedTime(ctx context.Context, req *pbcs.SetAppLa
 	return &pbcs.SetAppLastConsumedTimeResp{}, nil
 }
 
+// SetCredentialUsage 设置凭证使用记录
+func (s *Service) SetCredentialUsage(ctx context.Context, req *pbcs.SetCredentialUsageReq) (
+	*pbcs.SetCredentialUsageResp, error) {
+
+	kt := kit.FromGrpcContext(ctx)
+	if err := s.op.SetCredentialUsage(kt, req.GetBizId(), req.GetUsages()); err != nil {
+		return nil, err
+	}
+	return &pbcs.SetCredentialUsageResp{}, nil
+}
+
 // SetPublishTime set publish time
 func (s *Service) SetPublishTime(ctx context.Context, req *pbcs.SetPublishTimeReq) (*pbcs.SetPublishTimeResp,
 	error) {
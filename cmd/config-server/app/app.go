@@ -32,6 +32,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/tracer"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/uuid"
@@ -88,9 +89,9 @@ func (ds *configServer) prepare(opt *options.Option) error {
 		strconv.Itoa(int(cc.ConfigServer().Network.RpcPort))))
 	metrics.Register().MustRegister(metrics.BSCPServerHandledTotal)
 
-	etcdOpt, err := cc.ConfigServer().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
+	// init tracing
+	if err := tracer.Setup(cc.ConfigServerName); err != nil {
+		return fmt.Errorf("setup tracing failed, err: %v", err)
 	}
 
 	// register data service.
@@ -100,7 +101,7 @@ func (ds *configServer) prepare(opt *options.Option) error {
 		Port: cc.ConfigServer().Network.RpcPort,
 		Uid:  uuid.UUID(),
 	}
-	sd, err := serviced.NewServiceD(etcdOpt, svcOpt)
+	sd, err := serviced.NewServiceDWithConf(cc.ConfigServer().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service discovery faield, err: %v", err)
 	}
@@ -125,6 +126,7 @@ func (ds *configServer) listenAndServe() error {
 
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(math.MaxInt32),
 		grpc.ChainUnaryInterceptor(
+			brpc.TraceUnaryServerInterceptor(),
 			brpc.LogUnaryServerInterceptor(),
 			brpc.TenantUnaryServerInterceptor(),
 			brpc.GrpcServerHandledTotalInterceptor(),
@@ -133,6 +135,7 @@ func (ds *configServer) listenAndServe() error {
 			audit.UnaryServerInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
+			brpc.TraceStreamServerInterceptor(),
 			grpcMetrics.StreamServerInterceptor(),
 			brpc.TenantStreamServerInterceptor(),
 			grpc_recovery.StreamServerInterceptor(recoveryOpt),
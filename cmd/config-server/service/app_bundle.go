@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// ExportAppBundle export the manifest of app bundle
+func (s *Service) ExportAppBundle(ctx context.Context, req *pbcs.ExportAppBundleReq) (
+	*pbcs.ExportAppBundleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	// the values of the secret kvs and variables are only exported to the one who can update the app.
+	if req.IncludeSecrets {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId})
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ExportAppBundle(kt.RpcCtx(), &pbds.ExportAppBundleReq{
+		BizId:          req.BizId,
+		AppId:          req.AppId,
+		IncludeSecrets: req.IncludeSecrets,
+		Passphrase:     req.Passphrase,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.ExportAppBundleResp{Manifest: rp.Manifest}, nil
+}
+
+// ImportAppBundle import the manifest of app bundle, the contents of config items should be uploaded before.
+func (s *Service) ImportAppBundle(ctx context.Context, req *pbcs.ImportAppBundleReq) (
+	*pbcs.ImportAppBundleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	r := &pbds.ImportAppBundleReq{
+		BizId:          req.BizId,
+		Manifest:       req.Manifest,
+		DryRun:         true,
+		ConflictPolicy: req.ConflictPolicy,
+		AppName:        req.AppName,
+		Passphrase:     req.Passphrase,
+	}
+	// the dry run is used to find out whether the app is created or updated by the import.
+	rp, err := s.client.DS.ImportAppBundle(kt.RpcCtx(), r)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if rp.AppId > 0 {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: rp.AppId}, BizID: req.BizId})
+	} else {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: meta.Create}, BizID: req.BizId})
+	}
+	if err = s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	if !req.DryRun {
+		r.DryRun = false
+		if rp, err = s.client.DS.ImportAppBundle(kt.RpcCtx(), r); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &pbcs.ImportAppBundleResp{
+		AppId:   rp.AppId,
+		AppName: rp.AppName,
+		DryRun:  rp.DryRun,
+		Actions: make([]*pbcs.ImportAppBundleResp_Action, 0, len(rp.Actions)),
+	}
+	for _, one := range rp.Actions {
+		resp.Actions = append(resp.Actions, &pbcs.ImportAppBundleResp_Action{
+			ResourceType: one.ResourceType,
+			Name:         one.Name,
+			Action:       one.Action,
+			TargetName:   one.TargetName,
+			SourceId:     one.SourceId,
+			TargetId:     one.TargetId,
+			Message:      one.Message,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,156 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// SetApprovalFlow set the builtin approval flow of the app
+func (s *Service) SetApprovalFlow(ctx context.Context, req *pbcs.SetApprovalFlowReq) (*pbcs.SetApprovalFlowResp,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.SetApprovalFlow(kt.RpcCtx(), &pbds.SetApprovalFlowReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Spec:  req.Spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.SetApprovalFlowResp{}, nil
+}
+
+// GetApprovalFlow get the builtin approval flow of the app
+func (s *Service) GetApprovalFlow(ctx context.Context, req *pbcs.GetApprovalFlowReq) (*pbcs.GetApprovalFlowResp,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.GetApprovalFlow(kt.RpcCtx(), &pbds.GetApprovalFlowReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.GetApprovalFlowResp{Flow: rp}, nil
+}
+
+// GetApprovalTicket get the builtin approval ticket of the release with its actions
+func (s *Service) GetApprovalTicket(ctx context.Context, req *pbcs.GetApprovalTicketReq) (
+	*pbcs.GetApprovalTicketResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.GetApprovalTicket(kt.RpcCtx(), &pbds.GetApprovalTicketReq{
+		BizId:      req.BizId,
+		AppId:      req.AppId,
+		ReleaseId:  req.ReleaseId,
+		StrategyId: req.StrategyId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.GetApprovalTicketResp{
+		Ticket:           rp.Ticket,
+		Actions:          rp.Actions,
+		PendingApprovers: rp.PendingApprovers,
+	}, nil
+}
+
+// DelegateApproval delegate the approval of the current stage to another user
+func (s *Service) DelegateApproval(ctx context.Context, req *pbcs.DelegateApprovalReq) (
+	*pbcs.DelegateApprovalResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	// 与审批通过和驳回一致，转审无需服务权限，由审批引擎校验是否为当前阶段的审批人
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DelegateApproval(kt.RpcCtx(), &pbds.DelegateApprovalReq{
+		BizId:      req.BizId,
+		AppId:      req.AppId,
+		ReleaseId:  req.ReleaseId,
+		StrategyId: req.StrategyId,
+		DelegateTo: req.DelegateTo,
+		Comment:    req.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.DelegateApprovalResp{}, nil
+}
+
+// CommentApproval comment on the builtin approval ticket
+func (s *Service) CommentApproval(ctx context.Context, req *pbcs.CommentApprovalReq) (*pbcs.CommentApprovalResp,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.CommentApproval(kt.RpcCtx(), &pbds.CommentApprovalReq{
+		BizId:      req.BizId,
+		AppId:      req.AppId,
+		ReleaseId:  req.ReleaseId,
+		StrategyId: req.StrategyId,
+		Comment:    req.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.CommentApprovalResp{}, nil
+}
@@ -0,0 +1,170 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbcf "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/change-freeze"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateChangeFreeze create change freeze window
+func (s *Service) CreateChangeFreeze(ctx context.Context, req *pbcs.CreateChangeFreezeReq) (
+	*pbcs.CreateChangeFreezeResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeChangeFreeze(kt, req.BizId, req.AppId, meta.Update); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateChangeFreeze(kt.RpcCtx(), &pbds.CreateChangeFreezeReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Spec:  req.Spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.CreateChangeFreezeResp{Id: rp.Id}, nil
+}
+
+// UpdateChangeFreeze update change freeze window
+func (s *Service) UpdateChangeFreeze(ctx context.Context, req *pbcs.UpdateChangeFreezeReq) (
+	*pbcs.UpdateChangeFreezeResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeChangeFreezeByID(kt, req.BizId, req.Id); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateChangeFreeze(kt.RpcCtx(), &pbds.UpdateChangeFreezeReq{
+		Id:    req.Id,
+		BizId: req.BizId,
+		Spec:  req.Spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.UpdateChangeFreezeResp{}, nil
+}
+
+// DeleteChangeFreeze delete change freeze window
+func (s *Service) DeleteChangeFreeze(ctx context.Context, req *pbcs.DeleteChangeFreezeReq) (
+	*pbcs.DeleteChangeFreezeResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeChangeFreezeByID(kt, req.BizId, req.Id); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteChangeFreeze(kt.RpcCtx(), &pbds.DeleteChangeFreezeReq{
+		Id:    req.Id,
+		BizId: req.BizId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.DeleteChangeFreezeResp{}, nil
+}
+
+// ListChangeFreezes list change freeze windows of the biz or the app
+func (s *Service) ListChangeFreezes(ctx context.Context, req *pbcs.ListChangeFreezesReq) (
+	*pbcs.ListChangeFreezesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeChangeFreeze(kt, req.BizId, req.AppId, meta.View); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListChangeFreezes(kt.RpcCtx(), &pbds.ListChangeFreezesReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.ListChangeFreezesResp{Details: rp.Details}, nil
+}
+
+// GetChangeFreezeStatus get the active change freeze windows of the biz or the app, and whether the current
+// user's change is frozen
+func (s *Service) GetChangeFreezeStatus(ctx context.Context, req *pbcs.GetChangeFreezeStatusReq) (
+	*pbcs.GetChangeFreezeStatusResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeChangeFreeze(kt, req.BizId, req.AppId, meta.View); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListChangeFreezes(kt.RpcCtx(), &pbds.ListChangeFreezesReq{
+		BizId:       req.BizId,
+		AppId:       req.AppId,
+		EnabledOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	freezes := pbcf.ChangeFreezes(rp.Details)
+	active := make([]*table.ChangeFreeze, 0)
+	for _, one := range freezes {
+		if one.Covers(req.AppId) && one.Spec.ActiveAt(now) {
+			active = append(active, one)
+		}
+	}
+	frozen, overridden := table.CheckChangeFreeze(active, req.AppId, kt.User, now)
+
+	return &pbcs.GetChangeFreezeStatusResp{
+		Frozen:     frozen != nil,
+		BreakGlass: frozen == nil && len(overridden) > 0,
+		Active:     pbcf.PbChangeFreezes(active),
+	}, nil
+}
+
+func (s *Service) authorizeChangeFreezeByID(kt *kit.Kit, bizID, id uint32) error {
+	freeze, err := s.client.DS.GetChangeFreeze(kt.RpcCtx(), &pbds.GetChangeFreezeReq{Id: id, BizId: bizID})
+	if err != nil {
+		return err
+	}
+
+	return s.authorizeChangeFreeze(kt, bizID, freeze.GetAttachment().GetAppId(), meta.Update)
+}
+
+// authorizeChangeFreeze the change freeze of an app requires the update permission of the app to be changed,
+// and the change freeze of the whole biz, which blocks the changes of all its apps, requires the biz manage
+// permission to be changed
+func (s *Service) authorizeChangeFreeze(kt *kit.Kit, bizID, appID uint32, action meta.Action) error {
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: bizID},
+	}
+	switch {
+	case appID > 0:
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: action, ResourceID: appID}, BizID: bizID})
+	case action != meta.View:
+		res = append(res, &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.Biz, Action: meta.Manage}, BizID: bizID})
+	}
+
+	return s.authorizer.Authorize(kt, res...)
+}
@@ -352,6 +352,31 @@ func (s *Service) ClientSpecificFailedReason(ctx context.Context, req *pbclient.
 	})
 }
 
+// ClientStageStatistics 统计客户端版本变更各阶段耗时
+func (s *Service) ClientStageStatistics(ctx context.Context, req *pbclient.ClientCommonReq) (
+	*structpb.Struct, error) {
+
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+
+	err := s.authorizer.Authorize(kt, res...)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DS.ClientStageStatistics(kt.RpcCtx(), &pbclient.ClientCommonReq{
+		BizId:             req.GetBizId(),
+		AppId:             req.GetAppId(),
+		Search:            req.GetSearch(),
+		LastHeartbeatTime: req.GetLastHeartbeatTime(),
+		PullTime:          req.GetPullTime(),
+	})
+}
+
 // RetryClients 重试客户端执行版本变更回调
 func (s *Service) RetryClients(ctx context.Context, req *pbcs.RetryClientsReq) (*pbcs.RetryClientsResp, error) {
 
@@ -0,0 +1,211 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateClientAlertRule create client alert rule
+func (s *Service) CreateClientAlertRule(ctx context.Context, req *pbcs.CreateClientAlertRuleReq) (
+	*pbcs.CreateClientAlertRuleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateClientAlertRule(kt.RpcCtx(), &pbds.CreateClientAlertRuleReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Spec:  req.Spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.CreateClientAlertRuleResp{Id: rp.Id}, nil
+}
+
+// UpdateClientAlertRule update client alert rule
+func (s *Service) UpdateClientAlertRule(ctx context.Context, req *pbcs.UpdateClientAlertRuleReq) (
+	*pbcs.UpdateClientAlertRuleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateClientAlertRule(kt.RpcCtx(), &pbds.UpdateClientAlertRuleReq{
+		Id:    req.Id,
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Spec:  req.Spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.UpdateClientAlertRuleResp{}, nil
+}
+
+// DeleteClientAlertRule delete client alert rule
+func (s *Service) DeleteClientAlertRule(ctx context.Context, req *pbcs.DeleteClientAlertRuleReq) (
+	*pbcs.DeleteClientAlertRuleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteClientAlertRule(kt.RpcCtx(), &pbds.DeleteClientAlertRuleReq{
+		Id:    req.Id,
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.DeleteClientAlertRuleResp{}, nil
+}
+
+// ListClientAlertRules list client alert rules
+func (s *Service) ListClientAlertRules(ctx context.Context, req *pbcs.ListClientAlertRulesReq) (
+	*pbcs.ListClientAlertRulesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListClientAlertRules(kt.RpcCtx(), &pbds.ListClientAlertRulesReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Start: req.Start,
+		Limit: req.Limit,
+		All:   req.All,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.ListClientAlertRulesResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// SilenceClientAlertRule silence client alert rule for a duration
+func (s *Service) SilenceClientAlertRule(ctx context.Context, req *pbcs.SilenceClientAlertRuleReq) (
+	*pbcs.SilenceClientAlertRuleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.SilenceClientAlertRule(kt.RpcCtx(), &pbds.SilenceClientAlertRuleReq{
+		Id:       req.Id,
+		BizId:    req.BizId,
+		AppId:    req.AppId,
+		Duration: req.Duration,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.SilenceClientAlertRuleResp{}, nil
+}
+
+// AckClientAlert acknowledge the firing client alert
+func (s *Service) AckClientAlert(ctx context.Context, req *pbcs.AckClientAlertReq) (
+	*pbcs.AckClientAlertResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.AckClientAlert(kt.RpcCtx(), &pbds.AckClientAlertReq{
+		Id:    req.Id,
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.AckClientAlertResp{}, nil
+}
+
+// ListClientAlertHistories list client alert histories
+func (s *Service) ListClientAlertHistories(ctx context.Context, req *pbcs.ListClientAlertHistoriesReq) (
+	*pbcs.ListClientAlertHistoriesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListClientAlertHistories(kt.RpcCtx(), &pbds.ListClientAlertHistoriesReq{
+		BizId:  req.BizId,
+		AppId:  req.AppId,
+		RuleId: req.RuleId,
+		Status: req.Status,
+		Start:  req.Start,
+		Limit:  req.Limit,
+		All:    req.All,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.ListClientAlertHistoriesResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
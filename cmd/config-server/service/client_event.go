@@ -37,15 +37,17 @@ func (s *Service) ListClientEvents(ctx context.Context, req *pbcs.ListClientEven
 	}
 
 	items, err := s.client.DS.ListClientEvents(kt.RpcCtx(), &pbds.ListClientEventsReq{
-		BizId:       req.GetBizId(),
-		AppId:       req.GetAppId(),
-		ClientId:    req.GetClientId(),
-		All:         req.GetAll(),
-		Limit:       req.GetLimit(),
-		Start:       req.GetStart(),
-		SearchValue: req.GetSearchValue(),
-		StartTime:   req.GetStartTime(),
-		EndTime:     req.GetEndTime(),
+		BizId:           req.GetBizId(),
+		AppId:           req.GetAppId(),
+		ClientId:        req.GetClientId(),
+		All:             req.GetAll(),
+		Limit:           req.GetLimit(),
+		Start:           req.GetStart(),
+		SearchValue:     req.GetSearchValue(),
+		StartTime:       req.GetStartTime(),
+		EndTime:         req.GetEndTime(),
+		TargetReleaseId: req.GetTargetReleaseId(),
+		CurrentStage:    req.GetCurrentStage(),
 		Order: &pbds.ListClientEventsReq_Order{
 			Asc:  req.GetOrder().GetAsc(),
 			Desc: req.GetOrder().GetDesc(),
@@ -38,10 +38,12 @@ func (s *Service) UpdateConfigHook(ctx context.Context,
 	}
 
 	r := &pbds.UpdateConfigHookReq{
-		BizId:      req.BizId,
-		AppId:      req.AppId,
-		PreHookId:  req.PreHookId,
-		PostHookId: req.PostHookId,
+		BizId:          req.BizId,
+		AppId:          req.AppId,
+		PreHookId:      req.PreHookId,
+		PostHookId:     req.PostHookId,
+		PreHookParams:  req.PreHookParams,
+		PostHookParams: req.PostHookParams,
 	}
 	if _, e := s.client.DS.UpdateConfigHook(grpcKit.RpcCtx(), r); e != nil {
 		logs.Errorf("update ConfigHook failed, err: %v, rid: %s", e, grpcKit.Rid)
@@ -41,6 +41,7 @@ func (s *Service) ListConfigInstances(ctx context.Context, req *pbcs.ListConfigI
 		ConfigTemplateId:         req.GetConfigTemplateId(),
 		ConfigTemplateVersionIds: req.GetConfigTemplateVersionIds(),
 		Search:                   req.GetSearch(),
+		DriftStatus:              req.GetDriftStatus(),
 		Start:                    req.GetStart(),
 		Limit:                    req.GetLimit(),
 		All:                      req.GetAll(),
@@ -129,6 +130,32 @@ func (s *Service) PushConfig(ctx context.Context, req *pbcs.PushConfigReq) (*pbc
 	return &pbcs.PushConfigResp{BatchId: dsResp.GetBatchId()}, nil
 }
 
+// RepushDriftedConfig implements pbcs.ConfigServer.
+// RepushDriftedConfig 重新下发漂移的配置
+func (s *Service) RepushDriftedConfig(ctx context.Context, req *pbcs.RepushDriftedConfigReq) (
+	*pbcs.RepushDriftedConfigResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.ProcConfigMgmt, Action: meta.ReleaseConfig}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	dsResp, err := s.client.DS.RepushDriftedConfig(grpcKit.RpcCtx(), &pbds.RepushDriftedConfigReq{
+		BizId:            req.GetBizId(),
+		ConfigTemplateId: req.GetConfigTemplateId(),
+		CcProcessIds:     req.GetCcProcessIds(),
+	})
+	if err != nil {
+		logs.Errorf("repush drifted config failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	return &pbcs.RepushDriftedConfigResp{BatchId: dsResp.GetBatchId()}, nil
+}
+
 // GetConfigRenderResult implements pbcs.ConfigServer.
 // GetConfigRenderResult 获取配置生成结果
 func (s *Service) GetConfigRenderResult(ctx context.Context, req *pbcs.GetConfigRenderResultReq) (*pbcs.GetConfigRenderResultResp, error) {
@@ -14,6 +14,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
@@ -26,6 +27,9 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 )
 
+// defaultCredentialGracePeriod 轮换密钥时旧密钥默认继续有效的时间
+const defaultCredentialGracePeriod = 24 * time.Hour
+
 // CreateCredentials create a credential
 func (s *Service) CreateCredentials(ctx context.Context,
 	req *pbcs.CreateCredentialReq) (*pbcs.CreateCredentialResp, error) {
@@ -63,6 +67,7 @@ func (s *Service) CreateCredentials(ctx context.Context,
 			Enable:         true,
 			EncAlgorithm:   encryptionAlgorithm,
 			EncCredential:  credential,
+			ExpiredAt:      req.ExpiredAt,
 		},
 	}
 
@@ -182,9 +187,10 @@ func (s *Service) UpdateCredential(ctx context.Context,
 			BizId: req.BizId,
 		},
 		Spec: &pbcredential.CredentialSpec{
-			Enable: req.Enable,
-			Name:   req.Name,
-			Memo:   req.Memo,
+			Enable:    req.Enable,
+			Name:      req.Name,
+			Memo:      req.Memo,
+			ExpiredAt: req.ExpiredAt,
 		},
 	}
 	_, err = s.client.DS.UpdateCredential(grpcKit.RpcCtx(), r)
@@ -196,6 +202,56 @@ func (s *Service) UpdateCredential(ctx context.Context,
 	return resp, nil
 }
 
+// RotateCredential rotate the credential, the previous one is still valid in the grace period.
+func (s *Service) RotateCredential(ctx context.Context,
+	req *pbcs.RotateCredentialReq) (*pbcs.RotateCredentialResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.Credential, Action: meta.Manage}, BizID: req.BizId},
+	}
+	err := s.authorizer.Authorize(grpcKit, res...)
+	if err != nil {
+		return nil, err
+	}
+
+	grace := uint32(defaultCredentialGracePeriod / time.Second)
+	if req.GracePeriodSeconds != nil {
+		grace = req.GetGracePeriodSeconds()
+	}
+
+	masterKey := cc.ConfigServer().Credential.MasterKey
+	encryptionAlgorithm := cc.ConfigServer().Credential.EncryptionAlgorithm
+
+	// create token
+	encCredential, err := tools.CreateCredential(masterKey, encryptionAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := tools.DecryptCredential(encCredential, masterKey, encryptionAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	previousExpiredAt := time.Now().UTC().Add(time.Duration(grace) * time.Second)
+	_, err = s.client.DS.RotateCredential(grpcKit.RpcCtx(), &pbds.RotateCredentialReq{
+		BizId:              req.BizId,
+		Id:                 req.Id,
+		EncCredential:      encCredential,
+		GracePeriodSeconds: grace,
+	})
+	if err != nil {
+		logs.Errorf("rotate credential failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.RotateCredentialResp{
+		Credential:        credential,
+		PreviousExpiredAt: previousExpiredAt.Format(time.RFC3339),
+	}, nil
+}
+
 // CheckCredentialName Check if the credential name exists
 func (s *Service) CheckCredentialName(ctx context.Context, req *pbcs.CheckCredentialNameReq) (
 	*pbcs.CheckCredentialNameResp, error) {
@@ -239,12 +295,14 @@ func (s *Service) CredentialScopePreview(ctx context.Context, req *pbcs.Credenti
 	}
 
 	data, err := s.client.DS.CredentialScopePreview(grpcKit.RpcCtx(), &pbds.CredentialScopePreviewReq{
-		BizId:       req.BizId,
-		AppName:     req.AppName,
-		Scope:       req.Scope,
-		Limit:       req.Limit,
-		Start:       req.Start,
-		SearchValue: req.SearchValue,
+		BizId:          req.BizId,
+		AppName:        req.AppName,
+		Scope:          req.Scope,
+		Limit:          req.Limit,
+		Start:          req.Start,
+		SearchValue:    req.SearchValue,
+		KvKeyPattern:   req.KvKeyPattern,
+		KvKeyMatchType: req.KvKeyMatchType,
 	})
 	if err != nil {
 		return resp, err
@@ -0,0 +1,171 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateGitRepoLink create git repository link
+func (s *Service) CreateGitRepoLink(ctx context.Context, req *pbcs.CreateGitRepoLinkReq) (
+	*pbcs.CreateGitRepoLinkResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeGitRepoLink(kt, req.BizId, req.AppId); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateGitRepoLink(kt.RpcCtx(), &pbds.CreateGitRepoLinkReq{
+		BizId:           req.BizId,
+		AppId:           req.AppId,
+		TemplateSpaceId: req.TemplateSpaceId,
+		Spec:            req.Spec,
+		Token:           req.Token,
+		WebhookToken:    req.WebhookToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.CreateGitRepoLinkResp{Id: rp.Id}, nil
+}
+
+// UpdateGitRepoLink update git repository link
+func (s *Service) UpdateGitRepoLink(ctx context.Context, req *pbcs.UpdateGitRepoLinkReq) (
+	*pbcs.UpdateGitRepoLinkResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeGitRepoLinkByID(kt, req.BizId, req.Id); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateGitRepoLink(kt.RpcCtx(), &pbds.UpdateGitRepoLinkReq{
+		Id:           req.Id,
+		BizId:        req.BizId,
+		Spec:         req.Spec,
+		Token:        req.Token,
+		WebhookToken: req.WebhookToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.UpdateGitRepoLinkResp{}, nil
+}
+
+// DeleteGitRepoLink delete git repository link
+func (s *Service) DeleteGitRepoLink(ctx context.Context, req *pbcs.DeleteGitRepoLinkReq) (
+	*pbcs.DeleteGitRepoLinkResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeGitRepoLinkByID(kt, req.BizId, req.Id); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteGitRepoLink(kt.RpcCtx(), &pbds.DeleteGitRepoLinkReq{
+		Id:    req.Id,
+		BizId: req.BizId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.DeleteGitRepoLinkResp{}, nil
+}
+
+// ListGitRepoLinks list git repository links of the app or the template space
+func (s *Service) ListGitRepoLinks(ctx context.Context, req *pbcs.ListGitRepoLinksReq) (
+	*pbcs.ListGitRepoLinksResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if req.AppId > 0 {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId})
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListGitRepoLinks(kt.RpcCtx(), &pbds.ListGitRepoLinksReq{
+		BizId:           req.BizId,
+		AppId:           req.AppId,
+		TemplateSpaceId: req.TemplateSpaceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.ListGitRepoLinksResp{Details: rp.Details}, nil
+}
+
+// SyncGitRepoLink sync git repository link, the request with webhook token is triggered by webhook
+// and it's verified by the token instead of the user's permission
+func (s *Service) SyncGitRepoLink(ctx context.Context, req *pbcs.SyncGitRepoLinkReq) (
+	*pbcs.SyncGitRepoLinkResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if req.WebhookToken == "" {
+		if err := s.authorizeGitRepoLinkByID(kt, req.BizId, req.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	rp, err := s.client.DS.SyncGitRepoLink(kt.RpcCtx(), &pbds.SyncGitRepoLinkReq{
+		Id:           req.Id,
+		BizId:        req.BizId,
+		WebhookToken: req.WebhookToken,
+		Force:        req.Force,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.SyncGitRepoLinkResp{
+		SyncedSha: rp.SyncedSha,
+		Changed:   rp.Changed,
+		Skipped:   rp.Skipped,
+	}, nil
+}
+
+// authorizeGitRepoLinkByID authorize the operation on the git repository link with the app or
+// the template space which it belongs to
+func (s *Service) authorizeGitRepoLinkByID(kt *kit.Kit, bizID, id uint32) error {
+	link, err := s.client.DS.GetGitRepoLink(kt.RpcCtx(), &pbds.GetGitRepoLinkReq{Id: id, BizId: bizID})
+	if err != nil {
+		return err
+	}
+
+	return s.authorizeGitRepoLink(kt, bizID, link.GetAttachment().GetAppId())
+}
+
+// authorizeGitRepoLink the link of an app requires the update permission of the app, and the link of
+// a template space requires the biz permission as the templates do
+func (s *Service) authorizeGitRepoLink(kt *kit.Kit, bizID, appID uint32) error {
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: bizID},
+	}
+	if appID > 0 {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: appID}, BizID: bizID})
+	}
+
+	return s.authorizer.Authorize(kt, res...)
+}
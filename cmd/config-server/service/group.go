@@ -56,12 +56,13 @@ func (s *Service) CreateGroup(ctx context.Context, req *pbcs.CreateGroupReq) (*p
 			BizId: req.BizId,
 		},
 		Spec: &pbgroup.GroupSpec{
-			Name:     req.Name,
-			Public:   req.Public,
-			BindApps: req.BindApps,
-			Mode:     req.Mode,
-			Selector: req.Selector,
-			Uid:      req.Uid,
+			Name:          req.Name,
+			Public:        req.Public,
+			BindApps:      req.BindApps,
+			Mode:          req.Mode,
+			Selector:      req.Selector,
+			Uid:           req.Uid,
+			ClientQueryId: req.ClientQueryId,
 		},
 	}
 	rp, err := s.client.DS.CreateGroup(grpcKit.RpcCtx(), r)
@@ -193,12 +194,13 @@ func (s *Service) UpdateGroup(ctx context.Context, req *pbcs.UpdateGroupReq) (*p
 			BizId: req.BizId,
 		},
 		Spec: &pbgroup.GroupSpec{
-			Name:     req.Name,
-			Public:   req.Public,
-			BindApps: req.BindApps,
-			Mode:     req.Mode,
-			Selector: req.Selector,
-			Uid:      req.Uid,
+			Name:          req.Name,
+			Public:        req.Public,
+			BindApps:      req.BindApps,
+			Mode:          req.Mode,
+			Selector:      req.Selector,
+			Uid:           req.Uid,
+			ClientQueryId: req.ClientQueryId,
 		},
 	}
 	_, err = s.client.DS.UpdateGroup(grpcKit.RpcCtx(), r)
@@ -383,6 +385,11 @@ func (s *Service) ListGroupReleasedApps(ctx context.Context, req *pbcs.ListGroup
 			ReleaseId:   detail.ReleaseId,
 			ReleaseName: detail.ReleaseName,
 			Edited:      detail.Edited,
+			// 分组被固定时客户端实际使用固定的版本
+			PinnedReleaseId:   detail.PinnedReleaseId,
+			PinnedReleaseName: detail.PinnedReleaseName,
+			PinnedUntil:       detail.PinnedUntil,
+			PinMemo:           detail.PinMemo,
 		}
 	}
 	resp.Details = data
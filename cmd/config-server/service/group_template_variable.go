@@ -0,0 +1,130 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// UpdateGroupTmplVariables update the template variables overridden by the group of the app
+func (s *Service) UpdateGroupTmplVariables(ctx context.Context, req *pbcs.UpdateGroupTmplVariablesReq) (
+	*pbcs.UpdateGroupTmplVariablesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.UpdateGroupTmplVariablesReq{
+		BizId:     req.BizId,
+		AppId:     req.AppId,
+		GroupId:   req.GroupId,
+		Variables: req.Variables,
+	}
+	if _, err := s.client.DS.UpdateGroupTmplVariables(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("update group template variables failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateGroupTmplVariablesResp{}, nil
+}
+
+// ListGroupTmplVariables list the template variables overridden by the group of the app
+func (s *Service) ListGroupTmplVariables(ctx context.Context, req *pbcs.ListGroupTmplVariablesReq) (
+	*pbcs.ListGroupTmplVariablesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListGroupTmplVariables(grpcKit.RpcCtx(), &pbds.ListGroupTmplVariablesReq{
+		BizId:   req.BizId,
+		AppId:   req.AppId,
+		GroupId: req.GroupId,
+	})
+	if err != nil {
+		logs.Errorf("list group template variables failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListGroupTmplVariablesResp{
+		Details: rp.Details,
+	}, nil
+}
+
+// TraceAppTmplVariables trace the values of the app template variables in the biz, app and group layers
+func (s *Service) TraceAppTmplVariables(ctx context.Context, req *pbcs.TraceAppTmplVariablesReq) (
+	*pbcs.TraceAppTmplVariablesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.TraceAppTmplVariables(grpcKit.RpcCtx(), &pbds.TraceAppTmplVariablesReq{
+		BizId:    req.BizId,
+		AppId:    req.AppId,
+		GroupIds: req.GroupIds,
+		Labels:   req.Labels,
+		Uid:      req.Uid,
+	})
+	if err != nil {
+		logs.Errorf("trace app template variables failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.TraceAppTmplVariablesResp{
+		MatchedGroupIds: rp.MatchedGroupIds,
+		Details:         make([]*pbcs.TraceAppTmplVariablesResp_Detail, 0, len(rp.Details)),
+	}
+	for _, d := range rp.Details {
+		detail := &pbcs.TraceAppTmplVariablesResp_Detail{
+			Name:          d.Name,
+			Type:          d.Type,
+			Value:         d.Value,
+			Source:        d.Source,
+			SourceGroupId: d.SourceGroupId,
+			Conflict:      d.Conflict,
+			Layers:        make([]*pbcs.TraceAppTmplVariablesResp_Layer, 0, len(d.Layers)),
+		}
+		for _, l := range d.Layers {
+			detail.Layers = append(detail.Layers, &pbcs.TraceAppTmplVariablesResp_Layer{
+				Scope:   l.Scope,
+				GroupId: l.GroupId,
+				Value:   l.Value,
+			})
+		}
+		resp.Details = append(resp.Details, detail)
+	}
+
+	return resp, nil
+}
@@ -394,11 +394,13 @@ func (s *Service) ListHookReferences(ctx context.Context,
 			ReleaseName:      detail.ReleaseName,
 			Type:             detail.Type,
 			Deprecated:       detail.Deprecated,
+			Params:           detail.Params,
 		})
 	}
 	resp := &pbcs.ListHookReferencesResp{
-		Count:   rp.Count,
-		Details: details,
+		Count:            rp.Count,
+		Details:          details,
+		TemplateSpaceIds: rp.TemplateSpaceIds,
 	}
 
 	return resp, nil
@@ -440,6 +442,7 @@ func (s *Service) GetReleaseHook(ctx context.Context, req *pbcs.GetReleaseHookRe
 			HookRevisionName: grhResp.PreHook.HookRevisionName,
 			Type:             grhResp.PreHook.Type,
 			Content:          grhResp.PreHook.Content,
+			Params:           grhResp.PreHook.Params,
 		}
 	}
 	if grhResp.PostHook != nil {
@@ -450,6 +453,7 @@ func (s *Service) GetReleaseHook(ctx context.Context, req *pbcs.GetReleaseHookRe
 			HookRevisionName: grhResp.PostHook.HookRevisionName,
 			Type:             grhResp.PostHook.Type,
 			Content:          grhResp.PostHook.Content,
+			Params:           grhResp.PostHook.Params,
 		}
 	}
 	resp := &pbcs.GetReleaseHookResp{
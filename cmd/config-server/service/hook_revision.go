@@ -46,6 +46,7 @@ func (s *Service) CreateHookRevision(ctx context.Context,
 			Name:    req.Name,
 			Content: req.Content,
 			Memo:    req.Memo,
+			Params:  req.Params,
 		},
 	}
 
@@ -214,6 +215,7 @@ func (s *Service) UpdateHookRevision(ctx context.Context, req *pbcs.UpdateHookRe
 			Name:    req.Name,
 			Content: req.Content,
 			Memo:    req.Memo,
+			Params:  req.Params,
 		},
 	}
 	if _, err := s.client.DS.UpdateHookRevision(grpcKit.RpcCtx(), r); err != nil {
@@ -0,0 +1,136 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/sandbox"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// TestHookRevision run a hook revision in the sandbox with the sample release env vars, the script runs on the
+// config server host, so it requires the permission to update the hook.
+func (s *Service) TestHookRevision(ctx context.Context, req *pbcs.TestHookRevisionReq) (
+	*pbcs.TestHookRevisionResp, error) {
+
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.Hook, Action: meta.Update, ResourceID: req.HookId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	opt := cc.ConfigServer().HookSandbox
+	if !opt.Enabled {
+		return nil, errf.Errorf(errf.FailedPrecondition, "%s", i18n.T(grpcKit, "hook sandbox is not enabled"))
+	}
+	if err := sandbox.ValidateEnv(req.Envs); err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, "%s", err.Error())
+	}
+
+	timeout := opt.TimeoutDuration()
+	if req.TimeoutSeconds > 0 {
+		if custom := time.Duration(req.TimeoutSeconds) * time.Second; custom < timeout {
+			timeout = custom
+		}
+	}
+
+	hook, err := s.client.DS.GetHook(grpcKit.RpcCtx(), &pbds.GetHookReq{BizId: req.BizId, HookId: req.HookId})
+	if err != nil {
+		logs.Errorf("get hook failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	revision, err := s.client.DS.GetHookRevisionByID(grpcKit.RpcCtx(), &pbds.GetHookRevisionByIdReq{
+		BizId:  req.BizId,
+		HookId: req.HookId,
+		Id:     req.RevisionId,
+	})
+	if err != nil {
+		logs.Errorf("get hook revision failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	interpreters := make(map[table.ScriptType]string, len(opt.Interpreters))
+	for typ, bin := range opt.Interpreters {
+		interpreters[table.ScriptType(typ)] = bin
+	}
+	runner := sandbox.New(sandbox.Options{
+		Timeout:        timeout,
+		MaxMemoryBytes: opt.MaxMemoryMB << 20,
+		MaxProcesses:   opt.MaxProcesses,
+		MaxFileBytes:   opt.MaxFileSizeMB << 20,
+		MaxOutputBytes: opt.MaxOutputKB << 10,
+		WorkDir:        opt.WorkDir,
+		Interpreters:   interpreters,
+	})
+
+	envs := sampleHookEnvs(req.BizId)
+	for k, v := range req.Envs {
+		envs[k] = v
+	}
+
+	result, err := runner.Run(grpcKit.Ctx, table.ScriptType(hook.Spec.Type), revision.Spec.Content, envs)
+	if err != nil {
+		logs.Errorf("test hook revision %d failed, err: %v, rid: %s", req.RevisionId, err, grpcKit.Rid)
+		if errors.Is(err, sandbox.ErrUnavailable) {
+			return nil, errf.Errorf(errf.FailedPrecondition, "%s",
+				i18n.T(grpcKit, "hook sandbox is unavailable, %s", err))
+		}
+		return nil, errf.Errorf(errf.Aborted, "%s", i18n.T(grpcKit, "test hook revision failed, %s", err))
+	}
+
+	return &pbcs.TestHookRevisionResp{
+		Stdout:     result.Stdout,
+		Stderr:     result.Stderr,
+		ExitCode:   int32(result.ExitCode),
+		DurationMs: result.Duration.Milliseconds(),
+		TimedOut:   result.TimedOut,
+		Truncated:  result.Truncated,
+		Envs:       envs,
+	}, nil
+}
+
+// sampleHookEnvs 客户端执行前置/后置脚本时注入的环境变量示例
+func sampleHookEnvs(bizID uint32) map[string]string {
+	const (
+		app       = "sample-app"
+		tempDir   = "/data/bscp"
+		version   = "v1.0.0"
+		configDir = "files"
+	)
+	appTempDir := path.Join(tempDir, fmt.Sprint(bizID), app)
+	return map[string]string{
+		"bk_bscp_biz":                  fmt.Sprint(bizID),
+		"bk_bscp_app":                  app,
+		"bk_bscp_temp_dir":             tempDir,
+		"bk_bscp_app_temp_dir":         appTempDir,
+		"bk_bscp_current_version_name": version,
+		"bk_bscp_current_config_dir":   path.Join(appTempDir, configDir),
+	}
+}
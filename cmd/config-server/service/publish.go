@@ -257,6 +257,111 @@ func (s *Service) Approve(ctx context.Context, req *pbcs.ApproveReq) (*pbcs.Appr
 	return resp, nil
 }
 
+// RollbackRelease rollback the release, the groups on it are restored to the release published before
+func (s *Service) RollbackRelease(ctx context.Context, req *pbcs.RollbackReleaseReq) (
+	*pbcs.RollbackReleaseResp, error) {
+
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	err := s.authorizer.Authorize(grpcKit, res...)
+	if err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.RollbackRelease(grpcKit.RpcCtx(), &pbds.RollbackReleaseReq{
+		BizId:     req.BizId,
+		AppId:     req.AppId,
+		ReleaseId: req.ReleaseId,
+		Groups:    req.Groups,
+		Memo:      req.Memo,
+	})
+	if err != nil {
+		logs.Errorf("rollback release failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	groups := make([]*pbcs.RollbackReleaseResp_Group, 0, len(rp.Groups))
+	for _, one := range rp.Groups {
+		groups = append(groups, &pbcs.RollbackReleaseResp_Group{
+			GroupId:     one.GroupId,
+			GroupName:   one.GroupName,
+			ReleaseId:   one.ReleaseId,
+			ReleaseName: one.ReleaseName,
+		})
+	}
+
+	return &pbcs.RollbackReleaseResp{
+		Groups:               groups,
+		Ids:                  rp.PublishedStrategyHistoryIds,
+		PublishStatus:        rp.PublishStatus,
+		RemainingClientCount: rp.RemainingClientCount,
+		RemainingClientUids:  rp.RemainingClientUids,
+	}, nil
+}
+
+// PinGroupRelease pin the group of the app to a release
+func (s *Service) PinGroupRelease(ctx context.Context, req *pbcs.PinGroupReleaseReq) (
+	*pbcs.PinGroupReleaseResp, error) {
+
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	err := s.authorizer.Authorize(grpcKit, res...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.DS.PinGroupRelease(grpcKit.RpcCtx(), &pbds.PinGroupReleaseReq{
+		BizId:       req.BizId,
+		AppId:       req.AppId,
+		GroupId:     req.GroupId,
+		ReleaseId:   req.ReleaseId,
+		PinnedUntil: req.PinnedUntil,
+		Memo:        req.Memo,
+	})
+	if err != nil {
+		logs.Errorf("pin group release failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.PinGroupReleaseResp{}, nil
+}
+
+// UnpinGroupRelease unpin the group of the app
+func (s *Service) UnpinGroupRelease(ctx context.Context, req *pbcs.UnpinGroupReleaseReq) (
+	*pbcs.UnpinGroupReleaseResp, error) {
+
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	err := s.authorizer.Authorize(grpcKit, res...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.DS.UnpinGroupRelease(grpcKit.RpcCtx(), &pbds.UnpinGroupReleaseReq{
+		BizId:   req.BizId,
+		AppId:   req.AppId,
+		GroupId: req.GroupId,
+	})
+	if err != nil {
+		logs.Errorf("unpin group release failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UnpinGroupReleaseResp{}, nil
+}
+
 // GenerateReleaseAndPublish generate release and publish
 func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbcs.GenerateReleaseAndPublishReq) (
 	*pbcs.PublishResp, error) {
@@ -46,6 +46,7 @@ func (s *Service) CreateRelease(ctx context.Context, req *pbcs.CreateReleaseReq)
 		Spec: &pbrelease.ReleaseSpec{
 			Name: req.Name,
 			Memo: req.Memo,
+			Tags: req.Tags,
 		},
 		Variables: req.Variables,
 	}
@@ -235,6 +236,106 @@ func (s *Service) DeleteRelease(ctx context.Context, req *pbcs.DeleteReleaseReq)
 	return resp, nil
 }
 
+// UpdateReleaseTags update the tags of a release
+func (s *Service) UpdateReleaseTags(ctx context.Context, req *pbcs.UpdateReleaseTagsReq) (
+	*pbcs.UpdateReleaseTagsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	err := s.authorizer.Authorize(kt, res...)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &pbds.UpdateReleaseTagsReq{
+		BizId:     req.BizId,
+		AppId:     req.AppId,
+		ReleaseId: req.ReleaseId,
+		Tags:      req.Tags,
+	}
+	_, err = s.client.DS.UpdateReleaseTags(kt.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("update tags of release %d failed, err: %v, rid: %s", req.ReleaseId, err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateReleaseTagsResp{}, nil
+}
+
+// PromoteRelease promote a release to another app of the biz
+func (s *Service) PromoteRelease(ctx context.Context, req *pbcs.PromoteReleaseReq) (*pbcs.PromoteReleaseResp,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	// the preview only needs to view the target app
+	if req.DryRun {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.TargetAppId}, BizID: req.BizId})
+	} else {
+		res = append(res,
+			&meta.ResourceAttribute{
+				Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.TargetAppId}, BizID: req.BizId},
+			&meta.ResourceAttribute{
+				Basic: meta.Basic{Type: meta.App, Action: meta.GenerateRelease, ResourceID: req.TargetAppId},
+				BizID: req.BizId})
+	}
+	err := s.authorizer.Authorize(kt, res...)
+	if err != nil {
+		return nil, err
+	}
+
+	remaps := make([]*pbds.PromoteReleaseReq_VariableRemap, 0, len(req.VariableRemaps))
+	for _, one := range req.VariableRemaps {
+		remaps = append(remaps, &pbds.PromoteReleaseReq_VariableRemap{
+			Name:       one.Name,
+			Value:      one.Value,
+			KeepTarget: one.KeepTarget,
+		})
+	}
+
+	rp, err := s.client.DS.PromoteRelease(kt.RpcCtx(), &pbds.PromoteReleaseReq{
+		BizId:          req.BizId,
+		AppId:          req.AppId,
+		ReleaseId:      req.ReleaseId,
+		TargetAppId:    req.TargetAppId,
+		Name:           req.Name,
+		Memo:           req.Memo,
+		Tags:           req.Tags,
+		VariableRemaps: remaps,
+		DryRun:         req.DryRun,
+	})
+	if err != nil {
+		logs.Errorf("promote release %d to app %d failed, err: %v, rid: %s", req.ReleaseId, req.TargetAppId, err,
+			kt.Rid)
+		return nil, err
+	}
+
+	changes := make([]*pbcs.PromoteReleaseResp_Change, 0, len(rp.Changes))
+	for _, one := range rp.Changes {
+		changes = append(changes, &pbcs.PromoteReleaseResp_Change{
+			ResourceType: one.ResourceType,
+			Name:         one.Name,
+			Action:       one.Action,
+			Source:       one.Source,
+			Target:       one.Target,
+			Message:      one.Message,
+		})
+	}
+
+	return &pbcs.PromoteReleaseResp{
+		ReleaseId: rp.ReleaseId,
+		DryRun:    rp.DryRun,
+		Changes:   changes,
+	}, nil
+}
+
 // CheckReleaseName 检测某个服务下已发布的名称是否存在
 func (s *Service) CheckReleaseName(ctx context.Context, req *pbcs.CheckReleaseNameReq) (
 	*pbcs.CheckReleaseNameResp, error) {
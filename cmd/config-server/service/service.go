@@ -24,6 +24,7 @@ import (
 
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	esbcli "github.com/TencentBlueKing/bk-bscp/internal/thirdparty/esb/client"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
@@ -85,6 +86,9 @@ func newClientSet(sd serviced.Discover, tls cc.TLSConfig) (*ClientSet, error) {
 
 	opts := make([]grpc.DialOption, 0)
 
+	// add tracing interceptors.
+	opts = append(opts, brpc.TraceDialOptions()...)
+
 	// add dial load balancer.
 	opts = append(opts, sd.LBRoundRobin())
 
@@ -17,6 +17,8 @@ import (
 	"fmt"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -266,3 +268,92 @@ func (s *Service) GetLatestTemplateVersionsInSpace(ctx context.Context, req *pbc
 		TemplateSet:   items,
 	}, nil
 }
+
+// ImportTemplateSpaceHooks import the hooks into the hook library of the template space
+func (s *Service) ImportTemplateSpaceHooks(ctx context.Context, req *pbcs.ImportTemplateSpaceHooksReq) (
+	*pbcs.ImportTemplateSpaceHooksResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if len(req.HookIds) == 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, "%s", i18n.T(grpcKit, "hook ids is required"))
+	}
+
+	r := &pbds.ImportTemplateSpaceHooksReq{
+		BizId:           req.BizId,
+		TemplateSpaceId: req.TemplateSpaceId,
+		HookIds:         req.HookIds,
+	}
+	if _, err := s.client.DS.ImportTemplateSpaceHooks(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("import template space hooks failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ImportTemplateSpaceHooksResp{}, nil
+}
+
+// ListTemplateSpaceHooks list the hooks in the hook library of the template space
+func (s *Service) ListTemplateSpaceHooks(ctx context.Context, req *pbcs.ListTemplateSpaceHooksReq) (
+	*pbcs.ListTemplateSpaceHooksResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.ListTemplateSpaceHooksReq{
+		BizId:           req.BizId,
+		TemplateSpaceId: req.TemplateSpaceId,
+	}
+	rp, err := s.client.DS.ListTemplateSpaceHooks(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("list template space hooks failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	details := make([]*pbcs.ListTemplateSpaceHooksResp_Detail, 0, len(rp.Details))
+	for _, one := range rp.Details {
+		details = append(details, &pbcs.ListTemplateSpaceHooksResp_Detail{
+			Hook:       one.Hook,
+			Params:     one.Params,
+			ImportedBy: one.ImportedBy,
+			ImportedAt: one.ImportedAt,
+		})
+	}
+
+	return &pbcs.ListTemplateSpaceHooksResp{Details: details}, nil
+}
+
+// DeleteTemplateSpaceHook remove the hook from the hook library of the template space
+func (s *Service) DeleteTemplateSpaceHook(ctx context.Context, req *pbcs.DeleteTemplateSpaceHookReq) (
+	*pbcs.DeleteTemplateSpaceHookResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.DeleteTemplateSpaceHookReq{
+		BizId:           req.BizId,
+		TemplateSpaceId: req.TemplateSpaceId,
+		HookId:          req.HookId,
+	}
+	if _, err := s.client.DS.DeleteTemplateSpaceHook(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("delete template space hook failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteTemplateSpaceHookResp{}, nil
+}
@@ -58,10 +58,11 @@ func (s *Service) CreateTemplateVariable(ctx context.Context, req *pbcs.CreateTe
 			BizId: grpcKit.BizID,
 		},
 		Spec: &pbtv.TemplateVariableSpec{
-			Name:       req.Name,
-			Type:       req.Type,
-			DefaultVal: req.DefaultVal,
-			Memo:       req.Memo,
+			Name:        req.Name,
+			Type:        req.Type,
+			DefaultVal:  req.DefaultVal,
+			Memo:        req.Memo,
+			Constraints: req.Constraints,
 		},
 	}
 	rp, err := s.client.DS.CreateTemplateVariable(grpcKit.RpcCtx(), r)
@@ -200,8 +201,9 @@ func (s *Service) UpdateTemplateVariable(ctx context.Context, req *pbcs.UpdateTe
 			BizId: grpcKit.BizID,
 		},
 		Spec: &pbtv.TemplateVariableSpec{
-			DefaultVal: req.DefaultVal,
-			Memo:       req.Memo,
+			DefaultVal:  req.DefaultVal,
+			Memo:        req.Memo,
+			Constraints: req.Constraints,
 		},
 	}
 	if _, err := s.client.DS.UpdateTemplateVariable(grpcKit.RpcCtx(), r); err != nil {
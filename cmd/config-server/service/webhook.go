@@ -0,0 +1,175 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateWebhook create webhook
+func (s *Service) CreateWebhook(ctx context.Context, req *pbcs.CreateWebhookReq) (*pbcs.CreateWebhookResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeWebhook(kt, req.BizId, req.AppId, meta.Update); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateWebhook(kt.RpcCtx(), &pbds.CreateWebhookReq{
+		BizId:  req.BizId,
+		AppId:  req.AppId,
+		Spec:   req.Spec,
+		Secret: req.Secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.CreateWebhookResp{Id: rp.Id}, nil
+}
+
+// UpdateWebhook update webhook
+func (s *Service) UpdateWebhook(ctx context.Context, req *pbcs.UpdateWebhookReq) (*pbcs.UpdateWebhookResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeWebhookByID(kt, req.BizId, req.Id, meta.Update); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateWebhook(kt.RpcCtx(), &pbds.UpdateWebhookReq{
+		Id:     req.Id,
+		BizId:  req.BizId,
+		Spec:   req.Spec,
+		Secret: req.Secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.UpdateWebhookResp{}, nil
+}
+
+// DeleteWebhook delete webhook and its deliveries
+func (s *Service) DeleteWebhook(ctx context.Context, req *pbcs.DeleteWebhookReq) (*pbcs.DeleteWebhookResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeWebhookByID(kt, req.BizId, req.Id, meta.Update); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteWebhook(kt.RpcCtx(), &pbds.DeleteWebhookReq{
+		Id:    req.Id,
+		BizId: req.BizId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.DeleteWebhookResp{}, nil
+}
+
+// ListWebhooks list webhooks of the biz or the app
+func (s *Service) ListWebhooks(ctx context.Context, req *pbcs.ListWebhooksReq) (*pbcs.ListWebhooksResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeWebhook(kt, req.BizId, req.AppId, meta.View); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListWebhooks(kt.RpcCtx(), &pbds.ListWebhooksReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.ListWebhooksResp{Details: rp.Details}, nil
+}
+
+// ListWebhookDeliveries list the delivery logs of the webhook
+func (s *Service) ListWebhookDeliveries(ctx context.Context, req *pbcs.ListWebhookDeliveriesReq) (
+	*pbcs.ListWebhookDeliveriesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeWebhookByID(kt, req.BizId, req.WebhookId, meta.View); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListWebhookDeliveries(kt.RpcCtx(), &pbds.ListWebhookDeliveriesReq{
+		BizId:     req.BizId,
+		WebhookId: req.WebhookId,
+		Status:    req.Status,
+		Start:     req.Start,
+		Limit:     req.Limit,
+		All:       req.All,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.ListWebhookDeliveriesResp{Count: rp.Count, Details: rp.Details}, nil
+}
+
+// RedeliverWebhookDelivery redeliver the event of the delivery
+func (s *Service) RedeliverWebhookDelivery(ctx context.Context, req *pbcs.RedeliverWebhookDeliveryReq) (
+	*pbcs.RedeliverWebhookDeliveryResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizeWebhookByID(kt, req.BizId, req.WebhookId, meta.Update); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.RedeliverWebhookDelivery(kt.RpcCtx(), &pbds.RedeliverWebhookDeliveryReq{
+		Id:        req.Id,
+		BizId:     req.BizId,
+		WebhookId: req.WebhookId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.RedeliverWebhookDeliveryResp{Id: rp.Id}, nil
+}
+
+func (s *Service) authorizeWebhookByID(kt *kit.Kit, bizID, id uint32, action meta.Action) error {
+	webhook, err := s.client.DS.GetWebhook(kt.RpcCtx(), &pbds.GetWebhookReq{Id: id, BizId: bizID})
+	if err != nil {
+		return err
+	}
+
+	return s.authorizeWebhook(kt, bizID, webhook.GetAttachment().GetAppId(), action)
+}
+
+// authorizeWebhook the webhooks post the events of the biz to outside, so changing a webhook requires the biz
+// manage permission, and the webhook of an app requires the permission of the app as well
+func (s *Service) authorizeWebhook(kt *kit.Kit, bizID, appID uint32, action meta.Action) error {
+	bizAction := meta.Manage
+	if action == meta.View {
+		bizAction = meta.FindBusinessResource
+	}
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: bizAction}, BizID: bizID},
+	}
+	if appID > 0 {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: action, ResourceID: appID}, BizID: bizID})
+	}
+
+	return s.authorizer.Authorize(kt, res...)
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/lock"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/tracer"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/internal/space"
 	"github.com/TencentBlueKing/bk-bscp/internal/task"
@@ -112,6 +113,7 @@ type dataService struct {
 	cmdb            bkcmdb.Service
 	cmdbRenderCache processorcmdb.RenderCache
 	gseSvc          *gse.Service
+	pm              pushmanager.Service
 }
 
 // prepare do prepare jobs before run data service.
@@ -130,13 +132,13 @@ func (ds *dataService) prepare(opt *options.Option) error {
 	metrics.InitMetrics(net.JoinHostPort(cc.DataService().Network.BindIP,
 		strconv.Itoa(int(cc.DataService().Network.RpcPort))))
 
-	if err := componentratelimit.Setup(cc.DataServiceName); err != nil {
-		return fmt.Errorf("setup component rate limit failed, err: %v", err)
+	// init tracing
+	if err := tracer.Setup(cc.DataServiceName); err != nil {
+		return fmt.Errorf("setup tracing failed, err: %v", err)
 	}
 
-	etcdOpt, err := cc.DataService().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
+	if err := componentratelimit.Setup(cc.DataServiceName); err != nil {
+		return fmt.Errorf("setup component rate limit failed, err: %v", err)
 	}
 
 	// register data service.
@@ -146,14 +148,14 @@ func (ds *dataService) prepare(opt *options.Option) error {
 		Port: cc.DataService().Network.RpcPort,
 		Uid:  uuid.UUID(),
 	}
-	sd, err := serviced.NewService(etcdOpt, svcOpt)
+	sd, err := serviced.NewServiceWithConf(cc.DataService().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service faield, err: %v", err)
 	}
 
 	ds.sd = sd
 
-	ssd, err := serviced.NewServiceD(etcdOpt, svcOpt)
+	ssd, err := serviced.NewServiceDWithConf(cc.DataService().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service faield, err: %v", err)
 	}
@@ -237,6 +239,7 @@ func (ds *dataService) initTaskManager() error {
 	if err != nil {
 		return err
 	}
+	ds.pm = pm
 
 	bds, err := bedis.NewRedisCache(cc.DataService().Repo.RedisCluster)
 	if err != nil {
@@ -248,7 +251,7 @@ func (ds *dataService) initTaskManager() error {
 	}
 	ds.cmdbRenderCache = processorcmdb.NewRedisCMDBRenderCache(bds, renderCacheOptions)
 	redLock := lock.NewRedisLock(bds, 60)
-	register.RegisterExecutor(gseService, ds.cmdb, ds.daoSet, ds.repo, redLock, pm, ds.cmdbRenderCache)
+	register.RegisterExecutor(gseService, ds.cmdb, ds.daoSet, ds.repo, redLock, pm, ds.cmdbRenderCache, ds.vault)
 
 	taskManager, err := task.NewTaskMgr(
 		context.Background(),
@@ -328,16 +331,27 @@ func (ds *dataService) listenAndServe() error {
 	grpcMetrics := grpc_prometheus.NewServerMetrics()
 	grpcMetrics.EnableHandlingTimeHistogram(metrics.GrpcBuckets)
 
+	svc, err := service.NewService(
+		ds.sd, ds.ssd, ds.daoSet, ds.vault, ds.esb, ds.repo, ds.cmdb, ds.taskManager, ds.gseSvc, ds.cmdbRenderCache,
+		ds.pm,
+	)
+	if err != nil {
+		return err
+	}
+
 	recoveryOpt := grpc_recovery.WithRecoveryHandlerContext(brpc.RecoveryHandlerFuncContext)
 
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(math.MaxInt32),
 		grpc.ChainUnaryInterceptor(
+			brpc.TraceUnaryServerInterceptor(),
 			brpc.LogUnaryServerInterceptor(),
 			brpc.TenantUnaryServerInterceptor(),
 			grpcMetrics.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
+			svc.ChangeFreezeUnaryInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
+			brpc.TraceStreamServerInterceptor(),
 			grpcMetrics.StreamServerInterceptor(),
 			brpc.TenantStreamServerInterceptor(),
 			grpc_recovery.StreamServerInterceptor(recoveryOpt),
@@ -358,12 +372,6 @@ func (ds *dataService) listenAndServe() error {
 	}
 
 	serve := grpc.NewServer(opts...)
-	svc, err := service.NewService(
-		ds.sd, ds.ssd, ds.daoSet, ds.vault, ds.esb, ds.repo, ds.cmdb, ds.taskManager, ds.gseSvc, ds.cmdbRenderCache,
-	)
-	if err != nil {
-		return err
-	}
 
 	pbds.RegisterDataServer(serve, svc)
 
@@ -598,6 +606,95 @@ func (ds *dataService) startCronTasks() {
 		watchCmdb.Run()
 	}
 
+	// 定时检查已下发的配置文件是否发生漂移
+	if crontabConfig.ScanConfigDrift.Enabled {
+		interval, err := time.ParseDuration(crontabConfig.ScanConfigDrift.Interval)
+		if err != nil {
+			logs.Errorf("parse scanConfigDrift interval failed, using default: %v", err)
+		}
+
+		scanConfigDrift := crontab.NewScanConfigDrift(ds.sd, ds.service, interval)
+		scanConfigDrift.Run()
+	}
+
+	// 定时计算客户端告警规则
+	if crontabConfig.EvaluateClientAlert.Enabled {
+		interval, err := time.ParseDuration(crontabConfig.EvaluateClientAlert.Interval)
+		if err != nil {
+			logs.Errorf("parse evaluateClientAlert interval failed, using default: %v", err)
+		}
+
+		evaluateClientAlert := crontab.NewEvaluateClientAlert(ds.sd, ds.service, interval)
+		evaluateClientAlert.Run()
+	}
+
+	// 定时汇总客户端指标并清理过期指标
+	if crontabConfig.RollupClientMetric.Enabled {
+		interval, err := time.ParseDuration(crontabConfig.RollupClientMetric.Interval)
+		if err != nil {
+			logs.Errorf("parse rollupClientMetric interval failed, using default: %v", err)
+		}
+
+		rollupClientMetric := crontab.NewRollupClientMetric(ds.sd, ds.service, interval,
+			crontabConfig.RollupClientMetric.HourlyRetentionDays, crontabConfig.RollupClientMetric.DailyRetentionDays)
+		rollupClientMetric.Run()
+	}
+
+	// 定时提醒即将过期和长期未使用的密钥
+	if crontabConfig.RemindCredential.Enabled {
+		interval, err := time.ParseDuration(crontabConfig.RemindCredential.Interval)
+		if err != nil {
+			logs.Errorf("parse remindCredential interval failed, using default: %v", err)
+		}
+
+		remindCredential := crontab.NewRemindCredential(ds.sd, ds.service, interval, crontabConfig.RemindCredential)
+		remindCredential.Run()
+	}
+
+	// 定时同步到期的 git 仓库关联
+	if crontabConfig.SyncGitRepo.Enabled {
+		interval, err := time.ParseDuration(crontabConfig.SyncGitRepo.Interval)
+		if err != nil {
+			logs.Errorf("parse syncGitRepo interval failed, using default: %v", err)
+		}
+
+		syncGitRepo := crontab.NewSyncGitRepo(ds.sd, ds.service, interval)
+		syncGitRepo.Run()
+	}
+
+	// 定时投递 webhook 事件
+	if crontabConfig.DeliverWebhook.Enabled {
+		interval, err := time.ParseDuration(crontabConfig.DeliverWebhook.Interval)
+		if err != nil {
+			logs.Errorf("parse deliverWebhook interval failed, using default: %v", err)
+		}
+
+		deliverWebhook := crontab.NewDeliverWebhook(ds.sd, ds.service, interval, crontabConfig.DeliverWebhook)
+		deliverWebhook.Run()
+	}
+
+	// 定时驳回超时的内置审批单
+	if crontabConfig.ExpireApproval.Enabled {
+		interval, err := time.ParseDuration(crontabConfig.ExpireApproval.Interval)
+		if err != nil {
+			logs.Errorf("parse expireApproval interval failed, using default: %v", err)
+		}
+
+		expireApproval := crontab.NewExpireApproval(ds.sd, ds.service, interval, crontabConfig.ExpireApproval)
+		expireApproval.Run()
+	}
+
+	// 定时解除到期的分组版本固定
+	if crontabConfig.ExpireGroupPin.Enabled {
+		interval, err := time.ParseDuration(crontabConfig.ExpireGroupPin.Interval)
+		if err != nil {
+			logs.Errorf("parse expireGroupPin interval failed, using default: %v", err)
+		}
+
+		expireGroupPin := crontab.NewExpireGroupPin(ds.sd, ds.service, interval, crontabConfig.ExpireGroupPin)
+		expireGroupPin.Run()
+	}
+
 	// 初始化ITSM模板[只有v4版本才需要]
 	if cc.DataService().ITSM.EnableV4 {
 		registerItsmV4Templates := crontab.RegisterItsmV4Templates(ds.daoSet, ds.sd)
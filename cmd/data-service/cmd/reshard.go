@@ -0,0 +1,141 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-reshard/resharder"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// cmd for resharding
+var reshardCmd = &cobra.Command{
+	Use:   "reshard",
+	Short: "move a biz's rows to another sharding db",
+	Run: func(cmd *cobra.Command, args []string) {
+
+	},
+}
+
+// sub reshard cmd to move a biz to the target sharding db
+var reshardRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "copy the biz's rows to the target sharding db, verify them and switch the biz's routing under write freeze",
+	Run: func(cmd *cobra.Command, args []string) {
+		r, err := newResharder(cmd)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		bizID, _ := cmd.Flags().GetUint32("biz-id")
+		target, _ := cmd.Flags().GetUint32("target-db-id")
+
+		if err = r.Run(context.Background(), &resharder.RunOption{BizID: bizID, Target: target}); err != nil {
+			fmt.Printf("Unable to move biz %d to sharding db %d, err: %v\n", bizID, target, err)
+			return
+		}
+		fmt.Printf("Biz %d is moved to sharding db %d\n", bizID, target)
+	},
+}
+
+// sub reshard cmd to compare a biz's rows between its sharding db and the target sharding db
+var reshardVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "compare the row counts and checksums of the biz's tables between its sharding db and the target one",
+	Run: func(cmd *cobra.Command, args []string) {
+		r, err := newResharder(cmd)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		bizID, _ := cmd.Flags().GetUint32("biz-id")
+		target, _ := cmd.Flags().GetUint32("target-db-id")
+
+		ctx := context.Background()
+		current, _, err := r.CurrentShard(ctx, bizID)
+		if err != nil {
+			fmt.Println("Unable to get the sharding db of the biz, err:", err)
+			return
+		}
+		reports, err := r.Verify(ctx, bizID, current, target)
+		if err != nil {
+			fmt.Println("Unable to verify the biz, err:", err)
+			return
+		}
+
+		fmt.Printf("Biz %d, sharding db %d -> %d\n", bizID, current, target)
+		for _, report := range reports {
+			fmt.Printf("%-40s source rows: %-10d target rows: %-10d matched: %v\n", report.Table,
+				report.SourceRows, report.TargetRows, report.Matched())
+		}
+	},
+}
+
+// sub reshard cmd to move a biz back to the sharding db it was moved from
+var reshardRollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "move the biz back to the sharding db it was moved from",
+	Run: func(cmd *cobra.Command, args []string) {
+		r, err := newResharder(cmd)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		bizID, _ := cmd.Flags().GetUint32("biz-id")
+
+		if err = r.Rollback(context.Background(), bizID); err != nil {
+			fmt.Printf("Unable to roll back biz %d, err: %v\n", bizID, err)
+			return
+		}
+		fmt.Printf("Biz %d is rolled back\n", bizID)
+	},
+}
+
+func newResharder(cmd *cobra.Command) (*resharder.Resharder, error) {
+	if err := cc.LoadSettings(SysOpt); err != nil {
+		return nil, fmt.Errorf("load settings from config files failed, err: %v", err)
+	}
+	logs.InitLogger(cc.DataService().Log.Logs())
+
+	bizID, _ := cmd.Flags().GetUint32("biz-id")
+	if bizID == 0 {
+		return nil, fmt.Errorf("flag `biz-id` is required")
+	}
+	batchSize, _ := cmd.Flags().GetInt("batch-size")
+	debug, _ := cmd.Flags().GetBool("debug")
+
+	r, err := resharder.New(cc.DataService().Sharding.AdminDatabase, batchSize, debug)
+	if err != nil {
+		return nil, fmt.Errorf("unable to new resharder, err: %v", err)
+	}
+	return r, nil
+}
+
+func init() {
+	reshardCmd.PersistentFlags().BoolP("debug", "d", false, "whether debug gorm to print sql, default is false")
+	reshardCmd.PersistentFlags().Uint32("biz-id", 0, "the biz to move")
+	reshardCmd.PersistentFlags().Int("batch-size", 500, "number of rows to copy and compare in a batch")
+
+	reshardRunCmd.Flags().Uint32("target-db-id", 0, "id of the sharding db in sharding_dbs to move to, "+
+		"0 means the admin database")
+	reshardVerifyCmd.Flags().Uint32("target-db-id", 0, "id of the sharding db in sharding_dbs to compare with, "+
+		"0 means the admin database")
+
+	reshardCmd.AddCommand(reshardRunCmd, reshardVerifyCmd, reshardRollbackCmd)
+	rootCmd.AddCommand(reshardCmd)
+}
@@ -66,9 +66,14 @@ func mig20240315120636Up(tx *gorm.DB) error {
 	// 2. 全量发布: JSON_LENGTH(strategies.scope->'$.groups') = 0
 	// 则将发布策略对应的版本标记为全量发布过
 	// gorm 限制了 UPDATE 必须有 WHERE 条件，所以这里使用了 Where("1 = 1")
+	emptyGroups := "JSON_LENGTH(strategies.scope->'$.groups') = 0"
+	if migrator.IsPostgres(tx) {
+		emptyGroups = "COALESCE(jsonb_array_length(CASE WHEN jsonb_typeof(strategies.scope->'groups') = 'array' " +
+			"THEN strategies.scope->'groups' END), 0) = 0"
+	}
 	return tx.Model(&Release20240315120636{}).Where("1 = 1").UpdateColumn("fully_released",
 		gorm.Expr("EXISTS(SELECT 1 FROM strategies WHERE strategies.release_id = releases.id"+
-			" AND (strategies.as_default = 1 OR JSON_LENGTH(strategies.scope->'$.groups') = 0))")).Error
+			" AND (strategies.as_default = true OR "+emptyGroups+"))")).Error
 }
 
 // mig20240315120636Down for down migration
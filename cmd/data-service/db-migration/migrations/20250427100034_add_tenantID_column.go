@@ -421,13 +421,7 @@ func oldIndexesToRestore(tx *gorm.DB) error {
 			continue
 		}
 		if err := tx.Migrator().CreateIndex(idx.table, idx.indexName); err != nil {
-			if err := tx.Exec(fmt.Sprintf("CREATE %s INDEX %s ON `%s` (%s)",
-				func() string {
-					if idx.unique {
-						return "UNIQUE"
-					}
-					return ""
-				}(), idx.indexName, idx.table, idx.column)).Error; err != nil {
+			if err := tx.Exec(createIndexSQL(tx, idx.unique, idx.indexName, idx.table, idx.column)).Error; err != nil {
 				return fmt.Errorf("failed to create index %s on table %s: %w", idx.indexName, idx.table, err)
 			}
 		}
@@ -731,14 +725,8 @@ func createNewIndexes(tx *gorm.DB) error {
 		}
 		if err := tx.Migrator().CreateIndex(idx.Table, idx.IndexName); err != nil {
 			// 构建列字符串，带前缀
-			columns := buildIndexColumns(idx.Fields)
-			if err := tx.Exec(fmt.Sprintf("CREATE %s INDEX %s ON `%s` (%s)",
-				func() string {
-					if idx.Unique {
-						return "UNIQUE "
-					}
-					return ""
-				}(), idx.IndexName, idx.Table, columns)).Error; err != nil {
+			columns := buildIndexColumns(idx.Fields, migrator.IsPostgres(tx))
+			if err := tx.Exec(createIndexSQL(tx, idx.Unique, idx.IndexName, idx.Table, columns)).Error; err != nil {
 				return fmt.Errorf("failed to create index %s on table %s: %w", idx.IndexName, idx.Table, err)
 			}
 		}
@@ -746,10 +734,13 @@ func createNewIndexes(tx *gorm.DB) error {
 	return nil
 }
 
-func buildIndexColumns(fields []indexField) string {
+func buildIndexColumns(fields []indexField, postgres bool) string {
 	parts := make([]string, len(fields))
 	for i, f := range fields {
-		if f.Prefix > 0 {
+		// postgresql 不支持前缀索引，使用完整列建立索引
+		if postgres {
+			parts[i] = fmt.Sprintf(`"%s"`, f.Column)
+		} else if f.Prefix > 0 {
 			parts[i] = fmt.Sprintf("`%s`(%d)", f.Column, f.Prefix)
 		} else {
 			parts[i] = fmt.Sprintf("`%s`", f.Column)
@@ -758,6 +749,19 @@ func buildIndexColumns(fields []indexField) string {
 	return strings.Join(parts, ", ")
 }
 
+// createIndexSQL 生成对应数据库方言的创建索引语句，postgresql 的索引名在 schema 内唯一，需加上表名前缀
+func createIndexSQL(tx *gorm.DB, unique bool, index, table, columns string) string {
+	class := ""
+	if unique {
+		class = "UNIQUE "
+	}
+	if migrator.IsPostgres(tx) {
+		return fmt.Sprintf(`CREATE %sINDEX IF NOT EXISTS "%s" ON "%s" (%s)`, class, migrator.PgIndexName(table, index),
+			table, strings.ReplaceAll(columns, "`", `"`))
+	}
+	return fmt.Sprintf("CREATE %sINDEX %s ON `%s` (%s)", class, index, table, columns)
+}
+
 // 删除新索引
 func dropNewIndexes(tx *gorm.DB) error {
 	indexesToDrop := map[string][]string{
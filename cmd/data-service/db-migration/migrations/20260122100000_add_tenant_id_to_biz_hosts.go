@@ -28,15 +28,28 @@ func init() {
 	})
 }
 
+// dropBizHostsPrimaryKeySQL returns the sql to drop the primary key of biz_hosts,
+// the primary key constraint of postgresql is named as <table>_pkey by default.
+func dropBizHostsPrimaryKeySQL(tx *gorm.DB) string {
+	if migrator.IsPostgres(tx) {
+		return "ALTER TABLE biz_hosts DROP CONSTRAINT biz_hosts_pkey"
+	}
+	return "ALTER TABLE biz_hosts DROP PRIMARY KEY"
+}
+
 // mig20260122100000Up for up migration
 func mig20260122100000Up(tx *gorm.DB) error {
-	// 1. 添加 tenant_id 列
-	if err := tx.Exec("ALTER TABLE biz_hosts ADD COLUMN tenant_id varchar(64) NOT NULL DEFAULT 'default' FIRST").Error; err != nil {
+	// 1. 添加 tenant_id 列, postgresql 不支持指定列的位置
+	addColumnSQL := "ALTER TABLE biz_hosts ADD COLUMN tenant_id varchar(64) NOT NULL DEFAULT 'default' FIRST"
+	if migrator.IsPostgres(tx) {
+		addColumnSQL = "ALTER TABLE biz_hosts ADD COLUMN tenant_id varchar(64) NOT NULL DEFAULT 'default'"
+	}
+	if err := tx.Exec(addColumnSQL).Error; err != nil {
 		return err
 	}
 
 	// 2. 删除原有主键
-	if err := tx.Exec("ALTER TABLE biz_hosts DROP PRIMARY KEY").Error; err != nil {
+	if err := tx.Exec(dropBizHostsPrimaryKeySQL(tx)).Error; err != nil {
 		return err
 	}
 
@@ -51,7 +64,7 @@ func mig20260122100000Up(tx *gorm.DB) error {
 // mig20260122100000Down for down migration
 func mig20260122100000Down(tx *gorm.DB) error {
 	// 1. 删除主键
-	if err := tx.Exec("ALTER TABLE biz_hosts DROP PRIMARY KEY").Error; err != nil {
+	if err := tx.Exec(dropBizHostsPrimaryKeySQL(tx)).Error; err != nil {
 		return err
 	}
 
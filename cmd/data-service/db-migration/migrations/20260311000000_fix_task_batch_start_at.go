@@ -31,14 +31,20 @@ func init() {
 // mig20260311000000Up removes the implicit ON UPDATE CURRENT_TIMESTAMP from start_at.
 // MySQL automatically adds ON UPDATE CURRENT_TIMESTAMP to the first TIMESTAMP NOT NULL
 // column when explicit_defaults_for_timestamp is OFF, causing start_at to be overwritten
-// on every UPDATE to the row.
+// on every UPDATE to the row. PostgreSQL never adds it, so only the default is kept.
 func mig20260311000000Up(tx *gorm.DB) error {
+	if migrator.IsPostgres(tx) {
+		return tx.Exec("ALTER TABLE task_batches ALTER COLUMN start_at SET DEFAULT CURRENT_TIMESTAMP").Error
+	}
 	return tx.Exec(
 		"ALTER TABLE task_batches MODIFY COLUMN start_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '任务开始时间'",
 	).Error
 }
 
 func mig20260311000000Down(tx *gorm.DB) error {
+	if migrator.IsPostgres(tx) {
+		return nil
+	}
 	return tx.Exec(
 		"ALTER TABLE task_batches MODIFY COLUMN start_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '任务开始时间'",
 	).Error
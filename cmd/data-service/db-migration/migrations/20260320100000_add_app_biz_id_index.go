@@ -14,6 +14,7 @@ package migrations
 
 import (
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
 )
@@ -33,9 +34,17 @@ func init() {
 // Queries that look up by biz_id alone (e.g. GetOneAppByBiz for tenant_id reverse lookup,
 // ListBizTenantMap, CheckBizExists) can no longer use the leftmost prefix of the unique index.
 func mig20260320100000Up(tx *gorm.DB) error {
+	if migrator.IsPostgres(tx) {
+		return tx.Exec("CREATE INDEX IF NOT EXISTS ? ON applications (biz_id)",
+			clause.Column{Name: migrator.PgIndexName("applications", "idx_bizID")}).Error
+	}
 	return tx.Exec("ALTER TABLE applications ADD INDEX idx_bizID (biz_id)").Error
 }
 
 func mig20260320100000Down(tx *gorm.DB) error {
+	if migrator.IsPostgres(tx) {
+		return tx.Exec("DROP INDEX IF EXISTS ?",
+			clause.Column{Name: migrator.PgIndexName("applications", "idx_bizID")}).Error
+	}
 	return tx.Exec("ALTER TABLE applications DROP INDEX idx_bizID").Error
 }
@@ -14,6 +14,7 @@ package migrations
 
 import (
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
 )
@@ -33,9 +34,17 @@ func init() {
 // index idx_tenantID_bizID_appID_relID has release_id at position 4. Without app_id in the WHERE
 // clause the leftmost prefix only covers (tenant_id, biz_id), making release_id filtering a scan.
 func mig20260507100000Up(tx *gorm.DB) error {
+	if migrator.IsPostgres(tx) {
+		return tx.Exec("CREATE INDEX IF NOT EXISTS ? ON released_config_items (tenant_id, biz_id, release_id)",
+			clause.Column{Name: migrator.PgIndexName("released_config_items", "idx_tenantID_bizID_releaseID")}).Error
+	}
 	return tx.Exec("ALTER TABLE released_config_items ADD INDEX idx_tenantID_bizID_releaseID (tenant_id, biz_id, release_id)").Error
 }
 
 func mig20260507100000Down(tx *gorm.DB) error {
+	if migrator.IsPostgres(tx) {
+		return tx.Exec("DROP INDEX IF EXISTS ?",
+			clause.Column{Name: migrator.PgIndexName("released_config_items", "idx_tenantID_bizID_releaseID")}).Error
+	}
 	return tx.Exec("ALTER TABLE released_config_items DROP INDEX idx_tenantID_bizID_releaseID").Error
 }
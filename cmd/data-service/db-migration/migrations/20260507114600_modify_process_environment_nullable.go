@@ -30,6 +30,10 @@ func init() {
 
 // mig20260507114600Up makes the environment column nullable in processes table
 func mig20260507114600Up(tx *gorm.DB) error {
+	if migrator.IsPostgres(tx) {
+		return tx.Exec("ALTER TABLE processes ALTER COLUMN environment DROP NOT NULL, " +
+			"ALTER COLUMN environment SET DEFAULT ''").Error
+	}
 	return tx.Exec("ALTER TABLE processes MODIFY COLUMN environment varchar(128) DEFAULT '' COMMENT '环境类型(1:测试, 2:体验, 3:正式)'").Error
 }
 
@@ -38,5 +42,8 @@ func mig20260507114600Down(tx *gorm.DB) error {
 	if err := tx.Exec("UPDATE processes SET environment = '' WHERE environment IS NULL").Error; err != nil {
 		return err
 	}
+	if migrator.IsPostgres(tx) {
+		return tx.Exec("ALTER TABLE processes ALTER COLUMN environment SET NOT NULL").Error
+	}
 	return tx.Exec("ALTER TABLE processes MODIFY COLUMN environment varchar(128) NOT NULL COMMENT '环境类型(1:测试, 2:体验, 3:正式)'").Error
 }
@@ -29,6 +29,9 @@ func init() {
 }
 
 func mig20260526170000Up(tx *gorm.DB) error {
+	if migrator.IsPostgres(tx) {
+		return tx.Exec("ALTER TABLE events ALTER COLUMN resource_uid TYPE varchar(256)").Error
+	}
 	if err := tx.Exec("ALTER TABLE `events` MODIFY COLUMN `resource_uid` varchar(256) DEFAULT ''").Error; err != nil {
 		return err
 	}
@@ -36,6 +39,9 @@ func mig20260526170000Up(tx *gorm.DB) error {
 }
 
 func mig20260526170000Down(tx *gorm.DB) error {
+	if migrator.IsPostgres(tx) {
+		return tx.Exec("ALTER TABLE events ALTER COLUMN resource_uid TYPE varchar(64)").Error
+	}
 	if err := tx.Exec("ALTER TABLE `events` MODIFY COLUMN `resource_uid` varchar(64) DEFAULT ''").Error; err != nil {
 		return err
 	}
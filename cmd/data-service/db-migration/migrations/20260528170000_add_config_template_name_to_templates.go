@@ -31,33 +31,41 @@ func init() {
 func mig20260528170000Up(tx *gorm.DB) error {
 	// 1. Add config_template_name column
 	if !tx.Migrator().HasColumn("templates", "config_template_name") {
-		if err := tx.Exec("ALTER TABLE `templates` ADD COLUMN `config_template_name` varchar(255) NOT NULL DEFAULT ''").Error; err != nil {
+		if err := tx.Exec("ALTER TABLE templates ADD COLUMN config_template_name varchar(255) NOT NULL DEFAULT ''").Error; err != nil {
 			return err
 		}
 	}
 
 	// 2. Backfill config_template_name from config_templates for existing records in config_delivery spaces
-	if err := tx.Exec(
-		"UPDATE `templates` t " +
-			"INNER JOIN `config_templates` ct ON ct.template_id = t.id AND ct.biz_id = t.biz_id " +
-			"INNER JOIN `template_spaces` ts ON ts.id = t.template_space_id AND ts.biz_id = t.biz_id " +
-			"SET t.config_template_name = ct.name " +
-			"WHERE t.config_template_name = '' AND ts.name = 'config_delivery'").Error; err != nil {
+	backfillSQL := "UPDATE `templates` t " +
+		"INNER JOIN `config_templates` ct ON ct.template_id = t.id AND ct.biz_id = t.biz_id " +
+		"INNER JOIN `template_spaces` ts ON ts.id = t.template_space_id AND ts.biz_id = t.biz_id " +
+		"SET t.config_template_name = ct.name " +
+		"WHERE t.config_template_name = '' AND ts.name = 'config_delivery'"
+	if migrator.IsPostgres(tx) {
+		backfillSQL = "UPDATE templates t SET config_template_name = ct.name " +
+			"FROM config_templates ct, template_spaces ts " +
+			"WHERE ct.template_id = t.id AND ct.biz_id = t.biz_id " +
+			"AND ts.id = t.template_space_id AND ts.biz_id = t.biz_id " +
+			"AND t.config_template_name = '' AND ts.name = 'config_delivery'"
+	}
+	if err := tx.Exec(backfillSQL).Error; err != nil {
 		return err
 	}
 
 	// 3. Drop old unique index
 	if tx.Migrator().HasIndex("templates", "idx_tenantID_bizID_tempSpaID_name_path") {
-		if err := tx.Exec("DROP INDEX `idx_tenantID_bizID_tempSpaID_name_path` ON `templates`").Error; err != nil {
+		if err := tx.Migrator().DropIndex("templates", "idx_tenantID_bizID_tempSpaID_name_path"); err != nil {
 			return err
 		}
 	}
 
 	// 4. Create new unique index including config_template_name
 	if !tx.Migrator().HasIndex("templates", "idx_tenantID_bizID_tempSpaID_name_path_ctName") {
-		if err := tx.Exec("CREATE UNIQUE INDEX `idx_tenantID_bizID_tempSpaID_name_path_ctName` " +
-			"ON `templates` (`tenant_id`, `biz_id`, `template_space_id`, `name`(100), `path`(100), " +
-			"`config_template_name`(100))").Error; err != nil {
+		fields := []indexField{{"tenant_id", 0}, {"biz_id", 0}, {"template_space_id", 0}, {"name", 100}, {"path", 100},
+			{"config_template_name", 100}}
+		if err := tx.Exec(createIndexSQL(tx, true, "idx_tenantID_bizID_tempSpaID_name_path_ctName", "templates",
+			buildIndexColumns(fields, migrator.IsPostgres(tx)))).Error; err != nil {
 			return err
 		}
 	}
@@ -68,22 +76,23 @@ func mig20260528170000Up(tx *gorm.DB) error {
 func mig20260528170000Down(tx *gorm.DB) error {
 	// 1. Drop new unique index
 	if tx.Migrator().HasIndex("templates", "idx_tenantID_bizID_tempSpaID_name_path_ctName") {
-		if err := tx.Exec("DROP INDEX `idx_tenantID_bizID_tempSpaID_name_path_ctName` ON `templates`").Error; err != nil {
+		if err := tx.Migrator().DropIndex("templates", "idx_tenantID_bizID_tempSpaID_name_path_ctName"); err != nil {
 			return err
 		}
 	}
 
 	// 2. Restore old unique index
 	if !tx.Migrator().HasIndex("templates", "idx_tenantID_bizID_tempSpaID_name_path") {
-		if err := tx.Exec("CREATE UNIQUE INDEX `idx_tenantID_bizID_tempSpaID_name_path` " +
-			"ON `templates` (`tenant_id`, `biz_id`, `template_space_id`, `name`(100), `path`(100))").Error; err != nil {
+		fields := []indexField{{"tenant_id", 0}, {"biz_id", 0}, {"template_space_id", 0}, {"name", 100}, {"path", 100}}
+		if err := tx.Exec(createIndexSQL(tx, true, "idx_tenantID_bizID_tempSpaID_name_path", "templates",
+			buildIndexColumns(fields, migrator.IsPostgres(tx)))).Error; err != nil {
 			return err
 		}
 	}
 
 	// 3. Drop config_template_name column
 	if tx.Migrator().HasColumn("templates", "config_template_name") {
-		if err := tx.Exec("ALTER TABLE `templates` DROP COLUMN `config_template_name`").Error; err != nil {
+		if err := tx.Exec("ALTER TABLE templates DROP COLUMN config_template_name").Error; err != nil {
 			return err
 		}
 	}
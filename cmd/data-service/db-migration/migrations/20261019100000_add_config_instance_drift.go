@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019100000",
+		Name:    "20261019100000_add_config_instance_drift",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019100000Up,
+		Down:    mig20261019100000Down,
+	})
+}
+
+// mig20261019100000Up for up migration
+func mig20261019100000Up(tx *gorm.DB) error {
+	// ConfigInstances : 配置实例表，新增漂移检测相关字段
+	type ConfigInstances struct {
+		BizID          uint       `gorm:"type:bigint unsigned not null;index:idx_bizID_driftStatus,priority:1"`
+		DriftStatus    string     `gorm:"column:drift_status;type:varchar(32);not null;default:'';index:idx_bizID_driftStatus,priority:2;comment:漂移检测状态"`
+		ActualMd5      string     `gorm:"column:actual_md5;type:varchar(64);not null;default:'';comment:主机上文件的MD5"`
+		DriftCheckedAt *time.Time `gorm:"column:drift_checked_at;type:datetime(6);default:NULL;comment:最近一次漂移检测时间"`
+	}
+
+	// add new column
+	for _, column := range []string{"drift_status", "actual_md5", "drift_checked_at"} {
+		if !tx.Migrator().HasColumn(&ConfigInstances{}, column) {
+			if err := tx.Migrator().AddColumn(&ConfigInstances{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	// create new index
+	if !tx.Migrator().HasIndex(&ConfigInstances{}, "idx_bizID_driftStatus") {
+		if err := tx.Migrator().CreateIndex(&ConfigInstances{}, "idx_bizID_driftStatus"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20261019100000Down for down migration
+func mig20261019100000Down(tx *gorm.DB) error {
+	// ConfigInstances : 配置实例表，新增漂移检测相关字段
+	type ConfigInstances struct {
+		BizID          uint       `gorm:"type:bigint unsigned not null;index:idx_bizID_driftStatus,priority:1"`
+		DriftStatus    string     `gorm:"column:drift_status;type:varchar(32);not null;default:'';index:idx_bizID_driftStatus,priority:2;comment:漂移检测状态"`
+		ActualMd5      string     `gorm:"column:actual_md5;type:varchar(64);not null;default:'';comment:主机上文件的MD5"`
+		DriftCheckedAt *time.Time `gorm:"column:drift_checked_at;type:datetime(6);default:NULL;comment:最近一次漂移检测时间"`
+	}
+
+	// delete old index
+	if tx.Migrator().HasIndex(&ConfigInstances{}, "idx_bizID_driftStatus") {
+		if err := tx.Migrator().DropIndex(&ConfigInstances{}, "idx_bizID_driftStatus"); err != nil {
+			return err
+		}
+	}
+
+	// delete column
+	for _, column := range []string{"drift_status", "actual_md5", "drift_checked_at"} {
+		if tx.Migrator().HasColumn(&ConfigInstances{}, column) {
+			if err := tx.Migrator().DropColumn(&ConfigInstances{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
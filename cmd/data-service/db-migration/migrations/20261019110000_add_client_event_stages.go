@@ -0,0 +1,70 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019110000",
+		Name:    "20261019110000_add_client_event_stages",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019110000Up,
+		Down:    mig20261019110000Down,
+	})
+}
+
+// mig20261019110000Up for up migration
+func mig20261019110000Up(tx *gorm.DB) error {
+	// ClientEvents : 客户端事件表，新增版本变更阶段时间线
+	type ClientEvents struct {
+		CurrentStage string `gorm:"column:current_stage;type:varchar(32);not null;default:'';comment:当前所处的变更阶段"`
+		Stages       string `gorm:"column:stages;type:json;default:NULL;comment:变更阶段时间线"`
+	}
+
+	// add new column
+	for _, column := range []string{"current_stage", "stages"} {
+		if !tx.Migrator().HasColumn(&ClientEvents{}, column) {
+			if err := tx.Migrator().AddColumn(&ClientEvents{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// mig20261019110000Down for down migration
+func mig20261019110000Down(tx *gorm.DB) error {
+	// ClientEvents : 客户端事件表，新增版本变更阶段时间线
+	type ClientEvents struct {
+		CurrentStage string `gorm:"column:current_stage;type:varchar(32);not null;default:'';comment:当前所处的变更阶段"`
+		Stages       string `gorm:"column:stages;type:json;default:NULL;comment:变更阶段时间线"`
+	}
+
+	// delete column
+	for _, column := range []string{"current_stage", "stages"} {
+		if tx.Migrator().HasColumn(&ClientEvents{}, column) {
+			if err := tx.Migrator().DropColumn(&ClientEvents{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
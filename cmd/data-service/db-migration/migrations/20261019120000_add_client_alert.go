@@ -0,0 +1,144 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019120000",
+		Name:    "20261019120000_add_client_alert",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019120000Up,
+		Down:    mig20261019120000Down,
+	})
+}
+
+// nolint
+// mig20261019120000Up for up migration
+func mig20261019120000Up(tx *gorm.DB) error {
+	// ClientAlertRules 客户端告警规则表
+	type ClientAlertRules struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Name          string     `gorm:"column:name;type:varchar(255);not null;comment:规则名称"`
+		RuleType      string     `gorm:"column:rule_type;type:varchar(32);not null;comment:规则类型"`
+		ReleaseID     uint       `gorm:"column:release_id;type:bigint unsigned;not null;default:0;comment:版本ID"`
+		Threshold     float64    `gorm:"column:threshold;type:double;not null;default:0;comment:阈值"`
+		ClientVersion string     `gorm:"column:client_version;type:varchar(64);not null;default:'';comment:客户端版本"`
+		Notifiers     string     `gorm:"column:notifiers;type:varchar(255);not null;comment:通知渠道"`
+		WebhookURL    string     `gorm:"column:webhook_url;type:varchar(1024);not null;default:'';comment:webhook地址"`
+		Receivers     string     `gorm:"column:receivers;type:varchar(1024);not null;default:'';comment:通知接收人"`
+		Enabled       bool       `gorm:"column:enabled;type:tinyint(1);not null;default:1;comment:是否启用"`
+		SilencedUntil *time.Time `gorm:"column:silenced_until;type:datetime(6);NULL;comment:静默截止时间"`
+		Memo          string     `gorm:"column:memo;type:varchar(256);not null;default:'';comment:描述"`
+
+		// Attachment is attachment info of the resource
+		BizID    uint   `gorm:"column:biz_id;type:bigint unsigned;not null;index:idx_bizID_appID,priority:1;comment:业务ID"`
+		AppID    uint   `gorm:"column:app_id;type:bigint unsigned;not null;index:idx_bizID_appID,priority:2;comment:服务ID"`
+		TenantID string `gorm:"column:tenant_id;type:varchar(255);not null;default:default;comment:租户ID"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// ClientAlertHistories 客户端告警历史表
+	type ClientAlertHistories struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource
+		RuleID       uint       `gorm:"column:rule_id;type:bigint unsigned;not null;index:idx_ruleID_status,priority:1;comment:规则ID"`
+		RuleName     string     `gorm:"column:rule_name;type:varchar(255);not null;comment:规则名称"`
+		RuleType     string     `gorm:"column:rule_type;type:varchar(32);not null;comment:规则类型"`
+		Status       string     `gorm:"column:status;type:varchar(20);not null;index:idx_ruleID_status,priority:2;comment:告警状态"`
+		Value        float64    `gorm:"column:value;type:double;not null;default:0;comment:告警值"`
+		ClientCount  uint       `gorm:"column:client_count;type:bigint unsigned;not null;default:0;comment:命中客户端数量"`
+		Message      string     `gorm:"column:message;type:text;comment:告警内容"`
+		Silenced     bool       `gorm:"column:silenced;type:tinyint(1);not null;default:0;comment:是否静默"`
+		NotifyResult string     `gorm:"column:notify_result;type:text;comment:通知结果"`
+		FiredAt      time.Time  `gorm:"column:fired_at;type:datetime(6);not null;comment:触发时间"`
+		AckedBy      string     `gorm:"column:acked_by;type:varchar(64);not null;default:'';comment:确认人"`
+		AckedAt      *time.Time `gorm:"column:acked_at;type:datetime(6);NULL;comment:确认时间"`
+		ResolvedAt   *time.Time `gorm:"column:resolved_at;type:datetime(6);NULL;comment:恢复时间"`
+
+		// Attachment is attachment info of the resource
+		BizID    uint   `gorm:"column:biz_id;type:bigint unsigned;not null;index:idx_bizID_appID,priority:1;comment:业务ID"`
+		AppID    uint   `gorm:"column:app_id;type:bigint unsigned;not null;index:idx_bizID_appID,priority:2;comment:服务ID"`
+		TenantID string `gorm:"column:tenant_id;type:varchar(255);not null;default:default;comment:租户ID"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&ClientAlertRules{}, &ClientAlertHistories{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "client_alert_rules", MaxID: 0, UpdatedAt: now},
+		{Resource: "client_alert_histories", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20261019120000Down for down migration
+func mig20261019120000Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"client_alert_rules",
+		"client_alert_histories",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("client_alert_rules", "client_alert_histories"); err != nil {
+		return err
+	}
+
+	return nil
+}
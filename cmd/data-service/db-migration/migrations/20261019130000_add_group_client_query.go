@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019130000",
+		Name:    "20261019130000_add_group_client_query",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019130000Up,
+		Down:    mig20261019130000Down,
+	})
+}
+
+// mig20261019130000Up for up migration
+func mig20261019130000Up(tx *gorm.DB) error {
+	// Groups : 分组表，新增动态分组关联的客户端查询
+	type Groups struct {
+		ClientQueryID uint `gorm:"column:client_query_id;type:bigint(1) unsigned;not null;default:0;comment:动态分组关联的客户端查询ID"`
+	}
+
+	// add new column
+	if !tx.Migrator().HasColumn(&Groups{}, "client_query_id") {
+		if err := tx.Migrator().AddColumn(&Groups{}, "client_query_id"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20261019130000Down for down migration
+func mig20261019130000Down(tx *gorm.DB) error {
+	// Groups : 分组表，新增动态分组关联的客户端查询
+	type Groups struct {
+		ClientQueryID uint `gorm:"column:client_query_id;type:bigint(1) unsigned;not null;default:0;comment:动态分组关联的客户端查询ID"`
+	}
+
+	// delete column
+	if tx.Migrator().HasColumn(&Groups{}, "client_query_id") {
+		if err := tx.Migrator().DropColumn(&Groups{}, "client_query_id"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019140000",
+		Name:    "20261019140000_add_client_metrics",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019140000Up,
+		Down:    mig20261019140000Down,
+	})
+}
+
+// nolint
+// mig20261019140000Up for up migration
+func mig20261019140000Up(tx *gorm.DB) error {
+	// ClientMetrics 客户端指标表，按小时和天保存客户端资源和拉取指标
+	type ClientMetrics struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource
+		Granularity     string    `gorm:"column:granularity;type:varchar(16);not null;uniqueIndex:idx_bizID_appID_uid_bucket,priority:4;index:idx_bizID_appID_bucket,priority:3;comment:聚合粒度"`
+		BucketTime      time.Time `gorm:"column:bucket_time;type:datetime(6);not null;uniqueIndex:idx_bizID_appID_uid_bucket,priority:5;index:idx_bizID_appID_bucket,priority:4;index:idx_bucket;comment:时间桶开始时间"`
+		ClientVersion   string    `gorm:"column:client_version;type:varchar(64);not null;default:'';comment:客户端版本"`
+		SampleCount     uint      `gorm:"column:sample_count;type:bigint unsigned;not null;default:0;comment:资源采样次数"`
+		CpuSum          float64   `gorm:"column:cpu_sum;type:double;not null;default:0;comment:cpu使用率累加值"`
+		CpuMax          float64   `gorm:"column:cpu_max;type:double;not null;default:0;comment:cpu最大使用率"`
+		MemorySum       uint64    `gorm:"column:memory_sum;type:bigint unsigned;not null;default:0;comment:内存使用累加值"`
+		MemoryMax       uint64    `gorm:"column:memory_max;type:bigint unsigned;not null;default:0;comment:内存最大使用"`
+		PullCount       uint      `gorm:"column:pull_count;type:bigint unsigned;not null;default:0;comment:拉取次数"`
+		PullFailedCount uint      `gorm:"column:pull_failed_count;type:bigint unsigned;not null;default:0;comment:拉取失败次数"`
+		PullSeconds     float64   `gorm:"column:pull_seconds;type:double;not null;default:0;comment:拉取耗时累加值"`
+
+		// Attachment is attachment info of the resource
+		BizID    uint   `gorm:"column:biz_id;type:bigint unsigned;not null;uniqueIndex:idx_bizID_appID_uid_bucket,priority:1;index:idx_bizID_appID_bucket,priority:1;comment:业务ID"`
+		AppID    uint   `gorm:"column:app_id;type:bigint unsigned;not null;uniqueIndex:idx_bizID_appID_uid_bucket,priority:2;index:idx_bizID_appID_bucket,priority:2;comment:服务ID"`
+		UID      string `gorm:"column:uid;type:varchar(64);not null;uniqueIndex:idx_bizID_appID_uid_bucket,priority:3;comment:客户端UID"`
+		TenantID string `gorm:"column:tenant_id;type:varchar(255);not null;default:default;comment:租户ID"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&ClientMetrics{}); err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "client_metrics", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20261019140000Down for down migration
+func mig20261019140000Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"client_metrics"}).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("client_metrics"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019150000",
+		Name:    "20261019150000_add_rbac_roles",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019150000Up,
+		Down:    mig20261019150000Down,
+	})
+}
+
+// nolint
+// mig20261019150000Up for up migration
+func mig20261019150000Up(tx *gorm.DB) error {
+	// Roles 内置权限角色表
+	type Roles struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Name        string `gorm:"column:name;type:varchar(255);not null;uniqueIndex:idx_bizID_name,priority:2;comment:角色名称"`
+		Permissions string `gorm:"column:permissions;type:text;not null;comment:角色权限"`
+		Memo        string `gorm:"column:memo;type:varchar(256);not null;default:'';comment:描述"`
+
+		// Attachment is attachment info of the resource
+		BizID    uint   `gorm:"column:biz_id;type:bigint unsigned;not null;uniqueIndex:idx_bizID_name,priority:1;comment:业务ID"`
+		TenantID string `gorm:"column:tenant_id;type:varchar(255);not null;default:default;comment:租户ID"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// RoleBindings 内置权限角色绑定表
+	type RoleBindings struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		RoleID    uint   `gorm:"column:role_id;type:bigint unsigned;not null;uniqueIndex:idx_bizID_subject_scope_role,priority:5;index:idx_roleID;comment:角色ID"`
+		Subject   string `gorm:"column:subject;type:varchar(64);not null;uniqueIndex:idx_bizID_subject_scope_role,priority:2;comment:授权用户"`
+		ScopeType string `gorm:"column:scope_type;type:varchar(32);not null;uniqueIndex:idx_bizID_subject_scope_role,priority:3;comment:授权范围类型"`
+		ScopeID   uint   `gorm:"column:scope_id;type:bigint unsigned;not null;default:0;uniqueIndex:idx_bizID_subject_scope_role,priority:4;comment:授权范围ID"`
+
+		// Attachment is attachment info of the resource
+		BizID    uint   `gorm:"column:biz_id;type:bigint unsigned;not null;uniqueIndex:idx_bizID_subject_scope_role,priority:1;comment:业务ID"`
+		TenantID string `gorm:"column:tenant_id;type:varchar(255);not null;default:default;comment:租户ID"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&Roles{}, &RoleBindings{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "roles", MaxID: 0, UpdatedAt: now},
+		{Resource: "role_bindings", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20261019150000Down for down migration
+func mig20261019150000Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"roles",
+		"role_bindings",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("roles", "role_bindings"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019160000",
+		Name:    "20261019160000_add_credential_scope_rules",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019160000Up,
+		Down:    mig20261019160000Down,
+	})
+}
+
+// nolint
+// mig20261019160000Up for up migration
+func mig20261019160000Up(tx *gorm.DB) error {
+	// CredentialScopes : 凭证关联规则表，新增 kv key, 实例标签和权限限制, 过期时间改为可空
+	type CredentialScopes struct {
+		KvKeyPattern   string     `gorm:"column:kv_key_pattern;type:varchar(255);not null;default:'';comment:kv key 匹配规则"`
+		KvKeyMatchType string     `gorm:"column:kv_key_match_type;type:varchar(20);not null;default:'';comment:kv key 匹配方式"`
+		Labels         string     `gorm:"column:labels;type:varchar(1024);not null;default:'{}';comment:允许声明的实例标签"`
+		Permission     string     `gorm:"column:permission;type:varchar(20);not null;default:'watch';comment:权限"`
+		ExpiredAt      *time.Time `gorm:"column:expired_at;type:datetime(6);comment:过期时间"`
+	}
+
+	// add new column
+	for _, column := range []string{"kv_key_pattern", "kv_key_match_type", "labels", "permission"} {
+		if !tx.Migrator().HasColumn(&CredentialScopes{}, column) {
+			if err := tx.Migrator().AddColumn(&CredentialScopes{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	// expired_at 历史上写入的是创建时间且未生效, 改为可空并清空, 空表示永不过期
+	if err := tx.Migrator().AlterColumn(&CredentialScopes{}, "expired_at"); err != nil {
+		return err
+	}
+
+	if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Model(&CredentialScopes{}).
+		Update("expired_at", nil).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// mig20261019160000Down for down migration
+func mig20261019160000Down(tx *gorm.DB) error {
+	// CredentialScopes : 凭证关联规则表
+	type CredentialScopes struct {
+		KvKeyPattern   string    `gorm:"column:kv_key_pattern;type:varchar(255);not null;default:''"`
+		KvKeyMatchType string    `gorm:"column:kv_key_match_type;type:varchar(20);not null;default:''"`
+		Labels         string    `gorm:"column:labels;type:varchar(1024);not null;default:'{}'"`
+		Permission     string    `gorm:"column:permission;type:varchar(20);not null;default:'watch'"`
+		ExpiredAt      time.Time `gorm:"column:expired_at;type:datetime(6) not null"`
+	}
+
+	// delete column
+	for _, column := range []string{"kv_key_pattern", "kv_key_match_type", "labels", "permission"} {
+		if tx.Migrator().HasColumn(&CredentialScopes{}, column) {
+			if err := tx.Migrator().DropColumn(&CredentialScopes{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Model(&CredentialScopes{}).Where("expired_at IS NULL").
+		Update("expired_at", time.Now()).Error; err != nil {
+		return err
+	}
+
+	return tx.Migrator().AlterColumn(&CredentialScopes{}, "expired_at")
+}
@@ -0,0 +1,142 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019170000",
+		Name:    "20261019170000_add_credential_lifecycle",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019170000Up,
+		Down:    mig20261019170000Down,
+	})
+}
+
+// nolint
+// mig20261019170000Up for up migration
+func mig20261019170000Up(tx *gorm.DB) error {
+	// Credentials : 凭证表，新增轮换和使用记录, 过期时间改为可空
+	type Credentials struct {
+		ExpiredAt             *time.Time `gorm:"column:expired_at;type:datetime(6);comment:过期时间"`
+		PreviousEncCredential string     `gorm:"column:previous_enc_credential;type:varchar(255);not null;default:'';comment:轮换前的凭证"`
+		PreviousExpiredAt     *time.Time `gorm:"column:previous_expired_at;type:datetime(6);comment:轮换前的凭证过期时间"`
+		RotatedAt             *time.Time `gorm:"column:rotated_at;type:datetime(6);comment:最近一次轮换时间"`
+		LastUsedAt            *time.Time `gorm:"column:last_used_at;type:datetime(6);comment:最近一次使用时间"`
+	}
+
+	// CredentialClients : 凭证的使用客户端
+	type CredentialClients struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource
+		ClientUID  string    `gorm:"column:client_uid;type:varchar(64);not null;uniqueIndex:idx_credentialID_clientUID,priority:2;comment:客户端UID"`
+		LastUsedAt time.Time `gorm:"column:last_used_at;type:datetime(6);not null;index:idx_lastUsedAt;comment:最近一次使用时间"`
+
+		// Attachment is attachment info of the resource
+		BizID        uint `gorm:"column:biz_id;type:bigint unsigned;not null;comment:业务ID"`
+		CredentialID uint `gorm:"column:credential_id;type:bigint unsigned;not null;uniqueIndex:idx_credentialID_clientUID,priority:1;comment:凭证ID"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// add new column
+	for _, column := range []string{"previous_enc_credential", "previous_expired_at", "rotated_at", "last_used_at"} {
+		if !tx.Migrator().HasColumn(&Credentials{}, column) {
+			if err := tx.Migrator().AddColumn(&Credentials{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	// expired_at 历史上写入的是创建时间且未生效, 改为可空并清空, 空表示永不过期
+	if err := tx.Migrator().AlterColumn(&Credentials{}, "expired_at"); err != nil {
+		return err
+	}
+
+	if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Model(&Credentials{}).
+		Update("expired_at", nil).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&CredentialClients{}); err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "credential_clients", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20261019170000Down for down migration
+func mig20261019170000Down(tx *gorm.DB) error {
+	// Credentials : 凭证表
+	type Credentials struct {
+		ExpiredAt             time.Time  `gorm:"column:expired_at;type:datetime(6) not null"`
+		PreviousEncCredential string     `gorm:"column:previous_enc_credential;type:varchar(255);not null;default:''"`
+		PreviousExpiredAt     *time.Time `gorm:"column:previous_expired_at;type:datetime(6)"`
+		RotatedAt             *time.Time `gorm:"column:rotated_at;type:datetime(6)"`
+		LastUsedAt            *time.Time `gorm:"column:last_used_at;type:datetime(6)"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"credential_clients"}).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("credential_clients"); err != nil {
+		return err
+	}
+
+	// delete column
+	for _, column := range []string{"previous_enc_credential", "previous_expired_at", "rotated_at", "last_used_at"} {
+		if tx.Migrator().HasColumn(&Credentials{}, column) {
+			if err := tx.Migrator().DropColumn(&Credentials{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Model(&Credentials{}).Where("expired_at IS NULL").
+		Update("expired_at", time.Now()).Error; err != nil {
+		return err
+	}
+
+	return tx.Migrator().AlterColumn(&Credentials{}, "expired_at")
+}
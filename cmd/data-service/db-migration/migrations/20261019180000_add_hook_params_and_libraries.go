@@ -0,0 +1,136 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019180000",
+		Name:    "20261019180000_add_hook_params_and_libraries",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019180000Up,
+		Down:    mig20261019180000Down,
+	})
+}
+
+// mig20261019180000Up for up migration
+func mig20261019180000Up(tx *gorm.DB) error {
+	// HookRevisions : 脚本版本表，新增参数定义
+	type HookRevisions struct {
+		Params string `gorm:"column:params;type:json;default:null;comment:脚本参数定义"`
+	}
+
+	// ReleasedHooks : 已发布脚本表，新增服务绑定的参数值
+	type ReleasedHooks struct {
+		Params string `gorm:"column:params;type:json;default:null;comment:服务绑定的脚本参数值"`
+	}
+
+	// TemplateSpaceHooks : 导入到模板空间的脚本库
+	type TemplateSpaceHooks struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Attachment is attachment info of the resource
+		BizID           uint   `gorm:"column:biz_id;type:bigint unsigned;not null;index:idx_bizID;comment:业务ID"`
+		TemplateSpaceID uint   `gorm:"column:template_space_id;type:bigint unsigned;not null;uniqueIndex:idx_spaceID_hookID,priority:1;comment:模板空间ID"`
+		HookID          uint   `gorm:"column:hook_id;type:bigint unsigned;not null;uniqueIndex:idx_spaceID_hookID,priority:2;index:idx_hookID;comment:脚本ID"`
+		TenantID        string `gorm:"column:tenant_id;type:varchar(255);not null;default:default;comment:租户ID"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// add new column
+	if !tx.Migrator().HasColumn(&HookRevisions{}, "params") {
+		if err := tx.Migrator().AddColumn(&HookRevisions{}, "params"); err != nil {
+			return err
+		}
+	}
+	if !tx.Migrator().HasColumn(&ReleasedHooks{}, "params") {
+		if err := tx.Migrator().AddColumn(&ReleasedHooks{}, "params"); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&TemplateSpaceHooks{}); err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "template_space_hooks", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20261019180000Down for down migration
+func mig20261019180000Down(tx *gorm.DB) error {
+	// HookRevisions : 脚本版本表
+	type HookRevisions struct {
+		Params string `gorm:"column:params;type:json;default:null"`
+	}
+
+	// ReleasedHooks : 已发布脚本表
+	type ReleasedHooks struct {
+		Params string `gorm:"column:params;type:json;default:null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"template_space_hooks"}).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("template_space_hooks"); err != nil {
+		return err
+	}
+
+	// delete column
+	if tx.Migrator().HasColumn(&HookRevisions{}, "params") {
+		if err := tx.Migrator().DropColumn(&HookRevisions{}, "params"); err != nil {
+			return err
+		}
+	}
+	if tx.Migrator().HasColumn(&ReleasedHooks{}, "params") {
+		if err := tx.Migrator().DropColumn(&ReleasedHooks{}, "params"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019190000",
+		Name:    "20261019190000_add_template_variable_constraints",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019190000Up,
+		Down:    mig20261019190000Down,
+	})
+}
+
+// mig20261019190000Up for up migration
+func mig20261019190000Up(tx *gorm.DB) error {
+	// TemplateVariables : 模版变量表，新增变量值约束
+	type TemplateVariables struct {
+		Constraints string `gorm:"column:constraints;type:json;default:null;comment:变量值约束"`
+	}
+
+	// add new column
+	if !tx.Migrator().HasColumn(&TemplateVariables{}, "constraints") {
+		if err := tx.Migrator().AddColumn(&TemplateVariables{}, "constraints"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20261019190000Down for down migration
+func mig20261019190000Down(tx *gorm.DB) error {
+	// TemplateVariables : 模版变量表
+	type TemplateVariables struct {
+		Constraints string `gorm:"column:constraints;type:json;default:null"`
+	}
+
+	// delete column
+	if tx.Migrator().HasColumn(&TemplateVariables{}, "constraints") {
+		if err := tx.Migrator().DropColumn(&TemplateVariables{}, "constraints"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019210000",
+		Name:    "20261019210000_add_git_repo_links",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019210000Up,
+		Down:    mig20261019210000Down,
+	})
+}
+
+// mig20261019210000Up for up migration
+func mig20261019210000Up(tx *gorm.DB) error {
+	// GitRepoLinks : 服务或模板空间关联的git仓库
+	type GitRepoLinks struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		RepoURL          string `gorm:"type:varchar(1024) not null"`
+		Ref              string `gorm:"type:varchar(255) not null"`
+		SubPath          string `gorm:"type:varchar(1024) not null;default:''"`
+		Username         string `gorm:"type:varchar(255) not null;default:''"`
+		SyncInterval     uint   `gorm:"type:int unsigned not null;default:0;index:idx_syncInterval"`
+		ReplaceAll       bool   `gorm:"type:tinyint(1) not null;default:0"`
+		WebhookTokenHash string `gorm:"type:varchar(64) not null;default:''"`
+		Memo             string `gorm:"type:varchar(256) default ''"`
+
+		// Status is the status of the last sync
+		SyncStatus  string     `gorm:"type:varchar(20) not null;default:''"`
+		SyncedSHA   string     `gorm:"column:synced_sha;type:varchar(64) not null;default:''"`
+		SyncMessage string     `gorm:"type:varchar(1024) not null;default:''"`
+		SyncedAt    *time.Time `gorm:"type:datetime(6)"`
+
+		// Attachment is attachment info of the resource
+		BizID           uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:1"`
+		AppID           uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:2"`
+		TemplateSpaceID uint   `gorm:"type:bigint(1) unsigned not null"`
+		TenantID        string `gorm:"type:varchar(255);not null;default:default"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&GitRepoLinks{}); err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "git_repo_links", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20261019210000Down for down migration
+func mig20261019210000Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"git_repo_links"}).
+		Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("git_repo_links"); err != nil {
+		return err
+	}
+
+	return nil
+}
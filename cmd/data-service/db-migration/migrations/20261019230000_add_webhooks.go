@@ -0,0 +1,134 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261019230000",
+		Name:    "20261019230000_add_webhooks",
+		Mode:    migrator.GormMode,
+		Up:      mig20261019230000Up,
+		Down:    mig20261019230000Down,
+	})
+}
+
+// mig20261019230000Up for up migration
+func mig20261019230000Up(tx *gorm.DB) error {
+	// Webhooks : 业务或服务的事件订阅
+	type Webhooks struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Name       string `gorm:"type:varchar(255) not null"`
+		URL        string `gorm:"column:url;type:varchar(1024) not null"`
+		EventTypes string `gorm:"type:json not null"`
+		Enabled    bool   `gorm:"type:tinyint(1) not null;default:1"`
+		Memo       string `gorm:"type:varchar(256) default ''"`
+
+		// Attachment is attachment info of the resource
+		BizID    uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:1"`
+		AppID    uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:2"`
+		TenantID string `gorm:"type:varchar(255);not null;default:default"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// WebhookDeliveries : 事件订阅的投递记录
+	type WebhookDeliveries struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		EventType string `gorm:"type:varchar(64) not null"`
+		EventID   string `gorm:"type:varchar(64) not null;index:idx_eventID"`
+		Payload   string `gorm:"type:mediumtext not null"`
+
+		// Status is status info of the resource
+		Status        string     `gorm:"type:varchar(20) not null;index:idx_status_nextAttemptAt,priority:1"`
+		Attempts      uint       `gorm:"type:int unsigned not null;default:0"`
+		NextAttemptAt *time.Time `gorm:"type:datetime(6);index:idx_status_nextAttemptAt,priority:2"`
+		ResponseCode  uint       `gorm:"type:int unsigned not null;default:0"`
+		ResponseBody  string     `gorm:"type:text"`
+		LastError     string     `gorm:"type:text"`
+		DeliveredAt   *time.Time `gorm:"type:datetime(6)"`
+
+		// Attachment is attachment info of the resource
+		BizID     uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_webhookID,priority:1"`
+		AppID     uint   `gorm:"type:bigint(1) unsigned not null"`
+		WebhookID uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_webhookID,priority:2"`
+		TenantID  string `gorm:"type:varchar(255);not null;default:default"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&Webhooks{}, &WebhookDeliveries{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "webhooks", MaxID: 0, UpdatedAt: now},
+		{Resource: "webhook_deliveries", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20261019230000Down for down migration
+func mig20261019230000Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"webhooks", "webhook_deliveries"}).
+		Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("webhooks", "webhook_deliveries"); err != nil {
+		return err
+	}
+
+	return nil
+}
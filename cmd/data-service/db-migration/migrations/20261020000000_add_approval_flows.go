@@ -0,0 +1,147 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261020000000",
+		Name:    "20261020000000_add_approval_flows",
+		Mode:    migrator.GormMode,
+		Up:      mig20261020000000Up,
+		Down:    mig20261020000000Down,
+	})
+}
+
+// mig20261020000000Up for up migration
+func mig20261020000000Up(tx *gorm.DB) error {
+	// ApprovalFlows : 服务的内置审批流程
+	type ApprovalFlows struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Enabled bool   `gorm:"type:tinyint(1) not null;default:0"`
+		Stages  string `gorm:"type:json not null"`
+		Memo    string `gorm:"type:varchar(256) default ''"`
+
+		// Attachment is attachment info of the resource
+		BizID    uint   `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID,priority:1"`
+		AppID    uint   `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID,priority:2"`
+		TenantID string `gorm:"type:varchar(255);not null;default:default"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// ApprovalTickets : 内置审批单据
+	type ApprovalTickets struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Stages        string     `gorm:"type:json not null"`
+		CurrentStage  uint       `gorm:"type:int unsigned not null;default:0"`
+		Status        string     `gorm:"type:varchar(20) not null;index:idx_status_stageDeadline,priority:1"`
+		StageDeadline *time.Time `gorm:"type:datetime(6);index:idx_status_stageDeadline,priority:2"`
+
+		// Attachment is attachment info of the resource
+		BizID      uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_strategyID,priority:1"`
+		AppID      uint   `gorm:"type:bigint(1) unsigned not null"`
+		ReleaseID  uint   `gorm:"type:bigint(1) unsigned not null"`
+		StrategyID uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_strategyID,priority:2"`
+		TenantID   string `gorm:"type:varchar(255);not null;default:default"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// ApprovalActions : 内置审批单据的审批、转审和评论记录
+	type ApprovalActions struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Stage      uint   `gorm:"type:int unsigned not null;default:0"`
+		Action     string `gorm:"type:varchar(20) not null"`
+		DelegateTo string `gorm:"type:varchar(64) default ''"`
+		Comment    string `gorm:"type:text"`
+
+		// Attachment is attachment info of the resource
+		BizID    uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_ticketID,priority:1"`
+		AppID    uint   `gorm:"type:bigint(1) unsigned not null"`
+		TicketID uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_ticketID,priority:2"`
+		TenantID string `gorm:"type:varchar(255);not null;default:default"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&ApprovalFlows{}, &ApprovalTickets{}, &ApprovalActions{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "approval_flows", MaxID: 0, UpdatedAt: now},
+		{Resource: "approval_tickets", MaxID: 0, UpdatedAt: now},
+		{Resource: "approval_actions", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20261020000000Down for down migration
+func mig20261020000000Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"approval_flows", "approval_tickets", "approval_actions"}).
+		Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("approval_flows", "approval_tickets", "approval_actions"); err != nil {
+		return err
+	}
+
+	return nil
+}
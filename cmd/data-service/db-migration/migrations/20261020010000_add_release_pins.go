@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261020010000",
+		Name:    "20261020010000_add_release_pins",
+		Mode:    migrator.GormMode,
+		Up:      mig20261020010000Up,
+		Down:    mig20261020010000Down,
+	})
+}
+
+// nolint
+// mig20261020010000Up for up migration
+func mig20261020010000Up(tx *gorm.DB) error {
+	// Releases : 版本表，新增版本标签
+	type Releases struct {
+		Tags string `gorm:"column:tags;type:json;comment:版本标签"`
+	}
+
+	// ReleasedGroups : 已上线分组表，新增分组固定版本
+	type ReleasedGroups struct {
+		PinnedReleaseID uint       `gorm:"column:pinned_release_id;type:bigint(1) unsigned;not null;default:0;comment:固定的版本ID"`
+		PinnedUntil     *time.Time `gorm:"column:pinned_until;type:datetime(6);index:idx_pinnedUntil;comment:固定的截止时间"`
+		PinMemo         string     `gorm:"column:pin_memo;type:varchar(256);not null;default:'';comment:固定说明"`
+	}
+
+	if !tx.Migrator().HasColumn(&Releases{}, "tags") {
+		if err := tx.Migrator().AddColumn(&Releases{}, "tags"); err != nil {
+			return err
+		}
+	}
+
+	// json 类型的列不支持默认值，存量的版本统一设置为空标签
+	if err := tx.Model(&Releases{}).Where("tags IS NULL").Update("tags", "[]").Error; err != nil {
+		return err
+	}
+
+	for _, column := range []string{"pinned_release_id", "pinned_until", "pin_memo"} {
+		if !tx.Migrator().HasColumn(&ReleasedGroups{}, column) {
+			if err := tx.Migrator().AddColumn(&ReleasedGroups{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !tx.Migrator().HasIndex(&ReleasedGroups{}, "idx_pinnedUntil") {
+		if err := tx.Migrator().CreateIndex(&ReleasedGroups{}, "idx_pinnedUntil"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20261020010000Down for down migration
+func mig20261020010000Down(tx *gorm.DB) error {
+	// Releases : 版本表
+	type Releases struct {
+		Tags string `gorm:"column:tags;type:json"`
+	}
+
+	// ReleasedGroups : 已上线分组表
+	type ReleasedGroups struct {
+		PinnedReleaseID uint       `gorm:"column:pinned_release_id;type:bigint(1) unsigned;not null;default:0"`
+		PinnedUntil     *time.Time `gorm:"column:pinned_until;type:datetime(6);index:idx_pinnedUntil"`
+		PinMemo         string     `gorm:"column:pin_memo;type:varchar(256);not null;default:''"`
+	}
+
+	if tx.Migrator().HasIndex(&ReleasedGroups{}, "idx_pinnedUntil") {
+		if err := tx.Migrator().DropIndex(&ReleasedGroups{}, "idx_pinnedUntil"); err != nil {
+			return err
+		}
+	}
+
+	for _, column := range []string{"pinned_release_id", "pinned_until", "pin_memo"} {
+		if tx.Migrator().HasColumn(&ReleasedGroups{}, column) {
+			if err := tx.Migrator().DropColumn(&ReleasedGroups{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	if tx.Migrator().HasColumn(&Releases{}, "tags") {
+		if err := tx.Migrator().DropColumn(&Releases{}, "tags"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261020020000",
+		Name:    "20261020020000_add_release_source",
+		Mode:    migrator.GormMode,
+		Up:      mig20261020020000Up,
+		Down:    mig20261020020000Down,
+	})
+}
+
+// nolint
+// mig20261020020000Up for up migration
+func mig20261020020000Up(tx *gorm.DB) error {
+	// Releases : 版本表，新增晋级版本的来源
+	type Releases struct {
+		SourceAppID     uint `gorm:"column:source_app_id;type:bigint(1) unsigned;not null;default:0;comment:来源服务ID"`
+		SourceReleaseID uint `gorm:"column:source_release_id;type:bigint(1) unsigned;not null;default:0;comment:来源版本ID"`
+	}
+
+	for _, column := range []string{"source_app_id", "source_release_id"} {
+		if !tx.Migrator().HasColumn(&Releases{}, column) {
+			if err := tx.Migrator().AddColumn(&Releases{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// mig20261020020000Down for down migration
+func mig20261020020000Down(tx *gorm.DB) error {
+	// Releases : 版本表
+	type Releases struct {
+		SourceAppID     uint `gorm:"column:source_app_id;type:bigint(1) unsigned;not null;default:0"`
+		SourceReleaseID uint `gorm:"column:source_release_id;type:bigint(1) unsigned;not null;default:0"`
+	}
+
+	for _, column := range []string{"source_app_id", "source_release_id"} {
+		if tx.Migrator().HasColumn(&Releases{}, column) {
+			if err := tx.Migrator().DropColumn(&Releases{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261020030000",
+		Name:    "20261020030000_add_released_group_config_items",
+		Mode:    migrator.GormMode,
+		Up:      mig20261020030000Up,
+		Down:    mig20261020030000Down,
+	})
+}
+
+// mig20261020030000Up for up migration
+func mig20261020030000Up(tx *gorm.DB) error {
+	// ReleasedGroupConfigItems : 已生成版本按分组变量渲染的配置项
+	type ReleasedGroupConfigItems struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Signature string `gorm:"type:varchar(64) not null"`
+		Md5       string `gorm:"type:varchar(64) not null"`
+		ByteSize  uint   `gorm:"type:bigint(1) unsigned not null"`
+
+		// Attachment is attachment info of the resource
+		BizID                uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_releaseID,priority:1"`
+		AppID                uint   `gorm:"type:bigint(1) unsigned not null;index:idx_appID"`
+		ReleaseID            uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_releaseID,priority:2"`
+		GroupID              uint   `gorm:"type:bigint(1) unsigned not null"`
+		ReleasedConfigItemID uint   `gorm:"type:bigint(1) unsigned not null"`
+		TenantID             string `gorm:"type:varchar(255);not null;default:default"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&ReleasedGroupConfigItems{}); err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "released_group_config_items", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20261020030000Down for down migration
+func mig20261020030000Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"released_group_config_items"}).
+		Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("released_group_config_items"); err != nil {
+		return err
+	}
+
+	return nil
+}
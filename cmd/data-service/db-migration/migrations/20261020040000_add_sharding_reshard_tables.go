@@ -0,0 +1,61 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261020040000",
+		Name:    "20261020040000_add_sharding_reshard_tables",
+		Mode:    migrator.GormMode,
+		Up:      mig20261020040000Up,
+		Down:    mig20261020040000Down,
+	})
+}
+
+// mig20261020040000Up for up migration
+func mig20261020040000Up(tx *gorm.DB) error {
+	// ShardingBizFreezes : 业务迁移分片库期间的禁写记录, 仅使用admin库中的记录
+	type ShardingBizFreezes struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement"`
+		BizID     uint      `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID"`
+		Memo      string    `gorm:"type:varchar(256) default ''"`
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// ShardingDeletes : 已迁移分片库的业务删除的数据, 用于回滚时区分删除的数据和缺失的数据
+	type ShardingDeletes struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement"`
+		BizID     uint      `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_tableName,priority:1"`
+		TableName string    `gorm:"type:varchar(64) not null;index:idx_bizID_tableName,priority:2"`
+		RowID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	return tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&ShardingBizFreezes{}, &ShardingDeletes{})
+}
+
+// mig20261020040000Down for down migration
+func mig20261020040000Down(tx *gorm.DB) error {
+	return tx.Migrator().DropTable("sharding_biz_freezes", "sharding_deletes")
+}
@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20261020050000",
+		Name:    "20261020050000_add_approval_action_voter",
+		Mode:    migrator.GormMode,
+		Up:      mig20261020050000Up,
+		Down:    mig20261020050000Down,
+	})
+}
+
+// nolint
+// mig20261020050000Up for up migration
+func mig20261020050000Up(tx *gorm.DB) error {
+	// ApprovalActions : 内置审批单据的审批记录，新增投票人，同一审批人在同一阶段只能投票一次
+	type ApprovalActions struct {
+		Stage    uint    `gorm:"type:int unsigned not null;default:0;uniqueIndex:idx_ticketID_stage_voter,priority:2"`
+		TicketID uint    `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_ticketID_stage_voter,priority:1"`
+		Voter    *string `gorm:"column:voter;type:varchar(64);default:null;uniqueIndex:idx_ticketID_stage_voter,priority:3;comment:投票人"`
+	}
+
+	if !tx.Migrator().HasColumn(&ApprovalActions{}, "voter") {
+		if err := tx.Migrator().AddColumn(&ApprovalActions{}, "voter"); err != nil {
+			return err
+		}
+	}
+
+	// 已有的审批和驳回记录中，同一审批人在同一阶段只保留第一次投票的投票人
+	backfill := "UPDATE approval_actions a JOIN (SELECT MIN(id) AS id FROM approval_actions " +
+		"WHERE action IN ('approve', 'reject') GROUP BY ticket_id, stage, creator) f ON a.id = f.id " +
+		"SET a.voter = a.creator"
+	if migrator.IsPostgres(tx) {
+		backfill = "UPDATE approval_actions SET voter = creator FROM (SELECT MIN(id) AS id FROM approval_actions " +
+			"WHERE action IN ('approve', 'reject') GROUP BY ticket_id, stage, creator) f " +
+			"WHERE approval_actions.id = f.id"
+	}
+	if err := tx.Exec(backfill).Error; err != nil {
+		return err
+	}
+
+	if !tx.Migrator().HasIndex(&ApprovalActions{}, "idx_ticketID_stage_voter") {
+		if err := tx.Migrator().CreateIndex(&ApprovalActions{}, "idx_ticketID_stage_voter"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20261020050000Down for down migration
+func mig20261020050000Down(tx *gorm.DB) error {
+	// ApprovalActions : 内置审批单据的审批记录
+	type ApprovalActions struct {
+		Stage    uint    `gorm:"type:int unsigned not null;default:0;uniqueIndex:idx_ticketID_stage_voter,priority:2"`
+		TicketID uint    `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_ticketID_stage_voter,priority:1"`
+		Voter    *string `gorm:"column:voter;type:varchar(64);default:null;uniqueIndex:idx_ticketID_stage_voter,priority:3"`
+	}
+
+	if tx.Migrator().HasIndex(&ApprovalActions{}, "idx_ticketID_stage_voter") {
+		if err := tx.Migrator().DropIndex(&ApprovalActions{}, "idx_ticketID_stage_voter"); err != nil {
+			return err
+		}
+	}
+
+	if tx.Migrator().HasColumn(&ApprovalActions{}, "voter") {
+		if err := tx.Migrator().DropColumn(&ApprovalActions{}, "voter"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
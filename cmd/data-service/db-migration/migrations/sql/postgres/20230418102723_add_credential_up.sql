@@ -0,0 +1,33 @@
+insert into id_generators(resource, max_id, updated_at)
+values ('credentials', 0, now());
+insert into id_generators(resource, max_id, updated_at)
+values ('credential_scopes', 0, now());
+
+CREATE TABLE if not exists "credentials" (
+    "id" bigint NOT NULL,
+    "biz_id" bigint NOT NULL,
+    "credential_type" varchar(64) NOT NULL,
+    "enc_credential" varchar(64) NOT NULL,
+    "enc_algorithm" varchar(64) NOT NULL,
+    "memo" text,
+    "enable" boolean   default false,
+    "creator" varchar(64) NOT NULL,
+    "reviser" varchar(64) NOT NULL,
+    "created_at" timestamp(6) NOT NULL,
+    "updated_at" timestamp(6) NOT NULL,
+    "expired_at" timestamp(6) NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE if not exists "credential_scopes" (
+     "id" bigint NOT NULL,
+     "biz_id" bigint NOT NULL,
+     "credential_id" bigint NOT NULL,
+     "credential_scope" varchar(64) NOT NULL,
+     "creator" varchar(64) NOT NULL,
+     "reviser" varchar(64) NOT NULL,
+     "updated_at" timestamp(6) NOT NULL,
+     "created_at" timestamp(6) NOT NULL,
+     "expired_at" timestamp(6) NOT NULL,
+     PRIMARY KEY ("id")
+);
\ No newline at end of file
@@ -15,7 +15,6 @@ package migrator
 import (
 	"fmt"
 
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
@@ -25,15 +24,27 @@ import (
 
 // NewDB new a gorm db instance
 func NewDB(debug bool) (*gorm.DB, error) {
-	fmt.Println("Connecting to MySQL database...")
-
 	dbConf := cc.DataService().Sharding.AdminDatabase
 	dbConf.WriteTimeoutSec = 300
 	dbConf.ReadTimeoutSec = 300
-	dsn := sharding.URI(dbConf)
+	return Open(dbConf, debug)
+}
+
+// Open opens the gorm db instance of the database to apply the migrations.
+func Open(dbConf cc.Database, debug bool) (*gorm.DB, error) {
+	dialect := sharding.DialectOf(dbConf)
+	fmt.Printf("Connecting to %s database...\n", dialect.Name())
+
+	dsn := dialect.DSN(dbConf)
+	dialector := dialect.Open(dsn)
+	if dbConf.Driver == cc.PostgresDriver {
+		// the column types and index names of the migrations need to be rendered for postgresql
+		dialector = newPgDialector(dsn)
+	}
+
 	if debug {
 		// return the gorm db instance which prints debug log
-		return gorm.Open(mysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Info)})
+		return gorm.Open(dialector, &gorm.Config{Logger: logger.Default.LogMode(logger.Info)})
 	}
-	return gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	return gorm.Open(dialector, &gorm.Config{})
 }
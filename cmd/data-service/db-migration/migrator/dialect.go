@@ -0,0 +1,264 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrator
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	gormmigrator "gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
+)
+
+// pgMaxIdentifierLen is the max length of the identifier in postgresql.
+const pgMaxIdentifierLen = 63
+
+// IsPostgres returns whether the migration is applied on postgresql, the migrations
+// which execute raw sql should render the sql for postgresql when it returns true.
+func IsPostgres(tx *gorm.DB) bool {
+	return utils.IsPostgres(tx)
+}
+
+// PgIndexName returns the index name in postgresql. The index names of the migrations are only
+// unique in their tables, but the index name is unique in the schema of postgresql, so the index
+// is prefixed with the table name, and the name is truncated with a hash suffix if it is too long.
+func PgIndexName(table, name string) string {
+	if strings.HasPrefix(name, table+"_") {
+		return name
+	}
+
+	full := table + "_" + name
+	if len(full) <= pgMaxIdentifierLen {
+		return full
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(full))
+	suffix := fmt.Sprintf("_%08x", h.Sum32())
+	return full[:pgMaxIdentifierLen-len(suffix)] + suffix
+}
+
+// pgDialector is the postgresql dialector for the migrations, the column types of the
+// migrations are defined in mysql flavor, which are rendered to postgresql types by it.
+type pgDialector struct {
+	postgres.Dialector
+}
+
+// newPgDialector returns the postgresql dialector of the migrations.
+func newPgDialector(dsn string) gorm.Dialector {
+	return pgDialector{Dialector: postgres.Dialector{Config: &postgres.Config{DSN: dsn}}}
+}
+
+// Migrator returns the migrator which creates indexes with the postgresql index names.
+func (d pgDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return pgMigrator{Migrator: postgres.Migrator{Migrator: gormmigrator.Migrator{Config: gormmigrator.Config{
+		DB:                          db,
+		Dialector:                   d,
+		CreateIndexAfterCreateTable: true,
+	}}}}
+}
+
+var (
+	// mysqlTypeOptions are the mysql only options in the column type.
+	mysqlTypeOptions = regexp.MustCompile(`(?i)\s+(?:(?:unsigned|zerofill|auto_increment|` +
+		`(?:character\s+set|charset|collate)\s+\w+|on\s+update\s+current_timestamp)\b(?:\(\d*\))?|comment\s+'[^']*')`)
+	// mysqlBaseType matches the base type and its size of the column type.
+	mysqlBaseType = regexp.MustCompile(`^(?i)\s*([a-z]+)(\s*\([^)]*\))?`)
+	// mysqlEnumType matches the enum type whose values may contain spaces.
+	mysqlEnumType = regexp.MustCompile(`^(?i)\s*enum\s*\([^)]*\)`)
+)
+
+// DataTypeOf renders the mysql column type of the field to the postgresql one.
+func (d pgDialector) DataTypeOf(field *schema.Field) string {
+	switch field.DataType {
+	case schema.Bool, schema.Int, schema.Uint, schema.Float, schema.String, schema.Time, schema.Bytes:
+		return d.Dialector.DataTypeOf(field)
+	}
+
+	return pgDataType(string(field.DataType), field)
+}
+
+// pgDataType converts the mysql column type to the postgresql column type.
+func pgDataType(mysqlType string, field *schema.Field) string {
+	sqlType := mysqlEnumType.ReplaceAllString(mysqlType, "varchar(64)")
+	unsigned := strings.Contains(strings.ToLower(sqlType), "unsigned")
+	sqlType = strings.TrimSpace(mysqlTypeOptions.ReplaceAllString(sqlType, ""))
+
+	loc := mysqlBaseType.FindStringSubmatchIndex(sqlType)
+	if loc == nil {
+		return sqlType
+	}
+
+	base := strings.ToLower(sqlType[loc[2]:loc[3]])
+	size := ""
+	if loc[4] >= 0 {
+		size = strings.ReplaceAll(sqlType[loc[4]:loc[5]], " ", "")
+	}
+	rest := sqlType[loc[1]:]
+
+	var pgType string
+	switch base {
+	case "tinyint":
+		// tinyint(1) is the boolean type of mysql.
+		if size == "(1)" || field.GORMDataType == schema.Bool {
+			pgType = "boolean"
+		} else {
+			pgType = "smallint"
+		}
+	case "smallint":
+		// postgresql has no unsigned types, use the wider type to hold the unsigned values.
+		pgType = "smallint"
+		if unsigned {
+			pgType = "integer"
+		}
+	case "mediumint", "int", "integer":
+		pgType = "integer"
+		if unsigned {
+			pgType = "bigint"
+		}
+	case "bigint":
+		pgType = "bigint"
+	case "datetime", "timestamp":
+		pgType = "timestamp" + size
+	case "double", "real":
+		pgType = "double precision"
+	case "float":
+		pgType = "real"
+	case "decimal", "numeric":
+		pgType = "numeric" + size
+	case "varchar", "char":
+		pgType = base + size
+	case "tinytext", "text", "mediumtext", "longtext":
+		pgType = "text"
+	case "json":
+		pgType = "jsonb"
+	case "tinyblob", "blob", "mediumblob", "longblob", "binary", "varbinary":
+		pgType = "bytea"
+	default:
+		pgType = base + size
+	}
+
+	if field.AutoIncrement {
+		switch pgType {
+		case "smallint", "integer":
+			pgType = "serial"
+		case "bigint":
+			pgType = "bigserial"
+		}
+	}
+
+	return pgType + rest
+}
+
+// pgMigrator is the postgresql migrator of the migrations.
+type pgMigrator struct {
+	postgres.Migrator
+}
+
+// CreateTable creates the tables without the mysql table options like engine and charset.
+func (m pgMigrator) CreateTable(values ...interface{}) error {
+	m.DB = m.DB.Set("gorm:table_options", "")
+	return m.Migrator.CreateTable(values...)
+}
+
+// FullDataTypeOf returns the full data type of the field, the numeric default value of
+// the tinyint(1) column is converted to boolean as it is rendered to the boolean type.
+func (m pgMigrator) FullDataTypeOf(field *schema.Field) clause.Expr {
+	if m.DataTypeOf(field) == "boolean" && field.HasDefaultValue {
+		f := *field
+		switch f.DefaultValue {
+		case "0":
+			f.DefaultValueInterface = false
+		case "1":
+			f.DefaultValueInterface = true
+		}
+		field = &f
+	}
+
+	return m.Migrator.FullDataTypeOf(field)
+}
+
+// HasIndex checks whether the index with the postgresql index name exists.
+func (m pgMigrator) HasIndex(value interface{}, name string) bool {
+	var count int64
+	_ = m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		if stmt.Schema != nil {
+			if idx := stmt.Schema.LookIndex(name); idx != nil {
+				name = idx.Name
+			}
+		}
+		currentSchema, curTable := m.CurrentSchema(stmt, stmt.Table)
+		return m.DB.Raw("SELECT count(*) FROM pg_indexes WHERE tablename = ? AND indexname = ? AND schemaname = ?",
+			curTable, PgIndexName(stmt.Table, name), currentSchema).Scan(&count).Error
+	})
+
+	return count > 0
+}
+
+// CreateIndex creates the index with the postgresql index name.
+func (m pgMigrator) CreateIndex(value interface{}, name string) error {
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		if stmt.Schema == nil {
+			return fmt.Errorf("failed to create index with name %v", name)
+		}
+		idx := stmt.Schema.LookIndex(name)
+		if idx == nil {
+			return fmt.Errorf("failed to create index with name %v", name)
+		}
+
+		sql := "CREATE "
+		if idx.Class != "" {
+			sql += idx.Class + " "
+		}
+		sql += "INDEX IF NOT EXISTS ? ON ?"
+		if idx.Type != "" {
+			sql += " USING " + idx.Type + "(?)"
+		} else {
+			sql += " ?"
+		}
+		if idx.Where != "" {
+			sql += " WHERE " + idx.Where
+		}
+
+		return m.DB.Exec(sql, clause.Column{Name: PgIndexName(stmt.Table, idx.Name)}, m.CurrentTable(stmt),
+			m.BuildIndexOptions(idx.Fields, stmt)).Error
+	})
+}
+
+// DropIndex drops the index with the postgresql index name.
+func (m pgMigrator) DropIndex(value interface{}, name string) error {
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		if stmt.Schema != nil {
+			if idx := stmt.Schema.LookIndex(name); idx != nil {
+				name = idx.Name
+			}
+		}
+
+		return m.DB.Exec("DROP INDEX IF EXISTS ?", clause.Column{Name: PgIndexName(stmt.Table, name)}).Error
+	})
+}
+
+// RenameIndex renames the index with the postgresql index names.
+func (m pgMigrator) RenameIndex(value interface{}, oldName, newName string) error {
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		return m.DB.Exec("ALTER INDEX ? RENAME TO ?", clause.Column{Name: PgIndexName(stmt.Table, oldName)},
+			clause.Column{Name: PgIndexName(stmt.Table, newName)}).Error
+	})
+}
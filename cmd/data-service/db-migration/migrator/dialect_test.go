@@ -0,0 +1,76 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrator
+
+import (
+	"strings"
+	"testing"
+
+	"gorm.io/gorm/schema"
+)
+
+func TestPgDataType(t *testing.T) {
+	cases := []struct {
+		mysqlType     string
+		gormType      schema.DataType
+		autoIncrement bool
+		expect        string
+	}{
+		{mysqlType: "bigint(1) unsigned not null", expect: "bigint not null"},
+		{mysqlType: "bigint(1) unsigned", autoIncrement: true, expect: "bigserial"},
+		{mysqlType: "int unsigned not null", expect: "bigint not null"},
+		{mysqlType: "int", expect: "integer"},
+		{mysqlType: "tinyint(1) unsigned", expect: "boolean"},
+		{mysqlType: "tinyint(4)", gormType: schema.Bool, expect: "boolean"},
+		{mysqlType: "tinyint(4)", expect: "smallint"},
+		{mysqlType: "datetime(6) not null", expect: "timestamp(6) not null"},
+		{mysqlType: "timestamp NULL", expect: "timestamp NULL"},
+		{mysqlType: "double unsigned", expect: "double precision"},
+		{mysqlType: "varchar(255) collate utf8mb4_bin not null", expect: "varchar(255) not null"},
+		{mysqlType: "varchar(256) default ''", expect: "varchar(256) default ''"},
+		{mysqlType: "longtext", expect: "text"},
+		{mysqlType: "json not null", expect: "jsonb not null"},
+		{mysqlType: "mediumblob", expect: "bytea"},
+		{mysqlType: "enum('file', 'kv')", expect: "varchar(64)"},
+		{mysqlType: "timestamp not null on update current_timestamp comment 'updated'", expect: "timestamp not null"},
+	}
+
+	for _, c := range cases {
+		f := &schema.Field{DataType: schema.DataType(c.mysqlType), GORMDataType: c.gormType,
+			AutoIncrement: c.autoIncrement}
+		if got := pgDataType(c.mysqlType, f); got != c.expect {
+			t.Errorf("render %q for postgres, expect %q, got %q", c.mysqlType, c.expect, got)
+		}
+	}
+}
+
+func TestPgIndexName(t *testing.T) {
+	if got := PgIndexName("applications", "idx_bizID"); got != "applications_idx_bizID" {
+		t.Errorf("unexpected index name %s", got)
+	}
+	if got := PgIndexName("applications", "applications_idx_bizID"); got != "applications_idx_bizID" {
+		t.Errorf("qualified index name should be kept, got %s", got)
+	}
+
+	long := PgIndexName("released_app_template_variables", "idx_tenantID_bizID_appID_releaseID_name_path")
+	if len(long) > pgMaxIdentifierLen {
+		t.Errorf("index name %s exceeds the max identifier length", long)
+	}
+	if !strings.HasPrefix(long, "released_app_template_variables_idx_") {
+		t.Errorf("long index name should keep the table prefix, got %s", long)
+	}
+	other := PgIndexName("released_app_template_variables", "idx_tenantID_bizID_appID_releaseID_name_path2")
+	if long == other {
+		t.Errorf("truncated index names should be different, got %s", long)
+	}
+}
@@ -38,7 +38,13 @@ const (
 	SqlMode string = "sql"
 )
 
-var allSQLFiles []string
+const (
+	// sqlDir is the directory of the migration sql files, which are written in mysql.
+	sqlDir = "migrations/sql"
+	// pgSQLDir is the directory of the migration sql files rendered for postgresql,
+	// which override the mysql ones with the same file name.
+	pgSQLDir = "migrations/sql/postgres"
+)
 
 // Migration is one specific db migration
 type Migration struct {
@@ -90,17 +96,25 @@ func Init(db *gorm.DB) (*Migrator, error) {
 	var err error
 
 	// Create `schema_migrations` table to remember which migrations were executed.
-	if result := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+	ddl := `CREATE TABLE IF NOT EXISTS schema_migrations (
 		id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
 		applied DATETIME ( 6 ) NOT NULL,
 		version VARCHAR ( 255 )
-	);`); result.Error != nil {
+	);`
+	if IsPostgres(db) {
+		ddl = `CREATE TABLE IF NOT EXISTS schema_migrations (
+		id SERIAL PRIMARY KEY,
+		applied TIMESTAMP ( 6 ) NOT NULL,
+		version VARCHAR ( 255 )
+	);`
+	}
+	if result := db.Exec(ddl); result.Error != nil {
 		fmt.Println("Unable to create `schema_migrations` table", result.Error)
 		return migrator, result.Error
 	}
 
 	// Find out all the executed migrations
-	rows, err := db.Raw("SELECT version FROM `schema_migrations`;").Rows()
+	rows, err := db.Raw("SELECT version FROM schema_migrations;").Rows()
 	if err != nil {
 		return migrator, err
 	}
@@ -121,12 +135,12 @@ func Init(db *gorm.DB) (*Migrator, error) {
 		}
 	}
 
-	migrator.MigrationSQLs, err = getMigrationSQLs()
+	migrator.MigrationSQLs, err = getMigrationSQLs(IsPostgres(db))
 	if err != nil {
 		return migrator, err
 	}
 
-	migrator.checkSQLFiles()
+	migrator.checkSQLFiles(IsPostgres(db))
 
 	return migrator, nil
 }
@@ -158,7 +172,7 @@ func (m *Migrator) Up(step int) error {
 			return err
 		}
 
-		if result := tx.Exec("INSERT INTO `schema_migrations` (applied, version) VALUES(?, ?)",
+		if result := tx.Exec("INSERT INTO schema_migrations (applied, version) VALUES(?, ?)",
 			time.Now().UTC(), mg.Version); result.Error != nil {
 			tx.Rollback()
 			return result.Error
@@ -197,7 +211,7 @@ func (m *Migrator) Down(step int) error {
 			return err
 		}
 
-		if result := tx.Exec("DELETE FROM `schema_migrations` WHERE version = ?", mg.Version); result.Error != nil {
+		if result := tx.Exec("DELETE FROM schema_migrations WHERE version = ?", mg.Version); result.Error != nil {
 			tx.Rollback()
 			return result.Error
 		}
@@ -326,7 +340,7 @@ func genSqlMigrationFile(version, fileName string, in interface{}) error {
 }
 
 // checkSQLFiles check if every migration has corresponding sql file
-func (m *Migrator) checkSQLFiles() {
+func (m *Migrator) checkSQLFiles(postgres bool) {
 	for _, v := range m.Migrations {
 		// not check for gore mode
 		if v.Mode == GormMode {
@@ -335,6 +349,12 @@ func (m *Migrator) checkSQLFiles() {
 		// only check 'up sql' files, 'down sql' files are optional
 		if _, ok := m.MigrationSQLs[GetUpSQLKey(v.Name)]; !ok {
 			fmt.Printf("Warning: missing sql file for migration %s, please check!\n", v.Name)
+			continue
+		}
+		if postgres {
+			if _, err := dbmigration.SQLFiles.Open(path.Join(pgSQLDir, GetUpSQLKey(v.Name)+".sql")); err != nil {
+				fmt.Printf("Warning: missing postgres sql file for migration %s, please check!\n", v.Name)
+			}
 		}
 	}
 }
@@ -348,42 +368,57 @@ func getName(name string) (fileName string, funcName string) {
 	return
 }
 
-// getMigrationSQLs get migration sql from specific files
-func getMigrationSQLs() (map[string]string, error) {
+// getMigrationSQLs get migration sql from specific files, the sql files rendered for
+// postgresql override the mysql ones when the migrations are applied on postgresql.
+func getMigrationSQLs(postgres bool) (map[string]string, error) {
 	migrationSQLs := make(map[string]string)
-	dir := "migrations/sql"
-	if err := getAllSQLFiles(dir); err != nil {
-		fmt.Printf("get all sql fiiles(%s) err: %s", dir, err)
+	dirs := []string{sqlDir}
+	if postgres {
+		dirs = append(dirs, pgSQLDir)
 	}
-	for _, file := range allSQLFiles {
-		content, err := dbmigration.SQLFiles.ReadFile(file)
+
+	for _, dir := range dirs {
+		files, err := getAllSQLFiles(dir)
 		if err != nil {
-			fmt.Printf("read file %s err: %s", file, err)
-			return nil, fmt.Errorf("read file %s err: %s", file, err)
+			fmt.Printf("get all sql fiiles(%s) err: %s", dir, err)
+		}
+		for _, file := range files {
+			content, err := dbmigration.SQLFiles.ReadFile(file)
+			if err != nil {
+				fmt.Printf("read file %s err: %s", file, err)
+				return nil, fmt.Errorf("read file %s err: %s", file, err)
+			}
+			filename := filepath.Base(file)
+			migrationSQLs[strings.TrimSuffix(filename, path.Ext(filename))] = string(content)
 		}
-		filename := filepath.Base(file)
-		migrationSQLs[strings.TrimSuffix(filename, path.Ext(filename))] = string(content)
 	}
 
 	return migrationSQLs, nil
 }
 
-func getAllSQLFiles(dir string) error {
+// getAllSQLFiles get all the sql files in the dir, the sql files of other dialects are skipped.
+func getAllSQLFiles(dir string) ([]string, error) {
 	entries, err := dbmigration.SQLFiles.ReadDir(dir)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	var files []string
 	for _, e := range entries {
 		file := filepath.Join(dir, e.Name())
 		if e.IsDir() {
-			if err = getAllSQLFiles(file); err != nil {
-				return err
+			if file == pgSQLDir {
+				continue
+			}
+			sub, err := getAllSQLFiles(file)
+			if err != nil {
+				return nil, err
 			}
+			files = append(files, sub...)
 		} else if filepath.Ext(file) == ".sql" {
-			allSQLFiles = append(allSQLFiles, file)
+			files = append(files, file)
 		}
 	}
-	return nil
+	return files, nil
 }
 
 func reverse(arr []string) []string {
@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resharder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/sharding"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const freezesTable = "sharding_biz_freezes"
+
+// freeze is the write freeze of a biz recorded in sharding_biz_freezes.
+type freeze struct {
+	ID        uint32    `gorm:"column:id"`
+	BizID     uint32    `gorm:"column:biz_id"`
+	Memo      string    `gorm:"column:memo"`
+	Creator   string    `gorm:"column:creator"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+// Freeze records the write freeze of the biz, and waits until every process which writes the bizs has
+// reloaded it or stopped writing with the stale routes. The freeze is applied to the gorm statements of
+// data-service, including its crontab jobs, and cache-service, which are all the writers of the bizs,
+// the feed-servers and the other servers write through them.
+// It returns the id of the freeze, which is used to unfreeze the biz.
+func (r *Resharder) Freeze(ctx context.Context, bizID uint32, memo string) (uint32, error) {
+	f := &freeze{BizID: bizID, Memo: memo, Creator: constant.BKSystemUser, CreatedAt: time.Now().UTC()}
+	if err := r.admin.WithContext(ctx).Table(freezesTable).Create(f).Error; err != nil {
+		return 0, fmt.Errorf("freeze biz %d failed, it may have been frozen by another resharding, err: %v",
+			bizID, err)
+	}
+	logs.Infof("biz %d is frozen, wait %s for all the processes to reload the routes", bizID,
+		sharding.RouteSettleTime)
+
+	if err := settle(ctx); err != nil {
+		return f.ID, err
+	}
+	if err := r.confirmFreeze(ctx, f.ID); err != nil {
+		return f.ID, err
+	}
+
+	return f.ID, nil
+}
+
+// confirmFreeze confirms the freeze is still recorded, it may be removed by hand or another resharding.
+func (r *Resharder) confirmFreeze(ctx context.Context, id uint32) error {
+	var count int64
+	if err := r.admin.WithContext(ctx).Table(freezesTable).Where("id = ?", id).Count(&count).Error; err != nil {
+		return fmt.Errorf("confirm freeze %d failed, err: %v", id, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("freeze %d is removed during resharding", id)
+	}
+
+	return nil
+}
+
+// Unfreeze removes the write freeze of the biz.
+func (r *Resharder) Unfreeze(ctx context.Context, id uint32) error {
+	if err := r.admin.WithContext(ctx).Exec("DELETE FROM sharding_biz_freezes WHERE id = ?", id).Error; err != nil {
+		return fmt.Errorf("unfreeze %d failed, err: %v", id, err)
+	}
+
+	return nil
+}
+
+// settle waits for all the processes to reload the routes or stop writing with the stale ones.
+func settle(ctx context.Context) error {
+	select {
+	case <-time.After(sharding.RouteSettleTime):
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for the routes reloaded is canceled, err: %v", ctx.Err())
+	}
+}
@@ -0,0 +1,318 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package resharder moves a biz's rows to another sharding db and switches its routing entry in sharding_bizs.
+package resharder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/sharding"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	// AdminShardID is the shard id of the admin database, which is used by the biz without a routing entry.
+	AdminShardID uint32 = 0
+	// previousShardMemo is the memo of the routing entry, which records the shard the biz is moved from,
+	// it is used to roll back the resharding.
+	previousShardMemo = "resharded from sharding db %d"
+)
+
+// Resharder moves the rows of a biz between the shards.
+type Resharder struct {
+	admin     *gorm.DB
+	adminOpt  cc.Database
+	batchSize int
+	shards    map[uint32]*gorm.DB
+}
+
+// New a resharder with the admin database option.
+func New(adminOpt cc.Database, batchSize int, debug bool) (*Resharder, error) {
+	if batchSize <= 0 {
+		return nil, errors.New("batch size should be > 0")
+	}
+
+	level := logger.Warn
+	if debug {
+		level = logger.Info
+	}
+	admin, err := gorm.Open(sharding.GormDialector(adminOpt), &gorm.Config{Logger: logger.Default.LogMode(level)})
+	if err != nil {
+		return nil, fmt.Errorf("open admin db failed, err: %v", err)
+	}
+
+	return &Resharder{
+		admin:     admin,
+		adminOpt:  adminOpt,
+		batchSize: batchSize,
+		shards:    map[uint32]*gorm.DB{AdminShardID: admin},
+	}, nil
+}
+
+// shard returns the db of the sharding db id.
+func (r *Resharder) shard(ctx context.Context, id uint32) (*gorm.DB, error) {
+	if db, ok := r.shards[id]; ok {
+		return db, nil
+	}
+
+	spec := new(sharding.ShardingDB)
+	if err := r.admin.WithContext(ctx).Table("sharding_dbs").Where("id = ?", id).Take(spec).Error; err != nil {
+		return nil, fmt.Errorf("get sharding db %d failed, err: %v", id, err)
+	}
+	db, err := gorm.Open(sharding.GormDialector(sharding.ShardDatabase(r.adminOpt, spec)),
+		&gorm.Config{Logger: r.admin.Logger})
+	if err != nil {
+		return nil, fmt.Errorf("open sharding db %d failed, err: %v", id, err)
+	}
+
+	r.shards[id] = db
+	return db, nil
+}
+
+// CurrentShard returns the sharding db id which the biz is routed to, and the memo of the routing entry.
+func (r *Resharder) CurrentShard(ctx context.Context, bizID uint32) (uint32, string, error) {
+	route := struct {
+		ShardingDBID uint32 `gorm:"column:sharding_db_id"`
+		Memo         string `gorm:"column:memo"`
+	}{}
+	err := r.admin.WithContext(ctx).Table("sharding_bizs").Select("sharding_db_id", "memo").
+		Where("biz_id = ?", bizID).Take(&route).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return AdminShardID, "", nil
+	}
+	if err != nil {
+		return 0, "", fmt.Errorf("get sharding biz %d failed, err: %v", bizID, err)
+	}
+
+	return route.ShardingDBID, route.Memo, nil
+}
+
+// Sync copies the rows of the biz from the shard to the target shard, only the batches which differ
+// are copied, so it can be run repeatedly. When rolling back, the rows only in the target shard are
+// deleted only if their deletes are recorded in the source shard.
+func (r *Resharder) Sync(ctx context.Context, bizID, from, to uint32, rollback bool) ([]*TableReport, error) {
+	return r.walk(ctx, bizID, from, to, rollback, (*tableSyncer).sync)
+}
+
+// Verify compares the row counts and checksums of the biz's tables between the shards.
+func (r *Resharder) Verify(ctx context.Context, bizID, from, to uint32) ([]*TableReport, error) {
+	return r.walk(ctx, bizID, from, to, false, (*tableSyncer).verify)
+}
+
+func (r *Resharder) walk(ctx context.Context, bizID, from, to uint32, rollback bool,
+	do func(*tableSyncer, context.Context) (*TableReport, error)) ([]*TableReport, error) {
+
+	if from == to {
+		return nil, fmt.Errorf("biz %d is already in sharding db %d", bizID, to)
+	}
+	src, err := r.shard(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := r.shard(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := bizTables(src)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*TableReport, 0, len(tables))
+	for _, table := range tables {
+		syncer, err := newTableSyncer(src, dst, table, bizID, r.batchSize, rollback)
+		if err != nil {
+			return nil, err
+		}
+		report, err := do(syncer, ctx)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// Switch routes the biz to the target shard, the shard which the biz is moved from is recorded for rollback.
+// the deletes recorded in the target shard during the biz's last stay in it are cleared before switching.
+func (r *Resharder) Switch(ctx context.Context, bizID, from, to uint32) error {
+	dst, err := r.shard(ctx, to)
+	if err != nil {
+		return err
+	}
+	if dst.Migrator().HasTable(sharding.DeletesTable) {
+		if err := dst.WithContext(ctx).Exec("DELETE FROM ? WHERE biz_id = ?", clause.Table{Name: sharding.DeletesTable},
+			bizID).Error; err != nil {
+			return fmt.Errorf("clear recorded deletes of biz %d failed, err: %v", bizID, err)
+		}
+	}
+
+	return r.admin.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM sharding_bizs WHERE biz_id = ?", bizID).Error; err != nil {
+			return fmt.Errorf("delete sharding biz %d failed, err: %v", bizID, err)
+		}
+		if to == AdminShardID {
+			return nil
+		}
+
+		now := time.Now().UTC()
+		entry := map[string]interface{}{
+			"memo":           fmt.Sprintf(previousShardMemo, from),
+			"biz_id":         bizID,
+			"sharding_db_id": to,
+			"creator":        constant.BKSystemUser,
+			"reviser":        constant.BKSystemUser,
+			"created_at":     now,
+			"updated_at":     now,
+		}
+		if err := tx.Table("sharding_bizs").Create(entry).Error; err != nil {
+			return fmt.Errorf("create sharding biz %d failed, err: %v", bizID, err)
+		}
+		return nil
+	})
+}
+
+// RunOption defines the options to move a biz to another shard.
+type RunOption struct {
+	BizID uint32
+	// Target is the sharding db id to move the biz to, 0 means the admin database.
+	Target uint32
+	// Rollback means the biz is moved back to the shard it was moved from, whose rows are only deleted
+	// if their deletes are recorded in the current shard.
+	Rollback bool
+}
+
+// Run moves the biz to the target shard:
+//  1. copy the rows online, while the biz is still written.
+//  2. freeze the writes of the biz, copy the rows changed during step 1 and verify all the tables.
+//  3. switch the routing entry, wait for all the processes to reload it, and unfreeze the writes.
+//
+// the routing entry is left unchanged if any step before switching fails, the rows in the source
+// shard are kept for rollback.
+func (r *Resharder) Run(ctx context.Context, opt *RunOption) (err error) {
+	from, _, err := r.CurrentShard(ctx, opt.BizID)
+	if err != nil {
+		return err
+	}
+	logs.Infof("move biz %d from sharding db %d to %d", opt.BizID, from, opt.Target)
+
+	reports, err := r.Sync(ctx, opt.BizID, from, opt.Target, opt.Rollback)
+	if err != nil {
+		return err
+	}
+	logSynced("online copy", reports)
+
+	freezeID, err := r.Freeze(ctx, opt.BizID, fmt.Sprintf("move from sharding db %d to %d", from, opt.Target))
+	if freezeID != 0 {
+		defer func() {
+			// the freeze is kept if the routes may not be reloaded after switching, otherwise the processes
+			// still using the old routes would write the biz into the shard it is moved from.
+			if errors.Is(err, errSwitchedUnsettled) {
+				return
+			}
+			if e := r.Unfreeze(context.Background(), freezeID); e != nil {
+				logs.Errorf("unfreeze biz %d failed, please delete freeze %d from %s by hand, err: %v",
+					opt.BizID, freezeID, freezesTable, e)
+				if err == nil {
+					err = e
+				}
+			}
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	if reports, err = r.Sync(ctx, opt.BizID, from, opt.Target, opt.Rollback); err != nil {
+		return err
+	}
+	logSynced("frozen copy", reports)
+	for _, report := range reports {
+		if report.UnrecordedRows != 0 {
+			return fmt.Errorf("table %s of biz %d has %d rows only in sharding db %d without recorded deletes, "+
+				"they are kept, please check them by hand", report.Table, opt.BizID, report.UnrecordedRows, opt.Target)
+		}
+	}
+
+	if reports, err = r.Verify(ctx, opt.BizID, from, opt.Target); err != nil {
+		return err
+	}
+	logVerified(reports)
+	for _, report := range reports {
+		if !report.Matched() {
+			return fmt.Errorf("table %s of biz %d mismatched after copy, source rows: %d, target rows: %d",
+				report.Table, opt.BizID, report.SourceRows, report.TargetRows)
+		}
+	}
+
+	if err = r.Switch(ctx, opt.BizID, from, opt.Target); err != nil {
+		return err
+	}
+	logs.Infof("biz %d is routed to sharding db %d, wait %s for all the processes to reload the routes",
+		opt.BizID, opt.Target, sharding.RouteSettleTime)
+
+	if err = settle(ctx); err != nil {
+		logs.Errorf("biz %d is switched but still frozen, please delete freeze %d from %s by hand after %s",
+			opt.BizID, freezeID, freezesTable, sharding.RouteSettleTime)
+		return fmt.Errorf("%w, %v", errSwitchedUnsettled, err)
+	}
+	return nil
+}
+
+// errSwitchedUnsettled means the biz is switched, but the processes may not have reloaded the routes.
+var errSwitchedUnsettled = errors.New("biz is switched before the routes are reloaded")
+
+// Rollback moves the biz back to the shard it was moved from, the rows written after the resharding
+// are copied back as well, the rows deleted after the resharding are deleted only if the deletes are
+// recorded in the current shard.
+func (r *Resharder) Rollback(ctx context.Context, bizID uint32) error {
+	current, memo, err := r.CurrentShard(ctx, bizID)
+	if err != nil {
+		return err
+	}
+	if current == AdminShardID {
+		return fmt.Errorf("biz %d is not resharded", bizID)
+	}
+
+	var previous uint32
+	if _, err := fmt.Sscanf(memo, previousShardMemo, &previous); err != nil {
+		return fmt.Errorf("the shard biz %d is moved from is unknown, memo: %s", bizID, memo)
+	}
+
+	return r.Run(ctx, &RunOption{BizID: bizID, Target: previous, Rollback: true})
+}
+
+func logSynced(phase string, reports []*TableReport) {
+	for _, report := range reports {
+		logs.Infof("%s table %s, source rows: %d, copied rows: %d", phase, report.Table, report.SourceRows,
+			report.CopiedRows)
+	}
+}
+
+func logVerified(reports []*TableReport) {
+	for _, report := range reports {
+		logs.Infof("verify table %s, source rows: %d, target rows: %d, matched: %v", report.Table,
+			report.SourceRows, report.TargetRows, report.Matched())
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resharder
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestPlanBack(t *testing.T) {
+	row := func(id uint64, name string) map[string]interface{} {
+		return map[string]interface{}{"id": id, "biz_id": uint32(2), "name": name}
+	}
+	s := &tableSyncer{table: "apps", bizID: 2, hasID: true, rollback: true,
+		recorded: map[uint64]struct{}{3: {}}}
+
+	// row 1 is unchanged, row 2 is updated, row 3 is deleted with record, row 4 is missing without record,
+	// row 5 is created in the source shard.
+	src := []map[string]interface{}{row(1, "a"), row(2, "b2"), row(5, "e")}
+	dst := []map[string]interface{}{row(1, "a"), row(2, "b"), row(3, "c"), row(4, "d")}
+
+	report := new(TableReport)
+	ids, copies, err := s.planBack(report, src, dst)
+	if err != nil {
+		t.Fatalf("plan back failed, err: %v", err)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if !reflect.DeepEqual(ids, []uint64{2, 3, 5}) {
+		t.Errorf("unexpected deleted ids: %v", ids)
+	}
+	if len(copies) != 2 || copies[0]["id"] != uint64(2) || copies[1]["id"] != uint64(5) {
+		t.Errorf("unexpected copied rows: %v", copies)
+	}
+	if report.UnrecordedRows != 1 {
+		t.Errorf("unrecorded rows should be 1, but got %d", report.UnrecordedRows)
+	}
+
+	// the rows without id are never deleted.
+	s.hasID = false
+	report = new(TableReport)
+	ids, copies, err = s.planBack(report, src[:1], dst[:2])
+	if err != nil {
+		t.Fatalf("plan back failed, err: %v", err)
+	}
+	if len(ids) != 0 || len(copies) != 0 || report.UnrecordedRows != 1 {
+		t.Errorf("unexpected plan of table without id, ids: %v, copies: %v, unrecorded rows: %d", ids, copies,
+			report.UnrecordedRows)
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	now := time.Now()
+	a := []map[string]interface{}{{"id": uint64(1), "biz_id": uint32(2), "name": "a", "created_at": now, "memo": nil}}
+	b := []map[string]interface{}{{"memo": nil, "created_at": now.In(time.FixedZone("x", 3600)), "name": []byte("a"),
+		"biz_id": int64(2), "id": int64(1)}}
+	if checksum(a) != checksum(b) {
+		t.Errorf("checksum of the same row read differently mismatched, %s != %s", rowString(a[0]), rowString(b[0]))
+	}
+
+	b[0]["name"] = "b"
+	if checksum(a) == checksum(b) {
+		t.Errorf("checksum of different rows matched")
+	}
+
+	id, err := rowID(map[string]interface{}{"id": []byte("42")})
+	if err != nil || id != 42 {
+		t.Errorf("row id = %d, err: %v, want 42", id, err)
+	}
+}
@@ -0,0 +1,469 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resharder
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/sharding"
+)
+
+// TableReport is the sync or verify result of a biz-scoped table.
+type TableReport struct {
+	Table          string
+	SourceRows     int64
+	TargetRows     int64
+	SourceChecksum string
+	TargetChecksum string
+	// CopiedRows is the rows copied to the target shard to make it up to date with the source shard.
+	CopiedRows int64
+	// UnrecordedRows is the rows only in the target shard which are kept when the biz is rolled back,
+	// because their deletes are not recorded in the source shard.
+	UnrecordedRows int64
+}
+
+// Matched returns whether the rows of the source and target shard are the same.
+func (t *TableReport) Matched() bool {
+	return t.SourceRows == t.TargetRows && t.SourceChecksum == t.TargetChecksum
+}
+
+// bizTables returns the tables which have biz_id column in the source shard, sorted by name.
+func bizTables(db *gorm.DB) ([]string, error) {
+	tables, err := db.Migrator().GetTables()
+	if err != nil {
+		return nil, fmt.Errorf("list tables failed, err: %v", err)
+	}
+
+	result := make([]string, 0, len(tables))
+	for _, t := range tables {
+		if _, ok := sharding.AdminTables[t]; ok || t == sharding.DeletesTable {
+			continue
+		}
+		if db.Migrator().HasColumn(t, "biz_id") {
+			result = append(result, t)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// tableSyncer syncs and verifies the rows of a biz in a table between the source and target shard.
+// the rows are walked in batches of primary key id, a batch is copied again only if its checksum
+// differs between the shards, so that syncing under the write freeze only copies what changed.
+type tableSyncer struct {
+	src, dst  *gorm.DB
+	table     string
+	bizID     uint32
+	batchSize int
+	hasID     bool
+	// rollback means the target shard is the one the biz is moved from, whose rows are only deleted
+	// if their deletes are recorded in the source shard.
+	rollback bool
+	// recorded is the ids of the rows whose deletes are recorded in the source shard.
+	recorded map[uint64]struct{}
+}
+
+func newTableSyncer(src, dst *gorm.DB, table string, bizID uint32, batchSize int, rollback bool) (*tableSyncer,
+	error) {
+	if !dst.Migrator().HasTable(table) {
+		return nil, fmt.Errorf("table %s not exists in target shard, apply the migrations on it first", table)
+	}
+
+	s := &tableSyncer{
+		src:       src,
+		dst:       dst,
+		table:     table,
+		bizID:     bizID,
+		batchSize: batchSize,
+		hasID:     src.Migrator().HasColumn(table, "id"),
+		rollback:  rollback,
+		recorded:  make(map[uint64]struct{}),
+	}
+	if !rollback || !s.hasID {
+		return s, nil
+	}
+
+	ids := make([]uint64, 0)
+	if err := src.Table(sharding.DeletesTable).Where("biz_id = ? AND table_name = ?", bizID, table).
+		Pluck("row_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("list recorded deletes of table %s failed, err: %v", table, err)
+	}
+	for _, id := range ids {
+		s.recorded[id] = struct{}{}
+	}
+	return s, nil
+}
+
+// sync makes the rows of the biz in the target shard the same as the source shard.
+func (s *tableSyncer) sync(ctx context.Context) (*TableReport, error) {
+	report := &TableReport{Table: s.table}
+	if !s.hasID {
+		// the table without id is walked as a whole.
+		rows, err := s.list(ctx, s.src, 0, nil)
+		if err != nil {
+			return nil, err
+		}
+		report.SourceRows = int64(len(rows))
+		return report, s.syncRange(ctx, report, rows, nil, nil)
+	}
+
+	var lastID uint64
+	for {
+		rows, err := s.list(ctx, s.src, lastID, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			// the rows beyond the last source row have been deleted in the source shard.
+			if s.rollback {
+				return report, s.deleteRecordedAfter(ctx, report, lastID)
+			}
+			return report, s.delete(ctx, &lastID, nil)
+		}
+
+		report.SourceRows += int64(len(rows))
+
+		hi, err := rowID(rows[len(rows)-1])
+		if err != nil {
+			return nil, fmt.Errorf("parse id of table %s failed, err: %v", s.table, err)
+		}
+		if err := s.syncRange(ctx, report, rows, &lastID, &hi); err != nil {
+			return nil, err
+		}
+		lastID = hi
+	}
+}
+
+// syncRange copies the source rows whose id is in (lo, hi] again if the checksum differs.
+func (s *tableSyncer) syncRange(ctx context.Context, report *TableReport, srcRows []map[string]interface{},
+	lo, hi *uint64) error {
+	var after uint64
+	if lo != nil {
+		after = *lo
+	}
+	dstRows, err := s.list(ctx, s.dst, after, hi)
+	if err != nil {
+		return err
+	}
+	if checksum(srcRows) == checksum(dstRows) {
+		return nil
+	}
+	if s.rollback {
+		return s.syncBack(ctx, report, srcRows, dstRows)
+	}
+
+	return s.dst.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.deleteWith(tx, lo, hi); err != nil {
+			return err
+		}
+		if len(srcRows) == 0 {
+			return nil
+		}
+		if err := tx.Table(s.table).CreateInBatches(srcRows, s.batchSize).Error; err != nil {
+			return fmt.Errorf("copy rows of table %s failed, err: %v", s.table, err)
+		}
+		report.CopiedRows += int64(len(srcRows))
+		return nil
+	})
+}
+
+// syncBack copies the source rows which differ from the target shard, the rows only in the target shard
+// are deleted only if their deletes are recorded in the source shard, the others are kept and reported.
+func (s *tableSyncer) syncBack(ctx context.Context, report *TableReport, srcRows,
+	dstRows []map[string]interface{}) error {
+	ids, copies, err := s.planBack(report, srcRows, dstRows)
+	if err != nil {
+		return err
+	}
+	return s.replace(ctx, report, ids, copies)
+}
+
+// planBack returns the ids of the rows to delete from the target shard and the source rows to copy to it.
+func (s *tableSyncer) planBack(report *TableReport, srcRows, dstRows []map[string]interface{}) ([]uint64,
+	[]map[string]interface{}, error) {
+	if !s.hasID {
+		// the rows without id are compared as a whole, none of them is deleted.
+		dstSet := make(map[string]int, len(dstRows))
+		for _, row := range dstRows {
+			dstSet[rowString(row)]++
+		}
+		copies := make([]map[string]interface{}, 0)
+		for _, row := range srcRows {
+			key := rowString(row)
+			if dstSet[key] > 0 {
+				dstSet[key]--
+				continue
+			}
+			copies = append(copies, row)
+		}
+		for _, left := range dstSet {
+			report.UnrecordedRows += int64(left)
+		}
+		return nil, copies, nil
+	}
+
+	dstByID := make(map[uint64]string, len(dstRows))
+	for _, row := range dstRows {
+		id, err := rowID(row)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse id of table %s failed, err: %v", s.table, err)
+		}
+		dstByID[id] = rowString(row)
+	}
+
+	ids := make([]uint64, 0)
+	copies := make([]map[string]interface{}, 0)
+	for _, row := range srcRows {
+		id, err := rowID(row)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse id of table %s failed, err: %v", s.table, err)
+		}
+		dst, exists := dstByID[id]
+		delete(dstByID, id)
+		if exists && dst == rowString(row) {
+			continue
+		}
+		ids = append(ids, id)
+		copies = append(copies, row)
+	}
+	for id := range dstByID {
+		if _, ok := s.recorded[id]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		report.UnrecordedRows++
+	}
+
+	return ids, copies, nil
+}
+
+// deleteRecordedAfter deletes the rows of the target shard whose id is greater than the last source row,
+// only if their deletes are recorded in the source shard.
+func (s *tableSyncer) deleteRecordedAfter(ctx context.Context, report *TableReport, lastID uint64) error {
+	for {
+		rows, err := s.list(ctx, s.dst, lastID, nil)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		ids := make([]uint64, 0)
+		for _, row := range rows {
+			id, err := rowID(row)
+			if err != nil {
+				return fmt.Errorf("parse id of table %s failed, err: %v", s.table, err)
+			}
+			if _, ok := s.recorded[id]; ok {
+				ids = append(ids, id)
+				continue
+			}
+			report.UnrecordedRows++
+		}
+		if err := s.replace(ctx, report, ids, nil); err != nil {
+			return err
+		}
+
+		if lastID, err = rowID(rows[len(rows)-1]); err != nil {
+			return fmt.Errorf("parse id of table %s failed, err: %v", s.table, err)
+		}
+	}
+}
+
+// replace deletes the rows of the ids from the target shard and copies the rows to it in a transaction.
+func (s *tableSyncer) replace(ctx context.Context, report *TableReport, ids []uint64,
+	copies []map[string]interface{}) error {
+	if len(ids) == 0 && len(copies) == 0 {
+		return nil
+	}
+
+	return s.dst.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(ids) != 0 {
+			if err := tx.Exec("DELETE FROM ? WHERE biz_id = ? AND id IN ?", clause.Table{Name: s.table}, s.bizID,
+				ids).Error; err != nil {
+				return fmt.Errorf("delete rows of table %s failed, err: %v", s.table, err)
+			}
+		}
+		if len(copies) == 0 {
+			return nil
+		}
+		if err := tx.Table(s.table).CreateInBatches(copies, s.batchSize).Error; err != nil {
+			return fmt.Errorf("copy rows of table %s failed, err: %v", s.table, err)
+		}
+		report.CopiedRows += int64(len(copies))
+		return nil
+	})
+}
+
+// verify compares the row count and checksum of the biz between the source and target shard.
+func (s *tableSyncer) verify(ctx context.Context) (*TableReport, error) {
+	report := &TableReport{Table: s.table}
+
+	var err error
+	if report.SourceRows, report.SourceChecksum, err = s.digest(ctx, s.src); err != nil {
+		return nil, err
+	}
+	if report.TargetRows, report.TargetChecksum, err = s.digest(ctx, s.dst); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// digest returns the row count and checksum of the biz's rows in the shard.
+func (s *tableSyncer) digest(ctx context.Context, db *gorm.DB) (int64, string, error) {
+	h := sha256.New()
+	if !s.hasID {
+		rows, err := s.list(ctx, db, 0, nil)
+		if err != nil {
+			return 0, "", err
+		}
+		writeRows(h, rows)
+		return int64(len(rows)), hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var count int64
+	var lastID uint64
+	for {
+		rows, err := s.list(ctx, db, lastID, nil)
+		if err != nil {
+			return 0, "", err
+		}
+		if len(rows) == 0 {
+			return count, hex.EncodeToString(h.Sum(nil)), nil
+		}
+		writeRows(h, rows)
+		count += int64(len(rows))
+
+		if lastID, err = rowID(rows[len(rows)-1]); err != nil {
+			return 0, "", fmt.Errorf("parse id of table %s failed, err: %v", s.table, err)
+		}
+	}
+}
+
+// list returns the rows of the biz whose id is in (after, hi], a batch is returned if hi is nil.
+// all the rows are returned if the table has no id.
+func (s *tableSyncer) list(ctx context.Context, db *gorm.DB, after uint64, hi *uint64) ([]map[string]interface{}, error) {
+	q := db.WithContext(ctx).Table(s.table).Where("biz_id = ?", s.bizID)
+	if s.hasID {
+		q = q.Where("id > ?", after).Order("id")
+		if hi != nil {
+			q = q.Where("id <= ?", *hi)
+		} else {
+			q = q.Limit(s.batchSize)
+		}
+	}
+
+	rows := make([]map[string]interface{}, 0)
+	if err := q.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("list rows of table %s failed, err: %v", s.table, err)
+	}
+	if !s.hasID {
+		sort.Slice(rows, func(i, j int) bool { return rowString(rows[i]) < rowString(rows[j]) })
+	}
+	return rows, nil
+}
+
+func (s *tableSyncer) delete(ctx context.Context, lo, hi *uint64) error {
+	return s.deleteWith(s.dst.WithContext(ctx), lo, hi)
+}
+
+// deleteWith deletes the rows of the biz whose id is in (lo, hi] in the target shard.
+func (s *tableSyncer) deleteWith(tx *gorm.DB, lo, hi *uint64) error {
+	sql := "DELETE FROM ? WHERE biz_id = ?"
+	vars := []interface{}{clause.Table{Name: s.table}, s.bizID}
+	if lo != nil {
+		sql += " AND id > ?"
+		vars = append(vars, *lo)
+	}
+	if hi != nil {
+		sql += " AND id <= ?"
+		vars = append(vars, *hi)
+	}
+
+	if err := tx.Exec(sql, vars...).Error; err != nil {
+		return fmt.Errorf("delete rows of table %s failed, err: %v", s.table, err)
+	}
+	return nil
+}
+
+func checksum(rows []map[string]interface{}) string {
+	h := sha256.New()
+	writeRows(h, rows)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeRows(h hash.Hash, rows []map[string]interface{}) {
+	for _, row := range rows {
+		_, _ = h.Write([]byte(rowString(row)))
+		_, _ = h.Write([]byte{'\n'})
+	}
+}
+
+// rowString formats the row with columns sorted by name, so that the same row read from
+// different shards is formatted the same.
+func rowString(row map[string]interface{}) string {
+	columns := make([]string, 0, len(row))
+	for column := range row {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	result := make([]byte, 0, 256)
+	for _, column := range columns {
+		result = append(result, column...)
+		result = append(result, '=')
+		result = append(result, formatValue(row[column])...)
+		result = append(result, ';')
+	}
+	return string(result)
+}
+
+func formatValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "NULL"
+	case []byte:
+		return strconv.Quote(string(val))
+	case string:
+		return strconv.Quote(val)
+	case time.Time:
+		return val.UTC().Format(time.RFC3339Nano)
+	case *time.Time:
+		if val == nil {
+			return "NULL"
+		}
+		return val.UTC().Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+func rowID(row map[string]interface{}) (uint64, error) {
+	switch id := row["id"].(type) {
+	case []byte:
+		return strconv.ParseUint(string(id), 10, 64)
+	default:
+		return strconv.ParseUint(fmt.Sprint(id), 10, 64)
+	}
+}
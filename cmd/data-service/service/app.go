@@ -247,6 +247,24 @@ func (s *Service) deleteAppRelatedResources(grpcKit *kit.Kit, req *pbds.DeleteAp
 		return err
 	}
 
+	// delete released group config items
+	if err := s.dao.ReleasedGroupCI().BatchDeleteByAppIDWithTx(grpcKit, tx, req.Id, req.BizId); err != nil {
+		logs.Errorf("delete released group config items failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return err
+	}
+
+	// delete group template variables
+	if err := s.dao.GroupTemplateVariable().DeleteByAppIDWithTx(grpcKit, tx, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete group template variables failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return err
+	}
+
+	// delete git repository links
+	if err := s.dao.GitRepoLink().DeleteByAppIDWithTx(grpcKit, tx, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete git repo links failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return err
+	}
+
 	// delete released hook
 	if err := s.dao.ReleasedHook().DeleteByAppIDWithTx(grpcKit, tx, req.Id, req.BizId); err != nil {
 		logs.Errorf("delete released hooks failed, err: %v, rid: %s", err, grpcKit.Rid)
@@ -558,7 +576,7 @@ func (s *Service) createConfigItems(kit *kit.Kit, tx *gen.QueryTx, bizID, appID,
 		})
 	}
 
-	_, err := s.doBatchCreateConfigItems(kit, tx, items, now, bizID, appID)
+	_, err := s.doBatchCreateConfigItems(kit, tx, items, now, bizID, appID, "")
 	if err != nil {
 		logs.Errorf("batch create config items failed, err: %v, rid: %s", err, kit.Rid)
 		return err
@@ -728,7 +746,7 @@ func (s *Service) referenceHook(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, pre
 	}
 
 	if preHookId > 0 {
-		hook, err := s.getReleasedHook(kit, preHook)
+		hook, err := s.getReleasedHook(kit, preHook, nil)
 		if err != nil {
 			logs.Errorf("no released releases of the pre-hook, err: %v, rid: %s", err, kit.Rid)
 			return errors.New("no released releases of the pre-hook")
@@ -741,7 +759,7 @@ func (s *Service) referenceHook(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, pre
 	}
 
 	if postHookId > 0 {
-		hook, err := s.getReleasedHook(kit, postHook)
+		hook, err := s.getReleasedHook(kit, postHook, nil)
 		if err != nil {
 			logs.Errorf("get post-hook failed, err: %v, rid: %s", err, kit.Rid)
 			return err
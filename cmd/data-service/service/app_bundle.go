@@ -0,0 +1,1405 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbapp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app"
+	pbatb "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app-template-binding"
+	pbatv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app-template-variable"
+	pbci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
+	pbcontent "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
+	pbgroup "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/group"
+	pbhr "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook-revision"
+	pbkv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/kv"
+	pbtv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/template-variable"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// the resource types reported by the app bundle import.
+const (
+	bundleResApp             = "app"
+	bundleResConfigItem      = "config_item"
+	bundleResKv              = "kv"
+	bundleResHook            = "hook"
+	bundleResHookRevision    = "hook_revision"
+	bundleResTemplateBinding = "template_binding"
+	bundleResVariable        = "variable"
+	bundleResGroup           = "group"
+	bundleResGroupVariable   = "group_variable"
+)
+
+// ExportAppBundle export the app with its config items, kvs, hooks, template bindings, variables and groups
+// as the manifest of the app bundle, the contents of the config items are packed by the caller.
+func (s *Service) ExportAppBundle(ctx context.Context, req *pbds.ExportAppBundleReq) (
+	*pbds.ExportAppBundleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if req.IncludeSecrets && req.Passphrase == "" {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s",
+			i18n.T(kt, "passphrase is required to export the secret values"))
+	}
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "get app failed, err: %v", err))
+	}
+
+	spec := *app.Spec
+	spec.LastConsumedTime = nil
+	spec.TenantID = ""
+	bundle := &types.AppBundle{
+		Version:    types.AppBundleVersion,
+		ExportedAt: time.Now().UTC(),
+		App:        &types.AppBundleApp{ID: app.ID, BizID: app.BizID, Spec: &spec},
+	}
+
+	// the passphrase is only used to derive the key to encrypt the secret values when they are included.
+	if req.IncludeSecrets {
+		if err = bundle.SealSecrets(req.Passphrase); err != nil {
+			return nil, err
+		}
+	}
+
+	if app.Spec.ConfigType == table.KV {
+		err = s.exportBundleKvs(kt, bundle, req.IncludeSecrets)
+	} else {
+		err = s.exportBundleConfigItems(kt, bundle)
+		if err == nil {
+			err = s.exportBundleTemplateBindings(kt, bundle)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.exportBundleHooks(kt, bundle); err != nil {
+		return nil, err
+	}
+
+	if err = s.exportBundleVariables(kt, bundle, req.IncludeSecrets); err != nil {
+		return nil, err
+	}
+
+	if err = s.exportBundleGroups(kt, bundle, req.IncludeSecrets); err != nil {
+		return nil, err
+	}
+
+	manifest, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbds.ExportAppBundleResp{Manifest: manifest}, nil
+}
+
+func (s *Service) exportBundleConfigItems(kt *kit.Kit, bundle *types.AppBundle) error {
+	bizID, appID := bundle.App.BizID, bundle.App.ID
+	cis, err := s.dao.ConfigItem().ListAllByAppID(kt, appID, bizID)
+	if err != nil {
+		logs.Errorf("list editing config items failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	commits, err := s.dao.Commit().ListAppLatestCommits(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list latest commits failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	contents := make(map[uint32]*table.ContentSpec, len(commits))
+	for _, c := range commits {
+		contents[c.Attachment.ConfigItemID] = c.Spec.Content
+	}
+
+	for _, ci := range cis {
+		content, ok := contents[ci.ID]
+		if !ok || content == nil {
+			continue
+		}
+		bundle.ConfigItems = append(bundle.ConfigItems, &types.AppBundleConfigItem{
+			ID:      ci.ID,
+			Spec:    ci.Spec,
+			Content: content,
+		})
+	}
+
+	return nil
+}
+
+// exportBundleKvs export the kvs of the app, the value of the secret kv is only kept encrypted when the
+// secrets are included, and the value of the secret kv which is hidden is never exported.
+func (s *Service) exportBundleKvs(kt *kit.Kit, bundle *types.AppBundle, includeSecrets bool) error {
+	bizID, appID := bundle.App.BizID, bundle.App.ID
+	kvs, err := s.dao.Kv().ListAllByAppID(kt, appID, bizID, []string{string(table.KvStateAdd),
+		string(table.KvStateRevise), string(table.KvStateUnchange)})
+	if err != nil {
+		logs.Errorf("list kvs failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	for _, kv := range kvs {
+		_, value, err := s.getKv(kt, bizID, appID, kv.Spec.Version, kv.Spec.Key)
+		if err != nil {
+			logs.Errorf("get value of kv %s failed, err: %v, rid: %s", kv.Spec.Key, err, kt.Rid)
+			return err
+		}
+
+		spec := *kv.Spec
+		spec.Version = 0
+		one := &types.AppBundleKv{ID: kv.ID, Spec: &spec, Value: value}
+		if spec.KvType == table.KvSecret {
+			one.Value = ""
+			if includeSecrets && !spec.SecretHidden {
+				if one.EncryptedValue, err = bundle.EncryptSecret(value); err != nil {
+					return err
+				}
+			}
+		}
+		bundle.Kvs = append(bundle.Kvs, one)
+	}
+
+	return nil
+}
+
+func (s *Service) exportBundleHooks(kt *kit.Kit, bundle *types.AppBundle) error {
+	bizID, appID := bundle.App.BizID, bundle.App.ID
+	for _, tp := range []table.HookType{table.PreHook, table.PostHook} {
+		rh, err := s.dao.ReleasedHook().Get(kt, bizID, appID, 0, tp)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			logs.Errorf("get %s failed, err: %v, rid: %s", tp.String(), err, kt.Rid)
+			return err
+		}
+
+		hook, err := s.dao.Hook().GetByID(kt, bizID, rh.HookID)
+		if err != nil {
+			logs.Errorf("get hook (%d) failed, err: %v, rid: %s", rh.HookID, err, kt.Rid)
+			return err
+		}
+
+		revisions, _, err := s.dao.HookRevision().List(kt, &types.ListHookRevisionsOption{
+			BizID:  bizID,
+			HookID: hook.ID,
+			Page:   &types.BasePage{All: true},
+		})
+		if err != nil {
+			logs.Errorf("list revisions of hook (%d) failed, err: %v, rid: %s", hook.ID, err, kt.Rid)
+			return err
+		}
+
+		one := &types.AppBundleHook{
+			ID:       hook.ID,
+			HookType: tp,
+			Spec:     hook.Spec,
+			Deployed: rh.HookRevisionName,
+			Params:   rh.ParamValues(),
+		}
+		for _, r := range revisions {
+			one.Revisions = append(one.Revisions, r.Spec)
+		}
+		bundle.Hooks = append(bundle.Hooks, one)
+	}
+
+	return nil
+}
+
+func (s *Service) exportBundleTemplateBindings(kt *kit.Kit, bundle *types.AppBundle) error {
+	bizID, appID := bundle.App.BizID, bundle.App.ID
+	atb, err := s.dao.AppTemplateBinding().GetAppTemplateBindingByAppID(kt, bizID, appID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		logs.Errorf("get app template binding failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	sets, err := s.dao.TemplateSet().ListByIDs(kt, atb.Spec.TemplateSetIDs)
+	if err != nil {
+		logs.Errorf("list template sets failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	spaces, err := s.dao.TemplateSpace().ListByIDs(kt, atb.Spec.TemplateSpaceIDs)
+	if err != nil {
+		logs.Errorf("list template spaces failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	tmpls, err := s.dao.Template().ListByIDs(kt, atb.Spec.TemplateIDs)
+	if err != nil {
+		logs.Errorf("list templates failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	revisions, err := s.dao.TemplateRevision().ListByIDs(kt, atb.Spec.TemplateRevisionIDs)
+	if err != nil {
+		logs.Errorf("list template revisions failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	setMap := make(map[uint32]*table.TemplateSet, len(sets))
+	for _, one := range sets {
+		setMap[one.ID] = one
+	}
+	spaceNames := make(map[uint32]string, len(spaces))
+	for _, one := range spaces {
+		spaceNames[one.ID] = one.Spec.Name
+	}
+	tmplMap := make(map[uint32]*table.Template, len(tmpls))
+	for _, one := range tmpls {
+		tmplMap[one.ID] = one
+	}
+	revisionNames := make(map[uint32]string, len(revisions))
+	for _, one := range revisions {
+		revisionNames[one.ID] = one.Spec.RevisionName
+	}
+
+	for _, b := range atb.Spec.Bindings {
+		set, ok := setMap[b.TemplateSetID]
+		if !ok {
+			continue
+		}
+		one := &types.AppBundleTemplateBinding{
+			TemplateSpace: spaceNames[set.Attachment.TemplateSpaceID],
+			TemplateSet:   set.Spec.Name,
+		}
+		for _, r := range b.TemplateRevisions {
+			tmpl, ok := tmplMap[r.TemplateID]
+			if !ok {
+				continue
+			}
+			one.Templates = append(one.Templates, &types.AppBundleTemplate{
+				Name:     tmpl.Spec.Name,
+				Path:     tmpl.Spec.Path,
+				Revision: revisionNames[r.TemplateRevisionID],
+				IsLatest: r.IsLatest,
+			})
+		}
+		bundle.TemplateBindings = append(bundle.TemplateBindings, one)
+	}
+
+	return nil
+}
+
+func (s *Service) exportBundleVariables(kt *kit.Kit, bundle *types.AppBundle, includeSecrets bool) error {
+	bizID, appID := bundle.App.BizID, bundle.App.ID
+	vars, err := s.dao.AppTemplateVariable().ListVariables(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list app template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	bundle.Variables, err = s.exportBundleVariableSpecs(kt, bundle, 0, vars, includeSecrets)
+	return err
+}
+
+func (s *Service) exportBundleGroups(kt *kit.Kit, bundle *types.AppBundle, includeSecrets bool) error {
+	bizID, appID := bundle.App.BizID, bundle.App.ID
+	groups, err := s.dao.Group().ListAppGroups(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list app groups failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	for _, g := range groups {
+		if g.Spec.Mode == table.GroupModeDefault {
+			continue
+		}
+
+		vars, err := s.dao.GroupTemplateVariable().ListVariables(kt, bizID, appID, g.ID)
+		if err != nil {
+			logs.Errorf("list group template variables failed, err: %v, rid: %s", err, kt.Rid)
+			return err
+		}
+		one := &types.AppBundleGroup{ID: g.ID, Spec: g.Spec}
+		if one.Variables, err = s.exportBundleVariableSpecs(kt, bundle, g.ID, vars, includeSecrets); err != nil {
+			return err
+		}
+		bundle.Groups = append(bundle.Groups, one)
+	}
+
+	return nil
+}
+
+// exportBundleVariableSpecs export the variables overridden by the app or its group, the mask of the secret
+// variable is cleared, and its value in vault is only exported encrypted when the secrets are included.
+func (s *Service) exportBundleVariableSpecs(kt *kit.Kit, bundle *types.AppBundle, groupID uint32,
+	vars []*table.TemplateVariableSpec, includeSecrets bool) ([]*types.AppBundleVariable, error) {
+	result := make([]*types.AppBundleVariable, 0, len(vars))
+	for _, v := range vars {
+		if !v.IsSecret() {
+			result = append(result, &types.AppBundleVariable{Spec: v})
+			continue
+		}
+
+		spec := *v
+		spec.DefaultVal = ""
+		one := &types.AppBundleVariable{Spec: &spec}
+		if includeSecrets {
+			val, err := s.vault.GetSecretVariable(kt, &types.SecretVariableOption{BizID: bundle.App.BizID,
+				AppID: bundle.App.ID, GroupID: groupID, Name: v.Name})
+			if err != nil {
+				logs.Errorf("get secret variable %s failed, err: %v, rid: %s", v.Name, err, kt.Rid)
+				return nil, err
+			}
+			if val != "" {
+				if one.EncryptedValue, err = bundle.EncryptSecret(val); err != nil {
+					return nil, err
+				}
+			}
+		}
+		result = append(result, one)
+	}
+
+	return result, nil
+}
+
+// ImportAppBundle import the app bundle into the biz. The app, hooks and groups are matched by name, the
+// config items by path and name, and the kvs by key, the existing ones are handled by the conflict policy.
+// The ids in the bundle are remapped to the matched or created resources, which are reported for each
+// resource. Nothing is changed in the dry run. The import is not atomic, the app, hooks and groups created by
+// the import are deleted when it is failed, but the changes of the existing ones are kept.
+func (s *Service) ImportAppBundle(ctx context.Context, req *pbds.ImportAppBundleReq) (
+	*pbds.ImportAppBundleResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	policy := types.BundleConflictPolicy(req.ConflictPolicy)
+	if policy == "" {
+		policy = types.BundleConflictSkip
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	bundle := new(types.AppBundle)
+	if err := json.Unmarshal(req.Manifest, bundle); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "decode app bundle failed, err: %v", err))
+	}
+	if err := bundle.Validate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+	if req.Passphrase != "" {
+		if err := bundle.CheckPassphrase(req.Passphrase); err != nil {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+		}
+	}
+
+	im := &appBundleImporter{
+		s:          s,
+		kt:         kt,
+		bizID:      req.BizId,
+		bundle:     bundle,
+		policy:     policy,
+		dryRun:     req.DryRun,
+		passphrase: req.Passphrase,
+		appName:    req.AppName,
+		resp:       &pbds.ImportAppBundleResp{DryRun: req.DryRun},
+	}
+	if im.appName == "" {
+		im.appName = bundle.App.Spec.Name
+	}
+
+	steps := []func() error{im.importApp, im.importHooks, im.importConfigItems, im.importKvs,
+		im.importTemplateBindings, im.importVariables, im.importGroups}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			logs.Errorf("import app bundle failed, err: %v, rid: %s", err, kt.Rid)
+			im.cleanup()
+			return nil, err
+		}
+	}
+
+	im.resp.AppId, im.resp.AppName = im.appID, im.appName
+	return im.resp, nil
+}
+
+// appBundleImporter imports the resources of the app bundle one by one.
+type appBundleImporter struct {
+	s          *Service
+	kt         *kit.Kit
+	bizID      uint32
+	bundle     *types.AppBundle
+	policy     types.BundleConflictPolicy
+	dryRun     bool
+	passphrase string
+	// appID is 0 when the app is going to be created in the dry run.
+	appID   uint32
+	appName string
+	resp    *pbds.ImportAppBundleResp
+	// the resources created by the import, which are deleted when the import is failed.
+	createdApp    bool
+	createdHooks  []uint32
+	createdGroups []uint32
+}
+
+// ctx returns the context to call the other methods of the service for the target app.
+func (im *appBundleImporter) ctx() context.Context {
+	kt := im.kt.Clone()
+	kt.BizID, kt.AppID = im.bizID, im.appID
+	return kt.InternalRpcCtx()
+}
+
+func (im *appBundleImporter) record(resType, name string, action types.BundleImportAction, target string,
+	sourceID, targetID uint32, msg string) {
+	im.resp.Actions = append(im.resp.Actions, &pbds.ImportAppBundleResp_Action{
+		ResourceType: resType,
+		Name:         name,
+		Action:       string(action),
+		TargetName:   target,
+		SourceId:     sourceID,
+		TargetId:     targetID,
+		Message:      msg,
+	})
+}
+
+// cleanup deletes the resources created by the import after it is failed, the created app is deleted in the
+// same way as DeleteApp. The errors are only logged, since the import is failed anyway.
+func (im *appBundleImporter) cleanup() {
+	kt := im.kt
+	for _, id := range im.createdGroups {
+		if _, err := im.s.DeleteGroup(im.ctx(), &pbds.DeleteGroupReq{
+			Id:         id,
+			Attachment: &pbgroup.GroupAttachment{BizId: im.bizID},
+		}); err != nil {
+			logs.Errorf("delete group %d created by the import failed, err: %v, rid: %s", id, err, kt.Rid)
+		}
+	}
+
+	if im.createdApp {
+		if _, err := im.s.DeleteApp(im.ctx(), &pbds.DeleteAppReq{Id: im.appID, BizId: im.bizID}); err != nil {
+			logs.Errorf("delete app %d created by the import failed, err: %v, rid: %s", im.appID, err, kt.Rid)
+		}
+	}
+
+	// the hooks may be bound to the existing app by the import, which are unbound with force.
+	for _, id := range im.createdHooks {
+		if _, err := im.s.DeleteHook(im.ctx(), &pbds.DeleteHookReq{BizId: im.bizID, HookId: id,
+			Force: true}); err != nil {
+			logs.Errorf("delete hook %d created by the import failed, err: %v, rid: %s", id, err, kt.Rid)
+		}
+	}
+}
+
+// conflictAction returns the action of the resource which already exists.
+func (im *appBundleImporter) conflictAction() types.BundleImportAction {
+	switch im.policy {
+	case types.BundleConflictOverwrite:
+		return types.BundleImportOverwrite
+	case types.BundleConflictRename:
+		return types.BundleImportRename
+	default:
+		return types.BundleImportSkip
+	}
+}
+
+// secretValue decrypts the secret value in the bundle, the message is returned instead if the value
+// can not be imported.
+func (im *appBundleImporter) secretValue(encrypted string) (string, string, error) {
+	if encrypted == "" {
+		return "", i18n.T(im.kt, "the secret value is not included in the bundle"), nil
+	}
+	if im.passphrase == "" {
+		return "", i18n.T(im.kt, "passphrase is required to import the secret value"), nil
+	}
+
+	val, err := im.bundle.DecryptSecret(encrypted)
+	if err != nil {
+		return "", "", errf.Errorf(errf.InvalidParameter, "%s", i18n.T(im.kt, "decrypt secret value failed"))
+	}
+
+	return val, "", nil
+}
+
+func (im *appBundleImporter) importApp() error {
+	source := im.bundle.App
+	name := im.appName
+	existing, err := im.s.dao.App().GetByName(im.kt, im.bizID, name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, im.kt.Rid)
+		return errf.Errorf(errf.DBOpFailed, "%s", i18n.T(im.kt, "get app failed, err: %v", err))
+	}
+
+	action := types.BundleImportCreate
+	if err == nil {
+		action = im.conflictAction()
+		if action != types.BundleImportRename && existing.Spec.ConfigType != source.Spec.ConfigType {
+			return errf.Errorf(errf.InvalidParameter, "%s", i18n.T(im.kt,
+				"app %s already exists with config type %s", name, existing.Spec.ConfigType))
+		}
+
+		switch action {
+		case types.BundleImportSkip:
+			im.appID = existing.ID
+			im.record(bundleResApp, source.Spec.Name, action, name, source.ID, existing.ID, "")
+			return nil
+		case types.BundleImportOverwrite:
+			im.appID = existing.ID
+			if !im.dryRun {
+				spec := *source.Spec
+				spec.Name, spec.Alias, spec.LastConsumedTime = existing.Spec.Name, existing.Spec.Alias, nil
+				if _, err = im.s.UpdateApp(im.ctx(), &pbds.UpdateAppReq{
+					Id:    existing.ID,
+					BizId: im.bizID,
+					Spec:  pbapp.PbAppSpec(&spec),
+				}); err != nil {
+					return err
+				}
+			}
+			im.record(bundleResApp, source.Spec.Name, action, name, source.ID, existing.ID, "")
+			return nil
+		}
+
+		name = types.RenameForBundle(name, func(n string) bool {
+			_, e := im.s.dao.App().GetByName(im.kt, im.bizID, n)
+			return e == nil
+		})
+	}
+
+	spec := *source.Spec
+	spec.Name, spec.LastConsumedTime, spec.TenantID = name, nil, ""
+	if _, e := im.s.dao.App().GetByAlias(im.kt, im.bizID, spec.Alias); e == nil {
+		spec.Alias = types.RenameForBundle(spec.Alias, func(n string) bool {
+			_, e := im.s.dao.App().GetByAlias(im.kt, im.bizID, n)
+			return e == nil
+		})
+	}
+
+	if !im.dryRun {
+		resp, err := im.s.CreateApp(im.ctx(), &pbds.CreateAppReq{BizId: im.bizID, Spec: pbapp.PbAppSpec(&spec)})
+		if err != nil {
+			return err
+		}
+		im.appID, im.createdApp = resp.Id, true
+	}
+	im.appName = name
+	im.record(bundleResApp, source.Spec.Name, action, name, source.ID, im.appID, "")
+
+	return nil
+}
+
+func (im *appBundleImporter) importHooks() error {
+	if len(im.bundle.Hooks) == 0 {
+		return nil
+	}
+
+	req := &pbds.UpdateConfigHookReq{BizId: im.bizID, AppId: im.appID}
+	bound := make(map[table.HookType]bool)
+	if im.appID > 0 {
+		// keep the hooks already bound by the app when the bundle does not contain them.
+		for _, tp := range []table.HookType{table.PreHook, table.PostHook} {
+			rh, err := im.s.dao.ReleasedHook().Get(im.kt, im.bizID, im.appID, 0, tp)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					continue
+				}
+				logs.Errorf("get %s failed, err: %v, rid: %s", tp.String(), err, im.kt.Rid)
+				return err
+			}
+			bound[tp] = true
+			setConfigHook(req, tp, rh.HookID, rh.ParamValues())
+		}
+	}
+
+	changed := false
+	for _, h := range im.bundle.Hooks {
+		if bound[h.HookType] && im.policy != types.BundleConflictOverwrite {
+			im.record(bundleResHook, h.Spec.Name, types.BundleImportSkip, "", h.ID, 0,
+				i18n.T(im.kt, "the app has bound the %s already", h.HookType.String()))
+			continue
+		}
+
+		hookID, err := im.importHook(h)
+		if err != nil {
+			return err
+		}
+		setConfigHook(req, h.HookType, hookID, h.Params)
+		changed = true
+	}
+
+	if !changed || im.dryRun {
+		return nil
+	}
+
+	_, err := im.s.UpdateConfigHook(im.ctx(), req)
+	return err
+}
+
+func setConfigHook(req *pbds.UpdateConfigHookReq, tp table.HookType, hookID uint32, params map[string]string) {
+	if tp == table.PreHook {
+		req.PreHookId, req.PreHookParams = hookID, params
+		return
+	}
+	req.PostHookId, req.PostHookParams = hookID, params
+}
+
+// importHook import the hook by name, and returns the id of the hook bound by the app.
+func (im *appBundleImporter) importHook(h *types.AppBundleHook) (uint32, error) {
+	name := h.Spec.Name
+	existing, err := im.s.dao.Hook().GetByName(im.kt, im.bizID, name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get hook failed, err: %v, rid: %s", err, im.kt.Rid)
+		return 0, err
+	}
+
+	action := types.BundleImportCreate
+	if err == nil {
+		action = im.conflictAction()
+		switch action {
+		case types.BundleImportSkip:
+			im.record(bundleResHook, h.Spec.Name, action, name, h.ID, existing.ID, "")
+			return existing.ID, nil
+		case types.BundleImportOverwrite:
+			if err = im.overwriteHookRevisions(existing, h); err != nil {
+				return 0, err
+			}
+			im.record(bundleResHook, h.Spec.Name, action, name, h.ID, existing.ID, "")
+			return existing.ID, nil
+		}
+
+		name = types.RenameForBundle(name, func(n string) bool {
+			_, e := im.s.dao.Hook().GetByName(im.kt, im.bizID, n)
+			return e == nil
+		})
+	}
+
+	var id uint32
+	if !im.dryRun {
+		if id, err = im.createHook(name, h); err != nil {
+			return 0, err
+		}
+		im.createdHooks = append(im.createdHooks, id)
+	}
+	im.record(bundleResHook, h.Spec.Name, action, name, h.ID, id, "")
+
+	return id, nil
+}
+
+// createHook create the hook with all the revisions in the bundle, the revision used by the source app
+// is deployed.
+func (im *appBundleImporter) createHook(name string, h *types.AppBundleHook) (uint32, error) {
+	kt := im.kt
+	tx := im.s.dao.GenQuery().Begin()
+	committed := false
+	defer func() {
+		if !committed {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+			}
+		}
+	}()
+
+	spec := *h.Spec
+	spec.Name = name
+	hook := &table.Hook{
+		Spec:       &spec,
+		Attachment: &table.HookAttachment{BizID: im.bizID},
+		Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+	}
+	id, err := im.s.dao.Hook().CreateWithTx(kt, tx, hook, false)
+	if err != nil {
+		logs.Errorf("create hook failed, err: %v, rid: %s", err, kt.Rid)
+		return 0, err
+	}
+
+	for _, r := range h.Revisions {
+		rs := *r
+		switch {
+		case rs.Name == h.Deployed:
+			rs.State = table.HookRevisionStatusDeployed
+		case rs.State == table.HookRevisionStatusDeployed:
+			rs.State = table.HookRevisionStatusShutdown
+		}
+		revision := &table.HookRevision{
+			Spec:       &rs,
+			Attachment: &table.HookRevisionAttachment{BizID: im.bizID, HookID: id},
+			Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+		}
+		if _, err = im.s.dao.HookRevision().CreateWithTx(kt, tx, revision); err != nil {
+			logs.Errorf("create hook revision failed, err: %v, rid: %s", err, kt.Rid)
+			return 0, err
+		}
+
+		err = im.s.dao.AuditDao().Decorator(kt, im.bizID, &table.AuditField{
+			ResourceInstance: fmt.Sprintf(constant.HookName+constant.ResSeparator+constant.HookRevisionName,
+				name, rs.Name),
+			Status: enumor.Success,
+			Detail: rs.Memo,
+		}).PrepareCreate(revision).Do(tx.Query)
+		if err != nil {
+			logs.Errorf("PrepareCreate HookRevision failed, err: %v, rid: %s", err, kt.Rid)
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return 0, err
+	}
+	committed = true
+
+	return id, nil
+}
+
+// overwriteHookRevisions create the revisions of the bundle which do not exist in the hook, and deploy the
+// revision used by the source app, the existing revisions are kept because they may be referenced.
+func (im *appBundleImporter) overwriteHookRevisions(hook *table.Hook, h *types.AppBundleHook) error {
+	for _, r := range h.Revisions {
+		_, err := im.s.dao.HookRevision().GetByName(im.kt, im.bizID, hook.ID, r.Name)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Errorf("get hook revision failed, err: %v, rid: %s", err, im.kt.Rid)
+			return err
+		}
+
+		var id uint32
+		if !im.dryRun {
+			resp, err := im.s.CreateHookRevision(im.ctx(), &pbds.CreateHookRevisionReq{
+				Attachment: &pbhr.HookRevisionAttachment{BizId: im.bizID, HookId: hook.ID},
+				Spec:       pbhr.PbHookRevisionSpec(r),
+			})
+			if err != nil {
+				return err
+			}
+			id = resp.Id
+		}
+		im.record(bundleResHookRevision, hook.Spec.Name+"/"+r.Name, types.BundleImportCreate, r.Name, 0, id, "")
+	}
+
+	if im.dryRun || h.Deployed == "" {
+		return nil
+	}
+
+	deployed, err := im.s.dao.HookRevision().GetByName(im.kt, im.bizID, hook.ID, h.Deployed)
+	if err != nil {
+		logs.Errorf("get hook revision failed, err: %v, rid: %s", err, im.kt.Rid)
+		return err
+	}
+	if deployed.Spec.State == table.HookRevisionStatusDeployed {
+		return nil
+	}
+
+	_, err = im.s.PublishHookRevision(im.ctx(), &pbds.PublishHookRevisionReq{
+		BizId:  im.bizID,
+		HookId: hook.ID,
+		Id:     deployed.ID,
+	})
+	return err
+}
+
+func (im *appBundleImporter) importConfigItems() error {
+	if im.bundle.App.Spec.ConfigType != table.File || len(im.bundle.ConfigItems) == 0 {
+		return nil
+	}
+
+	existing := make(map[string]bool)
+	if im.appID > 0 {
+		cis, err := im.s.dao.ConfigItem().ListAllByAppID(im.kt, im.appID, im.bizID)
+		if err != nil {
+			logs.Errorf("list editing config items failed, err: %v, rid: %s", err, im.kt.Rid)
+			return err
+		}
+		for _, ci := range cis {
+			existing[path.Join(ci.Spec.Path, ci.Spec.Name)] = true
+		}
+	}
+
+	type planned struct {
+		source *types.AppBundleConfigItem
+		target string
+		action types.BundleImportAction
+	}
+	plans := make([]*planned, 0, len(im.bundle.ConfigItems))
+	items := make([]*pbds.BatchUpsertConfigItemsReq_ConfigItem, 0, len(im.bundle.ConfigItems))
+	for _, ci := range im.bundle.ConfigItems {
+		spec := *ci.Spec
+		name := path.Join(spec.Path, spec.Name)
+		action := types.BundleImportCreate
+		if existing[name] {
+			action = im.conflictAction()
+			if action == types.BundleImportSkip {
+				im.record(bundleResConfigItem, name, action, name, ci.ID, 0, "")
+				continue
+			}
+			if action == types.BundleImportRename {
+				spec.Name = types.RenameForBundle(spec.Name, func(n string) bool {
+					return existing[path.Join(spec.Path, n)]
+				})
+			}
+		}
+
+		target := path.Join(spec.Path, spec.Name)
+		existing[target] = true
+		plans = append(plans, &planned{source: ci, target: target, action: action})
+		items = append(items, &pbds.BatchUpsertConfigItemsReq_ConfigItem{
+			ConfigItemAttachment: &pbci.ConfigItemAttachment{BizId: im.bizID, AppId: im.appID},
+			ConfigItemSpec:       pbci.PbConfigItemSpec(&spec),
+			ContentSpec:          pbcontent.PbContentSpec(ci.Content),
+		})
+	}
+
+	ids := make(map[string]uint32)
+	if !im.dryRun && len(items) > 0 {
+		if err := im.checkContents(); err != nil {
+			return err
+		}
+
+		if _, err := im.s.BatchUpsertConfigItems(im.ctx(), &pbds.BatchUpsertConfigItemsReq{
+			BizId: im.bizID,
+			AppId: im.appID,
+			Items: items,
+		}); err != nil {
+			return err
+		}
+
+		cis, err := im.s.dao.ConfigItem().ListAllByAppID(im.kt, im.appID, im.bizID)
+		if err != nil {
+			logs.Errorf("list editing config items failed, err: %v, rid: %s", err, im.kt.Rid)
+			return err
+		}
+		for _, ci := range cis {
+			ids[path.Join(ci.Spec.Path, ci.Spec.Name)] = ci.ID
+		}
+	}
+
+	for _, p := range plans {
+		im.record(bundleResConfigItem, path.Join(p.source.Spec.Path, p.source.Spec.Name), p.action, p.target,
+			p.source.ID, ids[p.target], "")
+	}
+
+	return nil
+}
+
+// checkContents check the contents of the config items have been uploaded to the repository of the biz.
+func (im *appBundleImporter) checkContents() error {
+	kt := im.kt.Clone()
+	kt.BizID, kt.AppID = im.bizID, im.appID
+	checked := make(map[string]bool)
+	for _, ci := range im.bundle.ConfigItems {
+		sign := ci.Content.Signature
+		if checked[sign] {
+			continue
+		}
+		checked[sign] = true
+
+		if _, err := im.s.repo.Metadata(kt, sign); err != nil {
+			if errors.Is(err, errf.ErrFileContentNotFound) {
+				return errf.Errorf(errf.InvalidParameter, "%s", i18n.T(im.kt,
+					"content of config item %s is not uploaded", path.Join(ci.Spec.Path, ci.Spec.Name)))
+			}
+			logs.Errorf("get metadata of content %s failed, err: %v, rid: %s", sign, err, im.kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (im *appBundleImporter) importKvs() error {
+	if im.bundle.App.Spec.ConfigType != table.KV || len(im.bundle.Kvs) == 0 {
+		return nil
+	}
+
+	states := []string{string(table.KvStateAdd), string(table.KvStateRevise), string(table.KvStateUnchange)}
+	existing := make(map[string]bool)
+	if im.appID > 0 {
+		kvs, err := im.s.dao.Kv().ListAllByAppID(im.kt, im.appID, im.bizID, states)
+		if err != nil {
+			logs.Errorf("list kvs failed, err: %v, rid: %s", err, im.kt.Rid)
+			return err
+		}
+		for _, kv := range kvs {
+			existing[kv.Spec.Key] = true
+		}
+	}
+
+	type planned struct {
+		source *types.AppBundleKv
+		target string
+		action types.BundleImportAction
+	}
+	plans := make([]*planned, 0, len(im.bundle.Kvs))
+	items := make([]*pbds.BatchUpsertKvsReq_Kv, 0, len(im.bundle.Kvs))
+	for _, kv := range im.bundle.Kvs {
+		spec := *kv.Spec
+		value := kv.Value
+		if spec.KvType == table.KvSecret {
+			val, msg, err := im.secretValue(kv.EncryptedValue)
+			if err != nil {
+				return err
+			}
+			if msg != "" {
+				im.record(bundleResKv, spec.Key, types.BundleImportSkip, "", kv.ID, 0, msg)
+				continue
+			}
+			value = val
+		}
+
+		action := types.BundleImportCreate
+		if existing[spec.Key] {
+			action = im.conflictAction()
+			if action == types.BundleImportSkip {
+				im.record(bundleResKv, spec.Key, action, spec.Key, kv.ID, 0, "")
+				continue
+			}
+			if action == types.BundleImportRename {
+				spec.Key = types.RenameForBundle(spec.Key, func(n string) bool { return existing[n] })
+			}
+		}
+
+		existing[spec.Key] = true
+		plans = append(plans, &planned{source: kv, target: spec.Key, action: action})
+		items = append(items, &pbds.BatchUpsertKvsReq_Kv{
+			KvAttachment: &pbkv.KvAttachment{BizId: im.bizID, AppId: im.appID},
+			KvSpec:       pbkv.PbKvSpec(&spec, value),
+		})
+	}
+
+	ids := make(map[string]uint32)
+	if !im.dryRun && len(items) > 0 {
+		if _, err := im.s.BatchUpsertKvs(im.ctx(), &pbds.BatchUpsertKvsReq{
+			BizId: im.bizID,
+			AppId: im.appID,
+			Kvs:   items,
+		}); err != nil {
+			return err
+		}
+
+		kvs, err := im.s.dao.Kv().ListAllByAppID(im.kt, im.appID, im.bizID, states)
+		if err != nil {
+			logs.Errorf("list kvs failed, err: %v, rid: %s", err, im.kt.Rid)
+			return err
+		}
+		for _, kv := range kvs {
+			ids[kv.Spec.Key] = kv.ID
+		}
+	}
+
+	for _, p := range plans {
+		im.record(bundleResKv, p.source.Spec.Key, p.action, p.target, p.source.ID, ids[p.target], "")
+	}
+
+	return nil
+}
+
+func (im *appBundleImporter) importTemplateBindings() error {
+	if im.bundle.App.Spec.ConfigType != table.File || len(im.bundle.TemplateBindings) == 0 {
+		return nil
+	}
+
+	var current *table.AppTemplateBinding
+	if im.appID > 0 {
+		atb, err := im.s.dao.AppTemplateBinding().GetAppTemplateBindingByAppID(im.kt, im.bizID, im.appID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Errorf("get app template binding failed, err: %v, rid: %s", err, im.kt.Rid)
+			return err
+		}
+		if err == nil {
+			current = atb
+		}
+	}
+
+	bindings := make([]*table.TemplateBinding, 0)
+	bound := make(map[uint32]int)
+	if current != nil {
+		for _, b := range current.Spec.Bindings {
+			bound[b.TemplateSetID] = len(bindings)
+			bindings = append(bindings, b)
+		}
+	}
+
+	changed := false
+	for _, b := range im.bundle.TemplateBindings {
+		name := b.TemplateSpace + "/" + b.TemplateSet
+		tb, msg, err := im.resolveTemplateBinding(b)
+		if err != nil {
+			return err
+		}
+		if tb == nil {
+			im.record(bundleResTemplateBinding, name, types.BundleImportSkip, "", 0, 0, msg)
+			continue
+		}
+
+		action := types.BundleImportCreate
+		if idx, ok := bound[tb.TemplateSetID]; ok {
+			if im.policy != types.BundleConflictOverwrite {
+				im.record(bundleResTemplateBinding, name, types.BundleImportSkip, name, 0, tb.TemplateSetID,
+					i18n.T(im.kt, "the template set has been bound by the app"))
+				continue
+			}
+			action = types.BundleImportOverwrite
+			bindings[idx] = tb
+		} else {
+			bound[tb.TemplateSetID] = len(bindings)
+			bindings = append(bindings, tb)
+		}
+		changed = true
+		im.record(bundleResTemplateBinding, name, action, name, 0, tb.TemplateSetID, msg)
+	}
+
+	if !changed || im.dryRun {
+		return nil
+	}
+
+	pbBindings := make([]*pbatb.TemplateBinding, 0, len(bindings))
+	for _, b := range bindings {
+		pbBindings = append(pbBindings, pbatb.PbTemplateBinding(b))
+	}
+	attachment := &pbatb.AppTemplateBindingAttachment{BizId: im.bizID, AppId: im.appID}
+	spec := &pbatb.AppTemplateBindingSpec{Bindings: pbBindings}
+	if current == nil {
+		_, err := im.s.CreateAppTemplateBinding(im.ctx(), &pbds.CreateAppTemplateBindingReq{
+			Attachment: attachment,
+			Spec:       spec,
+		})
+		return err
+	}
+
+	_, err := im.s.UpdateAppTemplateBinding(im.ctx(), &pbds.UpdateAppTemplateBindingReq{
+		Id:         current.ID,
+		Attachment: attachment,
+		Spec:       spec,
+	})
+	return err
+}
+
+// resolveTemplateBinding resolve the template set and templates bound in the bundle by name, the message
+// is returned instead if the template set can not be bound, the templates which are not found are ignored,
+// and the latest revision is used if the bound revision is not found.
+func (im *appBundleImporter) resolveTemplateBinding(b *types.AppBundleTemplateBinding) (
+	*table.TemplateBinding, string, error) {
+	kt := im.kt
+	space, err := im.s.dao.TemplateSpace().GetByUniqueKey(kt, im.bizID, b.TemplateSpace)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, i18n.T(kt, "template space %s is not found", b.TemplateSpace), nil
+		}
+		logs.Errorf("get template space failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, "", err
+	}
+
+	set, err := im.s.dao.TemplateSet().GetByUniqueKey(kt, im.bizID, space.ID, b.TemplateSet)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, i18n.T(kt, "template set %s is not found", b.TemplateSet), nil
+		}
+		logs.Errorf("get template set failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, "", err
+	}
+	if !set.Spec.Public && !slices.Contains(set.Spec.BoundApps, im.appID) {
+		return nil, i18n.T(kt, "template set %s is not available for the app", b.TemplateSet), nil
+	}
+
+	tb := &table.TemplateBinding{TemplateSetID: set.ID}
+	missing := make([]string, 0)
+	for _, t := range b.Templates {
+		tmpl, err := im.s.dao.Template().GetByUniqueKey(kt, im.bizID, space.ID, t.Name, t.Path)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Errorf("get template failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, "", err
+		}
+		if err != nil || !slices.Contains(set.Spec.TemplateIDs, tmpl.ID) {
+			missing = append(missing, path.Join(t.Path, t.Name))
+			continue
+		}
+
+		var revision *table.TemplateRevision
+		if !t.IsLatest {
+			revision, err = im.s.dao.TemplateRevision().GetByUniqueKey(kt, im.bizID, tmpl.ID, t.Revision)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				logs.Errorf("get template revision failed, err: %v, rid: %s", err, kt.Rid)
+				return nil, "", err
+			}
+		}
+		isLatest := revision == nil
+		if isLatest {
+			if revision, err = im.s.dao.TemplateRevision().GetLatestTemplateRevision(kt, im.bizID, tmpl.ID); err != nil {
+				logs.Errorf("get latest template revision failed, err: %v, rid: %s", err, kt.Rid)
+				return nil, "", err
+			}
+		}
+		tb.TemplateRevisions = append(tb.TemplateRevisions, &table.TemplateRevisionBinding{
+			TemplateID:         tmpl.ID,
+			TemplateRevisionID: revision.ID,
+			IsLatest:           isLatest,
+		})
+	}
+
+	msg := ""
+	if len(missing) > 0 {
+		msg = i18n.T(kt, "templates %s are not found in the template set", strings.Join(missing, ", "))
+	}
+	return tb, msg, nil
+}
+
+func (im *appBundleImporter) importVariables() error {
+	if len(im.bundle.Variables) == 0 {
+		return nil
+	}
+
+	var current []*table.TemplateVariableSpec
+	if im.appID > 0 {
+		var err error
+		if current, err = im.s.dao.AppTemplateVariable().ListVariables(im.kt, im.bizID, im.appID); err != nil {
+			logs.Errorf("list app template variables failed, err: %v, rid: %s", err, im.kt.Rid)
+			return err
+		}
+	}
+
+	vars, changed, err := im.mergeVariables(bundleResVariable, "", current, im.bundle.Variables)
+	if err != nil || !changed || im.dryRun {
+		return err
+	}
+
+	_, err = im.s.UpdateAppTmplVariables(im.ctx(), &pbds.UpdateAppTmplVariablesReq{
+		Attachment: &pbatv.AppTemplateVariableAttachment{BizId: im.bizID, AppId: im.appID},
+		Spec:       &pbatv.AppTemplateVariableSpec{Variables: pbtv.PbTemplateVariableSpecs(vars)},
+	})
+	return err
+}
+
+// mergeVariables merge the variables in the bundle into the current ones by name, the variables can not
+// be renamed because they are referenced by name, so the existing ones are only overwritten or skipped.
+func (im *appBundleImporter) mergeVariables(resType, scope string, current []*table.TemplateVariableSpec,
+	incoming []*types.AppBundleVariable) ([]*table.TemplateVariableSpec, bool, error) {
+	merged := make([]*table.TemplateVariableSpec, 0, len(current)+len(incoming))
+	index := make(map[string]int, len(current))
+	for _, v := range current {
+		index[v.Name] = len(merged)
+		merged = append(merged, v)
+	}
+
+	changed := false
+	for _, v := range incoming {
+		spec := *v.Spec
+		name := scope + spec.Name
+		action := types.BundleImportCreate
+		idx, exists := index[spec.Name]
+		if exists {
+			if im.policy != types.BundleConflictOverwrite {
+				im.record(resType, name, types.BundleImportSkip, name, 0, 0, "")
+				continue
+			}
+			action = types.BundleImportOverwrite
+		}
+
+		if spec.IsSecret() {
+			val, msg, err := im.secretValue(v.EncryptedValue)
+			if err != nil {
+				return nil, false, err
+			}
+			if msg != "" {
+				im.record(resType, name, types.BundleImportSkip, "", 0, 0, msg)
+				continue
+			}
+			spec.DefaultVal = val
+		}
+
+		if exists {
+			merged[idx] = &spec
+		} else {
+			index[spec.Name] = len(merged)
+			merged = append(merged, &spec)
+		}
+		changed = true
+		im.record(resType, name, action, name, 0, 0, "")
+	}
+
+	return merged, changed, nil
+}
+
+func (im *appBundleImporter) importGroups() error {
+	for _, g := range im.bundle.Groups {
+		groupID, available, err := im.importGroup(g)
+		if err != nil {
+			return err
+		}
+		if len(g.Variables) == 0 {
+			continue
+		}
+		if !available {
+			im.record(bundleResGroupVariable, g.Spec.Name, types.BundleImportSkip, "", 0, 0,
+				i18n.T(im.kt, "group %s is not available for the app", g.Spec.Name))
+			continue
+		}
+
+		var current []*table.TemplateVariableSpec
+		if groupID > 0 && im.appID > 0 {
+			if current, err = im.s.dao.GroupTemplateVariable().ListVariables(im.kt, im.bizID, im.appID,
+				groupID); err != nil {
+				logs.Errorf("list group template variables failed, err: %v, rid: %s", err, im.kt.Rid)
+				return err
+			}
+		}
+
+		vars, changed, err := im.mergeVariables(bundleResGroupVariable, g.Spec.Name+"/", current, g.Variables)
+		if err != nil {
+			return err
+		}
+		if !changed || im.dryRun {
+			continue
+		}
+
+		if _, err = im.s.UpdateGroupTmplVariables(im.ctx(), &pbds.UpdateGroupTmplVariablesReq{
+			BizId:     im.bizID,
+			AppId:     im.appID,
+			GroupId:   groupID,
+			Variables: pbtv.PbTemplateVariableSpecs(vars),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importGroup import the group by name, and returns the id of the group and whether it's available for the app.
+func (im *appBundleImporter) importGroup(g *types.AppBundleGroup) (uint32, bool, error) {
+	spec := *g.Spec
+	if spec.Mode == table.GroupModeDynamic {
+		im.record(bundleResGroup, spec.Name, types.BundleImportSkip, "", g.ID, 0,
+			i18n.T(im.kt, "dynamic group refers to the client query of the source biz"))
+		return 0, false, nil
+	}
+
+	existing, err := im.s.dao.Group().GetByName(im.kt, im.bizID, spec.Name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get group failed, err: %v, rid: %s", err, im.kt.Rid)
+		return 0, false, err
+	}
+
+	action := types.BundleImportCreate
+	if err == nil {
+		action = im.conflictAction()
+		switch action {
+		case types.BundleImportSkip:
+			available, err := im.groupAvailable(existing)
+			if err != nil {
+				return 0, false, err
+			}
+			im.record(bundleResGroup, spec.Name, action, spec.Name, g.ID, existing.ID, "")
+			return existing.ID, available, nil
+		case types.BundleImportOverwrite:
+			if !im.dryRun {
+				if err = im.overwriteGroup(existing, &spec); err != nil {
+					return 0, false, err
+				}
+			}
+			im.record(bundleResGroup, spec.Name, action, spec.Name, g.ID, existing.ID, "")
+			return existing.ID, true, nil
+		}
+
+		spec.Name = types.RenameForBundle(spec.Name, func(n string) bool {
+			_, e := im.s.dao.Group().GetByName(im.kt, im.bizID, n)
+			return e == nil
+		})
+	}
+
+	pbSpec, err := pbgroup.PbGroupSpec(&spec)
+	if err != nil {
+		return 0, false, err
+	}
+	if !spec.Public {
+		pbSpec.BindApps = []uint32{im.appID}
+	}
+
+	var id uint32
+	if !im.dryRun {
+		resp, err := im.s.CreateGroup(im.ctx(), &pbds.CreateGroupReq{
+			Attachment: &pbgroup.GroupAttachment{BizId: im.bizID},
+			Spec:       pbSpec,
+		})
+		if err != nil {
+			return 0, false, err
+		}
+		id = resp.Id
+		im.createdGroups = append(im.createdGroups, id)
+	}
+	im.record(bundleResGroup, g.Spec.Name, action, spec.Name, g.ID, id, "")
+
+	return id, true, nil
+}
+
+func (im *appBundleImporter) groupAvailable(group *table.Group) (bool, error) {
+	if group.Spec.Public {
+		return true, nil
+	}
+	if im.appID == 0 {
+		return false, nil
+	}
+
+	_, err := im.s.dao.GroupAppBind().Get(im.kt, group.ID, im.appID, im.bizID)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	logs.Errorf("get group app bind failed, err: %v, rid: %s", err, im.kt.Rid)
+	return false, err
+}
+
+// overwriteGroup update the group with the spec in the bundle, the visible range and the mode of the group
+// are kept, and the app is bound to it if it's not public.
+func (im *appBundleImporter) overwriteGroup(group *table.Group, spec *table.GroupSpec) error {
+	binds, err := im.s.dao.GroupAppBind().BatchListByGroupIDs(im.kt, im.bizID, []uint32{group.ID})
+	if err != nil {
+		logs.Errorf("list group app binds failed, err: %v, rid: %s", err, im.kt.Rid)
+		return err
+	}
+
+	updated := *spec
+	updated.Name, updated.Public, updated.Mode = group.Spec.Name, group.Spec.Public, group.Spec.Mode
+	updated.ClientQueryID = group.Spec.ClientQueryID
+	pbSpec, err := pbgroup.PbGroupSpec(&updated)
+	if err != nil {
+		return err
+	}
+	if !updated.Public {
+		apps := make([]uint32, 0, len(binds)+1)
+		for _, b := range binds {
+			apps = append(apps, b.AppID)
+		}
+		if !slices.Contains(apps, im.appID) {
+			apps = append(apps, im.appID)
+		}
+		pbSpec.BindApps = apps
+	}
+
+	_, err = im.s.UpdateGroup(im.ctx(), &pbds.UpdateGroupReq{
+		Id:         group.ID,
+		Attachment: &pbgroup.GroupAttachment{BizId: im.bizID},
+		Spec:       pbSpec,
+	})
+	return err
+}
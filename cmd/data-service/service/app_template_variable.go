@@ -17,7 +17,9 @@ import (
 	"sort"
 	"time"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbatv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app-template-variable"
@@ -301,11 +303,11 @@ func (s *Service) ListAppTmplVariables(ctx context.Context, req *pbds.ListAppTmp
 	finalVar := make([]*pbtv.TemplateVariableSpec, 0)
 	for _, name := range allVariables {
 		if v, ok := appVarMap[name]; ok {
-			finalVar = append(finalVar, pbtv.PbTemplateVariableSpec(v))
+			finalVar = append(finalVar, pbtv.PbTemplateVariableSpec(v.Masked()))
 			continue
 		}
 		if v, ok := bizVarMap[name]; ok {
-			finalVar = append(finalVar, pbtv.PbTemplateVariableSpec(v))
+			finalVar = append(finalVar, pbtv.PbTemplateVariableSpec(v.Masked()))
 			continue
 		}
 		// for unset variable, just return its name, other fields keep empty
@@ -370,6 +372,11 @@ func (s *Service) UpdateAppTmplVariables(ctx context.Context, req *pbds.UpdateAp
 			Reviser: kt.User,
 		},
 	}
+	if err := s.prepareAppVariables(kt, req.Attachment.BizId, req.Attachment.AppId, 0,
+		appVar.Spec.Variables); err != nil {
+		logs.Errorf("validate app template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
 	if err := s.dao.AppTemplateVariable().Upsert(kt, appVar); err != nil {
 		logs.Errorf("update app template variables failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, err
@@ -377,3 +384,36 @@ func (s *Service) UpdateAppTmplVariables(ctx context.Context, req *pbds.UpdateAp
 
 	return new(pbbase.EmptyResp), nil
 }
+
+// prepareAppVariables validate the variables overridden by the app or the group of the app with the type
+// and constraints of the biz variables, and keep the values of secret variables in vault.
+func (s *Service) prepareAppVariables(kt *kit.Kit, bizID, appID, groupID uint32,
+	vars []*table.TemplateVariableSpec) error {
+	bizVars, _, err := s.dao.TemplateVariable().List(kt, bizID, nil, &types.BasePage{All: true})
+	if err != nil {
+		logs.Errorf("list template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	bizVarMap := make(map[string]*table.TemplateVariableSpec, len(bizVars))
+	for _, v := range bizVars {
+		bizVarMap[v.Spec.Name] = v.Spec
+	}
+
+	for _, v := range vars {
+		if def, ok := bizVarMap[v.Name]; ok {
+			if v.Type != def.Type {
+				return errf.Errorf(errf.InvalidArgument, "%s",
+					i18n.T(kt, "the type of variable %s should be %s", v.Name, def.Type))
+			}
+			v.Constraints = def.Constraints
+		}
+		if err := v.ValidateCreate(kt); err != nil {
+			return err
+		}
+		if err := s.saveSecretVariable(kt, bizID, appID, groupID, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
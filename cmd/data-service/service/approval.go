@@ -0,0 +1,640 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbapproval "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/approval"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// SetApprovalFlow create or update the builtin approval flow of the app.
+func (s *Service) SetApprovalFlow(ctx context.Context, req *pbds.SetApprovalFlowReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().ApprovalFlowSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "approval flow spec is required"))
+	}
+
+	if _, err := s.dao.App().Get(kt, req.BizId, req.AppId); err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "app %d not found", req.AppId))
+	}
+
+	old, err := s.dao.ApprovalFlow().GetByApp(kt, req.BizId, req.AppId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get approval flow failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	flow := &table.ApprovalFlow{
+		Spec: spec,
+		Attachment: &table.ApprovalFlowAttachment{
+			BizID:    req.BizId,
+			AppID:    req.AppId,
+			TenantID: kt.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	if old == nil {
+		if err = flow.ValidateCreate(); err != nil {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+		}
+		if _, err = s.dao.ApprovalFlow().Create(kt, flow); err != nil {
+			logs.Errorf("create approval flow failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "create approval flow failed, err: %v", err))
+		}
+		return new(pbbase.EmptyResp), nil
+	}
+
+	flow.ID = old.ID
+	if err = flow.ValidateUpdate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+	if err = s.dao.ApprovalFlow().Update(kt, flow); err != nil {
+		logs.Errorf("update approval flow failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "update approval flow failed, err: %v", err))
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// GetApprovalFlow get the builtin approval flow of the app, a disabled flow is returned if it is not set.
+func (s *Service) GetApprovalFlow(ctx context.Context, req *pbds.GetApprovalFlowReq) (*pbapproval.ApprovalFlow,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	flow, err := s.dao.ApprovalFlow().GetByApp(kt, req.BizId, req.AppId)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Errorf("get approval flow failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+		return &pbapproval.ApprovalFlow{
+			Spec:       &pbapproval.ApprovalFlowSpec{},
+			Attachment: &pbapproval.ApprovalFlowAttachment{BizId: req.BizId, AppId: req.AppId},
+		}, nil
+	}
+
+	return pbapproval.PbApprovalFlow(flow), nil
+}
+
+// GetApprovalTicket get the builtin approval ticket of the publish strategy with its actions.
+func (s *Service) GetApprovalTicket(ctx context.Context, req *pbds.GetApprovalTicketReq) (
+	*pbds.GetApprovalTicketResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	_, ticket, err := s.getStrategyApprovalTicket(kt, req.BizId, req.AppId, req.ReleaseId, req.StrategyId)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := s.dao.ApprovalAction().List(kt, req.BizId, ticket.ID)
+	if err != nil {
+		logs.Errorf("list approval actions failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	pending := make([]string, 0)
+	if stage := ticket.CurrentStage(); stage != nil && ticket.Spec.Status == table.ApprovalTicketPending {
+		_, pending = stage.Evaluate(ticket.Spec.CurrentStage, actions)
+	}
+
+	return &pbds.GetApprovalTicketResp{
+		Ticket:           pbapproval.PbApprovalTicket(ticket),
+		Actions:          pbapproval.PbApprovalActions(actions),
+		PendingApprovers: pending,
+	}, nil
+}
+
+// DelegateApproval delegate the approval of the current stage to another user.
+func (s *Service) DelegateApproval(ctx context.Context, req *pbds.DelegateApprovalReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	delegateTo := strings.TrimSpace(req.DelegateTo)
+	if delegateTo == "" || delegateTo == kt.User {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "invalid delegate user %s", req.DelegateTo))
+	}
+
+	_, ticket, err := s.getStrategyApprovalTicket(kt, req.BizId, req.AppId, req.ReleaseId, req.StrategyId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.inApprovalTx(kt, func(tx *gen.QueryTx) error {
+		// 锁定审批单后重新检查状态，与并发的审批和超时按顺序处理
+		ticket, strategy, err := s.lockApprovalTicket(kt, tx, ticket)
+		if err != nil {
+			return err
+		}
+		if strategy.Spec.PublishStatus != table.PendingApproval || ticket.Spec.Status != table.ApprovalTicketPending {
+			return errors.New(i18n.T(kt, "delegate not allowed, current publish status is: %s",
+				strategy.Spec.PublishStatus))
+		}
+
+		actions, err := s.dao.ApprovalAction().ListWithTx(kt, tx, req.BizId, ticket.ID)
+		if err != nil {
+			logs.Errorf("list approval actions failed, err: %v, rid: %s", err, kt.Rid)
+			return err
+		}
+
+		stageIdx := ticket.Spec.CurrentStage
+		stage := ticket.CurrentStage()
+		_, remaining := stage.Evaluate(stageIdx, actions)
+		// 只有当前阶段尚未审批的审批人才能转审，且不能转给本阶段的其他审批人，避免一人占用多票
+		if !slices.Contains(remaining, kt.User) {
+			return errors.New(i18n.T(kt, "no permission to approve"))
+		}
+		if slices.Contains(stage.EffectiveApprovers(stageIdx, actions), delegateTo) {
+			return errf.Errorf(errf.InvalidParameter, "%s",
+				i18n.T(kt, "%s is already an approver of the current stage", delegateTo))
+		}
+
+		if _, err := s.dao.ApprovalAction().CreateWithTx(kt, tx, newApprovalAction(kt, ticket,
+			&table.ApprovalActionSpec{
+				Stage:      stageIdx,
+				Action:     table.ApprovalActionDelegate,
+				DelegateTo: delegateTo,
+				Comment:    req.Comment,
+			})); err != nil {
+			logs.Errorf("create approval action failed, err: %v, rid: %s", err, kt.Rid)
+			return err
+		}
+
+		remaining[slices.Index(remaining, kt.User)] = delegateTo
+		return s.dao.Strategy().UpdateByID(kt, tx, strategy.ID, map[string]interface{}{
+			"approver_progress": strings.Join(remaining, constant.NameSeparator),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// CommentApproval comment on the builtin approval ticket.
+func (s *Service) CommentApproval(ctx context.Context, req *pbds.CommentApprovalReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if strings.TrimSpace(req.Comment) == "" {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "comment can not be empty"))
+	}
+
+	_, ticket, err := s.getStrategyApprovalTicket(kt, req.BizId, req.AppId, req.ReleaseId, req.StrategyId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.doApprovalAction(kt, ticket, &table.ApprovalActionSpec{
+		Stage:   ticket.Spec.CurrentStage,
+		Action:  table.ApprovalActionComment,
+		Comment: req.Comment,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ExpireApprovalTickets rejects the pending builtin approval tickets whose current stage is timed out.
+func (s *Service) ExpireApprovalTickets(kt *kit.Kit, limit int) error {
+	tickets, err := s.dao.ApprovalTicket().ListExpired(kt, time.Now().UTC(), limit)
+	if err != nil {
+		return err
+	}
+
+	for _, one := range tickets {
+		if err := s.expireApprovalTicket(kt, one); err != nil {
+			logs.Errorf("expire approval ticket %d failed, err: %v, rid: %s", one.ID, err, kt.Rid)
+		}
+	}
+
+	return nil
+}
+
+// expireApprovalTicket rejects the ticket and its publish strategy because the current stage is timed out.
+// the ticket is skipped if it is not pending or its current stage is not timed out any more when it is locked,
+// and only the ticket is closed if the strategy is not pending approval any more.
+func (s *Service) expireApprovalTicket(kt *kit.Kit, ticket *table.ApprovalTicket) error {
+	var strategy *table.Strategy
+	var reason string
+	rejected := false
+	err := s.inApprovalTx(kt, func(tx *gen.QueryTx) error {
+		var err error
+		// 锁定审批单后重新检查状态，避免覆盖并发的审批、转审或撤销
+		ticket, strategy, err = s.lockApprovalTicket(kt, tx, ticket)
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		deadline := ticket.Spec.StageDeadline
+		if ticket.Spec.Status != table.ApprovalTicketPending || deadline == nil || deadline.After(now) {
+			return nil
+		}
+
+		actions, err := s.dao.ApprovalAction().ListWithTx(kt, tx, ticket.Attachment.BizID, ticket.ID)
+		if err != nil {
+			return err
+		}
+
+		stage := ticket.CurrentStage()
+		_, remaining := stage.Evaluate(ticket.Spec.CurrentStage, actions)
+		reason = i18n.T(kt, "approval stage %s timed out", stage.Name)
+
+		if _, err := s.dao.ApprovalAction().CreateWithTx(kt, tx, newApprovalAction(kt, ticket,
+			&table.ApprovalActionSpec{
+				Stage:   ticket.Spec.CurrentStage,
+				Action:  table.ApprovalActionTimeout,
+				Comment: reason,
+			})); err != nil {
+			return err
+		}
+
+		ticket.Spec.Status = table.ApprovalTicketRejected
+		ticket.Spec.StageDeadline = nil
+		ticket.Revision.Reviser = kt.User
+		ticket.Revision.UpdatedAt = now
+		if err := s.dao.ApprovalTicket().UpdateStatusWithTx(kt, tx, ticket); err != nil {
+			return err
+		}
+
+		if strategy.Spec.PublishStatus != table.PendingApproval {
+			return nil
+		}
+		rejected = true
+		if err := s.dao.Strategy().UpdateByID(kt, tx, strategy.ID, map[string]interface{}{
+			"publish_status":      table.RejectedApproval,
+			"reject_reason":       reason,
+			"approver_progress":   strings.Join(remaining, constant.NameSeparator),
+			"reviser":             kt.User,
+			"final_approval_time": now,
+		}); err != nil {
+			return err
+		}
+		return s.dao.AuditDao().UpdateByStrategyID(kt, tx, strategy.ID, map[string]interface{}{
+			"status": table.RejectedApproval,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if rejected {
+		at := ticket.Attachment
+		release, err := s.dao.Release().Get(kt, at.BizID, at.AppID, at.ReleaseID)
+		if err != nil {
+			logs.Errorf("get release %d failed, err: %v, rid: %s", at.ReleaseID, err, kt.Rid)
+			return nil
+		}
+		s.emitApproveWebhookEvents(kt, &pbds.ApproveReq{
+			BizId:         at.BizID,
+			AppId:         at.AppID,
+			ReleaseId:     at.ReleaseID,
+			StrategyId:    at.StrategyID,
+			PublishStatus: string(table.RejectedApproval),
+			Reason:        reason,
+		}, release, strategy, table.RejectedApproval)
+	}
+
+	return nil
+}
+
+// lockApprovalTicket locks the ticket and its publish strategy until the transaction ends, and returns them
+// read in the transaction.
+func (s *Service) lockApprovalTicket(kt *kit.Kit, tx *gen.QueryTx, ticket *table.ApprovalTicket) (
+	*table.ApprovalTicket, *table.Strategy, error) {
+
+	ticket, err := s.dao.ApprovalTicket().GetForUpdateWithTx(kt, tx, ticket.Attachment.BizID, ticket.ID)
+	if err != nil {
+		logs.Errorf("lock approval ticket failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, nil, err
+	}
+
+	at := ticket.Attachment
+	strategy, err := s.dao.Strategy().GetForUpdateWithTx(kt, tx, at.BizID, at.AppID, at.StrategyID)
+	if err != nil {
+		logs.Errorf("lock strategy %d failed, err: %v, rid: %s", at.StrategyID, err, kt.Rid)
+		return nil, nil, err
+	}
+
+	return ticket, strategy, nil
+}
+
+// getEnabledApprovalFlow returns the enabled builtin approval flow of the app, nil means the app is
+// approved by ITSM.
+func (s *Service) getEnabledApprovalFlow(kt *kit.Kit, bizID, appID uint32) (*table.ApprovalFlow, error) {
+	flow, err := s.dao.ApprovalFlow().GetByApp(kt, bizID, appID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		logs.Errorf("get approval flow failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if !flow.Spec.Enabled {
+		return nil, nil
+	}
+
+	return flow, nil
+}
+
+// getApprovalTicket returns the builtin approval ticket of the strategy, nil means the strategy is
+// approved by ITSM.
+func (s *Service) getApprovalTicket(kt *kit.Kit, bizID, strategyID uint32) (*table.ApprovalTicket, error) {
+	ticket, err := s.dao.ApprovalTicket().GetByStrategy(kt, bizID, strategyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		logs.Errorf("get approval ticket failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+// getStrategyApprovalTicket returns the publish strategy of the release and its builtin approval ticket.
+func (s *Service) getStrategyApprovalTicket(kt *kit.Kit, bizID, appID, releaseID, strategyID uint32) (
+	*table.Strategy, *table.ApprovalTicket, error) {
+	strategy, err := s.dao.Strategy().GetLast(kt, bizID, appID, releaseID, strategyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errf.Errorf(errf.RecordNotFound, "%s", i18n.T(kt, "publish strategy not found"))
+		}
+		logs.Errorf("get strategy failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, nil, err
+	}
+
+	ticket, err := s.getApprovalTicket(kt, bizID, strategy.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ticket == nil || ticket.Attachment.AppID != appID {
+		return nil, nil, errf.Errorf(errf.RecordNotFound, "%s",
+			i18n.T(kt, "builtin approval ticket of strategy %d not found", strategy.ID))
+	}
+
+	return strategy, ticket, nil
+}
+
+// createApprovalTicket creates the builtin approval ticket of the submitted strategy, the stages of the
+// flow are snapshotted so that the later changes of the flow do not affect the ticket.
+func (s *Service) createApprovalTicket(kt *kit.Kit, tx *gen.QueryTx, flow *table.ApprovalFlow,
+	releaseID, strategyID uint32) error {
+	now := time.Now().UTC()
+	stages := flow.Spec.Stages
+	ticket := &table.ApprovalTicket{
+		Spec: &table.ApprovalTicketSpec{
+			Stages:        stages,
+			CurrentStage:  0,
+			Status:        table.ApprovalTicketPending,
+			StageDeadline: stages[0].Deadline(now),
+		},
+		Attachment: &table.ApprovalTicketAttachment{
+			BizID:      flow.Attachment.BizID,
+			AppID:      flow.Attachment.AppID,
+			ReleaseID:  releaseID,
+			StrategyID: strategyID,
+			TenantID:   kt.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	if _, err := s.dao.ApprovalTicket().CreateWithTx(kt, tx, ticket); err != nil {
+		logs.Errorf("create approval ticket failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	// 审批人为所有阶段的审批人，当前审批人为第一阶段的审批人
+	approvers := make([]string, 0)
+	for _, one := range stages {
+		for _, user := range one.Approvers {
+			if !slices.Contains(approvers, user) {
+				approvers = append(approvers, user)
+			}
+		}
+	}
+
+	return s.dao.Strategy().UpdateByID(kt, tx, strategyID, map[string]interface{}{
+		"approver":          strings.Join(approvers, constant.NameSeparator),
+		"approver_progress": strings.Join(stages[0].Approvers, constant.NameSeparator),
+	})
+}
+
+// voteApprovalTicket records the vote of the approver on the builtin approval ticket, and returns the
+// strategy fields to update. The ticket moves to the next stage once the current stage is passed, and the
+// strategy is passed or rejected once the ticket is finished.
+// nolint: funlen
+func (s *Service) voteApprovalTicket(kt *kit.Kit, tx *gen.QueryTx, req *pbds.ApproveReq, strategy *table.Strategy,
+	ticket *table.ApprovalTicket, action table.ApprovalActionType) (map[string]interface{}, error) {
+
+	// 锁定审批单，并发的审批按顺序计票
+	ticket, err := s.dao.ApprovalTicket().GetForUpdateWithTx(kt, tx, ticket.Attachment.BizID, ticket.ID)
+	if err != nil {
+		logs.Errorf("lock approval ticket failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if strategy.Spec.PublishStatus != table.PendingApproval || ticket.Spec.Status != table.ApprovalTicketPending {
+		if action == table.ApprovalActionReject {
+			return nil, errors.New(i18n.T(kt, "rejected not allowed, current publish status is: %s",
+				strategy.Spec.PublishStatus))
+		}
+		return nil, errors.New(i18n.T(kt, "pass not allowed, current publish status is: %s",
+			strategy.Spec.PublishStatus))
+	}
+
+	if action == table.ApprovalActionReject && req.Reason == "" {
+		return nil, errors.New(i18n.T(kt, "reason can not empty"))
+	}
+
+	actions, err := s.dao.ApprovalAction().ListWithTx(kt, tx, req.BizId, ticket.ID)
+	if err != nil {
+		logs.Errorf("list approval actions failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	stageIdx := ticket.Spec.CurrentStage
+	stage := ticket.CurrentStage()
+	// 已投票或者不是当前阶段审批人的情况返回无权限审批
+	if _, remaining := stage.Evaluate(stageIdx, actions); !slices.Contains(remaining, kt.User) {
+		return nil, errors.New(i18n.T(kt, "no permission to approve"))
+	}
+
+	voter := kt.User
+	vote := newApprovalAction(kt, ticket, &table.ApprovalActionSpec{
+		Stage:   stageIdx,
+		Action:  action,
+		Comment: req.Reason,
+		Voter:   &voter,
+	})
+	if _, err = s.dao.ApprovalAction().CreateWithTx(kt, tx, vote); err != nil {
+		logs.Errorf("create approval action failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	status, remaining := stage.Evaluate(stageIdx, append(actions, vote))
+	if status == table.ApprovalTicketPending {
+		return map[string]interface{}{
+			"publish_status":    table.PendingApproval,
+			"approver_progress": strings.Join(remaining, constant.NameSeparator),
+		}, nil
+	}
+
+	now := time.Now().UTC()
+	ticket.Revision.Reviser = kt.User
+	ticket.Revision.UpdatedAt = now
+	ticket.Spec.StageDeadline = nil
+	result := make(map[string]interface{})
+
+	switch {
+	case status == table.ApprovalTicketRejected:
+		ticket.Spec.Status = table.ApprovalTicketRejected
+		result["publish_status"] = table.RejectedApproval
+		result["reject_reason"] = req.Reason
+		result["approver_progress"] = kt.User
+	case int(stageIdx)+1 < len(ticket.Spec.Stages):
+		// 当前阶段通过，进入下一阶段
+		next := ticket.Spec.Stages[stageIdx+1]
+		ticket.Spec.CurrentStage++
+		ticket.Spec.StageDeadline = next.Deadline(now)
+		result["publish_status"] = table.PendingApproval
+		result["approver_progress"] = strings.Join(next.Approvers, constant.NameSeparator)
+	default:
+		ticket.Spec.Status = table.ApprovalTicketPassed
+		publishStatus, err := s.publishApproved(kt, tx, req, strategy)
+		if err != nil {
+			return nil, err
+		}
+		result["publish_status"] = publishStatus
+		result["approver_progress"] = strategy.Spec.Approver
+	}
+
+	if err = s.dao.ApprovalTicket().UpdateStatusWithTx(kt, tx, ticket); err != nil {
+		logs.Errorf("update approval ticket failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// revokeApprovalTicket revokes the pending builtin approval ticket when the strategy is revoked.
+func (s *Service) revokeApprovalTicket(kt *kit.Kit, tx *gen.QueryTx, ticket *table.ApprovalTicket,
+	reason string) error {
+	if ticket.Spec.Status != table.ApprovalTicketPending {
+		return nil
+	}
+
+	if _, err := s.dao.ApprovalAction().CreateWithTx(kt, tx, newApprovalAction(kt, ticket, &table.ApprovalActionSpec{
+		Stage:   ticket.Spec.CurrentStage,
+		Action:  table.ApprovalActionRevoke,
+		Comment: reason,
+	})); err != nil {
+		return err
+	}
+
+	ticket.Spec.Status = table.ApprovalTicketRevoked
+	ticket.Spec.StageDeadline = nil
+	ticket.Revision.Reviser = kt.User
+	ticket.Revision.UpdatedAt = time.Now().UTC()
+
+	return s.dao.ApprovalTicket().UpdateStatusWithTx(kt, tx, ticket)
+}
+
+// doApprovalAction records the action of the ticket and does the other updates in one transaction.
+func (s *Service) doApprovalAction(kt *kit.Kit, ticket *table.ApprovalTicket, spec *table.ApprovalActionSpec,
+	do func(tx *gen.QueryTx) error) error {
+	return s.inApprovalTx(kt, func(tx *gen.QueryTx) error {
+		if _, err := s.dao.ApprovalAction().CreateWithTx(kt, tx, newApprovalAction(kt, ticket, spec)); err != nil {
+			logs.Errorf("create approval action failed, err: %v, rid: %s", err, kt.Rid)
+			return err
+		}
+
+		if do != nil {
+			if err := do(tx); err != nil {
+				logs.Errorf("do approval action %s failed, err: %v, rid: %s", spec.Action, err, kt.Rid)
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// inApprovalTx runs do in one transaction, which is committed if do succeeds.
+func (s *Service) inApprovalTx(kt *kit.Kit, do func(tx *gen.QueryTx) error) error {
+	tx := s.dao.GenQuery().Begin()
+	committed := false
+	defer func() {
+		if !committed {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+			}
+		}
+	}()
+
+	if err := do(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	committed = true
+
+	return nil
+}
+
+// newApprovalAction returns the action of the ticket which is done by the current user.
+func newApprovalAction(kt *kit.Kit, ticket *table.ApprovalTicket, spec *table.ApprovalActionSpec) *table.ApprovalAction {
+	return &table.ApprovalAction{
+		Spec: spec,
+		Attachment: &table.ApprovalActionAttachment{
+			BizID:    ticket.Attachment.BizID,
+			AppID:    ticket.Attachment.AppID,
+			TicketID: ticket.ID,
+			TenantID: kt.TenantID,
+		},
+		Revision: &table.CreatedRevision{Creator: kt.User},
+	}
+}
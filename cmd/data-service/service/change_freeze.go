@@ -0,0 +1,327 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbcf "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/change-freeze"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateChangeFreeze create change freeze window.
+func (s *Service) CreateChangeFreeze(ctx context.Context, req *pbds.CreateChangeFreezeReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().ChangeFreezeSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "change freeze spec is required"))
+	}
+
+	if req.AppId > 0 {
+		if _, err := s.dao.App().Get(kt, req.BizId, req.AppId); err != nil {
+			logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "app %d not found", req.AppId))
+		}
+	}
+
+	now := time.Now().UTC()
+	freeze := &table.ChangeFreeze{
+		Spec: spec,
+		Attachment: &table.ChangeFreezeAttachment{
+			BizID:    req.BizId,
+			AppID:    req.AppId,
+			TenantID: kt.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	if err := freeze.ValidateCreate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	id, err := s.dao.ChangeFreeze().Create(kt, freeze)
+	if err != nil {
+		logs.Errorf("create change freeze failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "create change freeze failed, err: %v", err))
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpdateChangeFreeze update change freeze window.
+func (s *Service) UpdateChangeFreeze(ctx context.Context, req *pbds.UpdateChangeFreezeReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().ChangeFreezeSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "change freeze spec is required"))
+	}
+
+	old, err := s.getChangeFreeze(kt, req.BizId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	freeze := &table.ChangeFreeze{
+		ID:         req.Id,
+		Spec:       spec,
+		Attachment: old.Attachment,
+		Revision: &table.Revision{
+			Reviser:   kt.User,
+			UpdatedAt: time.Now().UTC(),
+		},
+	}
+	if err = freeze.ValidateUpdate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	if err = s.dao.ChangeFreeze().Update(kt, freeze); err != nil {
+		logs.Errorf("update change freeze failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "update change freeze failed, err: %v", err))
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteChangeFreeze delete change freeze window.
+func (s *Service) DeleteChangeFreeze(ctx context.Context, req *pbds.DeleteChangeFreezeReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.getChangeFreeze(kt, req.BizId, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.dao.ChangeFreeze().Delete(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete change freeze failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "delete change freeze failed, err: %v", err))
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// GetChangeFreeze get change freeze window.
+func (s *Service) GetChangeFreeze(ctx context.Context, req *pbds.GetChangeFreezeReq) (*pbcf.ChangeFreeze, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	freeze, err := s.getChangeFreeze(kt, req.BizId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return pbcf.PbChangeFreeze(freeze), nil
+}
+
+// ListChangeFreezes list change freeze windows of the biz.
+func (s *Service) ListChangeFreezes(ctx context.Context, req *pbds.ListChangeFreezesReq) (
+	*pbds.ListChangeFreezesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	var freezes []*table.ChangeFreeze
+	var err error
+	if req.EnabledOnly {
+		freezes, err = s.dao.ChangeFreeze().ListEnabled(kt, req.BizId)
+	} else {
+		freezes, err = s.dao.ChangeFreeze().List(kt, req.BizId, req.AppId)
+	}
+	if err != nil {
+		logs.Errorf("list change freezes failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "list change freezes failed, err: %v", err))
+	}
+
+	if req.EnabledOnly && req.AppId > 0 {
+		covered := make([]*table.ChangeFreeze, 0, len(freezes))
+		for _, one := range freezes {
+			if one.Covers(req.AppId) {
+				covered = append(covered, one)
+			}
+		}
+		freezes = covered
+	}
+
+	return &pbds.ListChangeFreezesResp{Details: pbcf.PbChangeFreezes(freezes)}, nil
+}
+
+// RecordChangeFreezeBreakGlass record the audits of the change freeze windows overridden by the break-glass user.
+func (s *Service) RecordChangeFreezeBreakGlass(ctx context.Context, req *pbds.RecordChangeFreezeBreakGlassReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	freezes := make([]*table.ChangeFreeze, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		one, err := s.getChangeFreeze(kt, req.BizId, id)
+		if err != nil {
+			return nil, err
+		}
+		if !one.Spec.CanBreakGlass(kt.User) {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s",
+				i18n.T(kt, "%s is not the break-glass user of change freeze %s", kt.User, one.Spec.Name))
+		}
+		freezes = append(freezes, one)
+	}
+
+	if err := s.dao.ChangeFreeze().RecordBreakGlass(kt, req.BizId, req.AppId, freezes, req.Detail); err != nil {
+		logs.Errorf("record change freeze break glass failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s",
+			i18n.T(kt, "record change freeze break glass failed, err: %v", err))
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+func (s *Service) getChangeFreeze(kt *kit.Kit, bizID, id uint32) (*table.ChangeFreeze, error) {
+	freeze, err := s.dao.ChangeFreeze().Get(kt, bizID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, "%s", i18n.T(kt, "change freeze %d not found", id))
+		}
+		logs.Errorf("get change freeze failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "get change freeze failed, err: %v", err))
+	}
+
+	return freeze, nil
+}
+
+// checkChangeFreeze returns error if the change of the app is frozen for the user now, the overrides by the
+// break-glass user are recorded in the audits with the operation as detail.
+func (s *Service) checkChangeFreeze(kt *kit.Kit, bizID, appID uint32, operation string) error {
+	freezes, err := s.dao.ChangeFreeze().ListEnabled(kt, bizID)
+	if err != nil {
+		logs.Errorf("list change freezes failed, err: %v, rid: %s", err, kt.Rid)
+		return errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "list change freezes failed, err: %v", err))
+	}
+
+	frozen, overridden := table.CheckChangeFreeze(freezes, appID, kt.User, time.Now())
+	if frozen != nil {
+		logs.Warnf("%s of biz %d app %d is frozen by change freeze %d, user: %s, rid: %s", operation, bizID, appID,
+			frozen.ID, kt.User, kt.Rid)
+		return errf.Errorf(errf.ChangeFrozen, "%s", i18n.T(kt, "in change freeze %s, %s is not allowed, "+
+			"please contact the break-glass users: %s", frozen.Spec.Name, operation,
+			strings.Join(frozen.Spec.BreakGlassUsers, ", ")))
+	}
+
+	if len(overridden) == 0 {
+		return nil
+	}
+
+	if err := s.dao.ChangeFreeze().RecordBreakGlass(kt, bizID, appID, overridden, operation); err != nil {
+		logs.Errorf("record change freeze break glass failed, err: %v, rid: %s", err, kt.Rid)
+		return errf.Errorf(errf.DBOpFailed, "%s",
+			i18n.T(kt, "record change freeze break glass failed, err: %v", err))
+	}
+	logs.Infof("%s of biz %d app %d overrides %d change freezes by break-glass user %s, rid: %s", operation,
+		bizID, appID, len(overridden), kt.User, kt.Rid)
+
+	return nil
+}
+
+// bizFrozenMethods are the rpcs which change the biz level resources, their callers are only authorized to
+// find the business resources, which does not tell the writes from the reads, so the change freeze windows
+// of the whole biz are checked for them here.
+var bizFrozenMethods = map[string]struct{}{
+	pbds.Data_CreateGroup_FullMethodName:                    {},
+	pbds.Data_UpdateGroup_FullMethodName:                    {},
+	pbds.Data_DeleteGroup_FullMethodName:                    {},
+	pbds.Data_CreateHook_FullMethodName:                     {},
+	pbds.Data_UpdateHook_FullMethodName:                     {},
+	pbds.Data_DeleteHook_FullMethodName:                     {},
+	pbds.Data_CreateHookRevision_FullMethodName:             {},
+	pbds.Data_UpdateHookRevision_FullMethodName:             {},
+	pbds.Data_DeleteHookRevision_FullMethodName:             {},
+	pbds.Data_PublishHookRevision_FullMethodName:            {},
+	pbds.Data_CreateTemplateSpace_FullMethodName:            {},
+	pbds.Data_UpdateTemplateSpace_FullMethodName:            {},
+	pbds.Data_DeleteTemplateSpace_FullMethodName:            {},
+	pbds.Data_ImportTemplateSpaceHooks_FullMethodName:       {},
+	pbds.Data_DeleteTemplateSpaceHook_FullMethodName:        {},
+	pbds.Data_CreateTemplate_FullMethodName:                 {},
+	pbds.Data_UpdateTemplate_FullMethodName:                 {},
+	pbds.Data_DeleteTemplate_FullMethodName:                 {},
+	pbds.Data_BatchDeleteTemplate_FullMethodName:            {},
+	pbds.Data_BatchUpsertTemplates_FullMethodName:           {},
+	pbds.Data_BatchUpdateTemplatePermissions_FullMethodName: {},
+	pbds.Data_AddTmplsToTmplSets_FullMethodName:             {},
+	pbds.Data_DeleteTmplsFromTmplSets_FullMethodName:        {},
+	pbds.Data_CreateTemplateRevision_FullMethodName:         {},
+	pbds.Data_UpdateTemplateRevision_FullMethodName:         {},
+	pbds.Data_DeleteTemplateRevision_FullMethodName:         {},
+	pbds.Data_CreateTemplateSet_FullMethodName:              {},
+	pbds.Data_UpdateTemplateSet_FullMethodName:              {},
+	pbds.Data_DeleteTemplateSet_FullMethodName:              {},
+	pbds.Data_CreateTemplateVariable_FullMethodName:         {},
+	pbds.Data_UpdateTemplateVariable_FullMethodName:         {},
+	pbds.Data_DeleteTemplateVariable_FullMethodName:         {},
+	pbds.Data_ImportTemplateVariables_FullMethodName:        {},
+}
+
+// ChangeFreezeUnaryInterceptor returns a unary server interceptor which refuses the rpcs of bizFrozenMethods
+// in the change freeze windows of the whole biz, except for the break-glass users.
+func (s *Service) ChangeFreezeUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if _, ok := bizFrozenMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		// the request without biz is refused by the rpc itself.
+		bizID := requestBizID(req)
+		if bizID == 0 {
+			return handler(ctx, req)
+		}
+
+		kt := kit.FromGrpcContext(ctx)
+		if err := s.checkChangeFreeze(kt, bizID, 0, path.Base(info.FullMethod)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// requestBizID returns the biz id of the request, which is in the request or its attachment.
+func requestBizID(req interface{}) uint32 {
+	type bizReq interface {
+		GetBizId() uint32
+	}
+
+	if r, ok := req.(bizReq); ok {
+		return r.GetBizId()
+	}
+
+	method := reflect.ValueOf(req).MethodByName("GetAttachment")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return 0
+	}
+	if r, ok := method.Call(nil)[0].Interface().(bizReq); ok {
+		return r.GetBizId()
+	}
+	return 0
+}
@@ -0,0 +1,40 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+
+	pbgroup "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/group"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+func TestRequestBizID(t *testing.T) {
+	cases := []struct {
+		name string
+		req  interface{}
+		biz  uint32
+	}{
+		{name: "biz id of the request", req: &pbds.DeleteHookReq{BizId: 3}, biz: 3},
+		{name: "biz id of the attachment", req: &pbds.UpdateGroupReq{Attachment: &pbgroup.GroupAttachment{BizId: 5}},
+			biz: 5},
+		{name: "nil attachment", req: &pbds.UpdateGroupReq{}, biz: 0},
+		{name: "request without biz", req: &pbds.ListChangeFreezesResp{}, biz: 0},
+	}
+
+	for _, c := range cases {
+		if got := requestBizID(c.req); got != c.biz {
+			t.Errorf("%s: expect biz %d, got %d", c.name, c.biz, got)
+		}
+	}
+}
@@ -104,6 +104,9 @@ func (s *Service) BatchUpsertClientMetrics(ctx context.Context, req *pbds.BatchU
 		return nil, e
 	}
 	committed = true
+
+	s.accumulateClientMetrics(kt, req.GetClientItems(), req.GetClientEventItems())
+
 	return &pbds.BatchUpsertClientMetricsResp{}, nil
 }
 
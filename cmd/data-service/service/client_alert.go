@@ -0,0 +1,488 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-version"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/notifier"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbcalert "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-alert"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// clientAlertLookback 只加载最近一段时间内有心跳的客户端参与告警计算，更早的离线客户端离线时长必然超过
+// 离线时长规则的阈值，只统计数量和最早的心跳时间，避免客户端离线过久后告警自动恢复
+const clientAlertLookback = table.ClientAlertMaxOfflineMinutes * time.Minute
+
+// CreateClientAlertRule create client alert rule.
+func (s *Service) CreateClientAlertRule(ctx context.Context, req *pbds.CreateClientAlertRuleReq) (
+	*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().ClientAlertRuleSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "client alert rule spec is required"))
+	}
+
+	now := time.Now().UTC()
+	rule := &table.ClientAlertRule{
+		Spec: spec,
+		Attachment: &table.ClientAlertRuleAttachment{
+			BizID:    req.BizId,
+			AppID:    req.AppId,
+			TenantID: kt.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	if err := rule.ValidateCreate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	id, err := s.dao.ClientAlertRule().Create(kt, rule)
+	if err != nil {
+		logs.Errorf("create client alert rule failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "create client alert rule failed, err: %v", err))
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpdateClientAlertRule update client alert rule.
+func (s *Service) UpdateClientAlertRule(ctx context.Context, req *pbds.UpdateClientAlertRuleReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().ClientAlertRuleSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "client alert rule spec is required"))
+	}
+
+	rule := &table.ClientAlertRule{
+		ID:   req.Id,
+		Spec: spec,
+		Attachment: &table.ClientAlertRuleAttachment{
+			BizID: req.BizId,
+			AppID: req.AppId,
+		},
+		Revision: &table.Revision{
+			Reviser:   kt.User,
+			UpdatedAt: time.Now().UTC(),
+		},
+	}
+	if err := rule.ValidateUpdate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	if err := s.dao.ClientAlertRule().Update(kt, rule); err != nil {
+		logs.Errorf("update client alert rule failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "update client alert rule failed, err: %v", err))
+	}
+
+	return &pbbase.EmptyResp{}, nil
+}
+
+// DeleteClientAlertRule delete client alert rule, the alert histories of the rule are kept.
+func (s *Service) DeleteClientAlertRule(ctx context.Context, req *pbds.DeleteClientAlertRuleReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	err := s.dao.ClientAlertRule().Delete(kt, &table.ClientAlertRule{
+		ID: req.Id,
+		Attachment: &table.ClientAlertRuleAttachment{
+			BizID: req.BizId,
+			AppID: req.AppId,
+		},
+	})
+	if err != nil {
+		logs.Errorf("delete client alert rule failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "delete client alert rule failed, err: %v", err))
+	}
+
+	return &pbbase.EmptyResp{}, nil
+}
+
+// ListClientAlertRules list client alert rules.
+func (s *Service) ListClientAlertRules(ctx context.Context, req *pbds.ListClientAlertRulesReq) (
+	*pbds.ListClientAlertRulesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	rules, count, err := s.dao.ClientAlertRule().List(kt, req.BizId, req.AppId,
+		&types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All})
+	if err != nil {
+		logs.Errorf("list client alert rules failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "list client alert rules failed, err: %v", err))
+	}
+
+	return &pbds.ListClientAlertRulesResp{
+		Count:   uint32(count),
+		Details: pbcalert.PbClientAlertRules(rules),
+	}, nil
+}
+
+// SilenceClientAlertRule silence the client alert rule for a duration, zero duration means cancel the silence.
+func (s *Service) SilenceClientAlertRule(ctx context.Context, req *pbds.SilenceClientAlertRuleReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.dao.ClientAlertRule().Get(kt, req.BizId, req.AppId, req.Id); err != nil {
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "get client alert rule failed, err: %v", err))
+	}
+
+	var silencedUntil *time.Time
+	if req.Duration > 0 {
+		until := time.Now().UTC().Add(time.Duration(req.Duration) * time.Second)
+		silencedUntil = &until
+	}
+
+	if err := s.dao.ClientAlertRule().UpdateSilence(kt, req.BizId, req.AppId, req.Id, silencedUntil); err != nil {
+		logs.Errorf("silence client alert rule failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "silence client alert rule failed, err: %v", err))
+	}
+
+	return &pbbase.EmptyResp{}, nil
+}
+
+// AckClientAlert acknowledge the firing client alert, it won't be delivered again until resolved.
+func (s *Service) AckClientAlert(ctx context.Context, req *pbds.AckClientAlertReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.dao.ClientAlertHistory().Ack(kt, req.BizId, req.AppId, req.Id); err != nil {
+		logs.Errorf("ack client alert failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "ack client alert failed, err: %v", err))
+	}
+
+	return &pbbase.EmptyResp{}, nil
+}
+
+// ListClientAlertHistories list client alert histories.
+func (s *Service) ListClientAlertHistories(ctx context.Context, req *pbds.ListClientAlertHistoriesReq) (
+	*pbds.ListClientAlertHistoriesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if req.Status != "" {
+		if err := table.ClientAlertStatus(req.Status).Validate(); err != nil {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+		}
+	}
+
+	histories, count, err := s.dao.ClientAlertHistory().List(kt, req.BizId, req.AppId, req.RuleId, req.Status,
+		&types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All})
+	if err != nil {
+		logs.Errorf("list client alert histories failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s",
+			i18n.T(kt, "list client alert histories failed, err: %v", err))
+	}
+
+	return &pbds.ListClientAlertHistoriesResp{
+		Count:   uint32(count),
+		Details: pbcalert.PbClientAlertHistories(histories),
+	}, nil
+}
+
+// EvaluateClientAlerts evaluate all the enabled client alert rules, record the alert histories and deliver the
+// alerts through the notifiers of the rules.
+func (s *Service) EvaluateClientAlerts(kt *kit.Kit) error {
+	rules, err := s.dao.ClientAlertRule().ListEnabled(kt)
+	if err != nil {
+		return fmt.Errorf("list enabled client alert rules failed, err: %v", err)
+	}
+
+	now := time.Now().UTC()
+	clients := make(map[[2]uint32][]*table.Client)
+	stale := make(map[[2]uint32]*types.OfflineClientStat)
+	for _, rule := range rules {
+		key := [2]uint32{rule.Attachment.BizID, rule.Attachment.AppID}
+		if _, ok := clients[key]; !ok {
+			list, err := s.dao.Client().ListForAlert(kt, rule.Attachment.BizID, rule.Attachment.AppID,
+				now.Add(-clientAlertLookback))
+			if err != nil {
+				logs.Errorf("list clients for alert rule %d failed, err: %v, rid: %s", rule.ID, err, kt.Rid)
+				continue
+			}
+			clients[key] = list
+		}
+		if _, ok := stale[key]; !ok && rule.Spec.RuleType == table.ClientAlertOfflineDuration {
+			stat, err := s.dao.Client().GetOfflineStatBefore(kt, rule.Attachment.BizID, rule.Attachment.AppID,
+				now.Add(-clientAlertLookback))
+			if err != nil {
+				logs.Errorf("get offline clients stat for alert rule %d failed, err: %v, rid: %s", rule.ID, err,
+					kt.Rid)
+				continue
+			}
+			stale[key] = stat
+		}
+
+		result := evaluateClientAlertRule(rule, clients[key], stale[key], now)
+		if err := s.handleClientAlertResult(kt, rule, result, now); err != nil {
+			logs.Errorf("handle client alert rule %d result failed, err: %v, rid: %s", rule.ID, err, kt.Rid)
+		}
+	}
+
+	return nil
+}
+
+// clientAlertResult is the result of evaluating a client alert rule.
+type clientAlertResult struct {
+	firing      bool
+	value       float64
+	clientCount uint32
+	message     string
+}
+
+// evaluateClientAlertRule evaluate the client alert rule with the clients of the app, stale is the stat of the
+// offline clients whose heartbeat is before the lookback, it's only used by the offline duration rule.
+func evaluateClientAlertRule(rule *table.ClientAlertRule, clients []*table.Client, stale *types.OfflineClientStat,
+	now time.Time) clientAlertResult {
+	switch rule.Spec.RuleType {
+	case table.ClientAlertFailedRatio:
+		return evaluateFailedRatio(rule.Spec, clients)
+	case table.ClientAlertOfflineDuration:
+		return evaluateOfflineDuration(rule.Spec, clients, stale, now)
+	case table.ClientAlertVersionBelow:
+		return evaluateVersionBelow(rule.Spec, clients)
+	default:
+		return clientAlertResult{}
+	}
+}
+
+func evaluateFailedRatio(spec *table.ClientAlertRuleSpec, clients []*table.Client) clientAlertResult {
+	var total, failed uint32
+	for _, c := range clients {
+		if c.Spec.TargetReleaseID == 0 {
+			continue
+		}
+		if spec.ReleaseID != 0 && c.Spec.TargetReleaseID != spec.ReleaseID {
+			continue
+		}
+		total++
+		if c.Spec.ReleaseChangeStatus == table.Failed {
+			failed++
+		}
+	}
+
+	if total == 0 {
+		return clientAlertResult{}
+	}
+
+	ratio := float64(failed) / float64(total) * 100
+	return clientAlertResult{
+		firing:      ratio > spec.Threshold,
+		value:       ratio,
+		clientCount: failed,
+		message: fmt.Sprintf("%d/%d clients failed to change release, failed ratio %.2f%% exceeds %.2f%%",
+			failed, total, ratio, spec.Threshold),
+	}
+}
+
+func evaluateOfflineDuration(spec *table.ClientAlertRuleSpec, clients []*table.Client,
+	stale *types.OfflineClientStat, now time.Time) clientAlertResult {
+	threshold := time.Duration(spec.Threshold * float64(time.Minute))
+
+	var count uint32
+	var longest time.Duration
+	// the threshold is not more than the lookback, so the stale offline clients are all over the threshold
+	if stale != nil && stale.Count > 0 && stale.EarliestHeartbeatTime != nil {
+		count = stale.Count
+		longest = now.Sub(*stale.EarliestHeartbeatTime)
+	}
+	for _, c := range clients {
+		if !strings.EqualFold(c.Spec.OnlineStatus, sfs.Offline.String()) {
+			continue
+		}
+		offline := now.Sub(c.Spec.LastHeartbeatTime)
+		if offline <= threshold {
+			continue
+		}
+		count++
+		if offline > longest {
+			longest = offline
+		}
+	}
+
+	return clientAlertResult{
+		firing:      count > 0,
+		value:       longest.Minutes(),
+		clientCount: count,
+		message: fmt.Sprintf("%d clients have been offline for more than %v minutes, the longest is %.0f minutes",
+			count, spec.Threshold, longest.Minutes()),
+	}
+}
+
+func evaluateVersionBelow(spec *table.ClientAlertRuleSpec, clients []*table.Client) clientAlertResult {
+	minVersion, err := version.NewVersion(spec.ClientVersion)
+	if err != nil {
+		return clientAlertResult{}
+	}
+
+	var count uint32
+	for _, c := range clients {
+		if !strings.EqualFold(c.Spec.OnlineStatus, sfs.Online.String()) {
+			continue
+		}
+		v, err := version.NewVersion(c.Spec.ClientVersion)
+		if err != nil {
+			continue
+		}
+		if v.LessThan(minVersion) {
+			count++
+		}
+	}
+
+	return clientAlertResult{
+		firing:      count > 0,
+		value:       float64(count),
+		clientCount: count,
+		message:     fmt.Sprintf("%d online clients' version is below %s", count, spec.ClientVersion),
+	}
+}
+
+// handleClientAlertResult 告警触发时记录告警历史并通知，已触发的告警在恢复前不会重复通知
+func (s *Service) handleClientAlertResult(kt *kit.Kit, rule *table.ClientAlertRule, result clientAlertResult,
+	now time.Time) error {
+	active, err := s.dao.ClientAlertHistory().GetActive(kt, rule.Attachment.BizID, rule.ID)
+	if err != nil {
+		return err
+	}
+
+	// 告警恢复
+	if !result.firing {
+		if active == nil {
+			return nil
+		}
+		if err := s.dao.ClientAlertHistory().Resolve(kt, rule.Attachment.BizID, active.ID); err != nil {
+			return err
+		}
+		if !rule.Spec.IsSilenced(now) {
+			s.notifyClientAlert(kt, rule, table.ClientAlertResolved, active.Spec.Message, active.Spec.FiredAt)
+		}
+		return nil
+	}
+
+	// 告警持续中，不重复通知
+	if active != nil {
+		return nil
+	}
+
+	history := &table.ClientAlertHistory{
+		Spec: &table.ClientAlertHistorySpec{
+			RuleID:      rule.ID,
+			RuleName:    rule.Spec.Name,
+			RuleType:    rule.Spec.RuleType,
+			Status:      table.ClientAlertFiring,
+			Value:       result.value,
+			ClientCount: result.clientCount,
+			Message:     result.message,
+			Silenced:    rule.Spec.IsSilenced(now),
+			FiredAt:     now,
+		},
+		Attachment: &table.ClientAlertRuleAttachment{
+			BizID:    rule.Attachment.BizID,
+			AppID:    rule.Attachment.AppID,
+			TenantID: rule.Attachment.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	if !history.Spec.Silenced {
+		history.Spec.NotifyResult = s.notifyClientAlert(kt, rule, table.ClientAlertFiring, result.message, now)
+	}
+
+	if _, err = s.dao.ClientAlertHistory().Create(kt, history); err != nil {
+		return err
+	}
+
+	// 客户端变更失败率告警推送到订阅的 webhook
+	if rule.Spec.RuleType == table.ClientAlertFailedRatio {
+		s.emitWebhookEvent(kt, rule.Attachment.BizID, rule.Attachment.AppID, table.WebhookClientFailureSpike,
+			&webhookClientFailureData{
+				RuleID:        rule.ID,
+				RuleName:      rule.Spec.Name,
+				ReleaseID:     rule.Spec.ReleaseID,
+				FailedRatio:   result.value,
+				Threshold:     rule.Spec.Threshold,
+				FailedClients: result.clientCount,
+				Message:       result.message,
+				Silenced:      history.Spec.Silenced,
+			})
+	}
+
+	return nil
+}
+
+// notifyClientAlert 通过告警规则配置的通知渠道发送告警，返回各渠道的通知结果
+func (s *Service) notifyClientAlert(kt *kit.Kit, rule *table.ClientAlertRule, status table.ClientAlertStatus,
+	message string, firedAt time.Time) string {
+	receivers := make([]string, 0)
+	for _, r := range strings.Split(rule.Spec.Receivers, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			receivers = append(receivers, r)
+		}
+	}
+
+	msg := &notifier.Message{
+		Title: fmt.Sprintf("[BSCP] client alert %s: %s", status, rule.Spec.Name),
+		Content: fmt.Sprintf("biz: %d\napp: %d\nrule: %s(%s)\nstatus: %s\nfired at: %s\n%s",
+			rule.Attachment.BizID, rule.Attachment.AppID, rule.Spec.Name, rule.Spec.RuleType, status,
+			firedAt.Format(time.RFC3339), message),
+		Status: string(status),
+		Labels: map[string]string{
+			"biz_id":    strconv.Itoa(int(rule.Attachment.BizID)),
+			"app_id":    strconv.Itoa(int(rule.Attachment.AppID)),
+			"rule_id":   strconv.Itoa(int(rule.ID)),
+			"rule_type": string(rule.Spec.RuleType),
+		},
+		FiredAt:    firedAt,
+		WebhookURL: rule.Spec.WebhookURL,
+		Receivers:  receivers,
+	}
+
+	results := make([]string, 0)
+	for _, name := range rule.Spec.NotifierList() {
+		n, ok := s.notifiers[name]
+		if !ok {
+			results = append(results, fmt.Sprintf("%s: notifier not supported", name))
+			continue
+		}
+		if err := n.Notify(kt.Ctx, msg); err != nil {
+			logs.Errorf("notify client alert rule %d through %s failed, err: %v, rid: %s", rule.ID, name, err, kt.Rid)
+			results = append(results, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		results = append(results, fmt.Sprintf("%s: success", name))
+	}
+
+	return strings.Join(results, "; ")
+}
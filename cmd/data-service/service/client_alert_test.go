@@ -0,0 +1,99 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+func TestEvaluateClientAlertRule(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	client := func(online string, heartbeat time.Duration, version string, target uint32,
+		status table.Status) *table.Client {
+		return &table.Client{Spec: &table.ClientSpec{
+			OnlineStatus:        online,
+			LastHeartbeatTime:   now.Add(-heartbeat),
+			ClientVersion:       version,
+			TargetReleaseID:     target,
+			ReleaseChangeStatus: status,
+		}}
+	}
+	online, offline := sfs.Online.String(), sfs.Offline.String()
+	staleHeartbeat := now.Add(-48 * time.Hour)
+	clients := []*table.Client{
+		client(online, time.Minute, "v1.2.0", 1, table.Success),
+		client(online, time.Minute, "v1.0.0", 1, table.Failed),
+		client(offline, 90*time.Minute, "v1.0.0", 2, table.Failed),
+		client(offline, 10*time.Minute, "v1.1.0", 2, table.Success),
+	}
+
+	cases := []struct {
+		name        string
+		spec        *table.ClientAlertRuleSpec
+		stale       *types.OfflineClientStat
+		firing      bool
+		value       float64
+		clientCount uint32
+	}{
+		{
+			name:   "全部版本失败率超过阈值",
+			spec:   &table.ClientAlertRuleSpec{RuleType: table.ClientAlertFailedRatio, Threshold: 40},
+			firing: true, value: 50, clientCount: 2,
+		},
+		{
+			name:   "指定版本失败率未超过阈值",
+			spec:   &table.ClientAlertRuleSpec{RuleType: table.ClientAlertFailedRatio, ReleaseID: 1, Threshold: 50},
+			firing: false, value: 50, clientCount: 1,
+		},
+		{
+			name:   "离线时长超过阈值",
+			spec:   &table.ClientAlertRuleSpec{RuleType: table.ClientAlertOfflineDuration, Threshold: 30},
+			firing: true, value: 90, clientCount: 1,
+		},
+		{
+			name:   "离线时长均未超过阈值",
+			spec:   &table.ClientAlertRuleSpec{RuleType: table.ClientAlertOfflineDuration, Threshold: 120},
+			firing: false, value: 0, clientCount: 0,
+		},
+		{
+			name:   "离线超过回溯时间的客户端仍然告警",
+			spec:   &table.ClientAlertRuleSpec{RuleType: table.ClientAlertOfflineDuration, Threshold: 120},
+			stale:  &types.OfflineClientStat{Count: 2, EarliestHeartbeatTime: &staleHeartbeat},
+			firing: true, value: 48 * 60, clientCount: 2,
+		},
+		{
+			name:   "在线客户端版本低于指定版本",
+			spec:   &table.ClientAlertRuleSpec{RuleType: table.ClientAlertVersionBelow, ClientVersion: "1.1.0"},
+			firing: true, value: 1, clientCount: 1,
+		},
+		{
+			name:   "在线客户端版本均不低于指定版本",
+			spec:   &table.ClientAlertRuleSpec{RuleType: table.ClientAlertVersionBelow, ClientVersion: "1.0.0"},
+			firing: false, value: 0, clientCount: 0,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := evaluateClientAlertRule(&table.ClientAlertRule{Spec: c.spec}, clients, c.stale, now)
+			if got.firing != c.firing || got.value != c.value || got.clientCount != c.clientCount {
+				t.Fatalf("evaluateClientAlertRule() = {firing: %v, value: %v, count: %d}, want {%v, %v, %d}",
+					got.firing, got.value, got.clientCount, c.firing, c.value, c.clientCount)
+			}
+		})
+	}
+}
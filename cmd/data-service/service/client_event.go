@@ -15,12 +15,16 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
+	"google.golang.org/protobuf/types/known/structpb"
+
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbclient "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
 	pbce "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-event"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
 	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
@@ -166,6 +170,8 @@ func (s *Service) ListClientEvents(ctx context.Context, req *pbds.ListClientEven
 		starTime,
 		endTime,
 		req.GetSearchValue(),
+		req.GetTargetReleaseId(),
+		req.GetCurrentStage(),
 		req.GetOrder(),
 		&types.BasePage{
 			Start: req.GetStart(),
@@ -215,3 +221,148 @@ func (s *Service) ListClientEvents(ctx context.Context, req *pbds.ListClientEven
 	}
 	return resp, nil
 }
+
+// ClientStageStatistics 统计客户端版本变更各阶段耗时，按目标版本分别给出每个阶段的 p50/p95 耗时，
+// 以及停留在该阶段的客户端数量，用于定位版本变更卡在哪个阶段
+func (s *Service) ClientStageStatistics(ctx context.Context, req *pbclient.ClientCommonReq) (
+	*structpb.Struct, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	// 通过查询条件获取clientID
+	var clientID []uint32
+	if req.GetSearch().String() != "" || req.GetLastHeartbeatTime() > 0 {
+		items, _, err := s.dao.Client().List(grpcKit, req.GetBizId(), req.GetAppId(), req.GetLastHeartbeatTime(),
+			req.GetSearch(), &pbds.ListClientsReq_Order{}, &types.BasePage{All: true})
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return structpb.NewStruct(map[string]interface{}{"releases": []interface{}{}})
+		}
+		for _, v := range items {
+			clientID = append(clientID, v.ID)
+		}
+	}
+
+	events, err := s.dao.ClientEvent().ListStageTimelines(grpcKit, req.GetBizId(), req.GetAppId(), clientID,
+		req.GetPullTime())
+	if err != nil {
+		return nil, err
+	}
+
+	releaseIDs := make([]uint32, 0)
+	releaseMap := make(map[uint32]struct{})
+	for _, v := range events {
+		if _, ok := releaseMap[v.Spec.TargetReleaseID]; !ok && v.Spec.TargetReleaseID > 0 {
+			releaseMap[v.Spec.TargetReleaseID] = struct{}{}
+			releaseIDs = append(releaseIDs, v.Spec.TargetReleaseID)
+		}
+	}
+	releases, err := s.dao.Release().ListAllByIDs(grpcKit, releaseIDs, req.GetBizId())
+	if err != nil {
+		return nil, err
+	}
+	releaseNames := map[uint32]string{}
+	for _, v := range releases {
+		releaseNames[v.ID] = v.Spec.Name
+	}
+
+	return structpb.NewStruct(map[string]interface{}{
+		"releases": buildStageStatistics(events, releaseNames),
+	})
+}
+
+// stageStatistic 单个版本下某个变更阶段的统计
+type stageStatistic struct {
+	seconds []float64
+	stalled int
+	failed  int
+}
+
+// buildStageStatistics 按目标版本、变更阶段聚合阶段耗时
+func buildStageStatistics(events []*table.ClientEvent, releaseNames map[uint32]string) []interface{} {
+	releaseStages := make(map[uint32]map[string]*stageStatistic)
+	releaseClients := make(map[uint32]int)
+	for _, event := range events {
+		releaseID := event.Spec.TargetReleaseID
+		stages, ok := releaseStages[releaseID]
+		if !ok {
+			stages = make(map[string]*stageStatistic)
+			releaseStages[releaseID] = stages
+		}
+		releaseClients[releaseID]++
+
+		for _, stage := range event.Spec.Stages {
+			stat := getStageStatistic(stages, stage.Stage)
+			// 未结束的阶段不参与耗时统计
+			if !stage.EndTime.IsZero() {
+				stat.seconds = append(stat.seconds, stage.Seconds)
+			}
+		}
+
+		// 客户端最后停留的阶段
+		stat := getStageStatistic(stages, event.Spec.CurrentStage)
+		switch event.Spec.ReleaseChangeStatus {
+		case table.Processing:
+			stat.stalled++
+		case table.Failed:
+			stat.failed++
+		}
+	}
+
+	releaseIDs := make([]uint32, 0, len(releaseStages))
+	for id := range releaseStages {
+		releaseIDs = append(releaseIDs, id)
+	}
+	sort.Slice(releaseIDs, func(i, j int) bool { return releaseIDs[i] > releaseIDs[j] })
+
+	result := make([]interface{}, 0, len(releaseIDs))
+	for _, releaseID := range releaseIDs {
+		stages := releaseStages[releaseID]
+		charts := make([]interface{}, 0, len(stages))
+		for _, stage := range sfs.ReleaseChangeStages {
+			stat, ok := stages[stage.String()]
+			if !ok {
+				continue
+			}
+			sort.Float64s(stat.seconds)
+			charts = append(charts, map[string]interface{}{
+				"stage":   stage.String(),
+				"count":   len(stat.seconds),
+				"p50":     percentile(stat.seconds, 50),
+				"p95":     percentile(stat.seconds, 95),
+				"stalled": stat.stalled,
+				"failed":  stat.failed,
+			})
+		}
+		result = append(result, map[string]interface{}{
+			"release_id":   releaseID,
+			"release_name": releaseNames[releaseID],
+			"client_count": releaseClients[releaseID],
+			"stages":       charts,
+		})
+	}
+
+	return result
+}
+
+func getStageStatistic(stages map[string]*stageStatistic, stage string) *stageStatistic {
+	stat, ok := stages[stage]
+	if !ok {
+		stat = &stageStatistic{}
+		stages[stage] = stat
+	}
+	return stat
+}
+
+// percentile 按最近秩法计算已排序数据的百分位数
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
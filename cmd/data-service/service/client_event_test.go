@@ -0,0 +1,96 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func TestPercentile(t *testing.T) {
+	cases := []struct {
+		name   string
+		sorted []float64
+		p      float64
+		want   float64
+	}{
+		{name: "空数据", sorted: nil, p: 50, want: 0},
+		{name: "单个数据", sorted: []float64{3}, p: 95, want: 3},
+		{name: "p50", sorted: []float64{1, 2, 3, 4}, p: 50, want: 2},
+		{name: "p95", sorted: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, p: 95, want: 10},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := percentile(c.sorted, c.p); got != c.want {
+				t.Fatalf("percentile(%v, %v) = %v, want %v", c.sorted, c.p, got, c.want)
+			}
+		})
+	}
+}
+
+func TestBuildStageStatistics(t *testing.T) {
+	now := time.Now()
+	event := func(status table.Status, current string, stages ...*table.ClientEventStage) *table.ClientEvent {
+		return &table.ClientEvent{Spec: &table.ClientEventSpec{
+			TargetReleaseID:     1,
+			ReleaseChangeStatus: status,
+			CurrentStage:        current,
+			Stages:              stages,
+		}}
+	}
+	stage := func(name string, seconds float64, finished bool) *table.ClientEventStage {
+		s := &table.ClientEventStage{Stage: name, StartTime: now, Seconds: seconds}
+		if finished {
+			s.EndTime = now.Add(time.Duration(seconds) * time.Second)
+		}
+		return s
+	}
+
+	events := []*table.ClientEvent{
+		event(table.Success, "reloaded", stage("notified", 1, true), stage("downloading", 2, true),
+			stage("reloaded", 1, true)),
+		event(table.Processing, "downloading", stage("notified", 3, true), stage("downloading", 0, false)),
+	}
+
+	result := buildStageStatistics(events, map[uint32]string{1: "v1"})
+	if len(result) != 1 {
+		t.Fatalf("expect 1 release, got %d", len(result))
+	}
+	release := result[0].(map[string]interface{})
+	if release["release_name"] != "v1" || release["client_count"] != 2 {
+		t.Fatalf("unexpected release statistic: %v", release)
+	}
+
+	stages := release["stages"].([]interface{})
+	want := []struct {
+		stage   string
+		count   int
+		p50     float64
+		stalled int
+	}{
+		{stage: "notified", count: 2, p50: 1, stalled: 0},
+		{stage: "downloading", count: 1, p50: 2, stalled: 1},
+		{stage: "reloaded", count: 1, p50: 1, stalled: 0},
+	}
+	if len(stages) != len(want) {
+		t.Fatalf("expect %d stages, got %d", len(want), len(stages))
+	}
+	for i, w := range want {
+		got := stages[i].(map[string]interface{})
+		if got["stage"] != w.stage || got["count"] != w.count || got["p50"] != w.p50 || got["stalled"] != w.stalled {
+			t.Fatalf("stage %d = %v, want %+v", i, got, w)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbclient "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
+	pbce "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-event"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+const (
+	// clientMetricHourMaxRange 按小时查询趋势的最大时间范围
+	clientMetricHourMaxRange = 31 * 24 * time.Hour
+	// clientMetricDayMaxRange 按天查询趋势的最大时间范围
+	clientMetricDayMaxRange = 366 * 24 * time.Hour
+)
+
+// ListClientMetricTrends list the resource usage and pull trends of the app's clients or a specific client.
+func (s *Service) ListClientMetricTrends(ctx context.Context, req *pbds.ListClientMetricTrendsReq) (
+	*pbds.ListClientMetricTrendsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	granularity := table.ClientMetricGranularity(req.GetGranularity())
+	if granularity == "" {
+		granularity = table.ClientMetricHour
+	}
+	if err := granularity.Validate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, "%s", err.Error())
+	}
+
+	startTime, err := time.ParseInLocation(time.RFC3339, req.GetStartTime(), time.UTC)
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kt, "invalid start time %s, err: %v",
+			req.GetStartTime(), err))
+	}
+	endTime, err := time.ParseInLocation(time.RFC3339, req.GetEndTime(), time.UTC)
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kt, "invalid end time %s, err: %v",
+			req.GetEndTime(), err))
+	}
+	if !endTime.After(startTime) {
+		return nil, errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kt, "end time must be after start time"))
+	}
+	maxRange := clientMetricHourMaxRange
+	if granularity == table.ClientMetricDay {
+		maxRange = clientMetricDayMaxRange
+	}
+	if endTime.Sub(startTime) > maxRange {
+		return nil, errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kt,
+			"the time range of %s granularity can not exceed %d days", granularity, int(maxRange.Hours()/24)))
+	}
+
+	trends, err := s.dao.ClientMetric().ListTrends(kt, &types.ListClientMetricTrendsOption{
+		BizID:       req.GetBizId(),
+		AppID:       req.GetAppId(),
+		UID:         req.GetUid(),
+		Granularity: string(granularity),
+		StartTime:   granularity.Truncate(startTime),
+		EndTime:     endTime,
+		ByVersion:   req.GetByVersion(),
+	})
+	if err != nil {
+		logs.Errorf("list client metric trends failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	details := make([]*pbclient.ClientMetricTrend, 0, len(trends))
+	for _, v := range trends {
+		trend := &pbclient.ClientMetricTrend{
+			BucketTime:      timestamppb.New(v.BucketTime),
+			ClientVersion:   v.ClientVersion,
+			ClientCount:     v.ClientCount,
+			CpuMaxUsage:     v.CpuMax,
+			MemoryMaxUsage:  v.MemoryMax,
+			PullCount:       v.PullCount,
+			PullFailedCount: v.PullFailedCount,
+		}
+		if v.SampleCount > 0 {
+			trend.CpuAvgUsage = v.CpuSum / float64(v.SampleCount)
+			trend.MemoryAvgUsage = v.MemorySum / v.SampleCount
+		}
+		if v.PullCount > 0 {
+			trend.PullAvgSeconds = v.PullSeconds / float64(v.PullCount)
+		}
+		details = append(details, trend)
+	}
+
+	return &pbds.ListClientMetricTrendsResp{Details: details}, nil
+}
+
+// RollupClientMetrics downsample the hourly client metrics of yesterday and today into daily client metrics,
+// and clean up the expired hourly and daily client metrics.
+func (s *Service) RollupClientMetrics(kt *kit.Kit, hourlyRetentionDays, dailyRetentionDays int) error {
+	today := table.ClientMetricDay.Truncate(time.Now())
+	// 昨天最后几个小时的指标可能在今天才上报，所以每次都重新汇总昨天的数据
+	for _, day := range []time.Time{today.AddDate(0, 0, -1), today} {
+		if err := s.dao.ClientMetric().RollupDay(kt, day); err != nil {
+			return fmt.Errorf("rollup client metrics of %s failed, err: %v", day.Format(time.DateOnly), err)
+		}
+	}
+
+	hourly, err := s.dao.ClientMetric().DeleteBefore(kt, table.ClientMetricHour,
+		today.AddDate(0, 0, -hourlyRetentionDays))
+	if err != nil {
+		return fmt.Errorf("delete expired hourly client metrics failed, err: %v", err)
+	}
+	daily, err := s.dao.ClientMetric().DeleteBefore(kt, table.ClientMetricDay,
+		today.AddDate(0, 0, -dailyRetentionDays))
+	if err != nil {
+		return fmt.Errorf("delete expired daily client metrics failed, err: %v", err)
+	}
+	logs.Infof("rollup client metrics success, deleted %d hourly and %d daily metrics, rid: %s",
+		hourly, daily, kt.Rid)
+
+	return nil
+}
+
+// accumulateClientMetrics 将本批次上报的心跳和拉取事件累加到客户端小时指标中，
+// 指标只用于趋势展示，失败时只记录日志，不影响客户端数据的上报
+func (s *Service) accumulateClientMetrics(kt *kit.Kit, clients []*pbclient.Client, events []*pbce.ClientEvent) {
+	metrics := buildHourlyClientMetrics(clients, events)
+	if err := s.dao.ClientMetric().BatchAccumulate(kt, metrics); err != nil {
+		logs.Errorf("accumulate %d client metrics failed, err: %v, rid: %s", len(metrics), err, kt.Rid)
+	}
+}
+
+// buildHourlyClientMetrics aggregate the reported heartbeats and pull events into hourly client metrics.
+func buildHourlyClientMetrics(clients []*pbclient.Client, events []*pbce.ClientEvent) []*table.ClientMetric {
+	metrics := make([]*table.ClientMetric, 0)
+	index := make(map[string]*table.ClientMetric)
+	get := func(bizID, appID uint32, uid string, t time.Time) *table.ClientMetric {
+		bucket := table.ClientMetricHour.Truncate(t)
+		key := fmt.Sprintf("%d-%d-%s-%d", bizID, appID, uid, bucket.Unix())
+		if m, ok := index[key]; ok {
+			return m
+		}
+		m := &table.ClientMetric{
+			Attachment: &table.ClientMetricAttachment{BizID: bizID, AppID: appID, UID: uid},
+			Spec:       &table.ClientMetricSpec{Granularity: table.ClientMetricHour, BucketTime: bucket},
+		}
+		index[key] = m
+		metrics = append(metrics, m)
+		return m
+	}
+
+	for _, c := range clients {
+		if c.GetMessageType() != sfs.Heartbeat.String() || c.GetAttachment() == nil || c.GetSpec() == nil ||
+			c.GetSpec().GetLastHeartbeatTime() == nil {
+			continue
+		}
+		m := get(c.Attachment.BizId, c.Attachment.AppId, c.Attachment.Uid, c.Spec.LastHeartbeatTime.AsTime())
+		res := c.Spec.GetResource()
+		m.Spec.SampleCount++
+		m.Spec.CpuSum += res.GetCpuUsage()
+		m.Spec.CpuMax = max(m.Spec.CpuMax, res.GetCpuUsage())
+		m.Spec.MemorySum += res.GetMemoryUsage()
+		m.Spec.MemoryMax = max(m.Spec.MemoryMax, res.GetMemoryUsage())
+		if c.Spec.ClientVersion != "" {
+			m.Spec.ClientVersion = c.Spec.ClientVersion
+		}
+	}
+
+	for _, e := range events {
+		if e.GetMessageType() != sfs.VersionChangeMessage.String() || e.GetAttachment() == nil || e.GetSpec() == nil {
+			continue
+		}
+		status := e.Spec.ReleaseChangeStatus
+		if status != sfs.Success.String() && status != sfs.Failed.String() {
+			continue
+		}
+		t := e.Spec.GetEndTime()
+		if t == nil || t.AsTime().IsZero() || t.AsTime().Unix() <= 0 {
+			t = e.GetHeartbeatTime()
+		}
+		if t == nil {
+			continue
+		}
+		m := get(e.Attachment.BizId, e.Attachment.AppId, e.Attachment.Uid, t.AsTime())
+		m.Spec.PullCount++
+		if status == sfs.Failed.String() {
+			m.Spec.PullFailedCount++
+		}
+		m.Spec.PullSeconds += e.Spec.TotalSeconds
+	}
+
+	return metrics
+}
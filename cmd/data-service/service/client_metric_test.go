@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbclient "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
+	pbce "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-event"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+)
+
+func TestBuildHourlyClientMetrics(t *testing.T) {
+	hour := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	heartbeat := func(uid string, at time.Duration, version string, cpu float64, mem uint64) *pbclient.Client {
+		return &pbclient.Client{
+			MessageType: sfs.Heartbeat.String(),
+			Attachment:  &pbclient.ClientAttachment{BizId: 1, AppId: 2, Uid: uid},
+			Spec: &pbclient.ClientSpec{
+				ClientVersion:     version,
+				LastHeartbeatTime: timestamppb.New(hour.Add(at)),
+				Resource:          &pbclient.ClientResource{CpuUsage: cpu, MemoryUsage: mem},
+			},
+		}
+	}
+	event := func(uid string, at time.Duration, status sfs.Status, seconds float64) *pbce.ClientEvent {
+		return &pbce.ClientEvent{
+			MessageType:   sfs.VersionChangeMessage.String(),
+			HeartbeatTime: timestamppb.New(hour.Add(at)),
+			Attachment:    &pbce.ClientEventAttachment{BizId: 1, AppId: 2, Uid: uid},
+			Spec:          &pbce.ClientEventSpec{ReleaseChangeStatus: status.String(), TotalSeconds: seconds},
+		}
+	}
+
+	clients := []*pbclient.Client{
+		heartbeat("a", time.Minute, "v1.0.0", 0.2, 100),
+		heartbeat("a", 30*time.Minute, "v1.1.0", 0.6, 50),
+		heartbeat("a", 70*time.Minute, "v1.1.0", 0.1, 10),
+		heartbeat("b", 10*time.Minute, "v1.0.0", 0.3, 30),
+		// 非心跳消息不计入资源采样
+		{MessageType: sfs.VersionChangeMessage.String(), Attachment: &pbclient.ClientAttachment{BizId: 1, AppId: 2,
+			Uid: "a"}, Spec: &pbclient.ClientSpec{LastHeartbeatTime: timestamppb.New(hour)}},
+	}
+	events := []*pbce.ClientEvent{
+		event("a", 5*time.Minute, sfs.Success, 2),
+		event("a", 6*time.Minute, sfs.Failed, 4),
+		// 处理中的拉取事件不计入拉取统计
+		event("a", 7*time.Minute, sfs.Processing, 8),
+		event("c", 20*time.Minute, sfs.Success, 1),
+	}
+
+	metrics := buildHourlyClientMetrics(clients, events)
+	got := make(map[string]int)
+	for i, m := range metrics {
+		got[m.Attachment.UID+"@"+m.Spec.BucketTime.Format("15")] = i
+	}
+
+	cases := []struct {
+		name        string
+		key         string
+		version     string
+		samples     uint32
+		cpuSum      float64
+		cpuMax      float64
+		memorySum   uint64
+		memoryMax   uint64
+		pullCount   uint32
+		pullFailed  uint32
+		pullSeconds float64
+	}{
+		{
+			name: "同一小时内的心跳和拉取事件累加到同一个指标桶", key: "a@12", version: "v1.1.0", samples: 2,
+			cpuSum: 0.8, cpuMax: 0.6, memorySum: 150, memoryMax: 100, pullCount: 2, pullFailed: 1, pullSeconds: 6,
+		},
+		{
+			name: "跨小时的心跳写入新的指标桶", key: "a@13", version: "v1.1.0", samples: 1,
+			cpuSum: 0.1, cpuMax: 0.1, memorySum: 10, memoryMax: 10,
+		},
+		{
+			name: "只有心跳的客户端", key: "b@12", version: "v1.0.0", samples: 1,
+			cpuSum: 0.3, cpuMax: 0.3, memorySum: 30, memoryMax: 30,
+		},
+		{
+			name: "只有拉取事件的客户端", key: "c@12", pullCount: 1, pullSeconds: 1,
+		},
+	}
+
+	if len(metrics) != len(cases) {
+		t.Fatalf("expect %d metrics, but got %d", len(cases), len(metrics))
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			i, ok := got[c.key]
+			if !ok {
+				t.Fatalf("metric %s not found", c.key)
+			}
+			s := metrics[i].Spec
+			if s.ClientVersion != c.version || s.SampleCount != c.samples || s.CpuMax != c.cpuMax ||
+				s.MemorySum != c.memorySum || s.MemoryMax != c.memoryMax || s.PullCount != c.pullCount ||
+				s.PullFailedCount != c.pullFailed || s.PullSeconds != c.pullSeconds {
+				t.Errorf("unexpected metric %s: %+v", c.key, s)
+			}
+			if diff := s.CpuSum - c.cpuSum; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("expect cpu sum %v, but got %v", c.cpuSum, s.CpuSum)
+			}
+		})
+	}
+}
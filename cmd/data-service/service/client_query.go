@@ -16,11 +16,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
@@ -176,7 +179,21 @@ func (s *Service) DeleteClientQuery(ctx context.Context, req *pbds.DeleteClientQ
 	*pbbase.EmptyResp, error) {
 	grpcKit := kit.FromGrpcContext(ctx)
 
-	err := s.dao.ClientQuery().Delete(grpcKit, &table.ClientQuery{
+	// 被动态分组引用的查询不允许删除，否则分组在发布时无法解析成员
+	groups, err := s.dao.Group().ListByClientQueryID(grpcKit, req.BizId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) > 0 {
+		names := make([]string, 0, len(groups))
+		for _, g := range groups {
+			names = append(names, g.Spec.Name)
+		}
+		return nil, errf.New(errf.InvalidParameter,
+			fmt.Sprintf("client query is used by dynamic groups [%s]", strings.Join(names, ",")))
+	}
+
+	err = s.dao.ClientQuery().Delete(grpcKit, &table.ClientQuery{
 		ID: req.Id,
 		Attachment: &table.ClientQueryAttachment{
 			BizID: req.BizId,
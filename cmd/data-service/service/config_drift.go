@@ -0,0 +1,295 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
+	"github.com/TencentBlueKing/bk-bscp/internal/task"
+	"github.com/TencentBlueKing/bk-bscp/internal/task/builder/config"
+	executorCommon "github.com/TencentBlueKing/bk-bscp/internal/task/executor/common"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcin "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-instance"
+	pbproc "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/process"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// RepushDriftedConfig implements pbds.DataServer.
+// RepushDriftedConfig 将漂移的配置实例按其最后一次下发的内容重新下发
+// nolint:funlen
+func (s *Service) RepushDriftedConfig(ctx context.Context, req *pbds.RepushDriftedConfigReq) (
+	*pbds.RepushDriftedConfigResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if req.GetConfigTemplateId() == 0 {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "config template id is required"))
+	}
+
+	configTemplate, err := s.dao.ConfigTemplate().GetByID(kt, req.GetBizId(), req.GetConfigTemplateId())
+	if err != nil {
+		return nil, errf.Errorf(errf.DBOpFailed, "%s",
+			i18n.T(kt, "get config template failed, err: %v", err))
+	}
+
+	// 查询漂移的配置实例
+	driftedInstances, _, err := s.dao.ConfigInstance().List(kt, req.GetBizId(), &dao.ConfigInstanceSearchCondition{
+		ConfigTemplateId: req.GetConfigTemplateId(),
+		CcProcessIds:     req.GetCcProcessIds(),
+		DriftStatus:      table.ConfigDriftDrifted,
+	}, &types.BasePage{All: true})
+	if err != nil {
+		return nil, errf.Errorf(errf.DBOpFailed, "%s",
+			i18n.T(kt, "list config instances failed, err: %v", err))
+	}
+	if len(driftedInstances) == 0 {
+		return nil, errf.Errorf(errf.RecordNotFound, "%s",
+			i18n.T(kt, "no drifted config instances found for config template %d", req.GetConfigTemplateId()))
+	}
+
+	configTemplateIDs := []uint32{req.GetConfigTemplateId()}
+	if err = validateOperate(s.dao, kt, req.GetBizId(), configTemplateIDs, nil); err != nil {
+		return nil, err
+	}
+
+	// 查询关联的进程、进程实例及配置实例当前的版本
+	ccProcessIDs := make([]uint32, 0, len(driftedInstances))
+	versionIDs := make([]uint32, 0, len(driftedInstances))
+	for _, ci := range driftedInstances {
+		ccProcessIDs = append(ccProcessIDs, ci.Attachment.CcProcessID)
+		versionIDs = append(versionIDs, ci.Attachment.ConfigVersionID)
+	}
+	processes, _, err := s.dao.Process().List(kt, req.GetBizId(), &pbproc.ProcessSearchCondition{
+		CcProcessIds: tools.RemoveDuplicates(ccProcessIDs),
+	}, &types.BasePage{All: true})
+	if err != nil {
+		return nil, errf.Errorf(errf.DBOpFailed, "%s",
+			i18n.T(kt, "get process failed, err: %v", err))
+	}
+	processInstances, err := getProcessInstances(kt, s.dao, req.GetBizId(), processes)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := s.dao.TemplateRevision().ListByIDs(kt, tools.RemoveDuplicates(versionIDs))
+	if err != nil {
+		return nil, errf.Errorf(errf.DBOpFailed, "%s",
+			i18n.T(kt, "list template revisions failed, err: %v", err))
+	}
+
+	processMap := make(map[uint32]*table.Process, len(processes))
+	for _, p := range processes {
+		processMap[p.Attachment.CcProcessID] = p
+	}
+	processInstanceMap := make(map[string]*table.ProcessInstance, len(processInstances))
+	for _, inst := range processInstances {
+		key := buildConfigInstanceKey(inst.Attachment.CcProcessID, req.GetConfigTemplateId(), inst.Spec.ModuleInstSeq)
+		processInstanceMap[key] = inst
+	}
+	revisionMap := make(map[uint32]*table.TemplateRevision, len(revisions))
+	for _, r := range revisions {
+		revisionMap[r.ID] = r
+	}
+
+	// 构建下发任务的 payload，进程或版本已被删除的配置实例不再下发
+	payloads := make([]*executorCommon.TaskPayload, 0, len(driftedInstances))
+	pushedProcesses := make([]*table.Process, 0, len(driftedInstances))
+	for _, ci := range driftedInstances {
+		key := buildConfigInstanceKey(ci.Attachment.CcProcessID, ci.Attachment.ConfigTemplateID, ci.Attachment.ModuleInstSeq)
+		process, ok := processMap[ci.Attachment.CcProcessID]
+		inst, instOk := processInstanceMap[key]
+		revision, revOk := revisionMap[ci.Attachment.ConfigVersionID]
+		if !ok || !instOk || !revOk {
+			logs.Warnf("skip drifted config instance %d, process, process instance or revision not found, rid: %s",
+				ci.ID, kt.Rid)
+			continue
+		}
+
+		payload := executorCommon.BuildConfigTaskPayload(process, inst, revision, configTemplate.ID, configTemplate.Spec.Name)
+		payload.ConfigPayload.ConfigContent = ci.Attachment.Content
+		payload.ConfigPayload.ConfigContentSignature = tools.SHA256(ci.Attachment.Content)
+		payloads = append(payloads, payload)
+		pushedProcesses = append(pushedProcesses, process)
+	}
+	if len(payloads) == 0 {
+		return nil, errf.Errorf(errf.RecordNotFound, "%s",
+			i18n.T(kt, "no drifted config instances found for config template %d", req.GetConfigTemplateId()))
+	}
+
+	// 创建任务批次
+	now := time.Now()
+	batch := &table.TaskBatch{
+		Attachment: &table.TaskBatchAttachment{TenantID: kt.TenantID, BizID: req.GetBizId()},
+		Spec: &table.TaskBatchSpec{
+			TaskObject: table.TaskObjectConfigFile,
+			TaskAction: table.TaskActionConfigPublish,
+			Status:     table.TaskBatchStatusRunning,
+			StartAt:    &now,
+			TotalCount: uint32(len(payloads)),
+			ExtraData:  "{}",
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	batch.Spec.SetTaskData(&table.TaskExecutionData{
+		Environment:       pushedProcesses[0].Spec.Environment,
+		OperateRange:      s.buildOperateRange(pushedProcesses, false, nil),
+		ConfigTemplateIDs: configTemplateIDs,
+	})
+	batchID, err := s.dao.TaskBatch().Create(kt, batch)
+	if err != nil {
+		return nil, errf.Errorf(errf.DBOpFailed, "%s",
+			i18n.T(kt, "create batch failed, err: %v", err))
+	}
+
+	// 分发任务
+	var dispatched uint32
+	defer func() {
+		if failed := uint32(len(payloads)) - dispatched; failed > 0 {
+			logs.Warnf("batch %d partially dispatched: %d/%d tasks, %d failed, rid: %s",
+				batchID, dispatched, len(payloads), failed, kt.Rid)
+			if err := s.dao.TaskBatch().AddFailedCount(kt, batchID, failed); err != nil {
+				logs.Errorf("add failed count for batch %d failed, err: %v, rid: %s", batchID, err, kt.Rid)
+			}
+		}
+	}()
+
+	for _, payload := range payloads {
+		taskObj, err := task.NewByTaskBuilder(
+			config.NewPushConfigTask(
+				s.dao,
+				kt.TenantID,
+				req.GetBizId(),
+				batchID,
+				table.ConfigOperateType(table.TaskActionConfigPublish),
+				kt.User,
+				payload,
+			),
+		)
+		if err != nil {
+			logs.Errorf("create repush config task failed, config_key: %s, err: %v, rid: %s",
+				payload.ConfigPayload.ConfigInstanceKey, err, kt.Rid)
+			continue
+		}
+
+		s.taskManager.Dispatch(taskObj)
+		dispatched++
+	}
+
+	logs.Infof("repush drifted config batch created, batch_id: %d, config_template_id: %d, task_count: %d, rid: %s",
+		batchID, req.GetConfigTemplateId(), dispatched, kt.Rid)
+
+	return &pbds.RepushDriftedConfigResp{BatchId: batchID}, nil
+}
+
+// ScanConfigDrift 对已下发的配置实例发起配置检查，检查结果会记录到配置实例的漂移状态中
+func (s *Service) ScanConfigDrift(kt *kit.Kit) error {
+	bizIDs, err := s.dao.ConfigInstance().ListBizIDs(kt)
+	if err != nil {
+		return err
+	}
+
+	for _, bizID := range bizIDs {
+		if err := s.scanBizConfigDrift(kt, bizID); err != nil {
+			logs.Errorf("scan config drift for biz %d failed, err: %v, rid: %s", bizID, err, kt.Rid)
+		}
+	}
+
+	return nil
+}
+
+// scanBizConfigDrift 按配置模版为业务下已下发的配置实例创建配置检查任务
+func (s *Service) scanBizConfigDrift(kt *kit.Kit, bizID uint32) error {
+	configInstances, _, err := s.dao.ConfigInstance().List(kt, bizID, nil, &types.BasePage{All: true})
+	if err != nil {
+		return err
+	}
+
+	// 按配置模版分组已下发过的进程
+	templateProcessIDs := make(map[uint32][]uint32)
+	for _, ci := range configInstances {
+		if ci.Attachment.ConfigVersionID == 0 {
+			continue
+		}
+		templateProcessIDs[ci.Attachment.ConfigTemplateID] = append(
+			templateProcessIDs[ci.Attachment.ConfigTemplateID], ci.Attachment.CcProcessID)
+	}
+
+	for configTemplateID, ccProcessIDs := range templateProcessIDs {
+		hasRunning, err := s.dao.TaskBatch().HasRunningConfigPushTasks(kt, bizID, []uint32{configTemplateID})
+		if err != nil {
+			return err
+		}
+		if hasRunning {
+			logs.Infof("skip scan config drift for config template %d, push task is running, rid: %s",
+				configTemplateID, kt.Rid)
+			continue
+		}
+
+		configTemplate, err := s.dao.ConfigTemplate().GetByID(kt, bizID, configTemplateID)
+		if err != nil {
+			logs.Errorf("get config template %d failed, err: %v, rid: %s", configTemplateID, err, kt.Rid)
+			continue
+		}
+
+		latestRevision, err := s.dao.TemplateRevision().GetLatestTemplateRevision(kt, bizID,
+			configTemplate.Attachment.TemplateID)
+		if err != nil {
+			logs.Errorf("get latest template revision for config template %d failed, err: %v, rid: %s",
+				configTemplateID, err, kt.Rid)
+			continue
+		}
+
+		// 只检查仍与配置模版绑定且未被删除的进程
+		processes, err := getFilteredProcesses(kt, bizID, s.dao, configTemplate, &pbcin.ConfigInstanceSearchCondition{
+			CcProcessIds: tools.RemoveDuplicates(ccProcessIDs),
+		})
+		if err != nil {
+			logs.Errorf("get processes for config template %d failed, err: %v, rid: %s", configTemplateID, err, kt.Rid)
+			continue
+		}
+		if len(processes) == 0 {
+			continue
+		}
+		boundProcessIDs := make([]uint32, 0, len(processes))
+		for _, p := range processes {
+			boundProcessIDs = append(boundProcessIDs, p.Attachment.CcProcessID)
+		}
+
+		batchID, err := s.runConfigTask(kt, bizID, []*pbcin.ConfigTemplateGroup{{
+			ConfigTemplateId:        configTemplateID,
+			ConfigTemplateVersionId: latestRevision.ID,
+			CcProcessIds:            boundProcessIDs,
+		}}, ConfigTaskCheck, false, nil)
+		if err != nil {
+			logs.Errorf("run config check task for config template %d failed, err: %v, rid: %s",
+				configTemplateID, err, kt.Rid)
+			continue
+		}
+
+		logs.Infof("scan config drift, biz_id: %d, config_template_id: %d, batch_id: %d, rid: %s",
+			bizID, configTemplateID, batchID, kt.Rid)
+	}
+
+	return nil
+}
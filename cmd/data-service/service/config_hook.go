@@ -14,9 +14,9 @@ package service
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -59,10 +59,10 @@ func (s *Service) UpdateConfigHook(ctx context.Context, req *pbds.UpdateConfigHo
 	}
 
 	if req.PreHookId > 0 {
-		hook, err := s.getReleasedHook(kt, preHook)
+		hook, err := s.getReleasedHook(kt, preHook, req.PreHookParams)
 		if err != nil {
 			logs.Errorf("no released releases of the pre-hook, err: %v, rid: %s", err, kt.Rid)
-			return nil, errors.New("no released releases of the pre-hook")
+			return nil, err
 		}
 
 		if err = s.dao.ReleasedHook().UpsertWithTx(kt, tx, hook); err != nil {
@@ -77,7 +77,7 @@ func (s *Service) UpdateConfigHook(ctx context.Context, req *pbds.UpdateConfigHo
 	}
 
 	if req.PostHookId > 0 {
-		hook, err := s.getReleasedHook(kt, postHook)
+		hook, err := s.getReleasedHook(kt, postHook, req.PostHookParams)
 		if err != nil {
 			logs.Errorf("get post-hook failed, err: %v, rid: %s", err, kt.Rid)
 			return nil, err
@@ -102,8 +102,9 @@ func (s *Service) UpdateConfigHook(ctx context.Context, req *pbds.UpdateConfigHo
 	return new(pbbase.EmptyResp), nil
 }
 
-// getReleasedHook ...
-func (s *Service) getReleasedHook(kt *kit.Kit, rh *table.ReleasedHook) (*table.ReleasedHook, error) {
+// getReleasedHook get the published revision of the hook bound by the app with the param values.
+func (s *Service) getReleasedHook(kt *kit.Kit, rh *table.ReleasedHook, values map[string]string) (
+	*table.ReleasedHook, error) {
 
 	h, err := s.dao.Hook().GetByID(kt, rh.BizID, rh.HookID)
 	if err != nil {
@@ -122,6 +123,11 @@ func (s *Service) getReleasedHook(kt *kit.Kit, rh *table.ReleasedHook) (*table.R
 		return nil, fmt.Errorf("no released releases of the %s", rh.HookType.String())
 	}
 
+	params, err := hr.Spec.BindParams(kt, values)
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "bind params of the %s failed, %v", rh.HookType.String(), err)
+	}
+
 	return &table.ReleasedHook{
 		BizID:            rh.BizID,
 		AppID:            rh.AppID,
@@ -133,6 +139,34 @@ func (s *Service) getReleasedHook(kt *kit.Kit, rh *table.ReleasedHook) (*table.R
 		Content:          hr.Spec.Content,
 		ScriptType:       h.Spec.Type,
 		HookType:         rh.HookType,
+		Params:           params,
 		Reviser:          kt.User,
 	}, nil
 }
+
+// renderReleasedHook render the hook content with the param values bound by the app when creating a release,
+// the params which are added to the hook revision after binding use the default values.
+func (s *Service) renderReleasedHook(kt *kit.Kit, rh *table.ReleasedHook) error {
+	hr, err := s.dao.HookRevision().Get(kt, rh.BizID, rh.HookID, rh.HookRevisionID)
+	if err != nil {
+		logs.Errorf("get hook revision %d failed, err: %v, rid: %s", rh.HookRevisionID, err, kt.Rid)
+		return err
+	}
+	if len(hr.Spec.Params) == 0 {
+		rh.Params = table.AppVariables{}
+		return nil
+	}
+
+	params, err := hr.Spec.BindParams(kt, rh.ParamValues())
+	if err != nil {
+		return errf.Errorf(errf.InvalidParameter, "render params of the %s failed, %v", rh.HookType.String(), err)
+	}
+	kv := make(map[string]interface{}, len(params))
+	for _, p := range params {
+		kv[p.Name] = p.DefaultVal
+	}
+
+	rh.Params = params
+	rh.Content = string(s.tmplProc.Render([]byte(rh.Content), kv))
+	return nil
+}
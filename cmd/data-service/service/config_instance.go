@@ -127,6 +127,9 @@ func (s *Service) ListConfigInstances(ctx context.Context, req *pbds.ListConfigI
 	// 根据配置模版版本过滤配置实例
 	finalConfigInstances = filterConfigInstancesByVersion(finalConfigInstances, req.ConfigTemplateVersionIds)
 
+	// 根据漂移状态过滤配置实例
+	finalConfigInstances = filterConfigInstancesByDriftStatus(finalConfigInstances, req.GetDriftStatus())
+
 	// 获取关联数据
 	relatedData, err := getRelatedData(kt, s.dao, configTemplate, finalConfigInstances)
 	if err != nil {
@@ -676,6 +679,22 @@ func filterConfigInstancesByVersion(configInstances []*table.ConfigInstance, con
 	return filteredInstances
 }
 
+// filterConfigInstancesByDriftStatus 根据漂移状态过滤配置实例
+func filterConfigInstancesByDriftStatus(configInstances []*table.ConfigInstance, driftStatus string) []*table.ConfigInstance {
+	if driftStatus == "" {
+		return configInstances
+	}
+
+	filteredInstances := make([]*table.ConfigInstance, 0, len(configInstances))
+	for _, ci := range configInstances {
+		if ci.Attachment != nil && string(ci.Attachment.DriftStatus) == driftStatus {
+			filteredInstances = append(filteredInstances, ci)
+		}
+	}
+
+	return filteredInstances
+}
+
 // buildFilterOptions 构建过滤选项
 func buildFilterOptions(kt *kit.Kit, dao dao.Set, configTemplate *table.ConfigTemplate,
 	configInstances []*table.ConfigInstance) (*pbcin.ConfigInstanceFilterOptions, error) {
@@ -988,6 +1007,12 @@ func (s *Service) PreviewConfig(ctx context.Context, req *pbds.PreviewConfigReq)
 		return nil, errf.Errorf(errf.Internal, "%s",
 			i18n.T(grpcKit, "expand ginclude failed, err: %v", err))
 	}
+	templateContent, err = executorCommon.RenderTemplateVariables(grpcKit, s.dao, s.vault, req.GetBizId(),
+		templateContent)
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, "%s",
+			i18n.T(grpcKit, "render template variables failed, err: %v", err))
+	}
 
 	// 6. 渲染模板
 	renderedContent, err := render.Template(templateContent, contextParams)
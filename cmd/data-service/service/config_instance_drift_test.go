@@ -0,0 +1,55 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func TestFilterConfigInstancesByDriftStatus(t *testing.T) {
+	instance := func(id uint32, status table.ConfigDriftStatus) *table.ConfigInstance {
+		return &table.ConfigInstance{ID: id, Attachment: &table.ConfigInstanceAttachment{DriftStatus: status}}
+	}
+	instances := []*table.ConfigInstance{
+		instance(1, table.ConfigDriftConsistent),
+		instance(2, table.ConfigDriftDrifted),
+		instance(3, table.ConfigDriftUnchecked),
+		{ID: 4},
+	}
+
+	cases := []struct {
+		name        string
+		driftStatus string
+		want        []uint32
+	}{
+		{name: "未指定漂移状态不过滤", driftStatus: "", want: []uint32{1, 2, 3, 4}},
+		{name: "只保留漂移的配置实例", driftStatus: string(table.ConfigDriftDrifted), want: []uint32{2}},
+		{name: "只保留一致的配置实例", driftStatus: string(table.ConfigDriftConsistent), want: []uint32{1}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := filterConfigInstancesByDriftStatus(instances, c.driftStatus)
+			if len(got) != len(c.want) {
+				t.Fatalf("filterConfigInstancesByDriftStatus(%q) got %d instances, want %d",
+					c.driftStatus, len(got), len(c.want))
+			}
+			for i, ci := range got {
+				if ci.ID != c.want[i] {
+					t.Fatalf("filterConfigInstancesByDriftStatus(%q)[%d] = %d, want %d", c.driftStatus, i, ci.ID, c.want[i])
+				}
+			}
+		})
+	}
+}
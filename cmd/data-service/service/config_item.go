@@ -236,7 +236,7 @@ func (s *Service) BatchUpsertConfigItems(ctx context.Context, req *pbds.BatchUps
 	}
 
 	// 7. 添加非模板配置文件以及文件内容
-	createIds, e := s.doBatchCreateConfigItems(grpcKit, tx, toCreate, now, req.BizId, req.AppId)
+	createIds, e := s.doBatchCreateConfigItems(grpcKit, tx, toCreate, now, req.BizId, req.AppId, req.CommitMemo)
 	if e != nil {
 		logs.Errorf("do batch create config items failed, err: %v, rid: %s", e, grpcKit.Rid)
 		return nil, e
@@ -248,7 +248,7 @@ func (s *Service) BatchUpsertConfigItems(ctx context.Context, req *pbds.BatchUps
 		return nil, e
 	}
 	if e := s.doBatchUpdateConfigItemContent(grpcKit, tx, toUpdateContent, now,
-		req.BizId, req.AppId, editingCIMap); e != nil {
+		req.BizId, req.AppId, editingCIMap, req.CommitMemo); e != nil {
 		logs.Errorf("do batch update config item content failed, err: %v, rid: %s", e, grpcKit.Rid)
 		return nil, e
 	}
@@ -695,7 +695,8 @@ func (s *Service) checkConfigItems(kt *kit.Kit, req *pbds.BatchUpsertConfigItems
 }
 
 func (s *Service) doBatchCreateConfigItems(kt *kit.Kit, tx *gen.QueryTx,
-	toCreate []*pbds.BatchUpsertConfigItemsReq_ConfigItem, now time.Time, bizID, appID uint32) ([]uint32, error) {
+	toCreate []*pbds.BatchUpsertConfigItemsReq_ConfigItem, now time.Time, bizID, appID uint32, memo string) (
+	[]uint32, error) {
 	createIds := []uint32{}
 	toCreateConfigItems := []*table.ConfigItem{}
 	for _, item := range toCreate {
@@ -741,6 +742,7 @@ func (s *Service) doBatchCreateConfigItems(kt *kit.Kit, tx *gen.QueryTx,
 			Spec: &table.CommitSpec{
 				ContentID: toCreateContent[i].ID,
 				Content:   toCreateContent[i].Spec,
+				Memo:      memo,
 			},
 			Attachment: &table.CommitAttachment{
 				BizID:        bizID,
@@ -793,7 +795,7 @@ func (s *Service) doBatchUpdateConfigItemSpec(kt *kit.Kit, tx *gen.QueryTx,
 
 func (s *Service) doBatchUpdateConfigItemContent(kt *kit.Kit, tx *gen.QueryTx,
 	toUpdate []*pbds.BatchUpsertConfigItemsReq_ConfigItem, now time.Time,
-	bizID, appID uint32, ciMap map[string]*table.ConfigItem) error {
+	bizID, appID uint32, ciMap map[string]*table.ConfigItem, memo string) error {
 	toCreateContents := []*table.Content{}
 	for _, item := range toUpdate {
 		content := &table.Content{
@@ -821,6 +823,7 @@ func (s *Service) doBatchUpdateConfigItemContent(kt *kit.Kit, tx *gen.QueryTx,
 			Spec: &table.CommitSpec{
 				ContentID: toCreateContents[i].ID,
 				Content:   item.ContentSpec.ContentSpec(),
+				Memo:      memo,
 			},
 			Attachment: &table.CommitAttachment{
 				BizID:        bizID,
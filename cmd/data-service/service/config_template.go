@@ -20,11 +20,11 @@ import (
 	"strings"
 	"time"
 
-	"github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/components/bkcmdb"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
 	"github.com/TencentBlueKing/bk-bscp/internal/processor/cmdb"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
@@ -530,7 +530,7 @@ func (s *Service) createTemplateAndRevision(kit *kit.Kit, tx *gen.QueryTx, templ
 	}
 	if _, err = s.dao.TemplateRevision().CreateWithTx(kit, tx, templateRevision, false, false); err != nil {
 		logs.Errorf("create template revision failed, err: %v, rid: %s", err, kit.Rid)
-		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+		if utils.IsDuplicateKeyErr(err) {
 			return 0, errf.Errorf(errf.AlreadyExists, "%s",
 				i18n.T(kit, "version number %s already exists. Please change it and try again.",
 					templateRevision.Spec.RevisionName))
@@ -1043,7 +1043,7 @@ func (s *Service) UpdateConfigTemplate(ctx context.Context, req *pbds.UpdateConf
 	_, err = s.dao.TemplateRevision().CreateWithTx(grpcKit, tx, templateRevision, true, false)
 	if err != nil {
 		logs.Errorf("create template revision failed, err: %v, rid: %s", err, grpcKit.Rid)
-		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+		if utils.IsDuplicateKeyErr(err) {
 			return nil, errf.Errorf(errf.AlreadyExists, "%s",
 				i18n.T(grpcKit, "version number %s already exists. Please change it and try again.",
 					templateRevision.Spec.RevisionName))
@@ -20,10 +20,10 @@ import (
 	"strings"
 	"time"
 
-	"github.com/gobwas/glob"
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -33,10 +33,14 @@ import (
 	pbcredential "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/credential"
 	pbtset "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/template-set"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/credential"
 	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 	"github.com/TencentBlueKing/bk-bscp/pkg/types"
 )
 
+// maxCredentialGracePeriod 轮换密钥时旧密钥继续有效的最长时间
+const maxCredentialGracePeriod = 30 * 24 * time.Hour
+
 // CreateCredential Create Credential
 func (s *Service) CreateCredential(ctx context.Context, req *pbds.CreateCredentialReq) (*pbds.CreateResp, error) {
 	kt := kit.FromGrpcContext(ctx)
@@ -45,6 +49,11 @@ func (s *Service) CreateCredential(ctx context.Context, req *pbds.CreateCredenti
 		return nil, fmt.Errorf("credential name %s already exists", req.Spec.Name)
 	}
 
+	expiredAt, err := req.Spec.ParseExpiredAt()
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%v", err)
+	}
+
 	credential := &table.Credential{
 		Spec:       req.Spec.CredentialSpec(),
 		Attachment: req.Attachment.CredentialAttachment(),
@@ -53,7 +62,7 @@ func (s *Service) CreateCredential(ctx context.Context, req *pbds.CreateCredenti
 			Reviser: kt.User,
 		},
 	}
-	credential.Spec.ExpiredAt = time.Now().UTC()
+	credential.Spec.ExpiredAt = expiredAt
 	id, err := s.dao.Credential().Create(kt, credential)
 	if err != nil {
 		logs.Errorf("create credential failed, err: %v, rid: %s", err, kt.Rid)
@@ -84,11 +93,17 @@ func (s *Service) ListCredentials(ctx context.Context, req *pbds.ListCredentialR
 		return nil, err
 	}
 	credentialScopes := map[uint32][]string{}
+	clientCounts := map[uint32]uint32{}
 	if count > 0 {
 		credentialID := []uint32{}
 		for _, v := range details {
 			credentialID = append(credentialID, v.ID)
 		}
+		// 获取使用密钥的客户端数量
+		clientCounts, err = s.dao.CredentialClient().CountByCredentialIDs(kt, req.BizId, credentialID)
+		if err != nil {
+			return nil, err
+		}
 		// 获取关联规则
 		item, err := s.dao.CredentialScope().ListByCredentialIDs(kt, credentialID, req.BizId)
 		if err != nil {
@@ -108,6 +123,7 @@ func (s *Service) ListCredentials(ctx context.Context, req *pbds.ListCredentialR
 
 	for _, v := range data {
 		v.CredentialScopes = credentialScopes[v.Id]
+		v.ClientCount = clientCounts[v.Id]
 	}
 
 	resp := &pbds.ListCredentialResp{
@@ -164,6 +180,11 @@ func (s *Service) UpdateCredential(ctx context.Context, req *pbds.UpdateCredenti
 		return nil, fmt.Errorf("credential name %s already exists", req.Spec.Name)
 	}
 
+	expiredAt, err := req.Spec.ParseExpiredAt()
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%v", err)
+	}
+
 	credential := &table.Credential{
 		ID:         req.Id,
 		Spec:       req.Spec.CredentialSpec(),
@@ -172,6 +193,7 @@ func (s *Service) UpdateCredential(ctx context.Context, req *pbds.UpdateCredenti
 			Reviser: kt.User,
 		},
 	}
+	credential.Spec.ExpiredAt = expiredAt
 	if e := s.dao.Credential().Update(kt, credential); e != nil {
 		logs.Errorf("update credential failed, err: %v, rid: %s", e, kt.Rid)
 		return nil, e
@@ -180,6 +202,72 @@ func (s *Service) UpdateCredential(ctx context.Context, req *pbds.UpdateCredenti
 	return new(pbbase.EmptyResp), nil
 }
 
+// RotateCredential rotate the credential, the previous one is still valid in the grace period.
+func (s *Service) RotateCredential(ctx context.Context, req *pbds.RotateCredentialReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	grace := time.Duration(req.GracePeriodSeconds) * time.Second
+	if grace > maxCredentialGracePeriod {
+		return nil, errf.Errorf(errf.InvalidParameter, "grace period should <= %s", maxCredentialGracePeriod)
+	}
+
+	if err := s.dao.Credential().Rotate(kt, req.BizId, req.Id, req.EncCredential, grace); err != nil {
+		logs.Errorf("rotate credential failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// BatchUpdateCredentialUsage 记录客户端使用密钥的时间, 由 cache-service 定时汇总 feed-server 上报的使用记录后调用
+func (s *Service) BatchUpdateCredentialUsage(ctx context.Context, req *pbds.BatchUpdateCredentialUsageReq) (
+	*pbds.BatchUpdateCredentialUsageResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	lastUsedAt := make(map[uint32]time.Time)
+	clients := make(map[string]*table.CredentialClient)
+	for _, u := range req.GetUsages() {
+		if u.CredentialId == 0 {
+			continue
+		}
+		usedAt := time.Unix(u.UsedAt, 0).UTC()
+		if usedAt.After(lastUsedAt[u.CredentialId]) {
+			lastUsedAt[u.CredentialId] = usedAt
+		}
+		if u.ClientUid == "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%d-%s", u.CredentialId, u.ClientUid)
+		if c, ok := clients[key]; ok {
+			if usedAt.After(c.Spec.LastUsedAt) {
+				c.Spec.LastUsedAt = usedAt
+			}
+			continue
+		}
+		clients[key] = &table.CredentialClient{
+			Attachment: &table.CredentialClientAttachment{BizID: u.BizId, CredentialID: u.CredentialId},
+			Spec:       &table.CredentialClientSpec{ClientUID: u.ClientUid, LastUsedAt: usedAt},
+		}
+	}
+
+	if err := s.dao.Credential().BatchUpdateLastUsedAt(kt, lastUsedAt); err != nil {
+		logs.Errorf("batch update credential last used time failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	list := make([]*table.CredentialClient, 0, len(clients))
+	for _, c := range clients {
+		list = append(list, c)
+	}
+	if err := s.dao.CredentialClient().BatchUpsert(kt, list); err != nil {
+		logs.Errorf("batch upsert credential clients failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.BatchUpdateCredentialUsageResp{}, nil
+}
+
 // CheckCredentialName Check if the credential name exists
 func (s *Service) CheckCredentialName(ctx context.Context, req *pbds.CheckCredentialNameReq) (
 	*pbds.CheckCredentialNameResp, error) {
@@ -216,7 +304,16 @@ func (s *Service) CredentialScopePreview(ctx context.Context, req *pbds.Credenti
 			return nil, err
 		}
 	} else {
-		preview, err = s.getKVConfigItems(kt, app.ID, req.BizId, req.Scope, req.SearchValue)
+		rule := &credential.Rule{
+			Scope:          credential.Scope(req.AppName + "/" + strings.TrimPrefix(req.Scope, "/")),
+			KvKeyPattern:   req.KvKeyPattern,
+			KvKeyMatchType: credential.MatchType(req.KvKeyMatchType),
+		}
+		if err = rule.Validate(); err != nil {
+			return nil, errf.Errorf(errf.InvalidParameter, "%v", err)
+		}
+
+		preview, err = s.getKVConfigItems(kt, app.ID, req.BizId, rule, req.SearchValue)
 		if err != nil {
 			return nil, err
 		}
@@ -282,15 +379,10 @@ func (s *Service) getFileConfileItems(kt *kit.Kit, appID, bizID uint32, scope, s
 }
 
 // 获取kv配置项
-func (s *Service) getKVConfigItems(kt *kit.Kit, appID, bizID uint32, scope, searchValue string) (
+func (s *Service) getKVConfigItems(kt *kit.Kit, appID, bizID uint32, rule *credential.Rule, searchValue string) (
 	[]*pbds.CredentialScopePreviewResp_Detail, error) {
 	preview := []*pbds.CredentialScopePreviewResp_Detail{}
 
-	scope = strings.TrimPrefix(scope, "/")
-	g, err := glob.Compile(scope)
-	if err != nil {
-		return preview, err
-	}
 	// 获取未删除的KV
 	kvState := []string{
 		string(table.KvStateAdd),
@@ -303,7 +395,7 @@ func (s *Service) getKVConfigItems(kt *kit.Kit, appID, bizID uint32, scope, sear
 	}
 
 	for _, v := range kv {
-		if !g.Match(v.Spec.Key) {
+		if !rule.MatchKv(v.Spec.Key) {
 			continue
 		}
 		if searchValue != "" && !strings.Contains(v.Spec.Key, searchValue) {
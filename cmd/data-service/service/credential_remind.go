@@ -0,0 +1,118 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/notifier"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// RemindCredentials 提醒密钥负责人即将过期和长期未使用的密钥
+func (s *Service) RemindCredentials(kt *kit.Kit, opt cc.RemindCredentialConfig) error {
+	now := time.Now().UTC()
+	expiringBefore := now.AddDate(0, 0, opt.ExpiringDays)
+	unusedSince := now.AddDate(0, 0, -opt.UnusedDays)
+
+	credentials, err := s.dao.Credential().ListToRemind(kt, expiringBefore, unusedSince)
+	if err != nil {
+		return err
+	}
+
+	for _, one := range credentials {
+		reason := credentialRemindReason(one, now, unusedSince)
+		if reason == "" {
+			continue
+		}
+		s.notifyCredential(kt, one, reason, now, opt.WebhookURL)
+	}
+
+	return nil
+}
+
+// CleanupCredentialClients 清理长期未使用密钥的客户端记录, 凭证客户端表没有租户字段, 不需要按租户清理
+func (s *Service) CleanupCredentialClients(kt *kit.Kit, before time.Time) (int64, error) {
+	return s.dao.CredentialClient().DeleteBefore(kt, before)
+}
+
+// credentialRemindReason 密钥需要提醒的原因, 即将过期优先
+func credentialRemindReason(c *table.Credential, now, unusedSince time.Time) string {
+	if c.Spec.ExpiredAt != nil && c.Spec.ExpiredAt.After(now) {
+		return fmt.Sprintf("expiring at %s", c.Spec.ExpiredAt.UTC().Format(time.RFC3339))
+	}
+
+	if c.Spec.LastUsedAt == nil {
+		if c.Revision.CreatedAt.Before(unusedSince) {
+			return fmt.Sprintf("never used since created at %s", c.Revision.CreatedAt.UTC().Format(time.RFC3339))
+		}
+		return ""
+	}
+
+	if c.Spec.LastUsedAt.Before(unusedSince) {
+		return fmt.Sprintf("not used since %s", c.Spec.LastUsedAt.UTC().Format(time.RFC3339))
+	}
+
+	return ""
+}
+
+// notifyCredential 通过推送平台通知密钥的创建人和最近修改人, 配置了 webhook 时同时回调
+func (s *Service) notifyCredential(kt *kit.Kit, c *table.Credential, reason string, now time.Time,
+	webhookURL string) {
+	receivers := make([]string, 0, 2)
+	for _, u := range []string{c.Revision.Creator, c.Revision.Reviser} {
+		if u == "" || u == constant.BKSystemUser {
+			continue
+		}
+		if len(receivers) == 0 || receivers[0] != u {
+			receivers = append(receivers, u)
+		}
+	}
+
+	msg := &notifier.Message{
+		Title:   fmt.Sprintf("[BSCP] credential reminder: %s", c.Spec.Name),
+		Content: fmt.Sprintf("biz: %d\ncredential: %s\n%s", c.Attachment.BizID, c.Spec.Name, reason),
+		Status:  "reminder",
+		Labels: map[string]string{
+			"biz_id":        strconv.Itoa(int(c.Attachment.BizID)),
+			"credential_id": strconv.Itoa(int(c.ID)),
+		},
+		FiredAt:    now,
+		WebhookURL: webhookURL,
+		Receivers:  receivers,
+	}
+
+	channels := make([]table.ClientAlertNotifier, 0, 2)
+	if len(receivers) != 0 {
+		channels = append(channels, table.ClientAlertNotifierPushManager)
+	}
+	if webhookURL != "" {
+		channels = append(channels, table.ClientAlertNotifierWebhook)
+	}
+
+	for _, name := range channels {
+		n, ok := s.notifiers[name]
+		if !ok {
+			continue
+		}
+		if err := n.Notify(kt.Ctx, msg); err != nil {
+			logs.Errorf("notify credential %d reminder through %s failed, err: %v, rid: %s", c.ID, name, err, kt.Rid)
+		}
+	}
+}
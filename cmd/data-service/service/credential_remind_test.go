@@ -0,0 +1,81 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func TestCredentialRemindReason(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	unusedSince := now.AddDate(0, 0, -30)
+	at := func(d time.Duration) *time.Time {
+		v := now.Add(d)
+		return &v
+	}
+	credential := func(expiredAt, lastUsedAt *time.Time, createdAt time.Time) *table.Credential {
+		return &table.Credential{
+			Spec:     &table.CredentialSpec{ExpiredAt: expiredAt, LastUsedAt: lastUsedAt},
+			Revision: &table.Revision{CreatedAt: createdAt},
+		}
+	}
+
+	cases := []struct {
+		name   string
+		cred   *table.Credential
+		prefix string
+	}{
+		{"expiring", credential(at(48*time.Hour), at(-time.Hour), now), "expiring at"},
+		{"recently used", credential(nil, at(-time.Hour), now.AddDate(-1, 0, 0)), ""},
+		{"not used", credential(nil, at(-40*24*time.Hour), now.AddDate(-1, 0, 0)), "not used since"},
+		{"never used", credential(nil, nil, now.AddDate(0, 0, -31)), "never used"},
+		{"new credential", credential(nil, nil, now.Add(-time.Hour)), ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			reason := credentialRemindReason(c.cred, now, unusedSince)
+			if c.prefix == "" {
+				if reason != "" {
+					t.Fatalf("expect no reminder, got %q", reason)
+				}
+				return
+			}
+			if !strings.HasPrefix(reason, c.prefix) {
+				t.Fatalf("expect reason with prefix %q, got %q", c.prefix, reason)
+			}
+		})
+	}
+}
+
+func TestCredentialSpecGracePeriod(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	spec := &table.CredentialSpec{PreviousEncCredential: "old", PreviousExpiredAt: &later}
+	if !spec.InGracePeriod(now) {
+		t.Fatal("previous credential should be valid in grace period")
+	}
+	if spec.InGracePeriod(later) {
+		t.Fatal("previous credential should expire at the end of grace period")
+	}
+	if spec.IsExpired(now) {
+		t.Fatal("credential without expiry should never expire")
+	}
+	spec.ExpiredAt = &now
+	if !spec.IsExpired(now) {
+		t.Fatal("credential should be expired at its expiry")
+	}
+}
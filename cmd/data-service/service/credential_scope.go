@@ -15,10 +15,10 @@ package service
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -79,12 +79,14 @@ func (s *Service) UpdateCredentialScopes(ctx context.Context,
 			return nil, err
 		}
 
+		spec := &table.CredentialScopeSpec{CredentialScope: credScope}
+		if err := updated.Restriction.ApplyTo(spec); err != nil {
+			return nil, errf.Errorf(errf.InvalidParameter, "%v", err)
+		}
+
 		credentialScope := &table.CredentialScope{
-			ID: updated.Id,
-			Spec: &table.CredentialScopeSpec{
-				CredentialScope: credScope,
-				ExpiredAt:       time.Now().UTC(),
-			},
+			ID:   updated.Id,
+			Spec: spec,
 			Attachment: &table.CredentialScopeAttachment{
 				BizID:        req.BizId,
 				CredentialId: req.CredentialId,
@@ -111,11 +113,13 @@ func (s *Service) UpdateCredentialScopes(ctx context.Context,
 			return nil, err
 		}
 
+		spec := &table.CredentialScopeSpec{CredentialScope: credScope}
+		if err := created.Restriction.ApplyTo(spec); err != nil {
+			return nil, errf.Errorf(errf.InvalidParameter, "%v", err)
+		}
+
 		credentialScope := &table.CredentialScope{
-			Spec: &table.CredentialScopeSpec{
-				CredentialScope: credScope,
-				ExpiredAt:       time.Now().UTC(),
-			},
+			Spec: spec,
 			Attachment: &table.CredentialScopeAttachment{
 				BizID:        req.BizId,
 				CredentialId: req.CredentialId,
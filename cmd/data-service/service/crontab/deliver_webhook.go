@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkuser"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	defaultDeliverWebhookInterval = 10 * time.Second
+)
+
+// NewDeliverWebhook init deliver webhook task
+func NewDeliverWebhook(sd serviced.Service, svc *service.Service, interval time.Duration,
+	opt cc.DeliverWebhookConfig) *deliverWebhook {
+	if interval <= 0 {
+		interval = defaultDeliverWebhookInterval
+	}
+	return &deliverWebhook{
+		state:    sd,
+		svc:      svc,
+		interval: interval,
+		opt:      opt,
+	}
+}
+
+// deliverWebhook 定时投递到期的 webhook 事件，失败的投递按指数退避重试
+type deliverWebhook struct {
+	state    serviced.Service
+	svc      *service.Service
+	interval time.Duration
+	opt      cc.DeliverWebhookConfig
+}
+
+// Run the deliver webhook task
+func (d *deliverWebhook) Run() {
+	logs.Infof("[deliverWebhook] start deliver webhook task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-notifier.Signal:
+				logs.Infof("[deliverWebhook] stop deliver webhook task success")
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !d.state.IsMaster() {
+					continue
+				}
+
+				d.deliverByTenant()
+			}
+		}
+	}()
+}
+
+// deliverByTenant 按租户投递 webhook 事件
+func (d *deliverWebhook) deliverByTenant() {
+	// 单租户模式：使用空租户ID
+	if !cc.DataService().FeatureFlags.EnableMultiTenantMode {
+		d.deliver(kit.New())
+		return
+	}
+
+	// 多租户模式：获取所有启用的租户并逐个投递
+	tenants, err := bkuser.ListEnabledTenants(kit.New().Ctx)
+	if err != nil {
+		logs.Errorf("[deliverWebhook] list enabled tenants failed, err: %v", err)
+		return
+	}
+	for _, tenant := range tenants {
+		d.deliver(kit.NewWithTenant(tenant.ID))
+	}
+}
+
+func (d *deliverWebhook) deliver(kt *kit.Kit) {
+	kt.User = constant.BKSystemUser
+	if err := d.svc.DeliverWebhooks(kt, d.opt); err != nil {
+		logs.Errorf("[deliverWebhook] deliver webhook failed, tenant: %s, err: %v, rid: %s",
+			kt.TenantID, err, kt.Rid)
+	}
+}
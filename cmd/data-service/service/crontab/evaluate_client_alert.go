@@ -0,0 +1,105 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkuser"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	defaultEvaluateClientAlertInterval = time.Minute
+)
+
+// NewEvaluateClientAlert init evaluate client alert task
+func NewEvaluateClientAlert(sd serviced.Service, svc *service.Service, interval time.Duration) *evaluateClientAlert {
+	if interval <= 0 {
+		interval = defaultEvaluateClientAlertInterval
+	}
+	return &evaluateClientAlert{
+		state:    sd,
+		svc:      svc,
+		interval: interval,
+	}
+}
+
+// evaluateClientAlert 定时计算客户端告警规则，记录告警历史并通知
+type evaluateClientAlert struct {
+	state    serviced.Service
+	svc      *service.Service
+	interval time.Duration
+}
+
+// Run the evaluate client alert task
+func (s *evaluateClientAlert) Run() {
+	logs.Infof("[evaluateClientAlert] start evaluate client alert task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-notifier.Signal:
+				logs.Infof("[evaluateClientAlert] stop evaluate client alert task success")
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !s.state.IsMaster() {
+					logs.Infof("[evaluateClientAlert] current instance is slave, skip evaluate client alert")
+					continue
+				}
+
+				s.evaluateByTenant()
+			}
+		}
+	}()
+}
+
+// evaluateByTenant 按租户计算客户端告警规则
+func (s *evaluateClientAlert) evaluateByTenant() {
+	start := time.Now()
+
+	// 单租户模式：使用空租户ID
+	if !cc.DataService().FeatureFlags.EnableMultiTenantMode {
+		s.evaluate(kit.New())
+		logs.Infof("[evaluateClientAlert] evaluate client alert completed, cost: %s", time.Since(start))
+		return
+	}
+
+	// 多租户模式：获取所有启用的租户并逐个计算
+	tenants, err := bkuser.ListEnabledTenants(kit.New().Ctx)
+	if err != nil {
+		logs.Errorf("[evaluateClientAlert] list enabled tenants failed, err: %v", err)
+		return
+	}
+	for _, tenant := range tenants {
+		s.evaluate(kit.NewWithTenant(tenant.ID))
+	}
+	logs.Infof("[evaluateClientAlert] evaluate client alert for %d tenants completed, cost: %s", len(tenants), time.Since(start))
+}
+
+func (s *evaluateClientAlert) evaluate(kt *kit.Kit) {
+	kt.User = constant.BKSystemUser
+	if err := s.svc.EvaluateClientAlerts(kt); err != nil {
+		logs.Errorf("[evaluateClientAlert] evaluate client alert failed, tenant: %s, err: %v, rid: %s",
+			kt.TenantID, err, kt.Rid)
+	}
+}
@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkuser"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	defaultExpireApprovalInterval = time.Minute
+)
+
+// NewExpireApproval init expire approval task
+func NewExpireApproval(sd serviced.Service, svc *service.Service, interval time.Duration,
+	opt cc.ExpireApprovalConfig) *expireApproval {
+	if interval <= 0 {
+		interval = defaultExpireApprovalInterval
+	}
+	return &expireApproval{
+		state:    sd,
+		svc:      svc,
+		interval: interval,
+		opt:      opt,
+	}
+}
+
+// expireApproval 定时驳回当前阶段已超时的内置审批单
+type expireApproval struct {
+	state    serviced.Service
+	svc      *service.Service
+	interval time.Duration
+	opt      cc.ExpireApprovalConfig
+}
+
+// Run the expire approval task
+func (e *expireApproval) Run() {
+	logs.Infof("[expireApproval] start expire approval task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-notifier.Signal:
+				logs.Infof("[expireApproval] stop expire approval task success")
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !e.state.IsMaster() {
+					continue
+				}
+
+				e.expireByTenant()
+			}
+		}
+	}()
+}
+
+// expireByTenant 按租户驳回超时的审批单
+func (e *expireApproval) expireByTenant() {
+	// 单租户模式：使用空租户ID
+	if !cc.DataService().FeatureFlags.EnableMultiTenantMode {
+		e.expire(kit.New())
+		return
+	}
+
+	// 多租户模式：获取所有启用的租户并逐个处理
+	tenants, err := bkuser.ListEnabledTenants(kit.New().Ctx)
+	if err != nil {
+		logs.Errorf("[expireApproval] list enabled tenants failed, err: %v", err)
+		return
+	}
+	for _, tenant := range tenants {
+		e.expire(kit.NewWithTenant(tenant.ID))
+	}
+}
+
+func (e *expireApproval) expire(kt *kit.Kit) {
+	kt.User = constant.BKSystemUser
+	if err := e.svc.ExpireApprovalTickets(kt, e.opt.BatchSize); err != nil {
+		logs.Errorf("[expireApproval] expire approval tickets failed, tenant: %s, err: %v, rid: %s",
+			kt.TenantID, err, kt.Rid)
+	}
+}
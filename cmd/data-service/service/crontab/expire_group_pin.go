@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkuser"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	defaultExpireGroupPinInterval = time.Minute
+)
+
+// NewExpireGroupPin init expire group pin task
+func NewExpireGroupPin(sd serviced.Service, svc *service.Service, interval time.Duration,
+	opt cc.ExpireGroupPinConfig) *expireGroupPin {
+	if interval <= 0 {
+		interval = defaultExpireGroupPinInterval
+	}
+	return &expireGroupPin{
+		state:    sd,
+		svc:      svc,
+		interval: interval,
+		opt:      opt,
+	}
+}
+
+// expireGroupPin 定时解除已到期的分组版本固定，并通知分组内的客户端拉取发布的版本
+type expireGroupPin struct {
+	state    serviced.Service
+	svc      *service.Service
+	interval time.Duration
+	opt      cc.ExpireGroupPinConfig
+}
+
+// Run the expire group pin task
+func (e *expireGroupPin) Run() {
+	logs.Infof("[expireGroupPin] start expire group pin task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-notifier.Signal:
+				logs.Infof("[expireGroupPin] stop expire group pin task success")
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !e.state.IsMaster() {
+					continue
+				}
+
+				e.expireByTenant()
+			}
+		}
+	}()
+}
+
+// expireByTenant 按租户解除到期的分组版本固定
+func (e *expireGroupPin) expireByTenant() {
+	// 单租户模式：使用空租户ID
+	if !cc.DataService().FeatureFlags.EnableMultiTenantMode {
+		e.expire(kit.New())
+		return
+	}
+
+	// 多租户模式：获取所有启用的租户并逐个处理
+	tenants, err := bkuser.ListEnabledTenants(kit.New().Ctx)
+	if err != nil {
+		logs.Errorf("[expireGroupPin] list enabled tenants failed, err: %v", err)
+		return
+	}
+	for _, tenant := range tenants {
+		e.expire(kit.NewWithTenant(tenant.ID))
+	}
+}
+
+func (e *expireGroupPin) expire(kt *kit.Kit) {
+	kt.User = constant.BKSystemUser
+	if err := e.svc.ExpireGroupPins(kt, e.opt.BatchSize); err != nil {
+		logs.Errorf("[expireGroupPin] expire group pins failed, tenant: %s, err: %v, rid: %s",
+			kt.TenantID, err, kt.Rid)
+	}
+}
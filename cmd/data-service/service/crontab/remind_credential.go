@@ -0,0 +1,122 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkuser"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	defaultRemindCredentialInterval = 24 * time.Hour
+)
+
+// NewRemindCredential init remind credential task
+func NewRemindCredential(sd serviced.Service, svc *service.Service, interval time.Duration,
+	opt cc.RemindCredentialConfig) *remindCredential {
+	if interval <= 0 {
+		interval = defaultRemindCredentialInterval
+	}
+	return &remindCredential{
+		state:    sd,
+		svc:      svc,
+		interval: interval,
+		opt:      opt,
+	}
+}
+
+// remindCredential 定时提醒即将过期和长期未使用的密钥，并清理过期的密钥客户端记录
+type remindCredential struct {
+	state    serviced.Service
+	svc      *service.Service
+	interval time.Duration
+	opt      cc.RemindCredentialConfig
+}
+
+// Run the remind credential task
+func (s *remindCredential) Run() {
+	logs.Infof("[remindCredential] start remind credential task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-notifier.Signal:
+				logs.Infof("[remindCredential] stop remind credential task success")
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !s.state.IsMaster() {
+					logs.Infof("[remindCredential] current instance is slave, skip remind credential")
+					continue
+				}
+
+				s.remindByTenant()
+				s.cleanup()
+			}
+		}
+	}()
+}
+
+// remindByTenant 按租户提醒密钥
+func (s *remindCredential) remindByTenant() {
+	start := time.Now()
+
+	// 单租户模式：使用空租户ID
+	if !cc.DataService().FeatureFlags.EnableMultiTenantMode {
+		s.remind(kit.New())
+		logs.Infof("[remindCredential] remind credential completed, cost: %s", time.Since(start))
+		return
+	}
+
+	// 多租户模式：获取所有启用的租户并逐个提醒
+	tenants, err := bkuser.ListEnabledTenants(kit.New().Ctx)
+	if err != nil {
+		logs.Errorf("[remindCredential] list enabled tenants failed, err: %v", err)
+		return
+	}
+	for _, tenant := range tenants {
+		s.remind(kit.NewWithTenant(tenant.ID))
+	}
+	logs.Infof("[remindCredential] remind credential for %d tenants completed, cost: %s", len(tenants), time.Since(start))
+}
+
+func (s *remindCredential) remind(kt *kit.Kit) {
+	kt.User = constant.BKSystemUser
+	if err := s.svc.RemindCredentials(kt, s.opt); err != nil {
+		logs.Errorf("[remindCredential] remind credential failed, tenant: %s, err: %v, rid: %s",
+			kt.TenantID, err, kt.Rid)
+	}
+}
+
+// cleanup 清理超过未使用天数的密钥客户端记录
+func (s *remindCredential) cleanup() {
+	kt := kit.New()
+	kt.User = constant.BKSystemUser
+	before := time.Now().UTC().AddDate(0, 0, -s.opt.UnusedDays)
+	deleted, err := s.svc.CleanupCredentialClients(kt, before)
+	if err != nil {
+		logs.Errorf("[remindCredential] cleanup credential clients failed, err: %v, rid: %s", err, kt.Rid)
+		return
+	}
+	logs.Infof("[remindCredential] cleanup %d credential clients before %s", deleted, before.Format(time.RFC3339))
+}
@@ -0,0 +1,110 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkuser"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	defaultRollupClientMetricInterval = time.Hour
+)
+
+// NewRollupClientMetric init rollup client metric task
+func NewRollupClientMetric(sd serviced.Service, svc *service.Service, interval time.Duration,
+	hourlyRetentionDays, dailyRetentionDays int) *rollupClientMetric {
+	if interval <= 0 {
+		interval = defaultRollupClientMetricInterval
+	}
+	return &rollupClientMetric{
+		state:               sd,
+		svc:                 svc,
+		interval:            interval,
+		hourlyRetentionDays: hourlyRetentionDays,
+		dailyRetentionDays:  dailyRetentionDays,
+	}
+}
+
+// rollupClientMetric 定时将客户端小时指标降采样为天指标，并清理过期的指标
+type rollupClientMetric struct {
+	state               serviced.Service
+	svc                 *service.Service
+	interval            time.Duration
+	hourlyRetentionDays int
+	dailyRetentionDays  int
+}
+
+// Run the rollup client metric task
+func (s *rollupClientMetric) Run() {
+	logs.Infof("[rollupClientMetric] start rollup client metric task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-notifier.Signal:
+				logs.Infof("[rollupClientMetric] stop rollup client metric task success")
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !s.state.IsMaster() {
+					logs.Infof("[rollupClientMetric] current instance is slave, skip rollup client metric")
+					continue
+				}
+
+				s.rollupByTenant()
+			}
+		}
+	}()
+}
+
+// rollupByTenant 按租户汇总客户端指标
+func (s *rollupClientMetric) rollupByTenant() {
+	start := time.Now()
+
+	// 单租户模式：使用空租户ID
+	if !cc.DataService().FeatureFlags.EnableMultiTenantMode {
+		s.rollup(kit.New())
+		logs.Infof("[rollupClientMetric] rollup client metric completed, cost: %s", time.Since(start))
+		return
+	}
+
+	// 多租户模式：获取所有启用的租户并逐个汇总
+	tenants, err := bkuser.ListEnabledTenants(kit.New().Ctx)
+	if err != nil {
+		logs.Errorf("[rollupClientMetric] list enabled tenants failed, err: %v", err)
+		return
+	}
+	for _, tenant := range tenants {
+		s.rollup(kit.NewWithTenant(tenant.ID))
+	}
+	logs.Infof("[rollupClientMetric] rollup client metric for %d tenants completed, cost: %s", len(tenants), time.Since(start))
+}
+
+func (s *rollupClientMetric) rollup(kt *kit.Kit) {
+	kt.User = constant.BKSystemUser
+	if err := s.svc.RollupClientMetrics(kt, s.hourlyRetentionDays, s.dailyRetentionDays); err != nil {
+		logs.Errorf("[rollupClientMetric] rollup client metric failed, tenant: %s, err: %v, rid: %s",
+			kt.TenantID, err, kt.Rid)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkuser"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	defaultScanConfigDriftInterval = 24 * time.Hour
+)
+
+// NewScanConfigDrift init scan config drift task
+func NewScanConfigDrift(sd serviced.Service, svc *service.Service, interval time.Duration) *scanConfigDrift {
+	if interval <= 0 {
+		interval = defaultScanConfigDriftInterval
+	}
+	return &scanConfigDrift{
+		state:    sd,
+		svc:      svc,
+		interval: interval,
+	}
+}
+
+// scanConfigDrift 定时对已下发的配置实例发起配置检查，记录主机上配置文件的漂移状态
+type scanConfigDrift struct {
+	state    serviced.Service
+	svc      *service.Service
+	interval time.Duration
+}
+
+// Run the scan config drift task
+func (s *scanConfigDrift) Run() {
+	logs.Infof("[scanConfigDrift] start scan config drift task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-notifier.Signal:
+				logs.Infof("[scanConfigDrift] stop scan config drift task success")
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !s.state.IsMaster() {
+					logs.Infof("[scanConfigDrift] current instance is slave, skip scan config drift")
+					continue
+				}
+
+				s.scanByTenant()
+			}
+		}
+	}()
+}
+
+// scanByTenant 按租户扫描配置漂移
+func (s *scanConfigDrift) scanByTenant() {
+	start := time.Now()
+
+	// 单租户模式：使用空租户ID
+	if !cc.DataService().FeatureFlags.EnableMultiTenantMode {
+		s.scan(kit.New())
+		logs.Infof("[scanConfigDrift] scan config drift completed, cost: %s", time.Since(start))
+		return
+	}
+
+	// 多租户模式：获取所有启用的租户并逐个扫描
+	tenants, err := bkuser.ListEnabledTenants(kit.New().Ctx)
+	if err != nil {
+		logs.Errorf("[scanConfigDrift] list enabled tenants failed, err: %v", err)
+		return
+	}
+	for _, tenant := range tenants {
+		s.scan(kit.NewWithTenant(tenant.ID))
+	}
+	logs.Infof("[scanConfigDrift] scan config drift for %d tenants completed, cost: %s", len(tenants), time.Since(start))
+}
+
+func (s *scanConfigDrift) scan(kt *kit.Kit) {
+	kt.User = constant.BKSystemUser
+	if err := s.svc.ScanConfigDrift(kt); err != nil {
+		logs.Errorf("[scanConfigDrift] scan config drift failed, tenant: %s, err: %v, rid: %s",
+			kt.TenantID, err, kt.Rid)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkuser"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	defaultSyncGitRepoInterval = time.Minute
+)
+
+// NewSyncGitRepo init sync git repository task
+func NewSyncGitRepo(sd serviced.Service, svc *service.Service, interval time.Duration) *syncGitRepo {
+	if interval <= 0 {
+		interval = defaultSyncGitRepoInterval
+	}
+	return &syncGitRepo{
+		state:    sd,
+		svc:      svc,
+		interval: interval,
+	}
+}
+
+// syncGitRepo 定时同步到期的 git 仓库关联
+type syncGitRepo struct {
+	state    serviced.Service
+	svc      *service.Service
+	interval time.Duration
+}
+
+// Run the sync git repository task
+func (s *syncGitRepo) Run() {
+	logs.Infof("[syncGitRepo] start sync git repository task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-notifier.Signal:
+				logs.Infof("[syncGitRepo] stop sync git repository task success")
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !s.state.IsMaster() {
+					logs.Infof("[syncGitRepo] current instance is slave, skip sync git repository")
+					continue
+				}
+
+				s.syncByTenant()
+			}
+		}
+	}()
+}
+
+// syncByTenant 按租户同步 git 仓库关联
+func (s *syncGitRepo) syncByTenant() {
+	start := time.Now()
+
+	// 单租户模式：使用空租户ID
+	if !cc.DataService().FeatureFlags.EnableMultiTenantMode {
+		s.sync(kit.New())
+		logs.Infof("[syncGitRepo] sync git repository completed, cost: %s", time.Since(start))
+		return
+	}
+
+	// 多租户模式：获取所有启用的租户并逐个同步
+	tenants, err := bkuser.ListEnabledTenants(kit.New().Ctx)
+	if err != nil {
+		logs.Errorf("[syncGitRepo] list enabled tenants failed, err: %v", err)
+		return
+	}
+	for _, tenant := range tenants {
+		s.sync(kit.NewWithTenant(tenant.ID))
+	}
+	logs.Infof("[syncGitRepo] sync git repository for %d tenants completed, cost: %s", len(tenants), time.Since(start))
+}
+
+func (s *syncGitRepo) sync(kt *kit.Kit) {
+	kt.User = constant.BKSystemUser
+	if err := s.svc.SyncScheduledGitRepoLinks(kt); err != nil {
+		logs.Errorf("[syncGitRepo] sync git repository failed, tenant: %s, err: %v, rid: %s",
+			kt.TenantID, err, kt.Rid)
+	}
+}
@@ -0,0 +1,655 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // nolint:gosec
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/gitrepo"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
+	pbcontent "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
+	pbgrl "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/git-repo-link"
+	pbtemplate "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/template"
+	pbtr "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/template-revision"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// gitRepoSyncTimeout is the max duration of one sync, a link which is syncing longer than it is
+// regarded as stale and can be synced again.
+const gitRepoSyncTimeout = 10 * time.Minute
+
+// CreateGitRepoLink create git repository link.
+func (s *Service) CreateGitRepoLink(ctx context.Context, req *pbds.CreateGitRepoLinkReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().GitRepoLinkSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "git repo link spec is required"))
+	}
+	spec.SubPath = spec.CleanSubPath()
+	if req.WebhookToken != "" {
+		spec.WebhookTokenHash = hashGitRepoWebhookToken(req.WebhookToken)
+	}
+
+	now := time.Now().UTC()
+	link := &table.GitRepoLink{
+		Spec: spec,
+		Attachment: &table.GitRepoLinkAttachment{
+			BizID:           req.BizId,
+			AppID:           req.AppId,
+			TemplateSpaceID: req.TemplateSpaceId,
+			TenantID:        kt.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	if err := link.ValidateCreate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+	if err := s.validateGitRepoLinkTarget(kt, link.Attachment); err != nil {
+		return nil, err
+	}
+
+	id, err := s.dao.GitRepoLink().Create(kt, link)
+	if err != nil {
+		logs.Errorf("create git repo link failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "create git repo link failed, err: %v", err))
+	}
+
+	if req.Token != "" {
+		if err := s.vault.UpsertGitRepoToken(kt, req.BizId, id, req.Token); err != nil {
+			logs.Errorf("save git repo token failed, err: %v, rid: %s", err, kt.Rid)
+			if dErr := s.dao.GitRepoLink().Delete(kt, req.BizId, id); dErr != nil {
+				logs.Errorf("delete git repo link %d failed, err: %v, rid: %s", id, dErr, kt.Rid)
+			}
+			return nil, err
+		}
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpdateGitRepoLink update git repository link.
+func (s *Service) UpdateGitRepoLink(ctx context.Context, req *pbds.UpdateGitRepoLinkReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().GitRepoLinkSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "git repo link spec is required"))
+	}
+
+	old, err := s.getGitRepoLink(kt, req.BizId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	spec.SubPath = spec.CleanSubPath()
+	spec.WebhookTokenHash = old.Spec.WebhookTokenHash
+	if req.WebhookToken != "" {
+		spec.WebhookTokenHash = hashGitRepoWebhookToken(req.WebhookToken)
+	}
+	link := &table.GitRepoLink{
+		ID:         req.Id,
+		Spec:       spec,
+		Attachment: old.Attachment,
+		Revision: &table.Revision{
+			Reviser:   kt.User,
+			UpdatedAt: time.Now().UTC(),
+		},
+	}
+	if err = link.ValidateUpdate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	if req.Token != "" {
+		if err = s.vault.UpsertGitRepoToken(kt, req.BizId, req.Id, req.Token); err != nil {
+			logs.Errorf("save git repo token failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+	}
+
+	if err = s.dao.GitRepoLink().Update(kt, link); err != nil {
+		logs.Errorf("update git repo link failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "update git repo link failed, err: %v", err))
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteGitRepoLink delete git repository link, the config items and templates which are synced are kept.
+func (s *Service) DeleteGitRepoLink(ctx context.Context, req *pbds.DeleteGitRepoLinkReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.getGitRepoLink(kt, req.BizId, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.vault.DeleteGitRepoToken(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete git repo token failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if err := s.dao.GitRepoLink().Delete(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete git repo link failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "delete git repo link failed, err: %v", err))
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// GetGitRepoLink get git repository link.
+func (s *Service) GetGitRepoLink(ctx context.Context, req *pbds.GetGitRepoLinkReq) (*pbgrl.GitRepoLink, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	link, err := s.getGitRepoLink(kt, req.BizId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return pbgrl.PbGitRepoLink(link), nil
+}
+
+// ListGitRepoLinks list git repository links of the app or the template space.
+func (s *Service) ListGitRepoLinks(ctx context.Context, req *pbds.ListGitRepoLinksReq) (
+	*pbds.ListGitRepoLinksResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	links, err := s.dao.GitRepoLink().List(kt, req.BizId, req.AppId, req.TemplateSpaceId)
+	if err != nil {
+		logs.Errorf("list git repo links failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "list git repo links failed, err: %v", err))
+	}
+
+	return &pbds.ListGitRepoLinksResp{Details: pbgrl.PbGitRepoLinks(links)}, nil
+}
+
+// SyncGitRepoLink sync the files of the git repository to the app or the template space of the link.
+func (s *Service) SyncGitRepoLink(ctx context.Context, req *pbds.SyncGitRepoLinkReq) (
+	*pbds.SyncGitRepoLinkResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	link, err := s.getGitRepoLink(kt, req.BizId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.WebhookToken != "" {
+		if link.Spec.WebhookTokenHash == "" || subtle.ConstantTimeCompare(
+			[]byte(hashGitRepoWebhookToken(req.WebhookToken)), []byte(link.Spec.WebhookTokenHash)) != 1 {
+			return nil, errf.Errorf(errf.PermissionDenied, "%s", i18n.T(kt, "invalid webhook token"))
+		}
+	}
+
+	return s.syncGitRepoLink(kt, link, req.Force)
+}
+
+// SyncScheduledGitRepoLinks sync the git repository links whose sync interval is elapsed.
+func (s *Service) SyncScheduledGitRepoLinks(kt *kit.Kit) error {
+	links, err := s.dao.GitRepoLink().ListScheduled(kt)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, link := range links {
+		if link.Status != nil && link.Status.SyncedAt != nil &&
+			link.Status.SyncedAt.Add(time.Duration(link.Spec.SyncInterval)*time.Minute).After(now) {
+			continue
+		}
+
+		resp, err := s.syncGitRepoLink(kt, link, false)
+		if err != nil {
+			logs.Errorf("sync git repo link %d failed, err: %v, rid: %s", link.ID, err, kt.Rid)
+			continue
+		}
+		if !resp.Skipped {
+			logs.Infof("sync git repo link %d to %s, %d changed, rid: %s", link.ID, resp.SyncedSha, resp.Changed, kt.Rid)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) getGitRepoLink(kt *kit.Kit, bizID, id uint32) (*table.GitRepoLink, error) {
+	link, err := s.dao.GitRepoLink().Get(kt, bizID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, "%s", i18n.T(kt, "git repo link %d not found", id))
+		}
+		logs.Errorf("get git repo link failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "get git repo link failed, err: %v", err))
+	}
+
+	return link, nil
+}
+
+// validateGitRepoLinkTarget validate the app or the template space which the link belongs to exists,
+// only the file type app can be linked.
+func (s *Service) validateGitRepoLinkTarget(kt *kit.Kit, at *table.GitRepoLinkAttachment) error {
+	if at.AppID > 0 {
+		app, err := s.dao.App().Get(kt, at.BizID, at.AppID)
+		if err != nil {
+			logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+			return errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "app %d not found", at.AppID))
+		}
+		if app.Spec.ConfigType != table.File {
+			return errf.Errorf(errf.InvalidParameter, "%s",
+				i18n.T(kt, "only the file type app can be linked to git repository"))
+		}
+		return nil
+	}
+
+	if _, err := s.dao.TemplateSpace().Get(kt, at.BizID, at.TemplateSpaceID); err != nil {
+		logs.Errorf("get template space failed, err: %v, rid: %s", err, kt.Rid)
+		return errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "template space %d not found", at.TemplateSpaceID))
+	}
+
+	return nil
+}
+
+// syncGitRepoLink sync the link and record the result in the status of the link.
+func (s *Service) syncGitRepoLink(kt *kit.Kit, link *table.GitRepoLink, force bool) (
+	*pbds.SyncGitRepoLinkResp, error) {
+	bizID := link.Attachment.BizID
+	started, err := s.dao.GitRepoLink().StartSync(kt, bizID, link.ID, time.Now().UTC().Add(-gitRepoSyncTimeout))
+	if err != nil {
+		logs.Errorf("start sync git repo link failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if !started {
+		return nil, errf.Errorf(errf.Aborted, "%s", i18n.T(kt, "git repo link %d is being synced", link.ID))
+	}
+
+	resp, syncErr := s.doSyncGitRepoLink(kt, link, force)
+
+	now := time.Now().UTC()
+	status := &table.GitRepoLinkStatus{SyncedAt: &now}
+	if syncErr != nil {
+		status.SyncStatus = table.GitRepoSyncFailed
+		status.SyncedSHA = link.Status.SyncedSHA
+		status.SyncMessage = syncErr.Error()
+	} else {
+		status.SyncStatus = table.GitRepoSyncSuccess
+		status.SyncedSHA = resp.SyncedSha
+		status.SyncMessage = fmt.Sprintf("%d changed", resp.Changed)
+		if resp.Skipped {
+			status.SyncMessage = "already synced"
+		}
+	}
+	if err := s.dao.GitRepoLink().UpdateStatus(kt, bizID, link.ID, status); err != nil {
+		logs.Errorf("update git repo link %d status failed, err: %v, rid: %s", link.ID, err, kt.Rid)
+	}
+
+	return resp, syncErr
+}
+
+// gitRepoFile is the file of the git repository which is uploaded to the repository of bscp.
+type gitRepoFile struct {
+	name     string
+	path     string
+	fileType table.FileFormat
+	charset  table.FileCharset
+	content  *pbcontent.ContentSpec
+}
+
+func (s *Service) doSyncGitRepoLink(kt *kit.Kit, link *table.GitRepoLink, force bool) (
+	*pbds.SyncGitRepoLinkResp, error) {
+	token, err := s.vault.GetGitRepoToken(kt, link.Attachment.BizID, link.ID)
+	if err != nil {
+		logs.Errorf("get git repo token failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "bscp-git-repo-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(kt.Ctx, gitRepoSyncTimeout)
+	defer cancel()
+	repoDir := filepath.Join(dir, "repo")
+	sha, err := gitrepo.Clone(ctx, repoDir, &gitrepo.CloneOption{
+		URL:      link.Spec.RepoURL,
+		Ref:      link.Spec.Ref,
+		Username: link.Spec.Username,
+		Token:    token,
+	})
+	if err != nil {
+		logs.Errorf("clone git repo of link %d failed, err: %v, rid: %s", link.ID, err, kt.Rid)
+		return nil, err
+	}
+
+	if !force && sha == link.Status.SyncedSHA && link.Status.SyncStatus == table.GitRepoSyncSuccess {
+		return &pbds.SyncGitRepoLinkResp{SyncedSha: sha, Skipped: true}, nil
+	}
+
+	repoKt := kt.Clone()
+	repoKt.BizID = link.Attachment.BizID
+	repoKt.AppID = link.Attachment.AppID
+	if link.Attachment.TemplateSpaceID > 0 {
+		repoKt = repoKt.GetKitForRepoTmpl(link.Attachment.TemplateSpaceID)
+	}
+	root, err := resolveGitRepoSubPath(repoDir, link.Spec.SubPath)
+	if err != nil {
+		logs.Errorf("resolve sub path of git repo link %d failed, err: %v, rid: %s", link.ID, err, kt.Rid)
+		return nil, err
+	}
+	files, err := s.uploadGitRepoFiles(repoKt, root)
+	if err != nil {
+		logs.Errorf("upload files of git repo link %d failed, err: %v, rid: %s", link.ID, err, kt.Rid)
+		return nil, err
+	}
+
+	memo := fmt.Sprintf("git %s@%s", link.Spec.RepoURL, sha)
+	var changed int
+	if link.Attachment.AppID > 0 {
+		changed, err = s.syncGitRepoToApp(kt, link, files, memo)
+	} else {
+		changed, err = s.syncGitRepoToTemplateSpace(kt, link, files, memo)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbds.SyncGitRepoLinkResp{SyncedSha: sha, Changed: uint32(changed)}, nil
+}
+
+// resolveGitRepoSubPath resolve the symlinks of the sub path in the repo directory, the resolved path must be
+// still under the repo directory, so that a symlink committed to the repository can't expose the host's files.
+func resolveGitRepoSubPath(repoDir, subPath string) (string, error) {
+	base, err := filepath.EvalSymlinks(repoDir)
+	if err != nil {
+		return "", err
+	}
+	root, err := filepath.EvalSymlinks(filepath.Join(base, filepath.FromSlash(subPath)))
+	if err != nil {
+		return "", fmt.Errorf("sub path is not a directory of the git repository")
+	}
+
+	rel, err := filepath.Rel(base, root)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("sub path is out of the git repository")
+	}
+
+	return root, nil
+}
+
+// uploadGitRepoFiles upload the regular files under the root directory, the .git directory and the symlinks
+// are skipped.
+func (s *Service) uploadGitRepoFiles(kt *kit.Kit, root string) ([]*gitRepoFile, error) {
+	info, err := os.Stat(root)
+	if err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("sub path is not a directory of the git repository")
+	}
+
+	files := make([]*gitRepoFile, 0)
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// the symlinks may point to the files out of the repository, so they are never followed
+		if d.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		file, err := s.uploadGitRepoFile(kt, p)
+		if err != nil {
+			return fmt.Errorf("upload file %s failed, err: %v", rel, err)
+		}
+		file.name = d.Name()
+		file.path = path.Join("/", filepath.ToSlash(filepath.Dir(rel)))
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func (s *Service) uploadGitRepoFile(kt *kit.Kit, p string) (*gitRepoFile, error) {
+	content, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+
+	sha := sha256.Sum256(content)
+	sum := md5.Sum(content) // nolint:gosec
+	file := &gitRepoFile{
+		fileType: table.Binary,
+		content: &pbcontent.ContentSpec{
+			Signature: hex.EncodeToString(sha[:]),
+			ByteSize:  uint64(len(content)),
+			Md5:       hex.EncodeToString(sum[:]),
+		},
+	}
+	if len(content) <= constant.MaxUploadTextFileSize && utf8.Valid(content) {
+		file.fileType = table.Text
+		file.charset = table.UTF8
+	}
+
+	if _, err = s.repo.Metadata(kt, file.content.Signature); err == nil {
+		return file, nil
+	} else if !errors.Is(err, errf.ErrFileContentNotFound) {
+		return nil, err
+	}
+
+	if _, err = s.repo.Upload(kt, file.content.Signature, bytes.NewReader(content)); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// syncGitRepoToApp upsert the files as the config items of the app, the attributes of the existing
+// config items are kept, and only the content is updated.
+func (s *Service) syncGitRepoToApp(kt *kit.Kit, link *table.GitRepoLink, files []*gitRepoFile, memo string) (
+	int, error) {
+	bizID, appID := link.Attachment.BizID, link.Attachment.AppID
+	cis, err := s.dao.ConfigItem().ListAllByAppID(kt, appID, bizID)
+	if err != nil {
+		logs.Errorf("list editing config items failed, err: %v, rid: %s", err, kt.Rid)
+		return 0, err
+	}
+	existing := make(map[string]*table.ConfigItem, len(cis))
+	for _, ci := range cis {
+		existing[path.Join(ci.Spec.Path, ci.Spec.Name)] = ci
+	}
+
+	items := make([]*pbds.BatchUpsertConfigItemsReq_ConfigItem, 0, len(files))
+	for _, f := range files {
+		key := path.Join(f.path, f.name)
+		spec := &pbci.ConfigItemSpec{
+			Name:       f.name,
+			Path:       f.path,
+			FileType:   string(f.fileType),
+			FileMode:   string(table.Unix),
+			Permission: defaultGitRepoFilePermission(),
+			Charset:    string(f.charset),
+		}
+		if ci, ok := existing[key]; ok {
+			spec = pbci.PbConfigItemSpec(ci.Spec)
+			delete(existing, key)
+		}
+		items = append(items, &pbds.BatchUpsertConfigItemsReq_ConfigItem{
+			ConfigItemAttachment: &pbci.ConfigItemAttachment{BizId: bizID, AppId: appID},
+			ConfigItemSpec:       spec,
+			ContentSpec:          f.content,
+		})
+	}
+
+	upsertKt := kt.Clone()
+	upsertKt.BizID, upsertKt.AppID = bizID, appID
+	resp, err := s.BatchUpsertConfigItems(upsertKt.InternalRpcCtx(), &pbds.BatchUpsertConfigItemsReq{
+		BizId:      bizID,
+		AppId:      appID,
+		Items:      items,
+		CommitMemo: memo,
+	})
+	if err != nil {
+		logs.Errorf("batch upsert config items of git repo link %d failed, err: %v, rid: %s", link.ID, err, kt.Rid)
+		return 0, err
+	}
+
+	// the replace all of batch upsert also clears the template bindings and variables of the app,
+	// so only the config items which are not in the repository are deleted here.
+	if !link.Spec.ReplaceAll || len(existing) == 0 {
+		return len(resp.Ids), nil
+	}
+
+	toDelete := make([]uint32, 0, len(existing))
+	for _, ci := range existing {
+		toDelete = append(toDelete, ci.ID)
+	}
+	tx := s.dao.GenQuery().Begin()
+	if err = s.doBatchDeleteConfigItems(kt, tx, toDelete, bizID, appID); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return 0, err
+	}
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return 0, err
+	}
+
+	return len(resp.Ids) + len(toDelete), nil
+}
+
+// syncGitRepoToTemplateSpace upsert the changed files as the templates of the template space, a new
+// revision is created for each changed template, the attributes of the existing templates are kept.
+func (s *Service) syncGitRepoToTemplateSpace(kt *kit.Kit, link *table.GitRepoLink, files []*gitRepoFile,
+	memo string) (int, error) {
+	bizID, spaceID := link.Attachment.BizID, link.Attachment.TemplateSpaceID
+	if len(files) == 0 {
+		return 0, nil
+	}
+
+	tuples := make([]*pbds.ListTemplateByTupleReq_Item, 0, len(files))
+	for _, f := range files {
+		tuples = append(tuples, &pbds.ListTemplateByTupleReq_Item{
+			BizId:           bizID,
+			TemplateSpaceId: spaceID,
+			Name:            f.name,
+			Path:            f.path,
+		})
+	}
+	upsertKt := kt.Clone()
+	upsertKt.BizID = bizID
+	tupleResp, err := s.ListTemplateByTuple(upsertKt.InternalRpcCtx(), &pbds.ListTemplateByTupleReq{Items: tuples})
+	if err != nil {
+		return 0, err
+	}
+	existing := make(map[string]*pbds.ListTemplateByTupleReqResp_Item, len(tupleResp.Items))
+	for _, item := range tupleResp.Items {
+		existing[path.Join(item.Template.Spec.Path, item.Template.Spec.Name)] = item
+	}
+
+	items := make([]*pbds.BatchUpsertTemplatesReq_Item, 0)
+	for _, f := range files {
+		template := &pbtemplate.Template{
+			Spec:       &pbtemplate.TemplateSpec{Name: f.name, Path: f.path},
+			Attachment: &pbtemplate.TemplateAttachment{BizId: bizID, TemplateSpaceId: spaceID},
+		}
+		revision := &pbtr.TemplateRevisionSpec{
+			RevisionMemo: memo,
+			Name:         f.name,
+			Path:         f.path,
+			FileType:     string(f.fileType),
+			FileMode:     string(table.Unix),
+			Permission:   defaultGitRepoFilePermission(),
+			ContentSpec:  f.content,
+			Charset:      string(f.charset),
+		}
+		if old, ok := existing[path.Join(f.path, f.name)]; ok && old.TemplateRevision.GetSpec() != nil {
+			oldSpec := old.TemplateRevision.Spec
+			if oldSpec.GetContentSpec().GetSignature() == f.content.Signature {
+				continue
+			}
+			template.Id, template.Spec.Memo = old.Template.Id, old.Template.Spec.Memo
+			revision.FileType, revision.FileMode = oldSpec.FileType, oldSpec.FileMode
+			revision.Permission, revision.Charset = oldSpec.Permission, oldSpec.Charset
+		}
+		items = append(items, &pbds.BatchUpsertTemplatesReq_Item{
+			Template: template,
+			TemplateRevision: &pbtr.TemplateRevision{
+				Spec:       revision,
+				Attachment: &pbtr.TemplateRevisionAttachment{BizId: bizID, TemplateSpaceId: spaceID},
+			},
+		})
+	}
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	if _, err = s.BatchUpsertTemplates(upsertKt.InternalRpcCtx(), &pbds.BatchUpsertTemplatesReq{
+		BizId: bizID,
+		Items: items,
+	}); err != nil {
+		logs.Errorf("batch upsert templates of git repo link %d failed, err: %v, rid: %s", link.ID, err, kt.Rid)
+		return 0, err
+	}
+
+	return len(items), nil
+}
+
+func defaultGitRepoFilePermission() *pbci.FilePermission {
+	return &pbci.FilePermission{User: "root", UserGroup: "root", Privilege: "644"}
+}
+
+func hashGitRepoWebhookToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,66 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveGitRepoSubPath(t *testing.T) {
+	dir := t.TempDir()
+	repoDir := filepath.Join(dir, "repo")
+	outside := filepath.Join(dir, "outside")
+	for _, d := range []string{filepath.Join(repoDir, "conf", "prod"), outside} {
+		if err := os.MkdirAll(d, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink(outside, filepath.Join(repoDir, "escape")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("conf", filepath.Join(repoDir, "alias")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		subPath string
+		want    string
+		wantErr bool
+	}{
+		{subPath: "", want: "."},
+		{subPath: "conf/prod", want: "conf/prod"},
+		{subPath: "alias/prod", want: "conf/prod"},
+		{subPath: "escape", wantErr: true},
+		{subPath: "../outside", wantErr: true},
+		{subPath: "missing", wantErr: true},
+	}
+	for _, tt := range tests {
+		root, err := resolveGitRepoSubPath(repoDir, tt.subPath)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("sub path %q should be rejected, got %s", tt.subPath, root)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("resolve sub path %q failed, err: %v", tt.subPath, err)
+			continue
+		}
+		base, _ := filepath.EvalSymlinks(repoDir)
+		if rel, _ := filepath.Rel(base, root); rel != filepath.FromSlash(tt.want) {
+			t.Errorf("sub path %q resolved to %s, want %s", tt.subPath, rel, tt.want)
+		}
+	}
+}
@@ -15,10 +15,13 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
@@ -50,6 +53,10 @@ func (s *Service) CreateGroup(ctx context.Context, req *pbds.CreateGroupReq) (*p
 		logs.Errorf("group must not bind apps when public is set to true, rid: %s", kt.Rid)
 		return nil, errf.New(errf.InvalidParameter, "group must not bind apps when public is set to true")
 	}
+	if err = s.validateDynamicGroup(kt, req.Attachment.BizId, spec.Mode, spec, req.Spec.BindApps); err != nil {
+		logs.Errorf("validate dynamic group failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
 
 	group := &table.Group{
 		Spec:       spec,
@@ -100,6 +107,33 @@ func (s *Service) CreateGroup(ctx context.Context, req *pbds.CreateGroupReq) (*p
 	return resp, nil
 }
 
+// validateDynamicGroup 动态分组只能绑定客户端查询所属的服务，非动态分组不能关联客户端查询
+func (s *Service) validateDynamicGroup(kt *kit.Kit, bizID uint32, mode table.GroupMode, spec *table.GroupSpec,
+	bindApps []uint32) error {
+	if mode != table.GroupModeDynamic {
+		if spec.ClientQueryID > 0 {
+			return errf.New(errf.InvalidParameter, "client query can only be set for dynamic group")
+		}
+		return nil
+	}
+
+	if spec.ClientQueryID == 0 {
+		return errf.New(errf.InvalidParameter, "dynamic group must set client query id")
+	}
+	if spec.Public || len(bindApps) != 1 {
+		return errf.New(errf.InvalidParameter, "dynamic group must bind exactly one app")
+	}
+	if _, err := s.dao.ClientQuery().Get(kt, bizID, bindApps[0], spec.ClientQueryID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errf.New(errf.InvalidParameter,
+				fmt.Sprintf("client query %d not exists in app %d", spec.ClientQueryID, bindApps[0]))
+		}
+		return err
+	}
+
+	return nil
+}
+
 // ListAllGroups list all groups in biz.
 func (s *Service) ListAllGroups(ctx context.Context, req *pbds.ListAllGroupsReq) (*pbds.ListAllGroupsResp, error) {
 	kt := kit.FromGrpcContext(ctx)
@@ -293,6 +327,10 @@ func (s *Service) UpdateGroup(ctx context.Context, req *pbds.UpdateGroupReq) (*p
 	if err != nil {
 		return nil, err
 	}
+	if err = s.validateDynamicGroup(kt, req.Attachment.BizId, old.Spec.Mode, n.Spec, req.Spec.BindApps); err != nil {
+		logs.Errorf("validate dynamic group failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
 
 	if !n.Spec.Public {
 		// check if the reduced app was already released.
@@ -337,6 +375,11 @@ func (s *Service) UpdateGroup(ctx context.Context, req *pbds.UpdateGroupReq) (*p
 		return nil, e
 	}
 
+	if e := s.dao.GroupTemplateVariable().DeleteByGroupIDWithTx(kt, tx, req.Attachment.BizId, req.Id); e != nil {
+		logs.Errorf("delete group template variables failed, err: %v, rid: %s", e, kt.Rid)
+		return nil, e
+	}
+
 	if !n.Spec.Public {
 		groupApps := make([]*table.GroupAppBind, len(req.Spec.BindApps))
 		for idx, app := range req.Spec.BindApps {
@@ -353,7 +396,7 @@ func (s *Service) UpdateGroup(ctx context.Context, req *pbds.UpdateGroupReq) (*p
 	}
 
 	var edited = false
-	if old.Spec.UID != n.Spec.UID {
+	if old.Spec.UID != n.Spec.UID || old.Spec.ClientQueryID != n.Spec.ClientQueryID {
 		edited = true
 	}
 
@@ -456,6 +499,13 @@ func (s *Service) ListGroupReleasedApps(ctx context.Context, req *pbds.ListGroup
 			ReleaseId:   detail.ReleaseID,
 			ReleaseName: detail.ReleaseName,
 			Edited:      detail.Edited,
+			// 分组被固定时客户端实际使用固定的版本
+			PinnedReleaseId:   detail.PinnedReleaseID,
+			PinnedReleaseName: detail.PinnedReleaseName,
+			PinMemo:           detail.PinMemo,
+		}
+		if detail.PinnedUntil != nil {
+			data[idx].PinnedUntil = detail.PinnedUntil.UTC().Format(time.RFC3339)
 		}
 	}
 
@@ -0,0 +1,280 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// PinGroupRelease pin the released group of the app to a release, the clients in the group only use the
+// pinned release until the pin expires or is unpinned, the publishes during the pin take effect after that.
+func (s *Service) PinGroupRelease(ctx context.Context, req *pbds.PinGroupReleaseReq) (
+	*pbds.PinGroupReleaseResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	var until *time.Time
+	if req.PinnedUntil != "" {
+		t, err := time.Parse(time.RFC3339, req.PinnedUntil)
+		if err != nil {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s",
+				i18n.T(kt, "invalid pinned until time %s, should be in RFC3339 format", req.PinnedUntil))
+		}
+		if !t.After(time.Now()) {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "pinned until time should be in the future"))
+		}
+		t = t.UTC()
+		until = &t
+	}
+
+	rg, err := s.getPinReleasedGroup(kt, req.BizId, req.AppId, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.dao.Release().Get(kt, req.BizId, req.AppId, req.ReleaseId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, "%s", i18n.T(kt, "release %d not found", req.ReleaseId))
+		}
+		return nil, err
+	}
+	if release.Spec.Deprecated {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s",
+			i18n.T(kt, "release %s is deprecated, can not be pinned", release.Spec.Name))
+	}
+
+	groupName, err := s.getPinGroupName(kt, req.BizId, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkChangeFreeze(kt, req.BizId, req.AppId,
+		fmt.Sprintf("pin group %s to release %s", groupName, release.Spec.Name)); err != nil {
+		return nil, err
+	}
+
+	rg.PinnedReleaseID = release.ID
+	rg.PinnedUntil = until
+	rg.PinMemo = req.Memo
+	resInstance := fmt.Sprintf(constant.PinConfigReleaseName+constant.ResSeparator+constant.GroupName,
+		release.Spec.Name, groupName)
+	if err = s.updateGroupPin(kt, rg, release, resInstance, req.Memo); err != nil {
+		return nil, err
+	}
+
+	return &pbds.PinGroupReleaseResp{}, nil
+}
+
+// UnpinGroupRelease unpin the released group of the app, the clients in the group use the published release again.
+func (s *Service) UnpinGroupRelease(ctx context.Context, req *pbds.UnpinGroupReleaseReq) (
+	*pbds.UnpinGroupReleaseResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	rg, err := s.getPinReleasedGroup(kt, req.BizId, req.AppId, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if rg.PinnedReleaseID == 0 {
+		return &pbds.UnpinGroupReleaseResp{}, nil
+	}
+
+	groupName, err := s.getPinGroupName(kt, req.BizId, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkChangeFreeze(kt, req.BizId, req.AppId, fmt.Sprintf("unpin group %s", groupName)); err != nil {
+		return nil, err
+	}
+
+	release := s.getPinnedRelease(kt, rg)
+	rg.PinnedReleaseID = 0
+	rg.PinnedUntil = nil
+	rg.PinMemo = ""
+	resInstance := fmt.Sprintf(constant.UnpinConfigReleaseName+constant.ResSeparator+constant.GroupName,
+		release.Spec.Name, groupName)
+	if err = s.updateGroupPin(kt, rg, release, resInstance, ""); err != nil {
+		return nil, err
+	}
+
+	return &pbds.UnpinGroupReleaseResp{}, nil
+}
+
+// ExpireGroupPins clears the pins of the released groups which are expired, and notifies the clients in the groups
+// to use the published release again.
+func (s *Service) ExpireGroupPins(kt *kit.Kit, limit int) error {
+	now := time.Now().UTC()
+	rgs, err := s.dao.ReleasedGroup().ListExpiredPins(kt, now, limit)
+	if err != nil {
+		return err
+	}
+
+	for _, one := range rgs {
+		if err := s.expireGroupPin(kt, one, now); err != nil {
+			logs.Errorf("expire pin of released group %d failed, err: %v, rid: %s", one.ID, err, kt.Rid)
+		}
+	}
+
+	return nil
+}
+
+// expireGroupPin clears the expired pin of the released group.
+func (s *Service) expireGroupPin(kt *kit.Kit, rg *table.ReleasedGroup, now time.Time) error {
+	release := s.getPinnedRelease(kt, rg)
+	groupName, err := s.getPinGroupName(kt, rg.BizID, rg.GroupID)
+	if err != nil {
+		return err
+	}
+
+	return s.doGroupPinTx(kt, func(tx *gen.QueryTx) (bool, error) {
+		cleared, err := s.dao.ReleasedGroup().ClearExpiredPinWithTx(kt, tx, rg.BizID, rg.ID, now)
+		if err != nil || !cleared {
+			// the pin is updated or unpinned by others
+			return false, err
+		}
+
+		resInstance := fmt.Sprintf(constant.UnpinConfigReleaseName+constant.ResSeparator+constant.GroupName,
+			release.Spec.Name, groupName)
+		return true, s.fireGroupPinWithTx(kt, tx, rg, release, resInstance, "pin expired")
+	})
+}
+
+// updateGroupPin updates the pin of the released group, and notifies the clients in the group.
+func (s *Service) updateGroupPin(kt *kit.Kit, rg *table.ReleasedGroup, release *table.Release,
+	resInstance, memo string) error {
+
+	return s.doGroupPinTx(kt, func(tx *gen.QueryTx) (bool, error) {
+		if err := s.dao.ReleasedGroup().UpdatePinWithTx(kt, tx, rg); err != nil {
+			logs.Errorf("update pin of released group %d failed, err: %v, rid: %s", rg.ID, err, kt.Rid)
+			return false, err
+		}
+
+		return true, s.fireGroupPinWithTx(kt, tx, rg, release, resInstance, memo)
+	})
+}
+
+// doGroupPinTx run the pin operation in a transaction, the transaction is rolled back if the operation
+// returns error or does not need to commit.
+func (s *Service) doGroupPinTx(kt *kit.Kit, do func(tx *gen.QueryTx) (bool, error)) error {
+	tx := s.dao.GenQuery().Begin()
+	committed := false
+	defer func() {
+		if !committed {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+			}
+		}
+	}()
+
+	commit, err := do(tx)
+	if err != nil || !commit {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	committed = true
+
+	return nil
+}
+
+// fireGroupPinWithTx fires the publish event, so that the clients of the group match the release again, and
+// records the audit of the pin.
+func (s *Service) fireGroupPinWithTx(kt *kit.Kit, tx *gen.QueryTx, rg *table.ReleasedGroup,
+	release *table.Release, resInstance, memo string) error {
+
+	one := types.Event{
+		Spec: &table.EventSpec{
+			Resource:   table.Publish,
+			ResourceID: rg.ReleaseID,
+			OpType:     table.InsertOp,
+		},
+		Attachment: &table.EventAttachment{BizID: rg.BizID, AppID: rg.AppID},
+		Revision:   &table.CreatedRevision{Creator: kt.User},
+	}
+	if err := s.dao.Event().Eventf(kt).FireWithTx(tx, one); err != nil {
+		logs.Errorf("fire group pin publish event failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return s.dao.AuditDao().Decorator(kt, rg.BizID, &table.AuditField{
+		ResourceInstance: resInstance,
+		Status:           enumor.Success,
+		AppId:            rg.AppID,
+		Detail:           memo,
+	}).PrepareUpdate(release).Do(tx.Query)
+}
+
+// getPinReleasedGroup get the released group to be pinned, only the group published in the app can be pinned.
+func (s *Service) getPinReleasedGroup(kt *kit.Kit, bizID, appID, groupID uint32) (*table.ReleasedGroup, error) {
+	rg, err := s.dao.ReleasedGroup().GetByGroupID(kt, bizID, appID, groupID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s",
+				i18n.T(kt, "group %d has not been published in the app", groupID))
+		}
+		logs.Errorf("get released group failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return rg, nil
+}
+
+// getPinGroupName returns the name of the group, 0 is the default group.
+func (s *Service) getPinGroupName(kt *kit.Kit, bizID, groupID uint32) (string, error) {
+	if groupID == 0 {
+		return "默认分组", nil
+	}
+
+	group, err := s.dao.Group().Get(kt, groupID, bizID)
+	if err != nil {
+		logs.Errorf("get group %d failed, err: %v, rid: %s", groupID, err, kt.Rid)
+		return "", err
+	}
+
+	return group.Spec.Name, nil
+}
+
+// getPinnedRelease returns the release which the group is pinned to, it is only used to record the audit,
+// so the release id is used as name if the release can not be found.
+func (s *Service) getPinnedRelease(kt *kit.Kit, rg *table.ReleasedGroup) *table.Release {
+	release, err := s.dao.Release().Get(kt, rg.BizID, rg.AppID, rg.PinnedReleaseID)
+	if err == nil {
+		return release
+	}
+
+	logs.Warnf("get pinned release %d failed, err: %v, rid: %s", rg.PinnedReleaseID, err, kt.Rid)
+	return &table.Release{
+		ID:         rg.PinnedReleaseID,
+		Spec:       &table.ReleaseSpec{Name: fmt.Sprintf("%d", rg.PinnedReleaseID)},
+		Attachment: &table.ReleaseAttachment{BizID: rg.BizID, AppID: rg.AppID},
+	}
+}
@@ -0,0 +1,230 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbtv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/template-variable"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
+)
+
+// UpdateGroupTmplVariables update the template variables overridden by the group of the app.
+func (s *Service) UpdateGroupTmplVariables(ctx context.Context, req *pbds.UpdateGroupTmplVariablesReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.getAppGroup(kt, req.BizId, req.AppId, req.GroupId); err != nil {
+		return nil, err
+	}
+
+	vars := make([]*table.TemplateVariableSpec, 0, len(req.Variables))
+	for _, v := range req.Variables {
+		if v == nil {
+			continue
+		}
+		vars = append(vars, v.TemplateVariableSpec())
+	}
+	if err := s.prepareAppVariables(kt, req.BizId, req.AppId, req.GroupId, vars); err != nil {
+		logs.Errorf("validate group template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	groupVar := &table.GroupTemplateVariable{
+		Spec: &table.GroupTemplateVariableSpec{Variables: vars},
+		Attachment: &table.GroupTemplateVariableAttachment{
+			BizID:    req.BizId,
+			AppID:    req.AppId,
+			GroupID:  req.GroupId,
+			TenantID: kt.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	if err := s.dao.GroupTemplateVariable().Upsert(kt, groupVar); err != nil {
+		logs.Errorf("update group template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListGroupTmplVariables list the template variables overridden by the group of the app.
+func (s *Service) ListGroupTmplVariables(ctx context.Context, req *pbds.ListGroupTmplVariablesReq) (
+	*pbds.ListGroupTmplVariablesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	vars, err := s.dao.GroupTemplateVariable().ListVariables(kt, req.BizId, req.AppId, req.GroupId)
+	if err != nil {
+		logs.Errorf("list group template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	details := make([]*pbtv.TemplateVariableSpec, 0, len(vars))
+	for _, v := range vars {
+		details = append(details, pbtv.PbTemplateVariableSpec(v.Masked()))
+	}
+
+	return &pbds.ListGroupTmplVariablesResp{Details: sortVariables(details)}, nil
+}
+
+// TraceAppTmplVariables trace the resolution of the app's template variables for the given groups or
+// the groups matched by the instance's labels and uid, which shows the value of each layer and the
+// layer which the final value comes from.
+func (s *Service) TraceAppTmplVariables(ctx context.Context, req *pbds.TraceAppTmplVariablesReq) (
+	*pbds.TraceAppTmplVariablesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	groupIDs := req.GroupIds
+	if len(groupIDs) == 0 {
+		var err error
+		if groupIDs, err = s.matchAppGroups(kt, req.BizId, req.AppId, req.Labels, req.Uid); err != nil {
+			return nil, err
+		}
+	}
+
+	extractResp, err := s.ExtractAppTmplVariables(ctx, &pbds.ExtractAppTmplVariablesReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("extract app template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	appVars, err := s.dao.AppTemplateVariable().ListVariables(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("list app template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	layers, err := s.getVariableLayers(kt, req.BizId, req.AppId, appVars, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := resolveVariables(extractResp.Details, layers)
+	details := make([]*pbds.TraceAppTmplVariablesResp_Detail, 0, len(extractResp.Details))
+	for _, name := range extractResp.Details {
+		rv := resolved[name]
+		detail := &pbds.TraceAppTmplVariablesResp_Detail{
+			Name:          name,
+			Source:        rv.scope,
+			SourceGroupId: rv.groupID,
+			Conflict:      rv.conflict,
+			Layers:        make([]*pbds.TraceAppTmplVariablesResp_Layer, 0, len(rv.hits)),
+		}
+		if rv.spec != nil {
+			masked := rv.spec.Masked()
+			detail.Type = string(masked.Type)
+			detail.Value = masked.DefaultVal
+		}
+		for _, hit := range rv.hits {
+			detail.Layers = append(detail.Layers, &pbds.TraceAppTmplVariablesResp_Layer{
+				Scope:   hit.scope,
+				GroupId: hit.groupID,
+				Value:   hit.spec.Masked().DefaultVal,
+			})
+		}
+		details = append(details, detail)
+	}
+
+	return &pbds.TraceAppTmplVariablesResp{
+		MatchedGroupIds: groupIDs,
+		Details:         details,
+	}, nil
+}
+
+// getAppGroup get the group which is available for the app.
+func (s *Service) getAppGroup(kt *kit.Kit, bizID, appID, groupID uint32) (*table.Group, error) {
+	groups, err := s.dao.Group().ListAppGroups(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list app groups failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	for _, g := range groups {
+		if g.ID == groupID {
+			return g, nil
+		}
+	}
+
+	return nil, errf.Errorf(errf.InvalidArgument, "%s",
+		i18n.T(kt, "group %d is not available for the app", groupID))
+}
+
+// matchAppGroups match the groups of the app with the instance's labels and uid.
+func (s *Service) matchAppGroups(kt *kit.Kit, bizID, appID uint32, labels map[string]string, uid string) (
+	[]uint32, error) {
+	groups, err := s.dao.Group().ListAppGroups(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list app groups failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	matched := make([]uint32, 0)
+	for _, g := range groups {
+		ok, err := matchGroupInstance(g, labels, uid)
+		if err != nil {
+			logs.Errorf("match group %d failed, err: %v, rid: %s", g.ID, err, kt.Rid)
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, g.ID)
+		}
+	}
+
+	return matched, nil
+}
+
+// matchGroupInstance check whether the instance with the labels and uid belongs to the group, the gray
+// percent of the custom group is ignored, because it's decided by the feed server when the instance pulls.
+func matchGroupInstance(g *table.Group, labels map[string]string, uid string) (bool, error) {
+	switch g.Spec.Mode {
+	case table.GroupModeDebug:
+		return uid != "" && g.Spec.UID == uid, nil
+	case table.GroupModeCustom:
+		if g.Spec.Selector == nil {
+			return false, nil
+		}
+		sel := &selector.Selector{
+			MatchAll:  g.Spec.Selector.MatchAll,
+			LabelsOr:  g.Spec.Selector.LabelsOr,
+			LabelsAnd: make(selector.Label, 0, len(g.Spec.Selector.LabelsAnd)),
+		}
+		for _, element := range g.Spec.Selector.LabelsAnd {
+			if element.Key != table.GrayPercentKey {
+				sel.LabelsAnd = append(sel.LabelsAnd, element)
+			}
+		}
+		// the group which only has the gray percent label matches all the instances
+		if sel.IsEmpty() {
+			return len(g.Spec.Selector.LabelsAnd) > 0, nil
+		}
+		return sel.MatchLabels(labels)
+	case table.GroupModeDynamic:
+		if g.Spec.Selector == nil || uid == "" {
+			return false, nil
+		}
+		return g.Spec.Selector.MatchLabels(map[string]string{table.DynamicGroupUIDKey: uid})
+	default:
+		return false, nil
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 	pbhook "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook"
+	pbtv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/template-variable"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
 	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 	"github.com/TencentBlueKing/bk-bscp/pkg/types"
@@ -202,12 +204,17 @@ func (s *Service) DeleteHook(ctx context.Context, req *pbds.DeleteHookReq) (*pbb
 		logs.Errorf("delete released hook failed, err: %v, rid: %s", e, kt.Rid)
 		return nil, e
 	}
-	// 3. delete all hook revisions by hook id
+	// 3. remove the hook from the template space hook libraries
+	if e := s.dao.TemplateSpaceHook().DeleteByHookIDWithTx(kt, tx, req.BizId, req.HookId); e != nil {
+		logs.Errorf("delete template space hook failed, err: %v, rid: %s", e, kt.Rid)
+		return nil, e
+	}
+	// 4. delete all hook revisions by hook id
 	if e := s.dao.HookRevision().DeleteByHookIDWithTx(kt, tx, req.HookId, req.BizId); e != nil {
 		logs.Errorf("delete hook revision failed, err: %v, rid: %s", e, kt.Rid)
 		return nil, e
 	}
-	// 4. delete hook
+	// 5. delete hook
 	hook := &table.Hook{
 		ID: req.HookId,
 		Attachment: &table.HookAttachment{
@@ -353,12 +360,20 @@ func (s *Service) ListHookReferences(ctx context.Context,
 			ReleaseName:      result.ReleaseName,
 			Type:             result.HookType,
 			Deprecated:       result.Deprecated,
+			Params:           table.ReleasedHook{Params: result.Params}.ParamValues(),
 		})
 	}
 
+	spaceIDs, err := s.dao.TemplateSpaceHook().ListTemplateSpaceIDs(kt, req.BizId, req.HookId)
+	if err != nil {
+		logs.Errorf("list template spaces of hook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
 	resp := &pbds.ListHookReferencesResp{
-		Count:   uint32(count),
-		Details: details,
+		Count:            uint32(count),
+		Details:          details,
+		TemplateSpaceIds: spaceIDs,
 	}
 
 	return resp, nil
@@ -388,6 +403,7 @@ func (s *Service) GetReleaseHook(ctx context.Context, req *pbds.GetReleaseHookRe
 			HookRevisionName: preHook.HookRevisionName,
 			Type:             preHook.ScriptType.String(),
 			Content:          preHook.Content,
+			Params:           preHook.ParamValues(),
 		}
 	}
 	if postHook != nil {
@@ -398,6 +414,7 @@ func (s *Service) GetReleaseHook(ctx context.Context, req *pbds.GetReleaseHookRe
 			HookRevisionName: postHook.HookRevisionName,
 			Type:             postHook.ScriptType.String(),
 			Content:          postHook.Content,
+			Params:           postHook.ParamValues(),
 		}
 	}
 	resp := &pbds.GetReleaseHookResp{
@@ -437,3 +454,74 @@ func (s *Service) GetHookReferencedIDs(ctx context.Context, req *pbds.GetHookRef
 		Ids: ids,
 	}, nil
 }
+
+// ImportTemplateSpaceHooks import the hooks into the template space as a shared hook library.
+func (s *Service) ImportTemplateSpaceHooks(ctx context.Context, req *pbds.ImportTemplateSpaceHooksReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	hookIDs := tools.RemoveDuplicates(req.HookIds)
+	if err := s.dao.TemplateSpaceHook().BatchCreate(kt, req.BizId, req.TemplateSpaceId, hookIDs); err != nil {
+		logs.Errorf("import template space hooks failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListTemplateSpaceHooks list the hooks imported into the template space with the params of the published revision.
+func (s *Service) ListTemplateSpaceHooks(ctx context.Context, req *pbds.ListTemplateSpaceHooksReq) (
+	*pbds.ListTemplateSpaceHooksResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	imported, err := s.dao.TemplateSpaceHook().List(kt, req.BizId, req.TemplateSpaceId)
+	if err != nil {
+		logs.Errorf("list template space hooks failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	details := make([]*pbds.ListTemplateSpaceHooksResp_Detail, 0, len(imported))
+	for _, one := range imported {
+		hook, err := s.dao.Hook().GetByID(kt, req.BizId, one.Attachment.HookID)
+		if err != nil {
+			logs.Errorf("get hook %d failed, err: %v, rid: %s", one.Attachment.HookID, err, kt.Rid)
+			return nil, err
+		}
+
+		var params []*pbtv.TemplateVariableSpec
+		revision, err := s.dao.HookRevision().GetByPubState(kt, &types.GetByPubStateOption{
+			BizID:  req.BizId,
+			HookID: hook.ID,
+			State:  table.HookRevisionStatusDeployed,
+		})
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Errorf("get published revision of hook %d failed, err: %v, rid: %s", hook.ID, err, kt.Rid)
+			return nil, err
+		}
+		if err == nil {
+			params = pbtv.PbTemplateVariableSpecs(revision.Spec.Params)
+		}
+
+		details = append(details, &pbds.ListTemplateSpaceHooksResp_Detail{
+			Hook:       pbhook.PbHook(hook),
+			Params:     params,
+			ImportedBy: one.Revision.Creator,
+			ImportedAt: one.Revision.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &pbds.ListTemplateSpaceHooksResp{Details: details}, nil
+}
+
+// DeleteTemplateSpaceHook remove the hook from the template space.
+func (s *Service) DeleteTemplateSpaceHook(ctx context.Context, req *pbds.DeleteTemplateSpaceHookReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.dao.TemplateSpaceHook().Delete(kt, req.BizId, req.TemplateSpaceId, req.HookId); err != nil {
+		logs.Errorf("delete template space hook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
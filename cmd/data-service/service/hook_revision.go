@@ -294,6 +294,9 @@ func (s *Service) PublishHookRevision(ctx context.Context, req *pbds.PublishHook
 	}
 	committed = true
 
+	s.emitWebhookEvent(kt, req.BizId, 0, table.WebhookHookPublished, &webhookHookData{
+		HookID: req.HookId, RevisionID: hookRevision.ID, RevisionName: hookRevision.Spec.Name})
+
 	return new(pbbase.EmptyResp), nil
 
 }
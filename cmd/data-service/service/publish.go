@@ -92,6 +92,12 @@ func (s *Service) SubmitPublishApprove(
 		return nil, fmt.Errorf(i18n.T(grpcKit, "release %s is deprecated, can not be submited", release.Spec.Name))
 	}
 
+	// 变更冻结窗口内仅允许紧急变更人员上线
+	if err = s.checkChangeFreeze(grpcKit, req.BizId, req.AppId,
+		fmt.Sprintf("publish release %s", release.Spec.Name)); err != nil {
+		return nil, err
+	}
+
 	// 获取最近的上线版本
 	strategy, err := s.dao.Strategy().GetLast(grpcKit, req.BizId, req.AppId, 0, 0)
 	if err != nil {
@@ -108,6 +114,12 @@ func (s *Service) SubmitPublishApprove(
 		return nil, errors.New(i18n.T(grpcKit, "there is a release in publishing currently"))
 	}
 
+	// 启用了内置审批流程的服务不依赖 itsm 审批
+	flow, err := s.getEnabledApprovalFlow(grpcKit, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := s.dao.GenQuery().Begin()
 	committed := false
 	defer func() {
@@ -167,8 +179,16 @@ func (s *Service) SubmitPublishApprove(
 		return nil, err
 	}
 
-	// itsm流程创建ticket
-	if app.Spec.IsApprove {
+	switch {
+	case app.Spec.IsApprove && flow != nil:
+		// 内置审批引擎创建审批单
+		if opt.PublishStatus == table.PendingApproval {
+			if err = s.createApprovalTicket(grpcKit, tx, flow, release.ID, pshID); err != nil {
+				return nil, err
+			}
+		}
+	case app.Spec.IsApprove:
+		// itsm流程创建ticket
 		scope := strings.Join(groupName, constant.NameSeparator)
 		ticketData, errCreate := s.submitCreateApproveTicket(
 			grpcKit, app, release.Spec.Name, scope, req.Memo, ad.GetAuditID(), release.ID)
@@ -209,6 +229,11 @@ func (s *Service) SubmitPublishApprove(
 	}
 	committed = true
 
+	if opt.PublishStatus == table.AlreadyPublish {
+		s.emitWebhookEvent(grpcKit, req.BizId, req.AppId, table.WebhookReleasePublished, &webhookReleaseData{
+			ReleaseID: release.ID, ReleaseName: release.Spec.Name, StrategyID: pshID, Memo: req.Memo})
+	}
+
 	resp := &pbds.PublishResp{
 		PublishedStrategyHistoryId: pshID,
 		HaveCredentials:            haveCredentials,
@@ -248,10 +273,16 @@ func (s *Service) Approve(ctx context.Context, req *pbds.ApproveReq) (*pbds.Appr
 		return &pbds.ApproveResp{}, nil
 	}
 
+	// 内置审批引擎的审批单，为空表示由 itsm 审批
+	ticket, err := s.getApprovalTicket(grpcKit, req.BizId, strategy.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	var message string
 	// 获取itsm ticket状态，不审批的不查
 	// message 不为空的情况：itsm操作后数据不正常的message皆不为空，但数据库需要更新
-	if app.Spec.IsApprove {
+	if app.Spec.IsApprove && ticket == nil {
 		// 获取active key
 		activeKey := ""
 
@@ -295,6 +326,11 @@ func (s *Service) Approve(ctx context.Context, req *pbds.ApproveReq) (*pbds.Appr
 		if err != nil {
 			return nil, err
 		}
+		if ticket != nil {
+			if err = s.revokeApprovalTicket(grpcKit, tx, ticket, req.Reason); err != nil {
+				return nil, err
+			}
+		}
 		itsmUpdata = api.ApprovalTicketReq{
 			TicketID:      strategy.Spec.ItsmTicketSn,
 			Operator:      strategy.Revision.Creator,
@@ -302,7 +338,11 @@ func (s *Service) Approve(ctx context.Context, req *pbds.ApproveReq) (*pbds.Appr
 			ActionType:    "WITHDRAW",
 		}
 	case string(table.RejectedApproval):
-		updateContent, err = s.rejectApprove(grpcKit, req, strategy)
+		if ticket != nil {
+			updateContent, err = s.voteApprovalTicket(grpcKit, tx, req, strategy, ticket, table.ApprovalActionReject)
+		} else {
+			updateContent, err = s.rejectApprove(grpcKit, req, strategy)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -314,7 +354,11 @@ func (s *Service) Approve(ctx context.Context, req *pbds.ApproveReq) (*pbds.Appr
 			Desc:     req.Reason,
 		}
 	case string(table.PendingPublish):
-		updateContent, err = s.passApprove(grpcKit, tx, req, strategy)
+		if ticket != nil {
+			updateContent, err = s.voteApprovalTicket(grpcKit, tx, req, strategy, ticket, table.ApprovalActionApprove)
+		} else {
+			updateContent, err = s.passApprove(grpcKit, tx, req, strategy)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -325,6 +369,10 @@ func (s *Service) Approve(ctx context.Context, req *pbds.ApproveReq) (*pbds.Appr
 			Action:   "true",
 		}
 	case string(table.AlreadyPublish):
+		if err = s.checkChangeFreeze(grpcKit, req.BizId, req.AppId,
+			fmt.Sprintf("publish release %s", release.Spec.Name)); err != nil {
+			return nil, err
+		}
 		updateContent, err = s.publishApprove(grpcKit, tx, req, strategy)
 		if err != nil {
 			return nil, err
@@ -404,6 +452,10 @@ func (s *Service) Approve(ctx context.Context, req *pbds.ApproveReq) (*pbds.Appr
 		return nil, err
 	}
 	committed = true
+
+	publishStatus, _ := updateContent["publish_status"].(table.PublishStatus)
+	s.emitApproveWebhookEvents(grpcKit, req, release, strategy, publishStatus)
+
 	return &pbds.ApproveResp{
 		HaveCredentials: haveCredentials,
 		HavePull:        havePull,
@@ -428,6 +480,12 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbds.Gener
 		return nil, errors.New(i18n.T(grpcKit, "release name %s already exists", req.ReleaseName))
 	}
 
+	// 变更冻结窗口内仅允许紧急变更人员上线
+	if err = s.checkChangeFreeze(grpcKit, req.BizId, req.AppId,
+		fmt.Sprintf("publish release %s", req.ReleaseName)); err != nil {
+		return nil, err
+	}
+
 	// 获取最近的上线版本
 	strategy, err := s.dao.Strategy().GetLast(grpcKit, req.BizId, req.AppId, 0, 0)
 	if err != nil {
@@ -446,6 +504,12 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbds.Gener
 		return nil, errors.New(i18n.T(grpcKit, "there is a release in publishing currently"))
 	}
 
+	// 启用了内置审批流程的服务不依赖 itsm 审批
+	flow, err := s.getEnabledApprovalFlow(grpcKit, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
 	// 默认要回滚，除非已经提交
 	tx := s.dao.GenQuery().Begin()
 	committed := false
@@ -573,8 +637,14 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbds.Gener
 		return nil, err
 	}
 
-	// itsm流程创建ticket
-	if app.Spec.IsApprove {
+	switch {
+	case app.Spec.IsApprove && flow != nil:
+		// 内置审批引擎创建审批单
+		if err = s.createApprovalTicket(grpcKit, tx, flow, releaseID, pshID); err != nil {
+			return nil, err
+		}
+	case app.Spec.IsApprove:
+		// itsm流程创建ticket
 		scope := strings.Join(groupName, constant.NameSeparator)
 		ticketData, errCreate := s.submitCreateApproveTicket(
 			grpcKit, app, release.Spec.Name, scope, req.ReleaseMemo, ad.GetAuditID(), release.ID)
@@ -603,6 +673,14 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbds.Gener
 		return nil, err
 	}
 	committed = true
+
+	s.emitWebhookEvent(kt, req.BizId, req.AppId, table.WebhookReleaseCreated, &webhookReleaseData{
+		ReleaseID: releaseID, ReleaseName: release.Spec.Name, Memo: req.ReleaseMemo})
+	if opt.PublishStatus == table.AlreadyPublish {
+		s.emitWebhookEvent(kt, req.BizId, req.AppId, table.WebhookReleasePublished, &webhookReleaseData{
+			ReleaseID: releaseID, ReleaseName: release.Spec.Name, StrategyID: pshID, Memo: req.ReleaseMemo})
+	}
+
 	return &pbds.PublishResp{PublishedStrategyHistoryId: pshID}, nil
 }
 
@@ -725,31 +803,55 @@ func (s *Service) passApprove(
 		}
 	}
 
-	// 自动上线则直接上线
-	if publishStatus == table.PendingPublish && strategy.Spec.PublishType == table.Automatically {
-		opt := types.PublishOption{
-			BizID:     req.BizId,
-			AppID:     req.AppId,
-			ReleaseID: req.ReleaseId,
-			All:       false,
-		}
-
-		if len(strategy.Spec.Scope.Groups) == 0 {
-			opt.All = true
-		}
-
-		err := s.dao.Publish().UpsertPublishWithTx(kit, tx, &opt, strategy)
-
+	if publishStatus == table.PendingPublish {
+		var err error
+		publishStatus, err = s.publishApproved(kit, tx, req, strategy)
 		if err != nil {
 			return nil, err
 		}
-		publishStatus = table.AlreadyPublish
 	}
 
 	result["publish_status"] = publishStatus
 	return result, nil
 }
 
+// publishApproved returns the publish status after the approval is passed, the strategy is published
+// directly if it is published automatically.
+func (s *Service) publishApproved(
+	kit *kit.Kit, tx *gen.QueryTx, req *pbds.ApproveReq, strategy *table.Strategy) (table.PublishStatus, error) {
+
+	// 自动上线则直接上线
+	if strategy.Spec.PublishType != table.Automatically {
+		return table.PendingPublish, nil
+	}
+
+	// 变更冻结窗口按上线提交人检查，冻结期间审批通过会被拒绝，需在冻结结束后重试
+	submitter := kit.Clone()
+	submitter.User = strategy.Revision.Creator
+	if err := s.checkChangeFreeze(submitter, req.BizId, req.AppId,
+		fmt.Sprintf("publish release %d automatically", req.ReleaseId)); err != nil {
+		return "", err
+	}
+
+	opt := types.PublishOption{
+		BizID:     req.BizId,
+		AppID:     req.AppId,
+		ReleaseID: req.ReleaseId,
+		All:       false,
+	}
+
+	if len(strategy.Spec.Scope.Groups) == 0 {
+		opt.All = true
+	}
+
+	err := s.dao.Publish().UpsertPublishWithTx(kit, tx, &opt, strategy)
+
+	if err != nil {
+		return "", err
+	}
+	return table.AlreadyPublish, nil
+}
+
 // publishApprove publish approve.
 func (s *Service) publishApprove(
 	kit *kit.Kit, tx *gen.QueryTx, req *pbds.ApproveReq, strategy *table.Strategy) (map[string]interface{}, error) {
@@ -971,6 +1073,9 @@ func (s *Service) createReleasedHook(grpcKit *kit.Kit, tx *gen.QueryTx, bizID, a
 	if err == nil {
 		pre.ID = 0
 		pre.ReleaseID = releaseID
+		if e := s.renderReleasedHook(grpcKit, pre); e != nil {
+			return e
+		}
 		if _, e := s.dao.ReleasedHook().CreateWithTx(grpcKit, tx, pre); e != nil {
 			logs.Errorf("create released pre-hook failed, err: %v, rid: %s", e, grpcKit.Rid)
 			return e
@@ -983,6 +1088,9 @@ func (s *Service) createReleasedHook(grpcKit *kit.Kit, tx *gen.QueryTx, bizID, a
 	if err == nil {
 		post.ID = 0
 		post.ReleaseID = releaseID
+		if e := s.renderReleasedHook(grpcKit, post); e != nil {
+			return e
+		}
 		if _, e := s.dao.ReleasedHook().CreateWithTx(grpcKit, tx, post); e != nil {
 			logs.Errorf("create released post-hook failed, err: %v, rid: %s", e, grpcKit.Rid)
 			return e
@@ -82,29 +82,7 @@ func (s *Service) CreateRelease(ctx context.Context, req *pbds.CreateReleaseReq)
 	}
 
 	// 2. create released hook.
-	pre, err := s.dao.ReleasedHook().Get(grpcKit, req.Attachment.BizId, req.Attachment.AppId, 0, table.PreHook)
-	if err == nil {
-		pre.ID = 0
-		pre.ReleaseID = release.ID
-		if _, e := s.dao.ReleasedHook().CreateWithTx(grpcKit, tx, pre); e != nil {
-			logs.Errorf("create released pre-hook failed, err: %v, rid: %s", e, grpcKit.Rid)
-			return nil, e
-		}
-	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
-		logs.Errorf("query released pre-hook failed, err: %v, rid: %s", err, grpcKit.Rid)
-		return nil, err
-	}
-
-	post, err := s.dao.ReleasedHook().Get(grpcKit, req.Attachment.BizId, req.Attachment.AppId, 0, table.PostHook)
-	if err == nil {
-		post.ID = 0
-		post.ReleaseID = release.ID
-		if _, e := s.dao.ReleasedHook().CreateWithTx(grpcKit, tx, post); e != nil {
-			logs.Errorf("create released post-hook failed, err: %v, rid: %s", e, grpcKit.Rid)
-			return nil, e
-		}
-	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
-		logs.Errorf("query released post-hook failed, err: %v, rid: %s", err, grpcKit.Rid)
+	if err = s.createReleasedHook(grpcKit, tx, req.Attachment.BizId, req.Attachment.AppId, release.ID); err != nil {
 		return nil, err
 	}
 
@@ -168,6 +146,10 @@ func (s *Service) CreateRelease(ctx context.Context, req *pbds.CreateReleaseReq)
 		return nil, err
 	}
 	committed = true
+
+	s.emitWebhookEvent(grpcKit, req.Attachment.BizId, req.Attachment.AppId, table.WebhookReleaseCreated,
+		&webhookReleaseData{ReleaseID: id, ReleaseName: release.Spec.Name, Memo: release.Spec.Memo})
+
 	return &pbds.CreateResp{Id: id}, nil
 }
 
@@ -185,16 +167,16 @@ func (s *Service) CreateRelease(ctx context.Context, req *pbds.CreateReleaseReq)
 func (s *Service) doConfigItemOperations(kt *kit.Kit, variables []*pbtv.TemplateVariableSpec,
 	tx *gen.QueryTx, releaseID uint32, tmplRevisions []*table.TemplateRevision, cis []*pbci.ConfigItem) error {
 	// validate input variables and get the map
-	inputVarMap := make(map[string]*table.TemplateVariableSpec)
+	inputVars := make([]*table.TemplateVariableSpec, 0, len(variables))
 	for _, v := range variables {
 		if v == nil {
 			continue
 		}
-		if err := v.TemplateVariableSpec().ValidateCreate(kt); err != nil {
-			logs.Errorf("validate template variables failed, err: %v, rid: %s", err, kt.Rid)
-			return err
-		}
-		inputVarMap[v.Name] = v.TemplateVariableSpec()
+		inputVars = append(inputVars, v.TemplateVariableSpec())
+	}
+	if err := s.prepareAppVariables(kt, kt.BizID, kt.AppID, 0, inputVars); err != nil {
+		logs.Errorf("validate template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return err
 	}
 
 	tmplsNeedRender := filterSizeForTmplRevisions(tmplRevisions)
@@ -219,7 +201,7 @@ func (s *Service) doConfigItemOperations(kt *kit.Kit, variables []*pbtv.Template
 		return err
 	}
 
-	usedVars, renderKV, err := s.getRenderedVars(kt, allVars, inputVarMap)
+	usedVars, renderKV, err := s.getRenderedVars(kt, allVars, inputVars)
 	if err != nil {
 		logs.Errorf("get rendered variables failed, err: %v, rid: %s", err, kt.Rid)
 		return err
@@ -231,9 +213,13 @@ func (s *Service) doConfigItemOperations(kt *kit.Kit, variables []*pbtv.Template
 	md5Map := make(map[uint32]string, len(tmplRevisions))
 	byteSizeMap := make(map[uint32]uint64, len(tmplRevisions))
 	revisionMap := make(map[uint32]*table.TemplateRevision, len(tmplRevisions))
+	// the origin content of the rendered config items, which are rendered again with the group's variables
+	groupTargets := make([]*groupRenderTarget, 0, len(tmplsNeedRender)+len(cisNeedRender))
+	tmplTargets := make(map[uint32]*groupRenderTarget, len(tmplsNeedRender))
 	// data which need render
 	for idx, r := range tmplsNeedRender {
 		revisionMap[r.ID] = r
+		tmplTargets[r.ID] = &groupRenderTarget{content: contents[idx]}
 		renderedContentMap[r.ID] = s.tmplProc.Render(contents[idx], renderKV)
 		signatureMap[r.ID] = tools.ByteSHA256(renderedContentMap[r.ID])
 		md5Map[r.ID] = tools.ByteMD5(renderedContentMap[r.ID])
@@ -256,9 +242,11 @@ func (s *Service) doConfigItemOperations(kt *kit.Kit, variables []*pbtv.Template
 	ciMd5Map := make(map[uint32]string, len(cis))
 	ciByteSizeMap := make(map[uint32]uint64, len(cis))
 	ciMap := make(map[uint32]*pbci.ConfigItem, len(cis))
+	ciTargets := make(map[uint32]*groupRenderTarget, len(cisNeedRender))
 	// data which need render
 	for idx, ci := range cisNeedRender {
 		ciMap[ci.Id] = ci
+		ciTargets[ci.Id] = &groupRenderTarget{content: ciContents[idx]}
 		ciRenderedContentMap[ci.Id] = s.tmplProc.Render(ciContents[idx], renderKV)
 		ciSignatureMap[ci.Id] = tools.ByteSHA256(ciRenderedContentMap[ci.Id])
 		ciMd5Map[ci.Id] = tools.ByteMD5(ciRenderedContentMap[ci.Id])
@@ -286,17 +274,36 @@ func (s *Service) doConfigItemOperations(kt *kit.Kit, variables []*pbtv.Template
 		return e
 	}
 
-	if e := s.createReleasedRenderedTemplateCIs(kt, tx, releaseID, tmplRevisions, renderedContentMap, byteSizeMap,
-		signatureMap, md5Map); e != nil {
-		logs.Errorf("create released rendered template config items failed, err: %v, rid: %s", e, kt.Rid)
-		return e
+	tmplReleasedCIs, err := s.createReleasedRenderedTemplateCIs(kt, tx, releaseID, tmplRevisions,
+		renderedContentMap, byteSizeMap, signatureMap, md5Map)
+	if err != nil {
+		logs.Errorf("create released rendered template config items failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	for idx, r := range tmplRevisions {
+		if target, ok := tmplTargets[r.ID]; ok {
+			target.releasedCI = tmplReleasedCIs[idx]
+			groupTargets = append(groupTargets, target)
+		}
 	}
 
-	if err = s.createReleasedRenderedCIs(kt, tx, releaseID, cis, ciRenderedContentMap, ciByteSizeMap,
-		ciSignatureMap, ciMd5Map); err != nil {
+	releasedCIs, err := s.createReleasedRenderedCIs(kt, tx, releaseID, cis, ciRenderedContentMap, ciByteSizeMap,
+		ciSignatureMap, ciMd5Map)
+	if err != nil {
 		logs.Errorf("create released config items failed, err: %v, rid: %s", err, kt.Rid)
 		return err
 	}
+	for _, rci := range releasedCIs {
+		if target, ok := ciTargets[rci.ConfigItemID]; ok {
+			target.releasedCI = rci
+			groupTargets = append(groupTargets, target)
+		}
+	}
+
+	if e := s.createReleasedGroupCIs(kt, tx, releaseID, inputVars, allVars, renderKV, groupTargets); e != nil {
+		logs.Errorf("create released group config items failed, err: %v, rid: %s", e, kt.Rid)
+		return e
+	}
 
 	if e := s.createReleasedAppTemplates(kt, tx, releaseID, renderedContentMap, byteSizeMap, signatureMap,
 		md5Map); e != nil {
@@ -317,35 +324,36 @@ func (s *Service) doConfigItemOperations(kt *kit.Kit, variables []*pbtv.Template
 	return nil
 }
 
-func (s *Service) getRenderedVars(kt *kit.Kit, allVars []string, inputVarMap map[string]*table.TemplateVariableSpec) (
+// getRenderedVars get the variables used to render the app's templates, the used variables are resolved
+// with the layers of biz -> app, the layers of the groups are resolved by createReleasedGroupCIs.
+func (s *Service) getRenderedVars(kt *kit.Kit, allVars []string, inputVars []*table.TemplateVariableSpec) (
 	[]*table.TemplateVariableSpec, map[string]interface{}, error) {
-	// get biz template variables
-	bizVars, _, err := s.dao.TemplateVariable().List(kt, kt.BizID, nil, &types.BasePage{All: true})
+	layers, err := s.getVariableLayers(kt, kt.BizID, kt.AppID, inputVars, nil)
 	if err != nil {
-		logs.Errorf("list template variables failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, nil, err
 	}
-	bizVarMap := make(map[string]*table.TemplateVariableSpec, len(bizVars))
-	for _, v := range bizVars {
-		bizVarMap[v.Spec.Name] = v.Spec
-	}
 
 	// get variables which are used to render the template
 	usedVars := make([]*table.TemplateVariableSpec, 0)
 	renderKV := make(map[string]interface{})
 	var missingVars []string
+	resolved := resolveVariables(allVars, layers)
 	for _, name := range allVars {
-		if _, ok := inputVarMap[name]; ok {
-			usedVars = append(usedVars, inputVarMap[name])
-			renderKV[name] = inputVarMap[name].DefaultVal
+		rv := resolved[name]
+		if rv.spec == nil {
+			missingVars = append(missingVars, name)
 			continue
 		}
-		if _, ok := bizVarMap[name]; ok {
-			usedVars = append(usedVars, bizVarMap[name])
-			renderKV[name] = bizVarMap[name].DefaultVal
-			continue
+
+		usedVars = append(usedVars, rv.spec.Masked())
+		renderKV[name] = rv.spec.DefaultVal
+		// the secret value is only resolved from vault when it's rendered
+		if rv.spec.IsSecret() {
+			if renderKV[name], err = s.getSecretVariable(kt, kt.BizID, kt.AppID, 0, name); err != nil {
+				logs.Errorf("get secret variable %s failed, err: %v, rid: %s", name, err, kt.Rid)
+				return nil, nil, err
+			}
 		}
-		missingVars = append(missingVars, name)
 	}
 	if len(missingVars) > 0 {
 		logs.Errorf("variable name in %v is missing for render the app's template config, rid: %s", missingVars, kt.Rid)
@@ -355,10 +363,168 @@ func (s *Service) getRenderedVars(kt *kit.Kit, allVars []string, inputVarMap map
 	return usedVars, renderKV, nil
 }
 
+// getVariableLayers get the variable layers of biz -> app -> groups, the app layer is the given app variables.
+func (s *Service) getVariableLayers(kt *kit.Kit, bizID, appID uint32, appVars []*table.TemplateVariableSpec,
+	groupIDs []uint32) ([]*variableLayer, error) {
+	bizVars, _, err := s.dao.TemplateVariable().List(kt, bizID, nil, &types.BasePage{All: true})
+	if err != nil {
+		logs.Errorf("list template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	bizSpecs := make([]*table.TemplateVariableSpec, 0, len(bizVars))
+	for _, v := range bizVars {
+		bizSpecs = append(bizSpecs, v.Spec)
+	}
+
+	layers := []*variableLayer{
+		newVariableLayer(bizVariableScope, 0, bizSpecs),
+		newVariableLayer(appVariableScope, 0, appVars),
+	}
+	if len(groupIDs) == 0 {
+		return layers, nil
+	}
+
+	groupVars, err := s.dao.GroupTemplateVariable().ListByGroupIDs(kt, bizID, appID, groupIDs)
+	if err != nil {
+		logs.Errorf("list group template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	for _, g := range groupVars {
+		layers = append(layers, newVariableLayer(groupVariableScope, g.Attachment.GroupID, g.Spec.Variables))
+	}
+
+	return layers, nil
+}
+
+// groupRenderTarget is a released config item which is rendered with the template variables.
+type groupRenderTarget struct {
+	releasedCI *table.ReleasedConfigItem
+	// content is the origin content of the config item before rendered
+	content []byte
+}
+
+// createReleasedGroupCIs render the config items of the release with the template variables overridden by
+// each group of the app, the contents which differ from the release's are saved as the group's contents,
+// and the feed server delivers them to the instances matched by the group. The groups are resolved one by
+// one, so the groups which override a variable with different values are not conflicted with each other.
+func (s *Service) createReleasedGroupCIs(kt *kit.Kit, tx *gen.QueryTx, releaseID uint32,
+	appVars []*table.TemplateVariableSpec, allVars []string, renderKV map[string]interface{},
+	targets []*groupRenderTarget) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	groupVars, err := s.dao.GroupTemplateVariable().ListByAppID(kt, kt.BizID, kt.AppID)
+	if err != nil {
+		logs.Errorf("list group template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	if len(groupVars) == 0 {
+		return nil
+	}
+
+	layers, err := s.getVariableLayers(kt, kt.BizID, kt.AppID, appVars, nil)
+	if err != nil {
+		return err
+	}
+
+	items := make([]*table.ReleasedGroupConfigItem, 0)
+	contents := make(map[string][]byte)
+	for _, g := range groupVars {
+		groupID := g.Attachment.GroupID
+		groupKV, err := s.getGroupRenderedVars(kt, groupID, allVars, renderKV,
+			append(layers, newVariableLayer(groupVariableScope, groupID, g.Spec.Variables)))
+		if err != nil {
+			return err
+		}
+		// none of the used variables is overridden by the group
+		if groupKV == nil {
+			continue
+		}
+
+		for _, target := range targets {
+			rendered := s.tmplProc.Render(target.content, groupKV)
+			signature := tools.ByteSHA256(rendered)
+			if signature == target.releasedCI.CommitSpec.Content.Signature {
+				continue
+			}
+			contents[signature] = rendered
+			items = append(items, &table.ReleasedGroupConfigItem{
+				Spec: &table.ReleasedGroupConfigItemSpec{
+					Signature: signature,
+					Md5:       tools.ByteMD5(rendered),
+					ByteSize:  uint64(len(rendered)),
+				},
+				Attachment: &table.ReleasedGroupConfigItemAttachment{
+					BizID:                kt.BizID,
+					AppID:                kt.AppID,
+					ReleaseID:            releaseID,
+					GroupID:              groupID,
+					ReleasedConfigItemID: target.releasedCI.ID,
+					TenantID:             kt.TenantID,
+				},
+				Revision: &table.CreatedRevision{
+					Creator: kt.User,
+				},
+			})
+		}
+	}
+
+	if e := s.uploadGroupRenderedContent(kt, contents); e != nil {
+		logs.Errorf("upload group rendered content failed, err: %v, rid: %s", e, kt.Rid)
+		return e
+	}
+
+	if e := s.dao.ReleasedGroupCI().BulkCreateWithTx(kt, tx, items); e != nil {
+		logs.Errorf("bulk create released group config items failed, err: %v, rid: %s", e, kt.Rid)
+		return e
+	}
+
+	return nil
+}
+
+// getGroupRenderedVars get the variables to render the app's templates for the instances matched by the group,
+// it returns nil if none of the used variables is overridden by the group.
+func (s *Service) getGroupRenderedVars(kt *kit.Kit, groupID uint32, allVars []string,
+	renderKV map[string]interface{}, layers []*variableLayer) (map[string]interface{}, error) {
+	var groupKV map[string]interface{}
+	resolved := resolveVariables(allVars, layers)
+	for _, name := range allVars {
+		rv := resolved[name]
+		if rv.spec == nil || rv.scope != groupVariableScope {
+			continue
+		}
+
+		var val interface{} = rv.spec.DefaultVal
+		if rv.spec.IsSecret() {
+			secret, err := s.getSecretVariable(kt, kt.BizID, kt.AppID, groupID, name)
+			if err != nil {
+				logs.Errorf("get secret variable %s of group %d failed, err: %v, rid: %s", name, groupID, err,
+					kt.Rid)
+				return nil, err
+			}
+			val = secret
+		}
+		if val == renderKV[name] {
+			continue
+		}
+
+		if groupKV == nil {
+			groupKV = make(map[string]interface{}, len(renderKV))
+			for k, v := range renderKV {
+				groupKV[k] = v
+			}
+		}
+		groupKV[name] = val
+	}
+
+	return groupKV, nil
+}
+
 // createReleasedRenderedTemplateCIs create released rendered templates config items.
 func (s *Service) createReleasedRenderedTemplateCIs(kt *kit.Kit, tx *gen.QueryTx, releaseID uint32,
 	tmplRevisions []*table.TemplateRevision, renderedContentMap map[uint32][]byte, byteSizeMap map[uint32]uint64,
-	signatureMap map[uint32]string, md5Map map[uint32]string) error {
+	signatureMap map[uint32]string, md5Map map[uint32]string) ([]*table.ReleasedConfigItem, error) {
 	releasedCIs := make([]*table.ReleasedConfigItem, len(tmplRevisions))
 	for idx, r := range tmplRevisions {
 		creator := r.Revision.Creator
@@ -407,19 +573,19 @@ func (s *Service) createReleasedRenderedTemplateCIs(kt *kit.Kit, tx *gen.QueryTx
 	}
 	if err := s.dao.ReleasedCI().BulkCreateWithTx(kt, tx, releasedCIs); err != nil {
 		logs.Errorf("bulk create released rendered template config item failed, err: %v, rid: %s", err, kt.Rid)
-		return err
+		return nil, err
 	}
 
-	return nil
+	return releasedCIs, nil
 }
 
 // createReleasedRenderedCIs create released rendered config items
 func (s *Service) createReleasedRenderedCIs(kt *kit.Kit, tx *gen.QueryTx, releaseID uint32, cis []*pbci.ConfigItem,
 	ciRenderedContentMap map[uint32][]byte, byteSizeMap map[uint32]uint64, signatureMap map[uint32]string,
-	md5Map map[uint32]string) error {
+	md5Map map[uint32]string) ([]*table.ReleasedConfigItem, error) {
 	releasedCIs := make([]*table.ReleasedConfigItem, 0)
 	if len(cis) == 0 {
-		return nil
+		return releasedCIs, nil
 	}
 
 	for _, ci := range cis {
@@ -427,7 +593,7 @@ func (s *Service) createReleasedRenderedCIs(kt *kit.Kit, tx *gen.QueryTx, releas
 		commit, e := s.dao.Commit().GetLatestCommit(kt, kt.BizID, kt.AppID, ci.Id)
 		if e != nil {
 			logs.Errorf("query config item latest commit failed, err: %v, rid: %s", e, kt.Rid)
-			return e
+			return nil, e
 		}
 
 		creator := ci.Revision.Creator
@@ -437,12 +603,12 @@ func (s *Service) createReleasedRenderedCIs(kt *kit.Kit, tx *gen.QueryTx, releas
 		createdAt, err = time.Parse(time.RFC3339, ci.Revision.CreateAt)
 		if err != nil {
 			logs.Errorf("parse time from createAt string failed, err: %v, rid: %s", err, kt.Rid)
-			return fmt.Errorf("parse time from createAt string failed, err: %v", err)
+			return nil, fmt.Errorf("parse time from createAt string failed, err: %v", err)
 		}
 		updatedAt, err = time.Parse(time.RFC3339, ci.Revision.UpdateAt)
 		if err != nil {
 			logs.Errorf("parse time from UpdateAt string failed, err: %v, rid: %s", err, kt.Rid)
-			return fmt.Errorf("parse time from UpdateAt string failed, err: %v", err)
+			return nil, fmt.Errorf("parse time from UpdateAt string failed, err: %v", err)
 		}
 		// if rendered with variables, which means the config item is new generated, update the user and time
 		if _, ok := ciRenderedContentMap[ci.Id]; ok {
@@ -496,10 +662,10 @@ func (s *Service) createReleasedRenderedCIs(kt *kit.Kit, tx *gen.QueryTx, releas
 	}
 	if err := s.dao.ReleasedCI().BulkCreateWithTx(kt, tx, releasedCIs); err != nil {
 		logs.Errorf("bulk create released config item failed, err: %v, rid: %s", err, kt.Rid)
-		return err
+		return nil, err
 	}
 
-	return nil
+	return releasedCIs, nil
 }
 
 // createReleasedAppTemplates create released app templates.
@@ -807,6 +973,77 @@ func (s *Service) UnDeprecateRelease(ctx context.Context, req *pbds.UnDeprecateR
 	return new(pbbase.EmptyResp), err
 }
 
+// UpdateReleaseTags update the tags of a release, the clients are notified to match the release again if the
+// release is in use, because the clients may declare the release constraint with the tags.
+func (s *Service) UpdateReleaseTags(ctx context.Context, req *pbds.UpdateReleaseTagsReq) (*pbbase.EmptyResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := table.ValidateReleaseTags(req.Tags); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	release, err := s.dao.Release().Get(grpcKit, req.BizId, req.AppId, req.ReleaseId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, "%s", i18n.T(grpcKit, "release %d not found", req.ReleaseId))
+		}
+		logs.Errorf("get release failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	rgs, err := s.dao.ReleasedGroup().ListAllByAppID(grpcKit, req.AppId, req.BizId)
+	if err != nil {
+		logs.Errorf("list released groups failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	inUse := false
+	for _, rg := range rgs {
+		if rg.ReleaseID == release.ID || rg.PinnedReleaseID == release.ID {
+			inUse = true
+			break
+		}
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	committed := false
+	defer func() {
+		if !committed {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+			}
+		}
+	}()
+
+	if err = s.dao.Release().UpdateTagsWithTx(grpcKit, tx, release, req.Tags); err != nil {
+		logs.Errorf("update release tags failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	if inUse {
+		one := types.Event{
+			Spec: &table.EventSpec{
+				Resource:   table.Publish,
+				ResourceID: release.ID,
+				OpType:     table.InsertOp,
+			},
+			Attachment: &table.EventAttachment{BizID: req.BizId, AppID: req.AppId},
+			Revision:   &table.CreatedRevision{Creator: grpcKit.User},
+		}
+		if err = s.dao.Event().Eventf(grpcKit).FireWithTx(tx, one); err != nil {
+			logs.Errorf("fire release tags publish event failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	committed = true
+
+	return new(pbbase.EmptyResp), nil
+}
+
 // DeleteRelease delete a release
 func (s *Service) DeleteRelease(ctx context.Context, req *pbds.DeleteReleaseReq) (*pbbase.EmptyResp, error) {
 	grpcKit := kit.FromGrpcContext(ctx)
@@ -1034,6 +1271,11 @@ func (s *Service) deleteReleaseRelatedResources(grpcKit *kit.Kit, tx *gen.QueryT
 			logs.Errorf("batch delete released ci by release id failed, err: %v, rid: %s", err, grpcKit.Rid)
 			return err
 		}
+		if err := s.dao.ReleasedGroupCI().BatchDeleteByReleaseIDWithTx(grpcKit, tx,
+			bizID, appID, releaseID); err != nil {
+			logs.Errorf("batch delete released group ci by release id failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return err
+		}
 	case table.KV:
 		if err := s.dao.ReleasedKv().BatchDeleteByReleaseIDWithTx(grpcKit, tx,
 			bizID, appID, releaseID); err != nil {
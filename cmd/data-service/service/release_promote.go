@@ -0,0 +1,841 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbatb "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app-template-binding"
+	pbci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
+	pbcontent "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
+	pbkv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/kv"
+	pbrelease "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/release"
+	pbtv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/template-variable"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// the resource types reported by the release promotion.
+const (
+	promoteResConfigItem     = "config_item"
+	promoteResTmplConfigItem = "template_config_item"
+	promoteResKv             = "kv"
+	promoteResHook           = "hook"
+	promoteResVariable       = "variable"
+)
+
+// PromoteRelease promote the release of the app to another app of the biz, such as from the test app to the
+// prod app. The config items, template bindings, kvs, hooks and variables of the target app are replaced with
+// the ones of the source release, then a release linked to the source release is created in the target app.
+// The changes of the target app are returned, and nothing is changed in the dry run. The steps of the promotion
+// are not in one transaction, so everything checked by them is validated before the first write.
+func (s *Service) PromoteRelease(ctx context.Context, req *pbds.PromoteReleaseReq) (*pbds.PromoteReleaseResp,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	pm, err := s.newReleasePromoter(kt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := []func() error{pm.planConfigItems, pm.planTemplates, pm.planKvs, pm.planHooks, pm.planVariables}
+	for _, step := range steps {
+		if err = step(); err != nil {
+			logs.Errorf("plan release promotion failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+	}
+
+	if req.DryRun {
+		return pm.resp, nil
+	}
+
+	if len(pm.problems) > 0 {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s",
+			i18n.T(kt, "the release can not be promoted, %s", strings.Join(pm.problems, "; ")))
+	}
+
+	if err = pm.validate(); err != nil {
+		logs.Errorf("validate release promotion failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if err = pm.apply(); err != nil {
+		logs.Errorf("promote release %d of app %d to app %d failed, err: %v, rid: %s", req.ReleaseId, req.AppId,
+			req.TargetAppId, err, kt.Rid)
+		return nil, err
+	}
+
+	return pm.resp, nil
+}
+
+// releasePromoter plans and applies the changes of the target app to promote the source release.
+type releasePromoter struct {
+	s         *Service
+	kt        *kit.Kit
+	req       *pbds.PromoteReleaseReq
+	source    *table.Release
+	sourceApp *table.App
+	targetApp *table.App
+	tags      []string
+	remaps    map[string]*pbds.PromoteReleaseReq_VariableRemap
+
+	items     []*pbds.BatchUpsertConfigItemsReq_ConfigItem
+	bindings  []*pbds.BatchUpsertConfigItemsReq_TemplateBinding
+	kvs       []*pbds.BatchUpsertKvsReq_Kv
+	hooks     *pbds.UpdateConfigHookReq
+	variables []*table.TemplateVariableSpec
+	// tmplPaths are the paths of the template config items to be bound, and tmplCount is the number of the
+	// templates in the bound template sets, which is counted by the limit of the app.
+	tmplPaths []string
+	tmplCount int
+	// problems are the reasons why the release can not be promoted.
+	problems []string
+	resp     *pbds.PromoteReleaseResp
+}
+
+func (s *Service) newReleasePromoter(kt *kit.Kit, req *pbds.PromoteReleaseReq) (*releasePromoter, error) {
+	if req.TargetAppId == req.AppId {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s",
+			i18n.T(kt, "the target app should be different from the source app"))
+	}
+
+	sourceApp, err := s.getPromoteApp(kt, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+	targetApp, err := s.getPromoteApp(kt, req.BizId, req.TargetAppId)
+	if err != nil {
+		return nil, err
+	}
+	if sourceApp.Spec.ConfigType != targetApp.Spec.ConfigType {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt,
+			"the config type of the target app %s is different from the source app %s", targetApp.Spec.Name,
+			sourceApp.Spec.Name))
+	}
+
+	source, err := s.dao.Release().Get(kt, req.BizId, req.AppId, req.ReleaseId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, "%s", i18n.T(kt, "release %d not found", req.ReleaseId))
+		}
+		logs.Errorf("get release failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	tags := req.Tags
+	if len(tags) == 0 {
+		tags = source.Spec.Tags
+	}
+	if err = table.ValidateReleaseTags(tags); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	if req.Name != "" {
+		if _, err = s.dao.Release().GetByName(kt, req.BizId, req.TargetAppId, req.Name); err == nil {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s",
+				i18n.T(kt, "release name %s already exists", req.Name))
+		}
+	} else if !req.DryRun {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "release name is required"))
+	}
+
+	remaps := make(map[string]*pbds.PromoteReleaseReq_VariableRemap, len(req.VariableRemaps))
+	for _, r := range req.VariableRemaps {
+		if r.Name == "" {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "variable name to be remapped is empty"))
+		}
+		if _, ok := remaps[r.Name]; ok {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s",
+				i18n.T(kt, "variable %s is remapped repeatedly", r.Name))
+		}
+		remaps[r.Name] = r
+	}
+
+	return &releasePromoter{
+		s:         s,
+		kt:        kt,
+		req:       req,
+		source:    source,
+		sourceApp: sourceApp,
+		targetApp: targetApp,
+		tags:      tags,
+		remaps:    remaps,
+		hooks:     &pbds.UpdateConfigHookReq{BizId: req.BizId, AppId: req.TargetAppId},
+		resp:      &pbds.PromoteReleaseResp{DryRun: req.DryRun},
+	}, nil
+}
+
+// getPromoteApp get the app of the biz to be promoted from or to.
+func (s *Service) getPromoteApp(kt *kit.Kit, bizID, appID uint32) (*table.App, error) {
+	app, err := s.dao.App().Get(kt, bizID, appID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, "%s", i18n.T(kt, "app %d not found", appID))
+		}
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return app, nil
+}
+
+// ctx returns the context to call the other methods of the service for the target app.
+func (pm *releasePromoter) ctx() context.Context {
+	kt := pm.kt.Clone()
+	kt.BizID, kt.AppID = pm.req.BizId, pm.req.TargetAppId
+	return kt.InternalRpcCtx()
+}
+
+func (pm *releasePromoter) record(resType, name string, action types.PromoteChangeAction, source, target,
+	msg string) {
+	pm.resp.Changes = append(pm.resp.Changes, &pbds.PromoteReleaseResp_Change{
+		ResourceType: resType,
+		Name:         name,
+		Action:       string(action),
+		Source:       source,
+		Target:       target,
+		Message:      msg,
+	})
+}
+
+// block records the change which makes the release unable to be promoted.
+func (pm *releasePromoter) block(resType, name string, action types.PromoteChangeAction, source, target,
+	msg string) {
+	pm.record(resType, name, action, source, target, msg)
+	pm.problems = append(pm.problems, fmt.Sprintf("%s %s: %s", resType, name, msg))
+}
+
+// planConfigItems plan the non-template config items, the contents before rendered are promoted, so that they
+// are rendered with the variables of the target app when the release is created.
+func (pm *releasePromoter) planConfigItems() error {
+	if pm.targetApp.Spec.ConfigType != table.File {
+		return nil
+	}
+
+	kt := pm.kt
+	rcis, _, err := pm.s.dao.ReleasedCI().List(kt, pm.req.BizId, pm.req.AppId, pm.req.ReleaseId,
+		&types.BasePage{All: true})
+	if err != nil {
+		logs.Errorf("list released config items failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	details, err := pm.s.dao.ConfigItem().ListAllByAppID(kt, pm.req.TargetAppId, pm.req.BizId)
+	if err != nil {
+		logs.Errorf("list editing config items failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	current := make([]*pbci.ConfigItem, 0, len(details))
+	for _, ci := range details {
+		current = append(current, pbci.PbConfigItem(ci, ""))
+	}
+	if err = pm.s.setCommitSpecForCIs(kt, current); err != nil {
+		logs.Errorf("set commit spec for config items failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	targets := make(map[string]*pbci.ConfigItem, len(current))
+	for _, ci := range current {
+		targets[path.Join(ci.Spec.Path, ci.Spec.Name)] = ci
+	}
+
+	for _, rci := range rcis {
+		name := path.Join(rci.ConfigItemSpec.Path, rci.ConfigItemSpec.Name)
+		content, err := pm.originContent(rci.CommitSpec.Content)
+		if err != nil {
+			return err
+		}
+		spec := pbci.PbConfigItemSpec(rci.ConfigItemSpec)
+		pm.items = append(pm.items, &pbds.BatchUpsertConfigItemsReq_ConfigItem{
+			ConfigItemAttachment: &pbci.ConfigItemAttachment{BizId: pm.req.BizId, AppId: pm.req.TargetAppId},
+			ConfigItemSpec:       spec,
+			ContentSpec:          content,
+		})
+
+		target, ok := targets[name]
+		delete(targets, name)
+		switch {
+		case !ok:
+			pm.record(promoteResConfigItem, name, types.PromoteAdd, content.Signature, "", "")
+		case target.CommitSpec.GetContent().GetSignature() != content.Signature || !proto.Equal(target.Spec, spec):
+			pm.record(promoteResConfigItem, name, types.PromoteUpdate, content.Signature,
+				target.CommitSpec.GetContent().GetSignature(), "")
+		default:
+			pm.record(promoteResConfigItem, name, types.PromoteUnchanged, content.Signature, content.Signature, "")
+		}
+	}
+
+	for _, ci := range current {
+		name := path.Join(ci.Spec.Path, ci.Spec.Name)
+		if _, ok := targets[name]; ok {
+			pm.record(promoteResConfigItem, name, types.PromoteDelete, "", ci.CommitSpec.GetContent().GetSignature(),
+				"")
+		}
+	}
+
+	return nil
+}
+
+// originContent returns the content of the released config item before rendered, the md5 of the rendered
+// content is different, so it's got from the repository.
+func (pm *releasePromoter) originContent(rc *table.ReleasedContentSpec) (*pbcontent.ContentSpec, error) {
+	content := &pbcontent.ContentSpec{Signature: rc.OriginSignature, ByteSize: rc.OriginByteSize, Md5: rc.Md5}
+	if rc.Signature == rc.OriginSignature {
+		return content, nil
+	}
+
+	kt := pm.kt.Clone()
+	kt.BizID, kt.AppID = pm.req.BizId, pm.req.TargetAppId
+	metadata, err := pm.s.repo.Metadata(kt, rc.OriginSignature)
+	if err != nil {
+		logs.Errorf("get metadata of content %s failed, err: %v, rid: %s", rc.OriginSignature, err, kt.Rid)
+		return nil, err
+	}
+	content.Md5 = metadata.Md5
+
+	return content, nil
+}
+
+// planTemplates plan the template bindings, the templates are bound to the revisions of the source release
+// instead of the latest ones, so that the promoted contents are the same as the source release.
+func (pm *releasePromoter) planTemplates() error {
+	if pm.targetApp.Spec.ConfigType != table.File {
+		return nil
+	}
+
+	kt := pm.kt
+	rts, _, err := pm.s.dao.ReleasedAppTemplate().List(kt, pm.req.BizId, pm.req.AppId, pm.req.ReleaseId,
+		&types.BasePage{All: true})
+	if err != nil {
+		logs.Errorf("list released app templates failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	// the revisions of the templates bound by the target app.
+	current := make(map[uint32]uint32)
+	atb, err := pm.s.dao.AppTemplateBinding().GetAppTemplateBindingByAppID(kt, pm.req.BizId, pm.req.TargetAppId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get app template binding failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	if err == nil {
+		for _, b := range atb.Spec.Bindings {
+			for _, r := range b.TemplateRevisions {
+				current[r.TemplateID] = r.TemplateRevisionID
+			}
+		}
+	}
+
+	setIDs := make([]uint32, 0)
+	revisionIDs := make([]uint32, 0, len(rts)+len(current))
+	bindings := make(map[uint32]*pbds.BatchUpsertConfigItemsReq_TemplateBinding)
+	for _, rt := range rts {
+		if _, ok := bindings[rt.Spec.TemplateSetID]; !ok {
+			setIDs = append(setIDs, rt.Spec.TemplateSetID)
+			bindings[rt.Spec.TemplateSetID] = &pbds.BatchUpsertConfigItemsReq_TemplateBinding{
+				TemplateSpaceId: rt.Spec.TemplateSpaceID,
+				TemplateBinding: &pbatb.TemplateBinding{TemplateSetId: rt.Spec.TemplateSetID},
+			}
+		}
+		tb := bindings[rt.Spec.TemplateSetID].TemplateBinding
+		tb.TemplateRevisions = append(tb.TemplateRevisions, &pbatb.TemplateRevisionBinding{
+			TemplateId:         rt.Spec.TemplateID,
+			TemplateRevisionId: rt.Spec.TemplateRevisionID,
+		})
+		revisionIDs = append(revisionIDs, rt.Spec.TemplateRevisionID)
+	}
+	for _, id := range current {
+		revisionIDs = append(revisionIDs, id)
+	}
+
+	sets, err := pm.s.dao.TemplateSet().ListByIDs(kt, setIDs)
+	if err != nil {
+		logs.Errorf("list template sets failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	setMap := make(map[uint32]*table.TemplateSet, len(sets))
+	for _, set := range sets {
+		setMap[set.ID] = set
+	}
+	revisions, err := pm.s.dao.TemplateRevision().ListByIDs(kt, tools.RemoveDuplicates(revisionIDs))
+	if err != nil {
+		logs.Errorf("list template revisions failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	revisionMap := make(map[uint32]*table.TemplateRevision, len(revisions))
+	for _, r := range revisions {
+		revisionMap[r.ID] = r
+	}
+
+	for _, id := range setIDs {
+		pm.bindings = append(pm.bindings, bindings[id])
+		if set, ok := setMap[id]; ok {
+			pm.tmplCount += len(set.Spec.TemplateIDs)
+		}
+	}
+	for _, rt := range rts {
+		name := path.Join(rt.Spec.TemplateSpaceName, rt.Spec.TemplateSetName, rt.Spec.Path, rt.Spec.Name)
+		pm.tmplPaths = append(pm.tmplPaths, path.Join(rt.Spec.Path, rt.Spec.Name))
+		pm.planTemplate(name, rt, setMap[rt.Spec.TemplateSetID], revisionMap, current)
+		delete(current, rt.Spec.TemplateID)
+	}
+
+	for _, id := range current {
+		r, ok := revisionMap[id]
+		if !ok {
+			continue
+		}
+		pm.record(promoteResTmplConfigItem, path.Join(r.Spec.Path, r.Spec.Name), types.PromoteDelete, "",
+			r.Spec.RevisionName, "")
+	}
+
+	return nil
+}
+
+// planTemplate plan the template of the source release, which should be still available for the target app.
+func (pm *releasePromoter) planTemplate(name string, rt *table.ReleasedAppTemplate, set *table.TemplateSet,
+	revisions map[uint32]*table.TemplateRevision, current map[uint32]uint32) {
+
+	source := rt.Spec.TemplateRevisionName
+	target := ""
+	if r, ok := revisions[current[rt.Spec.TemplateID]]; ok {
+		target = r.Spec.RevisionName
+	}
+	action := types.PromoteAdd
+	if _, ok := current[rt.Spec.TemplateID]; ok {
+		action = types.PromoteUpdate
+		if current[rt.Spec.TemplateID] == rt.Spec.TemplateRevisionID {
+			action = types.PromoteUnchanged
+		}
+	}
+
+	switch {
+	case set == nil || !slices.Contains(set.Spec.TemplateIDs, rt.Spec.TemplateID):
+		pm.block(promoteResTmplConfigItem, name, action, source, target,
+			i18n.T(pm.kt, "the template is not in the template set %s any more", rt.Spec.TemplateSetName))
+	case !set.Spec.Public && !slices.Contains(set.Spec.BoundApps, pm.req.TargetAppId):
+		pm.block(promoteResTmplConfigItem, name, action, source, target,
+			i18n.T(pm.kt, "template set %s is not available for the app", rt.Spec.TemplateSetName))
+	case revisions[rt.Spec.TemplateRevisionID] == nil:
+		pm.block(promoteResTmplConfigItem, name, action, source, target,
+			i18n.T(pm.kt, "template revision %s is not found", rt.Spec.TemplateRevisionName))
+	default:
+		pm.record(promoteResTmplConfigItem, name, action, source, target, "")
+	}
+}
+
+// planKvs plan the kvs, the values are got from vault with the version of the source release.
+func (pm *releasePromoter) planKvs() error {
+	if pm.targetApp.Spec.ConfigType != table.KV {
+		return nil
+	}
+
+	kt := pm.kt
+	rkvs, _, err := pm.s.dao.ReleasedKv().List(kt, &types.ListRKvOption{
+		BizID:     pm.req.BizId,
+		AppID:     pm.req.AppId,
+		ReleaseID: pm.req.ReleaseId,
+		All:       true,
+		Page:      &types.BasePage{All: true},
+	})
+	if err != nil {
+		logs.Errorf("list released kvs failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	states := []string{string(table.KvStateAdd), string(table.KvStateRevise), string(table.KvStateUnchange)}
+	current, err := pm.s.dao.Kv().ListAllByAppID(kt, pm.req.TargetAppId, pm.req.BizId, states)
+	if err != nil {
+		logs.Errorf("list kvs failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	targets := make(map[string]*table.Kv, len(current))
+	for _, kv := range current {
+		targets[kv.Spec.Key] = kv
+	}
+
+	for _, rkv := range rkvs {
+		_, value, err := pm.s.getReleasedKv(kt, pm.req.BizId, pm.req.AppId, rkv.Spec.Version, rkv.ReleaseID,
+			rkv.Spec.Key)
+		if err != nil {
+			logs.Errorf("get vault released kv failed, err: %v, rid: %s", err, kt.Rid)
+			return err
+		}
+		spec := *rkv.Spec
+		pm.kvs = append(pm.kvs, &pbds.BatchUpsertKvsReq_Kv{
+			KvAttachment: &pbkv.KvAttachment{BizId: pm.req.BizId, AppId: pm.req.TargetAppId},
+			KvSpec:       pbkv.PbKvSpec(&spec, value),
+		})
+
+		source := promoteKvBrief(spec.KvType, value)
+		target, ok := targets[spec.Key]
+		delete(targets, spec.Key)
+		switch {
+		case !ok:
+			pm.record(promoteResKv, spec.Key, types.PromoteAdd, source, "", "")
+		case target.Spec.KvType != spec.KvType || target.ContentSpec.Signature != tools.SHA256(value) ||
+			target.Spec.Memo != spec.Memo || target.Spec.SecretType != spec.SecretType ||
+			target.Spec.SecretHidden != spec.SecretHidden:
+			pm.record(promoteResKv, spec.Key, types.PromoteUpdate, source, target.ContentSpec.Signature, "")
+		default:
+			pm.record(promoteResKv, spec.Key, types.PromoteUnchanged, source, target.ContentSpec.Signature, "")
+		}
+	}
+
+	for _, kv := range current {
+		if _, ok := targets[kv.Spec.Key]; ok {
+			pm.record(promoteResKv, kv.Spec.Key, types.PromoteDelete, "", kv.ContentSpec.Signature, "")
+		}
+	}
+
+	return nil
+}
+
+// promoteKvBrief returns the value of the kv in the changes, the value of the secret kv is masked.
+func promoteKvBrief(kvType table.DataType, value string) string {
+	if kvType == table.KvSecret {
+		return table.SecretMask
+	}
+	return value
+}
+
+// planHooks plan the pre-hook and post-hook, the hooks are bound by the target app with the params of the source
+// release, and the revisions deployed when the release is created are used.
+func (pm *releasePromoter) planHooks() error {
+	kt := pm.kt
+	for _, tp := range []table.HookType{table.PreHook, table.PostHook} {
+		source, err := pm.getReleasedHook(pm.req.AppId, pm.req.ReleaseId, tp)
+		if err != nil {
+			return err
+		}
+		target, err := pm.getReleasedHook(pm.req.TargetAppId, 0, tp)
+		if err != nil {
+			return err
+		}
+
+		name := tp.String()
+		switch {
+		case source == nil && target == nil:
+			continue
+		case source == nil:
+			pm.record(promoteResHook, name, types.PromoteDelete, "", promoteHookBrief(target), "")
+			continue
+		}
+
+		setConfigHook(pm.hooks, tp, source.HookID, source.ParamValues())
+		action := types.PromoteAdd
+		targetBrief := ""
+		if target != nil {
+			targetBrief = promoteHookBrief(target)
+			action = types.PromoteUpdate
+			if target.HookID == source.HookID && target.HookRevisionID == source.HookRevisionID &&
+				maps.Equal(target.ParamValues(), source.ParamValues()) {
+				action = types.PromoteUnchanged
+			}
+		}
+
+		deployed, err := pm.s.dao.HookRevision().GetByPubState(kt, &types.GetByPubStateOption{
+			BizID:  pm.req.BizId,
+			HookID: source.HookID,
+			State:  table.HookRevisionStatusDeployed,
+		})
+		switch {
+		case err != nil:
+			logs.Errorf("get deployed revision of hook %d failed, err: %v, rid: %s", source.HookID, err, kt.Rid)
+			pm.block(promoteResHook, name, action, promoteHookBrief(source), targetBrief,
+				i18n.T(kt, "hook %s has no deployed revision", source.HookName))
+		case deployed.ID != source.HookRevisionID:
+			pm.record(promoteResHook, name, action, promoteHookBrief(source), targetBrief, i18n.T(kt,
+				"the deployed revision %s of the hook is used instead", deployed.Spec.Name))
+		default:
+			pm.record(promoteResHook, name, action, promoteHookBrief(source), targetBrief, "")
+		}
+	}
+
+	return nil
+}
+
+// getReleasedHook get the hook of the release, nil is returned if the hook is not bound.
+func (pm *releasePromoter) getReleasedHook(appID, releaseID uint32, tp table.HookType) (*table.ReleasedHook,
+	error) {
+	rh, err := pm.s.dao.ReleasedHook().Get(pm.kt, pm.req.BizId, appID, releaseID, tp)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		logs.Errorf("get %s failed, err: %v, rid: %s", tp.String(), err, pm.kt.Rid)
+		return nil, err
+	}
+
+	return rh, nil
+}
+
+func promoteHookBrief(rh *table.ReleasedHook) string {
+	return rh.HookName + "/" + rh.HookRevisionName
+}
+
+// planVariables plan the variables used by the source release with the remaps.
+func (pm *releasePromoter) planVariables() error {
+	if pm.targetApp.Spec.ConfigType != table.File {
+		return nil
+	}
+
+	kt := pm.kt
+	source, err := pm.s.dao.ReleasedAppTemplateVariable().ListVariables(kt, pm.req.BizId, pm.req.AppId,
+		pm.req.ReleaseId)
+	if err != nil {
+		logs.Errorf("list released app template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	target, err := pm.s.dao.AppTemplateVariable().ListVariables(kt, pm.req.BizId, pm.req.TargetAppId)
+	if err != nil {
+		logs.Errorf("list app template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	vars, changes := mergePromotedVariables(kt, source, target, pm.remaps)
+	pm.variables = vars
+	for _, c := range changes {
+		if c.blocked {
+			pm.block(promoteResVariable, c.name, c.action, c.source, c.target, c.message)
+			continue
+		}
+		pm.record(promoteResVariable, c.name, c.action, c.source, c.target, c.message)
+	}
+
+	return nil
+}
+
+// promotedVariable is the change of the variable when the release is promoted.
+type promotedVariable struct {
+	name    string
+	action  types.PromoteChangeAction
+	source  string
+	target  string
+	message string
+	blocked bool
+}
+
+// mergePromotedVariables merge the variables used by the source release into the variables of the target app
+// with the remaps. The value of the variable is overridden by the remap, or the value of the target app is kept
+// if the remap says so. The secret values are not promoted, the ones of the target app are kept if they are
+// not remapped. The variables only owned by the target app are kept too.
+func mergePromotedVariables(kt *kit.Kit, source, target []*table.TemplateVariableSpec,
+	remaps map[string]*pbds.PromoteReleaseReq_VariableRemap) ([]*table.TemplateVariableSpec, []*promotedVariable) {
+
+	targets := make(map[string]*table.TemplateVariableSpec, len(target))
+	for _, v := range target {
+		targets[v.Name] = v
+	}
+
+	merged := make([]*table.TemplateVariableSpec, 0, len(source)+len(target))
+	changes := make([]*promotedVariable, 0, len(source))
+	used := make(map[string]bool, len(source))
+	for _, v := range source {
+		used[v.Name] = true
+		t := targets[v.Name]
+		change := &promotedVariable{name: v.Name}
+		if t != nil {
+			change.target = t.Masked().DefaultVal
+		}
+
+		spec := *v
+		remap := remaps[v.Name]
+		switch {
+		case remap != nil && remap.KeepTarget:
+			if t == nil {
+				change.blocked = true
+				change.message = i18n.T(kt, "variable %s does not exist in the target app", v.Name)
+				break
+			}
+			spec = *t
+			change.message = i18n.T(kt, "the value of the target app is kept")
+		case remap != nil:
+			if err := spec.ValidateValue(kt, remap.Value); err != nil {
+				change.blocked = true
+				change.message = err.Error()
+				break
+			}
+			spec.DefaultVal = remap.Value
+			change.message = i18n.T(kt, "the value is remapped")
+		case v.IsSecret():
+			if t == nil || !t.IsSecret() || t.DefaultVal == "" {
+				change.blocked = true
+				change.message = i18n.T(kt, "the secret value of variable %s should be remapped", v.Name)
+				break
+			}
+			spec = *t
+			change.message = i18n.T(kt, "the secret value of the target app is kept")
+		}
+		change.source = spec.Masked().DefaultVal
+
+		switch {
+		case t == nil:
+			change.action = types.PromoteAdd
+		case t.Type == spec.Type && t.DefaultVal == spec.DefaultVal:
+			change.action = types.PromoteUnchanged
+		default:
+			change.action = types.PromoteUpdate
+		}
+
+		merged = append(merged, &spec)
+		changes = append(changes, change)
+	}
+
+	for _, v := range target {
+		if !used[v.Name] {
+			merged = append(merged, v)
+		}
+	}
+
+	for _, r := range remaps {
+		if !used[r.Name] {
+			changes = append(changes, &promotedVariable{
+				name:    r.Name,
+				action:  types.PromoteUnchanged,
+				message: i18n.T(kt, "variable %s is not used by the source release", r.Name),
+				blocked: true,
+			})
+		}
+	}
+
+	return merged, changes
+}
+
+// validate checks the planned contents with the rules of the steps to apply them, so that the target app is
+// not left with the replaced contents because of the invalid ones.
+func (pm *releasePromoter) validate() error {
+	kt := pm.kt
+	spec := table.ReleaseSpec{Name: pm.req.Name, Memo: pm.req.Memo, Tags: pm.tags}
+	if err := spec.Validate(kt); err != nil {
+		return errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	limit := getAppConfigCnt(pm.req.BizId)
+	switch pm.targetApp.Spec.ConfigType {
+	case table.File:
+		if len(pm.items) == 0 && len(pm.tmplPaths) == 0 {
+			return errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "app config items is empty"))
+		}
+		if len(pm.items)+pm.tmplCount > limit {
+			return errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "the total number of app %s config "+
+				"items(including template and non-template)exceeded the limit %d", pm.targetApp.Spec.Name, limit))
+		}
+
+		paths := slices.Clone(pm.tmplPaths)
+		for _, item := range pm.items {
+			paths = append(paths, path.Join(item.ConfigItemSpec.Path, item.ConfigItemSpec.Name))
+		}
+		if n, _ := tools.CheckExistingPathConflict(paths); n > 0 {
+			return errf.Errorf(errf.InvalidParameter, "%s",
+				i18n.T(kt, "create release failed there is a file conflict"))
+		}
+	case table.KV:
+		if len(pm.kvs) == 0 {
+			return errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "app config items is empty"))
+		}
+		if len(pm.kvs) > limit {
+			return errf.Errorf(errf.InvalidParameter, "%s",
+				i18n.T(kt, "the total number of config items exceeded the limit %d", limit))
+		}
+
+		now := time.Now().UTC()
+		for _, kv := range pm.kvs {
+			spec := kv.KvSpec.KvSpec()
+			if !checkKVTypeMatch(spec.KvType, pm.targetApp.Spec.DataType) {
+				return errf.Errorf(errf.InvalidParameter, "%s",
+					i18n.T(kt, "kv type does not match the data type defined in the application"))
+			}
+			if spec.CertificateExpirationDate != nil && !spec.CertificateExpirationDate.After(now) {
+				return errf.Errorf(errf.InvalidParameter, "%s",
+					i18n.T(kt, "create release failed there is a certificate expiration exists"))
+			}
+		}
+	}
+
+	return nil
+}
+
+// apply replace the contents of the target app with the planned ones, then create the release.
+func (pm *releasePromoter) apply() error {
+	kt := pm.kt
+	memo := fmt.Sprintf("promoted from release %s of app %s", pm.source.Spec.Name, pm.sourceApp.Spec.Name)
+
+	switch pm.targetApp.Spec.ConfigType {
+	case table.File:
+		// the secret values are kept in vault, and only the masks are saved as the app variables.
+		for _, v := range pm.variables {
+			if err := pm.s.saveSecretVariable(kt, pm.req.BizId, pm.req.TargetAppId, 0, v); err != nil {
+				return err
+			}
+		}
+
+		if _, err := pm.s.BatchUpsertConfigItems(pm.ctx(), &pbds.BatchUpsertConfigItemsReq{
+			BizId:      pm.req.BizId,
+			AppId:      pm.req.TargetAppId,
+			Items:      pm.items,
+			ReplaceAll: true,
+			Variables:  pbtv.PbTemplateVariableSpecs(pm.variables),
+			Bindings:   pm.bindings,
+			CommitMemo: memo,
+		}); err != nil {
+			return err
+		}
+	case table.KV:
+		if _, err := pm.s.BatchUpsertKvs(pm.ctx(), &pbds.BatchUpsertKvsReq{
+			BizId:      pm.req.BizId,
+			AppId:      pm.req.TargetAppId,
+			Kvs:        pm.kvs,
+			ReplaceAll: true,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if _, err := pm.s.UpdateConfigHook(pm.ctx(), pm.hooks); err != nil {
+		return err
+	}
+
+	resp, err := pm.s.CreateRelease(pm.ctx(), &pbds.CreateReleaseReq{
+		Attachment: &pbrelease.ReleaseAttachment{BizId: pm.req.BizId, AppId: pm.req.TargetAppId},
+		Spec: &pbrelease.ReleaseSpec{
+			Name:            pm.req.Name,
+			Memo:            pm.req.Memo,
+			Tags:            pm.tags,
+			SourceAppId:     pm.req.AppId,
+			SourceReleaseId: pm.req.ReleaseId,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	pm.resp.ReleaseId = resp.Id
+
+	return nil
+}
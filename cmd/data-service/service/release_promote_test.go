@@ -0,0 +1,119 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+func promoteVar(name string, tp table.VariableType, val string) *table.TemplateVariableSpec {
+	return &table.TemplateVariableSpec{Name: name, Type: tp, DefaultVal: val}
+}
+
+func TestMergePromotedVariables(t *testing.T) {
+	kt := &kit.Kit{}
+	source := []*table.TemplateVariableSpec{
+		promoteVar("bk_bscp_host", table.StringVar, "test.example.com"),
+		promoteVar("bk_bscp_port", table.NumberVar, "8080"),
+		promoteVar("bk_bscp_replicas", table.NumberVar, "1"),
+		promoteVar("bk_bscp_debug", table.BoolVar, "true"),
+		promoteVar("bk_bscp_password", table.SecretVar, table.SecretMask),
+		promoteVar("bk_bscp_token", table.SecretVar, table.SecretMask),
+	}
+	target := []*table.TemplateVariableSpec{
+		promoteVar("bk_bscp_host", table.StringVar, "prod.example.com"),
+		promoteVar("bk_bscp_port", table.NumberVar, "8080"),
+		promoteVar("bk_bscp_replicas", table.NumberVar, "3"),
+		promoteVar("bk_bscp_password", table.SecretVar, table.SecretMask),
+		promoteVar("bk_bscp_region", table.StringVar, "prod"),
+	}
+	remaps := map[string]*pbds.PromoteReleaseReq_VariableRemap{
+		"bk_bscp_host":     {Name: "bk_bscp_host", Value: "prod.example.com"},
+		"bk_bscp_replicas": {Name: "bk_bscp_replicas", KeepTarget: true},
+		"bk_bscp_debug":    {Name: "bk_bscp_debug", Value: "no"},
+		"bk_bscp_unknown":  {Name: "bk_bscp_unknown", Value: "1"},
+	}
+
+	merged, changes := mergePromotedVariables(kt, source, target, remaps)
+
+	values := make(map[string]string, len(merged))
+	for _, v := range merged {
+		values[v.Name] = v.DefaultVal
+	}
+	wantValues := map[string]string{
+		"bk_bscp_host":     "prod.example.com",
+		"bk_bscp_port":     "8080",
+		"bk_bscp_replicas": "3",
+		"bk_bscp_debug":    "true",
+		"bk_bscp_password": table.SecretMask,
+		"bk_bscp_token":    table.SecretMask,
+		"bk_bscp_region":   "prod",
+	}
+	if len(values) != len(wantValues) {
+		t.Fatalf("expect %d merged variables, got %d", len(wantValues), len(values))
+	}
+	for name, want := range wantValues {
+		if values[name] != want {
+			t.Errorf("variable %s: expect value %q, got %q", name, want, values[name])
+		}
+	}
+
+	wantChanges := map[string]struct {
+		action  types.PromoteChangeAction
+		blocked bool
+	}{
+		"bk_bscp_host":     {action: types.PromoteUnchanged},
+		"bk_bscp_port":     {action: types.PromoteUnchanged},
+		"bk_bscp_replicas": {action: types.PromoteUnchanged},
+		"bk_bscp_debug":    {action: types.PromoteAdd, blocked: true},
+		"bk_bscp_password": {action: types.PromoteUnchanged},
+		"bk_bscp_token":    {action: types.PromoteAdd, blocked: true},
+		"bk_bscp_unknown":  {action: types.PromoteUnchanged, blocked: true},
+	}
+	if len(changes) != len(wantChanges) {
+		t.Fatalf("expect %d changes, got %d", len(wantChanges), len(changes))
+	}
+	for _, c := range changes {
+		want, ok := wantChanges[c.name]
+		if !ok {
+			t.Errorf("unexpected change of variable %s", c.name)
+			continue
+		}
+		if c.action != want.action || c.blocked != want.blocked {
+			t.Errorf("variable %s: expect action %s blocked %v, got action %s blocked %v", c.name, want.action,
+				want.blocked, c.action, c.blocked)
+		}
+	}
+}
+
+func TestMergePromotedVariablesUpdate(t *testing.T) {
+	source := []*table.TemplateVariableSpec{promoteVar("bk_bscp_port", table.NumberVar, "8080")}
+	target := []*table.TemplateVariableSpec{promoteVar("bk_bscp_port", table.NumberVar, "80")}
+
+	merged, changes := mergePromotedVariables(&kit.Kit{}, source, target, nil)
+	if len(merged) != 1 || merged[0].DefaultVal != "8080" {
+		t.Fatalf("expect the value of the source release is promoted, got %+v", merged)
+	}
+	if len(changes) != 1 || changes[0].action != types.PromoteUpdate || changes[0].target != "80" {
+		t.Fatalf("expect variable is updated from 80, got %+v", changes)
+	}
+	// the source spec should not be changed
+	if source[0].DefaultVal != "8080" {
+		t.Fatalf("source variable is changed to %s", source[0].DefaultVal)
+	}
+}
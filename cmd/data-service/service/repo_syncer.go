@@ -234,7 +234,7 @@ func (s *RepoSyncer) syncAll(kt *kit.Kit) {
 	skipKt := kt.WithSkipTenantFilter()
 	for _, bizID := range bizs {
 		var allSigns []string
-		var normalSigns, releasedNormalSigns, tmplSigns, releasedTmplSigns []string
+		var normalSigns, releasedNormalSigns, tmplSigns, releasedTmplSigns, releasedGroupSigns []string
 		var err error
 		// 未发版的普通配置项
 		if normalSigns, err = s.set.Content().ListAllCISigns(skipKt, bizID); err != nil {
@@ -264,6 +264,13 @@ func (s *RepoSyncer) syncAll(kt *kit.Kit) {
 			allSigns = append(allSigns, releasedTmplSigns...)
 		}
 
+		// 已发版按分组变量渲染的配置项
+		if releasedGroupSigns, err = s.set.ReleasedGroupCI().ListAllCISigns(skipKt, bizID); err != nil {
+			logs.Errorf("list released group ci signs failed, err: %v, rid: %s", err, kt.Rid)
+		} else {
+			allSigns = append(allSigns, releasedGroupSigns...)
+		}
+
 		allSigns = tools.RemoveDuplicateStrings(allSigns)
 		s.syncOneBiz(kt, bizID, allSigns)
 		if atomic.LoadInt32(&syncFailedCnt) > failedLimit {
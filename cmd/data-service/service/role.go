@@ -0,0 +1,303 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbrole "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/role"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// CreateRole create role of the built-in rbac policy engine.
+func (s *Service) CreateRole(ctx context.Context, req *pbds.CreateRoleReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().RoleSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "role spec is required"))
+	}
+
+	if err := s.checkRoleNameUnique(kt, req.BizId, 0, spec.Name); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	role := &table.Role{
+		Spec: spec,
+		Attachment: &table.RoleAttachment{
+			BizID:    req.BizId,
+			TenantID: kt.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	if err := role.ValidateCreate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	id, err := s.dao.Role().Create(kt, role)
+	if err != nil {
+		logs.Errorf("create role failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "create role failed, err: %v", err))
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpdateRole update role of the built-in rbac policy engine.
+func (s *Service) UpdateRole(ctx context.Context, req *pbds.UpdateRoleReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().RoleSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "role spec is required"))
+	}
+
+	if err := s.checkRoleNameUnique(kt, req.BizId, req.Id, spec.Name); err != nil {
+		return nil, err
+	}
+
+	role := &table.Role{
+		ID:   req.Id,
+		Spec: spec,
+		Attachment: &table.RoleAttachment{
+			BizID: req.BizId,
+		},
+		Revision: &table.Revision{
+			Reviser:   kt.User,
+			UpdatedAt: time.Now().UTC(),
+		},
+	}
+	if err := role.ValidateUpdate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	if err := s.dao.Role().Update(kt, role); err != nil {
+		logs.Errorf("update role failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "update role failed, err: %v", err))
+	}
+
+	return &pbbase.EmptyResp{}, nil
+}
+
+// DeleteRole delete role of the built-in rbac policy engine, the bindings of the role are deleted too.
+func (s *Service) DeleteRole(ctx context.Context, req *pbds.DeleteRoleReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	err := s.dao.Role().Delete(kt, &table.Role{
+		ID:         req.Id,
+		Attachment: &table.RoleAttachment{BizID: req.BizId},
+	})
+	if err != nil {
+		logs.Errorf("delete role failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "delete role failed, err: %v", err))
+	}
+
+	return &pbbase.EmptyResp{}, nil
+}
+
+// ListRoles list roles of the built-in rbac policy engine.
+func (s *Service) ListRoles(ctx context.Context, req *pbds.ListRolesReq) (*pbds.ListRolesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	roles, count, err := s.dao.Role().List(kt, req.BizId,
+		&types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All})
+	if err != nil {
+		logs.Errorf("list roles failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "list roles failed, err: %v", err))
+	}
+
+	return &pbds.ListRolesResp{
+		Count:   uint32(count),
+		Details: pbrole.PbRoles(roles),
+	}, nil
+}
+
+// CreateRoleBinding bind the role to the user in the scope of biz, app or template space.
+func (s *Service) CreateRoleBinding(ctx context.Context, req *pbds.CreateRoleBindingReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().RoleBindingSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "role binding spec is required"))
+	}
+
+	now := time.Now().UTC()
+	binding := &table.RoleBinding{
+		Spec: spec,
+		Attachment: &table.RoleBindingAttachment{
+			BizID:    req.BizId,
+			TenantID: kt.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	if err := binding.ValidateCreate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	if err := s.validateRoleBindingScope(kt, req.BizId, spec); err != nil {
+		return nil, err
+	}
+
+	id, err := s.dao.RoleBinding().Create(kt, binding)
+	if err != nil {
+		logs.Errorf("create role binding failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "create role binding failed, err: %v", err))
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// DeleteRoleBinding delete role binding.
+func (s *Service) DeleteRoleBinding(ctx context.Context, req *pbds.DeleteRoleBindingReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	err := s.dao.RoleBinding().Delete(kt, &table.RoleBinding{
+		ID:         req.Id,
+		Attachment: &table.RoleBindingAttachment{BizID: req.BizId},
+	})
+	if err != nil {
+		logs.Errorf("delete role binding failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "delete role binding failed, err: %v", err))
+	}
+
+	return &pbbase.EmptyResp{}, nil
+}
+
+// ListRoleBindings list role bindings of the biz.
+func (s *Service) ListRoleBindings(ctx context.Context, req *pbds.ListRoleBindingsReq) (
+	*pbds.ListRoleBindingsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	bindings, count, err := s.dao.RoleBinding().List(kt, req.BizId, req.RoleId, req.Subject,
+		&types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All})
+	if err != nil {
+		logs.Errorf("list role bindings failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "list role bindings failed, err: %v", err))
+	}
+
+	return &pbds.ListRoleBindingsResp{
+		Count:   uint32(count),
+		Details: pbrole.PbRoleBindings(bindings),
+	}, nil
+}
+
+// ListRolePolicies list the permissions which the subject is granted by role bindings in the bizs,
+// it is used by auth server to authorize with the built-in rbac policy engine.
+func (s *Service) ListRolePolicies(ctx context.Context, req *pbds.ListRolePoliciesReq) (
+	*pbds.ListRolePoliciesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if req.Subject == "" {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "subject is required"))
+	}
+
+	bindings, err := s.dao.RoleBinding().ListBySubject(kt, req.Subject, req.BizIds)
+	if err != nil {
+		logs.Errorf("list role bindings of %s failed, err: %v, rid: %s", req.Subject, err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "list role bindings failed, err: %v", err))
+	}
+
+	roleIDs := make([]uint32, 0, len(bindings))
+	for _, b := range bindings {
+		roleIDs = append(roleIDs, b.Spec.RoleID)
+	}
+	roles, err := s.dao.Role().ListByIDs(kt, roleIDs)
+	if err != nil {
+		logs.Errorf("list roles failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "list roles failed, err: %v", err))
+	}
+	roleMap := make(map[uint32]*table.Role, len(roles))
+	for _, r := range roles {
+		roleMap[r.ID] = r
+	}
+
+	details := make([]*pbrole.RolePolicy, 0, len(bindings))
+	for _, b := range bindings {
+		role, ok := roleMap[b.Spec.RoleID]
+		// 角色需属于同一业务，避免绑定其他业务的角色越权
+		if !ok || role.Attachment.BizID != b.Attachment.BizID {
+			continue
+		}
+		details = append(details, &pbrole.RolePolicy{
+			BizId:       b.Attachment.BizID,
+			ScopeType:   string(b.Spec.ScopeType),
+			ScopeId:     b.Spec.ScopeID,
+			Permissions: role.Spec.PermissionList(),
+		})
+	}
+
+	return &pbds.ListRolePoliciesResp{Details: details}, nil
+}
+
+// checkRoleNameUnique check the role name is unique in the biz, id is the role itself when update it.
+func (s *Service) checkRoleNameUnique(kt *kit.Kit, bizID, id uint32, name string) error {
+	old, err := s.dao.Role().GetByName(kt, bizID, name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get role by name failed, err: %v, rid: %s", err, kt.Rid)
+		return errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "get role failed, err: %v", err))
+	}
+	if err == nil && old.ID != id {
+		return errf.Errorf(errf.AlreadyExists, "%s", i18n.T(kt, "role name %s already exists", name))
+	}
+
+	return nil
+}
+
+// validateRoleBindingScope make sure the role and the scope of the binding belong to the biz.
+func (s *Service) validateRoleBindingScope(kt *kit.Kit, bizID uint32, spec *table.RoleBindingSpec) error {
+	if _, err := s.dao.Role().Get(kt, bizID, spec.RoleID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "role %d not found", spec.RoleID))
+		}
+		return errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "get role failed, err: %v", err))
+	}
+
+	var err error
+	switch spec.ScopeType {
+	case table.RoleScopeApp:
+		_, err = s.dao.App().Get(kt, bizID, spec.ScopeID)
+	case table.RoleScopeTemplateSpace:
+		_, err = s.dao.TemplateSpace().Get(kt, bizID, spec.ScopeID)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "%s %d not found in the biz",
+				spec.ScopeType, spec.ScopeID))
+		}
+		return errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "get %s failed, err: %v", spec.ScopeType, err))
+	}
+
+	return nil
+}
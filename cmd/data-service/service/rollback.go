@@ -0,0 +1,505 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+const (
+	// rollbackHistoryPageSize 查找分组之前上线的版本时每次查询的上线历史数量
+	rollbackHistoryPageSize = 100
+	// rollbackRemainingClientsLimit 返回的仍处于回滚版本的客户端 UID 数量上限
+	rollbackRemainingClientsLimit = 100
+)
+
+// rollbackGroup is the rollback plan of a group which is on the rolled back release.
+type rollbackGroup struct {
+	groupID uint32
+	// strategyID is the strategy which published the rolled back release to the group.
+	strategyID uint32
+	// pinned means the group is pinned to a release, the rolled back release is the one it follows after the
+	// pin expires.
+	pinned bool
+	// target is the last strategy which published another release to the group before strategyID,
+	// nil means the group is removed from the released groups and falls back to the default group.
+	target  *table.Strategy
+	decided bool
+}
+
+// decide the rollback target of the group by an earlier published strategy, the strategy is ignored
+// if it does not change the release of the group.
+func (g *rollbackGroup) decide(st *table.Strategy) {
+	all := st.Spec.Scope == nil || len(st.Spec.Scope.Groups) == 0
+	inScope := false
+	if !all {
+		for _, one := range st.Spec.Scope.Groups {
+			if one.ID == g.groupID {
+				inScope = true
+				break
+			}
+		}
+	}
+
+	switch {
+	case all || st.Spec.AsDefault:
+		// 全量上线或上线默认分组时会清除范围内分组的上线记录，这些分组此后跟随默认分组
+		if g.groupID == 0 {
+			g.target = st
+			g.decided = true
+			return
+		}
+		if all || inScope {
+			g.decided = true
+		}
+	case inScope:
+		g.target = st
+		g.decided = true
+	}
+}
+
+// resolveRollbackGroups decide the rollback targets of the groups with the published strategies which are
+// ordered by id desc, returns the number of the groups which are still undecided.
+func resolveRollbackGroups(groups []*rollbackGroup, releaseID uint32, history []*table.Strategy) int {
+	undecided := 0
+	for _, g := range groups {
+		for _, st := range history {
+			if g.decided {
+				break
+			}
+			// 再次上线回滚版本的记录不改变分组的版本，继续向前查找
+			if st.ID >= g.strategyID || st.Spec.ReleaseID == releaseID {
+				continue
+			}
+			g.decide(st)
+		}
+		if !g.decided {
+			undecided++
+		}
+	}
+
+	return undecided
+}
+
+// RollbackRelease rollback the release, the groups which are on the release are restored to the release
+// published to them before, which goes through the approval if the app requires.
+// nolint: funlen
+func (s *Service) RollbackRelease(ctx context.Context, req *pbds.RollbackReleaseReq) (
+	*pbds.RollbackReleaseResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.dao.Release().Get(kt, req.BizId, req.AppId, req.ReleaseId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, "%s", i18n.T(kt, "release %d not found", req.ReleaseId))
+		}
+		return nil, err
+	}
+
+	// 回滚同样受变更冻结窗口限制，紧急情况由紧急变更人员操作
+	if err = s.checkChangeFreeze(kt, req.BizId, req.AppId,
+		fmt.Sprintf("rollback release %s", release.Spec.Name)); err != nil {
+		return nil, err
+	}
+
+	// 有在上线的版本则提示不能回滚
+	last, err := s.dao.Strategy().GetLast(kt, req.BizId, req.AppId, 0, 0)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil && (last.Spec.PublishStatus == table.PendingApproval ||
+		last.Spec.PublishStatus == table.PendingPublish) {
+		return nil, errors.New(i18n.T(kt, "there is a release in publishing currently"))
+	}
+
+	groups, err := s.planRollbackGroups(kt, req, release)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按恢复的版本合并分组，每个版本生成一条上线策略
+	targetGroups := make(map[uint32][]uint32)
+	fallback := make([]uint32, 0)
+	for _, g := range groups {
+		if g.target == nil {
+			fallback = append(fallback, g.groupID)
+			continue
+		}
+		targetGroups[g.target.Spec.ReleaseID] = append(targetGroups[g.target.Spec.ReleaseID], g.groupID)
+	}
+	targetIDs := make([]uint32, 0, len(targetGroups))
+	for id := range targetGroups {
+		targetIDs = append(targetIDs, id)
+	}
+	sort.Slice(targetIDs, func(i, j int) bool { return targetIDs[i] < targetIDs[j] })
+
+	// 需要审批的服务同时只能有一个待审批的上线策略，且回退为跟随默认分组无法审批
+	if app.Spec.IsApprove && (len(targetIDs) != 1 || len(fallback) != 0) {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt,
+			"rollback of release %s requires approval, the groups can only be restored to one earlier release "+
+				"at a time, please rollback the groups separately", release.Spec.Name))
+	}
+
+	targets := make(map[uint32]*table.Release)
+	if len(targetIDs) != 0 {
+		releases, e := s.dao.Release().ListAllByIDs(kt, targetIDs, req.BizId)
+		if e != nil {
+			return nil, e
+		}
+		for _, one := range releases {
+			if one.Spec.Deprecated {
+				return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt,
+					"release %s is deprecated, can not rollback to it", one.Spec.Name))
+			}
+			targets[one.ID] = one
+		}
+		for _, id := range targetIDs {
+			if targets[id] == nil {
+				return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt,
+					"release %d published before is deleted, can not rollback to it", id))
+			}
+		}
+	}
+
+	groupNames, err := s.getRollbackGroupNames(kt, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 固定版本的分组不能回退为跟随默认分组，否则会删除分组的固定版本
+	for _, g := range groups {
+		if g.target == nil && g.pinned {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt,
+				"group %s is pinned and has no earlier release, please unpin it before rolling it back",
+				groupNames(g.groupID)))
+		}
+	}
+
+	var flow *table.ApprovalFlow
+	if app.Spec.IsApprove {
+		// 启用了内置审批流程的服务不依赖 itsm 审批
+		if flow, err = s.getEnabledApprovalFlow(kt, req.BizId, req.AppId); err != nil {
+			return nil, err
+		}
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	committed := false
+	defer func() {
+		if !committed {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+			}
+		}
+	}()
+
+	publishStatus := table.AlreadyPublish
+	strategyIDs := make([]uint32, 0, len(targetIDs))
+	for _, id := range targetIDs {
+		pshID, status, e := s.submitRollbackStrategy(kt, tx, app, flow, release, targets[id], targetGroups[id],
+			groupNames, req.Memo)
+		if e != nil {
+			return nil, e
+		}
+		publishStatus = status
+		strategyIDs = append(strategyIDs, pshID)
+	}
+
+	if len(fallback) != 0 {
+		if err = s.fallbackRollbackGroups(kt, tx, release, fallback, groupNames, req.Memo); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	committed = true
+
+	resp := &pbds.RollbackReleaseResp{
+		Groups:                      make([]*pbds.RollbackReleaseResp_Group, 0, len(groups)),
+		PublishedStrategyHistoryIds: strategyIDs,
+		PublishStatus:               string(publishStatus),
+	}
+	event := &webhookRollbackData{ReleaseID: release.ID, ReleaseName: release.Spec.Name, StrategyIDs: strategyIDs,
+		Memo: req.Memo}
+	for _, g := range groups {
+		one := &pbds.RollbackReleaseResp_Group{GroupId: g.groupID, GroupName: groupNames(g.groupID)}
+		if g.target != nil {
+			one.ReleaseId = g.target.Spec.ReleaseID
+			one.ReleaseName = targets[g.target.Spec.ReleaseID].Spec.Name
+		}
+		resp.Groups = append(resp.Groups, one)
+		event.Groups = append(event.Groups, &webhookRollbackGroupData{GroupID: one.GroupId, GroupName: one.GroupName,
+			ReleaseID: one.ReleaseId, ReleaseName: one.ReleaseName})
+	}
+
+	// 待审批的回滚在审批通过上线时再通知
+	if publishStatus == table.AlreadyPublish {
+		s.emitWebhookEvent(kt, req.BizId, req.AppId, table.WebhookReleaseRolledBack, event)
+	}
+
+	// 回滚已经提交，查询客户端失败不影响回滚结果
+	uids, count, err := s.dao.Client().ListOnlineUIDsByCurrentRelease(kt, req.BizId, req.AppId, release.ID,
+		rollbackRemainingClientsLimit)
+	if err != nil {
+		logs.Errorf("list clients on release %d failed, err: %v, rid: %s", release.ID, err, kt.Rid)
+		return resp, nil
+	}
+	resp.RemainingClientCount = uint32(count)
+	resp.RemainingClientUids = uids
+
+	return resp, nil
+}
+
+// planRollbackGroups find the groups which are on the release, and decide the release to be restored
+// of each group by the published strategies history.
+func (s *Service) planRollbackGroups(kt *kit.Kit, req *pbds.RollbackReleaseReq, release *table.Release) (
+	[]*rollbackGroup, error) {
+	rgs, err := s.dao.ReleasedGroup().ListAllByReleaseID(kt, release.ID, req.BizId)
+	if err != nil {
+		logs.Errorf("list released groups of release %d failed, err: %v, rid: %s", release.ID, err, kt.Rid)
+		return nil, err
+	}
+
+	wanted := make(map[uint32]bool, len(req.Groups))
+	for _, id := range req.Groups {
+		wanted[id] = true
+	}
+
+	groups := make([]*rollbackGroup, 0, len(rgs))
+	var beforeID uint32
+	for _, rg := range rgs {
+		if rg.AppID != req.AppId || (len(wanted) != 0 && !wanted[rg.GroupID]) {
+			continue
+		}
+		delete(wanted, rg.GroupID)
+		groups = append(groups, &rollbackGroup{groupID: rg.GroupID, strategyID: rg.StrategyID,
+			pinned: rg.PinnedReleaseID != 0})
+		if rg.StrategyID > beforeID {
+			beforeID = rg.StrategyID
+		}
+	}
+	for id := range wanted {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "group %d is not on release %s", id,
+			release.Spec.Name))
+	}
+	if len(groups) == 0 {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "release %s is not published to any group",
+			release.Spec.Name))
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].groupID < groups[j].groupID })
+
+	for {
+		history, e := s.dao.Strategy().ListPublishedBefore(kt, req.BizId, req.AppId, beforeID,
+			rollbackHistoryPageSize)
+		if e != nil {
+			logs.Errorf("list published strategies before %d failed, err: %v, rid: %s", beforeID, e, kt.Rid)
+			return nil, e
+		}
+
+		undecided := resolveRollbackGroups(groups, release.ID, history)
+		if undecided == 0 || len(history) < rollbackHistoryPageSize {
+			break
+		}
+		beforeID = history[len(history)-1].ID
+	}
+
+	// 之前未上线过其他版本的分组回退为跟随默认分组，默认分组则无法回滚
+	for _, g := range groups {
+		if !g.decided && g.groupID == 0 {
+			return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt,
+				"no release is published to the default group before release %s, can not rollback it",
+				release.Spec.Name))
+		}
+	}
+
+	return groups, nil
+}
+
+// getRollbackGroupNames returns the func to get the group name of the app by group id.
+func (s *Service) getRollbackGroupNames(kt *kit.Kit, bizID, appID uint32) (func(id uint32) string, error) {
+	groups, err := s.dao.Group().ListAppGroups(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list app groups failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	names := make(map[uint32]string, len(groups))
+	for _, one := range groups {
+		names[one.ID] = one.Spec.Name
+	}
+
+	return func(id uint32) string {
+		if id == 0 {
+			return "默认分组"
+		}
+		if name, ok := names[id]; ok {
+			return name
+		}
+		return fmt.Sprintf("%d", id)
+	}, nil
+}
+
+// submitRollbackStrategy submit the strategy which restores the groups to the target release, the strategy is
+// published immediately, or waits for the approval if the app requires.
+func (s *Service) submitRollbackStrategy(kt *kit.Kit, tx *gen.QueryTx, app *table.App, flow *table.ApprovalFlow,
+	release, target *table.Release, groupIDs []uint32, groupNames func(id uint32) string, memo string) (
+	uint32, table.PublishStatus, error) {
+
+	opt := &types.PublishOption{
+		BizID:     app.BizID,
+		AppID:     app.ID,
+		ReleaseID: target.ID,
+		Memo:      memo,
+		Groups:    groupIDs,
+		Revision: &table.CreatedRevision{
+			Creator: kt.User,
+		},
+		PublishType:   table.Immediately,
+		PublishStatus: table.AlreadyPublish,
+		PubState:      string(table.Publishing),
+		ApproveType:   string(app.Spec.ApproveType),
+	}
+
+	// if approval required, current approver required, pub_state unpublished
+	if app.Spec.IsApprove {
+		opt.PublishType = table.Automatically
+		opt.PublishStatus = table.PendingApproval
+		opt.Approver = app.Spec.Approver
+		opt.ApproverProgress = app.Spec.Approver
+		opt.PubState = string(table.Unpublished)
+	}
+
+	pshID, err := s.dao.Publish().SubmitWithTx(kt, tx, opt)
+	if err != nil {
+		logs.Errorf("submit rollback strategy failed, err: %v, rid: %s", err, kt.Rid)
+		return 0, "", err
+	}
+
+	names := make([]string, 0, len(groupIDs))
+	for _, id := range groupIDs {
+		names = append(names, groupNames(id))
+	}
+	scope := strings.Join(names, constant.NameSeparator)
+	resInstance := fmt.Sprintf(constant.RollbackConfigReleaseName+constant.ResSeparator+constant.ConfigReleaseName+
+		constant.ResSeparator+constant.ConfigReleaseScope, release.Spec.Name, target.Spec.Name, scope)
+
+	// audit this to create strategy details
+	ad := s.dao.AuditDao().Decorator(kt, opt.BizID, &table.AuditField{
+		ResourceInstance: resInstance,
+		Status:           enumor.AuditStatus(opt.PublishStatus),
+		AppId:            app.AppID(),
+		StrategyId:       pshID,
+		Detail:           memo,
+	}).PrepareRollback(&table.Strategy{ID: pshID, Attachment: &table.StrategyAttachment{
+		BizID: opt.BizID, AppID: opt.AppID}})
+	if err = ad.Do(tx.Query); err != nil {
+		return 0, "", err
+	}
+
+	switch {
+	case app.Spec.IsApprove && flow != nil:
+		// 内置审批引擎创建审批单
+		if err = s.createApprovalTicket(kt, tx, flow, target.ID, pshID); err != nil {
+			return 0, "", err
+		}
+	case app.Spec.IsApprove:
+		// itsm流程创建ticket
+		ticketData, errCreate := s.submitCreateApproveTicket(
+			kt, app, target.Spec.Name, scope, memo, ad.GetAuditID(), target.ID)
+		if errCreate != nil {
+			logs.Errorf("submit create approve ticket, err: %v, rid: %s", errCreate, kt.Rid)
+			return 0, "", errCreate
+		}
+
+		err = s.dao.Strategy().UpdateByID(kt, tx, pshID, map[string]interface{}{
+			"itsm_ticket_type":     constant.ItsmTicketTypeCreate,
+			"itsm_ticket_url":      ticketData.TicketURL,
+			"itsm_ticket_sn":       ticketData.SN,
+			"itsm_ticket_status":   constant.ItsmTicketStatusCreated,
+			"itsm_ticket_state_id": ticketData.StateID,
+			"itsm_callback_token":  ticketData.CallbackToken,
+		})
+		if err != nil {
+			logs.Errorf("update strategy by id err: %v, rid: %s", err, kt.Rid)
+			return 0, "", err
+		}
+	}
+
+	return pshID, opt.PublishStatus, nil
+}
+
+// fallbackRollbackGroups remove the groups which have no earlier release from the released groups, so that
+// they fall back to the default group.
+func (s *Service) fallbackRollbackGroups(kt *kit.Kit, tx *gen.QueryTx, release *table.Release, groupIDs []uint32,
+	groupNames func(id uint32) string, memo string) error {
+	bizID, appID := release.Attachment.BizID, release.Attachment.AppID
+
+	if err := s.dao.ReleasedGroup().BatchDeleteByGroupIDsWithTx(kt, tx, bizID, appID, groupIDs); err != nil {
+		logs.Errorf("delete released groups failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	// fire the publish event, so that the clients of the groups match the release again.
+	one := types.Event{
+		Spec: &table.EventSpec{
+			Resource:   table.Publish,
+			ResourceID: release.ID,
+			OpType:     table.InsertOp,
+		},
+		Attachment: &table.EventAttachment{BizID: bizID, AppID: appID},
+		Revision:   &table.CreatedRevision{Creator: kt.User},
+	}
+	if err := s.dao.Event().Eventf(kt).FireWithTx(tx, one); err != nil {
+		logs.Errorf("fire rollback publish event failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	names := make([]string, 0, len(groupIDs))
+	for _, id := range groupIDs {
+		names = append(names, groupNames(id))
+	}
+	resInstance := fmt.Sprintf(constant.RollbackConfigReleaseName+constant.ResSeparator+constant.ConfigReleaseScope,
+		release.Spec.Name, strings.Join(names, constant.NameSeparator))
+
+	return s.dao.AuditDao().Decorator(kt, bizID, &table.AuditField{
+		ResourceInstance: resInstance,
+		Status:           enumor.Success,
+		AppId:            appID,
+		Detail:           memo,
+	}).PrepareRollback(release).Do(tx.Query)
+}
@@ -0,0 +1,82 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func rollbackStrategy(id, releaseID uint32, asDefault bool, groupIDs ...uint32) *table.Strategy {
+	groups := make([]*table.Group, 0, len(groupIDs))
+	for _, one := range groupIDs {
+		groups = append(groups, &table.Group{ID: one})
+	}
+
+	return &table.Strategy{
+		ID: id,
+		Spec: &table.StrategySpec{
+			ReleaseID: releaseID,
+			AsDefault: asDefault,
+			Scope:     &table.Scope{Groups: groups},
+		},
+	}
+}
+
+func TestResolveRollbackGroups(t *testing.T) {
+	// the bad release is 9, history is ordered by id desc
+	history := []*table.Strategy{
+		rollbackStrategy(8, 9, false, 1),
+		rollbackStrategy(7, 5, false, 1, 2),
+		rollbackStrategy(6, 4, true, 0, 3),
+		rollbackStrategy(5, 3, false, 4),
+		rollbackStrategy(4, 2, false),
+		rollbackStrategy(3, 1, false, 5),
+	}
+
+	cases := []struct {
+		name       string
+		group      uint32
+		strategyID uint32
+		decided    bool
+		target     uint32
+	}{
+		{name: "skip republish of the bad release", group: 1, strategyID: 10, decided: true, target: 7},
+		{name: "restore the gray release", group: 2, strategyID: 10, decided: true, target: 7},
+		{name: "default group restores the default release", group: 0, strategyID: 10, decided: true, target: 6},
+		{name: "group published with default falls back", group: 3, strategyID: 10, decided: true},
+		{name: "only earlier strategies are considered", group: 4, strategyID: 5, decided: true},
+		{name: "full publish makes groups fall back", group: 5, strategyID: 10, decided: true},
+		{name: "default group only published before full publish", group: 0, strategyID: 4, decided: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := &rollbackGroup{groupID: c.group, strategyID: c.strategyID}
+			undecided := resolveRollbackGroups([]*rollbackGroup{g}, 9, history)
+
+			if g.decided != c.decided || (undecided == 0) != c.decided {
+				t.Fatalf("expect decided %v, got %v, undecided: %d", c.decided, g.decided, undecided)
+			}
+
+			var target uint32
+			if g.target != nil {
+				target = g.target.ID
+			}
+			if target != c.target {
+				t.Errorf("expect target strategy %d, got %d", c.target, target)
+			}
+		})
+	}
+}
@@ -26,16 +26,20 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/components/bkcmdb"
 	"github.com/TencentBlueKing/bk-bscp/internal/components/gse"
 	"github.com/TencentBlueKing/bk-bscp/internal/components/itsm"
+	pushmanager "github.com/TencentBlueKing/bk-bscp/internal/components/push_manager"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/vault"
+	"github.com/TencentBlueKing/bk-bscp/internal/notifier"
 	processorcmdb "github.com/TencentBlueKing/bk-bscp/internal/processor/cmdb"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/internal/task"
 	"github.com/TencentBlueKing/bk-bscp/internal/thirdparty/esb/client"
 	"github.com/TencentBlueKing/bk-bscp/internal/tmplprocess"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/cache-service"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
@@ -59,12 +63,13 @@ type Service struct {
 	gseSvc              *gse.Service
 	configKVCache       *ConfigKVCache
 	configKVCacheCancel context.CancelFunc
+	notifiers           map[table.ClientAlertNotifier]notifier.Notifier
 }
 
 // NewService create a service instance.
 func NewService(sd serviced.Service, ssd serviced.ServiceDiscover, daoSet dao.Set, vaultSet vault.Set, esb client.Client,
 	repo repository.Provider, cmdb bkcmdb.Service, taskManager *task.TaskManager, gseSvc *gse.Service,
-	cmdbRenderCache processorcmdb.RenderCache) (*Service, error) {
+	cmdbRenderCache processorcmdb.RenderCache, pm pushmanager.Service) (*Service, error) {
 	state, ok := sd.(serviced.State)
 	if !ok {
 		return nil, errors.New("discover convert state failed")
@@ -76,6 +81,9 @@ func NewService(sd serviced.Service, ssd serviced.ServiceDiscover, daoSet dao.Se
 
 	opts := make([]grpc.DialOption, 0)
 
+	// add tracing interceptors.
+	opts = append(opts, brpc.TraceDialOptions()...)
+
 	// add dial load balancer.
 	opts = append(opts, ssd.LBRoundRobin())
 
@@ -116,6 +124,10 @@ func NewService(sd serviced.Service, ssd serviced.ServiceDiscover, daoSet dao.Se
 		cmdbRenderCache: cmdbRenderCache,
 		taskManager:     taskManager,
 		gseSvc:          gseSvc,
+		notifiers: map[table.ClientAlertNotifier]notifier.Notifier{
+			table.ClientAlertNotifierWebhook:     notifier.NewWebhook(),
+			table.ClientAlertNotifierPushManager: notifier.NewPushManager(pm, cc.G().PushProvider.Config),
+		},
 	}
 
 	svc.InitConfigKVCache()
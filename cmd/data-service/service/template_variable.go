@@ -49,8 +49,16 @@ func (s *Service) CreateTemplateVariable(ctx context.Context, req *pbds.CreateTe
 			i18n.T(kt, "same template variable name %s already exists", req.Spec.Name))
 	}
 
+	spec := req.Spec.TemplateVariableSpec()
+	if err = spec.ValidateCreate(kt); err != nil {
+		return nil, err
+	}
+	if err = s.saveSecretVariable(kt, req.Attachment.BizId, 0, 0, spec); err != nil {
+		return nil, err
+	}
+
 	templateVariable := &table.TemplateVariable{
-		Spec:       req.Spec.TemplateVariableSpec(),
+		Spec:       spec,
 		Attachment: req.Attachment.TemplateVariableAttachment(),
 		Revision: &table.Revision{
 			Creator: kt.User,
@@ -101,9 +109,28 @@ func (s *Service) UpdateTemplateVariable(ctx context.Context, req *pbds.UpdateTe
 	error) {
 	kt := kit.FromGrpcContext(ctx)
 
+	old, err := s.dao.TemplateVariable().GetByID(kt, req.Attachment.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get template variable failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	// the type can't be changed, and the constraints are kept if they are not set
+	spec := req.Spec.TemplateVariableSpec()
+	spec.Type = old.Spec.Type
+	if spec.Constraints == nil {
+		spec.Constraints = old.Spec.Constraints
+	}
+	if err = spec.ValidateUpdate(kt); err != nil {
+		return nil, err
+	}
+	if err = s.saveSecretVariable(kt, req.Attachment.BizId, 0, 0, spec); err != nil {
+		return nil, err
+	}
+
 	templateVariable := &table.TemplateVariable{
 		ID:         req.Id,
-		Spec:       req.Spec.TemplateVariableSpec(),
+		Spec:       spec,
 		Attachment: req.Attachment.TemplateVariableAttachment(),
 		Revision: &table.Revision{
 			Reviser: kt.User,
@@ -122,15 +149,29 @@ func (s *Service) DeleteTemplateVariable(ctx context.Context, req *pbds.DeleteTe
 	error) {
 	kt := kit.FromGrpcContext(ctx)
 
+	old, err := s.dao.TemplateVariable().GetByID(kt, req.Attachment.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get template variable failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
 	templateVariable := &table.TemplateVariable{
 		ID:         req.Id,
 		Attachment: req.Attachment.TemplateVariableAttachment(),
 	}
-	if err := s.dao.TemplateVariable().Delete(kt, templateVariable); err != nil {
+	if err = s.dao.TemplateVariable().Delete(kt, templateVariable); err != nil {
 		logs.Errorf("delete template variable failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, err
 	}
 
+	if old.Spec.IsSecret() {
+		opt := &types.SecretVariableOption{BizID: req.Attachment.BizId, Name: old.Spec.Name}
+		if err = s.vault.DeleteSecretVariable(kt, opt); err != nil {
+			logs.Errorf("delete secret variable %s failed, err: %v, rid: %s", old.Spec.Name, err, kt.Rid)
+			return nil, err
+		}
+	}
+
 	return new(pbbase.EmptyResp), nil
 }
 
@@ -154,6 +195,10 @@ func (s *Service) ImportTemplateVariables(ctx context.Context, req *pbds.ImportT
 	var toCreate, toUpdate []*table.TemplateVariable
 	var diffTypes []string
 	for _, spec := range req.Specs {
+		if spec.Type == string(table.SecretVar) {
+			return nil, errf.Errorf(errf.InvalidArgument, "%s",
+				i18n.T(kt, "secret variable %s can't be imported, please create it separately", spec.Name))
+		}
 		if _, ok := oldVarMap[spec.Name]; ok {
 			if spec.Type == string(oldVarMap[spec.Name].Spec.Type) {
 				toUpdate = append(toUpdate, &table.TemplateVariable{
@@ -273,3 +318,55 @@ func (s *Service) TemplateVariableFetchIDsExcluding(ctx context.Context,
 		Ids: ids,
 	}, nil
 }
+
+// saveSecretVariable keeps the value of secret variable in vault and replaces it with the mask, the value
+// which is the mask means it's not changed, and the empty value means it's cleared.
+func (s *Service) saveSecretVariable(kt *kit.Kit, bizID, appID, groupID uint32,
+	spec *table.TemplateVariableSpec) error {
+	if !spec.IsSecret() || spec.DefaultVal == table.SecretMask {
+		return nil
+	}
+
+	opt := &types.SecretVariableOption{BizID: bizID, AppID: appID, GroupID: groupID, Name: spec.Name}
+	if spec.DefaultVal == "" {
+		if err := s.vault.DeleteSecretVariable(kt, opt); err != nil {
+			logs.Errorf("delete secret variable %s failed, err: %v, rid: %s", spec.Name, err, kt.Rid)
+			return err
+		}
+		return nil
+	}
+
+	if err := s.vault.UpsertSecretVariable(kt, opt, spec.DefaultVal); err != nil {
+		logs.Errorf("save secret variable %s failed, err: %v, rid: %s", spec.Name, err, kt.Rid)
+		return err
+	}
+	spec.DefaultVal = table.SecretMask
+	return nil
+}
+
+// getSecretVariable get the value of secret variable, the value overridden by the group is used first,
+// then the value overridden by the app, and the value of the biz at last.
+func (s *Service) getSecretVariable(kt *kit.Kit, bizID, appID, groupID uint32, name string) (string, error) {
+	if groupID > 0 {
+		val, err := s.vault.GetSecretVariable(kt, &types.SecretVariableOption{BizID: bizID, AppID: appID,
+			GroupID: groupID, Name: name})
+		if err != nil {
+			return "", err
+		}
+		if val != "" {
+			return val, nil
+		}
+	}
+
+	if appID > 0 {
+		val, err := s.vault.GetSecretVariable(kt, &types.SecretVariableOption{BizID: bizID, AppID: appID, Name: name})
+		if err != nil {
+			return "", err
+		}
+		if val != "" {
+			return val, nil
+		}
+	}
+
+	return s.vault.GetSecretVariable(kt, &types.SecretVariableOption{BizID: bizID, Name: name})
+}
@@ -426,3 +426,37 @@ func (s *Service) uploadRenderedCIContent(kt *kit.Kit, ciRenderedContentMap map[
 
 	return nil
 }
+
+// uploadGroupRenderedContent upload the content rendered with the group's template variables to repo,
+// the contents map is signature => rendered content.
+func (s *Service) uploadGroupRenderedContent(kt *kit.Kit, contents map[string][]byte) error {
+	var hitError error
+	pipe := make(chan struct{}, 10)
+	wg := sync.WaitGroup{}
+
+	for signature := range contents {
+		wg.Add(1)
+
+		pipe <- struct{}{}
+		go func(signature string) {
+			defer func() {
+				wg.Done()
+				<-pipe
+			}()
+
+			k := kt.GetKitForRepoCfg()
+			if _, err := s.repo.Upload(k, signature, bytes.NewReader(contents[signature])); err != nil {
+				hitError = fmt.Errorf("upload group rendered content %s to repo failed, error: %v", signature, err)
+				return
+			}
+		}(signature)
+	}
+	wg.Wait()
+
+	if hitError != nil {
+		logs.Errorf("upload group rendered content failed, err: %v, rid: %s", hitError, kt.Rid)
+		return hitError
+	}
+
+	return nil
+}
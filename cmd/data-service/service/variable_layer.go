@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+const (
+	// bizVariableScope the variable is defined by the biz
+	bizVariableScope = "biz"
+	// appVariableScope the variable is overridden by the app
+	appVariableScope = "app"
+	// groupVariableScope the variable is overridden by the group of the app
+	groupVariableScope = "group"
+)
+
+// variableLayer is a layer of template variables, the layers are resolved in the order of
+// biz -> app -> group, and the latter layer overrides the former one.
+type variableLayer struct {
+	scope   string
+	groupID uint32
+	vars    map[string]*table.TemplateVariableSpec
+}
+
+// newVariableLayer create a variable layer with the variables.
+func newVariableLayer(scope string, groupID uint32, vars []*table.TemplateVariableSpec) *variableLayer {
+	layer := &variableLayer{
+		scope:   scope,
+		groupID: groupID,
+		vars:    make(map[string]*table.TemplateVariableSpec, len(vars)),
+	}
+	for _, v := range vars {
+		if v == nil {
+			continue
+		}
+		layer.vars[v.Name] = v
+	}
+	return layer
+}
+
+// variableHit is the variable found in a layer.
+type variableHit struct {
+	scope   string
+	groupID uint32
+	spec    *table.TemplateVariableSpec
+}
+
+// resolvedVariable is the final variable resolved from all the layers.
+type resolvedVariable struct {
+	// spec is the winning variable, it's nil when no layer defines the variable
+	spec    *table.TemplateVariableSpec
+	scope   string
+	groupID uint32
+	// conflict means more than one group overrides the variable with different values
+	conflict bool
+	// hits are all the layers which define the variable, in the order of resolving
+	hits []*variableHit
+}
+
+// resolveVariables resolve the variables with the layers, the group layers are on the same level, so if
+// several groups override one variable with different values, the first group wins and it's marked
+// as conflicted. A secret variable overridden by several groups is always conflicted, because the values
+// are kept in vault and can not be compared here.
+func resolveVariables(names []string, layers []*variableLayer) map[string]*resolvedVariable {
+	resolved := make(map[string]*resolvedVariable, len(names))
+	for _, name := range names {
+		rv := &resolvedVariable{}
+		for _, layer := range layers {
+			v, ok := layer.vars[name]
+			if !ok {
+				continue
+			}
+			rv.hits = append(rv.hits, &variableHit{scope: layer.scope, groupID: layer.groupID, spec: v})
+
+			if layer.scope == groupVariableScope && rv.scope == groupVariableScope {
+				if v.IsSecret() || v.DefaultVal != rv.spec.DefaultVal {
+					rv.conflict = true
+				}
+				continue
+			}
+			rv.spec, rv.scope, rv.groupID = v, layer.scope, layer.groupID
+		}
+		resolved[name] = rv
+	}
+
+	return resolved
+}
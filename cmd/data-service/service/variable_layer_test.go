@@ -0,0 +1,135 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func TestResolveVariables(t *testing.T) {
+	v := func(name, val string) *table.TemplateVariableSpec {
+		return &table.TemplateVariableSpec{Name: name, Type: table.StringVar, DefaultVal: val}
+	}
+	secret := &table.TemplateVariableSpec{Name: "bk_bscp_token", Type: table.SecretVar, DefaultVal: table.SecretMask}
+	layers := []*variableLayer{
+		newVariableLayer(bizVariableScope, 0, []*table.TemplateVariableSpec{
+			v("bk_bscp_host", "biz"), v("bk_bscp_port", "80"), v("bk_bscp_env", "dev"), secret}),
+		newVariableLayer(appVariableScope, 0, []*table.TemplateVariableSpec{v("bk_bscp_port", "8080")}),
+		newVariableLayer(groupVariableScope, 1, []*table.TemplateVariableSpec{
+			v("bk_bscp_env", "prod"), v("bk_bscp_zone", "a"), secret}),
+		newVariableLayer(groupVariableScope, 2, []*table.TemplateVariableSpec{
+			v("bk_bscp_env", "prod"), v("bk_bscp_zone", "b"), secret}),
+	}
+
+	tests := []struct {
+		name     string
+		scope    string
+		groupID  uint32
+		value    string
+		conflict bool
+		hits     int
+	}{
+		{name: "bk_bscp_host", scope: bizVariableScope, value: "biz", hits: 1},
+		{name: "bk_bscp_port", scope: appVariableScope, value: "8080", hits: 2},
+		{name: "bk_bscp_env", scope: groupVariableScope, groupID: 1, value: "prod", hits: 3},
+		{name: "bk_bscp_zone", scope: groupVariableScope, groupID: 1, value: "a", conflict: true, hits: 2},
+		{name: "bk_bscp_token", scope: groupVariableScope, groupID: 1, value: table.SecretMask, conflict: true, hits: 3},
+		{name: "bk_bscp_missing"},
+	}
+	names := make([]string, 0, len(tests))
+	for _, tt := range tests {
+		names = append(names, tt.name)
+	}
+
+	resolved := resolveVariables(names, layers)
+	for _, tt := range tests {
+		rv := resolved[tt.name]
+		if tt.scope == "" {
+			if rv.spec != nil || len(rv.hits) != 0 {
+				t.Errorf("%s should not be resolved, got %+v", tt.name, rv.spec)
+			}
+			continue
+		}
+		if rv.spec == nil {
+			t.Errorf("%s should be resolved", tt.name)
+			continue
+		}
+		if rv.scope != tt.scope || rv.groupID != tt.groupID || rv.spec.DefaultVal != tt.value {
+			t.Errorf("%s resolved to %s/%d/%s, want %s/%d/%s", tt.name, rv.scope, rv.groupID, rv.spec.DefaultVal,
+				tt.scope, tt.groupID, tt.value)
+		}
+		if rv.conflict != tt.conflict {
+			t.Errorf("%s conflict is %v, want %v", tt.name, rv.conflict, tt.conflict)
+		}
+		if len(rv.hits) != tt.hits {
+			t.Errorf("%s hits %d layers, want %d", tt.name, len(rv.hits), tt.hits)
+		}
+	}
+}
+
+func TestGetGroupRenderedVars(t *testing.T) {
+	v := func(name, val string) *table.TemplateVariableSpec {
+		return &table.TemplateVariableSpec{Name: name, Type: table.StringVar, DefaultVal: val}
+	}
+	layers := []*variableLayer{
+		newVariableLayer(bizVariableScope, 0, []*table.TemplateVariableSpec{
+			v("bk_bscp_host", "biz"), v("bk_bscp_port", "80")}),
+		newVariableLayer(appVariableScope, 0, []*table.TemplateVariableSpec{v("bk_bscp_port", "8080")}),
+	}
+	allVars := []string{"bk_bscp_host", "bk_bscp_port"}
+	renderKV := map[string]interface{}{"bk_bscp_host": "biz", "bk_bscp_port": "8080"}
+
+	tests := []struct {
+		name  string
+		group []*table.TemplateVariableSpec
+		want  map[string]interface{}
+	}{
+		{
+			name:  "overridden with different value",
+			group: []*table.TemplateVariableSpec{v("bk_bscp_host", "prod"), v("bk_bscp_port", "8080")},
+			want:  map[string]interface{}{"bk_bscp_host": "prod", "bk_bscp_port": "8080"},
+		},
+		{
+			name:  "overridden with the same value",
+			group: []*table.TemplateVariableSpec{v("bk_bscp_port", "8080")},
+		},
+		{
+			name:  "unused variable overridden",
+			group: []*table.TemplateVariableSpec{v("bk_bscp_zone", "a")},
+		},
+	}
+
+	s := new(Service)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.getGroupRenderedVars(nil, 1, allVars, renderKV,
+				append(layers, newVariableLayer(groupVariableScope, 1, tt.group)))
+			if err != nil {
+				t.Fatalf("get group rendered vars failed, err: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for k, want := range tt.want {
+				if got[k] != want {
+					t.Errorf("%s is %v, want %v", k, got[k], want)
+				}
+			}
+		})
+	}
+	if renderKV["bk_bscp_host"] != "biz" {
+		t.Errorf("release variables should not be changed, got %v", renderKV)
+	}
+}
@@ -0,0 +1,248 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbwh "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/webhook"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// CreateWebhook create webhook.
+func (s *Service) CreateWebhook(ctx context.Context, req *pbds.CreateWebhookReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().WebhookSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "webhook spec is required"))
+	}
+
+	if req.Secret == "" {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "webhook secret is required"))
+	}
+
+	if req.AppId > 0 {
+		if _, err := s.dao.App().Get(kt, req.BizId, req.AppId); err != nil {
+			logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "app %d not found", req.AppId))
+		}
+	}
+
+	now := time.Now().UTC()
+	webhook := &table.Webhook{
+		Spec: spec,
+		Attachment: &table.WebhookAttachment{
+			BizID:    req.BizId,
+			AppID:    req.AppId,
+			TenantID: kt.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+	if err := webhook.ValidateCreate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	id, err := s.dao.Webhook().Create(kt, webhook)
+	if err != nil {
+		logs.Errorf("create webhook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "create webhook failed, err: %v", err))
+	}
+
+	if err := s.vault.UpsertWebhookSecret(kt, req.BizId, id, req.Secret); err != nil {
+		logs.Errorf("save webhook secret failed, err: %v, rid: %s", err, kt.Rid)
+		if dErr := s.dao.Webhook().Delete(kt, req.BizId, id); dErr != nil {
+			logs.Errorf("delete webhook %d failed, err: %v, rid: %s", id, dErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpdateWebhook update webhook.
+func (s *Service) UpdateWebhook(ctx context.Context, req *pbds.UpdateWebhookReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.GetSpec().WebhookSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", i18n.T(kt, "webhook spec is required"))
+	}
+
+	old, err := s.getWebhook(kt, req.BizId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := &table.Webhook{
+		ID:         req.Id,
+		Spec:       spec,
+		Attachment: old.Attachment,
+		Revision: &table.Revision{
+			Reviser:   kt.User,
+			UpdatedAt: time.Now().UTC(),
+		},
+	}
+	if err = webhook.ValidateUpdate(); err != nil {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s", err.Error())
+	}
+
+	if err = s.dao.Webhook().Update(kt, webhook); err != nil {
+		logs.Errorf("update webhook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "update webhook failed, err: %v", err))
+	}
+
+	if req.Secret != "" {
+		if err = s.vault.UpsertWebhookSecret(kt, req.BizId, req.Id, req.Secret); err != nil {
+			logs.Errorf("save webhook secret failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteWebhook delete webhook and its deliveries.
+func (s *Service) DeleteWebhook(ctx context.Context, req *pbds.DeleteWebhookReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.getWebhook(kt, req.BizId, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.dao.Webhook().Delete(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete webhook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "delete webhook failed, err: %v", err))
+	}
+
+	if err := s.vault.DeleteWebhookSecret(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete webhook %d secret failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// GetWebhook get webhook.
+func (s *Service) GetWebhook(ctx context.Context, req *pbds.GetWebhookReq) (*pbwh.Webhook, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	webhook, err := s.getWebhook(kt, req.BizId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return pbwh.PbWebhook(webhook), nil
+}
+
+// ListWebhooks list webhooks of the biz.
+func (s *Service) ListWebhooks(ctx context.Context, req *pbds.ListWebhooksReq) (*pbds.ListWebhooksResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	webhooks, err := s.dao.Webhook().List(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("list webhooks failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "list webhooks failed, err: %v", err))
+	}
+
+	return &pbds.ListWebhooksResp{Details: pbwh.PbWebhooks(webhooks)}, nil
+}
+
+// ListWebhookDeliveries list the delivery logs of the webhook.
+func (s *Service) ListWebhookDeliveries(ctx context.Context, req *pbds.ListWebhookDeliveriesReq) (
+	*pbds.ListWebhookDeliveriesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	switch table.WebhookDeliveryStatus(req.Status) {
+	case "", table.WebhookDeliveryPending, table.WebhookDeliverySuccess, table.WebhookDeliveryFailed:
+	default:
+		return nil, errf.Errorf(errf.InvalidParameter, "%s",
+			i18n.T(kt, "unsupported webhook delivery status %s", req.Status))
+	}
+
+	deliveries, count, err := s.dao.WebhookDelivery().List(kt, req.BizId, req.WebhookId, req.Status,
+		&types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All})
+	if err != nil {
+		logs.Errorf("list webhook deliveries failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s",
+			i18n.T(kt, "list webhook deliveries failed, err: %v", err))
+	}
+
+	return &pbds.ListWebhookDeliveriesResp{
+		Count:   uint32(count),
+		Details: pbwh.PbWebhookDeliveries(deliveries),
+	}, nil
+}
+
+// RedeliverWebhookDelivery redeliver the event of the delivery, a new delivery of the event is created and
+// sent by the deliver webhook task, the original delivery log is kept.
+func (s *Service) RedeliverWebhookDelivery(ctx context.Context, req *pbds.RedeliverWebhookDeliveryReq) (
+	*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	delivery, err := s.dao.WebhookDelivery().Get(kt, req.BizId, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, "%s",
+				i18n.T(kt, "webhook delivery %d not found", req.Id))
+		}
+		logs.Errorf("get webhook delivery failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "get webhook delivery failed, err: %v", err))
+	}
+
+	if req.WebhookId > 0 && req.WebhookId != delivery.Attachment.WebhookID {
+		return nil, errf.Errorf(errf.InvalidParameter, "%s",
+			i18n.T(kt, "webhook delivery %d does not belong to webhook %d", req.Id, req.WebhookId))
+	}
+
+	if _, err = s.getWebhook(kt, req.BizId, delivery.Attachment.WebhookID); err != nil {
+		return nil, err
+	}
+
+	redelivery := newWebhookDelivery(kt, delivery.Attachment, delivery.Spec, time.Now().UTC())
+	if err = s.dao.WebhookDelivery().BatchCreate(kt, []*table.WebhookDelivery{redelivery}); err != nil {
+		logs.Errorf("create webhook delivery failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s",
+			i18n.T(kt, "create webhook delivery failed, err: %v", err))
+	}
+
+	return &pbds.CreateResp{Id: redelivery.ID}, nil
+}
+
+func (s *Service) getWebhook(kt *kit.Kit, bizID, id uint32) (*table.Webhook, error) {
+	webhook, err := s.dao.Webhook().Get(kt, bizID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, "%s", i18n.T(kt, "webhook %d not found", id))
+		}
+		logs.Errorf("get webhook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, "%s", i18n.T(kt, "get webhook failed, err: %v", err))
+	}
+
+	return webhook, nil
+}
@@ -0,0 +1,337 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/uuid"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+const (
+	// webhookEventHeader is the header which carries the event type of the delivery.
+	webhookEventHeader = "X-BSCP-Event"
+	// webhookDeliveryHeader is the header which carries the id of the delivery.
+	webhookDeliveryHeader = "X-BSCP-Delivery"
+	// webhookTimestampHeader is the header which carries the unix timestamp when the delivery is sent.
+	webhookTimestampHeader = "X-BSCP-Timestamp"
+	// webhookSignatureHeader is the header which carries the signature of the delivery, the format is
+	// sha256=hex(hmac_sha256(secret, timestamp + "." + body)).
+	webhookSignatureHeader = "X-BSCP-Signature"
+)
+
+// webhookEvent is the payload which is posted to the webhooks.
+type webhookEvent struct {
+	ID         string                 `json:"id"`
+	Type       table.WebhookEventType `json:"type"`
+	BizID      uint32                 `json:"biz_id"`
+	AppID      uint32                 `json:"app_id"`
+	Operator   string                 `json:"operator"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	Data       interface{}            `json:"data"`
+}
+
+// webhookReleaseData is the data of the release and approval events.
+type webhookReleaseData struct {
+	ReleaseID   uint32 `json:"release_id"`
+	ReleaseName string `json:"release_name"`
+	StrategyID  uint32 `json:"strategy_id,omitempty"`
+	Memo        string `json:"memo,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+// webhookRollbackData is the data of the release rolled back event.
+type webhookRollbackData struct {
+	ReleaseID   uint32                      `json:"release_id"`
+	ReleaseName string                      `json:"release_name"`
+	StrategyIDs []uint32                    `json:"strategy_ids,omitempty"`
+	Groups      []*webhookRollbackGroupData `json:"groups"`
+	Memo        string                      `json:"memo,omitempty"`
+}
+
+// webhookRollbackGroupData is the release restored to the group, release id 0 means the group falls back to
+// the default group.
+type webhookRollbackGroupData struct {
+	GroupID     uint32 `json:"group_id"`
+	GroupName   string `json:"group_name"`
+	ReleaseID   uint32 `json:"release_id"`
+	ReleaseName string `json:"release_name,omitempty"`
+}
+
+// webhookHookData is the data of the hook published event.
+type webhookHookData struct {
+	HookID       uint32 `json:"hook_id"`
+	RevisionID   uint32 `json:"revision_id"`
+	RevisionName string `json:"revision_name"`
+}
+
+// webhookClientFailureData is the data of the client failure spike event.
+type webhookClientFailureData struct {
+	RuleID        uint32  `json:"rule_id"`
+	RuleName      string  `json:"rule_name"`
+	ReleaseID     uint32  `json:"release_id"`
+	FailedRatio   float64 `json:"failed_ratio"`
+	Threshold     float64 `json:"threshold"`
+	FailedClients uint32  `json:"failed_clients"`
+	Message       string  `json:"message"`
+	Silenced      bool    `json:"silenced"`
+}
+
+// emitWebhookEvent record the event as pending deliveries of the enabled webhooks which subscribe it, the
+// deliveries are sent by the deliver webhook task. It never fails the operation which triggers the event.
+func (s *Service) emitWebhookEvent(kt *kit.Kit, bizID, appID uint32, eventType table.WebhookEventType,
+	data interface{}) {
+	webhooks, err := s.dao.Webhook().ListEnabled(kt, bizID)
+	if err != nil {
+		logs.Errorf("list webhooks of biz %d for event %s failed, err: %v, rid: %s", bizID, eventType, err, kt.Rid)
+		return
+	}
+
+	subscribed := make([]*table.Webhook, 0)
+	for _, one := range webhooks {
+		if one.Subscribes(appID, eventType) {
+			subscribed = append(subscribed, one)
+		}
+	}
+	if len(subscribed) == 0 {
+		return
+	}
+
+	now := time.Now().UTC()
+	event := &webhookEvent{
+		ID:         uuid.UUID(),
+		Type:       eventType,
+		BizID:      bizID,
+		AppID:      appID,
+		Operator:   kt.User,
+		OccurredAt: now,
+		Data:       data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logs.Errorf("marshal webhook event %s failed, err: %v, rid: %s", eventType, err, kt.Rid)
+		return
+	}
+
+	spec := &table.WebhookDeliverySpec{EventType: eventType, EventID: event.ID, Payload: string(payload)}
+	deliveries := make([]*table.WebhookDelivery, 0, len(subscribed))
+	for _, one := range subscribed {
+		deliveries = append(deliveries, newWebhookDelivery(kt, &table.WebhookDeliveryAttachment{
+			BizID:     bizID,
+			AppID:     appID,
+			WebhookID: one.ID,
+		}, spec, now))
+	}
+
+	if err := s.dao.WebhookDelivery().BatchCreate(kt, deliveries); err != nil {
+		logs.Errorf("create webhook deliveries of event %s failed, err: %v, rid: %s", eventType, err, kt.Rid)
+	}
+}
+
+// emitApproveWebhookEvents emit the approval and publish events by the publish status after the approve.
+func (s *Service) emitApproveWebhookEvents(kt *kit.Kit, req *pbds.ApproveReq, release *table.Release,
+	strategy *table.Strategy, status table.PublishStatus) {
+	data := &webhookReleaseData{
+		ReleaseID:   release.ID,
+		ReleaseName: release.Spec.Name,
+		StrategyID:  strategy.ID,
+		Memo:        strategy.Spec.Memo,
+		Reason:      req.Reason,
+	}
+
+	switch {
+	case req.PublishStatus == string(table.RejectedApproval) && status == table.RejectedApproval:
+		// 内置审批按法定人数审批，单个驳回未使阶段驳回时仍为待审批状态
+		s.emitWebhookEvent(kt, req.BizId, req.AppId, table.WebhookApprovalRejected, data)
+	case req.PublishStatus == string(table.PendingPublish) && status != table.PendingApproval:
+		// 会签未全部通过时仍为待审批状态，不推送审批通过事件
+		s.emitWebhookEvent(kt, req.BizId, req.AppId, table.WebhookApprovalPassed, data)
+	}
+
+	if status == table.AlreadyPublish {
+		s.emitWebhookEvent(kt, req.BizId, req.AppId, table.WebhookReleasePublished, data)
+	}
+}
+
+// newWebhookDelivery returns a pending delivery of the event which is sent immediately.
+func newWebhookDelivery(kt *kit.Kit, at *table.WebhookDeliveryAttachment, spec *table.WebhookDeliverySpec,
+	now time.Time) *table.WebhookDelivery {
+	return &table.WebhookDelivery{
+		Spec: &table.WebhookDeliverySpec{
+			EventType: spec.EventType,
+			EventID:   spec.EventID,
+			Payload:   spec.Payload,
+		},
+		Status: &table.WebhookDeliveryStatusInfo{
+			Status:        table.WebhookDeliveryPending,
+			NextAttemptAt: &now,
+		},
+		Attachment: &table.WebhookDeliveryAttachment{
+			BizID:     at.BizID,
+			AppID:     at.AppID,
+			WebhookID: at.WebhookID,
+			TenantID:  kt.TenantID,
+		},
+		Revision: &table.Revision{
+			Creator:   kt.User,
+			Reviser:   kt.User,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+}
+
+// DeliverWebhooks send the webhook deliveries which are due, the failed deliveries are retried with
+// exponential backoff until the max attempts are exhausted.
+func (s *Service) DeliverWebhooks(kt *kit.Kit, opt cc.DeliverWebhookConfig) error {
+	deliveries, err := s.dao.WebhookDelivery().ListDue(kt, time.Now().UTC(), opt.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	timeout, _ := time.ParseDuration(opt.Timeout)
+	backoff, _ := time.ParseDuration(opt.RetryBackoff)
+	maxBackoff, _ := time.ParseDuration(opt.MaxRetryBackoff)
+
+	webhooks := make(map[uint32]*table.Webhook)
+	secrets := make(map[uint32]string)
+	for _, one := range deliveries {
+		at := one.Attachment
+		webhook, ok := webhooks[at.WebhookID]
+		if !ok {
+			webhook, err = s.dao.Webhook().Get(kt, at.BizID, at.WebhookID)
+			if err != nil {
+				logs.Errorf("get webhook %d failed, err: %v, rid: %s", at.WebhookID, err, kt.Rid)
+				continue
+			}
+			webhooks[at.WebhookID] = webhook
+
+			secret, err := s.vault.GetWebhookSecret(kt, at.BizID, at.WebhookID)
+			if err != nil {
+				logs.Errorf("get webhook %d secret failed, err: %v, rid: %s", at.WebhookID, err, kt.Rid)
+				delete(webhooks, at.WebhookID)
+				continue
+			}
+			secrets[at.WebhookID] = secret
+		}
+
+		status := one.Status
+		now := time.Now().UTC()
+		status.Attempts++
+		status.DeliveredAt = &now
+		status.ResponseCode, status.ResponseBody, status.LastError = 0, "", ""
+
+		if !webhook.Spec.Enabled {
+			status.LastError = "webhook is disabled"
+		} else {
+			code, body, sErr := sendWebhookDelivery(kt.Ctx, components.WebhookClient, webhook.Spec.URL, secrets[at.WebhookID],
+				one, now, timeout)
+			status.ResponseCode, status.ResponseBody = code, body
+			if sErr != nil {
+				status.LastError = sErr.Error()
+			}
+		}
+
+		switch {
+		case status.LastError == "":
+			status.Status = table.WebhookDeliverySuccess
+			status.NextAttemptAt = nil
+		case !webhook.Spec.Enabled || status.Attempts >= uint32(opt.MaxAttempts):
+			status.Status = table.WebhookDeliveryFailed
+			status.NextAttemptAt = nil
+		default:
+			next := now.Add(table.WebhookRetryDelay(backoff, maxBackoff, status.Attempts))
+			status.NextAttemptAt = &next
+		}
+
+		if err := s.dao.WebhookDelivery().UpdateStatus(kt, at.BizID, one.ID, status); err != nil {
+			logs.Errorf("update webhook delivery %d status failed, err: %v, rid: %s", one.ID, err, kt.Rid)
+			continue
+		}
+		if status.LastError != "" {
+			logs.Warnf("webhook delivery %d to webhook %d failed, attempts: %d, err: %s, rid: %s", one.ID,
+				at.WebhookID, status.Attempts, status.LastError, kt.Rid)
+		}
+	}
+
+	return nil
+}
+
+// sendWebhookDelivery post the payload of the delivery to the url with the signature headers, it returns
+// the http code and the response body, which is truncated to WebhookMaxResponseLength, the error is not nil
+// unless the webhook responds with 2xx.
+func sendWebhookDelivery(ctx context.Context, client *http.Client, url, secret string,
+	delivery *table.WebhookDelivery, now time.Time, timeout time.Duration) (uint32, string, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(delivery.Spec.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, string(delivery.Spec.EventType))
+	req.Header.Set(webhookDeliveryHeader, strconv.FormatUint(uint64(delivery.ID), 10))
+	req.Header.Set(webhookTimestampHeader, timestamp)
+	req.Header.Set(webhookSignatureHeader, signWebhookPayload(secret, timestamp, []byte(delivery.Spec.Payload)))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	// only the head of the response body is read, the webhook should respond with a short body.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, table.WebhookMaxResponseLength))
+	if err != nil {
+		return uint32(resp.StatusCode), "", fmt.Errorf("read webhook response failed, err: %v", err)
+	}
+
+	code := uint32(resp.StatusCode)
+	if code < 200 || code >= 300 {
+		return code, string(body), fmt.Errorf("webhook responded with http code %d", code)
+	}
+
+	return code, string(body), nil
+}
+
+// signWebhookPayload returns the signature of the payload, the receiver should compute the signature
+// with the timestamp header and the raw body, and compare it with the signature header.
+func signWebhookPayload(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
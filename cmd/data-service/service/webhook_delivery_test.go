@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func TestSignWebhookPayload(t *testing.T) {
+	payload := []byte(`{"type":"release.published"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1760000000." + string(payload)))
+	expect := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := signWebhookPayload("secret", "1760000000", payload); got != expect {
+		t.Errorf("expect signature %s, got %s", expect, got)
+	}
+
+	if signWebhookPayload("secret", "1760000001", payload) == expect {
+		t.Errorf("signature should change with the timestamp")
+	}
+
+	if signWebhookPayload("other", "1760000000", payload) == expect {
+		t.Errorf("signature should change with the secret")
+	}
+}
+
+func TestSendWebhookDelivery(t *testing.T) {
+	now := time.Unix(1760000000, 0)
+	delivery := &table.WebhookDelivery{
+		ID: 7,
+		Spec: &table.WebhookDeliverySpec{
+			EventType: table.WebhookReleasePublished,
+			EventID:   "event",
+			Payload:   `{"type":"release.published"}`,
+		},
+	}
+
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp := r.Header.Get(webhookTimestampHeader)
+		if r.Header.Get(webhookSignatureHeader) != signWebhookPayload("secret", timestamp, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(webhookEventHeader) != "release.published" || r.Header.Get(webhookDeliveryHeader) != "7" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	status = http.StatusOK
+	code, body, err := sendWebhookDelivery(context.Background(), server.Client(), server.URL, "secret", delivery,
+		now, time.Second)
+	if err != nil || code != http.StatusOK || body != "ok" {
+		t.Errorf("expect delivery success, got code: %d, body: %s, err: %v", code, body, err)
+	}
+
+	code, _, err = sendWebhookDelivery(context.Background(), server.Client(), server.URL, "wrong", delivery, now,
+		time.Second)
+	if err == nil || code != http.StatusUnauthorized {
+		t.Errorf("expect delivery failed with wrong secret, got code: %d, err: %v", code, err)
+	}
+
+	status = http.StatusInternalServerError
+	code, _, err = sendWebhookDelivery(context.Background(), server.Client(), server.URL, "secret", delivery, now,
+		time.Second)
+	if err == nil || code != http.StatusInternalServerError {
+		t.Errorf("expect delivery failed with server error, got code: %d, err: %v", code, err)
+	}
+
+	// the test server listens on the loopback address, which the webhook client refuses to connect to.
+	status = http.StatusOK
+	code, _, err = sendWebhookDelivery(context.Background(), components.WebhookClient, server.URL, "secret", delivery, now,
+		time.Second)
+	if err == nil || code != 0 {
+		t.Errorf("expect delivery to loopback address refused, got code: %d, err: %v", code, err)
+	}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/tracer"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/uuid"
@@ -94,13 +95,13 @@ func (fs *feedServer) prepare(opt *options.Option) error {
 	metrics.InitMetrics(net.JoinHostPort(cc.FeedServer().Network.BindIP,
 		strconv.Itoa(int(cc.FeedServer().Network.RpcPort))))
 
-	if err := componentratelimit.Setup(cc.FeedServerName); err != nil {
-		return fmt.Errorf("setup component rate limit failed, err: %v", err)
+	// init tracing
+	if err := tracer.Setup(cc.FeedServerName); err != nil {
+		return fmt.Errorf("setup tracing failed, err: %v", err)
 	}
 
-	etcdOpt, err := cc.FeedServer().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
+	if err := componentratelimit.Setup(cc.FeedServerName); err != nil {
+		return fmt.Errorf("setup component rate limit failed, err: %v", err)
 	}
 
 	// register data service.
@@ -110,7 +111,7 @@ func (fs *feedServer) prepare(opt *options.Option) error {
 		Port: cc.FeedServer().Network.RpcPort,
 		Uid:  uuid.UUID(),
 	}
-	sd, err := serviced.NewServiceD(etcdOpt, svcOpt)
+	sd, err := serviced.NewServiceDWithConf(cc.FeedServer().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service discovery failed, err: %v", err)
 	}
@@ -157,6 +158,7 @@ func (fs *feedServer) listenAndServe() error {
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(1 * 1024 * 1024),
 		// add bscp unary interceptor and standard grpc server metrics interceptor.
 		grpc.ChainUnaryInterceptor(
+			brpc.TraceUnaryServerInterceptor(),
 			realip.UnaryServerInterceptorOpts(),
 			service.LogUnaryServerInterceptor(),
 			grpcMetrics.UnaryServerInterceptor(),
@@ -167,6 +169,7 @@ func (fs *feedServer) listenAndServe() error {
 			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
 		),
 		grpc.ChainStreamInterceptor(
+			brpc.TraceStreamServerInterceptor(),
 			realip.StreamServerInterceptorOpts(),
 			grpcMetrics.StreamServerInterceptor(),
 			ratelimit.StreamServerInterceptor(ipLimiter),
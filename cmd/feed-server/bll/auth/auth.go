@@ -43,6 +43,11 @@ func (as *AuthService) CanMatchCI(kt *kit.Kit, bizID uint32,
 	return as.cache.Credential.CanMatchCI(kt, bizID, app, token, path, name)
 }
 
+// RecordUsage record the credential usage of the client.
+func (as *AuthService) RecordUsage(kt *kit.Kit, bizID, credentialID uint32, clientUID string) error {
+	return as.cache.Credential.RecordUsage(kt, bizID, credentialID, clientUID)
+}
+
 // GetCred 获取凭证配置
 func (as *AuthService) GetCred(kt *kit.Kit, bizID uint32, token string) (*types.CredentialCache, error) {
 	return as.cache.Credential.GetCred(kt, bizID, token)
@@ -102,14 +102,15 @@ func (ae *appEvent) doFirstMatch(kt *kit.Kit, subSpec *SubscribeSpec) (uint32, u
 	cursor := ae.cursor.ID()
 
 	meta := &btyp.AppInstanceMeta{
-		BizID:  subSpec.InstSpec.BizID,
-		AppID:  subSpec.InstSpec.AppID,
-		App:    subSpec.InstSpec.App,
-		Uid:    subSpec.InstSpec.Uid,
-		Labels: subSpec.InstSpec.Labels,
+		BizID:      subSpec.InstSpec.BizID,
+		AppID:      subSpec.InstSpec.AppID,
+		App:        subSpec.InstSpec.App,
+		Uid:        subSpec.InstSpec.Uid,
+		Labels:     subSpec.InstSpec.Labels,
+		Constraint: subSpec.InstSpec.Constraint,
 	}
 
-	matchedRelease, err := ae.sch.handler.GetMatchedRelease(kt, meta)
+	matchedRelease, _, err := ae.sch.handler.GetMatchedRelease(kt, meta)
 	if err != nil {
 		// filter out the no matched strategies error and handle it specially.
 		// so that sidecar do not retry repeatedly.
@@ -30,6 +30,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -51,9 +52,9 @@ type Option struct {
 
 // Handler all the call back handles, used to handle schedule jobs.
 type Handler struct {
-	// GetMatchedRelease get the specified app instance's current release id.
+	// GetMatchedRelease get the specified app instance's current release id and the matched group id.
 	// Note: this function's match pipeline should not use cache data,
-	GetMatchedRelease func(kt *kit.Kit, meta *btyp.AppInstanceMeta) (uint32, error)
+	GetMatchedRelease func(kt *kit.Kit, meta *btyp.AppInstanceMeta) (uint32, uint32, error)
 }
 
 // NewScheduler create a new scheduler instance.
@@ -260,13 +261,20 @@ func (sch *Scheduler) notifyOne(kt *kit.Kit, cursorID uint32, one *member) {
 	// Note: optimize this when a mount of instances have the same labels with same release id.
 	inst := one.InstSpec
 	meta := &btyp.AppInstanceMeta{
-		BizID:  inst.BizID,
-		AppID:  inst.AppID,
-		App:    inst.App,
-		Uid:    inst.Uid,
-		Labels: inst.Labels,
+		BizID:      inst.BizID,
+		AppID:      inst.AppID,
+		App:        inst.App,
+		Uid:        inst.Uid,
+		Labels:     inst.Labels,
+		Constraint: inst.Constraint,
+	}
+	releaseID, groupID, e := sch.handler.GetMatchedRelease(kt, meta)
+	if errors.Is(e, errf.ErrReleaseConstraintNotSatisfied) {
+		// the instance stays on its current release until a release satisfying its constraint is matched.
+		logs.Warnf("%s [sn: %d] matched release does not satisfy the constraint %s, skip notify, rid: %s",
+			inst.Format(), one.sn, inst.Constraint, kt.Rid)
+		return
 	}
-	releaseID, e := sch.handler.GetMatchedRelease(kt, meta)
 	if e != nil {
 		sch.retry.Add(cursorID, one)
 		logs.Errorf("get %s [sn: %d] matched strategy failed, err: %v, rid: %s", inst.Format(), one.sn, e, kt.Rid)
@@ -307,7 +315,7 @@ func (sch *Scheduler) notifyOne(kt *kit.Kit, cursorID uint32, one *member) {
 		if len(ciList) == 0 {
 			return
 		}
-		event = sch.buildEvent(inst, ciList, preHook, postHook, releaseID, cursorID)
+		event = sch.buildEvent(inst, ciList, preHook, postHook, releaseID, groupID, cursorID)
 
 	default:
 		logs.Errorf("Unsupported application type (%s), rid: %s", inst.Format(), kt.Rid)
@@ -322,7 +330,7 @@ func (sch *Scheduler) notifyOne(kt *kit.Kit, cursorID uint32, one *member) {
 }
 
 func (sch *Scheduler) buildEvent(inst *sfs.InstanceSpec, ciList []*types.ReleaseCICache,
-	pre *types.ReleasedHookCache, post *types.ReleasedHookCache, releaseID uint32, cursorID uint32) *Event {
+	pre *types.ReleasedHookCache, post *types.ReleasedHookCache, releaseID, groupID uint32, cursorID uint32) *Event {
 	uriD := sch.provider.URIDecorator(inst.BizID)
 	ciMeta := make([]*sfs.ConfigItemMetaV1, 0)
 	for _, one := range ciList {
@@ -338,13 +346,15 @@ func (sch *Scheduler) buildEvent(inst *sfs.InstanceSpec, ciList []*types.Release
 				continue
 			}
 		}
+		// the content rendered with the template variables overridden by the matched group is used first
+		commitSpec := one.CommitSpecOf(groupID)
 		m := &sfs.ConfigItemMetaV1{
 			ID:       one.ID,
 			CommitID: one.CommitID,
 			ContentSpec: &pbct.ContentSpec{
-				Signature: one.CommitSpec.Signature,
-				ByteSize:  one.CommitSpec.ByteSize,
-				Md5:       one.CommitSpec.Md5,
+				Signature: commitSpec.Signature,
+				ByteSize:  commitSpec.ByteSize,
+				Md5:       commitSpec.Md5,
 			},
 			ConfigItemSpec: &pbci.ConfigItemSpec{
 				Name:     cis.Name,
@@ -369,7 +379,7 @@ func (sch *Scheduler) buildEvent(inst *sfs.InstanceSpec, ciList []*types.Release
 				CreateAt: one.Revision.CreatedAt.Format(time.RFC3339),
 				UpdateAt: one.Revision.UpdatedAt.Format(time.RFC3339),
 			},
-			RepositoryPath: uriD.Path(one.CommitSpec.Signature),
+			RepositoryPath: uriD.Path(commitSpec.Signature),
 		}
 		ciMeta = append(ciMeta, m)
 	}
@@ -30,6 +30,9 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/types"
 )
 
+// credentialUsageReportInterval 同一凭证同一客户端的使用记录上报间隔
+const credentialUsageReportInterval = 5 * time.Minute
+
 // newCredential credential's local cache instance.
 func newCredential(mc *metric, cs *clientset.ClientSet) *Credential {
 	stg := new(Credential)
@@ -41,6 +44,10 @@ func newCredential(mc *metric, cs *clientset.ClientSet) *Credential {
 		EvictedFunc(stg.evictRecorder).
 		Expiration(time.Duration(opt.CredentialCacheTTLSec) * time.Second).
 		Build()
+	stg.usage = gcache.New(int(opt.CredentialCacheSize)).
+		LRU().
+		Expiration(credentialUsageReportInterval).
+		Build()
 	stg.mc = mc
 	stg.collectHitRate()
 
@@ -51,7 +58,9 @@ func newCredential(mc *metric, cs *clientset.ClientSet) *Credential {
 type Credential struct {
 	mc     *metric
 	client gcache.Cache
-	cs     *clientset.ClientSet
+	// usage 记录已上报的凭证使用记录, 用于限制上报频率
+	usage gcache.Cache
+	cs    *clientset.ClientSet
 }
 
 // CanMatchCI the credential's local cache.
@@ -75,16 +84,7 @@ func (s *Credential) CanMatchCI(kt *kit.Kit, bizID uint32, app string, credentia
 
 	if hit {
 		s.mc.hitCounter.With(prm.Labels{"resource": "credential", "biz": tools.Itoa(bizID)}).Inc()
-		if !c.Enabled {
-			return false, nil
-		}
-		for _, s := range c.Scope {
-			ok, _ := tools.MatchAppConfigItem(s, app, path, name)
-			if ok {
-				return true, nil
-			}
-		}
-		return false, nil
+		return c.Enabled && !c.IsExpired(time.Now()) && c.MatchConfigItem(app, path, name), nil
 	}
 
 	// get the cache from cache service directly.
@@ -108,17 +108,7 @@ func (s *Credential) CanMatchCI(kt *kit.Kit, bizID uint32, app string, credentia
 		// do not return, ignore th error directly.
 	}
 
-	for _, s := range c.Scope {
-		if !c.Enabled {
-			return false, nil
-		}
-		ok, _ := tools.MatchAppConfigItem(s, app, path, name)
-		if ok {
-			return true, nil
-		}
-	}
-
-	return false, nil
+	return c.Enabled && !c.IsExpired(time.Now()) && c.MatchConfigItem(app, path, name), nil
 }
 
 // GetCred 获取凭证, 并缓存
@@ -155,6 +145,37 @@ func (s *Credential) GetCred(kt *kit.Kit, bizID uint32, credential string) (*typ
 	return &c, nil
 }
 
+// RecordUsage 上报凭证使用记录, 同一凭证同一客户端在上报间隔内只上报一次
+func (s *Credential) RecordUsage(kt *kit.Kit, bizID, credentialID uint32, clientUID string) error {
+	if credentialID == 0 || len(clientUID) == 0 {
+		return nil
+	}
+
+	key := fmt.Sprintf("%d-%d-%s", bizID, credentialID, clientUID)
+	if s.usage.Has(key) {
+		return nil
+	}
+	if err := s.usage.Set(key, struct{}{}); err != nil {
+		logs.Errorf("set credential usage %s cache failed, %s", key, err.Error())
+	}
+
+	_, err := s.cs.CS().SetCredentialUsage(kt.RpcCtx(), &pbcs.SetCredentialUsageReq{
+		BizId: bizID,
+		Usages: []*pbcs.CredentialUsage{{
+			CredentialId: credentialID,
+			ClientUid:    clientUID,
+			UsedAt:       time.Now().Unix(),
+		}},
+	})
+	if err != nil {
+		// 上报失败时允许下次请求重新上报
+		s.usage.Remove(key)
+		return err
+	}
+
+	return nil
+}
+
 func (s *Credential) getCredentialFromCache(_ *kit.Kit, bizID uint32, credential string) (
 	c types.CredentialCache, hit bool, err error) {
 
@@ -20,6 +20,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/types"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
@@ -30,39 +31,47 @@ import (
 	ptypes "github.com/TencentBlueKing/bk-bscp/pkg/types"
 )
 
-// GetMatchedRelease get the app instance's matched release id.
-func (rs *ReleasedService) GetMatchedRelease(kt *kit.Kit, meta *types.AppInstanceMeta) (uint32, error) {
+// GetMatchedRelease get the app instance's matched release id and the id of the group which the release is
+// matched by.
+func (rs *ReleasedService) GetMatchedRelease(kt *kit.Kit, meta *types.AppInstanceMeta) (uint32, uint32, error) {
 
 	ctx, cancel := context.WithTimeout(context.TODO(), rs.matchReleaseWaitTime)
 	defer cancel()
 
 	if err := rs.limiter.Wait(ctx); err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 
 	am, err := rs.cache.App.GetMeta(kt, meta.BizID, meta.AppID)
 	if err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 
 	switch am.ConfigType {
 	case table.File:
 	case table.KV:
 	default:
-		return 0, errf.New(errf.InvalidParameter, "only supports File and KV configuration types.")
+		return 0, 0, errf.New(errf.InvalidParameter, "only supports File and KV configuration types.")
 	}
 
 	groups, err := rs.listReleasedGroups(kt, meta)
 	if err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 
 	matched, err := rs.matchReleasedGroupWithLabels(kt, groups, meta)
 	if err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 
-	return matched.ReleaseID, nil
+	// 客户端声明了版本约束时，匹配到的版本不满足约束则不下发，客户端保持当前版本
+	if !meta.Constraint.Satisfied(matched.Tags) {
+		logs.Warnf("biz: %d, app: %d, uid: %s matched release %d of group %d does not satisfy the constraint %s, "+
+			"rid: %s", meta.BizID, meta.AppID, meta.Uid, matched.ReleaseID, matched.GroupID, meta.Constraint, kt.Rid)
+		return 0, 0, errf.ErrReleaseConstraintNotSatisfied
+	}
+
+	return matched.ReleaseID, matched.GroupID, nil
 }
 
 // listReleasedGroups list released groups
@@ -81,6 +90,18 @@ type matchedMeta struct {
 	ReleaseID   uint32
 	GroupID     uint32
 	GrayPercent float64 // 灰度比例，用于选择最大比例的分组
+	Tags        []string
+}
+
+// newMatchedMeta returns the matched meta of the group, the release is the pinned release if the group is pinned.
+func newMatchedMeta(group *ptypes.ReleasedGroupCache, now time.Time) *matchedMeta {
+	releaseID, tags := group.EffectiveRelease(now)
+	return &matchedMeta{
+		ReleaseID:  releaseID,
+		GroupID:    group.GroupID,
+		StrategyID: group.StrategyID,
+		Tags:       tags,
+	}
 }
 
 // matchOneStrategyWithLabels match at most only one strategy with app instance labels.
@@ -92,18 +113,15 @@ func (rs *ReleasedService) matchReleasedGroupWithLabels(
 	sort.Slice(groups, func(i, j int) bool {
 		return groups[i].UpdatedAt.After(groups[j].UpdatedAt)
 	})
-	// 2. match groups with labels
+	// 2. match groups with labels, the pinned groups use the pinned release
+	now := time.Now()
 	matchedList := []*matchedMeta{}
 	var def *matchedMeta
 	for _, group := range groups {
 		switch group.Mode {
 		case table.GroupModeDebug:
 			if group.UID == meta.Uid {
-				matchedList = append(matchedList, &matchedMeta{
-					ReleaseID:  group.ReleaseID,
-					GroupID:    group.GroupID,
-					StrategyID: group.StrategyID,
-				})
+				matchedList = append(matchedList, newMatchedMeta(group, now))
 			}
 		case table.GroupModeCustom:
 			matched, grayPercent, err := rs.matchCustomGroupWithGrayStrategy(group, meta)
@@ -112,19 +130,24 @@ func (rs *ReleasedService) matchReleasedGroupWithLabels(
 			}
 
 			if matched {
-				matchedList = append(matchedList, &matchedMeta{
-					ReleaseID:   group.ReleaseID,
-					GroupID:     group.GroupID,
-					StrategyID:  group.StrategyID,
-					GrayPercent: grayPercent,
-				})
+				one := newMatchedMeta(group, now)
+				one.GrayPercent = grayPercent
+				matchedList = append(matchedList, one)
 			}
-		case table.GroupModeDefault:
-			def = &matchedMeta{
-				ReleaseID:  group.ReleaseID,
-				GroupID:    group.GroupID,
-				StrategyID: group.StrategyID,
+		case table.GroupModeDynamic:
+			// 动态分组在发布时已解析为客户端 UID 列表，按 UID 匹配
+			if group.Selector == nil {
+				return nil, errf.New(errf.InvalidParameter, "dynamic group must have selector")
 			}
+			matched, err := group.Selector.MatchLabels(map[string]string{table.DynamicGroupUIDKey: meta.Uid})
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				matchedList = append(matchedList, newMatchedMeta(group, now))
+			}
+		case table.GroupModeDefault:
+			def = newMatchedMeta(group, now)
 		}
 	}
 
@@ -15,6 +15,7 @@ package release
 import (
 	"fmt"
 	"math"
+	"reflect"
 	"testing"
 	"time"
 
@@ -383,6 +384,71 @@ func TestMatchReleasedGroupWithLabels(t *testing.T) {
 			t.Log("✅ 灰度一致性检查通过")
 		}
 	})
+
+	t.Run("TestDynamicGroup_MatchByUID", func(t *testing.T) {
+		// 测试动态分组按发布时解析出的客户端 UID 匹配，未命中时回退到默认分组
+		dynamic := &ptypes.ReleasedGroupCache{
+			GroupID:    1,
+			ReleaseID:  101,
+			StrategyID: 1001,
+			Mode:       table.GroupModeDynamic,
+			UpdatedAt:  time.Now(),
+			Selector: &selector.Selector{
+				LabelsAnd: []selector.Element{
+					{
+						Key:   table.DynamicGroupUIDKey,
+						Op:    &selector.InOperator,
+						Value: []interface{}{"uid-1", "uid-2"},
+					},
+				},
+			},
+		}
+		groups := []*ptypes.ReleasedGroupCache{dynamic, createDefaultGroup(2, 102)}
+
+		for uid, want := range map[string]uint32{"uid-2": 1, "uid-3": 2} {
+			matched, err := rs.matchReleasedGroupWithLabels(nil, groups, &types.AppInstanceMeta{Uid: uid})
+			if err != nil {
+				t.Fatalf("matchReleasedGroupWithLabels failed: %v", err)
+			}
+			if matched.GroupID != want {
+				t.Errorf("uid %s expected to match GroupID=%d, but got GroupID=%d", uid, want, matched.GroupID)
+			}
+		}
+	})
+
+	t.Run("TestPinnedGroup_UsePinnedRelease", func(t *testing.T) {
+		// 测试分组固定期间使用固定的版本及其标签，固定到期后使用发布的版本
+		expired := time.Now().Add(-time.Minute)
+		future := time.Now().Add(time.Hour)
+		cases := []struct {
+			name    string
+			until   *time.Time
+			release uint32
+			tags    []string
+		}{
+			{name: "pinned forever", until: nil, release: 90, tags: []string{"stable"}},
+			{name: "pinned until future", until: &future, release: 90, tags: []string{"stable"}},
+			{name: "pin expired", until: &expired, release: 102, tags: []string{"beta"}},
+		}
+
+		for _, c := range cases {
+			def := createDefaultGroup(2, 102)
+			def.ReleaseTags = []string{"beta"}
+			def.PinnedReleaseID = 90
+			def.PinnedReleaseTags = []string{"stable"}
+			def.PinnedUntil = c.until
+
+			matched, err := rs.matchReleasedGroupWithLabels(nil, []*ptypes.ReleasedGroupCache{def},
+				&types.AppInstanceMeta{Uid: "uid-1"})
+			if err != nil {
+				t.Fatalf("%s: matchReleasedGroupWithLabels failed: %v", c.name, err)
+			}
+			if matched.ReleaseID != c.release || !reflect.DeepEqual(matched.Tags, c.tags) {
+				t.Errorf("%s: expected release %d with tags %v, but got release %d with tags %v", c.name,
+					c.release, c.tags, matched.ReleaseID, matched.Tags)
+			}
+		}
+	})
 }
 
 // TestMultipleGrayGroupsRealWorld 真实场景下的多分组测试
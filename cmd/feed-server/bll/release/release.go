@@ -14,6 +14,7 @@
 package release
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/types"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 	pbcommit "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/commit"
@@ -68,9 +70,9 @@ type ReleasedService struct {
 func (rs *ReleasedService) ListAppLatestReleaseMeta(kt *kit.Kit, opts *types.AppInstanceMeta) (
 	*types.AppLatestReleaseMeta, error) {
 
-	releaseID, err := rs.GetMatchedRelease(kt, opts)
+	releaseID, groupID, err := rs.GetMatchedRelease(kt, opts)
 	if err != nil {
-		return nil, err
+		return nil, matchedReleaseError(err)
 	}
 
 	rci, err := rs.cache.ReleasedCI.Get(kt, opts.BizID, releaseID)
@@ -107,15 +109,17 @@ func (rs *ReleasedService) ListAppLatestReleaseMeta(kt *kit.Kit, opts *types.App
 	}
 	ciList := make([]*types.ReleasedCIMeta, len(rci))
 	for idx, one := range rci {
+		// the content rendered with the template variables overridden by the matched group is used first
+		commitSpec := one.CommitSpecOf(groupID)
 		ciList[idx] = &types.ReleasedCIMeta{
 			RciId:    one.ID,
 			CommitID: one.CommitID,
 			CommitSpec: &pbcommit.CommitSpec{
-				ContentId: one.CommitSpec.ContentID,
+				ContentId: commitSpec.ContentID,
 				Content: &pbcontent.ContentSpec{
-					Signature: one.CommitSpec.Signature,
-					ByteSize:  one.CommitSpec.ByteSize,
-					Md5:       one.CommitSpec.Md5,
+					Signature: commitSpec.Signature,
+					ByteSize:  commitSpec.ByteSize,
+					Md5:       commitSpec.Md5,
 				},
 			},
 			ConfigItemSpec: &pbci.ConfigItemSpec{
@@ -139,7 +143,7 @@ func (rs *ReleasedService) ListAppLatestReleaseMeta(kt *kit.Kit, opts *types.App
 				CreateAt: one.Revision.CreatedAt.Format(time.RFC3339),
 				UpdateAt: one.Revision.UpdatedAt.Format(time.RFC3339),
 			},
-			RepositorySpec: &types.RepositorySpec{Path: uriDec.Path(one.CommitSpec.Signature)},
+			RepositorySpec: &types.RepositorySpec{Path: uriDec.Path(commitSpec.Signature)},
 		}
 	}
 	meta.ConfigItems = ciList
@@ -151,9 +155,9 @@ func (rs *ReleasedService) ListAppLatestReleaseMeta(kt *kit.Kit, opts *types.App
 func (rs *ReleasedService) ListAppLatestReleaseKvMeta(kt *kit.Kit, opts *types.AppInstanceMeta) (
 	*types.AppLatestReleaseKvMeta, error) {
 
-	releaseID, err := rs.GetMatchedRelease(kt, opts)
+	releaseID, _, err := rs.GetMatchedRelease(kt, opts)
 	if err != nil {
-		return nil, err
+		return nil, matchedReleaseError(err)
 	}
 
 	rkv, err := rs.cache.ReleasedKv.Get(kt, opts.BizID, releaseID)
@@ -188,3 +192,13 @@ func (rs *ReleasedService) ListAppLatestReleaseKvMeta(kt *kit.Kit, opts *types.A
 
 	return meta, nil
 }
+
+// matchedReleaseError converts the error of matching the release for the client which pulls the configs, the
+// client gets the ReleaseConstraintNotSatisfied code if the matched release does not satisfy its constraint.
+func matchedReleaseError(err error) error {
+	if errors.Is(err, errf.ErrReleaseConstraintNotSatisfied) {
+		return errf.New(errf.ReleaseConstraintNotSatisfied, err.Error())
+	}
+
+	return err
+}
@@ -91,6 +91,7 @@ func (wh *watchHandler) subscribe() error {
 				Labels:     one.Labels,
 				Match:      one.Match,
 				ConfigType: meta.ConfigType,
+				Constraint: wh.im.Meta.Constraint,
 			},
 			Receiver: eventc.InitReceiver(wh.eventReceiver, wh.cancelCtx),
 		}
@@ -26,6 +26,7 @@ import (
 	pbcontent "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
 	pbhook "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook"
 	pbkv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/kv"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
 )
 
 var (
@@ -57,6 +58,8 @@ type AppInstanceMeta struct {
 	Namespace string            `json:"namespace"`
 	Uid       string            `json:"uid"`
 	Labels    map[string]string `json:"labels"`
+	// Constraint is the constraint of the releases which the app instance accepts
+	Constraint *sfs.ReleaseConstraint `json:"constraint"`
 }
 
 // ListFileAppLatestReleaseMetaReq defines options to list a file type app's latest release metadata.
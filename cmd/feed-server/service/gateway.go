@@ -26,6 +26,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -37,6 +38,9 @@ import (
 func newFeedServerMux() (*runtime.ServeMux, error) {
 	opts := make([]grpc.DialOption, 0)
 
+	// add tracing interceptors.
+	opts = append(opts, brpc.TraceDialOptions()...)
+
 	network := cc.FeedServer().Network
 	tls := network.TLS
 	if !tls.Enable() {
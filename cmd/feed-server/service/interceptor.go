@@ -26,6 +26,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -101,12 +102,73 @@ func (s *Service) authorize(ctx context.Context, bizID uint32) (context.Context,
 	if !cred.Enabled {
 		return nil, status.Errorf(codes.PermissionDenied, "credential is disabled")
 	}
+	if err := s.useCredential(kt, bizID, cred, credentialClientUID(ctx, md)); err != nil {
+		return nil, err
+	}
 
 	// 获取scope，到下一步处理
 	ctx = withCredential(ctx, cred)
 	return ctx, nil
 }
 
+// useCredential 校验凭证是否过期并上报使用记录, 上报失败不影响请求
+func (s *Service) useCredential(kt *kit.Kit, bizID uint32, cred *types.CredentialCache, clientUID string) error {
+	if cred.IsExpired(time.Now()) {
+		return status.Errorf(codes.Unauthenticated, "credential is expired")
+	}
+	if err := s.bll.Auth().RecordUsage(kt, bizID, cred.ID, clientUID); err != nil {
+		logs.Errorf("record credential %d usage failed, err: %v, rid: %s", cred.ID, err, kt.Rid)
+	}
+	return nil
+}
+
+// credentialClientUID 使用凭证的客户端标识, 优先取 sidecar 指纹, 否则取客户端 IP
+func credentialClientUID(ctx context.Context, md metadata.MD) string {
+	if sm := md.Get(constant.SidecarMetaKey); len(sm) != 0 {
+		meta := new(sfs.SidecarMetaHeader)
+		if err := jsoni.UnmarshalFromString(sm[0], meta); err == nil && meta.Fingerprint != "" {
+			return meta.Fingerprint
+		}
+	}
+	return brpc.MustGetRealIP(ctx)
+}
+
+// matchCredentialLabels 校验凭证是否允许客户端声明的标签
+func matchCredentialLabels(cred *types.CredentialCache, app string, labels map[string]string) error {
+	if !cred.MatchLabels(app, labels) {
+		return status.Errorf(codes.PermissionDenied, "credential not allowed labels %v of app %s", labels, app)
+	}
+	return nil
+}
+
+// authorizeWatch 校验凭证的监听权限, 未携带凭证的老版本 sidecar 仅在配置了 allowLegacyWatch 时跳过校验
+func (s *Service) authorizeWatch(ctx context.Context, payload *sfs.SideWatchPayload) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("authorization")) == 0 {
+		if cc.FeedServer().AllowLegacyWatch {
+			return nil
+		}
+		return status.Error(codes.Unauthenticated, "missing authorization credential")
+	}
+
+	ctx, err := s.authorize(ctx, payload.BizID)
+	if err != nil {
+		return err
+	}
+
+	cred := getCredential(ctx)
+	for _, one := range payload.Applications {
+		if !cred.CanWatch(one.App) {
+			return status.Errorf(codes.PermissionDenied, "not have app %s watch permission", one.App)
+		}
+		if err := matchCredentialLabels(cred, one.App, one.Labels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // FeedEnsureTenantInterceptor extracts biz_id from all requests (new + legacy) and resolves tenant_id.
 func FeedEnsureTenantInterceptor(
 	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
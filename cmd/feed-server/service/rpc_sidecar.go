@@ -103,6 +103,10 @@ func (s *Service) Handshake(ctx context.Context, hm *pbfs.HandshakeMessage) (*pb
 			EnableAsyncDownload: cc.FeedServer().GSE.Enabled,
 		},
 	}
+	// 回传接受的版本约束，便于客户端确认服务端支持版本约束
+	if !im.Meta.Constraint.IsEmpty() {
+		payload.ReleaseConstraint = im.Meta.Constraint
+	}
 
 	payloadBytes, err := jsoni.Marshal(payload)
 	if err != nil {
@@ -156,6 +160,10 @@ func (s *Service) Watch(swm *pbfs.SideWatchMeta, fws pbfs.Upstream_WatchServer)
 		return status.Errorf(codes.Aborted, "invalid payload, err: %s", err.Error())
 	}
 
+	if err := s.authorizeWatch(fws.Context(), payload); err != nil {
+		return err
+	}
+
 	var msg string
 	for _, one := range payload.Applications {
 		msg += fmt.Sprintf("biz: %d, app: %s, uid: %s, labels: %s, ", payload.BizID, one.App, one.Uid, one.Labels)
@@ -219,11 +227,12 @@ func (s *Service) Messaging(ctx context.Context, msg *pbfs.MessagingMeta) (*pbfs
 			// 获取TargetReleaseID时出现错误直接忽略
 			if vc.Application.TargetReleaseID == 0 {
 				meta := &types.AppInstanceMeta{
-					BizID:  vc.BasicData.BizID,
-					App:    vc.Application.App,
-					AppID:  appID,
-					Uid:    vc.Application.Uid,
-					Labels: vc.Application.Labels,
+					BizID:      vc.BasicData.BizID,
+					App:        vc.Application.App,
+					AppID:      appID,
+					Uid:        vc.Application.Uid,
+					Labels:     vc.Application.Labels,
+					Constraint: releaseConstraintFromContext(ctx),
 				}
 				cancel := im.Kit.CtxWithTimeoutMS(1500)
 				defer cancel()
@@ -351,12 +360,27 @@ func (s *Service) PullAppFileMeta(ctx context.Context, req *pbfs.PullAppFileMeta
 		}
 		return nil, status.Error(codes.Aborted, fmt.Sprintf("get app id failed, %s", err.Error()))
 	}
+	// 配置项的凭证校验见 CanMatchCI, 这里校验客户端声明的标签
+	if req.Token != "" {
+		cred, err := s.bll.Auth().GetCred(im.Kit, req.BizId, req.Token)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "do authorization failed, %s", err.Error())
+		}
+		if err := s.useCredential(im.Kit, req.BizId, cred, req.AppMeta.Uid); err != nil {
+			return nil, err
+		}
+		if err := matchCredentialLabels(cred, req.AppMeta.App, req.AppMeta.Labels); err != nil {
+			return nil, err
+		}
+	}
+
 	meta := &types.AppInstanceMeta{
-		BizID:  req.BizId,
-		App:    req.GetAppMeta().App,
-		AppID:  appID,
-		Uid:    req.AppMeta.Uid,
-		Labels: req.AppMeta.Labels,
+		BizID:      req.BizId,
+		App:        req.GetAppMeta().App,
+		AppID:      appID,
+		Uid:        req.AppMeta.Uid,
+		Labels:     req.AppMeta.Labels,
+		Constraint: releaseConstraintFromContext(ctx),
 	}
 
 	cancel := im.Kit.CtxWithTimeoutMS(1500)
@@ -548,6 +572,9 @@ func (s *Service) PullKvMeta(ctx context.Context, req *pbfs.PullKvMetaReq) (*pbf
 	if !credential.MatchApp(req.AppMeta.App) {
 		return nil, status.Errorf(codes.PermissionDenied, "not have app %s permission", req.AppMeta.App)
 	}
+	if err := matchCredentialLabels(credential, req.AppMeta.App, req.AppMeta.Labels); err != nil {
+		return nil, err
+	}
 
 	appID, err := s.bll.AppCache().GetAppID(kt, req.BizId, req.AppMeta.App)
 	if err != nil {
@@ -570,11 +597,12 @@ func (s *Service) PullKvMeta(ctx context.Context, req *pbfs.PullKvMetaReq) (*pbf
 	}
 
 	meta := &types.AppInstanceMeta{
-		BizID:  req.BizId,
-		App:    req.AppMeta.App,
-		AppID:  appID,
-		Uid:    req.AppMeta.Uid,
-		Labels: req.AppMeta.Labels,
+		BizID:      req.BizId,
+		App:        req.AppMeta.App,
+		AppID:      appID,
+		Uid:        req.AppMeta.Uid,
+		Labels:     req.AppMeta.Labels,
+		Constraint: releaseConstraintFromContext(ctx),
 	}
 
 	metas, err := s.bll.Release().ListAppLatestReleaseKvMeta(kt, meta)
@@ -629,6 +657,9 @@ func (s *Service) GetKvValue(ctx context.Context, req *pbfs.GetKvValueReq) (*pbf
 	if !credential.MatchApp(req.AppMeta.App) {
 		return nil, status.Errorf(codes.PermissionDenied, "not have app %s permission", req.AppMeta.App)
 	}
+	if err := matchCredentialLabels(credential, req.AppMeta.App, req.AppMeta.Labels); err != nil {
+		return nil, err
+	}
 
 	if !credential.MatchKv(req.AppMeta.App, req.Key) {
 		return nil, status.Error(codes.PermissionDenied, "no permission get value")
@@ -655,11 +686,12 @@ func (s *Service) GetKvValue(ctx context.Context, req *pbfs.GetKvValueReq) (*pbf
 	}
 
 	meta := &types.AppInstanceMeta{
-		BizID:  req.BizId,
-		App:    req.GetAppMeta().App,
-		AppID:  appID,
-		Uid:    req.AppMeta.Uid,
-		Labels: req.AppMeta.Labels,
+		BizID:      req.BizId,
+		App:        req.GetAppMeta().App,
+		AppID:      appID,
+		Uid:        req.AppMeta.Uid,
+		Labels:     req.AppMeta.Labels,
+		Constraint: releaseConstraintFromContext(ctx),
 	}
 
 	metas, err := s.bll.Release().ListAppLatestReleaseKvMeta(kt, meta)
@@ -932,6 +964,9 @@ func (s *Service) GetSingleKvMeta(ctx context.Context, req *pbfs.GetSingleKvValu
 	if !credential.MatchApp(req.AppMeta.App) {
 		return nil, status.Errorf(codes.PermissionDenied, "not have app %s permission", req.AppMeta.App)
 	}
+	if err := matchCredentialLabels(credential, req.AppMeta.App, req.AppMeta.Labels); err != nil {
+		return nil, err
+	}
 
 	if !credential.MatchKv(req.AppMeta.App, req.Key) {
 		return nil, status.Error(codes.PermissionDenied, "no permission get value")
@@ -958,11 +993,12 @@ func (s *Service) GetSingleKvMeta(ctx context.Context, req *pbfs.GetSingleKvValu
 	}
 
 	meta := &types.AppInstanceMeta{
-		BizID:  req.BizId,
-		App:    req.AppMeta.App,
-		AppID:  appID,
-		Uid:    req.AppMeta.Uid,
-		Labels: req.AppMeta.Labels,
+		BizID:      req.BizId,
+		App:        req.AppMeta.App,
+		AppID:      appID,
+		Uid:        req.AppMeta.Uid,
+		Labels:     req.AppMeta.Labels,
+		Constraint: releaseConstraintFromContext(ctx),
 	}
 
 	metas, err := s.bll.Release().ListAppLatestReleaseKvMeta(kt, meta)
@@ -1011,6 +1047,9 @@ func (s *Service) GetSingleKvValue(ctx context.Context, req *pbfs.GetSingleKvVal
 	if !credential.MatchApp(req.AppMeta.App) {
 		return nil, status.Errorf(codes.PermissionDenied, "not have app %s permission", req.AppMeta.App)
 	}
+	if err := matchCredentialLabels(credential, req.AppMeta.App, req.AppMeta.Labels); err != nil {
+		return nil, err
+	}
 
 	if !credential.MatchKv(req.AppMeta.App, req.Key) {
 		return nil, status.Error(codes.PermissionDenied, "no permission get value")
@@ -1037,11 +1076,12 @@ func (s *Service) GetSingleKvValue(ctx context.Context, req *pbfs.GetSingleKvVal
 	}
 
 	meta := &types.AppInstanceMeta{
-		BizID:  req.BizId,
-		App:    req.GetAppMeta().App,
-		AppID:  appID,
-		Uid:    req.AppMeta.Uid,
-		Labels: req.AppMeta.Labels,
+		BizID:      req.BizId,
+		App:        req.GetAppMeta().App,
+		AppID:      appID,
+		Uid:        req.AppMeta.Uid,
+		Labels:     req.AppMeta.Labels,
+		Constraint: releaseConstraintFromContext(ctx),
 	}
 
 	metas, err := s.bll.Release().ListAppLatestReleaseKvMeta(kt, meta)
@@ -1138,11 +1178,12 @@ func (s *Service) GetSingleFileContent(req *pbfs.GetSingleFileContentReq,
 	}
 
 	meta := &types.AppInstanceMeta{
-		BizID:  req.BizId,
-		App:    req.GetAppMeta().App,
-		AppID:  appID,
-		Uid:    req.AppMeta.Uid,
-		Labels: req.AppMeta.Labels,
+		BizID:      req.BizId,
+		App:        req.GetAppMeta().App,
+		AppID:      appID,
+		Uid:        req.AppMeta.Uid,
+		Labels:     req.AppMeta.Labels,
+		Constraint: releaseConstraintFromContext(stream.Context()),
 	}
 
 	metas, err := s.bll.Release().ListAppLatestReleaseMeta(im.Kit, meta)
@@ -1353,3 +1394,14 @@ func (s *Service) clientEventChangeRecord(basicData *sfs.BasicData, appMeta *sfs
 	s.mc.changeTotalSeconds.With(versionChange).Observe(float64(appMeta.TotalSeconds))
 
 }
+
+// releaseConstraintFromContext returns the release constraint declared by the client with the sidecar metadata,
+// the sdk which pulls the configs without the sidecar metadata accepts all the releases.
+func releaseConstraintFromContext(ctx context.Context) *sfs.ReleaseConstraint {
+	im, err := sfs.ParseFeedIncomingContext(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return im.Meta.Constraint
+}
@@ -31,6 +31,7 @@ import (
 	bscp "github.com/TencentBlueKing/bk-bscp"
 	"github.com/TencentBlueKing/bk-bscp/docs"
 	_ "github.com/TencentBlueKing/bk-bscp/docs" // 文档自动注册到 swagger
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkpaas"
 	"github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/handler"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
@@ -200,6 +201,11 @@ func (s *WebServer) subRouter() http.Handler {
 	r.With(metrics.RequestCollect("no_permission")).Get("/403.html",
 		s.embedWebServer.Render403Handler(conf).ServeHTTP)
 
+	// OIDC 等由 bscp 自身完成的登入流程, 不带鉴权信息
+	if loginHandler := s.authorizer.LoginHandler(); loginHandler != nil {
+		r.Mount(bkpaas.OIDCRoutePrefix, loginHandler)
+	}
+
 	// vue 模版渲染
 	r.With(metrics.RequestCollect("index"),
 		s.webAuthentication).Get("/", s.embedWebServer.RenderIndexHandler(conf).ServeHTTP)
@@ -85,11 +85,6 @@ func (as *vaultService) prepare(opt *options.Option) error {
 	metrics.InitMetrics(net.JoinHostPort(cc.VaultServer().Network.BindIP,
 		strconv.Itoa(int(cc.VaultServer().Network.RpcPort))))
 
-	etcdOpt, err := cc.VaultServer().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
-	}
-
 	// register vault server.
 	svcOpt := serviced.ServiceOption{
 		Name: cc.VaultServerName,
@@ -97,7 +92,7 @@ func (as *vaultService) prepare(opt *options.Option) error {
 		Port: cc.VaultServer().Network.RpcPort,
 		Uid:  uuid.UUID(),
 	}
-	sd, err := serviced.NewServiceD(etcdOpt, svcOpt)
+	sd, err := serviced.NewServiceDWithConf(cc.VaultServer().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service discovery faield, err: %v", err)
 	}
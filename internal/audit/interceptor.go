@@ -22,6 +22,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"k8s.io/klog/v2"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
 )
@@ -47,6 +48,12 @@ func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 			var input map[string]any
 			js, _ := json.Marshal(req)
 			_ = json.Unmarshal(js, &input)
+			maskSecretVariables(input)
+			if untypedVariableMethods[info.FullMethod] {
+				if val, ok := input["default_val"].(string); ok && val != "" {
+					input["default_val"] = table.SecretMask
+				}
+			}
 			res.ResourceData = input
 			kt := kit.FromGrpcContext(ctx)
 
@@ -106,3 +113,29 @@ func getUserAgent(ctx context.Context) string {
 
 	return ""
 }
+
+// untypedVariableMethods are the methods which update the variable value without the variable type,
+// the value may be a secret one, so it's always masked.
+var untypedVariableMethods = map[string]bool{
+	"/pbcs.Config/UpdateTemplateVariable": true,
+}
+
+// maskSecretVariables replace the values of secret template variables in the request with the mask,
+// so that the secret values are not recorded in the audit logs.
+func maskSecretVariables(data any) {
+	switch v := data.(type) {
+	case map[string]any:
+		if v["type"] == string(table.SecretVar) {
+			if val, ok := v["default_val"].(string); ok && val != "" {
+				v["default_val"] = table.SecretMask
+			}
+		}
+		for _, one := range v {
+			maskSecretVariables(one)
+		}
+	case []any:
+		for _, one := range v {
+			maskSecretVariables(one)
+		}
+	}
+}
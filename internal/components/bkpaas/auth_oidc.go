@@ -0,0 +1,577 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bkpaas
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+)
+
+const (
+	// OIDCRoutePrefix oidc 登入流程的路由前缀, 由 bscp ui 提供
+	OIDCRoutePrefix = "/oidc"
+	// OIDCLoginPath 发起 oidc 授权码登入的路由
+	OIDCLoginPath = "/login"
+	// OIDCCallbackPath oidc 授权码回调的路由
+	OIDCCallbackPath = "/callback"
+	// OIDCTokenCookie 登入成功后保存 id token 的 cookie
+	OIDCTokenCookie = "bscp_oidc_token"
+
+	// oidcStateCookie 保存 state, PKCE code verifier 和登入后跳转地址的 cookie
+	oidcStateCookie = "bscp_oidc_state"
+	// oidcStateTTL 授权码登入流程的有效时间, 单位秒
+	oidcStateTTL = 600
+	// oidcKeysRefreshInterval jwks 的最小刷新间隔, 避免伪造 kid 的请求频繁拉取 jwks
+	oidcKeysRefreshInterval = time.Minute
+)
+
+// oidcSigningMethods 支持的 id token 签名算法
+var oidcSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// LoginFlowHandler 需要由 bscp 自身完成登入流程的提供方, 如 OIDC 授权码登入
+type LoginFlowHandler interface {
+	// LoginHandler 跳转到提供方的登入页面
+	LoginHandler(w http.ResponseWriter, r *http.Request)
+	// CallbackHandler 处理提供方的登入回调, 写入登入态 cookie 后跳转回原页面
+	CallbackHandler(w http.ResponseWriter, r *http.Request)
+}
+
+// oidcDiscovery oidc 提供方的服务发现文档
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+// jsonWebKey jwks 中的公钥, 支持 RSA 和 EC 类型
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// oidcTokenResult 授权码换取 token 的返回
+type oidcTokenResult struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// oidcAuthClient 通用 OIDC 登入, 如 Keycloak/Dex 等, 使用授权码 + PKCE 登入, 通过 jwks 校验 id token
+type oidcAuthClient struct {
+	conf *cc.LoginAuthSettings
+
+	mu         sync.RWMutex
+	discovery  *oidcDiscovery
+	keys       map[string]interface{}
+	keysSyncAt time.Time
+}
+
+// GetLoginCredentialFromCookies 从 cookie 获取 LoginCredential
+func (o *oidcAuthClient) GetLoginCredentialFromCookies(r *http.Request) (*LoginCredential, error) {
+	token, err := r.Cookie(OIDCTokenCookie)
+	if err != nil {
+		if errors.Is(err, http.ErrNoCookie) {
+			return nil, fmt.Errorf("%s cookie not present", OIDCTokenCookie)
+		}
+		return nil, err
+	}
+
+	return &LoginCredential{UID: "", Token: token.Value}, nil
+}
+
+// GetUserInfoByToken 校验 id token 并获取用户名
+func (o *oidcAuthClient) GetUserInfoByToken(ctx context.Context, _, _, token string) (string, error) {
+	info, err := o.GetTenantUserInfoByToken(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	return info.BkUsername, nil
+}
+
+// GetTenantUserInfoByToken 校验 id token 并按配置的 claim 映射用户名和租户
+func (o *oidcAuthClient) GetTenantUserInfoByToken(ctx context.Context, token string) (*TenantUserInfo, error) {
+	claims, err := o.verifyIDToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.userInfoFromClaims(claims)
+}
+
+// BuildLoginRedirectURL 登入跳转URL
+func (o *oidcAuthClient) BuildLoginRedirectURL(r *http.Request, webHost string) string {
+	return o.loginURL() + "?c_url=" + url.QueryEscape(buildAbsoluteUri(webHost, r))
+}
+
+// BuildLoginURL API未登入访问URL
+func (o *oidcAuthClient) BuildLoginURL(r *http.Request) (string, string) {
+	loginURL := o.loginURL() + "?c_url="
+	return loginURL, loginURL
+}
+
+// LoginHandler 生成 state 和 PKCE code verifier 后跳转到提供方的授权页面
+func (o *oidcAuthClient) LoginHandler(w http.ResponseWriter, r *http.Request) {
+	discovery, err := o.getDiscovery(r.Context())
+	if err != nil {
+		http.Error(w, fmt.Sprintf("get oidc discovery failed, err: %v", err), http.StatusBadGateway)
+		return
+	}
+
+	state, err := randomString()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	verifier, err := randomString()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	cURL := r.URL.Query().Get("c_url")
+	if !o.isSafeRedirect(cURL) {
+		cURL = "/"
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    strings.Join([]string{state, verifier, base64.RawURLEncoding.EncodeToString([]byte(cURL))}, "."),
+		Path:     "/",
+		MaxAge:   oidcStateTTL,
+		HttpOnly: true,
+		Secure:   o.isSecure(),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	challenge := sha256.Sum256([]byte(verifier))
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", o.conf.OIDC.ClientID)
+	query.Set("redirect_uri", o.conf.OIDC.RedirectURL)
+	query.Set("scope", strings.Join(o.conf.OIDC.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", state)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	http.Redirect(w, r, discovery.AuthorizationEndpoint+sep+query.Encode(), http.StatusFound)
+}
+
+// CallbackHandler 校验 state 后使用授权码和 code verifier 换取 id token, 写入登入态 cookie
+func (o *oidcAuthClient) CallbackHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if e := query.Get("error"); e != "" {
+		http.Error(w, fmt.Sprintf("oidc login failed, %s: %s", e, query.Get("error_description")),
+			http.StatusUnauthorized)
+		return
+	}
+
+	stateCookie, err := r.Cookie(oidcStateCookie)
+	if err != nil {
+		http.Error(w, "oidc login state not found, please login again", http.StatusBadRequest)
+		return
+	}
+	parts := strings.Split(stateCookie.Value, ".")
+	if len(parts) != 3 || subtle.ConstantTimeCompare([]byte(parts[0]), []byte(query.Get("state"))) != 1 {
+		http.Error(w, "oidc login state mismatch, please login again", http.StatusBadRequest)
+		return
+	}
+	state, verifier := parts[0], parts[1]
+	cURL := "/"
+	if raw, e := base64.RawURLEncoding.DecodeString(parts[2]); e == nil && o.isSafeRedirect(string(raw)) {
+		cURL = string(raw)
+	}
+
+	idToken, err := o.exchangeCode(r.Context(), query.Get("code"), verifier)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	claims, err := o.verifyIDToken(r.Context(), idToken)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if nonce, _ := claims["nonce"].(string); subtle.ConstantTimeCompare([]byte(nonce), []byte(state)) != 1 {
+		http.Error(w, "oidc id token nonce mismatch", http.StatusUnauthorized)
+		return
+	}
+
+	tokenCookie := &http.Cookie{
+		Name:     OIDCTokenCookie,
+		Value:    idToken,
+		Path:     "/",
+		Domain:   o.conf.OIDC.CookieDomain,
+		HttpOnly: true,
+		Secure:   o.isSecure(),
+		SameSite: http.SameSiteLaxMode,
+	}
+	if exp, ok := claims["exp"].(float64); ok {
+		tokenCookie.Expires = time.Unix(int64(exp), 0)
+	}
+	http.SetCookie(w, tokenCookie)
+	http.SetCookie(w, &http.Cookie{Name: oidcStateCookie, Value: "", Path: "/", MaxAge: -1})
+
+	http.Redirect(w, r, cURL, http.StatusFound)
+}
+
+// exchangeCode 使用授权码和 PKCE code verifier 换取 id token
+func (o *oidcAuthClient) exchangeCode(ctx context.Context, code, verifier string) (string, error) {
+	if code == "" {
+		return "", errors.New("oidc authorization code is required")
+	}
+
+	discovery, err := o.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := map[string]string{
+		"grant_type":    "authorization_code",
+		"code":          code,
+		"redirect_uri":  o.conf.OIDC.RedirectURL,
+		"client_id":     o.conf.OIDC.ClientID,
+		"code_verifier": verifier,
+	}
+	if o.conf.OIDC.ClientSecret != "" {
+		form["client_secret"] = o.conf.OIDC.ClientSecret
+	}
+
+	resp, err := components.GetClient().R().
+		SetContext(ctx).
+		SetFormData(form).
+		Post(discovery.TokenEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	result := new(oidcTokenResult)
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return "", fmt.Errorf("unmarshal oidc token response failed, http code: %d, err: %v", resp.StatusCode(), err)
+	}
+
+	if resp.StatusCode() != http.StatusOK || result.Error != "" {
+		return "", fmt.Errorf("exchange oidc token failed, http code: %d, %s: %s", resp.StatusCode(), result.Error,
+			result.ErrorDescription)
+	}
+
+	if result.IDToken == "" {
+		return "", errors.New("id_token not found in oidc token response")
+	}
+
+	return result.IDToken, nil
+}
+
+// verifyIDToken 通过 jwks 校验 id token 的签名, 签发者, 受众和有效期
+func (o *oidcAuthClient) verifyIDToken(ctx context.Context, token string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods(oidcSigningMethods))
+	_, err := parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return o.getKey(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("verify oidc id token failed, err: %v", err)
+	}
+
+	if !claims.VerifyIssuer(o.conf.OIDC.Issuer, true) {
+		return nil, fmt.Errorf("oidc id token issuer %v mismatch", claims["iss"])
+	}
+
+	if !claims.VerifyAudience(o.conf.OIDC.ClientID, true) {
+		return nil, fmt.Errorf("oidc id token audience %v mismatch", claims["aud"])
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("oidc id token is expired")
+	}
+
+	return claims, nil
+}
+
+// userInfoFromClaims 按配置的 claim 映射用户名和租户
+func (o *oidcAuthClient) userInfoFromClaims(claims jwt.MapClaims) (*TenantUserInfo, error) {
+	username, _ := claims[o.conf.OIDC.UsernameClaim].(string)
+	if username == "" {
+		return nil, fmt.Errorf("username claim %s not found in oidc id token", o.conf.OIDC.UsernameClaim)
+	}
+
+	info := &TenantUserInfo{BkUsername: username, TenantID: constant.DefaultTenantID}
+	if o.conf.OIDC.TenantClaim != "" {
+		tenantID, _ := claims[o.conf.OIDC.TenantClaim].(string)
+		if tenantID == "" {
+			return nil, fmt.Errorf("tenant claim %s not found in oidc id token", o.conf.OIDC.TenantClaim)
+		}
+		info.TenantID = tenantID
+	}
+
+	if zone, ok := claims["zoneinfo"].(string); ok {
+		info.TimeZone = zone
+	}
+
+	return info, nil
+}
+
+// getDiscovery 获取并缓存提供方的服务发现文档
+func (o *oidcAuthClient) getDiscovery(ctx context.Context) (*oidcDiscovery, error) {
+	o.mu.RLock()
+	discovery := o.discovery
+	o.mu.RUnlock()
+	if discovery != nil {
+		return discovery, nil
+	}
+
+	wellKnown := strings.TrimSuffix(o.conf.OIDC.Issuer, "/") + "/.well-known/openid-configuration"
+	resp, err := components.GetClient().R().SetContext(ctx).Get(wellKnown)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("http code %d != 200, body: %s", resp.StatusCode(), resp.Body())
+	}
+
+	discovery = new(oidcDiscovery)
+	if err := json.Unmarshal(resp.Body(), discovery); err != nil {
+		return nil, err
+	}
+
+	if discovery.Issuer != o.conf.OIDC.Issuer {
+		return nil, fmt.Errorf("oidc discovery issuer %s mismatch with %s", discovery.Issuer, o.conf.OIDC.Issuer)
+	}
+
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JwksURI == "" {
+		return nil, fmt.Errorf("invalid oidc discovery document: %s", resp.Body())
+	}
+
+	o.mu.Lock()
+	o.discovery = discovery
+	o.mu.Unlock()
+
+	return discovery, nil
+}
+
+// getKey 获取 id token 签名的公钥, kid 不存在时刷新 jwks, 以支持提供方轮换密钥
+func (o *oidcAuthClient) getKey(ctx context.Context, kid string) (interface{}, error) {
+	o.mu.RLock()
+	key, ok := o.lookupKey(kid)
+	needRefresh := time.Since(o.keysSyncAt) >= oidcKeysRefreshInterval
+	o.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	if !needRefresh {
+		return nil, fmt.Errorf("oidc signing key %s not found", kid)
+	}
+
+	if err := o.syncKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if key, ok = o.lookupKey(kid); ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("oidc signing key %s not found", kid)
+}
+
+// lookupKey 按 kid 查找公钥, id token 未指定 kid 时只有一个公钥才能使用, 调用方需持有锁
+func (o *oidcAuthClient) lookupKey(kid string) (interface{}, bool) {
+	if kid != "" {
+		key, ok := o.keys[kid]
+		return key, ok
+	}
+
+	if len(o.keys) != 1 {
+		return nil, false
+	}
+
+	for _, key := range o.keys {
+		return key, true
+	}
+
+	return nil, false
+}
+
+// syncKeys 拉取提供方的 jwks
+func (o *oidcAuthClient) syncKeys(ctx context.Context) error {
+	discovery, err := o.getDiscovery(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := components.GetClient().R().SetContext(ctx).Get(discovery.JwksURI)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("http code %d != 200, body: %s", resp.StatusCode(), resp.Body())
+	}
+
+	jwks := new(struct {
+		Keys []jsonWebKey `json:"keys"`
+	})
+	if err := json.Unmarshal(resp.Body(), jwks); err != nil {
+		return err
+	}
+
+	keys := make(map[string]interface{}, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			return fmt.Errorf("parse oidc signing key %s failed, err: %v", jwk.Kid, err)
+		}
+		// 不支持的密钥类型忽略
+		if key != nil {
+			keys[jwk.Kid] = key
+		}
+	}
+
+	o.mu.Lock()
+	o.keys = keys
+	o.keysSyncAt = time.Now()
+	o.mu.Unlock()
+
+	return nil
+}
+
+// loginURL bscp 自身的 oidc 登入地址, 与回调地址同域
+func (o *oidcAuthClient) loginURL() string {
+	return strings.TrimSuffix(o.conf.OIDC.RedirectURL, OIDCCallbackPath) + OIDCLoginPath
+}
+
+// isSecure 回调地址为 https 时 cookie 只通过 https 传输
+func (o *oidcAuthClient) isSecure() bool {
+	return strings.HasPrefix(o.conf.OIDC.RedirectURL, "https://")
+}
+
+// isSafeRedirect 登入后只允许跳转到站内相对路径, 或与回调地址/cookie 域名同域的地址, 避免开放重定向
+func (o *oidcAuthClient) isSafeRedirect(target string) bool {
+	// 浏览器会把 "\" 当作 "/", 如 "/\evil.com" 会跳到站外, 控制字符同样可能被浏览器忽略, 都直接拒绝
+	if target == "" || strings.ContainsFunc(target, func(r rune) bool { return r == '\\' || unicode.IsControl(r) }) {
+		return false
+	}
+
+	u, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme == "" && u.Host == "" {
+		return u.Opaque == "" && strings.HasPrefix(target, "/") && !strings.HasPrefix(target, "//")
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return false
+	}
+
+	redirect, err := url.Parse(o.conf.OIDC.RedirectURL)
+	if err == nil && u.Host == redirect.Host {
+		return true
+	}
+
+	domain := strings.TrimPrefix(o.conf.OIDC.CookieDomain, ".")
+	return domain != "" && (u.Hostname() == domain || strings.HasSuffix(u.Hostname(), "."+domain))
+}
+
+// publicKey 解析 jwk 为公钥, 不支持的类型返回 nil
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// randomString 生成 state 和 PKCE code verifier 使用的随机串
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate random string failed, err: %v", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
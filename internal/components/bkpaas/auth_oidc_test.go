@@ -0,0 +1,141 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bkpaas
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+const testIssuer = "https://oidc.example.com/realms/bscp"
+
+func newTestOIDCClient() AuthLoginClient {
+	return NewAuthLoginClient(&cc.LoginAuthSettings{
+		Provider: OIDCProvider,
+		OIDC: cc.OIDC{
+			Issuer:        testIssuer,
+			ClientID:      "bscp",
+			RedirectURL:   "https://bscp.example.com/oidc/callback",
+			Scopes:        []string{"openid"},
+			UsernameClaim: "preferred_username",
+			TenantClaim:   "tenant",
+		},
+	})
+}
+
+func mockOIDCProvider(t *testing.T, key *rsa.PrivateKey) func() {
+	jwks := fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":"k1","use":"sig","n":"%s","e":"%s"}]}`,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+	discovery := fmt.Sprintf(`{"issuer":"%s","authorization_endpoint":"%s/auth","token_endpoint":"%s/token",
+		"jwks_uri":"%s/certs"}`, testIssuer, testIssuer, testIssuer, testIssuer)
+
+	client := components.GetClient()
+	oldTransport := client.GetClient().Transport
+	client.SetTransport(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		var body string
+		switch r.URL.Path {
+		case "/realms/bscp/.well-known/openid-configuration":
+			body = discovery
+		case "/realms/bscp/certs":
+			body = jwks
+		default:
+			t.Fatalf("unexpected request path %s", r.URL.Path)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	}))
+
+	return func() { client.SetTransport(oldTransport) }
+}
+
+func signTestIDToken(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "k1"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestOIDCGetTenantUserInfoByToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	defer mockOIDCProvider(t, key)()
+
+	valid := jwt.MapClaims{
+		"iss":                testIssuer,
+		"aud":                "bscp",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"preferred_username": "test-user",
+		"tenant":             "tencent",
+	}
+
+	client := newTestOIDCClient()
+	info, err := client.GetTenantUserInfoByToken(context.Background(), signTestIDToken(t, key, valid))
+	require.NoError(t, err)
+	require.Equal(t, "test-user", info.BkUsername)
+	require.Equal(t, "tencent", info.TenantID)
+
+	invalid := map[string]func(jwt.MapClaims){
+		"wrong audience": func(c jwt.MapClaims) { c["aud"] = "other" },
+		"wrong issuer":   func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" },
+		"expired":        func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+		"no tenant":      func(c jwt.MapClaims) { delete(c, "tenant") },
+	}
+	for name, mutate := range invalid {
+		claims := jwt.MapClaims{}
+		for k, v := range valid {
+			claims[k] = v
+		}
+		mutate(claims)
+
+		_, err := client.GetTenantUserInfoByToken(context.Background(), signTestIDToken(t, key, claims))
+		require.Error(t, err, name)
+	}
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = client.GetTenantUserInfoByToken(context.Background(), signTestIDToken(t, other, valid))
+	require.Error(t, err)
+}
+
+func TestOIDCIsSafeRedirect(t *testing.T) {
+	client := newTestOIDCClient().(*oidcAuthClient)
+
+	require.True(t, client.isSafeRedirect("/bscp/space/2/service/all"))
+	require.True(t, client.isSafeRedirect("https://bscp.example.com/space/2"))
+	require.False(t, client.isSafeRedirect("//evil.example.com"))
+	require.False(t, client.isSafeRedirect("https://evil.example.com/"))
+	require.False(t, client.isSafeRedirect("javascript:alert(1)"))
+	require.False(t, client.isSafeRedirect("/\\evil.example.com"))
+	require.False(t, client.isSafeRedirect("/\t/evil.example.com"))
+}
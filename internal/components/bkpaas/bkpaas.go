@@ -28,6 +28,8 @@ const (
 	BKLoginProvider = "BK_LOGIN"
 	// BKPaaSProvider 外部统一登入, 可使用主域名或者ESB查询
 	BKPaaSProvider = "BK_PAAS"
+	// OIDCProvider 通用 OIDC 登入, 如 Keycloak/Dex 等
+	OIDCProvider = cc.OIDCLoginProvider
 )
 
 // LoginCredential uid/token for grpc auth
@@ -54,8 +56,11 @@ type AuthLoginClient interface {
 
 // NewAuthLoginClient init client
 func NewAuthLoginClient(conf *cc.LoginAuthSettings) AuthLoginClient {
-	if conf.Provider == BKLoginProvider {
+	switch conf.Provider {
+	case BKLoginProvider:
 		return &bkLoginAuthClient{conf: conf}
+	case OIDCProvider:
+		return &oidcAuthClient{conf: conf}
 	}
 	return &bkPaaSAuthClient{conf: conf}
 }
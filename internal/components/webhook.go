@@ -0,0 +1,62 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package components
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// WebhookClient is the http client which posts to the urls set by the users, such as the webhooks and the
+// client alert callbacks. It refuses to connect to the loopback, private and link-local addresses, and never
+// follows the redirects, the caller should read the response body with table.WebhookMaxResponseLength.
+var WebhookClient = &http.Client{
+	Transport: &http.Transport{
+		// the proxy would connect to the url instead, so that its address can not be checked.
+		Proxy:               nil,
+		DialContext:         DialWebhook,
+		TLSHandshakeTimeout: 10 * time.Second,
+		MaxIdleConnsPerHost: 2,
+		IdleConnTimeout:     90 * time.Second,
+	},
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// DialWebhook connects to the address set by the users, the address is checked after the host name is
+// resolved, so that the host name which resolves to an internal address is refused as well.
+func DialWebhook(ctx context.Context, network, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || table.WebhookForbiddenIP(ip) {
+				return fmt.Errorf("address %s is not allowed, loopback, private and link-local addresses "+
+					"are forbidden", host)
+			}
+			return nil
+		},
+	}
+
+	return dialer.DialContext(ctx, network, addr)
+}
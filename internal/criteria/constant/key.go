@@ -272,6 +272,16 @@ const (
 	RestoreConfigReleaseName = "restore_config_release_name: %s"
 	// DeleteConfigReleaseName 删除配置版本名称
 	DeleteConfigReleaseName = "delete_config_release_name: %s"
+	// RollbackConfigReleaseName 回滚配置版本名称
+	RollbackConfigReleaseName = "rollback_config_release_name: %s"
+	// PinConfigReleaseName 分组固定的配置版本名称
+	PinConfigReleaseName = "pin_config_release_name: %s"
+	// UnpinConfigReleaseName 分组解除固定的配置版本名称
+	UnpinConfigReleaseName = "unpin_config_release_name: %s"
+	// TagConfigReleaseName 修改标签的配置版本名称
+	TagConfigReleaseName = "tag_config_release_name: %s"
+	// PromoteConfigReleaseName 晋级的配置版本名称
+	PromoteConfigReleaseName = "promote_config_release_name: %s"
 	// CredentialEnableName 启用密钥名称
 	CredentialEnableName = "credential_enable_name: %s" // nolint
 	// CredentialUnableName 禁用密钥名称
@@ -286,6 +296,12 @@ const (
 	OperateObject = "operate_objects: %d" // nolint
 	// ConfigTemplateName 配置模板名称
 	ConfigTemplateName = "config_template_name: %s"
+	// ChangeFreezeName 变更冻结窗口名称
+	ChangeFreezeName = "change_freeze_name: %s"
+	// WebhookName 事件订阅名称
+	WebhookName = "webhook_name: %s"
+	// ApprovalFlowAppName 内置审批流程所属服务名称
+	ApprovalFlowAppName = "approval_flow_app_name: %s"
 )
 
 const (
@@ -223,6 +223,7 @@ func newClusterClient(opt cc.RedisCluster) (RedisClient, error) {
 	if err := client.Ping(context.TODO()).Err(); err != nil {
 		return nil, fmt.Errorf("init redis cluster client, but ping failed, err: %v", err)
 	}
+	client.AddHook(tracingHook{})
 
 	return client, nil
 }
@@ -255,6 +256,7 @@ func newStandaloneClient(opt cc.RedisCluster) (RedisClient, error) {
 	if err := client.Ping(context.TODO()).Err(); err != nil {
 		return nil, fmt.Errorf("init redis cluster client, but ping failed, err: %v", err)
 	}
+	client.AddHook(tracingHook{})
 
 	return client, nil
 }
@@ -0,0 +1,75 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bedis
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/tracer"
+)
+
+// tracingHook starts a span for each redis command and pipeline.
+type tracingHook struct{}
+
+var _ redis.Hook = tracingHook{}
+
+// BeforeProcess starts the span of the command.
+func (tracingHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	ctx, _ = tracer.Start(ctx, "redis."+cmd.FullName(), trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.system", "redis"), attribute.String("db.operation", cmd.Name())))
+	return ctx, nil
+}
+
+// AfterProcess ends the span of the command, the nil reply is not taken as an error.
+func (tracingHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	tracer.End(trace.SpanFromContext(ctx), cmdError(cmd.Err()))
+	return nil
+}
+
+// BeforeProcessPipeline starts the span of the pipeline.
+func (tracingHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	names := make([]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		names = append(names, cmd.Name())
+	}
+	ctx, _ = tracer.Start(ctx, "redis.pipeline", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.system", "redis"),
+			attribute.String("db.operation", strings.Join(names, " ")),
+			attribute.Int("db.redis.num_cmd", len(cmds))))
+	return ctx, nil
+}
+
+// AfterProcessPipeline ends the span of the pipeline with the first error of the commands.
+func (tracingHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if err = cmdError(cmd.Err()); err != nil {
+			break
+		}
+	}
+	tracer.End(trace.SpanFromContext(ctx), err)
+	return nil
+}
+
+func cmdError(err error) error {
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	return err
+}
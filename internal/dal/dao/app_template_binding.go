@@ -85,13 +85,9 @@ func (dao *appTemplateBindingDao) GetBindingAppByTemplateSetID(kit *kit.Kit, biz
 	conds := make([]rawgen.Condition, 0)
 	for k, v := range templateSetIDs {
 		if k == 0 {
-			conds = append(conds, q.Where(utils.RawCond("JSON_CONTAINS(?,?)", utils.Field{
-				Field: m.TemplateSetIDs,
-			}, fmt.Sprintf("%d", v))))
+			conds = append(conds, q.Where(utils.JSONContains(m.TemplateSetIDs, fmt.Sprintf("%d", v))))
 		} else {
-			conds = append(conds, q.Or(utils.RawCond("JSON_CONTAINS(?,?)", utils.Field{
-				Field: m.TemplateSetIDs,
-			}, fmt.Sprintf("%d", v))))
+			conds = append(conds, q.Or(utils.JSONContains(m.TemplateSetIDs, fmt.Sprintf("%d", v))))
 		}
 	}
 
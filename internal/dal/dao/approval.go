@@ -0,0 +1,280 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// ApprovalFlow supplies all the builtin approval flow related operations.
+type ApprovalFlow interface {
+	// Create one approval flow instance.
+	Create(kit *kit.Kit, flow *table.ApprovalFlow) (uint32, error)
+	// Update the spec of one approval flow instance.
+	Update(kit *kit.Kit, flow *table.ApprovalFlow) error
+	// GetByApp get the approval flow of the app.
+	GetByApp(kit *kit.Kit, bizID, appID uint32) (*table.ApprovalFlow, error)
+}
+
+var _ ApprovalFlow = new(approvalFlowDao)
+
+type approvalFlowDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one approval flow instance.
+func (dao *approvalFlowDao) Create(kit *kit.Kit, flow *table.ApprovalFlow) (uint32, error) {
+	if flow == nil {
+		return 0, errors.New("approval flow is nil")
+	}
+
+	if err := flow.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	app, err := dao.genQ.App.WithContext(kit.Ctx).
+		Where(dao.genQ.App.BizID.Eq(flow.Attachment.BizID), dao.genQ.App.ID.Eq(flow.Attachment.AppID)).Take()
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ApprovalFlowsTable)
+	if err != nil {
+		return 0, err
+	}
+	flow.ID = id
+
+	ad := dao.auditDao.Decorator(kit, flow.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ApprovalFlowAppName, app.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           flow.Spec.Memo,
+		AppId:            flow.Attachment.AppID,
+	}).PrepareCreate(flow)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.ApprovalFlow.WithContext(kit.Ctx).Create(flow); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Update the spec of one approval flow instance.
+func (dao *approvalFlowDao) Update(kit *kit.Kit, flow *table.ApprovalFlow) error {
+	if flow == nil {
+		return errors.New("approval flow is nil")
+	}
+
+	if err := flow.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	app, err := dao.genQ.App.WithContext(kit.Ctx).
+		Where(dao.genQ.App.BizID.Eq(flow.Attachment.BizID), dao.genQ.App.ID.Eq(flow.Attachment.AppID)).Take()
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, flow.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ApprovalFlowAppName, app.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           flow.Spec.Memo,
+		AppId:            flow.Attachment.AppID,
+	}).PrepareUpdate(flow)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.ApprovalFlow
+		// enabled is selected explicitly, so that the flow can be disabled with false value
+		if _, err := m.WithContext(kit.Ctx).
+			Select(m.Enabled, m.Stages, m.Memo, m.Reviser, m.UpdatedAt).
+			Where(m.BizID.Eq(flow.Attachment.BizID), m.ID.Eq(flow.ID)).
+			Updates(flow); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// GetByApp get the approval flow of the app.
+func (dao *approvalFlowDao) GetByApp(kit *kit.Kit, bizID, appID uint32) (*table.ApprovalFlow, error) {
+	m := dao.genQ.ApprovalFlow
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Take()
+}
+
+// ApprovalTicket supplies all the builtin approval ticket related operations.
+type ApprovalTicket interface {
+	// CreateWithTx create one approval ticket instance with transaction.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, ticket *table.ApprovalTicket) (uint32, error)
+	// UpdateStatusWithTx update the stage and status of the approval ticket with transaction.
+	UpdateStatusWithTx(kit *kit.Kit, tx *gen.QueryTx, ticket *table.ApprovalTicket) error
+	// GetByStrategy get the approval ticket of the publish strategy.
+	GetByStrategy(kit *kit.Kit, bizID, strategyID uint32) (*table.ApprovalTicket, error)
+	// GetForUpdateWithTx get the approval ticket and lock it until the transaction ends.
+	GetForUpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) (*table.ApprovalTicket, error)
+	// ListExpired list the pending tickets whose current stage is timed out before the time.
+	ListExpired(kit *kit.Kit, before time.Time, limit int) ([]*table.ApprovalTicket, error)
+}
+
+var _ ApprovalTicket = new(approvalTicketDao)
+
+type approvalTicketDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// CreateWithTx create one approval ticket instance with transaction.
+func (dao *approvalTicketDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, ticket *table.ApprovalTicket) (
+	uint32, error) {
+	if ticket == nil {
+		return 0, errors.New("approval ticket is nil")
+	}
+
+	if err := ticket.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ApprovalTicketsTable)
+	if err != nil {
+		return 0, err
+	}
+	ticket.ID = id
+
+	if err := tx.ApprovalTicket.WithContext(kit.Ctx).Create(ticket); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateStatusWithTx update the stage and status of the approval ticket with transaction.
+func (dao *approvalTicketDao) UpdateStatusWithTx(kit *kit.Kit, tx *gen.QueryTx, ticket *table.ApprovalTicket) error {
+	if ticket == nil {
+		return errors.New("approval ticket is nil")
+	}
+
+	m := tx.ApprovalTicket
+	// stage deadline is selected explicitly, so that it can be cleared with nil value
+	_, err := m.WithContext(kit.Ctx).
+		Select(m.CurrentStage, m.Status, m.StageDeadline, m.Reviser, m.UpdatedAt).
+		Where(m.BizID.Eq(ticket.Attachment.BizID), m.ID.Eq(ticket.ID)).
+		Updates(ticket)
+
+	return err
+}
+
+// GetByStrategy get the approval ticket of the publish strategy.
+func (dao *approvalTicketDao) GetByStrategy(kit *kit.Kit, bizID, strategyID uint32) (*table.ApprovalTicket, error) {
+	m := dao.genQ.ApprovalTicket
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.StrategyID.Eq(strategyID)).Take()
+}
+
+// GetForUpdateWithTx get the approval ticket and lock it until the transaction ends.
+func (dao *approvalTicketDao) GetForUpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) (
+	*table.ApprovalTicket, error) {
+	m := tx.ApprovalTicket
+
+	return m.WithContext(kit.Ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// ListExpired list the pending tickets whose current stage is timed out before the time.
+func (dao *approvalTicketDao) ListExpired(kit *kit.Kit, before time.Time, limit int) (
+	[]*table.ApprovalTicket, error) {
+	m := dao.genQ.ApprovalTicket
+
+	return m.WithContext(kit.Ctx).
+		Where(m.Status.Eq(string(table.ApprovalTicketPending)), m.StageDeadline.IsNotNull(),
+			m.StageDeadline.Lte(before)).
+		Order(m.StageDeadline, m.ID).
+		Limit(limit).
+		Find()
+}
+
+// ApprovalAction supplies all the builtin approval action related operations.
+type ApprovalAction interface {
+	// CreateWithTx create one approval action instance with transaction.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, action *table.ApprovalAction) (uint32, error)
+	// List the actions of the approval ticket in the order they happened.
+	List(kit *kit.Kit, bizID, ticketID uint32) ([]*table.ApprovalAction, error)
+	// ListWithTx list the actions of the approval ticket in the order they happened with transaction.
+	ListWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, ticketID uint32) ([]*table.ApprovalAction, error)
+}
+
+var _ ApprovalAction = new(approvalActionDao)
+
+type approvalActionDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// CreateWithTx create one approval action instance with transaction.
+func (dao *approvalActionDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, action *table.ApprovalAction) (
+	uint32, error) {
+	if action == nil || action.Spec == nil || action.Attachment == nil || action.Revision == nil {
+		return 0, errors.New("approval action is invalid")
+	}
+
+	if err := action.Revision.Validate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ApprovalActionsTable)
+	if err != nil {
+		return 0, err
+	}
+	action.ID = id
+
+	if err := tx.ApprovalAction.WithContext(kit.Ctx).Create(action); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// List the actions of the approval ticket in the order they happened.
+func (dao *approvalActionDao) List(kit *kit.Kit, bizID, ticketID uint32) ([]*table.ApprovalAction, error) {
+	m := dao.genQ.ApprovalAction
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.TicketID.Eq(ticketID)).Order(m.ID).Find()
+}
+
+// ListWithTx list the actions of the approval ticket in the order they happened with transaction.
+func (dao *approvalActionDao) ListWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, ticketID uint32) (
+	[]*table.ApprovalAction, error) {
+	m := tx.ApprovalAction
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.TicketID.Eq(ticketID)).Order(m.ID).Find()
+}
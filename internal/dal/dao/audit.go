@@ -182,7 +182,8 @@ func (au *audit) createQuery(kit *kit.Kit, req *pbds.ListAuditsReq) (gen.IAuditD
 		LeftJoin(client, audit.ResourceID.EqCol(client.ID), audit.ResourceType.Eq(string(enumor.Instance))).
 		Where(audit.BizID.Eq(req.BizId), audit.ResourceType.In(string(enumor.App), string(enumor.Config),
 			string(enumor.Hook), string(enumor.Release), string(enumor.Group),
-			string(enumor.Template), string(enumor.Credential), string(enumor.Instance), string(enumor.Variable)))
+			string(enumor.Template), string(enumor.Credential), string(enumor.Instance), string(enumor.Variable),
+			string(enumor.ChangeFreeze), string(enumor.Webhook), string(enumor.ApprovalFlow)))
 
 	if req.Id != 0 {
 		result = result.Where(audit.ID.Eq(req.Id))
@@ -41,6 +41,8 @@ type AuditPrepare interface {
 	PrepareUpdate(obj AuditRes) AuditDo
 	PrepareDelete(obj AuditRes) AuditDo
 	PreparePublish(obj AuditRes) AuditDo
+	PrepareBreakGlass(obj AuditRes) AuditDo
+	PrepareRollback(obj AuditRes) AuditDo
 }
 
 // initAuditBuilder create a new audit builder instance.
@@ -146,3 +148,21 @@ func (ab *AuditBuilderV2) PreparePublish(obj AuditRes) AuditDo {
 
 	return ab
 }
+
+// PrepareBreakGlass 在变更冻结窗口内强制变更
+func (ab *AuditBuilderV2) PrepareBreakGlass(obj AuditRes) AuditDo {
+	ab.toAudit.ResourceType = enumor.AuditResourceType(obj.ResType())
+	ab.toAudit.ResourceID = obj.ResID()
+	ab.toAudit.Action = enumor.BreakGlass
+
+	return ab
+}
+
+// PrepareRollback 回滚版本
+func (ab *AuditBuilderV2) PrepareRollback(obj AuditRes) AuditDo {
+	ab.toAudit.ResourceType = enumor.AuditResourceType(obj.ResType())
+	ab.toAudit.ResourceID = obj.ResID()
+	ab.toAudit.Action = enumor.Rollback
+
+	return ab
+}
@@ -0,0 +1,208 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/types"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// ChangeFreeze supplies all the change freeze window related operations.
+type ChangeFreeze interface {
+	// Create one change freeze window instance.
+	Create(kit *kit.Kit, window *table.ChangeFreeze) (uint32, error)
+	// Update the spec of one change freeze window instance.
+	Update(kit *kit.Kit, window *table.ChangeFreeze) error
+	// Delete one change freeze window instance.
+	Delete(kit *kit.Kit, bizID, id uint32) error
+	// Get change freeze window by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.ChangeFreeze, error)
+	// List change freeze windows of the biz, only the windows of the app are listed if app id is set.
+	List(kit *kit.Kit, bizID, appID uint32) ([]*table.ChangeFreeze, error)
+	// ListEnabled list the enabled change freeze windows of the biz.
+	ListEnabled(kit *kit.Kit, bizID uint32) ([]*table.ChangeFreeze, error)
+	// RecordBreakGlass record the audits of the windows overridden by the break-glass user.
+	RecordBreakGlass(kit *kit.Kit, bizID, appID uint32, windows []*table.ChangeFreeze, detail string) error
+}
+
+var _ ChangeFreeze = new(changeFreezeDao)
+
+type changeFreezeDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one change freeze window instance.
+func (dao *changeFreezeDao) Create(kit *kit.Kit, window *table.ChangeFreeze) (uint32, error) {
+	if window == nil {
+		return 0, errors.New("change freeze window is nil")
+	}
+
+	if err := window.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ChangeFreezesTable)
+	if err != nil {
+		return 0, err
+	}
+	window.ID = id
+	normalizeChangeFreezeSpec(window.Spec)
+
+	ad := dao.auditDao.Decorator(kit, window.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ChangeFreezeName, window.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           window.Spec.Memo,
+		AppId:            window.Attachment.AppID,
+	}).PrepareCreate(window)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.ChangeFreeze.WithContext(kit.Ctx).Create(window); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Update the spec of one change freeze window instance.
+func (dao *changeFreezeDao) Update(kit *kit.Kit, window *table.ChangeFreeze) error {
+	if window == nil {
+		return errors.New("change freeze window is nil")
+	}
+
+	if err := window.ValidateUpdate(); err != nil {
+		return err
+	}
+	normalizeChangeFreezeSpec(window.Spec)
+
+	ad := dao.auditDao.Decorator(kit, window.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ChangeFreezeName, window.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           window.Spec.Memo,
+		AppId:            window.Attachment.AppID,
+	}).PrepareUpdate(window)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.ChangeFreeze
+		// enabled is selected explicitly, so that the window can be disabled with false value
+		if _, err := m.WithContext(kit.Ctx).
+			Select(m.Name, m.Kind, m.Timezone, m.Weekdays, m.StartTime, m.EndTime, m.StartAt, m.EndAt,
+				m.BreakGlassUsers, m.Enabled, m.Memo, m.Reviser, m.UpdatedAt).
+			Where(m.BizID.Eq(window.Attachment.BizID), m.ID.Eq(window.ID)).
+			Updates(window); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Delete one change freeze window instance.
+func (dao *changeFreezeDao) Delete(kit *kit.Kit, bizID, id uint32) error {
+	window, err := dao.Get(kit, bizID, id)
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ChangeFreezeName, window.Spec.Name),
+		Status:           enumor.Success,
+		AppId:            window.Attachment.AppID,
+	}).PrepareDelete(window)
+
+	deleteTx := func(tx *gen.Query) error {
+		m := tx.ChangeFreeze
+		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get change freeze window by id.
+func (dao *changeFreezeDao) Get(kit *kit.Kit, bizID, id uint32) (*table.ChangeFreeze, error) {
+	m := dao.genQ.ChangeFreeze
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// List change freeze windows of the biz, only the windows of the app are listed if app id is set.
+func (dao *changeFreezeDao) List(kit *kit.Kit, bizID, appID uint32) ([]*table.ChangeFreeze, error) {
+	m := dao.genQ.ChangeFreeze
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID))
+	if appID > 0 {
+		q = q.Where(m.AppID.Eq(appID))
+	}
+
+	return q.Order(m.ID.Desc()).Find()
+}
+
+// ListEnabled list the enabled change freeze windows of the biz.
+func (dao *changeFreezeDao) ListEnabled(kit *kit.Kit, bizID uint32) ([]*table.ChangeFreeze, error) {
+	m := dao.genQ.ChangeFreeze
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Enabled.Is(true)).Order(m.ID).Find()
+}
+
+// RecordBreakGlass record the audits of the windows overridden by the break-glass user.
+func (dao *changeFreezeDao) RecordBreakGlass(kit *kit.Kit, bizID, appID uint32,
+	windows []*table.ChangeFreeze, detail string) error {
+
+	ads := make([]AuditDo, 0, len(windows))
+	for _, one := range windows {
+		ads = append(ads, dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+			ResourceInstance: fmt.Sprintf(constant.ChangeFreezeName, one.Spec.Name),
+			Status:           enumor.Success,
+			Detail:           detail,
+			AppId:            appID,
+		}).PrepareBreakGlass(one))
+	}
+
+	return dao.genQ.Transaction(func(tx *gen.Query) error {
+		for _, ad := range ads {
+			if err := ad.Do(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// normalizeChangeFreezeSpec saves the empty json arrays instead of null.
+func normalizeChangeFreezeSpec(spec *table.ChangeFreezeSpec) {
+	if spec.Weekdays == nil {
+		spec.Weekdays = make(types.Uint32Slice, 0)
+	}
+	if spec.BreakGlassUsers == nil {
+		spec.BreakGlassUsers = make(types.StringSlice, 0)
+	}
+}
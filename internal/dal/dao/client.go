@@ -19,7 +19,6 @@ import (
 	"strings"
 	"time"
 
-	"gorm.io/datatypes"
 	rawgen "gorm.io/gen"
 	"gorm.io/gen/field"
 	"gorm.io/gorm/clause"
@@ -85,6 +84,15 @@ type Client interface {
 		search *pbclient.ClientQueryCondition) (int64, error)
 	// GetClientsField 获取客户端某个字段
 	GetClientsLables(kit *kit.Kit, bizID uint32, lableName string) ([]*table.Client, error)
+	// ListForAlert 获取心跳时间在 heartbeatTime 之后的客户端，仅包含告警规则计算需要的字段
+	ListForAlert(kit *kit.Kit, bizID, appID uint32, heartbeatTime time.Time) ([]*table.Client, error)
+	// GetOfflineStatBefore 统计心跳时间在 heartbeatTime 之前的离线客户端数量和最早的心跳时间
+	GetOfflineStatBefore(kit *kit.Kit, bizID, appID uint32, heartbeatTime time.Time) (*types.OfflineClientStat, error)
+	// ListUIDsBySearch 获取符合搜索条件的客户端 UID，最多返回 limit 个
+	ListUIDsBySearch(kit *kit.Kit, bizID, appID uint32, heartbeatTime int64,
+		search *pbclient.ClientQueryCondition, limit int) ([]string, error)
+	// ListOnlineUIDsByCurrentRelease 获取当前版本为指定版本的在线客户端 UID，最多返回 limit 个，同时返回总数
+	ListOnlineUIDsByCurrentRelease(kit *kit.Kit, bizID, appID, releaseID uint32, limit int) ([]string, int64, error)
 }
 
 var _ Client = new(clientDao)
@@ -283,6 +291,89 @@ func (dao *clientDao) ListClientByIDs(kit *kit.Kit, bizID uint32, appID uint32,
 	return result, err
 }
 
+// ListForAlert 获取心跳时间在 heartbeatTime 之后的客户端，仅包含告警规则计算需要的字段
+func (dao *clientDao) ListForAlert(kit *kit.Kit, bizID, appID uint32, heartbeatTime time.Time) (
+	[]*table.Client, error) {
+	m := dao.genQ.Client
+
+	return dao.genQ.Client.WithContext(kit.Ctx).
+		Select(m.ID, m.BizID, m.AppID, m.ClientVersion, m.OnlineStatus, m.LastHeartbeatTime,
+			m.TargetReleaseID, m.ReleaseChangeStatus).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.LastHeartbeatTime.Gte(heartbeatTime)).
+		Find()
+}
+
+// GetOfflineStatBefore 统计心跳时间在 heartbeatTime 之前的离线客户端数量和最早的心跳时间
+func (dao *clientDao) GetOfflineStatBefore(kit *kit.Kit, bizID, appID uint32, heartbeatTime time.Time) (
+	*types.OfflineClientStat, error) {
+	m := dao.genQ.Client
+
+	stat := new(types.OfflineClientStat)
+	err := dao.genQ.Client.WithContext(kit.Ctx).
+		Select(m.ID.Count().As("count"), m.LastHeartbeatTime.Min().As("earliest_heartbeat_time")).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.OnlineStatus.Eq("offline"),
+			m.LastHeartbeatTime.Lt(heartbeatTime)).
+		Scan(stat)
+	if err != nil {
+		return nil, err
+	}
+
+	return stat, nil
+}
+
+// ListUIDsBySearch 获取符合搜索条件的客户端 UID，最多返回 limit 个
+func (dao *clientDao) ListUIDsBySearch(kit *kit.Kit, bizID, appID uint32, heartbeatTime int64,
+	search *pbclient.ClientQueryCondition, limit int) ([]string, error) {
+
+	m := dao.genQ.Client
+	q := dao.genQ.Client.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID))
+
+	var err error
+	var conds []rawgen.Condition
+	if search.String() != "" {
+		conds, err = dao.handleSearch(kit, bizID, appID, search)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if heartbeatTime > 0 {
+		lastHeartbeatTime := time.Now().UTC().Add(time.Duration(-heartbeatTime) * time.Minute)
+		conds = append(conds, q.Where(m.LastHeartbeatTime.Gte(lastHeartbeatTime)))
+	}
+
+	var uids []string
+	if err = q.Where(conds...).Distinct(m.UID).Order(m.UID).Limit(limit).Pluck(m.UID, &uids); err != nil {
+		return nil, err
+	}
+
+	return uids, nil
+}
+
+// ListOnlineUIDsByCurrentRelease 获取当前版本为指定版本的在线客户端 UID，最多返回 limit 个，同时返回总数
+func (dao *clientDao) ListOnlineUIDsByCurrentRelease(kit *kit.Kit, bizID, appID, releaseID uint32, limit int) (
+	[]string, int64, error) {
+
+	m := dao.genQ.Client
+	q := dao.genQ.Client.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID),
+		m.CurrentReleaseID.Eq(releaseID), m.OnlineStatus.Eq("online"))
+
+	count, err := q.Count()
+	if err != nil {
+		return nil, 0, err
+	}
+	if count == 0 {
+		return []string{}, 0, nil
+	}
+
+	var uids []string
+	if err = q.Order(m.UID).Limit(limit).Pluck(m.UID, &uids); err != nil {
+		return nil, 0, err
+	}
+
+	return uids, count, nil
+}
+
 // ListClientGroupByFailedReason 按照失败原因列出客户端组
 func (dao *clientDao) ListClientGroupByFailedReason(kit *kit.Kit, bizID uint32, appID uint32, heartbeatTime int64,
 	search *pbclient.ClientQueryCondition) ([]types.FailedReasonChart, error) {
@@ -414,7 +505,7 @@ func (dao *clientDao) List(kit *kit.Kit, bizID, appID uint32, heartbeatTime int6
 	}
 
 	orderStr := fmt.Sprintf("CASE WHEN release_change_status = '%s' THEN 1 WHEN release_change_status = '%s' THEN 2 "+
-		"WHEN release_change_status = '%s' THEN 3 END,`id` desc", table.Processing, table.Failed, table.Success)
+		"WHEN release_change_status = '%s' THEN 3 END,id desc", table.Processing, table.Failed, table.Success)
 	d := q.Where(conds...).Order(exprs...).Order(utils.NewCustomExpr(orderStr, nil))
 	if opt.All {
 		result, err := d.Find()
@@ -580,17 +671,17 @@ func (dao *clientDao) handleLabelSearch(q gen.IClientDo, search *pbclient.Client
 			for key, value := range label {
 				for _, v := range value {
 					if isFirst {
-						q = q.Or(rawgen.Cond(datatypes.JSONQuery("labels").Equals(v, fmt.Sprintf(`"%s"`, key)))...)
+						q = q.Or(utils.JSONEquals("labels", v, key))
 					} else {
-						q = q.Where(rawgen.Cond(datatypes.JSONQuery("labels").Equals(v, fmt.Sprintf(`"%s"`, key)))...)
+						q = q.Where(utils.JSONEquals("labels", v, key))
 					}
 					isFirst = true
 				}
 				if len(value) == 0 {
 					if isFirst {
-						q = q.Or(rawgen.Cond(datatypes.JSONQuery("labels").HasKey(fmt.Sprintf(`"%s"`, key)))...)
+						q = q.Or(utils.JSONHasKey("labels", key))
 					} else {
-						q = q.Where(rawgen.Cond(datatypes.JSONQuery("labels").HasKey(fmt.Sprintf(`"%s"`, key)))...)
+						q = q.Where(utils.JSONHasKey("labels", key))
 					}
 					isFirst = true
 				}
@@ -653,8 +744,7 @@ func (dao *clientDao) handleSearch(kit *kit.Kit, bizID, appID uint32, search *pb
 	if search.GetAnnotations() != nil && len(search.GetAnnotations().GetFields()) != 0 {
 		for k, v := range search.GetAnnotations().GetFields() {
 			if k != "" {
-				conds = append(conds, q.Where(rawgen.Cond(datatypes.JSONQuery("annotations").
-					Equals(v.AsInterface(), fmt.Sprintf(`"%s"`, k)))...))
+				conds = append(conds, q.Where(utils.JSONEquals("annotations", v.AsInterface(), k)))
 			}
 		}
 	}
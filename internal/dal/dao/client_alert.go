@@ -0,0 +1,285 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// ClientAlertRule supplies all the client alert rule related operations.
+type ClientAlertRule interface {
+	// Create one client alert rule instance.
+	Create(kit *kit.Kit, rule *table.ClientAlertRule) (uint32, error)
+	// Update one client alert rule instance.
+	Update(kit *kit.Kit, rule *table.ClientAlertRule) error
+	// Delete one client alert rule instance.
+	Delete(kit *kit.Kit, rule *table.ClientAlertRule) error
+	// Get client alert rule by id.
+	Get(kit *kit.Kit, bizID, appID, id uint32) (*table.ClientAlertRule, error)
+	// List client alert rules of the app with options.
+	List(kit *kit.Kit, bizID, appID uint32, opt *types.BasePage) ([]*table.ClientAlertRule, int64, error)
+	// ListEnabled list all the enabled client alert rules.
+	ListEnabled(kit *kit.Kit) ([]*table.ClientAlertRule, error)
+	// UpdateSilence update the silence end time of the client alert rule, nil means cancel the silence.
+	UpdateSilence(kit *kit.Kit, bizID, appID, id uint32, silencedUntil *time.Time) error
+}
+
+var _ ClientAlertRule = new(clientAlertRuleDao)
+
+type clientAlertRuleDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one client alert rule instance.
+func (dao *clientAlertRuleDao) Create(kit *kit.Kit, rule *table.ClientAlertRule) (uint32, error) {
+	if rule == nil {
+		return 0, errors.New("client alert rule is nil")
+	}
+
+	if err := rule.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ClientAlertRulesTable)
+	if err != nil {
+		return 0, err
+	}
+	rule.ID = id
+
+	if err := dao.genQ.ClientAlertRule.WithContext(kit.Ctx).Create(rule); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Update one client alert rule instance.
+func (dao *clientAlertRuleDao) Update(kit *kit.Kit, rule *table.ClientAlertRule) error {
+	if rule == nil {
+		return errors.New("client alert rule is nil")
+	}
+
+	if err := rule.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	m := dao.genQ.ClientAlertRule
+	_, err := m.WithContext(kit.Ctx).
+		Select(m.Name, m.RuleType, m.ReleaseID, m.Threshold, m.ClientVersion, m.Notifiers, m.WebhookURL,
+			m.Receivers, m.Enabled, m.Memo, m.Reviser, m.UpdatedAt).
+		Where(m.BizID.Eq(rule.Attachment.BizID), m.AppID.Eq(rule.Attachment.AppID), m.ID.Eq(rule.ID)).
+		Updates(rule)
+
+	return err
+}
+
+// Delete one client alert rule instance.
+func (dao *clientAlertRuleDao) Delete(kit *kit.Kit, rule *table.ClientAlertRule) error {
+	if rule == nil {
+		return errors.New("client alert rule is nil")
+	}
+
+	if err := rule.ValidateDelete(); err != nil {
+		return err
+	}
+
+	m := dao.genQ.ClientAlertRule
+	_, err := m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(rule.Attachment.BizID), m.AppID.Eq(rule.Attachment.AppID), m.ID.Eq(rule.ID)).
+		Delete()
+
+	return err
+}
+
+// Get client alert rule by id.
+func (dao *clientAlertRuleDao) Get(kit *kit.Kit, bizID, appID, id uint32) (*table.ClientAlertRule, error) {
+	m := dao.genQ.ClientAlertRule
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).Take()
+}
+
+// List client alert rules of the app with options.
+func (dao *clientAlertRuleDao) List(kit *kit.Kit, bizID, appID uint32, opt *types.BasePage) (
+	[]*table.ClientAlertRule, int64, error) {
+	m := dao.genQ.ClientAlertRule
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Order(m.ID.Desc())
+
+	if opt.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// ListEnabled list all the enabled client alert rules.
+func (dao *clientAlertRuleDao) ListEnabled(kit *kit.Kit) ([]*table.ClientAlertRule, error) {
+	m := dao.genQ.ClientAlertRule
+
+	return m.WithContext(kit.Ctx).Where(m.Enabled.Is(true)).Order(m.BizID, m.AppID, m.ID).Find()
+}
+
+// UpdateSilence update the silence end time of the client alert rule, nil means cancel the silence.
+func (dao *clientAlertRuleDao) UpdateSilence(kit *kit.Kit, bizID, appID, id uint32, silencedUntil *time.Time) error {
+	m := dao.genQ.ClientAlertRule
+	silence := m.SilencedUntil.Null()
+	if silencedUntil != nil {
+		silence = m.SilencedUntil.Value(*silencedUntil)
+	}
+
+	_, err := m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).
+		UpdateSimple(silence, m.Reviser.Value(kit.User), m.UpdatedAt.Value(time.Now().UTC()))
+
+	return err
+}
+
+// ClientAlertHistory supplies all the client alert history related operations.
+type ClientAlertHistory interface {
+	// Create one client alert history instance.
+	Create(kit *kit.Kit, history *table.ClientAlertHistory) (uint32, error)
+	// Get client alert history by id.
+	Get(kit *kit.Kit, bizID, appID, id uint32) (*table.ClientAlertHistory, error)
+	// GetActive get the latest firing or acked alert of the rule, return nil if the rule is not firing.
+	GetActive(kit *kit.Kit, bizID, ruleID uint32) (*table.ClientAlertHistory, error)
+	// List client alert histories of the app with options.
+	List(kit *kit.Kit, bizID, appID, ruleID uint32, status string, opt *types.BasePage) (
+		[]*table.ClientAlertHistory, int64, error)
+	// Ack acknowledge the firing alert.
+	Ack(kit *kit.Kit, bizID, appID, id uint32) error
+	// Resolve mark the firing or acked alert as resolved.
+	Resolve(kit *kit.Kit, bizID, id uint32) error
+}
+
+var _ ClientAlertHistory = new(clientAlertHistoryDao)
+
+type clientAlertHistoryDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one client alert history instance.
+func (dao *clientAlertHistoryDao) Create(kit *kit.Kit, history *table.ClientAlertHistory) (uint32, error) {
+	if history == nil {
+		return 0, errors.New("client alert history is nil")
+	}
+
+	if err := history.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ClientAlertHistoriesTable)
+	if err != nil {
+		return 0, err
+	}
+	history.ID = id
+
+	if err := dao.genQ.ClientAlertHistory.WithContext(kit.Ctx).Create(history); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Get client alert history by id.
+func (dao *clientAlertHistoryDao) Get(kit *kit.Kit, bizID, appID, id uint32) (*table.ClientAlertHistory, error) {
+	m := dao.genQ.ClientAlertHistory
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).Take()
+}
+
+// GetActive get the latest firing or acked alert of the rule, return nil if the rule is not firing.
+func (dao *clientAlertHistoryDao) GetActive(kit *kit.Kit, bizID, ruleID uint32) (*table.ClientAlertHistory, error) {
+	m := dao.genQ.ClientAlertHistory
+	result, err := m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.RuleID.Eq(ruleID)).
+		Where(m.Status.In(string(table.ClientAlertFiring), string(table.ClientAlertAcked))).
+		Order(m.ID.Desc()).
+		Limit(1).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return result[0], nil
+}
+
+// List client alert histories of the app with options.
+func (dao *clientAlertHistoryDao) List(kit *kit.Kit, bizID, appID, ruleID uint32, status string,
+	opt *types.BasePage) ([]*table.ClientAlertHistory, int64, error) {
+	m := dao.genQ.ClientAlertHistory
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID))
+
+	if ruleID != 0 {
+		q = q.Where(m.RuleID.Eq(ruleID))
+	}
+	if status != "" {
+		q = q.Where(m.Status.Eq(status))
+	}
+
+	q = q.Order(m.ID.Desc())
+	if opt.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// Ack acknowledge the firing alert.
+func (dao *clientAlertHistoryDao) Ack(kit *kit.Kit, bizID, appID, id uint32) error {
+	m := dao.genQ.ClientAlertHistory
+	now := time.Now().UTC()
+	result, err := m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id), m.Status.Eq(string(table.ClientAlertFiring))).
+		UpdateSimple(m.Status.Value(string(table.ClientAlertAcked)), m.AckedBy.Value(kit.User),
+			m.AckedAt.Value(now), m.Reviser.Value(kit.User), m.UpdatedAt.Value(now))
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("client alert is not firing")
+	}
+
+	return nil
+}
+
+// Resolve mark the firing or acked alert as resolved.
+func (dao *clientAlertHistoryDao) Resolve(kit *kit.Kit, bizID, id uint32) error {
+	m := dao.genQ.ClientAlertHistory
+	now := time.Now().UTC()
+	_, err := m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.ID.Eq(id)).
+		UpdateSimple(m.Status.Value(string(table.ClientAlertResolved)), m.ResolvedAt.Value(now),
+			m.Reviser.Value(kit.User), m.UpdatedAt.Value(now))
+
+	return err
+}
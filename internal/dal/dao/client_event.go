@@ -35,7 +35,11 @@ type ClientEvent interface {
 	ListClientByTuple(kit *kit.Kit, data [][]interface{}) ([]*table.ClientEvent, error)
 	// List list client event details
 	List(kit *kit.Kit, bizID, appID, clientID uint32, startTime, endTime time.Time, searchValue string,
-		order *pbds.ListClientEventsReq_Order, opt *types.BasePage) ([]*table.ClientEvent, int64, error)
+		targetReleaseID uint32, currentStage string, order *pbds.ListClientEventsReq_Order,
+		opt *types.BasePage) ([]*table.ClientEvent, int64, error)
+	// ListStageTimelines 获取版本变更的阶段时间线
+	ListStageTimelines(kit *kit.Kit, bizID, appID uint32, clientID []uint32, pullTime int64) (
+		[]*table.ClientEvent, error)
 	// GetMinMaxAvgTime 获取最小最大平均时间
 	GetMinMaxAvgTime(kit *kit.Kit, bizID, appID uint32, clientID []uint32, releaseChangeStatus []string) (
 		types.MinMaxAvgTimeChart, error)
@@ -85,6 +89,27 @@ func (dao *clientEventDao) GetPullTrend(kit *kit.Kit, bizID uint32, appID uint32
 	return items, err
 }
 
+// ListStageTimelines 获取版本变更的阶段时间线
+func (dao *clientEventDao) ListStageTimelines(kit *kit.Kit, bizID, appID uint32, clientID []uint32, pullTime int64) (
+	[]*table.ClientEvent, error) {
+
+	m := dao.genQ.ClientEvent
+	q := dao.genQ.ClientEvent.WithContext(kit.Ctx).
+		Select(m.ID, m.TargetReleaseID, m.ReleaseChangeStatus, m.CurrentStage, m.Stages).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.CurrentStage.Neq(""),
+			m.OriginalReleaseID.NeqCol(m.TargetReleaseID))
+
+	if pullTime > 0 {
+		startTime := time.Now().AddDate(0, 0, -int(pullTime)).Truncate(24 * time.Hour)
+		q = q.Where(m.StartTime.Gte(startTime))
+	}
+	if len(clientID) > 0 {
+		q = q.Where(m.ClientID.In(clientID...))
+	}
+
+	return q.Find()
+}
+
 // GetMinMaxAvgTime 获取最小最大平均时间
 func (dao *clientEventDao) GetMinMaxAvgTime(kit *kit.Kit, bizID uint32, appID uint32, clientID []uint32,
 	releaseChangeStatus []string) (types.MinMaxAvgTimeChart, error) {
@@ -114,8 +139,8 @@ func (dao *clientEventDao) GetMinMaxAvgTime(kit *kit.Kit, bizID uint32, appID ui
 
 // List list client event details
 func (dao *clientEventDao) List(kit *kit.Kit, bizID, appID, clientID uint32, startTime, endTime time.Time,
-	searchValue string, order *pbds.ListClientEventsReq_Order, opt *types.BasePage) (
-	[]*table.ClientEvent, int64, error) {
+	searchValue string, targetReleaseID uint32, currentStage string, order *pbds.ListClientEventsReq_Order,
+	opt *types.BasePage) ([]*table.ClientEvent, int64, error) {
 
 	m := dao.genQ.ClientEvent
 	q := dao.genQ.ClientEvent.WithContext(kit.Ctx)
@@ -148,6 +173,12 @@ func (dao *clientEventDao) List(kit *kit.Kit, bizID, appID, clientID uint32, sta
 	if endTime != zeroTime {
 		conds = append(conds, m.EndTime.Lte(endTime))
 	}
+	if targetReleaseID > 0 {
+		conds = append(conds, m.TargetReleaseID.Eq(targetReleaseID))
+	}
+	if len(currentStage) > 0 {
+		conds = append(conds, m.CurrentStage.Eq(currentStage))
+	}
 
 	d := q.Where(conds...).Order(exprs...)
 	if opt.All {
@@ -280,7 +311,7 @@ func (dao *clientEventDao) UpsertVersionChange(kit *kit.Kit, tx *gen.QueryTx, da
 			"client_mode", "original_release_id", "target_release_id", "start_time", "end_time",
 			"release_change_status", "release_change_failed_reason", "failed_detail_reason",
 			"download_file_size", "download_file_num", "total_seconds", "total_file_size",
-			"total_file_num", "download_file_num", "specific_failed_reason",
+			"total_file_num", "download_file_num", "specific_failed_reason", "current_stage", "stages",
 		}),
 	}).CreateInBatches(data, 500)
 }
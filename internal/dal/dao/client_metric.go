@@ -0,0 +1,270 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// ClientMetric supplies all the client metric related operations.
+type ClientMetric interface {
+	// BatchAccumulate accumulate the hourly client metrics into the existing buckets.
+	BatchAccumulate(kit *kit.Kit, metrics []*table.ClientMetric) error
+	// RollupDay downsample the hourly client metrics of the day into daily client metrics.
+	RollupDay(kit *kit.Kit, day time.Time) error
+	// DeleteBefore delete the client metrics of the granularity whose bucket time is before the time.
+	DeleteBefore(kit *kit.Kit, granularity table.ClientMetricGranularity, before time.Time) (int64, error)
+	// ListTrends list the client metric trends of the app or the client.
+	ListTrends(kit *kit.Kit, opt *types.ListClientMetricTrendsOption) ([]*types.ClientMetricTrend, error)
+}
+
+var _ ClientMetric = new(clientMetricDao)
+
+type clientMetricDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+const (
+	clientMetricBatchSize  = 500
+	clientMetricDeleteSize = 5000
+)
+
+// BatchAccumulate accumulate the hourly client metrics into the existing buckets.
+func (dao *clientMetricDao) BatchAccumulate(kit *kit.Kit, metrics []*table.ClientMetric) error {
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	if err := dao.assignIDs(kit, metrics); err != nil {
+		return err
+	}
+
+	// accumulate adds the value to be inserted to the existing value of the column.
+	accumulate := func(column string) clause.Expr {
+		return gorm.Expr("? + ?", utils.Existing(column), utils.Excluded(column))
+	}
+	return dao.genQ.ClientMetric.WithContext(kit.Ctx).Clauses(clause.OnConflict{
+		Columns: clientMetricUniqueColumns,
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"sample_count":      accumulate("sample_count"),
+			"cpu_sum":           accumulate("cpu_sum"),
+			"cpu_max":           gorm.Expr("GREATEST(?, ?)", utils.Existing("cpu_max"), utils.Excluded("cpu_max")),
+			"memory_sum":        accumulate("memory_sum"),
+			"memory_max":        gorm.Expr("GREATEST(?, ?)", utils.Existing("memory_max"), utils.Excluded("memory_max")),
+			"pull_count":        accumulate("pull_count"),
+			"pull_failed_count": accumulate("pull_failed_count"),
+			"pull_seconds":      accumulate("pull_seconds"),
+			"client_version": gorm.Expr("CASE WHEN ? = '' THEN ? ELSE ? END", utils.Excluded("client_version"),
+				utils.Existing("client_version"), utils.Excluded("client_version")),
+		}),
+	}).CreateInBatches(metrics, clientMetricBatchSize)
+}
+
+// RollupDay downsample the hourly client metrics of the day into daily client metrics,
+// the daily client metrics are overwritten so that it can be rolled up repeatedly.
+func (dao *clientMetricDao) RollupDay(kit *kit.Kit, day time.Time) error {
+	day = table.ClientMetricDay.Truncate(day)
+
+	m := dao.genQ.ClientMetric
+	var rows []*struct {
+		BizID           uint32
+		AppID           uint32
+		UID             string `gorm:"column:uid"`
+		ClientVersion   string
+		SampleCount     uint32
+		CpuSum          float64
+		CpuMax          float64
+		MemorySum       uint64
+		MemoryMax       uint64
+		PullCount       uint32
+		PullFailedCount uint32
+		PullSeconds     float64
+	}
+	// 天粒度的客户端版本取当天最后一个小时的版本
+	db := m.WithContext(kit.Ctx).UnderlyingDB()
+	lastVersion := "SUBSTRING_INDEX(GROUP_CONCAT(client_version ORDER BY bucket_time DESC), ',', 1)"
+	if utils.IsPostgres(db) {
+		lastVersion = "(ARRAY_AGG(client_version ORDER BY bucket_time DESC))[1]"
+	}
+	err := db.
+		Select("biz_id, app_id, uid, "+lastVersion+" AS client_version, "+
+			"SUM(sample_count) AS sample_count, SUM(cpu_sum) AS cpu_sum, MAX(cpu_max) AS cpu_max, "+
+			"SUM(memory_sum) AS memory_sum, MAX(memory_max) AS memory_max, SUM(pull_count) AS pull_count, "+
+			"SUM(pull_failed_count) AS pull_failed_count, SUM(pull_seconds) AS pull_seconds").
+		Where("granularity = ? AND bucket_time >= ? AND bucket_time < ?",
+			table.ClientMetricHour, day, day.AddDate(0, 0, 1)).
+		Group("biz_id, app_id, uid").
+		Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	metrics := make([]*table.ClientMetric, 0, len(rows))
+	for _, r := range rows {
+		metrics = append(metrics, &table.ClientMetric{
+			Attachment: &table.ClientMetricAttachment{BizID: r.BizID, AppID: r.AppID, UID: r.UID},
+			Spec: &table.ClientMetricSpec{
+				Granularity:     table.ClientMetricDay,
+				BucketTime:      day,
+				ClientVersion:   r.ClientVersion,
+				SampleCount:     r.SampleCount,
+				CpuSum:          r.CpuSum,
+				CpuMax:          r.CpuMax,
+				MemorySum:       r.MemorySum,
+				MemoryMax:       r.MemoryMax,
+				PullCount:       r.PullCount,
+				PullFailedCount: r.PullFailedCount,
+				PullSeconds:     r.PullSeconds,
+			},
+		})
+	}
+
+	if err := dao.assignIDs(kit, metrics); err != nil {
+		return err
+	}
+
+	return m.WithContext(kit.Ctx).Clauses(clause.OnConflict{
+		Columns: clientMetricUniqueColumns,
+		DoUpdates: clause.AssignmentColumns([]string{"client_version", "sample_count", "cpu_sum", "cpu_max",
+			"memory_sum", "memory_max", "pull_count", "pull_failed_count", "pull_seconds"}),
+	}).CreateInBatches(metrics, clientMetricBatchSize)
+}
+
+// DeleteBefore delete the client metrics of the granularity whose bucket time is before the time.
+func (dao *clientMetricDao) DeleteBefore(kit *kit.Kit, granularity table.ClientMetricGranularity,
+	before time.Time) (int64, error) {
+
+	m := dao.genQ.ClientMetric
+	var total int64
+	// 分批删除，避免长时间锁表
+	for {
+		result, err := m.WithContext(kit.Ctx).
+			Where(m.Granularity.Eq(string(granularity)), m.BucketTime.Lt(before)).
+			Limit(clientMetricDeleteSize).Delete()
+		if err != nil {
+			return total, err
+		}
+		total += result.RowsAffected
+		if result.RowsAffected < clientMetricDeleteSize {
+			return total, nil
+		}
+	}
+}
+
+// ListTrends list the client metric trends of the app or the client.
+func (dao *clientMetricDao) ListTrends(kit *kit.Kit, opt *types.ListClientMetricTrendsOption) (
+	[]*types.ClientMetricTrend, error) {
+
+	m := dao.genQ.ClientMetric
+	groupBy := "bucket_time"
+	selects := "bucket_time, COUNT(DISTINCT uid) AS client_count, SUM(sample_count) AS sample_count, " +
+		"SUM(cpu_sum) AS cpu_sum, MAX(cpu_max) AS cpu_max, SUM(memory_sum) AS memory_sum, " +
+		"MAX(memory_max) AS memory_max, SUM(pull_count) AS pull_count, " +
+		"SUM(pull_failed_count) AS pull_failed_count, SUM(pull_seconds) AS pull_seconds"
+	if opt.ByVersion {
+		groupBy += ", client_version"
+		selects += ", client_version"
+	}
+
+	q := m.WithContext(kit.Ctx).UnderlyingDB().Select(selects).
+		Where("biz_id = ? AND app_id = ? AND granularity = ? AND bucket_time >= ? AND bucket_time < ?",
+			opt.BizID, opt.AppID, opt.Granularity, opt.StartTime, opt.EndTime)
+	if opt.UID != "" {
+		q = q.Where("uid = ?", opt.UID)
+	}
+
+	trends := make([]*types.ClientMetricTrend, 0)
+	if err := q.Group(groupBy).Order(groupBy).Scan(&trends).Error; err != nil {
+		return nil, err
+	}
+
+	return trends, nil
+}
+
+// clientMetricUniqueColumns is the unique key of the client metric bucket.
+var clientMetricUniqueColumns = []clause.Column{{Name: "biz_id"}, {Name: "app_id"}, {Name: "uid"},
+	{Name: "granularity"}, {Name: "bucket_time"}}
+
+// assignIDs 已存在的指标桶沿用原有ID，只为新的指标桶生成ID，避免每次累加都消耗ID
+func (dao *clientMetricDao) assignIDs(kit *kit.Kit, metrics []*table.ClientMetric) error {
+	key := func(bizID, appID uint32, uid string, g table.ClientMetricGranularity, t time.Time) string {
+		return fmt.Sprintf("%d-%d-%s-%s-%d", bizID, appID, uid, g, t.Unix())
+	}
+
+	tuples := make([][]interface{}, 0, len(metrics))
+	for _, v := range metrics {
+		tuples = append(tuples, []interface{}{v.Attachment.BizID, v.Attachment.AppID, v.Attachment.UID,
+			string(v.Spec.Granularity), v.Spec.BucketTime})
+	}
+
+	m := dao.genQ.ClientMetric
+	existing := make(map[string]uint32)
+	for start := 0; start < len(tuples); start += clientMetricBatchSize {
+		end := start + clientMetricBatchSize
+		if end > len(tuples) {
+			end = len(tuples)
+		}
+		list, err := m.WithContext(kit.Ctx).
+			Select(m.ID, m.BizID, m.AppID, m.UID, m.Granularity, m.BucketTime).
+			Where(m.WithContext(kit.Ctx).Columns(m.BizID, m.AppID, m.UID, m.Granularity, m.BucketTime).
+				In(field.Values(tuples[start:end]))).
+			Find()
+		if err != nil {
+			return err
+		}
+		for _, v := range list {
+			existing[key(v.Attachment.BizID, v.Attachment.AppID, v.Attachment.UID, v.Spec.Granularity,
+				v.Spec.BucketTime)] = v.ID
+		}
+	}
+
+	toCreate := make([]*table.ClientMetric, 0)
+	for _, v := range metrics {
+		id, ok := existing[key(v.Attachment.BizID, v.Attachment.AppID, v.Attachment.UID, v.Spec.Granularity,
+			v.Spec.BucketTime)]
+		if ok {
+			v.ID = id
+			continue
+		}
+		toCreate = append(toCreate, v)
+	}
+	if len(toCreate) == 0 {
+		return nil
+	}
+
+	ids, err := dao.idGen.Batch(kit, table.ClientMetricsTable, len(toCreate))
+	if err != nil {
+		return err
+	}
+	for i, v := range toCreate {
+		v.ID = ids[i]
+	}
+
+	return nil
+}
@@ -39,6 +39,8 @@ type ClientQuery interface {
 	// GetBySearchName Get by search name
 	GetBySearchName(kit *kit.Kit, bizID, appID uint32, creator, searchName string) (
 		*table.ClientQuery, error)
+	// Get client query by id, includes the built-in system queries.
+	Get(kit *kit.Kit, bizID, appID, id uint32) (*table.ClientQuery, error)
 }
 
 var _ ClientQuery = new(clientQueryDao)
@@ -60,6 +62,14 @@ func (dao *clientQueryDao) GetBySearchName(kit *kit.Kit, bizID uint32, appID uin
 		Where(m.SearchName.Eq(searchName)).Take()
 }
 
+// Get client query by id, includes the built-in system queries.
+func (dao *clientQueryDao) Get(kit *kit.Kit, bizID, appID, id uint32) (*table.ClientQuery, error) {
+	m := dao.genQ.ClientQuery
+
+	return dao.genQ.ClientQuery.WithContext(kit.Ctx).
+		Where(m.ID.Eq(id), m.BizID.In(bizID, 0), m.AppID.In(appID, 0)).Take()
+}
+
 // ListBySearchCondition Get by search criteria
 func (dao *clientQueryDao) ListBySearchCondition(kit *kit.Kit, bizID, appID uint32, creator, searchType,
 	searchCondition string) ([]*table.ClientQuery, error) {
@@ -67,9 +77,7 @@ func (dao *clientQueryDao) ListBySearchCondition(kit *kit.Kit, bizID, appID uint
 
 	return dao.genQ.ClientQuery.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).
 		Where(m.Creator.Eq(creator), m.SearchType.Eq(searchType)).
-		Where(utils.RawCond("JSON_CONTAINS(?,?)", utils.Field{
-			Field: m.SearchCondition,
-		}, searchCondition)).
+		Where(utils.JSONContains(m.SearchCondition, searchCondition)).
 		Find()
 }
 
@@ -15,6 +15,7 @@ package dao
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	rawgen "gorm.io/gen"
 	"gorm.io/gorm"
@@ -31,6 +32,7 @@ type ConfigInstanceSearchCondition struct {
 	ConfigTemplateId uint32
 	CcProcessId      uint32
 	ModuleInstSeq    uint32
+	DriftStatus      table.ConfigDriftStatus
 }
 
 // ConfigInstance supplies all the config instance related operations.
@@ -43,6 +45,10 @@ type ConfigInstance interface {
 	// 获取配置实例
 	GetConfigInstance(kit *kit.Kit, bizID uint32, search *ConfigInstanceSearchCondition) (*table.ConfigInstance, error)
 	ListConfigInstancesByTemplateID(kit *kit.Kit, bizID uint32, configTemplateIDs []uint32) ([]*table.ConfigInstance, error)
+	// UpdateDriftStatus 更新配置实例的漂移检测结果
+	UpdateDriftStatus(kit *kit.Kit, bizID, id uint32, status table.ConfigDriftStatus, actualMd5 string) error
+	// ListBizIDs 获取存在配置实例的业务ID列表
+	ListBizIDs(kit *kit.Kit) ([]uint32, error)
 }
 
 var _ ConfigInstance = new(configInstanceDao)
@@ -105,6 +111,11 @@ func (dao *configInstanceDao) handleSearch(search *ConfigInstanceSearchCondition
 		conds = append(conds, m.ModuleInstSeq.Eq(search.ModuleInstSeq))
 	}
 
+	// DriftStatus 过滤
+	if search.DriftStatus != table.ConfigDriftUnchecked {
+		conds = append(conds, m.DriftStatus.Eq(string(search.DriftStatus)))
+	}
+
 	return conds
 }
 
@@ -150,7 +161,8 @@ func (dao *configInstanceDao) Upsert(kit *kit.Kit, configInstance *table.ConfigI
 	// 如果记录已存在，更新记录
 	configInstance.ID = existing.ID
 	if _, err := q.Where(m.ID.Eq(existing.ID)).
-		Select(m.ConfigVersionID, m.GenerateTaskID, m.TenantID, m.Reviser, m.UpdatedAt, m.Md5, m.Content).
+		Select(m.ConfigVersionID, m.GenerateTaskID, m.TenantID, m.Reviser, m.UpdatedAt, m.Md5, m.Content,
+			m.DriftStatus, m.ActualMd5, m.DriftCheckedAt).
 		Updates(configInstance); err != nil {
 		return fmt.Errorf("update config instance failed: %w", err)
 	}
@@ -175,3 +187,33 @@ func (dao *configInstanceDao) GetConfigInstance(kit *kit.Kit, bizID uint32, sear
 
 	return existing, nil
 }
+
+// UpdateDriftStatus 更新配置实例的漂移检测结果
+func (dao *configInstanceDao) UpdateDriftStatus(kit *kit.Kit, bizID, id uint32, status table.ConfigDriftStatus,
+	actualMd5 string) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+
+	m := dao.genQ.ConfigInstance
+	now := time.Now()
+	if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).
+		UpdateSimple(m.DriftStatus.Value(string(status)), m.ActualMd5.Value(actualMd5),
+			m.DriftCheckedAt.Value(now)); err != nil {
+		return fmt.Errorf("update config instance drift status failed: %w", err)
+	}
+
+	return nil
+}
+
+// ListBizIDs 获取存在配置实例的业务ID列表
+func (dao *configInstanceDao) ListBizIDs(kit *kit.Kit) ([]uint32, error) {
+	m := dao.genQ.ConfigInstance
+
+	var bizIDs []uint32
+	if err := m.WithContext(kit.Ctx).Distinct(m.BizID).Pluck(m.BizID, &bizIDs); err != nil {
+		return nil, err
+	}
+
+	return bizIDs, nil
+}
@@ -16,7 +16,6 @@ import (
 	"errors"
 	"fmt"
 
-	"gorm.io/datatypes"
 	rawgen "gorm.io/gen"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
@@ -211,7 +210,7 @@ func (dao *configTemplateDao) ListByCCProcessID(kit *kit.Kit, bizID uint32, ccPr
 
 	err := dao.genQ.ConfigTemplate.WithContext(kit.Ctx).
 		Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery(m.CcProcessIDs.ColumnName().String()).Contains(ccProcessID))...).
+		Where(utils.JSONArrayContains(m.CcProcessIDs.ColumnName().String(), ccProcessID)).
 		Pluck(m.ID, &ids)
 	if err != nil {
 		return nil, err
@@ -228,7 +227,7 @@ func (dao *configTemplateDao) ListByCCTemplateProcessID(kit *kit.Kit, bizID uint
 
 	err := dao.genQ.ConfigTemplate.WithContext(kit.Ctx).
 		Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery(m.CcTemplateProcessIDs.ColumnName().String()).Contains(ccProcessTemplateID))...).
+		Where(utils.JSONArrayContains(m.CcTemplateProcessIDs.ColumnName().String(), ccProcessTemplateID)).
 		Pluck(m.ID, &ids)
 	if err != nil {
 		return nil, err
@@ -15,6 +15,7 @@ package dao
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	rawgen "gorm.io/gen"
 
@@ -54,6 +55,13 @@ type Credential interface {
 	UpdateRevisionWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error
 	// GetByName get Credential by name.
 	GetByName(kit *kit.Kit, bizID uint32, name string) (*table.Credential, error)
+	// Rotate replace the credential string, the previous one is still valid in the grace period.
+	Rotate(kit *kit.Kit, bizID, id uint32, encCredential string, gracePeriod time.Duration) error
+	// BatchUpdateLastUsedAt update the last used time of the credentials.
+	BatchUpdateLastUsedAt(kit *kit.Kit, lastUsedAt map[uint32]time.Time) error
+	// ListToRemind list the enabled credentials which expire before the expiring time,
+	// or have not been used since the unused time.
+	ListToRemind(kit *kit.Kit, expiringBefore, unusedSince time.Time) ([]*table.Credential, error)
 }
 
 var _ Credential = new(credentialDao)
@@ -106,11 +114,21 @@ func (dao *credentialDao) GetByCredentialString(kit *kit.Kit, bizID uint32, str
 	m := dao.genQ.Credential
 	q := dao.genQ.Credential.WithContext(kit.Ctx)
 
-	credential, err := q.Where(m.BizID.Eq(bizID), m.EncCredential.Eq(encrypted)).Take()
+	now := time.Now().UTC()
+	credential, err := q.Where(m.BizID.Eq(bizID)).
+		Where(q.Where(m.EncCredential.Eq(encrypted)).
+			Or(m.PreviousEncCredential.Eq(encrypted), m.PreviousExpiredAt.Gt(now))).Take()
 	if err != nil {
 		return nil, fmt.Errorf("get credential failed, err: %w", err)
 	}
 
+	// 轮换前的凭证只在宽限期内有效, 返回的过期时间为宽限期结束时间
+	if credential.Spec.EncCredential != encrypted {
+		if credential.Spec.ExpiredAt == nil || credential.Spec.PreviousExpiredAt.Before(*credential.Spec.ExpiredAt) {
+			credential.Spec.ExpiredAt = credential.Spec.PreviousExpiredAt
+		}
+	}
+
 	return credential, nil
 }
 
@@ -211,7 +229,7 @@ func (dao *credentialDao) List(kit *kit.Kit, bizID uint32, searchKey string, opt
 			CredentialID uint32
 		}
 		err := cs.WithContext(kit.Ctx).Select(cs.CredentialId).
-			Where(cs.BizID.Eq(bizID), cs.CredentialScope.Regexp(searchVal)).Group(cs.CredentialId).Scan(&item)
+			Where(cs.BizID.Eq(bizID), utils.Regexp(cs.CredentialScope, searchVal)).Group(cs.CredentialId).Scan(&item)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -220,11 +238,11 @@ func (dao *credentialDao) List(kit *kit.Kit, bizID uint32, searchKey string, opt
 			for _, v := range item {
 				credentialID = append(credentialID, v.CredentialID)
 			}
-			conds = append(conds, q.Where(m.Memo.Regexp(searchVal)).Or(m.Reviser.Regexp(searchVal)).
-				Or(m.Name.Regexp(searchVal)).Or(m.ID.In(credentialID...)).Or(m.EncCredential.Eq(encCredential)))
+			conds = append(conds, q.Where(utils.Regexp(m.Memo, searchVal)).Or(utils.Regexp(m.Reviser, searchVal)).
+				Or(utils.Regexp(m.Name, searchVal)).Or(m.ID.In(credentialID...)).Or(m.EncCredential.Eq(encCredential)))
 		} else {
-			conds = append(conds, q.Where(m.Memo.Regexp(searchVal)).Or(m.Reviser.Regexp(searchVal)).
-				Or(m.Name.Regexp(searchVal)).Or(m.EncCredential.Eq(encCredential)))
+			conds = append(conds, q.Where(utils.Regexp(m.Memo, searchVal)).Or(utils.Regexp(m.Reviser, searchVal)).
+				Or(utils.Regexp(m.Name, searchVal)).Or(m.EncCredential.Eq(encCredential)))
 		}
 
 	}
@@ -279,25 +297,13 @@ func (dao *credentialDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id
 		return e
 	}
 
-	// decode credential string
-	masterKey := dao.credentialSetting.MasterKey
-	encrypted, err := tools.DecryptCredential(oldOne.Spec.EncCredential, masterKey, oldOne.Spec.EncAlgorithm)
+	// fire the event with txn to ensure the if save the event failed then the business logic is failed anyway.
+	events, err := dao.credentialEvents(kit, oldOne, table.DeleteOp)
 	if err != nil {
 		return err
 	}
-	// fire the event with txn to ensure the if save the event failed then the business logic is failed anyway.
-	one := types.Event{
-		Spec: &table.EventSpec{
-			Resource:    table.CredentialEvent,
-			ResourceID:  id,
-			ResourceUid: encrypted,
-			OpType:      table.DeleteOp,
-		},
-		Attachment: &table.EventAttachment{BizID: bizID},
-		Revision:   &table.CreatedRevision{Creator: kit.User},
-	}
 	eDecorator := dao.event.Eventf(kit)
-	if err = eDecorator.FireWithTx(tx, one); err != nil {
+	if err = eDecorator.FireWithTx(tx, events...); err != nil {
 		logs.Errorf("fire delete credential: %d event failed, err: %v, rid: %s", id, err, kit.Rid)
 		return errors.New("fire event failed, " + err.Error())
 	}
@@ -320,23 +326,11 @@ func (dao *credentialDao) Update(kit *kit.Kit, g *table.Credential) error {
 		return err
 	}
 
-	// decode credential string
-	masterKey := dao.credentialSetting.MasterKey
-	encrypted, err := tools.DecryptCredential(oldOne.Spec.EncCredential, masterKey, oldOne.Spec.EncAlgorithm)
+	// fire the event with txn to ensure the if save the event failed then the business logic is failed anyway.
+	events, err := dao.credentialEvents(kit, oldOne, table.UpdateOp)
 	if err != nil {
 		return err
 	}
-	// fire the event with txn to ensure the if save the event failed then the business logic is failed anyway.
-	one := types.Event{
-		Spec: &table.EventSpec{
-			Resource:    table.CredentialEvent,
-			ResourceID:  g.ID,
-			ResourceUid: encrypted,
-			OpType:      table.UpdateOp,
-		},
-		Attachment: &table.EventAttachment{BizID: g.Attachment.BizID},
-		Revision:   &table.CreatedRevision{Creator: kit.User},
-	}
 	eDecorator := dao.event.Eventf(kit)
 	resInstance := fmt.Sprintf(constant.CredentialEnableName, g.Spec.Name)
 	// 禁用秘钥
@@ -353,7 +347,7 @@ func (dao *credentialDao) Update(kit *kit.Kit, g *table.Credential) error {
 	updateTx := func(tx *gen.Query) error {
 		q = tx.Credential.WithContext(kit.Ctx)
 		if _, e := q.Where(m.BizID.Eq(g.Attachment.BizID), m.ID.Eq(g.ID)).
-			Select(m.Memo, m.Name, m.Enable, m.Reviser).Updates(g); e != nil {
+			Select(m.Memo, m.Name, m.Enable, m.ExpiredAt, m.Reviser).Updates(g); e != nil {
 			return e
 		}
 
@@ -361,8 +355,8 @@ func (dao *credentialDao) Update(kit *kit.Kit, g *table.Credential) error {
 			return e
 		}
 
-		if e := eDecorator.Fire(one); e != nil {
-			logs.Errorf("fire update credential: %s event failed, err: %v, rid: %s", g.ID, e, kit.Rid)
+		if e := eDecorator.Fire(events...); e != nil {
+			logs.Errorf("fire update credential: %d event failed, err: %v, rid: %s", g.ID, e, kit.Rid)
 			return errors.New("fire event failed, " + e.Error())
 		}
 
@@ -388,9 +382,7 @@ func (dao *credentialDao) UpdateRevisionWithTx(kit *kit.Kit, tx *gen.QueryTx, bi
 		return err
 	}
 
-	// decode credential string
-	masterKey := dao.credentialSetting.MasterKey
-	encrypted, err := tools.DecryptCredential(oldOne.Spec.EncCredential, masterKey, oldOne.Spec.EncAlgorithm)
+	events, err := dao.credentialEvents(kit, oldOne, table.UpdateOp)
 	if err != nil {
 		return err
 	}
@@ -402,18 +394,8 @@ func (dao *credentialDao) UpdateRevisionWithTx(kit *kit.Kit, tx *gen.QueryTx, bi
 	}
 
 	// fire the event with txn to ensure the if save the event failed then the business logic is failed anyway.
-	one := types.Event{
-		Spec: &table.EventSpec{
-			Resource:    table.CredentialEvent,
-			ResourceID:  id,
-			ResourceUid: encrypted,
-			OpType:      table.UpdateOp,
-		},
-		Attachment: &table.EventAttachment{BizID: bizID},
-		Revision:   &table.CreatedRevision{Creator: kit.User},
-	}
 	eDecorator := dao.event.Eventf(kit)
-	if err = eDecorator.FireWithTx(tx, one); err != nil {
+	if err = eDecorator.FireWithTx(tx, events...); err != nil {
 		logs.Errorf("fire update credential: %d event failed, err: %v, rid: %s", id, err, kit.Rid)
 		return errors.New("fire event failed, " + err.Error())
 	}
@@ -434,3 +416,163 @@ func (dao *credentialDao) GetByName(kit *kit.Kit, bizID uint32, name string) (*t
 
 	return credential, nil
 }
+
+// Rotate replace the credential string, the previous one is still valid in the grace period.
+// !Note: rotate credential should emit a update event for the previous credential string.
+func (dao *credentialDao) Rotate(kit *kit.Kit, bizID, id uint32, encCredential string,
+	gracePeriod time.Duration) error {
+	if bizID == 0 || id == 0 {
+		return errors.New("credential bizID or id is zero")
+	}
+	if encCredential == "" {
+		return errors.New("enc credential is empty")
+	}
+	if gracePeriod < 0 {
+		return errors.New("grace period should >= 0")
+	}
+
+	m := dao.genQ.Credential
+	oldOne, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(id), m.BizID.Eq(bizID)).Take()
+	if err != nil {
+		return err
+	}
+
+	// 上一次轮换未过宽限期的凭证直接失效, 当前凭证在宽限期内继续有效, 没有宽限期时直接失效
+	now := time.Now().UTC()
+	events := make([]types.Event, 0, 2)
+	if oldOne.Spec.InGracePeriod(now) {
+		one, e := dao.credentialEvent(kit, oldOne, oldOne.Spec.PreviousEncCredential, table.DeleteOp)
+		if e != nil {
+			return e
+		}
+		events = append(events, one)
+	}
+	op := table.UpdateOp
+	if gracePeriod == 0 {
+		op = table.DeleteOp
+	}
+	one, err := dao.credentialEvent(kit, oldOne, oldOne.Spec.EncCredential, op)
+	if err != nil {
+		return err
+	}
+	events = append(events, one)
+
+	previousExpiredAt := now.Add(gracePeriod)
+	g := &table.Credential{
+		ID: id,
+		Spec: &table.CredentialSpec{
+			Name:                  oldOne.Spec.Name,
+			EncCredential:         encCredential,
+			PreviousEncCredential: oldOne.Spec.EncCredential,
+			PreviousExpiredAt:     &previousExpiredAt,
+			RotatedAt:             &now,
+		},
+		Attachment: oldOne.Attachment,
+		Revision:   &table.Revision{Reviser: kit.User},
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.CredentialName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           fmt.Sprintf("rotate credential, grace period: %s", gracePeriod),
+	}).PrepareUpdate(g)
+
+	eDecorator := dao.event.Eventf(kit)
+	updateTx := func(tx *gen.Query) error {
+		q := tx.Credential.WithContext(kit.Ctx)
+		if _, e := q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).
+			Select(m.EncCredential, m.PreviousEncCredential, m.PreviousExpiredAt, m.RotatedAt, m.Reviser).
+			Updates(g); e != nil {
+			return e
+		}
+
+		if e := ad.Do(tx); e != nil {
+			return e
+		}
+
+		if e := eDecorator.Fire(events...); e != nil {
+			logs.Errorf("fire rotate credential: %d event failed, err: %v, rid: %s", id, e, kit.Rid)
+			return errors.New("fire event failed, " + e.Error())
+		}
+
+		return nil
+	}
+	err = dao.genQ.Transaction(updateTx)
+
+	eDecorator.Finalizer(err)
+
+	return err
+}
+
+// BatchUpdateLastUsedAt update the last used time of the credentials.
+// 注意: 该方法由 cache-service 定时任务调用，无租户上下文，按主键 ID 更新不存在跨租户风险。
+func (dao *credentialDao) BatchUpdateLastUsedAt(kit *kit.Kit, lastUsedAt map[uint32]time.Time) error {
+	m := dao.genQ.Credential
+	for id, usedAt := range lastUsedAt {
+		if _, err := m.WithContext(kit.WithSkipTenantFilter().Ctx).
+			Where(m.ID.Eq(id)).
+			Where(m.WithContext(kit.Ctx).Where(m.LastUsedAt.IsNull()).Or(m.LastUsedAt.Lt(usedAt))).
+			UpdateSimple(m.LastUsedAt.Value(usedAt)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListToRemind list the enabled credentials which expire before the expiring time,
+// or have not been used since the unused time.
+func (dao *credentialDao) ListToRemind(kit *kit.Kit, expiringBefore, unusedSince time.Time) (
+	[]*table.Credential, error) {
+	m := dao.genQ.Credential
+	q := dao.genQ.Credential.WithContext(kit.Ctx)
+
+	now := time.Now().UTC()
+	expiring := q.Where(m.ExpiredAt.Gt(now), m.ExpiredAt.Lte(expiringBefore))
+	unused := q.Where(m.LastUsedAt.Lt(unusedSince)).
+		Or(m.LastUsedAt.IsNull(), m.CreatedAt.Lt(unusedSince))
+
+	return q.Where(m.Enable.Is(true)).Where(q.Where(expiring).Or(unused)).Find()
+}
+
+// credentialEvents 凭证变更时需要刷新缓存的事件, 宽限期内轮换前的凭证同样需要刷新
+func (dao *credentialDao) credentialEvents(kit *kit.Kit, c *table.Credential, op table.EventType) (
+	[]types.Event, error) {
+	encs := []string{c.Spec.EncCredential}
+	if c.Spec.InGracePeriod(time.Now()) {
+		encs = append(encs, c.Spec.PreviousEncCredential)
+	}
+
+	events := make([]types.Event, 0, len(encs))
+	for _, enc := range encs {
+		one, err := dao.credentialEvent(kit, c, enc, op)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, one)
+	}
+
+	return events, nil
+}
+
+// credentialEvent 凭证缓存以凭证明文为 key, 事件中记录解密后的凭证
+func (dao *credentialDao) credentialEvent(kit *kit.Kit, c *table.Credential, enc string, op table.EventType) (
+	types.Event, error) {
+	// decode credential string
+	masterKey := dao.credentialSetting.MasterKey
+	decrypted, err := tools.DecryptCredential(enc, masterKey, c.Spec.EncAlgorithm)
+	if err != nil {
+		return types.Event{}, err
+	}
+
+	return types.Event{
+		Spec: &table.EventSpec{
+			Resource:    table.CredentialEvent,
+			ResourceID:  c.ID,
+			ResourceUid: decrypted,
+			OpType:      op,
+		},
+		Attachment: &table.EventAttachment{BizID: c.Attachment.BizID},
+		Revision:   &table.CreatedRevision{Creator: kit.User},
+	}, nil
+}
@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// CredentialClient supplies all the credential client related operations.
+type CredentialClient interface {
+	// BatchUpsert create the credential clients or update their last used time.
+	BatchUpsert(kit *kit.Kit, clients []*table.CredentialClient) error
+	// CountByCredentialIDs count the clients of the credentials.
+	CountByCredentialIDs(kit *kit.Kit, bizID uint32, ids []uint32) (map[uint32]uint32, error)
+	// DeleteBefore delete the credential clients which have not used the credential since the time.
+	DeleteBefore(kit *kit.Kit, before time.Time) (int64, error)
+}
+
+var _ CredentialClient = new(credentialClientDao)
+
+type credentialClientDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+const (
+	credentialClientBatchSize  = 500
+	credentialClientDeleteSize = 5000
+)
+
+// BatchUpsert create the credential clients or update their last used time.
+// 注意: 该方法由 cache-service 定时任务调用，无租户上下文，凭证客户端表按凭证ID关联，没有租户字段。
+func (dao *credentialClientDao) BatchUpsert(kit *kit.Kit, clients []*table.CredentialClient) error {
+	if len(clients) == 0 {
+		return nil
+	}
+
+	if err := dao.assignIDs(kit, clients); err != nil {
+		return err
+	}
+
+	return dao.genQ.CredentialClient.WithContext(kit.Ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "credential_id"}, {Name: "client_uid"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_used_at": gorm.Expr("GREATEST(?, ?)", utils.Existing("last_used_at"), utils.Excluded("last_used_at")),
+		}),
+	}).CreateInBatches(clients, credentialClientBatchSize)
+}
+
+// CountByCredentialIDs count the clients of the credentials.
+func (dao *credentialClientDao) CountByCredentialIDs(kit *kit.Kit, bizID uint32, ids []uint32) (
+	map[uint32]uint32, error) {
+	counts := make(map[uint32]uint32)
+	if len(ids) == 0 {
+		return counts, nil
+	}
+
+	m := dao.genQ.CredentialClient
+	var rows []struct {
+		CredentialID uint32
+		Count        uint32
+	}
+	if err := m.WithContext(kit.Ctx).Select(m.CredentialID, m.ID.Count().As("count")).
+		Where(m.BizID.Eq(bizID), m.CredentialID.In(ids...)).
+		Group(m.CredentialID).Scan(&rows); err != nil {
+		return nil, err
+	}
+
+	for _, r := range rows {
+		counts[r.CredentialID] = r.Count
+	}
+
+	return counts, nil
+}
+
+// DeleteBefore delete the credential clients which have not used the credential since the time.
+func (dao *credentialClientDao) DeleteBefore(kit *kit.Kit, before time.Time) (int64, error) {
+	m := dao.genQ.CredentialClient
+	var total int64
+	// 分批删除，避免长时间锁表
+	for {
+		result, err := m.WithContext(kit.Ctx).Where(m.LastUsedAt.Lt(before)).
+			Limit(credentialClientDeleteSize).Delete()
+		if err != nil {
+			return total, err
+		}
+		total += result.RowsAffected
+		if result.RowsAffected < credentialClientDeleteSize {
+			return total, nil
+		}
+	}
+}
+
+// assignIDs 已存在的客户端沿用原有ID，只为新的客户端生成ID，避免每次更新都消耗ID
+func (dao *credentialClientDao) assignIDs(kit *kit.Kit, clients []*table.CredentialClient) error {
+	key := func(credentialID uint32, uid string) string {
+		return fmt.Sprintf("%d-%s", credentialID, uid)
+	}
+
+	tuples := make([][]interface{}, 0, len(clients))
+	for _, v := range clients {
+		tuples = append(tuples, []interface{}{v.Attachment.CredentialID, v.Spec.ClientUID})
+	}
+
+	m := dao.genQ.CredentialClient
+	existing := make(map[string]uint32)
+	for start := 0; start < len(tuples); start += credentialClientBatchSize {
+		end := start + credentialClientBatchSize
+		if end > len(tuples) {
+			end = len(tuples)
+		}
+		list, err := m.WithContext(kit.Ctx).
+			Select(m.ID, m.CredentialID, m.ClientUID).
+			Where(m.WithContext(kit.Ctx).Columns(m.CredentialID, m.ClientUID).In(field.Values(tuples[start:end]))).
+			Find()
+		if err != nil {
+			return err
+		}
+		for _, v := range list {
+			existing[key(v.Attachment.CredentialID, v.Spec.ClientUID)] = v.ID
+		}
+	}
+
+	toCreate := make([]*table.CredentialClient, 0)
+	for _, v := range clients {
+		if id, ok := existing[key(v.Attachment.CredentialID, v.Spec.ClientUID)]; ok {
+			v.ID = id
+			continue
+		}
+		toCreate = append(toCreate, v)
+	}
+	if len(toCreate) == 0 {
+		return nil
+	}
+
+	ids, err := dao.idGen.Batch(kit, table.CredentialClientsTable, len(toCreate))
+	if err != nil {
+		return err
+	}
+	for i, v := range toCreate {
+		v.ID = ids[i]
+	}
+
+	return nil
+}
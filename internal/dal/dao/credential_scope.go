@@ -128,8 +128,10 @@ func (dao *credentialScopeDao) UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, g *ta
 
 	m := tx.CredentialScope
 
+	// 显式指定更新的列, 以支持清空 kv key 规则, 标签限制和过期时间
 	if _, err := tx.CredentialScope.WithContext(kit.Ctx).Where(m.BizID.Eq(g.Attachment.BizID), m.ID.Eq(g.ID)).
-		Omit(m.BizID, m.ID).Updates(g); err != nil {
+		Select(m.CredentialScope, m.KvKeyPattern, m.KvKeyMatchType, m.Labels, m.Permission, m.ExpiredAt,
+			m.Reviser, m.UpdatedAt).Updates(g); err != nil {
 		return err
 	}
 
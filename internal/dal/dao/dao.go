@@ -18,7 +18,6 @@ import (
 	"time"
 
 	"github.com/changsongl/gorm-plugin/query"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"gorm.io/plugin/opentelemetry/tracing"
@@ -85,6 +84,22 @@ type Set interface {
 	BizHost() BizHost
 	ConfigTemplate() ConfigTemplate
 	ConfigInstance() ConfigInstance
+	ClientAlertRule() ClientAlertRule
+	ClientAlertHistory() ClientAlertHistory
+	ClientMetric() ClientMetric
+	CredentialClient() CredentialClient
+	TemplateSpaceHook() TemplateSpaceHook
+	Role() Role
+	RoleBinding() RoleBinding
+	GroupTemplateVariable() GroupTemplateVariable
+	ReleasedGroupCI() ReleasedGroupCI
+	GitRepoLink() GitRepoLink
+	ChangeFreeze() ChangeFreeze
+	Webhook() Webhook
+	WebhookDelivery() WebhookDelivery
+	ApprovalFlow() ApprovalFlow
+	ApprovalTicket() ApprovalTicket
+	ApprovalAction() ApprovalAction
 }
 
 // NewDaoSet create the DAO set instance.
@@ -96,7 +111,7 @@ func NewDaoSet(opt cc.Sharding, credentialSetting cc.Credential, gormSetting cc.
 		return nil, fmt.Errorf("init sharding failed, err: %v", err)
 	}
 
-	adminDB, err := gorm.Open(mysql.Open(sharding.URI(opt.AdminDatabase)),
+	adminDB, err := gorm.Open(sharding.GormDialector(opt.AdminDatabase),
 		&gorm.Config{Logger: logger.Default.LogMode(gormSetting.GetLogLevel())})
 	if err != nil {
 		return nil, err
@@ -113,6 +128,11 @@ func NewDaoSet(opt cc.Sharding, credentialSetting cc.Credential, gormSetting cc.
 	db.SetMaxIdleConns(int(opt.AdminDatabase.MaxIdleConn))
 	db.SetConnMaxLifetime(time.Duration(opt.AdminDatabase.MaxIdleTimeoutMin) * time.Minute)
 
+	// route the statements of gorm to the sharding db of their biz, the same as the sharding instance.
+	if e := sd.RouteGorm(adminDB); e != nil {
+		return nil, fmt.Errorf("route gorm by sharding failed, err: %v", e)
+	}
+
 	if e := adminDB.Use(tracing.NewPlugin(tracing.WithoutMetrics())); e != nil {
 		return nil, err
 	}
@@ -575,6 +595,127 @@ func (s *set) ConfigInstance() ConfigInstance {
 	}
 }
 
+// ClientAlertRule returns the ClientAlertRule scope's DAO
+func (s *set) ClientAlertRule() ClientAlertRule {
+	return &clientAlertRuleDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ClientAlertHistory returns the ClientAlertHistory scope's DAO
+func (s *set) ClientAlertHistory() ClientAlertHistory {
+	return &clientAlertHistoryDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ClientMetric returns the ClientMetric scope's DAO
+func (s *set) ClientMetric() ClientMetric {
+	return &clientMetricDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// CredentialClient returns the CredentialClient scope's DAO
+func (s *set) CredentialClient() CredentialClient {
+	return &credentialClientDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// TemplateSpaceHook returns the TemplateSpaceHook scope's DAO
+func (s *set) TemplateSpaceHook() TemplateSpaceHook {
+	return &templateSpaceHookDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// GroupTemplateVariable returns the GroupTemplateVariable scope's DAO
+func (s *set) GroupTemplateVariable() GroupTemplateVariable {
+	return &groupTemplateVariableDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ReleasedGroupCI returns the released group config item's DAO
+func (s *set) ReleasedGroupCI() ReleasedGroupCI {
+	return &releasedGroupCIDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// GitRepoLink returns the GitRepoLink scope's DAO
+func (s *set) GitRepoLink() GitRepoLink {
+	return &gitRepoLinkDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ChangeFreeze returns the ChangeFreeze scope's DAO
+func (s *set) ChangeFreeze() ChangeFreeze {
+	return &changeFreezeDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// Webhook returns the Webhook scope's DAO
+func (s *set) Webhook() Webhook {
+	return &webhookDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// WebhookDelivery returns the WebhookDelivery scope's DAO
+func (s *set) WebhookDelivery() WebhookDelivery {
+	return &webhookDeliveryDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// ApprovalFlow returns the ApprovalFlow scope's DAO
+func (s *set) ApprovalFlow() ApprovalFlow {
+	return &approvalFlowDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ApprovalTicket returns the ApprovalTicket scope's DAO
+func (s *set) ApprovalTicket() ApprovalTicket {
+	return &approvalTicketDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// ApprovalAction returns the ApprovalAction scope's DAO
+func (s *set) ApprovalAction() ApprovalAction {
+	return &approvalActionDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
 func (s *set) TaskBatch() TaskBatch {
 	return &taskBatchDao{
 		idGen:    s.idGen,
@@ -598,3 +739,21 @@ func (s *set) ConfigTemplate() ConfigTemplate {
 		genQ:     s.genQ,
 	}
 }
+
+// Role returns the role scope's DAO
+func (s *set) Role() Role {
+	return &roleDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// RoleBinding returns the role binding scope's DAO
+func (s *set) RoleBinding() RoleBinding {
+	return &roleBindingDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
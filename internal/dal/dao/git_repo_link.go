@@ -0,0 +1,175 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// GitRepoLink supplies all the git repository link related operations.
+type GitRepoLink interface {
+	// Create one git repository link instance.
+	Create(kit *kit.Kit, link *table.GitRepoLink) (uint32, error)
+	// Update the spec of one git repository link instance.
+	Update(kit *kit.Kit, link *table.GitRepoLink) error
+	// Delete one git repository link instance.
+	Delete(kit *kit.Kit, bizID, id uint32) error
+	// Get git repository link by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.GitRepoLink, error)
+	// List git repository links of the app or the template space.
+	List(kit *kit.Kit, bizID, appID, templateSpaceID uint32) ([]*table.GitRepoLink, error)
+	// ListScheduled list all the git repository links which are synced on schedule.
+	ListScheduled(kit *kit.Kit) ([]*table.GitRepoLink, error)
+	// StartSync mark the git repository link as syncing, it returns false if the link is being synced
+	// by others and the sync is not started before the stale time.
+	StartSync(kit *kit.Kit, bizID, id uint32, staleBefore time.Time) (bool, error)
+	// UpdateStatus update the status of the last sync of the git repository link.
+	UpdateStatus(kit *kit.Kit, bizID, id uint32, status *table.GitRepoLinkStatus) error
+	// DeleteByAppIDWithTx delete the git repository links of the app with transaction.
+	DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error
+}
+
+var _ GitRepoLink = new(gitRepoLinkDao)
+
+type gitRepoLinkDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one git repository link instance.
+func (dao *gitRepoLinkDao) Create(kit *kit.Kit, link *table.GitRepoLink) (uint32, error) {
+	if link == nil {
+		return 0, errors.New("git repo link is nil")
+	}
+
+	if err := link.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.GitRepoLinksTable)
+	if err != nil {
+		return 0, err
+	}
+	link.ID = id
+	if link.Status == nil {
+		link.Status = new(table.GitRepoLinkStatus)
+	}
+
+	if err := dao.genQ.GitRepoLink.WithContext(kit.Ctx).Create(link); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Update the spec of one git repository link instance.
+func (dao *gitRepoLinkDao) Update(kit *kit.Kit, link *table.GitRepoLink) error {
+	if link == nil {
+		return errors.New("git repo link is nil")
+	}
+
+	if err := link.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	m := dao.genQ.GitRepoLink
+	_, err := m.WithContext(kit.Ctx).
+		Select(m.RepoURL, m.Ref, m.SubPath, m.Username, m.SyncInterval, m.ReplaceAll, m.WebhookTokenHash, m.Memo,
+			m.Reviser, m.UpdatedAt).
+		Where(m.BizID.Eq(link.Attachment.BizID), m.ID.Eq(link.ID)).
+		Updates(link)
+
+	return err
+}
+
+// Delete one git repository link instance.
+func (dao *gitRepoLinkDao) Delete(kit *kit.Kit, bizID, id uint32) error {
+	m := dao.genQ.GitRepoLink
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete()
+
+	return err
+}
+
+// Get git repository link by id.
+func (dao *gitRepoLinkDao) Get(kit *kit.Kit, bizID, id uint32) (*table.GitRepoLink, error) {
+	m := dao.genQ.GitRepoLink
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// List git repository links of the app or the template space.
+func (dao *gitRepoLinkDao) List(kit *kit.Kit, bizID, appID, templateSpaceID uint32) ([]*table.GitRepoLink, error) {
+	m := dao.genQ.GitRepoLink
+
+	return m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.TemplateSpaceID.Eq(templateSpaceID)).
+		Order(m.ID.Desc()).
+		Find()
+}
+
+// ListScheduled list all the git repository links which are synced on schedule.
+func (dao *gitRepoLinkDao) ListScheduled(kit *kit.Kit) ([]*table.GitRepoLink, error) {
+	m := dao.genQ.GitRepoLink
+
+	return m.WithContext(kit.Ctx).Where(m.SyncInterval.Gt(0)).Order(m.BizID, m.ID).Find()
+}
+
+// StartSync mark the git repository link as syncing, it returns false if the link is being synced
+// by others and the sync is not started before the stale time.
+func (dao *gitRepoLinkDao) StartSync(kit *kit.Kit, bizID, id uint32, staleBefore time.Time) (bool, error) {
+	m := dao.genQ.GitRepoLink
+	q := m.WithContext(kit.Ctx)
+	result, err := q.
+		Where(m.BizID.Eq(bizID), m.ID.Eq(id)).
+		Where(q.Where(m.SyncStatus.Neq(string(table.GitRepoSyncing))).Or(m.SyncedAt.IsNull()).
+			Or(m.SyncedAt.Lt(staleBefore))).
+		UpdateSimple(m.SyncStatus.Value(string(table.GitRepoSyncing)), m.SyncedAt.Value(time.Now().UTC()))
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// UpdateStatus update the status of the last sync of the git repository link.
+func (dao *gitRepoLinkDao) UpdateStatus(kit *kit.Kit, bizID, id uint32, status *table.GitRepoLinkStatus) error {
+	if status == nil {
+		return errors.New("git repo link status is nil")
+	}
+
+	if len(status.SyncMessage) > table.GitRepoMaxSyncMessageLength {
+		status.SyncMessage = status.SyncMessage[:table.GitRepoMaxSyncMessageLength]
+	}
+
+	m := dao.genQ.GitRepoLink
+	_, err := m.WithContext(kit.Ctx).
+		Select(m.SyncStatus, m.SyncedSHA, m.SyncMessage, m.SyncedAt).
+		Where(m.BizID.Eq(bizID), m.ID.Eq(id)).
+		Updates(&table.GitRepoLink{Status: status})
+
+	return err
+}
+
+// DeleteByAppIDWithTx delete the git repository links of the app with transaction.
+func (dao *gitRepoLinkDao) DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error {
+	m := tx.GitRepoLink
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Delete()
+
+	return err
+}
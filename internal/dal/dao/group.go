@@ -46,6 +46,8 @@ type Group interface {
 	// ListGroupReleasedApps list all the released apps of the group.
 	ListGroupReleasedApps(kit *kit.Kit, opts *types.ListGroupReleasedAppsOption) (
 		*types.ListGroupReleasedAppsDetails, error)
+	// ListByClientQueryID list the dynamic groups which resolve members from the client query.
+	ListByClientQueryID(kit *kit.Kit, bizID, clientQueryID uint32) ([]*table.Group, error)
 }
 
 var _ Group = new(groupDao)
@@ -125,7 +127,7 @@ func (dao *groupDao) UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, g *table.Group)
 
 	_, err := m.WithContext(kit.Ctx).
 		Where(m.ID.Eq(g.ID), m.BizID.Eq(g.Attachment.BizID)).
-		Select(m.Name, m.Public, m.Selector, m.UID, m.Reviser).
+		Select(m.Name, m.Public, m.Selector, m.UID, m.ClientQueryID, m.Reviser).
 		Updates(g)
 	if err != nil {
 		return err
@@ -162,6 +164,17 @@ func (dao *groupDao) GetByName(kit *kit.Kit, bizID uint32, name string) (*table.
 	return m.WithContext(kit.Ctx).Where(m.Name.Eq(name), m.BizID.Eq(bizID)).Take()
 }
 
+// ListByClientQueryID list the dynamic groups which resolve members from the client query.
+func (dao *groupDao) ListByClientQueryID(kit *kit.Kit, bizID, clientQueryID uint32) ([]*table.Group, error) {
+
+	if bizID == 0 || clientQueryID == 0 {
+		return nil, errf.New(errf.InvalidParameter, "biz id or client query id is 0")
+	}
+
+	m := dao.genQ.Group
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ClientQueryID.Eq(clientQueryID)).Find()
+}
+
 // ListAll list all the groups in biz.
 func (dao *groupDao) ListAll(kit *kit.Kit, bizID uint32, topIds []uint32) ([]*table.Group, error) {
 
@@ -245,6 +258,8 @@ func (dao *groupDao) ListGroupReleasedApps(kit *kit.Kit, opts *types.ListGroupRe
 	aq := a.WithContext(kit.Ctx)
 	r := dao.genQ.Release
 	g := dao.genQ.ReleasedGroup
+	// pr is the release which the group is pinned to
+	pr := dao.genQ.Release.As("pinned_releases")
 
 	list := make([]*types.ListGroupReleasedAppsData, 0)
 
@@ -253,16 +268,20 @@ func (dao *groupDao) ListGroupReleasedApps(kit *kit.Kit, opts *types.ListGroupRe
 
 	if opts.SearchKey == "" {
 		count, err = a.WithContext(kit.Ctx).
-			Select(a.ID.As("app_id"), a.Name.As("app_name"), r.ID.As("release_id"), r.Name.As("release_name"), g.Edited).
+			Select(a.ID.As("app_id"), a.Name.As("app_name"), r.ID.As("release_id"), r.Name.As("release_name"), g.Edited,
+				g.PinnedReleaseID, pr.Name.As("pinned_release_name"), g.PinnedUntil, g.PinMemo).
 			Join(r, a.ID.EqCol(r.AppID)).Join(g, r.ID.EqCol(g.ReleaseID), a.ID.EqCol(g.AppID)).
+			LeftJoin(pr, pr.ID.EqCol(g.PinnedReleaseID)).
 			Where(g.GroupID.Eq(opts.GroupID), a.BizID.Eq(opts.BizID), r.BizID.Eq(opts.BizID), g.BizID.Eq(opts.BizID)).
 			ScanByPage(&list, int(opts.Start), int(opts.Limit))
 	} else {
 		count, err = a.WithContext(kit.Ctx).
-			Select(a.ID.As("app_id"), a.Name.As("app_name"), r.ID.As("release_id"), r.Name.As("release_name"), g.Edited).
+			Select(a.ID.As("app_id"), a.Name.As("app_name"), r.ID.As("release_id"), r.Name.As("release_name"), g.Edited,
+				g.PinnedReleaseID, pr.Name.As("pinned_release_name"), g.PinnedUntil, g.PinMemo).
 			Join(r, a.ID.EqCol(r.AppID)).Join(g, r.ID.EqCol(g.ReleaseID), a.ID.EqCol(g.AppID)).
+			LeftJoin(pr, pr.ID.EqCol(g.PinnedReleaseID)).
 			Where(g.GroupID.Eq(opts.GroupID), a.BizID.Eq(opts.BizID), r.BizID.Eq(opts.BizID), g.BizID.Eq(opts.BizID)).
-			Where(aq.Where(a.Name.Regexp("(?i)"+opts.SearchKey)).Or(r.Name.Regexp("(?i)"+opts.SearchKey))).
+			Where(aq.Where(utils.Regexp(a.Name, "(?i)"+opts.SearchKey)).Or(utils.Regexp(r.Name, "(?i)"+opts.SearchKey))).
 			ScanByPage(&list, int(opts.Start), int(opts.Limit))
 	}
 
@@ -0,0 +1,149 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// GroupTemplateVariable supplies all the group template variable related operations.
+type GroupTemplateVariable interface {
+	// Upsert create or update the template variables overridden by the group of the app.
+	Upsert(kit *kit.Kit, g *table.GroupTemplateVariable) error
+	// ListVariables list the template variables overridden by the group of the app.
+	ListVariables(kit *kit.Kit, bizID, appID, groupID uint32) ([]*table.TemplateVariableSpec, error)
+	// ListByGroupIDs list the template variables overridden by the groups of the app.
+	ListByGroupIDs(kit *kit.Kit, bizID, appID uint32, groupIDs []uint32) ([]*table.GroupTemplateVariable, error)
+	// ListByAppID list the template variables overridden by all the groups of the app.
+	ListByAppID(kit *kit.Kit, bizID, appID uint32) ([]*table.GroupTemplateVariable, error)
+	// DeleteByGroupIDWithTx delete the template variables overridden by the group with transaction.
+	DeleteByGroupIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, groupID uint32) error
+	// DeleteByAppIDWithTx delete the template variables overridden by all the groups of the app with transaction.
+	DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error
+}
+
+var _ GroupTemplateVariable = new(groupTemplateVariableDao)
+
+type groupTemplateVariableDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Upsert create or update the template variables overridden by the group of the app.
+func (dao *groupTemplateVariableDao) Upsert(kit *kit.Kit, g *table.GroupTemplateVariable) error {
+	if err := g.ValidateUpsert(kit); err != nil {
+		return err
+	}
+
+	m := dao.genQ.GroupTemplateVariable
+	q := dao.genQ.GroupTemplateVariable.WithContext(kit.Ctx)
+	old, findErr := q.Where(m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID),
+		m.GroupID.Eq(g.Attachment.GroupID)).Take()
+	if findErr != nil && !errors.Is(findErr, gorm.ErrRecordNotFound) {
+		return findErr
+	}
+
+	upsertTx := func(tx *gen.Query) error {
+		ad := dao.auditDao.Decorator(kit, g.Attachment.BizID, &table.AuditField{
+			ResourceInstance: fmt.Sprintf(constant.SetVariableName, g.Spec.GetVariableNames()),
+			Status:           enumor.Success,
+			AppId:            g.Attachment.AppID,
+		})
+
+		// if old exists, update it.
+		if findErr == nil {
+			g.ID = old.ID
+			if _, err := tx.GroupTemplateVariable.WithContext(kit.Ctx).
+				Where(m.BizID.Eq(g.Attachment.BizID), m.ID.Eq(g.ID)).
+				Select(m.Variables, m.Reviser).
+				Updates(g); err != nil {
+				return err
+			}
+			return ad.PrepareUpdate(old).Do(tx)
+		}
+
+		// if old not exists, create it.
+		id, err := dao.idGen.One(kit, table.GroupTemplateVariablesTable)
+		if err != nil {
+			return err
+		}
+		g.ID = id
+		if err := tx.GroupTemplateVariable.WithContext(kit.Ctx).Create(g); err != nil {
+			return err
+		}
+		return ad.PrepareCreate(g).Do(tx)
+	}
+
+	return dao.genQ.Transaction(upsertTx)
+}
+
+// ListVariables list the template variables overridden by the group of the app.
+func (dao *groupTemplateVariableDao) ListVariables(kit *kit.Kit, bizID, appID, groupID uint32) (
+	[]*table.TemplateVariableSpec, error) {
+	vars, err := dao.ListByGroupIDs(kit, bizID, appID, []uint32{groupID})
+	if err != nil {
+		return nil, err
+	}
+	if len(vars) == 0 {
+		return []*table.TemplateVariableSpec{}, nil
+	}
+	return vars[0].Spec.Variables, nil
+}
+
+// ListByGroupIDs list the template variables overridden by the groups of the app.
+func (dao *groupTemplateVariableDao) ListByGroupIDs(kit *kit.Kit, bizID, appID uint32, groupIDs []uint32) (
+	[]*table.GroupTemplateVariable, error) {
+	if len(groupIDs) == 0 {
+		return []*table.GroupTemplateVariable{}, nil
+	}
+
+	m := dao.genQ.GroupTemplateVariable
+	return dao.genQ.GroupTemplateVariable.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.GroupID.In(groupIDs...)).
+		Order(m.GroupID).
+		Find()
+}
+
+// ListByAppID list the template variables overridden by all the groups of the app.
+func (dao *groupTemplateVariableDao) ListByAppID(kit *kit.Kit, bizID, appID uint32) (
+	[]*table.GroupTemplateVariable, error) {
+	m := dao.genQ.GroupTemplateVariable
+	return dao.genQ.GroupTemplateVariable.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).
+		Order(m.GroupID).
+		Find()
+}
+
+// DeleteByGroupIDWithTx delete the template variables overridden by the group with transaction.
+func (dao *groupTemplateVariableDao) DeleteByGroupIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, groupID uint32) error {
+	m := tx.GroupTemplateVariable
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.GroupID.Eq(groupID)).Delete()
+	return err
+}
+
+// DeleteByAppIDWithTx delete the template variables overridden by all the groups of the app with transaction.
+func (dao *groupTemplateVariableDao) DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error {
+	m := tx.GroupTemplateVariable
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Delete()
+	return err
+}
@@ -16,9 +16,6 @@ import (
 	"errors"
 	"fmt"
 
-	"gorm.io/datatypes"
-	rawgen "gorm.io/gen"
-
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
@@ -126,11 +123,11 @@ func (dao *hookDao) CountNumberUnReferences(kit *kit.Kit, bizID uint32,
 		q = q.Where(h.Name.Like("%" + opt.Name + "%"))
 	}
 	if opt.Tag != "" {
-		q = q.Where(rawgen.Cond(datatypes.JSONArrayQuery("tags").Contains(opt.Tag))...)
+		q = q.Where(utils.JSONArrayContains("tags", opt.Tag))
 	} else if opt.NotTag {
 		// when the length of tags is 2, it must be '[]'
 		// It could also be null
-		q = q.Where(h.Tags.Length().Eq(2)).Or(h.Tags.Length().Eq(4))
+		q = q.Where(utils.JSONTextLength(h.Tags, "=", 2)).Or(utils.JSONTextLength(h.Tags, "=", 4))
 	}
 
 	return q.LeftJoin(rh, h.ID.EqCol(rh.HookID)).Where(rh.HookID.IsNull()).Count()
@@ -210,19 +207,19 @@ func (dao *hookDao) ListWithRefer(kit *kit.Kit, opt *types.ListHooksWithReferOpt
 		q = q.Where(h.Name.Like("%" + opt.Name + "%"))
 	}
 	if opt.Tag != "" {
-		q = q.Where(rawgen.Cond(datatypes.JSONArrayQuery("tags").Contains(opt.Tag))...)
+		q = q.Where(utils.JSONArrayContains("tags", opt.Tag))
 	} else if opt.NotTag {
 		// when the length of tags is 2, it must be '[]'
 		// It could also be null
-		q = q.Where(h.Tags.Length().Eq(2)).Or(h.Tags.Length().Eq(4))
+		q = q.Where(utils.JSONTextLength(h.Tags, "=", 2)).Or(utils.JSONTextLength(h.Tags, "=", 4))
 	}
 
 	if opt.SearchKey != "" {
 		searchKey := "(?i)" + opt.SearchKey
 		// Where 内嵌表示括号, 例如: q.Where(q.Where(a).Or(b)) => (a or b)
 		// 参考: https://gorm.io/zh_CN/gen/query.html#Group-%E6%9D%A1%E4%BB%B6
-		q = q.Where(q.Where(h.Name.Regexp(searchKey)).Or(h.Memo.Regexp(searchKey)).Or(
-			h.Creator.Regexp(searchKey)).Or(h.Reviser.Regexp(searchKey)))
+		q = q.Where(q.Where(utils.Regexp(h.Name, searchKey)).Or(utils.Regexp(h.Memo, searchKey)).Or(
+			utils.Regexp(h.Creator, searchKey)).Or(utils.Regexp(h.Reviser, searchKey)))
 	}
 
 	details := make([]*types.ListHooksWithReferDetail, 0)
@@ -262,7 +259,8 @@ func (dao *hookDao) ListHookReferences(kit *kit.Kit, opt *types.ListHookReferenc
 
 	query := rh.WithContext(kit.Ctx).
 		Select(rh.ID.As("hook_revision_id"), rh.HookRevisionName.As("hook_revision_name"), rh.HookType.As("hook_type"),
-			a.ID.As("app_id"), a.Name.As("app_name"), r.ID.As("release_id"), r.Name.As("release_name"), r.Deprecated).
+			a.ID.As("app_id"), a.Name.As("app_name"), r.ID.As("release_id"), r.Name.As("release_name"), r.Deprecated,
+			rh.Params).
 		LeftJoin(a, rh.AppID.EqCol(a.ID)).
 		LeftJoin(r, rh.ReleaseID.EqCol(r.ID)).
 		Where(rh.HookID.Eq(opt.HookID), rh.BizID.Eq(opt.BizID))
@@ -271,7 +269,7 @@ func (dao *hookDao) ListHookReferences(kit *kit.Kit, opt *types.ListHookReferenc
 		// Where 内嵌表示括号, 例如: q.Where(q.Where(a).Or(b)) => (a or b)
 		// 参考: https://gorm.io/zh_CN/gen/query.html#Group-%E6%9D%A1%E4%BB%B6
 		query = query.Where(query.Where(
-			a.Name.Regexp(searchKey)).Or(r.Name.Regexp(searchKey)).Or(rh.HookRevisionName.Regexp(searchKey)))
+			utils.Regexp(a.Name, searchKey)).Or(utils.Regexp(r.Name, searchKey)).Or(utils.Regexp(rh.HookRevisionName, searchKey)))
 	}
 
 	count, err = query.Order(rh.ID.Desc()).ScanByPage(&details, opt.Page.Offset(), opt.Page.LimitInt())
@@ -293,7 +291,7 @@ func (dao *hookDao) CountHookTag(kit *kit.Kit, bizID uint32) ([]*types.HookTagCo
 	var allTags []dtypes.StringSlice
 	if err := q.Select(m.Tags).Where(m.BizID.Eq(bizID)).
 		// when the length of tags greater than 2, it must not be empty which means not be '[]'
-		Where(m.Tags.Length().Gt(2)).
+		Where(utils.JSONTextLength(m.Tags, ">", 2)).
 		Scan(&allTags); err != nil {
 		return nil, err
 	}
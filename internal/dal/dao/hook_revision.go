@@ -20,6 +20,7 @@ import (
 
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
@@ -311,7 +312,7 @@ func (dao *hookRevisionDao) ListHookRevisionReferences(kit *kit.Kit, opt *types.
 		// Where 内嵌表示括号, 例如: q.Where(q.Where(a).Or(b)) => (a or b)
 		// 参考: https://gorm.io/zh_CN/gen/query.html#Group-%E6%9D%A1%E4%BB%B6
 		query = query.Where(query.Where(
-			a.Name.Regexp(searchKey)).Or(r.Name.Regexp(searchKey)).Or(rh.HookRevisionName.Regexp(searchKey)))
+			utils.Regexp(a.Name, searchKey)).Or(utils.Regexp(r.Name, searchKey)).Or(utils.Regexp(rh.HookRevisionName, searchKey)))
 	}
 
 	count, err = query.Order(rh.ID.Desc()).ScanByPage(&details, opt.Page.Offset(), opt.Page.LimitInt())
@@ -474,7 +475,7 @@ func (dao *hookRevisionDao) Update(kit *kit.Kit, hr *table.HookRevision) error {
 	updateTx := func(tx *gen.Query) error {
 		q = tx.HookRevision.WithContext(kit.Ctx)
 		if _, e := q.Where(m.BizID.Eq(hr.Attachment.BizID), m.ID.Eq(hr.ID)).
-			Select(m.Name, m.Memo, m.Content, m.Reviser).
+			Select(m.Name, m.Memo, m.Content, m.Params, m.Reviser).
 			Updates(hr); e != nil {
 			return e
 		}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	rawgen "gorm.io/gen"
+	"gorm.io/gorm/clause"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
@@ -354,14 +355,16 @@ func (dao *kvDao) List(kit *kit.Kit, opt *types.ListKvOption) ([]*table.Kv, int6
 
 	orderStr := "CASE WHEN kv_state = 'ADD' THEN 1 WHEN kv_state = 'REVISE' THEN 2 " +
 		"WHEN kv_state = 'DELETE' THEN 3 WHEN kv_state = 'UNCHANGE' THEN 4 END," +
-		"certificate_expiration_date IS NULL, certificate_expiration_date asc, `key` asc"
+		"certificate_expiration_date IS NULL, certificate_expiration_date asc, ? asc"
+	// key is a reserved word of mysql, quote it with the dialect of the db.
+	keyCol := clause.Column{Name: "key"}
 
 	if opt.Page.Order == types.Descending {
 		q = q.Order(orderCol.Desc())
 	} else if len(opt.TopIDs) != 0 {
-		q = q.Order(utils.NewCustomExpr("CASE WHEN id IN (?) THEN 0 ELSE 1 END, "+orderStr, []interface{}{opt.TopIDs}))
+		q = q.Order(utils.NewCustomExpr("CASE WHEN id IN (?) THEN 0 ELSE 1 END, "+orderStr, []interface{}{opt.TopIDs, keyCol}))
 	} else {
-		q = q.Order(utils.NewCustomExpr(orderStr, nil))
+		q = q.Order(utils.NewCustomExpr(orderStr, []interface{}{keyCol}))
 	}
 
 	var conds []rawgen.Condition
@@ -17,12 +17,14 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/protobuf/encoding/protojson"
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbclient "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
 	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 	"github.com/TencentBlueKing/bk-bscp/pkg/types"
@@ -167,13 +169,26 @@ func (dao *pubDao) upsertReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.
 		},
 	}
 	if opt.All {
-		// 1. delete all released groups
+		// 1. delete all released groups, the pinned groups are kept and follow this release after the pin expired
 		m := tx.ReleasedGroup
-		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID)).Delete(); err != nil {
+		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID),
+			m.PinnedReleaseID.Eq(0)).Delete(); err != nil {
 			logs.Errorf("delete all released groups failed, err: %v, rid: %s", err, kit.Rid)
 			return err
 		}
-		// 2. insert default group
+		if err := dao.followPinnedReleasedGroups(kit, tx, opt, stg, nil); err != nil {
+			return err
+		}
+		// 2. insert default group if it is not pinned
+		count, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID),
+			m.GroupID.Eq(defaultGroup.ID)).Count()
+		if err != nil {
+			logs.Errorf("count default released group failed, err: %v, rid: %s", err, kit.Rid)
+			return err
+		}
+		if count != 0 {
+			return nil
+		}
 		rgID, err := dao.idGen.One(kit, table.ReleasedGroupTable)
 		if err != nil {
 			logs.Errorf("generate released group id failed, err: %v, rid: %s", err, kit.Rid)
@@ -205,7 +220,8 @@ func (dao *pubDao) upsertReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.
 		// 1. delete other released groups in this release.
 		m := tx.ReleasedGroup
 		if _, err := m.WithContext(kit.Ctx).
-			Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID), m.ReleaseID.Eq(opt.ReleaseID)).
+			Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID), m.ReleaseID.Eq(opt.ReleaseID),
+				m.PinnedReleaseID.Eq(0)).
 			Delete(); err != nil {
 			logs.Errorf("delete other released groups in release failed, err: %v, rid: %s", err, kit.Rid)
 			return err
@@ -216,22 +232,35 @@ func (dao *pubDao) upsertReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.
 			groupIDs = append(groupIDs, group.ID)
 		}
 		if _, err := m.WithContext(kit.Ctx).
-			Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID), m.GroupID.In(groupIDs...)).
+			Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID), m.GroupID.In(groupIDs...),
+				m.PinnedReleaseID.Eq(0)).
 			Delete(); err != nil {
 			logs.Errorf("delete other released groups in other releases failed, err: %v, rid: %s", err, kit.Rid)
 			return err
 		}
+		if err := dao.followPinnedReleasedGroups(kit, tx, opt, stg, groupIDs); err != nil {
+			return err
+		}
 		// 3. only publish default group
 		groups = []*table.Group{defaultGroup}
 	}
 	for _, group := range groups {
+		sel := group.Spec.Selector
+		if group.Spec.Mode == table.GroupModeDynamic {
+			var err error
+			if sel, err = dao.resolveDynamicGroup(kit, opt.BizID, opt.AppID, group); err != nil {
+				logs.Errorf("resolve dynamic group %d failed, err: %v, rid: %s", group.ID, err, kit.Rid)
+				return err
+			}
+		}
+
 		rg := &table.ReleasedGroup{
 			GroupID:    group.ID,
 			AppID:      opt.AppID,
 			ReleaseID:  opt.ReleaseID,
 			StrategyID: stg.ID,
 			Mode:       group.Spec.Mode,
-			Selector:   group.Spec.Selector,
+			Selector:   sel,
 			UID:        group.Spec.UID,
 			Edited:     false,
 			BizID:      opt.BizID,
@@ -264,6 +293,75 @@ func (dao *pubDao) upsertReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.
 	return nil
 }
 
+// followPinnedReleasedGroups 被固定的分组在全量或默认发布时不删除，只将其发布的版本更新为本次发布的版本，
+// 固定期间客户端仍使用固定的版本，固定到期后使用本次发布的版本. groupIDs 为空时更新服务下所有被固定的分组.
+func (dao *pubDao) followPinnedReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.PublishOption,
+	stg *table.Strategy, groupIDs []uint32) error {
+
+	m := tx.ReleasedGroup
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID), m.PinnedReleaseID.Neq(0))
+	if len(groupIDs) != 0 {
+		q = q.Where(m.GroupID.In(groupIDs...))
+	}
+
+	if _, err := q.Updates(map[string]interface{}{
+		m.ReleaseID.ColumnName().String():  opt.ReleaseID,
+		m.StrategyID.ColumnName().String(): stg.ID,
+		m.Edited.ColumnName().String():     false,
+		m.Reviser.ColumnName().String():    kit.User,
+	}); err != nil {
+		logs.Errorf("update pinned released groups failed, err: %v, rid: %s", err, kit.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// dynamicGroupLookbackMinutes only the clients which reported heartbeat within it are resolved into dynamic group.
+const dynamicGroupLookbackMinutes = 24 * 60
+
+// resolveDynamicGroup 按照动态分组关联的客户端查询条件解析出当前匹配的客户端，并生成以客户端 UID 匹配的选择器
+func (dao *pubDao) resolveDynamicGroup(kit *kit.Kit, bizID, appID uint32, group *table.Group) (
+	*selector.Selector, error) {
+
+	query, err := (&clientQueryDao{genQ: dao.genQ}).Get(kit, bizID, appID, group.Spec.ClientQueryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("client query %d of dynamic group %s not exists",
+				group.Spec.ClientQueryID, group.Spec.Name)
+		}
+		return nil, err
+	}
+
+	search := new(pbclient.ClientQueryCondition)
+	if err = (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(
+		[]byte(query.Spec.SearchCondition), search); err != nil {
+		return nil, fmt.Errorf("unmarshal search condition of client query %d failed, err: %v", query.ID, err)
+	}
+
+	uids, err := (&clientDao{genQ: dao.genQ}).ListUIDsBySearch(kit, bizID, appID, dynamicGroupLookbackMinutes,
+		search, table.DynamicGroupMaxClients+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(uids) == 0 {
+		return nil, fmt.Errorf("dynamic group %s matches no clients", group.Spec.Name)
+	}
+	if len(uids) > table.DynamicGroupMaxClients {
+		return nil, fmt.Errorf("dynamic group %s matches more than %d clients", group.Spec.Name,
+			table.DynamicGroupMaxClients)
+	}
+
+	values := make([]interface{}, 0, len(uids))
+	for _, uid := range uids {
+		values = append(values, uid)
+	}
+
+	return &selector.Selector{
+		LabelsAnd: selector.Label{{Key: table.DynamicGroupUIDKey, Op: &selector.InOperator, Value: values}},
+	}, nil
+}
+
 // SubmitWithTx submit with transaction
 func (dao *pubDao) SubmitWithTx(kit *kit.Kit, tx *gen.QueryTx, opt *types.PublishOption) (uint32, error) {
 	if opt == nil {
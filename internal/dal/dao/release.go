@@ -14,6 +14,7 @@ package dao
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -21,9 +22,11 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/sharding"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	dtypes "github.com/TencentBlueKing/bk-bscp/pkg/dal/types"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/types"
 )
@@ -42,6 +45,8 @@ type Release interface {
 	Get(kit *kit.Kit, bizID, appID, releaseID uint32) (*table.Release, error)
 	// UpdateDeprecated update release deprecated status.
 	UpdateDeprecated(kit *kit.Kit, bizID, appID, releaseID uint32, deprecated bool) error
+	// UpdateTagsWithTx update release tags with tx.
+	UpdateTagsWithTx(kit *kit.Kit, tx *gen.QueryTx, release *table.Release, tags []string) error
 	// DeleteWithTx delete release with tx.
 	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) error
 	// GetReleaseLately get release lately info
@@ -91,8 +96,12 @@ func (dao *releaseDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, g *table.Rele
 		return 0, err
 	}
 
+	resInstance := fmt.Sprintf(constant.ConfigReleaseName, g.Spec.Name)
+	if g.Spec.SourceReleaseID > 0 {
+		resInstance = fmt.Sprintf(constant.PromoteConfigReleaseName, g.Spec.Name)
+	}
 	ad := dao.auditDao.Decorator(kit, g.Attachment.BizID, &table.AuditField{
-		ResourceInstance: fmt.Sprintf(constant.ConfigReleaseName, g.Spec.Name),
+		ResourceInstance: resInstance,
 		Status:           enumor.Success,
 		Detail:           g.Spec.Memo,
 		AppId:            g.Attachment.AppID,
@@ -139,7 +148,7 @@ func (dao *releaseDao) List(kit *kit.Kit, opts *types.ListReleasesOption) (*type
 	} else {
 		searchKey := "(?i)" + opts.SearchKey
 		q = q.Where(m.BizID.Eq(opts.BizID), m.AppID.Eq(opts.AppID), m.Deprecated.Is(opts.Deprecated)).Where(
-			q.Where(m.Name.Regexp(searchKey)).Or(m.Memo.Regexp(searchKey)).Or(m.Creator.Regexp(searchKey)))
+			q.Where(utils.Regexp(m.Name, searchKey)).Or(utils.Regexp(m.Memo, searchKey)).Or(utils.Regexp(m.Creator, searchKey)))
 	}
 	q = q.Order(m.ID.Desc())
 
@@ -220,6 +229,27 @@ func (dao *releaseDao) UpdateDeprecated(kit *kit.Kit, bizID, appID, releaseID ui
 	return err
 }
 
+// UpdateTagsWithTx update release tags with tx.
+func (dao *releaseDao) UpdateTagsWithTx(kit *kit.Kit, tx *gen.QueryTx, release *table.Release, tags []string) error {
+	if err := table.ValidateReleaseTags(tags); err != nil {
+		return errf.New(errf.InvalidParameter, err.Error())
+	}
+
+	m := tx.Release
+	if _, err := m.WithContext(kit.Ctx).
+		Where(m.ID.Eq(release.ID), m.AppID.Eq(release.Attachment.AppID), m.BizID.Eq(release.Attachment.BizID)).
+		Update(m.Tags, dtypes.StringSlice(tags)); err != nil {
+		return err
+	}
+
+	return dao.auditDao.Decorator(kit, release.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.TagConfigReleaseName, release.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           strings.Join(tags, ","),
+		AppId:            release.Attachment.AppID,
+	}).PrepareUpdate(release).Do(tx.Query)
+}
+
 // DeleteWithTx delete release with tx.
 func (dao *releaseDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) error {
 	m := tx.Release
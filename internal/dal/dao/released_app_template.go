@@ -157,8 +157,8 @@ func (dao *releasedAppTemplateDao) GetReleasedLately(kit *kit.Kit, bizID, appId
 	q := dao.genQ.ReleasedAppTemplate.WithContext(kit.Ctx)
 	query := q.Where(m.BizID.Eq(bizID), m.AppID.Eq(appId))
 	subQuery := q.Where(m.BizID.Eq(bizID), m.AppID.Eq(appId)).
-		Order(m.ReleaseID.Desc(), utils.NewCustomExpr("CASE WHEN RIGHT(path, 1) = '/' THEN CONCAT(path,`name`) ELSE "+
-			"CONCAT_WS('/', path, `name`) END", nil)).
+		Order(m.ReleaseID.Desc(), utils.NewCustomExpr("CASE WHEN RIGHT(path, 1) = '/' THEN CONCAT(path,name) ELSE "+
+			"CONCAT_WS('/', path, name) END", nil)).
 		Limit(1).
 		Select(m.ReleaseID)
 	return query.Where(q.Columns(m.ReleaseID).Eq(subQuery)).Find()
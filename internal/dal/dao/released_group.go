@@ -13,6 +13,8 @@
 package dao
 
 import (
+	"time"
+
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
@@ -37,6 +39,18 @@ type ReleasedGroup interface {
 	UpdateEditedStatusWithTx(kit *kit.Kit, tx *gen.QueryTx, edited bool, groupID, bizID uint32) error
 	// BatchDeleteByAppIDWithTx batch delete by app id with transaction.
 	BatchDeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, bizID uint32) error
+	// BatchDeleteByGroupIDsWithTx batch delete the released groups of the app by group ids with transaction,
+	// the pinned groups are kept.
+	BatchDeleteByGroupIDsWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32, groupIDs []uint32) error
+	// GetByGroupID get the released group of the app by group id.
+	GetByGroupID(kit *kit.Kit, bizID, appID, groupID uint32) (*table.ReleasedGroup, error)
+	// UpdatePinWithTx pin the released group to the release with transaction, pinnedReleaseID 0 means unpin.
+	UpdatePinWithTx(kit *kit.Kit, tx *gen.QueryTx, rg *table.ReleasedGroup) error
+	// ListExpiredPins list the released groups whose pin is expired before the time.
+	ListExpiredPins(kit *kit.Kit, before time.Time, limit int) ([]*table.ReleasedGroup, error)
+	// ClearExpiredPinWithTx clear the pin of the released group if it is still expired before the time,
+	// returns whether the pin is cleared.
+	ClearExpiredPinWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32, before time.Time) (bool, error)
 }
 
 var _ ReleasedGroup = new(releasedGroupDao)
@@ -155,3 +169,90 @@ func (dao *releasedGroupDao) BatchDeleteByAppIDWithTx(kit *kit.Kit, tx *gen.Quer
 	_, err := m.WithContext(kit.Ctx).Where(m.AppID.Eq(appID), m.BizID.Eq(bizID)).Delete()
 	return err
 }
+
+// BatchDeleteByGroupIDsWithTx batch delete the released groups of the app by group ids with transaction,
+// the pinned groups are kept.
+func (dao *releasedGroupDao) BatchDeleteByGroupIDsWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32,
+	groupIDs []uint32) error {
+	if bizID == 0 {
+		return errf.New(errf.InvalidParameter, "bizID is 0")
+	}
+	if appID == 0 {
+		return errf.New(errf.InvalidParameter, "appID is 0")
+	}
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	m := tx.ReleasedGroup
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.GroupID.In(groupIDs...),
+		m.PinnedReleaseID.Eq(0)).Delete()
+	return err
+}
+
+// GetByGroupID get the released group of the app by group id.
+func (dao *releasedGroupDao) GetByGroupID(kit *kit.Kit, bizID, appID, groupID uint32) (*table.ReleasedGroup, error) {
+	if bizID == 0 {
+		return nil, errf.New(errf.InvalidParameter, "bizID is 0")
+	}
+	if appID == 0 {
+		return nil, errf.New(errf.InvalidParameter, "appID is 0")
+	}
+
+	m := dao.genQ.ReleasedGroup
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.GroupID.Eq(groupID)).Take()
+}
+
+// UpdatePinWithTx pin the released group to the release with transaction, pinnedReleaseID 0 means unpin.
+func (dao *releasedGroupDao) UpdatePinWithTx(kit *kit.Kit, tx *gen.QueryTx, rg *table.ReleasedGroup) error {
+	if rg == nil {
+		return errf.New(errf.InvalidParameter, "released group is nil")
+	}
+	if err := rg.ValidateUpdate(); err != nil {
+		return errf.New(errf.InvalidParameter, err.Error())
+	}
+
+	m := tx.ReleasedGroup
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(rg.ID), m.BizID.Eq(rg.BizID)).
+		Select(m.PinnedReleaseID, m.PinnedUntil, m.PinMemo, m.Reviser).
+		Updates(&table.ReleasedGroup{
+			PinnedReleaseID: rg.PinnedReleaseID,
+			PinnedUntil:     rg.PinnedUntil,
+			PinMemo:         rg.PinMemo,
+			Reviser:         kit.User,
+		})
+	return err
+}
+
+// ListExpiredPins list the released groups whose pin is expired before the time.
+func (dao *releasedGroupDao) ListExpiredPins(kit *kit.Kit, before time.Time, limit int) (
+	[]*table.ReleasedGroup, error) {
+	m := dao.genQ.ReleasedGroup
+
+	return m.WithContext(kit.Ctx).
+		Where(m.PinnedReleaseID.Neq(0), m.PinnedUntil.IsNotNull(), m.PinnedUntil.Lte(before)).
+		Order(m.PinnedUntil, m.ID).
+		Limit(limit).
+		Find()
+}
+
+// ClearExpiredPinWithTx clear the pin of the released group if it is still expired before the time,
+// returns whether the pin is cleared.
+func (dao *releasedGroupDao) ClearExpiredPinWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32,
+	before time.Time) (bool, error) {
+	if bizID == 0 {
+		return false, errf.New(errf.InvalidParameter, "bizID is 0")
+	}
+
+	m := tx.ReleasedGroup
+	result, err := m.WithContext(kit.Ctx).
+		Where(m.ID.Eq(id), m.BizID.Eq(bizID), m.PinnedReleaseID.Neq(0), m.PinnedUntil.IsNotNull(),
+			m.PinnedUntil.Lte(before)).
+		Select(m.PinnedReleaseID, m.PinnedUntil, m.PinMemo, m.Reviser).
+		Updates(&table.ReleasedGroup{Reviser: kit.User})
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected != 0, nil
+}
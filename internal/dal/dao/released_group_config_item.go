@@ -0,0 +1,125 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// ReleasedGroupCI supplies all the released group config item related operations.
+type ReleasedGroupCI interface {
+	// BulkCreateWithTx bulk create released group config items with transaction.
+	BulkCreateWithTx(kit *kit.Kit, tx *gen.QueryTx, items []*table.ReleasedGroupConfigItem) error
+	// ListAllByReleaseIDs list all the released group config items by release ids.
+	ListAllByReleaseIDs(kit *kit.Kit, releaseIDs []uint32, bizID uint32) ([]*table.ReleasedGroupConfigItem, error)
+	// ListAllCISigns lists all released group config item signatures of one biz.
+	ListAllCISigns(kit *kit.Kit, bizID uint32) ([]string, error)
+	// BatchDeleteByAppIDWithTx batch delete by app id with transaction.
+	BatchDeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, bizID uint32) error
+	// BatchDeleteByReleaseIDWithTx batch delete by release id with transaction.
+	BatchDeleteByReleaseIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) error
+}
+
+var _ ReleasedGroupCI = new(releasedGroupCIDao)
+
+type releasedGroupCIDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// BulkCreateWithTx bulk create released group config items with transaction.
+func (dao *releasedGroupCIDao) BulkCreateWithTx(kit *kit.Kit, tx *gen.QueryTx,
+	items []*table.ReleasedGroupConfigItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	for _, item := range items {
+		if err := item.ValidateCreate(); err != nil {
+			return err
+		}
+	}
+
+	ids, err := dao.idGen.Batch(kit, table.ReleasedGroupConfigItemsTable, len(items))
+	if err != nil {
+		return err
+	}
+	for idx, item := range items {
+		item.ID = ids[idx]
+	}
+
+	if err := tx.ReleasedGroupConfigItem.WithContext(kit.Ctx).CreateInBatches(items, 100); err != nil {
+		return fmt.Errorf("create released group config items in batch failed, err: %v", err)
+	}
+
+	return nil
+}
+
+// ListAllByReleaseIDs list all the released group config items by release ids.
+func (dao *releasedGroupCIDao) ListAllByReleaseIDs(kit *kit.Kit, releaseIDs []uint32, bizID uint32) (
+	[]*table.ReleasedGroupConfigItem, error) {
+	if bizID == 0 {
+		return nil, errf.New(errf.InvalidParameter, "biz_id can not be 0")
+	}
+
+	m := dao.genQ.ReleasedGroupConfigItem
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ReleaseID.In(releaseIDs...)).Find()
+}
+
+// ListAllCISigns lists all released group config item signatures of one biz.
+func (dao *releasedGroupCIDao) ListAllCISigns(kit *kit.Kit, bizID uint32) ([]string, error) {
+	m := dao.genQ.ReleasedGroupConfigItem
+	var signs []string
+	if err := m.WithContext(kit.Ctx).Select(m.Signature.Distinct()).
+		Where(m.BizID.Eq(bizID)).
+		Pluck(m.Signature, &signs); err != nil {
+		return nil, err
+	}
+
+	return signs, nil
+}
+
+// BatchDeleteByAppIDWithTx batch delete by app id with transaction.
+func (dao *releasedGroupCIDao) BatchDeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, bizID uint32) error {
+	if bizID == 0 {
+		return errf.New(errf.InvalidParameter, "bizID is 0")
+	}
+	if appID == 0 {
+		return errf.New(errf.InvalidParameter, "appID is 0")
+	}
+
+	m := tx.ReleasedGroupConfigItem
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Delete()
+	return err
+}
+
+// BatchDeleteByReleaseIDWithTx batch delete by release id with transaction.
+func (dao *releasedGroupCIDao) BatchDeleteByReleaseIDWithTx(kit *kit.Kit, tx *gen.QueryTx,
+	bizID, appID, releaseID uint32) error {
+	if bizID == 0 {
+		return errf.New(errf.InvalidParameter, "bizID is 0")
+	}
+	if releaseID == 0 {
+		return errf.New(errf.InvalidParameter, "releaseID is 0")
+	}
+
+	m := tx.ReleasedGroupConfigItem
+	_, err := m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ReleaseID.Eq(releaseID)).Delete()
+	return err
+}
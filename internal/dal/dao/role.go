@@ -0,0 +1,248 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// Role supplies all the role related operations of the built-in rbac policy engine.
+type Role interface {
+	// Create one role instance.
+	Create(kit *kit.Kit, role *table.Role) (uint32, error)
+	// Update one role instance.
+	Update(kit *kit.Kit, role *table.Role) error
+	// Delete one role instance and all of its bindings.
+	Delete(kit *kit.Kit, role *table.Role) error
+	// Get role by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.Role, error)
+	// GetByName get role by name.
+	GetByName(kit *kit.Kit, bizID uint32, name string) (*table.Role, error)
+	// List roles of the biz with options.
+	List(kit *kit.Kit, bizID uint32, opt *types.BasePage) ([]*table.Role, int64, error)
+	// ListByIDs list roles by ids.
+	ListByIDs(kit *kit.Kit, ids []uint32) ([]*table.Role, error)
+}
+
+var _ Role = new(roleDao)
+
+type roleDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one role instance.
+func (dao *roleDao) Create(kit *kit.Kit, role *table.Role) (uint32, error) {
+	if role == nil {
+		return 0, errors.New("role is nil")
+	}
+
+	if err := role.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.RolesTable)
+	if err != nil {
+		return 0, err
+	}
+	role.ID = id
+
+	if err := dao.genQ.Role.WithContext(kit.Ctx).Create(role); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Update one role instance.
+func (dao *roleDao) Update(kit *kit.Kit, role *table.Role) error {
+	if role == nil {
+		return errors.New("role is nil")
+	}
+
+	if err := role.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	m := dao.genQ.Role
+	_, err := m.WithContext(kit.Ctx).
+		Select(m.Name, m.Permissions, m.Memo, m.Reviser, m.UpdatedAt).
+		Where(m.BizID.Eq(role.Attachment.BizID), m.ID.Eq(role.ID)).
+		Updates(role)
+
+	return err
+}
+
+// Delete one role instance and all of its bindings.
+func (dao *roleDao) Delete(kit *kit.Kit, role *table.Role) error {
+	if role == nil {
+		return errors.New("role is nil")
+	}
+
+	if err := role.ValidateDelete(); err != nil {
+		return err
+	}
+
+	return dao.genQ.Transaction(func(tx *gen.Query) error {
+		b := tx.RoleBinding
+		if _, err := b.WithContext(kit.Ctx).
+			Where(b.BizID.Eq(role.Attachment.BizID), b.RoleID.Eq(role.ID)).Delete(); err != nil {
+			return err
+		}
+
+		m := tx.Role
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(role.Attachment.BizID), m.ID.Eq(role.ID)).Delete()
+		return err
+	})
+}
+
+// Get role by id.
+func (dao *roleDao) Get(kit *kit.Kit, bizID, id uint32) (*table.Role, error) {
+	m := dao.genQ.Role
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// GetByName get role by name.
+func (dao *roleDao) GetByName(kit *kit.Kit, bizID uint32, name string) (*table.Role, error) {
+	m := dao.genQ.Role
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Name.Eq(name)).Take()
+}
+
+// List roles of the biz with options.
+func (dao *roleDao) List(kit *kit.Kit, bizID uint32, opt *types.BasePage) ([]*table.Role, int64, error) {
+	m := dao.genQ.Role
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Order(m.ID.Desc())
+
+	if opt.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// ListByIDs list roles by ids.
+func (dao *roleDao) ListByIDs(kit *kit.Kit, ids []uint32) ([]*table.Role, error) {
+	if len(ids) == 0 {
+		return []*table.Role{}, nil
+	}
+
+	m := dao.genQ.Role
+
+	return m.WithContext(kit.Ctx).Where(m.ID.In(ids...)).Find()
+}
+
+// RoleBinding supplies all the role binding related operations of the built-in rbac policy engine.
+type RoleBinding interface {
+	// Create one role binding instance.
+	Create(kit *kit.Kit, binding *table.RoleBinding) (uint32, error)
+	// Delete one role binding instance.
+	Delete(kit *kit.Kit, binding *table.RoleBinding) error
+	// List role bindings of the biz, filtered by role id and subject if they are set.
+	List(kit *kit.Kit, bizID, roleID uint32, subject string, opt *types.BasePage) ([]*table.RoleBinding, int64, error)
+	// ListBySubject list the role bindings of the subject in the bizs.
+	ListBySubject(kit *kit.Kit, subject string, bizIDs []uint32) ([]*table.RoleBinding, error)
+}
+
+var _ RoleBinding = new(roleBindingDao)
+
+type roleBindingDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one role binding instance.
+func (dao *roleBindingDao) Create(kit *kit.Kit, binding *table.RoleBinding) (uint32, error) {
+	if binding == nil {
+		return 0, errors.New("role binding is nil")
+	}
+
+	if err := binding.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.RoleBindingsTable)
+	if err != nil {
+		return 0, err
+	}
+	binding.ID = id
+
+	if err := dao.genQ.RoleBinding.WithContext(kit.Ctx).Create(binding); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Delete one role binding instance.
+func (dao *roleBindingDao) Delete(kit *kit.Kit, binding *table.RoleBinding) error {
+	if binding == nil {
+		return errors.New("role binding is nil")
+	}
+
+	if err := binding.ValidateDelete(); err != nil {
+		return err
+	}
+
+	m := dao.genQ.RoleBinding
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(binding.Attachment.BizID), m.ID.Eq(binding.ID)).Delete()
+
+	return err
+}
+
+// List role bindings of the biz, filtered by role id and subject if they are set.
+func (dao *roleBindingDao) List(kit *kit.Kit, bizID, roleID uint32, subject string, opt *types.BasePage) (
+	[]*table.RoleBinding, int64, error) {
+	m := dao.genQ.RoleBinding
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Order(m.ID.Desc())
+	if roleID > 0 {
+		q = q.Where(m.RoleID.Eq(roleID))
+	}
+	if subject != "" {
+		q = q.Where(m.Subject.Eq(subject))
+	}
+
+	if opt.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// ListBySubject list the role bindings of the subject in the bizs.
+func (dao *roleBindingDao) ListBySubject(kit *kit.Kit, subject string, bizIDs []uint32) ([]*table.RoleBinding,
+	error) {
+	if len(bizIDs) == 0 {
+		return []*table.RoleBinding{}, nil
+	}
+
+	m := dao.genQ.RoleBinding
+
+	return m.WithContext(kit.Ctx).Where(m.Subject.Eq(subject), m.BizID.In(bizIDs...)).Find()
+}
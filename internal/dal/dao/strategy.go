@@ -13,6 +13,8 @@
 package dao
 
 import (
+	"gorm.io/gorm/clause"
+
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
@@ -23,12 +25,17 @@ import (
 type Strategy interface {
 	// Get last strategy.
 	GetLast(kit *kit.Kit, bizID, appID, releasedID, strategyID uint32) (*table.Strategy, error)
+	// GetForUpdateWithTx get the strategy and lock it until the transaction ends.
+	GetForUpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, strategyID uint32) (*table.Strategy, error)
 	// GetStrategyByIDs Get strategy by ids.
 	GetStrategyByIDs(kit *kit.Kit, strategyIDs []uint32) ([]*table.Strategy, error)
 	// ListStrategyByItsm list strategy by itsm.
 	ListStrategyByItsm(kit *kit.Kit) ([]*table.Strategy, error)
 	// ListStrategyByReleasesIDs list strategy by ReleasesID.
 	ListStrategyByReleasesIDs(kit *kit.Kit, releasesIDs []uint32) ([]*table.Strategy, error)
+	// ListPublishedBefore list the published strategies of the app whose id is less than beforeID,
+	// ordered by id desc.
+	ListPublishedBefore(kit *kit.Kit, bizID, appID, beforeID uint32, limit int) ([]*table.Strategy, error)
 	// UpdateByID update strategy kv by id.
 	UpdateByID(kit *kit.Kit, tx *gen.QueryTx, strategyID uint32, m map[string]interface{}) error
 	// UpdateByIDs update strategy kv by ids
@@ -58,6 +65,15 @@ func (dao *strategyDao) GetLast(kit *kit.Kit, bizID, appID, releasedID, strategy
 	return temp.Last()
 }
 
+// GetForUpdateWithTx get the strategy and lock it until the transaction ends.
+func (dao *strategyDao) GetForUpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, strategyID uint32) (
+	*table.Strategy, error) {
+	m := tx.Strategy
+
+	return m.WithContext(kit.Ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(strategyID)).Take()
+}
+
 // GetStrategyByIDs Get strategy by ids.
 func (dao *strategyDao) GetStrategyByIDs(kit *kit.Kit, strategyIDs []uint32) ([]*table.Strategy, error) {
 	m := dao.genQ.Strategy
@@ -101,6 +117,20 @@ func (dao *strategyDao) ListStrategyByReleasesIDs(kit *kit.Kit, releasesIDs []ui
 	return m.WithContext(kit.Ctx).Where(m.ID.In(ids...)).Find()
 }
 
+// ListPublishedBefore list the published strategies of the app whose id is less than beforeID,
+// ordered by id desc.
+func (dao *strategyDao) ListPublishedBefore(kit *kit.Kit, bizID, appID, beforeID uint32, limit int) (
+	[]*table.Strategy, error) {
+	m := dao.genQ.Strategy
+	// 早期版本的上线策略没有上线状态，均为立即上线
+	return m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Lt(beforeID),
+			m.PublishStatus.In(string(table.AlreadyPublish), "")).
+		Order(m.ID.Desc()).
+		Limit(limit).
+		Find()
+}
+
 // UpdateByID update strategy kv by id
 func (dao *strategyDao) UpdateByID(kit *kit.Kit, tx *gen.QueryTx, strategyID uint32, m map[string]interface{}) error {
 	s := tx.Strategy
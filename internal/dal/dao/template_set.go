@@ -18,12 +18,12 @@ import (
 	"path"
 	"strings"
 
-	"gorm.io/datatypes"
 	rawgen "gorm.io/gen"
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
 	"github.com/TencentBlueKing/bk-bscp/internal/search"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
@@ -587,8 +587,8 @@ func (dao *templateSetDao) AddTmplsToTmplSetsWithTx(kit *kit.Kit, tx *gen.QueryT
 	q := tx.TemplateSet.WithContext(kit.Ctx)
 	for _, tmplID := range tmplIDs {
 		if _, err := q.Where(m.ID.In(tmplSetIDs...)).
-			Not(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(tmplID))...).
-			Update(m.TemplateIDs, gorm.Expr("JSON_ARRAY_APPEND(template_ids, '$', ?)", tmplID)); err != nil {
+			Not(utils.JSONArrayContains("template_ids", tmplID)).
+			Update(m.TemplateIDs, utils.JSONArrayAppend("template_ids", tmplID)); err != nil {
 			return err
 		}
 	}
@@ -601,12 +601,9 @@ func (dao *templateSetDao) DeleteTmplsFromTmplSetsWithTx(kit *kit.Kit, tx *gen.Q
 	m := tx.TemplateSet
 	q := tx.TemplateSet.WithContext(kit.Ctx)
 	for _, tmplID := range tmplIDs {
-		// subQuery get the array of template ids after delete the target template id, set it to '[]' if no records found
-		subQuery := "COALESCE ((SELECT JSON_ARRAYAGG(oid) new_oids FROM " +
-			"JSON_TABLE (template_ids, '$[*]' COLUMNS (oid BIGINT (1) UNSIGNED PATH '$')) AS t1 WHERE oid<> ?), '[]')"
 		if _, err := q.Where(m.ID.In(tmplSetIDs...)).
-			Where(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(tmplID))...).
-			Update(m.TemplateIDs, gorm.Expr(subQuery, tmplID)); err != nil {
+			Where(utils.JSONArrayContains("template_ids", tmplID)).
+			Update(m.TemplateIDs, utils.JSONArrayRemove("template_ids", tmplID)); err != nil {
 			return err
 		}
 	}
@@ -619,8 +616,8 @@ func (dao *templateSetDao) AddTmplToTmplSetsWithTx(
 	m := tx.TemplateSet
 	q := tx.TemplateSet.WithContext(kit.Ctx)
 	if _, err := q.Where(m.ID.In(tmplSetIDs...)).
-		Not(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(tmplID))...).
-		Update(m.TemplateIDs, gorm.Expr("JSON_ARRAY_APPEND(template_ids, '$', ?)", tmplID)); err != nil {
+		Not(utils.JSONArrayContains("template_ids", tmplID)).
+		Update(m.TemplateIDs, utils.JSONArrayAppend("template_ids", tmplID)); err != nil {
 		return err
 	}
 	return nil
@@ -630,12 +627,9 @@ func (dao *templateSetDao) AddTmplToTmplSetsWithTx(
 func (dao *templateSetDao) DeleteTmplFromAllTmplSetsWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, tmplID uint32) error {
 	m := tx.TemplateSet
 	q := tx.TemplateSet.WithContext(kit.Ctx)
-	// subQuery get the array of template ids after delete the target template id, set it to '[]' if no records found
-	subQuery := "COALESCE ((SELECT JSON_ARRAYAGG(oid) new_oids FROM " +
-		"JSON_TABLE (template_ids, '$[*]' COLUMNS (oid BIGINT (1) UNSIGNED PATH '$')) AS t1 WHERE oid<> ?), '[]')"
 	if _, err := q.Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(tmplID))...).
-		Update(m.TemplateIDs, gorm.Expr(subQuery, tmplID)); err != nil {
+		Where(utils.JSONArrayContains("template_ids", tmplID)).
+		Update(m.TemplateIDs, utils.JSONArrayRemove("template_ids", tmplID)); err != nil {
 		return err
 	}
 	return nil
@@ -648,7 +642,7 @@ func (dao *templateSetDao) ListAppTmplSets(kit *kit.Kit, bizID, appID uint32) ([
 
 	return q.Where(m.BizID.Eq(bizID)).
 		Where(m.Public.Is(true)).
-		Or(rawgen.Cond(datatypes.JSONArrayQuery("bound_apps").Contains(appID))...).
+		Or(utils.JSONArrayContains("bound_apps", appID)).
 		Order(m.Name).
 		Find()
 }
@@ -687,7 +681,7 @@ func (dao *templateSetDao) ListAllTmplSetsOfBiz(kit *kit.Kit, bizID, appID uint3
 	// if appID > 0 , return the app's all visible template sets
 	if appID > 0 {
 		q = q.Where(m.Public.Is(true)).
-			Or(rawgen.Cond(datatypes.JSONArrayQuery("bound_apps").Contains(appID))...)
+			Or(utils.JSONArrayContains("bound_apps", appID))
 		return q.Where(m.BizID.Eq(bizID)).Find()
 	}
 	return q.Find()
@@ -305,6 +305,12 @@ func (dao *templateSpaceDao) Delete(kit *kit.Kit, g *table.TemplateSpace) error
 		if _, err := q.Where(m.BizID.Eq(g.Attachment.BizID)).Delete(g); err != nil {
 			return err
 		}
+		// the hooks imported into the template space are removed with it
+		tsh := tx.TemplateSpaceHook
+		if _, err := tsh.WithContext(kit.Ctx).Where(tsh.BizID.Eq(g.Attachment.BizID),
+			tsh.TemplateSpaceID.Eq(g.ID)).Delete(); err != nil {
+			return err
+		}
 
 		if err := ad.Do(tx); err != nil {
 			return err
@@ -0,0 +1,196 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"fmt"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// TemplateSpaceHook supplies all the template space hook related operations.
+type TemplateSpaceHook interface {
+	// BatchCreate import the hooks into the template space, the imported hooks are ignored.
+	BatchCreate(kit *kit.Kit, bizID, templateSpaceID uint32, hookIDs []uint32) error
+	// List the hooks imported into the template space.
+	List(kit *kit.Kit, bizID, templateSpaceID uint32) ([]*table.TemplateSpaceHook, error)
+	// ListTemplateSpaceIDs list the template spaces which the hook is imported into.
+	ListTemplateSpaceIDs(kit *kit.Kit, bizID, hookID uint32) ([]uint32, error)
+	// Delete remove the hook from the template space.
+	Delete(kit *kit.Kit, bizID, templateSpaceID, hookID uint32) error
+	// DeleteByHookIDWithTx remove the hook from all the template spaces with transaction.
+	DeleteByHookIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, hookID uint32) error
+}
+
+var _ TemplateSpaceHook = new(templateSpaceHookDao)
+
+type templateSpaceHookDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// BatchCreate import the hooks into the template space, the imported hooks are ignored.
+func (dao *templateSpaceHookDao) BatchCreate(kit *kit.Kit, bizID, templateSpaceID uint32, hookIDs []uint32) error {
+	if len(hookIDs) == 0 {
+		return nil
+	}
+
+	ts := dao.genQ.TemplateSpace
+	space, err := ts.WithContext(kit.Ctx).Where(ts.BizID.Eq(bizID), ts.ID.Eq(templateSpaceID)).Take()
+	if err != nil {
+		return errf.Errorf(errf.InvalidParameter, "get template space %d failed, err: %v", templateSpaceID, err)
+	}
+
+	h := dao.genQ.Hook
+	hooks, err := h.WithContext(kit.Ctx).Where(h.BizID.Eq(bizID), h.ID.In(hookIDs...)).Find()
+	if err != nil {
+		return err
+	}
+	if len(hooks) != len(hookIDs) {
+		return errf.Errorf(errf.InvalidParameter, "some hooks of %v do not exist in biz %d", hookIDs, bizID)
+	}
+
+	m := dao.genQ.TemplateSpaceHook
+	existing, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.TemplateSpaceID.Eq(templateSpaceID),
+		m.HookID.In(hookIDs...)).Find()
+	if err != nil {
+		return err
+	}
+	imported := make(map[uint32]bool, len(existing))
+	for _, one := range existing {
+		imported[one.Attachment.HookID] = true
+	}
+
+	toCreate := make([]*table.TemplateSpaceHook, 0, len(hooks))
+	for _, hook := range hooks {
+		if imported[hook.ID] {
+			continue
+		}
+		one := &table.TemplateSpaceHook{
+			Attachment: &table.TemplateSpaceHookAttachment{
+				BizID:           bizID,
+				TemplateSpaceID: templateSpaceID,
+				HookID:          hook.ID,
+			},
+			Revision: &table.CreatedRevision{Creator: kit.User},
+		}
+		if err = one.ValidateCreate(); err != nil {
+			return err
+		}
+		toCreate = append(toCreate, one)
+	}
+	if len(toCreate) == 0 {
+		return nil
+	}
+
+	ids, err := dao.idGen.Batch(kit, table.TemplateSpaceHooksTable, len(toCreate))
+	if err != nil {
+		return err
+	}
+	names := make(map[uint32]string, len(hooks))
+	for _, hook := range hooks {
+		names[hook.ID] = hook.Spec.Name
+	}
+
+	createTx := func(tx *gen.Query) error {
+		for i, one := range toCreate {
+			one.ID = ids[i]
+			ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+				ResourceInstance: fmt.Sprintf(constant.TemplateSpaceName+constant.ResSeparator+constant.HookName,
+					space.Spec.Name, names[one.Attachment.HookID]),
+				Status: enumor.Success,
+			}).PrepareCreate(one)
+			if err := tx.TemplateSpaceHook.WithContext(kit.Ctx).
+				Clauses(clause.OnConflict{DoNothing: true}).Create(one); err != nil {
+				return err
+			}
+			if err := ad.Do(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return dao.genQ.Transaction(createTx)
+}
+
+// List the hooks imported into the template space.
+func (dao *templateSpaceHookDao) List(kit *kit.Kit, bizID, templateSpaceID uint32) (
+	[]*table.TemplateSpaceHook, error) {
+	m := dao.genQ.TemplateSpaceHook
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.TemplateSpaceID.Eq(templateSpaceID)).
+		Order(m.ID).Find()
+}
+
+// ListTemplateSpaceIDs list the template spaces which the hook is imported into.
+func (dao *templateSpaceHookDao) ListTemplateSpaceIDs(kit *kit.Kit, bizID, hookID uint32) ([]uint32, error) {
+	m := dao.genQ.TemplateSpaceHook
+	var ids []uint32
+	if err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.HookID.Eq(hookID)).
+		Pluck(m.TemplateSpaceID, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Delete remove the hook from the template space.
+func (dao *templateSpaceHookDao) Delete(kit *kit.Kit, bizID, templateSpaceID, hookID uint32) error {
+	m := dao.genQ.TemplateSpaceHook
+	one, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.TemplateSpaceID.Eq(templateSpaceID),
+		m.HookID.Eq(hookID)).Take()
+	if err != nil {
+		return errf.Errorf(errf.InvalidParameter, "hook %d is not imported into template space %d, err: %v",
+			hookID, templateSpaceID, err)
+	}
+
+	ts := dao.genQ.TemplateSpace
+	space, err := ts.WithContext(kit.Ctx).Where(ts.BizID.Eq(bizID), ts.ID.Eq(templateSpaceID)).Take()
+	if err != nil {
+		return err
+	}
+	h := dao.genQ.Hook
+	hook, err := h.WithContext(kit.Ctx).Where(h.BizID.Eq(bizID), h.ID.Eq(hookID)).Take()
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.TemplateSpaceName+constant.ResSeparator+constant.HookName,
+			space.Spec.Name, hook.Spec.Name),
+		Status: enumor.Success,
+	}).PrepareDelete(one)
+
+	deleteTx := func(tx *gen.Query) error {
+		if _, err := tx.TemplateSpaceHook.WithContext(kit.Ctx).Where(m.ID.Eq(one.ID)).Delete(); err != nil {
+			return err
+		}
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// DeleteByHookIDWithTx remove the hook from all the template spaces with transaction.
+func (dao *templateSpaceHookDao) DeleteByHookIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, hookID uint32) error {
+	m := tx.TemplateSpaceHook
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.HookID.Eq(hookID)).Delete()
+	return err
+}
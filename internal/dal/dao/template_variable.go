@@ -44,6 +44,8 @@ type TemplateVariable interface {
 	BatchUpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, tmplVars []*table.TemplateVariable) error
 	// GetByUniqueKey get template variable by unique key.
 	GetByUniqueKey(kit *kit.Kit, bizID uint32, name string) (*table.TemplateVariable, error)
+	// GetByID get template variable by id.
+	GetByID(kit *kit.Kit, bizID, id uint32) (*table.TemplateVariable, error)
 	// FetchIDsExcluding 获取指定ID后排除的ID
 	FetchIDsExcluding(kit *kit.Kit, bizID uint32, ids []uint32) ([]uint32, error)
 }
@@ -132,7 +134,7 @@ func (dao *templateVariableDao) Update(kit *kit.Kit, g *table.TemplateVariable)
 	updateTx := func(tx *gen.Query) error {
 		q = tx.TemplateVariable.WithContext(kit.Ctx)
 		if _, err := q.Where(m.BizID.Eq(g.Attachment.BizID), m.ID.Eq(g.ID)).
-			Select(m.DefaultVal, m.Memo, m.Reviser).
+			Select(m.DefaultVal, m.Memo, m.Constraints, m.Reviser).
 			Updates(g); err != nil {
 			return err
 		}
@@ -273,3 +275,10 @@ func (dao *templateVariableDao) GetByUniqueKey(kit *kit.Kit, bizID uint32, name
 	q := dao.genQ.TemplateVariable.WithContext(kit.Ctx)
 	return q.Where(m.BizID.Eq(bizID), m.Name.Eq(name)).Take()
 }
+
+// GetByID get template variable by id
+func (dao *templateVariableDao) GetByID(kit *kit.Kit, bizID, id uint32) (*table.TemplateVariable, error) {
+	m := dao.genQ.TemplateVariable
+	q := dao.genQ.TemplateVariable.WithContext(kit.Ctx)
+	return q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
@@ -0,0 +1,277 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// Webhook supplies all the webhook related operations.
+type Webhook interface {
+	// Create one webhook instance.
+	Create(kit *kit.Kit, webhook *table.Webhook) (uint32, error)
+	// Update the spec of one webhook instance.
+	Update(kit *kit.Kit, webhook *table.Webhook) error
+	// Delete one webhook instance and its deliveries.
+	Delete(kit *kit.Kit, bizID, id uint32) error
+	// Get webhook by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.Webhook, error)
+	// List webhooks of the biz, only the webhooks of the app are listed if app id is set.
+	List(kit *kit.Kit, bizID, appID uint32) ([]*table.Webhook, error)
+	// ListEnabled list the enabled webhooks of the biz.
+	ListEnabled(kit *kit.Kit, bizID uint32) ([]*table.Webhook, error)
+}
+
+var _ Webhook = new(webhookDao)
+
+type webhookDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one webhook instance.
+func (dao *webhookDao) Create(kit *kit.Kit, webhook *table.Webhook) (uint32, error) {
+	if webhook == nil {
+		return 0, errors.New("webhook is nil")
+	}
+
+	if err := webhook.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.WebhooksTable)
+	if err != nil {
+		return 0, err
+	}
+	webhook.ID = id
+
+	ad := dao.auditDao.Decorator(kit, webhook.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.WebhookName, webhook.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           webhook.Spec.Memo,
+		AppId:            webhook.Attachment.AppID,
+	}).PrepareCreate(webhook)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.Webhook.WithContext(kit.Ctx).Create(webhook); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Update the spec of one webhook instance.
+func (dao *webhookDao) Update(kit *kit.Kit, webhook *table.Webhook) error {
+	if webhook == nil {
+		return errors.New("webhook is nil")
+	}
+
+	if err := webhook.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, webhook.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.WebhookName, webhook.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           webhook.Spec.Memo,
+		AppId:            webhook.Attachment.AppID,
+	}).PrepareUpdate(webhook)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.Webhook
+		// enabled is selected explicitly, so that the webhook can be disabled with false value
+		if _, err := m.WithContext(kit.Ctx).
+			Select(m.Name, m.URL, m.EventTypes, m.Enabled, m.Memo, m.Reviser, m.UpdatedAt).
+			Where(m.BizID.Eq(webhook.Attachment.BizID), m.ID.Eq(webhook.ID)).
+			Updates(webhook); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Delete one webhook instance and its deliveries.
+func (dao *webhookDao) Delete(kit *kit.Kit, bizID, id uint32) error {
+	webhook, err := dao.Get(kit, bizID, id)
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.WebhookName, webhook.Spec.Name),
+		Status:           enumor.Success,
+		AppId:            webhook.Attachment.AppID,
+	}).PrepareDelete(webhook)
+
+	deleteTx := func(tx *gen.Query) error {
+		m := tx.Webhook
+		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+
+		d := tx.WebhookDelivery
+		if _, err := d.WithContext(kit.Ctx).Where(d.BizID.Eq(bizID), d.WebhookID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get webhook by id.
+func (dao *webhookDao) Get(kit *kit.Kit, bizID, id uint32) (*table.Webhook, error) {
+	m := dao.genQ.Webhook
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// List webhooks of the biz, only the webhooks of the app are listed if app id is set.
+func (dao *webhookDao) List(kit *kit.Kit, bizID, appID uint32) ([]*table.Webhook, error) {
+	m := dao.genQ.Webhook
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID))
+	if appID > 0 {
+		q = q.Where(m.AppID.Eq(appID))
+	}
+
+	return q.Order(m.ID.Desc()).Find()
+}
+
+// ListEnabled list the enabled webhooks of the biz.
+func (dao *webhookDao) ListEnabled(kit *kit.Kit, bizID uint32) ([]*table.Webhook, error) {
+	m := dao.genQ.Webhook
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Enabled.Is(true)).Order(m.ID).Find()
+}
+
+// WebhookDelivery supplies all the webhook delivery related operations.
+type WebhookDelivery interface {
+	// BatchCreate create the webhook deliveries which are pending to be sent.
+	BatchCreate(kit *kit.Kit, deliveries []*table.WebhookDelivery) error
+	// Get webhook delivery by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.WebhookDelivery, error)
+	// List the deliveries of the webhook with options.
+	List(kit *kit.Kit, bizID, webhookID uint32, status string, opt *types.BasePage) (
+		[]*table.WebhookDelivery, int64, error)
+	// ListDue list the pending deliveries which are due to be sent before the time.
+	ListDue(kit *kit.Kit, before time.Time, limit int) ([]*table.WebhookDelivery, error)
+	// UpdateStatus update the status of the delivery after an attempt.
+	UpdateStatus(kit *kit.Kit, bizID, id uint32, status *table.WebhookDeliveryStatusInfo) error
+}
+
+var _ WebhookDelivery = new(webhookDeliveryDao)
+
+type webhookDeliveryDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// BatchCreate create the webhook deliveries which are pending to be sent.
+func (dao *webhookDeliveryDao) BatchCreate(kit *kit.Kit, deliveries []*table.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	ids, err := dao.idGen.Batch(kit, table.WebhookDeliveriesTable, len(deliveries))
+	if err != nil {
+		return err
+	}
+	for i, one := range deliveries {
+		one.ID = ids[i]
+	}
+
+	return dao.genQ.WebhookDelivery.WithContext(kit.Ctx).CreateInBatches(deliveries, 100)
+}
+
+// Get webhook delivery by id.
+func (dao *webhookDeliveryDao) Get(kit *kit.Kit, bizID, id uint32) (*table.WebhookDelivery, error) {
+	m := dao.genQ.WebhookDelivery
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// List the deliveries of the webhook with options.
+func (dao *webhookDeliveryDao) List(kit *kit.Kit, bizID, webhookID uint32, status string, opt *types.BasePage) (
+	[]*table.WebhookDelivery, int64, error) {
+	m := dao.genQ.WebhookDelivery
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.WebhookID.Eq(webhookID))
+	if status != "" {
+		q = q.Where(m.Status.Eq(status))
+	}
+
+	q = q.Order(m.ID.Desc())
+	if opt.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// ListDue list the pending deliveries which are due to be sent before the time.
+func (dao *webhookDeliveryDao) ListDue(kit *kit.Kit, before time.Time, limit int) ([]*table.WebhookDelivery, error) {
+	m := dao.genQ.WebhookDelivery
+
+	return m.WithContext(kit.Ctx).
+		Where(m.Status.Eq(string(table.WebhookDeliveryPending)), m.NextAttemptAt.Lte(before)).
+		Order(m.NextAttemptAt, m.ID).
+		Limit(limit).
+		Find()
+}
+
+// UpdateStatus update the status of the delivery after an attempt.
+func (dao *webhookDeliveryDao) UpdateStatus(kit *kit.Kit, bizID, id uint32,
+	status *table.WebhookDeliveryStatusInfo) error {
+	if status == nil {
+		return errors.New("webhook delivery status is nil")
+	}
+
+	if len(status.ResponseBody) > table.WebhookMaxResponseLength {
+		status.ResponseBody = status.ResponseBody[:table.WebhookMaxResponseLength]
+	}
+	if len(status.LastError) > table.WebhookMaxResponseLength {
+		status.LastError = status.LastError[:table.WebhookMaxResponseLength]
+	}
+
+	m := dao.genQ.WebhookDelivery
+	_, err := m.WithContext(kit.Ctx).
+		Select(m.Status, m.Attempts, m.NextAttemptAt, m.ResponseCode, m.ResponseBody, m.LastError, m.DeliveredAt,
+			m.UpdatedAt).
+		Where(m.BizID.Eq(bizID), m.ID.Eq(id)).
+		Updates(&table.WebhookDelivery{Status: status, Revision: &table.Revision{UpdatedAt: time.Now().UTC()}})
+
+	return err
+}
@@ -0,0 +1,436 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newApprovalAction(db *gorm.DB, opts ...gen.DOOption) approvalAction {
+	_approvalAction := approvalAction{}
+
+	_approvalAction.approvalActionDo.UseDB(db, opts...)
+	_approvalAction.approvalActionDo.UseModel(&table.ApprovalAction{})
+
+	tableName := _approvalAction.approvalActionDo.TableName()
+	_approvalAction.ALL = field.NewAsterisk(tableName)
+	_approvalAction.ID = field.NewUint32(tableName, "id")
+	_approvalAction.Stage = field.NewUint32(tableName, "stage")
+	_approvalAction.Action = field.NewString(tableName, "action")
+	_approvalAction.DelegateTo = field.NewString(tableName, "delegate_to")
+	_approvalAction.Comment = field.NewString(tableName, "comment")
+	_approvalAction.Voter = field.NewString(tableName, "voter")
+	_approvalAction.BizID = field.NewUint32(tableName, "biz_id")
+	_approvalAction.AppID = field.NewUint32(tableName, "app_id")
+	_approvalAction.TicketID = field.NewUint32(tableName, "ticket_id")
+	_approvalAction.TenantID = field.NewString(tableName, "tenant_id")
+	_approvalAction.Creator = field.NewString(tableName, "creator")
+	_approvalAction.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_approvalAction.fillFieldMap()
+
+	return _approvalAction
+}
+
+type approvalAction struct {
+	approvalActionDo approvalActionDo
+
+	ALL        field.Asterisk
+	ID         field.Uint32
+	Stage      field.Uint32
+	Action     field.String
+	DelegateTo field.String
+	Comment    field.String
+	Voter      field.String
+	BizID      field.Uint32
+	AppID      field.Uint32
+	TicketID   field.Uint32
+	TenantID   field.String
+	Creator    field.String
+	CreatedAt  field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (a approvalAction) Table(newTableName string) *approvalAction {
+	a.approvalActionDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a approvalAction) As(alias string) *approvalAction {
+	a.approvalActionDo.DO = *(a.approvalActionDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *approvalAction) updateTableName(table string) *approvalAction {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewUint32(table, "id")
+	a.Stage = field.NewUint32(table, "stage")
+	a.Action = field.NewString(table, "action")
+	a.DelegateTo = field.NewString(table, "delegate_to")
+	a.Comment = field.NewString(table, "comment")
+	a.Voter = field.NewString(table, "voter")
+	a.BizID = field.NewUint32(table, "biz_id")
+	a.AppID = field.NewUint32(table, "app_id")
+	a.TicketID = field.NewUint32(table, "ticket_id")
+	a.TenantID = field.NewString(table, "tenant_id")
+	a.Creator = field.NewString(table, "creator")
+	a.CreatedAt = field.NewTime(table, "created_at")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *approvalAction) WithContext(ctx context.Context) IApprovalActionDo {
+	return a.approvalActionDo.WithContext(ctx)
+}
+
+func (a approvalAction) TableName() string { return a.approvalActionDo.TableName() }
+
+func (a approvalAction) Alias() string { return a.approvalActionDo.Alias() }
+
+func (a approvalAction) Columns(cols ...field.Expr) gen.Columns {
+	return a.approvalActionDo.Columns(cols...)
+}
+
+func (a *approvalAction) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *approvalAction) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 12)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["stage"] = a.Stage
+	a.fieldMap["action"] = a.Action
+	a.fieldMap["delegate_to"] = a.DelegateTo
+	a.fieldMap["comment"] = a.Comment
+	a.fieldMap["voter"] = a.Voter
+	a.fieldMap["biz_id"] = a.BizID
+	a.fieldMap["app_id"] = a.AppID
+	a.fieldMap["ticket_id"] = a.TicketID
+	a.fieldMap["tenant_id"] = a.TenantID
+	a.fieldMap["creator"] = a.Creator
+	a.fieldMap["created_at"] = a.CreatedAt
+}
+
+func (a approvalAction) clone(db *gorm.DB) approvalAction {
+	a.approvalActionDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a approvalAction) replaceDB(db *gorm.DB) approvalAction {
+	a.approvalActionDo.ReplaceDB(db)
+	return a
+}
+
+type approvalActionDo struct{ gen.DO }
+
+type IApprovalActionDo interface {
+	gen.SubQuery
+	Debug() IApprovalActionDo
+	WithContext(ctx context.Context) IApprovalActionDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IApprovalActionDo
+	WriteDB() IApprovalActionDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IApprovalActionDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IApprovalActionDo
+	Not(conds ...gen.Condition) IApprovalActionDo
+	Or(conds ...gen.Condition) IApprovalActionDo
+	Select(conds ...field.Expr) IApprovalActionDo
+	Where(conds ...gen.Condition) IApprovalActionDo
+	Order(conds ...field.Expr) IApprovalActionDo
+	Distinct(cols ...field.Expr) IApprovalActionDo
+	Omit(cols ...field.Expr) IApprovalActionDo
+	Join(table schema.Tabler, on ...field.Expr) IApprovalActionDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IApprovalActionDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IApprovalActionDo
+	Group(cols ...field.Expr) IApprovalActionDo
+	Having(conds ...gen.Condition) IApprovalActionDo
+	Limit(limit int) IApprovalActionDo
+	Offset(offset int) IApprovalActionDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IApprovalActionDo
+	Unscoped() IApprovalActionDo
+	Create(values ...*table.ApprovalAction) error
+	CreateInBatches(values []*table.ApprovalAction, batchSize int) error
+	Save(values ...*table.ApprovalAction) error
+	First() (*table.ApprovalAction, error)
+	Take() (*table.ApprovalAction, error)
+	Last() (*table.ApprovalAction, error)
+	Find() ([]*table.ApprovalAction, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ApprovalAction, err error)
+	FindInBatches(result *[]*table.ApprovalAction, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ApprovalAction) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IApprovalActionDo
+	Assign(attrs ...field.AssignExpr) IApprovalActionDo
+	Joins(fields ...field.RelationField) IApprovalActionDo
+	Preload(fields ...field.RelationField) IApprovalActionDo
+	FirstOrInit() (*table.ApprovalAction, error)
+	FirstOrCreate() (*table.ApprovalAction, error)
+	FindByPage(offset int, limit int) (result []*table.ApprovalAction, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IApprovalActionDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (a approvalActionDo) Debug() IApprovalActionDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a approvalActionDo) WithContext(ctx context.Context) IApprovalActionDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a approvalActionDo) ReadDB() IApprovalActionDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a approvalActionDo) WriteDB() IApprovalActionDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a approvalActionDo) Session(config *gorm.Session) IApprovalActionDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a approvalActionDo) Clauses(conds ...clause.Expression) IApprovalActionDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a approvalActionDo) Returning(value interface{}, columns ...string) IApprovalActionDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a approvalActionDo) Not(conds ...gen.Condition) IApprovalActionDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a approvalActionDo) Or(conds ...gen.Condition) IApprovalActionDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a approvalActionDo) Select(conds ...field.Expr) IApprovalActionDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a approvalActionDo) Where(conds ...gen.Condition) IApprovalActionDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a approvalActionDo) Order(conds ...field.Expr) IApprovalActionDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a approvalActionDo) Distinct(cols ...field.Expr) IApprovalActionDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a approvalActionDo) Omit(cols ...field.Expr) IApprovalActionDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a approvalActionDo) Join(table schema.Tabler, on ...field.Expr) IApprovalActionDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a approvalActionDo) LeftJoin(table schema.Tabler, on ...field.Expr) IApprovalActionDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a approvalActionDo) RightJoin(table schema.Tabler, on ...field.Expr) IApprovalActionDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a approvalActionDo) Group(cols ...field.Expr) IApprovalActionDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a approvalActionDo) Having(conds ...gen.Condition) IApprovalActionDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a approvalActionDo) Limit(limit int) IApprovalActionDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a approvalActionDo) Offset(offset int) IApprovalActionDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a approvalActionDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IApprovalActionDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a approvalActionDo) Unscoped() IApprovalActionDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a approvalActionDo) Create(values ...*table.ApprovalAction) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a approvalActionDo) CreateInBatches(values []*table.ApprovalAction, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a approvalActionDo) Save(values ...*table.ApprovalAction) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a approvalActionDo) First() (*table.ApprovalAction, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalAction), nil
+	}
+}
+
+func (a approvalActionDo) Take() (*table.ApprovalAction, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalAction), nil
+	}
+}
+
+func (a approvalActionDo) Last() (*table.ApprovalAction, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalAction), nil
+	}
+}
+
+func (a approvalActionDo) Find() ([]*table.ApprovalAction, error) {
+	result, err := a.DO.Find()
+	return result.([]*table.ApprovalAction), err
+}
+
+func (a approvalActionDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ApprovalAction, err error) {
+	buf := make([]*table.ApprovalAction, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a approvalActionDo) FindInBatches(result *[]*table.ApprovalAction, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a approvalActionDo) Attrs(attrs ...field.AssignExpr) IApprovalActionDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a approvalActionDo) Assign(attrs ...field.AssignExpr) IApprovalActionDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a approvalActionDo) Joins(fields ...field.RelationField) IApprovalActionDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a approvalActionDo) Preload(fields ...field.RelationField) IApprovalActionDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a approvalActionDo) FirstOrInit() (*table.ApprovalAction, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalAction), nil
+	}
+}
+
+func (a approvalActionDo) FirstOrCreate() (*table.ApprovalAction, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalAction), nil
+	}
+}
+
+func (a approvalActionDo) FindByPage(offset int, limit int) (result []*table.ApprovalAction, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a approvalActionDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a approvalActionDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a approvalActionDo) Delete(models ...*table.ApprovalAction) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *approvalActionDo) withDO(do gen.Dao) *approvalActionDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
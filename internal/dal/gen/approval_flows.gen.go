@@ -0,0 +1,432 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newApprovalFlow(db *gorm.DB, opts ...gen.DOOption) approvalFlow {
+	_approvalFlow := approvalFlow{}
+
+	_approvalFlow.approvalFlowDo.UseDB(db, opts...)
+	_approvalFlow.approvalFlowDo.UseModel(&table.ApprovalFlow{})
+
+	tableName := _approvalFlow.approvalFlowDo.TableName()
+	_approvalFlow.ALL = field.NewAsterisk(tableName)
+	_approvalFlow.ID = field.NewUint32(tableName, "id")
+	_approvalFlow.Enabled = field.NewBool(tableName, "enabled")
+	_approvalFlow.Stages = field.NewField(tableName, "stages")
+	_approvalFlow.Memo = field.NewString(tableName, "memo")
+	_approvalFlow.BizID = field.NewUint32(tableName, "biz_id")
+	_approvalFlow.AppID = field.NewUint32(tableName, "app_id")
+	_approvalFlow.TenantID = field.NewString(tableName, "tenant_id")
+	_approvalFlow.Creator = field.NewString(tableName, "creator")
+	_approvalFlow.Reviser = field.NewString(tableName, "reviser")
+	_approvalFlow.CreatedAt = field.NewTime(tableName, "created_at")
+	_approvalFlow.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_approvalFlow.fillFieldMap()
+
+	return _approvalFlow
+}
+
+type approvalFlow struct {
+	approvalFlowDo approvalFlowDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	Enabled   field.Bool
+	Stages    field.Field
+	Memo      field.String
+	BizID     field.Uint32
+	AppID     field.Uint32
+	TenantID  field.String
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (a approvalFlow) Table(newTableName string) *approvalFlow {
+	a.approvalFlowDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a approvalFlow) As(alias string) *approvalFlow {
+	a.approvalFlowDo.DO = *(a.approvalFlowDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *approvalFlow) updateTableName(table string) *approvalFlow {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewUint32(table, "id")
+	a.Enabled = field.NewBool(table, "enabled")
+	a.Stages = field.NewField(table, "stages")
+	a.Memo = field.NewString(table, "memo")
+	a.BizID = field.NewUint32(table, "biz_id")
+	a.AppID = field.NewUint32(table, "app_id")
+	a.TenantID = field.NewString(table, "tenant_id")
+	a.Creator = field.NewString(table, "creator")
+	a.Reviser = field.NewString(table, "reviser")
+	a.CreatedAt = field.NewTime(table, "created_at")
+	a.UpdatedAt = field.NewTime(table, "updated_at")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *approvalFlow) WithContext(ctx context.Context) IApprovalFlowDo {
+	return a.approvalFlowDo.WithContext(ctx)
+}
+
+func (a approvalFlow) TableName() string { return a.approvalFlowDo.TableName() }
+
+func (a approvalFlow) Alias() string { return a.approvalFlowDo.Alias() }
+
+func (a approvalFlow) Columns(cols ...field.Expr) gen.Columns {
+	return a.approvalFlowDo.Columns(cols...)
+}
+
+func (a *approvalFlow) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *approvalFlow) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 11)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["enabled"] = a.Enabled
+	a.fieldMap["stages"] = a.Stages
+	a.fieldMap["memo"] = a.Memo
+	a.fieldMap["biz_id"] = a.BizID
+	a.fieldMap["app_id"] = a.AppID
+	a.fieldMap["tenant_id"] = a.TenantID
+	a.fieldMap["creator"] = a.Creator
+	a.fieldMap["reviser"] = a.Reviser
+	a.fieldMap["created_at"] = a.CreatedAt
+	a.fieldMap["updated_at"] = a.UpdatedAt
+}
+
+func (a approvalFlow) clone(db *gorm.DB) approvalFlow {
+	a.approvalFlowDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a approvalFlow) replaceDB(db *gorm.DB) approvalFlow {
+	a.approvalFlowDo.ReplaceDB(db)
+	return a
+}
+
+type approvalFlowDo struct{ gen.DO }
+
+type IApprovalFlowDo interface {
+	gen.SubQuery
+	Debug() IApprovalFlowDo
+	WithContext(ctx context.Context) IApprovalFlowDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IApprovalFlowDo
+	WriteDB() IApprovalFlowDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IApprovalFlowDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IApprovalFlowDo
+	Not(conds ...gen.Condition) IApprovalFlowDo
+	Or(conds ...gen.Condition) IApprovalFlowDo
+	Select(conds ...field.Expr) IApprovalFlowDo
+	Where(conds ...gen.Condition) IApprovalFlowDo
+	Order(conds ...field.Expr) IApprovalFlowDo
+	Distinct(cols ...field.Expr) IApprovalFlowDo
+	Omit(cols ...field.Expr) IApprovalFlowDo
+	Join(table schema.Tabler, on ...field.Expr) IApprovalFlowDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IApprovalFlowDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IApprovalFlowDo
+	Group(cols ...field.Expr) IApprovalFlowDo
+	Having(conds ...gen.Condition) IApprovalFlowDo
+	Limit(limit int) IApprovalFlowDo
+	Offset(offset int) IApprovalFlowDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IApprovalFlowDo
+	Unscoped() IApprovalFlowDo
+	Create(values ...*table.ApprovalFlow) error
+	CreateInBatches(values []*table.ApprovalFlow, batchSize int) error
+	Save(values ...*table.ApprovalFlow) error
+	First() (*table.ApprovalFlow, error)
+	Take() (*table.ApprovalFlow, error)
+	Last() (*table.ApprovalFlow, error)
+	Find() ([]*table.ApprovalFlow, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ApprovalFlow, err error)
+	FindInBatches(result *[]*table.ApprovalFlow, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ApprovalFlow) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IApprovalFlowDo
+	Assign(attrs ...field.AssignExpr) IApprovalFlowDo
+	Joins(fields ...field.RelationField) IApprovalFlowDo
+	Preload(fields ...field.RelationField) IApprovalFlowDo
+	FirstOrInit() (*table.ApprovalFlow, error)
+	FirstOrCreate() (*table.ApprovalFlow, error)
+	FindByPage(offset int, limit int) (result []*table.ApprovalFlow, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IApprovalFlowDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (a approvalFlowDo) Debug() IApprovalFlowDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a approvalFlowDo) WithContext(ctx context.Context) IApprovalFlowDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a approvalFlowDo) ReadDB() IApprovalFlowDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a approvalFlowDo) WriteDB() IApprovalFlowDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a approvalFlowDo) Session(config *gorm.Session) IApprovalFlowDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a approvalFlowDo) Clauses(conds ...clause.Expression) IApprovalFlowDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a approvalFlowDo) Returning(value interface{}, columns ...string) IApprovalFlowDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a approvalFlowDo) Not(conds ...gen.Condition) IApprovalFlowDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a approvalFlowDo) Or(conds ...gen.Condition) IApprovalFlowDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a approvalFlowDo) Select(conds ...field.Expr) IApprovalFlowDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a approvalFlowDo) Where(conds ...gen.Condition) IApprovalFlowDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a approvalFlowDo) Order(conds ...field.Expr) IApprovalFlowDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a approvalFlowDo) Distinct(cols ...field.Expr) IApprovalFlowDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a approvalFlowDo) Omit(cols ...field.Expr) IApprovalFlowDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a approvalFlowDo) Join(table schema.Tabler, on ...field.Expr) IApprovalFlowDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a approvalFlowDo) LeftJoin(table schema.Tabler, on ...field.Expr) IApprovalFlowDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a approvalFlowDo) RightJoin(table schema.Tabler, on ...field.Expr) IApprovalFlowDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a approvalFlowDo) Group(cols ...field.Expr) IApprovalFlowDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a approvalFlowDo) Having(conds ...gen.Condition) IApprovalFlowDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a approvalFlowDo) Limit(limit int) IApprovalFlowDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a approvalFlowDo) Offset(offset int) IApprovalFlowDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a approvalFlowDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IApprovalFlowDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a approvalFlowDo) Unscoped() IApprovalFlowDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a approvalFlowDo) Create(values ...*table.ApprovalFlow) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a approvalFlowDo) CreateInBatches(values []*table.ApprovalFlow, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a approvalFlowDo) Save(values ...*table.ApprovalFlow) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a approvalFlowDo) First() (*table.ApprovalFlow, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalFlow), nil
+	}
+}
+
+func (a approvalFlowDo) Take() (*table.ApprovalFlow, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalFlow), nil
+	}
+}
+
+func (a approvalFlowDo) Last() (*table.ApprovalFlow, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalFlow), nil
+	}
+}
+
+func (a approvalFlowDo) Find() ([]*table.ApprovalFlow, error) {
+	result, err := a.DO.Find()
+	return result.([]*table.ApprovalFlow), err
+}
+
+func (a approvalFlowDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ApprovalFlow, err error) {
+	buf := make([]*table.ApprovalFlow, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a approvalFlowDo) FindInBatches(result *[]*table.ApprovalFlow, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a approvalFlowDo) Attrs(attrs ...field.AssignExpr) IApprovalFlowDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a approvalFlowDo) Assign(attrs ...field.AssignExpr) IApprovalFlowDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a approvalFlowDo) Joins(fields ...field.RelationField) IApprovalFlowDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a approvalFlowDo) Preload(fields ...field.RelationField) IApprovalFlowDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a approvalFlowDo) FirstOrInit() (*table.ApprovalFlow, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalFlow), nil
+	}
+}
+
+func (a approvalFlowDo) FirstOrCreate() (*table.ApprovalFlow, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalFlow), nil
+	}
+}
+
+func (a approvalFlowDo) FindByPage(offset int, limit int) (result []*table.ApprovalFlow, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a approvalFlowDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a approvalFlowDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a approvalFlowDo) Delete(models ...*table.ApprovalFlow) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *approvalFlowDo) withDO(do gen.Dao) *approvalFlowDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
@@ -0,0 +1,444 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newApprovalTicket(db *gorm.DB, opts ...gen.DOOption) approvalTicket {
+	_approvalTicket := approvalTicket{}
+
+	_approvalTicket.approvalTicketDo.UseDB(db, opts...)
+	_approvalTicket.approvalTicketDo.UseModel(&table.ApprovalTicket{})
+
+	tableName := _approvalTicket.approvalTicketDo.TableName()
+	_approvalTicket.ALL = field.NewAsterisk(tableName)
+	_approvalTicket.ID = field.NewUint32(tableName, "id")
+	_approvalTicket.Stages = field.NewField(tableName, "stages")
+	_approvalTicket.CurrentStage = field.NewUint32(tableName, "current_stage")
+	_approvalTicket.Status = field.NewString(tableName, "status")
+	_approvalTicket.StageDeadline = field.NewTime(tableName, "stage_deadline")
+	_approvalTicket.BizID = field.NewUint32(tableName, "biz_id")
+	_approvalTicket.AppID = field.NewUint32(tableName, "app_id")
+	_approvalTicket.ReleaseID = field.NewUint32(tableName, "release_id")
+	_approvalTicket.StrategyID = field.NewUint32(tableName, "strategy_id")
+	_approvalTicket.TenantID = field.NewString(tableName, "tenant_id")
+	_approvalTicket.Creator = field.NewString(tableName, "creator")
+	_approvalTicket.Reviser = field.NewString(tableName, "reviser")
+	_approvalTicket.CreatedAt = field.NewTime(tableName, "created_at")
+	_approvalTicket.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_approvalTicket.fillFieldMap()
+
+	return _approvalTicket
+}
+
+type approvalTicket struct {
+	approvalTicketDo approvalTicketDo
+
+	ALL           field.Asterisk
+	ID            field.Uint32
+	Stages        field.Field
+	CurrentStage  field.Uint32
+	Status        field.String
+	StageDeadline field.Time
+	BizID         field.Uint32
+	AppID         field.Uint32
+	ReleaseID     field.Uint32
+	StrategyID    field.Uint32
+	TenantID      field.String
+	Creator       field.String
+	Reviser       field.String
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (a approvalTicket) Table(newTableName string) *approvalTicket {
+	a.approvalTicketDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a approvalTicket) As(alias string) *approvalTicket {
+	a.approvalTicketDo.DO = *(a.approvalTicketDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *approvalTicket) updateTableName(table string) *approvalTicket {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewUint32(table, "id")
+	a.Stages = field.NewField(table, "stages")
+	a.CurrentStage = field.NewUint32(table, "current_stage")
+	a.Status = field.NewString(table, "status")
+	a.StageDeadline = field.NewTime(table, "stage_deadline")
+	a.BizID = field.NewUint32(table, "biz_id")
+	a.AppID = field.NewUint32(table, "app_id")
+	a.ReleaseID = field.NewUint32(table, "release_id")
+	a.StrategyID = field.NewUint32(table, "strategy_id")
+	a.TenantID = field.NewString(table, "tenant_id")
+	a.Creator = field.NewString(table, "creator")
+	a.Reviser = field.NewString(table, "reviser")
+	a.CreatedAt = field.NewTime(table, "created_at")
+	a.UpdatedAt = field.NewTime(table, "updated_at")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *approvalTicket) WithContext(ctx context.Context) IApprovalTicketDo {
+	return a.approvalTicketDo.WithContext(ctx)
+}
+
+func (a approvalTicket) TableName() string { return a.approvalTicketDo.TableName() }
+
+func (a approvalTicket) Alias() string { return a.approvalTicketDo.Alias() }
+
+func (a approvalTicket) Columns(cols ...field.Expr) gen.Columns {
+	return a.approvalTicketDo.Columns(cols...)
+}
+
+func (a *approvalTicket) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *approvalTicket) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 14)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["stages"] = a.Stages
+	a.fieldMap["current_stage"] = a.CurrentStage
+	a.fieldMap["status"] = a.Status
+	a.fieldMap["stage_deadline"] = a.StageDeadline
+	a.fieldMap["biz_id"] = a.BizID
+	a.fieldMap["app_id"] = a.AppID
+	a.fieldMap["release_id"] = a.ReleaseID
+	a.fieldMap["strategy_id"] = a.StrategyID
+	a.fieldMap["tenant_id"] = a.TenantID
+	a.fieldMap["creator"] = a.Creator
+	a.fieldMap["reviser"] = a.Reviser
+	a.fieldMap["created_at"] = a.CreatedAt
+	a.fieldMap["updated_at"] = a.UpdatedAt
+}
+
+func (a approvalTicket) clone(db *gorm.DB) approvalTicket {
+	a.approvalTicketDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a approvalTicket) replaceDB(db *gorm.DB) approvalTicket {
+	a.approvalTicketDo.ReplaceDB(db)
+	return a
+}
+
+type approvalTicketDo struct{ gen.DO }
+
+type IApprovalTicketDo interface {
+	gen.SubQuery
+	Debug() IApprovalTicketDo
+	WithContext(ctx context.Context) IApprovalTicketDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IApprovalTicketDo
+	WriteDB() IApprovalTicketDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IApprovalTicketDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IApprovalTicketDo
+	Not(conds ...gen.Condition) IApprovalTicketDo
+	Or(conds ...gen.Condition) IApprovalTicketDo
+	Select(conds ...field.Expr) IApprovalTicketDo
+	Where(conds ...gen.Condition) IApprovalTicketDo
+	Order(conds ...field.Expr) IApprovalTicketDo
+	Distinct(cols ...field.Expr) IApprovalTicketDo
+	Omit(cols ...field.Expr) IApprovalTicketDo
+	Join(table schema.Tabler, on ...field.Expr) IApprovalTicketDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IApprovalTicketDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IApprovalTicketDo
+	Group(cols ...field.Expr) IApprovalTicketDo
+	Having(conds ...gen.Condition) IApprovalTicketDo
+	Limit(limit int) IApprovalTicketDo
+	Offset(offset int) IApprovalTicketDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IApprovalTicketDo
+	Unscoped() IApprovalTicketDo
+	Create(values ...*table.ApprovalTicket) error
+	CreateInBatches(values []*table.ApprovalTicket, batchSize int) error
+	Save(values ...*table.ApprovalTicket) error
+	First() (*table.ApprovalTicket, error)
+	Take() (*table.ApprovalTicket, error)
+	Last() (*table.ApprovalTicket, error)
+	Find() ([]*table.ApprovalTicket, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ApprovalTicket, err error)
+	FindInBatches(result *[]*table.ApprovalTicket, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ApprovalTicket) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IApprovalTicketDo
+	Assign(attrs ...field.AssignExpr) IApprovalTicketDo
+	Joins(fields ...field.RelationField) IApprovalTicketDo
+	Preload(fields ...field.RelationField) IApprovalTicketDo
+	FirstOrInit() (*table.ApprovalTicket, error)
+	FirstOrCreate() (*table.ApprovalTicket, error)
+	FindByPage(offset int, limit int) (result []*table.ApprovalTicket, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IApprovalTicketDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (a approvalTicketDo) Debug() IApprovalTicketDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a approvalTicketDo) WithContext(ctx context.Context) IApprovalTicketDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a approvalTicketDo) ReadDB() IApprovalTicketDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a approvalTicketDo) WriteDB() IApprovalTicketDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a approvalTicketDo) Session(config *gorm.Session) IApprovalTicketDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a approvalTicketDo) Clauses(conds ...clause.Expression) IApprovalTicketDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a approvalTicketDo) Returning(value interface{}, columns ...string) IApprovalTicketDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a approvalTicketDo) Not(conds ...gen.Condition) IApprovalTicketDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a approvalTicketDo) Or(conds ...gen.Condition) IApprovalTicketDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a approvalTicketDo) Select(conds ...field.Expr) IApprovalTicketDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a approvalTicketDo) Where(conds ...gen.Condition) IApprovalTicketDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a approvalTicketDo) Order(conds ...field.Expr) IApprovalTicketDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a approvalTicketDo) Distinct(cols ...field.Expr) IApprovalTicketDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a approvalTicketDo) Omit(cols ...field.Expr) IApprovalTicketDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a approvalTicketDo) Join(table schema.Tabler, on ...field.Expr) IApprovalTicketDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a approvalTicketDo) LeftJoin(table schema.Tabler, on ...field.Expr) IApprovalTicketDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a approvalTicketDo) RightJoin(table schema.Tabler, on ...field.Expr) IApprovalTicketDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a approvalTicketDo) Group(cols ...field.Expr) IApprovalTicketDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a approvalTicketDo) Having(conds ...gen.Condition) IApprovalTicketDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a approvalTicketDo) Limit(limit int) IApprovalTicketDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a approvalTicketDo) Offset(offset int) IApprovalTicketDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a approvalTicketDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IApprovalTicketDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a approvalTicketDo) Unscoped() IApprovalTicketDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a approvalTicketDo) Create(values ...*table.ApprovalTicket) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a approvalTicketDo) CreateInBatches(values []*table.ApprovalTicket, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a approvalTicketDo) Save(values ...*table.ApprovalTicket) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a approvalTicketDo) First() (*table.ApprovalTicket, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalTicket), nil
+	}
+}
+
+func (a approvalTicketDo) Take() (*table.ApprovalTicket, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalTicket), nil
+	}
+}
+
+func (a approvalTicketDo) Last() (*table.ApprovalTicket, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalTicket), nil
+	}
+}
+
+func (a approvalTicketDo) Find() ([]*table.ApprovalTicket, error) {
+	result, err := a.DO.Find()
+	return result.([]*table.ApprovalTicket), err
+}
+
+func (a approvalTicketDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ApprovalTicket, err error) {
+	buf := make([]*table.ApprovalTicket, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a approvalTicketDo) FindInBatches(result *[]*table.ApprovalTicket, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a approvalTicketDo) Attrs(attrs ...field.AssignExpr) IApprovalTicketDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a approvalTicketDo) Assign(attrs ...field.AssignExpr) IApprovalTicketDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a approvalTicketDo) Joins(fields ...field.RelationField) IApprovalTicketDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a approvalTicketDo) Preload(fields ...field.RelationField) IApprovalTicketDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a approvalTicketDo) FirstOrInit() (*table.ApprovalTicket, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalTicket), nil
+	}
+}
+
+func (a approvalTicketDo) FirstOrCreate() (*table.ApprovalTicket, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ApprovalTicket), nil
+	}
+}
+
+func (a approvalTicketDo) FindByPage(offset int, limit int) (result []*table.ApprovalTicket, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a approvalTicketDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a approvalTicketDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a approvalTicketDo) Delete(models ...*table.ApprovalTicket) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *approvalTicketDo) withDO(do gen.Dao) *approvalTicketDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
@@ -0,0 +1,464 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newChangeFreeze(db *gorm.DB, opts ...gen.DOOption) changeFreeze {
+	_changeFreeze := changeFreeze{}
+
+	_changeFreeze.changeFreezeDo.UseDB(db, opts...)
+	_changeFreeze.changeFreezeDo.UseModel(&table.ChangeFreeze{})
+
+	tableName := _changeFreeze.changeFreezeDo.TableName()
+	_changeFreeze.ALL = field.NewAsterisk(tableName)
+	_changeFreeze.ID = field.NewUint32(tableName, "id")
+	_changeFreeze.Name = field.NewString(tableName, "name")
+	_changeFreeze.Kind = field.NewString(tableName, "kind")
+	_changeFreeze.Timezone = field.NewString(tableName, "timezone")
+	_changeFreeze.Weekdays = field.NewField(tableName, "weekdays")
+	_changeFreeze.StartTime = field.NewString(tableName, "start_time")
+	_changeFreeze.EndTime = field.NewString(tableName, "end_time")
+	_changeFreeze.StartAt = field.NewTime(tableName, "start_at")
+	_changeFreeze.EndAt = field.NewTime(tableName, "end_at")
+	_changeFreeze.BreakGlassUsers = field.NewField(tableName, "break_glass_users")
+	_changeFreeze.Enabled = field.NewBool(tableName, "enabled")
+	_changeFreeze.Memo = field.NewString(tableName, "memo")
+	_changeFreeze.BizID = field.NewUint32(tableName, "biz_id")
+	_changeFreeze.AppID = field.NewUint32(tableName, "app_id")
+	_changeFreeze.TenantID = field.NewString(tableName, "tenant_id")
+	_changeFreeze.Creator = field.NewString(tableName, "creator")
+	_changeFreeze.Reviser = field.NewString(tableName, "reviser")
+	_changeFreeze.CreatedAt = field.NewTime(tableName, "created_at")
+	_changeFreeze.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_changeFreeze.fillFieldMap()
+
+	return _changeFreeze
+}
+
+type changeFreeze struct {
+	changeFreezeDo changeFreezeDo
+
+	ALL             field.Asterisk
+	ID              field.Uint32
+	Name            field.String
+	Kind            field.String
+	Timezone        field.String
+	Weekdays        field.Field
+	StartTime       field.String
+	EndTime         field.String
+	StartAt         field.Time
+	EndAt           field.Time
+	BreakGlassUsers field.Field
+	Enabled         field.Bool
+	Memo            field.String
+	BizID           field.Uint32
+	AppID           field.Uint32
+	TenantID        field.String
+	Creator         field.String
+	Reviser         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c changeFreeze) Table(newTableName string) *changeFreeze {
+	c.changeFreezeDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c changeFreeze) As(alias string) *changeFreeze {
+	c.changeFreezeDo.DO = *(c.changeFreezeDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *changeFreeze) updateTableName(table string) *changeFreeze {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.Name = field.NewString(table, "name")
+	c.Kind = field.NewString(table, "kind")
+	c.Timezone = field.NewString(table, "timezone")
+	c.Weekdays = field.NewField(table, "weekdays")
+	c.StartTime = field.NewString(table, "start_time")
+	c.EndTime = field.NewString(table, "end_time")
+	c.StartAt = field.NewTime(table, "start_at")
+	c.EndAt = field.NewTime(table, "end_at")
+	c.BreakGlassUsers = field.NewField(table, "break_glass_users")
+	c.Enabled = field.NewBool(table, "enabled")
+	c.Memo = field.NewString(table, "memo")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.AppID = field.NewUint32(table, "app_id")
+	c.TenantID = field.NewString(table, "tenant_id")
+	c.Creator = field.NewString(table, "creator")
+	c.Reviser = field.NewString(table, "reviser")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.UpdatedAt = field.NewTime(table, "updated_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *changeFreeze) WithContext(ctx context.Context) IChangeFreezeDo {
+	return c.changeFreezeDo.WithContext(ctx)
+}
+
+func (c changeFreeze) TableName() string { return c.changeFreezeDo.TableName() }
+
+func (c changeFreeze) Alias() string { return c.changeFreezeDo.Alias() }
+
+func (c changeFreeze) Columns(cols ...field.Expr) gen.Columns {
+	return c.changeFreezeDo.Columns(cols...)
+}
+
+func (c *changeFreeze) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *changeFreeze) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 19)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["name"] = c.Name
+	c.fieldMap["kind"] = c.Kind
+	c.fieldMap["timezone"] = c.Timezone
+	c.fieldMap["weekdays"] = c.Weekdays
+	c.fieldMap["start_time"] = c.StartTime
+	c.fieldMap["end_time"] = c.EndTime
+	c.fieldMap["start_at"] = c.StartAt
+	c.fieldMap["end_at"] = c.EndAt
+	c.fieldMap["break_glass_users"] = c.BreakGlassUsers
+	c.fieldMap["enabled"] = c.Enabled
+	c.fieldMap["memo"] = c.Memo
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["app_id"] = c.AppID
+	c.fieldMap["tenant_id"] = c.TenantID
+	c.fieldMap["creator"] = c.Creator
+	c.fieldMap["reviser"] = c.Reviser
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["updated_at"] = c.UpdatedAt
+}
+
+func (c changeFreeze) clone(db *gorm.DB) changeFreeze {
+	c.changeFreezeDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c changeFreeze) replaceDB(db *gorm.DB) changeFreeze {
+	c.changeFreezeDo.ReplaceDB(db)
+	return c
+}
+
+type changeFreezeDo struct{ gen.DO }
+
+type IChangeFreezeDo interface {
+	gen.SubQuery
+	Debug() IChangeFreezeDo
+	WithContext(ctx context.Context) IChangeFreezeDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IChangeFreezeDo
+	WriteDB() IChangeFreezeDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IChangeFreezeDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IChangeFreezeDo
+	Not(conds ...gen.Condition) IChangeFreezeDo
+	Or(conds ...gen.Condition) IChangeFreezeDo
+	Select(conds ...field.Expr) IChangeFreezeDo
+	Where(conds ...gen.Condition) IChangeFreezeDo
+	Order(conds ...field.Expr) IChangeFreezeDo
+	Distinct(cols ...field.Expr) IChangeFreezeDo
+	Omit(cols ...field.Expr) IChangeFreezeDo
+	Join(table schema.Tabler, on ...field.Expr) IChangeFreezeDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IChangeFreezeDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IChangeFreezeDo
+	Group(cols ...field.Expr) IChangeFreezeDo
+	Having(conds ...gen.Condition) IChangeFreezeDo
+	Limit(limit int) IChangeFreezeDo
+	Offset(offset int) IChangeFreezeDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IChangeFreezeDo
+	Unscoped() IChangeFreezeDo
+	Create(values ...*table.ChangeFreeze) error
+	CreateInBatches(values []*table.ChangeFreeze, batchSize int) error
+	Save(values ...*table.ChangeFreeze) error
+	First() (*table.ChangeFreeze, error)
+	Take() (*table.ChangeFreeze, error)
+	Last() (*table.ChangeFreeze, error)
+	Find() ([]*table.ChangeFreeze, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ChangeFreeze, err error)
+	FindInBatches(result *[]*table.ChangeFreeze, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ChangeFreeze) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IChangeFreezeDo
+	Assign(attrs ...field.AssignExpr) IChangeFreezeDo
+	Joins(fields ...field.RelationField) IChangeFreezeDo
+	Preload(fields ...field.RelationField) IChangeFreezeDo
+	FirstOrInit() (*table.ChangeFreeze, error)
+	FirstOrCreate() (*table.ChangeFreeze, error)
+	FindByPage(offset int, limit int) (result []*table.ChangeFreeze, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IChangeFreezeDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c changeFreezeDo) Debug() IChangeFreezeDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c changeFreezeDo) WithContext(ctx context.Context) IChangeFreezeDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c changeFreezeDo) ReadDB() IChangeFreezeDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c changeFreezeDo) WriteDB() IChangeFreezeDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c changeFreezeDo) Session(config *gorm.Session) IChangeFreezeDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c changeFreezeDo) Clauses(conds ...clause.Expression) IChangeFreezeDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c changeFreezeDo) Returning(value interface{}, columns ...string) IChangeFreezeDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c changeFreezeDo) Not(conds ...gen.Condition) IChangeFreezeDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c changeFreezeDo) Or(conds ...gen.Condition) IChangeFreezeDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c changeFreezeDo) Select(conds ...field.Expr) IChangeFreezeDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c changeFreezeDo) Where(conds ...gen.Condition) IChangeFreezeDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c changeFreezeDo) Order(conds ...field.Expr) IChangeFreezeDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c changeFreezeDo) Distinct(cols ...field.Expr) IChangeFreezeDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c changeFreezeDo) Omit(cols ...field.Expr) IChangeFreezeDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c changeFreezeDo) Join(table schema.Tabler, on ...field.Expr) IChangeFreezeDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c changeFreezeDo) LeftJoin(table schema.Tabler, on ...field.Expr) IChangeFreezeDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c changeFreezeDo) RightJoin(table schema.Tabler, on ...field.Expr) IChangeFreezeDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c changeFreezeDo) Group(cols ...field.Expr) IChangeFreezeDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c changeFreezeDo) Having(conds ...gen.Condition) IChangeFreezeDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c changeFreezeDo) Limit(limit int) IChangeFreezeDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c changeFreezeDo) Offset(offset int) IChangeFreezeDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c changeFreezeDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IChangeFreezeDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c changeFreezeDo) Unscoped() IChangeFreezeDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c changeFreezeDo) Create(values ...*table.ChangeFreeze) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c changeFreezeDo) CreateInBatches(values []*table.ChangeFreeze, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c changeFreezeDo) Save(values ...*table.ChangeFreeze) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c changeFreezeDo) First() (*table.ChangeFreeze, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ChangeFreeze), nil
+	}
+}
+
+func (c changeFreezeDo) Take() (*table.ChangeFreeze, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ChangeFreeze), nil
+	}
+}
+
+func (c changeFreezeDo) Last() (*table.ChangeFreeze, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ChangeFreeze), nil
+	}
+}
+
+func (c changeFreezeDo) Find() ([]*table.ChangeFreeze, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.ChangeFreeze), err
+}
+
+func (c changeFreezeDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ChangeFreeze, err error) {
+	buf := make([]*table.ChangeFreeze, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c changeFreezeDo) FindInBatches(result *[]*table.ChangeFreeze, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c changeFreezeDo) Attrs(attrs ...field.AssignExpr) IChangeFreezeDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c changeFreezeDo) Assign(attrs ...field.AssignExpr) IChangeFreezeDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c changeFreezeDo) Joins(fields ...field.RelationField) IChangeFreezeDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c changeFreezeDo) Preload(fields ...field.RelationField) IChangeFreezeDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c changeFreezeDo) FirstOrInit() (*table.ChangeFreeze, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ChangeFreeze), nil
+	}
+}
+
+func (c changeFreezeDo) FirstOrCreate() (*table.ChangeFreeze, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ChangeFreeze), nil
+	}
+}
+
+func (c changeFreezeDo) FindByPage(offset int, limit int) (result []*table.ChangeFreeze, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c changeFreezeDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c changeFreezeDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c changeFreezeDo) Delete(models ...*table.ChangeFreeze) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *changeFreezeDo) withDO(do gen.Dao) *changeFreezeDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
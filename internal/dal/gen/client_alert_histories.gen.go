@@ -0,0 +1,472 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newClientAlertHistory(db *gorm.DB, opts ...gen.DOOption) clientAlertHistory {
+	_clientAlertHistory := clientAlertHistory{}
+
+	_clientAlertHistory.clientAlertHistoryDo.UseDB(db, opts...)
+	_clientAlertHistory.clientAlertHistoryDo.UseModel(&table.ClientAlertHistory{})
+
+	tableName := _clientAlertHistory.clientAlertHistoryDo.TableName()
+	_clientAlertHistory.ALL = field.NewAsterisk(tableName)
+	_clientAlertHistory.ID = field.NewUint32(tableName, "id")
+	_clientAlertHistory.RuleID = field.NewUint32(tableName, "rule_id")
+	_clientAlertHistory.RuleName = field.NewString(tableName, "rule_name")
+	_clientAlertHistory.RuleType = field.NewString(tableName, "rule_type")
+	_clientAlertHistory.Status = field.NewString(tableName, "status")
+	_clientAlertHistory.Value = field.NewFloat64(tableName, "value")
+	_clientAlertHistory.ClientCount = field.NewUint32(tableName, "client_count")
+	_clientAlertHistory.Message = field.NewString(tableName, "message")
+	_clientAlertHistory.Silenced = field.NewBool(tableName, "silenced")
+	_clientAlertHistory.NotifyResult = field.NewString(tableName, "notify_result")
+	_clientAlertHistory.FiredAt = field.NewTime(tableName, "fired_at")
+	_clientAlertHistory.AckedBy = field.NewString(tableName, "acked_by")
+	_clientAlertHistory.AckedAt = field.NewTime(tableName, "acked_at")
+	_clientAlertHistory.ResolvedAt = field.NewTime(tableName, "resolved_at")
+	_clientAlertHistory.BizID = field.NewUint32(tableName, "biz_id")
+	_clientAlertHistory.AppID = field.NewUint32(tableName, "app_id")
+	_clientAlertHistory.TenantID = field.NewString(tableName, "tenant_id")
+	_clientAlertHistory.Creator = field.NewString(tableName, "creator")
+	_clientAlertHistory.Reviser = field.NewString(tableName, "reviser")
+	_clientAlertHistory.CreatedAt = field.NewTime(tableName, "created_at")
+	_clientAlertHistory.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_clientAlertHistory.fillFieldMap()
+
+	return _clientAlertHistory
+}
+
+type clientAlertHistory struct {
+	clientAlertHistoryDo clientAlertHistoryDo
+
+	ALL          field.Asterisk
+	ID           field.Uint32
+	RuleID       field.Uint32
+	RuleName     field.String
+	RuleType     field.String
+	Status       field.String
+	Value        field.Float64
+	ClientCount  field.Uint32
+	Message      field.String
+	Silenced     field.Bool
+	NotifyResult field.String
+	FiredAt      field.Time
+	AckedBy      field.String
+	AckedAt      field.Time
+	ResolvedAt   field.Time
+	BizID        field.Uint32
+	AppID        field.Uint32
+	TenantID     field.String
+	Creator      field.String
+	Reviser      field.String
+	CreatedAt    field.Time
+	UpdatedAt    field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c clientAlertHistory) Table(newTableName string) *clientAlertHistory {
+	c.clientAlertHistoryDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c clientAlertHistory) As(alias string) *clientAlertHistory {
+	c.clientAlertHistoryDo.DO = *(c.clientAlertHistoryDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *clientAlertHistory) updateTableName(table string) *clientAlertHistory {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.RuleID = field.NewUint32(table, "rule_id")
+	c.RuleName = field.NewString(table, "rule_name")
+	c.RuleType = field.NewString(table, "rule_type")
+	c.Status = field.NewString(table, "status")
+	c.Value = field.NewFloat64(table, "value")
+	c.ClientCount = field.NewUint32(table, "client_count")
+	c.Message = field.NewString(table, "message")
+	c.Silenced = field.NewBool(table, "silenced")
+	c.NotifyResult = field.NewString(table, "notify_result")
+	c.FiredAt = field.NewTime(table, "fired_at")
+	c.AckedBy = field.NewString(table, "acked_by")
+	c.AckedAt = field.NewTime(table, "acked_at")
+	c.ResolvedAt = field.NewTime(table, "resolved_at")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.AppID = field.NewUint32(table, "app_id")
+	c.TenantID = field.NewString(table, "tenant_id")
+	c.Creator = field.NewString(table, "creator")
+	c.Reviser = field.NewString(table, "reviser")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.UpdatedAt = field.NewTime(table, "updated_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *clientAlertHistory) WithContext(ctx context.Context) IClientAlertHistoryDo {
+	return c.clientAlertHistoryDo.WithContext(ctx)
+}
+
+func (c clientAlertHistory) TableName() string { return c.clientAlertHistoryDo.TableName() }
+
+func (c clientAlertHistory) Alias() string { return c.clientAlertHistoryDo.Alias() }
+
+func (c clientAlertHistory) Columns(cols ...field.Expr) gen.Columns {
+	return c.clientAlertHistoryDo.Columns(cols...)
+}
+
+func (c *clientAlertHistory) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *clientAlertHistory) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 21)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["rule_id"] = c.RuleID
+	c.fieldMap["rule_name"] = c.RuleName
+	c.fieldMap["rule_type"] = c.RuleType
+	c.fieldMap["status"] = c.Status
+	c.fieldMap["value"] = c.Value
+	c.fieldMap["client_count"] = c.ClientCount
+	c.fieldMap["message"] = c.Message
+	c.fieldMap["silenced"] = c.Silenced
+	c.fieldMap["notify_result"] = c.NotifyResult
+	c.fieldMap["fired_at"] = c.FiredAt
+	c.fieldMap["acked_by"] = c.AckedBy
+	c.fieldMap["acked_at"] = c.AckedAt
+	c.fieldMap["resolved_at"] = c.ResolvedAt
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["app_id"] = c.AppID
+	c.fieldMap["tenant_id"] = c.TenantID
+	c.fieldMap["creator"] = c.Creator
+	c.fieldMap["reviser"] = c.Reviser
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["updated_at"] = c.UpdatedAt
+}
+
+func (c clientAlertHistory) clone(db *gorm.DB) clientAlertHistory {
+	c.clientAlertHistoryDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c clientAlertHistory) replaceDB(db *gorm.DB) clientAlertHistory {
+	c.clientAlertHistoryDo.ReplaceDB(db)
+	return c
+}
+
+type clientAlertHistoryDo struct{ gen.DO }
+
+type IClientAlertHistoryDo interface {
+	gen.SubQuery
+	Debug() IClientAlertHistoryDo
+	WithContext(ctx context.Context) IClientAlertHistoryDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IClientAlertHistoryDo
+	WriteDB() IClientAlertHistoryDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IClientAlertHistoryDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IClientAlertHistoryDo
+	Not(conds ...gen.Condition) IClientAlertHistoryDo
+	Or(conds ...gen.Condition) IClientAlertHistoryDo
+	Select(conds ...field.Expr) IClientAlertHistoryDo
+	Where(conds ...gen.Condition) IClientAlertHistoryDo
+	Order(conds ...field.Expr) IClientAlertHistoryDo
+	Distinct(cols ...field.Expr) IClientAlertHistoryDo
+	Omit(cols ...field.Expr) IClientAlertHistoryDo
+	Join(table schema.Tabler, on ...field.Expr) IClientAlertHistoryDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IClientAlertHistoryDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IClientAlertHistoryDo
+	Group(cols ...field.Expr) IClientAlertHistoryDo
+	Having(conds ...gen.Condition) IClientAlertHistoryDo
+	Limit(limit int) IClientAlertHistoryDo
+	Offset(offset int) IClientAlertHistoryDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IClientAlertHistoryDo
+	Unscoped() IClientAlertHistoryDo
+	Create(values ...*table.ClientAlertHistory) error
+	CreateInBatches(values []*table.ClientAlertHistory, batchSize int) error
+	Save(values ...*table.ClientAlertHistory) error
+	First() (*table.ClientAlertHistory, error)
+	Take() (*table.ClientAlertHistory, error)
+	Last() (*table.ClientAlertHistory, error)
+	Find() ([]*table.ClientAlertHistory, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ClientAlertHistory, err error)
+	FindInBatches(result *[]*table.ClientAlertHistory, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ClientAlertHistory) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IClientAlertHistoryDo
+	Assign(attrs ...field.AssignExpr) IClientAlertHistoryDo
+	Joins(fields ...field.RelationField) IClientAlertHistoryDo
+	Preload(fields ...field.RelationField) IClientAlertHistoryDo
+	FirstOrInit() (*table.ClientAlertHistory, error)
+	FirstOrCreate() (*table.ClientAlertHistory, error)
+	FindByPage(offset int, limit int) (result []*table.ClientAlertHistory, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IClientAlertHistoryDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c clientAlertHistoryDo) Debug() IClientAlertHistoryDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c clientAlertHistoryDo) WithContext(ctx context.Context) IClientAlertHistoryDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c clientAlertHistoryDo) ReadDB() IClientAlertHistoryDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c clientAlertHistoryDo) WriteDB() IClientAlertHistoryDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c clientAlertHistoryDo) Session(config *gorm.Session) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c clientAlertHistoryDo) Clauses(conds ...clause.Expression) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c clientAlertHistoryDo) Returning(value interface{}, columns ...string) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c clientAlertHistoryDo) Not(conds ...gen.Condition) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c clientAlertHistoryDo) Or(conds ...gen.Condition) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c clientAlertHistoryDo) Select(conds ...field.Expr) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c clientAlertHistoryDo) Where(conds ...gen.Condition) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c clientAlertHistoryDo) Order(conds ...field.Expr) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c clientAlertHistoryDo) Distinct(cols ...field.Expr) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c clientAlertHistoryDo) Omit(cols ...field.Expr) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c clientAlertHistoryDo) Join(table schema.Tabler, on ...field.Expr) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c clientAlertHistoryDo) LeftJoin(table schema.Tabler, on ...field.Expr) IClientAlertHistoryDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c clientAlertHistoryDo) RightJoin(table schema.Tabler, on ...field.Expr) IClientAlertHistoryDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c clientAlertHistoryDo) Group(cols ...field.Expr) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c clientAlertHistoryDo) Having(conds ...gen.Condition) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c clientAlertHistoryDo) Limit(limit int) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c clientAlertHistoryDo) Offset(offset int) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c clientAlertHistoryDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c clientAlertHistoryDo) Unscoped() IClientAlertHistoryDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c clientAlertHistoryDo) Create(values ...*table.ClientAlertHistory) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c clientAlertHistoryDo) CreateInBatches(values []*table.ClientAlertHistory, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c clientAlertHistoryDo) Save(values ...*table.ClientAlertHistory) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c clientAlertHistoryDo) First() (*table.ClientAlertHistory, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientAlertHistory), nil
+	}
+}
+
+func (c clientAlertHistoryDo) Take() (*table.ClientAlertHistory, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientAlertHistory), nil
+	}
+}
+
+func (c clientAlertHistoryDo) Last() (*table.ClientAlertHistory, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientAlertHistory), nil
+	}
+}
+
+func (c clientAlertHistoryDo) Find() ([]*table.ClientAlertHistory, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.ClientAlertHistory), err
+}
+
+func (c clientAlertHistoryDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ClientAlertHistory, err error) {
+	buf := make([]*table.ClientAlertHistory, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c clientAlertHistoryDo) FindInBatches(result *[]*table.ClientAlertHistory, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c clientAlertHistoryDo) Attrs(attrs ...field.AssignExpr) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c clientAlertHistoryDo) Assign(attrs ...field.AssignExpr) IClientAlertHistoryDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c clientAlertHistoryDo) Joins(fields ...field.RelationField) IClientAlertHistoryDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c clientAlertHistoryDo) Preload(fields ...field.RelationField) IClientAlertHistoryDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c clientAlertHistoryDo) FirstOrInit() (*table.ClientAlertHistory, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientAlertHistory), nil
+	}
+}
+
+func (c clientAlertHistoryDo) FirstOrCreate() (*table.ClientAlertHistory, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientAlertHistory), nil
+	}
+}
+
+func (c clientAlertHistoryDo) FindByPage(offset int, limit int) (result []*table.ClientAlertHistory, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c clientAlertHistoryDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c clientAlertHistoryDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c clientAlertHistoryDo) Delete(models ...*table.ClientAlertHistory) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *clientAlertHistoryDo) withDO(do gen.Dao) *clientAlertHistoryDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
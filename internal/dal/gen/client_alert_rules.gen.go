@@ -0,0 +1,464 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newClientAlertRule(db *gorm.DB, opts ...gen.DOOption) clientAlertRule {
+	_clientAlertRule := clientAlertRule{}
+
+	_clientAlertRule.clientAlertRuleDo.UseDB(db, opts...)
+	_clientAlertRule.clientAlertRuleDo.UseModel(&table.ClientAlertRule{})
+
+	tableName := _clientAlertRule.clientAlertRuleDo.TableName()
+	_clientAlertRule.ALL = field.NewAsterisk(tableName)
+	_clientAlertRule.ID = field.NewUint32(tableName, "id")
+	_clientAlertRule.Name = field.NewString(tableName, "name")
+	_clientAlertRule.RuleType = field.NewString(tableName, "rule_type")
+	_clientAlertRule.ReleaseID = field.NewUint32(tableName, "release_id")
+	_clientAlertRule.Threshold = field.NewFloat64(tableName, "threshold")
+	_clientAlertRule.ClientVersion = field.NewString(tableName, "client_version")
+	_clientAlertRule.Notifiers = field.NewString(tableName, "notifiers")
+	_clientAlertRule.WebhookURL = field.NewString(tableName, "webhook_url")
+	_clientAlertRule.Receivers = field.NewString(tableName, "receivers")
+	_clientAlertRule.Enabled = field.NewBool(tableName, "enabled")
+	_clientAlertRule.SilencedUntil = field.NewTime(tableName, "silenced_until")
+	_clientAlertRule.Memo = field.NewString(tableName, "memo")
+	_clientAlertRule.BizID = field.NewUint32(tableName, "biz_id")
+	_clientAlertRule.AppID = field.NewUint32(tableName, "app_id")
+	_clientAlertRule.TenantID = field.NewString(tableName, "tenant_id")
+	_clientAlertRule.Creator = field.NewString(tableName, "creator")
+	_clientAlertRule.Reviser = field.NewString(tableName, "reviser")
+	_clientAlertRule.CreatedAt = field.NewTime(tableName, "created_at")
+	_clientAlertRule.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_clientAlertRule.fillFieldMap()
+
+	return _clientAlertRule
+}
+
+type clientAlertRule struct {
+	clientAlertRuleDo clientAlertRuleDo
+
+	ALL           field.Asterisk
+	ID            field.Uint32
+	Name          field.String
+	RuleType      field.String
+	ReleaseID     field.Uint32
+	Threshold     field.Float64
+	ClientVersion field.String
+	Notifiers     field.String
+	WebhookURL    field.String
+	Receivers     field.String
+	Enabled       field.Bool
+	SilencedUntil field.Time
+	Memo          field.String
+	BizID         field.Uint32
+	AppID         field.Uint32
+	TenantID      field.String
+	Creator       field.String
+	Reviser       field.String
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c clientAlertRule) Table(newTableName string) *clientAlertRule {
+	c.clientAlertRuleDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c clientAlertRule) As(alias string) *clientAlertRule {
+	c.clientAlertRuleDo.DO = *(c.clientAlertRuleDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *clientAlertRule) updateTableName(table string) *clientAlertRule {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.Name = field.NewString(table, "name")
+	c.RuleType = field.NewString(table, "rule_type")
+	c.ReleaseID = field.NewUint32(table, "release_id")
+	c.Threshold = field.NewFloat64(table, "threshold")
+	c.ClientVersion = field.NewString(table, "client_version")
+	c.Notifiers = field.NewString(table, "notifiers")
+	c.WebhookURL = field.NewString(table, "webhook_url")
+	c.Receivers = field.NewString(table, "receivers")
+	c.Enabled = field.NewBool(table, "enabled")
+	c.SilencedUntil = field.NewTime(table, "silenced_until")
+	c.Memo = field.NewString(table, "memo")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.AppID = field.NewUint32(table, "app_id")
+	c.TenantID = field.NewString(table, "tenant_id")
+	c.Creator = field.NewString(table, "creator")
+	c.Reviser = field.NewString(table, "reviser")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.UpdatedAt = field.NewTime(table, "updated_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *clientAlertRule) WithContext(ctx context.Context) IClientAlertRuleDo {
+	return c.clientAlertRuleDo.WithContext(ctx)
+}
+
+func (c clientAlertRule) TableName() string { return c.clientAlertRuleDo.TableName() }
+
+func (c clientAlertRule) Alias() string { return c.clientAlertRuleDo.Alias() }
+
+func (c clientAlertRule) Columns(cols ...field.Expr) gen.Columns {
+	return c.clientAlertRuleDo.Columns(cols...)
+}
+
+func (c *clientAlertRule) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *clientAlertRule) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 19)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["name"] = c.Name
+	c.fieldMap["rule_type"] = c.RuleType
+	c.fieldMap["release_id"] = c.ReleaseID
+	c.fieldMap["threshold"] = c.Threshold
+	c.fieldMap["client_version"] = c.ClientVersion
+	c.fieldMap["notifiers"] = c.Notifiers
+	c.fieldMap["webhook_url"] = c.WebhookURL
+	c.fieldMap["receivers"] = c.Receivers
+	c.fieldMap["enabled"] = c.Enabled
+	c.fieldMap["silenced_until"] = c.SilencedUntil
+	c.fieldMap["memo"] = c.Memo
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["app_id"] = c.AppID
+	c.fieldMap["tenant_id"] = c.TenantID
+	c.fieldMap["creator"] = c.Creator
+	c.fieldMap["reviser"] = c.Reviser
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["updated_at"] = c.UpdatedAt
+}
+
+func (c clientAlertRule) clone(db *gorm.DB) clientAlertRule {
+	c.clientAlertRuleDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c clientAlertRule) replaceDB(db *gorm.DB) clientAlertRule {
+	c.clientAlertRuleDo.ReplaceDB(db)
+	return c
+}
+
+type clientAlertRuleDo struct{ gen.DO }
+
+type IClientAlertRuleDo interface {
+	gen.SubQuery
+	Debug() IClientAlertRuleDo
+	WithContext(ctx context.Context) IClientAlertRuleDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IClientAlertRuleDo
+	WriteDB() IClientAlertRuleDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IClientAlertRuleDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IClientAlertRuleDo
+	Not(conds ...gen.Condition) IClientAlertRuleDo
+	Or(conds ...gen.Condition) IClientAlertRuleDo
+	Select(conds ...field.Expr) IClientAlertRuleDo
+	Where(conds ...gen.Condition) IClientAlertRuleDo
+	Order(conds ...field.Expr) IClientAlertRuleDo
+	Distinct(cols ...field.Expr) IClientAlertRuleDo
+	Omit(cols ...field.Expr) IClientAlertRuleDo
+	Join(table schema.Tabler, on ...field.Expr) IClientAlertRuleDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IClientAlertRuleDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IClientAlertRuleDo
+	Group(cols ...field.Expr) IClientAlertRuleDo
+	Having(conds ...gen.Condition) IClientAlertRuleDo
+	Limit(limit int) IClientAlertRuleDo
+	Offset(offset int) IClientAlertRuleDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IClientAlertRuleDo
+	Unscoped() IClientAlertRuleDo
+	Create(values ...*table.ClientAlertRule) error
+	CreateInBatches(values []*table.ClientAlertRule, batchSize int) error
+	Save(values ...*table.ClientAlertRule) error
+	First() (*table.ClientAlertRule, error)
+	Take() (*table.ClientAlertRule, error)
+	Last() (*table.ClientAlertRule, error)
+	Find() ([]*table.ClientAlertRule, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ClientAlertRule, err error)
+	FindInBatches(result *[]*table.ClientAlertRule, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ClientAlertRule) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IClientAlertRuleDo
+	Assign(attrs ...field.AssignExpr) IClientAlertRuleDo
+	Joins(fields ...field.RelationField) IClientAlertRuleDo
+	Preload(fields ...field.RelationField) IClientAlertRuleDo
+	FirstOrInit() (*table.ClientAlertRule, error)
+	FirstOrCreate() (*table.ClientAlertRule, error)
+	FindByPage(offset int, limit int) (result []*table.ClientAlertRule, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IClientAlertRuleDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c clientAlertRuleDo) Debug() IClientAlertRuleDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c clientAlertRuleDo) WithContext(ctx context.Context) IClientAlertRuleDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c clientAlertRuleDo) ReadDB() IClientAlertRuleDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c clientAlertRuleDo) WriteDB() IClientAlertRuleDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c clientAlertRuleDo) Session(config *gorm.Session) IClientAlertRuleDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c clientAlertRuleDo) Clauses(conds ...clause.Expression) IClientAlertRuleDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c clientAlertRuleDo) Returning(value interface{}, columns ...string) IClientAlertRuleDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c clientAlertRuleDo) Not(conds ...gen.Condition) IClientAlertRuleDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c clientAlertRuleDo) Or(conds ...gen.Condition) IClientAlertRuleDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c clientAlertRuleDo) Select(conds ...field.Expr) IClientAlertRuleDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c clientAlertRuleDo) Where(conds ...gen.Condition) IClientAlertRuleDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c clientAlertRuleDo) Order(conds ...field.Expr) IClientAlertRuleDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c clientAlertRuleDo) Distinct(cols ...field.Expr) IClientAlertRuleDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c clientAlertRuleDo) Omit(cols ...field.Expr) IClientAlertRuleDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c clientAlertRuleDo) Join(table schema.Tabler, on ...field.Expr) IClientAlertRuleDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c clientAlertRuleDo) LeftJoin(table schema.Tabler, on ...field.Expr) IClientAlertRuleDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c clientAlertRuleDo) RightJoin(table schema.Tabler, on ...field.Expr) IClientAlertRuleDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c clientAlertRuleDo) Group(cols ...field.Expr) IClientAlertRuleDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c clientAlertRuleDo) Having(conds ...gen.Condition) IClientAlertRuleDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c clientAlertRuleDo) Limit(limit int) IClientAlertRuleDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c clientAlertRuleDo) Offset(offset int) IClientAlertRuleDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c clientAlertRuleDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IClientAlertRuleDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c clientAlertRuleDo) Unscoped() IClientAlertRuleDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c clientAlertRuleDo) Create(values ...*table.ClientAlertRule) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c clientAlertRuleDo) CreateInBatches(values []*table.ClientAlertRule, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c clientAlertRuleDo) Save(values ...*table.ClientAlertRule) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c clientAlertRuleDo) First() (*table.ClientAlertRule, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientAlertRule), nil
+	}
+}
+
+func (c clientAlertRuleDo) Take() (*table.ClientAlertRule, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientAlertRule), nil
+	}
+}
+
+func (c clientAlertRuleDo) Last() (*table.ClientAlertRule, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientAlertRule), nil
+	}
+}
+
+func (c clientAlertRuleDo) Find() ([]*table.ClientAlertRule, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.ClientAlertRule), err
+}
+
+func (c clientAlertRuleDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ClientAlertRule, err error) {
+	buf := make([]*table.ClientAlertRule, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c clientAlertRuleDo) FindInBatches(result *[]*table.ClientAlertRule, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c clientAlertRuleDo) Attrs(attrs ...field.AssignExpr) IClientAlertRuleDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c clientAlertRuleDo) Assign(attrs ...field.AssignExpr) IClientAlertRuleDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c clientAlertRuleDo) Joins(fields ...field.RelationField) IClientAlertRuleDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c clientAlertRuleDo) Preload(fields ...field.RelationField) IClientAlertRuleDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c clientAlertRuleDo) FirstOrInit() (*table.ClientAlertRule, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientAlertRule), nil
+	}
+}
+
+func (c clientAlertRuleDo) FirstOrCreate() (*table.ClientAlertRule, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientAlertRule), nil
+	}
+}
+
+func (c clientAlertRuleDo) FindByPage(offset int, limit int) (result []*table.ClientAlertRule, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c clientAlertRuleDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c clientAlertRuleDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c clientAlertRuleDo) Delete(models ...*table.ClientAlertRule) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *clientAlertRuleDo) withDO(do gen.Dao) *clientAlertRuleDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
@@ -48,6 +48,8 @@ func newClientEvent(db *gorm.DB, opts ...gen.DOOption) clientEvent {
 	_clientEvent.ReleaseChangeFailedReason = field.NewString(tableName, "release_change_failed_reason")
 	_clientEvent.SpecificFailedReason = field.NewString(tableName, "specific_failed_reason")
 	_clientEvent.FailedDetailReason = field.NewString(tableName, "failed_detail_reason")
+	_clientEvent.CurrentStage = field.NewString(tableName, "current_stage")
+	_clientEvent.Stages = field.NewField(tableName, "stages")
 
 	_clientEvent.fillFieldMap()
 
@@ -79,6 +81,8 @@ type clientEvent struct {
 	ReleaseChangeFailedReason field.String
 	SpecificFailedReason      field.String
 	FailedDetailReason        field.String
+	CurrentStage              field.String
+	Stages                    field.Field
 
 	fieldMap map[string]field.Expr
 }
@@ -116,6 +120,8 @@ func (c *clientEvent) updateTableName(table string) *clientEvent {
 	c.ReleaseChangeFailedReason = field.NewString(table, "release_change_failed_reason")
 	c.SpecificFailedReason = field.NewString(table, "specific_failed_reason")
 	c.FailedDetailReason = field.NewString(table, "failed_detail_reason")
+	c.CurrentStage = field.NewString(table, "current_stage")
+	c.Stages = field.NewField(table, "stages")
 
 	c.fillFieldMap()
 
@@ -142,7 +148,7 @@ func (c *clientEvent) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (c *clientEvent) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 21)
+	c.fieldMap = make(map[string]field.Expr, 23)
 	c.fieldMap["id"] = c.ID
 	c.fieldMap["client_id"] = c.ClientID
 	c.fieldMap["cursor_id"] = c.CursorID
@@ -164,6 +170,8 @@ func (c *clientEvent) fillFieldMap() {
 	c.fieldMap["release_change_failed_reason"] = c.ReleaseChangeFailedReason
 	c.fieldMap["specific_failed_reason"] = c.SpecificFailedReason
 	c.fieldMap["failed_detail_reason"] = c.FailedDetailReason
+	c.fieldMap["current_stage"] = c.CurrentStage
+	c.fieldMap["stages"] = c.Stages
 }
 
 func (c clientEvent) clone(db *gorm.DB) clientEvent {
@@ -0,0 +1,452 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newClientMetric(db *gorm.DB, opts ...gen.DOOption) clientMetric {
+	_clientMetric := clientMetric{}
+
+	_clientMetric.clientMetricDo.UseDB(db, opts...)
+	_clientMetric.clientMetricDo.UseModel(&table.ClientMetric{})
+
+	tableName := _clientMetric.clientMetricDo.TableName()
+	_clientMetric.ALL = field.NewAsterisk(tableName)
+	_clientMetric.ID = field.NewUint32(tableName, "id")
+	_clientMetric.BizID = field.NewUint32(tableName, "biz_id")
+	_clientMetric.AppID = field.NewUint32(tableName, "app_id")
+	_clientMetric.UID = field.NewString(tableName, "uid")
+	_clientMetric.TenantID = field.NewString(tableName, "tenant_id")
+	_clientMetric.Granularity = field.NewString(tableName, "granularity")
+	_clientMetric.BucketTime = field.NewTime(tableName, "bucket_time")
+	_clientMetric.ClientVersion = field.NewString(tableName, "client_version")
+	_clientMetric.SampleCount = field.NewUint32(tableName, "sample_count")
+	_clientMetric.CpuSum = field.NewFloat64(tableName, "cpu_sum")
+	_clientMetric.CpuMax = field.NewFloat64(tableName, "cpu_max")
+	_clientMetric.MemorySum = field.NewUint64(tableName, "memory_sum")
+	_clientMetric.MemoryMax = field.NewUint64(tableName, "memory_max")
+	_clientMetric.PullCount = field.NewUint32(tableName, "pull_count")
+	_clientMetric.PullFailedCount = field.NewUint32(tableName, "pull_failed_count")
+	_clientMetric.PullSeconds = field.NewFloat64(tableName, "pull_seconds")
+
+	_clientMetric.fillFieldMap()
+
+	return _clientMetric
+}
+
+type clientMetric struct {
+	clientMetricDo clientMetricDo
+
+	ALL             field.Asterisk
+	ID              field.Uint32
+	BizID           field.Uint32
+	AppID           field.Uint32
+	UID             field.String
+	TenantID        field.String
+	Granularity     field.String
+	BucketTime      field.Time
+	ClientVersion   field.String
+	SampleCount     field.Uint32
+	CpuSum          field.Float64
+	CpuMax          field.Float64
+	MemorySum       field.Uint64
+	MemoryMax       field.Uint64
+	PullCount       field.Uint32
+	PullFailedCount field.Uint32
+	PullSeconds     field.Float64
+
+	fieldMap map[string]field.Expr
+}
+
+func (c clientMetric) Table(newTableName string) *clientMetric {
+	c.clientMetricDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c clientMetric) As(alias string) *clientMetric {
+	c.clientMetricDo.DO = *(c.clientMetricDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *clientMetric) updateTableName(table string) *clientMetric {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.AppID = field.NewUint32(table, "app_id")
+	c.UID = field.NewString(table, "uid")
+	c.TenantID = field.NewString(table, "tenant_id")
+	c.Granularity = field.NewString(table, "granularity")
+	c.BucketTime = field.NewTime(table, "bucket_time")
+	c.ClientVersion = field.NewString(table, "client_version")
+	c.SampleCount = field.NewUint32(table, "sample_count")
+	c.CpuSum = field.NewFloat64(table, "cpu_sum")
+	c.CpuMax = field.NewFloat64(table, "cpu_max")
+	c.MemorySum = field.NewUint64(table, "memory_sum")
+	c.MemoryMax = field.NewUint64(table, "memory_max")
+	c.PullCount = field.NewUint32(table, "pull_count")
+	c.PullFailedCount = field.NewUint32(table, "pull_failed_count")
+	c.PullSeconds = field.NewFloat64(table, "pull_seconds")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *clientMetric) WithContext(ctx context.Context) IClientMetricDo {
+	return c.clientMetricDo.WithContext(ctx)
+}
+
+func (c clientMetric) TableName() string { return c.clientMetricDo.TableName() }
+
+func (c clientMetric) Alias() string { return c.clientMetricDo.Alias() }
+
+func (c clientMetric) Columns(cols ...field.Expr) gen.Columns {
+	return c.clientMetricDo.Columns(cols...)
+}
+
+func (c *clientMetric) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *clientMetric) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 16)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["app_id"] = c.AppID
+	c.fieldMap["uid"] = c.UID
+	c.fieldMap["tenant_id"] = c.TenantID
+	c.fieldMap["granularity"] = c.Granularity
+	c.fieldMap["bucket_time"] = c.BucketTime
+	c.fieldMap["client_version"] = c.ClientVersion
+	c.fieldMap["sample_count"] = c.SampleCount
+	c.fieldMap["cpu_sum"] = c.CpuSum
+	c.fieldMap["cpu_max"] = c.CpuMax
+	c.fieldMap["memory_sum"] = c.MemorySum
+	c.fieldMap["memory_max"] = c.MemoryMax
+	c.fieldMap["pull_count"] = c.PullCount
+	c.fieldMap["pull_failed_count"] = c.PullFailedCount
+	c.fieldMap["pull_seconds"] = c.PullSeconds
+}
+
+func (c clientMetric) clone(db *gorm.DB) clientMetric {
+	c.clientMetricDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c clientMetric) replaceDB(db *gorm.DB) clientMetric {
+	c.clientMetricDo.ReplaceDB(db)
+	return c
+}
+
+type clientMetricDo struct{ gen.DO }
+
+type IClientMetricDo interface {
+	gen.SubQuery
+	Debug() IClientMetricDo
+	WithContext(ctx context.Context) IClientMetricDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IClientMetricDo
+	WriteDB() IClientMetricDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IClientMetricDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IClientMetricDo
+	Not(conds ...gen.Condition) IClientMetricDo
+	Or(conds ...gen.Condition) IClientMetricDo
+	Select(conds ...field.Expr) IClientMetricDo
+	Where(conds ...gen.Condition) IClientMetricDo
+	Order(conds ...field.Expr) IClientMetricDo
+	Distinct(cols ...field.Expr) IClientMetricDo
+	Omit(cols ...field.Expr) IClientMetricDo
+	Join(table schema.Tabler, on ...field.Expr) IClientMetricDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IClientMetricDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IClientMetricDo
+	Group(cols ...field.Expr) IClientMetricDo
+	Having(conds ...gen.Condition) IClientMetricDo
+	Limit(limit int) IClientMetricDo
+	Offset(offset int) IClientMetricDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IClientMetricDo
+	Unscoped() IClientMetricDo
+	Create(values ...*table.ClientMetric) error
+	CreateInBatches(values []*table.ClientMetric, batchSize int) error
+	Save(values ...*table.ClientMetric) error
+	First() (*table.ClientMetric, error)
+	Take() (*table.ClientMetric, error)
+	Last() (*table.ClientMetric, error)
+	Find() ([]*table.ClientMetric, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ClientMetric, err error)
+	FindInBatches(result *[]*table.ClientMetric, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ClientMetric) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IClientMetricDo
+	Assign(attrs ...field.AssignExpr) IClientMetricDo
+	Joins(fields ...field.RelationField) IClientMetricDo
+	Preload(fields ...field.RelationField) IClientMetricDo
+	FirstOrInit() (*table.ClientMetric, error)
+	FirstOrCreate() (*table.ClientMetric, error)
+	FindByPage(offset int, limit int) (result []*table.ClientMetric, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IClientMetricDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c clientMetricDo) Debug() IClientMetricDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c clientMetricDo) WithContext(ctx context.Context) IClientMetricDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c clientMetricDo) ReadDB() IClientMetricDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c clientMetricDo) WriteDB() IClientMetricDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c clientMetricDo) Session(config *gorm.Session) IClientMetricDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c clientMetricDo) Clauses(conds ...clause.Expression) IClientMetricDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c clientMetricDo) Returning(value interface{}, columns ...string) IClientMetricDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c clientMetricDo) Not(conds ...gen.Condition) IClientMetricDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c clientMetricDo) Or(conds ...gen.Condition) IClientMetricDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c clientMetricDo) Select(conds ...field.Expr) IClientMetricDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c clientMetricDo) Where(conds ...gen.Condition) IClientMetricDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c clientMetricDo) Order(conds ...field.Expr) IClientMetricDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c clientMetricDo) Distinct(cols ...field.Expr) IClientMetricDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c clientMetricDo) Omit(cols ...field.Expr) IClientMetricDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c clientMetricDo) Join(table schema.Tabler, on ...field.Expr) IClientMetricDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c clientMetricDo) LeftJoin(table schema.Tabler, on ...field.Expr) IClientMetricDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c clientMetricDo) RightJoin(table schema.Tabler, on ...field.Expr) IClientMetricDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c clientMetricDo) Group(cols ...field.Expr) IClientMetricDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c clientMetricDo) Having(conds ...gen.Condition) IClientMetricDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c clientMetricDo) Limit(limit int) IClientMetricDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c clientMetricDo) Offset(offset int) IClientMetricDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c clientMetricDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IClientMetricDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c clientMetricDo) Unscoped() IClientMetricDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c clientMetricDo) Create(values ...*table.ClientMetric) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c clientMetricDo) CreateInBatches(values []*table.ClientMetric, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c clientMetricDo) Save(values ...*table.ClientMetric) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c clientMetricDo) First() (*table.ClientMetric, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientMetric), nil
+	}
+}
+
+func (c clientMetricDo) Take() (*table.ClientMetric, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientMetric), nil
+	}
+}
+
+func (c clientMetricDo) Last() (*table.ClientMetric, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientMetric), nil
+	}
+}
+
+func (c clientMetricDo) Find() ([]*table.ClientMetric, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.ClientMetric), err
+}
+
+func (c clientMetricDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ClientMetric, err error) {
+	buf := make([]*table.ClientMetric, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c clientMetricDo) FindInBatches(result *[]*table.ClientMetric, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c clientMetricDo) Attrs(attrs ...field.AssignExpr) IClientMetricDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c clientMetricDo) Assign(attrs ...field.AssignExpr) IClientMetricDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c clientMetricDo) Joins(fields ...field.RelationField) IClientMetricDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c clientMetricDo) Preload(fields ...field.RelationField) IClientMetricDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c clientMetricDo) FirstOrInit() (*table.ClientMetric, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientMetric), nil
+	}
+}
+
+func (c clientMetricDo) FirstOrCreate() (*table.ClientMetric, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientMetric), nil
+	}
+}
+
+func (c clientMetricDo) FindByPage(offset int, limit int) (result []*table.ClientMetric, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c clientMetricDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c clientMetricDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c clientMetricDo) Delete(models ...*table.ClientMetric) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *clientMetricDo) withDO(do gen.Dao) *clientMetricDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
@@ -37,6 +37,9 @@ func newConfigInstance(db *gorm.DB, opts ...gen.DOOption) configInstance {
 	_configInstance.TenantID = field.NewString(tableName, "tenant_id")
 	_configInstance.Md5 = field.NewString(tableName, "md5")
 	_configInstance.Content = field.NewString(tableName, "content")
+	_configInstance.DriftStatus = field.NewString(tableName, "drift_status")
+	_configInstance.ActualMd5 = field.NewString(tableName, "actual_md5")
+	_configInstance.DriftCheckedAt = field.NewTime(tableName, "drift_checked_at")
 	_configInstance.Creator = field.NewString(tableName, "creator")
 	_configInstance.Reviser = field.NewString(tableName, "reviser")
 	_configInstance.CreatedAt = field.NewTime(tableName, "created_at")
@@ -61,6 +64,9 @@ type configInstance struct {
 	TenantID         field.String
 	Md5              field.String
 	Content          field.String
+	DriftStatus      field.String
+	ActualMd5        field.String
+	DriftCheckedAt   field.Time
 	Creator          field.String
 	Reviser          field.String
 	CreatedAt        field.Time
@@ -91,6 +97,9 @@ func (c *configInstance) updateTableName(table string) *configInstance {
 	c.TenantID = field.NewString(table, "tenant_id")
 	c.Md5 = field.NewString(table, "md5")
 	c.Content = field.NewString(table, "content")
+	c.DriftStatus = field.NewString(table, "drift_status")
+	c.ActualMd5 = field.NewString(table, "actual_md5")
+	c.DriftCheckedAt = field.NewTime(table, "drift_checked_at")
 	c.Creator = field.NewString(table, "creator")
 	c.Reviser = field.NewString(table, "reviser")
 	c.CreatedAt = field.NewTime(table, "created_at")
@@ -123,7 +132,7 @@ func (c *configInstance) GetFieldByName(fieldName string) (field.OrderExpr, bool
 }
 
 func (c *configInstance) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 14)
+	c.fieldMap = make(map[string]field.Expr, 17)
 	c.fieldMap["id"] = c.ID
 	c.fieldMap["biz_id"] = c.BizID
 	c.fieldMap["config_template_id"] = c.ConfigTemplateID
@@ -134,6 +143,9 @@ func (c *configInstance) fillFieldMap() {
 	c.fieldMap["tenant_id"] = c.TenantID
 	c.fieldMap["md5"] = c.Md5
 	c.fieldMap["content"] = c.Content
+	c.fieldMap["drift_status"] = c.DriftStatus
+	c.fieldMap["actual_md5"] = c.ActualMd5
+	c.fieldMap["drift_checked_at"] = c.DriftCheckedAt
 	c.fieldMap["creator"] = c.Creator
 	c.fieldMap["reviser"] = c.Reviser
 	c.fieldMap["created_at"] = c.CreatedAt
@@ -0,0 +1,408 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newCredentialClient(db *gorm.DB, opts ...gen.DOOption) credentialClient {
+	_credentialClient := credentialClient{}
+
+	_credentialClient.credentialClientDo.UseDB(db, opts...)
+	_credentialClient.credentialClientDo.UseModel(&table.CredentialClient{})
+
+	tableName := _credentialClient.credentialClientDo.TableName()
+	_credentialClient.ALL = field.NewAsterisk(tableName)
+	_credentialClient.ID = field.NewUint32(tableName, "id")
+	_credentialClient.BizID = field.NewUint32(tableName, "biz_id")
+	_credentialClient.CredentialID = field.NewUint32(tableName, "credential_id")
+	_credentialClient.ClientUID = field.NewString(tableName, "client_uid")
+	_credentialClient.LastUsedAt = field.NewTime(tableName, "last_used_at")
+
+	_credentialClient.fillFieldMap()
+
+	return _credentialClient
+}
+
+type credentialClient struct {
+	credentialClientDo credentialClientDo
+
+	ALL          field.Asterisk
+	ID           field.Uint32
+	BizID        field.Uint32
+	CredentialID field.Uint32
+	ClientUID    field.String
+	LastUsedAt   field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c credentialClient) Table(newTableName string) *credentialClient {
+	c.credentialClientDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c credentialClient) As(alias string) *credentialClient {
+	c.credentialClientDo.DO = *(c.credentialClientDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *credentialClient) updateTableName(table string) *credentialClient {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.CredentialID = field.NewUint32(table, "credential_id")
+	c.ClientUID = field.NewString(table, "client_uid")
+	c.LastUsedAt = field.NewTime(table, "last_used_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *credentialClient) WithContext(ctx context.Context) ICredentialClientDo {
+	return c.credentialClientDo.WithContext(ctx)
+}
+
+func (c credentialClient) TableName() string { return c.credentialClientDo.TableName() }
+
+func (c credentialClient) Alias() string { return c.credentialClientDo.Alias() }
+
+func (c credentialClient) Columns(cols ...field.Expr) gen.Columns {
+	return c.credentialClientDo.Columns(cols...)
+}
+
+func (c *credentialClient) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *credentialClient) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 5)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["credential_id"] = c.CredentialID
+	c.fieldMap["client_uid"] = c.ClientUID
+	c.fieldMap["last_used_at"] = c.LastUsedAt
+}
+
+func (c credentialClient) clone(db *gorm.DB) credentialClient {
+	c.credentialClientDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c credentialClient) replaceDB(db *gorm.DB) credentialClient {
+	c.credentialClientDo.ReplaceDB(db)
+	return c
+}
+
+type credentialClientDo struct{ gen.DO }
+
+type ICredentialClientDo interface {
+	gen.SubQuery
+	Debug() ICredentialClientDo
+	WithContext(ctx context.Context) ICredentialClientDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ICredentialClientDo
+	WriteDB() ICredentialClientDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ICredentialClientDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ICredentialClientDo
+	Not(conds ...gen.Condition) ICredentialClientDo
+	Or(conds ...gen.Condition) ICredentialClientDo
+	Select(conds ...field.Expr) ICredentialClientDo
+	Where(conds ...gen.Condition) ICredentialClientDo
+	Order(conds ...field.Expr) ICredentialClientDo
+	Distinct(cols ...field.Expr) ICredentialClientDo
+	Omit(cols ...field.Expr) ICredentialClientDo
+	Join(table schema.Tabler, on ...field.Expr) ICredentialClientDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ICredentialClientDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ICredentialClientDo
+	Group(cols ...field.Expr) ICredentialClientDo
+	Having(conds ...gen.Condition) ICredentialClientDo
+	Limit(limit int) ICredentialClientDo
+	Offset(offset int) ICredentialClientDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ICredentialClientDo
+	Unscoped() ICredentialClientDo
+	Create(values ...*table.CredentialClient) error
+	CreateInBatches(values []*table.CredentialClient, batchSize int) error
+	Save(values ...*table.CredentialClient) error
+	First() (*table.CredentialClient, error)
+	Take() (*table.CredentialClient, error)
+	Last() (*table.CredentialClient, error)
+	Find() ([]*table.CredentialClient, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.CredentialClient, err error)
+	FindInBatches(result *[]*table.CredentialClient, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.CredentialClient) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ICredentialClientDo
+	Assign(attrs ...field.AssignExpr) ICredentialClientDo
+	Joins(fields ...field.RelationField) ICredentialClientDo
+	Preload(fields ...field.RelationField) ICredentialClientDo
+	FirstOrInit() (*table.CredentialClient, error)
+	FirstOrCreate() (*table.CredentialClient, error)
+	FindByPage(offset int, limit int) (result []*table.CredentialClient, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ICredentialClientDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c credentialClientDo) Debug() ICredentialClientDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c credentialClientDo) WithContext(ctx context.Context) ICredentialClientDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c credentialClientDo) ReadDB() ICredentialClientDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c credentialClientDo) WriteDB() ICredentialClientDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c credentialClientDo) Session(config *gorm.Session) ICredentialClientDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c credentialClientDo) Clauses(conds ...clause.Expression) ICredentialClientDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c credentialClientDo) Returning(value interface{}, columns ...string) ICredentialClientDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c credentialClientDo) Not(conds ...gen.Condition) ICredentialClientDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c credentialClientDo) Or(conds ...gen.Condition) ICredentialClientDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c credentialClientDo) Select(conds ...field.Expr) ICredentialClientDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c credentialClientDo) Where(conds ...gen.Condition) ICredentialClientDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c credentialClientDo) Order(conds ...field.Expr) ICredentialClientDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c credentialClientDo) Distinct(cols ...field.Expr) ICredentialClientDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c credentialClientDo) Omit(cols ...field.Expr) ICredentialClientDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c credentialClientDo) Join(table schema.Tabler, on ...field.Expr) ICredentialClientDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c credentialClientDo) LeftJoin(table schema.Tabler, on ...field.Expr) ICredentialClientDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c credentialClientDo) RightJoin(table schema.Tabler, on ...field.Expr) ICredentialClientDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c credentialClientDo) Group(cols ...field.Expr) ICredentialClientDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c credentialClientDo) Having(conds ...gen.Condition) ICredentialClientDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c credentialClientDo) Limit(limit int) ICredentialClientDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c credentialClientDo) Offset(offset int) ICredentialClientDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c credentialClientDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ICredentialClientDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c credentialClientDo) Unscoped() ICredentialClientDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c credentialClientDo) Create(values ...*table.CredentialClient) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c credentialClientDo) CreateInBatches(values []*table.CredentialClient, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c credentialClientDo) Save(values ...*table.CredentialClient) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c credentialClientDo) First() (*table.CredentialClient, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.CredentialClient), nil
+	}
+}
+
+func (c credentialClientDo) Take() (*table.CredentialClient, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.CredentialClient), nil
+	}
+}
+
+func (c credentialClientDo) Last() (*table.CredentialClient, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.CredentialClient), nil
+	}
+}
+
+func (c credentialClientDo) Find() ([]*table.CredentialClient, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.CredentialClient), err
+}
+
+func (c credentialClientDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.CredentialClient, err error) {
+	buf := make([]*table.CredentialClient, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c credentialClientDo) FindInBatches(result *[]*table.CredentialClient, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c credentialClientDo) Attrs(attrs ...field.AssignExpr) ICredentialClientDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c credentialClientDo) Assign(attrs ...field.AssignExpr) ICredentialClientDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c credentialClientDo) Joins(fields ...field.RelationField) ICredentialClientDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c credentialClientDo) Preload(fields ...field.RelationField) ICredentialClientDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c credentialClientDo) FirstOrInit() (*table.CredentialClient, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.CredentialClient), nil
+	}
+}
+
+func (c credentialClientDo) FirstOrCreate() (*table.CredentialClient, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.CredentialClient), nil
+	}
+}
+
+func (c credentialClientDo) FindByPage(offset int, limit int) (result []*table.CredentialClient, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c credentialClientDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c credentialClientDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c credentialClientDo) Delete(models ...*table.CredentialClient) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *credentialClientDo) withDO(do gen.Dao) *credentialClientDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
@@ -29,6 +29,10 @@ func newCredentialScope(db *gorm.DB, opts ...gen.DOOption) credentialScope {
 	_credentialScope.ALL = field.NewAsterisk(tableName)
 	_credentialScope.ID = field.NewUint32(tableName, "id")
 	_credentialScope.CredentialScope = field.NewString(tableName, "credential_scope")
+	_credentialScope.KvKeyPattern = field.NewString(tableName, "kv_key_pattern")
+	_credentialScope.KvKeyMatchType = field.NewString(tableName, "kv_key_match_type")
+	_credentialScope.Labels = field.NewField(tableName, "labels")
+	_credentialScope.Permission = field.NewString(tableName, "permission")
 	_credentialScope.ExpiredAt = field.NewTime(tableName, "expired_at")
 	_credentialScope.BizID = field.NewUint32(tableName, "biz_id")
 	_credentialScope.CredentialId = field.NewUint32(tableName, "credential_id")
@@ -49,6 +53,10 @@ type credentialScope struct {
 	ALL             field.Asterisk
 	ID              field.Uint32
 	CredentialScope field.String
+	KvKeyPattern    field.String
+	KvKeyMatchType  field.String
+	Labels          field.Field
+	Permission      field.String
 	ExpiredAt       field.Time
 	BizID           field.Uint32
 	CredentialId    field.Uint32
@@ -75,6 +83,10 @@ func (c *credentialScope) updateTableName(table string) *credentialScope {
 	c.ALL = field.NewAsterisk(table)
 	c.ID = field.NewUint32(table, "id")
 	c.CredentialScope = field.NewString(table, "credential_scope")
+	c.KvKeyPattern = field.NewString(table, "kv_key_pattern")
+	c.KvKeyMatchType = field.NewString(table, "kv_key_match_type")
+	c.Labels = field.NewField(table, "labels")
+	c.Permission = field.NewString(table, "permission")
 	c.ExpiredAt = field.NewTime(table, "expired_at")
 	c.BizID = field.NewUint32(table, "biz_id")
 	c.CredentialId = field.NewUint32(table, "credential_id")
@@ -111,9 +123,13 @@ func (c *credentialScope) GetFieldByName(fieldName string) (field.OrderExpr, boo
 }
 
 func (c *credentialScope) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 10)
+	c.fieldMap = make(map[string]field.Expr, 14)
 	c.fieldMap["id"] = c.ID
 	c.fieldMap["credential_scope"] = c.CredentialScope
+	c.fieldMap["kv_key_pattern"] = c.KvKeyPattern
+	c.fieldMap["kv_key_match_type"] = c.KvKeyMatchType
+	c.fieldMap["labels"] = c.Labels
+	c.fieldMap["permission"] = c.Permission
 	c.fieldMap["expired_at"] = c.ExpiredAt
 	c.fieldMap["biz_id"] = c.BizID
 	c.fieldMap["credential_id"] = c.CredentialId
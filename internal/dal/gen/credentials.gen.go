@@ -35,6 +35,10 @@ func newCredential(db *gorm.DB, opts ...gen.DOOption) credential {
 	_credential.Memo = field.NewString(tableName, "memo")
 	_credential.Enable = field.NewBool(tableName, "enable")
 	_credential.ExpiredAt = field.NewTime(tableName, "expired_at")
+	_credential.PreviousEncCredential = field.NewString(tableName, "previous_enc_credential")
+	_credential.PreviousExpiredAt = field.NewTime(tableName, "previous_expired_at")
+	_credential.RotatedAt = field.NewTime(tableName, "rotated_at")
+	_credential.LastUsedAt = field.NewTime(tableName, "last_used_at")
 	_credential.BizID = field.NewUint32(tableName, "biz_id")
 	_credential.TenantID = field.NewString(tableName, "tenant_id")
 	_credential.Creator = field.NewString(tableName, "creator")
@@ -50,21 +54,25 @@ func newCredential(db *gorm.DB, opts ...gen.DOOption) credential {
 type credential struct {
 	credentialDo credentialDo
 
-	ALL            field.Asterisk
-	ID             field.Uint32
-	CredentialType field.String
-	EncCredential  field.String
-	EncAlgorithm   field.String
-	Name           field.String
-	Memo           field.String
-	Enable         field.Bool
-	ExpiredAt      field.Time
-	BizID          field.Uint32
-	TenantID       field.String
-	Creator        field.String
-	Reviser        field.String
-	CreatedAt      field.Time
-	UpdatedAt      field.Time
+	ALL                   field.Asterisk
+	ID                    field.Uint32
+	CredentialType        field.String
+	EncCredential         field.String
+	EncAlgorithm          field.String
+	Name                  field.String
+	Memo                  field.String
+	Enable                field.Bool
+	ExpiredAt             field.Time
+	PreviousEncCredential field.String
+	PreviousExpiredAt     field.Time
+	RotatedAt             field.Time
+	LastUsedAt            field.Time
+	BizID                 field.Uint32
+	TenantID              field.String
+	Creator               field.String
+	Reviser               field.String
+	CreatedAt             field.Time
+	UpdatedAt             field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -89,6 +97,10 @@ func (c *credential) updateTableName(table string) *credential {
 	c.Memo = field.NewString(table, "memo")
 	c.Enable = field.NewBool(table, "enable")
 	c.ExpiredAt = field.NewTime(table, "expired_at")
+	c.PreviousEncCredential = field.NewString(table, "previous_enc_credential")
+	c.PreviousExpiredAt = field.NewTime(table, "previous_expired_at")
+	c.RotatedAt = field.NewTime(table, "rotated_at")
+	c.LastUsedAt = field.NewTime(table, "last_used_at")
 	c.BizID = field.NewUint32(table, "biz_id")
 	c.TenantID = field.NewString(table, "tenant_id")
 	c.Creator = field.NewString(table, "creator")
@@ -121,7 +133,7 @@ func (c *credential) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (c *credential) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 14)
+	c.fieldMap = make(map[string]field.Expr, 18)
 	c.fieldMap["id"] = c.ID
 	c.fieldMap["credential_type"] = c.CredentialType
 	c.fieldMap["enc_credential"] = c.EncCredential
@@ -130,6 +142,10 @@ func (c *credential) fillFieldMap() {
 	c.fieldMap["memo"] = c.Memo
 	c.fieldMap["enable"] = c.Enable
 	c.fieldMap["expired_at"] = c.ExpiredAt
+	c.fieldMap["previous_enc_credential"] = c.PreviousEncCredential
+	c.fieldMap["previous_expired_at"] = c.PreviousExpiredAt
+	c.fieldMap["rotated_at"] = c.RotatedAt
+	c.fieldMap["last_used_at"] = c.LastUsedAt
 	c.fieldMap["biz_id"] = c.BizID
 	c.fieldMap["tenant_id"] = c.TenantID
 	c.fieldMap["creator"] = c.Creator
@@ -20,11 +20,18 @@ var (
 	App                         *app
 	AppTemplateBinding          *appTemplateBinding
 	AppTemplateVariable         *appTemplateVariable
+	ApprovalAction              *approvalAction
+	ApprovalFlow                *approvalFlow
+	ApprovalTicket              *approvalTicket
 	ArchivedApp                 *archivedApp
 	Audit                       *audit
 	BizHost                     *bizHost
 	Client                      *client
+	ChangeFreeze                *changeFreeze
+	ClientAlertHistory          *clientAlertHistory
+	ClientAlertRule             *clientAlertRule
 	ClientEvent                 *clientEvent
+	ClientMetric                *clientMetric
 	ClientQuery                 *clientQuery
 	Commit                      *commit
 	Config                      *config
@@ -33,10 +40,13 @@ var (
 	ConfigTemplate              *configTemplate
 	Content                     *content
 	Credential                  *credential
+	CredentialClient            *credentialClient
 	CredentialScope             *credentialScope
 	Event                       *event
+	GitRepoLink                 *gitRepoLink
 	Group                       *group
 	GroupAppBind                *groupAppBind
+	GroupTemplateVariable       *groupTemplateVariable
 	Hook                        *hook
 	HookRevision                *hookRevision
 	IDGenerator                 *iDGenerator
@@ -48,16 +58,22 @@ var (
 	ReleasedAppTemplateVariable *releasedAppTemplateVariable
 	ReleasedConfigItem          *releasedConfigItem
 	ReleasedGroup               *releasedGroup
+	ReleasedGroupConfigItem     *releasedGroupConfigItem
 	ReleasedHook                *releasedHook
 	ReleasedKv                  *releasedKv
 	ResourceLock                *resourceLock
+	Role                        *role
+	RoleBinding                 *roleBinding
 	Strategy                    *strategy
 	TaskBatch                   *taskBatch
 	Template                    *template
 	TemplateRevision            *templateRevision
 	TemplateSet                 *templateSet
 	TemplateSpace               *templateSpace
+	TemplateSpaceHook           *templateSpaceHook
 	TemplateVariable            *templateVariable
+	Webhook                     *webhook
+	WebhookDelivery             *webhookDelivery
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
@@ -65,11 +81,18 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	App = &Q.App
 	AppTemplateBinding = &Q.AppTemplateBinding
 	AppTemplateVariable = &Q.AppTemplateVariable
+	ApprovalAction = &Q.ApprovalAction
+	ApprovalFlow = &Q.ApprovalFlow
+	ApprovalTicket = &Q.ApprovalTicket
 	ArchivedApp = &Q.ArchivedApp
 	Audit = &Q.Audit
 	BizHost = &Q.BizHost
 	Client = &Q.Client
+	ChangeFreeze = &Q.ChangeFreeze
+	ClientAlertHistory = &Q.ClientAlertHistory
+	ClientAlertRule = &Q.ClientAlertRule
 	ClientEvent = &Q.ClientEvent
+	ClientMetric = &Q.ClientMetric
 	ClientQuery = &Q.ClientQuery
 	Commit = &Q.Commit
 	Config = &Q.Config
@@ -78,10 +101,13 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	ConfigTemplate = &Q.ConfigTemplate
 	Content = &Q.Content
 	Credential = &Q.Credential
+	CredentialClient = &Q.CredentialClient
 	CredentialScope = &Q.CredentialScope
 	Event = &Q.Event
+	GitRepoLink = &Q.GitRepoLink
 	Group = &Q.Group
 	GroupAppBind = &Q.GroupAppBind
+	GroupTemplateVariable = &Q.GroupTemplateVariable
 	Hook = &Q.Hook
 	HookRevision = &Q.HookRevision
 	IDGenerator = &Q.IDGenerator
@@ -93,16 +119,22 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	ReleasedAppTemplateVariable = &Q.ReleasedAppTemplateVariable
 	ReleasedConfigItem = &Q.ReleasedConfigItem
 	ReleasedGroup = &Q.ReleasedGroup
+	ReleasedGroupConfigItem = &Q.ReleasedGroupConfigItem
 	ReleasedHook = &Q.ReleasedHook
 	ReleasedKv = &Q.ReleasedKv
 	ResourceLock = &Q.ResourceLock
+	Role = &Q.Role
+	RoleBinding = &Q.RoleBinding
 	Strategy = &Q.Strategy
 	TaskBatch = &Q.TaskBatch
 	Template = &Q.Template
 	TemplateRevision = &Q.TemplateRevision
 	TemplateSet = &Q.TemplateSet
 	TemplateSpace = &Q.TemplateSpace
+	TemplateSpaceHook = &Q.TemplateSpaceHook
 	TemplateVariable = &Q.TemplateVariable
+	Webhook = &Q.Webhook
+	WebhookDelivery = &Q.WebhookDelivery
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
@@ -111,11 +143,18 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		App:                         newApp(db, opts...),
 		AppTemplateBinding:          newAppTemplateBinding(db, opts...),
 		AppTemplateVariable:         newAppTemplateVariable(db, opts...),
+		ApprovalAction:              newApprovalAction(db, opts...),
+		ApprovalFlow:                newApprovalFlow(db, opts...),
+		ApprovalTicket:              newApprovalTicket(db, opts...),
 		ArchivedApp:                 newArchivedApp(db, opts...),
 		Audit:                       newAudit(db, opts...),
 		BizHost:                     newBizHost(db, opts...),
 		Client:                      newClient(db, opts...),
+		ChangeFreeze:                newChangeFreeze(db, opts...),
+		ClientAlertHistory:          newClientAlertHistory(db, opts...),
+		ClientAlertRule:             newClientAlertRule(db, opts...),
 		ClientEvent:                 newClientEvent(db, opts...),
+		ClientMetric:                newClientMetric(db, opts...),
 		ClientQuery:                 newClientQuery(db, opts...),
 		Commit:                      newCommit(db, opts...),
 		Config:                      newConfig(db, opts...),
@@ -124,10 +163,13 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		ConfigTemplate:              newConfigTemplate(db, opts...),
 		Content:                     newContent(db, opts...),
 		Credential:                  newCredential(db, opts...),
+		CredentialClient:            newCredentialClient(db, opts...),
 		CredentialScope:             newCredentialScope(db, opts...),
 		Event:                       newEvent(db, opts...),
+		GitRepoLink:                 newGitRepoLink(db, opts...),
 		Group:                       newGroup(db, opts...),
 		GroupAppBind:                newGroupAppBind(db, opts...),
+		GroupTemplateVariable:       newGroupTemplateVariable(db, opts...),
 		Hook:                        newHook(db, opts...),
 		HookRevision:                newHookRevision(db, opts...),
 		IDGenerator:                 newIDGenerator(db, opts...),
@@ -139,16 +181,22 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		ReleasedAppTemplateVariable: newReleasedAppTemplateVariable(db, opts...),
 		ReleasedConfigItem:          newReleasedConfigItem(db, opts...),
 		ReleasedGroup:               newReleasedGroup(db, opts...),
+		ReleasedGroupConfigItem:     newReleasedGroupConfigItem(db, opts...),
 		ReleasedHook:                newReleasedHook(db, opts...),
 		ReleasedKv:                  newReleasedKv(db, opts...),
 		ResourceLock:                newResourceLock(db, opts...),
+		Role:                        newRole(db, opts...),
+		RoleBinding:                 newRoleBinding(db, opts...),
 		Strategy:                    newStrategy(db, opts...),
 		TaskBatch:                   newTaskBatch(db, opts...),
 		Template:                    newTemplate(db, opts...),
 		TemplateRevision:            newTemplateRevision(db, opts...),
 		TemplateSet:                 newTemplateSet(db, opts...),
 		TemplateSpace:               newTemplateSpace(db, opts...),
+		TemplateSpaceHook:           newTemplateSpaceHook(db, opts...),
 		TemplateVariable:            newTemplateVariable(db, opts...),
+		Webhook:                     newWebhook(db, opts...),
+		WebhookDelivery:             newWebhookDelivery(db, opts...),
 	}
 }
 
@@ -158,11 +206,18 @@ type Query struct {
 	App                         app
 	AppTemplateBinding          appTemplateBinding
 	AppTemplateVariable         appTemplateVariable
+	ApprovalAction              approvalAction
+	ApprovalFlow                approvalFlow
+	ApprovalTicket              approvalTicket
 	ArchivedApp                 archivedApp
 	Audit                       audit
 	BizHost                     bizHost
 	Client                      client
+	ChangeFreeze                changeFreeze
+	ClientAlertHistory          clientAlertHistory
+	ClientAlertRule             clientAlertRule
 	ClientEvent                 clientEvent
+	ClientMetric                clientMetric
 	ClientQuery                 clientQuery
 	Commit                      commit
 	Config                      config
@@ -171,10 +226,13 @@ type Query struct {
 	ConfigTemplate              configTemplate
 	Content                     content
 	Credential                  credential
+	CredentialClient            credentialClient
 	CredentialScope             credentialScope
 	Event                       event
+	GitRepoLink                 gitRepoLink
 	Group                       group
 	GroupAppBind                groupAppBind
+	GroupTemplateVariable       groupTemplateVariable
 	Hook                        hook
 	HookRevision                hookRevision
 	IDGenerator                 iDGenerator
@@ -186,16 +244,22 @@ type Query struct {
 	ReleasedAppTemplateVariable releasedAppTemplateVariable
 	ReleasedConfigItem          releasedConfigItem
 	ReleasedGroup               releasedGroup
+	ReleasedGroupConfigItem     releasedGroupConfigItem
 	ReleasedHook                releasedHook
 	ReleasedKv                  releasedKv
 	ResourceLock                resourceLock
+	Role                        role
+	RoleBinding                 roleBinding
 	Strategy                    strategy
 	TaskBatch                   taskBatch
 	Template                    template
 	TemplateRevision            templateRevision
 	TemplateSet                 templateSet
 	TemplateSpace               templateSpace
+	TemplateSpaceHook           templateSpaceHook
 	TemplateVariable            templateVariable
+	Webhook                     webhook
+	WebhookDelivery             webhookDelivery
 }
 
 func (q *Query) Available() bool { return q.db != nil }
@@ -206,11 +270,18 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		App:                         q.App.clone(db),
 		AppTemplateBinding:          q.AppTemplateBinding.clone(db),
 		AppTemplateVariable:         q.AppTemplateVariable.clone(db),
+		ApprovalAction:              q.ApprovalAction.clone(db),
+		ApprovalFlow:                q.ApprovalFlow.clone(db),
+		ApprovalTicket:              q.ApprovalTicket.clone(db),
 		ArchivedApp:                 q.ArchivedApp.clone(db),
 		Audit:                       q.Audit.clone(db),
 		BizHost:                     q.BizHost.clone(db),
 		Client:                      q.Client.clone(db),
+		ChangeFreeze:                q.ChangeFreeze.clone(db),
+		ClientAlertHistory:          q.ClientAlertHistory.clone(db),
+		ClientAlertRule:             q.ClientAlertRule.clone(db),
 		ClientEvent:                 q.ClientEvent.clone(db),
+		ClientMetric:                q.ClientMetric.clone(db),
 		ClientQuery:                 q.ClientQuery.clone(db),
 		Commit:                      q.Commit.clone(db),
 		Config:                      q.Config.clone(db),
@@ -219,10 +290,13 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		ConfigTemplate:              q.ConfigTemplate.clone(db),
 		Content:                     q.Content.clone(db),
 		Credential:                  q.Credential.clone(db),
+		CredentialClient:            q.CredentialClient.clone(db),
 		CredentialScope:             q.CredentialScope.clone(db),
 		Event:                       q.Event.clone(db),
+		GitRepoLink:                 q.GitRepoLink.clone(db),
 		Group:                       q.Group.clone(db),
 		GroupAppBind:                q.GroupAppBind.clone(db),
+		GroupTemplateVariable:       q.GroupTemplateVariable.clone(db),
 		Hook:                        q.Hook.clone(db),
 		HookRevision:                q.HookRevision.clone(db),
 		IDGenerator:                 q.IDGenerator.clone(db),
@@ -234,16 +308,22 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		ReleasedAppTemplateVariable: q.ReleasedAppTemplateVariable.clone(db),
 		ReleasedConfigItem:          q.ReleasedConfigItem.clone(db),
 		ReleasedGroup:               q.ReleasedGroup.clone(db),
+		ReleasedGroupConfigItem:     q.ReleasedGroupConfigItem.clone(db),
 		ReleasedHook:                q.ReleasedHook.clone(db),
 		ReleasedKv:                  q.ReleasedKv.clone(db),
 		ResourceLock:                q.ResourceLock.clone(db),
+		Role:                        q.Role.clone(db),
+		RoleBinding:                 q.RoleBinding.clone(db),
 		Strategy:                    q.Strategy.clone(db),
 		TaskBatch:                   q.TaskBatch.clone(db),
 		Template:                    q.Template.clone(db),
 		TemplateRevision:            q.TemplateRevision.clone(db),
 		TemplateSet:                 q.TemplateSet.clone(db),
 		TemplateSpace:               q.TemplateSpace.clone(db),
+		TemplateSpaceHook:           q.TemplateSpaceHook.clone(db),
 		TemplateVariable:            q.TemplateVariable.clone(db),
+		Webhook:                     q.Webhook.clone(db),
+		WebhookDelivery:             q.WebhookDelivery.clone(db),
 	}
 }
 
@@ -261,11 +341,18 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		App:                         q.App.replaceDB(db),
 		AppTemplateBinding:          q.AppTemplateBinding.replaceDB(db),
 		AppTemplateVariable:         q.AppTemplateVariable.replaceDB(db),
+		ApprovalAction:              q.ApprovalAction.replaceDB(db),
+		ApprovalFlow:                q.ApprovalFlow.replaceDB(db),
+		ApprovalTicket:              q.ApprovalTicket.replaceDB(db),
 		ArchivedApp:                 q.ArchivedApp.replaceDB(db),
 		Audit:                       q.Audit.replaceDB(db),
 		BizHost:                     q.BizHost.replaceDB(db),
 		Client:                      q.Client.replaceDB(db),
+		ChangeFreeze:                q.ChangeFreeze.replaceDB(db),
+		ClientAlertHistory:          q.ClientAlertHistory.replaceDB(db),
+		ClientAlertRule:             q.ClientAlertRule.replaceDB(db),
 		ClientEvent:                 q.ClientEvent.replaceDB(db),
+		ClientMetric:                q.ClientMetric.replaceDB(db),
 		ClientQuery:                 q.ClientQuery.replaceDB(db),
 		Commit:                      q.Commit.replaceDB(db),
 		Config:                      q.Config.replaceDB(db),
@@ -274,10 +361,13 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		ConfigTemplate:              q.ConfigTemplate.replaceDB(db),
 		Content:                     q.Content.replaceDB(db),
 		Credential:                  q.Credential.replaceDB(db),
+		CredentialClient:            q.CredentialClient.replaceDB(db),
 		CredentialScope:             q.CredentialScope.replaceDB(db),
 		Event:                       q.Event.replaceDB(db),
+		GitRepoLink:                 q.GitRepoLink.replaceDB(db),
 		Group:                       q.Group.replaceDB(db),
 		GroupAppBind:                q.GroupAppBind.replaceDB(db),
+		GroupTemplateVariable:       q.GroupTemplateVariable.replaceDB(db),
 		Hook:                        q.Hook.replaceDB(db),
 		HookRevision:                q.HookRevision.replaceDB(db),
 		IDGenerator:                 q.IDGenerator.replaceDB(db),
@@ -289,16 +379,22 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		ReleasedAppTemplateVariable: q.ReleasedAppTemplateVariable.replaceDB(db),
 		ReleasedConfigItem:          q.ReleasedConfigItem.replaceDB(db),
 		ReleasedGroup:               q.ReleasedGroup.replaceDB(db),
+		ReleasedGroupConfigItem:     q.ReleasedGroupConfigItem.replaceDB(db),
 		ReleasedHook:                q.ReleasedHook.replaceDB(db),
 		ReleasedKv:                  q.ReleasedKv.replaceDB(db),
 		ResourceLock:                q.ResourceLock.replaceDB(db),
+		Role:                        q.Role.replaceDB(db),
+		RoleBinding:                 q.RoleBinding.replaceDB(db),
 		Strategy:                    q.Strategy.replaceDB(db),
 		TaskBatch:                   q.TaskBatch.replaceDB(db),
 		Template:                    q.Template.replaceDB(db),
 		TemplateRevision:            q.TemplateRevision.replaceDB(db),
 		TemplateSet:                 q.TemplateSet.replaceDB(db),
 		TemplateSpace:               q.TemplateSpace.replaceDB(db),
+		TemplateSpaceHook:           q.TemplateSpaceHook.replaceDB(db),
 		TemplateVariable:            q.TemplateVariable.replaceDB(db),
+		Webhook:                     q.Webhook.replaceDB(db),
+		WebhookDelivery:             q.WebhookDelivery.replaceDB(db),
 	}
 }
 
@@ -306,11 +402,18 @@ type queryCtx struct {
 	App                         IAppDo
 	AppTemplateBinding          IAppTemplateBindingDo
 	AppTemplateVariable         IAppTemplateVariableDo
+	ApprovalAction              IApprovalActionDo
+	ApprovalFlow                IApprovalFlowDo
+	ApprovalTicket              IApprovalTicketDo
 	ArchivedApp                 IArchivedAppDo
 	Audit                       IAuditDo
 	BizHost                     IBizHostDo
 	Client                      IClientDo
+	ChangeFreeze                IChangeFreezeDo
+	ClientAlertHistory          IClientAlertHistoryDo
+	ClientAlertRule             IClientAlertRuleDo
 	ClientEvent                 IClientEventDo
+	ClientMetric                IClientMetricDo
 	ClientQuery                 IClientQueryDo
 	Commit                      ICommitDo
 	Config                      IConfigDo
@@ -319,10 +422,13 @@ type queryCtx struct {
 	ConfigTemplate              IConfigTemplateDo
 	Content                     IContentDo
 	Credential                  ICredentialDo
+	CredentialClient            ICredentialClientDo
 	CredentialScope             ICredentialScopeDo
 	Event                       IEventDo
+	GitRepoLink                 IGitRepoLinkDo
 	Group                       IGroupDo
 	GroupAppBind                IGroupAppBindDo
+	GroupTemplateVariable       IGroupTemplateVariableDo
 	Hook                        IHookDo
 	HookRevision                IHookRevisionDo
 	IDGenerator                 IIDGeneratorDo
@@ -334,16 +440,22 @@ type queryCtx struct {
 	ReleasedAppTemplateVariable IReleasedAppTemplateVariableDo
 	ReleasedConfigItem          IReleasedConfigItemDo
 	ReleasedGroup               IReleasedGroupDo
+	ReleasedGroupConfigItem     IReleasedGroupConfigItemDo
 	ReleasedHook                IReleasedHookDo
 	ReleasedKv                  IReleasedKvDo
 	ResourceLock                IResourceLockDo
+	Role                        IRoleDo
+	RoleBinding                 IRoleBindingDo
 	Strategy                    IStrategyDo
 	TaskBatch                   ITaskBatchDo
 	Template                    ITemplateDo
 	TemplateRevision            ITemplateRevisionDo
 	TemplateSet                 ITemplateSetDo
 	TemplateSpace               ITemplateSpaceDo
+	TemplateSpaceHook           ITemplateSpaceHookDo
 	TemplateVariable            ITemplateVariableDo
+	Webhook                     IWebhookDo
+	WebhookDelivery             IWebhookDeliveryDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
@@ -351,11 +463,18 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		App:                         q.App.WithContext(ctx),
 		AppTemplateBinding:          q.AppTemplateBinding.WithContext(ctx),
 		AppTemplateVariable:         q.AppTemplateVariable.WithContext(ctx),
+		ApprovalAction:              q.ApprovalAction.WithContext(ctx),
+		ApprovalFlow:                q.ApprovalFlow.WithContext(ctx),
+		ApprovalTicket:              q.ApprovalTicket.WithContext(ctx),
 		ArchivedApp:                 q.ArchivedApp.WithContext(ctx),
 		Audit:                       q.Audit.WithContext(ctx),
 		BizHost:                     q.BizHost.WithContext(ctx),
 		Client:                      q.Client.WithContext(ctx),
+		ChangeFreeze:                q.ChangeFreeze.WithContext(ctx),
+		ClientAlertHistory:          q.ClientAlertHistory.WithContext(ctx),
+		ClientAlertRule:             q.ClientAlertRule.WithContext(ctx),
 		ClientEvent:                 q.ClientEvent.WithContext(ctx),
+		ClientMetric:                q.ClientMetric.WithContext(ctx),
 		ClientQuery:                 q.ClientQuery.WithContext(ctx),
 		Commit:                      q.Commit.WithContext(ctx),
 		Config:                      q.Config.WithContext(ctx),
@@ -364,10 +483,13 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		ConfigTemplate:              q.ConfigTemplate.WithContext(ctx),
 		Content:                     q.Content.WithContext(ctx),
 		Credential:                  q.Credential.WithContext(ctx),
+		CredentialClient:            q.CredentialClient.WithContext(ctx),
 		CredentialScope:             q.CredentialScope.WithContext(ctx),
 		Event:                       q.Event.WithContext(ctx),
+		GitRepoLink:                 q.GitRepoLink.WithContext(ctx),
 		Group:                       q.Group.WithContext(ctx),
 		GroupAppBind:                q.GroupAppBind.WithContext(ctx),
+		GroupTemplateVariable:       q.GroupTemplateVariable.WithContext(ctx),
 		Hook:                        q.Hook.WithContext(ctx),
 		HookRevision:                q.HookRevision.WithContext(ctx),
 		IDGenerator:                 q.IDGenerator.WithContext(ctx),
@@ -379,16 +501,22 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		ReleasedAppTemplateVariable: q.ReleasedAppTemplateVariable.WithContext(ctx),
 		ReleasedConfigItem:          q.ReleasedConfigItem.WithContext(ctx),
 		ReleasedGroup:               q.ReleasedGroup.WithContext(ctx),
+		ReleasedGroupConfigItem:     q.ReleasedGroupConfigItem.WithContext(ctx),
 		ReleasedHook:                q.ReleasedHook.WithContext(ctx),
 		ReleasedKv:                  q.ReleasedKv.WithContext(ctx),
 		ResourceLock:                q.ResourceLock.WithContext(ctx),
+		Role:                        q.Role.WithContext(ctx),
+		RoleBinding:                 q.RoleBinding.WithContext(ctx),
 		Strategy:                    q.Strategy.WithContext(ctx),
 		TaskBatch:                   q.TaskBatch.WithContext(ctx),
 		Template:                    q.Template.WithContext(ctx),
 		TemplateRevision:            q.TemplateRevision.WithContext(ctx),
 		TemplateSet:                 q.TemplateSet.WithContext(ctx),
 		TemplateSpace:               q.TemplateSpace.WithContext(ctx),
+		TemplateSpaceHook:           q.TemplateSpaceHook.WithContext(ctx),
 		TemplateVariable:            q.TemplateVariable.WithContext(ctx),
+		Webhook:                     q.Webhook.WithContext(ctx),
+		WebhookDelivery:             q.WebhookDelivery.WithContext(ctx),
 	}
 }
 
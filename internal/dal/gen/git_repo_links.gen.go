@@ -0,0 +1,470 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newGitRepoLink(db *gorm.DB, opts ...gen.DOOption) gitRepoLink {
+	_gitRepoLink := gitRepoLink{}
+
+	_gitRepoLink.gitRepoLinkDo.UseDB(db, opts...)
+	_gitRepoLink.gitRepoLinkDo.UseModel(&table.GitRepoLink{})
+
+	tableName := _gitRepoLink.gitRepoLinkDo.TableName()
+	_gitRepoLink.ALL = field.NewAsterisk(tableName)
+	_gitRepoLink.ID = field.NewUint32(tableName, "id")
+	_gitRepoLink.RepoURL = field.NewString(tableName, "repo_url")
+	_gitRepoLink.Ref = field.NewString(tableName, "ref")
+	_gitRepoLink.SubPath = field.NewString(tableName, "sub_path")
+	_gitRepoLink.Username = field.NewString(tableName, "username")
+	_gitRepoLink.SyncInterval = field.NewUint32(tableName, "sync_interval")
+	_gitRepoLink.ReplaceAll = field.NewBool(tableName, "replace_all")
+	_gitRepoLink.WebhookTokenHash = field.NewString(tableName, "webhook_token_hash")
+	_gitRepoLink.Memo = field.NewString(tableName, "memo")
+	_gitRepoLink.SyncStatus = field.NewString(tableName, "sync_status")
+	_gitRepoLink.SyncedSHA = field.NewString(tableName, "synced_sha")
+	_gitRepoLink.SyncMessage = field.NewString(tableName, "sync_message")
+	_gitRepoLink.SyncedAt = field.NewTime(tableName, "synced_at")
+	_gitRepoLink.BizID = field.NewUint32(tableName, "biz_id")
+	_gitRepoLink.AppID = field.NewUint32(tableName, "app_id")
+	_gitRepoLink.TemplateSpaceID = field.NewUint32(tableName, "template_space_id")
+	_gitRepoLink.TenantID = field.NewString(tableName, "tenant_id")
+	_gitRepoLink.Creator = field.NewString(tableName, "creator")
+	_gitRepoLink.Reviser = field.NewString(tableName, "reviser")
+	_gitRepoLink.CreatedAt = field.NewTime(tableName, "created_at")
+	_gitRepoLink.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_gitRepoLink.fillFieldMap()
+
+	return _gitRepoLink
+}
+
+type gitRepoLink struct {
+	gitRepoLinkDo gitRepoLinkDo
+
+	ALL              field.Asterisk
+	ID               field.Uint32
+	RepoURL          field.String
+	Ref              field.String
+	SubPath          field.String
+	Username         field.String
+	SyncInterval     field.Uint32
+	ReplaceAll       field.Bool
+	WebhookTokenHash field.String
+	Memo             field.String
+	SyncStatus       field.String
+	SyncedSHA        field.String
+	SyncMessage      field.String
+	SyncedAt         field.Time
+	BizID            field.Uint32
+	AppID            field.Uint32
+	TemplateSpaceID  field.Uint32
+	TenantID         field.String
+	Creator          field.String
+	Reviser          field.String
+	CreatedAt        field.Time
+	UpdatedAt        field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (g gitRepoLink) Table(newTableName string) *gitRepoLink {
+	g.gitRepoLinkDo.UseTable(newTableName)
+	return g.updateTableName(newTableName)
+}
+
+func (g gitRepoLink) As(alias string) *gitRepoLink {
+	g.gitRepoLinkDo.DO = *(g.gitRepoLinkDo.As(alias).(*gen.DO))
+	return g.updateTableName(alias)
+}
+
+func (g *gitRepoLink) updateTableName(table string) *gitRepoLink {
+	g.ALL = field.NewAsterisk(table)
+	g.ID = field.NewUint32(table, "id")
+	g.RepoURL = field.NewString(table, "repo_url")
+	g.Ref = field.NewString(table, "ref")
+	g.SubPath = field.NewString(table, "sub_path")
+	g.Username = field.NewString(table, "username")
+	g.SyncInterval = field.NewUint32(table, "sync_interval")
+	g.ReplaceAll = field.NewBool(table, "replace_all")
+	g.WebhookTokenHash = field.NewString(table, "webhook_token_hash")
+	g.Memo = field.NewString(table, "memo")
+	g.SyncStatus = field.NewString(table, "sync_status")
+	g.SyncedSHA = field.NewString(table, "synced_sha")
+	g.SyncMessage = field.NewString(table, "sync_message")
+	g.SyncedAt = field.NewTime(table, "synced_at")
+	g.BizID = field.NewUint32(table, "biz_id")
+	g.AppID = field.NewUint32(table, "app_id")
+	g.TemplateSpaceID = field.NewUint32(table, "template_space_id")
+	g.TenantID = field.NewString(table, "tenant_id")
+	g.Creator = field.NewString(table, "creator")
+	g.Reviser = field.NewString(table, "reviser")
+	g.CreatedAt = field.NewTime(table, "created_at")
+	g.UpdatedAt = field.NewTime(table, "updated_at")
+
+	g.fillFieldMap()
+
+	return g
+}
+
+func (g *gitRepoLink) WithContext(ctx context.Context) IGitRepoLinkDo {
+	return g.gitRepoLinkDo.WithContext(ctx)
+}
+
+func (g gitRepoLink) TableName() string { return g.gitRepoLinkDo.TableName() }
+
+func (g gitRepoLink) Alias() string { return g.gitRepoLinkDo.Alias() }
+
+func (g gitRepoLink) Columns(cols ...field.Expr) gen.Columns { return g.gitRepoLinkDo.Columns(cols...) }
+
+func (g *gitRepoLink) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := g.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (g *gitRepoLink) fillFieldMap() {
+	g.fieldMap = make(map[string]field.Expr, 21)
+	g.fieldMap["id"] = g.ID
+	g.fieldMap["repo_url"] = g.RepoURL
+	g.fieldMap["ref"] = g.Ref
+	g.fieldMap["sub_path"] = g.SubPath
+	g.fieldMap["username"] = g.Username
+	g.fieldMap["sync_interval"] = g.SyncInterval
+	g.fieldMap["replace_all"] = g.ReplaceAll
+	g.fieldMap["webhook_token_hash"] = g.WebhookTokenHash
+	g.fieldMap["memo"] = g.Memo
+	g.fieldMap["sync_status"] = g.SyncStatus
+	g.fieldMap["synced_sha"] = g.SyncedSHA
+	g.fieldMap["sync_message"] = g.SyncMessage
+	g.fieldMap["synced_at"] = g.SyncedAt
+	g.fieldMap["biz_id"] = g.BizID
+	g.fieldMap["app_id"] = g.AppID
+	g.fieldMap["template_space_id"] = g.TemplateSpaceID
+	g.fieldMap["tenant_id"] = g.TenantID
+	g.fieldMap["creator"] = g.Creator
+	g.fieldMap["reviser"] = g.Reviser
+	g.fieldMap["created_at"] = g.CreatedAt
+	g.fieldMap["updated_at"] = g.UpdatedAt
+}
+
+func (g gitRepoLink) clone(db *gorm.DB) gitRepoLink {
+	g.gitRepoLinkDo.ReplaceConnPool(db.Statement.ConnPool)
+	return g
+}
+
+func (g gitRepoLink) replaceDB(db *gorm.DB) gitRepoLink {
+	g.gitRepoLinkDo.ReplaceDB(db)
+	return g
+}
+
+type gitRepoLinkDo struct{ gen.DO }
+
+type IGitRepoLinkDo interface {
+	gen.SubQuery
+	Debug() IGitRepoLinkDo
+	WithContext(ctx context.Context) IGitRepoLinkDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IGitRepoLinkDo
+	WriteDB() IGitRepoLinkDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IGitRepoLinkDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IGitRepoLinkDo
+	Not(conds ...gen.Condition) IGitRepoLinkDo
+	Or(conds ...gen.Condition) IGitRepoLinkDo
+	Select(conds ...field.Expr) IGitRepoLinkDo
+	Where(conds ...gen.Condition) IGitRepoLinkDo
+	Order(conds ...field.Expr) IGitRepoLinkDo
+	Distinct(cols ...field.Expr) IGitRepoLinkDo
+	Omit(cols ...field.Expr) IGitRepoLinkDo
+	Join(table schema.Tabler, on ...field.Expr) IGitRepoLinkDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IGitRepoLinkDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IGitRepoLinkDo
+	Group(cols ...field.Expr) IGitRepoLinkDo
+	Having(conds ...gen.Condition) IGitRepoLinkDo
+	Limit(limit int) IGitRepoLinkDo
+	Offset(offset int) IGitRepoLinkDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IGitRepoLinkDo
+	Unscoped() IGitRepoLinkDo
+	Create(values ...*table.GitRepoLink) error
+	CreateInBatches(values []*table.GitRepoLink, batchSize int) error
+	Save(values ...*table.GitRepoLink) error
+	First() (*table.GitRepoLink, error)
+	Take() (*table.GitRepoLink, error)
+	Last() (*table.GitRepoLink, error)
+	Find() ([]*table.GitRepoLink, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.GitRepoLink, err error)
+	FindInBatches(result *[]*table.GitRepoLink, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.GitRepoLink) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IGitRepoLinkDo
+	Assign(attrs ...field.AssignExpr) IGitRepoLinkDo
+	Joins(fields ...field.RelationField) IGitRepoLinkDo
+	Preload(fields ...field.RelationField) IGitRepoLinkDo
+	FirstOrInit() (*table.GitRepoLink, error)
+	FirstOrCreate() (*table.GitRepoLink, error)
+	FindByPage(offset int, limit int) (result []*table.GitRepoLink, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IGitRepoLinkDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (g gitRepoLinkDo) Debug() IGitRepoLinkDo {
+	return g.withDO(g.DO.Debug())
+}
+
+func (g gitRepoLinkDo) WithContext(ctx context.Context) IGitRepoLinkDo {
+	return g.withDO(g.DO.WithContext(ctx))
+}
+
+func (g gitRepoLinkDo) ReadDB() IGitRepoLinkDo {
+	return g.Clauses(dbresolver.Read)
+}
+
+func (g gitRepoLinkDo) WriteDB() IGitRepoLinkDo {
+	return g.Clauses(dbresolver.Write)
+}
+
+func (g gitRepoLinkDo) Session(config *gorm.Session) IGitRepoLinkDo {
+	return g.withDO(g.DO.Session(config))
+}
+
+func (g gitRepoLinkDo) Clauses(conds ...clause.Expression) IGitRepoLinkDo {
+	return g.withDO(g.DO.Clauses(conds...))
+}
+
+func (g gitRepoLinkDo) Returning(value interface{}, columns ...string) IGitRepoLinkDo {
+	return g.withDO(g.DO.Returning(value, columns...))
+}
+
+func (g gitRepoLinkDo) Not(conds ...gen.Condition) IGitRepoLinkDo {
+	return g.withDO(g.DO.Not(conds...))
+}
+
+func (g gitRepoLinkDo) Or(conds ...gen.Condition) IGitRepoLinkDo {
+	return g.withDO(g.DO.Or(conds...))
+}
+
+func (g gitRepoLinkDo) Select(conds ...field.Expr) IGitRepoLinkDo {
+	return g.withDO(g.DO.Select(conds...))
+}
+
+func (g gitRepoLinkDo) Where(conds ...gen.Condition) IGitRepoLinkDo {
+	return g.withDO(g.DO.Where(conds...))
+}
+
+func (g gitRepoLinkDo) Order(conds ...field.Expr) IGitRepoLinkDo {
+	return g.withDO(g.DO.Order(conds...))
+}
+
+func (g gitRepoLinkDo) Distinct(cols ...field.Expr) IGitRepoLinkDo {
+	return g.withDO(g.DO.Distinct(cols...))
+}
+
+func (g gitRepoLinkDo) Omit(cols ...field.Expr) IGitRepoLinkDo {
+	return g.withDO(g.DO.Omit(cols...))
+}
+
+func (g gitRepoLinkDo) Join(table schema.Tabler, on ...field.Expr) IGitRepoLinkDo {
+	return g.withDO(g.DO.Join(table, on...))
+}
+
+func (g gitRepoLinkDo) LeftJoin(table schema.Tabler, on ...field.Expr) IGitRepoLinkDo {
+	return g.withDO(g.DO.LeftJoin(table, on...))
+}
+
+func (g gitRepoLinkDo) RightJoin(table schema.Tabler, on ...field.Expr) IGitRepoLinkDo {
+	return g.withDO(g.DO.RightJoin(table, on...))
+}
+
+func (g gitRepoLinkDo) Group(cols ...field.Expr) IGitRepoLinkDo {
+	return g.withDO(g.DO.Group(cols...))
+}
+
+func (g gitRepoLinkDo) Having(conds ...gen.Condition) IGitRepoLinkDo {
+	return g.withDO(g.DO.Having(conds...))
+}
+
+func (g gitRepoLinkDo) Limit(limit int) IGitRepoLinkDo {
+	return g.withDO(g.DO.Limit(limit))
+}
+
+func (g gitRepoLinkDo) Offset(offset int) IGitRepoLinkDo {
+	return g.withDO(g.DO.Offset(offset))
+}
+
+func (g gitRepoLinkDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IGitRepoLinkDo {
+	return g.withDO(g.DO.Scopes(funcs...))
+}
+
+func (g gitRepoLinkDo) Unscoped() IGitRepoLinkDo {
+	return g.withDO(g.DO.Unscoped())
+}
+
+func (g gitRepoLinkDo) Create(values ...*table.GitRepoLink) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Create(values)
+}
+
+func (g gitRepoLinkDo) CreateInBatches(values []*table.GitRepoLink, batchSize int) error {
+	return g.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (g gitRepoLinkDo) Save(values ...*table.GitRepoLink) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Save(values)
+}
+
+func (g gitRepoLinkDo) First() (*table.GitRepoLink, error) {
+	if result, err := g.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GitRepoLink), nil
+	}
+}
+
+func (g gitRepoLinkDo) Take() (*table.GitRepoLink, error) {
+	if result, err := g.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GitRepoLink), nil
+	}
+}
+
+func (g gitRepoLinkDo) Last() (*table.GitRepoLink, error) {
+	if result, err := g.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GitRepoLink), nil
+	}
+}
+
+func (g gitRepoLinkDo) Find() ([]*table.GitRepoLink, error) {
+	result, err := g.DO.Find()
+	return result.([]*table.GitRepoLink), err
+}
+
+func (g gitRepoLinkDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.GitRepoLink, err error) {
+	buf := make([]*table.GitRepoLink, 0, batchSize)
+	err = g.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (g gitRepoLinkDo) FindInBatches(result *[]*table.GitRepoLink, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return g.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (g gitRepoLinkDo) Attrs(attrs ...field.AssignExpr) IGitRepoLinkDo {
+	return g.withDO(g.DO.Attrs(attrs...))
+}
+
+func (g gitRepoLinkDo) Assign(attrs ...field.AssignExpr) IGitRepoLinkDo {
+	return g.withDO(g.DO.Assign(attrs...))
+}
+
+func (g gitRepoLinkDo) Joins(fields ...field.RelationField) IGitRepoLinkDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Joins(_f))
+	}
+	return &g
+}
+
+func (g gitRepoLinkDo) Preload(fields ...field.RelationField) IGitRepoLinkDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Preload(_f))
+	}
+	return &g
+}
+
+func (g gitRepoLinkDo) FirstOrInit() (*table.GitRepoLink, error) {
+	if result, err := g.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GitRepoLink), nil
+	}
+}
+
+func (g gitRepoLinkDo) FirstOrCreate() (*table.GitRepoLink, error) {
+	if result, err := g.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GitRepoLink), nil
+	}
+}
+
+func (g gitRepoLinkDo) FindByPage(offset int, limit int) (result []*table.GitRepoLink, count int64, err error) {
+	result, err = g.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = g.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (g gitRepoLinkDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = g.Count()
+	if err != nil {
+		return
+	}
+
+	err = g.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (g gitRepoLinkDo) Scan(result interface{}) (err error) {
+	return g.DO.Scan(result)
+}
+
+func (g gitRepoLinkDo) Delete(models ...*table.GitRepoLink) (result gen.ResultInfo, err error) {
+	return g.DO.Delete(models)
+}
+
+func (g *gitRepoLinkDo) withDO(do gen.Dao) *gitRepoLinkDo {
+	g.DO = *do.(*gen.DO)
+	return g
+}
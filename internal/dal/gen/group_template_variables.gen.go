@@ -0,0 +1,428 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newGroupTemplateVariable(db *gorm.DB, opts ...gen.DOOption) groupTemplateVariable {
+	_groupTemplateVariable := groupTemplateVariable{}
+
+	_groupTemplateVariable.groupTemplateVariableDo.UseDB(db, opts...)
+	_groupTemplateVariable.groupTemplateVariableDo.UseModel(&table.GroupTemplateVariable{})
+
+	tableName := _groupTemplateVariable.groupTemplateVariableDo.TableName()
+	_groupTemplateVariable.ALL = field.NewAsterisk(tableName)
+	_groupTemplateVariable.ID = field.NewUint32(tableName, "id")
+	_groupTemplateVariable.Variables = field.NewField(tableName, "variables")
+	_groupTemplateVariable.BizID = field.NewUint32(tableName, "biz_id")
+	_groupTemplateVariable.AppID = field.NewUint32(tableName, "app_id")
+	_groupTemplateVariable.GroupID = field.NewUint32(tableName, "group_id")
+	_groupTemplateVariable.TenantID = field.NewString(tableName, "tenant_id")
+	_groupTemplateVariable.Creator = field.NewString(tableName, "creator")
+	_groupTemplateVariable.Reviser = field.NewString(tableName, "reviser")
+	_groupTemplateVariable.CreatedAt = field.NewTime(tableName, "created_at")
+	_groupTemplateVariable.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_groupTemplateVariable.fillFieldMap()
+
+	return _groupTemplateVariable
+}
+
+type groupTemplateVariable struct {
+	groupTemplateVariableDo groupTemplateVariableDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	Variables field.Field
+	BizID     field.Uint32
+	AppID     field.Uint32
+	GroupID   field.Uint32
+	TenantID  field.String
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (g groupTemplateVariable) Table(newTableName string) *groupTemplateVariable {
+	g.groupTemplateVariableDo.UseTable(newTableName)
+	return g.updateTableName(newTableName)
+}
+
+func (g groupTemplateVariable) As(alias string) *groupTemplateVariable {
+	g.groupTemplateVariableDo.DO = *(g.groupTemplateVariableDo.As(alias).(*gen.DO))
+	return g.updateTableName(alias)
+}
+
+func (g *groupTemplateVariable) updateTableName(table string) *groupTemplateVariable {
+	g.ALL = field.NewAsterisk(table)
+	g.ID = field.NewUint32(table, "id")
+	g.Variables = field.NewField(table, "variables")
+	g.BizID = field.NewUint32(table, "biz_id")
+	g.AppID = field.NewUint32(table, "app_id")
+	g.GroupID = field.NewUint32(table, "group_id")
+	g.TenantID = field.NewString(table, "tenant_id")
+	g.Creator = field.NewString(table, "creator")
+	g.Reviser = field.NewString(table, "reviser")
+	g.CreatedAt = field.NewTime(table, "created_at")
+	g.UpdatedAt = field.NewTime(table, "updated_at")
+
+	g.fillFieldMap()
+
+	return g
+}
+
+func (g *groupTemplateVariable) WithContext(ctx context.Context) IGroupTemplateVariableDo {
+	return g.groupTemplateVariableDo.WithContext(ctx)
+}
+
+func (g groupTemplateVariable) TableName() string { return g.groupTemplateVariableDo.TableName() }
+
+func (g groupTemplateVariable) Alias() string { return g.groupTemplateVariableDo.Alias() }
+
+func (g groupTemplateVariable) Columns(cols ...field.Expr) gen.Columns {
+	return g.groupTemplateVariableDo.Columns(cols...)
+}
+
+func (g *groupTemplateVariable) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := g.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (g *groupTemplateVariable) fillFieldMap() {
+	g.fieldMap = make(map[string]field.Expr, 10)
+	g.fieldMap["id"] = g.ID
+	g.fieldMap["variables"] = g.Variables
+	g.fieldMap["biz_id"] = g.BizID
+	g.fieldMap["app_id"] = g.AppID
+	g.fieldMap["group_id"] = g.GroupID
+	g.fieldMap["tenant_id"] = g.TenantID
+	g.fieldMap["creator"] = g.Creator
+	g.fieldMap["reviser"] = g.Reviser
+	g.fieldMap["created_at"] = g.CreatedAt
+	g.fieldMap["updated_at"] = g.UpdatedAt
+}
+
+func (g groupTemplateVariable) clone(db *gorm.DB) groupTemplateVariable {
+	g.groupTemplateVariableDo.ReplaceConnPool(db.Statement.ConnPool)
+	return g
+}
+
+func (g groupTemplateVariable) replaceDB(db *gorm.DB) groupTemplateVariable {
+	g.groupTemplateVariableDo.ReplaceDB(db)
+	return g
+}
+
+type groupTemplateVariableDo struct{ gen.DO }
+
+type IGroupTemplateVariableDo interface {
+	gen.SubQuery
+	Debug() IGroupTemplateVariableDo
+	WithContext(ctx context.Context) IGroupTemplateVariableDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IGroupTemplateVariableDo
+	WriteDB() IGroupTemplateVariableDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IGroupTemplateVariableDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IGroupTemplateVariableDo
+	Not(conds ...gen.Condition) IGroupTemplateVariableDo
+	Or(conds ...gen.Condition) IGroupTemplateVariableDo
+	Select(conds ...field.Expr) IGroupTemplateVariableDo
+	Where(conds ...gen.Condition) IGroupTemplateVariableDo
+	Order(conds ...field.Expr) IGroupTemplateVariableDo
+	Distinct(cols ...field.Expr) IGroupTemplateVariableDo
+	Omit(cols ...field.Expr) IGroupTemplateVariableDo
+	Join(table schema.Tabler, on ...field.Expr) IGroupTemplateVariableDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IGroupTemplateVariableDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IGroupTemplateVariableDo
+	Group(cols ...field.Expr) IGroupTemplateVariableDo
+	Having(conds ...gen.Condition) IGroupTemplateVariableDo
+	Limit(limit int) IGroupTemplateVariableDo
+	Offset(offset int) IGroupTemplateVariableDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IGroupTemplateVariableDo
+	Unscoped() IGroupTemplateVariableDo
+	Create(values ...*table.GroupTemplateVariable) error
+	CreateInBatches(values []*table.GroupTemplateVariable, batchSize int) error
+	Save(values ...*table.GroupTemplateVariable) error
+	First() (*table.GroupTemplateVariable, error)
+	Take() (*table.GroupTemplateVariable, error)
+	Last() (*table.GroupTemplateVariable, error)
+	Find() ([]*table.GroupTemplateVariable, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.GroupTemplateVariable, err error)
+	FindInBatches(result *[]*table.GroupTemplateVariable, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.GroupTemplateVariable) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IGroupTemplateVariableDo
+	Assign(attrs ...field.AssignExpr) IGroupTemplateVariableDo
+	Joins(fields ...field.RelationField) IGroupTemplateVariableDo
+	Preload(fields ...field.RelationField) IGroupTemplateVariableDo
+	FirstOrInit() (*table.GroupTemplateVariable, error)
+	FirstOrCreate() (*table.GroupTemplateVariable, error)
+	FindByPage(offset int, limit int) (result []*table.GroupTemplateVariable, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IGroupTemplateVariableDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (g groupTemplateVariableDo) Debug() IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Debug())
+}
+
+func (g groupTemplateVariableDo) WithContext(ctx context.Context) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.WithContext(ctx))
+}
+
+func (g groupTemplateVariableDo) ReadDB() IGroupTemplateVariableDo {
+	return g.Clauses(dbresolver.Read)
+}
+
+func (g groupTemplateVariableDo) WriteDB() IGroupTemplateVariableDo {
+	return g.Clauses(dbresolver.Write)
+}
+
+func (g groupTemplateVariableDo) Session(config *gorm.Session) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Session(config))
+}
+
+func (g groupTemplateVariableDo) Clauses(conds ...clause.Expression) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Clauses(conds...))
+}
+
+func (g groupTemplateVariableDo) Returning(value interface{}, columns ...string) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Returning(value, columns...))
+}
+
+func (g groupTemplateVariableDo) Not(conds ...gen.Condition) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Not(conds...))
+}
+
+func (g groupTemplateVariableDo) Or(conds ...gen.Condition) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Or(conds...))
+}
+
+func (g groupTemplateVariableDo) Select(conds ...field.Expr) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Select(conds...))
+}
+
+func (g groupTemplateVariableDo) Where(conds ...gen.Condition) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Where(conds...))
+}
+
+func (g groupTemplateVariableDo) Order(conds ...field.Expr) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Order(conds...))
+}
+
+func (g groupTemplateVariableDo) Distinct(cols ...field.Expr) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Distinct(cols...))
+}
+
+func (g groupTemplateVariableDo) Omit(cols ...field.Expr) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Omit(cols...))
+}
+
+func (g groupTemplateVariableDo) Join(table schema.Tabler, on ...field.Expr) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Join(table, on...))
+}
+
+func (g groupTemplateVariableDo) LeftJoin(table schema.Tabler, on ...field.Expr) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.LeftJoin(table, on...))
+}
+
+func (g groupTemplateVariableDo) RightJoin(table schema.Tabler, on ...field.Expr) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.RightJoin(table, on...))
+}
+
+func (g groupTemplateVariableDo) Group(cols ...field.Expr) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Group(cols...))
+}
+
+func (g groupTemplateVariableDo) Having(conds ...gen.Condition) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Having(conds...))
+}
+
+func (g groupTemplateVariableDo) Limit(limit int) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Limit(limit))
+}
+
+func (g groupTemplateVariableDo) Offset(offset int) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Offset(offset))
+}
+
+func (g groupTemplateVariableDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Scopes(funcs...))
+}
+
+func (g groupTemplateVariableDo) Unscoped() IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Unscoped())
+}
+
+func (g groupTemplateVariableDo) Create(values ...*table.GroupTemplateVariable) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Create(values)
+}
+
+func (g groupTemplateVariableDo) CreateInBatches(values []*table.GroupTemplateVariable, batchSize int) error {
+	return g.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (g groupTemplateVariableDo) Save(values ...*table.GroupTemplateVariable) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Save(values)
+}
+
+func (g groupTemplateVariableDo) First() (*table.GroupTemplateVariable, error) {
+	if result, err := g.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GroupTemplateVariable), nil
+	}
+}
+
+func (g groupTemplateVariableDo) Take() (*table.GroupTemplateVariable, error) {
+	if result, err := g.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GroupTemplateVariable), nil
+	}
+}
+
+func (g groupTemplateVariableDo) Last() (*table.GroupTemplateVariable, error) {
+	if result, err := g.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GroupTemplateVariable), nil
+	}
+}
+
+func (g groupTemplateVariableDo) Find() ([]*table.GroupTemplateVariable, error) {
+	result, err := g.DO.Find()
+	return result.([]*table.GroupTemplateVariable), err
+}
+
+func (g groupTemplateVariableDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.GroupTemplateVariable, err error) {
+	buf := make([]*table.GroupTemplateVariable, 0, batchSize)
+	err = g.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (g groupTemplateVariableDo) FindInBatches(result *[]*table.GroupTemplateVariable, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return g.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (g groupTemplateVariableDo) Attrs(attrs ...field.AssignExpr) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Attrs(attrs...))
+}
+
+func (g groupTemplateVariableDo) Assign(attrs ...field.AssignExpr) IGroupTemplateVariableDo {
+	return g.withDO(g.DO.Assign(attrs...))
+}
+
+func (g groupTemplateVariableDo) Joins(fields ...field.RelationField) IGroupTemplateVariableDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Joins(_f))
+	}
+	return &g
+}
+
+func (g groupTemplateVariableDo) Preload(fields ...field.RelationField) IGroupTemplateVariableDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Preload(_f))
+	}
+	return &g
+}
+
+func (g groupTemplateVariableDo) FirstOrInit() (*table.GroupTemplateVariable, error) {
+	if result, err := g.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GroupTemplateVariable), nil
+	}
+}
+
+func (g groupTemplateVariableDo) FirstOrCreate() (*table.GroupTemplateVariable, error) {
+	if result, err := g.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GroupTemplateVariable), nil
+	}
+}
+
+func (g groupTemplateVariableDo) FindByPage(offset int, limit int) (result []*table.GroupTemplateVariable, count int64, err error) {
+	result, err = g.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = g.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (g groupTemplateVariableDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = g.Count()
+	if err != nil {
+		return
+	}
+
+	err = g.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (g groupTemplateVariableDo) Scan(result interface{}) (err error) {
+	return g.DO.Scan(result)
+}
+
+func (g groupTemplateVariableDo) Delete(models ...*table.GroupTemplateVariable) (result gen.ResultInfo, err error) {
+	return g.DO.Delete(models)
+}
+
+func (g *groupTemplateVariableDo) withDO(do gen.Dao) *groupTemplateVariableDo {
+	g.DO = *do.(*gen.DO)
+	return g
+}
@@ -33,6 +33,7 @@ func newGroup(db *gorm.DB, opts ...gen.DOOption) group {
 	_group.Mode = field.NewString(tableName, "mode")
 	_group.Selector = field.NewField(tableName, "selector")
 	_group.UID = field.NewString(tableName, "uid")
+	_group.ClientQueryID = field.NewUint32(tableName, "client_query_id")
 	_group.BizID = field.NewUint32(tableName, "biz_id")
 	_group.TenantID = field.NewString(tableName, "tenant_id")
 	_group.Creator = field.NewString(tableName, "creator")
@@ -48,19 +49,20 @@ func newGroup(db *gorm.DB, opts ...gen.DOOption) group {
 type group struct {
 	groupDo groupDo
 
-	ALL       field.Asterisk
-	ID        field.Uint32
-	Name      field.String
-	Public    field.Bool
-	Mode      field.String
-	Selector  field.Field
-	UID       field.String
-	BizID     field.Uint32
-	TenantID  field.String
-	Creator   field.String
-	Reviser   field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
+	ALL           field.Asterisk
+	ID            field.Uint32
+	Name          field.String
+	Public        field.Bool
+	Mode          field.String
+	Selector      field.Field
+	UID           field.String
+	ClientQueryID field.Uint32
+	BizID         field.Uint32
+	TenantID      field.String
+	Creator       field.String
+	Reviser       field.String
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -83,6 +85,7 @@ func (g *group) updateTableName(table string) *group {
 	g.Mode = field.NewString(table, "mode")
 	g.Selector = field.NewField(table, "selector")
 	g.UID = field.NewString(table, "uid")
+	g.ClientQueryID = field.NewUint32(table, "client_query_id")
 	g.BizID = field.NewUint32(table, "biz_id")
 	g.TenantID = field.NewString(table, "tenant_id")
 	g.Creator = field.NewString(table, "creator")
@@ -113,13 +116,14 @@ func (g *group) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (g *group) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 12)
+	g.fieldMap = make(map[string]field.Expr, 13)
 	g.fieldMap["id"] = g.ID
 	g.fieldMap["name"] = g.Name
 	g.fieldMap["public"] = g.Public
 	g.fieldMap["mode"] = g.Mode
 	g.fieldMap["selector"] = g.Selector
 	g.fieldMap["uid"] = g.UID
+	g.fieldMap["client_query_id"] = g.ClientQueryID
 	g.fieldMap["biz_id"] = g.BizID
 	g.fieldMap["tenant_id"] = g.TenantID
 	g.fieldMap["creator"] = g.Creator
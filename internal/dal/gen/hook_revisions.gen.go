@@ -32,6 +32,7 @@ func newHookRevision(db *gorm.DB, opts ...gen.DOOption) hookRevision {
 	_hookRevision.State = field.NewString(tableName, "state")
 	_hookRevision.Content = field.NewString(tableName, "content")
 	_hookRevision.Memo = field.NewString(tableName, "memo")
+	_hookRevision.Params = field.NewField(tableName, "params")
 	_hookRevision.BizID = field.NewUint32(tableName, "biz_id")
 	_hookRevision.HookID = field.NewUint32(tableName, "hook_id")
 	_hookRevision.TenantID = field.NewString(tableName, "tenant_id")
@@ -54,6 +55,7 @@ type hookRevision struct {
 	State     field.String
 	Content   field.String
 	Memo      field.String
+	Params    field.Field
 	BizID     field.Uint32
 	HookID    field.Uint32
 	TenantID  field.String
@@ -82,6 +84,7 @@ func (h *hookRevision) updateTableName(table string) *hookRevision {
 	h.State = field.NewString(table, "state")
 	h.Content = field.NewString(table, "content")
 	h.Memo = field.NewString(table, "memo")
+	h.Params = field.NewField(table, "params")
 	h.BizID = field.NewUint32(table, "biz_id")
 	h.HookID = field.NewUint32(table, "hook_id")
 	h.TenantID = field.NewString(table, "tenant_id")
@@ -117,12 +120,13 @@ func (h *hookRevision) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (h *hookRevision) fillFieldMap() {
-	h.fieldMap = make(map[string]field.Expr, 12)
+	h.fieldMap = make(map[string]field.Expr, 13)
 	h.fieldMap["id"] = h.ID
 	h.fieldMap["name"] = h.Name
 	h.fieldMap["state"] = h.State
 	h.fieldMap["content"] = h.Content
 	h.fieldMap["memo"] = h.Memo
+	h.fieldMap["params"] = h.Params
 	h.fieldMap["biz_id"] = h.BizID
 	h.fieldMap["hook_id"] = h.HookID
 	h.fieldMap["tenant_id"] = h.TenantID
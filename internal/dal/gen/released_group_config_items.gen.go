@@ -0,0 +1,436 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newReleasedGroupConfigItem(db *gorm.DB, opts ...gen.DOOption) releasedGroupConfigItem {
+	_releasedGroupConfigItem := releasedGroupConfigItem{}
+
+	_releasedGroupConfigItem.releasedGroupConfigItemDo.UseDB(db, opts...)
+	_releasedGroupConfigItem.releasedGroupConfigItemDo.UseModel(&table.ReleasedGroupConfigItem{})
+
+	tableName := _releasedGroupConfigItem.releasedGroupConfigItemDo.TableName()
+	_releasedGroupConfigItem.ALL = field.NewAsterisk(tableName)
+	_releasedGroupConfigItem.ID = field.NewUint32(tableName, "id")
+	_releasedGroupConfigItem.Signature = field.NewString(tableName, "signature")
+	_releasedGroupConfigItem.Md5 = field.NewString(tableName, "md5")
+	_releasedGroupConfigItem.ByteSize = field.NewUint64(tableName, "byte_size")
+	_releasedGroupConfigItem.BizID = field.NewUint32(tableName, "biz_id")
+	_releasedGroupConfigItem.AppID = field.NewUint32(tableName, "app_id")
+	_releasedGroupConfigItem.ReleaseID = field.NewUint32(tableName, "release_id")
+	_releasedGroupConfigItem.GroupID = field.NewUint32(tableName, "group_id")
+	_releasedGroupConfigItem.ReleasedConfigItemID = field.NewUint32(tableName, "released_config_item_id")
+	_releasedGroupConfigItem.TenantID = field.NewString(tableName, "tenant_id")
+	_releasedGroupConfigItem.Creator = field.NewString(tableName, "creator")
+	_releasedGroupConfigItem.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_releasedGroupConfigItem.fillFieldMap()
+
+	return _releasedGroupConfigItem
+}
+
+type releasedGroupConfigItem struct {
+	releasedGroupConfigItemDo releasedGroupConfigItemDo
+
+	ALL                  field.Asterisk
+	ID                   field.Uint32
+	Signature            field.String
+	Md5                  field.String
+	ByteSize             field.Uint64
+	BizID                field.Uint32
+	AppID                field.Uint32
+	ReleaseID            field.Uint32
+	GroupID              field.Uint32
+	ReleasedConfigItemID field.Uint32
+	TenantID             field.String
+	Creator              field.String
+	CreatedAt            field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (r releasedGroupConfigItem) Table(newTableName string) *releasedGroupConfigItem {
+	r.releasedGroupConfigItemDo.UseTable(newTableName)
+	return r.updateTableName(newTableName)
+}
+
+func (r releasedGroupConfigItem) As(alias string) *releasedGroupConfigItem {
+	r.releasedGroupConfigItemDo.DO = *(r.releasedGroupConfigItemDo.As(alias).(*gen.DO))
+	return r.updateTableName(alias)
+}
+
+func (r *releasedGroupConfigItem) updateTableName(table string) *releasedGroupConfigItem {
+	r.ALL = field.NewAsterisk(table)
+	r.ID = field.NewUint32(table, "id")
+	r.Signature = field.NewString(table, "signature")
+	r.Md5 = field.NewString(table, "md5")
+	r.ByteSize = field.NewUint64(table, "byte_size")
+	r.BizID = field.NewUint32(table, "biz_id")
+	r.AppID = field.NewUint32(table, "app_id")
+	r.ReleaseID = field.NewUint32(table, "release_id")
+	r.GroupID = field.NewUint32(table, "group_id")
+	r.ReleasedConfigItemID = field.NewUint32(table, "released_config_item_id")
+	r.TenantID = field.NewString(table, "tenant_id")
+	r.Creator = field.NewString(table, "creator")
+	r.CreatedAt = field.NewTime(table, "created_at")
+
+	r.fillFieldMap()
+
+	return r
+}
+
+func (r *releasedGroupConfigItem) WithContext(ctx context.Context) IReleasedGroupConfigItemDo {
+	return r.releasedGroupConfigItemDo.WithContext(ctx)
+}
+
+func (r releasedGroupConfigItem) TableName() string { return r.releasedGroupConfigItemDo.TableName() }
+
+func (r releasedGroupConfigItem) Alias() string { return r.releasedGroupConfigItemDo.Alias() }
+
+func (r releasedGroupConfigItem) Columns(cols ...field.Expr) gen.Columns {
+	return r.releasedGroupConfigItemDo.Columns(cols...)
+}
+
+func (r *releasedGroupConfigItem) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := r.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (r *releasedGroupConfigItem) fillFieldMap() {
+	r.fieldMap = make(map[string]field.Expr, 12)
+	r.fieldMap["id"] = r.ID
+	r.fieldMap["signature"] = r.Signature
+	r.fieldMap["md5"] = r.Md5
+	r.fieldMap["byte_size"] = r.ByteSize
+	r.fieldMap["biz_id"] = r.BizID
+	r.fieldMap["app_id"] = r.AppID
+	r.fieldMap["release_id"] = r.ReleaseID
+	r.fieldMap["group_id"] = r.GroupID
+	r.fieldMap["released_config_item_id"] = r.ReleasedConfigItemID
+	r.fieldMap["tenant_id"] = r.TenantID
+	r.fieldMap["creator"] = r.Creator
+	r.fieldMap["created_at"] = r.CreatedAt
+}
+
+func (r releasedGroupConfigItem) clone(db *gorm.DB) releasedGroupConfigItem {
+	r.releasedGroupConfigItemDo.ReplaceConnPool(db.Statement.ConnPool)
+	return r
+}
+
+func (r releasedGroupConfigItem) replaceDB(db *gorm.DB) releasedGroupConfigItem {
+	r.releasedGroupConfigItemDo.ReplaceDB(db)
+	return r
+}
+
+type releasedGroupConfigItemDo struct{ gen.DO }
+
+type IReleasedGroupConfigItemDo interface {
+	gen.SubQuery
+	Debug() IReleasedGroupConfigItemDo
+	WithContext(ctx context.Context) IReleasedGroupConfigItemDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IReleasedGroupConfigItemDo
+	WriteDB() IReleasedGroupConfigItemDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IReleasedGroupConfigItemDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IReleasedGroupConfigItemDo
+	Not(conds ...gen.Condition) IReleasedGroupConfigItemDo
+	Or(conds ...gen.Condition) IReleasedGroupConfigItemDo
+	Select(conds ...field.Expr) IReleasedGroupConfigItemDo
+	Where(conds ...gen.Condition) IReleasedGroupConfigItemDo
+	Order(conds ...field.Expr) IReleasedGroupConfigItemDo
+	Distinct(cols ...field.Expr) IReleasedGroupConfigItemDo
+	Omit(cols ...field.Expr) IReleasedGroupConfigItemDo
+	Join(table schema.Tabler, on ...field.Expr) IReleasedGroupConfigItemDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IReleasedGroupConfigItemDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IReleasedGroupConfigItemDo
+	Group(cols ...field.Expr) IReleasedGroupConfigItemDo
+	Having(conds ...gen.Condition) IReleasedGroupConfigItemDo
+	Limit(limit int) IReleasedGroupConfigItemDo
+	Offset(offset int) IReleasedGroupConfigItemDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IReleasedGroupConfigItemDo
+	Unscoped() IReleasedGroupConfigItemDo
+	Create(values ...*table.ReleasedGroupConfigItem) error
+	CreateInBatches(values []*table.ReleasedGroupConfigItem, batchSize int) error
+	Save(values ...*table.ReleasedGroupConfigItem) error
+	First() (*table.ReleasedGroupConfigItem, error)
+	Take() (*table.ReleasedGroupConfigItem, error)
+	Last() (*table.ReleasedGroupConfigItem, error)
+	Find() ([]*table.ReleasedGroupConfigItem, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ReleasedGroupConfigItem, err error)
+	FindInBatches(result *[]*table.ReleasedGroupConfigItem, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ReleasedGroupConfigItem) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IReleasedGroupConfigItemDo
+	Assign(attrs ...field.AssignExpr) IReleasedGroupConfigItemDo
+	Joins(fields ...field.RelationField) IReleasedGroupConfigItemDo
+	Preload(fields ...field.RelationField) IReleasedGroupConfigItemDo
+	FirstOrInit() (*table.ReleasedGroupConfigItem, error)
+	FirstOrCreate() (*table.ReleasedGroupConfigItem, error)
+	FindByPage(offset int, limit int) (result []*table.ReleasedGroupConfigItem, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IReleasedGroupConfigItemDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (r releasedGroupConfigItemDo) Debug() IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Debug())
+}
+
+func (r releasedGroupConfigItemDo) WithContext(ctx context.Context) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.WithContext(ctx))
+}
+
+func (r releasedGroupConfigItemDo) ReadDB() IReleasedGroupConfigItemDo {
+	return r.Clauses(dbresolver.Read)
+}
+
+func (r releasedGroupConfigItemDo) WriteDB() IReleasedGroupConfigItemDo {
+	return r.Clauses(dbresolver.Write)
+}
+
+func (r releasedGroupConfigItemDo) Session(config *gorm.Session) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Session(config))
+}
+
+func (r releasedGroupConfigItemDo) Clauses(conds ...clause.Expression) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Clauses(conds...))
+}
+
+func (r releasedGroupConfigItemDo) Returning(value interface{}, columns ...string) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Returning(value, columns...))
+}
+
+func (r releasedGroupConfigItemDo) Not(conds ...gen.Condition) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Not(conds...))
+}
+
+func (r releasedGroupConfigItemDo) Or(conds ...gen.Condition) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Or(conds...))
+}
+
+func (r releasedGroupConfigItemDo) Select(conds ...field.Expr) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Select(conds...))
+}
+
+func (r releasedGroupConfigItemDo) Where(conds ...gen.Condition) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Where(conds...))
+}
+
+func (r releasedGroupConfigItemDo) Order(conds ...field.Expr) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Order(conds...))
+}
+
+func (r releasedGroupConfigItemDo) Distinct(cols ...field.Expr) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Distinct(cols...))
+}
+
+func (r releasedGroupConfigItemDo) Omit(cols ...field.Expr) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Omit(cols...))
+}
+
+func (r releasedGroupConfigItemDo) Join(table schema.Tabler, on ...field.Expr) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Join(table, on...))
+}
+
+func (r releasedGroupConfigItemDo) LeftJoin(table schema.Tabler, on ...field.Expr) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.LeftJoin(table, on...))
+}
+
+func (r releasedGroupConfigItemDo) RightJoin(table schema.Tabler, on ...field.Expr) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.RightJoin(table, on...))
+}
+
+func (r releasedGroupConfigItemDo) Group(cols ...field.Expr) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Group(cols...))
+}
+
+func (r releasedGroupConfigItemDo) Having(conds ...gen.Condition) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Having(conds...))
+}
+
+func (r releasedGroupConfigItemDo) Limit(limit int) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Limit(limit))
+}
+
+func (r releasedGroupConfigItemDo) Offset(offset int) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Offset(offset))
+}
+
+func (r releasedGroupConfigItemDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Scopes(funcs...))
+}
+
+func (r releasedGroupConfigItemDo) Unscoped() IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Unscoped())
+}
+
+func (r releasedGroupConfigItemDo) Create(values ...*table.ReleasedGroupConfigItem) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Create(values)
+}
+
+func (r releasedGroupConfigItemDo) CreateInBatches(values []*table.ReleasedGroupConfigItem, batchSize int) error {
+	return r.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (r releasedGroupConfigItemDo) Save(values ...*table.ReleasedGroupConfigItem) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Save(values)
+}
+
+func (r releasedGroupConfigItemDo) First() (*table.ReleasedGroupConfigItem, error) {
+	if result, err := r.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleasedGroupConfigItem), nil
+	}
+}
+
+func (r releasedGroupConfigItemDo) Take() (*table.ReleasedGroupConfigItem, error) {
+	if result, err := r.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleasedGroupConfigItem), nil
+	}
+}
+
+func (r releasedGroupConfigItemDo) Last() (*table.ReleasedGroupConfigItem, error) {
+	if result, err := r.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleasedGroupConfigItem), nil
+	}
+}
+
+func (r releasedGroupConfigItemDo) Find() ([]*table.ReleasedGroupConfigItem, error) {
+	result, err := r.DO.Find()
+	return result.([]*table.ReleasedGroupConfigItem), err
+}
+
+func (r releasedGroupConfigItemDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ReleasedGroupConfigItem, err error) {
+	buf := make([]*table.ReleasedGroupConfigItem, 0, batchSize)
+	err = r.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (r releasedGroupConfigItemDo) FindInBatches(result *[]*table.ReleasedGroupConfigItem, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return r.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (r releasedGroupConfigItemDo) Attrs(attrs ...field.AssignExpr) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Attrs(attrs...))
+}
+
+func (r releasedGroupConfigItemDo) Assign(attrs ...field.AssignExpr) IReleasedGroupConfigItemDo {
+	return r.withDO(r.DO.Assign(attrs...))
+}
+
+func (r releasedGroupConfigItemDo) Joins(fields ...field.RelationField) IReleasedGroupConfigItemDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Joins(_f))
+	}
+	return &r
+}
+
+func (r releasedGroupConfigItemDo) Preload(fields ...field.RelationField) IReleasedGroupConfigItemDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Preload(_f))
+	}
+	return &r
+}
+
+func (r releasedGroupConfigItemDo) FirstOrInit() (*table.ReleasedGroupConfigItem, error) {
+	if result, err := r.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleasedGroupConfigItem), nil
+	}
+}
+
+func (r releasedGroupConfigItemDo) FirstOrCreate() (*table.ReleasedGroupConfigItem, error) {
+	if result, err := r.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleasedGroupConfigItem), nil
+	}
+}
+
+func (r releasedGroupConfigItemDo) FindByPage(offset int, limit int) (result []*table.ReleasedGroupConfigItem, count int64, err error) {
+	result, err = r.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = r.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (r releasedGroupConfigItemDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = r.Count()
+	if err != nil {
+		return
+	}
+
+	err = r.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (r releasedGroupConfigItemDo) Scan(result interface{}) (err error) {
+	return r.DO.Scan(result)
+}
+
+func (r releasedGroupConfigItemDo) Delete(models ...*table.ReleasedGroupConfigItem) (result gen.ResultInfo, err error) {
+	return r.DO.Delete(models)
+}
+
+func (r *releasedGroupConfigItemDo) withDO(do gen.Dao) *releasedGroupConfigItemDo {
+	r.DO = *do.(*gen.DO)
+	return r
+}
@@ -37,6 +37,9 @@ func newReleasedGroup(db *gorm.DB, opts ...gen.DOOption) releasedGroup {
 	_releasedGroup.UID = field.NewString(tableName, "uid")
 	_releasedGroup.Edited = field.NewBool(tableName, "edited")
 	_releasedGroup.BizID = field.NewUint32(tableName, "biz_id")
+	_releasedGroup.PinnedReleaseID = field.NewUint32(tableName, "pinned_release_id")
+	_releasedGroup.PinnedUntil = field.NewTime(tableName, "pinned_until")
+	_releasedGroup.PinMemo = field.NewString(tableName, "pin_memo")
 	_releasedGroup.Reviser = field.NewString(tableName, "reviser")
 	_releasedGroup.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_releasedGroup.TenantID = field.NewString(tableName, "tenant_id")
@@ -49,20 +52,23 @@ func newReleasedGroup(db *gorm.DB, opts ...gen.DOOption) releasedGroup {
 type releasedGroup struct {
 	releasedGroupDo releasedGroupDo
 
-	ALL        field.Asterisk
-	ID         field.Uint32
-	GroupID    field.Uint32
-	AppID      field.Uint32
-	ReleaseID  field.Uint32
-	StrategyID field.Uint32
-	Mode       field.String
-	Selector   field.Field
-	UID        field.String
-	Edited     field.Bool
-	BizID      field.Uint32
-	Reviser    field.String
-	UpdatedAt  field.Time
-	TenantID   field.String
+	ALL             field.Asterisk
+	ID              field.Uint32
+	GroupID         field.Uint32
+	AppID           field.Uint32
+	ReleaseID       field.Uint32
+	StrategyID      field.Uint32
+	Mode            field.String
+	Selector        field.Field
+	UID             field.String
+	Edited          field.Bool
+	BizID           field.Uint32
+	PinnedReleaseID field.Uint32
+	PinnedUntil     field.Time
+	PinMemo         field.String
+	Reviser         field.String
+	UpdatedAt       field.Time
+	TenantID        field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -89,6 +95,9 @@ func (r *releasedGroup) updateTableName(table string) *releasedGroup {
 	r.UID = field.NewString(table, "uid")
 	r.Edited = field.NewBool(table, "edited")
 	r.BizID = field.NewUint32(table, "biz_id")
+	r.PinnedReleaseID = field.NewUint32(table, "pinned_release_id")
+	r.PinnedUntil = field.NewTime(table, "pinned_until")
+	r.PinMemo = field.NewString(table, "pin_memo")
 	r.Reviser = field.NewString(table, "reviser")
 	r.UpdatedAt = field.NewTime(table, "updated_at")
 	r.TenantID = field.NewString(table, "tenant_id")
@@ -120,7 +129,7 @@ func (r *releasedGroup) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (r *releasedGroup) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 13)
+	r.fieldMap = make(map[string]field.Expr, 16)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["group_id"] = r.GroupID
 	r.fieldMap["app_id"] = r.AppID
@@ -131,6 +140,9 @@ func (r *releasedGroup) fillFieldMap() {
 	r.fieldMap["uid"] = r.UID
 	r.fieldMap["edited"] = r.Edited
 	r.fieldMap["biz_id"] = r.BizID
+	r.fieldMap["pinned_release_id"] = r.PinnedReleaseID
+	r.fieldMap["pinned_until"] = r.PinnedUntil
+	r.fieldMap["pin_memo"] = r.PinMemo
 	r.fieldMap["reviser"] = r.Reviser
 	r.fieldMap["updated_at"] = r.UpdatedAt
 	r.fieldMap["tenant_id"] = r.TenantID
@@ -37,6 +37,7 @@ func newReleasedHook(db *gorm.DB, opts ...gen.DOOption) releasedHook {
 	_releasedHook.Content = field.NewString(tableName, "content")
 	_releasedHook.ScriptType = field.NewString(tableName, "script_type")
 	_releasedHook.HookType = field.NewString(tableName, "hook_type")
+	_releasedHook.Params = field.NewField(tableName, "params")
 	_releasedHook.BizID = field.NewUint32(tableName, "biz_id")
 	_releasedHook.Reviser = field.NewString(tableName, "reviser")
 	_releasedHook.UpdatedAt = field.NewTime(tableName, "updated_at")
@@ -61,6 +62,7 @@ type releasedHook struct {
 	Content          field.String
 	ScriptType       field.String
 	HookType         field.String
+	Params           field.Field
 	BizID            field.Uint32
 	Reviser          field.String
 	UpdatedAt        field.Time
@@ -91,6 +93,7 @@ func (r *releasedHook) updateTableName(table string) *releasedHook {
 	r.Content = field.NewString(table, "content")
 	r.ScriptType = field.NewString(table, "script_type")
 	r.HookType = field.NewString(table, "hook_type")
+	r.Params = field.NewField(table, "params")
 	r.BizID = field.NewUint32(table, "biz_id")
 	r.Reviser = field.NewString(table, "reviser")
 	r.UpdatedAt = field.NewTime(table, "updated_at")
@@ -123,7 +126,7 @@ func (r *releasedHook) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (r *releasedHook) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 14)
+	r.fieldMap = make(map[string]field.Expr, 15)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["app_id"] = r.AppID
 	r.fieldMap["release_id"] = r.ReleaseID
@@ -134,6 +137,7 @@ func (r *releasedHook) fillFieldMap() {
 	r.fieldMap["content"] = r.Content
 	r.fieldMap["script_type"] = r.ScriptType
 	r.fieldMap["hook_type"] = r.HookType
+	r.fieldMap["params"] = r.Params
 	r.fieldMap["biz_id"] = r.BizID
 	r.fieldMap["reviser"] = r.Reviser
 	r.fieldMap["updated_at"] = r.UpdatedAt
@@ -33,6 +33,9 @@ func newRelease(db *gorm.DB, opts ...gen.DOOption) release {
 	_release.Deprecated = field.NewBool(tableName, "deprecated")
 	_release.PublishNum = field.NewUint32(tableName, "publish_num")
 	_release.FullyReleased = field.NewBool(tableName, "fully_released")
+	_release.Tags = field.NewField(tableName, "tags")
+	_release.SourceAppID = field.NewUint32(tableName, "source_app_id")
+	_release.SourceReleaseID = field.NewUint32(tableName, "source_release_id")
 	_release.BizID = field.NewUint32(tableName, "biz_id")
 	_release.AppID = field.NewUint32(tableName, "app_id")
 	_release.TenantID = field.NewString(tableName, "tenant_id")
@@ -47,18 +50,21 @@ func newRelease(db *gorm.DB, opts ...gen.DOOption) release {
 type release struct {
 	releaseDo releaseDo
 
-	ALL           field.Asterisk
-	ID            field.Uint32
-	Name          field.String
-	Memo          field.String
-	Deprecated    field.Bool
-	PublishNum    field.Uint32
-	FullyReleased field.Bool
-	BizID         field.Uint32
-	AppID         field.Uint32
-	TenantID      field.String
-	Creator       field.String
-	CreatedAt     field.Time
+	ALL             field.Asterisk
+	ID              field.Uint32
+	Name            field.String
+	Memo            field.String
+	Deprecated      field.Bool
+	PublishNum      field.Uint32
+	FullyReleased   field.Bool
+	Tags            field.Field
+	SourceAppID     field.Uint32
+	SourceReleaseID field.Uint32
+	BizID           field.Uint32
+	AppID           field.Uint32
+	TenantID        field.String
+	Creator         field.String
+	CreatedAt       field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -81,6 +87,9 @@ func (r *release) updateTableName(table string) *release {
 	r.Deprecated = field.NewBool(table, "deprecated")
 	r.PublishNum = field.NewUint32(table, "publish_num")
 	r.FullyReleased = field.NewBool(table, "fully_released")
+	r.Tags = field.NewField(table, "tags")
+	r.SourceAppID = field.NewUint32(table, "source_app_id")
+	r.SourceReleaseID = field.NewUint32(table, "source_release_id")
 	r.BizID = field.NewUint32(table, "biz_id")
 	r.AppID = field.NewUint32(table, "app_id")
 	r.TenantID = field.NewString(table, "tenant_id")
@@ -110,13 +119,16 @@ func (r *release) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (r *release) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 11)
+	r.fieldMap = make(map[string]field.Expr, 14)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["name"] = r.Name
 	r.fieldMap["memo"] = r.Memo
 	r.fieldMap["deprecated"] = r.Deprecated
 	r.fieldMap["publish_num"] = r.PublishNum
 	r.fieldMap["fully_released"] = r.FullyReleased
+	r.fieldMap["tags"] = r.Tags
+	r.fieldMap["source_app_id"] = r.SourceAppID
+	r.fieldMap["source_release_id"] = r.SourceReleaseID
 	r.fieldMap["biz_id"] = r.BizID
 	r.fieldMap["app_id"] = r.AppID
 	r.fieldMap["tenant_id"] = r.TenantID
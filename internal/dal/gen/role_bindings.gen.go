@@ -0,0 +1,430 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newRoleBinding(db *gorm.DB, opts ...gen.DOOption) roleBinding {
+	_roleBinding := roleBinding{}
+
+	_roleBinding.roleBindingDo.UseDB(db, opts...)
+	_roleBinding.roleBindingDo.UseModel(&table.RoleBinding{})
+
+	tableName := _roleBinding.roleBindingDo.TableName()
+	_roleBinding.ALL = field.NewAsterisk(tableName)
+	_roleBinding.ID = field.NewUint32(tableName, "id")
+	_roleBinding.RoleID = field.NewUint32(tableName, "role_id")
+	_roleBinding.Subject = field.NewString(tableName, "subject")
+	_roleBinding.ScopeType = field.NewString(tableName, "scope_type")
+	_roleBinding.ScopeID = field.NewUint32(tableName, "scope_id")
+	_roleBinding.BizID = field.NewUint32(tableName, "biz_id")
+	_roleBinding.TenantID = field.NewString(tableName, "tenant_id")
+	_roleBinding.Creator = field.NewString(tableName, "creator")
+	_roleBinding.Reviser = field.NewString(tableName, "reviser")
+	_roleBinding.CreatedAt = field.NewTime(tableName, "created_at")
+	_roleBinding.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_roleBinding.fillFieldMap()
+
+	return _roleBinding
+}
+
+type roleBinding struct {
+	roleBindingDo roleBindingDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	RoleID    field.Uint32
+	Subject   field.String
+	ScopeType field.String
+	ScopeID   field.Uint32
+	BizID     field.Uint32
+	TenantID  field.String
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (r roleBinding) Table(newTableName string) *roleBinding {
+	r.roleBindingDo.UseTable(newTableName)
+	return r.updateTableName(newTableName)
+}
+
+func (r roleBinding) As(alias string) *roleBinding {
+	r.roleBindingDo.DO = *(r.roleBindingDo.As(alias).(*gen.DO))
+	return r.updateTableName(alias)
+}
+
+func (r *roleBinding) updateTableName(table string) *roleBinding {
+	r.ALL = field.NewAsterisk(table)
+	r.ID = field.NewUint32(table, "id")
+	r.RoleID = field.NewUint32(table, "role_id")
+	r.Subject = field.NewString(table, "subject")
+	r.ScopeType = field.NewString(table, "scope_type")
+	r.ScopeID = field.NewUint32(table, "scope_id")
+	r.BizID = field.NewUint32(table, "biz_id")
+	r.TenantID = field.NewString(table, "tenant_id")
+	r.Creator = field.NewString(table, "creator")
+	r.Reviser = field.NewString(table, "reviser")
+	r.CreatedAt = field.NewTime(table, "created_at")
+	r.UpdatedAt = field.NewTime(table, "updated_at")
+
+	r.fillFieldMap()
+
+	return r
+}
+
+func (r *roleBinding) WithContext(ctx context.Context) IRoleBindingDo {
+	return r.roleBindingDo.WithContext(ctx)
+}
+
+func (r roleBinding) TableName() string { return r.roleBindingDo.TableName() }
+
+func (r roleBinding) Alias() string { return r.roleBindingDo.Alias() }
+
+func (r roleBinding) Columns(cols ...field.Expr) gen.Columns { return r.roleBindingDo.Columns(cols...) }
+
+func (r *roleBinding) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := r.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (r *roleBinding) fillFieldMap() {
+	r.fieldMap = make(map[string]field.Expr, 11)
+	r.fieldMap["id"] = r.ID
+	r.fieldMap["role_id"] = r.RoleID
+	r.fieldMap["subject"] = r.Subject
+	r.fieldMap["scope_type"] = r.ScopeType
+	r.fieldMap["scope_id"] = r.ScopeID
+	r.fieldMap["biz_id"] = r.BizID
+	r.fieldMap["tenant_id"] = r.TenantID
+	r.fieldMap["creator"] = r.Creator
+	r.fieldMap["reviser"] = r.Reviser
+	r.fieldMap["created_at"] = r.CreatedAt
+	r.fieldMap["updated_at"] = r.UpdatedAt
+}
+
+func (r roleBinding) clone(db *gorm.DB) roleBinding {
+	r.roleBindingDo.ReplaceConnPool(db.Statement.ConnPool)
+	return r
+}
+
+func (r roleBinding) replaceDB(db *gorm.DB) roleBinding {
+	r.roleBindingDo.ReplaceDB(db)
+	return r
+}
+
+type roleBindingDo struct{ gen.DO }
+
+type IRoleBindingDo interface {
+	gen.SubQuery
+	Debug() IRoleBindingDo
+	WithContext(ctx context.Context) IRoleBindingDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IRoleBindingDo
+	WriteDB() IRoleBindingDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IRoleBindingDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IRoleBindingDo
+	Not(conds ...gen.Condition) IRoleBindingDo
+	Or(conds ...gen.Condition) IRoleBindingDo
+	Select(conds ...field.Expr) IRoleBindingDo
+	Where(conds ...gen.Condition) IRoleBindingDo
+	Order(conds ...field.Expr) IRoleBindingDo
+	Distinct(cols ...field.Expr) IRoleBindingDo
+	Omit(cols ...field.Expr) IRoleBindingDo
+	Join(table schema.Tabler, on ...field.Expr) IRoleBindingDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IRoleBindingDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IRoleBindingDo
+	Group(cols ...field.Expr) IRoleBindingDo
+	Having(conds ...gen.Condition) IRoleBindingDo
+	Limit(limit int) IRoleBindingDo
+	Offset(offset int) IRoleBindingDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IRoleBindingDo
+	Unscoped() IRoleBindingDo
+	Create(values ...*table.RoleBinding) error
+	CreateInBatches(values []*table.RoleBinding, batchSize int) error
+	Save(values ...*table.RoleBinding) error
+	First() (*table.RoleBinding, error)
+	Take() (*table.RoleBinding, error)
+	Last() (*table.RoleBinding, error)
+	Find() ([]*table.RoleBinding, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.RoleBinding, err error)
+	FindInBatches(result *[]*table.RoleBinding, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.RoleBinding) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IRoleBindingDo
+	Assign(attrs ...field.AssignExpr) IRoleBindingDo
+	Joins(fields ...field.RelationField) IRoleBindingDo
+	Preload(fields ...field.RelationField) IRoleBindingDo
+	FirstOrInit() (*table.RoleBinding, error)
+	FirstOrCreate() (*table.RoleBinding, error)
+	FindByPage(offset int, limit int) (result []*table.RoleBinding, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IRoleBindingDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (r roleBindingDo) Debug() IRoleBindingDo {
+	return r.withDO(r.DO.Debug())
+}
+
+func (r roleBindingDo) WithContext(ctx context.Context) IRoleBindingDo {
+	return r.withDO(r.DO.WithContext(ctx))
+}
+
+func (r roleBindingDo) ReadDB() IRoleBindingDo {
+	return r.Clauses(dbresolver.Read)
+}
+
+func (r roleBindingDo) WriteDB() IRoleBindingDo {
+	return r.Clauses(dbresolver.Write)
+}
+
+func (r roleBindingDo) Session(config *gorm.Session) IRoleBindingDo {
+	return r.withDO(r.DO.Session(config))
+}
+
+func (r roleBindingDo) Clauses(conds ...clause.Expression) IRoleBindingDo {
+	return r.withDO(r.DO.Clauses(conds...))
+}
+
+func (r roleBindingDo) Returning(value interface{}, columns ...string) IRoleBindingDo {
+	return r.withDO(r.DO.Returning(value, columns...))
+}
+
+func (r roleBindingDo) Not(conds ...gen.Condition) IRoleBindingDo {
+	return r.withDO(r.DO.Not(conds...))
+}
+
+func (r roleBindingDo) Or(conds ...gen.Condition) IRoleBindingDo {
+	return r.withDO(r.DO.Or(conds...))
+}
+
+func (r roleBindingDo) Select(conds ...field.Expr) IRoleBindingDo {
+	return r.withDO(r.DO.Select(conds...))
+}
+
+func (r roleBindingDo) Where(conds ...gen.Condition) IRoleBindingDo {
+	return r.withDO(r.DO.Where(conds...))
+}
+
+func (r roleBindingDo) Order(conds ...field.Expr) IRoleBindingDo {
+	return r.withDO(r.DO.Order(conds...))
+}
+
+func (r roleBindingDo) Distinct(cols ...field.Expr) IRoleBindingDo {
+	return r.withDO(r.DO.Distinct(cols...))
+}
+
+func (r roleBindingDo) Omit(cols ...field.Expr) IRoleBindingDo {
+	return r.withDO(r.DO.Omit(cols...))
+}
+
+func (r roleBindingDo) Join(table schema.Tabler, on ...field.Expr) IRoleBindingDo {
+	return r.withDO(r.DO.Join(table, on...))
+}
+
+func (r roleBindingDo) LeftJoin(table schema.Tabler, on ...field.Expr) IRoleBindingDo {
+	return r.withDO(r.DO.LeftJoin(table, on...))
+}
+
+func (r roleBindingDo) RightJoin(table schema.Tabler, on ...field.Expr) IRoleBindingDo {
+	return r.withDO(r.DO.RightJoin(table, on...))
+}
+
+func (r roleBindingDo) Group(cols ...field.Expr) IRoleBindingDo {
+	return r.withDO(r.DO.Group(cols...))
+}
+
+func (r roleBindingDo) Having(conds ...gen.Condition) IRoleBindingDo {
+	return r.withDO(r.DO.Having(conds...))
+}
+
+func (r roleBindingDo) Limit(limit int) IRoleBindingDo {
+	return r.withDO(r.DO.Limit(limit))
+}
+
+func (r roleBindingDo) Offset(offset int) IRoleBindingDo {
+	return r.withDO(r.DO.Offset(offset))
+}
+
+func (r roleBindingDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IRoleBindingDo {
+	return r.withDO(r.DO.Scopes(funcs...))
+}
+
+func (r roleBindingDo) Unscoped() IRoleBindingDo {
+	return r.withDO(r.DO.Unscoped())
+}
+
+func (r roleBindingDo) Create(values ...*table.RoleBinding) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Create(values)
+}
+
+func (r roleBindingDo) CreateInBatches(values []*table.RoleBinding, batchSize int) error {
+	return r.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (r roleBindingDo) Save(values ...*table.RoleBinding) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Save(values)
+}
+
+func (r roleBindingDo) First() (*table.RoleBinding, error) {
+	if result, err := r.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RoleBinding), nil
+	}
+}
+
+func (r roleBindingDo) Take() (*table.RoleBinding, error) {
+	if result, err := r.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RoleBinding), nil
+	}
+}
+
+func (r roleBindingDo) Last() (*table.RoleBinding, error) {
+	if result, err := r.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RoleBinding), nil
+	}
+}
+
+func (r roleBindingDo) Find() ([]*table.RoleBinding, error) {
+	result, err := r.DO.Find()
+	return result.([]*table.RoleBinding), err
+}
+
+func (r roleBindingDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.RoleBinding, err error) {
+	buf := make([]*table.RoleBinding, 0, batchSize)
+	err = r.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (r roleBindingDo) FindInBatches(result *[]*table.RoleBinding, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return r.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (r roleBindingDo) Attrs(attrs ...field.AssignExpr) IRoleBindingDo {
+	return r.withDO(r.DO.Attrs(attrs...))
+}
+
+func (r roleBindingDo) Assign(attrs ...field.AssignExpr) IRoleBindingDo {
+	return r.withDO(r.DO.Assign(attrs...))
+}
+
+func (r roleBindingDo) Joins(fields ...field.RelationField) IRoleBindingDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Joins(_f))
+	}
+	return &r
+}
+
+func (r roleBindingDo) Preload(fields ...field.RelationField) IRoleBindingDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Preload(_f))
+	}
+	return &r
+}
+
+func (r roleBindingDo) FirstOrInit() (*table.RoleBinding, error) {
+	if result, err := r.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RoleBinding), nil
+	}
+}
+
+func (r roleBindingDo) FirstOrCreate() (*table.RoleBinding, error) {
+	if result, err := r.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RoleBinding), nil
+	}
+}
+
+func (r roleBindingDo) FindByPage(offset int, limit int) (result []*table.RoleBinding, count int64, err error) {
+	result, err = r.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = r.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (r roleBindingDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = r.Count()
+	if err != nil {
+		return
+	}
+
+	err = r.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (r roleBindingDo) Scan(result interface{}) (err error) {
+	return r.DO.Scan(result)
+}
+
+func (r roleBindingDo) Delete(models ...*table.RoleBinding) (result gen.ResultInfo, err error) {
+	return r.DO.Delete(models)
+}
+
+func (r *roleBindingDo) withDO(do gen.Dao) *roleBindingDo {
+	r.DO = *do.(*gen.DO)
+	return r
+}
@@ -0,0 +1,424 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newRole(db *gorm.DB, opts ...gen.DOOption) role {
+	_role := role{}
+
+	_role.roleDo.UseDB(db, opts...)
+	_role.roleDo.UseModel(&table.Role{})
+
+	tableName := _role.roleDo.TableName()
+	_role.ALL = field.NewAsterisk(tableName)
+	_role.ID = field.NewUint32(tableName, "id")
+	_role.Name = field.NewString(tableName, "name")
+	_role.Permissions = field.NewString(tableName, "permissions")
+	_role.Memo = field.NewString(tableName, "memo")
+	_role.BizID = field.NewUint32(tableName, "biz_id")
+	_role.TenantID = field.NewString(tableName, "tenant_id")
+	_role.Creator = field.NewString(tableName, "creator")
+	_role.Reviser = field.NewString(tableName, "reviser")
+	_role.CreatedAt = field.NewTime(tableName, "created_at")
+	_role.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_role.fillFieldMap()
+
+	return _role
+}
+
+type role struct {
+	roleDo roleDo
+
+	ALL         field.Asterisk
+	ID          field.Uint32
+	Name        field.String
+	Permissions field.String
+	Memo        field.String
+	BizID       field.Uint32
+	TenantID    field.String
+	Creator     field.String
+	Reviser     field.String
+	CreatedAt   field.Time
+	UpdatedAt   field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (r role) Table(newTableName string) *role {
+	r.roleDo.UseTable(newTableName)
+	return r.updateTableName(newTableName)
+}
+
+func (r role) As(alias string) *role {
+	r.roleDo.DO = *(r.roleDo.As(alias).(*gen.DO))
+	return r.updateTableName(alias)
+}
+
+func (r *role) updateTableName(table string) *role {
+	r.ALL = field.NewAsterisk(table)
+	r.ID = field.NewUint32(table, "id")
+	r.Name = field.NewString(table, "name")
+	r.Permissions = field.NewString(table, "permissions")
+	r.Memo = field.NewString(table, "memo")
+	r.BizID = field.NewUint32(table, "biz_id")
+	r.TenantID = field.NewString(table, "tenant_id")
+	r.Creator = field.NewString(table, "creator")
+	r.Reviser = field.NewString(table, "reviser")
+	r.CreatedAt = field.NewTime(table, "created_at")
+	r.UpdatedAt = field.NewTime(table, "updated_at")
+
+	r.fillFieldMap()
+
+	return r
+}
+
+func (r *role) WithContext(ctx context.Context) IRoleDo { return r.roleDo.WithContext(ctx) }
+
+func (r role) TableName() string { return r.roleDo.TableName() }
+
+func (r role) Alias() string { return r.roleDo.Alias() }
+
+func (r role) Columns(cols ...field.Expr) gen.Columns { return r.roleDo.Columns(cols...) }
+
+func (r *role) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := r.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (r *role) fillFieldMap() {
+	r.fieldMap = make(map[string]field.Expr, 10)
+	r.fieldMap["id"] = r.ID
+	r.fieldMap["name"] = r.Name
+	r.fieldMap["permissions"] = r.Permissions
+	r.fieldMap["memo"] = r.Memo
+	r.fieldMap["biz_id"] = r.BizID
+	r.fieldMap["tenant_id"] = r.TenantID
+	r.fieldMap["creator"] = r.Creator
+	r.fieldMap["reviser"] = r.Reviser
+	r.fieldMap["created_at"] = r.CreatedAt
+	r.fieldMap["updated_at"] = r.UpdatedAt
+}
+
+func (r role) clone(db *gorm.DB) role {
+	r.roleDo.ReplaceConnPool(db.Statement.ConnPool)
+	return r
+}
+
+func (r role) replaceDB(db *gorm.DB) role {
+	r.roleDo.ReplaceDB(db)
+	return r
+}
+
+type roleDo struct{ gen.DO }
+
+type IRoleDo interface {
+	gen.SubQuery
+	Debug() IRoleDo
+	WithContext(ctx context.Context) IRoleDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IRoleDo
+	WriteDB() IRoleDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IRoleDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IRoleDo
+	Not(conds ...gen.Condition) IRoleDo
+	Or(conds ...gen.Condition) IRoleDo
+	Select(conds ...field.Expr) IRoleDo
+	Where(conds ...gen.Condition) IRoleDo
+	Order(conds ...field.Expr) IRoleDo
+	Distinct(cols ...field.Expr) IRoleDo
+	Omit(cols ...field.Expr) IRoleDo
+	Join(table schema.Tabler, on ...field.Expr) IRoleDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IRoleDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IRoleDo
+	Group(cols ...field.Expr) IRoleDo
+	Having(conds ...gen.Condition) IRoleDo
+	Limit(limit int) IRoleDo
+	Offset(offset int) IRoleDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IRoleDo
+	Unscoped() IRoleDo
+	Create(values ...*table.Role) error
+	CreateInBatches(values []*table.Role, batchSize int) error
+	Save(values ...*table.Role) error
+	First() (*table.Role, error)
+	Take() (*table.Role, error)
+	Last() (*table.Role, error)
+	Find() ([]*table.Role, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.Role, err error)
+	FindInBatches(result *[]*table.Role, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.Role) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IRoleDo
+	Assign(attrs ...field.AssignExpr) IRoleDo
+	Joins(fields ...field.RelationField) IRoleDo
+	Preload(fields ...field.RelationField) IRoleDo
+	FirstOrInit() (*table.Role, error)
+	FirstOrCreate() (*table.Role, error)
+	FindByPage(offset int, limit int) (result []*table.Role, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IRoleDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (r roleDo) Debug() IRoleDo {
+	return r.withDO(r.DO.Debug())
+}
+
+func (r roleDo) WithContext(ctx context.Context) IRoleDo {
+	return r.withDO(r.DO.WithContext(ctx))
+}
+
+func (r roleDo) ReadDB() IRoleDo {
+	return r.Clauses(dbresolver.Read)
+}
+
+func (r roleDo) WriteDB() IRoleDo {
+	return r.Clauses(dbresolver.Write)
+}
+
+func (r roleDo) Session(config *gorm.Session) IRoleDo {
+	return r.withDO(r.DO.Session(config))
+}
+
+func (r roleDo) Clauses(conds ...clause.Expression) IRoleDo {
+	return r.withDO(r.DO.Clauses(conds...))
+}
+
+func (r roleDo) Returning(value interface{}, columns ...string) IRoleDo {
+	return r.withDO(r.DO.Returning(value, columns...))
+}
+
+func (r roleDo) Not(conds ...gen.Condition) IRoleDo {
+	return r.withDO(r.DO.Not(conds...))
+}
+
+func (r roleDo) Or(conds ...gen.Condition) IRoleDo {
+	return r.withDO(r.DO.Or(conds...))
+}
+
+func (r roleDo) Select(conds ...field.Expr) IRoleDo {
+	return r.withDO(r.DO.Select(conds...))
+}
+
+func (r roleDo) Where(conds ...gen.Condition) IRoleDo {
+	return r.withDO(r.DO.Where(conds...))
+}
+
+func (r roleDo) Order(conds ...field.Expr) IRoleDo {
+	return r.withDO(r.DO.Order(conds...))
+}
+
+func (r roleDo) Distinct(cols ...field.Expr) IRoleDo {
+	return r.withDO(r.DO.Distinct(cols...))
+}
+
+func (r roleDo) Omit(cols ...field.Expr) IRoleDo {
+	return r.withDO(r.DO.Omit(cols...))
+}
+
+func (r roleDo) Join(table schema.Tabler, on ...field.Expr) IRoleDo {
+	return r.withDO(r.DO.Join(table, on...))
+}
+
+func (r roleDo) LeftJoin(table schema.Tabler, on ...field.Expr) IRoleDo {
+	return r.withDO(r.DO.LeftJoin(table, on...))
+}
+
+func (r roleDo) RightJoin(table schema.Tabler, on ...field.Expr) IRoleDo {
+	return r.withDO(r.DO.RightJoin(table, on...))
+}
+
+func (r roleDo) Group(cols ...field.Expr) IRoleDo {
+	return r.withDO(r.DO.Group(cols...))
+}
+
+func (r roleDo) Having(conds ...gen.Condition) IRoleDo {
+	return r.withDO(r.DO.Having(conds...))
+}
+
+func (r roleDo) Limit(limit int) IRoleDo {
+	return r.withDO(r.DO.Limit(limit))
+}
+
+func (r roleDo) Offset(offset int) IRoleDo {
+	return r.withDO(r.DO.Offset(offset))
+}
+
+func (r roleDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IRoleDo {
+	return r.withDO(r.DO.Scopes(funcs...))
+}
+
+func (r roleDo) Unscoped() IRoleDo {
+	return r.withDO(r.DO.Unscoped())
+}
+
+func (r roleDo) Create(values ...*table.Role) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Create(values)
+}
+
+func (r roleDo) CreateInBatches(values []*table.Role, batchSize int) error {
+	return r.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (r roleDo) Save(values ...*table.Role) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Save(values)
+}
+
+func (r roleDo) First() (*table.Role, error) {
+	if result, err := r.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Role), nil
+	}
+}
+
+func (r roleDo) Take() (*table.Role, error) {
+	if result, err := r.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Role), nil
+	}
+}
+
+func (r roleDo) Last() (*table.Role, error) {
+	if result, err := r.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Role), nil
+	}
+}
+
+func (r roleDo) Find() ([]*table.Role, error) {
+	result, err := r.DO.Find()
+	return result.([]*table.Role), err
+}
+
+func (r roleDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.Role, err error) {
+	buf := make([]*table.Role, 0, batchSize)
+	err = r.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (r roleDo) FindInBatches(result *[]*table.Role, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return r.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (r roleDo) Attrs(attrs ...field.AssignExpr) IRoleDo {
+	return r.withDO(r.DO.Attrs(attrs...))
+}
+
+func (r roleDo) Assign(attrs ...field.AssignExpr) IRoleDo {
+	return r.withDO(r.DO.Assign(attrs...))
+}
+
+func (r roleDo) Joins(fields ...field.RelationField) IRoleDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Joins(_f))
+	}
+	return &r
+}
+
+func (r roleDo) Preload(fields ...field.RelationField) IRoleDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Preload(_f))
+	}
+	return &r
+}
+
+func (r roleDo) FirstOrInit() (*table.Role, error) {
+	if result, err := r.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Role), nil
+	}
+}
+
+func (r roleDo) FirstOrCreate() (*table.Role, error) {
+	if result, err := r.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Role), nil
+	}
+}
+
+func (r roleDo) FindByPage(offset int, limit int) (result []*table.Role, count int64, err error) {
+	result, err = r.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = r.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (r roleDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = r.Count()
+	if err != nil {
+		return
+	}
+
+	err = r.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (r roleDo) Scan(result interface{}) (err error) {
+	return r.DO.Scan(result)
+}
+
+func (r roleDo) Delete(models ...*table.Role) (result gen.ResultInfo, err error) {
+	return r.DO.Delete(models)
+}
+
+func (r *roleDo) withDO(do gen.Dao) *roleDo {
+	r.DO = *do.(*gen.DO)
+	return r
+}
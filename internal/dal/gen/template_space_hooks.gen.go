@@ -0,0 +1,416 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newTemplateSpaceHook(db *gorm.DB, opts ...gen.DOOption) templateSpaceHook {
+	_templateSpaceHook := templateSpaceHook{}
+
+	_templateSpaceHook.templateSpaceHookDo.UseDB(db, opts...)
+	_templateSpaceHook.templateSpaceHookDo.UseModel(&table.TemplateSpaceHook{})
+
+	tableName := _templateSpaceHook.templateSpaceHookDo.TableName()
+	_templateSpaceHook.ALL = field.NewAsterisk(tableName)
+	_templateSpaceHook.ID = field.NewUint32(tableName, "id")
+	_templateSpaceHook.BizID = field.NewUint32(tableName, "biz_id")
+	_templateSpaceHook.TemplateSpaceID = field.NewUint32(tableName, "template_space_id")
+	_templateSpaceHook.HookID = field.NewUint32(tableName, "hook_id")
+	_templateSpaceHook.TenantID = field.NewString(tableName, "tenant_id")
+	_templateSpaceHook.Creator = field.NewString(tableName, "creator")
+	_templateSpaceHook.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_templateSpaceHook.fillFieldMap()
+
+	return _templateSpaceHook
+}
+
+type templateSpaceHook struct {
+	templateSpaceHookDo templateSpaceHookDo
+
+	ALL             field.Asterisk
+	ID              field.Uint32
+	BizID           field.Uint32
+	TemplateSpaceID field.Uint32
+	HookID          field.Uint32
+	TenantID        field.String
+	Creator         field.String
+	CreatedAt       field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (t templateSpaceHook) Table(newTableName string) *templateSpaceHook {
+	t.templateSpaceHookDo.UseTable(newTableName)
+	return t.updateTableName(newTableName)
+}
+
+func (t templateSpaceHook) As(alias string) *templateSpaceHook {
+	t.templateSpaceHookDo.DO = *(t.templateSpaceHookDo.As(alias).(*gen.DO))
+	return t.updateTableName(alias)
+}
+
+func (t *templateSpaceHook) updateTableName(table string) *templateSpaceHook {
+	t.ALL = field.NewAsterisk(table)
+	t.ID = field.NewUint32(table, "id")
+	t.BizID = field.NewUint32(table, "biz_id")
+	t.TemplateSpaceID = field.NewUint32(table, "template_space_id")
+	t.HookID = field.NewUint32(table, "hook_id")
+	t.TenantID = field.NewString(table, "tenant_id")
+	t.Creator = field.NewString(table, "creator")
+	t.CreatedAt = field.NewTime(table, "created_at")
+
+	t.fillFieldMap()
+
+	return t
+}
+
+func (t *templateSpaceHook) WithContext(ctx context.Context) ITemplateSpaceHookDo {
+	return t.templateSpaceHookDo.WithContext(ctx)
+}
+
+func (t templateSpaceHook) TableName() string { return t.templateSpaceHookDo.TableName() }
+
+func (t templateSpaceHook) Alias() string { return t.templateSpaceHookDo.Alias() }
+
+func (t templateSpaceHook) Columns(cols ...field.Expr) gen.Columns {
+	return t.templateSpaceHookDo.Columns(cols...)
+}
+
+func (t *templateSpaceHook) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := t.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (t *templateSpaceHook) fillFieldMap() {
+	t.fieldMap = make(map[string]field.Expr, 7)
+	t.fieldMap["id"] = t.ID
+	t.fieldMap["biz_id"] = t.BizID
+	t.fieldMap["template_space_id"] = t.TemplateSpaceID
+	t.fieldMap["hook_id"] = t.HookID
+	t.fieldMap["tenant_id"] = t.TenantID
+	t.fieldMap["creator"] = t.Creator
+	t.fieldMap["created_at"] = t.CreatedAt
+}
+
+func (t templateSpaceHook) clone(db *gorm.DB) templateSpaceHook {
+	t.templateSpaceHookDo.ReplaceConnPool(db.Statement.ConnPool)
+	return t
+}
+
+func (t templateSpaceHook) replaceDB(db *gorm.DB) templateSpaceHook {
+	t.templateSpaceHookDo.ReplaceDB(db)
+	return t
+}
+
+type templateSpaceHookDo struct{ gen.DO }
+
+type ITemplateSpaceHookDo interface {
+	gen.SubQuery
+	Debug() ITemplateSpaceHookDo
+	WithContext(ctx context.Context) ITemplateSpaceHookDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ITemplateSpaceHookDo
+	WriteDB() ITemplateSpaceHookDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ITemplateSpaceHookDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ITemplateSpaceHookDo
+	Not(conds ...gen.Condition) ITemplateSpaceHookDo
+	Or(conds ...gen.Condition) ITemplateSpaceHookDo
+	Select(conds ...field.Expr) ITemplateSpaceHookDo
+	Where(conds ...gen.Condition) ITemplateSpaceHookDo
+	Order(conds ...field.Expr) ITemplateSpaceHookDo
+	Distinct(cols ...field.Expr) ITemplateSpaceHookDo
+	Omit(cols ...field.Expr) ITemplateSpaceHookDo
+	Join(table schema.Tabler, on ...field.Expr) ITemplateSpaceHookDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ITemplateSpaceHookDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ITemplateSpaceHookDo
+	Group(cols ...field.Expr) ITemplateSpaceHookDo
+	Having(conds ...gen.Condition) ITemplateSpaceHookDo
+	Limit(limit int) ITemplateSpaceHookDo
+	Offset(offset int) ITemplateSpaceHookDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ITemplateSpaceHookDo
+	Unscoped() ITemplateSpaceHookDo
+	Create(values ...*table.TemplateSpaceHook) error
+	CreateInBatches(values []*table.TemplateSpaceHook, batchSize int) error
+	Save(values ...*table.TemplateSpaceHook) error
+	First() (*table.TemplateSpaceHook, error)
+	Take() (*table.TemplateSpaceHook, error)
+	Last() (*table.TemplateSpaceHook, error)
+	Find() ([]*table.TemplateSpaceHook, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.TemplateSpaceHook, err error)
+	FindInBatches(result *[]*table.TemplateSpaceHook, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.TemplateSpaceHook) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ITemplateSpaceHookDo
+	Assign(attrs ...field.AssignExpr) ITemplateSpaceHookDo
+	Joins(fields ...field.RelationField) ITemplateSpaceHookDo
+	Preload(fields ...field.RelationField) ITemplateSpaceHookDo
+	FirstOrInit() (*table.TemplateSpaceHook, error)
+	FirstOrCreate() (*table.TemplateSpaceHook, error)
+	FindByPage(offset int, limit int) (result []*table.TemplateSpaceHook, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ITemplateSpaceHookDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (t templateSpaceHookDo) Debug() ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Debug())
+}
+
+func (t templateSpaceHookDo) WithContext(ctx context.Context) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.WithContext(ctx))
+}
+
+func (t templateSpaceHookDo) ReadDB() ITemplateSpaceHookDo {
+	return t.Clauses(dbresolver.Read)
+}
+
+func (t templateSpaceHookDo) WriteDB() ITemplateSpaceHookDo {
+	return t.Clauses(dbresolver.Write)
+}
+
+func (t templateSpaceHookDo) Session(config *gorm.Session) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Session(config))
+}
+
+func (t templateSpaceHookDo) Clauses(conds ...clause.Expression) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Clauses(conds...))
+}
+
+func (t templateSpaceHookDo) Returning(value interface{}, columns ...string) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Returning(value, columns...))
+}
+
+func (t templateSpaceHookDo) Not(conds ...gen.Condition) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Not(conds...))
+}
+
+func (t templateSpaceHookDo) Or(conds ...gen.Condition) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Or(conds...))
+}
+
+func (t templateSpaceHookDo) Select(conds ...field.Expr) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Select(conds...))
+}
+
+func (t templateSpaceHookDo) Where(conds ...gen.Condition) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Where(conds...))
+}
+
+func (t templateSpaceHookDo) Order(conds ...field.Expr) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Order(conds...))
+}
+
+func (t templateSpaceHookDo) Distinct(cols ...field.Expr) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Distinct(cols...))
+}
+
+func (t templateSpaceHookDo) Omit(cols ...field.Expr) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Omit(cols...))
+}
+
+func (t templateSpaceHookDo) Join(table schema.Tabler, on ...field.Expr) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Join(table, on...))
+}
+
+func (t templateSpaceHookDo) LeftJoin(table schema.Tabler, on ...field.Expr) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.LeftJoin(table, on...))
+}
+
+func (t templateSpaceHookDo) RightJoin(table schema.Tabler, on ...field.Expr) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.RightJoin(table, on...))
+}
+
+func (t templateSpaceHookDo) Group(cols ...field.Expr) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Group(cols...))
+}
+
+func (t templateSpaceHookDo) Having(conds ...gen.Condition) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Having(conds...))
+}
+
+func (t templateSpaceHookDo) Limit(limit int) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Limit(limit))
+}
+
+func (t templateSpaceHookDo) Offset(offset int) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Offset(offset))
+}
+
+func (t templateSpaceHookDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Scopes(funcs...))
+}
+
+func (t templateSpaceHookDo) Unscoped() ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Unscoped())
+}
+
+func (t templateSpaceHookDo) Create(values ...*table.TemplateSpaceHook) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return t.DO.Create(values)
+}
+
+func (t templateSpaceHookDo) CreateInBatches(values []*table.TemplateSpaceHook, batchSize int) error {
+	return t.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (t templateSpaceHookDo) Save(values ...*table.TemplateSpaceHook) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return t.DO.Save(values)
+}
+
+func (t templateSpaceHookDo) First() (*table.TemplateSpaceHook, error) {
+	if result, err := t.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.TemplateSpaceHook), nil
+	}
+}
+
+func (t templateSpaceHookDo) Take() (*table.TemplateSpaceHook, error) {
+	if result, err := t.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.TemplateSpaceHook), nil
+	}
+}
+
+func (t templateSpaceHookDo) Last() (*table.TemplateSpaceHook, error) {
+	if result, err := t.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.TemplateSpaceHook), nil
+	}
+}
+
+func (t templateSpaceHookDo) Find() ([]*table.TemplateSpaceHook, error) {
+	result, err := t.DO.Find()
+	return result.([]*table.TemplateSpaceHook), err
+}
+
+func (t templateSpaceHookDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.TemplateSpaceHook, err error) {
+	buf := make([]*table.TemplateSpaceHook, 0, batchSize)
+	err = t.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (t templateSpaceHookDo) FindInBatches(result *[]*table.TemplateSpaceHook, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return t.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (t templateSpaceHookDo) Attrs(attrs ...field.AssignExpr) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Attrs(attrs...))
+}
+
+func (t templateSpaceHookDo) Assign(attrs ...field.AssignExpr) ITemplateSpaceHookDo {
+	return t.withDO(t.DO.Assign(attrs...))
+}
+
+func (t templateSpaceHookDo) Joins(fields ...field.RelationField) ITemplateSpaceHookDo {
+	for _, _f := range fields {
+		t = *t.withDO(t.DO.Joins(_f))
+	}
+	return &t
+}
+
+func (t templateSpaceHookDo) Preload(fields ...field.RelationField) ITemplateSpaceHookDo {
+	for _, _f := range fields {
+		t = *t.withDO(t.DO.Preload(_f))
+	}
+	return &t
+}
+
+func (t templateSpaceHookDo) FirstOrInit() (*table.TemplateSpaceHook, error) {
+	if result, err := t.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.TemplateSpaceHook), nil
+	}
+}
+
+func (t templateSpaceHookDo) FirstOrCreate() (*table.TemplateSpaceHook, error) {
+	if result, err := t.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.TemplateSpaceHook), nil
+	}
+}
+
+func (t templateSpaceHookDo) FindByPage(offset int, limit int) (result []*table.TemplateSpaceHook, count int64, err error) {
+	result, err = t.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = t.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (t templateSpaceHookDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = t.Count()
+	if err != nil {
+		return
+	}
+
+	err = t.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (t templateSpaceHookDo) Scan(result interface{}) (err error) {
+	return t.DO.Scan(result)
+}
+
+func (t templateSpaceHookDo) Delete(models ...*table.TemplateSpaceHook) (result gen.ResultInfo, err error) {
+	return t.DO.Delete(models)
+}
+
+func (t *templateSpaceHookDo) withDO(do gen.Dao) *templateSpaceHookDo {
+	t.DO = *do.(*gen.DO)
+	return t
+}
@@ -32,6 +32,7 @@ func newTemplateVariable(db *gorm.DB, opts ...gen.DOOption) templateVariable {
 	_templateVariable.Type = field.NewString(tableName, "type")
 	_templateVariable.DefaultVal = field.NewString(tableName, "default_val")
 	_templateVariable.Memo = field.NewString(tableName, "memo")
+	_templateVariable.Constraints = field.NewField(tableName, "constraints")
 	_templateVariable.BizID = field.NewUint32(tableName, "biz_id")
 	_templateVariable.TenantID = field.NewString(tableName, "tenant_id")
 	_templateVariable.Creator = field.NewString(tableName, "creator")
@@ -47,18 +48,19 @@ func newTemplateVariable(db *gorm.DB, opts ...gen.DOOption) templateVariable {
 type templateVariable struct {
 	templateVariableDo templateVariableDo
 
-	ALL        field.Asterisk
-	ID         field.Uint32
-	Name       field.String
-	Type       field.String
-	DefaultVal field.String
-	Memo       field.String
-	BizID      field.Uint32
-	TenantID   field.String
-	Creator    field.String
-	Reviser    field.String
-	CreatedAt  field.Time
-	UpdatedAt  field.Time
+	ALL         field.Asterisk
+	ID          field.Uint32
+	Name        field.String
+	Type        field.String
+	DefaultVal  field.String
+	Memo        field.String
+	Constraints field.Field
+	BizID       field.Uint32
+	TenantID    field.String
+	Creator     field.String
+	Reviser     field.String
+	CreatedAt   field.Time
+	UpdatedAt   field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -80,6 +82,7 @@ func (t *templateVariable) updateTableName(table string) *templateVariable {
 	t.Type = field.NewString(table, "type")
 	t.DefaultVal = field.NewString(table, "default_val")
 	t.Memo = field.NewString(table, "memo")
+	t.Constraints = field.NewField(table, "constraints")
 	t.BizID = field.NewUint32(table, "biz_id")
 	t.TenantID = field.NewString(table, "tenant_id")
 	t.Creator = field.NewString(table, "creator")
@@ -114,12 +117,13 @@ func (t *templateVariable) GetFieldByName(fieldName string) (field.OrderExpr, bo
 }
 
 func (t *templateVariable) fillFieldMap() {
-	t.fieldMap = make(map[string]field.Expr, 11)
+	t.fieldMap = make(map[string]field.Expr, 12)
 	t.fieldMap["id"] = t.ID
 	t.fieldMap["name"] = t.Name
 	t.fieldMap["type"] = t.Type
 	t.fieldMap["default_val"] = t.DefaultVal
 	t.fieldMap["memo"] = t.Memo
+	t.fieldMap["constraints"] = t.Constraints
 	t.fieldMap["biz_id"] = t.BizID
 	t.fieldMap["tenant_id"] = t.TenantID
 	t.fieldMap["creator"] = t.Creator
@@ -0,0 +1,464 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newWebhookDelivery(db *gorm.DB, opts ...gen.DOOption) webhookDelivery {
+	_webhookDelivery := webhookDelivery{}
+
+	_webhookDelivery.webhookDeliveryDo.UseDB(db, opts...)
+	_webhookDelivery.webhookDeliveryDo.UseModel(&table.WebhookDelivery{})
+
+	tableName := _webhookDelivery.webhookDeliveryDo.TableName()
+	_webhookDelivery.ALL = field.NewAsterisk(tableName)
+	_webhookDelivery.ID = field.NewUint32(tableName, "id")
+	_webhookDelivery.EventType = field.NewString(tableName, "event_type")
+	_webhookDelivery.EventID = field.NewString(tableName, "event_id")
+	_webhookDelivery.Payload = field.NewString(tableName, "payload")
+	_webhookDelivery.Status = field.NewString(tableName, "status")
+	_webhookDelivery.Attempts = field.NewUint32(tableName, "attempts")
+	_webhookDelivery.NextAttemptAt = field.NewTime(tableName, "next_attempt_at")
+	_webhookDelivery.ResponseCode = field.NewUint32(tableName, "response_code")
+	_webhookDelivery.ResponseBody = field.NewString(tableName, "response_body")
+	_webhookDelivery.LastError = field.NewString(tableName, "last_error")
+	_webhookDelivery.DeliveredAt = field.NewTime(tableName, "delivered_at")
+	_webhookDelivery.BizID = field.NewUint32(tableName, "biz_id")
+	_webhookDelivery.AppID = field.NewUint32(tableName, "app_id")
+	_webhookDelivery.WebhookID = field.NewUint32(tableName, "webhook_id")
+	_webhookDelivery.TenantID = field.NewString(tableName, "tenant_id")
+	_webhookDelivery.Creator = field.NewString(tableName, "creator")
+	_webhookDelivery.Reviser = field.NewString(tableName, "reviser")
+	_webhookDelivery.CreatedAt = field.NewTime(tableName, "created_at")
+	_webhookDelivery.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_webhookDelivery.fillFieldMap()
+
+	return _webhookDelivery
+}
+
+type webhookDelivery struct {
+	webhookDeliveryDo webhookDeliveryDo
+
+	ALL           field.Asterisk
+	ID            field.Uint32
+	EventType     field.String
+	EventID       field.String
+	Payload       field.String
+	Status        field.String
+	Attempts      field.Uint32
+	NextAttemptAt field.Time
+	ResponseCode  field.Uint32
+	ResponseBody  field.String
+	LastError     field.String
+	DeliveredAt   field.Time
+	BizID         field.Uint32
+	AppID         field.Uint32
+	WebhookID     field.Uint32
+	TenantID      field.String
+	Creator       field.String
+	Reviser       field.String
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (w webhookDelivery) Table(newTableName string) *webhookDelivery {
+	w.webhookDeliveryDo.UseTable(newTableName)
+	return w.updateTableName(newTableName)
+}
+
+func (w webhookDelivery) As(alias string) *webhookDelivery {
+	w.webhookDeliveryDo.DO = *(w.webhookDeliveryDo.As(alias).(*gen.DO))
+	return w.updateTableName(alias)
+}
+
+func (w *webhookDelivery) updateTableName(table string) *webhookDelivery {
+	w.ALL = field.NewAsterisk(table)
+	w.ID = field.NewUint32(table, "id")
+	w.EventType = field.NewString(table, "event_type")
+	w.EventID = field.NewString(table, "event_id")
+	w.Payload = field.NewString(table, "payload")
+	w.Status = field.NewString(table, "status")
+	w.Attempts = field.NewUint32(table, "attempts")
+	w.NextAttemptAt = field.NewTime(table, "next_attempt_at")
+	w.ResponseCode = field.NewUint32(table, "response_code")
+	w.ResponseBody = field.NewString(table, "response_body")
+	w.LastError = field.NewString(table, "last_error")
+	w.DeliveredAt = field.NewTime(table, "delivered_at")
+	w.BizID = field.NewUint32(table, "biz_id")
+	w.AppID = field.NewUint32(table, "app_id")
+	w.WebhookID = field.NewUint32(table, "webhook_id")
+	w.TenantID = field.NewString(table, "tenant_id")
+	w.Creator = field.NewString(table, "creator")
+	w.Reviser = field.NewString(table, "reviser")
+	w.CreatedAt = field.NewTime(table, "created_at")
+	w.UpdatedAt = field.NewTime(table, "updated_at")
+
+	w.fillFieldMap()
+
+	return w
+}
+
+func (w *webhookDelivery) WithContext(ctx context.Context) IWebhookDeliveryDo {
+	return w.webhookDeliveryDo.WithContext(ctx)
+}
+
+func (w webhookDelivery) TableName() string { return w.webhookDeliveryDo.TableName() }
+
+func (w webhookDelivery) Alias() string { return w.webhookDeliveryDo.Alias() }
+
+func (w webhookDelivery) Columns(cols ...field.Expr) gen.Columns {
+	return w.webhookDeliveryDo.Columns(cols...)
+}
+
+func (w *webhookDelivery) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := w.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (w *webhookDelivery) fillFieldMap() {
+	w.fieldMap = make(map[string]field.Expr, 19)
+	w.fieldMap["id"] = w.ID
+	w.fieldMap["event_type"] = w.EventType
+	w.fieldMap["event_id"] = w.EventID
+	w.fieldMap["payload"] = w.Payload
+	w.fieldMap["status"] = w.Status
+	w.fieldMap["attempts"] = w.Attempts
+	w.fieldMap["next_attempt_at"] = w.NextAttemptAt
+	w.fieldMap["response_code"] = w.ResponseCode
+	w.fieldMap["response_body"] = w.ResponseBody
+	w.fieldMap["last_error"] = w.LastError
+	w.fieldMap["delivered_at"] = w.DeliveredAt
+	w.fieldMap["biz_id"] = w.BizID
+	w.fieldMap["app_id"] = w.AppID
+	w.fieldMap["webhook_id"] = w.WebhookID
+	w.fieldMap["tenant_id"] = w.TenantID
+	w.fieldMap["creator"] = w.Creator
+	w.fieldMap["reviser"] = w.Reviser
+	w.fieldMap["created_at"] = w.CreatedAt
+	w.fieldMap["updated_at"] = w.UpdatedAt
+}
+
+func (w webhookDelivery) clone(db *gorm.DB) webhookDelivery {
+	w.webhookDeliveryDo.ReplaceConnPool(db.Statement.ConnPool)
+	return w
+}
+
+func (w webhookDelivery) replaceDB(db *gorm.DB) webhookDelivery {
+	w.webhookDeliveryDo.ReplaceDB(db)
+	return w
+}
+
+type webhookDeliveryDo struct{ gen.DO }
+
+type IWebhookDeliveryDo interface {
+	gen.SubQuery
+	Debug() IWebhookDeliveryDo
+	WithContext(ctx context.Context) IWebhookDeliveryDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IWebhookDeliveryDo
+	WriteDB() IWebhookDeliveryDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IWebhookDeliveryDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IWebhookDeliveryDo
+	Not(conds ...gen.Condition) IWebhookDeliveryDo
+	Or(conds ...gen.Condition) IWebhookDeliveryDo
+	Select(conds ...field.Expr) IWebhookDeliveryDo
+	Where(conds ...gen.Condition) IWebhookDeliveryDo
+	Order(conds ...field.Expr) IWebhookDeliveryDo
+	Distinct(cols ...field.Expr) IWebhookDeliveryDo
+	Omit(cols ...field.Expr) IWebhookDeliveryDo
+	Join(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo
+	Group(cols ...field.Expr) IWebhookDeliveryDo
+	Having(conds ...gen.Condition) IWebhookDeliveryDo
+	Limit(limit int) IWebhookDeliveryDo
+	Offset(offset int) IWebhookDeliveryDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IWebhookDeliveryDo
+	Unscoped() IWebhookDeliveryDo
+	Create(values ...*table.WebhookDelivery) error
+	CreateInBatches(values []*table.WebhookDelivery, batchSize int) error
+	Save(values ...*table.WebhookDelivery) error
+	First() (*table.WebhookDelivery, error)
+	Take() (*table.WebhookDelivery, error)
+	Last() (*table.WebhookDelivery, error)
+	Find() ([]*table.WebhookDelivery, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.WebhookDelivery, err error)
+	FindInBatches(result *[]*table.WebhookDelivery, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.WebhookDelivery) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IWebhookDeliveryDo
+	Assign(attrs ...field.AssignExpr) IWebhookDeliveryDo
+	Joins(fields ...field.RelationField) IWebhookDeliveryDo
+	Preload(fields ...field.RelationField) IWebhookDeliveryDo
+	FirstOrInit() (*table.WebhookDelivery, error)
+	FirstOrCreate() (*table.WebhookDelivery, error)
+	FindByPage(offset int, limit int) (result []*table.WebhookDelivery, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IWebhookDeliveryDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (w webhookDeliveryDo) Debug() IWebhookDeliveryDo {
+	return w.withDO(w.DO.Debug())
+}
+
+func (w webhookDeliveryDo) WithContext(ctx context.Context) IWebhookDeliveryDo {
+	return w.withDO(w.DO.WithContext(ctx))
+}
+
+func (w webhookDeliveryDo) ReadDB() IWebhookDeliveryDo {
+	return w.Clauses(dbresolver.Read)
+}
+
+func (w webhookDeliveryDo) WriteDB() IWebhookDeliveryDo {
+	return w.Clauses(dbresolver.Write)
+}
+
+func (w webhookDeliveryDo) Session(config *gorm.Session) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Session(config))
+}
+
+func (w webhookDeliveryDo) Clauses(conds ...clause.Expression) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Clauses(conds...))
+}
+
+func (w webhookDeliveryDo) Returning(value interface{}, columns ...string) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Returning(value, columns...))
+}
+
+func (w webhookDeliveryDo) Not(conds ...gen.Condition) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Not(conds...))
+}
+
+func (w webhookDeliveryDo) Or(conds ...gen.Condition) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Or(conds...))
+}
+
+func (w webhookDeliveryDo) Select(conds ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Select(conds...))
+}
+
+func (w webhookDeliveryDo) Where(conds ...gen.Condition) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Where(conds...))
+}
+
+func (w webhookDeliveryDo) Order(conds ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Order(conds...))
+}
+
+func (w webhookDeliveryDo) Distinct(cols ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Distinct(cols...))
+}
+
+func (w webhookDeliveryDo) Omit(cols ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Omit(cols...))
+}
+
+func (w webhookDeliveryDo) Join(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Join(table, on...))
+}
+
+func (w webhookDeliveryDo) LeftJoin(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.LeftJoin(table, on...))
+}
+
+func (w webhookDeliveryDo) RightJoin(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.RightJoin(table, on...))
+}
+
+func (w webhookDeliveryDo) Group(cols ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Group(cols...))
+}
+
+func (w webhookDeliveryDo) Having(conds ...gen.Condition) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Having(conds...))
+}
+
+func (w webhookDeliveryDo) Limit(limit int) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Limit(limit))
+}
+
+func (w webhookDeliveryDo) Offset(offset int) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Offset(offset))
+}
+
+func (w webhookDeliveryDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Scopes(funcs...))
+}
+
+func (w webhookDeliveryDo) Unscoped() IWebhookDeliveryDo {
+	return w.withDO(w.DO.Unscoped())
+}
+
+func (w webhookDeliveryDo) Create(values ...*table.WebhookDelivery) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Create(values)
+}
+
+func (w webhookDeliveryDo) CreateInBatches(values []*table.WebhookDelivery, batchSize int) error {
+	return w.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (w webhookDeliveryDo) Save(values ...*table.WebhookDelivery) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Save(values)
+}
+
+func (w webhookDeliveryDo) First() (*table.WebhookDelivery, error) {
+	if result, err := w.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.WebhookDelivery), nil
+	}
+}
+
+func (w webhookDeliveryDo) Take() (*table.WebhookDelivery, error) {
+	if result, err := w.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.WebhookDelivery), nil
+	}
+}
+
+func (w webhookDeliveryDo) Last() (*table.WebhookDelivery, error) {
+	if result, err := w.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.WebhookDelivery), nil
+	}
+}
+
+func (w webhookDeliveryDo) Find() ([]*table.WebhookDelivery, error) {
+	result, err := w.DO.Find()
+	return result.([]*table.WebhookDelivery), err
+}
+
+func (w webhookDeliveryDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.WebhookDelivery, err error) {
+	buf := make([]*table.WebhookDelivery, 0, batchSize)
+	err = w.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (w webhookDeliveryDo) FindInBatches(result *[]*table.WebhookDelivery, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return w.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (w webhookDeliveryDo) Attrs(attrs ...field.AssignExpr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Attrs(attrs...))
+}
+
+func (w webhookDeliveryDo) Assign(attrs ...field.AssignExpr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Assign(attrs...))
+}
+
+func (w webhookDeliveryDo) Joins(fields ...field.RelationField) IWebhookDeliveryDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Joins(_f))
+	}
+	return &w
+}
+
+func (w webhookDeliveryDo) Preload(fields ...field.RelationField) IWebhookDeliveryDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Preload(_f))
+	}
+	return &w
+}
+
+func (w webhookDeliveryDo) FirstOrInit() (*table.WebhookDelivery, error) {
+	if result, err := w.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.WebhookDelivery), nil
+	}
+}
+
+func (w webhookDeliveryDo) FirstOrCreate() (*table.WebhookDelivery, error) {
+	if result, err := w.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.WebhookDelivery), nil
+	}
+}
+
+func (w webhookDeliveryDo) FindByPage(offset int, limit int) (result []*table.WebhookDelivery, count int64, err error) {
+	result, err = w.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = w.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (w webhookDeliveryDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = w.Count()
+	if err != nil {
+		return
+	}
+
+	err = w.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (w webhookDeliveryDo) Scan(result interface{}) (err error) {
+	return w.DO.Scan(result)
+}
+
+func (w webhookDeliveryDo) Delete(models ...*table.WebhookDelivery) (result gen.ResultInfo, err error) {
+	return w.DO.Delete(models)
+}
+
+func (w *webhookDeliveryDo) withDO(do gen.Dao) *webhookDeliveryDo {
+	w.DO = *do.(*gen.DO)
+	return w
+}
@@ -219,9 +219,18 @@ type repoProvider struct {
 
 // NewProvider init provider factory by storage type
 func NewProvider(conf cc.Repository) (Provider, error) {
+	var (
+		p   Provider
+		err error
+	)
 	if conf.EnableHA {
-		return newHAProvider(conf)
+		p, err = newHAProvider(conf)
+	} else {
+		p, err = newMasterProvider(conf)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return newMasterProvider(conf)
+	return &tracedProvider{Provider: p}, nil
 }
@@ -0,0 +1,99 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"io"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/tracer"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// tracedProvider starts a span for each repository operation with the file sign attached.
+type tracedProvider struct {
+	Provider
+}
+
+func (p *tracedProvider) start(kt *kit.Kit, op, sign string) (*kit.Kit, trace.Span) {
+	return tracer.StartKit(kt, "repository."+op, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("bscp.file.sign", sign)))
+}
+
+// DownloadLink 获取文件下载链接
+func (p *tracedProvider) DownloadLink(kt *kit.Kit, sign string, fetchLimit uint32) (links []string, err error) {
+	kt, span := p.start(kt, "DownloadLink", sign)
+	defer func() { tracer.End(span, err) }()
+	return p.Provider.DownloadLink(kt, sign, fetchLimit)
+}
+
+// AsyncDownload 异步下载文件
+func (p *tracedProvider) AsyncDownload(kt *kit.Kit, sign string) (taskID string, err error) {
+	kt, span := p.start(kt, "AsyncDownload", sign)
+	defer func() { tracer.End(span, err) }()
+	return p.Provider.AsyncDownload(kt, sign)
+}
+
+// AsyncDownloadStatus 查询异步下载任务状态
+func (p *tracedProvider) AsyncDownloadStatus(kt *kit.Kit, sign string, taskID string) (done bool, err error) {
+	kt, span := p.start(kt, "AsyncDownloadStatus", sign)
+	defer func() { tracer.End(span, err) }()
+	return p.Provider.AsyncDownloadStatus(kt, sign, taskID)
+}
+
+// Upload 上传文件
+func (p *tracedProvider) Upload(kt *kit.Kit, sign string, body io.Reader) (md *ObjectMetadata, err error) {
+	kt, span := p.start(kt, "Upload", sign)
+	defer func() { tracer.End(span, err) }()
+	return p.Provider.Upload(kt, sign, body)
+}
+
+// InitMultipartUpload 初始化分块上传
+func (p *tracedProvider) InitMultipartUpload(kt *kit.Kit, sign string) (uploadID string, err error) {
+	kt, span := p.start(kt, "InitMultipartUpload", sign)
+	defer func() { tracer.End(span, err) }()
+	return p.Provider.InitMultipartUpload(kt, sign)
+}
+
+// MultipartUpload 上传文件分块
+func (p *tracedProvider) MultipartUpload(kt *kit.Kit, sign string, uploadID string, partNum uint32,
+	body io.Reader) (err error) {
+	kt, span := p.start(kt, "MultipartUpload", sign)
+	span.SetAttributes(attribute.Int64("bscp.file.part_num", int64(partNum)))
+	defer func() { tracer.End(span, err) }()
+	return p.Provider.MultipartUpload(kt, sign, uploadID, partNum, body)
+}
+
+// CompleteMultipartUpload 完成分块上传
+func (p *tracedProvider) CompleteMultipartUpload(kt *kit.Kit, sign string, uploadID string) (
+	md *ObjectMetadata, err error) {
+	kt, span := p.start(kt, "CompleteMultipartUpload", sign)
+	defer func() { tracer.End(span, err) }()
+	return p.Provider.CompleteMultipartUpload(kt, sign, uploadID)
+}
+
+// Download 下载文件, span 只包含获取文件流的耗时
+func (p *tracedProvider) Download(kt *kit.Kit, sign string) (body io.ReadCloser, size int64, err error) {
+	kt, span := p.start(kt, "Download", sign)
+	defer func() { tracer.End(span, err) }()
+	return p.Provider.Download(kt, sign)
+}
+
+// Metadata 获取文件元数据
+func (p *tracedProvider) Metadata(kt *kit.Kit, sign string) (md *ObjectMetadata, err error) {
+	kt, span := p.start(kt, "Metadata", sign)
+	defer func() { tracer.End(span, err) }()
+	return p.Provider.Metadata(kt, sign)
+}
@@ -14,7 +14,6 @@ package sharding
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql" // import mysql drive, used to create conn.
@@ -23,11 +22,12 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 )
 
-// connect to mysql
+// connect to the database with the dialect of its driver.
 func connect(opt cc.Database) (*sqlx.DB, error) {
-	db, err := sqlx.Connect("mysql", URI(opt))
+	d := DialectOf(opt)
+	db, err := sqlx.Connect(d.DriverName(), d.DSN(opt))
 	if err != nil {
-		return nil, fmt.Errorf("connect to mysql failed, err: %v", err)
+		return nil, fmt.Errorf("connect to %s failed, err: %v", d.Name(), err)
 	}
 
 	db.SetMaxOpenConns(int(opt.MaxOpenConn))
@@ -39,16 +39,5 @@ func connect(opt cc.Database) (*sqlx.DB, error) {
 
 // URI generate the standard db connection string format uri.
 func URI(opt cc.Database) string {
-
-	return fmt.Sprintf(
-		"%s:%s@tcp(%s)/%s?parseTime=true&loc=UTC&timeout=%ds&readTimeout=%ds&writeTimeout=%ds&charset=%s",
-		opt.User,
-		opt.Password,
-		strings.Join(opt.Endpoints, ","),
-		opt.Database,
-		opt.DialTimeoutSec,
-		opt.ReadTimeoutSec,
-		opt.WriteTimeoutSec,
-		"utf8mb4",
-	)
+	return DialectOf(opt).DSN(opt)
 }
@@ -0,0 +1,141 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sharding
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // import pgx driver, used to create postgresql conn.
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// Dialect defines the database dialect related operations, which hides the
+// differences of connecting to mysql and postgresql.
+type Dialect interface {
+	// Name returns the dialect name, which is the same as the gorm dialector's name.
+	Name() string
+	// DriverName returns the database/sql driver name used by sqlx.
+	DriverName() string
+	// DSN generates the connection string of the database.
+	DSN(opt cc.Database) string
+	// Open returns the gorm dialector with the dsn.
+	Open(dsn string) gorm.Dialector
+}
+
+// DialectOf returns the dialect of the database.
+func DialectOf(opt cc.Database) Dialect {
+	if opt.Driver == cc.PostgresDriver {
+		return postgresDialect{}
+	}
+
+	return mysqlDialect{}
+}
+
+// GormDialector returns the gorm dialector to open the database.
+func GormDialector(opt cc.Database) gorm.Dialector {
+	d := DialectOf(opt)
+	return d.Open(d.DSN(opt))
+}
+
+type mysqlDialect struct{}
+
+// Name returns the dialect name.
+func (mysqlDialect) Name() string {
+	return string(cc.MySQLDriver)
+}
+
+// DriverName returns the database/sql driver name.
+func (mysqlDialect) DriverName() string {
+	return "mysql"
+}
+
+// DSN generates the mysql connection string.
+func (mysqlDialect) DSN(opt cc.Database) string {
+	return fmt.Sprintf(
+		"%s:%s@tcp(%s)/%s?parseTime=true&loc=UTC&timeout=%ds&readTimeout=%ds&writeTimeout=%ds&charset=%s",
+		opt.User,
+		opt.Password,
+		strings.Join(opt.Endpoints, ","),
+		opt.Database,
+		opt.DialTimeoutSec,
+		opt.ReadTimeoutSec,
+		opt.WriteTimeoutSec,
+		"utf8mb4",
+	)
+}
+
+// Open returns the gorm mysql dialector.
+func (mysqlDialect) Open(dsn string) gorm.Dialector {
+	return mysql.Open(dsn)
+}
+
+type postgresDialect struct{}
+
+// Name returns the dialect name.
+func (postgresDialect) Name() string {
+	return string(cc.PostgresDriver)
+}
+
+// DriverName returns the database/sql driver name.
+func (postgresDialect) DriverName() string {
+	return "pgx"
+}
+
+// DSN generates the postgresql connection url, multiple endpoints are tried in order by pgx.
+// postgresql has no read or write timeout, the statement timeout is set with the larger one.
+func (postgresDialect) DSN(opt cc.Database) string {
+	query := url.Values{}
+	query.Set("connect_timeout", fmt.Sprintf("%d", opt.DialTimeoutSec))
+	query.Set("statement_timeout", fmt.Sprintf("%d", 1000*max(opt.ReadTimeoutSec, opt.WriteTimeoutSec)))
+	query.Set("TimeZone", "UTC")
+
+	switch {
+	case !opt.TLS.Enable():
+		query.Set("sslmode", "disable")
+	case opt.TLS.InsecureSkipVerify:
+		query.Set("sslmode", "require")
+	default:
+		query.Set("sslmode", "verify-full")
+	}
+	if opt.TLS.CAFile != "" {
+		query.Set("sslrootcert", opt.TLS.CAFile)
+	}
+	if opt.TLS.CertFile != "" && opt.TLS.KeyFile != "" {
+		query.Set("sslcert", opt.TLS.CertFile)
+		query.Set("sslkey", opt.TLS.KeyFile)
+	}
+	if opt.TLS.Password != "" {
+		query.Set("sslpassword", opt.TLS.Password)
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(opt.User, opt.Password),
+		Host:     strings.Join(opt.Endpoints, ","),
+		Path:     "/" + opt.Database,
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
+}
+
+// Open returns the gorm postgres dialector.
+func (postgresDialect) Open(dsn string) gorm.Dialector {
+	return postgres.Open(dsn)
+}
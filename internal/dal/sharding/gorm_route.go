@@ -0,0 +1,650 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sharding
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// bizIDCondition matches the string condition of biz id, eg. "biz_id = ?" or "`apps`.`biz_id` = ?".
+var bizIDCondition = regexp.MustCompile("^\\s*(`?\\w+`?\\.)?`?biz_id`?\\s*=\\s*\\?\\s*$")
+
+// RouteGorm routes the statements of the gorm db to the sharding db of their biz, which is the same one
+// MustSharding and ShardingOne return for the biz:
+//  1. the statements of AdminTables and the tables without biz_id column use the admin db.
+//  2. the biz of a statement is the biz_id condition of its where clause, the biz_id field of the rows
+//     to write, or the biz of the request context.
+//  3. a transaction is begun on the db of its first statement, the statements of another sharding db in
+//     it are refused, except the writes of the admin tables, eg. the events fired with the transaction,
+//     which are applied to the admin db after the transaction commits.
+//  4. the writes of the frozen bizs are refused, and the deleted rows of the resharded bizs are recorded
+//     in DeletesTable of their sharding db.
+//  5. the statements of a biz whose sharding db is unavailable are refused, and so are the rows of the
+//     resharded bizs read from the admin db by a statement whose biz is unknown.
+func (s *Sharding) RouteGorm(db *gorm.DB) error {
+	admin, err := db.DB()
+	if err != nil {
+		return err
+	}
+
+	r := &gormRouter{s: s, admin: admin}
+	db.ConnPool = &routedPool{db: admin, admin: admin}
+	db.Statement.ConnPool = db.ConnPool
+
+	callback := db.Callback()
+	if err := callback.Create().Before("gorm:create").Register("route_sharding", r.routeWrite); err != nil {
+		return err
+	}
+	if err := callback.Update().Before("gorm:update").Register("route_sharding", r.routeWrite); err != nil {
+		return err
+	}
+	// the deleted rows are recorded with the same conditions as the delete, including the tenant condition.
+	if err := callback.Delete().Before("gorm:delete").After("set_tenant_id").Register("route_sharding",
+		r.routeDelete); err != nil {
+		return err
+	}
+	if err := callback.Query().Before("gorm:query").Register("route_sharding", r.routeRead); err != nil {
+		return err
+	}
+	if err := callback.Query().After("gorm:query").Register("route_sharding_check", r.checkRead); err != nil {
+		return err
+	}
+	if err := callback.Row().Before("gorm:row").Register("route_sharding", r.routeRead); err != nil {
+		return err
+	}
+	return callback.Raw().Before("gorm:raw").Register("route_sharding", r.routeRead)
+}
+
+// gormRouter routes the statements of gorm by the callbacks.
+type gormRouter struct {
+	s     *Sharding
+	admin *sql.DB
+}
+
+// routedPool is the connection pool of the statements routed to a db, which is the admin db by default.
+// the transactions begun on it are routed by their statements.
+type routedPool struct {
+	db    *sql.DB
+	admin *sql.DB
+}
+
+var _ gorm.ConnPoolBeginner = new(routedPool)
+var _ gorm.GetDBConnector = new(routedPool)
+
+// PrepareContext prepares the statement on the db.
+func (p *routedPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return p.db.PrepareContext(ctx, query)
+}
+
+// ExecContext executes the statement on the db.
+func (p *routedPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return p.db.ExecContext(ctx, query, args...)
+}
+
+// QueryContext queries on the db.
+func (p *routedPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return p.db.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext queries a row on the db.
+func (p *routedPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return p.db.QueryRowContext(ctx, query, args...)
+}
+
+// BeginTx begins a transaction, which is begun on a db when its first statement is executed.
+func (p *routedPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	return &routedTx{ctx: ctx, opts: opts, admin: p.admin}, nil
+}
+
+// GetDBConn returns the admin db, whose settings are used by all the statements.
+func (p *routedPool) GetDBConn() (*sql.DB, error) {
+	return p.admin, nil
+}
+
+// stmtRoute is where a statement is routed to.
+type stmtRoute struct {
+	// db is the db of the statement, nil means the statement is not routed, which uses the admin db, or the
+	// db of the transaction which has begun.
+	db *sql.DB
+	// adminTable means the statement is on a table which is only kept in the admin db.
+	adminTable bool
+	// biz is the biz of the statement on a biz table, 0 means unknown.
+	biz uint32
+}
+
+func (r *gormRouter) routeRead(db *gorm.DB) {
+	if db.Error != nil {
+		return
+	}
+
+	rt, err := r.resolve(db.Statement)
+	if err != nil {
+		_ = db.AddError(err)
+		return
+	}
+	r.apply(db, rt)
+}
+
+func (r *gormRouter) routeWrite(db *gorm.DB) {
+	r.write(db)
+}
+
+func (r *gormRouter) routeDelete(db *gorm.DB) {
+	rt := r.write(db)
+	if db.Error != nil || rt.adminTable || rt.biz == 0 || !r.s.resharded(rt.biz) {
+		return
+	}
+
+	if err := recordDeletes(db, rt.biz); err != nil {
+		_ = db.AddError(err)
+	}
+}
+
+// write routes the write statement, the writes of the frozen bizs are refused.
+func (r *gormRouter) write(db *gorm.DB) *stmtRoute {
+	if db.Error != nil {
+		return &stmtRoute{}
+	}
+
+	rt, err := r.resolve(db.Statement)
+	if err != nil {
+		_ = db.AddError(err)
+		return &stmtRoute{}
+	}
+	if !rt.adminTable && db.Statement.Schema != nil {
+		if err := r.s.checkWrite(rt.biz); err != nil {
+			_ = db.AddError(err)
+			return rt
+		}
+	}
+	r.apply(db, rt)
+	return rt
+}
+
+// apply sets the connection pool of the statement by its route.
+func (r *gormRouter) apply(db *gorm.DB, rt *stmtRoute) {
+	stmt := db.Statement
+
+	var tx *routedTx
+	switch pool := stmt.ConnPool.(type) {
+	case *routedTx:
+		tx = pool
+	case *adminWriter:
+		tx = pool.tx
+	case gorm.TxCommitter:
+		// the transaction is not begun by the router, it is used as it is.
+		return
+	default:
+		routed := &routedPool{db: r.admin, admin: r.admin}
+		if rt.db != nil {
+			routed.db = rt.db
+		}
+		stmt.ConnPool = routed
+		return
+	}
+
+	stmt.ConnPool = tx
+	if rt.adminTable && tx.tx != nil && tx.db != r.admin {
+		stmt.ConnPool = &adminWriter{tx: tx}
+		return
+	}
+	if rt.db == nil {
+		return
+	}
+	if err := tx.bind(rt.db); err != nil {
+		_ = db.AddError(err)
+	}
+}
+
+// resolve returns where the statement is routed to.
+func (r *gormRouter) resolve(stmt *gorm.Statement) (*stmtRoute, error) {
+	if stmt.Schema == nil {
+		// the raw sql or the statement without model is not routed.
+		return &stmtRoute{}, nil
+	}
+
+	if _, ok := AdminTables[stmt.Table]; ok || stmt.Schema.LookUpField("biz_id") == nil {
+		return &stmtRoute{db: r.admin, adminTable: true}, nil
+	}
+
+	biz, err := statementBizID(stmt)
+	if err != nil {
+		return nil, err
+	}
+	if biz == 0 {
+		return &stmtRoute{}, nil
+	}
+
+	db, err := r.s.routeDB(biz)
+	if err != nil {
+		return nil, err
+	}
+	rt := &stmtRoute{db: db, biz: biz}
+	if rt.db == nil {
+		rt.db = r.admin
+	}
+	return rt, nil
+}
+
+// checkRead refuses the rows read from the admin db by a statement whose biz is unknown, if they belong to
+// a resharded biz, the admin db only has a stale copy of them.
+func (r *gormRouter) checkRead(db *gorm.DB) {
+	stmt := db.Statement
+	if db.Error != nil || stmt.Schema == nil || !r.s.hasResharded() || !r.onAdmin(stmt) {
+		return
+	}
+	if _, ok := AdminTables[stmt.Table]; ok || stmt.Schema.LookUpField("biz_id") == nil {
+		return
+	}
+
+	err := eachRowBizID(stmt, func(biz uint32) error {
+		if r.s.resharded(biz) {
+			return fmt.Errorf("the rows of biz %d are read from the admin db, but the biz is resharded, "+
+				"the biz_id condition is required", biz)
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.AddError(err)
+	}
+}
+
+// onAdmin returns whether the statement is executed on the admin db.
+func (r *gormRouter) onAdmin(stmt *gorm.Statement) bool {
+	switch pool := stmt.ConnPool.(type) {
+	case *routedPool:
+		return pool.db == r.admin
+	case *routedTx:
+		return pool.db == nil || pool.db == r.admin
+	default:
+		return false
+	}
+}
+
+// statementBizID returns the biz of the statement, 0 means the biz is unknown.
+func statementBizID(stmt *gorm.Statement) (uint32, error) {
+	if c, ok := stmt.Clauses["WHERE"]; ok {
+		if where, ok := c.Expression.(clause.Where); ok {
+			if biz := whereBizID(where.Exprs); biz != 0 {
+				return biz, nil
+			}
+		}
+	}
+
+	biz, err := rowsBizID(stmt)
+	if err != nil || biz != 0 {
+		return biz, err
+	}
+
+	// the biz of the request context is used at last.
+	if _, ok := metadata.FromIncomingContext(stmt.Context); !ok {
+		return 0, nil
+	}
+	return kit.FromGrpcContext(stmt.Context).BizID, nil
+}
+
+// whereBizID returns the biz of the biz_id condition which all the rows should match.
+func whereBizID(exprs []clause.Expression) uint32 {
+	for _, expr := range exprs {
+		switch e := expr.(type) {
+		case clause.Eq:
+			if isBizIDColumn(e.Column) {
+				if biz := toBizID(e.Value); biz != 0 {
+					return biz
+				}
+			}
+		case clause.Expr:
+			if len(e.Vars) == 1 && bizIDCondition.MatchString(e.SQL) {
+				if biz := toBizID(e.Vars[0]); biz != 0 {
+					return biz
+				}
+			}
+		case clause.AndConditions:
+			if biz := whereBizID(e.Exprs); biz != 0 {
+				return biz
+			}
+		case clause.Where:
+			if biz := whereBizID(e.Exprs); biz != 0 {
+				return biz
+			}
+		}
+	}
+
+	return 0
+}
+
+func isBizIDColumn(column interface{}) bool {
+	switch c := column.(type) {
+	case clause.Column:
+		return c.Name == "biz_id"
+	case string:
+		return c == "biz_id" || strings.HasSuffix(c, ".biz_id")
+	default:
+		return false
+	}
+}
+
+func toBizID(value interface{}) uint32 {
+	switch v := value.(type) {
+	case uint32:
+		return v
+	case uint:
+		return uint32(v)
+	case uint64:
+		return uint32(v)
+	case int:
+		return uint32(v)
+	case int64:
+		return uint32(v)
+	case int32:
+		return uint32(v)
+	default:
+		return 0
+	}
+}
+
+// rowsBizID returns the biz of the rows to write, the rows of different bizs can not be written together.
+func rowsBizID(stmt *gorm.Statement) (uint32, error) {
+	var biz uint32
+	err := eachRowBizID(stmt, func(one uint32) error {
+		if biz != 0 && one != biz {
+			return fmt.Errorf("the rows of biz %d and %d are written together to table %s", biz, one, stmt.Table)
+		}
+		biz = one
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return biz, nil
+}
+
+// eachRowBizID calls fn with the biz of each row of the statement whose biz_id is set.
+func eachRowBizID(stmt *gorm.Statement, fn func(biz uint32) error) error {
+	field := stmt.Schema.LookUpField("biz_id")
+	rv := stmt.ReflectValue
+	if field == nil || !rv.IsValid() {
+		return nil
+	}
+
+	collect := func(item reflect.Value) error {
+		if item.Kind() == reflect.Ptr {
+			if item.IsNil() {
+				return nil
+			}
+			item = item.Elem()
+		}
+		if item.Kind() != reflect.Struct {
+			return nil
+		}
+		value, zero := field.ValueOf(stmt.Context, item)
+		if zero {
+			return nil
+		}
+		return fn(toBizID(value))
+	}
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := range rv.Len() {
+			if err := collect(rv.Index(i)); err != nil {
+				return err
+			}
+		}
+	default:
+		return collect(rv)
+	}
+
+	return nil
+}
+
+// recordDeletes records the ids of the rows which are going to be deleted by the statement in the same
+// transaction, so that they are deleted in the sharding db the biz is moved from when it is rolled back.
+func recordDeletes(db *gorm.DB, biz uint32) error {
+	stmt := db.Statement
+	if stmt.Schema.LookUpField("id") == nil {
+		return nil
+	}
+
+	exprs := deleteConditions(stmt)
+	if len(exprs) == 0 {
+		// the delete without conditions is refused by gorm.
+		return nil
+	}
+
+	ids := make([]uint64, 0)
+	if err := db.Session(&gorm.Session{NewDB: true}).Table(stmt.Table).Clauses(clause.Where{Exprs: exprs}).
+		Pluck("id", &ids).Error; err != nil {
+		return fmt.Errorf("list the rows to delete from table %s failed, err: %v", stmt.Table, err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	rows := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		rows = append(rows, map[string]interface{}{
+			"biz_id":     biz,
+			"table_name": stmt.Table,
+			"row_id":     id,
+			"created_at": now,
+		})
+	}
+	if err := db.Session(&gorm.Session{NewDB: true}).Table(DeletesTable).CreateInBatches(rows, 500).
+		Error; err != nil {
+		return fmt.Errorf("record the rows deleted from table %s failed, err: %v", stmt.Table, err)
+	}
+
+	return nil
+}
+
+// deleteConditions returns the conditions of the delete statement, the primary keys of the model are
+// added to the conditions in the same way as the gorm:delete callback.
+func deleteConditions(stmt *gorm.Statement) []clause.Expression {
+	exprs := make([]clause.Expression, 0)
+	if c, ok := stmt.Clauses["WHERE"]; ok {
+		if where, ok := c.Expression.(clause.Where); ok {
+			exprs = append(exprs, where.Exprs...)
+		}
+	}
+
+	_, queryValues := schema.GetIdentityFieldValuesMap(stmt.Context, stmt.ReflectValue, stmt.Schema.PrimaryFields)
+	column, values := schema.ToQueryValues(stmt.Table, stmt.Schema.PrimaryFieldDBNames, queryValues)
+	if len(values) > 0 {
+		exprs = append(exprs, clause.IN{Column: column, Values: values})
+	}
+
+	if stmt.ReflectValue.CanAddr() && stmt.Dest != stmt.Model && stmt.Model != nil {
+		_, queryValues = schema.GetIdentityFieldValuesMap(stmt.Context, reflect.ValueOf(stmt.Model),
+			stmt.Schema.PrimaryFields)
+		column, values = schema.ToQueryValues(stmt.Table, stmt.Schema.PrimaryFieldDBNames, queryValues)
+		if len(values) > 0 {
+			exprs = append(exprs, clause.IN{Column: column, Values: values})
+		}
+	}
+
+	return exprs
+}
+
+// routedTx is the transaction begun by the router, the transaction of db is begun when the first
+// statement is executed, which is the admin db if the statement is not routed.
+type routedTx struct {
+	ctx   context.Context
+	opts  *sql.TxOptions
+	admin *sql.DB
+
+	db *sql.DB
+	tx *sql.Tx
+	// adminWrites are the writes of the admin tables in the transaction of a sharding db, which are applied
+	// to the admin db after the transaction commits.
+	adminWrites []adminWrite
+}
+
+type adminWrite struct {
+	query string
+	args  []interface{}
+}
+
+var _ gorm.TxCommitter = new(routedTx)
+
+// bind begins the transaction on the db, the statements of a transaction should be in the same db.
+func (t *routedTx) bind(db *sql.DB) error {
+	if t.tx != nil {
+		if t.db != db {
+			return errors.New("the statements of a transaction are routed to different sharding dbs")
+		}
+		return nil
+	}
+
+	tx, err := db.BeginTx(t.ctx, t.opts)
+	if err != nil {
+		return err
+	}
+	t.db, t.tx = db, tx
+	return nil
+}
+
+func (t *routedTx) conn() (*sql.Tx, error) {
+	if t.tx == nil {
+		if err := t.bind(t.admin); err != nil {
+			return nil, err
+		}
+	}
+	return t.tx, nil
+}
+
+// PrepareContext prepares the statement in the transaction.
+func (t *routedTx) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	tx, err := t.conn()
+	if err != nil {
+		return nil, err
+	}
+	return tx.PrepareContext(ctx, query)
+}
+
+// ExecContext executes the statement in the transaction.
+func (t *routedTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	tx, err := t.conn()
+	if err != nil {
+		return nil, err
+	}
+	return tx.ExecContext(ctx, query, args...)
+}
+
+// QueryContext queries in the transaction.
+func (t *routedTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	tx, err := t.conn()
+	if err != nil {
+		return nil, err
+	}
+	return tx.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext queries a row in the transaction.
+func (t *routedTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	tx, err := t.conn()
+	if err != nil {
+		logs.Errorf("begin routed transaction failed, err: %v", err)
+		// the row returns the error of the canceled context when it is scanned.
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		return t.admin.QueryRowContext(canceled, query, args...)
+	}
+	return tx.QueryRowContext(ctx, query, args...)
+}
+
+// Commit commits the transaction, and then applies the writes of the admin tables.
+func (t *routedTx) Commit() error {
+	if t.tx == nil {
+		return nil
+	}
+	if err := t.tx.Commit(); err != nil {
+		return err
+	}
+
+	ctx := context.WithoutCancel(t.ctx)
+	for _, w := range t.adminWrites {
+		if _, err := t.admin.ExecContext(ctx, w.query, w.args...); err != nil {
+			return fmt.Errorf("the transaction is committed, but apply its writes of admin tables failed, err: %v",
+				err)
+		}
+	}
+	t.adminWrites = nil
+	return nil
+}
+
+// Rollback rolls back the transaction, and drops the writes of the admin tables.
+func (t *routedTx) Rollback() error {
+	t.adminWrites = nil
+	if t.tx == nil {
+		return nil
+	}
+	return t.tx.Rollback()
+}
+
+// adminWriter is the connection pool of the statements on the admin tables in the transaction of a sharding
+// db, the reads are done on the admin db, and the writes are applied when the transaction commits.
+type adminWriter struct {
+	tx *routedTx
+}
+
+// PrepareContext prepares the statement on the admin db.
+func (w *adminWriter) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return w.tx.admin.PrepareContext(ctx, query)
+}
+
+// ExecContext defers the write until the transaction commits.
+func (w *adminWriter) ExecContext(_ context.Context, query string, args ...interface{}) (sql.Result, error) {
+	w.tx.adminWrites = append(w.tx.adminWrites, adminWrite{query: query, args: args})
+	return deferredResult{}, nil
+}
+
+// QueryContext queries on the admin db.
+func (w *adminWriter) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return w.tx.admin.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext queries a row on the admin db.
+func (w *adminWriter) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return w.tx.admin.QueryRowContext(ctx, query, args...)
+}
+
+// deferredResult is the result of the deferred write, whose affected rows are unknown.
+type deferredResult struct{}
+
+// LastInsertId returns 0, the ids of the admin tables are generated before insert.
+func (deferredResult) LastInsertId() (int64, error) {
+	return 0, nil
+}
+
+// RowsAffected returns 0 as the write is not applied yet.
+func (deferredResult) RowsAffected() (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,229 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sharding
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/uuid"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	// routeRefreshInterval is the interval to reload the biz routing entries and write freezes.
+	routeRefreshInterval = 30 * time.Second
+	// routeStaleAfter is how long the routes can be used without being reloaded, the writes of the bizs are
+	// refused after it, so that a process which fails to reload the routes never writes a frozen or moved biz.
+	routeStaleAfter = 2 * routeRefreshInterval
+	// RouteSettleTime is the time for all the processes to reload the routes or stop writing with stale ones,
+	// the resharding tool waits for it after freezing a biz and after switching its routing entry.
+	RouteSettleTime = routeStaleAfter + 5*time.Second
+
+	// DeletesTable records the rows deleted from a resharded biz in its sharding db, which tells the rows
+	// deleted after resharding from the rows missing by accident when the biz is rolled back.
+	DeletesTable = "sharding_deletes"
+)
+
+// AdminTables are the tables which are only kept in the admin db, they are neither routed by biz nor copied
+// when a biz is resharded. the events are consumed across the bizs from the admin db.
+var AdminTables = map[string]struct{}{
+	"sharding_dbs":         {},
+	"sharding_bizs":        {},
+	"sharding_biz_freezes": {},
+	"id_generators":        {},
+	"schema_migrations":    {},
+	"events":               {},
+}
+
+// ShardingDB is the sharding db instance recorded in sharding_dbs.
+type ShardingDB struct {
+	ID       uint32 `db:"id" gorm:"column:id"`
+	Type     string `db:"type" gorm:"column:type"`
+	Host     string `db:"host" gorm:"column:host"`
+	Port     uint32 `db:"port" gorm:"column:port"`
+	User     string `db:"user" gorm:"column:user"`
+	Password string `db:"password" gorm:"column:password"`
+	Database string `db:"database" gorm:"column:database"`
+}
+
+// bizRoute is the routing entry of a biz recorded in sharding_bizs.
+type bizRoute struct {
+	BizID        uint32 `db:"biz_id"`
+	ShardingDBID uint32 `db:"sharding_db_id"`
+}
+
+// ShardDatabase returns the database option of the sharding db, the driver, timeouts and
+// connection pool settings are inherited from the admin database.
+func ShardDatabase(admin cc.Database, db *ShardingDB) cc.Database {
+	opt := admin
+	opt.Endpoints = []string{net.JoinHostPort(db.Host, strconv.Itoa(int(db.Port)))}
+	opt.User = db.User
+	opt.Password = db.Password
+	opt.Database = db.Database
+	return opt
+}
+
+// refreshRoutes reload the biz routing entries periodically.
+func (s *Sharding) refreshRoutes() {
+	ticker := time.NewTicker(routeRefreshInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := s.loadRoutes(); err != nil {
+			logs.Errorf("reload sharding biz routes failed, err: %v", err)
+		}
+	}
+}
+
+// loadRoutes load the biz routing entries from sharding_bizs and the write freezes from sharding_biz_freezes,
+// the biz without an entry uses the admin database. the biz whose sharding db can not be connected is marked
+// unavailable rather than using the admin database, which only has a stale copy of the resharded biz.
+func (s *Sharding) loadRoutes() error {
+	admin := s.one.db.Unsafe()
+	// the routes are as fresh as the time the loading starts, a freeze recorded after it may be missed.
+	startAt := time.Now()
+
+	dbs := make([]*ShardingDB, 0)
+	if err := admin.Select(&dbs, "SELECT * FROM sharding_dbs"); err != nil {
+		return fmt.Errorf("list sharding dbs failed, err: %v", err)
+	}
+	routes := make([]*bizRoute, 0)
+	if err := admin.Select(&routes, "SELECT biz_id, sharding_db_id FROM sharding_bizs"); err != nil {
+		return fmt.Errorf("list sharding bizs failed, err: %v", err)
+	}
+	frozenBizs := make([]uint32, 0)
+	if err := admin.Select(&frozenBizs, "SELECT biz_id FROM sharding_biz_freezes"); err != nil {
+		return fmt.Errorf("list sharding biz freezes failed, err: %v", err)
+	}
+
+	specs := make(map[uint32]*ShardingDB, len(dbs))
+	for _, db := range dbs {
+		specs[db.ID] = db
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bizs := make(map[uint32]*One, len(routes))
+	unavailable := make(map[uint32]error)
+	for _, route := range routes {
+		one, exists := s.dbs[route.ShardingDBID]
+		if !exists {
+			spec, ok := specs[route.ShardingDBID]
+			if !ok {
+				logs.Errorf("sharding db %d of biz %d not exists, the biz is unavailable", route.ShardingDBID,
+					route.BizID)
+				unavailable[route.BizID] = fmt.Errorf("sharding db %d of biz %d not exists", route.ShardingDBID,
+					route.BizID)
+				continue
+			}
+			db, err := connect(ShardDatabase(s.adminOpt, spec))
+			if err != nil {
+				logs.Errorf("connect sharding db %d of biz %d failed, the biz is unavailable, err: %v",
+					route.ShardingDBID, route.BizID, err)
+				unavailable[route.BizID] = fmt.Errorf("connect sharding db %d of biz %d failed, err: %v",
+					route.ShardingDBID, route.BizID, err)
+				continue
+			}
+			one = &One{shardingUid: uuid.UUID(), db: db}
+			s.dbs[route.ShardingDBID] = one
+		}
+		bizs[route.BizID] = one
+	}
+	s.bizs = bizs
+	s.unavailable = unavailable
+
+	frozen := make(map[uint32]struct{}, len(frozenBizs))
+	for _, biz := range frozenBizs {
+		frozen[biz] = struct{}{}
+	}
+	s.frozen = frozen
+	s.loadedAt = startAt
+
+	return nil
+}
+
+// route returns the sharding instance of the biz, the instance of an unavailable biz carries the error.
+func (s *Sharding) route(biz uint32) *One {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err, exists := s.unavailable[biz]; exists {
+		return &One{hitErr: err}
+	}
+	if one, exists := s.bizs[biz]; exists {
+		return one
+	}
+	return s.one
+}
+
+// routeDB returns the db of the biz for gorm, nil means the admin db.
+func (s *Sharding) routeDB(biz uint32) (*sql.DB, error) {
+	one := s.route(biz)
+	if one.hitErr != nil {
+		return nil, one.hitErr
+	}
+	if one == s.one {
+		return nil, nil
+	}
+	return one.db.DB, nil
+}
+
+// resharded returns whether the biz is routed to a sharding db other than the admin db, including the biz
+// whose sharding db is unavailable.
+func (s *Sharding) resharded(biz uint32) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, exists := s.unavailable[biz]; exists {
+		return true
+	}
+	_, exists := s.bizs[biz]
+	return exists
+}
+
+// hasResharded returns whether any biz is routed to a sharding db other than the admin db.
+func (s *Sharding) hasResharded() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.bizs) != 0 || len(s.unavailable) != 0
+}
+
+// checkWrite checks whether the biz can be written, biz 0 means the biz of the write is unknown.
+func (s *Sharding) checkWrite(biz uint32) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.loadedAt.IsZero() && time.Since(s.loadedAt) > routeStaleAfter {
+		return fmt.Errorf("the sharding routes are not reloaded since %s, writes are refused",
+			s.loadedAt.Format(time.RFC3339))
+	}
+	if biz == 0 {
+		if len(s.frozen) != 0 {
+			return errors.New("writes without biz are refused while some bizs are frozen for resharding")
+		}
+		return nil
+	}
+	if _, frozen := s.frozen[biz]; frozen {
+		return fmt.Errorf("writes of biz %d are frozen for resharding", biz)
+	}
+
+	return nil
+}
@@ -16,6 +16,8 @@ package sharding
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -29,6 +31,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/uuid"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 )
 
 // InitSharding initialize a sharding management instance.
@@ -44,25 +47,48 @@ func InitSharding(sd *cc.Sharding) (*Sharding, error) {
 			shardingUid: uuid.UUID(),
 			db:          db,
 		},
+		adminOpt:    sd.AdminDatabase,
+		bizs:        make(map[uint32]*One),
+		unavailable: make(map[uint32]error),
+		dbs:         make(map[uint32]*One),
+		frozen:      make(map[uint32]struct{}),
 	}
 
+	// the biz routing entries may not be ready before the migrations are applied, the biz uses
+	// admin db until the routing entries are loaded successfully.
+	if err := s.loadRoutes(); err != nil {
+		logs.Errorf("load sharding biz routes failed, err: %v", err)
+	}
+	go s.refreshRoutes()
+
 	return s, nil
 }
 
 // Sharding is used to manage all the mysql instances
 // which works for all the biz and admin resources.
 type Sharding struct {
-	// we support only one db just for now.
-	// Note: support sharding management later.
-	one *One
-	// db pool, like a connection pool
+	// one is the admin db, which is used by the biz without a routing entry.
+	one      *One
+	adminOpt cc.Database
+
+	mu sync.RWMutex
+	// bizs is the routing of biz id to sharding instance, loaded from sharding_bizs.
+	bizs map[uint32]*One
+	// unavailable is the bizs whose sharding db can not be connected, their statements are refused.
+	unavailable map[uint32]error
+	// dbs is the connected sharding instances of sharding_dbs, keyed by sharding db id.
+	dbs map[uint32]*One
+	// frozen is the bizs whose writes are frozen, loaded from sharding_biz_freezes.
+	frozen map[uint32]struct{}
+	// loadedAt is when the last successful loading of the routes started.
+	loadedAt time.Time
 }
 
 // MustSharding get a db instance with biz id.
 // It does not check the biz's value, caller should to
 // guarantee that biz is > 0; Otherwise, it will panic.
 func (s *Sharding) MustSharding(biz uint32) *sqlx.DB {
-	return s.one.db
+	return s.route(biz).db
 }
 
 // ShardingOne get a db instance with biz id.
@@ -71,7 +97,7 @@ func (s *Sharding) ShardingOne(biz uint32) *One {
 		return &One{hitErr: fmt.Errorf("invalid sharding one, because biz: %d is invalid", biz)}
 	}
 
-	return s.one
+	return s.route(biz)
 }
 
 // Admin get the admin db instance
@@ -135,8 +161,14 @@ func MustShardingAuditor(adminDB *gorm.DB) *gorm.DB {
 
 // InitAuditorSharding 审计表分库
 func InitAuditorSharding(adminDB *gorm.DB) (*gorm.DB, error) {
+	// 审计分库只支持 mysql
+	dialector, ok := adminDB.Dialector.(*mysql.Dialector)
+	if !ok {
+		return nil, fmt.Errorf("auditor sharding is not supported by %s", adminDB.Dialector.Name())
+	}
+
 	// 初始化配置
-	conf := adminDB.Dialector.(*mysql.Dialector).DSNConfig.Clone()
+	conf := dialector.DSNConfig.Clone()
 	conf.DBName = "bk_bscp_auditor"
 
 	auditorDB, err := gorm.Open(mysql.Open(conf.FormatDSN()), &gorm.Config{Logger: logger.Default.LogMode(logger.Info)})
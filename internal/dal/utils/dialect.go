@@ -0,0 +1,183 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/datatypes"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// postgresDialector is the name of the gorm postgres dialector.
+const postgresDialector = "postgres"
+
+// IsPostgres returns whether the db is connected to postgresql.
+func IsPostgres(db *gorm.DB) bool {
+	return db != nil && db.Dialector != nil && db.Dialector.Name() == postgresDialector
+}
+
+// IsDuplicateKeyErr returns whether the error is caused by the violation of the unique key.
+func IsDuplicateKeyErr(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == 1062
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// unique_violation
+		return pgErr.Code == "23505"
+	}
+
+	return false
+}
+
+// isPostgresBuilder returns whether the statement being built is executed on postgresql.
+func isPostgresBuilder(builder clause.Builder) bool {
+	stmt, ok := builder.(*gorm.Statement)
+	return ok && IsPostgres(stmt.DB)
+}
+
+// DialectExpr is an expression which is rendered by the dialect of the statement,
+// so that the same query can be executed on both mysql and postgresql.
+type DialectExpr struct {
+	MySQL    clause.Expression
+	Postgres clause.Expression
+}
+
+// Build build the expression with the dialect of the statement.
+func (e DialectExpr) Build(builder clause.Builder) {
+	if isPostgresBuilder(builder) {
+		e.Postgres.Build(builder)
+		return
+	}
+
+	e.MySQL.Build(builder)
+}
+
+// dialectCond is a gen condition built by DialectExpr, it embeds field.Field so that
+// it can be used as a field.Expr in the search conditions.
+type dialectCond struct {
+	field.Field
+	expr DialectExpr
+}
+
+// BeCond returns the dialect expression as the condition.
+func (c dialectCond) BeCond() interface{} { return c.expr }
+
+// CondError returns nil as the dialect condition is always valid.
+func (dialectCond) CondError() error { return nil }
+
+// Build build the condition with the dialect of the statement.
+func (c dialectCond) Build(builder clause.Builder) { c.expr.Build(builder) }
+
+// Regexp returns the condition that the field matches the regular expression,
+// mysql uses the REGEXP operator while postgresql uses the ~ operator, both of
+// them accept the (?i) prefix to ignore case.
+func Regexp(col field.Expr, pattern string) field.Expr {
+	return dialectCond{expr: DialectExpr{
+		MySQL:    clause.Expr{SQL: "? REGEXP ?", Vars: []interface{}{col.RawExpr(), pattern}},
+		Postgres: clause.Expr{SQL: "? ~ ?", Vars: []interface{}{col.RawExpr(), pattern}},
+	}}
+}
+
+// JSONArrayContains returns the condition that the json array column contains the value.
+func JSONArrayContains(column string, value interface{}) field.Expr {
+	elem, _ := json.Marshal([]interface{}{value})
+	return dialectCond{expr: DialectExpr{
+		MySQL: datatypes.JSONArrayQuery(column).Contains(value),
+		Postgres: clause.Expr{SQL: "CAST(? AS jsonb) @> CAST(? AS jsonb)",
+			Vars: []interface{}{clause.Column{Name: column}, string(elem)}},
+	}}
+}
+
+// JSONContains returns the condition that the json column contains the json document,
+// the same as the JSON_CONTAINS function of mysql.
+func JSONContains(col field.Expr, doc string) field.Expr {
+	return dialectCond{expr: DialectExpr{
+		MySQL: clause.Expr{SQL: "JSON_CONTAINS(?,?)", Vars: []interface{}{col.RawExpr(), doc}},
+		Postgres: clause.Expr{SQL: "CAST(? AS jsonb) @> CAST(? AS jsonb)",
+			Vars: []interface{}{col.RawExpr(), doc}},
+	}}
+}
+
+// JSONEquals returns the condition that the value of the key in the json object column equals to the value.
+func JSONEquals(column string, value interface{}, key string) field.Expr {
+	return dialectCond{expr: DialectExpr{
+		// mysql json path needs the key to be quoted, or the key with special characters can not be matched.
+		MySQL:    datatypes.JSONQuery(column).Equals(value, fmt.Sprintf(`"%s"`, key)),
+		Postgres: datatypes.JSONQuery(column).Equals(value, key),
+	}}
+}
+
+// JSONHasKey returns the condition that the json object column has the key.
+func JSONHasKey(column string, key string) field.Expr {
+	return dialectCond{expr: DialectExpr{
+		MySQL:    datatypes.JSONQuery(column).HasKey(fmt.Sprintf(`"%s"`, key)),
+		Postgres: datatypes.JSONQuery(column).HasKey(key),
+	}}
+}
+
+// JSONTextLength returns the condition that compares the text length of the json column with the length
+// by the operator, e.g. "=" or ">". PostgreSQL only gets the length of text, so the column is cast to text.
+func JSONTextLength(col field.Expr, op string, length int) field.Expr {
+	return dialectCond{expr: DialectExpr{
+		MySQL:    clause.Expr{SQL: "LENGTH(?) " + op + " ?", Vars: []interface{}{col.RawExpr(), length}},
+		Postgres: clause.Expr{SQL: "LENGTH(CAST(? AS text)) " + op + " ?", Vars: []interface{}{col.RawExpr(), length}},
+	}}
+}
+
+// JSONArrayAppend returns the expression which appends the uint32 value to the json array column.
+func JSONArrayAppend(column string, value uint32) clause.Expression {
+	return DialectExpr{
+		MySQL: clause.Expr{SQL: "JSON_ARRAY_APPEND(?, '$', ?)", Vars: []interface{}{clause.Column{Name: column}, value}},
+		Postgres: clause.Expr{SQL: "CAST(? AS jsonb) || to_jsonb(CAST(? AS bigint))",
+			Vars: []interface{}{clause.Column{Name: column}, value}},
+	}
+}
+
+// JSONArrayRemove returns the expression which removes the uint32 value from the json array column,
+// the column is set to an empty array if no elements left.
+func JSONArrayRemove(column string, value uint32) clause.Expression {
+	col := clause.Column{Name: column}
+	return DialectExpr{
+		MySQL: clause.Expr{SQL: "COALESCE ((SELECT JSON_ARRAYAGG(oid) new_oids FROM " +
+			"JSON_TABLE (?, '$[*]' COLUMNS (oid BIGINT (1) UNSIGNED PATH '$')) AS t1 WHERE oid<> ?), '[]')",
+			Vars: []interface{}{col, value}},
+		Postgres: clause.Expr{SQL: "COALESCE ((SELECT jsonb_agg(oid) FROM jsonb_array_elements(CAST(? AS jsonb)) " +
+			"AS t1(oid) WHERE oid <> to_jsonb(CAST(? AS bigint))), '[]'::jsonb)",
+			Vars: []interface{}{col, value}},
+	}
+}
+
+// Excluded returns the value of the column proposed for insertion in the upsert statement,
+// which is VALUES(column) in mysql and EXCLUDED.column in postgresql.
+func Excluded(column string) clause.Expression {
+	return DialectExpr{
+		MySQL:    clause.Expr{SQL: "VALUES(?)", Vars: []interface{}{clause.Column{Name: column}}},
+		Postgres: clause.Expr{SQL: "?", Vars: []interface{}{clause.Column{Table: "excluded", Name: column}}},
+	}
+}
+
+// Existing returns the existing value of the column in the upsert statement, the column is
+// qualified by the table name as it is ambiguous with the excluded one in postgresql.
+func Existing(column string) clause.Column {
+	return clause.Column{Table: clause.CurrentTable, Name: column}
+}
@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vault
+
+import (
+	"errors"
+	"fmt"
+
+	vault "github.com/openbao/openbao/api/v2"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// gitRepoTokenPath access token path of the git repository link
+const gitRepoTokenPath = "biz/%d/git_repos/%d/token"
+
+// UpsertGitRepoToken 创建｜更新 git 仓库关联的访问令牌
+func (s *set) UpsertGitRepoToken(kit *kit.Kit, bizID, linkID uint32, token string) error {
+	if bizID == 0 || linkID == 0 {
+		return errors.New("biz id and git repo link id are required")
+	}
+
+	data := map[string]interface{}{
+		"value": token,
+	}
+	_, err := s.cli.KVv2(MountPath).Put(kit.Ctx, fmt.Sprintf(gitRepoTokenPath, bizID, linkID), data)
+	return err
+}
+
+// GetGitRepoToken 获取 git 仓库关联的访问令牌, 未设置时返回空值
+func (s *set) GetGitRepoToken(kit *kit.Kit, bizID, linkID uint32) (string, error) {
+	if bizID == 0 || linkID == 0 {
+		return "", errors.New("biz id and git repo link id are required")
+	}
+
+	secret, err := s.cli.KVv2(MountPath).Get(kit.Ctx, fmt.Sprintf(gitRepoTokenPath, bizID, linkID))
+	if err != nil {
+		if errors.Is(err, vault.ErrSecretNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	value, ok := secret.Data["value"].(string)
+	if !ok {
+		return "", fmt.Errorf("token of git repo link %d type assertion failed", linkID)
+	}
+
+	return value, nil
+}
+
+// DeleteGitRepoToken 删除 git 仓库关联的访问令牌
+func (s *set) DeleteGitRepoToken(kit *kit.Kit, bizID, linkID uint32) error {
+	if bizID == 0 || linkID == 0 {
+		return errors.New("biz id and git repo link id are required")
+	}
+
+	return s.cli.KVv2(MountPath).DeleteMetadata(kit.Ctx, fmt.Sprintf(gitRepoTokenPath, bizID, linkID))
+}
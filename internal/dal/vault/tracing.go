@@ -0,0 +1,138 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vault
+
+import (
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/tracer"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// tracedSet starts a span for each vault operation with a kit.
+type tracedSet struct {
+	Set
+}
+
+func (s *tracedSet) start(kt *kit.Kit, op string) (*kit.Kit, trace.Span) {
+	return tracer.StartKit(kt, "vault."+op, trace.WithSpanKind(trace.SpanKindClient))
+}
+
+// UpsertKv 创建｜更新kv
+func (s *tracedSet) UpsertKv(kt *kit.Kit, opt *types.UpsertKvOption) (version int, err error) {
+	kt, span := s.start(kt, "UpsertKv")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.UpsertKv(kt, opt)
+}
+
+// GetLastKv 获取最新的kv
+func (s *tracedSet) GetLastKv(kt *kit.Kit, opt *types.GetLastKvOpt) (kvType table.DataType, value string,
+	err error) {
+	kt, span := s.start(kt, "GetLastKv")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.GetLastKv(kt, opt)
+}
+
+// GetKvByVersion 根据版本获取kv
+func (s *tracedSet) GetKvByVersion(kt *kit.Kit, opt *types.GetKvByVersion) (kvType table.DataType, value string,
+	err error) {
+	kt, span := s.start(kt, "GetKvByVersion")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.GetKvByVersion(kt, opt)
+}
+
+// DeleteKv deletes specified key-value data from Vault.
+func (s *tracedSet) DeleteKv(kt *kit.Kit, opt *types.DeleteKvOpt) (err error) {
+	kt, span := s.start(kt, "DeleteKv")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.DeleteKv(kt, opt)
+}
+
+// CreateRKv create released kv
+func (s *tracedSet) CreateRKv(kt *kit.Kit, opt *types.CreateReleasedKvOption) (version int, err error) {
+	kt, span := s.start(kt, "CreateRKv")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.CreateRKv(kt, opt)
+}
+
+// GetRKv get released kv
+func (s *tracedSet) GetRKv(kt *kit.Kit, opt *types.GetRKvOption) (kvType table.DataType, value string, err error) {
+	kt, span := s.start(kt, "GetRKv")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.GetRKv(kt, opt)
+}
+
+// UpsertSecretVariable 创建｜更新密文变量的值
+func (s *tracedSet) UpsertSecretVariable(kt *kit.Kit, opt *types.SecretVariableOption, value string) (err error) {
+	kt, span := s.start(kt, "UpsertSecretVariable")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.UpsertSecretVariable(kt, opt, value)
+}
+
+// GetSecretVariable 获取密文变量的值
+func (s *tracedSet) GetSecretVariable(kt *kit.Kit, opt *types.SecretVariableOption) (value string, err error) {
+	kt, span := s.start(kt, "GetSecretVariable")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.GetSecretVariable(kt, opt)
+}
+
+// DeleteSecretVariable 删除密文变量的值
+func (s *tracedSet) DeleteSecretVariable(kt *kit.Kit, opt *types.SecretVariableOption) (err error) {
+	kt, span := s.start(kt, "DeleteSecretVariable")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.DeleteSecretVariable(kt, opt)
+}
+
+// UpsertGitRepoToken 创建｜更新 git 仓库关联的访问令牌
+func (s *tracedSet) UpsertGitRepoToken(kt *kit.Kit, bizID, linkID uint32, token string) (err error) {
+	kt, span := s.start(kt, "UpsertGitRepoToken")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.UpsertGitRepoToken(kt, bizID, linkID, token)
+}
+
+// GetGitRepoToken 获取 git 仓库关联的访问令牌
+func (s *tracedSet) GetGitRepoToken(kt *kit.Kit, bizID, linkID uint32) (token string, err error) {
+	kt, span := s.start(kt, "GetGitRepoToken")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.GetGitRepoToken(kt, bizID, linkID)
+}
+
+// DeleteGitRepoToken 删除 git 仓库关联的访问令牌
+func (s *tracedSet) DeleteGitRepoToken(kt *kit.Kit, bizID, linkID uint32) (err error) {
+	kt, span := s.start(kt, "DeleteGitRepoToken")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.DeleteGitRepoToken(kt, bizID, linkID)
+}
+
+// UpsertWebhookSecret 创建｜更新事件订阅的签名密钥
+func (s *tracedSet) UpsertWebhookSecret(kt *kit.Kit, bizID, webhookID uint32, secret string) (err error) {
+	kt, span := s.start(kt, "UpsertWebhookSecret")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.UpsertWebhookSecret(kt, bizID, webhookID, secret)
+}
+
+// GetWebhookSecret 获取事件订阅的签名密钥
+func (s *tracedSet) GetWebhookSecret(kt *kit.Kit, bizID, webhookID uint32) (secret string, err error) {
+	kt, span := s.start(kt, "GetWebhookSecret")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.GetWebhookSecret(kt, bizID, webhookID)
+}
+
+// DeleteWebhookSecret 删除事件订阅的签名密钥
+func (s *tracedSet) DeleteWebhookSecret(kt *kit.Kit, bizID, webhookID uint32) (err error) {
+	kt, span := s.start(kt, "DeleteWebhookSecret")
+	defer func() { tracer.End(span, err) }()
+	return s.Set.DeleteWebhookSecret(kt, bizID, webhookID)
+}
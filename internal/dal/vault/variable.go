@@ -0,0 +1,87 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vault
+
+import (
+	"errors"
+	"fmt"
+
+	vault "github.com/openbao/openbao/api/v2"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+const (
+	// bizVariablePath secret template variable path of the biz
+	bizVariablePath = "biz/%d/variables/%s"
+	// appVariablePath secret template variable path overridden by the app
+	appVariablePath = "biz/%d/apps/%d/variables/%s"
+	// groupVariablePath secret template variable path overridden by the group of the app
+	groupVariablePath = "biz/%d/apps/%d/groups/%d/variables/%s"
+)
+
+func secretVariablePath(opt *types.SecretVariableOption) string {
+	switch {
+	case opt.GroupID > 0:
+		return fmt.Sprintf(groupVariablePath, opt.BizID, opt.AppID, opt.GroupID, opt.Name)
+	case opt.AppID > 0:
+		return fmt.Sprintf(appVariablePath, opt.BizID, opt.AppID, opt.Name)
+	default:
+		return fmt.Sprintf(bizVariablePath, opt.BizID, opt.Name)
+	}
+}
+
+// UpsertSecretVariable 创建｜更新密文变量的值
+func (s *set) UpsertSecretVariable(kit *kit.Kit, opt *types.SecretVariableOption, value string) error {
+	if err := opt.Validate(); err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"value": value,
+	}
+	_, err := s.cli.KVv2(MountPath).Put(kit.Ctx, secretVariablePath(opt), data)
+	return err
+}
+
+// GetSecretVariable 获取密文变量的值, 未设置时返回空值
+func (s *set) GetSecretVariable(kit *kit.Kit, opt *types.SecretVariableOption) (string, error) {
+	if err := opt.Validate(); err != nil {
+		return "", err
+	}
+
+	secret, err := s.cli.KVv2(MountPath).Get(kit.Ctx, secretVariablePath(opt))
+	if err != nil {
+		if errors.Is(err, vault.ErrSecretNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	value, ok := secret.Data["value"].(string)
+	if !ok {
+		return "", fmt.Errorf("value of secret variable %s type assertion failed", opt.Name)
+	}
+
+	return value, nil
+}
+
+// DeleteSecretVariable 删除密文变量的值
+func (s *set) DeleteSecretVariable(kit *kit.Kit, opt *types.SecretVariableOption) error {
+	if err := opt.Validate(); err != nil {
+		return err
+	}
+
+	return s.cli.KVv2(MountPath).DeleteMetadata(kit.Ctx, secretVariablePath(opt))
+}
@@ -40,6 +40,24 @@ type Set interface {
 	CreateRKv(kit *kit.Kit, opt *types.CreateReleasedKvOption) (int, error)
 	// GetRKv get released kv
 	GetRKv(kit *kit.Kit, opt *types.GetRKvOption) (kvType table.DataType, value string, err error)
+	// UpsertSecretVariable 创建｜更新密文变量的值
+	UpsertSecretVariable(kit *kit.Kit, opt *types.SecretVariableOption, value string) error
+	// GetSecretVariable 获取密文变量的值
+	GetSecretVariable(kit *kit.Kit, opt *types.SecretVariableOption) (string, error)
+	// DeleteSecretVariable 删除密文变量的值
+	DeleteSecretVariable(kit *kit.Kit, opt *types.SecretVariableOption) error
+	// UpsertGitRepoToken 创建｜更新 git 仓库关联的访问令牌
+	UpsertGitRepoToken(kit *kit.Kit, bizID, linkID uint32, token string) error
+	// GetGitRepoToken 获取 git 仓库关联的访问令牌
+	GetGitRepoToken(kit *kit.Kit, bizID, linkID uint32) (string, error)
+	// DeleteGitRepoToken 删除 git 仓库关联的访问令牌
+	DeleteGitRepoToken(kit *kit.Kit, bizID, linkID uint32) error
+	// UpsertWebhookSecret 创建｜更新事件订阅的签名密钥
+	UpsertWebhookSecret(kit *kit.Kit, bizID, webhookID uint32, secret string) error
+	// GetWebhookSecret 获取事件订阅的签名密钥
+	GetWebhookSecret(kit *kit.Kit, bizID, webhookID uint32) (string, error)
+	// DeleteWebhookSecret 删除事件订阅的签名密钥
+	DeleteWebhookSecret(kit *kit.Kit, bizID, webhookID uint32) error
 }
 
 type set struct {
@@ -63,5 +81,5 @@ func NewSet(opt cc.Vault) (Set, error) {
 		cli: client,
 	}
 
-	return s, nil
+	return &tracedSet{Set: s}, nil
 }
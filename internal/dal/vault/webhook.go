@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vault
+
+import (
+	"errors"
+	"fmt"
+
+	vault "github.com/openbao/openbao/api/v2"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// webhookSecretPath signing secret path of the webhook
+const webhookSecretPath = "biz/%d/webhooks/%d/secret"
+
+// UpsertWebhookSecret 创建｜更新事件订阅的签名密钥
+func (s *set) UpsertWebhookSecret(kit *kit.Kit, bizID, webhookID uint32, secret string) error {
+	if bizID == 0 || webhookID == 0 {
+		return errors.New("biz id and webhook id are required")
+	}
+
+	data := map[string]interface{}{
+		"value": secret,
+	}
+	_, err := s.cli.KVv2(MountPath).Put(kit.Ctx, fmt.Sprintf(webhookSecretPath, bizID, webhookID), data)
+	return err
+}
+
+// GetWebhookSecret 获取事件订阅的签名密钥, 未设置时返回空值
+func (s *set) GetWebhookSecret(kit *kit.Kit, bizID, webhookID uint32) (string, error) {
+	if bizID == 0 || webhookID == 0 {
+		return "", errors.New("biz id and webhook id are required")
+	}
+
+	secret, err := s.cli.KVv2(MountPath).Get(kit.Ctx, fmt.Sprintf(webhookSecretPath, bizID, webhookID))
+	if err != nil {
+		if errors.Is(err, vault.ErrSecretNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	value, ok := secret.Data["value"].(string)
+	if !ok {
+		return "", fmt.Errorf("secret of webhook %d type assertion failed", webhookID)
+	}
+
+	return value, nil
+}
+
+// DeleteWebhookSecret 删除事件订阅的签名密钥
+func (s *set) DeleteWebhookSecret(kit *kit.Kit, bizID, webhookID uint32) error {
+	if bizID == 0 || webhookID == 0 {
+		return errors.New("biz id and webhook id are required")
+	}
+
+	return s.cli.KVv2(MountPath).DeleteMetadata(kit.Ctx, fmt.Sprintf(webhookSecretPath, bizID, webhookID))
+}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -31,6 +32,7 @@ import (
 
 	"github.com/TencentBlueKing/bk-bscp/internal/components/bkcmdb"
 	"github.com/TencentBlueKing/bk-bscp/internal/components/bkpaas"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/gwparser"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/internal/space"
@@ -77,12 +79,17 @@ type Authorizer interface {
 	HasBiz(ctx context.Context, bizID uint32) bool
 	// IAMVerify iam 验证
 	IAMVerify(next http.Handler) http.Handler
+	// LoginHandler 由 bscp 自身完成的登入流程(如 OIDC 授权码登入), 挂载到 bkpaas.OIDCRoutePrefix, 其它登入方式返回 nil
+	LoginHandler() http.Handler
 }
 
 // NewAuthorizer create an authorizer for iam authorize related operation.
 func NewAuthorizer(sd serviced.Discover, tls cc.TLSConfig, authOpts ...Option) (Authorizer, error) {
 	opts := make([]grpc.DialOption, 0)
 
+	// add tracing interceptors.
+	opts = append(opts, brpc.TraceDialOptions()...)
+
 	// add dial load balancer.
 	opts = append(opts, sd.LBRoundRobin())
 
@@ -127,6 +134,18 @@ func NewAuthorizer(sd serviced.Discover, tls cc.TLSConfig, authOpts ...Option) (
 		InnerHost: loginAuth.GetInnerHost(),
 		Provider:  loginAuth.GetProvider(),
 	}
+	if oidc := loginAuth.GetOidc(); oidc != nil {
+		conf.OIDC = cc.OIDC{
+			Issuer:        oidc.GetIssuer(),
+			ClientID:      oidc.GetClientId(),
+			ClientSecret:  oidc.GetClientSecret(),
+			RedirectURL:   oidc.GetRedirectUrl(),
+			Scopes:        oidc.GetScopes(),
+			UsernameClaim: oidc.GetUsernameClaim(),
+			TenantClaim:   oidc.GetTenantClaim(),
+			CookieDomain:  oidc.GetCookieDomain(),
+		}
+	}
 
 	esbTLS := cc.TLSConfig{}
 	if esb.GetTls() != nil {
@@ -211,9 +230,18 @@ func validateAuthConf(resp *pbas.GetAuthConfResp) error {
 	missingFields := make([]string, 0)
 
 	loginAuth := resp.GetLoginAuth()
-	if loginAuth == nil {
+	switch {
+	case loginAuth == nil:
 		missingFields = append(missingFields, "loginAuth")
-	} else if strings.TrimSpace(loginAuth.GetHost()) == "" {
+	case loginAuth.GetProvider() == bkpaas.OIDCProvider:
+		// oidc 登入不依赖蓝鲸登入地址
+		if strings.TrimSpace(loginAuth.GetOidc().GetIssuer()) == "" {
+			missingFields = append(missingFields, "loginAuth.oidc.issuer")
+		}
+		if strings.TrimSpace(loginAuth.GetOidc().GetRedirectUrl()) == "" {
+			missingFields = append(missingFields, "loginAuth.oidc.redirectURL")
+		}
+	case strings.TrimSpace(loginAuth.GetHost()) == "":
 		missingFields = append(missingFields, "loginAuth.host")
 	}
 
@@ -380,6 +408,19 @@ func (a authorizer) LogOut(r *http.Request) *rest.UnauthorizedData {
 	return &rest.UnauthorizedData{LoginURL: loginURL, LoginPlainURL: loginPlainURL}
 }
 
+// LoginHandler 由 bscp 自身完成的登入流程(如 OIDC 授权码登入), 其它登入方式返回 nil
+func (a authorizer) LoginHandler() http.Handler {
+	flow, ok := a.authLoginClient.(bkpaas.LoginFlowHandler)
+	if !ok {
+		return nil
+	}
+
+	r := chi.NewRouter()
+	r.Get(bkpaas.OIDCLoginPath, flow.LoginHandler)
+	r.Get(bkpaas.OIDCCallbackPath, flow.CallbackHandler)
+	return r
+}
+
 // HasBiz 业务是否存在
 func (a authorizer) HasBiz(ctx context.Context, bizID uint32) bool {
 	return a.spaceMgr.HasCMDBSpace(ctx, strconv.FormatUint(uint64(bizID), 10))
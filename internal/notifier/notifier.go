@@ -0,0 +1,46 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package notifier delivers alert messages to the outside through different channels.
+package notifier
+
+import (
+	"context"
+	"time"
+)
+
+// Message is an alert message to be delivered.
+type Message struct {
+	// Title 告警标题
+	Title string `json:"title"`
+	// Content 告警内容
+	Content string `json:"content"`
+	// Status 告警状态，如 firing、resolved
+	Status string `json:"status"`
+	// Labels 告警维度，如 biz_id、app_id、rule_id
+	Labels map[string]string `json:"labels"`
+	// FiredAt 告警触发时间
+	FiredAt time.Time `json:"fired_at"`
+
+	// WebhookURL 告警回调地址，webhook 通知渠道使用
+	WebhookURL string `json:"-"`
+	// Receivers 告警接收人，push_manager 通知渠道使用
+	Receivers []string `json:"-"`
+}
+
+// Notifier delivers the alert message through one channel.
+type Notifier interface {
+	// Name returns the name of the notify channel.
+	Name() string
+	// Notify delivers the alert message.
+	Notify(ctx context.Context, msg *Message) error
+}
@@ -0,0 +1,105 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	pushmanager "github.com/TencentBlueKing/bk-bscp/internal/components/push_manager"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// PushManagerName is the name of push manager notifier.
+const PushManagerName = "push_manager"
+
+// NewPushManager create a notifier which pushes the alert message to the receivers through push manager.
+func NewPushManager(pm pushmanager.Service, cfg cc.PushProviderConfig) Notifier {
+	return &pushManager{pm: pm, cfg: cfg}
+}
+
+type pushManager struct {
+	pm  pushmanager.Service
+	cfg cc.PushProviderConfig
+}
+
+// Name returns the name of the notify channel.
+func (p *pushManager) Name() string {
+	return PushManagerName
+}
+
+// Notify pushes the alert message to the receivers.
+func (p *pushManager) Notify(ctx context.Context, msg *Message) error {
+	if msg == nil {
+		return errors.New("message is nil")
+	}
+	if len(msg.Receivers) == 0 {
+		return errors.New("receivers is empty")
+	}
+
+	fields, err := p.buildFields(msg)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.pm.CreatePushEvent(ctx, &pushmanager.CreatePushEventRequest{
+		Event: pushmanager.PushEvent{
+			Domain:      p.cfg.Domain,
+			EventDetail: pushmanager.PushEventDetail{Fields: fields},
+			PushLevel:   pushmanager.PushLevelWarning,
+			Dimension:   &pushmanager.Dimension{Fields: msg.Labels},
+			BkBizName:   p.cfg.Domain,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.Code != 0 {
+		return fmt.Errorf("push failed: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// buildFields 按照配置的推送类型填充推送内容，邮件接收人需要补齐邮件后缀
+func (p *pushManager) buildFields(msg *Message) (pushmanager.PushEventFields, error) {
+	fields := pushmanager.PushEventFields{Types: p.cfg.PushType}
+	receivers := strings.Join(msg.Receivers, ",")
+
+	for _, t := range strings.Split(p.cfg.PushType, ",") {
+		switch pushmanager.PushType(strings.TrimSpace(t)) {
+		case pushmanager.PushTypeRTX:
+			fields.RTXReceivers = receivers
+			fields.RTXTitle = msg.Title
+			fields.RTXContent = msg.Content
+		case pushmanager.PushTypeMail:
+			mails := make([]string, 0, len(msg.Receivers))
+			for _, r := range msg.Receivers {
+				mails = append(mails, r+p.cfg.MailSuffix)
+			}
+			fields.MailReceivers = strings.Join(mails, ",")
+			fields.MailTitle = msg.Title
+			fields.MailContent = msg.Content
+		case pushmanager.PushTypeMsg:
+			fields.MsgReceivers = receivers
+			fields.MsgContent = msg.Content
+		default:
+			return fields, fmt.Errorf("unsupported push type: %s", t)
+		}
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// WebhookName is the name of webhook notifier.
+const WebhookName = "webhook"
+
+// webhookTimeout is the timeout to post the alert message to the webhook.
+const webhookTimeout = 30 * time.Second
+
+// NewWebhook create a notifier which posts the alert message as json to the webhook url of the message.
+func NewWebhook() Notifier {
+	return &webhook{}
+}
+
+type webhook struct{}
+
+// Name returns the name of the notify channel.
+func (w *webhook) Name() string {
+	return WebhookName
+}
+
+// Notify posts the alert message to the webhook url with the guarded client, only the http code is returned
+// in the error, the response body is not, since the error is shown in the alert histories.
+func (w *webhook) Notify(ctx context.Context, msg *Message) error {
+	if msg == nil {
+		return errors.New("message is nil")
+	}
+	if msg.WebhookURL == "" {
+		return errors.New("webhook url is empty")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, msg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := components.WebhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain the head of the body, so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, table.WebhookMaxResponseLength))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with http code %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -87,7 +87,7 @@ func newOneClient(opt PoolOption) (interface{}, error) {
 		PermitWithoutStream: true,
 	}
 
-	opts := make([]grpc.DialOption, 0)
+	opts := TraceDialOptions()
 	opts = append(opts, opt.SvrDiscover.LBRoundRobin(),
 		grpc.WithWriteBufferSize(opt.WriteBufferSizeMB*1024*1024),
 		grpc.WithReadBufferSize(opt.ReadBufferSizeMB*1024*1024),
@@ -0,0 +1,148 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package brpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/tracer"
+)
+
+// mdCarrier adapts the grpc metadata to the propagation.TextMapCarrier.
+type mdCarrier metadata.MD
+
+// Get returns the first value of the key.
+func (c mdCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// Set sets the value of the key.
+func (c mdCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys returns all the keys of the metadata.
+func (c mdCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// startServerSpan extracts the upstream trace context from the incoming metadata and starts a server span.
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, mdCarrier(md.Copy()))
+	service, method := splitMethodName(fullMethod)
+	return tracer.Start(ctx, fullMethod[1:], trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("rpc.system", "grpc"), attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method)))
+}
+
+// startClientSpan starts a client span and injects its trace context into the outgoing metadata.
+func startClientSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	service, method := splitMethodName(fullMethod)
+	ctx, span := tracer.Start(ctx, fullMethod[1:], trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("rpc.system", "grpc"), attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method)))
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, mdCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+func endSpan(span trace.Span, err error) {
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+	tracer.End(span, err)
+}
+
+// TraceUnaryServerInterceptor starts a span for each unary call with the rid attached.
+func TraceUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		resp interface{}, err error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		defer func() { endSpan(span, err) }()
+
+		return handler(ctx, req)
+	}
+}
+
+// TraceStreamServerInterceptor starts a span for each stream call with the rid attached.
+func TraceStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) (err error) {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+		defer func() { endSpan(span, err) }()
+
+		return handler(srv, &tracedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// tracedServerStream overrides the context of the server stream with the one carrying the span.
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context carrying the span.
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// TraceUnaryClientInterceptor starts a span for each unary call and propagates the trace context
+// to the server.
+func TraceUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) (err error) {
+		ctx, span := startClientSpan(ctx, method)
+		defer func() { endSpan(span, err) }()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// TraceStreamClientInterceptor propagates the trace context to the server for each stream call, the span
+// only covers the establishment of the stream because the stream may live as long as the connection.
+func TraceStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (stream grpc.ClientStream, err error) {
+		ctx, span := startClientSpan(ctx, method)
+		defer func() { endSpan(span, err) }()
+
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// TraceDialOptions returns the dial options to trace the calls of the grpc client.
+func TraceDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(TraceUnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(TraceStreamClientInterceptor()),
+	}
+}
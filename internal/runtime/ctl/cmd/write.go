@@ -76,18 +76,13 @@ func WithDisableWriteAuthAccess(opt *options.DisableWriteOption) Cmd {
 				// split biz_id into separate ids, parse the ids into integer
 				bizIDStrArr := strings.Split(bizIDStr, ",")
 				for _, bizIDElement := range bizIDStrArr {
-					if len(bizIDElement) == 1 {
-						bizID, err := strconv.ParseUint(bizIDElement, 10, 64)
-						if err != nil {
-							logs.Errorf("parse biz id %s failed, err: %v, rid: %s", bizIDElement, err, kt.Rid)
-							return nil, err
-						}
-
-						opt.BizIDMap.Store(uint32(bizID), struct{}{})
-						continue
+					bizID, err := strconv.ParseUint(strings.TrimSpace(bizIDElement), 10, 32)
+					if err != nil {
+						logs.Errorf("parse biz id %s failed, err: %v, rid: %s", bizIDElement, err, kt.Rid)
+						return nil, errf.New(errf.InvalidParameter, "parse biz_id element failed")
 					}
 
-					return nil, errf.New(errf.InvalidParameter, "parse biz_id element failed")
+					opt.BizIDMap.Store(uint32(bizID), struct{}{})
 				}
 
 				logs.Infof("successfully disabled write operations with %+v, rid: %s", opt, kt.Rid)
@@ -0,0 +1,193 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package gitrepo fetches the files of a git repository.
+package gitrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components"
+)
+
+// DefaultMaxBytes is the max size of each response fetched from the repository if it's not set by the option.
+const DefaultMaxBytes int64 = 100 << 20
+
+func init() {
+	// the repositories are set by the users, they are cloned with the client which refuses to connect to the
+	// loopback, private and link-local addresses as the webhooks, and the size of the responses is limited.
+	c := http.NewClient(&nethttp.Client{
+		Transport: &limitedTransport{base: &nethttp.Transport{
+			Proxy:               nil,
+			DialContext:         components.DialWebhook,
+			TLSHandshakeTimeout: 10 * time.Second,
+			IdleConnTimeout:     90 * time.Second,
+		}},
+	})
+	client.InstallProtocol("http", c)
+	client.InstallProtocol("https", c)
+}
+
+// CloneOption defines the options to clone a git repository.
+type CloneOption struct {
+	// URL is the clone url of the repository.
+	URL string
+	// Ref is the branch or tag to clone, or a full reference name which starts with refs/.
+	Ref string
+	// Username and Token are used to clone the private repository with http basic auth.
+	Username string
+	Token    string
+	// MaxBytes is the max size of each response fetched from the repository, default is DefaultMaxBytes.
+	MaxBytes int64
+}
+
+// Clone shallow clones the ref of the repository into the dir, and returns the sha of the commit cloned.
+// The short ref name is tried as a branch first and then as a tag.
+func Clone(ctx context.Context, dir string, opt *CloneOption) (string, error) {
+	if opt == nil || opt.URL == "" || opt.Ref == "" {
+		return "", errors.New("repository url and ref are required")
+	}
+
+	var auth transport.AuthMethod
+	if opt.Token != "" {
+		username := opt.Username
+		if username == "" {
+			// most of the git servers accept any non-empty username with the access token
+			username = "oauth2"
+		}
+		auth = &http.BasicAuth{Username: username, Password: opt.Token}
+	}
+
+	maxBytes := opt.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+	ctx = context.WithValue(ctx, maxBytesKey{}, maxBytes)
+
+	var lastErr error
+	for _, ref := range candidateRefs(opt.Ref) {
+		if err := resetDir(dir); err != nil {
+			return "", err
+		}
+
+		repo, err := git.PlainCloneContext(ctx, dir, false, &git.CloneOptions{
+			URL:           opt.URL,
+			Auth:          auth,
+			ReferenceName: ref,
+			SingleBranch:  true,
+			Depth:         1,
+			Tags:          git.NoTags,
+		})
+		if err != nil {
+			if isRefNotFound(err) {
+				lastErr = err
+				continue
+			}
+			return "", fmt.Errorf("clone %s failed, err: %v", opt.URL, err)
+		}
+
+		head, err := repo.Head()
+		if err != nil {
+			return "", fmt.Errorf("get head of %s failed, err: %v", opt.URL, err)
+		}
+		return head.Hash().String(), nil
+	}
+
+	return "", fmt.Errorf("ref %s is not found in %s, err: %v", opt.Ref, opt.URL, lastErr)
+}
+
+// candidateRefs returns the reference names which the ref may stand for.
+func candidateRefs(ref string) []plumbing.ReferenceName {
+	if strings.HasPrefix(ref, "refs/") {
+		return []plumbing.ReferenceName{plumbing.ReferenceName(ref)}
+	}
+
+	return []plumbing.ReferenceName{plumbing.NewBranchReferenceName(ref), plumbing.NewTagReferenceName(ref)}
+}
+
+func isRefNotFound(err error) bool {
+	var noMatch git.NoMatchingRefSpecError
+	return errors.As(err, &noMatch) || errors.Is(err, plumbing.ErrReferenceNotFound)
+}
+
+// resetDir makes the dir exist and empty, since a failed clone may leave files in it.
+func resetDir(dir string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	return os.MkdirAll(dir, 0o750)
+}
+
+// maxBytesKey is the context key of the max size of the responses of a clone.
+type maxBytesKey struct{}
+
+// limitedTransport limits the size of the response body with the max size of the request's context.
+type limitedTransport struct {
+	base nethttp.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *limitedTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit, ok := req.Context().Value(maxBytesKey{}).(int64); ok && limit > 0 {
+		resp.Body = &limitedBody{ReadCloser: resp.Body, limit: limit, remain: limit}
+	}
+	return resp, nil
+}
+
+// limitedBody fails the read when the body is larger than the limit.
+type limitedBody struct {
+	io.ReadCloser
+	limit  int64
+	remain int64
+}
+
+// Read implements io.Reader.
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if b.remain <= 0 {
+		// the body is exactly the limit if nothing left.
+		n, err := b.ReadCloser.Read(make([]byte, 1))
+		if n > 0 {
+			return 0, fmt.Errorf("the repository is larger than the limit %d bytes", b.limit)
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > b.remain {
+		p = p[:b.remain]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remain -= int64(n)
+	return n, err
+}
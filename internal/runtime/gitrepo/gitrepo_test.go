@@ -0,0 +1,140 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gitrepo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestClone(t *testing.T) {
+	// the local repository is served by the git binary
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git executable not found")
+	}
+
+	src := t.TempDir()
+	repo, err := git.PlainInitWithOptions(src, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit := func(content string) plumbing.Hash {
+		if err = os.WriteFile(filepath.Join(src, "app.yaml"), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = wt.Add("app.yaml"); err != nil {
+			t.Fatal(err)
+		}
+		hash, err := wt.Commit(content, &git.CommitOptions{
+			Author: &object.Signature{Name: "bscp", Email: "bscp@example.com", When: time.Now()},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+	v1 := commit("v1")
+	if _, err = repo.CreateTag("v1", v1, nil); err != nil {
+		t.Fatal(err)
+	}
+	v2 := commit("v2")
+
+	tests := []struct {
+		ref     string
+		sha     string
+		content string
+		wantErr bool
+	}{
+		{ref: "main", sha: v2.String(), content: "v2"},
+		{ref: "refs/heads/main", sha: v2.String(), content: "v2"},
+		{ref: "v1", sha: v1.String(), content: "v1"},
+		{ref: "missing", wantErr: true},
+	}
+	for _, tt := range tests {
+		dir := filepath.Join(t.TempDir(), "repo")
+		sha, err := Clone(context.Background(), dir, &CloneOption{URL: "file://" + src, Ref: tt.ref})
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("clone %s should fail", tt.ref)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("clone %s failed, err: %v", tt.ref, err)
+			continue
+		}
+		if sha != tt.sha {
+			t.Errorf("clone %s got sha %s, want %s", tt.ref, sha, tt.sha)
+		}
+		content, err := os.ReadFile(filepath.Join(dir, "app.yaml"))
+		if err != nil || string(content) != tt.content {
+			t.Errorf("clone %s got content %q, err: %v, want %q", tt.ref, content, err, tt.content)
+		}
+	}
+}
+
+func TestCloneRefusesInternalAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("the request to the loopback address should be refused, got %s", r.URL)
+	}))
+	defer server.Close()
+
+	dir := filepath.Join(t.TempDir(), "repo")
+	_, err := Clone(context.Background(), dir, &CloneOption{URL: server.URL + "/repo.git", Ref: "main"})
+	if err == nil || !strings.Contains(err.Error(), "not allowed") {
+		t.Errorf("clone from the loopback address should be refused, got err: %v", err)
+	}
+}
+
+func TestLimitedBody(t *testing.T) {
+	tests := []struct {
+		body    string
+		limit   int64
+		wantErr bool
+	}{
+		{body: "0123", limit: 4},
+		{body: "0123", limit: 8},
+		{body: "0123456789", limit: 4, wantErr: true},
+	}
+	for _, tt := range tests {
+		body := &limitedBody{ReadCloser: io.NopCloser(strings.NewReader(tt.body)), limit: tt.limit, remain: tt.limit}
+		got, err := io.ReadAll(body)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("read %q with limit %d should fail", tt.body, tt.limit)
+			}
+			continue
+		}
+		if err != nil || string(got) != tt.body {
+			t.Errorf("read %q with limit %d got %q, err: %v", tt.body, tt.limit, got, err)
+		}
+	}
+}
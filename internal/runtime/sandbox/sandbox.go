@@ -0,0 +1,364 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sandbox runs the hook scripts in an isolated local process, which is used to
+// validate the hooks before they are published to the clients.
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+const (
+	defaultPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+	// waitDelay 超时后等待输出管道关闭的时间, 避免脚本派生的子进程占用管道导致阻塞
+	waitDelay = 2 * time.Second
+	// sandboxWorkDir 脚本在沙箱内的工作目录, 也是沙箱内唯一可写的目录
+	sandboxWorkDir = "/work"
+	// setupFailedCode 沙箱初始化失败时的退出码
+	setupFailedCode = 125
+	setupFailedMark = "bscp-hook-sandbox: setup failed"
+)
+
+// rootDirs 以只读方式挂载到沙箱根目录下的系统目录, 解释器需要位于这些目录下
+var rootDirs = []string{"/usr", "/bin", "/sbin", "/lib", "/lib32", "/lib64", "/libx32"}
+
+// setupScript 在新的 mount 命名空间中构造最小化的只读根目录, 只包含系统目录、工作目录和几个设备文件,
+// 然后 pivot_root 到该目录并卸载宿主机的根目录, 重新挂载 /proc, 最后限制进程数并去掉所有的 capability 执行脚本,
+// 使脚本无法访问宿主机上的配置文件和其他进程的信息。进程数在新的 user 命名空间内限制, 只统计脚本派生的进程,
+// 不受宿主机上同一用户的其他进程影响。
+// 参数依次为: 根目录, 工作目录, 系统目录, 最大进程数(0 表示不限制), 脚本命令及参数。
+var setupScript = `set -e
+trap 'echo "` + setupFailedMark + `" >&2; exit ` + strconv.Itoa(setupFailedCode) + `' EXIT
+root=$1 work=$2 dirs=$3 nproc=$4
+shift 4
+if [ "$nproc" != 0 ]; then
+	set -- prlimit --nproc="$nproc" -- "$@"
+fi
+mount -t tmpfs -o mode=0755,size=1m,nosuid,nodev bscp-hook-root "$root"
+for d in $dirs; do
+	if [ -L "$d" ]; then
+		ln -s "$(readlink "$d")" "$root$d"
+	elif [ -d "$d" ]; then
+		mkdir "$root$d"
+		mount --rbind "$d" "$root$d"
+		mount -o remount,bind,ro,nosuid,nodev "$root$d"
+	fi
+done
+mkdir "$root/dev" "$root/proc" "$root/tmp" "$root` + sandboxWorkDir + `" "$root/.old"
+for f in null zero random urandom; do
+	touch "$root/dev/$f"
+	mount --bind "/dev/$f" "$root/dev/$f"
+done
+mount --bind "$work" "$root` + sandboxWorkDir + `"
+mount --bind "$work" "$root/tmp"
+cd "$root"
+pivot_root . .old
+mount -t proc -o nosuid,nodev,noexec proc /proc
+umount -l /.old
+rmdir /.old
+mount -o remount,bind,ro /
+cd ` + sandboxWorkDir + `
+trap - EXIT
+exec setpriv --no-new-privs --inh-caps=-all --bounding-set=-all -- "$@"
+`
+
+// ErrUnavailable means the sandbox can not run on this host, e.g. the isolation tools are missing.
+var ErrUnavailable = errors.New("hook sandbox is unavailable")
+
+// reservedEnvs are set by the sandbox or change how the interpreters run, they can not be set by the caller.
+var reservedEnvs = map[string]struct{}{
+	"PATH": {}, "HOME": {}, "TMPDIR": {}, "LANG": {}, "PWD": {}, "SHELL": {}, "IFS": {}, "ENV": {}, "BASH_ENV": {},
+	"SHELLOPTS": {}, "BASHOPTS": {}, "PS4": {},
+}
+
+// reservedEnvPrefixes are the prefixes of the reserved env names, e.g. LD_PRELOAD and PYTHONSTARTUP.
+var reservedEnvPrefixes = []string{"LD_", "BASH_FUNC_", "PYTHON"}
+
+var envNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateEnv validate the env vars passed to the script, the names should be valid and not be reserved.
+func ValidateEnv(env map[string]string) error {
+	for k := range env {
+		if !envNameRe.MatchString(k) {
+			return fmt.Errorf("invalid env name %q", k)
+		}
+		if _, ok := reservedEnvs[strings.ToUpper(k)]; ok {
+			return fmt.Errorf("env %s is reserved", k)
+		}
+		for _, prefix := range reservedEnvPrefixes {
+			if strings.HasPrefix(strings.ToUpper(k), prefix) {
+				return fmt.Errorf("env %s is reserved", k)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Options defines the limits of the sandbox.
+type Options struct {
+	// Timeout is the max duration of a run, the whole process tree is killed when exceeded.
+	Timeout time.Duration
+	// MaxMemoryBytes limits the virtual memory of each process of the script, the processes spawned by the
+	// script are limited respectively, so the script can use at most MaxMemoryBytes * MaxProcesses in total.
+	MaxMemoryBytes uint64
+	// MaxProcesses limits the count of the processes of the script, including the ones spawned by it, the limit
+	// is not enforced by the kernel if the service runs as root, which should run as a normal user then.
+	MaxProcesses uint64
+	// MaxFileBytes limits the size of the files written by the script.
+	MaxFileBytes uint64
+	// MaxOutputBytes limits the captured stdout and stderr respectively.
+	MaxOutputBytes int
+	// WorkDir is the parent directory of the temporary work directories, default is os.TempDir().
+	WorkDir string
+	// Interpreters overrides the interpreter of the script types.
+	Interpreters map[table.ScriptType]string
+}
+
+// Result is the result of a run.
+type Result struct {
+	Stdout    string
+	Stderr    string
+	ExitCode  int
+	Duration  time.Duration
+	TimedOut  bool
+	Truncated bool
+}
+
+// Runner runs the scripts with the limits of the options.
+type Runner struct {
+	opt Options
+}
+
+// New create a sandbox runner.
+func New(opt Options) *Runner {
+	if opt.WorkDir == "" {
+		opt.WorkDir = os.TempDir()
+	}
+	return &Runner{opt: opt}
+}
+
+// interpreter 脚本类型对应的解释器及参数
+type interpreter struct {
+	bin  string
+	args []string
+	ext  string
+}
+
+var defaultInterpreters = map[table.ScriptType]interpreter{
+	table.Shell:      {bin: "bash", ext: ".sh"},
+	table.Python:     {bin: "python3", ext: ".py"},
+	table.Bat:        {bin: "cmd", args: []string{"/c"}, ext: ".bat"},
+	table.PowerShell: {bin: "pwsh", args: []string{"-NoProfile", "-NonInteractive", "-File"}, ext: ".ps1"},
+}
+
+func (r *Runner) interpreter(typ table.ScriptType) (interpreter, error) {
+	it, ok := defaultInterpreters[typ]
+	if !ok {
+		return it, fmt.Errorf("unsupported script type %s", typ)
+	}
+	if bin := r.opt.Interpreters[typ]; bin != "" {
+		it.bin = bin
+	}
+	path, err := exec.LookPath(it.bin)
+	if err != nil {
+		return it, fmt.Errorf("%w: interpreter %s of %s script not found", ErrUnavailable, it.bin, typ)
+	}
+	if !inRootDirs(path) {
+		return it, fmt.Errorf("%w: interpreter %s of %s script should be under %s", ErrUnavailable, path, typ,
+			strings.Join(rootDirs, ", "))
+	}
+	it.bin = path
+	return it, nil
+}
+
+// inRootDirs returns whether the path is under the system directories mounted in the sandbox.
+func inRootDirs(path string) bool {
+	for _, dir := range rootDirs {
+		if strings.HasPrefix(path, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Run runs the script content with the env vars, the script has no network and runs in its own pid and mount
+// namespaces, so that all the processes it spawns are killed when the run finished or timed out. The script can
+// only see the read-only system directories and its work directory, and has no capabilities.
+func (r *Runner) Run(ctx context.Context, typ table.ScriptType, content string, env map[string]string) (
+	*Result, error) {
+
+	if err := ValidateEnv(env); err != nil {
+		return nil, err
+	}
+	it, err := r.interpreter(typ)
+	if err != nil {
+		return nil, err
+	}
+	tools := make(map[string]string)
+	for _, name := range []string{"prlimit", "unshare", "sh", "mount", "umount", "pivot_root", "setpriv"} {
+		path, err := exec.LookPath(name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s not found", ErrUnavailable, name)
+		}
+		tools[name] = path
+	}
+
+	dir, err := os.MkdirTemp(r.opt.WorkDir, "bscp-hook-")
+	if err != nil {
+		return nil, fmt.Errorf("create work dir failed, err: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	root, work := filepath.Join(dir, "root"), filepath.Join(dir, "work")
+	for _, d := range []string{root, work} {
+		if err = os.Mkdir(d, 0o700); err != nil {
+			return nil, fmt.Errorf("create sandbox dir failed, err: %v", err)
+		}
+	}
+	name := "hook" + it.ext
+	if err = os.WriteFile(filepath.Join(work, name), []byte(content), 0o700); err != nil {
+		return nil, fmt.Errorf("write script failed, err: %v", err)
+	}
+
+	args := r.limitArgs()
+	args = append(args, "--", tools["unshare"], "--net", "--pid", "--fork", "--kill-child", "--map-root-user",
+		"--mount", "--propagation", "private", "--", tools["sh"], "-c", setupScript, "bscp-hook-sandbox",
+		root, work, strings.Join(rootDirs, " "), strconv.FormatUint(r.opt.MaxProcesses, 10), it.bin)
+	args = append(args, it.args...)
+	args = append(args, sandboxWorkDir+"/"+name)
+
+	if r.opt.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.opt.Timeout)
+		defer cancel()
+	}
+
+	stdout := &limitedBuffer{limit: r.opt.MaxOutputBytes}
+	stderr := &limitedBuffer{limit: r.opt.MaxOutputBytes}
+	cmd := exec.CommandContext(ctx, tools["prlimit"], args...)
+	cmd.Dir = work
+	cmd.Env = buildEnv(env)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.WaitDelay = waitDelay
+
+	start := time.Now()
+	runErr := cmd.Run()
+	result := &Result{
+		Stdout:    stdout.String(),
+		Stderr:    stderr.String(),
+		Duration:  time.Since(start),
+		TimedOut:  errors.Is(ctx.Err(), context.DeadlineExceeded),
+		Truncated: stdout.truncated || stderr.truncated,
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case runErr == nil:
+	case result.TimedOut:
+		result.ExitCode = -1
+	case errors.As(runErr, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+	default:
+		return nil, fmt.Errorf("run hook script failed, err: %v", runErr)
+	}
+
+	// the namespaces or mounts are not permitted on this host
+	if strings.HasPrefix(result.Stderr, "unshare:") ||
+		(result.ExitCode == setupFailedCode && strings.Contains(result.Stderr, setupFailedMark)) {
+		return nil, fmt.Errorf("%w: %s", ErrUnavailable, strings.TrimSpace(result.Stderr))
+	}
+
+	return result, nil
+}
+
+// limitArgs prlimit 资源限制参数, CPU 时间与超时时间保持一致, 内存限制针对单个进程, 进程数在 setupScript 中限制
+func (r *Runner) limitArgs() []string {
+	args := []string{"--nofile=256"}
+	if r.opt.MaxMemoryBytes > 0 {
+		args = append(args, "--as="+strconv.FormatUint(r.opt.MaxMemoryBytes, 10))
+	}
+	if r.opt.MaxFileBytes > 0 {
+		args = append(args, "--fsize="+strconv.FormatUint(r.opt.MaxFileBytes, 10))
+	}
+	if r.opt.Timeout > 0 {
+		seconds := int64((r.opt.Timeout + time.Second - 1) / time.Second)
+		args = append(args, "--cpu="+strconv.FormatInt(seconds, 10))
+	}
+	return args
+}
+
+// buildEnv 脚本只能看到最小化的环境变量, 不继承服务自身的环境变量
+func buildEnv(env map[string]string) []string {
+	result := []string{"PATH=" + defaultPath, "HOME=" + sandboxWorkDir, "TMPDIR=/tmp", "LANG=C.UTF-8"}
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		result = append(result, k+"="+env[k])
+	}
+	return result
+}
+
+// limitedBuffer 只保留前 limit 个字节的输出, limit <= 0 表示不限制
+type limitedBuffer struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+// Write implements io.Writer, it never returns an error to keep the script running.
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := len(p)
+	if b.limit > 0 {
+		remain := b.limit - b.buf.Len()
+		if remain <= 0 {
+			b.truncated = true
+			return n, nil
+		}
+		if len(p) > remain {
+			p = p[:remain]
+			b.truncated = true
+		}
+	}
+	b.buf.Write(p)
+	return n, nil
+}
+
+// String returns the captured output.
+func (b *limitedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,122 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func runShell(t *testing.T, opt Options, content string, env map[string]string) *Result {
+	t.Helper()
+	result, err := New(opt).Run(context.Background(), table.Shell, content, env)
+	if errors.Is(err, ErrUnavailable) {
+		t.Skipf("sandbox unavailable: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("run script failed: %v", err)
+	}
+	return result
+}
+
+func TestRunCapturesOutput(t *testing.T) {
+	result := runShell(t, Options{Timeout: 10 * time.Second},
+		"echo $bk_bscp_app; echo oops >&2; exit 3", map[string]string{"bk_bscp_app": "demo"})
+	if result.Stdout != "demo\n" || result.Stderr != "oops\n" || result.ExitCode != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	result := runShell(t, Options{Timeout: time.Second}, "sleep 30 & sleep 30", nil)
+	if !result.TimedOut || result.ExitCode != -1 {
+		t.Fatalf("expect timed out, got %+v", result)
+	}
+	if result.Duration > 10*time.Second {
+		t.Fatalf("the script is not killed in time, duration: %s", result.Duration)
+	}
+}
+
+func TestRunTruncatesOutput(t *testing.T) {
+	result := runShell(t, Options{Timeout: 10 * time.Second, MaxOutputBytes: 4}, "echo 0123456789", nil)
+	if result.Stdout != "0123" || !result.Truncated {
+		t.Fatalf("expect truncated output, got %+v", result)
+	}
+}
+
+func TestRunLimitsProcesses(t *testing.T) {
+	result := runShell(t, Options{Timeout: 10 * time.Second, MaxProcesses: 8}, "ulimit -u", nil)
+	if result.Stdout != "8\n" {
+		t.Fatalf("expect process limit 8, got %+v", result)
+	}
+}
+
+func TestRunUnsupportedType(t *testing.T) {
+	if _, err := New(Options{}).Run(context.Background(), table.ScriptType("perl"), "", nil); err == nil {
+		t.Fatal("expect unsupported script type error")
+	}
+}
+
+func TestRunIsolatesHostFiles(t *testing.T) {
+	secret := filepath.Join(t.TempDir(), "config_server.yaml")
+	if err := os.WriteFile(secret, []byte("master_key: secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	result := runShell(t, Options{Timeout: 10 * time.Second}, `
+cat `+secret+` || echo "no host file"
+ls /proc | grep -c '^[0-9]'
+touch /usr/bscp-hook || echo "read-only usr"
+grep CapEff /proc/self/status
+echo data > out && cat out
+`, nil)
+
+	lines := strings.Split(strings.TrimSpace(result.Stdout), "\n")
+	if strings.Contains(result.Stdout, "master_key") || len(lines) != 5 || lines[0] != "no host file" {
+		t.Fatalf("the host file should not be visible, got %+v", result)
+	}
+	// only the script and the ls command are in the pid namespace
+	if lines[1] != "2" && lines[1] != "3" {
+		t.Fatalf("the processes out of the sandbox should not be visible, got %s", lines[1])
+	}
+	if lines[2] != "read-only usr" {
+		t.Fatalf("the system directories should be read-only, got %s", lines[2])
+	}
+	if strings.TrimSpace(strings.TrimPrefix(lines[3], "CapEff:")) != "0000000000000000" {
+		t.Fatalf("the script should have no capabilities, got %s", lines[3])
+	}
+	if lines[4] != "data" {
+		t.Fatalf("the work directory should be writable, got %s", lines[4])
+	}
+}
+
+func TestValidateEnv(t *testing.T) {
+	for _, name := range []string{"PATH", "home", "LD_PRELOAD", "BASH_FUNC_x%%", "PYTHONSTARTUP", "A=B", ""} {
+		if err := ValidateEnv(map[string]string{name: "x"}); err == nil {
+			t.Errorf("env %q should be rejected", name)
+		}
+	}
+	if err := ValidateEnv(map[string]string{"bk_bscp_app": "demo", "APP_ENV": "test"}); err != nil {
+		t.Errorf("valid envs are rejected: %v", err)
+	}
+	if _, err := New(Options{}).Run(context.Background(), table.Shell, "env", map[string]string{"PATH": "/tmp"}); err == nil {
+		t.Error("run with reserved env should fail")
+	}
+}
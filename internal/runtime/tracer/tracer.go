@@ -0,0 +1,213 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tracer provides the opentelemetry tracing of bscp services.
+package tracer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/version"
+)
+
+const (
+	// instrumentationName is the name of the tracer used by bscp.
+	instrumentationName = "github.com/TencentBlueKing/bk-bscp"
+	// RidAttributeKey is the span attribute key of the request id.
+	RidAttributeKey = attribute.Key("bscp.rid")
+)
+
+var lowRidKey = strings.ToLower(constant.RidKey)
+
+// Setup initializes the global tracer provider and propagator of the service with its tracing settings,
+// and the buffered spans are flushed when the service is shutting down.
+// The global noop tracer provider is kept if the tracing is disabled, so that the spans cost nothing.
+func Setup(serviceName cc.Name) error {
+	opt, err := resolveServiceConfig(serviceName)
+	if err != nil {
+		return err
+	}
+
+	// the trace context is always propagated, so that the upstream trace is not broken by this service.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{},
+		propagation.Baggage{}))
+
+	if !opt.Enabled {
+		return nil
+	}
+
+	exporter, closeFn, err := newExporter(opt)
+	if err != nil {
+		return fmt.Errorf("new tracing exporter failed, err: %v", err)
+	}
+
+	res, err := resource.New(context.Background(), resource.WithFromEnv(), resource.WithTelemetrySDK(),
+		resource.WithHost(), resource.WithAttributes(
+			semconv.ServiceName(string(serviceName)),
+			semconv.ServiceVersion(version.VERSION),
+		))
+	if err != nil {
+		return fmt.Errorf("new tracing resource failed, err: %v", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opt.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	logs.Infof("tracing is enabled with %s exporter", opt.Exporter)
+
+	go func() {
+		notifier := shutdown.AddNotifier()
+		<-notifier.Signal
+		if err := tp.Shutdown(context.Background()); err != nil {
+			logs.Errorf("shutdown tracer provider failed, err: %v", err)
+		}
+		if closeFn != nil {
+			_ = closeFn()
+		}
+		notifier.Done()
+	}()
+
+	return nil
+}
+
+func resolveServiceConfig(serviceName cc.Name) (cc.Tracing, error) {
+	switch serviceName {
+	case cc.APIServerName:
+		return cc.ApiServer().Tracing, nil
+	case cc.ConfigServerName:
+		return cc.ConfigServer().Tracing, nil
+	case cc.DataServiceName:
+		return cc.DataService().Tracing, nil
+	case cc.CacheServiceName:
+		return cc.CacheService().Tracing, nil
+	case cc.FeedServerName:
+		return cc.FeedServer().Tracing, nil
+	default:
+		return cc.Tracing{}, fmt.Errorf("tracing is unsupported for service %s", serviceName)
+	}
+}
+
+// newExporter creates the span exporter, the returned close func releases the file of the file exporter.
+func newExporter(opt cc.Tracing) (sdktrace.SpanExporter, func() error, error) {
+	switch opt.Exporter {
+	case cc.StdoutTracingExporter:
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		return exporter, nil, err
+	case cc.FileTracingExporter:
+		f, err := os.OpenFile(opt.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, nil, err
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, nil, err
+		}
+		return exporter, f.Close, nil
+	default:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opt.Endpoint)}
+		if opt.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if len(opt.Headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(opt.Headers))
+		}
+		exporter, err := otlptracegrpc.New(context.Background(), opts...)
+		return exporter, nil, err
+	}
+}
+
+// Tracer returns the tracer of bscp.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start starts a span with the request id of the context attached.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	ctx, span := Tracer().Start(ctx, name, opts...)
+	if rid := RidFromContext(ctx); rid != "" {
+		span.SetAttributes(RidAttributeKey.String(rid))
+	}
+	return ctx, span
+}
+
+// StartKit starts a span with the context of the kit, and returns a copy of the kit carrying the span.
+func StartKit(kt *kit.Kit, name string, opts ...trace.SpanStartOption) (*kit.Kit, trace.Span) {
+	ctx := kt.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, span := Start(ctx, name, opts...)
+	if kt.Rid != "" {
+		span.SetAttributes(RidAttributeKey.String(kt.Rid))
+	}
+
+	traced := *kt
+	traced.Ctx = ctx
+	return &traced, span
+}
+
+// End records the error to the span if it's not nil, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// RidFromContext returns the request id carried by the context value, the http request or the grpc metadata.
+func RidFromContext(ctx context.Context) string {
+	if rid, ok := ctx.Value(constant.RidKey).(string); ok && rid != "" { //nolint:staticcheck
+		return rid
+	}
+
+	// the rid of the http request is generated by the request id middleware.
+	if rid := components.RequestIDValue(ctx); rid != "" {
+		return rid
+	}
+
+	for _, fn := range []func(context.Context) (metadata.MD, bool){metadata.FromIncomingContext,
+		metadata.FromOutgoingContext} {
+		if md, ok := fn(ctx); ok {
+			if rid := md.Get(lowRidKey); len(rid) != 0 && rid[0] != "" {
+				return rid[0]
+			}
+		}
+	}
+
+	return ""
+}
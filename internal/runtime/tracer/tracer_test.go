@@ -0,0 +1,110 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	return recorder
+}
+
+func ridOf(span sdktrace.ReadOnlySpan) string {
+	for _, attr := range span.Attributes() {
+		if attr.Key == RidAttributeKey {
+			return attr.Value.AsString()
+		}
+	}
+	return ""
+}
+
+func TestRidFromContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), constant.RidKey, "rid-value") //nolint:staticcheck
+	if got := RidFromContext(ctx); got != "rid-value" {
+		t.Errorf("rid from context value = %q, want rid-value", got)
+	}
+
+	md := metadata.Pairs(constant.RidKey, "rid-md")
+	if got := RidFromContext(metadata.NewIncomingContext(context.Background(), md)); got != "rid-md" {
+		t.Errorf("rid from incoming metadata = %q, want rid-md", got)
+	}
+
+	if got := RidFromContext(context.Background()); got != "" {
+		t.Errorf("rid from empty context = %q, want empty", got)
+	}
+}
+
+func TestStartKit(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	kt := kit.New()
+	kt.BizID = 2
+	traced, span := StartKit(kt, "op")
+	if traced == kt || traced.BizID != kt.BizID || traced.Rid != kt.Rid {
+		t.Fatalf("unexpected traced kit: %+v", traced)
+	}
+	if !trace.SpanFromContext(traced.Ctx).SpanContext().Equal(span.SpanContext()) {
+		t.Fatalf("span is not carried by the traced kit")
+	}
+	End(span, nil)
+
+	spans := recorder.Ended()
+	if len(spans) != 1 || spans[0].Name() != "op" || ridOf(spans[0]) != kt.Rid {
+		t.Fatalf("unexpected spans: %+v", spans)
+	}
+}
+
+func TestHTTPMiddlewareContinuesTrace(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	parentCtx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+	otel.GetTextMapPropagator().Inject(parentCtx, propagation.HeaderCarrier(req.Header))
+
+	h := HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want 2", len(spans))
+	}
+	server := spans[0]
+	if server.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("http span is not the child of the upstream span")
+	}
+	if server.Status().Code.String() != "Error" {
+		t.Errorf("http span status = %v, want Error", server.Status().Code)
+	}
+}
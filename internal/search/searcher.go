@@ -20,6 +20,7 @@ import (
 	"gorm.io/gen/field"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
 )
 
 // TableName is table name which support search.
@@ -225,13 +226,13 @@ func (s *searcher) SearchExprs(q *gen.Query) []field.Expr {
 	// if search fields is not specified, use the default search fields
 	if len(s.fields) == 0 {
 		for _, f := range defaultFields[s.tableName] {
-			exprs = append(exprs, getGenFieldsMap(q)[s.tableName][f].Regexp(searchVal))
+			exprs = append(exprs, utils.Regexp(getGenFieldsMap(q)[s.tableName][f], searchVal))
 		}
 		return exprs
 	}
 
 	for _, f := range s.fields {
-		exprs = append(exprs, getGenFieldsMap(q)[s.tableName][f].Regexp(searchVal))
+		exprs = append(exprs, utils.Regexp(getGenFieldsMap(q)[s.tableName][f], searchVal))
 	}
 	return exprs
 }
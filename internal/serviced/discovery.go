@@ -0,0 +1,210 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviced
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer/roundrobin"
+	"google.golang.org/grpc/resolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// NewServiceWithConf create a service instance with the discovery backend defined by conf.
+func NewServiceWithConf(conf cc.Service, opt ServiceOption) (Service, error) {
+	if conf.Discovery.Mode == cc.EtcdDiscoveryMode {
+		etcdOpt, err := conf.Etcd.ToConfig()
+		if err != nil {
+			return nil, fmt.Errorf("get etcd config failed, err: %v", err)
+		}
+
+		return NewService(etcdOpt, opt)
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return newLocalServiced(conf.Discovery, opt)
+}
+
+// NewServiceDWithConf create a service and discovery instance with the discovery backend defined by conf.
+func NewServiceDWithConf(conf cc.Service, opt ServiceOption) (ServiceDiscover, error) {
+	if conf.Discovery.Mode == cc.EtcdDiscoveryMode {
+		etcdOpt, err := conf.Etcd.ToConfig()
+		if err != nil {
+			return nil, fmt.Errorf("get etcd config failed, err: %v", err)
+		}
+
+		return NewServiceD(etcdOpt, opt)
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	s, err := newLocalServiced(conf.Discovery, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := registerResolver(conf.Discovery); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// NewDiscoveryWithConf create a service discovery instance with the discovery backend defined by conf.
+func NewDiscoveryWithConf(conf cc.Service) (Discover, error) {
+	if conf.Discovery.Mode == cc.EtcdDiscoveryMode {
+		etcdOpt, err := conf.Etcd.ToConfig()
+		if err != nil {
+			return nil, fmt.Errorf("get etcd config failed, err: %v", err)
+		}
+
+		return NewDiscovery(etcdOpt)
+	}
+
+	if err := registerResolver(conf.Discovery); err != nil {
+		return nil, err
+	}
+
+	// the discovery only instance never registers itself, so it's not the master all the time.
+	return &localServiced{elector: noopElector{}}, nil
+}
+
+// registerResolver registers the grpc resolver of the discovery backend, and use
+// its scheme as the dial target scheme.
+func registerResolver(conf cc.Discovery) error {
+	switch conf.Mode {
+	case cc.StaticDiscoveryMode:
+		resolver.Register(newStaticBuilder(conf.Static))
+		grpcScheme = staticScheme
+	case cc.DNSDiscoveryMode:
+		resolver.Register(newDNSBuilder(conf.DNS))
+		grpcScheme = dnsScheme
+	default:
+		return fmt.Errorf("unsupported discovery mode %s", conf.Mode)
+	}
+
+	return nil
+}
+
+// localServiced is the service instance of the discovery backends without a registry, like
+// static and dns, the service instances are resolved from the config or dns records, so the
+// register is only a state flag, and the master is elected by the elector.
+type localServiced struct {
+	svcOpt  ServiceOption
+	elector elector
+
+	// isRegisteredFlag service register flag.
+	isRegisteredFlag  bool
+	isRegisteredRWMux sync.RWMutex
+
+	// disableMasterSlaveFlag defines if the service instance's master-slave check is disabled and treated as slave.
+	disableMasterSlaveFlag bool
+	disableRWMux           sync.RWMutex
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// newLocalServiced create a service instance without a registry, and keep electing the master.
+func newLocalServiced(conf cc.Discovery, opt ServiceOption) (*localServiced, error) {
+	el, err := newElector(conf.Election, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &localServiced{
+		svcOpt:  opt,
+		elector: el,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	// keep synchronizing current node's master state.
+	go el.run(ctx)
+	return s, nil
+}
+
+// LBRoundRobin returns a load balance based on all the
+// service's instance.
+func (s *localServiced) LBRoundRobin() grpc.DialOption {
+	return grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, roundrobin.Name))
+}
+
+// Register the service
+func (s *localServiced) Register() error {
+	s.isRegisteredRWMux.Lock()
+	defer s.isRegisteredRWMux.Unlock()
+
+	if s.isRegisteredFlag {
+		return errors.New("only one is allowed to register for the current service")
+	}
+	s.isRegisteredFlag = true
+
+	return nil
+}
+
+// Deregister the service, and give up the master if it is.
+func (s *localServiced) Deregister() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+
+	s.isRegisteredRWMux.Lock()
+	s.isRegisteredFlag = false
+	s.isRegisteredRWMux.Unlock()
+
+	return nil
+}
+
+// IsMaster test if this service instance is
+// master or not.
+func (s *localServiced) IsMaster() bool {
+	s.disableRWMux.RLock()
+	disabled := s.disableMasterSlaveFlag
+	s.disableRWMux.RUnlock()
+
+	if disabled {
+		logs.Infof("master-slave is disabled, returns this service instance master state as slave")
+		return false
+	}
+
+	return s.elector.isLeader()
+}
+
+// DisableMasterSlave disable/enable this service instance's master-slave check.
+// if disabled, treat this service as a slave instead of checking if it is master from service discovery.
+func (s *localServiced) DisableMasterSlave(disable bool) {
+	s.disableRWMux.Lock()
+	s.disableMasterSlaveFlag = disable
+	s.disableRWMux.Unlock()
+
+	logs.Infof("master-slave disabled status: %v", disable)
+}
+
+// Healthz checks the election backend's health state, the static and dns discovery
+// backends have no registry to check.
+func (s *localServiced) Healthz() error {
+	return s.elector.healthz()
+}
@@ -0,0 +1,167 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviced
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// dnsBuilder creates a resolver that resolves the service with dns records.
+type dnsBuilder struct {
+	targets  map[cc.Name]string
+	interval time.Duration
+	lookup   dnsLookup
+}
+
+// dnsLookup is the subset of net.Resolver used by the dns resolver.
+type dnsLookup interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// newDNSBuilder new dnsBuilder.
+func newDNSBuilder(conf cc.DNSDiscovery) *dnsBuilder {
+	return &dnsBuilder{
+		targets:  conf.Targets,
+		interval: time.Duration(conf.RefreshIntervalSec) * time.Second,
+		lookup:   net.DefaultResolver,
+	}
+}
+
+// Build creates and starts a dns resolver that re-resolves the target periodically.
+func (b *dnsBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (
+	resolver.Resolver, error) {
+
+	name := serviceNameFromTarget(target.Endpoint())
+	dnsName, ok := b.targets[name]
+	if !ok {
+		return nil, fmt.Errorf("no dns discovery target of service %s", name)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &dnsResolver{
+		name:     name,
+		dnsName:  dnsName,
+		lookup:   b.lookup,
+		interval: b.interval,
+		cc:       cc,
+		resolve:  make(chan struct{}, 1),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+
+	go r.watcher()
+	return r, nil
+}
+
+// Scheme return grpc scheme.
+func (b *dnsBuilder) Scheme() string {
+	return dnsScheme
+}
+
+// dnsResolver resolves the target with A/AAAA records if it has a port, like the headless
+// kubernetes service, otherwise resolves it with SRV records.
+type dnsResolver struct {
+	name     cc.Name
+	dnsName  string
+	lookup   dnsLookup
+	interval time.Duration
+	cc       resolver.ClientConn
+	resolve  chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+// ResolveNow triggers a re-resolution at once, e.g. the connection to an address is broken.
+func (r *dnsResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolve <- struct{}{}:
+	default:
+	}
+}
+
+// Close closes the resolver.
+func (r *dnsResolver) Close() {
+	r.cancel()
+}
+
+func (r *dnsResolver) watcher() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		addresses, err := r.lookupAddresses()
+		if err != nil {
+			logs.Errorf("dns resolve service %s with %s failed, err: %v", r.name, r.dnsName, err)
+			r.cc.ReportError(err)
+		} else if e := r.cc.UpdateState(resolver.State{Addresses: addresses}); e != nil {
+			logs.Errorf("client conn update state failed, addr: %v, err: %v", addresses, e)
+		}
+
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.resolve:
+		}
+	}
+}
+
+// lookupAddresses resolves the dns name to the sorted grpc addresses.
+func (r *dnsResolver) lookupAddresses() ([]resolver.Address, error) {
+	ctx, cancel := context.WithTimeout(r.ctx, r.interval)
+	defer cancel()
+
+	var addrs []string
+	if host, port, err := net.SplitHostPort(r.dnsName); err == nil {
+		hosts, err := r.lookup.LookupHost(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range hosts {
+			addrs = append(addrs, net.JoinHostPort(h, port))
+		}
+	} else {
+		_, srvs, err := r.lookup.LookupSRV(ctx, "", "", r.dnsName)
+		if err != nil {
+			return nil, err
+		}
+		for _, srv := range srvs {
+			addrs = append(addrs, net.JoinHostPort(strings.TrimSuffix(srv.Target, "."),
+				strconv.Itoa(int(srv.Port))))
+		}
+	}
+
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no address resolved from %s", r.dnsName)
+	}
+
+	sort.Strings(addrs)
+	addresses := make([]resolver.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		addresses = append(addresses, resolver.Address{Addr: addr, ServerName: string(r.name)})
+	}
+
+	return addresses, nil
+}
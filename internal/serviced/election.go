@@ -0,0 +1,60 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviced
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// elector elects the master of the service instances for the discovery backends without a registry.
+type elector interface {
+	// run keeps electing until the ctx is done, and gives up the master when it returns.
+	run(ctx context.Context)
+	// isLeader test if this service instance is the master or not.
+	isLeader() bool
+	// healthz checks the election backend's health state.
+	healthz() error
+}
+
+// newElector create the elector by the election mode.
+func newElector(conf cc.Election, opt ServiceOption) (elector, error) {
+	switch conf.Mode {
+	case cc.StandaloneElectionMode:
+		return standaloneElector{}, nil
+	case cc.K8sLeaseElectionMode:
+		return newK8sLeaseElector(conf.Lease, opt)
+	default:
+		return nil, fmt.Errorf("unsupported election mode %s", conf.Mode)
+	}
+}
+
+// standaloneElector treats the service instance as master all the time.
+type standaloneElector struct{}
+
+func (standaloneElector) run(context.Context) {}
+
+func (standaloneElector) isLeader() bool { return true }
+
+func (standaloneElector) healthz() error { return nil }
+
+// noopElector never elects the service instance as master, it's used by the discovery only instance.
+type noopElector struct{}
+
+func (noopElector) run(context.Context) {}
+
+func (noopElector) isLeader() bool { return false }
+
+func (noopElector) healthz() error { return nil }
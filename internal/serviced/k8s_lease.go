@@ -0,0 +1,350 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviced
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	// k8sServiceAccountDir is the dir that the pod's service account token, ca and namespace mounted in.
+	k8sServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+	// k8sRequestTimeout is the timeout of each request to the kube-apiserver.
+	k8sRequestTimeout = 5 * time.Second
+)
+
+// k8sLeaseElector elects the master with the kubernetes Lease, the instance holds the lease
+// is the master, and the others take over the lease after it is not renewed for the lease
+// duration. it talks to the kube-apiserver with the pod's service account, which needs
+// the get, create and update permissions of the coordination.k8s.io leases.
+type k8sLeaseElector struct {
+	client    *http.Client
+	apiServer string
+	tokenFile string
+	namespace string
+	name      string
+	identity  string
+
+	duration      time.Duration
+	renewInterval time.Duration
+
+	mux sync.RWMutex
+	// leader is the master state of the last election.
+	leader bool
+	// lastRenew is the last time this instance renewed the lease as the master.
+	lastRenew time.Time
+	// lastSync is the last time this instance talked to the kube-apiserver successfully.
+	lastSync time.Time
+	lastErr  error
+}
+
+// newK8sLeaseElector create a k8s lease elector with the in-cluster config of the pod.
+func newK8sLeaseElector(conf cc.K8sLease, opt ServiceOption) (*k8sLeaseElector, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("k8s lease election should run in kubernetes, " +
+			"KUBERNETES_SERVICE_HOST or KUBERNETES_SERVICE_PORT is not set")
+	}
+
+	ca, err := os.ReadFile(filepath.Join(k8sServiceAccountDir, "ca.crt"))
+	if err != nil {
+		return nil, fmt.Errorf("read service account ca failed, err: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("parse service account ca failed")
+	}
+
+	namespace := conf.Namespace
+	if namespace == "" {
+		ns, err := os.ReadFile(filepath.Join(k8sServiceAccountDir, "namespace"))
+		if err != nil {
+			return nil, fmt.Errorf("read service account namespace failed, err: %v", err)
+		}
+		namespace = strings.TrimSpace(string(ns))
+	}
+
+	transport := &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}}
+	return &k8sLeaseElector{
+		client:        &http.Client{Transport: transport, Timeout: k8sRequestTimeout},
+		apiServer:     "https://" + net.JoinHostPort(host, port),
+		tokenFile:     filepath.Join(k8sServiceAccountDir, "token"),
+		namespace:     namespace,
+		name:          fmt.Sprintf("%s-%s", conf.NamePrefix, opt.Name),
+		identity:      fmt.Sprintf("%s_%s", opt.IP, opt.Uid),
+		duration:      time.Duration(conf.DurationSec) * time.Second,
+		renewInterval: time.Duration(conf.RenewIntervalSec) * time.Second,
+	}, nil
+}
+
+// run keeps acquiring or renewing the lease until the ctx is done, then releases the lease.
+func (e *k8sLeaseElector) run(ctx context.Context) {
+	ticker := time.NewTicker(e.renewInterval)
+	defer ticker.Stop()
+
+	for {
+		e.sync(ctx)
+
+		select {
+		case <-ctx.Done():
+			e.release()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// isLeader test if this service instance holds the lease, the master state is lost if the
+// lease is not renewed for the lease duration, e.g. the kube-apiserver can not be reached.
+func (e *k8sLeaseElector) isLeader() bool {
+	e.mux.RLock()
+	defer e.mux.RUnlock()
+
+	return e.leader && time.Since(e.lastRenew) < e.duration
+}
+
+// healthz returns the last error if it can not talk to the kube-apiserver for the lease duration.
+func (e *k8sLeaseElector) healthz() error {
+	e.mux.RLock()
+	defer e.mux.RUnlock()
+
+	if e.lastErr != nil && time.Since(e.lastSync) >= e.duration {
+		return fmt.Errorf("sync k8s lease %s/%s failed, err: %v", e.namespace, e.name, e.lastErr)
+	}
+
+	return nil
+}
+
+// sync acquires or renews the lease once, and updates the master state.
+func (e *k8sLeaseElector) sync(ctx context.Context) {
+	now := time.Now()
+	leader, err := e.tryAcquireOrRenew(ctx, now)
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	e.lastErr = err
+	if err != nil {
+		// keep the master state until the lease expires, which is checked by isLeader.
+		logs.Errorf("sync k8s lease %s/%s failed, identity: %s, err: %v", e.namespace, e.name, e.identity, err)
+		return
+	}
+	e.lastSync = now
+
+	if leader != e.leader {
+		logs.Infof("k8s lease %s/%s master state changed to %v, identity: %s", e.namespace, e.name, leader,
+			e.identity)
+	}
+	e.leader = leader
+	if leader {
+		e.lastRenew = now
+	}
+}
+
+// tryAcquireOrRenew creates the lease if not exist, renews it if held by this instance, or takes
+// it over if it is expired. it returns whether this instance holds the lease.
+func (e *k8sLeaseElector) tryAcquireOrRenew(ctx context.Context, now time.Time) (bool, error) {
+	lease, err := e.getLease(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	mt := metav1.NewMicroTime(now)
+	durationSec := int32(e.duration / time.Second)
+	if lease == nil {
+		lease = &coordinationv1.Lease{
+			TypeMeta:   metav1.TypeMeta{APIVersion: coordinationv1.SchemeGroupVersion.String(), Kind: "Lease"},
+			ObjectMeta: metav1.ObjectMeta{Name: e.name, Namespace: e.namespace},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &e.identity,
+				LeaseDurationSeconds: &durationSec,
+				AcquireTime:          &mt,
+				RenewTime:            &mt,
+			},
+		}
+		return e.writeLease(ctx, http.MethodPost, e.leasesURL(), lease)
+	}
+
+	holder := ""
+	if lease.Spec.HolderIdentity != nil {
+		holder = *lease.Spec.HolderIdentity
+	}
+
+	if holder != e.identity {
+		if holder != "" && !leaseExpired(lease.Spec, now) {
+			return false, nil
+		}
+
+		// take over the lease released or not renewed by the former master.
+		lease.Spec.AcquireTime = &mt
+		transitions := int32(0)
+		if lease.Spec.LeaseTransitions != nil {
+			transitions = *lease.Spec.LeaseTransitions
+		}
+		transitions++
+		lease.Spec.LeaseTransitions = &transitions
+	}
+
+	lease.Spec.HolderIdentity = &e.identity
+	lease.Spec.LeaseDurationSeconds = &durationSec
+	lease.Spec.RenewTime = &mt
+	// the lease's resource version makes sure only one instance can update it at the same time.
+	return e.writeLease(ctx, http.MethodPut, e.leaseURL(), lease)
+}
+
+// release gives up the lease if this instance holds it, so that the others can take it over at once.
+func (e *k8sLeaseElector) release() {
+	if !e.isLeader() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), k8sRequestTimeout)
+	defer cancel()
+
+	lease, err := e.getLease(ctx)
+	if err != nil || lease == nil || lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != e.identity {
+		logs.Errorf("get k8s lease %s/%s to release failed, err: %v", e.namespace, e.name, err)
+		return
+	}
+
+	empty := ""
+	durationSec := int32(1)
+	mt := metav1.NewMicroTime(time.Now())
+	lease.Spec.HolderIdentity = &empty
+	lease.Spec.LeaseDurationSeconds = &durationSec
+	lease.Spec.RenewTime = &mt
+	if _, err := e.writeLease(ctx, http.MethodPut, e.leaseURL(), lease); err != nil {
+		logs.Errorf("release k8s lease %s/%s failed, err: %v", e.namespace, e.name, err)
+		return
+	}
+
+	e.mux.Lock()
+	e.leader = false
+	e.mux.Unlock()
+	logs.Infof("released k8s lease %s/%s, identity: %s", e.namespace, e.name, e.identity)
+}
+
+// leaseExpired test if the lease is not renewed for its duration.
+func leaseExpired(spec coordinationv1.LeaseSpec, now time.Time) bool {
+	if spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+		return true
+	}
+
+	return spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second).Before(now)
+}
+
+// getLease get the lease, returns nil if it's not exist.
+func (e *k8sLeaseElector) getLease(ctx context.Context) (*coordinationv1.Lease, error) {
+	code, body, err := e.do(ctx, http.MethodGet, e.leaseURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch code {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("get lease response status: %d, body: %s", code, body)
+	}
+
+	lease := new(coordinationv1.Lease)
+	if err := json.Unmarshal(body, lease); err != nil {
+		return nil, fmt.Errorf("unmarshal lease failed, err: %v", err)
+	}
+
+	return lease, nil
+}
+
+// writeLease creates or updates the lease, returns false without error if another instance
+// has created or updated the lease first.
+func (e *k8sLeaseElector) writeLease(ctx context.Context, method, url string, lease *coordinationv1.Lease) (
+	bool, error) {
+
+	payload, err := json.Marshal(lease)
+	if err != nil {
+		return false, err
+	}
+
+	code, body, err := e.do(ctx, method, url, payload)
+	if err != nil {
+		return false, err
+	}
+
+	switch code {
+	case http.StatusOK, http.StatusCreated:
+		return true, nil
+	case http.StatusConflict:
+		return false, nil
+	default:
+		return false, fmt.Errorf("%s lease response status: %d, body: %s", method, code, body)
+	}
+}
+
+// do sends the request to the kube-apiserver with the service account token.
+func (e *k8sLeaseElector) do(ctx context.Context, method, url string, payload []byte) (int, []byte, error) {
+	// the projected service account token is rotated, so read it every time.
+	token, err := os.ReadFile(e.tokenFile)
+	if err != nil {
+		return 0, nil, fmt.Errorf("read service account token failed, err: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return resp.StatusCode, body, nil
+}
+
+func (e *k8sLeaseElector) leasesURL() string {
+	return fmt.Sprintf("%s/apis/coordination.k8s.io/v1/namespaces/%s/leases", e.apiServer, e.namespace)
+}
+
+func (e *k8sLeaseElector) leaseURL() string {
+	return e.leasesURL() + "/" + e.name
+}
@@ -53,7 +53,7 @@ func (b *etcdBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts
 
 // Scheme return grpc scheme.
 func (b *etcdBuilder) Scheme() string {
-	return "etcd"
+	return etcdScheme
 }
 
 // etcdResolver watches for the updates on the specified target.
@@ -0,0 +1,201 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviced
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// fakeLeaseServer is a kube-apiserver that serves one lease with the optimistic concurrency.
+type fakeLeaseServer struct {
+	mux     sync.Mutex
+	lease   *coordinationv1.Lease
+	version int
+}
+
+func (f *fakeLeaseServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		if f.lease == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(f.lease)
+	case http.MethodPost, http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		lease := new(coordinationv1.Lease)
+		if err := json.Unmarshal(body, lease); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if (r.Method == http.MethodPost && f.lease != nil) ||
+			(r.Method == http.MethodPut && lease.ResourceVersion != strconv.Itoa(f.version)) {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		f.version++
+		lease.ResourceVersion = strconv.Itoa(f.version)
+		f.lease = lease
+		_ = json.NewEncoder(w).Encode(f.lease)
+	}
+}
+
+func newTestElector(t *testing.T, apiServer, identity string) *k8sLeaseElector {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return &k8sLeaseElector{
+		client:        http.DefaultClient,
+		apiServer:     apiServer,
+		tokenFile:     tokenFile,
+		namespace:     "bscp",
+		name:          "bk-bscp-data-service",
+		identity:      identity,
+		duration:      time.Minute,
+		renewInterval: time.Second,
+	}
+}
+
+func TestK8sLeaseElector(t *testing.T) {
+	fake := &fakeLeaseServer{}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	a := newTestElector(t, srv.URL, "a")
+	b := newTestElector(t, srv.URL, "b")
+
+	a.sync(context.Background())
+	b.sync(context.Background())
+	if !a.isLeader() || b.isLeader() {
+		t.Fatalf("expected a to be the master, a: %v, b: %v", a.isLeader(), b.isLeader())
+	}
+
+	// renew by the master keeps the master state.
+	a.sync(context.Background())
+	if !a.isLeader() || *fake.lease.Spec.HolderIdentity != "a" {
+		t.Fatalf("expected a to renew the lease, got holder: %s", *fake.lease.Spec.HolderIdentity)
+	}
+
+	// the released lease is taken over by the others at once.
+	a.release()
+	b.sync(context.Background())
+	if a.isLeader() || !b.isLeader() {
+		t.Fatalf("expected b to take over the lease, a: %v, b: %v", a.isLeader(), b.isLeader())
+	}
+	if *fake.lease.Spec.LeaseTransitions != 1 {
+		t.Fatalf("expected lease transitions 1, got %d", *fake.lease.Spec.LeaseTransitions)
+	}
+
+	// the expired lease is taken over by the others.
+	expired := metav1.NewMicroTime(time.Now().Add(-2 * time.Minute))
+	fake.lease.Spec.RenewTime = &expired
+	a.sync(context.Background())
+	if !a.isLeader() {
+		t.Fatalf("expected a to take over the expired lease")
+	}
+}
+
+func TestK8sLeaseElectorHealthz(t *testing.T) {
+	e := newTestElector(t, "http://127.0.0.1:1", "a")
+	e.duration = 0
+	e.sync(context.Background())
+	if e.isLeader() {
+		t.Fatalf("expected not to be the master when kube-apiserver is unreachable")
+	}
+	if err := e.healthz(); err == nil {
+		t.Fatalf("expected healthz error when kube-apiserver is unreachable")
+	}
+}
+
+// fakeClientConn records the resolved addresses.
+type fakeClientConn struct {
+	resolver.ClientConn
+	state resolver.State
+}
+
+func (f *fakeClientConn) UpdateState(s resolver.State) error {
+	f.state = s
+	return nil
+}
+
+func TestStaticResolver(t *testing.T) {
+	b := newStaticBuilder(map[cc.Name][]string{cc.DataServiceName: {"10.0.0.1:9511", "10.0.0.2:9511"}})
+	conn := &fakeClientConn{}
+	target := resolver.Target{}
+	target.URL.Path = "/" + ServiceDiscoveryName(cc.DataServiceName)
+	if _, err := b.Build(target, conn, resolver.BuildOptions{}); err != nil {
+		t.Fatalf("build static resolver failed, err: %v", err)
+	}
+	if len(conn.state.Addresses) != 2 || conn.state.Addresses[0].ServerName != string(cc.DataServiceName) {
+		t.Fatalf("unexpected resolved addresses: %+v", conn.state.Addresses)
+	}
+
+	target.URL.Path = "/" + ServiceDiscoveryName(cc.CacheServiceName)
+	if _, err := b.Build(target, conn, resolver.BuildOptions{}); err == nil {
+		t.Fatalf("expected error for service without static address")
+	}
+}
+
+// fakeLookup resolves the names with the fixed records.
+type fakeLookup struct{}
+
+func (fakeLookup) LookupHost(_ context.Context, host string) ([]string, error) {
+	return []string{"10.0.0.2", "10.0.0.1"}, nil
+}
+
+func (fakeLookup) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	return name, []*net.SRV{{Target: "data-service-0.bscp.svc.cluster.local.", Port: 9511}}, nil
+}
+
+func TestDNSResolverLookup(t *testing.T) {
+	r := &dnsResolver{name: cc.DataServiceName, dnsName: "data-service-headless:9511", lookup: fakeLookup{},
+		interval: time.Second, ctx: context.Background()}
+	addresses, err := r.lookupAddresses()
+	if err != nil {
+		t.Fatalf("lookup headless service failed, err: %v", err)
+	}
+	if len(addresses) != 2 || addresses[0].Addr != "10.0.0.1:9511" {
+		t.Fatalf("unexpected headless service addresses: %+v", addresses)
+	}
+
+	r.dnsName = "_grpc._tcp.data-service"
+	addresses, err = r.lookupAddresses()
+	if err != nil {
+		t.Fatalf("lookup srv failed, err: %v", err)
+	}
+	if len(addresses) != 1 || addresses[0].Addr != "data-service-0.bscp.svc.cluster.local:9511" {
+		t.Fatalf("unexpected srv addresses: %+v", addresses)
+	}
+}
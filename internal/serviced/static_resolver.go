@@ -0,0 +1,67 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviced
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/resolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// staticBuilder creates a resolver that resolves the service with the configured address list.
+type staticBuilder struct {
+	addrs map[cc.Name][]string
+}
+
+// newStaticBuilder new staticBuilder.
+func newStaticBuilder(addrs map[cc.Name][]string) *staticBuilder {
+	return &staticBuilder{addrs: addrs}
+}
+
+// Build creates a static resolver and updates the target's addresses at once.
+func (b *staticBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (
+	resolver.Resolver, error) {
+
+	name := serviceNameFromTarget(target.Endpoint())
+	addrs, ok := b.addrs[name]
+	if !ok || len(addrs) == 0 {
+		return nil, fmt.Errorf("no static discovery address of service %s", name)
+	}
+
+	addresses := make([]resolver.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		addresses = append(addresses, resolver.Address{Addr: addr, ServerName: string(name)})
+	}
+
+	if err := cc.UpdateState(resolver.State{Addresses: addresses}); err != nil {
+		return nil, fmt.Errorf("client conn update state failed, addr: %v, err: %v", addresses, err)
+	}
+
+	return staticResolver{}, nil
+}
+
+// Scheme return grpc scheme.
+func (b *staticBuilder) Scheme() string {
+	return staticScheme
+}
+
+// staticResolver does nothing, as the addresses never change.
+type staticResolver struct{}
+
+// ResolveNow is a no-op for the static resolver.
+func (staticResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+// Close is a no-op for the static resolver.
+func (staticResolver) Close() {}
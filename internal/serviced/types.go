@@ -15,6 +15,7 @@ package serviced
 import (
 	"errors"
 	"fmt"
+	"path"
 	"time"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
@@ -29,6 +30,13 @@ const (
 	defaultGrantLeaseTTL = 10
 	// defaultErrSleepTime is exec failed need to wait time.
 	defaultErrSleepTime = time.Second
+
+	// etcdScheme is the grpc resolver scheme of the etcd discovery backend.
+	etcdScheme = "etcd"
+	// staticScheme is the grpc resolver scheme of the static discovery backend.
+	staticScheme = "static"
+	// dnsScheme is the grpc resolver scheme of the dns discovery backend.
+	dnsScheme = "bscp-dns"
 )
 
 // ServiceOption defines a service related options.
@@ -67,9 +75,18 @@ type DiscoveryOption struct {
 	Name cc.Name
 }
 
+// grpcScheme is the grpc resolver scheme of the discovery backend, it's set when
+// the service discovery instance is created at the start of the process.
+var grpcScheme = etcdScheme
+
 // GrpcServiceDiscoveryName grpc dial service discovery target name, protocol rule: Scheme:///ServiceDiscoveryName.
 func GrpcServiceDiscoveryName(serviceName cc.Name) string {
-	return "etcd:///" + ServiceDiscoveryName(serviceName)
+	return grpcScheme + ":///" + ServiceDiscoveryName(serviceName)
+}
+
+// serviceNameFromTarget return the service name from the grpc dial target's endpoint.
+func serviceNameFromTarget(endpoint string) cc.Name {
+	return cc.Name(path.Base(endpoint))
 }
 
 // ServiceDiscoveryName return the service's register path in etcd.
@@ -0,0 +1,72 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/vault"
+	"github.com/TencentBlueKing/bk-bscp/internal/tmplprocess"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// RenderTemplateVariables renders the bk_bscp_ template variables in the config template content before the
+// process context is rendered. The config templates are not bound to any app or group, so the variables are
+// resolved from the biz layer, and it fails if any variable is not defined by the biz.
+func RenderTemplateVariables(kt *kit.Kit, daoSet dao.Set, vaultSet vault.Set, bizID uint32,
+	content string) (string, error) {
+	proc := tmplprocess.NewTmplProcessor()
+	names := proc.ExtractVariables([]byte(content))
+	if len(names) == 0 {
+		return content, nil
+	}
+
+	vars, _, err := daoSet.TemplateVariable().List(kt, bizID, nil, &types.BasePage{All: true})
+	if err != nil {
+		return "", fmt.Errorf("list template variables failed: %w", err)
+	}
+	varMap := make(map[string]*table.TemplateVariableSpec, len(vars))
+	for _, v := range vars {
+		varMap[v.Spec.Name] = v.Spec
+	}
+
+	kv := make(map[string]interface{}, len(names))
+	var missing []string
+	for _, name := range names {
+		v, ok := varMap[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		kv[name] = v.DefaultVal
+		if !v.IsSecret() {
+			continue
+		}
+		// the secret value is only resolved from vault when it's rendered
+		if vaultSet == nil {
+			return "", fmt.Errorf("secret variable %s can not be resolved without vault", name)
+		}
+		if kv[name], err = vaultSet.GetSecretVariable(kt, &types.SecretVariableOption{BizID: bizID,
+			Name: name}); err != nil {
+			return "", fmt.Errorf("get secret variable %s failed: %w", name, err)
+		}
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("variable name in %v is missing for render the config template", missing)
+	}
+
+	return string(proc.Render([]byte(content), kv)), nil
+}
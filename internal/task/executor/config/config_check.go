@@ -231,6 +231,18 @@ func (e *CheckConfigExecutor) CheckConfigMD5(c *istep.Context) error {
 	logs.Infof("[CheckConfigMD5 STEP]: compare result, batch_id: %d, actualMD5=%s, storedMD5=%s, status=%s",
 		payload.BatchID, actualMD5, storedMD5, commonPayload.ConfigPayload.CompareStatus)
 
+	// 4. 记录漂移检测结果，用于配置实例列表展示及漂移配置重新下发
+	if configInstance != nil {
+		driftStatus := table.ConfigDriftConsistent
+		if commonPayload.ConfigPayload.CompareStatus == common.CompareResultDifferent {
+			driftStatus = table.ConfigDriftDrifted
+		}
+		if err := e.Dao.ConfigInstance().UpdateDriftStatus(kt, payload.BizID, configInstance.ID,
+			driftStatus, actualMD5); err != nil {
+			return fmt.Errorf("update config instance drift status failed: %w", err)
+		}
+	}
+
 	commonPayload.ConfigPayload.ConfigContentSignature = actualMD5
 
 	if err := c.SetCommonPayload(commonPayload); err != nil {
@@ -24,6 +24,7 @@ import (
 	pushmanager "github.com/TencentBlueKing/bk-bscp/internal/components/push_manager"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/vault"
 	processorcmdb "github.com/TencentBlueKing/bk-bscp/internal/processor/cmdb"
 	"github.com/TencentBlueKing/bk-bscp/internal/task/executor/common"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
@@ -46,6 +47,7 @@ type GenerateConfigExecutor struct {
 	*common.Executor
 	Repo            repository.Provider // 仓库服务，用于下载配置内容
 	CMDBRenderCache processorcmdb.RenderCache
+	Vault           vault.Set // 密文变量存储，用于渲染密文类型的模版变量
 }
 
 // NewConfigExecutor new config executor
@@ -67,6 +69,11 @@ func (e *GenerateConfigExecutor) SetCMDBService(cmdbService bkcmdb.Service) {
 	e.CMDBService = cmdbService
 }
 
+// SetVault 设置密文变量存储（用于渲染密文类型的模版变量）
+func (e *GenerateConfigExecutor) SetVault(vaultSet vault.Set) {
+	e.Vault = vaultSet
+}
+
 // SetCMDBRenderCache 设置 CMDB 渲染缓存。
 func (e *GenerateConfigExecutor) SetCMDBRenderCache(renderCache processorcmdb.RenderCache) {
 	e.CMDBRenderCache = renderCache
@@ -195,6 +202,13 @@ func (e *GenerateConfigExecutor) GenerateConfig(c *istep.Context) error {
 			return fmt.Errorf("expand ginclude failed, template id: %d, error: %w",
 				generatePayload.TemplateRevision.Attachment.TemplateID, err)
 		}
+
+		// 2.6 渲染 bk_bscp_ 模版变量，配置模版不属于任何服务和分组，变量取业务层的值
+		configContent, err = common.RenderTemplateVariables(kt, e.Dao, e.Vault, generatePayload.BizID, configContent)
+		if err != nil {
+			return fmt.Errorf("render template variables failed, template id: %d, error: %w",
+				generatePayload.TemplateRevision.Attachment.TemplateID, err)
+		}
 	}
 
 	// 3. 构建渲染上下文并渲染模板
@@ -262,6 +262,7 @@ func (e *PushConfigExecutor) Callback(c *istep.Context, cbErr error) error {
 	proc := payload.Payload.ProcessPayload
 	now := time.Now()
 
+	md5 := tools.ByteMD5([]byte(cfg.ConfigContent))
 	instance := &table.ConfigInstance{
 		Attachment: &table.ConfigInstanceAttachment{
 			BizID:            payload.BizID,
@@ -270,9 +271,13 @@ func (e *PushConfigExecutor) Callback(c *istep.Context, cbErr error) error {
 			CcProcessID:      proc.CcProcessID,
 			ModuleInstSeq:    proc.ModuleInstSeq,
 			GenerateTaskID:   c.GetTaskID(),
-			Md5:              tools.ByteMD5([]byte(cfg.ConfigContent)),
+			Md5:              md5,
 			Content:          cfg.ConfigContent,
 			TenantID:         payload.TenantID,
+			// 下发成功后主机上的文件与配置实例一致
+			DriftStatus:    table.ConfigDriftConsistent,
+			ActualMd5:      md5,
+			DriftCheckedAt: &now,
 		},
 		Revision: &table.Revision{
 			Creator:   kt.User,
@@ -18,6 +18,7 @@ import (
 	pushmanager "github.com/TencentBlueKing/bk-bscp/internal/components/push_manager"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/vault"
 	processorcmdb "github.com/TencentBlueKing/bk-bscp/internal/processor/cmdb"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/lock"
 	cmdbGse "github.com/TencentBlueKing/bk-bscp/internal/task/executor/cmdb_gse"
@@ -31,7 +32,7 @@ import (
 // RegisterExecutor 中可以补充参数，比如执行器依赖的配置，执行器依赖的第三方服务等
 // nolint: revive
 func RegisterExecutor(gseService *gse.Service, bkcmdbService bkcmdb.Service, dao dao.Set, repo repository.Provider,
-	redLock *lock.RedisLock, pm pushmanager.Service, renderCache processorcmdb.RenderCache) {
+	redLock *lock.RedisLock, pm pushmanager.Service, renderCache processorcmdb.RenderCache, vaultSet vault.Set) {
 	// 注册 process 执行器
 	processExecutor := process.NewProcessExecutor(gseService, bkcmdbService, pm, dao)
 	process.RegisterExecutor(processExecutor)
@@ -51,6 +52,8 @@ func RegisterExecutor(gseService *gse.Service, bkcmdbService bkcmdb.Service, dao
 	// 设置 CMDB 服务，用于获取 CC 拓扑 XML
 	configGenerateExecutor.SetCMDBService(bkcmdbService)
 	configGenerateExecutor.SetCMDBRenderCache(renderCache)
+	// 设置密文变量存储，用于渲染密文类型的模版变量
+	configGenerateExecutor.SetVault(vaultSet)
 	config.RegisterGenerateConfigExecutor(configGenerateExecutor)
 
 	// 注册 配置下发执行器
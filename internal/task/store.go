@@ -0,0 +1,300 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/task/stores/iface"
+	mysqlstore "github.com/Tencent/bk-bcs/bcs-common/common/task/stores/mysql"
+	itypes "github.com/Tencent/bk-bcs/bcs-common/common/task/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/sharding"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// newStore 根据数据库驱动创建任务存储, mysql 沿用 bcs-common 的实现,
+// 其他驱动使用基于 gorm 方言的通用实现, 表结构与 mysql store 保持一致
+func newStore(dbConfig cc.Database) (iface.Store, error) {
+	if sharding.DialectOf(dbConfig).Name() == string(cc.MySQLDriver) {
+		dsn := ""
+		if dbConfig.Database != "" {
+			dsn = fmt.Sprintf("%s:%s@tcp(%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+				dbConfig.User, dbConfig.Password, dbConfig.Endpoints[0], dbConfig.Database)
+		}
+		return mysqlstore.New(dsn, mysqlstore.WithDebug(true))
+	}
+
+	db, err := gorm.Open(sharding.GormDialector(dbConfig), &gorm.Config{Logger: logger.Default.LogMode(logger.Info)})
+	if err != nil {
+		return nil, err
+	}
+	return &gormStore{db: db}, nil
+}
+
+// gormStore 与数据库方言无关的任务存储
+type gormStore struct {
+	db *gorm.DB
+}
+
+// EnsureTable implement istore EnsureTable interface
+func (s *gormStore) EnsureTable(ctx context.Context, dst ...any) error {
+	if len(dst) == 0 {
+		dst = []any{&mysqlstore.TaskRecord{}, &mysqlstore.StepRecord{}}
+	}
+	return s.db.WithContext(ctx).AutoMigrate(dst...)
+}
+
+// CreateTask implement istore CreateTask interface
+func (s *gormStore) CreateTask(ctx context.Context, task *itypes.Task) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(toTaskRecord(task)).Error; err != nil {
+			return err
+		}
+		steps := toStepRecords(task)
+		if len(steps) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(steps, 100).Error
+	})
+}
+
+// ListTask implement istore ListTask interface
+func (s *gormStore) ListTask(ctx context.Context, opt *iface.ListOption) (*iface.Pagination[itypes.Task], error) {
+	tx := s.db.WithContext(ctx).Model(&mysqlstore.TaskRecord{}).Where(&mysqlstore.TaskRecord{
+		TaskID:        opt.TaskID,
+		TaskType:      opt.TaskType,
+		TaskName:      opt.TaskName,
+		TaskIndex:     opt.TaskIndex,
+		TaskIndexType: opt.TaskIndexType,
+		CurrentStep:   opt.CurrentStep,
+		Creator:       opt.Creator,
+	})
+
+	if len(opt.StatusList) > 0 {
+		tx = tx.Where("status IN ?", opt.StatusList)
+	} else if opt.Status != "" {
+		tx = tx.Where("status = ?", opt.Status)
+	}
+	if opt.CreatedGte != nil {
+		tx = tx.Where("created_at >= ?", opt.CreatedGte)
+	}
+	if opt.CreatedLte != nil {
+		tx = tx.Where("created_at <= ?", opt.CreatedLte)
+	}
+
+	if len(opt.Sort) == 0 {
+		tx = tx.Order("id DESC")
+	}
+	for field, direction := range opt.Sort {
+		if !slices.Contains(mysqlstore.SortableFields, field) {
+			return nil, fmt.Errorf("invalid sort field: %s", field)
+		}
+		if direction > 0 {
+			tx = tx.Order(field + " ASC")
+		} else {
+			tx = tx.Order(field + " DESC")
+		}
+	}
+
+	records, count, err := mysqlstore.FindByPage[mysqlstore.TaskRecord](tx, int(opt.Offset), int(opt.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	taskIDs := make([]string, 0, len(records))
+	for _, record := range records {
+		taskIDs = append(taskIDs, record.TaskID)
+	}
+	steps := make([]*mysqlstore.StepRecord, 0)
+	if len(taskIDs) > 0 {
+		if err := s.db.WithContext(ctx).Where("task_id IN ?", taskIDs).Find(&steps).Error; err != nil {
+			return nil, err
+		}
+	}
+	taskSteps := make(map[string][]*mysqlstore.StepRecord, len(taskIDs))
+	for _, step := range steps {
+		taskSteps[step.TaskID] = append(taskSteps[step.TaskID], step)
+	}
+
+	items := make([]*itypes.Task, 0, len(records))
+	for _, record := range records {
+		items = append(items, fromTaskRecord(record, taskSteps[record.TaskID]))
+	}
+
+	return &iface.Pagination[itypes.Task]{Count: count, Items: items}, nil
+}
+
+// GetTask implement istore GetTask interface
+func (s *gormStore) GetTask(ctx context.Context, taskID string) (*itypes.Task, error) {
+	tx := s.db.WithContext(ctx)
+	record := mysqlstore.TaskRecord{}
+	if err := tx.Where("task_id = ?", taskID).First(&record).Error; err != nil {
+		return nil, err
+	}
+
+	steps := make([]*mysqlstore.StepRecord, 0)
+	if err := tx.Where("task_id = ?", taskID).Find(&steps).Error; err != nil {
+		return nil, err
+	}
+	return fromTaskRecord(&record, steps), nil
+}
+
+// DeleteTask implement istore DeleteTask interface
+func (s *gormStore) DeleteTask(ctx context.Context, taskID string) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("task_id = ?", taskID).Delete(&mysqlstore.TaskRecord{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("task_id = ?", taskID).Delete(&mysqlstore.StepRecord{}).Error
+	})
+}
+
+// UpdateTask implement istore UpdateTask interface
+func (s *gormStore) UpdateTask(ctx context.Context, task *itypes.Task) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&mysqlstore.TaskRecord{}).
+			Where("task_id = ?", task.TaskID).
+			Select("CurrentStep", "CommonParams", "CommonPayload", "CallbackResult", "CallbackMessage",
+				"Status", "Message", "Start", "End", "ExecutionTime", "Updater").
+			Updates(&mysqlstore.TaskRecord{
+				CurrentStep:     task.CurrentStep,
+				CommonParams:    task.CommonParams,
+				CommonPayload:   task.CommonPayload,
+				CallbackResult:  task.CallbackResult,
+				CallbackMessage: task.CallbackMessage,
+				Status:          task.Status,
+				Message:         task.Message,
+				Start:           task.Start,
+				End:             task.End,
+				ExecutionTime:   task.ExecutionTime,
+				Updater:         task.Updater,
+			}).Error; err != nil {
+			return err
+		}
+
+		for _, step := range task.Steps {
+			if err := tx.Model(&mysqlstore.StepRecord{}).
+				Where("task_id = ? AND name = ?", task.TaskID, step.Name).
+				Select("Params", "Payload", "Status", "Message", "Start", "End", "ExecutionTime", "RetryCount").
+				Updates(&mysqlstore.StepRecord{
+					Params:        step.Params,
+					Payload:       step.Payload,
+					Status:        step.Status,
+					Message:       step.Message,
+					Start:         step.Start,
+					End:           step.End,
+					ExecutionTime: step.ExecutionTime,
+					RetryCount:    step.RetryCount,
+				}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func toTaskRecord(t *itypes.Task) *mysqlstore.TaskRecord {
+	sequence := make([]string, 0, len(t.Steps))
+	for _, step := range t.Steps {
+		sequence = append(sequence, step.Name)
+	}
+
+	return &mysqlstore.TaskRecord{
+		TaskID:              t.TaskID,
+		TaskType:            t.TaskType,
+		TaskIndex:           t.TaskIndex,
+		TaskIndexType:       t.TaskIndexType,
+		TaskName:            t.TaskName,
+		CurrentStep:         t.CurrentStep,
+		StepSequence:        sequence,
+		CallbackName:        t.CallbackName,
+		CommonParams:        t.CommonParams,
+		CommonPayload:       t.CommonPayload,
+		Status:              t.Status,
+		Message:             t.Message,
+		Start:               t.Start,
+		End:                 t.End,
+		ExecutionTime:       t.ExecutionTime,
+		MaxExecutionSeconds: t.MaxExecutionSeconds,
+		Creator:             t.Creator,
+		Updater:             t.Updater,
+	}
+}
+
+func toStepRecords(t *itypes.Task) []*mysqlstore.StepRecord {
+	records := make([]*mysqlstore.StepRecord, 0, len(t.Steps))
+	for _, step := range t.Steps {
+		records = append(records, &mysqlstore.StepRecord{
+			TaskID:              t.TaskID,
+			Name:                step.Name,
+			Alias:               step.Alias,
+			Executor:            step.Executor,
+			Payload:             step.Payload,
+			Status:              step.Status,
+			Message:             step.Message,
+			SkipOnFailed:        step.SkipOnFailed,
+			ETA:                 step.ETA,
+			RetryCount:          step.RetryCount,
+			MaxRetries:          step.MaxRetries,
+			Params:              step.Params,
+			Start:               step.Start,
+			End:                 step.End,
+			ExecutionTime:       step.ExecutionTime,
+			MaxExecutionSeconds: step.MaxExecutionSeconds,
+		})
+	}
+	return records
+}
+
+func fromTaskRecord(record *mysqlstore.TaskRecord, steps []*mysqlstore.StepRecord) *itypes.Task {
+	t := &itypes.Task{
+		TaskID:              record.TaskID,
+		TaskType:            record.TaskType,
+		TaskIndex:           record.TaskIndex,
+		TaskIndexType:       record.TaskIndexType,
+		TaskName:            record.TaskName,
+		CurrentStep:         record.CurrentStep,
+		CallbackName:        record.CallbackName,
+		CallbackResult:      record.CallbackResult,
+		CallbackMessage:     record.CallbackMessage,
+		CommonParams:        record.CommonParams,
+		CommonPayload:       record.CommonPayload,
+		Status:              record.Status,
+		Message:             record.Message,
+		Start:               record.Start,
+		End:                 record.End,
+		ExecutionTime:       record.ExecutionTime,
+		MaxExecutionSeconds: record.MaxExecutionSeconds,
+		CreatedAt:           record.CreatedAt,
+		LastUpdate:          record.UpdatedAt,
+		Creator:             record.Creator,
+		Updater:             record.Updater,
+	}
+
+	byName := make(map[string]*mysqlstore.StepRecord, len(steps))
+	for _, step := range steps {
+		byName[step.Name] = step
+	}
+	t.Steps = make([]*itypes.Step, 0, len(record.StepSequence))
+	for _, name := range record.StepSequence {
+		if step, ok := byName[name]; ok {
+			t.Steps = append(t.Steps, step.ToStep())
+		}
+	}
+	return t
+}
@@ -24,9 +24,7 @@ import (
 	etcdbroker "github.com/Tencent/bk-bcs/bcs-common/common/task/brokers/etcd"
 	etcdlock "github.com/Tencent/bk-bcs/bcs-common/common/task/locks/etcd"
 	etcdrevoker "github.com/Tencent/bk-bcs/bcs-common/common/task/revokers/etcd"
-	mysqlstore "github.com/Tencent/bk-bcs/bcs-common/common/task/stores/mysql"
 	itypes "github.com/Tencent/bk-bcs/bcs-common/common/task/types"
-	"github.com/samber/lo"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -97,12 +95,7 @@ func NewTaskMgr(ctx context.Context, etcdConfig cc.Etcd, dbConfig cc.Database) (
 	if err != nil {
 		return nil, err
 	}
-	dsn := lo.Ternary(dbConfig.Database != "", fmt.Sprintf("%s:%s@tcp(%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-		dbConfig.User, dbConfig.Password, dbConfig.Endpoints[0], dbConfig.Database), "")
-	store, err := mysqlstore.New(
-		dsn,
-		mysqlstore.WithDebug(true),
-	)
+	store, err := newStore(dbConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +112,7 @@ type ApiServerSetting struct {
 	FeatureFlags       FeatureFlags       `yaml:"featureFlags"`
 	ComponentRateLimit ComponentRateLimit `yaml:"componentRateLimit"`
 	CORSAllowedOrigins []string           `yaml:"corsAllowedOrigins"`
+	Tracing            Tracing            `yaml:"tracing"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -133,6 +134,7 @@ func (s *ApiServerSetting) trySetDefault() {
 	s.FeatureFlags.trySetDefault()
 	s.ComponentRateLimit.trySetDefault()
 	s.ApiGateway.trySetDefault()
+	s.Tracing.trySetDefault()
 }
 
 // Validate ApiServerSetting option.
@@ -158,6 +160,10 @@ func (s ApiServerSetting) Validate() error {
 		return err
 	}
 
+	if err := s.Tracing.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -168,6 +174,7 @@ type AuthServerSetting struct {
 	Log                LogOption          `yaml:"log"`
 	LoginAuth          LoginAuthSettings  `yaml:"loginAuth"`
 	IAM                IAM                `yaml:"iam"`
+	Authorization      Authorization      `yaml:"authorization"`
 	Esb                Esb                `yaml:"esb"`
 	ApiGateway         ApiGateway         `yaml:"apiGateway"`
 	FeatureFlags       FeatureFlags       `yaml:"featureFlags"`
@@ -180,6 +187,13 @@ type LoginAuthSettings struct {
 	InnerHost string `yaml:"innerHost"`
 	Provider  string `yaml:"provider"`
 	UseESB    bool   `yaml:"useEsb"`
+	// OIDC is only used when provider is OIDC.
+	OIDC OIDC `yaml:"oidc"`
+}
+
+// IsOIDC returns whether login with the generic oidc provider.
+func (l LoginAuthSettings) IsOIDC() bool {
+	return l.Provider == OIDCLoginProvider
 }
 
 // ApiGateway gateway conf
@@ -234,6 +248,8 @@ func (s *AuthServerSetting) trySetDefault() {
 	s.FeatureFlags.trySetDefault()
 	s.ComponentRateLimit.trySetDefault()
 	s.ApiGateway.trySetDefault()
+	s.Authorization.trySetDefault()
+	s.LoginAuth.OIDC.trySetDefault()
 }
 
 // Validate AuthServerSetting option.
@@ -247,10 +263,23 @@ func (s AuthServerSetting) Validate() error {
 		return err
 	}
 
-	if err := s.IAM.validate(); err != nil {
+	if err := s.Authorization.validate(); err != nil {
 		return err
 	}
 
+	if s.LoginAuth.IsOIDC() {
+		if err := s.LoginAuth.OIDC.validate(); err != nil {
+			return err
+		}
+	}
+
+	// iam is not required when the built-in rbac policy engine is used
+	if !s.Authorization.IsRBAC() {
+		if err := s.IAM.validate(); err != nil {
+			return err
+		}
+	}
+
 	if err := s.FeatureFlags.validate(); err != nil {
 		return err
 	}
@@ -272,6 +301,7 @@ type CacheServiceSetting struct {
 	Sharding     Sharding     `yaml:"sharding"`
 	RedisCluster RedisCluster `yaml:"redisCluster"`
 	Gorm         Gorm         `yaml:"gorm"`
+	Tracing      Tracing      `yaml:"tracing"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -292,6 +322,7 @@ func (s *CacheServiceSetting) trySetDefault() {
 	s.Sharding.trySetDefault()
 	s.RedisCluster.trySetDefault()
 	s.Gorm.trySetDefault()
+	s.Tracing.trySetDefault()
 }
 
 // Validate CacheServiceSetting option.
@@ -317,6 +348,10 @@ func (s CacheServiceSetting) Validate() error {
 		return err
 	}
 
+	if err := s.Tracing.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -329,6 +364,8 @@ type ConfigServerSetting struct {
 	Repo         Repository   `yaml:"repository"`
 	Esb          Esb          `yaml:"esb"`
 	FeatureFlags FeatureFlags `yaml:"featureFlags"`
+	HookSandbox  HookSandbox  `yaml:"hookSandbox"`
+	Tracing      Tracing      `yaml:"tracing"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -347,6 +384,8 @@ func (s *ConfigServerSetting) trySetDefault() {
 	s.Service.trySetDefault()
 	s.Log.trySetDefault()
 	s.FeatureFlags.trySetDefault()
+	s.HookSandbox.trySetDefault()
+	s.Tracing.trySetDefault()
 }
 
 // Validate ConfigServerSetting option.
@@ -372,6 +411,14 @@ func (s ConfigServerSetting) Validate() error {
 		return err
 	}
 
+	if err := s.HookSandbox.validate(); err != nil {
+		return err
+	}
+
+	if err := s.Tracing.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -395,6 +442,7 @@ type DataServiceSetting struct {
 	PushProvider       PushProvider       `yaml:"pushProvider"`
 	TaskFramework      TaskFramework      `yaml:"taskFramework"`
 	ComponentRateLimit ComponentRateLimit `yaml:"componentRateLimit"`
+	Tracing            Tracing            `yaml:"tracing"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -423,6 +471,7 @@ func (s *DataServiceSetting) trySetDefault() {
 	s.CMDB.trySetDefault()
 	s.TaskFramework.trySetDefault()
 	s.ComponentRateLimit.trySetDefault()
+	s.Tracing.trySetDefault()
 }
 
 // Validate DataServiceSetting option.
@@ -476,6 +525,10 @@ func (s DataServiceSetting) Validate() error {
 		return err
 	}
 
+	if err := s.Tracing.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -499,6 +552,10 @@ type FeedServerSetting struct {
 	ComponentRateLimit ComponentRateLimit  `yaml:"componentRateLimit"`
 	// VerifyAgentIDBelongs defines apps that can download across different businesses
 	VerifyAgentIDBelongs VerifyAgentIDBelongs `yaml:"verifyAgentIDBelongs"`
+	Tracing              Tracing              `yaml:"tracing"`
+	// AllowLegacyWatch allows the legacy sidecars to watch without the credential, the scopes of the
+	// credentials are not checked for them, it should only be enabled until all the sidecars are upgraded.
+	AllowLegacyWatch bool `yaml:"allowLegacyWatch"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -526,6 +583,7 @@ func (s *FeedServerSetting) trySetDefault() {
 	s.RateLimiter.trySetDefault()
 	s.VerifyAgentIDBelongs.trySetDefault()
 	s.ComponentRateLimit.trySetDefault()
+	s.Tracing.trySetDefault()
 }
 
 // Validate FeedServerSetting option.
@@ -575,6 +633,10 @@ func (s FeedServerSetting) Validate() error {
 		return err
 	}
 
+	if err := s.Tracing.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
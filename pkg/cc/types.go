@@ -17,6 +17,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"slices"
 	"strconv"
@@ -177,11 +178,14 @@ func (f *FeatureFlags) trySetDefault() {
 // Service defines Setting related runtime.
 type Service struct {
 	Etcd Etcd `yaml:"etcd"`
+	// Discovery defines the service discovery backend, etcd is used if not configured.
+	Discovery Discovery `yaml:"discovery"`
 }
 
 // trySetDefault set the Setting default value if user not configured.
 func (s *Service) trySetDefault() {
 	s.Etcd.trySetDefault()
+	s.Discovery.trySetDefault()
 }
 
 // validate Setting related runtime.
@@ -190,6 +194,178 @@ func (s Service) validate() error {
 		return err
 	}
 
+	if err := s.Discovery.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DiscoveryMode is the backend type of the service discovery.
+type DiscoveryMode string
+
+const (
+	// EtcdDiscoveryMode registers and resolves the services with etcd v3, the master is elected by etcd too.
+	EtcdDiscoveryMode DiscoveryMode = "etcd"
+	// StaticDiscoveryMode resolves the services with the configured address list.
+	StaticDiscoveryMode DiscoveryMode = "static"
+	// DNSDiscoveryMode resolves the services with dns, like SRV records or headless kubernetes services.
+	DNSDiscoveryMode DiscoveryMode = "dns"
+)
+
+// ElectionMode is the master election type of the service instances, which is used
+// when the discovery backend is not etcd.
+type ElectionMode string
+
+const (
+	// StandaloneElectionMode treats the service instance as master all the time,
+	// which is only suitable for the deployment with one replica of each service.
+	StandaloneElectionMode ElectionMode = "standalone"
+	// K8sLeaseElectionMode elects the master with the kubernetes coordination.k8s.io/v1 Lease.
+	K8sLeaseElectionMode ElectionMode = "k8s-lease"
+)
+
+// Discovery defines the service discovery related options.
+type Discovery struct {
+	Mode DiscoveryMode `yaml:"mode"`
+	// Static maps the service name to its grpc address list, like data-service: ["127.0.0.1:9511"].
+	Static map[Name][]string `yaml:"static"`
+	DNS    DNSDiscovery      `yaml:"dns"`
+	// Election defines how to elect the master instance when mode is static or dns.
+	Election Election `yaml:"election"`
+}
+
+// trySetDefault set the discovery default value if user not configured.
+func (d *Discovery) trySetDefault() {
+	if d.Mode == "" {
+		d.Mode = EtcdDiscoveryMode
+	}
+
+	if d.Mode == DNSDiscoveryMode {
+		d.DNS.trySetDefault()
+	}
+
+	if d.Mode != EtcdDiscoveryMode {
+		d.Election.trySetDefault()
+	}
+}
+
+// validate discovery options.
+func (d Discovery) validate() error {
+	switch d.Mode {
+	case EtcdDiscoveryMode:
+		return nil
+	case StaticDiscoveryMode:
+		for name, addrs := range d.Static {
+			for _, addr := range addrs {
+				if _, _, err := net.SplitHostPort(addr); err != nil {
+					return fmt.Errorf("invalid static discovery address %s of %s, err: %v", addr, name, err)
+				}
+			}
+		}
+	case DNSDiscoveryMode:
+		if err := d.DNS.validate(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported discovery mode %s", d.Mode)
+	}
+
+	return d.Election.validate()
+}
+
+// DNSDiscovery defines the dns service discovery options.
+type DNSDiscovery struct {
+	// Targets maps the service name to its dns name. the name with port, like
+	// bk-bscp-data-service-headless.bscp.svc.cluster.local:9511, is resolved with A/AAAA records,
+	// and the name without port, like _grpc._tcp.bk-bscp-data-service.bscp.svc.cluster.local,
+	// is resolved with SRV records.
+	Targets map[Name]string `yaml:"targets"`
+	// RefreshIntervalSec is the interval seconds to re-resolve the dns names.
+	RefreshIntervalSec uint `yaml:"refreshIntervalSec"`
+}
+
+// trySetDefault set the dns discovery default value if user not configured.
+func (d *DNSDiscovery) trySetDefault() {
+	if d.RefreshIntervalSec == 0 {
+		d.RefreshIntervalSec = 10
+	}
+}
+
+// validate dns discovery options.
+func (d DNSDiscovery) validate() error {
+	for name, target := range d.Targets {
+		if target == "" {
+			return fmt.Errorf("dns discovery target of %s is empty", name)
+		}
+	}
+
+	return nil
+}
+
+// Election defines the master election options.
+type Election struct {
+	Mode  ElectionMode `yaml:"mode"`
+	Lease K8sLease     `yaml:"lease"`
+}
+
+// trySetDefault set the election default value if user not configured.
+func (e *Election) trySetDefault() {
+	if e.Mode == "" {
+		e.Mode = StandaloneElectionMode
+	}
+
+	if e.Mode == K8sLeaseElectionMode {
+		e.Lease.trySetDefault()
+	}
+}
+
+// validate election options.
+func (e Election) validate() error {
+	switch e.Mode {
+	case StandaloneElectionMode:
+		return nil
+	case K8sLeaseElectionMode:
+		return e.Lease.validate()
+	default:
+		return fmt.Errorf("unsupported election mode %s", e.Mode)
+	}
+}
+
+// K8sLease defines the kubernetes lease election options, the lease is
+// named as <namePrefix>-<service name>, like bk-bscp-data-service.
+type K8sLease struct {
+	// Namespace of the lease, the pod's namespace is used if not configured.
+	Namespace  string `yaml:"namespace"`
+	NamePrefix string `yaml:"namePrefix"`
+	// DurationSec is the seconds the non-master instances wait before taking over the lease.
+	DurationSec uint `yaml:"durationSec"`
+	// RenewIntervalSec is the interval seconds to renew or acquire the lease.
+	RenewIntervalSec uint `yaml:"renewIntervalSec"`
+}
+
+// trySetDefault set the lease default value if user not configured.
+func (l *K8sLease) trySetDefault() {
+	if l.NamePrefix == "" {
+		l.NamePrefix = "bk-bscp"
+	}
+
+	if l.DurationSec == 0 {
+		l.DurationSec = 15
+	}
+
+	if l.RenewIntervalSec == 0 {
+		l.RenewIntervalSec = 5
+	}
+}
+
+// validate lease options.
+func (l K8sLease) validate() error {
+	if l.RenewIntervalSec >= l.DurationSec {
+		return fmt.Errorf("lease renewIntervalSec %d should be less than durationSec %d", l.RenewIntervalSec,
+			l.DurationSec)
+	}
+
 	return nil
 }
 
@@ -376,6 +552,110 @@ func (s IAM) validate() error {
 	return nil
 }
 
+const (
+	// AuthBackendIAM authorize the requests with BK-IAM.
+	AuthBackendIAM = "iam"
+	// AuthBackendRBAC authorize the requests with the built-in rbac policy engine, which does not depend on BK-IAM.
+	AuthBackendRBAC = "rbac"
+)
+
+// Authorization defines the authorization backend related runtime.
+type Authorization struct {
+	// Backend is the authorization backend, iam or rbac, default is iam.
+	Backend string `yaml:"backend"`
+	// Admins are the platform administrators who have all the permissions with rbac backend,
+	// they are used to create the initial roles and role bindings of bizs.
+	Admins []string `yaml:"admins"`
+}
+
+// trySetDefault set the authorization default value if user not configured.
+func (a *Authorization) trySetDefault() {
+	if a.Backend == "" {
+		a.Backend = AuthBackendIAM
+	}
+}
+
+// validate authorization runtime.
+func (a Authorization) validate() error {
+	switch a.Backend {
+	case AuthBackendIAM:
+	case AuthBackendRBAC:
+		if len(a.Admins) == 0 {
+			return errors.New("authorization admins should be set when rbac backend is used")
+		}
+	default:
+		return fmt.Errorf("unsupported authorization backend: %s", a.Backend)
+	}
+
+	return nil
+}
+
+// IsRBAC returns whether the built-in rbac policy engine is used.
+func (a Authorization) IsRBAC() bool {
+	return a.Backend == AuthBackendRBAC
+}
+
+// OIDCLoginProvider login with the generic oidc provider, such as keycloak and dex.
+const OIDCLoginProvider = "OIDC"
+
+// OIDC defines the generic oidc login provider settings, the user is logged in with the
+// authorization code flow with PKCE, and the id token is verified with the provider's jwks.
+type OIDC struct {
+	// Issuer is the issuer url of the provider, the discovery document is fetched from
+	// {issuer}/.well-known/openid-configuration.
+	Issuer       string `yaml:"issuer"`
+	ClientID     string `yaml:"clientID"`
+	ClientSecret string `yaml:"clientSecret"`
+	// RedirectURL is the absolute callback url of bscp ui, which must end with /oidc/callback.
+	RedirectURL string   `yaml:"redirectURL"`
+	Scopes      []string `yaml:"scopes"`
+	// UsernameClaim is the id token claim mapped to the username.
+	UsernameClaim string `yaml:"usernameClaim"`
+	// TenantClaim is the id token claim mapped to the tenant id, the default tenant is used if it is empty.
+	TenantClaim string `yaml:"tenantClaim"`
+	// CookieDomain is the domain of the login cookie, set it when ui and api are served with different sub domains.
+	CookieDomain string `yaml:"cookieDomain"`
+}
+
+// trySetDefault set the oidc default value if user not configured.
+func (o *OIDC) trySetDefault() {
+	if len(o.Scopes) == 0 {
+		o.Scopes = []string{"openid", "profile", "email"}
+	}
+
+	if o.UsernameClaim == "" {
+		o.UsernameClaim = "preferred_username"
+	}
+}
+
+// validate oidc options.
+func (o OIDC) validate() error {
+	if o.Issuer == "" {
+		return errors.New("loginAuth.oidc.issuer is not set")
+	}
+
+	if o.ClientID == "" {
+		return errors.New("loginAuth.oidc.clientID is not set")
+	}
+
+	u, err := url.Parse(o.RedirectURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid loginAuth.oidc.redirectURL: %s, must be absolute url", o.RedirectURL)
+	}
+
+	if !strings.HasSuffix(u.Path, "/oidc/callback") {
+		return fmt.Errorf("invalid loginAuth.oidc.redirectURL: %s, must end with /oidc/callback", o.RedirectURL)
+	}
+
+	for _, scope := range o.Scopes {
+		if scope == "openid" {
+			return nil
+		}
+	}
+
+	return errors.New("loginAuth.oidc.scopes must contain openid")
+}
+
 // StorageMode :
 type StorageMode string
 
@@ -618,8 +898,20 @@ type FeatureFlagOption struct {
 	List []string `yaml:"list"`
 }
 
+// DatabaseDriver is the dialect of the database.
+type DatabaseDriver string
+
+const (
+	// MySQLDriver is the mysql database, which is the default one.
+	MySQLDriver DatabaseDriver = "mysql"
+	// PostgresDriver is the postgresql database.
+	PostgresDriver DatabaseDriver = "postgres"
+)
+
 // Database defines database related runtime.
 type Database struct {
+	// Driver is the database dialect, one of mysql, postgres. default is mysql.
+	Driver DatabaseDriver `yaml:"driver"`
 	// Endpoints is a seed list of host:port addresses of database nodes.
 	Endpoints []string `yaml:"endpoints"`
 	Database  string   `yaml:"database"`
@@ -640,8 +932,16 @@ type Database struct {
 
 // trySetDefault set the database's default value if user not configured.
 func (ds *Database) trySetDefault() {
+	if ds.Driver == "" {
+		ds.Driver = MySQLDriver
+	}
+
 	if len(ds.Endpoints) == 0 {
-		ds.Endpoints = []string{"127.0.0.1:3306"}
+		if ds.Driver == PostgresDriver {
+			ds.Endpoints = []string{"127.0.0.1:5432"}
+		} else {
+			ds.Endpoints = []string{"127.0.0.1:3306"}
+		}
 	}
 
 	if ds.DialTimeoutSec == 0 {
@@ -671,6 +971,12 @@ func (ds *Database) trySetDefault() {
 
 // validate database runtime.
 func (ds Database) validate() error {
+	switch ds.Driver {
+	case MySQLDriver, PostgresDriver:
+	default:
+		return fmt.Errorf("unsupported database driver %s", ds.Driver)
+	}
+
 	if len(ds.Endpoints) == 0 {
 		return errors.New("database endpoints is not set")
 	}
@@ -1245,6 +1551,97 @@ type SyncCmdbGseConfig struct {
 	QpsLimit float64 `yaml:"qpsLimit"`
 }
 
+// ScanConfigDriftConfig defines scan config drift task configuration options.
+type ScanConfigDriftConfig struct {
+	// Enabled defines whether the scan config drift task is enabled
+	Enabled bool `yaml:"enabled"`
+	// Interval defines the interval for checking pushed config files on hosts
+	Interval string `yaml:"interval"`
+}
+
+// EvaluateClientAlertConfig defines evaluate client alert task configuration options.
+type EvaluateClientAlertConfig struct {
+	// Enabled defines whether the evaluate client alert task is enabled
+	Enabled bool `yaml:"enabled"`
+	// Interval defines the interval for evaluating client alert rules
+	Interval string `yaml:"interval"`
+}
+
+// RollupClientMetricConfig defines rollup client metric task configuration options.
+type RollupClientMetricConfig struct {
+	// Enabled defines whether the rollup client metric task is enabled
+	Enabled bool `yaml:"enabled"`
+	// Interval defines the interval for rolling up hourly client metrics into daily client metrics
+	Interval string `yaml:"interval"`
+	// HourlyRetentionDays defines how many days the hourly client metrics are kept
+	HourlyRetentionDays int `yaml:"hourlyRetentionDays"`
+	// DailyRetentionDays defines how many days the daily client metrics are kept
+	DailyRetentionDays int `yaml:"dailyRetentionDays"`
+}
+
+// RemindCredentialConfig defines remind credential task configuration options.
+type RemindCredentialConfig struct {
+	// Enabled defines whether the remind credential task is enabled
+	Enabled bool `yaml:"enabled"`
+	// Interval defines the interval for reminding the expiring and unused credentials
+	Interval string `yaml:"interval"`
+	// ExpiringDays defines the credentials which expire within the days are reminded
+	ExpiringDays int `yaml:"expiringDays"`
+	// UnusedDays defines the credentials which are not used for the days are reminded,
+	// it is also the retention days of the credential clients
+	UnusedDays int `yaml:"unusedDays"`
+	// WebhookURL defines the webhook which the reminders are sent to besides the credential owners, the
+	// loopback, private and link-local addresses are refused
+	WebhookURL string `yaml:"webhookURL"`
+}
+
+// SyncGitRepoConfig defines sync git repository task configuration options.
+type SyncGitRepoConfig struct {
+	// Enabled defines whether the sync git repository task is enabled
+	Enabled bool `yaml:"enabled"`
+	// Interval defines the interval for checking the git repository links which are due to sync,
+	// the sync interval of each link is set by the link itself
+	Interval string `yaml:"interval"`
+}
+
+// DeliverWebhookConfig defines deliver webhook task configuration options.
+type DeliverWebhookConfig struct {
+	// Enabled defines whether the deliver webhook task is enabled
+	Enabled bool `yaml:"enabled"`
+	// Interval defines the interval for checking the webhook deliveries which are due to send
+	Interval string `yaml:"interval"`
+	// BatchSize defines the max number of the deliveries which are sent in one round
+	BatchSize int `yaml:"batchSize"`
+	// Timeout defines the timeout of each http request to the webhook
+	Timeout string `yaml:"timeout"`
+	// MaxAttempts defines the max attempts of each delivery, the delivery is marked as failed after that
+	MaxAttempts int `yaml:"maxAttempts"`
+	// RetryBackoff defines the delay before the first retry, it doubles after each failed attempt
+	RetryBackoff string `yaml:"retryBackoff"`
+	// MaxRetryBackoff defines the max delay between the retries
+	MaxRetryBackoff string `yaml:"maxRetryBackoff"`
+}
+
+// ExpireApprovalConfig defines expire approval task configuration options.
+type ExpireApprovalConfig struct {
+	// Enabled defines whether the expire approval task is enabled
+	Enabled bool `yaml:"enabled"`
+	// Interval defines the interval for checking the builtin approval tickets which are timed out
+	Interval string `yaml:"interval"`
+	// BatchSize defines the max number of the tickets which are rejected in one round
+	BatchSize int `yaml:"batchSize"`
+}
+
+// ExpireGroupPinConfig defines expire group pin task configuration options.
+type ExpireGroupPinConfig struct {
+	// Enabled defines whether the expire group pin task is enabled
+	Enabled bool `yaml:"enabled"`
+	// Interval defines the interval for checking the group pins which are expired
+	Interval string `yaml:"interval"`
+	// BatchSize defines the max number of the group pins which are cleared in one round
+	BatchSize int `yaml:"batchSize"`
+}
+
 // CrontabConfig defines crontab task configuration options.
 type CrontabConfig struct {
 	// SyncBizHost defines sync business host task configuration
@@ -1260,6 +1657,22 @@ type CrontabConfig struct {
 	WatchCmdbResource WatchCmdbResourceConfig `yaml:"watchCmdbResource"`
 	// SyncCmdbGse defines sync cmdb and gse task configuration
 	SyncCmdbGse SyncCmdbGseConfig `yaml:"syncCmdbGse"`
+	// ScanConfigDrift defines scan config drift task configuration
+	ScanConfigDrift ScanConfigDriftConfig `yaml:"scanConfigDrift"`
+	// EvaluateClientAlert defines evaluate client alert task configuration
+	EvaluateClientAlert EvaluateClientAlertConfig `yaml:"evaluateClientAlert"`
+	// RollupClientMetric defines rollup client metric task configuration
+	RollupClientMetric RollupClientMetricConfig `yaml:"rollupClientMetric"`
+	// RemindCredential defines remind credential task configuration
+	RemindCredential RemindCredentialConfig `yaml:"remindCredential"`
+	// SyncGitRepo defines sync git repository task configuration
+	SyncGitRepo SyncGitRepoConfig `yaml:"syncGitRepo"`
+	// DeliverWebhook defines deliver webhook task configuration
+	DeliverWebhook DeliverWebhookConfig `yaml:"deliverWebhook"`
+	// ExpireApproval defines expire approval task configuration
+	ExpireApproval ExpireApprovalConfig `yaml:"expireApproval"`
+	// ExpireGroupPin defines expire group pin task configuration
+	ExpireGroupPin ExpireGroupPinConfig `yaml:"expireGroupPin"`
 }
 
 // validate if the sync biz host config is valid or not.
@@ -1322,6 +1735,143 @@ func (c WatchHostUpdatesConfig) validate() error {
 	return nil
 }
 
+// validate if the scan config drift config is valid or not.
+func (c ScanConfigDriftConfig) validate() error {
+	if c.Interval != "" {
+		if _, err := time.ParseDuration(c.Interval); err != nil {
+			return fmt.Errorf("invalid scanConfigDrift interval duration: %s", c.Interval)
+		}
+	}
+
+	return nil
+}
+
+// validate if the evaluate client alert config is valid or not.
+func (c EvaluateClientAlertConfig) validate() error {
+	if c.Interval != "" {
+		if _, err := time.ParseDuration(c.Interval); err != nil {
+			return fmt.Errorf("invalid evaluateClientAlert interval duration: %s", c.Interval)
+		}
+	}
+
+	return nil
+}
+
+// validate if the remind credential config is valid or not.
+func (c RemindCredentialConfig) validate() error {
+	if c.Interval != "" {
+		if _, err := time.ParseDuration(c.Interval); err != nil {
+			return fmt.Errorf("invalid remindCredential interval duration: %s", c.Interval)
+		}
+	}
+
+	if c.ExpiringDays < 0 {
+		return fmt.Errorf("invalid remindCredential expiringDays value: %d, should >= 0", c.ExpiringDays)
+	}
+
+	if c.UnusedDays < 0 {
+		return fmt.Errorf("invalid remindCredential unusedDays value: %d, should >= 0", c.UnusedDays)
+	}
+
+	if c.WebhookURL != "" {
+		if _, err := url.ParseRequestURI(c.WebhookURL); err != nil {
+			return fmt.Errorf("invalid remindCredential webhookURL: %s", c.WebhookURL)
+		}
+	}
+
+	return nil
+}
+
+// validate if the sync git repository config is valid or not.
+func (c SyncGitRepoConfig) validate() error {
+	if c.Interval != "" {
+		if _, err := time.ParseDuration(c.Interval); err != nil {
+			return fmt.Errorf("invalid syncGitRepo interval duration: %s", c.Interval)
+		}
+	}
+
+	return nil
+}
+
+// validate if the deliver webhook config is valid or not.
+func (c DeliverWebhookConfig) validate() error {
+	durations := map[string]string{
+		"interval":        c.Interval,
+		"timeout":         c.Timeout,
+		"retryBackoff":    c.RetryBackoff,
+		"maxRetryBackoff": c.MaxRetryBackoff,
+	}
+	for name, value := range durations {
+		if value == "" {
+			continue
+		}
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid deliverWebhook %s duration: %s", name, value)
+		}
+	}
+
+	if c.BatchSize < 0 {
+		return fmt.Errorf("invalid deliverWebhook batchSize value: %d, should >= 0", c.BatchSize)
+	}
+
+	if c.MaxAttempts < 0 {
+		return fmt.Errorf("invalid deliverWebhook maxAttempts value: %d, should >= 0", c.MaxAttempts)
+	}
+
+	return nil
+}
+
+// validate if the expire approval config is valid or not.
+func (c ExpireApprovalConfig) validate() error {
+	if c.Interval != "" {
+		if _, err := time.ParseDuration(c.Interval); err != nil {
+			return fmt.Errorf("invalid expireApproval interval duration: %s", c.Interval)
+		}
+	}
+
+	if c.BatchSize < 0 {
+		return fmt.Errorf("invalid expireApproval batchSize value: %d, should >= 0", c.BatchSize)
+	}
+
+	return nil
+}
+
+// validate if the expire group pin config is valid or not.
+func (c ExpireGroupPinConfig) validate() error {
+	if c.Interval != "" {
+		if _, err := time.ParseDuration(c.Interval); err != nil {
+			return fmt.Errorf("invalid expireGroupPin interval duration: %s", c.Interval)
+		}
+	}
+
+	if c.BatchSize < 0 {
+		return fmt.Errorf("invalid expireGroupPin batchSize value: %d, should >= 0", c.BatchSize)
+	}
+
+	return nil
+}
+
+// validate if the rollup client metric config is valid or not.
+func (c RollupClientMetricConfig) validate() error {
+	if c.Interval != "" {
+		if _, err := time.ParseDuration(c.Interval); err != nil {
+			return fmt.Errorf("invalid rollupClientMetric interval duration: %s", c.Interval)
+		}
+	}
+
+	if c.HourlyRetentionDays < 0 {
+		return fmt.Errorf("invalid rollupClientMetric hourlyRetentionDays value: %d, should >= 0",
+			c.HourlyRetentionDays)
+	}
+
+	if c.DailyRetentionDays < 0 {
+		return fmt.Errorf("invalid rollupClientMetric dailyRetentionDays value: %d, should >= 0",
+			c.DailyRetentionDays)
+	}
+
+	return nil
+}
+
 // validate if the crontab config is valid or not.
 func (c CrontabConfig) validate() error {
 	if err := c.SyncBizHost.validate(); err != nil {
@@ -1340,6 +1890,38 @@ func (c CrontabConfig) validate() error {
 		return err
 	}
 
+	if err := c.ScanConfigDrift.validate(); err != nil {
+		return err
+	}
+
+	if err := c.EvaluateClientAlert.validate(); err != nil {
+		return err
+	}
+
+	if err := c.RollupClientMetric.validate(); err != nil {
+		return err
+	}
+
+	if err := c.RemindCredential.validate(); err != nil {
+		return err
+	}
+
+	if err := c.SyncGitRepo.validate(); err != nil {
+		return err
+	}
+
+	if err := c.DeliverWebhook.validate(); err != nil {
+		return err
+	}
+
+	if err := c.ExpireApproval.validate(); err != nil {
+		return err
+	}
+
+	if err := c.ExpireGroupPin.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1398,6 +1980,106 @@ func (c *SyncCmdbGseConfig) trySetDefault() {
 	}
 }
 
+// trySetDefault try set the default value of scan config drift config
+func (c *ScanConfigDriftConfig) trySetDefault() {
+	if c.Interval == "" {
+		c.Interval = "24h" // 1 day
+	}
+}
+
+// trySetDefault try set the default value of evaluate client alert config
+func (c *EvaluateClientAlertConfig) trySetDefault() {
+	if c.Interval == "" {
+		c.Interval = "1m" // 1 minute
+	}
+}
+
+// trySetDefault try set the default value of rollup client metric config
+func (c *RollupClientMetricConfig) trySetDefault() {
+	if c.Interval == "" {
+		c.Interval = "1h" // 1 hour
+	}
+
+	if c.HourlyRetentionDays == 0 {
+		c.HourlyRetentionDays = 7 // 7 days
+	}
+
+	if c.DailyRetentionDays == 0 {
+		c.DailyRetentionDays = 180 // 180 days
+	}
+}
+
+// trySetDefault try set the default value of remind credential config
+func (c *RemindCredentialConfig) trySetDefault() {
+	if c.Interval == "" {
+		c.Interval = "24h" // 1 day
+	}
+
+	if c.ExpiringDays == 0 {
+		c.ExpiringDays = 7 // 7 days
+	}
+
+	if c.UnusedDays == 0 {
+		c.UnusedDays = 30 // 30 days
+	}
+}
+
+// trySetDefault try set the default value of sync git repository config
+func (c *SyncGitRepoConfig) trySetDefault() {
+	if c.Interval == "" {
+		c.Interval = "1m" // 1 minute
+	}
+}
+
+// trySetDefault try set the default value of deliver webhook config
+func (c *DeliverWebhookConfig) trySetDefault() {
+	if c.Interval == "" {
+		c.Interval = "10s" // 10 seconds
+	}
+
+	if c.BatchSize == 0 {
+		c.BatchSize = 100
+	}
+
+	if c.Timeout == "" {
+		c.Timeout = "10s" // 10 seconds
+	}
+
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = 8
+	}
+
+	if c.RetryBackoff == "" {
+		c.RetryBackoff = "30s" // 30 seconds
+	}
+
+	if c.MaxRetryBackoff == "" {
+		c.MaxRetryBackoff = "1h" // 1 hour
+	}
+}
+
+// trySetDefault try set the default value of expire approval config
+func (c *ExpireApprovalConfig) trySetDefault() {
+	if c.Interval == "" {
+		c.Interval = "1m" // 1 minute
+	}
+
+	if c.BatchSize == 0 {
+		c.BatchSize = 100
+	}
+}
+
+// trySetDefault try set the default value of expire group pin config
+func (c *ExpireGroupPinConfig) trySetDefault() {
+	if c.Interval == "" {
+		c.Interval = "1m" // 1 minute
+	}
+
+	if c.BatchSize == 0 {
+		c.BatchSize = 100
+	}
+}
+
 // trySetDefault try set the default value of crontab config
 func (c *CrontabConfig) trySetDefault() {
 	c.SyncBizHost.trySetDefault()
@@ -1405,6 +2087,14 @@ func (c *CrontabConfig) trySetDefault() {
 	c.WatchBizHostRelation.trySetDefault()
 	c.WatchHostUpdates.trySetDefault()
 	c.SyncCmdbGse.trySetDefault()
+	c.ScanConfigDrift.trySetDefault()
+	c.EvaluateClientAlert.trySetDefault()
+	c.RollupClientMetric.trySetDefault()
+	c.RemindCredential.trySetDefault()
+	c.SyncGitRepo.trySetDefault()
+	c.DeliverWebhook.trySetDefault()
+	c.ExpireApproval.trySetDefault()
+	c.ExpireGroupPin.trySetDefault()
 }
 
 // RateLimiter defines the rate limiter options for traffic control.
@@ -2327,3 +3017,139 @@ func (p PushProvider) ValidateBCSPPushManager() error {
 
 	return nil
 }
+
+// HookSandbox defines the sandbox options of running hook revisions in config server before they are published.
+type HookSandbox struct {
+	// Enabled defines whether the hook revisions can be tested in config server
+	Enabled bool `yaml:"enabled"`
+	// Timeout defines the max duration of a test run, default is 30s
+	Timeout string `yaml:"timeout"`
+	// MaxMemoryMB defines the max virtual memory of each process of a test run, default is 512
+	MaxMemoryMB uint64 `yaml:"maxMemoryMB"`
+	// MaxProcesses defines the max count of the processes of a test run, default is 64
+	MaxProcesses uint64 `yaml:"maxProcesses"`
+	// MaxFileSizeMB defines the max size of the files written by a test run, default is 10
+	MaxFileSizeMB uint64 `yaml:"maxFileSizeMB"`
+	// MaxOutputKB defines the max captured size of the stdout and stderr respectively, default is 64
+	MaxOutputKB int `yaml:"maxOutputKB"`
+	// WorkDir defines the parent directory of the temporary work directories, default is the system temp dir
+	WorkDir string `yaml:"workDir"`
+	// Interpreters defines the interpreter of the script types, key is shell, python, bat or powershell
+	Interpreters map[string]string `yaml:"interpreters"`
+}
+
+// trySetDefault set the hook sandbox default value if user not configured.
+func (h *HookSandbox) trySetDefault() {
+	if h.Timeout == "" {
+		h.Timeout = "30s"
+	}
+	if h.MaxMemoryMB == 0 {
+		h.MaxMemoryMB = 512
+	}
+	if h.MaxProcesses == 0 {
+		h.MaxProcesses = 64
+	}
+	if h.MaxFileSizeMB == 0 {
+		h.MaxFileSizeMB = 10
+	}
+	if h.MaxOutputKB == 0 {
+		h.MaxOutputKB = 64
+	}
+}
+
+// validate if the hook sandbox config is valid or not.
+func (h HookSandbox) validate() error {
+	if !h.Enabled {
+		return nil
+	}
+	timeout, err := time.ParseDuration(h.Timeout)
+	if err != nil || timeout <= 0 {
+		return fmt.Errorf("invalid hookSandbox timeout duration: %s", h.Timeout)
+	}
+	if h.MaxOutputKB < 0 {
+		return fmt.Errorf("invalid hookSandbox maxOutputKB value: %d, should >= 0", h.MaxOutputKB)
+	}
+	for typ := range h.Interpreters {
+		switch typ {
+		case "shell", "python", "bat", "powershell":
+		default:
+			return fmt.Errorf("invalid hookSandbox interpreter script type: %s", typ)
+		}
+	}
+	return nil
+}
+
+// TimeoutDuration returns the timeout of a test run.
+func (h HookSandbox) TimeoutDuration() time.Duration {
+	timeout, err := time.ParseDuration(h.Timeout)
+	if err != nil {
+		return 30 * time.Second
+	}
+	return timeout
+}
+
+// TracingExporter is the exporter type of the tracing spans.
+type TracingExporter string
+
+const (
+	// OTLPTracingExporter exports the spans to the otlp collector with grpc protocol.
+	OTLPTracingExporter TracingExporter = "otlp"
+	// FileTracingExporter writes the spans to the local file, which is used for local debugging.
+	FileTracingExporter TracingExporter = "file"
+	// StdoutTracingExporter writes the spans to the stdout, which is used for local debugging.
+	StdoutTracingExporter TracingExporter = "stdout"
+)
+
+// Tracing defines the opentelemetry tracing options.
+type Tracing struct {
+	Enabled  bool            `yaml:"enabled"`
+	Exporter TracingExporter `yaml:"exporter"`
+	// Endpoint is the address of the otlp collector, like 127.0.0.1:4317.
+	Endpoint string `yaml:"endpoint"`
+	// Insecure disables the tls of the connection to the otlp collector.
+	Insecure bool `yaml:"insecure"`
+	// Headers are sent with each export request to the otlp collector, like the auth token.
+	Headers map[string]string `yaml:"headers"`
+	// FilePath is the file the spans written to for the file exporter.
+	FilePath string `yaml:"filePath"`
+	// SampleRatio is the ratio of the root spans to be sampled, range in (0, 1].
+	SampleRatio float64 `yaml:"sampleRatio"`
+}
+
+// trySetDefault set the tracing's default value if user not configured.
+func (t *Tracing) trySetDefault() {
+	if t.Exporter == "" {
+		t.Exporter = OTLPTracingExporter
+	}
+
+	if t.Exporter == OTLPTracingExporter && t.Endpoint == "" {
+		t.Endpoint = "127.0.0.1:4317"
+	}
+
+	if t.Exporter == FileTracingExporter && t.FilePath == "" {
+		t.FilePath = "./traces.json"
+	}
+
+	if t.SampleRatio == 0 {
+		t.SampleRatio = 1
+	}
+}
+
+// validate tracing options.
+func (t Tracing) validate() error {
+	if !t.Enabled {
+		return nil
+	}
+
+	switch t.Exporter {
+	case OTLPTracingExporter, FileTracingExporter, StdoutTracingExporter:
+	default:
+		return fmt.Errorf("unsupported tracing exporter %s", t.Exporter)
+	}
+
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		return fmt.Errorf("tracing sampleRatio %v should be in range (0, 1]", t.SampleRatio)
+	}
+
+	return nil
+}
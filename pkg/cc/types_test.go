@@ -140,3 +140,73 @@ func TestFeatureFlagsValidate_DisabledTenantModeSkipsCheck(t *testing.T) {
 		t.Fatalf("expected no error when EnableTenantMode is false, got: %v", err)
 	}
 }
+
+func TestTracingTrySetDefault(t *testing.T) {
+	tr := Tracing{Enabled: true}
+	tr.trySetDefault()
+	if tr.Exporter != OTLPTracingExporter || tr.Endpoint != "127.0.0.1:4317" || tr.SampleRatio != 1 {
+		t.Fatalf("unexpected tracing defaults: %+v", tr)
+	}
+
+	tr = Tracing{Enabled: true, Exporter: FileTracingExporter}
+	tr.trySetDefault()
+	if tr.FilePath == "" || tr.Endpoint != "" {
+		t.Fatalf("unexpected file tracing defaults: %+v", tr)
+	}
+}
+
+func TestTracingValidate(t *testing.T) {
+	if err := (Tracing{Exporter: "unknown"}).validate(); err != nil {
+		t.Fatalf("expected no error when tracing is disabled, got: %v", err)
+	}
+	if err := (Tracing{Enabled: true, Exporter: "unknown", SampleRatio: 1}).validate(); err == nil {
+		t.Fatalf("expected error for unsupported exporter, got nil")
+	}
+	if err := (Tracing{Enabled: true, Exporter: StdoutTracingExporter, SampleRatio: 2}).validate(); err == nil {
+		t.Fatalf("expected error for invalid sample ratio, got nil")
+	}
+	if err := (Tracing{Enabled: true, Exporter: StdoutTracingExporter, SampleRatio: 0.5}).validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDiscoveryTrySetDefault(t *testing.T) {
+	d := Discovery{}
+	d.trySetDefault()
+	if d.Mode != EtcdDiscoveryMode || d.Election.Mode != "" {
+		t.Fatalf("unexpected discovery defaults: %+v", d)
+	}
+
+	d = Discovery{Mode: DNSDiscoveryMode, Election: Election{Mode: K8sLeaseElectionMode}}
+	d.trySetDefault()
+	if d.DNS.RefreshIntervalSec == 0 || d.Election.Lease.NamePrefix != "bk-bscp" ||
+		d.Election.Lease.DurationSec <= d.Election.Lease.RenewIntervalSec {
+		t.Fatalf("unexpected dns discovery defaults: %+v", d)
+	}
+
+	d = Discovery{Mode: StaticDiscoveryMode}
+	d.trySetDefault()
+	if d.Election.Mode != StandaloneElectionMode {
+		t.Fatalf("unexpected static discovery election default: %+v", d.Election)
+	}
+}
+
+func TestDiscoveryValidate(t *testing.T) {
+	if err := (Discovery{Mode: "zk"}).validate(); err == nil {
+		t.Fatalf("expected error for unsupported discovery mode, got nil")
+	}
+	static := Discovery{Mode: StaticDiscoveryMode, Election: Election{Mode: StandaloneElectionMode},
+		Static: map[Name][]string{DataServiceName: {"127.0.0.1"}}}
+	if err := static.validate(); err == nil {
+		t.Fatalf("expected error for static address without port, got nil")
+	}
+	static.Static[DataServiceName] = []string{"127.0.0.1:9511"}
+	if err := static.validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lease := Discovery{Mode: DNSDiscoveryMode, Election: Election{Mode: K8sLeaseElectionMode,
+		Lease: K8sLease{DurationSec: 5, RenewIntervalSec: 5}}}
+	if err := lease.validate(); err == nil {
+		t.Fatalf("expected error for lease renew interval not less than duration, got nil")
+	}
+}
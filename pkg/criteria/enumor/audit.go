@@ -47,6 +47,12 @@ const (
 	ConfigTemplate AuditResourceType = "config_template"
 	// ConfigInstance 配置实例
 	ConfigInstance AuditResourceType = "config_instance"
+	// ChangeFreeze 变更冻结窗口
+	ChangeFreeze AuditResourceType = "change_freeze"
+	// Webhook 事件订阅
+	Webhook AuditResourceType = "webhook"
+	// ApprovalFlow 内置审批流程
+	ApprovalFlow AuditResourceType = "approval_flow"
 )
 
 // AuditAction audit action type.
@@ -61,6 +67,10 @@ const (
 	Delete AuditAction = "delete"
 	// Publish 发布
 	Publish AuditAction = "publish"
+	// BreakGlass 在变更冻结窗口内强制变更
+	BreakGlass AuditAction = "break_glass"
+	// Rollback 回滚版本
+	Rollback AuditAction = "rollback"
 )
 
 // AuditStatus audit status.
@@ -116,6 +116,11 @@ const (
 const (
 	// AppNotExists means the app is not exist.
 	AppNotExists int32 = 11000
+	// ChangeFrozen means the change is rejected by the change freeze window.
+	ChangeFrozen int32 = 11001
+	// ReleaseConstraintNotSatisfied means the release matched by the client does not satisfy the release
+	// constraint declared by the client, the client should keep its current release.
+	ReleaseConstraintNotSatisfied int32 = 11002
 )
 
 var (
@@ -158,7 +163,9 @@ var (
 		NotImplemented:     "NOT_IMPLEMENTED",
 
 		// bscp专用错误码
-		AppNotExists: "APP_NOT_EXISTS",
+		AppNotExists:                  "APP_NOT_EXISTS",
+		ChangeFrozen:                  "CHANGE_FROZEN",
+		ReleaseConstraintNotSatisfied: "RELEASE_CONSTRAINT_NOT_SATISFIED",
 	}
 
 	// BscpStatusMap bscp错误码->状态映射
@@ -200,6 +207,8 @@ var (
 		NotImplemented:     http.StatusNotImplemented,
 
 		// bscp专用错误码
-		AppNotExists: http.StatusNotFound,
+		AppNotExists:                  http.StatusNotFound,
+		ChangeFrozen:                  http.StatusConflict,
+		ReleaseConstraintNotSatisfied: http.StatusPreconditionFailed,
 	}
 )
@@ -37,6 +37,10 @@ var (
 	// ErrAppInstanceNotMatchedRelease is error when the app instance can not match any release.
 	ErrAppInstanceNotMatchedRelease = errors.New("this app instance can not match any release")
 
+	// ErrReleaseConstraintNotSatisfied is error when the matched release does not satisfy the release constraint
+	// declared by the app instance.
+	ErrReleaseConstraintNotSatisfied = errors.New("the matched release does not satisfy the release constraint")
+
 	// ErrFileContentNotFound is error when the file content not found in file provider.
 	ErrFileContentNotFound = errors.New("file content not found")
 )
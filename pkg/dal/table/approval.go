@@ -0,0 +1,435 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+)
+
+const (
+	// maxApprovalStages is the max number of the stages of an approval flow.
+	maxApprovalStages = 10
+	// maxApprovalStageApprovers is the max number of the approvers of an approval stage.
+	maxApprovalStageApprovers = 50
+)
+
+// ApprovalFlow is the builtin approval flow of the app, the publishing of the app is approved by the
+// builtin approval engine instead of ITSM when the flow is enabled.
+type ApprovalFlow struct {
+	ID         uint32                  `json:"id" gorm:"primaryKey"`
+	Spec       *ApprovalFlowSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ApprovalFlowAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision               `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the approval flow's database table name.
+func (f *ApprovalFlow) TableName() string {
+	return "approval_flows"
+}
+
+// AppID AuditRes interface
+func (f *ApprovalFlow) AppID() uint32 {
+	return f.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (f *ApprovalFlow) ResID() uint32 {
+	return f.ID
+}
+
+// ResType AuditRes interface
+func (f *ApprovalFlow) ResType() string {
+	return string(enumor.ApprovalFlow)
+}
+
+// ValidateCreate validate approval flow is valid or not when create it.
+func (f *ApprovalFlow) ValidateCreate() error {
+	if f.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if f.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	if err := f.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if f.Attachment == nil || f.Attachment.BizID <= 0 || f.Attachment.AppID <= 0 {
+		return errors.New("invalid attachment, biz id and app id should be set")
+	}
+
+	if f.Revision == nil || f.Revision.Creator == "" {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate approval flow is valid or not when update it.
+func (f *ApprovalFlow) ValidateUpdate() error {
+	if f.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if f.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	if err := f.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if f.Attachment == nil || f.Attachment.BizID <= 0 || f.Attachment.AppID <= 0 {
+		return errors.New("invalid attachment, biz id and app id should be set")
+	}
+
+	if f.Revision == nil || f.Revision.Reviser == "" {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
+
+// ApprovalFlowSpec defines all the specifics for approval flow set by user.
+type ApprovalFlowSpec struct {
+	Enabled bool           `json:"enabled" gorm:"column:enabled"`
+	Stages  ApprovalStages `json:"stages" gorm:"column:stages;type:json;default:'[]'"`
+	Memo    string         `json:"memo" gorm:"column:memo"`
+}
+
+// Validate the approval flow spec is valid or not.
+func (s *ApprovalFlowSpec) Validate() error {
+	return s.Stages.Validate()
+}
+
+// ApprovalFlowAttachment defines the approval flow attachments.
+type ApprovalFlowAttachment struct {
+	BizID    uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID    uint32 `json:"app_id" gorm:"column:app_id"`
+	TenantID string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// ApprovalStages is the ordered stages of the approval flow, the stages are approved one by one.
+type ApprovalStages []*ApprovalStage
+
+// Validate the approval stages are valid or not.
+func (s ApprovalStages) Validate() error {
+	if len(s) == 0 {
+		return errors.New("at least one approval stage is required")
+	}
+
+	if len(s) > maxApprovalStages {
+		return fmt.Errorf("the number of approval stages exceeds the limit %d", maxApprovalStages)
+	}
+
+	for idx, one := range s {
+		if one == nil {
+			return fmt.Errorf("approval stage %d is nil", idx+1)
+		}
+		if err := one.Validate(); err != nil {
+			return fmt.Errorf("approval stage %d is invalid, %v", idx+1, err)
+		}
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+func (s ApprovalStages) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface
+func (s *ApprovalStages) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return fmt.Errorf("unsupported approval stages type: %T", value)
+	}
+}
+
+// ApprovalStage is one stage of the approval flow.
+type ApprovalStage struct {
+	Name      string   `json:"name"`
+	Approvers []string `json:"approvers"`
+	// Quorum is the number of the approvals required to pass the stage, 0 means all the approvers
+	// are required.
+	Quorum uint32 `json:"quorum"`
+	// TimeoutMinutes is the time limit of the stage, the ticket is rejected automatically if the stage
+	// is not finished in time, 0 means no limit.
+	TimeoutMinutes uint32 `json:"timeout_minutes"`
+}
+
+// Validate the approval stage is valid or not.
+func (s *ApprovalStage) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	if len(s.Approvers) == 0 {
+		return errors.New("at least one approver is required")
+	}
+
+	if len(s.Approvers) > maxApprovalStageApprovers {
+		return fmt.Errorf("the number of approvers exceeds the limit %d", maxApprovalStageApprovers)
+	}
+
+	exists := make(map[string]bool, len(s.Approvers))
+	for _, one := range s.Approvers {
+		if strings.TrimSpace(one) == "" {
+			return errors.New("approver can not be empty")
+		}
+		if exists[one] {
+			return fmt.Errorf("approver %s is duplicated", one)
+		}
+		exists[one] = true
+	}
+
+	if int(s.Quorum) > len(s.Approvers) {
+		return fmt.Errorf("quorum %d exceeds the number of approvers %d", s.Quorum, len(s.Approvers))
+	}
+
+	return nil
+}
+
+// RequiredApprovals returns the number of the approvals required to pass the stage.
+func (s *ApprovalStage) RequiredApprovals() int {
+	if s.Quorum == 0 {
+		return len(s.Approvers)
+	}
+
+	return int(s.Quorum)
+}
+
+// Deadline returns the deadline of the stage which is started at the start time, nil means no limit.
+func (s *ApprovalStage) Deadline(start time.Time) *time.Time {
+	if s.TimeoutMinutes == 0 {
+		return nil
+	}
+
+	deadline := start.Add(time.Duration(s.TimeoutMinutes) * time.Minute)
+	return &deadline
+}
+
+// EffectiveApprovers returns the approvers of the stage after the delegations of the stage are applied,
+// the delegator is replaced by the delegatee in place.
+func (s *ApprovalStage) EffectiveApprovers(stage uint32, actions []*ApprovalAction) []string {
+	approvers := make([]string, len(s.Approvers))
+	copy(approvers, s.Approvers)
+
+	for _, one := range actions {
+		if one.Spec.Stage != stage || one.Spec.Action != ApprovalActionDelegate {
+			continue
+		}
+		for idx := range approvers {
+			if approvers[idx] == one.Revision.Creator {
+				approvers[idx] = one.Spec.DelegateTo
+				break
+			}
+		}
+	}
+
+	return approvers
+}
+
+// Evaluate the stage with the actions of the ticket, returns the status of the stage and the approvers
+// who have not voted yet. The stage is rejected once the quorum can not be reached any more.
+func (s *ApprovalStage) Evaluate(stage uint32, actions []*ApprovalAction) (ApprovalTicketStatus, []string) {
+	approvers := s.EffectiveApprovers(stage, actions)
+	votes := make(map[string]ApprovalActionType)
+	for _, one := range actions {
+		if one.Spec.Stage != stage {
+			continue
+		}
+		if one.Spec.Action == ApprovalActionApprove || one.Spec.Action == ApprovalActionReject {
+			votes[one.Revision.Creator] = one.Spec.Action
+		}
+	}
+
+	approved, rejected := 0, 0
+	remaining := make([]string, 0)
+	for _, one := range approvers {
+		switch votes[one] {
+		case ApprovalActionApprove:
+			approved++
+		case ApprovalActionReject:
+			rejected++
+		default:
+			remaining = append(remaining, one)
+		}
+	}
+
+	required := s.RequiredApprovals()
+	switch {
+	case approved >= required:
+		return ApprovalTicketPassed, remaining
+	case len(approvers)-rejected < required:
+		return ApprovalTicketRejected, remaining
+	default:
+		return ApprovalTicketPending, remaining
+	}
+}
+
+// ApprovalTicketStatus is the status of the builtin approval ticket.
+type ApprovalTicketStatus string
+
+const (
+	// ApprovalTicketPending the ticket is waiting for the approvers of the current stage.
+	ApprovalTicketPending ApprovalTicketStatus = "pending"
+	// ApprovalTicketPassed all the stages of the ticket are passed.
+	ApprovalTicketPassed ApprovalTicketStatus = "passed"
+	// ApprovalTicketRejected the ticket is rejected by the approvers or timed out.
+	ApprovalTicketRejected ApprovalTicketStatus = "rejected"
+	// ApprovalTicketRevoked the ticket is revoked by the submitter.
+	ApprovalTicketRevoked ApprovalTicketStatus = "revoked"
+)
+
+// ApprovalTicket is the builtin approval ticket of a publish strategy, the stages of the flow are
+// snapshotted when the ticket is submitted.
+type ApprovalTicket struct {
+	ID         uint32                    `json:"id" gorm:"primaryKey"`
+	Spec       *ApprovalTicketSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ApprovalTicketAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                 `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the approval ticket's database table name.
+func (t *ApprovalTicket) TableName() string {
+	return "approval_tickets"
+}
+
+// ValidateCreate validate approval ticket is valid or not when create it.
+func (t *ApprovalTicket) ValidateCreate() error {
+	if t.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if t.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	if err := t.Spec.Stages.Validate(); err != nil {
+		return err
+	}
+
+	if t.Attachment == nil || t.Attachment.BizID <= 0 || t.Attachment.AppID <= 0 ||
+		t.Attachment.StrategyID <= 0 {
+		return errors.New("invalid attachment, biz id, app id and strategy id should be set")
+	}
+
+	if t.Revision == nil || t.Revision.Creator == "" {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// CurrentStage returns the stage which is waiting for approval.
+func (t *ApprovalTicket) CurrentStage() *ApprovalStage {
+	if int(t.Spec.CurrentStage) >= len(t.Spec.Stages) {
+		return nil
+	}
+
+	return t.Spec.Stages[t.Spec.CurrentStage]
+}
+
+// ApprovalTicketSpec defines all the specifics for approval ticket.
+type ApprovalTicketSpec struct {
+	Stages ApprovalStages `json:"stages" gorm:"column:stages;type:json;default:'[]'"`
+	// CurrentStage is the index of the stage which is waiting for approval.
+	CurrentStage  uint32               `json:"current_stage" gorm:"column:current_stage"`
+	Status        ApprovalTicketStatus `json:"status" gorm:"column:status"`
+	StageDeadline *time.Time           `json:"stage_deadline" gorm:"column:stage_deadline"`
+}
+
+// ApprovalTicketAttachment defines the approval ticket attachments.
+type ApprovalTicketAttachment struct {
+	BizID      uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID      uint32 `json:"app_id" gorm:"column:app_id"`
+	ReleaseID  uint32 `json:"release_id" gorm:"column:release_id"`
+	StrategyID uint32 `json:"strategy_id" gorm:"column:strategy_id"`
+	TenantID   string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// ApprovalActionType is the type of the action on the approval ticket.
+type ApprovalActionType string
+
+const (
+	// ApprovalActionApprove the approver approves the current stage.
+	ApprovalActionApprove ApprovalActionType = "approve"
+	// ApprovalActionReject the approver rejects the current stage.
+	ApprovalActionReject ApprovalActionType = "reject"
+	// ApprovalActionDelegate the approver delegates the approval of the current stage to another user.
+	ApprovalActionDelegate ApprovalActionType = "delegate"
+	// ApprovalActionComment the user comments on the ticket.
+	ApprovalActionComment ApprovalActionType = "comment"
+	// ApprovalActionTimeout the current stage is timed out and the ticket is rejected automatically.
+	ApprovalActionTimeout ApprovalActionType = "timeout"
+	// ApprovalActionRevoke the submitter revokes the ticket.
+	ApprovalActionRevoke ApprovalActionType = "revoke"
+)
+
+// ApprovalAction is the action log of the approval ticket, includes the votes, delegations and comments.
+type ApprovalAction struct {
+	ID         uint32                    `json:"id" gorm:"primaryKey"`
+	Spec       *ApprovalActionSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ApprovalActionAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *CreatedRevision          `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the approval action's database table name.
+func (a *ApprovalAction) TableName() string {
+	return "approval_actions"
+}
+
+// ApprovalActionSpec defines all the specifics for approval action.
+type ApprovalActionSpec struct {
+	// Stage is the index of the stage which the action happens in.
+	Stage      uint32             `json:"stage" gorm:"column:stage"`
+	Action     ApprovalActionType `json:"action" gorm:"column:action"`
+	DelegateTo string             `json:"delegate_to" gorm:"column:delegate_to"`
+	Comment    string             `json:"comment" gorm:"column:comment"`
+	// Voter is the approver of the approve or reject action, it is nil for the other actions, an approver
+	// can only vote once in a stage as it is unique with the ticket and stage.
+	Voter *string `json:"voter,omitempty" gorm:"column:voter"`
+}
+
+// ApprovalActionAttachment defines the approval action attachments.
+type ApprovalActionAttachment struct {
+	BizID    uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID    uint32 `json:"app_id" gorm:"column:app_id"`
+	TicketID uint32 `json:"ticket_id" gorm:"column:ticket_id"`
+	TenantID string `json:"tenant_id" gorm:"column:tenant_id"`
+}
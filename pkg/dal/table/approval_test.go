@@ -0,0 +1,115 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApprovalStagesValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		stages ApprovalStages
+		valid  bool
+	}{
+		{"valid", ApprovalStages{{Name: "dev", Approvers: []string{"a", "b"}, Quorum: 1}}, true},
+		{"no stage", ApprovalStages{}, false},
+		{"empty name", ApprovalStages{{Approvers: []string{"a"}}}, false},
+		{"no approver", ApprovalStages{{Name: "dev"}}, false},
+		{"duplicated approver", ApprovalStages{{Name: "dev", Approvers: []string{"a", "a"}}}, false},
+		{"quorum exceeds", ApprovalStages{{Name: "dev", Approvers: []string{"a"}, Quorum: 2}}, false},
+	}
+
+	for _, c := range cases {
+		if err := c.stages.Validate(); (err == nil) != c.valid {
+			t.Errorf("%s: expect valid %v, got err: %v", c.name, c.valid, err)
+		}
+	}
+}
+
+func newApprovalAction(stage uint32, action ApprovalActionType, user, delegateTo string) *ApprovalAction {
+	return &ApprovalAction{
+		Spec:     &ApprovalActionSpec{Stage: stage, Action: action, DelegateTo: delegateTo},
+		Revision: &CreatedRevision{Creator: user},
+	}
+}
+
+func TestApprovalStageEvaluate(t *testing.T) {
+	all := &ApprovalStage{Name: "all", Approvers: []string{"a", "b", "c"}}
+	quorum := &ApprovalStage{Name: "quorum", Approvers: []string{"a", "b", "c"}, Quorum: 2}
+
+	cases := []struct {
+		name      string
+		stage     *ApprovalStage
+		actions   []*ApprovalAction
+		status    ApprovalTicketStatus
+		remaining []string
+	}{
+		{"all pending", all, []*ApprovalAction{newApprovalAction(0, ApprovalActionApprove, "a", "")},
+			ApprovalTicketPending, []string{"b", "c"}},
+		{"all passed", all, []*ApprovalAction{
+			newApprovalAction(0, ApprovalActionApprove, "a", ""),
+			newApprovalAction(0, ApprovalActionApprove, "b", ""),
+			newApprovalAction(0, ApprovalActionApprove, "c", ""),
+		}, ApprovalTicketPassed, []string{}},
+		{"all rejected by one", all, []*ApprovalAction{newApprovalAction(0, ApprovalActionReject, "b", "")},
+			ApprovalTicketRejected, []string{"a", "c"}},
+		{"quorum reached", quorum, []*ApprovalAction{
+			newApprovalAction(0, ApprovalActionApprove, "a", ""),
+			newApprovalAction(0, ApprovalActionReject, "b", ""),
+			newApprovalAction(0, ApprovalActionApprove, "c", ""),
+		}, ApprovalTicketPassed, []string{}},
+		{"quorum still reachable", quorum, []*ApprovalAction{newApprovalAction(0, ApprovalActionReject, "a", "")},
+			ApprovalTicketPending, []string{"b", "c"}},
+		{"quorum unreachable", quorum, []*ApprovalAction{
+			newApprovalAction(0, ApprovalActionReject, "a", ""),
+			newApprovalAction(0, ApprovalActionReject, "b", ""),
+		}, ApprovalTicketRejected, []string{"c"}},
+		{"delegated vote", all, []*ApprovalAction{
+			newApprovalAction(0, ApprovalActionDelegate, "a", "d"),
+			newApprovalAction(0, ApprovalActionApprove, "d", ""),
+			newApprovalAction(0, ApprovalActionApprove, "b", ""),
+		}, ApprovalTicketPending, []string{"c"}},
+		{"votes of other stage ignored", all, []*ApprovalAction{
+			newApprovalAction(1, ApprovalActionReject, "a", ""),
+		}, ApprovalTicketPending, []string{"a", "b", "c"}},
+	}
+
+	for _, c := range cases {
+		status, remaining := c.stage.Evaluate(0, c.actions)
+		if status != c.status {
+			t.Errorf("%s: expect status %s, got %s", c.name, c.status, status)
+		}
+		if !reflect.DeepEqual(remaining, c.remaining) {
+			t.Errorf("%s: expect remaining %v, got %v", c.name, c.remaining, remaining)
+		}
+	}
+}
+
+func TestApprovalStageEffectiveApprovers(t *testing.T) {
+	stage := &ApprovalStage{Name: "dev", Approvers: []string{"a", "b"}}
+	actions := []*ApprovalAction{
+		newApprovalAction(0, ApprovalActionDelegate, "a", "c"),
+		newApprovalAction(0, ApprovalActionDelegate, "c", "d"),
+		newApprovalAction(1, ApprovalActionDelegate, "b", "e"),
+	}
+
+	got := stage.EffectiveApprovers(0, actions)
+	if !reflect.DeepEqual(got, []string{"d", "b"}) {
+		t.Errorf("expect effective approvers [d b], got %v", got)
+	}
+	if !reflect.DeepEqual(stage.Approvers, []string{"a", "b"}) {
+		t.Errorf("approvers of the stage should not be changed, got %v", stage.Approvers)
+	}
+}
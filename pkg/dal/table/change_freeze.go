@@ -0,0 +1,292 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/types"
+)
+
+// ChangeFreezeKind is the kind of change freeze window.
+type ChangeFreezeKind string
+
+const (
+	// ChangeFreezeRecurring the window recurs every week on the weekdays between start time and end time.
+	ChangeFreezeRecurring ChangeFreezeKind = "recurring"
+	// ChangeFreezeOnce the window takes effect once between start at and end at, e.g. a holiday.
+	ChangeFreezeOnce ChangeFreezeKind = "once"
+)
+
+// ChangeFreezeDefaultTimezone is the timezone used when the window's timezone is not set.
+const ChangeFreezeDefaultTimezone = "UTC"
+
+// ChangeFreeze defines a window during which the write operations and publishing of the biz or
+// the app are frozen, only the break-glass users can override it.
+type ChangeFreeze struct {
+	ID         uint32                  `json:"id" gorm:"primaryKey"`
+	Spec       *ChangeFreezeSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ChangeFreezeAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision               `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the change freeze window's database table name.
+func (c *ChangeFreeze) TableName() string {
+	return "change_freezes"
+}
+
+// AppID AuditRes interface
+func (c *ChangeFreeze) AppID() uint32 {
+	return c.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (c *ChangeFreeze) ResID() uint32 {
+	return c.ID
+}
+
+// ResType AuditRes interface
+func (c *ChangeFreeze) ResType() string {
+	return string(enumor.ChangeFreeze)
+}
+
+// ValidateCreate validate change freeze window is valid or not when create it.
+func (c *ChangeFreeze) ValidateCreate() error {
+	if c.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if c.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	if err := c.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if c.Attachment == nil || c.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment, biz id should be set")
+	}
+
+	if c.Revision == nil || c.Revision.Creator == "" {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate change freeze window is valid or not when update it.
+func (c *ChangeFreeze) ValidateUpdate() error {
+	if c.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if c.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	if err := c.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if c.Attachment == nil || c.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment, biz id should be set")
+	}
+
+	if c.Revision == nil || c.Revision.Reviser == "" {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
+
+// Covers returns whether the window covers the app, the window of the whole biz covers all the apps.
+func (c *ChangeFreeze) Covers(appID uint32) bool {
+	return c.Attachment.AppID == 0 || c.Attachment.AppID == appID
+}
+
+// ChangeFreezeSpec defines all the specifics for change freeze window set by user.
+type ChangeFreezeSpec struct {
+	Name string           `json:"name" gorm:"column:name"`
+	Kind ChangeFreezeKind `json:"kind" gorm:"column:kind"`
+	// Timezone is the IANA timezone name which the weekdays and times are in, default is UTC.
+	Timezone string `json:"timezone" gorm:"column:timezone"`
+	// Weekdays of the recurring window, 0 is Sunday, empty means every day.
+	Weekdays types.Uint32Slice `json:"weekdays" gorm:"column:weekdays;type:json;default:'[]'"`
+	// StartTime and EndTime of the recurring window in HH:MM, the window crosses midnight if the end time
+	// is before the start time, and lasts the whole day if they are equal.
+	StartTime string `json:"start_time" gorm:"column:start_time"`
+	EndTime   string `json:"end_time" gorm:"column:end_time"`
+	// StartAt and EndAt of the once window.
+	StartAt *time.Time `json:"start_at" gorm:"column:start_at"`
+	EndAt   *time.Time `json:"end_at" gorm:"column:end_at"`
+	// BreakGlassUsers are the users who can still write and publish during the window, every override is
+	// recorded in the audits.
+	BreakGlassUsers types.StringSlice `json:"break_glass_users" gorm:"column:break_glass_users;type:json;default:'[]'"`
+	Enabled         bool              `json:"enabled" gorm:"column:enabled"`
+	Memo            string            `json:"memo" gorm:"column:memo"`
+}
+
+// Validate the change freeze window spec is valid or not.
+func (s *ChangeFreezeSpec) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	if _, err := s.Location(); err != nil {
+		return fmt.Errorf("invalid timezone %s, err: %v", s.Timezone, err)
+	}
+
+	switch s.Kind {
+	case ChangeFreezeRecurring:
+		for _, day := range s.Weekdays {
+			if day > 6 {
+				return fmt.Errorf("invalid weekday %d, it should be 0-6 and 0 is Sunday", day)
+			}
+		}
+		if _, err := parseClock(s.StartTime); err != nil {
+			return fmt.Errorf("invalid start time %s, it should be HH:MM", s.StartTime)
+		}
+		if _, err := parseClock(s.EndTime); err != nil {
+			return fmt.Errorf("invalid end time %s, it should be HH:MM", s.EndTime)
+		}
+	case ChangeFreezeOnce:
+		if s.StartAt == nil || s.EndAt == nil || !s.EndAt.After(*s.StartAt) {
+			return errors.New("start at and end at are required, and end at should be after start at")
+		}
+	default:
+		return fmt.Errorf("invalid kind %s, it should be %s or %s", s.Kind, ChangeFreezeRecurring, ChangeFreezeOnce)
+	}
+
+	for _, user := range s.BreakGlassUsers {
+		if strings.TrimSpace(user) == "" {
+			return errors.New("break glass user can not be empty")
+		}
+	}
+
+	return nil
+}
+
+// Location returns the location of the window's timezone.
+func (s *ChangeFreezeSpec) Location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.LoadLocation(ChangeFreezeDefaultTimezone)
+	}
+
+	return time.LoadLocation(s.Timezone)
+}
+
+// ActiveAt returns whether the window is in effect at the time.
+func (s *ChangeFreezeSpec) ActiveAt(now time.Time) bool {
+	if !s.Enabled {
+		return false
+	}
+
+	if s.Kind == ChangeFreezeOnce {
+		return s.StartAt != nil && s.EndAt != nil && !now.Before(*s.StartAt) && now.Before(*s.EndAt)
+	}
+
+	loc, err := s.Location()
+	if err != nil {
+		return false
+	}
+	start, err := parseClock(s.StartTime)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(s.EndTime)
+	if err != nil {
+		return false
+	}
+
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	today := s.onWeekday(local.Weekday())
+	switch {
+	case start == end:
+		return today
+	case start < end:
+		return today && minute >= start && minute < end
+	default:
+		// the window crosses midnight, it starts on the weekday and ends on the next day
+		yesterday := s.onWeekday((local.Weekday() + 6) % 7)
+		return (today && minute >= start) || (yesterday && minute < end)
+	}
+}
+
+// CanBreakGlass returns whether the user can override the window.
+func (s *ChangeFreezeSpec) CanBreakGlass(user string) bool {
+	for _, one := range s.BreakGlassUsers {
+		if one == user {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *ChangeFreezeSpec) onWeekday(day time.Weekday) bool {
+	if len(s.Weekdays) == 0 {
+		return true
+	}
+
+	for _, one := range s.Weekdays {
+		if time.Weekday(one) == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseClock parse the HH:MM to the minutes since midnight.
+func parseClock(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// ChangeFreezeAttachment defines the change freeze window attachments.
+type ChangeFreezeAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	// AppID is 0 if the window freezes the whole biz.
+	AppID    uint32 `json:"app_id" gorm:"column:app_id"`
+	TenantID string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// CheckChangeFreeze checks the windows of the biz for the user's change of the app at the time, app id 0
+// means the change is not on an app, so only the windows of the whole biz apply. It returns the window which
+// freezes the change, or the active windows which are overridden by the user as a break-glass user.
+func CheckChangeFreeze(windows []*ChangeFreeze, appID uint32, user string, now time.Time) (
+	frozen *ChangeFreeze, overridden []*ChangeFreeze) {
+
+	for _, one := range windows {
+		if one.Spec == nil || one.Attachment == nil || !one.Covers(appID) || !one.Spec.ActiveAt(now) {
+			continue
+		}
+
+		if !one.Spec.CanBreakGlass(user) {
+			return one, nil
+		}
+		overridden = append(overridden, one)
+	}
+
+	return nil, overridden
+}
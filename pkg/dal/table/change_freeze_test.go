@@ -0,0 +1,135 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/types"
+)
+
+func TestChangeFreezeSpecActiveAt(t *testing.T) {
+	// 2024-01-05 is Friday
+	friday := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 5, hour, minute, 0, 0, time.UTC)
+	}
+	startAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endAt := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name   string
+		spec   *ChangeFreezeSpec
+		now    time.Time
+		active bool
+	}{
+		{"friday evening", &ChangeFreezeSpec{Kind: ChangeFreezeRecurring, Weekdays: types.Uint32Slice{5},
+			StartTime: "18:00", EndTime: "23:00", Enabled: true}, friday(19, 30), true},
+		{"before start", &ChangeFreezeSpec{Kind: ChangeFreezeRecurring, Weekdays: types.Uint32Slice{5},
+			StartTime: "18:00", EndTime: "23:00", Enabled: true}, friday(17, 59), false},
+		{"end is exclusive", &ChangeFreezeSpec{Kind: ChangeFreezeRecurring, Weekdays: types.Uint32Slice{5},
+			StartTime: "18:00", EndTime: "23:00", Enabled: true}, friday(23, 0), false},
+		{"other weekday", &ChangeFreezeSpec{Kind: ChangeFreezeRecurring, Weekdays: types.Uint32Slice{1},
+			StartTime: "18:00", EndTime: "23:00", Enabled: true}, friday(19, 0), false},
+		{"disabled", &ChangeFreezeSpec{Kind: ChangeFreezeRecurring, StartTime: "00:00", EndTime: "00:00"},
+			friday(12, 0), false},
+		{"whole day every day", &ChangeFreezeSpec{Kind: ChangeFreezeRecurring, StartTime: "00:00",
+			EndTime: "00:00", Enabled: true}, friday(12, 0), true},
+		{"crosses midnight from thursday", &ChangeFreezeSpec{Kind: ChangeFreezeRecurring,
+			Weekdays: types.Uint32Slice{4}, StartTime: "22:00", EndTime: "06:00", Enabled: true}, friday(5, 0), true},
+		{"crosses midnight ended", &ChangeFreezeSpec{Kind: ChangeFreezeRecurring,
+			Weekdays: types.Uint32Slice{4}, StartTime: "22:00", EndTime: "06:00", Enabled: true}, friday(6, 0), false},
+		{"timezone", &ChangeFreezeSpec{Kind: ChangeFreezeRecurring, Timezone: "Asia/Shanghai",
+			Weekdays: types.Uint32Slice{5}, StartTime: "18:00", EndTime: "23:00", Enabled: true},
+			friday(11, 0), true},
+		{"holiday", &ChangeFreezeSpec{Kind: ChangeFreezeOnce, StartAt: &startAt, EndAt: &endAt,
+			Enabled: true}, friday(12, 0), true},
+		{"after holiday", &ChangeFreezeSpec{Kind: ChangeFreezeOnce, StartAt: &startAt, EndAt: &endAt,
+			Enabled: true}, endAt, false},
+	}
+
+	for _, c := range cases {
+		if err := c.spec.Validate(); err != nil && c.spec.Name != "" {
+			t.Errorf("%s: unexpected validate err: %v", c.name, err)
+		}
+		if got := c.spec.ActiveAt(c.now); got != c.active {
+			t.Errorf("%s: expect active %v, got %v", c.name, c.active, got)
+		}
+	}
+}
+
+func TestChangeFreezeSpecValidate(t *testing.T) {
+	startAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		spec *ChangeFreezeSpec
+	}{
+		{"no name", &ChangeFreezeSpec{Kind: ChangeFreezeRecurring, StartTime: "00:00", EndTime: "01:00"}},
+		{"invalid kind", &ChangeFreezeSpec{Name: "a", Kind: "weekly"}},
+		{"invalid weekday", &ChangeFreezeSpec{Name: "a", Kind: ChangeFreezeRecurring,
+			Weekdays: types.Uint32Slice{7}, StartTime: "00:00", EndTime: "01:00"}},
+		{"invalid time", &ChangeFreezeSpec{Name: "a", Kind: ChangeFreezeRecurring, StartTime: "24:00",
+			EndTime: "01:00"}},
+		{"invalid timezone", &ChangeFreezeSpec{Name: "a", Kind: ChangeFreezeRecurring, Timezone: "Mars/Base",
+			StartTime: "00:00", EndTime: "01:00"}},
+		{"once without end", &ChangeFreezeSpec{Name: "a", Kind: ChangeFreezeOnce, StartAt: &startAt}},
+		{"empty break glass user", &ChangeFreezeSpec{Name: "a", Kind: ChangeFreezeRecurring,
+			StartTime: "00:00", EndTime: "01:00", BreakGlassUsers: types.StringSlice{" "}}},
+	}
+
+	for _, c := range cases {
+		if err := c.spec.Validate(); err == nil {
+			t.Errorf("%s: expect invalid, got nil err", c.name)
+		}
+	}
+}
+
+func TestCheckChangeFreeze(t *testing.T) {
+	now := time.Date(2024, 1, 5, 12, 0, 0, 0, time.UTC)
+	window := func(id, appID uint32, users ...string) *ChangeFreeze {
+		return &ChangeFreeze{
+			ID: id,
+			Spec: &ChangeFreezeSpec{Kind: ChangeFreezeRecurring, StartTime: "00:00", EndTime: "00:00",
+				BreakGlassUsers: users, Enabled: true},
+			Attachment: &ChangeFreezeAttachment{BizID: 1, AppID: appID},
+		}
+	}
+
+	frozen, _ := CheckChangeFreeze([]*ChangeFreeze{window(1, 0)}, 0, "alice", now)
+	if frozen == nil || frozen.ID != 1 {
+		t.Errorf("expect frozen by the biz window, got %v", frozen)
+	}
+
+	frozen, _ = CheckChangeFreeze([]*ChangeFreeze{window(1, 2)}, 3, "alice", now)
+	if frozen != nil {
+		t.Errorf("expect the window of other app not applied, got %d", frozen.ID)
+	}
+
+	frozen, _ = CheckChangeFreeze([]*ChangeFreeze{window(1, 2)}, 0, "alice", now)
+	if frozen != nil {
+		t.Errorf("expect the window of app not applied to the biz change, got %d", frozen.ID)
+	}
+
+	frozen, overridden := CheckChangeFreeze([]*ChangeFreeze{window(1, 0, "alice"), window(2, 2, "alice")},
+		2, "alice", now)
+	if frozen != nil || len(overridden) != 2 {
+		t.Errorf("expect both windows overridden, got frozen %v, overridden %d", frozen, len(overridden))
+	}
+
+	frozen, overridden = CheckChangeFreeze([]*ChangeFreeze{window(1, 0, "alice"), window(2, 2, "bob")},
+		2, "alice", now)
+	if frozen == nil || frozen.ID != 2 || overridden != nil {
+		t.Errorf("expect frozen by the app window, got frozen %v, overridden %d", frozen, len(overridden))
+	}
+}
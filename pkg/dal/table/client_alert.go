@@ -0,0 +1,345 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-version"
+)
+
+// ClientAlertRuleType is the type of client alert rule.
+type ClientAlertRuleType string
+
+const (
+	// ClientAlertFailedRatio fires when the ratio of clients failed to change to the release exceeds the threshold,
+	// the threshold is a percentage, e.g. 5 means 5%.
+	ClientAlertFailedRatio ClientAlertRuleType = "failed_ratio"
+	// ClientAlertOfflineDuration fires when any client has been offline for longer than the threshold,
+	// the threshold is in minutes.
+	ClientAlertOfflineDuration ClientAlertRuleType = "offline_duration"
+	// ClientAlertVersionBelow fires when any online client's version is lower than the client version of the rule.
+	ClientAlertVersionBelow ClientAlertRuleType = "client_version_below"
+)
+
+// ClientAlertMaxOfflineMinutes is the max threshold of offline_duration rule, clients which have not sent heartbeat
+// for more than a day are considered gone and won't be taken into account.
+const ClientAlertMaxOfflineMinutes = 24 * 60
+
+// Validate the client alert rule type is valid or not.
+func (t ClientAlertRuleType) Validate() error {
+	switch t {
+	case ClientAlertFailedRatio, ClientAlertOfflineDuration, ClientAlertVersionBelow:
+	default:
+		return fmt.Errorf("unsupported client alert rule type: %s", t)
+	}
+
+	return nil
+}
+
+// ClientAlertNotifier is the channel which the alert is delivered through.
+type ClientAlertNotifier string
+
+const (
+	// ClientAlertNotifierWebhook post the alert to the webhook url of the rule.
+	ClientAlertNotifierWebhook ClientAlertNotifier = "webhook"
+	// ClientAlertNotifierPushManager push the alert to the receivers of the rule through push manager.
+	ClientAlertNotifierPushManager ClientAlertNotifier = "push_manager"
+)
+
+// Validate the client alert notifier is valid or not.
+func (n ClientAlertNotifier) Validate() error {
+	switch n {
+	case ClientAlertNotifierWebhook, ClientAlertNotifierPushManager:
+	default:
+		return fmt.Errorf("unsupported client alert notifier: %s", n)
+	}
+
+	return nil
+}
+
+// ClientAlertRule defines a client alert rule's detail information
+type ClientAlertRule struct {
+	ID         uint32                     `json:"id" gorm:"primaryKey"`
+	Spec       *ClientAlertRuleSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ClientAlertRuleAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                  `json:"revision" gorm:"embedded"`
+}
+
+// ClientAlertRuleSpec defines all the specifics for client alert rule set by user.
+type ClientAlertRuleSpec struct {
+	Name     string              `json:"name" gorm:"column:name"`
+	RuleType ClientAlertRuleType `json:"rule_type" gorm:"column:rule_type"`
+	// ReleaseID only used by failed_ratio rule, 0 means all the releases of the app.
+	ReleaseID uint32 `json:"release_id" gorm:"column:release_id"`
+	// Threshold is a percentage for failed_ratio rule and minutes for offline_duration rule.
+	Threshold float64 `json:"threshold" gorm:"column:threshold"`
+	// ClientVersion only used by client_version_below rule.
+	ClientVersion string `json:"client_version" gorm:"column:client_version"`
+	// Notifiers is the comma separated notifiers, e.g. "webhook,push_manager".
+	Notifiers  string `json:"notifiers" gorm:"column:notifiers"`
+	WebhookURL string `json:"webhook_url" gorm:"column:webhook_url"`
+	// Receivers is the comma separated receivers of push manager.
+	Receivers string `json:"receivers" gorm:"column:receivers"`
+	Enabled   bool   `json:"enabled" gorm:"column:enabled"`
+	// SilencedUntil the alert of the rule is not delivered before this time.
+	SilencedUntil *time.Time `json:"silenced_until" gorm:"column:silenced_until"`
+	Memo          string     `json:"memo" gorm:"column:memo"`
+}
+
+// NotifierList returns the notifiers of the rule.
+func (s *ClientAlertRuleSpec) NotifierList() []ClientAlertNotifier {
+	notifiers := make([]ClientAlertNotifier, 0)
+	for _, one := range strings.Split(s.Notifiers, ",") {
+		one = strings.TrimSpace(one)
+		if one == "" {
+			continue
+		}
+		notifiers = append(notifiers, ClientAlertNotifier(one))
+	}
+
+	return notifiers
+}
+
+// IsSilenced returns whether the rule is silenced at the given time.
+func (s *ClientAlertRuleSpec) IsSilenced(now time.Time) bool {
+	return s.SilencedUntil != nil && s.SilencedUntil.After(now)
+}
+
+// ClientAlertRuleAttachment defines the client alert rule attachments.
+type ClientAlertRuleAttachment struct {
+	BizID    uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID    uint32 `json:"app_id" gorm:"column:app_id"`
+	TenantID string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// TableName is the client alert rule's database table name.
+func (r *ClientAlertRule) TableName() string {
+	return "client_alert_rules"
+}
+
+// AppID AuditRes interface
+func (r *ClientAlertRule) AppID() uint32 {
+	return r.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (r *ClientAlertRule) ResID() uint32 {
+	return r.ID
+}
+
+// ResType AuditRes interface
+func (r *ClientAlertRule) ResType() string {
+	return "client_alert_rule"
+}
+
+// ValidateCreate validate client alert rule is valid or not when create it.
+func (r *ClientAlertRule) ValidateCreate() error {
+	if r.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if r.Attachment == nil || r.Attachment.BizID <= 0 || r.Attachment.AppID <= 0 {
+		return errors.New("invalid attachment, biz id and app id should be set")
+	}
+
+	if err := r.validateSpec(); err != nil {
+		return err
+	}
+
+	if r.Revision == nil || r.Revision.Creator == "" {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate client alert rule is valid or not when update it.
+func (r *ClientAlertRule) ValidateUpdate() error {
+	if r.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if r.Attachment == nil || r.Attachment.BizID <= 0 || r.Attachment.AppID <= 0 {
+		return errors.New("invalid attachment, biz id and app id should be set")
+	}
+
+	if err := r.validateSpec(); err != nil {
+		return err
+	}
+
+	if r.Revision == nil || r.Revision.Reviser == "" {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
+
+// ValidateDelete validate the client alert rule's info when delete it.
+func (r *ClientAlertRule) ValidateDelete() error {
+	if r.ID <= 0 {
+		return errors.New("client alert rule id should be set")
+	}
+
+	if r.Attachment == nil || r.Attachment.BizID <= 0 || r.Attachment.AppID <= 0 {
+		return errors.New("invalid attachment, biz id and app id should be set")
+	}
+
+	return nil
+}
+
+func (r *ClientAlertRule) validateSpec() error {
+	if r.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if r.Spec.Name == "" {
+		return errors.New("name can not be empty")
+	}
+
+	if err := r.Spec.RuleType.Validate(); err != nil {
+		return err
+	}
+
+	switch r.Spec.RuleType {
+	case ClientAlertFailedRatio:
+		if r.Spec.Threshold <= 0 || r.Spec.Threshold > 100 {
+			return fmt.Errorf("invalid threshold %v, failed ratio should be in (0, 100]", r.Spec.Threshold)
+		}
+	case ClientAlertOfflineDuration:
+		if r.Spec.Threshold <= 0 || r.Spec.Threshold > ClientAlertMaxOfflineMinutes {
+			return fmt.Errorf("invalid threshold %v, offline minutes should be in (0, %d]", r.Spec.Threshold,
+				ClientAlertMaxOfflineMinutes)
+		}
+	case ClientAlertVersionBelow:
+		if _, err := version.NewVersion(r.Spec.ClientVersion); err != nil {
+			return fmt.Errorf("invalid client version %s, err: %v", r.Spec.ClientVersion, err)
+		}
+	}
+
+	notifiers := r.Spec.NotifierList()
+	if len(notifiers) == 0 {
+		return errors.New("at least one notifier should be set")
+	}
+	for _, n := range notifiers {
+		if err := n.Validate(); err != nil {
+			return err
+		}
+		if n == ClientAlertNotifierWebhook {
+			if r.Spec.WebhookURL == "" {
+				return errors.New("webhook url can not be empty when webhook notifier is used")
+			}
+			if err := ValidateWebhookURL(r.Spec.WebhookURL); err != nil {
+				return err
+			}
+		}
+		if n == ClientAlertNotifierPushManager && r.Spec.Receivers == "" {
+			return errors.New("receivers can not be empty when push_manager notifier is used")
+		}
+	}
+
+	return nil
+}
+
+// ClientAlertStatus is the status of a client alert.
+type ClientAlertStatus string
+
+const (
+	// ClientAlertFiring the alert rule is firing and not acknowledged.
+	ClientAlertFiring ClientAlertStatus = "firing"
+	// ClientAlertAcked the alert is acknowledged by someone, it won't be delivered again until resolved.
+	ClientAlertAcked ClientAlertStatus = "acked"
+	// ClientAlertResolved the alert rule is not firing any more.
+	ClientAlertResolved ClientAlertStatus = "resolved"
+)
+
+// Validate the client alert status is valid or not.
+func (s ClientAlertStatus) Validate() error {
+	switch s {
+	case ClientAlertFiring, ClientAlertAcked, ClientAlertResolved:
+	default:
+		return fmt.Errorf("unsupported client alert status: %s", s)
+	}
+
+	return nil
+}
+
+// ClientAlertHistory defines a client alert history's detail information
+type ClientAlertHistory struct {
+	ID         uint32                     `json:"id" gorm:"primaryKey"`
+	Spec       *ClientAlertHistorySpec    `json:"spec" gorm:"embedded"`
+	Attachment *ClientAlertRuleAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                  `json:"revision" gorm:"embedded"`
+}
+
+// ClientAlertHistorySpec defines all the specifics for client alert history.
+type ClientAlertHistorySpec struct {
+	RuleID   uint32              `json:"rule_id" gorm:"column:rule_id"`
+	RuleName string              `json:"rule_name" gorm:"column:rule_name"`
+	RuleType ClientAlertRuleType `json:"rule_type" gorm:"column:rule_type"`
+	Status   ClientAlertStatus   `json:"status" gorm:"column:status"`
+	// Value is the evaluated value when the alert fired, it's the failed ratio for failed_ratio rule, the longest
+	// offline minutes for offline_duration rule and the number of outdated clients for client_version_below rule.
+	Value float64 `json:"value" gorm:"column:value"`
+	// ClientCount is the number of clients matched by the rule when the alert fired.
+	ClientCount uint32 `json:"client_count" gorm:"column:client_count"`
+	Message     string `json:"message" gorm:"column:message"`
+	// Silenced the alert fired during the silence period of the rule and was not delivered.
+	Silenced bool `json:"silenced" gorm:"column:silenced"`
+	// NotifyResult is the delivery result of each notifier.
+	NotifyResult string     `json:"notify_result" gorm:"column:notify_result"`
+	FiredAt      time.Time  `json:"fired_at" gorm:"column:fired_at"`
+	AckedBy      string     `json:"acked_by" gorm:"column:acked_by"`
+	AckedAt      *time.Time `json:"acked_at" gorm:"column:acked_at"`
+	ResolvedAt   *time.Time `json:"resolved_at" gorm:"column:resolved_at"`
+}
+
+// TableName is the client alert history's database table name.
+func (h *ClientAlertHistory) TableName() string {
+	return "client_alert_histories"
+}
+
+// AppID AuditRes interface
+func (h *ClientAlertHistory) AppID() uint32 {
+	return h.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (h *ClientAlertHistory) ResID() uint32 {
+	return h.ID
+}
+
+// ResType AuditRes interface
+func (h *ClientAlertHistory) ResType() string {
+	return "client_alert_history"
+}
+
+// ValidateCreate validate client alert history is valid or not when create it.
+func (h *ClientAlertHistory) ValidateCreate() error {
+	if h.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if h.Attachment == nil || h.Attachment.BizID <= 0 || h.Attachment.AppID <= 0 {
+		return errors.New("invalid attachment, biz id and app id should be set")
+	}
+
+	if h.Spec == nil || h.Spec.RuleID <= 0 {
+		return errors.New("invalid spec, rule id should be set")
+	}
+
+	return h.Spec.Status.Validate()
+}
@@ -13,6 +13,8 @@
 package table
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -40,6 +42,51 @@ type ClientEventSpec struct {
 	ReleaseChangeFailedReason string    `gorm:"column:release_change_failed_reason" json:"release_change_failed_reason"`
 	SpecificFailedReason      string    `gorm:"column:specific_failed_reason" json:"specific_failed_reason"`
 	FailedDetailReason        string    `gorm:"column:failed_detail_reason" json:"failed_detail_reason"`
+	// CurrentStage 当前所处的变更阶段，即时间线中最后一个阶段
+	CurrentStage string            `gorm:"column:current_stage" json:"current_stage"`
+	Stages       ClientEventStages `gorm:"column:stages;type:json" json:"stages"`
+}
+
+// ClientEventStage 版本变更中单个阶段的耗时
+type ClientEventStage struct {
+	Stage     string    `json:"stage"`
+	Status    Status    `json:"status"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Seconds   float64   `json:"seconds"`
+}
+
+// ClientEventStages is []*ClientEventStage
+type ClientEventStages []*ClientEventStage
+
+// Value implements the driver.Valuer interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (c ClientEventStages) Value() (driver.Value, error) {
+	if c == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (c *ClientEventStages) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("unsupported Scan type for ClientEventStages")
+	}
 }
 
 // ClientEventAttachment is a client event attachment
@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"fmt"
+	"time"
+)
+
+// ClientMetricGranularity is the time bucket granularity of the client metric.
+type ClientMetricGranularity string
+
+const (
+	// ClientMetricHour 按小时聚合的客户端指标
+	ClientMetricHour ClientMetricGranularity = "hour"
+	// ClientMetricDay 按天聚合的客户端指标，由小时指标降采样得到
+	ClientMetricDay ClientMetricGranularity = "day"
+)
+
+// Validate the client metric granularity is valid or not.
+func (g ClientMetricGranularity) Validate() error {
+	switch g {
+	case ClientMetricHour:
+	case ClientMetricDay:
+	default:
+		return fmt.Errorf("unsupported client metric granularity: %s", g)
+	}
+
+	return nil
+}
+
+// Truncate returns the start time of the bucket which the time belongs to.
+func (g ClientMetricGranularity) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	if g == ClientMetricDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
+
+// ClientMetric is the resource usage and pull statistics of a client in a time bucket.
+type ClientMetric struct {
+	ID         uint32                  `gorm:"column:id" json:"id"`
+	Attachment *ClientMetricAttachment `json:"attachment" gorm:"embedded"`
+	Spec       *ClientMetricSpec       `json:"spec" gorm:"embedded"`
+}
+
+// ClientMetricSpec is the metric values of a client in a time bucket.
+// cpu and memory are accumulated by every heartbeat sample, so the average is sum / sample count.
+type ClientMetricSpec struct {
+	Granularity     ClientMetricGranularity `gorm:"column:granularity" json:"granularity"`
+	BucketTime      time.Time               `gorm:"column:bucket_time" json:"bucket_time"`
+	ClientVersion   string                  `gorm:"column:client_version" json:"client_version"`
+	SampleCount     uint32                  `gorm:"column:sample_count" json:"sample_count"`
+	CpuSum          float64                 `gorm:"column:cpu_sum" json:"cpu_sum"`
+	CpuMax          float64                 `gorm:"column:cpu_max" json:"cpu_max"`
+	MemorySum       uint64                  `gorm:"column:memory_sum" json:"memory_sum"`
+	MemoryMax       uint64                  `gorm:"column:memory_max" json:"memory_max"`
+	PullCount       uint32                  `gorm:"column:pull_count" json:"pull_count"`
+	PullFailedCount uint32                  `gorm:"column:pull_failed_count" json:"pull_failed_count"`
+	PullSeconds     float64                 `gorm:"column:pull_seconds" json:"pull_seconds"`
+}
+
+// ClientMetricAttachment is the client which the metric belongs to.
+type ClientMetricAttachment struct {
+	BizID    uint32 `gorm:"column:biz_id" json:"biz_id"`
+	AppID    uint32 `gorm:"column:app_id" json:"app_id"`
+	UID      string `gorm:"column:uid" json:"uid"`
+	TenantID string `gorm:"column:tenant_id" json:"tenant_id"`
+}
+
+// TableName is the client metric's database table name.
+func (c *ClientMetric) TableName() string {
+	return "client_metrics"
+}
+
+// AppID AuditRes interface
+func (c *ClientMetric) AppID() uint32 {
+	return c.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (c *ClientMetric) ResID() uint32 {
+	return c.ID
+}
+
+// ResType AuditRes interface
+func (c *ClientMetric) ResType() string {
+	return "client_metric"
+}
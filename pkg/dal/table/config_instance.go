@@ -12,10 +12,39 @@
 
 package table
 
-import "github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+import (
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+)
 
 type ConfigOperateType string
 
+// ConfigDriftStatus is the drift status of a config instance, which is the result of comparing
+// the checksum of the file on the host with the last generated config instance.
+type ConfigDriftStatus string
+
+const (
+	// ConfigDriftUnchecked the config instance has not been checked yet.
+	ConfigDriftUnchecked ConfigDriftStatus = ""
+	// ConfigDriftConsistent the file on the host is the same as the last generated config instance.
+	ConfigDriftConsistent ConfigDriftStatus = "consistent"
+	// ConfigDriftDrifted the file on the host has been modified after the config instance was pushed.
+	ConfigDriftDrifted ConfigDriftStatus = "drifted"
+)
+
+// Validate the config drift status is valid or not.
+func (s ConfigDriftStatus) Validate() error {
+	switch s {
+	case ConfigDriftUnchecked, ConfigDriftConsistent, ConfigDriftDrifted:
+	default:
+		return fmt.Errorf("unsupported config drift status: %s", s)
+	}
+
+	return nil
+}
+
 // ConfigInstance defines a config instance's detail information
 type ConfigInstance struct {
 	ID         uint32                    `json:"id" gorm:"primaryKey"`
@@ -43,6 +72,12 @@ type ConfigInstanceAttachment struct {
 	Md5 string `json:"md5" gorm:"column:md5"`
 	// Content is the config content.
 	Content string `json:"content" gorm:"column:content"`
+	// DriftStatus is the result of the last drift check.
+	DriftStatus ConfigDriftStatus `json:"drift_status" gorm:"column:drift_status"`
+	// ActualMd5 is the md5 value of the file on the host collected by the last drift check.
+	ActualMd5 string `json:"actual_md5" gorm:"column:actual_md5"`
+	// DriftCheckedAt is the time of the last drift check.
+	DriftCheckedAt *time.Time `json:"drift_checked_at" gorm:"column:drift_checked_at"`
 }
 
 // TableName is the config instance's database table name.
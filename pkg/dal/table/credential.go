@@ -92,7 +92,25 @@ type CredentialSpec struct {
 	Name           string         `json:"name" gorm:"column:name"`
 	Memo           string         `json:"memo" gorm:"column:memo"`
 	Enable         bool           `json:"enable" gorm:"column:enable"`
-	ExpiredAt      time.Time      `json:"expired_at" gorm:"column:expired_at"`
+	// ExpiredAt is the expiry time of the credential, nil means never expired.
+	ExpiredAt *time.Time `json:"expired_at" gorm:"column:expired_at"`
+	// PreviousEncCredential is the credential before the last rotation, it is still valid until
+	// PreviousExpiredAt so that the clients have time to switch to the new credential.
+	PreviousEncCredential string     `json:"previous_enc_credential" gorm:"column:previous_enc_credential"`
+	PreviousExpiredAt     *time.Time `json:"previous_expired_at" gorm:"column:previous_expired_at"`
+	RotatedAt             *time.Time `json:"rotated_at" gorm:"column:rotated_at"`
+	// LastUsedAt is the last time the credential is used by the clients, reported by feed server.
+	LastUsedAt *time.Time `json:"last_used_at" gorm:"column:last_used_at"`
+}
+
+// IsExpired returns whether the credential is expired at the time.
+func (c *CredentialSpec) IsExpired(now time.Time) bool {
+	return c.ExpiredAt != nil && !c.ExpiredAt.IsZero() && !now.Before(*c.ExpiredAt)
+}
+
+// InGracePeriod returns whether the previous credential is still valid at the time.
+func (c *CredentialSpec) InGracePeriod(now time.Time) bool {
+	return c.PreviousEncCredential != "" && c.PreviousExpiredAt != nil && now.Before(*c.PreviousExpiredAt)
 }
 
 const (
@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"time"
+)
+
+// CredentialClient is a client which used the credential, it is used to count the clients of the credential.
+type CredentialClient struct {
+	ID         uint32                      `gorm:"column:id" json:"id"`
+	Attachment *CredentialClientAttachment `json:"attachment" gorm:"embedded"`
+	Spec       *CredentialClientSpec       `json:"spec" gorm:"embedded"`
+}
+
+// CredentialClientSpec is the usage of the credential by the client.
+type CredentialClientSpec struct {
+	// ClientUID is the uid of the client, it is the client's ip if the uid is not reported.
+	ClientUID  string    `gorm:"column:client_uid" json:"client_uid"`
+	LastUsedAt time.Time `gorm:"column:last_used_at" json:"last_used_at"`
+}
+
+// CredentialClientAttachment is the credential which the client used.
+type CredentialClientAttachment struct {
+	BizID        uint32 `gorm:"column:biz_id" json:"biz_id"`
+	CredentialID uint32 `gorm:"column:credential_id" json:"credential_id"`
+}
+
+// TableName is the credential client's database table name.
+func (c *CredentialClient) TableName() string {
+	return "credential_clients"
+}
+
+// AppID AuditRes interface
+func (c *CredentialClient) AppID() uint32 {
+	return 0
+}
+
+// ResID AuditRes interface
+func (c *CredentialClient) ResID() uint32 {
+	return c.ID
+}
+
+// ResType AuditRes interface
+func (c *CredentialClient) ResType() string {
+	return "credential_client"
+}
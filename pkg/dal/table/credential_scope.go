@@ -60,7 +60,7 @@ func (c *CredentialScope) ValidateCreate() error {
 		return errors.New("spec not set")
 	}
 
-	if err := c.Spec.CredentialScope.Validate(); err != nil {
+	if err := c.Spec.Rule().Validate(); err != nil {
 		return err
 	}
 
@@ -82,7 +82,26 @@ func (c *CredentialScope) ValidateCreate() error {
 // CredentialScopeSpec defines credential scope's Spec
 type CredentialScopeSpec struct {
 	CredentialScope credential.Scope `json:"credential_scope" gorm:"column:credential_scope"`
-	ExpiredAt       time.Time        `json:"expired_at" gorm:"column:expired_at"`
+	// KvKeyPattern restricts the kv keys by glob or regex, the scope is used if it is empty.
+	KvKeyPattern   string               `json:"kv_key_pattern" gorm:"column:kv_key_pattern"`
+	KvKeyMatchType credential.MatchType `json:"kv_key_match_type" gorm:"column:kv_key_match_type"`
+	// Labels restricts the instance labels which the credential may claim.
+	Labels     credential.LabelSelector `json:"labels" gorm:"column:labels"`
+	Permission credential.Permission    `json:"permission" gorm:"column:permission"`
+	// ExpiredAt is the expiry time of the scope, nil means never expired.
+	ExpiredAt *time.Time `json:"expired_at" gorm:"column:expired_at"`
+}
+
+// Rule returns the credential rule of the scope.
+func (c *CredentialScopeSpec) Rule() *credential.Rule {
+	return &credential.Rule{
+		Scope:          c.CredentialScope,
+		KvKeyPattern:   c.KvKeyPattern,
+		KvKeyMatchType: c.KvKeyMatchType,
+		Labels:         c.Labels,
+		Permission:     c.Permission,
+		ExpiredAt:      c.ExpiredAt,
+	}
 }
 
 // CredentialScopeAttachment defines the credential scope attachments.
@@ -116,7 +135,7 @@ func (c *CredentialScope) ValidateUpdate() error {
 		return errors.New("spec not set")
 	}
 
-	if err := c.Spec.CredentialScope.Validate(); err != nil {
+	if err := c.Spec.Rule().Validate(); err != nil {
 		return err
 	}
 
@@ -0,0 +1,201 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// GitRepoSyncStatus is the status of the last sync of a git repository link.
+type GitRepoSyncStatus string
+
+const (
+	// GitRepoSyncing the repository is being synced.
+	GitRepoSyncing GitRepoSyncStatus = "syncing"
+	// GitRepoSyncSuccess the last sync is succeed.
+	GitRepoSyncSuccess GitRepoSyncStatus = "success"
+	// GitRepoSyncFailed the last sync is failed, the reason is recorded in the sync message.
+	GitRepoSyncFailed GitRepoSyncStatus = "failed"
+)
+
+// GitRepoMaxSyncMessageLength is the max length of the sync message which is recorded.
+const GitRepoMaxSyncMessageLength = 1024
+
+// GitRepoLink links an app or a template space to a directory of a git repository, the files in the
+// directory are imported as the config items of the app or the templates of the template space when
+// the link is synced.
+type GitRepoLink struct {
+	ID         uint32                 `json:"id" gorm:"primaryKey"`
+	Spec       *GitRepoLinkSpec       `json:"spec" gorm:"embedded"`
+	Status     *GitRepoLinkStatus     `json:"status" gorm:"embedded"`
+	Attachment *GitRepoLinkAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision              `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the git repository link's database table name.
+func (g *GitRepoLink) TableName() string {
+	return "git_repo_links"
+}
+
+// AppID AuditRes interface
+func (g *GitRepoLink) AppID() uint32 {
+	return g.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (g *GitRepoLink) ResID() uint32 {
+	return g.ID
+}
+
+// ResType AuditRes interface
+func (g *GitRepoLink) ResType() string {
+	return "git_repo_link"
+}
+
+// ValidateCreate validate git repository link is valid or not when create it.
+func (g *GitRepoLink) ValidateCreate() error {
+	if g.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if g.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	if err := g.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if g.Attachment == nil {
+		return errors.New("attachment should be set")
+	}
+
+	if err := g.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if g.Revision == nil || g.Revision.Creator == "" {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate git repository link is valid or not when update it.
+func (g *GitRepoLink) ValidateUpdate() error {
+	if g.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if g.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	if err := g.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if g.Attachment == nil || g.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment, biz id should be set")
+	}
+
+	if g.Revision == nil || g.Revision.Reviser == "" {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
+
+// GitRepoLinkSpec defines all the specifics for git repository link set by user.
+type GitRepoLinkSpec struct {
+	// RepoURL is the http(s) clone url of the repository.
+	RepoURL string `json:"repo_url" gorm:"column:repo_url"`
+	// Ref is the branch or tag to sync, or a full reference name which starts with refs/.
+	Ref string `json:"ref" gorm:"column:ref"`
+	// SubPath is the directory in the repository to import, empty means the root directory.
+	SubPath string `json:"sub_path" gorm:"column:sub_path"`
+	// Username is used with the access token kept in vault to clone the private repository.
+	Username string `json:"username" gorm:"column:username"`
+	// SyncInterval is the interval in minutes to sync on schedule, 0 means the link is only synced
+	// manually or by webhook.
+	SyncInterval uint32 `json:"sync_interval" gorm:"column:sync_interval"`
+	// ReplaceAll delete the config items which are not in the repository when sync, only for app.
+	ReplaceAll bool `json:"replace_all" gorm:"column:replace_all"`
+	// WebhookTokenHash is the sha256 of the token which the webhook request should carry.
+	WebhookTokenHash string `json:"-" gorm:"column:webhook_token_hash"`
+	Memo             string `json:"memo" gorm:"column:memo"`
+}
+
+// Validate the git repository link spec is valid or not.
+func (s *GitRepoLinkSpec) Validate() error {
+	// the repository is cloned by the server, so the internal addresses are refused as the webhooks.
+	if err := ValidateWebhookURL(s.RepoURL); err != nil {
+		return fmt.Errorf("invalid repo url, %v", err)
+	}
+
+	if u, _ := url.Parse(s.RepoURL); u.User != nil {
+		return errors.New("repo url should not contain the user info, use username and token instead")
+	}
+
+	if strings.TrimSpace(s.Ref) == "" {
+		return errors.New("ref is required")
+	}
+
+	for _, seg := range strings.Split(s.SubPath, "/") {
+		if seg == ".." {
+			return fmt.Errorf("invalid sub path %s, it should not contain '..'", s.SubPath)
+		}
+	}
+
+	return nil
+}
+
+// CleanSubPath returns the cleaned relative sub path, empty means the root directory.
+func (s *GitRepoLinkSpec) CleanSubPath() string {
+	return strings.TrimPrefix(path.Clean("/"+s.SubPath), "/")
+}
+
+// GitRepoLinkStatus defines the status of the last sync of git repository link.
+type GitRepoLinkStatus struct {
+	SyncStatus  GitRepoSyncStatus `json:"sync_status" gorm:"column:sync_status"`
+	SyncedSHA   string            `json:"synced_sha" gorm:"column:synced_sha"`
+	SyncMessage string            `json:"sync_message" gorm:"column:sync_message"`
+	SyncedAt    *time.Time        `json:"synced_at" gorm:"column:synced_at"`
+}
+
+// GitRepoLinkAttachment defines the git repository link attachments, the link belongs to an app or
+// a template space.
+type GitRepoLinkAttachment struct {
+	BizID           uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID           uint32 `json:"app_id" gorm:"column:app_id"`
+	TemplateSpaceID uint32 `json:"template_space_id" gorm:"column:template_space_id"`
+	TenantID        string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// Validate the git repository link attachment is valid or not.
+func (a *GitRepoLinkAttachment) Validate() error {
+	if a.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if (a.AppID > 0) == (a.TemplateSpaceID > 0) {
+		return errors.New("one and only one of app id and template space id should be set")
+	}
+
+	return nil
+}
@@ -157,6 +157,7 @@ var GroupSpecColumnDescriptor = ColumnDescriptors{
 	{Column: "mode", NamedC: "mode", Type: enumor.String},
 	{Column: "selector", NamedC: "selector", Type: enumor.String},
 	{Column: "uid", NamedC: "uid", Type: enumor.String},
+	{Column: "client_query_id", NamedC: "client_query_id", Type: enumor.Numeric},
 }
 
 // GroupSpec defines all the specifics for group set by user.
@@ -168,6 +169,8 @@ type GroupSpec struct {
 	Mode     GroupMode          `db:"mode" json:"mode" gorm:"column:mode"`
 	Selector *selector.Selector `db:"selector" json:"selector" gorm:"column:selector;type:json"`
 	UID      string             `db:"uid" json:"uid" gorm:"column:uid"`
+	// ClientQueryID is the saved client query which a dynamic group resolves its members from.
+	ClientQueryID uint32 `db:"client_query_id" json:"client_query_id" gorm:"column:client_query_id"`
 }
 
 const (
@@ -181,6 +184,13 @@ const (
 	// GroupModeBuiltIn define bscp built-in group,eg. ClusterID, Namespace, CMDBModuleID...
 	// Note: GroupModeBuiltIn define bscp built-in group,eg. ClusterID, Namespace, CMDBModuleID...
 	GroupModeBuiltIn GroupMode = "builtin"
+	// GroupModeDynamic means the group's members are resolved from a saved client query at publish time,
+	// the resolved clients' uid are recorded in the released group's selector.
+	GroupModeDynamic GroupMode = "dynamic"
+	// DynamicGroupUIDKey is the selector key of the resolved clients' uid in a released dynamic group.
+	DynamicGroupUIDKey = "uid"
+	// DynamicGroupMaxClients is the max number of clients a dynamic group can be resolved to.
+	DynamicGroupMaxClients = 5000
 	// GrayPercentKey is the key of gray percent.
 	// 用于设置针对客户端的灰度比例
 	GrayPercentKey = "gray_percent"
@@ -200,6 +210,7 @@ func (g GroupMode) Validate() error {
 	case GroupModeCustom:
 	case GroupModeDebug:
 	case GroupModeDefault:
+	case GroupModeDynamic:
 	default:
 		return fmt.Errorf("unsupported group working mode: %s", g)
 	}
@@ -227,6 +238,10 @@ func (g GroupSpec) ValidateCreate(kit *kit.Kit) error {
 		if g.UID == "" {
 			return errors.New("group works in debug mode, uid should be set")
 		}
+	case GroupModeDynamic:
+		if g.ClientQueryID == 0 {
+			return errors.New("group works in dynamic mode, client query id should be set")
+		}
 	default:
 		return fmt.Errorf("unsupported group working mode: %s", g.Mode.String())
 	}
@@ -243,6 +258,11 @@ func (g GroupSpec) ValidateUpdate(kit *kit.Kit) error {
 		return errors.New("group's mode can not be updated")
 	}
 
+	// dynamic group's members are resolved from the client query, it has no selector to validate.
+	if g.ClientQueryID > 0 {
+		return nil
+	}
+
 	if g.Selector == nil {
 		return errors.New("group's selector should be set")
 	}
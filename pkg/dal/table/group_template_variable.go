@@ -0,0 +1,116 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// GroupTemplateVariable is the template variables overridden by the group of the app, it's the layer
+// upon the biz template variables and the app template variables, which is used to render the app's
+// templates for the environment that the group stands for.
+type GroupTemplateVariable struct {
+	ID         uint32                           `json:"id" gorm:"primaryKey"`
+	Spec       *GroupTemplateVariableSpec       `json:"spec" gorm:"embedded"`
+	Attachment *GroupTemplateVariableAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                        `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the GroupTemplateVariable's database table name.
+func (t *GroupTemplateVariable) TableName() string {
+	return "group_template_variables"
+}
+
+// AppID AuditRes interface
+func (t *GroupTemplateVariable) AppID() uint32 {
+	return t.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (t *GroupTemplateVariable) ResID() uint32 {
+	return t.ID
+}
+
+// ResType AuditRes interface
+func (t *GroupTemplateVariable) ResType() string {
+	return string(enumor.Config)
+}
+
+// ValidateUpsert validate GroupTemplateVariable is valid or not when create or update it.
+func (t *GroupTemplateVariable) ValidateUpsert(kit *kit.Kit) error {
+	if t.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	for _, v := range t.Spec.Variables {
+		if err := v.ValidateCreate(kit); err != nil {
+			return err
+		}
+	}
+
+	if t.Attachment == nil {
+		return errors.New("attachment should be set")
+	}
+
+	if err := t.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if t.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	if err := t.Revision.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GroupTemplateVariableSpec defines all the specifics for GroupTemplateVariable set by user.
+type GroupTemplateVariableSpec struct {
+	Variables AppVariables `json:"variables" gorm:"column:variables;type:json;default:'[]'"`
+}
+
+// GetVariableNames get variable names
+func (t *GroupTemplateVariableSpec) GetVariableNames() string {
+	return (&AppTemplateVariableSpec{Variables: t.Variables}).GetVariableNames()
+}
+
+// GroupTemplateVariableAttachment defines the GroupTemplateVariable attachments.
+type GroupTemplateVariableAttachment struct {
+	BizID    uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID    uint32 `json:"app_id" gorm:"column:app_id"`
+	GroupID  uint32 `json:"group_id" gorm:"column:group_id"`
+	TenantID string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// Validate whether GroupTemplateVariable attachment is valid or not.
+func (t *GroupTemplateVariableAttachment) Validate() error {
+	if t.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if t.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	if t.GroupID <= 0 {
+		return errors.New("invalid attachment group id")
+	}
+
+	return nil
+}
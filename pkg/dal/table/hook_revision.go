@@ -38,6 +38,9 @@ type HookRevisionSpec struct {
 	State   HookRevisionStatus `json:"state" gorm:"column:state"`
 	Content string             `json:"content" gorm:"column:content"`
 	Memo    string             `json:"memo" gorm:"column:memo"`
+	// Params are the typed parameters referenced by the content as {{ .bk_bscp_xxx }},
+	// the apps bind values for them which are rendered into the content at release time.
+	Params AppVariables `json:"params" gorm:"column:params;type:json;default:'[]'"`
 }
 
 // HookRevisionAttachment defines the hook attachments.
@@ -112,7 +115,7 @@ func (s *HookRevisionSpec) ValidateCreate(kit *kit.Kit) error {
 		return errors.New("content should not be empty")
 	}
 
-	return nil
+	return s.validateParams(kit)
 }
 
 // ValidateUpdate validate spec when updated.
@@ -130,9 +133,50 @@ func (s *HookRevisionSpec) ValidateUpdate(kit *kit.Kit) error {
 		return errors.New("content should not be empty")
 	}
 
+	return s.validateParams(kit)
+}
+
+// validateParams validate the hook revision params.
+func (s *HookRevisionSpec) validateParams(kit *kit.Kit) error {
+	names := make(map[string]struct{}, len(s.Params))
+	for _, p := range s.Params {
+		if p == nil {
+			return errors.New("hook param should not be nil")
+		}
+		if err := p.ValidateCreate(kit); err != nil {
+			return err
+		}
+		// the hook content is rendered and stored in db, so the secret param is not supported
+		if p.IsSecret() {
+			return fmt.Errorf("hook param %s can not be a secret variable", p.Name)
+		}
+		if _, ok := names[p.Name]; ok {
+			return fmt.Errorf("hook param %s is duplicated", p.Name)
+		}
+		names[p.Name] = struct{}{}
+	}
+
 	return nil
 }
 
+// BindParams merges the values bound by the app into the params of the hook revision, the params
+// which are not bound use the default values, and the values whose params are removed are ignored.
+func (s *HookRevisionSpec) BindParams(kit *kit.Kit, values map[string]string) (AppVariables, error) {
+	result := make(AppVariables, 0, len(s.Params))
+	for _, p := range s.Params {
+		bound := *p
+		if v, ok := values[p.Name]; ok {
+			bound.DefaultVal = v
+		}
+		if err := bound.ValidateDefaultVal(kit); err != nil {
+			return nil, fmt.Errorf("invalid value of hook param %s, %v", p.Name, err)
+		}
+		result = append(result, &bound)
+	}
+
+	return result, nil
+}
+
 // Validate validate Attachment.
 func (a HookRevisionAttachment) Validate() error {
 
@@ -0,0 +1,65 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func TestHookRevisionSpecBindParams(t *testing.T) {
+	kt := kit.New()
+	spec := &HookRevisionSpec{
+		Params: AppVariables{
+			{Name: "bk_bscp_port", Type: NumberVar, DefaultVal: "80"},
+			{Name: "bk_bscp_host", Type: StringVar, DefaultVal: "localhost"},
+		},
+	}
+
+	bound, err := spec.BindParams(kt, map[string]string{"bk_bscp_port": "8080", "bk_bscp_removed": "x"})
+	if err != nil {
+		t.Fatalf("bind params failed, err: %v", err)
+	}
+	if len(bound) != 2 {
+		t.Fatalf("expect 2 bound params, got %d", len(bound))
+	}
+	if bound[0].DefaultVal != "8080" || bound[1].DefaultVal != "localhost" {
+		t.Errorf("unexpected bound values: %s, %s", bound[0].DefaultVal, bound[1].DefaultVal)
+	}
+	if spec.Params[0].DefaultVal != "80" {
+		t.Errorf("binding should not modify the params of the hook revision")
+	}
+
+	if _, err := spec.BindParams(kt, map[string]string{"bk_bscp_port": "abc"}); err == nil {
+		t.Errorf("expect error when binding a non-number value to a number param")
+	}
+}
+
+func TestHookRevisionSpecValidateParams(t *testing.T) {
+	kt := kit.New()
+	spec := &HookRevisionSpec{
+		Params: AppVariables{
+			{Name: "bk_bscp_port", Type: NumberVar, DefaultVal: "80"},
+			{Name: "bk_bscp_port", Type: NumberVar, DefaultVal: "81"},
+		},
+	}
+	if err := spec.validateParams(kt); err == nil {
+		t.Errorf("expect error for duplicated params")
+	}
+
+	spec.Params = AppVariables{{Name: "port", Type: StringVar}}
+	if err := spec.validateParams(kt); err == nil {
+		t.Errorf("expect error for param without bk_bscp_ prefix")
+	}
+}
@@ -15,9 +15,12 @@ package table
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/types"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 )
 
@@ -105,6 +108,9 @@ var ReleaseSpecColumnDescriptor = mergeColumnDescriptors("",
 		{Column: "memo", NamedC: "memo", Type: enumor.String},
 		{Column: "deprecated", NamedC: "deprecated", Type: enumor.Boolean},
 		{Column: "publish_num", NamedC: "publish_num", Type: enumor.Numeric},
+		{Column: "tags", NamedC: "tags", Type: enumor.String},
+		{Column: "source_app_id", NamedC: "source_app_id", Type: enumor.Numeric},
+		{Column: "source_release_id", NamedC: "source_release_id", Type: enumor.Numeric},
 	},
 	mergeColumnDescriptors("hook", HookColumnDescriptor))
 
@@ -124,6 +130,58 @@ type ReleaseSpec struct {
 	PublishNum uint32 `db:"publish_num" json:"publish_num"`
 	// 是否全量发布过
 	FullyReleased bool `db:"fully_released" json:"fully_released"`
+	// Tags 版本标签，客户端可以声明只拉取带有指定标签的版本，如 stable
+	Tags types.StringSlice `db:"tags" json:"tags" gorm:"column:tags;type:json;default:'[]'"`
+	// SourceAppID 晋级版本的来源服务ID，非晋级生成的版本为0
+	SourceAppID uint32 `db:"source_app_id" json:"source_app_id" gorm:"column:source_app_id"`
+	// SourceReleaseID 晋级版本的来源版本ID，非晋级生成的版本为0
+	SourceReleaseID uint32 `db:"source_release_id" json:"source_release_id" gorm:"column:source_release_id"`
+}
+
+const (
+	// maxReleaseTagNum is the max number of tags of a release.
+	maxReleaseTagNum = 10
+	// maxReleaseTagLength is the max length of a release tag.
+	maxReleaseTagLength = 32
+)
+
+var releaseTagRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// ValidateReleaseTags validate the tags of a release.
+func ValidateReleaseTags(tags []string) error {
+	if len(tags) > maxReleaseTagNum {
+		return fmt.Errorf("a release can have at most %d tags", maxReleaseTagNum)
+	}
+
+	exists := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		if len(tag) > maxReleaseTagLength || !releaseTagRegexp.MatchString(tag) {
+			return fmt.Errorf("invalid release tag %q, should be at most %d characters of letters, digits, "+
+				"'_', '.' and '-', and start with a letter or digit", tag, maxReleaseTagLength)
+		}
+		if _, ok := exists[tag]; ok {
+			return fmt.Errorf("release tag %q is duplicated", tag)
+		}
+		exists[tag] = struct{}{}
+	}
+
+	return nil
+}
+
+// HasTags returns whether the release has all the given tags.
+func (r ReleaseSpec) HasTags(tags []string) bool {
+	return ContainsAllTags(r.Tags, tags)
+}
+
+// ContainsAllTags returns whether the owned tags contain all the required tags.
+func ContainsAllTags(owned, required []string) bool {
+	for _, one := range required {
+		if !slices.Contains(owned, one) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // Validate a release specifics when it is created.
@@ -136,6 +194,10 @@ func (r ReleaseSpec) Validate(kit *kit.Kit) error {
 		return err
 	}
 
+	if err := ValidateReleaseTags(r.Tags); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,72 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateReleaseTags(t *testing.T) {
+	cases := []struct {
+		name  string
+		tags  []string
+		valid bool
+	}{
+		{"empty", nil, true},
+		{"normal", []string{"stable", "v1.2.0", "canary_2"}, true},
+		{"invalid char", []string{"stable tag"}, false},
+		{"invalid prefix", []string{"-stable"}, false},
+		{"too long", []string{strings.Repeat("a", 33)}, false},
+		{"duplicate", []string{"stable", "stable"}, false},
+		{"too many", strings.Split("a,b,c,d,e,f,g,h,i,j,k", ","), false},
+	}
+
+	for _, c := range cases {
+		err := ValidateReleaseTags(c.tags)
+		if (err == nil) != c.valid {
+			t.Errorf("%s: expected valid %v, got err: %v", c.name, c.valid, err)
+		}
+	}
+
+	spec := ReleaseSpec{Tags: []string{"stable", "v1"}}
+	if !spec.HasTags([]string{"v1"}) || !spec.HasTags(nil) || spec.HasTags([]string{"v1", "canary"}) {
+		t.Errorf("unexpected HasTags result")
+	}
+}
+
+func TestReleasedGroupEffectiveReleaseID(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	cases := []struct {
+		name     string
+		pinned   uint32
+		until    *time.Time
+		expected uint32
+	}{
+		{"not pinned", 0, nil, 1},
+		{"pinned forever", 2, nil, 2},
+		{"pinned until future", 2, &future, 2},
+		{"pin expired", 2, &past, 1},
+	}
+
+	for _, c := range cases {
+		rg := ReleasedGroup{ReleaseID: 1, PinnedReleaseID: c.pinned, PinnedUntil: c.until}
+		if got := rg.EffectiveReleaseID(now); got != c.expected {
+			t.Errorf("%s: expected release %d, got %d", c.name, c.expected, got)
+		}
+	}
+}
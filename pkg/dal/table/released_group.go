@@ -36,6 +36,9 @@ var ReleasedGroupColumnDescriptor = mergeColumnDescriptors("",
 		{Column: "uid", NamedC: "uid", Type: enumor.String},
 		{Column: "edited", NamedC: "edited", Type: enumor.Boolean},
 		{Column: "biz_id", NamedC: "biz_id", Type: enumor.Numeric},
+		{Column: "pinned_release_id", NamedC: "pinned_release_id", Type: enumor.Numeric},
+		{Column: "pinned_until", NamedC: "pinned_until", Type: enumor.Time},
+		{Column: "pin_memo", NamedC: "pin_memo", Type: enumor.String},
 		{Column: "reviser", NamedC: "reviser", Type: enumor.String},
 		{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
 	})
@@ -54,9 +57,28 @@ type ReleasedGroup struct {
 	UID        string             `db:"uid" json:"uid" gorm:"column:uid"`
 	Edited     bool               `db:"edited" json:"edited" gorm:"column:edited"`
 	BizID      uint32             `db:"biz_id" json:"biz_id" gorm:"column:biz_id"`
-	Reviser    string             `db:"reviser" json:"reviser" gorm:"column:reviser"`
-	UpdatedAt  time.Time          `db:"updated_at" json:"updated_at" gorm:"column:updated_at"`
-	TenantID   string             `json:"tenant_id" gorm:"column:tenant_id"`
+	// PinnedReleaseID 分组被固定的版本，固定期间分组内的客户端只会拉取该版本，0 表示未固定
+	PinnedReleaseID uint32 `db:"pinned_release_id" json:"pinned_release_id" gorm:"column:pinned_release_id"`
+	// PinnedUntil 固定的截止时间，为空表示一直固定直到手动解除
+	PinnedUntil *time.Time `db:"pinned_until" json:"pinned_until" gorm:"column:pinned_until"`
+	PinMemo     string     `db:"pin_memo" json:"pin_memo" gorm:"column:pin_memo"`
+	Reviser     string     `db:"reviser" json:"reviser" gorm:"column:reviser"`
+	UpdatedAt   time.Time  `db:"updated_at" json:"updated_at" gorm:"column:updated_at"`
+	TenantID    string     `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// IsPinned returns whether the released group is pinned to a release at the given time.
+func (c ReleasedGroup) IsPinned(now time.Time) bool {
+	return c.PinnedReleaseID != 0 && (c.PinnedUntil == nil || c.PinnedUntil.After(now))
+}
+
+// EffectiveReleaseID returns the release which the clients in this group should use at the given time.
+func (c ReleasedGroup) EffectiveReleaseID(now time.Time) uint32 {
+	if c.IsPinned(now) {
+		return c.PinnedReleaseID
+	}
+
+	return c.ReleaseID
 }
 
 // TableName is the released group's database table name.
@@ -88,6 +110,9 @@ func (c ReleasedGroup) ValidateCreate() error {
 	if c.Mode == GroupModeDebug && c.UID == "" {
 		return errors.New("uid should be set when mode is debug")
 	}
+	if c.Mode == GroupModeDynamic && c.Selector.IsEmpty() {
+		return errors.New("selector should be set when mode is dynamic")
+	}
 
 	return nil
 }
@@ -0,0 +1,123 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+)
+
+// ReleasedGroupConfigItem is the content of a released config item which is rendered with the template
+// variables overridden by a group, the instances matched by the group get this content instead of the
+// released config item's content.
+type ReleasedGroupConfigItem struct {
+	ID         uint32                             `json:"id" gorm:"primaryKey"`
+	Spec       *ReleasedGroupConfigItemSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ReleasedGroupConfigItemAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *CreatedRevision                   `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the ReleasedGroupConfigItem's database table name.
+func (r *ReleasedGroupConfigItem) TableName() string {
+	return "released_group_config_items"
+}
+
+// AppID AuditRes interface
+func (r *ReleasedGroupConfigItem) AppID() uint32 {
+	return r.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (r *ReleasedGroupConfigItem) ResID() uint32 {
+	return r.ID
+}
+
+// ResType AuditRes interface
+func (r *ReleasedGroupConfigItem) ResType() string {
+	return "released_group_config_item"
+}
+
+// ValidateCreate validate ReleasedGroupConfigItem is valid or not when created.
+func (r *ReleasedGroupConfigItem) ValidateCreate() error {
+	if r.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	if err := r.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if r.Attachment == nil {
+		return errors.New("attachment should be set")
+	}
+
+	if err := r.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if r.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return r.Revision.Validate()
+}
+
+// ReleasedGroupConfigItemSpec defines the rendered content of the released config item.
+type ReleasedGroupConfigItemSpec struct {
+	Signature string `json:"signature" gorm:"column:signature"`
+	Md5       string `json:"md5" gorm:"column:md5"`
+	ByteSize  uint64 `json:"byte_size" gorm:"column:byte_size"`
+}
+
+// Validate whether ReleasedGroupConfigItem spec is valid or not.
+func (r *ReleasedGroupConfigItemSpec) Validate() error {
+	if len(r.Signature) != 64 {
+		return errors.New("invalid content signature, should be config's sha256 value")
+	}
+
+	return nil
+}
+
+// ReleasedGroupConfigItemAttachment defines the ReleasedGroupConfigItem attachments.
+type ReleasedGroupConfigItemAttachment struct {
+	BizID                uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID                uint32 `json:"app_id" gorm:"column:app_id"`
+	ReleaseID            uint32 `json:"release_id" gorm:"column:release_id"`
+	GroupID              uint32 `json:"group_id" gorm:"column:group_id"`
+	ReleasedConfigItemID uint32 `json:"released_config_item_id" gorm:"column:released_config_item_id"`
+	TenantID             string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// Validate whether ReleasedGroupConfigItem attachment is valid or not.
+func (r *ReleasedGroupConfigItemAttachment) Validate() error {
+	if r.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if r.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	if r.ReleaseID <= 0 {
+		return errors.New("invalid attachment release id")
+	}
+
+	if r.GroupID <= 0 {
+		return errors.New("invalid attachment group id")
+	}
+
+	if r.ReleasedConfigItemID <= 0 {
+		return errors.New("invalid attachment released config item id")
+	}
+
+	return nil
+}
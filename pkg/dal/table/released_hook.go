@@ -32,10 +32,22 @@ type ReleasedHook struct {
 	Content          string     `db:"content" json:"content" gorm:"column:content"`
 	ScriptType       ScriptType `db:"script_type" json:"script_type" gorm:"column:script_type"`
 	HookType         HookType   `db:"hook_type" json:"hook_type" gorm:"column:hook_type"`
-	BizID            uint32     `db:"biz_id" json:"biz_id" gorm:"column:biz_id"`
-	Reviser          string     `db:"reviser" json:"reviser" gorm:"column:reviser"`
-	UpdatedAt        time.Time  `db:"updated_at" json:"updated_at" gorm:"column:updated_at"`
-	TenantID         string     `json:"tenant_id" gorm:"column:tenant_id"`
+	// Params are the param values bound by the app, the content of the released hooks whose release id
+	// is not 0 have been rendered with them.
+	Params    AppVariables `db:"params" json:"params" gorm:"column:params;type:json;default:'[]'"`
+	BizID     uint32       `db:"biz_id" json:"biz_id" gorm:"column:biz_id"`
+	Reviser   string       `db:"reviser" json:"reviser" gorm:"column:reviser"`
+	UpdatedAt time.Time    `db:"updated_at" json:"updated_at" gorm:"column:updated_at"`
+	TenantID  string       `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// ParamValues returns the bound param values by name.
+func (c ReleasedHook) ParamValues() map[string]string {
+	values := make(map[string]string, len(c.Params))
+	for _, p := range c.Params {
+		values[p.Name] = p.DefaultVal
+	}
+	return values
 }
 
 // TableName is the released hook's database table name.
@@ -0,0 +1,284 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RolePermissionWildcard matches any resource type or action in the role permission.
+const RolePermissionWildcard = "*"
+
+// rolePermissionRegexp a role permission is like "app:view", "app:*" or "*:*".
+var rolePermissionRegexp = regexp.MustCompile(`^(\*|[a-z_]+):(\*|[a-z_]+)$`)
+
+// RoleScopeType is the scope type which the role is bound to.
+type RoleScopeType string
+
+const (
+	// RoleScopeBiz the role takes effect on all the resources of the biz.
+	RoleScopeBiz RoleScopeType = "biz"
+	// RoleScopeApp the role only takes effect on the app.
+	RoleScopeApp RoleScopeType = "app"
+	// RoleScopeTemplateSpace the role only takes effect on the template space.
+	RoleScopeTemplateSpace RoleScopeType = "template_space"
+)
+
+// Validate the role scope type is valid or not.
+func (t RoleScopeType) Validate() error {
+	switch t {
+	case RoleScopeBiz, RoleScopeApp, RoleScopeTemplateSpace:
+	default:
+		return fmt.Errorf("unsupported role scope type: %s", t)
+	}
+
+	return nil
+}
+
+// Role defines a role of the built-in rbac policy engine, which is a set of permissions in the biz.
+type Role struct {
+	ID         uint32          `json:"id" gorm:"primaryKey"`
+	Spec       *RoleSpec       `json:"spec" gorm:"embedded"`
+	Attachment *RoleAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision       `json:"revision" gorm:"embedded"`
+}
+
+// RoleSpec defines all the specifics for role set by user.
+type RoleSpec struct {
+	Name string `json:"name" gorm:"column:name"`
+	// Permissions is the comma separated permissions, each one is like "resource_type:action",
+	// e.g. "app:view,app:update", "*" can be used as the resource type or action.
+	Permissions string `json:"permissions" gorm:"column:permissions"`
+	Memo        string `json:"memo" gorm:"column:memo"`
+}
+
+// PermissionList returns the permissions of the role.
+func (s *RoleSpec) PermissionList() []string {
+	permissions := make([]string, 0)
+	for _, one := range strings.Split(s.Permissions, ",") {
+		one = strings.TrimSpace(one)
+		if one == "" {
+			continue
+		}
+		permissions = append(permissions, one)
+	}
+
+	return permissions
+}
+
+// RoleAttachment defines the role attachments.
+type RoleAttachment struct {
+	BizID    uint32 `json:"biz_id" gorm:"column:biz_id"`
+	TenantID string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// TableName is the role's database table name.
+func (r *Role) TableName() string {
+	return "roles"
+}
+
+// AppID AuditRes interface
+func (r *Role) AppID() uint32 {
+	return 0
+}
+
+// ResID AuditRes interface
+func (r *Role) ResID() uint32 {
+	return r.ID
+}
+
+// ResType AuditRes interface
+func (r *Role) ResType() string {
+	return "role"
+}
+
+// ValidateCreate validate role is valid or not when create it.
+func (r *Role) ValidateCreate() error {
+	if r.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if r.Attachment == nil || r.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment, biz id should be set")
+	}
+
+	if err := r.validateSpec(); err != nil {
+		return err
+	}
+
+	if r.Revision == nil || r.Revision.Creator == "" {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate role is valid or not when update it.
+func (r *Role) ValidateUpdate() error {
+	if r.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if r.Attachment == nil || r.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment, biz id should be set")
+	}
+
+	if err := r.validateSpec(); err != nil {
+		return err
+	}
+
+	if r.Revision == nil || r.Revision.Reviser == "" {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
+
+// ValidateDelete validate the role's info when delete it.
+func (r *Role) ValidateDelete() error {
+	if r.ID <= 0 {
+		return errors.New("role id should be set")
+	}
+
+	if r.Attachment == nil || r.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment, biz id should be set")
+	}
+
+	return nil
+}
+
+func (r *Role) validateSpec() error {
+	if r.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if r.Spec.Name == "" {
+		return errors.New("name can not be empty")
+	}
+
+	permissions := r.Spec.PermissionList()
+	if len(permissions) == 0 {
+		return errors.New("permissions can not be empty")
+	}
+	for _, one := range permissions {
+		if !rolePermissionRegexp.MatchString(one) {
+			return fmt.Errorf("invalid permission %s, should be like resource_type:action", one)
+		}
+	}
+
+	return nil
+}
+
+// RoleBinding defines the binding of a role to a user in the scope of biz, app or template space.
+type RoleBinding struct {
+	ID         uint32                 `json:"id" gorm:"primaryKey"`
+	Spec       *RoleBindingSpec       `json:"spec" gorm:"embedded"`
+	Attachment *RoleBindingAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision              `json:"revision" gorm:"embedded"`
+}
+
+// RoleBindingSpec defines all the specifics for role binding set by user.
+type RoleBindingSpec struct {
+	RoleID uint32 `json:"role_id" gorm:"column:role_id"`
+	// Subject is the user name which the role is bound to.
+	Subject   string        `json:"subject" gorm:"column:subject"`
+	ScopeType RoleScopeType `json:"scope_type" gorm:"column:scope_type"`
+	// ScopeID is the app id or template space id, it is 0 for biz scope.
+	ScopeID uint32 `json:"scope_id" gorm:"column:scope_id"`
+}
+
+// RoleBindingAttachment defines the role binding attachments.
+type RoleBindingAttachment struct {
+	BizID    uint32 `json:"biz_id" gorm:"column:biz_id"`
+	TenantID string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// TableName is the role binding's database table name.
+func (b *RoleBinding) TableName() string {
+	return "role_bindings"
+}
+
+// AppID AuditRes interface
+func (b *RoleBinding) AppID() uint32 {
+	if b.Spec != nil && b.Spec.ScopeType == RoleScopeApp {
+		return b.Spec.ScopeID
+	}
+	return 0
+}
+
+// ResID AuditRes interface
+func (b *RoleBinding) ResID() uint32 {
+	return b.ID
+}
+
+// ResType AuditRes interface
+func (b *RoleBinding) ResType() string {
+	return "role_binding"
+}
+
+// ValidateCreate validate role binding is valid or not when create it.
+func (b *RoleBinding) ValidateCreate() error {
+	if b.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if b.Attachment == nil || b.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment, biz id should be set")
+	}
+
+	if b.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if b.Spec.RoleID <= 0 {
+		return errors.New("role id should be set")
+	}
+
+	if b.Spec.Subject == "" {
+		return errors.New("subject can not be empty")
+	}
+
+	if err := b.Spec.ScopeType.Validate(); err != nil {
+		return err
+	}
+
+	if b.Spec.ScopeType == RoleScopeBiz && b.Spec.ScopeID != 0 {
+		return errors.New("scope id should not be set for biz scope")
+	}
+
+	if b.Spec.ScopeType != RoleScopeBiz && b.Spec.ScopeID <= 0 {
+		return fmt.Errorf("scope id should be set for %s scope", b.Spec.ScopeType)
+	}
+
+	if b.Revision == nil || b.Revision.Creator == "" {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// ValidateDelete validate the role binding's info when delete it.
+func (b *RoleBinding) ValidateDelete() error {
+	if b.ID <= 0 {
+		return errors.New("role binding id should be set")
+	}
+
+	if b.Attachment == nil || b.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment, biz id should be set")
+	}
+
+	return nil
+}
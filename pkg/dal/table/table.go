@@ -263,6 +263,38 @@ const (
 	ConfigTemplatesTable Name = "config_templates"
 	// ConfigInstancesTable is config_instances table's name
 	ConfigInstancesTable Name = "config_instances"
+	// ClientAlertRulesTable is client_alert_rules table's name
+	ClientAlertRulesTable Name = "client_alert_rules"
+	// ClientAlertHistoriesTable is client_alert_histories table's name
+	ClientAlertHistoriesTable Name = "client_alert_histories"
+	// ClientMetricsTable is client_metrics table's name
+	ClientMetricsTable Name = "client_metrics"
+	// CredentialClientsTable is credential_clients table's name
+	CredentialClientsTable Name = "credential_clients"
+	// TemplateSpaceHooksTable is template_space_hooks table's name
+	TemplateSpaceHooksTable Name = "template_space_hooks"
+	// RolesTable is roles table's name
+	RolesTable Name = "roles"
+	// RoleBindingsTable is role_bindings table's name
+	RoleBindingsTable Name = "role_bindings"
+	// GroupTemplateVariablesTable is group_template_variables table's name
+	GroupTemplateVariablesTable Name = "group_template_variables"
+	// ReleasedGroupConfigItemsTable is released_group_config_items table's name
+	ReleasedGroupConfigItemsTable Name = "released_group_config_items"
+	// GitRepoLinksTable is git_repo_links table's name
+	GitRepoLinksTable Name = "git_repo_links"
+	// ChangeFreezesTable is change_freezes table's name
+	ChangeFreezesTable Name = "change_freezes"
+	// WebhooksTable is webhooks table's name
+	WebhooksTable Name = "webhooks"
+	// WebhookDeliveriesTable is webhook_deliveries table's name
+	WebhookDeliveriesTable Name = "webhook_deliveries"
+	// ApprovalFlowsTable is approval_flows table's name
+	ApprovalFlowsTable Name = "approval_flows"
+	// ApprovalTicketsTable is approval_tickets table's name
+	ApprovalTicketsTable Name = "approval_tickets"
+	// ApprovalActionsTable is approval_actions table's name
+	ApprovalActionsTable Name = "approval_actions"
 )
 
 // RevisionColumns defines all the Revision table's columns.
@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+)
+
+// TemplateSpaceHook is a hook of the biz hook library which is imported into a template space,
+// so that the apps using the templates of the space can reuse it.
+type TemplateSpaceHook struct {
+	ID         uint32                       `json:"id" gorm:"primaryKey"`
+	Attachment *TemplateSpaceHookAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *CreatedRevision             `json:"revision" gorm:"embedded"`
+}
+
+// TemplateSpaceHookAttachment defines the template space hook attachments.
+type TemplateSpaceHookAttachment struct {
+	BizID           uint32 `json:"biz_id" gorm:"column:biz_id"`
+	TemplateSpaceID uint32 `json:"template_space_id" gorm:"column:template_space_id"`
+	HookID          uint32 `json:"hook_id" gorm:"column:hook_id"`
+	TenantID        string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// TableName is the template space hook's database table name.
+func (t *TemplateSpaceHook) TableName() string {
+	return "template_space_hooks"
+}
+
+// AppID AuditRes interface
+func (t *TemplateSpaceHook) AppID() uint32 {
+	return 0
+}
+
+// ResID AuditRes interface
+func (t *TemplateSpaceHook) ResID() uint32 {
+	return t.ID
+}
+
+// ResType AuditRes interface
+func (t *TemplateSpaceHook) ResType() string {
+	return string(enumor.Hook)
+}
+
+// ValidateCreate validate template space hook is valid or not when create it.
+func (t *TemplateSpaceHook) ValidateCreate() error {
+	if t.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if t.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := t.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if t.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	if err := t.Revision.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Validate whether template space hook attachment is valid or not.
+func (t *TemplateSpaceHookAttachment) Validate() error {
+	if t.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if t.TemplateSpaceID <= 0 {
+		return errors.New("invalid attachment template space id")
+	}
+
+	if t.HookID <= 0 {
+		return errors.New("invalid attachment hook id")
+	}
+
+	return nil
+}
@@ -13,7 +13,13 @@
 package table
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
@@ -135,23 +141,28 @@ func (t *TemplateVariable) ValidateDelete() error {
 
 // TemplateVariableSpec defines all the specifics for template variable set by user.
 type TemplateVariableSpec struct {
-	Name       string       `json:"name" gorm:"column:name"`
-	Type       VariableType `json:"type" gorm:"column:type"`
-	DefaultVal string       `json:"default_val" gorm:"column:default_val"`
-	Memo       string       `json:"memo" gorm:"column:memo"`
+	Name        string               `json:"name" gorm:"column:name"`
+	Type        VariableType         `json:"type" gorm:"column:type"`
+	DefaultVal  string               `json:"default_val" gorm:"column:default_val"`
+	Memo        string               `json:"memo" gorm:"column:memo"`
+	Constraints *VariableConstraints `json:"constraints,omitempty" gorm:"column:constraints;type:json"`
 }
 
 // ValidateCreate validate template variable spec when it is created.
 func (t *TemplateVariableSpec) ValidateCreate(kit *kit.Kit) error {
-	if err := t.ValidateDefaultVal(kit); err != nil {
+	if err := validator.ValidateVariableName(kit, t.Name); err != nil {
 		return err
 	}
 
-	if err := validator.ValidateVariableName(kit, t.Name); err != nil {
+	if err := t.Type.Validate(kit); err != nil {
 		return err
 	}
 
-	if err := t.Type.Validate(kit); err != nil {
+	if err := t.Constraints.Validate(kit, t.Type); err != nil {
+		return err
+	}
+
+	if err := t.ValidateDefaultVal(kit); err != nil {
 		return err
 	}
 
@@ -160,6 +171,10 @@ func (t *TemplateVariableSpec) ValidateCreate(kit *kit.Kit) error {
 
 // ValidateUpdate validate template variable spec when it is updated.
 func (t *TemplateVariableSpec) ValidateUpdate(kit *kit.Kit) error {
+	if err := t.Constraints.Validate(kit, t.Type); err != nil {
+		return err
+	}
+
 	if err := t.ValidateDefaultVal(kit); err != nil {
 		return err
 	}
@@ -173,13 +188,171 @@ func (t *TemplateVariableSpec) ValidateUpdate(kit *kit.Kit) error {
 
 // ValidateDefaultVal validate template variable default value.
 func (t *TemplateVariableSpec) ValidateDefaultVal(kit *kit.Kit) error {
-	if t.Type == NumberVar && !tools.IsNumber(t.DefaultVal) {
-		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "default_val %s is not a number type", t.DefaultVal))
+	// the value of secret variable is kept in vault, only the mask is passed around.
+	if t.Type == SecretVar && (t.DefaultVal == "" || t.DefaultVal == SecretMask) {
+		return nil
+	}
+
+	return t.ValidateValue(kit, t.DefaultVal)
+}
+
+// ValidateValue validate the value matches the type and the constraints of the variable.
+func (t *TemplateVariableSpec) ValidateValue(kit *kit.Kit, val string) error {
+	switch t.Type {
+	case NumberVar:
+		if !tools.IsNumber(val) {
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "default_val %s is not a number type", val))
+		}
+	case BoolVar:
+		if val != "true" && val != "false" {
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "value of variable %s should be true or false", t.Name))
+		}
+	case JsonVar:
+		if !json.Valid([]byte(val)) {
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "value of variable %s is not a valid json", t.Name))
+		}
+	}
+
+	return t.Constraints.check(kit, t.Name, t.Type, val)
+}
+
+// IsSecret returns whether the variable is a secret variable.
+func (t *TemplateVariableSpec) IsSecret() bool {
+	return t.Type == SecretVar
+}
+
+// Masked returns a copy of the variable whose secret value is replaced with the mask.
+func (t *TemplateVariableSpec) Masked() *TemplateVariableSpec {
+	if !t.IsSecret() || t.DefaultVal == "" {
+		return t
+	}
+
+	masked := *t
+	masked.DefaultVal = SecretMask
+	return &masked
+}
+
+// VariableConstraints defines the constraints of the template variable value.
+type VariableConstraints struct {
+	// Pattern is the regular expression that the value of string, text, secret variable
+	// or each item of list variable should match.
+	Pattern string `json:"pattern,omitempty"`
+	// Min is the minimum of the number variable value.
+	Min *float64 `json:"min,omitempty"`
+	// Max is the maximum of the number variable value.
+	Max *float64 `json:"max,omitempty"`
+	// Options is the allowed values of enum variable.
+	Options []string `json:"options,omitempty"`
+}
+
+// Validate whether the constraints are valid for the variable type.
+func (c *VariableConstraints) Validate(kit *kit.Kit, typ VariableType) error {
+	if c == nil {
+		if typ == EnumVar {
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "options of enum variable is required"))
+		}
+		return nil
+	}
+
+	if c.Pattern != "" {
+		if typ != StringVar && typ != TextVar && typ != SecretVar && typ != ListVar {
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "pattern is not supported by %s variable", typ))
+		}
+		if _, err := regexp.Compile(c.Pattern); err != nil {
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "invalid pattern %s, err: %v", c.Pattern, err))
+		}
+	}
+
+	if c.Min != nil || c.Max != nil {
+		if typ != NumberVar {
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "range is not supported by %s variable", typ))
+		}
+		if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "min %v is greater than max %v", *c.Min, *c.Max))
+		}
+	}
+
+	if typ == EnumVar && len(c.Options) == 0 {
+		return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "options of enum variable is required"))
+	}
+	if typ != EnumVar && len(c.Options) > 0 {
+		return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "options is not supported by %s variable", typ))
+	}
+
+	return nil
+}
+
+// check whether the value satisfies the constraints.
+func (c *VariableConstraints) check(kit *kit.Kit, name string, typ VariableType, val string) error {
+	if c == nil {
+		return nil
+	}
+
+	switch typ {
+	case NumberVar:
+		num, _ := strconv.ParseFloat(val, 64)
+		if c.Min != nil && num < *c.Min {
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "value of variable %s is less than %v", name, *c.Min))
+		}
+		if c.Max != nil && num > *c.Max {
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "value of variable %s is greater than %v", name, *c.Max))
+		}
+	case EnumVar:
+		for _, opt := range c.Options {
+			if opt == val {
+				return nil
+			}
+		}
+		return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "value of variable %s should be one of %v", name, c.Options))
+	case ListVar:
+		if c.Pattern == "" || val == "" {
+			return nil
+		}
+		re := regexp.MustCompile(c.Pattern)
+		for _, item := range strings.Split(val, ListVarSeparator) {
+			if !re.MatchString(strings.TrimSpace(item)) {
+				return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "item %s of variable %s does not match %s",
+					item, name, c.Pattern))
+			}
+		}
+	case StringVar, TextVar, SecretVar:
+		if c.Pattern != "" && !regexp.MustCompile(c.Pattern).MatchString(val) {
+			// the secret value should not appear in the error message.
+			if typ == SecretVar {
+				return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "value of variable %s does not match %s",
+					name, c.Pattern))
+			}
+			return errf.Errorf(errf.InvalidArgument, "%s", i18n.T(kit, "value %s of variable %s does not match %s",
+				val, name, c.Pattern))
+		}
 	}
 
 	return nil
 }
 
+// Value implements the driver.Valuer interface
+func (c VariableConstraints) Value() (driver.Value, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface
+func (c *VariableConstraints) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("unsupported variable constraints type: %T", value)
+	}
+}
+
 // TemplateVariableAttachment defines the template variable attachments.
 type TemplateVariableAttachment struct {
 	BizID    uint32 `json:"biz_id" gorm:"column:biz_id"`
@@ -202,6 +375,23 @@ const (
 	NumberVar VariableType = "number"
 	// TextVar is text type variable
 	TextVar VariableType = "text"
+	// BoolVar is bool type variable, the value is true or false
+	BoolVar VariableType = "bool"
+	// EnumVar is enum type variable, the value is one of the options
+	EnumVar VariableType = "enum"
+	// ListVar is list type variable, the items of the value are separated by ListVarSeparator
+	ListVar VariableType = "list"
+	// JsonVar is json type variable
+	JsonVar VariableType = "json"
+	// SecretVar is secret type variable, the value is stored in vault and masked when it's returned
+	SecretVar VariableType = "secret"
+)
+
+const (
+	// SecretMask is the mask of the secret variable value
+	SecretMask = "******"
+	// ListVarSeparator is the separator of the list variable items
+	ListVarSeparator = ","
 )
 
 // VariableType is template variable type
@@ -213,6 +403,11 @@ func (t VariableType) Validate(kit *kit.Kit) error {
 	case StringVar:
 	case NumberVar:
 	case TextVar:
+	case BoolVar:
+	case EnumVar:
+	case ListVar:
+	case JsonVar:
+	case SecretVar:
 	default:
 		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "unsupported variable type: %s", t))
 	}
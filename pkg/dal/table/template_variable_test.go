@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func TestTemplateVariableSpecValidateValue(t *testing.T) {
+	kt := kit.New()
+	minPort, maxPort := 1.0, 65535.0
+
+	cases := []struct {
+		name  string
+		spec  *TemplateVariableSpec
+		value string
+		valid bool
+	}{
+		{"number in range", &TemplateVariableSpec{Type: NumberVar,
+			Constraints: &VariableConstraints{Min: &minPort, Max: &maxPort}}, "8080", true},
+		{"number out of range", &TemplateVariableSpec{Type: NumberVar,
+			Constraints: &VariableConstraints{Min: &minPort, Max: &maxPort}}, "70000", false},
+		{"bool", &TemplateVariableSpec{Type: BoolVar}, "true", true},
+		{"invalid bool", &TemplateVariableSpec{Type: BoolVar}, "yes", false},
+		{"enum option", &TemplateVariableSpec{Type: EnumVar,
+			Constraints: &VariableConstraints{Options: []string{"debug", "info"}}}, "info", true},
+		{"enum not option", &TemplateVariableSpec{Type: EnumVar,
+			Constraints: &VariableConstraints{Options: []string{"debug", "info"}}}, "warn", false},
+		{"list items match", &TemplateVariableSpec{Type: ListVar,
+			Constraints: &VariableConstraints{Pattern: `^\d+$`}}, "1, 2,3", true},
+		{"list item not match", &TemplateVariableSpec{Type: ListVar,
+			Constraints: &VariableConstraints{Pattern: `^\d+$`}}, "1,a", false},
+		{"json", &TemplateVariableSpec{Type: JsonVar}, `{"a": 1}`, true},
+		{"invalid json", &TemplateVariableSpec{Type: JsonVar}, `{a: 1}`, false},
+		{"string pattern", &TemplateVariableSpec{Type: StringVar,
+			Constraints: &VariableConstraints{Pattern: `^[a-z]+$`}}, "abc", true},
+		{"secret not match", &TemplateVariableSpec{Type: SecretVar,
+			Constraints: &VariableConstraints{Pattern: `^.{8,}$`}}, "short", false},
+	}
+
+	for _, c := range cases {
+		err := c.spec.ValidateValue(kt, c.value)
+		if c.valid && err != nil {
+			t.Errorf("%s: expect valid, got err: %v", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%s: expect invalid, got nil err", c.name)
+		}
+	}
+}
+
+func TestVariableConstraintsValidate(t *testing.T) {
+	kt := kit.New()
+	minVal, maxVal := 10.0, 1.0
+
+	if err := (*VariableConstraints)(nil).Validate(kt, EnumVar); err == nil {
+		t.Errorf("expect error for enum variable without options")
+	}
+	if err := (&VariableConstraints{Min: &minVal, Max: &maxVal}).Validate(kt, NumberVar); err == nil {
+		t.Errorf("expect error for min greater than max")
+	}
+	if err := (&VariableConstraints{Pattern: "("}).Validate(kt, StringVar); err == nil {
+		t.Errorf("expect error for invalid pattern")
+	}
+	if err := (&VariableConstraints{Pattern: "a"}).Validate(kt, NumberVar); err == nil {
+		t.Errorf("expect error for pattern of number variable")
+	}
+}
+
+func TestTemplateVariableSpecMasked(t *testing.T) {
+	spec := &TemplateVariableSpec{Name: "bk_bscp_password", Type: SecretVar, DefaultVal: "p@ss"}
+	if got := spec.Masked().DefaultVal; got != SecretMask {
+		t.Errorf("expect masked value, got %s", got)
+	}
+	if spec.DefaultVal != "p@ss" {
+		t.Errorf("masking should not modify the origin variable")
+	}
+	if err := spec.ValidateDefaultVal(kit.New()); err != nil {
+		t.Errorf("unexpected err: %v", err)
+	}
+
+	plain := &TemplateVariableSpec{Name: "bk_bscp_host", Type: StringVar, DefaultVal: "localhost"}
+	if plain.Masked().DefaultVal != "localhost" {
+		t.Errorf("non-secret variable should not be masked")
+	}
+}
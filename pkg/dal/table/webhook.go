@@ -0,0 +1,306 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/types"
+)
+
+// WebhookEventType is the type of the event which is delivered to the webhooks.
+type WebhookEventType string
+
+const (
+	// WebhookReleaseCreated a release of the app is created.
+	WebhookReleaseCreated WebhookEventType = "release.created"
+	// WebhookReleasePublished a release of the app is published.
+	WebhookReleasePublished WebhookEventType = "release.published"
+	// WebhookReleaseRolledBack a release of the app is rolled back.
+	WebhookReleaseRolledBack WebhookEventType = "release.rolled_back"
+	// WebhookApprovalPassed the publish approval is passed.
+	WebhookApprovalPassed WebhookEventType = "approval.passed"
+	// WebhookApprovalRejected the publish approval is rejected.
+	WebhookApprovalRejected WebhookEventType = "approval.rejected"
+	// WebhookHookPublished a revision of the hook script is published.
+	WebhookHookPublished WebhookEventType = "hook.published"
+	// WebhookClientFailureSpike the failed ratio of the clients changing release exceeds the alert threshold.
+	WebhookClientFailureSpike WebhookEventType = "client.failure_spike"
+)
+
+// WebhookEventTypes is all the supported webhook event types.
+var WebhookEventTypes = []WebhookEventType{
+	WebhookReleaseCreated, WebhookReleasePublished, WebhookReleaseRolledBack, WebhookApprovalPassed,
+	WebhookApprovalRejected, WebhookHookPublished, WebhookClientFailureSpike,
+}
+
+// Validate the webhook event type is supported or not.
+func (t WebhookEventType) Validate() error {
+	for _, one := range WebhookEventTypes {
+		if t == one {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported webhook event type %s", t)
+}
+
+// Webhook subscribes the events of the biz or the app, the events are posted to the url and signed
+// with the secret kept in vault.
+type Webhook struct {
+	ID         uint32             `json:"id" gorm:"primaryKey"`
+	Spec       *WebhookSpec       `json:"spec" gorm:"embedded"`
+	Attachment *WebhookAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision          `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the webhook's database table name.
+func (w *Webhook) TableName() string {
+	return "webhooks"
+}
+
+// AppID AuditRes interface
+func (w *Webhook) AppID() uint32 {
+	return w.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (w *Webhook) ResID() uint32 {
+	return w.ID
+}
+
+// ResType AuditRes interface
+func (w *Webhook) ResType() string {
+	return string(enumor.Webhook)
+}
+
+// ValidateCreate validate webhook is valid or not when create it.
+func (w *Webhook) ValidateCreate() error {
+	if w.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if w.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	if err := w.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if w.Attachment == nil || w.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment, biz id should be set")
+	}
+
+	if w.Revision == nil || w.Revision.Creator == "" {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate webhook is valid or not when update it.
+func (w *Webhook) ValidateUpdate() error {
+	if w.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if w.Spec == nil {
+		return errors.New("spec should be set")
+	}
+
+	if err := w.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if w.Attachment == nil || w.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment, biz id should be set")
+	}
+
+	if w.Revision == nil || w.Revision.Reviser == "" {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
+
+// Subscribes returns whether the event of the app is subscribed by the webhook, the webhook
+// without app subscribes the events of all the apps of the biz.
+func (w *Webhook) Subscribes(appID uint32, eventType WebhookEventType) bool {
+	if !w.Spec.Enabled {
+		return false
+	}
+
+	if w.Attachment.AppID != 0 && w.Attachment.AppID != appID {
+		return false
+	}
+
+	for _, one := range w.Spec.EventTypes {
+		if one == string(eventType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookSpec defines all the specifics for webhook set by user.
+type WebhookSpec struct {
+	Name string `json:"name" gorm:"column:name"`
+	// URL is the http(s) url which the events are posted to.
+	URL        string            `json:"url" gorm:"column:url"`
+	EventTypes types.StringSlice `json:"event_types" gorm:"column:event_types;type:json;default:'[]'"`
+	Enabled    bool              `json:"enabled" gorm:"column:enabled"`
+	Memo       string            `json:"memo" gorm:"column:memo"`
+}
+
+// Validate the webhook spec is valid or not.
+func (s *WebhookSpec) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	if err := ValidateWebhookURL(s.URL); err != nil {
+		return err
+	}
+
+	if len(s.EventTypes) == 0 {
+		return errors.New("at least one event type is required")
+	}
+
+	for _, one := range s.EventTypes {
+		if err := WebhookEventType(one).Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateWebhookURL validate the url set by the users to be posted to, only the http(s) url of the public
+// address is allowed, the host name is checked again with its resolved ip when it is posted to.
+func ValidateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url %s, only http(s) url is supported", raw)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	ip := net.ParseIP(host)
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || (ip != nil && WebhookForbiddenIP(ip)) {
+		return fmt.Errorf("invalid url %s, loopback, private and link-local addresses are not allowed", raw)
+	}
+
+	return nil
+}
+
+// WebhookForbiddenIP returns whether the ip is a loopback, private, link-local or unspecified address, which
+// the webhooks can not post to, so that the webhooks can not be used to reach the internal services.
+func WebhookForbiddenIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsUnspecified()
+}
+
+// WebhookAttachment defines the webhook attachments, app id 0 means the webhook subscribes the events
+// of all the apps of the biz.
+type WebhookAttachment struct {
+	BizID    uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID    uint32 `json:"app_id" gorm:"column:app_id"`
+	TenantID string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// WebhookDeliveryStatus is the status of the webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending the delivery is waiting to be sent or retried.
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliverySuccess the delivery is responded with 2xx.
+	WebhookDeliverySuccess WebhookDeliveryStatus = "success"
+	// WebhookDeliveryFailed the delivery is failed after all the attempts.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookMaxResponseLength is the max length of the response body and the error which are recorded.
+const WebhookMaxResponseLength = 1024
+
+// WebhookDelivery is the delivery log of an event to a webhook, it is retried with backoff until
+// succeed or the attempts are exhausted.
+type WebhookDelivery struct {
+	ID         uint32                     `json:"id" gorm:"primaryKey"`
+	Spec       *WebhookDeliverySpec       `json:"spec" gorm:"embedded"`
+	Status     *WebhookDeliveryStatusInfo `json:"status" gorm:"embedded"`
+	Attachment *WebhookDeliveryAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                  `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the webhook delivery's database table name.
+func (d *WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookDeliverySpec defines the event which is delivered.
+type WebhookDeliverySpec struct {
+	EventType WebhookEventType `json:"event_type" gorm:"column:event_type"`
+	// EventID is the unique id of the event, the redeliveries of the event share the same event id.
+	EventID string `json:"event_id" gorm:"column:event_id"`
+	// Payload is the json body which is posted to the webhook.
+	Payload string `json:"payload" gorm:"column:payload"`
+}
+
+// WebhookDeliveryStatusInfo defines the status of the webhook delivery.
+type WebhookDeliveryStatusInfo struct {
+	Status        WebhookDeliveryStatus `json:"status" gorm:"column:status"`
+	Attempts      uint32                `json:"attempts" gorm:"column:attempts"`
+	NextAttemptAt *time.Time            `json:"next_attempt_at" gorm:"column:next_attempt_at"`
+	ResponseCode  uint32                `json:"response_code" gorm:"column:response_code"`
+	ResponseBody  string                `json:"response_body" gorm:"column:response_body"`
+	LastError     string                `json:"last_error" gorm:"column:last_error"`
+	DeliveredAt   *time.Time            `json:"delivered_at" gorm:"column:delivered_at"`
+}
+
+// WebhookDeliveryAttachment defines the webhook delivery attachments.
+type WebhookDeliveryAttachment struct {
+	BizID     uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID     uint32 `json:"app_id" gorm:"column:app_id"`
+	WebhookID uint32 `json:"webhook_id" gorm:"column:webhook_id"`
+	TenantID  string `json:"tenant_id" gorm:"column:tenant_id"`
+}
+
+// WebhookRetryDelay returns the delay before the next attempt after the attempts are failed, the delay
+// doubles from the base after each attempt and is capped by the max.
+func WebhookRetryDelay(base, maxDelay time.Duration, attempts uint32) time.Duration {
+	if attempts == 0 {
+		return 0
+	}
+
+	delay := base
+	for i := uint32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+
+	if delay > maxDelay {
+		return maxDelay
+	}
+
+	return delay
+}
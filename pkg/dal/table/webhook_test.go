@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/types"
+)
+
+func TestWebhookSpecValidate(t *testing.T) {
+	cases := []struct {
+		name  string
+		spec  *WebhookSpec
+		valid bool
+	}{
+		{"valid", &WebhookSpec{Name: "ci", URL: "https://ci.example.com/hook",
+			EventTypes: types.StringSlice{string(WebhookReleasePublished)}}, true},
+		{"empty name", &WebhookSpec{URL: "https://ci.example.com/hook",
+			EventTypes: types.StringSlice{string(WebhookReleasePublished)}}, false},
+		{"invalid scheme", &WebhookSpec{Name: "ci", URL: "ftp://ci.example.com/hook",
+			EventTypes: types.StringSlice{string(WebhookReleasePublished)}}, false},
+		{"no event type", &WebhookSpec{Name: "ci", URL: "https://ci.example.com/hook"}, false},
+		{"loopback", &WebhookSpec{Name: "ci", URL: "http://127.0.0.1:8080/hook",
+			EventTypes: types.StringSlice{string(WebhookReleasePublished)}}, false},
+		{"localhost", &WebhookSpec{Name: "ci", URL: "http://localhost/hook",
+			EventTypes: types.StringSlice{string(WebhookReleasePublished)}}, false},
+		{"private", &WebhookSpec{Name: "ci", URL: "http://10.0.0.1/hook",
+			EventTypes: types.StringSlice{string(WebhookReleasePublished)}}, false},
+		{"link-local", &WebhookSpec{Name: "ci", URL: "http://169.254.169.254/latest/meta-data",
+			EventTypes: types.StringSlice{string(WebhookReleasePublished)}}, false},
+		{"ipv6 loopback", &WebhookSpec{Name: "ci", URL: "http://[::1]/hook",
+			EventTypes: types.StringSlice{string(WebhookReleasePublished)}}, false},
+		{"unsupported event type", &WebhookSpec{Name: "ci", URL: "https://ci.example.com/hook",
+			EventTypes: types.StringSlice{"release.deleted"}}, false},
+	}
+
+	for _, c := range cases {
+		if err := c.spec.Validate(); (err == nil) != c.valid {
+			t.Errorf("%s: expect valid %v, got err: %v", c.name, c.valid, err)
+		}
+	}
+}
+
+func TestWebhookSubscribes(t *testing.T) {
+	events := types.StringSlice{string(WebhookReleasePublished), string(WebhookApprovalRejected)}
+	bizWide := &Webhook{Spec: &WebhookSpec{EventTypes: events, Enabled: true},
+		Attachment: &WebhookAttachment{BizID: 1}}
+	appOnly := &Webhook{Spec: &WebhookSpec{EventTypes: events, Enabled: true},
+		Attachment: &WebhookAttachment{BizID: 1, AppID: 2}}
+	disabled := &Webhook{Spec: &WebhookSpec{EventTypes: events}, Attachment: &WebhookAttachment{BizID: 1}}
+
+	cases := []struct {
+		name       string
+		webhook    *Webhook
+		appID      uint32
+		eventType  WebhookEventType
+		subscribed bool
+	}{
+		{"biz wide", bizWide, 3, WebhookReleasePublished, true},
+		{"biz wide biz level event", bizWide, 0, WebhookApprovalRejected, true},
+		{"not subscribed event", bizWide, 3, WebhookReleaseCreated, false},
+		{"same app", appOnly, 2, WebhookReleasePublished, true},
+		{"other app", appOnly, 3, WebhookReleasePublished, false},
+		{"biz level event to app webhook", appOnly, 0, WebhookReleasePublished, false},
+		{"disabled", disabled, 3, WebhookReleasePublished, false},
+	}
+
+	for _, c := range cases {
+		if got := c.webhook.Subscribes(c.appID, c.eventType); got != c.subscribed {
+			t.Errorf("%s: expect subscribed %v, got %v", c.name, c.subscribed, got)
+		}
+	}
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	base, maxDelay := 30*time.Second, 5*time.Minute
+	cases := []struct {
+		attempts uint32
+		expect   time.Duration
+	}{
+		{0, 0},
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{4, 4 * time.Minute},
+		{5, 5 * time.Minute},
+		{100, 5 * time.Minute},
+	}
+
+	for _, c := range cases {
+		if got := WebhookRetryDelay(base, maxDelay, c.attempts); got != c.expect {
+			t.Errorf("attempts %d: expect delay %s, got %s", c.attempts, c.expect, got)
+		}
+	}
+}
@@ -31,6 +31,7 @@ func genBusinessManagementActionGroups() []client.ActionGroup {
 			NameEn: "Business",
 			Actions: []client.ActionWithID{
 				{ID: BusinessViewResource},
+				{ID: BusinessManage},
 			},
 		},
 		{
@@ -275,6 +275,16 @@ func genBusinessActions() []client.ResourceAction {
 		Version:              1,
 	})
 
+	actions = append(actions, client.ResourceAction{
+		ID:                   BusinessManage,
+		Name:                 ActionIDNameMap[BusinessManage],
+		NameEn:               "Manage Business",
+		Type:                 Manage,
+		RelatedResourceTypes: businessResource,
+		RelatedActions:       []client.ActionID{BusinessViewResource},
+		Version:              1,
+	})
+
 	return actions
 }
 
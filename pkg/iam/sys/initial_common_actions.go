@@ -41,6 +41,7 @@ func genBizCommonActions() []client.CommonAction {
 			EnglishName: "business ops",
 			Actions: []client.ActionWithID{
 				{ID: BusinessViewResource},
+				{ID: BusinessManage},
 				{ID: AppCreate},
 				{ID: AppView},
 				{ID: AppEdit},
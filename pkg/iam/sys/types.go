@@ -45,6 +45,8 @@ const (
 const (
 	// BusinessViewResource business view.
 	BusinessViewResource client.ActionID = "find_business_resource"
+	// BusinessManage business manage, e.g. manage the biz level change freezes and webhooks, test hook scripts.
+	BusinessManage client.ActionID = "business_manage"
 
 	// AppCreate app create.
 	AppCreate client.ActionID = "app_create"
@@ -109,6 +111,7 @@ const (
 // ActionIDNameMap is action id type map.
 var ActionIDNameMap = map[client.ActionID]string{
 	BusinessViewResource: "业务访问",
+	BusinessManage:       "业务管理",
 
 	AppCreate:               "服务创建",
 	AppView:                 "服务查看",
@@ -8,6 +8,7 @@ package pbas
 
 import (
 	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	role "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/role"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -24,6 +25,793 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CreateRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId       uint32   `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Memo        string   `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *CreateRoleReq) Reset() {
+	*x = CreateRoleReq{}
+	mi := &file_auth_server_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleReq) ProtoMessage() {}
+
+func (x *CreateRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleReq.ProtoReflect.Descriptor instead.
+func (*CreateRoleReq) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRoleReq) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *CreateRoleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoleReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *CreateRoleReq) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type CreateRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateRoleResp) Reset() {
+	*x = CreateRoleResp{}
+	mi := &file_auth_server_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleResp) ProtoMessage() {}
+
+func (x *CreateRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleResp.ProtoReflect.Descriptor instead.
+func (*CreateRoleResp) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRoleResp) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId       uint32   `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	RoleId      uint32   `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Name        string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Memo        string   `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *UpdateRoleReq) Reset() {
+	*x = UpdateRoleReq{}
+	mi := &file_auth_server_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleReq) ProtoMessage() {}
+
+func (x *UpdateRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleReq.ProtoReflect.Descriptor instead.
+func (*UpdateRoleReq) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateRoleReq) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *UpdateRoleReq) GetRoleId() uint32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *UpdateRoleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoleReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *UpdateRoleReq) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type UpdateRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateRoleResp) Reset() {
+	*x = UpdateRoleResp{}
+	mi := &file_auth_server_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResp) ProtoMessage() {}
+
+func (x *UpdateRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleResp.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResp) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{3}
+}
+
+type DeleteRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId  uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	RoleId uint32 `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+}
+
+func (x *DeleteRoleReq) Reset() {
+	*x = DeleteRoleReq{}
+	mi := &file_auth_server_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleReq) ProtoMessage() {}
+
+func (x *DeleteRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleReq.ProtoReflect.Descriptor instead.
+func (*DeleteRoleReq) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteRoleReq) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *DeleteRoleReq) GetRoleId() uint32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+type DeleteRoleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteRoleResp) Reset() {
+	*x = DeleteRoleResp{}
+	mi := &file_auth_server_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResp) ProtoMessage() {}
+
+func (x *DeleteRoleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleResp.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResp) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{5}
+}
+
+type ListRolesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Start uint32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	All   bool   `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *ListRolesReq) Reset() {
+	*x = ListRolesReq{}
+	mi := &file_auth_server_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesReq) ProtoMessage() {}
+
+func (x *ListRolesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesReq.ProtoReflect.Descriptor instead.
+func (*ListRolesReq) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListRolesReq) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ListRolesReq) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ListRolesReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRolesReq) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type ListRolesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   uint32       `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Details []*role.Role `protobuf:"bytes,2,rep,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *ListRolesResp) Reset() {
+	*x = ListRolesResp{}
+	mi := &file_auth_server_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResp) ProtoMessage() {}
+
+func (x *ListRolesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResp.ProtoReflect.Descriptor instead.
+func (*ListRolesResp) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListRolesResp) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ListRolesResp) GetDetails() []*role.Role {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type CreateRoleBindingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId     uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	RoleId    uint32 `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Subject   string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	ScopeType string `protobuf:"bytes,4,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"`
+	ScopeId   uint32 `protobuf:"varint,5,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+}
+
+func (x *CreateRoleBindingReq) Reset() {
+	*x = CreateRoleBindingReq{}
+	mi := &file_auth_server_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleBindingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleBindingReq) ProtoMessage() {}
+
+func (x *CreateRoleBindingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleBindingReq.ProtoReflect.Descriptor instead.
+func (*CreateRoleBindingReq) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateRoleBindingReq) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *CreateRoleBindingReq) GetRoleId() uint32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *CreateRoleBindingReq) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CreateRoleBindingReq) GetScopeType() string {
+	if x != nil {
+		return x.ScopeType
+	}
+	return ""
+}
+
+func (x *CreateRoleBindingReq) GetScopeId() uint32 {
+	if x != nil {
+		return x.ScopeId
+	}
+	return 0
+}
+
+type CreateRoleBindingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateRoleBindingResp) Reset() {
+	*x = CreateRoleBindingResp{}
+	mi := &file_auth_server_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleBindingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleBindingResp) ProtoMessage() {}
+
+func (x *CreateRoleBindingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleBindingResp.ProtoReflect.Descriptor instead.
+func (*CreateRoleBindingResp) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateRoleBindingResp) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteRoleBindingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId     uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	BindingId uint32 `protobuf:"varint,2,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+}
+
+func (x *DeleteRoleBindingReq) Reset() {
+	*x = DeleteRoleBindingReq{}
+	mi := &file_auth_server_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleBindingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleBindingReq) ProtoMessage() {}
+
+func (x *DeleteRoleBindingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleBindingReq.ProtoReflect.Descriptor instead.
+func (*DeleteRoleBindingReq) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteRoleBindingReq) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *DeleteRoleBindingReq) GetBindingId() uint32 {
+	if x != nil {
+		return x.BindingId
+	}
+	return 0
+}
+
+type DeleteRoleBindingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteRoleBindingResp) Reset() {
+	*x = DeleteRoleBindingResp{}
+	mi := &file_auth_server_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleBindingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleBindingResp) ProtoMessage() {}
+
+func (x *DeleteRoleBindingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleBindingResp.ProtoReflect.Descriptor instead.
+func (*DeleteRoleBindingResp) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{11}
+}
+
+type ListRoleBindingsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId   uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	RoleId  uint32 `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Start   uint32 `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	Limit   uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	All     bool   `protobuf:"varint,6,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *ListRoleBindingsReq) Reset() {
+	*x = ListRoleBindingsReq{}
+	mi := &file_auth_server_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleBindingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleBindingsReq) ProtoMessage() {}
+
+func (x *ListRoleBindingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleBindingsReq.ProtoReflect.Descriptor instead.
+func (*ListRoleBindingsReq) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListRoleBindingsReq) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ListRoleBindingsReq) GetRoleId() uint32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *ListRoleBindingsReq) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ListRoleBindingsReq) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ListRoleBindingsReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRoleBindingsReq) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type ListRoleBindingsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   uint32              `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Details []*role.RoleBinding `protobuf:"bytes,2,rep,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *ListRoleBindingsResp) Reset() {
+	*x = ListRoleBindingsResp{}
+	mi := &file_auth_server_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleBindingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleBindingsResp) ProtoMessage() {}
+
+func (x *ListRoleBindingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleBindingsResp.ProtoReflect.Descriptor instead.
+func (*ListRoleBindingsResp) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListRoleBindingsResp) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ListRoleBindingsResp) GetDetails() []*role.RoleBinding {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
 type IAMVerifyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -34,7 +822,7 @@ type IAMVerifyReq struct {
 
 func (x *IAMVerifyReq) Reset() {
 	*x = IAMVerifyReq{}
-	mi := &file_auth_server_proto_msgTypes[0]
+	mi := &file_auth_server_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46,7 +834,7 @@ func (x *IAMVerifyReq) String() string {
 func (*IAMVerifyReq) ProtoMessage() {}
 
 func (x *IAMVerifyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[0]
+	mi := &file_auth_server_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,7 +847,7 @@ func (x *IAMVerifyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IAMVerifyReq.ProtoReflect.Descriptor instead.
 func (*IAMVerifyReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{0}
+	return file_auth_server_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *IAMVerifyReq) GetToken() string {
@@ -79,7 +867,7 @@ type IAMVerifyResp struct {
 
 func (x *IAMVerifyResp) Reset() {
 	*x = IAMVerifyResp{}
-	mi := &file_auth_server_proto_msgTypes[1]
+	mi := &file_auth_server_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -91,7 +879,7 @@ func (x *IAMVerifyResp) String() string {
 func (*IAMVerifyResp) ProtoMessage() {}
 
 func (x *IAMVerifyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[1]
+	mi := &file_auth_server_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -104,7 +892,7 @@ func (x *IAMVerifyResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IAMVerifyResp.ProtoReflect.Descriptor instead.
 func (*IAMVerifyResp) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{1}
+	return file_auth_server_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *IAMVerifyResp) GetIsAuthorized() bool {
@@ -124,7 +912,7 @@ type InitAuthCenterReq struct {
 
 func (x *InitAuthCenterReq) Reset() {
 	*x = InitAuthCenterReq{}
-	mi := &file_auth_server_proto_msgTypes[2]
+	mi := &file_auth_server_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -136,7 +924,7 @@ func (x *InitAuthCenterReq) String() string {
 func (*InitAuthCenterReq) ProtoMessage() {}
 
 func (x *InitAuthCenterReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[2]
+	mi := &file_auth_server_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,7 +937,7 @@ func (x *InitAuthCenterReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitAuthCenterReq.ProtoReflect.Descriptor instead.
 func (*InitAuthCenterReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{2}
+	return file_auth_server_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *InitAuthCenterReq) GetHost() string {
@@ -167,7 +955,7 @@ type InitAuthCenterResp struct {
 
 func (x *InitAuthCenterResp) Reset() {
 	*x = InitAuthCenterResp{}
-	mi := &file_auth_server_proto_msgTypes[3]
+	mi := &file_auth_server_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -179,7 +967,7 @@ func (x *InitAuthCenterResp) String() string {
 func (*InitAuthCenterResp) ProtoMessage() {}
 
 func (x *InitAuthCenterResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[3]
+	mi := &file_auth_server_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -192,7 +980,7 @@ func (x *InitAuthCenterResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitAuthCenterResp.ProtoReflect.Descriptor instead.
 func (*InitAuthCenterResp) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{3}
+	return file_auth_server_proto_rawDescGZIP(), []int{17}
 }
 
 type GetAuthConfReq struct {
@@ -203,7 +991,7 @@ type GetAuthConfReq struct {
 
 func (x *GetAuthConfReq) Reset() {
 	*x = GetAuthConfReq{}
-	mi := &file_auth_server_proto_msgTypes[4]
+	mi := &file_auth_server_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +1003,7 @@ func (x *GetAuthConfReq) String() string {
 func (*GetAuthConfReq) ProtoMessage() {}
 
 func (x *GetAuthConfReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[4]
+	mi := &file_auth_server_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +1016,7 @@ func (x *GetAuthConfReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuthConfReq.ProtoReflect.Descriptor instead.
 func (*GetAuthConfReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{4}
+	return file_auth_server_proto_rawDescGZIP(), []int{18}
 }
 
 type GetAuthConfResp struct {
@@ -243,7 +1031,7 @@ type GetAuthConfResp struct {
 
 func (x *GetAuthConfResp) Reset() {
 	*x = GetAuthConfResp{}
-	mi := &file_auth_server_proto_msgTypes[5]
+	mi := &file_auth_server_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -255,7 +1043,7 @@ func (x *GetAuthConfResp) String() string {
 func (*GetAuthConfResp) ProtoMessage() {}
 
 func (x *GetAuthConfResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[5]
+	mi := &file_auth_server_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +1056,7 @@ func (x *GetAuthConfResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuthConfResp.ProtoReflect.Descriptor instead.
 func (*GetAuthConfResp) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{5}
+	return file_auth_server_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetAuthConfResp) GetLoginAuth() *LoginAuth {
@@ -302,11 +1090,12 @@ type LoginAuth struct {
 	Provider  string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
 	GwPubkey  string `protobuf:"bytes,4,opt,name=gw_pubkey,json=gwPubkey,proto3" json:"gw_pubkey,omitempty"`
 	UseEsb    bool   `protobuf:"varint,5,opt,name=use_esb,json=useEsb,proto3" json:"use_esb,omitempty"`
+	Oidc      *OIDC  `protobuf:"bytes,6,opt,name=oidc,proto3" json:"oidc,omitempty"`
 }
 
 func (x *LoginAuth) Reset() {
 	*x = LoginAuth{}
-	mi := &file_auth_server_proto_msgTypes[6]
+	mi := &file_auth_server_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -318,7 +1107,7 @@ func (x *LoginAuth) String() string {
 func (*LoginAuth) ProtoMessage() {}
 
 func (x *LoginAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[6]
+	mi := &file_auth_server_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -331,7 +1120,7 @@ func (x *LoginAuth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginAuth.ProtoReflect.Descriptor instead.
 func (*LoginAuth) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{6}
+	return file_auth_server_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *LoginAuth) GetHost() string {
@@ -369,6 +1158,114 @@ func (x *LoginAuth) GetUseEsb() bool {
 	return false
 }
 
+func (x *LoginAuth) GetOidc() *OIDC {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+type OIDC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issuer        string   `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId      string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string   `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	RedirectUrl   string   `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	Scopes        []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	UsernameClaim string   `protobuf:"bytes,6,opt,name=username_claim,json=usernameClaim,proto3" json:"username_claim,omitempty"`
+	TenantClaim   string   `protobuf:"bytes,7,opt,name=tenant_claim,json=tenantClaim,proto3" json:"tenant_claim,omitempty"`
+	CookieDomain  string   `protobuf:"bytes,8,opt,name=cookie_domain,json=cookieDomain,proto3" json:"cookie_domain,omitempty"`
+}
+
+func (x *OIDC) Reset() {
+	*x = OIDC{}
+	mi := &file_auth_server_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDC) ProtoMessage() {}
+
+func (x *OIDC) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_server_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDC.ProtoReflect.Descriptor instead.
+func (*OIDC) Descriptor() ([]byte, []int) {
+	return file_auth_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OIDC) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OIDC) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDC) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OIDC) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *OIDC) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OIDC) GetUsernameClaim() string {
+	if x != nil {
+		return x.UsernameClaim
+	}
+	return ""
+}
+
+func (x *OIDC) GetTenantClaim() string {
+	if x != nil {
+		return x.TenantClaim
+	}
+	return ""
+}
+
+func (x *OIDC) GetCookieDomain() string {
+	if x != nil {
+		return x.CookieDomain
+	}
+	return ""
+}
+
 type ESB struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -383,7 +1280,7 @@ type ESB struct {
 
 func (x *ESB) Reset() {
 	*x = ESB{}
-	mi := &file_auth_server_proto_msgTypes[7]
+	mi := &file_auth_server_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +1292,7 @@ func (x *ESB) String() string {
 func (*ESB) ProtoMessage() {}
 
 func (x *ESB) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[7]
+	mi := &file_auth_server_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +1305,7 @@ func (x *ESB) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ESB.ProtoReflect.Descriptor instead.
 func (*ESB) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{7}
+	return file_auth_server_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ESB) GetEndpoints() []string {
@@ -460,7 +1357,7 @@ type CMDB struct {
 
 func (x *CMDB) Reset() {
 	*x = CMDB{}
-	mi := &file_auth_server_proto_msgTypes[8]
+	mi := &file_auth_server_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -472,7 +1369,7 @@ func (x *CMDB) String() string {
 func (*CMDB) ProtoMessage() {}
 
 func (x *CMDB) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[8]
+	mi := &file_auth_server_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +1382,7 @@ func (x *CMDB) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CMDB.ProtoReflect.Descriptor instead.
 func (*CMDB) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{8}
+	return file_auth_server_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CMDB) GetHost() string {
@@ -537,7 +1434,7 @@ type TLS struct {
 
 func (x *TLS) Reset() {
 	*x = TLS{}
-	mi := &file_auth_server_proto_msgTypes[9]
+	mi := &file_auth_server_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -549,7 +1446,7 @@ func (x *TLS) String() string {
 func (*TLS) ProtoMessage() {}
 
 func (x *TLS) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[9]
+	mi := &file_auth_server_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +1459,7 @@ func (x *TLS) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLS.ProtoReflect.Descriptor instead.
 func (*TLS) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{9}
+	return file_auth_server_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TLS) GetInsecureSkipVerify() bool {
@@ -613,7 +1510,7 @@ type PullResourceReq struct {
 
 func (x *PullResourceReq) Reset() {
 	*x = PullResourceReq{}
-	mi := &file_auth_server_proto_msgTypes[10]
+	mi := &file_auth_server_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -625,7 +1522,7 @@ func (x *PullResourceReq) String() string {
 func (*PullResourceReq) ProtoMessage() {}
 
 func (x *PullResourceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[10]
+	mi := &file_auth_server_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,7 +1535,7 @@ func (x *PullResourceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullResourceReq.ProtoReflect.Descriptor instead.
 func (*PullResourceReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{10}
+	return file_auth_server_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *PullResourceReq) GetType() string {
@@ -681,7 +1578,7 @@ type PullResourceResp struct {
 
 func (x *PullResourceResp) Reset() {
 	*x = PullResourceResp{}
-	mi := &file_auth_server_proto_msgTypes[11]
+	mi := &file_auth_server_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -693,7 +1590,7 @@ func (x *PullResourceResp) String() string {
 func (*PullResourceResp) ProtoMessage() {}
 
 func (x *PullResourceResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[11]
+	mi := &file_auth_server_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +1603,7 @@ func (x *PullResourceResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullResourceResp.ProtoReflect.Descriptor instead.
 func (*PullResourceResp) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{11}
+	return file_auth_server_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PullResourceResp) GetCode() int32 {
@@ -740,7 +1637,7 @@ type CheckPermissionReq struct {
 
 func (x *CheckPermissionReq) Reset() {
 	*x = CheckPermissionReq{}
-	mi := &file_auth_server_proto_msgTypes[12]
+	mi := &file_auth_server_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -752,7 +1649,7 @@ func (x *CheckPermissionReq) String() string {
 func (*CheckPermissionReq) ProtoMessage() {}
 
 func (x *CheckPermissionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[12]
+	mi := &file_auth_server_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,7 +1662,7 @@ func (x *CheckPermissionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckPermissionReq.ProtoReflect.Descriptor instead.
 func (*CheckPermissionReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{12}
+	return file_auth_server_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CheckPermissionReq) GetResources() []*ResourceAttribute {
@@ -787,7 +1684,7 @@ type CheckPermissionResp struct {
 
 func (x *CheckPermissionResp) Reset() {
 	*x = CheckPermissionResp{}
-	mi := &file_auth_server_proto_msgTypes[13]
+	mi := &file_auth_server_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -799,7 +1696,7 @@ func (x *CheckPermissionResp) String() string {
 func (*CheckPermissionResp) ProtoMessage() {}
 
 func (x *CheckPermissionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[13]
+	mi := &file_auth_server_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1709,7 @@ func (x *CheckPermissionResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckPermissionResp.ProtoReflect.Descriptor instead.
 func (*CheckPermissionResp) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{13}
+	return file_auth_server_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CheckPermissionResp) GetIsAllowed() bool {
@@ -847,7 +1744,7 @@ type Page struct {
 
 func (x *Page) Reset() {
 	*x = Page{}
-	mi := &file_auth_server_proto_msgTypes[14]
+	mi := &file_auth_server_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +1756,7 @@ func (x *Page) String() string {
 func (*Page) ProtoMessage() {}
 
 func (x *Page) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[14]
+	mi := &file_auth_server_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +1769,7 @@ func (x *Page) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Page.ProtoReflect.Descriptor instead.
 func (*Page) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{14}
+	return file_auth_server_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Page) GetLimit() uint32 {
@@ -900,7 +1797,7 @@ type AuthorizeBatchReq struct {
 
 func (x *AuthorizeBatchReq) Reset() {
 	*x = AuthorizeBatchReq{}
-	mi := &file_auth_server_proto_msgTypes[15]
+	mi := &file_auth_server_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -912,7 +1809,7 @@ func (x *AuthorizeBatchReq) String() string {
 func (*AuthorizeBatchReq) ProtoMessage() {}
 
 func (x *AuthorizeBatchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[15]
+	mi := &file_auth_server_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,7 +1822,7 @@ func (x *AuthorizeBatchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizeBatchReq.ProtoReflect.Descriptor instead.
 func (*AuthorizeBatchReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{15}
+	return file_auth_server_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AuthorizeBatchReq) GetUser() *UserInfo {
@@ -952,7 +1849,7 @@ type UserInfo struct {
 
 func (x *UserInfo) Reset() {
 	*x = UserInfo{}
-	mi := &file_auth_server_proto_msgTypes[16]
+	mi := &file_auth_server_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -964,7 +1861,7 @@ func (x *UserInfo) String() string {
 func (*UserInfo) ProtoMessage() {}
 
 func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[16]
+	mi := &file_auth_server_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,7 +1874,7 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{16}
+	return file_auth_server_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UserInfo) GetUserName() string {
@@ -998,7 +1895,7 @@ type ResourceAttribute struct {
 
 func (x *ResourceAttribute) Reset() {
 	*x = ResourceAttribute{}
-	mi := &file_auth_server_proto_msgTypes[17]
+	mi := &file_auth_server_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1010,7 +1907,7 @@ func (x *ResourceAttribute) String() string {
 func (*ResourceAttribute) ProtoMessage() {}
 
 func (x *ResourceAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[17]
+	mi := &file_auth_server_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1920,7 @@ func (x *ResourceAttribute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceAttribute.ProtoReflect.Descriptor instead.
 func (*ResourceAttribute) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{17}
+	return file_auth_server_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ResourceAttribute) GetBasic() *Basic {
@@ -1052,7 +1949,7 @@ type Basic struct {
 
 func (x *Basic) Reset() {
 	*x = Basic{}
-	mi := &file_auth_server_proto_msgTypes[18]
+	mi := &file_auth_server_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1064,7 +1961,7 @@ func (x *Basic) String() string {
 func (*Basic) ProtoMessage() {}
 
 func (x *Basic) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[18]
+	mi := &file_auth_server_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,7 +1974,7 @@ func (x *Basic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Basic.ProtoReflect.Descriptor instead.
 func (*Basic) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{18}
+	return file_auth_server_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *Basic) GetType() string {
@@ -1116,7 +2013,7 @@ type BasicDetail struct {
 
 func (x *BasicDetail) Reset() {
 	*x = BasicDetail{}
-	mi := &file_auth_server_proto_msgTypes[19]
+	mi := &file_auth_server_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1128,7 +2025,7 @@ func (x *BasicDetail) String() string {
 func (*BasicDetail) ProtoMessage() {}
 
 func (x *BasicDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[19]
+	mi := &file_auth_server_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,7 +2038,7 @@ func (x *BasicDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BasicDetail.ProtoReflect.Descriptor instead.
 func (*BasicDetail) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{19}
+	return file_auth_server_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *BasicDetail) GetType() string {
@@ -1197,7 +2094,7 @@ type ApplyDetail struct {
 
 func (x *ApplyDetail) Reset() {
 	*x = ApplyDetail{}
-	mi := &file_auth_server_proto_msgTypes[20]
+	mi := &file_auth_server_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1209,7 +2106,7 @@ func (x *ApplyDetail) String() string {
 func (*ApplyDetail) ProtoMessage() {}
 
 func (x *ApplyDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[20]
+	mi := &file_auth_server_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +2119,7 @@ func (x *ApplyDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyDetail.ProtoReflect.Descriptor instead.
 func (*ApplyDetail) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{20}
+	return file_auth_server_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ApplyDetail) GetApplyUrl() string {
@@ -1249,7 +2146,7 @@ type AuthorizeBatchResp struct {
 
 func (x *AuthorizeBatchResp) Reset() {
 	*x = AuthorizeBatchResp{}
-	mi := &file_auth_server_proto_msgTypes[21]
+	mi := &file_auth_server_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1261,7 +2158,7 @@ func (x *AuthorizeBatchResp) String() string {
 func (*AuthorizeBatchResp) ProtoMessage() {}
 
 func (x *AuthorizeBatchResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[21]
+	mi := &file_auth_server_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,7 +2171,7 @@ func (x *AuthorizeBatchResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizeBatchResp.ProtoReflect.Descriptor instead.
 func (*AuthorizeBatchResp) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{21}
+	return file_auth_server_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *AuthorizeBatchResp) GetDecisions() []*Decision {
@@ -1295,7 +2192,7 @@ type Decision struct {
 
 func (x *Decision) Reset() {
 	*x = Decision{}
-	mi := &file_auth_server_proto_msgTypes[22]
+	mi := &file_auth_server_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1307,7 +2204,7 @@ func (x *Decision) String() string {
 func (*Decision) ProtoMessage() {}
 
 func (x *Decision) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[22]
+	mi := &file_auth_server_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,7 +2217,7 @@ func (x *Decision) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Decision.ProtoReflect.Descriptor instead.
 func (*Decision) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{22}
+	return file_auth_server_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *Decision) GetResource() *ResourceAttribute {
@@ -1347,7 +2244,7 @@ type GetPermissionToApplyReq struct {
 
 func (x *GetPermissionToApplyReq) Reset() {
 	*x = GetPermissionToApplyReq{}
-	mi := &file_auth_server_proto_msgTypes[23]
+	mi := &file_auth_server_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1359,7 +2256,7 @@ func (x *GetPermissionToApplyReq) String() string {
 func (*GetPermissionToApplyReq) ProtoMessage() {}
 
 func (x *GetPermissionToApplyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[23]
+	mi := &file_auth_server_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1372,7 +2269,7 @@ func (x *GetPermissionToApplyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionToApplyReq.ProtoReflect.Descriptor instead.
 func (*GetPermissionToApplyReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{23}
+	return file_auth_server_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetPermissionToApplyReq) GetResources() []*ResourceAttribute {
@@ -1393,7 +2290,7 @@ type GetPermissionToApplyResp struct {
 
 func (x *GetPermissionToApplyResp) Reset() {
 	*x = GetPermissionToApplyResp{}
-	mi := &file_auth_server_proto_msgTypes[24]
+	mi := &file_auth_server_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1405,7 +2302,7 @@ func (x *GetPermissionToApplyResp) String() string {
 func (*GetPermissionToApplyResp) ProtoMessage() {}
 
 func (x *GetPermissionToApplyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[24]
+	mi := &file_auth_server_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +2315,7 @@ func (x *GetPermissionToApplyResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionToApplyResp.ProtoReflect.Descriptor instead.
 func (*GetPermissionToApplyResp) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{24}
+	return file_auth_server_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetPermissionToApplyResp) GetPermission() *IamPermission {
@@ -1447,7 +2344,7 @@ type IamPermission struct {
 
 func (x *IamPermission) Reset() {
 	*x = IamPermission{}
-	mi := &file_auth_server_proto_msgTypes[25]
+	mi := &file_auth_server_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1459,7 +2356,7 @@ func (x *IamPermission) String() string {
 func (*IamPermission) ProtoMessage() {}
 
 func (x *IamPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[25]
+	mi := &file_auth_server_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,7 +2369,7 @@ func (x *IamPermission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IamPermission.ProtoReflect.Descriptor instead.
 func (*IamPermission) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{25}
+	return file_auth_server_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *IamPermission) GetSystemId() string {
@@ -1508,7 +2405,7 @@ type IamAction struct {
 
 func (x *IamAction) Reset() {
 	*x = IamAction{}
-	mi := &file_auth_server_proto_msgTypes[26]
+	mi := &file_auth_server_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1520,7 +2417,7 @@ func (x *IamAction) String() string {
 func (*IamAction) ProtoMessage() {}
 
 func (x *IamAction) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[26]
+	mi := &file_auth_server_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,7 +2430,7 @@ func (x *IamAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IamAction.ProtoReflect.Descriptor instead.
 func (*IamAction) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{26}
+	return file_auth_server_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *IamAction) GetId() string {
@@ -1572,7 +2469,7 @@ type IamResourceType struct {
 
 func (x *IamResourceType) Reset() {
 	*x = IamResourceType{}
-	mi := &file_auth_server_proto_msgTypes[27]
+	mi := &file_auth_server_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1584,7 +2481,7 @@ func (x *IamResourceType) String() string {
 func (*IamResourceType) ProtoMessage() {}
 
 func (x *IamResourceType) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[27]
+	mi := &file_auth_server_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,7 +2494,7 @@ func (x *IamResourceType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IamResourceType.ProtoReflect.Descriptor instead.
 func (*IamResourceType) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{27}
+	return file_auth_server_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *IamResourceType) GetSystemId() string {
@@ -1652,7 +2549,7 @@ type IamResourceInstances struct {
 
 func (x *IamResourceInstances) Reset() {
 	*x = IamResourceInstances{}
-	mi := &file_auth_server_proto_msgTypes[28]
+	mi := &file_auth_server_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1664,7 +2561,7 @@ func (x *IamResourceInstances) String() string {
 func (*IamResourceInstances) ProtoMessage() {}
 
 func (x *IamResourceInstances) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[28]
+	mi := &file_auth_server_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1677,7 +2574,7 @@ func (x *IamResourceInstances) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IamResourceInstances.ProtoReflect.Descriptor instead.
 func (*IamResourceInstances) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{28}
+	return file_auth_server_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *IamResourceInstances) GetInstances() []*IamResourceInstance {
@@ -1700,7 +2597,7 @@ type IamResourceInstance struct {
 
 func (x *IamResourceInstance) Reset() {
 	*x = IamResourceInstance{}
-	mi := &file_auth_server_proto_msgTypes[29]
+	mi := &file_auth_server_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1712,7 +2609,7 @@ func (x *IamResourceInstance) String() string {
 func (*IamResourceInstance) ProtoMessage() {}
 
 func (x *IamResourceInstance) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[29]
+	mi := &file_auth_server_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1725,7 +2622,7 @@ func (x *IamResourceInstance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IamResourceInstance.ProtoReflect.Descriptor instead.
 func (*IamResourceInstance) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{29}
+	return file_auth_server_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *IamResourceInstance) GetType() string {
@@ -1767,7 +2664,7 @@ type IamResourceAttribute struct {
 
 func (x *IamResourceAttribute) Reset() {
 	*x = IamResourceAttribute{}
-	mi := &file_auth_server_proto_msgTypes[30]
+	mi := &file_auth_server_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1779,7 +2676,7 @@ func (x *IamResourceAttribute) String() string {
 func (*IamResourceAttribute) ProtoMessage() {}
 
 func (x *IamResourceAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[30]
+	mi := &file_auth_server_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1792,7 +2689,7 @@ func (x *IamResourceAttribute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IamResourceAttribute.ProtoReflect.Descriptor instead.
 func (*IamResourceAttribute) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{30}
+	return file_auth_server_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *IamResourceAttribute) GetId() string {
@@ -1819,7 +2716,7 @@ type IamResourceAttributeValue struct {
 
 func (x *IamResourceAttributeValue) Reset() {
 	*x = IamResourceAttributeValue{}
-	mi := &file_auth_server_proto_msgTypes[31]
+	mi := &file_auth_server_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1831,7 +2728,7 @@ func (x *IamResourceAttributeValue) String() string {
 func (*IamResourceAttributeValue) ProtoMessage() {}
 
 func (x *IamResourceAttributeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[31]
+	mi := &file_auth_server_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1844,7 +2741,7 @@ func (x *IamResourceAttributeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IamResourceAttributeValue.ProtoReflect.Descriptor instead.
 func (*IamResourceAttributeValue) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{31}
+	return file_auth_server_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *IamResourceAttributeValue) GetId() string {
@@ -1865,7 +2762,7 @@ type UserCredentialReq struct {
 
 func (x *UserCredentialReq) Reset() {
 	*x = UserCredentialReq{}
-	mi := &file_auth_server_proto_msgTypes[32]
+	mi := &file_auth_server_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1877,7 +2774,7 @@ func (x *UserCredentialReq) String() string {
 func (*UserCredentialReq) ProtoMessage() {}
 
 func (x *UserCredentialReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[32]
+	mi := &file_auth_server_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,7 +2787,7 @@ func (x *UserCredentialReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserCredentialReq.ProtoReflect.Descriptor instead.
 func (*UserCredentialReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{32}
+	return file_auth_server_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *UserCredentialReq) GetUid() string {
@@ -1920,7 +2817,7 @@ type UserInfoResp struct {
 
 func (x *UserInfoResp) Reset() {
 	*x = UserInfoResp{}
-	mi := &file_auth_server_proto_msgTypes[33]
+	mi := &file_auth_server_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1932,7 +2829,7 @@ func (x *UserInfoResp) String() string {
 func (*UserInfoResp) ProtoMessage() {}
 
 func (x *UserInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[33]
+	mi := &file_auth_server_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1945,7 +2842,7 @@ func (x *UserInfoResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfoResp.ProtoReflect.Descriptor instead.
 func (*UserInfoResp) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{33}
+	return file_auth_server_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *UserInfoResp) GetUsername() string {
@@ -1984,7 +2881,7 @@ type ListUserSpaceReq struct {
 
 func (x *ListUserSpaceReq) Reset() {
 	*x = ListUserSpaceReq{}
-	mi := &file_auth_server_proto_msgTypes[34]
+	mi := &file_auth_server_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1996,7 +2893,7 @@ func (x *ListUserSpaceReq) String() string {
 func (*ListUserSpaceReq) ProtoMessage() {}
 
 func (x *ListUserSpaceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[34]
+	mi := &file_auth_server_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2009,7 +2906,7 @@ func (x *ListUserSpaceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserSpaceReq.ProtoReflect.Descriptor instead.
 func (*ListUserSpaceReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{34}
+	return file_auth_server_proto_rawDescGZIP(), []int{49}
 }
 
 // Space 项目空间，可同时兼容bk-cmdb, bcs project_code等
@@ -2028,7 +2925,7 @@ type Space struct {
 
 func (x *Space) Reset() {
 	*x = Space{}
-	mi := &file_auth_server_proto_msgTypes[35]
+	mi := &file_auth_server_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2040,7 +2937,7 @@ func (x *Space) String() string {
 func (*Space) ProtoMessage() {}
 
 func (x *Space) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[35]
+	mi := &file_auth_server_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2053,7 +2950,7 @@ func (x *Space) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Space.ProtoReflect.Descriptor instead.
 func (*Space) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{35}
+	return file_auth_server_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *Space) GetSpaceId() string {
@@ -2108,7 +3005,7 @@ type ListUserSpaceResp struct {
 
 func (x *ListUserSpaceResp) Reset() {
 	*x = ListUserSpaceResp{}
-	mi := &file_auth_server_proto_msgTypes[36]
+	mi := &file_auth_server_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2120,7 +3017,7 @@ func (x *ListUserSpaceResp) String() string {
 func (*ListUserSpaceResp) ProtoMessage() {}
 
 func (x *ListUserSpaceResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[36]
+	mi := &file_auth_server_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2133,7 +3030,7 @@ func (x *ListUserSpaceResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserSpaceResp.ProtoReflect.Descriptor instead.
 func (*ListUserSpaceResp) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{36}
+	return file_auth_server_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListUserSpaceResp) GetItems() []*Space {
@@ -2153,7 +3050,7 @@ type QuerySpaceReq struct {
 
 func (x *QuerySpaceReq) Reset() {
 	*x = QuerySpaceReq{}
-	mi := &file_auth_server_proto_msgTypes[37]
+	mi := &file_auth_server_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2165,7 +3062,7 @@ func (x *QuerySpaceReq) String() string {
 func (*QuerySpaceReq) ProtoMessage() {}
 
 func (x *QuerySpaceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[37]
+	mi := &file_auth_server_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,7 +3075,7 @@ func (x *QuerySpaceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySpaceReq.ProtoReflect.Descriptor instead.
 func (*QuerySpaceReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{37}
+	return file_auth_server_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *QuerySpaceReq) GetSpaceUid() []string {
@@ -2198,7 +3095,7 @@ type QuerySpaceResp struct {
 
 func (x *QuerySpaceResp) Reset() {
 	*x = QuerySpaceResp{}
-	mi := &file_auth_server_proto_msgTypes[38]
+	mi := &file_auth_server_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2210,7 +3107,7 @@ func (x *QuerySpaceResp) String() string {
 func (*QuerySpaceResp) ProtoMessage() {}
 
 func (x *QuerySpaceResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[38]
+	mi := &file_auth_server_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,7 +3120,7 @@ func (x *QuerySpaceResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySpaceResp.ProtoReflect.Descriptor instead.
 func (*QuerySpaceResp) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{38}
+	return file_auth_server_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *QuerySpaceResp) GetItems() []*Space {
@@ -2243,7 +3140,7 @@ type QuerySpaceByAppIDReq struct {
 
 func (x *QuerySpaceByAppIDReq) Reset() {
 	*x = QuerySpaceByAppIDReq{}
-	mi := &file_auth_server_proto_msgTypes[39]
+	mi := &file_auth_server_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2255,7 +3152,7 @@ func (x *QuerySpaceByAppIDReq) String() string {
 func (*QuerySpaceByAppIDReq) ProtoMessage() {}
 
 func (x *QuerySpaceByAppIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[39]
+	mi := &file_auth_server_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,7 +3165,7 @@ func (x *QuerySpaceByAppIDReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySpaceByAppIDReq.ProtoReflect.Descriptor instead.
 func (*QuerySpaceByAppIDReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{39}
+	return file_auth_server_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *QuerySpaceByAppIDReq) GetAppId() uint32 {
@@ -2293,7 +3190,7 @@ type GrantResourceCreatorActionReq struct {
 
 func (x *GrantResourceCreatorActionReq) Reset() {
 	*x = GrantResourceCreatorActionReq{}
-	mi := &file_auth_server_proto_msgTypes[40]
+	mi := &file_auth_server_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2305,7 +3202,7 @@ func (x *GrantResourceCreatorActionReq) String() string {
 func (*GrantResourceCreatorActionReq) ProtoMessage() {}
 
 func (x *GrantResourceCreatorActionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[40]
+	mi := &file_auth_server_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2318,7 +3215,7 @@ func (x *GrantResourceCreatorActionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrantResourceCreatorActionReq.ProtoReflect.Descriptor instead.
 func (*GrantResourceCreatorActionReq) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{40}
+	return file_auth_server_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GrantResourceCreatorActionReq) GetSystem() string {
@@ -2375,7 +3272,7 @@ type GrantResourceCreatorActionReq_Ancestor struct {
 
 func (x *GrantResourceCreatorActionReq_Ancestor) Reset() {
 	*x = GrantResourceCreatorActionReq_Ancestor{}
-	mi := &file_auth_server_proto_msgTypes[41]
+	mi := &file_auth_server_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2387,7 +3284,7 @@ func (x *GrantResourceCreatorActionReq_Ancestor) String() string {
 func (*GrantResourceCreatorActionReq_Ancestor) ProtoMessage() {}
 
 func (x *GrantResourceCreatorActionReq_Ancestor) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_server_proto_msgTypes[41]
+	mi := &file_auth_server_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2400,7 +3297,7 @@ func (x *GrantResourceCreatorActionReq_Ancestor) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GrantResourceCreatorActionReq_Ancestor.ProtoReflect.Descriptor instead.
 func (*GrantResourceCreatorActionReq_Ancestor) Descriptor() ([]byte, []int) {
-	return file_auth_server_proto_rawDescGZIP(), []int{40, 0}
+	return file_auth_server_proto_rawDescGZIP(), []int{55, 0}
 }
 
 func (x *GrantResourceCreatorActionReq_Ancestor) GetSystem() string {
@@ -2430,357 +3327,558 @@ var file_auth_server_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x61, 0x73, 0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x73,
-	0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x24, 0x0a, 0x0c, 0x49, 0x41, 0x4d, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x34,
-	0x0a, 0x0d, 0x49, 0x41, 0x4d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x64, 0x22, 0x27, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68,
-	0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x14, 0x0a,
-	0x12, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f,
-	0x6e, 0x66, 0x52, 0x65, 0x71, 0x22, 0x7e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68,
-	0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69,
-	0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
-	0x62, 0x61, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x09, 0x6c,
-	0x6f, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x03, 0x65, 0x73, 0x62, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x45, 0x53, 0x42,
-	0x52, 0x03, 0x65, 0x73, 0x62, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x6d, 0x64, 0x62, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x43, 0x4d, 0x44, 0x42, 0x52,
-	0x04, 0x63, 0x6d, 0x64, 0x62, 0x22, 0x90, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x6e, 0x65, 0x72,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x6e,
-	0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x77, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x77, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x73, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x45, 0x73, 0x62, 0x22, 0x8e, 0x01, 0x0a, 0x03, 0x45, 0x53, 0x42,
-	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70,
-	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
-	0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x03,
-	0x74, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x61, 0x73,
-	0x2e, 0x54, 0x4c, 0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x04, 0x43, 0x4d,
-	0x44, 0x42, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x20, 0x0a, 0x0c, 0x62, 0x6b, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x73, 0x62, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x45, 0x73, 0x62, 0x22, 0xa4, 0x01, 0x0a, 0x03,
-	0x54, 0x4c, 0x53, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f,
-	0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x63, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x63, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x0f, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x22, 0x6d, 0x0a, 0x10, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x4b, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62,
-	0x61, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22,
-	0x82, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x6c, 0x79,
-	0x55, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x42, 0x61,
-	0x73, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6e, 0x0a, 0x11, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12,
-	0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x70, 0x62, 0x61, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52,
-	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x08, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69,
-	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x42,
-	0x61, 0x73, 0x69, 0x63, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x12, 0x15, 0x0a, 0x06, 0x62,
-	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a,
-	0x49, 0x64, 0x22, 0x54, 0x0a, 0x05, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x73,
-	0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x79,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x6c,
-	0x79, 0x55, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x42,
-	0x61, 0x73, 0x69, 0x63, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x09, 0x64,
-	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09,
-	0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5f, 0x0a, 0x08, 0x44, 0x65, 0x63,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x41,
-	0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
-	0x62, 0x61, 0x73, 0x2e, 0x49, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
-	0x09, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x78, 0x0a, 0x0d, 0x49, 0x61,
-	0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x61,
-	0x73, 0x2e, 0x49, 0x61, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7c, 0x0a, 0x09, 0x49, 0x61, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x16, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x61, 0x6d,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x14, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x0f, 0x49, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e,
-	0x49, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
-	0x3a, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x61, 0x6d, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x14, 0x49,
-	0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x61,
-	0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x6a, 0x0a, 0x13,
-	0x49, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x14, 0x49, 0x61, 0x6d, 0x52,
+	0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x02, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a,
+	0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69,
+	0x7a, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x90,
+	0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x6e, 0x92, 0x41, 0x6b, 0x32, 0x69, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe6, 0x9d, 0x83,
+	0xe9, 0x99, 0x90, 0xef, 0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x20,
+	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x3a, 0xe6, 0x93, 0x8d,
+	0xe4, 0xbd, 0x9c, 0xef, 0xbc, 0x8c, 0xe5, 0xa6, 0x82, 0x20, 0x61, 0x70, 0x70, 0x3a, 0x76, 0x69,
+	0x65, 0x77, 0xe3, 0x80, 0x81, 0x61, 0x70, 0x70, 0x3a, 0x2a, 0xef, 0xbc, 0x8c, 0x2a, 0x20, 0xe8,
+	0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
+	0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe6, 0x88, 0x96, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08,
+	0x32, 0x06, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x2f,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xb8, 0x02, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44,
+	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8,
+	0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x49, 0x44, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x6e, 0x92, 0x41,
+	0x6b, 0x32, 0x69, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xef,
+	0xbc, 0x8c, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0xba, 0x20, 0xe8, 0xb5, 0x84, 0xe6,
+	0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x3a, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xef,
+	0xbc, 0x8c, 0xe5, 0xa6, 0x82, 0x20, 0x61, 0x70, 0x70, 0x3a, 0x76, 0x69, 0x65, 0x77, 0xe3, 0x80,
+	0x81, 0x61, 0x70, 0x70, 0x3a, 0x2a, 0xef, 0xbc, 0x8c, 0x2a, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4,
+	0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb,
+	0xe5, 0x9e, 0x8b, 0xe6, 0x88, 0x96, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x6d,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f,
+	0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x10, 0x0a, 0x0e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x5d, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a,
+	0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69,
+	0x7a, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xa7, 0x92, 0xe8, 0x89,
+	0xb2, 0x49, 0x44, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0xba, 0x01,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x24,
+	0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62,
+	0x69, 0x7a, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5,
+	0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe5, 0x80, 0xbc, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x27, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe5, 0xa4, 0xa7, 0xe5,
+	0xb0, 0x8f, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x03, 0x61, 0x6c, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x98, 0xaf,
+	0xe5, 0x90, 0xa6, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe5, 0x85, 0xa8, 0xe9, 0x87, 0x8f, 0xe6,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x5a, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32,
+	0x06, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x88, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05,
+	0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xa7, 0x92,
+	0xe8, 0x89, 0xb2, 0x49, 0x44, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x0a, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x61, 0x92, 0x41, 0x5e, 0x32, 0x5c, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83, 0xe8, 0x8c, 0x83, 0xe5,
+	0x9b, 0xb4, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x62, 0x69, 0x7a, 0x2d, 0xe6,
+	0x95, 0xb4, 0xe4, 0xb8, 0xaa, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0xef, 0xbc, 0x8c, 0x61, 0x70,
+	0x70, 0x2d, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xef, 0xbc,
+	0x8c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d,
+	0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe7, 0xa9, 0xba, 0xe9,
+	0x97, 0xb4, 0x52, 0x09, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x72, 0x0a,
+	0x08, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x57, 0x92, 0x41, 0x54, 0x32, 0x52, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83, 0xe8, 0x8c, 0x83, 0xe5,
+	0x9b, 0xb4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0x61, 0x70, 0x70, 0x20, 0xe4, 0xb8, 0xba, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0xe4, 0xb8, 0xba, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d,
+	0xbf, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0x62, 0x69, 0x7a, 0x20,
+	0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0x20, 0x30, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x49,
+	0x64, 0x22, 0x3c, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xa7, 0x92,
+	0xe8, 0x89, 0xb2, 0xe7, 0xbb, 0x91, 0xe5, 0xae, 0x9a, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x70, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8,
+	0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x32, 0x0a,
+	0x0a, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe7, 0xbb,
+	0x91, 0xe5, 0xae, 0x9a, 0x49, 0x44, 0x52, 0x09, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0xc0, 0x02, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49,
+	0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x1d,
+	0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0x20, 0x30,
+	0x20, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8d, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0x52, 0x06, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe6, 0x8e, 0x88,
+	0xe6, 0x9d, 0x83, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7,
+	0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8d, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0x88, 0x86,
+	0xe9, 0xa1, 0xb5, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe5, 0x80, 0xbc, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe5,
+	0xa4, 0xa7, 0xe5, 0xb0, 0x8f, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x03,
+	0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18,
+	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe5, 0x85, 0xa8, 0xe9,
+	0x87, 0x8f, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x68, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x80, 0xbb, 0xe6, 0x95,
+	0xb0, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x72, 0x6f,
+	0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x24, 0x0a, 0x0c, 0x49, 0x41, 0x4d, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x34, 0x0a,
+	0x0d, 0x49, 0x41, 0x4d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x22, 0x27, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12,
+	0x49, 0x6e, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x52, 0x65, 0x71, 0x22, 0x7e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43,
+	0x6f, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62,
+	0x61, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x09, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x03, 0x65, 0x73, 0x62, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x45, 0x53, 0x42, 0x52,
+	0x03, 0x65, 0x73, 0x62, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x6d, 0x64, 0x62, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x43, 0x4d, 0x44, 0x42, 0x52, 0x04,
+	0x63, 0x6d, 0x64, 0x62, 0x22, 0xb0, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x77, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x77, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x73, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x45, 0x73, 0x62, 0x12, 0x1e, 0x0a, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x4f, 0x49, 0x44,
+	0x43, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x22, 0x8a, 0x02, 0x0a, 0x04, 0x4f, 0x49, 0x44, 0x43,
+	0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x03, 0x45, 0x53, 0x42, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70,
+	0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x54, 0x4c, 0x53,
+	0x52, 0x03, 0x74, 0x6c, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x04, 0x43, 0x4d, 0x44, 0x42, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0c,
+	0x62, 0x6b, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x62, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x73, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x45, 0x73, 0x62, 0x22, 0xa4, 0x01, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x12,
+	0x30, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x8e,
+	0x01, 0x0a, 0x0f, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2f,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x70, 0x62, 0x61, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22,
+	0x6d, 0x0a, 0x10, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b,
+	0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x52,
 	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x37, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x49, 0x61, 0x6d,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12,
-	0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x68, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x3a, 0x54, 0x92,
-	0x41, 0x51, 0x0a, 0x4f, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x32, 0x3b, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0x94,
-	0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x9c, 0x89, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7, 0x9a, 0x84,
-	0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2c, 0x20, 0xe5, 0x90,
-	0xab, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0xe5, 0x92, 0x8c, 0x42, 0x43, 0x53, 0xe9, 0xa1, 0xb9,
-	0xe7, 0x9b, 0xae, 0x22, 0xce, 0x01, 0x0a, 0x05, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x69, 0x64,
-	0x12, 0x22, 0x0a, 0x0d, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x5e, 0x0a, 0x0d,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x69, 0x64, 0x3a, 0x30, 0x92, 0x41, 0x2d, 0x0a,
-	0x2b, 0x2a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x32, 0x1d, 0xe6,
-	0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x22, 0x33, 0x0a, 0x0e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21,
-	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x70, 0x62, 0x61, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x22, 0x63, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x42,
-	0x79, 0x41, 0x70, 0x70, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
-	0x3a, 0x34, 0x92, 0x41, 0x31, 0x0a, 0x2f, 0x2a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x42, 0x79, 0x41, 0x70, 0x70, 0x49, 0x44, 0x32, 0x1a, 0xe9, 0x80, 0x9a, 0xe8,
-	0xbf, 0x87, 0x20, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x20, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0x20, 0x53, 0x70, 0x61, 0x63, 0x65, 0x22, 0xe0, 0x02, 0x0a, 0x1d, 0x47, 0x72, 0x61, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x4a, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x47, 0x72, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x6e, 0x63, 0x65, 0x73,
-	0x74, 0x6f, 0x72, 0x52, 0x09, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x46,
-	0x0a, 0x08, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x41, 0x92, 0x41, 0x3e, 0x0a, 0x3c, 0x2a, 0x1d, 0x47,
-	0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x32, 0x1b, 0xe6, 0x8e,
-	0x88, 0xe6, 0x9d, 0x83, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba,
-	0xe8, 0x80, 0x85, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0x32, 0xfc, 0x07, 0x0a, 0x04, 0x41, 0x75,
-	0x74, 0x68, 0x12, 0x6c, 0x0a, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x6e, 0x69, 0x74,
-	0x41, 0x75, 0x74, 0x68, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
-	0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x65, 0x6e,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a,
-	0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68,
-	0x2f, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
-	0x12, 0x5a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75,
-	0x74, 0x68, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x62, 0x0a, 0x0d,
-	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x2e,
-	0x70, 0x62, 0x61, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x20,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x75, 0x74, 0x68, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
-	0x12, 0x3e, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x42, 0x79,
-	0x41, 0x70, 0x70, 0x49, 0x44, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x42, 0x79, 0x41, 0x70, 0x70, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x22, 0x00,
-	0x12, 0x69, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x15, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x69, 0x61, 0x6d, 0x2f, 0x66, 0x69,
-	0x6e, 0x64, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x74, 0x0a, 0x0f, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e,
+	0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x13,
 	0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x69, 0x61, 0x6d,
-	0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x45, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70,
-	0x62, 0x61, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x12,
+	0x2f, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x22, 0x34, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6e, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x61, 0x73,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x35, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x4d, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x22, 0x54,
+	0x0a, 0x05, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x69, 0x63, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c,
+	0x12, 0x2f, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x22, 0x42, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x61,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5f, 0x0a, 0x08, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x09, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50,
 	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x79,
-	0x12, 0x1d, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a,
-	0x1e, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x39, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x13, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x14, 0x2e, 0x70, 0x62,
-	0x61, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65,
-	0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68,
-	0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x1a, 0x47, 0x72,
-	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e,
-	0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e,
-	0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x49, 0x41, 0x4d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12,
-	0x12, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x41, 0x4d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x41, 0x4d, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c,
-	0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x75, 0x74, 0x68,
-	0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x3b, 0x70, 0x62, 0x61, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e,
+	0x49, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70,
+	0x6c, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70,
+	0x70, 0x6c, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x78, 0x0a, 0x0d, 0x49, 0x61, 0x6d, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x61,
+	0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x7c, 0x0a, 0x09, 0x49, 0x61, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x4b, 0x0a, 0x16, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x14, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xf6,
+	0x01, 0x0a, 0x0f, 0x49, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x14, 0x49, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x37, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x6a, 0x0a, 0x13, 0x49, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x14, 0x49, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
+	0x62, 0x61, 0x73, 0x2e, 0x49, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x49, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x83, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x68, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f,
+	0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x32, 0x3b, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
+	0xe6, 0x9c, 0x89, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7, 0x9a, 0x84, 0xe7, 0xa9, 0xba, 0xe9,
+	0x97, 0xb4, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2c, 0x20, 0xe5, 0x90, 0xab, 0xe4, 0xb8, 0x9a,
+	0xe5, 0x8a, 0xa1, 0xe5, 0x92, 0x8c, 0x42, 0x43, 0x53, 0xe9, 0xa1, 0xb9, 0xe7, 0x9b, 0xae, 0x22,
+	0xce, 0x01, 0x0a, 0x05, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x36, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x5e, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x55, 0x69, 0x64, 0x3a, 0x30, 0x92, 0x41, 0x2d, 0x0a, 0x2b, 0x2a, 0x0a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x32, 0x1d, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x53, 0x70, 0x61, 0x63, 0x65, 0xe7, 0xa9, 0xba, 0xe9,
+	0x97, 0xb4, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x22, 0x33, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x61, 0x73,
+	0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x63, 0x0a,
+	0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x42, 0x79, 0x41, 0x70, 0x70,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x3a, 0x34, 0x92, 0x41,
+	0x31, 0x0a, 0x2f, 0x2a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x42,
+	0x79, 0x41, 0x70, 0x70, 0x49, 0x44, 0x32, 0x1a, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0x20, 0x61,
+	0x70, 0x70, 0x5f, 0x69, 0x64, 0x20, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x20, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x22, 0xe0, 0x02, 0x0a, 0x1d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4a,
+	0x0a, 0x09, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x52,
+	0x09, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x46, 0x0a, 0x08, 0x41, 0x6e,
+	0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x3a, 0x41, 0x92, 0x41, 0x3e, 0x0a, 0x3c, 0x2a, 0x1d, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x32, 0x1b, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83,
+	0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0x80, 0x85, 0xe6,
+	0x9d, 0x83, 0xe9, 0x99, 0x90, 0x32, 0xa7, 0x0e, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x6c,
+	0x0a, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x12, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x61, 0x73,
+	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x69, 0x6e, 0x69,
+	0x74, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x70, 0x62,
+	0x61, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x62, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x61, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x11,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x42, 0x79, 0x41, 0x70, 0x70, 0x49,
+	0x44, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x42, 0x79, 0x41, 0x70, 0x70, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e,
+	0x70, 0x62, 0x61, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0c,
+	0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x70,
+	0x62, 0x61, 0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x22, 0x29, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x69, 0x61, 0x6d, 0x2f, 0x66, 0x69, 0x6e, 0x64, 0x2f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x74, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x61,
+	0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x69, 0x61, 0x6d, 0x2f, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x45, 0x0a,
+	0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x17, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x2e, 0x70,
+	0x62, 0x61, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x62,
+	0x61, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x39, 0x0a,
+	0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62,
+	0x61, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x14, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x70, 0x62, 0x61, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x1a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x09, 0x49, 0x41, 0x4d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x12, 0x2e, 0x70, 0x62,
+	0x61, 0x73, 0x2e, 0x49, 0x41, 0x4d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x13, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x49, 0x41, 0x4d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x63, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70,
+	0x62, 0x61, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x1a, 0x29, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x62, 0x69, 0x7a, 0x2f,
+	0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b,
+	0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62,
+	0x61, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x5d, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x61, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a, 0x22, 0x27, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x62, 0x69, 0x7a, 0x2f,
+	0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x70,
+	0x62, 0x61, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x2a, 0x34, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x62, 0x69, 0x7a, 0x2f,
+	0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x7a, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x61, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x75, 0x74, 0x68, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x42,
+	0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65,
+	0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b,
+	0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x3b, 0x70,
+	0x62, 0x61, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2795,109 +3893,143 @@ func file_auth_server_proto_rawDescGZIP() []byte {
 	return file_auth_server_proto_rawDescData
 }
 
-var file_auth_server_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_auth_server_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_auth_server_proto_goTypes = []any{
-	(*IAMVerifyReq)(nil),                           // 0: pbas.IAMVerifyReq
-	(*IAMVerifyResp)(nil),                          // 1: pbas.IAMVerifyResp
-	(*InitAuthCenterReq)(nil),                      // 2: pbas.InitAuthCenterReq
-	(*InitAuthCenterResp)(nil),                     // 3: pbas.InitAuthCenterResp
-	(*GetAuthConfReq)(nil),                         // 4: pbas.GetAuthConfReq
-	(*GetAuthConfResp)(nil),                        // 5: pbas.GetAuthConfResp
-	(*LoginAuth)(nil),                              // 6: pbas.LoginAuth
-	(*ESB)(nil),                                    // 7: pbas.ESB
-	(*CMDB)(nil),                                   // 8: pbas.CMDB
-	(*TLS)(nil),                                    // 9: pbas.TLS
-	(*PullResourceReq)(nil),                        // 10: pbas.PullResourceReq
-	(*PullResourceResp)(nil),                       // 11: pbas.PullResourceResp
-	(*CheckPermissionReq)(nil),                     // 12: pbas.CheckPermissionReq
-	(*CheckPermissionResp)(nil),                    // 13: pbas.CheckPermissionResp
-	(*Page)(nil),                                   // 14: pbas.Page
-	(*AuthorizeBatchReq)(nil),                      // 15: pbas.AuthorizeBatchReq
-	(*UserInfo)(nil),                               // 16: pbas.UserInfo
-	(*ResourceAttribute)(nil),                      // 17: pbas.ResourceAttribute
-	(*Basic)(nil),                                  // 18: pbas.Basic
-	(*BasicDetail)(nil),                            // 19: pbas.BasicDetail
-	(*ApplyDetail)(nil),                            // 20: pbas.ApplyDetail
-	(*AuthorizeBatchResp)(nil),                     // 21: pbas.AuthorizeBatchResp
-	(*Decision)(nil),                               // 22: pbas.Decision
-	(*GetPermissionToApplyReq)(nil),                // 23: pbas.GetPermissionToApplyReq
-	(*GetPermissionToApplyResp)(nil),               // 24: pbas.GetPermissionToApplyResp
-	(*IamPermission)(nil),                          // 25: pbas.IamPermission
-	(*IamAction)(nil),                              // 26: pbas.IamAction
-	(*IamResourceType)(nil),                        // 27: pbas.IamResourceType
-	(*IamResourceInstances)(nil),                   // 28: pbas.IamResourceInstances
-	(*IamResourceInstance)(nil),                    // 29: pbas.IamResourceInstance
-	(*IamResourceAttribute)(nil),                   // 30: pbas.IamResourceAttribute
-	(*IamResourceAttributeValue)(nil),              // 31: pbas.IamResourceAttributeValue
-	(*UserCredentialReq)(nil),                      // 32: pbas.UserCredentialReq
-	(*UserInfoResp)(nil),                           // 33: pbas.UserInfoResp
-	(*ListUserSpaceReq)(nil),                       // 34: pbas.ListUserSpaceReq
-	(*Space)(nil),                                  // 35: pbas.Space
-	(*ListUserSpaceResp)(nil),                      // 36: pbas.ListUserSpaceResp
-	(*QuerySpaceReq)(nil),                          // 37: pbas.QuerySpaceReq
-	(*QuerySpaceResp)(nil),                         // 38: pbas.QuerySpaceResp
-	(*QuerySpaceByAppIDReq)(nil),                   // 39: pbas.QuerySpaceByAppIDReq
-	(*GrantResourceCreatorActionReq)(nil),          // 40: pbas.GrantResourceCreatorActionReq
-	(*GrantResourceCreatorActionReq_Ancestor)(nil), // 41: pbas.GrantResourceCreatorActionReq.Ancestor
-	(*structpb.Struct)(nil),                        // 42: google.protobuf.Struct
-	(*base.EmptyResp)(nil),                         // 43: pbbase.EmptyResp
+	(*CreateRoleReq)(nil),                          // 0: pbas.CreateRoleReq
+	(*CreateRoleResp)(nil),                         // 1: pbas.CreateRoleResp
+	(*UpdateRoleReq)(nil),                          // 2: pbas.UpdateRoleReq
+	(*UpdateRoleResp)(nil),                         // 3: pbas.UpdateRoleResp
+	(*DeleteRoleReq)(nil),                          // 4: pbas.DeleteRoleReq
+	(*DeleteRoleResp)(nil),                         // 5: pbas.DeleteRoleResp
+	(*ListRolesReq)(nil),                           // 6: pbas.ListRolesReq
+	(*ListRolesResp)(nil),                          // 7: pbas.ListRolesResp
+	(*CreateRoleBindingReq)(nil),                   // 8: pbas.CreateRoleBindingReq
+	(*CreateRoleBindingResp)(nil),                  // 9: pbas.CreateRoleBindingResp
+	(*DeleteRoleBindingReq)(nil),                   // 10: pbas.DeleteRoleBindingReq
+	(*DeleteRoleBindingResp)(nil),                  // 11: pbas.DeleteRoleBindingResp
+	(*ListRoleBindingsReq)(nil),                    // 12: pbas.ListRoleBindingsReq
+	(*ListRoleBindingsResp)(nil),                   // 13: pbas.ListRoleBindingsResp
+	(*IAMVerifyReq)(nil),                           // 14: pbas.IAMVerifyReq
+	(*IAMVerifyResp)(nil),                          // 15: pbas.IAMVerifyResp
+	(*InitAuthCenterReq)(nil),                      // 16: pbas.InitAuthCenterReq
+	(*InitAuthCenterResp)(nil),                     // 17: pbas.InitAuthCenterResp
+	(*GetAuthConfReq)(nil),                         // 18: pbas.GetAuthConfReq
+	(*GetAuthConfResp)(nil),                        // 19: pbas.GetAuthConfResp
+	(*LoginAuth)(nil),                              // 20: pbas.LoginAuth
+	(*OIDC)(nil),                                   // 21: pbas.OIDC
+	(*ESB)(nil),                                    // 22: pbas.ESB
+	(*CMDB)(nil),                                   // 23: pbas.CMDB
+	(*TLS)(nil),                                    // 24: pbas.TLS
+	(*PullResourceReq)(nil),                        // 25: pbas.PullResourceReq
+	(*PullResourceResp)(nil),                       // 26: pbas.PullResourceResp
+	(*CheckPermissionReq)(nil),                     // 27: pbas.CheckPermissionReq
+	(*CheckPermissionResp)(nil),                    // 28: pbas.CheckPermissionResp
+	(*Page)(nil),                                   // 29: pbas.Page
+	(*AuthorizeBatchReq)(nil),                      // 30: pbas.AuthorizeBatchReq
+	(*UserInfo)(nil),                               // 31: pbas.UserInfo
+	(*ResourceAttribute)(nil),                      // 32: pbas.ResourceAttribute
+	(*Basic)(nil),                                  // 33: pbas.Basic
+	(*BasicDetail)(nil),                            // 34: pbas.BasicDetail
+	(*ApplyDetail)(nil),                            // 35: pbas.ApplyDetail
+	(*AuthorizeBatchResp)(nil),                     // 36: pbas.AuthorizeBatchResp
+	(*Decision)(nil),                               // 37: pbas.Decision
+	(*GetPermissionToApplyReq)(nil),                // 38: pbas.GetPermissionToApplyReq
+	(*GetPermissionToApplyResp)(nil),               // 39: pbas.GetPermissionToApplyResp
+	(*IamPermission)(nil),                          // 40: pbas.IamPermission
+	(*IamAction)(nil),                              // 41: pbas.IamAction
+	(*IamResourceType)(nil),                        // 42: pbas.IamResourceType
+	(*IamResourceInstances)(nil),                   // 43: pbas.IamResourceInstances
+	(*IamResourceInstance)(nil),                    // 44: pbas.IamResourceInstance
+	(*IamResourceAttribute)(nil),                   // 45: pbas.IamResourceAttribute
+	(*IamResourceAttributeValue)(nil),              // 46: pbas.IamResourceAttributeValue
+	(*UserCredentialReq)(nil),                      // 47: pbas.UserCredentialReq
+	(*UserInfoResp)(nil),                           // 48: pbas.UserInfoResp
+	(*ListUserSpaceReq)(nil),                       // 49: pbas.ListUserSpaceReq
+	(*Space)(nil),                                  // 50: pbas.Space
+	(*ListUserSpaceResp)(nil),                      // 51: pbas.ListUserSpaceResp
+	(*QuerySpaceReq)(nil),                          // 52: pbas.QuerySpaceReq
+	(*QuerySpaceResp)(nil),                         // 53: pbas.QuerySpaceResp
+	(*QuerySpaceByAppIDReq)(nil),                   // 54: pbas.QuerySpaceByAppIDReq
+	(*GrantResourceCreatorActionReq)(nil),          // 55: pbas.GrantResourceCreatorActionReq
+	(*GrantResourceCreatorActionReq_Ancestor)(nil), // 56: pbas.GrantResourceCreatorActionReq.Ancestor
+	(*role.Role)(nil),                              // 57: pbrole.Role
+	(*role.RoleBinding)(nil),                       // 58: pbrole.RoleBinding
+	(*structpb.Struct)(nil),                        // 59: google.protobuf.Struct
+	(*base.EmptyResp)(nil),                         // 60: pbbase.EmptyResp
 }
 var file_auth_server_proto_depIdxs = []int32{
-	6,  // 0: pbas.GetAuthConfResp.login_auth:type_name -> pbas.LoginAuth
-	7,  // 1: pbas.GetAuthConfResp.esb:type_name -> pbas.ESB
-	8,  // 2: pbas.GetAuthConfResp.cmdb:type_name -> pbas.CMDB
-	9,  // 3: pbas.ESB.tls:type_name -> pbas.TLS
-	42, // 4: pbas.PullResourceReq.filter:type_name -> google.protobuf.Struct
-	14, // 5: pbas.PullResourceReq.page:type_name -> pbas.Page
-	42, // 6: pbas.PullResourceResp.data:type_name -> google.protobuf.Struct
-	17, // 7: pbas.CheckPermissionReq.resources:type_name -> pbas.ResourceAttribute
-	19, // 8: pbas.CheckPermissionResp.resources:type_name -> pbas.BasicDetail
-	16, // 9: pbas.AuthorizeBatchReq.user:type_name -> pbas.UserInfo
-	17, // 10: pbas.AuthorizeBatchReq.resources:type_name -> pbas.ResourceAttribute
-	18, // 11: pbas.ResourceAttribute.basic:type_name -> pbas.Basic
-	19, // 12: pbas.ApplyDetail.resources:type_name -> pbas.BasicDetail
-	22, // 13: pbas.AuthorizeBatchResp.decisions:type_name -> pbas.Decision
-	17, // 14: pbas.Decision.resource:type_name -> pbas.ResourceAttribute
-	17, // 15: pbas.GetPermissionToApplyReq.resources:type_name -> pbas.ResourceAttribute
-	25, // 16: pbas.GetPermissionToApplyResp.permission:type_name -> pbas.IamPermission
-	26, // 17: pbas.IamPermission.actions:type_name -> pbas.IamAction
-	27, // 18: pbas.IamAction.related_resource_types:type_name -> pbas.IamResourceType
-	28, // 19: pbas.IamResourceType.instances:type_name -> pbas.IamResourceInstances
-	30, // 20: pbas.IamResourceType.attributes:type_name -> pbas.IamResourceAttribute
-	29, // 21: pbas.IamResourceInstances.instances:type_name -> pbas.IamResourceInstance
-	31, // 22: pbas.IamResourceAttribute.values:type_name -> pbas.IamResourceAttributeValue
-	35, // 23: pbas.ListUserSpaceResp.items:type_name -> pbas.Space
-	35, // 24: pbas.QuerySpaceResp.items:type_name -> pbas.Space
-	41, // 25: pbas.GrantResourceCreatorActionReq.ancestors:type_name -> pbas.GrantResourceCreatorActionReq.Ancestor
-	2,  // 26: pbas.Auth.InitAuthCenter:input_type -> pbas.InitAuthCenterReq
-	32, // 27: pbas.Auth.GetUserInfo:input_type -> pbas.UserCredentialReq
-	34, // 28: pbas.Auth.ListUserSpace:input_type -> pbas.ListUserSpaceReq
-	39, // 29: pbas.Auth.QuerySpaceByAppID:input_type -> pbas.QuerySpaceByAppIDReq
-	10, // 30: pbas.Auth.PullResource:input_type -> pbas.PullResourceReq
-	12, // 31: pbas.Auth.CheckPermission:input_type -> pbas.CheckPermissionReq
-	15, // 32: pbas.Auth.AuthorizeBatch:input_type -> pbas.AuthorizeBatchReq
-	23, // 33: pbas.Auth.GetPermissionToApply:input_type -> pbas.GetPermissionToApplyReq
-	37, // 34: pbas.Auth.QuerySpace:input_type -> pbas.QuerySpaceReq
-	4,  // 35: pbas.Auth.GetAuthConf:input_type -> pbas.GetAuthConfReq
-	40, // 36: pbas.Auth.GrantResourceCreatorAction:input_type -> pbas.GrantResourceCreatorActionReq
-	0,  // 37: pbas.Auth.IAMVerify:input_type -> pbas.IAMVerifyReq
-	3,  // 38: pbas.Auth.InitAuthCenter:output_type -> pbas.InitAuthCenterResp
-	33, // 39: pbas.Auth.GetUserInfo:output_type -> pbas.UserInfoResp
-	36, // 40: pbas.Auth.ListUserSpace:output_type -> pbas.ListUserSpaceResp
-	35, // 41: pbas.Auth.QuerySpaceByAppID:output_type -> pbas.Space
-	42, // 42: pbas.Auth.PullResource:output_type -> google.protobuf.Struct
-	13, // 43: pbas.Auth.CheckPermission:output_type -> pbas.CheckPermissionResp
-	21, // 44: pbas.Auth.AuthorizeBatch:output_type -> pbas.AuthorizeBatchResp
-	24, // 45: pbas.Auth.GetPermissionToApply:output_type -> pbas.GetPermissionToApplyResp
-	38, // 46: pbas.Auth.QuerySpace:output_type -> pbas.QuerySpaceResp
-	5,  // 47: pbas.Auth.GetAuthConf:output_type -> pbas.GetAuthConfResp
-	43, // 48: pbas.Auth.GrantResourceCreatorAction:output_type -> pbbase.EmptyResp
-	1,  // 49: pbas.Auth.IAMVerify:output_type -> pbas.IAMVerifyResp
-	38, // [38:50] is the sub-list for method output_type
-	26, // [26:38] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	57, // 0: pbas.ListRolesResp.details:type_name -> pbrole.Role
+	58, // 1: pbas.ListRoleBindingsResp.details:type_name -> pbrole.RoleBinding
+	20, // 2: pbas.GetAuthConfResp.login_auth:type_name -> pbas.LoginAuth
+	22, // 3: pbas.GetAuthConfResp.esb:type_name -> pbas.ESB
+	23, // 4: pbas.GetAuthConfResp.cmdb:type_name -> pbas.CMDB
+	21, // 5: pbas.LoginAuth.oidc:type_name -> pbas.OIDC
+	24, // 6: pbas.ESB.tls:type_name -> pbas.TLS
+	59, // 7: pbas.PullResourceReq.filter:type_name -> google.protobuf.Struct
+	29, // 8: pbas.PullResourceReq.page:type_name -> pbas.Page
+	59, // 9: pbas.PullResourceResp.data:type_name -> google.protobuf.Struct
+	32, // 10: pbas.CheckPermissionReq.resources:type_name -> pbas.ResourceAttribute
+	34, // 11: pbas.CheckPermissionResp.resources:type_name -> pbas.BasicDetail
+	31, // 12: pbas.AuthorizeBatchReq.user:type_name -> pbas.UserInfo
+	32, // 13: pbas.AuthorizeBatchReq.resources:type_name -> pbas.ResourceAttribute
+	33, // 14: pbas.ResourceAttribute.basic:type_name -> pbas.Basic
+	34, // 15: pbas.ApplyDetail.resources:type_name -> pbas.BasicDetail
+	37, // 16: pbas.AuthorizeBatchResp.decisions:type_name -> pbas.Decision
+	32, // 17: pbas.Decision.resource:type_name -> pbas.ResourceAttribute
+	32, // 18: pbas.GetPermissionToApplyReq.resources:type_name -> pbas.ResourceAttribute
+	40, // 19: pbas.GetPermissionToApplyResp.permission:type_name -> pbas.IamPermission
+	41, // 20: pbas.IamPermission.actions:type_name -> pbas.IamAction
+	42, // 21: pbas.IamAction.related_resource_types:type_name -> pbas.IamResourceType
+	43, // 22: pbas.IamResourceType.instances:type_name -> pbas.IamResourceInstances
+	45, // 23: pbas.IamResourceType.attributes:type_name -> pbas.IamResourceAttribute
+	44, // 24: pbas.IamResourceInstances.instances:type_name -> pbas.IamResourceInstance
+	46, // 25: pbas.IamResourceAttribute.values:type_name -> pbas.IamResourceAttributeValue
+	50, // 26: pbas.ListUserSpaceResp.items:type_name -> pbas.Space
+	50, // 27: pbas.QuerySpaceResp.items:type_name -> pbas.Space
+	56, // 28: pbas.GrantResourceCreatorActionReq.ancestors:type_name -> pbas.GrantResourceCreatorActionReq.Ancestor
+	16, // 29: pbas.Auth.InitAuthCenter:input_type -> pbas.InitAuthCenterReq
+	47, // 30: pbas.Auth.GetUserInfo:input_type -> pbas.UserCredentialReq
+	49, // 31: pbas.Auth.ListUserSpace:input_type -> pbas.ListUserSpaceReq
+	54, // 32: pbas.Auth.QuerySpaceByAppID:input_type -> pbas.QuerySpaceByAppIDReq
+	25, // 33: pbas.Auth.PullResource:input_type -> pbas.PullResourceReq
+	27, // 34: pbas.Auth.CheckPermission:input_type -> pbas.CheckPermissionReq
+	30, // 35: pbas.Auth.AuthorizeBatch:input_type -> pbas.AuthorizeBatchReq
+	38, // 36: pbas.Auth.GetPermissionToApply:input_type -> pbas.GetPermissionToApplyReq
+	52, // 37: pbas.Auth.QuerySpace:input_type -> pbas.QuerySpaceReq
+	18, // 38: pbas.Auth.GetAuthConf:input_type -> pbas.GetAuthConfReq
+	55, // 39: pbas.Auth.GrantResourceCreatorAction:input_type -> pbas.GrantResourceCreatorActionReq
+	14, // 40: pbas.Auth.IAMVerify:input_type -> pbas.IAMVerifyReq
+	0,  // 41: pbas.Auth.CreateRole:input_type -> pbas.CreateRoleReq
+	2,  // 42: pbas.Auth.UpdateRole:input_type -> pbas.UpdateRoleReq
+	4,  // 43: pbas.Auth.DeleteRole:input_type -> pbas.DeleteRoleReq
+	6,  // 44: pbas.Auth.ListRoles:input_type -> pbas.ListRolesReq
+	8,  // 45: pbas.Auth.CreateRoleBinding:input_type -> pbas.CreateRoleBindingReq
+	10, // 46: pbas.Auth.DeleteRoleBinding:input_type -> pbas.DeleteRoleBindingReq
+	12, // 47: pbas.Auth.ListRoleBindings:input_type -> pbas.ListRoleBindingsReq
+	17, // 48: pbas.Auth.InitAuthCenter:output_type -> pbas.InitAuthCenterResp
+	48, // 49: pbas.Auth.GetUserInfo:output_type -> pbas.UserInfoResp
+	51, // 50: pbas.Auth.ListUserSpace:output_type -> pbas.ListUserSpaceResp
+	50, // 51: pbas.Auth.QuerySpaceByAppID:output_type -> pbas.Space
+	59, // 52: pbas.Auth.PullResource:output_type -> google.protobuf.Struct
+	28, // 53: pbas.Auth.CheckPermission:output_type -> pbas.CheckPermissionResp
+	36, // 54: pbas.Auth.AuthorizeBatch:output_type -> pbas.AuthorizeBatchResp
+	39, // 55: pbas.Auth.GetPermissionToApply:output_type -> pbas.GetPermissionToApplyResp
+	53, // 56: pbas.Auth.QuerySpace:output_type -> pbas.QuerySpaceResp
+	19, // 57: pbas.Auth.GetAuthConf:output_type -> pbas.GetAuthConfResp
+	60, // 58: pbas.Auth.GrantResourceCreatorAction:output_type -> pbbase.EmptyResp
+	15, // 59: pbas.Auth.IAMVerify:output_type -> pbas.IAMVerifyResp
+	1,  // 60: pbas.Auth.CreateRole:output_type -> pbas.CreateRoleResp
+	3,  // 61: pbas.Auth.UpdateRole:output_type -> pbas.UpdateRoleResp
+	5,  // 62: pbas.Auth.DeleteRole:output_type -> pbas.DeleteRoleResp
+	7,  // 63: pbas.Auth.ListRoles:output_type -> pbas.ListRolesResp
+	9,  // 64: pbas.Auth.CreateRoleBinding:output_type -> pbas.CreateRoleBindingResp
+	11, // 65: pbas.Auth.DeleteRoleBinding:output_type -> pbas.DeleteRoleBindingResp
+	13, // 66: pbas.Auth.ListRoleBindings:output_type -> pbas.ListRoleBindingsResp
+	48, // [48:67] is the sub-list for method output_type
+	29, // [29:48] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_auth_server_proto_init() }
@@ -2911,7 +4043,7 @@ func file_auth_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_auth_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   42,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
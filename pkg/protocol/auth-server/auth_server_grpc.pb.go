@@ -33,6 +33,13 @@ const (
 	Auth_GetAuthConf_FullMethodName                = "/pbas.Auth/GetAuthConf"
 	Auth_GrantResourceCreatorAction_FullMethodName = "/pbas.Auth/GrantResourceCreatorAction"
 	Auth_IAMVerify_FullMethodName                  = "/pbas.Auth/IAMVerify"
+	Auth_CreateRole_FullMethodName                 = "/pbas.Auth/CreateRole"
+	Auth_UpdateRole_FullMethodName                 = "/pbas.Auth/UpdateRole"
+	Auth_DeleteRole_FullMethodName                 = "/pbas.Auth/DeleteRole"
+	Auth_ListRoles_FullMethodName                  = "/pbas.Auth/ListRoles"
+	Auth_CreateRoleBinding_FullMethodName          = "/pbas.Auth/CreateRoleBinding"
+	Auth_DeleteRoleBinding_FullMethodName          = "/pbas.Auth/DeleteRoleBinding"
+	Auth_ListRoleBindings_FullMethodName           = "/pbas.Auth/ListRoleBindings"
 )
 
 // AuthClient is the client API for Auth service.
@@ -60,6 +67,20 @@ type AuthClient interface {
 	GrantResourceCreatorAction(ctx context.Context, in *GrantResourceCreatorActionReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	// 验证iam
 	IAMVerify(ctx context.Context, in *IAMVerifyReq, opts ...grpc.CallOption) (*IAMVerifyResp, error)
+	// 创建内置 rbac 权限角色
+	CreateRole(ctx context.Context, in *CreateRoleReq, opts ...grpc.CallOption) (*CreateRoleResp, error)
+	// 更新内置 rbac 权限角色
+	UpdateRole(ctx context.Context, in *UpdateRoleReq, opts ...grpc.CallOption) (*UpdateRoleResp, error)
+	// 删除内置 rbac 权限角色，角色的绑定关系同时删除
+	DeleteRole(ctx context.Context, in *DeleteRoleReq, opts ...grpc.CallOption) (*DeleteRoleResp, error)
+	// 获取内置 rbac 权限角色列表
+	ListRoles(ctx context.Context, in *ListRolesReq, opts ...grpc.CallOption) (*ListRolesResp, error)
+	// 将角色授权给用户
+	CreateRoleBinding(ctx context.Context, in *CreateRoleBindingReq, opts ...grpc.CallOption) (*CreateRoleBindingResp, error)
+	// 删除角色授权
+	DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingReq, opts ...grpc.CallOption) (*DeleteRoleBindingResp, error)
+	// 获取角色授权列表
+	ListRoleBindings(ctx context.Context, in *ListRoleBindingsReq, opts ...grpc.CallOption) (*ListRoleBindingsResp, error)
 }
 
 type authClient struct {
@@ -178,6 +199,69 @@ func (c *authClient) IAMVerify(ctx context.Context, in *IAMVerifyReq, opts ...gr
 	return out, nil
 }
 
+func (c *authClient) CreateRole(ctx context.Context, in *CreateRoleReq, opts ...grpc.CallOption) (*CreateRoleResp, error) {
+	out := new(CreateRoleResp)
+	err := c.cc.Invoke(ctx, Auth_CreateRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) UpdateRole(ctx context.Context, in *UpdateRoleReq, opts ...grpc.CallOption) (*UpdateRoleResp, error) {
+	out := new(UpdateRoleResp)
+	err := c.cc.Invoke(ctx, Auth_UpdateRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) DeleteRole(ctx context.Context, in *DeleteRoleReq, opts ...grpc.CallOption) (*DeleteRoleResp, error) {
+	out := new(DeleteRoleResp)
+	err := c.cc.Invoke(ctx, Auth_DeleteRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ListRoles(ctx context.Context, in *ListRolesReq, opts ...grpc.CallOption) (*ListRolesResp, error) {
+	out := new(ListRolesResp)
+	err := c.cc.Invoke(ctx, Auth_ListRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CreateRoleBinding(ctx context.Context, in *CreateRoleBindingReq, opts ...grpc.CallOption) (*CreateRoleBindingResp, error) {
+	out := new(CreateRoleBindingResp)
+	err := c.cc.Invoke(ctx, Auth_CreateRoleBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingReq, opts ...grpc.CallOption) (*DeleteRoleBindingResp, error) {
+	out := new(DeleteRoleBindingResp)
+	err := c.cc.Invoke(ctx, Auth_DeleteRoleBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ListRoleBindings(ctx context.Context, in *ListRoleBindingsReq, opts ...grpc.CallOption) (*ListRoleBindingsResp, error) {
+	out := new(ListRoleBindingsResp)
+	err := c.cc.Invoke(ctx, Auth_ListRoleBindings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServer is the server API for Auth service.
 // All implementations should embed UnimplementedAuthServer
 // for forward compatibility
@@ -203,6 +287,20 @@ type AuthServer interface {
 	GrantResourceCreatorAction(context.Context, *GrantResourceCreatorActionReq) (*base.EmptyResp, error)
 	// 验证iam
 	IAMVerify(context.Context, *IAMVerifyReq) (*IAMVerifyResp, error)
+	// 创建内置 rbac 权限角色
+	CreateRole(context.Context, *CreateRoleReq) (*CreateRoleResp, error)
+	// 更新内置 rbac 权限角色
+	UpdateRole(context.Context, *UpdateRoleReq) (*UpdateRoleResp, error)
+	// 删除内置 rbac 权限角色，角色的绑定关系同时删除
+	DeleteRole(context.Context, *DeleteRoleReq) (*DeleteRoleResp, error)
+	// 获取内置 rbac 权限角色列表
+	ListRoles(context.Context, *ListRolesReq) (*ListRolesResp, error)
+	// 将角色授权给用户
+	CreateRoleBinding(context.Context, *CreateRoleBindingReq) (*CreateRoleBindingResp, error)
+	// 删除角色授权
+	DeleteRoleBinding(context.Context, *DeleteRoleBindingReq) (*DeleteRoleBindingResp, error)
+	// 获取角色授权列表
+	ListRoleBindings(context.Context, *ListRoleBindingsReq) (*ListRoleBindingsResp, error)
 }
 
 // UnimplementedAuthServer should be embedded to have forward compatible implementations.
@@ -245,6 +343,27 @@ func (UnimplementedAuthServer) GrantResourceCreatorAction(context.Context, *Gran
 func (UnimplementedAuthServer) IAMVerify(context.Context, *IAMVerifyReq) (*IAMVerifyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IAMVerify not implemented")
 }
+func (UnimplementedAuthServer) CreateRole(context.Context, *CreateRoleReq) (*CreateRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedAuthServer) UpdateRole(context.Context, *UpdateRoleReq) (*UpdateRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedAuthServer) DeleteRole(context.Context, *DeleteRoleReq) (*DeleteRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedAuthServer) ListRoles(context.Context, *ListRolesReq) (*ListRolesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedAuthServer) CreateRoleBinding(context.Context, *CreateRoleBindingReq) (*CreateRoleBindingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleBinding not implemented")
+}
+func (UnimplementedAuthServer) DeleteRoleBinding(context.Context, *DeleteRoleBindingReq) (*DeleteRoleBindingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleBinding not implemented")
+}
+func (UnimplementedAuthServer) ListRoleBindings(context.Context, *ListRoleBindingsReq) (*ListRoleBindingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleBindings not implemented")
+}
 
 // UnsafeAuthServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AuthServer will
@@ -473,6 +592,132 @@ func _Auth_IAMVerify_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_CreateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CreateRole(ctx, req.(*CreateRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).UpdateRole(ctx, req.(*UpdateRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_DeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).DeleteRole(ctx, req.(*DeleteRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ListRoles(ctx, req.(*ListRolesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_CreateRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleBindingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CreateRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_CreateRoleBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CreateRoleBinding(ctx, req.(*CreateRoleBindingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_DeleteRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleBindingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).DeleteRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_DeleteRoleBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).DeleteRoleBinding(ctx, req.(*DeleteRoleBindingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ListRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleBindingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ListRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ListRoleBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ListRoleBindings(ctx, req.(*ListRoleBindingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Auth_ServiceDesc is the grpc.ServiceDesc for Auth service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -528,6 +773,34 @@ var Auth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IAMVerify",
 			Handler:    _Auth_IAMVerify_Handler,
 		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _Auth_CreateRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _Auth_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _Auth_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _Auth_ListRoles_Handler,
+		},
+		{
+			MethodName: "CreateRoleBinding",
+			Handler:    _Auth_CreateRoleBinding_Handler,
+		},
+		{
+			MethodName: "DeleteRoleBinding",
+			Handler:    _Auth_DeleteRoleBinding_Handler,
+		},
+		{
+			MethodName: "ListRoleBindings",
+			Handler:    _Auth_ListRoleBindings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth_server.proto",
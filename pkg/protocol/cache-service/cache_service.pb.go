@@ -1591,6 +1591,157 @@ func (*SetAppLastConsumedTimeResp) Descriptor() ([]byte, []int) {
 	return file_cache_service_proto_rawDescGZIP(), []int{29}
 }
 
+type CredentialUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CredentialId uint32 `protobuf:"varint,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	ClientUid    string `protobuf:"bytes,2,opt,name=client_uid,json=clientUid,proto3" json:"client_uid,omitempty"`
+	// unix timestamp in seconds
+	UsedAt int64 `protobuf:"varint,3,opt,name=used_at,json=usedAt,proto3" json:"used_at,omitempty"`
+}
+
+func (x *CredentialUsage) Reset() {
+	*x = CredentialUsage{}
+	mi := &file_cache_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialUsage) ProtoMessage() {}
+
+func (x *CredentialUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialUsage.ProtoReflect.Descriptor instead.
+func (*CredentialUsage) Descriptor() ([]byte, []int) {
+	return file_cache_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CredentialUsage) GetCredentialId() uint32 {
+	if x != nil {
+		return x.CredentialId
+	}
+	return 0
+}
+
+func (x *CredentialUsage) GetClientUid() string {
+	if x != nil {
+		return x.ClientUid
+	}
+	return ""
+}
+
+func (x *CredentialUsage) GetUsedAt() int64 {
+	if x != nil {
+		return x.UsedAt
+	}
+	return 0
+}
+
+type SetCredentialUsageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId  uint32             `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Usages []*CredentialUsage `protobuf:"bytes,2,rep,name=usages,proto3" json:"usages,omitempty"`
+}
+
+func (x *SetCredentialUsageReq) Reset() {
+	*x = SetCredentialUsageReq{}
+	mi := &file_cache_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCredentialUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCredentialUsageReq) ProtoMessage() {}
+
+func (x *SetCredentialUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCredentialUsageReq.ProtoReflect.Descriptor instead.
+func (*SetCredentialUsageReq) Descriptor() ([]byte, []int) {
+	return file_cache_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetCredentialUsageReq) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *SetCredentialUsageReq) GetUsages() []*CredentialUsage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+type SetCredentialUsageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetCredentialUsageResp) Reset() {
+	*x = SetCredentialUsageResp{}
+	mi := &file_cache_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCredentialUsageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCredentialUsageResp) ProtoMessage() {}
+
+func (x *SetCredentialUsageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCredentialUsageResp.ProtoReflect.Descriptor instead.
+func (*SetCredentialUsageResp) Descriptor() ([]byte, []int) {
+	return file_cache_service_proto_rawDescGZIP(), []int{32}
+}
+
 type GetTenantIDByBizReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1602,7 +1753,7 @@ type GetTenantIDByBizReq struct {
 
 func (x *GetTenantIDByBizReq) Reset() {
 	*x = GetTenantIDByBizReq{}
-	mi := &file_cache_service_proto_msgTypes[30]
+	mi := &file_cache_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1614,7 +1765,7 @@ func (x *GetTenantIDByBizReq) String() string {
 func (*GetTenantIDByBizReq) ProtoMessage() {}
 
 func (x *GetTenantIDByBizReq) ProtoReflect() protoreflect.Message {
-	mi := &file_cache_service_proto_msgTypes[30]
+	mi := &file_cache_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,7 +1778,7 @@ func (x *GetTenantIDByBizReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantIDByBizReq.ProtoReflect.Descriptor instead.
 func (*GetTenantIDByBizReq) Descriptor() ([]byte, []int) {
-	return file_cache_service_proto_rawDescGZIP(), []int{30}
+	return file_cache_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetTenantIDByBizReq) GetBizId() uint32 {
@@ -1654,7 +1805,7 @@ type GetTenantIDByBizResp struct {
 
 func (x *GetTenantIDByBizResp) Reset() {
 	*x = GetTenantIDByBizResp{}
-	mi := &file_cache_service_proto_msgTypes[31]
+	mi := &file_cache_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1666,7 +1817,7 @@ func (x *GetTenantIDByBizResp) String() string {
 func (*GetTenantIDByBizResp) ProtoMessage() {}
 
 func (x *GetTenantIDByBizResp) ProtoReflect() protoreflect.Message {
-	mi := &file_cache_service_proto_msgTypes[31]
+	mi := &file_cache_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,7 +1830,7 @@ func (x *GetTenantIDByBizResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantIDByBizResp.ProtoReflect.Descriptor instead.
 func (*GetTenantIDByBizResp) Descriptor() ([]byte, []int) {
-	return file_cache_service_proto_rawDescGZIP(), []int{31}
+	return file_cache_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetTenantIDByBizResp) GetTenantId() string {
@@ -1699,7 +1850,7 @@ type GetAgentBizReq struct {
 
 func (x *GetAgentBizReq) Reset() {
 	*x = GetAgentBizReq{}
-	mi := &file_cache_service_proto_msgTypes[32]
+	mi := &file_cache_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1711,7 +1862,7 @@ func (x *GetAgentBizReq) String() string {
 func (*GetAgentBizReq) ProtoMessage() {}
 
 func (x *GetAgentBizReq) ProtoReflect() protoreflect.Message {
-	mi := &file_cache_service_proto_msgTypes[32]
+	mi := &file_cache_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1724,7 +1875,7 @@ func (x *GetAgentBizReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentBizReq.ProtoReflect.Descriptor instead.
 func (*GetAgentBizReq) Descriptor() ([]byte, []int) {
-	return file_cache_service_proto_rawDescGZIP(), []int{32}
+	return file_cache_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetAgentBizReq) GetAgentId() string {
@@ -1745,7 +1896,7 @@ type GetAgentBizResp struct {
 
 func (x *GetAgentBizResp) Reset() {
 	*x = GetAgentBizResp{}
-	mi := &file_cache_service_proto_msgTypes[33]
+	mi := &file_cache_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1757,7 +1908,7 @@ func (x *GetAgentBizResp) String() string {
 func (*GetAgentBizResp) ProtoMessage() {}
 
 func (x *GetAgentBizResp) ProtoReflect() protoreflect.Message {
-	mi := &file_cache_service_proto_msgTypes[33]
+	mi := &file_cache_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1770,7 +1921,7 @@ func (x *GetAgentBizResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentBizResp.ProtoReflect.Descriptor instead.
 func (*GetAgentBizResp) Descriptor() ([]byte, []int) {
-	return file_cache_service_proto_rawDescGZIP(), []int{33}
+	return file_cache_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetAgentBizResp) GetBizId() uint32 {
@@ -1942,149 +2093,169 @@ var file_cache_service_proto_rawDesc = []byte{
 	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x5f, 0x69,
 	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x70, 0x70, 0x49, 0x64, 0x73,
 	0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x46,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x79, 0x42,
-	0x69, 0x7a, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x79, 0x42, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1b,
-	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a,
-	0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x62,
-	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0xa6, 0x0f, 0x0a, 0x05, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x12, 0x6c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x44, 0x12, 0x11,
-	0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49,
-	0x44, 0x52, 0x65, 0x73, 0x70, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x62, 0x69, 0x7a,
-	0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b,
-	0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64,
-	0x12, 0x6b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x13,
-	0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6e,
+	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x55, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5d,
+	0x0a, 0x15, 0x53, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x2d,
+	0x0a, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x18, 0x0a,
+	0x16, 0x53, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x46, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x79, 0x42, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x12, 0x15,
+	0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22,
+	0x33, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x79,
+	0x42, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x42, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x7a,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x32, 0xf9, 0x0f, 0x0a, 0x05, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x6c, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x44, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x63,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x22, 0x39,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x12, 0x6b, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70,
+	0x62, 0x63, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70,
+	0x70, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x2f, 0x7b, 0x62,
+	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x33, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
+	0x70, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x49, 0x12, 0x16, 0x2e, 0x70,
+	0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43,
+	0x49, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e,
+	0x52, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a,
+	0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2f, 0x63, 0x69, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x2f, 0x62, 0x69,
+	0x7a, 0x5f, 0x69, 0x64, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x76, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x48, 0x6f, 0x6f, 0x6b,
+	0x12, 0x18, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x63,
+	0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x36, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x2f, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x2f, 0x7b, 0x62, 0x69,
+	0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x1e, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x61, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62,
+	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22,
+	0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x6c,
+	0x69, 0x73, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x6b, 0x0a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x70,
+	0x62, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a,
+	0x01, 0x2a, 0x22, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x76, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x1a, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x61, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b,
+	0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x2f, 0x7b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x7d, 0x12,
+	0x3f, 0x0a, 0x0c, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x12,
+	0x15, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x41, 0x70, 0x70, 0x4d,
+	0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x0f, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x43, 0x49, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x49, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x70, 0x62, 0x63, 0x73, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x43, 0x49, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x4b, 0x76, 0x12, 0x16, 0x2e, 0x70, 0x62,
+	0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x4b, 0x76,
 	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x52,
-	0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01,
-	0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65,
-	0x2f, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x62, 0x69,
-	0x7a, 0x5f, 0x69, 0x64, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x33, 0x0a,
-	0x08, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70,
-	0x62, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x00, 0x12, 0x70, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x64, 0x43, 0x49, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x49, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62,
-	0x63, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x34,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x63, 0x69, 0x2f, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x2f, 0x7b, 0x62, 0x69, 0x7a,
-	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x76, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
-	0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x61, 0x77,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22,
-	0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x68,
-	0x6f, 0x6f, 0x6b, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a,
-	0x5f, 0x69, 0x64, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8c, 0x01, 0x0a,
-	0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4a, 0x73,
-	0x6f, 0x6e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x3a, 0x12, 0x38, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65,
-	0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70,
-	0x70, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x63,
-	0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52,
-	0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x34, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x2f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x12, 0x6b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4d,
-	0x65, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x76,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
-	0x16, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4a,
-	0x73, 0x6f, 0x6e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x34, 0x12, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68,
-	0x65, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x2f, 0x7b, 0x63, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x7d, 0x12, 0x3f, 0x0a, 0x0c, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x41,
-	0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x42, 0x65,
-	0x6e, 0x63, 0x68, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x70, 0x62, 0x63, 0x73, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x42, 0x65, 0x6e, 0x63, 0x68,
-	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x49, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x63,
-	0x73, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43,
-	0x49, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x49, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x70, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
-	0x4b, 0x76, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x64, 0x4b, 0x76, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x63,
-	0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x34, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x6b, 0x76, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x64, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x63,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x4b, 0x76, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4a,
-	0x73, 0x6f, 0x6e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x46, 0x3a, 0x01, 0x2a, 0x22, 0x41, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x61, 0x63, 0x68, 0x65, 0x2f, 0x6b, 0x76, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
-	0x2f, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6b,
-	0x65, 0x79, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x18, 0x2e, 0x70,
-	0x62, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53, 0x65,
-	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x22, 0x36, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x62, 0x69, 0x7a, 0x2f,
-	0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x61,
-	0x70, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x12, 0x5d, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61,
-	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f,
-	0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x73, 0x74,
-	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x20, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x73,
-	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x18,
-	0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c,
-	0x3a, 0x01, 0x2a, 0x22, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x79, 0x42, 0x69, 0x7a,
-	0x12, 0x19, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x49, 0x44, 0x42, 0x79, 0x42, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x62,
-	0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x79,
-	0x42, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x7a, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x1a, 0x15,
-	0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69,
-	0x7a, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x62, 0x69,
-	0x7a, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f,
-	0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x3b, 0x70, 0x62, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01,
+	0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2f, 0x6b, 0x76, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a,
+	0x5f, 0x69, 0x64, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x4b, 0x76, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x11, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x61, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x3a, 0x01, 0x2a, 0x22, 0x41,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x6b, 0x76,
+	0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64,
+	0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b,
+	0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x65, 0x79, 0x2f, 0x7b, 0x6b, 0x65, 0x79,
+	0x7d, 0x12, 0x89, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x22, 0x36, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x5d, 0x0a,
+	0x16, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53,
+	0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e,
+	0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x12,
+	0x53, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x1c, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x77, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x63,
+	0x73, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a, 0x22,
+	0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x62,
+	0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x79, 0x42, 0x69, 0x7a, 0x12, 0x19, 0x2e, 0x70,
+	0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x42,
+	0x79, 0x42, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x79, 0x42, 0x69, 0x7a, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x42, 0x69, 0x7a, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x63,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x7a, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x7b,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x62, 0x69, 0x7a, 0x42, 0x44, 0x5a,
+	0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62,
+	0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3b, 0x70,
+	0x62, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2099,7 +2270,7 @@ func file_cache_service_proto_rawDescGZIP() []byte {
 	return file_cache_service_proto_rawDescData
 }
 
-var file_cache_service_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_cache_service_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_cache_service_proto_goTypes = []any{
 	(*JsonRawResp)(nil),                    // 0: pbcs.JsonRawResp
 	(*GetAppIDReq)(nil),                    // 1: pbcs.GetAppIDReq
@@ -2131,68 +2302,74 @@ var file_cache_service_proto_goTypes = []any{
 	(*SetPublishTimeResp)(nil),             // 27: pbcs.SetPublishTimeResp
 	(*SetAppLastConsumedTimeReq)(nil),      // 28: pbcs.SetAppLastConsumedTimeReq
 	(*SetAppLastConsumedTimeResp)(nil),     // 29: pbcs.SetAppLastConsumedTimeResp
-	(*GetTenantIDByBizReq)(nil),            // 30: pbcs.GetTenantIDByBizReq
-	(*GetTenantIDByBizResp)(nil),           // 31: pbcs.GetTenantIDByBizResp
-	(*GetAgentBizReq)(nil),                 // 32: pbcs.GetAgentBizReq
-	(*GetAgentBizResp)(nil),                // 33: pbcs.GetAgentBizResp
-	nil,                                    // 34: pbcs.BenchAppMetaResp.MetaEntry
-	(*app.App)(nil),                        // 35: pbapp.App
-	(*released_ci.ReleasedConfigItem)(nil), // 36: pbrci.ReleasedConfigItem
-	(*base.BasePage)(nil),                  // 37: pbbase.BasePage
-	(*event.EventSpec)(nil),                // 38: pbevent.EventSpec
-	(*event.EventAttachment)(nil),          // 39: pbevent.EventAttachment
-	(*base.EmptyReq)(nil),                  // 40: pbbase.EmptyReq
+	(*CredentialUsage)(nil),                // 30: pbcs.CredentialUsage
+	(*SetCredentialUsageReq)(nil),          // 31: pbcs.SetCredentialUsageReq
+	(*SetCredentialUsageResp)(nil),         // 32: pbcs.SetCredentialUsageResp
+	(*GetTenantIDByBizReq)(nil),            // 33: pbcs.GetTenantIDByBizReq
+	(*GetTenantIDByBizResp)(nil),           // 34: pbcs.GetTenantIDByBizResp
+	(*GetAgentBizReq)(nil),                 // 35: pbcs.GetAgentBizReq
+	(*GetAgentBizResp)(nil),                // 36: pbcs.GetAgentBizResp
+	nil,                                    // 37: pbcs.BenchAppMetaResp.MetaEntry
+	(*app.App)(nil),                        // 38: pbapp.App
+	(*released_ci.ReleasedConfigItem)(nil), // 39: pbrci.ReleasedConfigItem
+	(*base.BasePage)(nil),                  // 40: pbbase.BasePage
+	(*event.EventSpec)(nil),                // 41: pbevent.EventSpec
+	(*event.EventAttachment)(nil),          // 42: pbevent.EventAttachment
+	(*base.EmptyReq)(nil),                  // 43: pbbase.EmptyReq
 }
 var file_cache_service_proto_depIdxs = []int32{
-	35, // 0: pbcs.ListAppsResp.details:type_name -> pbapp.App
-	34, // 1: pbcs.BenchAppMetaResp.meta:type_name -> pbcs.BenchAppMetaResp.MetaEntry
-	36, // 2: pbcs.BenchReleasedCIResp.meta:type_name -> pbrci.ReleasedConfigItem
-	37, // 3: pbcs.ListEventsReq.page:type_name -> pbbase.BasePage
+	38, // 0: pbcs.ListAppsResp.details:type_name -> pbapp.App
+	37, // 1: pbcs.BenchAppMetaResp.meta:type_name -> pbcs.BenchAppMetaResp.MetaEntry
+	39, // 2: pbcs.BenchReleasedCIResp.meta:type_name -> pbrci.ReleasedConfigItem
+	40, // 3: pbcs.ListEventsReq.page:type_name -> pbbase.BasePage
 	19, // 4: pbcs.ListEventsResp.list:type_name -> pbcs.EventMeta
-	38, // 5: pbcs.EventMeta.spec:type_name -> pbevent.EventSpec
-	39, // 6: pbcs.EventMeta.attachment:type_name -> pbevent.EventAttachment
-	12, // 7: pbcs.BenchAppMetaResp.MetaEntry.value:type_name -> pbcs.AppMeta
-	1,  // 8: pbcs.Cache.GetAppID:input_type -> pbcs.GetAppIDReq
-	3,  // 9: pbcs.Cache.GetAppMeta:input_type -> pbcs.GetAppMetaReq
-	4,  // 10: pbcs.Cache.ListApps:input_type -> pbcs.ListAppsReq
-	6,  // 11: pbcs.Cache.GetReleasedCI:input_type -> pbcs.GetReleasedCIReq
-	7,  // 12: pbcs.Cache.GetReleasedHook:input_type -> pbcs.GetReleasedHookReq
-	8,  // 13: pbcs.Cache.ListAppReleasedGroups:input_type -> pbcs.ListAppReleasedGroupsReq
-	40, // 14: pbcs.Cache.GetCurrentCursorReminder:input_type -> pbbase.EmptyReq
-	17, // 15: pbcs.Cache.ListEventsMeta:input_type -> pbcs.ListEventsReq
-	21, // 16: pbcs.Cache.GetCredential:input_type -> pbcs.GetCredentialReq
-	10, // 17: pbcs.Cache.BenchAppMeta:input_type -> pbcs.BenchAppMetaReq
-	14, // 18: pbcs.Cache.BenchReleasedCI:input_type -> pbcs.BenchReleasedCIReq
-	22, // 19: pbcs.Cache.GetReleasedKv:input_type -> pbcs.GetReleasedKvReq
-	23, // 20: pbcs.Cache.GetReleasedKvValue:input_type -> pbcs.GetReleasedKvValueReq
-	24, // 21: pbcs.Cache.SetClientMetric:input_type -> pbcs.SetClientMetricReq
-	28, // 22: pbcs.Cache.SetAppLastConsumedTime:input_type -> pbcs.SetAppLastConsumedTimeReq
-	26, // 23: pbcs.Cache.SetPublishTime:input_type -> pbcs.SetPublishTimeReq
-	30, // 24: pbcs.Cache.GetTenantIDByBiz:input_type -> pbcs.GetTenantIDByBizReq
-	32, // 25: pbcs.Cache.GetAgentBiz:input_type -> pbcs.GetAgentBizReq
-	2,  // 26: pbcs.Cache.GetAppID:output_type -> pbcs.GetAppIDResp
-	0,  // 27: pbcs.Cache.GetAppMeta:output_type -> pbcs.JsonRawResp
-	5,  // 28: pbcs.Cache.ListApps:output_type -> pbcs.ListAppsResp
-	0,  // 29: pbcs.Cache.GetReleasedCI:output_type -> pbcs.JsonRawResp
-	0,  // 30: pbcs.Cache.GetReleasedHook:output_type -> pbcs.JsonRawResp
-	0,  // 31: pbcs.Cache.ListAppReleasedGroups:output_type -> pbcs.JsonRawResp
-	16, // 32: pbcs.Cache.GetCurrentCursorReminder:output_type -> pbcs.CurrentCursorReminderResp
-	18, // 33: pbcs.Cache.ListEventsMeta:output_type -> pbcs.ListEventsResp
-	0,  // 34: pbcs.Cache.GetCredential:output_type -> pbcs.JsonRawResp
-	11, // 35: pbcs.Cache.BenchAppMeta:output_type -> pbcs.BenchAppMetaResp
-	15, // 36: pbcs.Cache.BenchReleasedCI:output_type -> pbcs.BenchReleasedCIResp
-	0,  // 37: pbcs.Cache.GetReleasedKv:output_type -> pbcs.JsonRawResp
-	0,  // 38: pbcs.Cache.GetReleasedKvValue:output_type -> pbcs.JsonRawResp
-	25, // 39: pbcs.Cache.SetClientMetric:output_type -> pbcs.SetClientMetricResp
-	29, // 40: pbcs.Cache.SetAppLastConsumedTime:output_type -> pbcs.SetAppLastConsumedTimeResp
-	27, // 41: pbcs.Cache.SetPublishTime:output_type -> pbcs.SetPublishTimeResp
-	31, // 42: pbcs.Cache.GetTenantIDByBiz:output_type -> pbcs.GetTenantIDByBizResp
-	33, // 43: pbcs.Cache.GetAgentBiz:output_type -> pbcs.GetAgentBizResp
-	26, // [26:44] is the sub-list for method output_type
-	8,  // [8:26] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	41, // 5: pbcs.EventMeta.spec:type_name -> pbevent.EventSpec
+	42, // 6: pbcs.EventMeta.attachment:type_name -> pbevent.EventAttachment
+	30, // 7: pbcs.SetCredentialUsageReq.usages:type_name -> pbcs.CredentialUsage
+	12, // 8: pbcs.BenchAppMetaResp.MetaEntry.value:type_name -> pbcs.AppMeta
+	1,  // 9: pbcs.Cache.GetAppID:input_type -> pbcs.GetAppIDReq
+	3,  // 10: pbcs.Cache.GetAppMeta:input_type -> pbcs.GetAppMetaReq
+	4,  // 11: pbcs.Cache.ListApps:input_type -> pbcs.ListAppsReq
+	6,  // 12: pbcs.Cache.GetReleasedCI:input_type -> pbcs.GetReleasedCIReq
+	7,  // 13: pbcs.Cache.GetReleasedHook:input_type -> pbcs.GetReleasedHookReq
+	8,  // 14: pbcs.Cache.ListAppReleasedGroups:input_type -> pbcs.ListAppReleasedGroupsReq
+	43, // 15: pbcs.Cache.GetCurrentCursorReminder:input_type -> pbbase.EmptyReq
+	17, // 16: pbcs.Cache.ListEventsMeta:input_type -> pbcs.ListEventsReq
+	21, // 17: pbcs.Cache.GetCredential:input_type -> pbcs.GetCredentialReq
+	10, // 18: pbcs.Cache.BenchAppMeta:input_type -> pbcs.BenchAppMetaReq
+	14, // 19: pbcs.Cache.BenchReleasedCI:input_type -> pbcs.BenchReleasedCIReq
+	22, // 20: pbcs.Cache.GetReleasedKv:input_type -> pbcs.GetReleasedKvReq
+	23, // 21: pbcs.Cache.GetReleasedKvValue:input_type -> pbcs.GetReleasedKvValueReq
+	24, // 22: pbcs.Cache.SetClientMetric:input_type -> pbcs.SetClientMetricReq
+	28, // 23: pbcs.Cache.SetAppLastConsumedTime:input_type -> pbcs.SetAppLastConsumedTimeReq
+	31, // 24: pbcs.Cache.SetCredentialUsage:input_type -> pbcs.SetCredentialUsageReq
+	26, // 25: pbcs.Cache.SetPublishTime:input_type -> pbcs.SetPublishTimeReq
+	33, // 26: pbcs.Cache.GetTenantIDByBiz:input_type -> pbcs.GetTenantIDByBizReq
+	35, // 27: pbcs.Cache.GetAgentBiz:input_type -> pbcs.GetAgentBizReq
+	2,  // 28: pbcs.Cache.GetAppID:output_type -> pbcs.GetAppIDResp
+	0,  // 29: pbcs.Cache.GetAppMeta:output_type -> pbcs.JsonRawResp
+	5,  // 30: pbcs.Cache.ListApps:output_type -> pbcs.ListAppsResp
+	0,  // 31: pbcs.Cache.GetReleasedCI:output_type -> pbcs.JsonRawResp
+	0,  // 32: pbcs.Cache.GetReleasedHook:output_type -> pbcs.JsonRawResp
+	0,  // 33: pbcs.Cache.ListAppReleasedGroups:output_type -> pbcs.JsonRawResp
+	16, // 34: pbcs.Cache.GetCurrentCursorReminder:output_type -> pbcs.CurrentCursorReminderResp
+	18, // 35: pbcs.Cache.ListEventsMeta:output_type -> pbcs.ListEventsResp
+	0,  // 36: pbcs.Cache.GetCredential:output_type -> pbcs.JsonRawResp
+	11, // 37: pbcs.Cache.BenchAppMeta:output_type -> pbcs.BenchAppMetaResp
+	15, // 38: pbcs.Cache.BenchReleasedCI:output_type -> pbcs.BenchReleasedCIResp
+	0,  // 39: pbcs.Cache.GetReleasedKv:output_type -> pbcs.JsonRawResp
+	0,  // 40: pbcs.Cache.GetReleasedKvValue:output_type -> pbcs.JsonRawResp
+	25, // 41: pbcs.Cache.SetClientMetric:output_type -> pbcs.SetClientMetricResp
+	29, // 42: pbcs.Cache.SetAppLastConsumedTime:output_type -> pbcs.SetAppLastConsumedTimeResp
+	32, // 43: pbcs.Cache.SetCredentialUsage:output_type -> pbcs.SetCredentialUsageResp
+	27, // 44: pbcs.Cache.SetPublishTime:output_type -> pbcs.SetPublishTimeResp
+	34, // 45: pbcs.Cache.GetTenantIDByBiz:output_type -> pbcs.GetTenantIDByBizResp
+	36, // 46: pbcs.Cache.GetAgentBiz:output_type -> pbcs.GetAgentBizResp
+	28, // [28:47] is the sub-list for method output_type
+	9,  // [9:28] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_cache_service_proto_init() }
@@ -2206,7 +2383,7 @@ func file_cache_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cache_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   35,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,7 @@ const (
 	Cache_GetReleasedKvValue_FullMethodName       = "/pbcs.Cache/GetReleasedKvValue"
 	Cache_SetClientMetric_FullMethodName          = "/pbcs.Cache/SetClientMetric"
 	Cache_SetAppLastConsumedTime_FullMethodName   = "/pbcs.Cache/SetAppLastConsumedTime"
+	Cache_SetCredentialUsage_FullMethodName       = "/pbcs.Cache/SetCredentialUsage"
 	Cache_SetPublishTime_FullMethodName           = "/pbcs.Cache/SetPublishTime"
 	Cache_GetTenantIDByBiz_FullMethodName         = "/pbcs.Cache/GetTenantIDByBiz"
 	Cache_GetAgentBiz_FullMethodName              = "/pbcs.Cache/GetAgentBiz"
@@ -60,6 +61,7 @@ type CacheClient interface {
 	GetReleasedKvValue(ctx context.Context, in *GetReleasedKvValueReq, opts ...grpc.CallOption) (*JsonRawResp, error)
 	SetClientMetric(ctx context.Context, in *SetClientMetricReq, opts ...grpc.CallOption) (*SetClientMetricResp, error)
 	SetAppLastConsumedTime(ctx context.Context, in *SetAppLastConsumedTimeReq, opts ...grpc.CallOption) (*SetAppLastConsumedTimeResp, error)
+	SetCredentialUsage(ctx context.Context, in *SetCredentialUsageReq, opts ...grpc.CallOption) (*SetCredentialUsageResp, error)
 	SetPublishTime(ctx context.Context, in *SetPublishTimeReq, opts ...grpc.CallOption) (*SetPublishTimeResp, error)
 	GetTenantIDByBiz(ctx context.Context, in *GetTenantIDByBizReq, opts ...grpc.CallOption) (*GetTenantIDByBizResp, error)
 	GetAgentBiz(ctx context.Context, in *GetAgentBizReq, opts ...grpc.CallOption) (*GetAgentBizResp, error)
@@ -208,6 +210,15 @@ func (c *cacheClient) SetAppLastConsumedTime(ctx context.Context, in *SetAppLast
 	return out, nil
 }
 
+func (c *cacheClient) SetCredentialUsage(ctx context.Context, in *SetCredentialUsageReq, opts ...grpc.CallOption) (*SetCredentialUsageResp, error) {
+	out := new(SetCredentialUsageResp)
+	err := c.cc.Invoke(ctx, Cache_SetCredentialUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cacheClient) SetPublishTime(ctx context.Context, in *SetPublishTimeReq, opts ...grpc.CallOption) (*SetPublishTimeResp, error) {
 	out := new(SetPublishTimeResp)
 	err := c.cc.Invoke(ctx, Cache_SetPublishTime_FullMethodName, in, out, opts...)
@@ -255,6 +266,7 @@ type CacheServer interface {
 	GetReleasedKvValue(context.Context, *GetReleasedKvValueReq) (*JsonRawResp, error)
 	SetClientMetric(context.Context, *SetClientMetricReq) (*SetClientMetricResp, error)
 	SetAppLastConsumedTime(context.Context, *SetAppLastConsumedTimeReq) (*SetAppLastConsumedTimeResp, error)
+	SetCredentialUsage(context.Context, *SetCredentialUsageReq) (*SetCredentialUsageResp, error)
 	SetPublishTime(context.Context, *SetPublishTimeReq) (*SetPublishTimeResp, error)
 	GetTenantIDByBiz(context.Context, *GetTenantIDByBizReq) (*GetTenantIDByBizResp, error)
 	GetAgentBiz(context.Context, *GetAgentBizReq) (*GetAgentBizResp, error)
@@ -309,6 +321,9 @@ func (UnimplementedCacheServer) SetClientMetric(context.Context, *SetClientMetri
 func (UnimplementedCacheServer) SetAppLastConsumedTime(context.Context, *SetAppLastConsumedTimeReq) (*SetAppLastConsumedTimeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAppLastConsumedTime not implemented")
 }
+func (UnimplementedCacheServer) SetCredentialUsage(context.Context, *SetCredentialUsageReq) (*SetCredentialUsageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCredentialUsage not implemented")
+}
 func (UnimplementedCacheServer) SetPublishTime(context.Context, *SetPublishTimeReq) (*SetPublishTimeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPublishTime not implemented")
 }
@@ -600,6 +615,24 @@ func _Cache_SetAppLastConsumedTime_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cache_SetCredentialUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCredentialUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).SetCredentialUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_SetCredentialUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).SetCredentialUsage(ctx, req.(*SetCredentialUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cache_SetPublishTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetPublishTimeReq)
 	if err := dec(in); err != nil {
@@ -721,6 +754,10 @@ var Cache_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAppLastConsumedTime",
 			Handler:    _Cache_SetAppLastConsumedTime_Handler,
 		},
+		{
+			MethodName: "SetCredentialUsage",
+			Handler:    _Cache_SetCredentialUsage_Handler,
+		},
 		{
 			MethodName: "SetPublishTime",
 			Handler:    _Cache_SetPublishTime_Handler,
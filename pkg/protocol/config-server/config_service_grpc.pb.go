@@ -72,6 +72,7 @@ const (
 	Config_ListHookRevisions_FullMethodName                  = "/pbcs.Config/ListHookRevisions"
 	Config_DeleteHookRevision_FullMethodName                 = "/pbcs.Config/DeleteHookRevision"
 	Config_PublishHookRevision_FullMethodName                = "/pbcs.Config/PublishHookRevision"
+	Config_TestHookRevision_FullMethodName                   = "/pbcs.Config/TestHookRevision"
 	Config_GetHookRevision_FullMethodName                    = "/pbcs.Config/GetHookRevision"
 	Config_UpdateHookRevision_FullMethodName                 = "/pbcs.Config/UpdateHookRevision"
 	Config_ListHookReferences_FullMethodName                 = "/pbcs.Config/ListHookReferences"
@@ -81,6 +82,9 @@ const (
 	Config_DeleteTemplateSpace_FullMethodName                = "/pbcs.Config/DeleteTemplateSpace"
 	Config_UpdateTemplateSpace_FullMethodName                = "/pbcs.Config/UpdateTemplateSpace"
 	Config_ListTemplateSpaces_FullMethodName                 = "/pbcs.Config/ListTemplateSpaces"
+	Config_ImportTemplateSpaceHooks_FullMethodName           = "/pbcs.Config/ImportTemplateSpaceHooks"
+	Config_ListTemplateSpaceHooks_FullMethodName             = "/pbcs.Config/ListTemplateSpaceHooks"
+	Config_DeleteTemplateSpaceHook_FullMethodName            = "/pbcs.Config/DeleteTemplateSpaceHook"
 	Config_GetAllBizsOfTmplSpaces_FullMethodName             = "/pbcs.Config/GetAllBizsOfTmplSpaces"
 	Config_CreateDefaultTmplSpace_FullMethodName             = "/pbcs.Config/CreateDefaultTmplSpace"
 	Config_ListTmplSpacesByIDs_FullMethodName                = "/pbcs.Config/ListTmplSpacesByIDs"
@@ -151,6 +155,9 @@ const (
 	Config_UpdateAppTmplVariables_FullMethodName             = "/pbcs.Config/UpdateAppTmplVariables"
 	Config_ListAppTmplVariables_FullMethodName               = "/pbcs.Config/ListAppTmplVariables"
 	Config_ListReleasedAppTmplVariables_FullMethodName       = "/pbcs.Config/ListReleasedAppTmplVariables"
+	Config_UpdateGroupTmplVariables_FullMethodName           = "/pbcs.Config/UpdateGroupTmplVariables"
+	Config_ListGroupTmplVariables_FullMethodName             = "/pbcs.Config/ListGroupTmplVariables"
+	Config_TraceAppTmplVariables_FullMethodName              = "/pbcs.Config/TraceAppTmplVariables"
 	Config_CreateGroup_FullMethodName                        = "/pbcs.Config/CreateGroup"
 	Config_DeleteGroup_FullMethodName                        = "/pbcs.Config/DeleteGroup"
 	Config_BatchDeleteGroups_FullMethodName                  = "/pbcs.Config/BatchDeleteGroups"
@@ -163,6 +170,11 @@ const (
 	Config_Publish_FullMethodName                            = "/pbcs.Config/Publish"
 	Config_GenerateReleaseAndPublish_FullMethodName          = "/pbcs.Config/GenerateReleaseAndPublish"
 	Config_SubmitPublishApprove_FullMethodName               = "/pbcs.Config/SubmitPublishApprove"
+	Config_RollbackRelease_FullMethodName                    = "/pbcs.Config/RollbackRelease"
+	Config_PinGroupRelease_FullMethodName                    = "/pbcs.Config/PinGroupRelease"
+	Config_UnpinGroupRelease_FullMethodName                  = "/pbcs.Config/UnpinGroupRelease"
+	Config_UpdateReleaseTags_FullMethodName                  = "/pbcs.Config/UpdateReleaseTags"
+	Config_PromoteRelease_FullMethodName                     = "/pbcs.Config/PromoteRelease"
 	Config_Approve_FullMethodName                            = "/pbcs.Config/Approve"
 	Config_ApprovalCallback_FullMethodName                   = "/pbcs.Config/ApprovalCallback"
 	Config_GetLastSelect_FullMethodName                      = "/pbcs.Config/GetLastSelect"
@@ -173,6 +185,7 @@ const (
 	Config_ListCredentials_FullMethodName                    = "/pbcs.Config/ListCredentials"
 	Config_DeleteCredential_FullMethodName                   = "/pbcs.Config/DeleteCredential"
 	Config_UpdateCredential_FullMethodName                   = "/pbcs.Config/UpdateCredential"
+	Config_RotateCredential_FullMethodName                   = "/pbcs.Config/RotateCredential"
 	Config_CheckCredentialName_FullMethodName                = "/pbcs.Config/CheckCredentialName"
 	Config_ListCredentialScopes_FullMethodName               = "/pbcs.Config/ListCredentialScopes"
 	Config_UpdateCredentialScope_FullMethodName              = "/pbcs.Config/UpdateCredentialScope"
@@ -204,6 +217,38 @@ const (
 	Config_ClientVersionStatistics_FullMethodName            = "/pbcs.Config/ClientVersionStatistics"
 	Config_ListClientLabelAndAnnotation_FullMethodName       = "/pbcs.Config/ListClientLabelAndAnnotation"
 	Config_ClientSpecificFailedReason_FullMethodName         = "/pbcs.Config/ClientSpecificFailedReason"
+	Config_ClientStageStatistics_FullMethodName              = "/pbcs.Config/ClientStageStatistics"
+	Config_CreateClientAlertRule_FullMethodName              = "/pbcs.Config/CreateClientAlertRule"
+	Config_UpdateClientAlertRule_FullMethodName              = "/pbcs.Config/UpdateClientAlertRule"
+	Config_DeleteClientAlertRule_FullMethodName              = "/pbcs.Config/DeleteClientAlertRule"
+	Config_ListClientAlertRules_FullMethodName               = "/pbcs.Config/ListClientAlertRules"
+	Config_SilenceClientAlertRule_FullMethodName             = "/pbcs.Config/SilenceClientAlertRule"
+	Config_AckClientAlert_FullMethodName                     = "/pbcs.Config/AckClientAlert"
+	Config_ListClientAlertHistories_FullMethodName           = "/pbcs.Config/ListClientAlertHistories"
+	Config_ListClientMetricTrends_FullMethodName             = "/pbcs.Config/ListClientMetricTrends"
+	Config_CreateGitRepoLink_FullMethodName                  = "/pbcs.Config/CreateGitRepoLink"
+	Config_UpdateGitRepoLink_FullMethodName                  = "/pbcs.Config/UpdateGitRepoLink"
+	Config_DeleteGitRepoLink_FullMethodName                  = "/pbcs.Config/DeleteGitRepoLink"
+	Config_ListGitRepoLinks_FullMethodName                   = "/pbcs.Config/ListGitRepoLinks"
+	Config_SyncGitRepoLink_FullMethodName                    = "/pbcs.Config/SyncGitRepoLink"
+	Config_CreateChangeFreeze_FullMethodName                 = "/pbcs.Config/CreateChangeFreeze"
+	Config_UpdateChangeFreeze_FullMethodName                 = "/pbcs.Config/UpdateChangeFreeze"
+	Config_DeleteChangeFreeze_FullMethodName                 = "/pbcs.Config/DeleteChangeFreeze"
+	Config_ListChangeFreezes_FullMethodName                  = "/pbcs.Config/ListChangeFreezes"
+	Config_GetChangeFreezeStatus_FullMethodName              = "/pbcs.Config/GetChangeFreezeStatus"
+	Config_CreateWebhook_FullMethodName                      = "/pbcs.Config/CreateWebhook"
+	Config_UpdateWebhook_FullMethodName                      = "/pbcs.Config/UpdateWebhook"
+	Config_DeleteWebhook_FullMethodName                      = "/pbcs.Config/DeleteWebhook"
+	Config_ListWebhooks_FullMethodName                       = "/pbcs.Config/ListWebhooks"
+	Config_ListWebhookDeliveries_FullMethodName              = "/pbcs.Config/ListWebhookDeliveries"
+	Config_RedeliverWebhookDelivery_FullMethodName           = "/pbcs.Config/RedeliverWebhookDelivery"
+	Config_SetApprovalFlow_FullMethodName                    = "/pbcs.Config/SetApprovalFlow"
+	Config_GetApprovalFlow_FullMethodName                    = "/pbcs.Config/GetApprovalFlow"
+	Config_GetApprovalTicket_FullMethodName                  = "/pbcs.Config/GetApprovalTicket"
+	Config_DelegateApproval_FullMethodName                   = "/pbcs.Config/DelegateApproval"
+	Config_CommentApproval_FullMethodName                    = "/pbcs.Config/CommentApproval"
+	Config_ExportAppBundle_FullMethodName                    = "/pbcs.Config/ExportAppBundle"
+	Config_ImportAppBundle_FullMethodName                    = "/pbcs.Config/ImportAppBundle"
 	Config_CompareConfigItemConflicts_FullMethodName         = "/pbcs.Config/CompareConfigItemConflicts"
 	Config_CompareKvConflicts_FullMethodName                 = "/pbcs.Config/CompareKvConflicts"
 	Config_GetTemplateAndNonTemplateCICount_FullMethodName   = "/pbcs.Config/GetTemplateAndNonTemplateCICount"
@@ -229,6 +274,7 @@ const (
 	Config_GetConfigView_FullMethodName                      = "/pbcs.Config/GetConfigView"
 	Config_OperateGenerateConfig_FullMethodName              = "/pbcs.Config/OperateGenerateConfig"
 	Config_PushConfig_FullMethodName                         = "/pbcs.Config/PushConfig"
+	Config_RepushDriftedConfig_FullMethodName                = "/pbcs.Config/RepushDriftedConfig"
 	Config_GetConfigRenderResult_FullMethodName              = "/pbcs.Config/GetConfigRenderResult"
 	Config_ListConfigTemplate_FullMethodName                 = "/pbcs.Config/ListConfigTemplate"
 	Config_CreateConfigTemplate_FullMethodName               = "/pbcs.Config/CreateConfigTemplate"
@@ -339,6 +385,8 @@ type ConfigClient interface {
 	DeleteHookRevision(ctx context.Context, in *DeleteHookRevisionReq, opts ...grpc.CallOption) (*DeleteHookRevisionResp, error)
 	// 上线脚本版本
 	PublishHookRevision(ctx context.Context, in *PublishHookRevisionReq, opts ...grpc.CallOption) (*PublishHookRevisionResp, error)
+	// 在沙箱中试运行脚本版本
+	TestHookRevision(ctx context.Context, in *TestHookRevisionReq, opts ...grpc.CallOption) (*TestHookRevisionResp, error)
 	// 获取脚本版本
 	GetHookRevision(ctx context.Context, in *GetHookRevisionReq, opts ...grpc.CallOption) (*hook_revision.HookRevision, error)
 	// 更新脚本版本
@@ -357,6 +405,12 @@ type ConfigClient interface {
 	UpdateTemplateSpace(ctx context.Context, in *UpdateTemplateSpaceReq, opts ...grpc.CallOption) (*UpdateTemplateSpaceResp, error)
 	// 获取模板空间列表
 	ListTemplateSpaces(ctx context.Context, in *ListTemplateSpacesReq, opts ...grpc.CallOption) (*ListTemplateSpacesResp, error)
+	// 导入脚本到模板空间脚本库
+	ImportTemplateSpaceHooks(ctx context.Context, in *ImportTemplateSpaceHooksReq, opts ...grpc.CallOption) (*ImportTemplateSpaceHooksResp, error)
+	// 获取模板空间脚本库列表
+	ListTemplateSpaceHooks(ctx context.Context, in *ListTemplateSpaceHooksReq, opts ...grpc.CallOption) (*ListTemplateSpaceHooksResp, error)
+	// 从模板空间脚本库移除脚本
+	DeleteTemplateSpaceHook(ctx context.Context, in *DeleteTemplateSpaceHookReq, opts ...grpc.CallOption) (*DeleteTemplateSpaceHookResp, error)
 	// GetAllBizsOfTmplSpaces called only by system itself
 	GetAllBizsOfTmplSpaces(ctx context.Context, in *base.EmptyReq, opts ...grpc.CallOption) (*GetAllBizsOfTmplSpacesResp, error)
 	// 创建模板默认空间(仅由系统本身调用)
@@ -495,6 +549,12 @@ type ConfigClient interface {
 	ListAppTmplVariables(ctx context.Context, in *ListAppTmplVariablesReq, opts ...grpc.CallOption) (*ListAppTmplVariablesResp, error)
 	// 获取服务版本的模板变量列表
 	ListReleasedAppTmplVariables(ctx context.Context, in *ListReleasedAppTmplVariablesReq, opts ...grpc.CallOption) (*ListReleasedAppTmplVariablesResp, error)
+	// 更新分组覆盖的服务模板变量
+	UpdateGroupTmplVariables(ctx context.Context, in *UpdateGroupTmplVariablesReq, opts ...grpc.CallOption) (*UpdateGroupTmplVariablesResp, error)
+	// 获取分组覆盖的服务模板变量
+	ListGroupTmplVariables(ctx context.Context, in *ListGroupTmplVariablesReq, opts ...grpc.CallOption) (*ListGroupTmplVariablesResp, error)
+	// 追踪服务模板变量在业务、服务、分组各层的取值及最终来源
+	TraceAppTmplVariables(ctx context.Context, in *TraceAppTmplVariablesReq, opts ...grpc.CallOption) (*TraceAppTmplVariablesResp, error)
 	// 创建分组
 	CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupResp, error)
 	// 删除分组
@@ -519,6 +579,16 @@ type ConfigClient interface {
 	GenerateReleaseAndPublish(ctx context.Context, in *GenerateReleaseAndPublishReq, opts ...grpc.CallOption) (*PublishResp, error)
 	// 上线服务版本
 	SubmitPublishApprove(ctx context.Context, in *SubmitPublishApproveReq, opts ...grpc.CallOption) (*PublishResp, error)
+	// 回滚服务版本，处于该版本的分组恢复为之前上线的版本
+	RollbackRelease(ctx context.Context, in *RollbackReleaseReq, opts ...grpc.CallOption) (*RollbackReleaseResp, error)
+	// 固定分组的服务版本，固定期间分组内的客户端只会拉取该版本
+	PinGroupRelease(ctx context.Context, in *PinGroupReleaseReq, opts ...grpc.CallOption) (*PinGroupReleaseResp, error)
+	// 解除分组的服务版本固定
+	UnpinGroupRelease(ctx context.Context, in *UnpinGroupReleaseReq, opts ...grpc.CallOption) (*UnpinGroupReleaseResp, error)
+	// 修改服务版本的标签
+	UpdateReleaseTags(ctx context.Context, in *UpdateReleaseTagsReq, opts ...grpc.CallOption) (*UpdateReleaseTagsResp, error)
+	// 将服务版本晋级到同业务下的其他服务，如从测试服务晋级到正式服务，dry_run 时只返回变更预览
+	PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error)
 	// 审批同步，其中v2版本中itsm也是复用这个接口进行回调
 	Approve(ctx context.Context, in *ApproveReq, opts ...grpc.CallOption) (*ApproveResp, error)
 	// itsm v4 回调接口
@@ -535,6 +605,8 @@ type ConfigClient interface {
 	DeleteCredential(ctx context.Context, in *DeleteCredentialsReq, opts ...grpc.CallOption) (*DeleteCredentialsResp, error)
 	// 更新客户端密钥
 	UpdateCredential(ctx context.Context, in *UpdateCredentialsReq, opts ...grpc.CallOption) (*UpdateCredentialsResp, error)
+	// 轮换客户端密钥
+	RotateCredential(ctx context.Context, in *RotateCredentialReq, opts ...grpc.CallOption) (*RotateCredentialResp, error)
 	// 检测客户端密钥名称
 	CheckCredentialName(ctx context.Context, in *CheckCredentialNameReq, opts ...grpc.CallOption) (*CheckCredentialNameResp, error)
 	// 获取客户端密钥关联服务的列表
@@ -597,6 +669,70 @@ type ConfigClient interface {
 	ListClientLabelAndAnnotation(ctx context.Context, in *ListClientLabelAndAnnotationReq, opts ...grpc.CallOption) (*structpb.Struct, error)
 	// 获取客户端失败原因
 	ClientSpecificFailedReason(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error)
+	// 客户端版本变更阶段耗时统计
+	ClientStageStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error)
+	// 创建客户端告警规则
+	CreateClientAlertRule(ctx context.Context, in *CreateClientAlertRuleReq, opts ...grpc.CallOption) (*CreateClientAlertRuleResp, error)
+	// 更新客户端告警规则
+	UpdateClientAlertRule(ctx context.Context, in *UpdateClientAlertRuleReq, opts ...grpc.CallOption) (*UpdateClientAlertRuleResp, error)
+	// 删除客户端告警规则
+	DeleteClientAlertRule(ctx context.Context, in *DeleteClientAlertRuleReq, opts ...grpc.CallOption) (*DeleteClientAlertRuleResp, error)
+	// 获取客户端告警规则列表
+	ListClientAlertRules(ctx context.Context, in *ListClientAlertRulesReq, opts ...grpc.CallOption) (*ListClientAlertRulesResp, error)
+	// 静默客户端告警规则
+	SilenceClientAlertRule(ctx context.Context, in *SilenceClientAlertRuleReq, opts ...grpc.CallOption) (*SilenceClientAlertRuleResp, error)
+	// 确认客户端告警
+	AckClientAlert(ctx context.Context, in *AckClientAlertReq, opts ...grpc.CallOption) (*AckClientAlertResp, error)
+	// 获取客户端告警历史
+	ListClientAlertHistories(ctx context.Context, in *ListClientAlertHistoriesReq, opts ...grpc.CallOption) (*ListClientAlertHistoriesResp, error)
+	// 获取客户端资源和拉取指标的历史趋势
+	ListClientMetricTrends(ctx context.Context, in *ListClientMetricTrendsReq, opts ...grpc.CallOption) (*ListClientMetricTrendsResp, error)
+	// 创建 git 仓库关联
+	CreateGitRepoLink(ctx context.Context, in *CreateGitRepoLinkReq, opts ...grpc.CallOption) (*CreateGitRepoLinkResp, error)
+	// 更新 git 仓库关联
+	UpdateGitRepoLink(ctx context.Context, in *UpdateGitRepoLinkReq, opts ...grpc.CallOption) (*UpdateGitRepoLinkResp, error)
+	// 删除 git 仓库关联
+	DeleteGitRepoLink(ctx context.Context, in *DeleteGitRepoLinkReq, opts ...grpc.CallOption) (*DeleteGitRepoLinkResp, error)
+	// 获取服务或模板空间的 git 仓库关联列表
+	ListGitRepoLinks(ctx context.Context, in *ListGitRepoLinksReq, opts ...grpc.CallOption) (*ListGitRepoLinksResp, error)
+	// 同步 git 仓库关联，携带 webhook_token 时由 webhook 触发，不校验用户权限
+	SyncGitRepoLink(ctx context.Context, in *SyncGitRepoLinkReq, opts ...grpc.CallOption) (*SyncGitRepoLinkResp, error)
+	// 创建变更冻结窗口
+	CreateChangeFreeze(ctx context.Context, in *CreateChangeFreezeReq, opts ...grpc.CallOption) (*CreateChangeFreezeResp, error)
+	// 更新变更冻结窗口
+	UpdateChangeFreeze(ctx context.Context, in *UpdateChangeFreezeReq, opts ...grpc.CallOption) (*UpdateChangeFreezeResp, error)
+	// 删除变更冻结窗口
+	DeleteChangeFreeze(ctx context.Context, in *DeleteChangeFreezeReq, opts ...grpc.CallOption) (*DeleteChangeFreezeResp, error)
+	// 获取业务或服务的变更冻结窗口列表
+	ListChangeFreezes(ctx context.Context, in *ListChangeFreezesReq, opts ...grpc.CallOption) (*ListChangeFreezesResp, error)
+	// 获取当前生效的变更冻结窗口，以及当前用户是否可以强制变更
+	GetChangeFreezeStatus(ctx context.Context, in *GetChangeFreezeStatusReq, opts ...grpc.CallOption) (*GetChangeFreezeStatusResp, error)
+	// 创建事件订阅
+	CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateWebhookResp, error)
+	// 更新事件订阅
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookReq, opts ...grpc.CallOption) (*UpdateWebhookResp, error)
+	// 删除事件订阅及其投递记录
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*DeleteWebhookResp, error)
+	// 获取业务或服务的事件订阅列表
+	ListWebhooks(ctx context.Context, in *ListWebhooksReq, opts ...grpc.CallOption) (*ListWebhooksResp, error)
+	// 获取事件订阅的投递记录
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (*ListWebhookDeliveriesResp, error)
+	// 重新投递事件
+	RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryReq, opts ...grpc.CallOption) (*RedeliverWebhookDeliveryResp, error)
+	// 设置服务的内置审批流程
+	SetApprovalFlow(ctx context.Context, in *SetApprovalFlowReq, opts ...grpc.CallOption) (*SetApprovalFlowResp, error)
+	// 获取服务的内置审批流程
+	GetApprovalFlow(ctx context.Context, in *GetApprovalFlowReq, opts ...grpc.CallOption) (*GetApprovalFlowResp, error)
+	// 获取版本上线的内置审批单及审批记录
+	GetApprovalTicket(ctx context.Context, in *GetApprovalTicketReq, opts ...grpc.CallOption) (*GetApprovalTicketResp, error)
+	// 将当前阶段的审批转给其他人
+	DelegateApproval(ctx context.Context, in *DelegateApprovalReq, opts ...grpc.CallOption) (*DelegateApprovalResp, error)
+	// 评论内置审批单
+	CommentApproval(ctx context.Context, in *CommentApprovalReq, opts ...grpc.CallOption) (*CommentApprovalResp, error)
+	// 导出服务包清单
+	ExportAppBundle(ctx context.Context, in *ExportAppBundleReq, opts ...grpc.CallOption) (*ExportAppBundleResp, error)
+	// 导入服务包清单
+	ImportAppBundle(ctx context.Context, in *ImportAppBundleReq, opts ...grpc.CallOption) (*ImportAppBundleResp, error)
 	// 文件服务以及和其他服务版本之间对比
 	CompareConfigItemConflicts(ctx context.Context, in *CompareConfigItemConflictsReq, opts ...grpc.CallOption) (*CompareConfigItemConflictsResp, error)
 	// 键值服务以及和其他服务版本之间对比
@@ -648,6 +784,8 @@ type ConfigClient interface {
 	OperateGenerateConfig(ctx context.Context, in *OperateGenerateConfigReq, opts ...grpc.CallOption) (*OperateGenerateConfigResp, error)
 	// 配置下发
 	PushConfig(ctx context.Context, in *PushConfigReq, opts ...grpc.CallOption) (*PushConfigResp, error)
+	// 漂移配置重新下发
+	RepushDriftedConfig(ctx context.Context, in *RepushDriftedConfigReq, opts ...grpc.CallOption) (*RepushDriftedConfigResp, error)
 	// 查看配置生成结果
 	GetConfigRenderResult(ctx context.Context, in *GetConfigRenderResultReq, opts ...grpc.CallOption) (*GetConfigRenderResultResp, error)
 	// 配置模板列表
@@ -1091,6 +1229,15 @@ func (c *configClient) PublishHookRevision(ctx context.Context, in *PublishHookR
 	return out, nil
 }
 
+func (c *configClient) TestHookRevision(ctx context.Context, in *TestHookRevisionReq, opts ...grpc.CallOption) (*TestHookRevisionResp, error) {
+	out := new(TestHookRevisionResp)
+	err := c.cc.Invoke(ctx, Config_TestHookRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configClient) GetHookRevision(ctx context.Context, in *GetHookRevisionReq, opts ...grpc.CallOption) (*hook_revision.HookRevision, error) {
 	out := new(hook_revision.HookRevision)
 	err := c.cc.Invoke(ctx, Config_GetHookRevision_FullMethodName, in, out, opts...)
@@ -1172,6 +1319,33 @@ func (c *configClient) ListTemplateSpaces(ctx context.Context, in *ListTemplateS
 	return out, nil
 }
 
+func (c *configClient) ImportTemplateSpaceHooks(ctx context.Context, in *ImportTemplateSpaceHooksReq, opts ...grpc.CallOption) (*ImportTemplateSpaceHooksResp, error) {
+	out := new(ImportTemplateSpaceHooksResp)
+	err := c.cc.Invoke(ctx, Config_ImportTemplateSpaceHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTemplateSpaceHooks(ctx context.Context, in *ListTemplateSpaceHooksReq, opts ...grpc.CallOption) (*ListTemplateSpaceHooksResp, error) {
+	out := new(ListTemplateSpaceHooksResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplateSpaceHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteTemplateSpaceHook(ctx context.Context, in *DeleteTemplateSpaceHookReq, opts ...grpc.CallOption) (*DeleteTemplateSpaceHookResp, error) {
+	out := new(DeleteTemplateSpaceHookResp)
+	err := c.cc.Invoke(ctx, Config_DeleteTemplateSpaceHook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configClient) GetAllBizsOfTmplSpaces(ctx context.Context, in *base.EmptyReq, opts ...grpc.CallOption) (*GetAllBizsOfTmplSpacesResp, error) {
 	out := new(GetAllBizsOfTmplSpacesResp)
 	err := c.cc.Invoke(ctx, Config_GetAllBizsOfTmplSpaces_FullMethodName, in, out, opts...)
@@ -1802,6 +1976,33 @@ func (c *configClient) ListReleasedAppTmplVariables(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *configClient) UpdateGroupTmplVariables(ctx context.Context, in *UpdateGroupTmplVariablesReq, opts ...grpc.CallOption) (*UpdateGroupTmplVariablesResp, error) {
+	out := new(UpdateGroupTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Config_UpdateGroupTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListGroupTmplVariables(ctx context.Context, in *ListGroupTmplVariablesReq, opts ...grpc.CallOption) (*ListGroupTmplVariablesResp, error) {
+	out := new(ListGroupTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Config_ListGroupTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) TraceAppTmplVariables(ctx context.Context, in *TraceAppTmplVariablesReq, opts ...grpc.CallOption) (*TraceAppTmplVariablesResp, error) {
+	out := new(TraceAppTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Config_TraceAppTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configClient) CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupResp, error) {
 	out := new(CreateGroupResp)
 	err := c.cc.Invoke(ctx, Config_CreateGroup_FullMethodName, in, out, opts...)
@@ -1910,6 +2111,51 @@ func (c *configClient) SubmitPublishApprove(ctx context.Context, in *SubmitPubli
 	return out, nil
 }
 
+func (c *configClient) RollbackRelease(ctx context.Context, in *RollbackReleaseReq, opts ...grpc.CallOption) (*RollbackReleaseResp, error) {
+	out := new(RollbackReleaseResp)
+	err := c.cc.Invoke(ctx, Config_RollbackRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PinGroupRelease(ctx context.Context, in *PinGroupReleaseReq, opts ...grpc.CallOption) (*PinGroupReleaseResp, error) {
+	out := new(PinGroupReleaseResp)
+	err := c.cc.Invoke(ctx, Config_PinGroupRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UnpinGroupRelease(ctx context.Context, in *UnpinGroupReleaseReq, opts ...grpc.CallOption) (*UnpinGroupReleaseResp, error) {
+	out := new(UnpinGroupReleaseResp)
+	err := c.cc.Invoke(ctx, Config_UnpinGroupRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateReleaseTags(ctx context.Context, in *UpdateReleaseTagsReq, opts ...grpc.CallOption) (*UpdateReleaseTagsResp, error) {
+	out := new(UpdateReleaseTagsResp)
+	err := c.cc.Invoke(ctx, Config_UpdateReleaseTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error) {
+	out := new(PromoteReleaseResp)
+	err := c.cc.Invoke(ctx, Config_PromoteRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configClient) Approve(ctx context.Context, in *ApproveReq, opts ...grpc.CallOption) (*ApproveResp, error) {
 	out := new(ApproveResp)
 	err := c.cc.Invoke(ctx, Config_Approve_FullMethodName, in, out, opts...)
@@ -2000,6 +2246,15 @@ func (c *configClient) UpdateCredential(ctx context.Context, in *UpdateCredentia
 	return out, nil
 }
 
+func (c *configClient) RotateCredential(ctx context.Context, in *RotateCredentialReq, opts ...grpc.CallOption) (*RotateCredentialResp, error) {
+	out := new(RotateCredentialResp)
+	err := c.cc.Invoke(ctx, Config_RotateCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configClient) CheckCredentialName(ctx context.Context, in *CheckCredentialNameReq, opts ...grpc.CallOption) (*CheckCredentialNameResp, error) {
 	out := new(CheckCredentialNameResp)
 	err := c.cc.Invoke(ctx, Config_CheckCredentialName_FullMethodName, in, out, opts...)
@@ -2279,396 +2534,693 @@ func (c *configClient) ClientSpecificFailedReason(ctx context.Context, in *clien
 	return out, nil
 }
 
-func (c *configClient) CompareConfigItemConflicts(ctx context.Context, in *CompareConfigItemConflictsReq, opts ...grpc.CallOption) (*CompareConfigItemConflictsResp, error) {
-	out := new(CompareConfigItemConflictsResp)
-	err := c.cc.Invoke(ctx, Config_CompareConfigItemConflicts_FullMethodName, in, out, opts...)
+func (c *configClient) ClientStageStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Config_ClientStageStatistics_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CompareKvConflicts(ctx context.Context, in *CompareKvConflictsReq, opts ...grpc.CallOption) (*CompareKvConflictsResp, error) {
-	out := new(CompareKvConflictsResp)
-	err := c.cc.Invoke(ctx, Config_CompareKvConflicts_FullMethodName, in, out, opts...)
+func (c *configClient) CreateClientAlertRule(ctx context.Context, in *CreateClientAlertRuleReq, opts ...grpc.CallOption) (*CreateClientAlertRuleResp, error) {
+	out := new(CreateClientAlertRuleResp)
+	err := c.cc.Invoke(ctx, Config_CreateClientAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetTemplateAndNonTemplateCICount(ctx context.Context, in *GetTemplateAndNonTemplateCICountReq, opts ...grpc.CallOption) (*GetTemplateAndNonTemplateCICountResp, error) {
-	out := new(GetTemplateAndNonTemplateCICountResp)
-	err := c.cc.Invoke(ctx, Config_GetTemplateAndNonTemplateCICount_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateClientAlertRule(ctx context.Context, in *UpdateClientAlertRuleReq, opts ...grpc.CallOption) (*UpdateClientAlertRuleResp, error) {
+	out := new(UpdateClientAlertRuleResp)
+	err := c.cc.Invoke(ctx, Config_UpdateClientAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListProcess(ctx context.Context, in *ListProcessReq, opts ...grpc.CallOption) (*ListProcessResp, error) {
-	out := new(ListProcessResp)
-	err := c.cc.Invoke(ctx, Config_ListProcess_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteClientAlertRule(ctx context.Context, in *DeleteClientAlertRuleReq, opts ...grpc.CallOption) (*DeleteClientAlertRuleResp, error) {
+	out := new(DeleteClientAlertRuleResp)
+	err := c.cc.Invoke(ctx, Config_DeleteClientAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListProcessInnerIPs(ctx context.Context, in *ListProcessInnerIPsReq, opts ...grpc.CallOption) (*ListProcessInnerIPsResp, error) {
-	out := new(ListProcessInnerIPsResp)
-	err := c.cc.Invoke(ctx, Config_ListProcessInnerIPs_FullMethodName, in, out, opts...)
+func (c *configClient) ListClientAlertRules(ctx context.Context, in *ListClientAlertRulesReq, opts ...grpc.CallOption) (*ListClientAlertRulesResp, error) {
+	out := new(ListClientAlertRulesResp)
+	err := c.cc.Invoke(ctx, Config_ListClientAlertRules_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) OperateProcess(ctx context.Context, in *OperateProcessReq, opts ...grpc.CallOption) (*OperateProcessResp, error) {
-	out := new(OperateProcessResp)
-	err := c.cc.Invoke(ctx, Config_OperateProcess_FullMethodName, in, out, opts...)
+func (c *configClient) SilenceClientAlertRule(ctx context.Context, in *SilenceClientAlertRuleReq, opts ...grpc.CallOption) (*SilenceClientAlertRuleResp, error) {
+	out := new(SilenceClientAlertRuleResp)
+	err := c.cc.Invoke(ctx, Config_SilenceClientAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ProcessFilterOptions(ctx context.Context, in *ProcessFilterOptionsReq, opts ...grpc.CallOption) (*ProcessFilterOptionsResp, error) {
-	out := new(ProcessFilterOptionsResp)
-	err := c.cc.Invoke(ctx, Config_ProcessFilterOptions_FullMethodName, in, out, opts...)
+func (c *configClient) AckClientAlert(ctx context.Context, in *AckClientAlertReq, opts ...grpc.CallOption) (*AckClientAlertResp, error) {
+	out := new(AckClientAlertResp)
+	err := c.cc.Invoke(ctx, Config_AckClientAlert_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTaskBatch(ctx context.Context, in *ListTaskBatchReq, opts ...grpc.CallOption) (*ListTaskBatchResp, error) {
-	out := new(ListTaskBatchResp)
-	err := c.cc.Invoke(ctx, Config_ListTaskBatch_FullMethodName, in, out, opts...)
+func (c *configClient) ListClientAlertHistories(ctx context.Context, in *ListClientAlertHistoriesReq, opts ...grpc.CallOption) (*ListClientAlertHistoriesResp, error) {
+	out := new(ListClientAlertHistoriesResp)
+	err := c.cc.Invoke(ctx, Config_ListClientAlertHistories_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetTaskBatchDetail(ctx context.Context, in *GetTaskBatchDetailReq, opts ...grpc.CallOption) (*GetTaskBatchDetailResp, error) {
-	out := new(GetTaskBatchDetailResp)
-	err := c.cc.Invoke(ctx, Config_GetTaskBatchDetail_FullMethodName, in, out, opts...)
+func (c *configClient) ListClientMetricTrends(ctx context.Context, in *ListClientMetricTrendsReq, opts ...grpc.CallOption) (*ListClientMetricTrendsResp, error) {
+	out := new(ListClientMetricTrendsResp)
+	err := c.cc.Invoke(ctx, Config_ListClientMetricTrends_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) SyncCmdbGseStatus(ctx context.Context, in *SyncCmdbGseStatusReq, opts ...grpc.CallOption) (*SyncCmdbGseStatusResp, error) {
-	out := new(SyncCmdbGseStatusResp)
-	err := c.cc.Invoke(ctx, Config_SyncCmdbGseStatus_FullMethodName, in, out, opts...)
+func (c *configClient) CreateGitRepoLink(ctx context.Context, in *CreateGitRepoLinkReq, opts ...grpc.CallOption) (*CreateGitRepoLinkResp, error) {
+	out := new(CreateGitRepoLinkResp)
+	err := c.cc.Invoke(ctx, Config_CreateGitRepoLink_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CmdbGseStatus(ctx context.Context, in *CmdbGseStatusReq, opts ...grpc.CallOption) (*CmdbGseStatusResp, error) {
-	out := new(CmdbGseStatusResp)
-	err := c.cc.Invoke(ctx, Config_CmdbGseStatus_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateGitRepoLink(ctx context.Context, in *UpdateGitRepoLinkReq, opts ...grpc.CallOption) (*UpdateGitRepoLinkResp, error) {
+	out := new(UpdateGitRepoLinkResp)
+	err := c.cc.Invoke(ctx, Config_UpdateGitRepoLink_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) RetryTasks(ctx context.Context, in *RetryTasksReq, opts ...grpc.CallOption) (*RetryTasksResp, error) {
-	out := new(RetryTasksResp)
-	err := c.cc.Invoke(ctx, Config_RetryTasks_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteGitRepoLink(ctx context.Context, in *DeleteGitRepoLinkReq, opts ...grpc.CallOption) (*DeleteGitRepoLinkResp, error) {
+	out := new(DeleteGitRepoLinkResp)
+	err := c.cc.Invoke(ctx, Config_DeleteGitRepoLink_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) BizTopo(ctx context.Context, in *BizTopoReq, opts ...grpc.CallOption) (*BizTopoResp, error) {
-	out := new(BizTopoResp)
-	err := c.cc.Invoke(ctx, Config_BizTopo_FullMethodName, in, out, opts...)
+func (c *configClient) ListGitRepoLinks(ctx context.Context, in *ListGitRepoLinksReq, opts ...grpc.CallOption) (*ListGitRepoLinksResp, error) {
+	out := new(ListGitRepoLinksResp)
+	err := c.cc.Invoke(ctx, Config_ListGitRepoLinks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ServiceTemplate(ctx context.Context, in *ServiceTemplateReq, opts ...grpc.CallOption) (*ServiceTemplateResp, error) {
-	out := new(ServiceTemplateResp)
-	err := c.cc.Invoke(ctx, Config_ServiceTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) SyncGitRepoLink(ctx context.Context, in *SyncGitRepoLinkReq, opts ...grpc.CallOption) (*SyncGitRepoLinkResp, error) {
+	out := new(SyncGitRepoLinkResp)
+	err := c.cc.Invoke(ctx, Config_SyncGitRepoLink_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ProcessTemplate(ctx context.Context, in *ProcessTemplateReq, opts ...grpc.CallOption) (*ProcessTemplateResp, error) {
-	out := new(ProcessTemplateResp)
-	err := c.cc.Invoke(ctx, Config_ProcessTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) CreateChangeFreeze(ctx context.Context, in *CreateChangeFreezeReq, opts ...grpc.CallOption) (*CreateChangeFreezeResp, error) {
+	out := new(CreateChangeFreezeResp)
+	err := c.cc.Invoke(ctx, Config_CreateChangeFreeze_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListConfigInstances(ctx context.Context, in *ListConfigInstancesReq, opts ...grpc.CallOption) (*ListConfigInstancesResp, error) {
-	out := new(ListConfigInstancesResp)
-	err := c.cc.Invoke(ctx, Config_ListConfigInstances_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateChangeFreeze(ctx context.Context, in *UpdateChangeFreezeReq, opts ...grpc.CallOption) (*UpdateChangeFreezeResp, error) {
+	out := new(UpdateChangeFreezeResp)
+	err := c.cc.Invoke(ctx, Config_UpdateChangeFreeze_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ConfigGenerateStatus(ctx context.Context, in *ConfigGenerateStatusReq, opts ...grpc.CallOption) (*ConfigGenerateStatusResp, error) {
-	out := new(ConfigGenerateStatusResp)
-	err := c.cc.Invoke(ctx, Config_ConfigGenerateStatus_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteChangeFreeze(ctx context.Context, in *DeleteChangeFreezeReq, opts ...grpc.CallOption) (*DeleteChangeFreezeResp, error) {
+	out := new(DeleteChangeFreezeResp)
+	err := c.cc.Invoke(ctx, Config_DeleteChangeFreeze_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) PreviewConfig(ctx context.Context, in *PreviewConfigReq, opts ...grpc.CallOption) (*PreviewConfigResp, error) {
-	out := new(PreviewConfigResp)
-	err := c.cc.Invoke(ctx, Config_PreviewConfig_FullMethodName, in, out, opts...)
+func (c *configClient) ListChangeFreezes(ctx context.Context, in *ListChangeFreezesReq, opts ...grpc.CallOption) (*ListChangeFreezesResp, error) {
+	out := new(ListChangeFreezesResp)
+	err := c.cc.Invoke(ctx, Config_ListChangeFreezes_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CompareConfig(ctx context.Context, in *CompareConfigReq, opts ...grpc.CallOption) (*CompareConfigResp, error) {
-	out := new(CompareConfigResp)
-	err := c.cc.Invoke(ctx, Config_CompareConfig_FullMethodName, in, out, opts...)
+func (c *configClient) GetChangeFreezeStatus(ctx context.Context, in *GetChangeFreezeStatusReq, opts ...grpc.CallOption) (*GetChangeFreezeStatusResp, error) {
+	out := new(GetChangeFreezeStatusResp)
+	err := c.cc.Invoke(ctx, Config_GetChangeFreezeStatus_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GenerateConfig(ctx context.Context, in *GenerateConfigReq, opts ...grpc.CallOption) (*GenerateConfigResp, error) {
-	out := new(GenerateConfigResp)
-	err := c.cc.Invoke(ctx, Config_GenerateConfig_FullMethodName, in, out, opts...)
+func (c *configClient) CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateWebhookResp, error) {
+	out := new(CreateWebhookResp)
+	err := c.cc.Invoke(ctx, Config_CreateWebhook_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CheckConfig(ctx context.Context, in *CheckConfigReq, opts ...grpc.CallOption) (*CheckConfigResp, error) {
-	out := new(CheckConfigResp)
-	err := c.cc.Invoke(ctx, Config_CheckConfig_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookReq, opts ...grpc.CallOption) (*UpdateWebhookResp, error) {
+	out := new(UpdateWebhookResp)
+	err := c.cc.Invoke(ctx, Config_UpdateWebhook_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetConfigDiff(ctx context.Context, in *GetConfigDiffReq, opts ...grpc.CallOption) (*GetConfigDiffResp, error) {
-	out := new(GetConfigDiffResp)
-	err := c.cc.Invoke(ctx, Config_GetConfigDiff_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*DeleteWebhookResp, error) {
+	out := new(DeleteWebhookResp)
+	err := c.cc.Invoke(ctx, Config_DeleteWebhook_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetConfigView(ctx context.Context, in *GetConfigViewReq, opts ...grpc.CallOption) (*GetConfigViewResp, error) {
-	out := new(GetConfigViewResp)
-	err := c.cc.Invoke(ctx, Config_GetConfigView_FullMethodName, in, out, opts...)
+func (c *configClient) ListWebhooks(ctx context.Context, in *ListWebhooksReq, opts ...grpc.CallOption) (*ListWebhooksResp, error) {
+	out := new(ListWebhooksResp)
+	err := c.cc.Invoke(ctx, Config_ListWebhooks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) OperateGenerateConfig(ctx context.Context, in *OperateGenerateConfigReq, opts ...grpc.CallOption) (*OperateGenerateConfigResp, error) {
-	out := new(OperateGenerateConfigResp)
-	err := c.cc.Invoke(ctx, Config_OperateGenerateConfig_FullMethodName, in, out, opts...)
+func (c *configClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (*ListWebhookDeliveriesResp, error) {
+	out := new(ListWebhookDeliveriesResp)
+	err := c.cc.Invoke(ctx, Config_ListWebhookDeliveries_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) PushConfig(ctx context.Context, in *PushConfigReq, opts ...grpc.CallOption) (*PushConfigResp, error) {
-	out := new(PushConfigResp)
-	err := c.cc.Invoke(ctx, Config_PushConfig_FullMethodName, in, out, opts...)
+func (c *configClient) RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryReq, opts ...grpc.CallOption) (*RedeliverWebhookDeliveryResp, error) {
+	out := new(RedeliverWebhookDeliveryResp)
+	err := c.cc.Invoke(ctx, Config_RedeliverWebhookDelivery_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetConfigRenderResult(ctx context.Context, in *GetConfigRenderResultReq, opts ...grpc.CallOption) (*GetConfigRenderResultResp, error) {
-	out := new(GetConfigRenderResultResp)
-	err := c.cc.Invoke(ctx, Config_GetConfigRenderResult_FullMethodName, in, out, opts...)
+func (c *configClient) SetApprovalFlow(ctx context.Context, in *SetApprovalFlowReq, opts ...grpc.CallOption) (*SetApprovalFlowResp, error) {
+	out := new(SetApprovalFlowResp)
+	err := c.cc.Invoke(ctx, Config_SetApprovalFlow_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListConfigTemplate(ctx context.Context, in *ListConfigTemplateReq, opts ...grpc.CallOption) (*ListConfigTemplateResp, error) {
-	out := new(ListConfigTemplateResp)
-	err := c.cc.Invoke(ctx, Config_ListConfigTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) GetApprovalFlow(ctx context.Context, in *GetApprovalFlowReq, opts ...grpc.CallOption) (*GetApprovalFlowResp, error) {
+	out := new(GetApprovalFlowResp)
+	err := c.cc.Invoke(ctx, Config_GetApprovalFlow_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateConfigTemplate(ctx context.Context, in *CreateConfigTemplateReq, opts ...grpc.CallOption) (*CreateConfigTemplateResp, error) {
-	out := new(CreateConfigTemplateResp)
-	err := c.cc.Invoke(ctx, Config_CreateConfigTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) GetApprovalTicket(ctx context.Context, in *GetApprovalTicketReq, opts ...grpc.CallOption) (*GetApprovalTicketResp, error) {
+	out := new(GetApprovalTicketResp)
+	err := c.cc.Invoke(ctx, Config_GetApprovalTicket_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateConfigTemplate(ctx context.Context, in *UpdateConfigTemplateReq, opts ...grpc.CallOption) (*UpdateConfigTemplateResp, error) {
-	out := new(UpdateConfigTemplateResp)
-	err := c.cc.Invoke(ctx, Config_UpdateConfigTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) DelegateApproval(ctx context.Context, in *DelegateApprovalReq, opts ...grpc.CallOption) (*DelegateApprovalResp, error) {
+	out := new(DelegateApprovalResp)
+	err := c.cc.Invoke(ctx, Config_DelegateApproval_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetConfigTemplate(ctx context.Context, in *GetConfigTemplateReq, opts ...grpc.CallOption) (*GetConfigTemplateResp, error) {
-	out := new(GetConfigTemplateResp)
-	err := c.cc.Invoke(ctx, Config_GetConfigTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) CommentApproval(ctx context.Context, in *CommentApprovalReq, opts ...grpc.CallOption) (*CommentApprovalResp, error) {
+	out := new(CommentApprovalResp)
+	err := c.cc.Invoke(ctx, Config_CommentApproval_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ConfigTemplateVariable(ctx context.Context, in *ConfigTemplateVariableReq, opts ...grpc.CallOption) (*ConfigTemplateVariableResp, error) {
-	out := new(ConfigTemplateVariableResp)
-	err := c.cc.Invoke(ctx, Config_ConfigTemplateVariable_FullMethodName, in, out, opts...)
+func (c *configClient) ExportAppBundle(ctx context.Context, in *ExportAppBundleReq, opts ...grpc.CallOption) (*ExportAppBundleResp, error) {
+	out := new(ExportAppBundleResp)
+	err := c.cc.Invoke(ctx, Config_ExportAppBundle_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) BindProcessInstance(ctx context.Context, in *BindProcessInstanceReq, opts ...grpc.CallOption) (*BindProcessInstanceResp, error) {
-	out := new(BindProcessInstanceResp)
-	err := c.cc.Invoke(ctx, Config_BindProcessInstance_FullMethodName, in, out, opts...)
+func (c *configClient) ImportAppBundle(ctx context.Context, in *ImportAppBundleReq, opts ...grpc.CallOption) (*ImportAppBundleResp, error) {
+	out := new(ImportAppBundleResp)
+	err := c.cc.Invoke(ctx, Config_ImportAppBundle_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) PreviewBindProcessInstance(ctx context.Context, in *PreviewBindProcessInstanceReq, opts ...grpc.CallOption) (*PreviewBindProcessInstanceResp, error) {
-	out := new(PreviewBindProcessInstanceResp)
-	err := c.cc.Invoke(ctx, Config_PreviewBindProcessInstance_FullMethodName, in, out, opts...)
+func (c *configClient) CompareConfigItemConflicts(ctx context.Context, in *CompareConfigItemConflictsReq, opts ...grpc.CallOption) (*CompareConfigItemConflictsResp, error) {
+	out := new(CompareConfigItemConflictsResp)
+	err := c.cc.Invoke(ctx, Config_CompareConfigItemConflicts_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ProcessInstance(ctx context.Context, in *ProcessInstanceReq, opts ...grpc.CallOption) (*ProcessInstanceResp, error) {
-	out := new(ProcessInstanceResp)
-	err := c.cc.Invoke(ctx, Config_ProcessInstance_FullMethodName, in, out, opts...)
+func (c *configClient) CompareKvConflicts(ctx context.Context, in *CompareKvConflictsReq, opts ...grpc.CallOption) (*CompareKvConflictsResp, error) {
+	out := new(CompareKvConflictsResp)
+	err := c.cc.Invoke(ctx, Config_CompareKvConflicts_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ServiceInstance(ctx context.Context, in *ServiceInstanceReq, opts ...grpc.CallOption) (*ServiceInstanceResp, error) {
-	out := new(ServiceInstanceResp)
-	err := c.cc.Invoke(ctx, Config_ServiceInstance_FullMethodName, in, out, opts...)
+func (c *configClient) GetTemplateAndNonTemplateCICount(ctx context.Context, in *GetTemplateAndNonTemplateCICountReq, opts ...grpc.CallOption) (*GetTemplateAndNonTemplateCICountResp, error) {
+	out := new(GetTemplateAndNonTemplateCICountResp)
+	err := c.cc.Invoke(ctx, Config_GetTemplateAndNonTemplateCICount_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteConfigTemplate(ctx context.Context, in *DeleteConfigTemplateReq, opts ...grpc.CallOption) (*DeleteConfigTemplateResp, error) {
-	out := new(DeleteConfigTemplateResp)
-	err := c.cc.Invoke(ctx, Config_DeleteConfigTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) ListProcess(ctx context.Context, in *ListProcessReq, opts ...grpc.CallOption) (*ListProcessResp, error) {
+	out := new(ListProcessResp)
+	err := c.cc.Invoke(ctx, Config_ListProcess_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetProcessInstanceTopo(ctx context.Context, in *GetProcessInstanceTopoReq, opts ...grpc.CallOption) (*GetProcessInstanceTopoResp, error) {
-	out := new(GetProcessInstanceTopoResp)
-	err := c.cc.Invoke(ctx, Config_GetProcessInstanceTopo_FullMethodName, in, out, opts...)
+func (c *configClient) ListProcessInnerIPs(ctx context.Context, in *ListProcessInnerIPsReq, opts ...grpc.CallOption) (*ListProcessInnerIPsResp, error) {
+	out := new(ListProcessInnerIPsResp)
+	err := c.cc.Invoke(ctx, Config_ListProcessInnerIPs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ManageConfigKV(ctx context.Context, in *ManageConfigKVReq, opts ...grpc.CallOption) (*ManageConfigKVResp, error) {
-	out := new(ManageConfigKVResp)
-	err := c.cc.Invoke(ctx, Config_ManageConfigKV_FullMethodName, in, out, opts...)
+func (c *configClient) OperateProcess(ctx context.Context, in *OperateProcessReq, opts ...grpc.CallOption) (*OperateProcessResp, error) {
+	out := new(OperateProcessResp)
+	err := c.cc.Invoke(ctx, Config_OperateProcess_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetProcessConfigView(ctx context.Context, in *GetProcessConfigViewReq, opts ...grpc.CallOption) (*GetProcessConfigViewResp, error) {
-	out := new(GetProcessConfigViewResp)
-	err := c.cc.Invoke(ctx, Config_GetProcessConfigView_FullMethodName, in, out, opts...)
+func (c *configClient) ProcessFilterOptions(ctx context.Context, in *ProcessFilterOptionsReq, opts ...grpc.CallOption) (*ProcessFilterOptionsResp, error) {
+	out := new(ProcessFilterOptionsResp)
+	err := c.cc.Invoke(ctx, Config_ProcessFilterOptions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ConfigServer is the server API for Config service.
-// All implementations should embed UnimplementedConfigServer
-// for forward compatibility
-type ConfigServer interface {
-	//  创建服务
-	CreateApp(context.Context, *CreateAppReq) (*CreateAppResp, error)
-	// 更新服务
-	UpdateApp(context.Context, *UpdateAppReq) (*app.App, error)
-	// 删除服务
-	DeleteApp(context.Context, *DeleteAppReq) (*DeleteAppResp, error)
-	// 获取服务
-	GetApp(context.Context, *GetAppReq) (*app.App, error)
-	// 按服务名称获取
-	GetAppByName(context.Context, *GetAppByNameReq) (*app.App, error)
-	// 获取用户有权限的 spaces 所有的 apps
-	ListAppsRest(context.Context, *ListAppsRestReq) (*ListAppsResp, error)
-	// 按 space 查询 app 信息
-	ListAppsBySpaceRest(context.Context, *ListAppsBySpaceRestReq) (*ListAppsResp, error)
-	// 克隆服务
-	CloneApp(context.Context, *CloneAppReq) (*CreateAppResp, error)
-	// 创建文件配置项
-	CreateConfigItem(context.Context, *CreateConfigItemReq) (*CreateConfigItemResp, error)
-	// 批量创建或更新文件配置项
-	BatchUpsertConfigItems(context.Context, *BatchUpsertConfigItemsReq) (*BatchUpsertConfigItemsResp, error)
-	// 更新文件配置项
-	UpdateConfigItem(context.Context, *UpdateConfigItemReq) (*UpdateConfigItemResp, error)
-	// 删除文件配置项
-	DeleteConfigItem(context.Context, *DeleteConfigItemReq) (*DeleteConfigItemResp, error)
-	// 批量删除文件配置项
-	BatchDeleteConfigItems(context.Context, *BatchDeleteAppResourcesReq) (*BatchDeleteResp, error)
-	// 恢复文件配置项
-	UnDeleteConfigItem(context.Context, *UnDeleteConfigItemReq) (*UnDeleteConfigItemResp, error)
-	// 批量恢复文件配置项
-	BatchUnDeleteConfigItem(context.Context, *BatchUnDeleteConfigItemReq) (*BatchUnDeleteConfigItemResp, error)
-	// 撤销文件配置项
-	UndoConfigItem(context.Context, *UndoConfigItemReq) (*UndoConfigItemResp, error)
-	// 获取文件配置项
-	GetConfigItem(context.Context, *GetConfigItemReq) (*GetConfigItemResp, error)
-	// 获取已发布文件配置项
+func (c *configClient) ListTaskBatch(ctx context.Context, in *ListTaskBatchReq, opts ...grpc.CallOption) (*ListTaskBatchResp, error) {
+	out := new(ListTaskBatchResp)
+	err := c.cc.Invoke(ctx, Config_ListTaskBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetTaskBatchDetail(ctx context.Context, in *GetTaskBatchDetailReq, opts ...grpc.CallOption) (*GetTaskBatchDetailResp, error) {
+	out := new(GetTaskBatchDetailResp)
+	err := c.cc.Invoke(ctx, Config_GetTaskBatchDetail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) SyncCmdbGseStatus(ctx context.Context, in *SyncCmdbGseStatusReq, opts ...grpc.CallOption) (*SyncCmdbGseStatusResp, error) {
+	out := new(SyncCmdbGseStatusResp)
+	err := c.cc.Invoke(ctx, Config_SyncCmdbGseStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CmdbGseStatus(ctx context.Context, in *CmdbGseStatusReq, opts ...grpc.CallOption) (*CmdbGseStatusResp, error) {
+	out := new(CmdbGseStatusResp)
+	err := c.cc.Invoke(ctx, Config_CmdbGseStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) RetryTasks(ctx context.Context, in *RetryTasksReq, opts ...grpc.CallOption) (*RetryTasksResp, error) {
+	out := new(RetryTasksResp)
+	err := c.cc.Invoke(ctx, Config_RetryTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) BizTopo(ctx context.Context, in *BizTopoReq, opts ...grpc.CallOption) (*BizTopoResp, error) {
+	out := new(BizTopoResp)
+	err := c.cc.Invoke(ctx, Config_BizTopo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ServiceTemplate(ctx context.Context, in *ServiceTemplateReq, opts ...grpc.CallOption) (*ServiceTemplateResp, error) {
+	out := new(ServiceTemplateResp)
+	err := c.cc.Invoke(ctx, Config_ServiceTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ProcessTemplate(ctx context.Context, in *ProcessTemplateReq, opts ...grpc.CallOption) (*ProcessTemplateResp, error) {
+	out := new(ProcessTemplateResp)
+	err := c.cc.Invoke(ctx, Config_ProcessTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListConfigInstances(ctx context.Context, in *ListConfigInstancesReq, opts ...grpc.CallOption) (*ListConfigInstancesResp, error) {
+	out := new(ListConfigInstancesResp)
+	err := c.cc.Invoke(ctx, Config_ListConfigInstances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ConfigGenerateStatus(ctx context.Context, in *ConfigGenerateStatusReq, opts ...grpc.CallOption) (*ConfigGenerateStatusResp, error) {
+	out := new(ConfigGenerateStatusResp)
+	err := c.cc.Invoke(ctx, Config_ConfigGenerateStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PreviewConfig(ctx context.Context, in *PreviewConfigReq, opts ...grpc.CallOption) (*PreviewConfigResp, error) {
+	out := new(PreviewConfigResp)
+	err := c.cc.Invoke(ctx, Config_PreviewConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CompareConfig(ctx context.Context, in *CompareConfigReq, opts ...grpc.CallOption) (*CompareConfigResp, error) {
+	out := new(CompareConfigResp)
+	err := c.cc.Invoke(ctx, Config_CompareConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GenerateConfig(ctx context.Context, in *GenerateConfigReq, opts ...grpc.CallOption) (*GenerateConfigResp, error) {
+	out := new(GenerateConfigResp)
+	err := c.cc.Invoke(ctx, Config_GenerateConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CheckConfig(ctx context.Context, in *CheckConfigReq, opts ...grpc.CallOption) (*CheckConfigResp, error) {
+	out := new(CheckConfigResp)
+	err := c.cc.Invoke(ctx, Config_CheckConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetConfigDiff(ctx context.Context, in *GetConfigDiffReq, opts ...grpc.CallOption) (*GetConfigDiffResp, error) {
+	out := new(GetConfigDiffResp)
+	err := c.cc.Invoke(ctx, Config_GetConfigDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetConfigView(ctx context.Context, in *GetConfigViewReq, opts ...grpc.CallOption) (*GetConfigViewResp, error) {
+	out := new(GetConfigViewResp)
+	err := c.cc.Invoke(ctx, Config_GetConfigView_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) OperateGenerateConfig(ctx context.Context, in *OperateGenerateConfigReq, opts ...grpc.CallOption) (*OperateGenerateConfigResp, error) {
+	out := new(OperateGenerateConfigResp)
+	err := c.cc.Invoke(ctx, Config_OperateGenerateConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PushConfig(ctx context.Context, in *PushConfigReq, opts ...grpc.CallOption) (*PushConfigResp, error) {
+	out := new(PushConfigResp)
+	err := c.cc.Invoke(ctx, Config_PushConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) RepushDriftedConfig(ctx context.Context, in *RepushDriftedConfigReq, opts ...grpc.CallOption) (*RepushDriftedConfigResp, error) {
+	out := new(RepushDriftedConfigResp)
+	err := c.cc.Invoke(ctx, Config_RepushDriftedConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetConfigRenderResult(ctx context.Context, in *GetConfigRenderResultReq, opts ...grpc.CallOption) (*GetConfigRenderResultResp, error) {
+	out := new(GetConfigRenderResultResp)
+	err := c.cc.Invoke(ctx, Config_GetConfigRenderResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListConfigTemplate(ctx context.Context, in *ListConfigTemplateReq, opts ...grpc.CallOption) (*ListConfigTemplateResp, error) {
+	out := new(ListConfigTemplateResp)
+	err := c.cc.Invoke(ctx, Config_ListConfigTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CreateConfigTemplate(ctx context.Context, in *CreateConfigTemplateReq, opts ...grpc.CallOption) (*CreateConfigTemplateResp, error) {
+	out := new(CreateConfigTemplateResp)
+	err := c.cc.Invoke(ctx, Config_CreateConfigTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateConfigTemplate(ctx context.Context, in *UpdateConfigTemplateReq, opts ...grpc.CallOption) (*UpdateConfigTemplateResp, error) {
+	out := new(UpdateConfigTemplateResp)
+	err := c.cc.Invoke(ctx, Config_UpdateConfigTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetConfigTemplate(ctx context.Context, in *GetConfigTemplateReq, opts ...grpc.CallOption) (*GetConfigTemplateResp, error) {
+	out := new(GetConfigTemplateResp)
+	err := c.cc.Invoke(ctx, Config_GetConfigTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ConfigTemplateVariable(ctx context.Context, in *ConfigTemplateVariableReq, opts ...grpc.CallOption) (*ConfigTemplateVariableResp, error) {
+	out := new(ConfigTemplateVariableResp)
+	err := c.cc.Invoke(ctx, Config_ConfigTemplateVariable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) BindProcessInstance(ctx context.Context, in *BindProcessInstanceReq, opts ...grpc.CallOption) (*BindProcessInstanceResp, error) {
+	out := new(BindProcessInstanceResp)
+	err := c.cc.Invoke(ctx, Config_BindProcessInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PreviewBindProcessInstance(ctx context.Context, in *PreviewBindProcessInstanceReq, opts ...grpc.CallOption) (*PreviewBindProcessInstanceResp, error) {
+	out := new(PreviewBindProcessInstanceResp)
+	err := c.cc.Invoke(ctx, Config_PreviewBindProcessInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ProcessInstance(ctx context.Context, in *ProcessInstanceReq, opts ...grpc.CallOption) (*ProcessInstanceResp, error) {
+	out := new(ProcessInstanceResp)
+	err := c.cc.Invoke(ctx, Config_ProcessInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ServiceInstance(ctx context.Context, in *ServiceInstanceReq, opts ...grpc.CallOption) (*ServiceInstanceResp, error) {
+	out := new(ServiceInstanceResp)
+	err := c.cc.Invoke(ctx, Config_ServiceInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteConfigTemplate(ctx context.Context, in *DeleteConfigTemplateReq, opts ...grpc.CallOption) (*DeleteConfigTemplateResp, error) {
+	out := new(DeleteConfigTemplateResp)
+	err := c.cc.Invoke(ctx, Config_DeleteConfigTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetProcessInstanceTopo(ctx context.Context, in *GetProcessInstanceTopoReq, opts ...grpc.CallOption) (*GetProcessInstanceTopoResp, error) {
+	out := new(GetProcessInstanceTopoResp)
+	err := c.cc.Invoke(ctx, Config_GetProcessInstanceTopo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ManageConfigKV(ctx context.Context, in *ManageConfigKVReq, opts ...grpc.CallOption) (*ManageConfigKVResp, error) {
+	out := new(ManageConfigKVResp)
+	err := c.cc.Invoke(ctx, Config_ManageConfigKV_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetProcessConfigView(ctx context.Context, in *GetProcessConfigViewReq, opts ...grpc.CallOption) (*GetProcessConfigViewResp, error) {
+	out := new(GetProcessConfigViewResp)
+	err := c.cc.Invoke(ctx, Config_GetProcessConfigView_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfigServer is the server API for Config service.
+// All implementations should embed UnimplementedConfigServer
+// for forward compatibility
+type ConfigServer interface {
+	//  创建服务
+	CreateApp(context.Context, *CreateAppReq) (*CreateAppResp, error)
+	// 更新服务
+	UpdateApp(context.Context, *UpdateAppReq) (*app.App, error)
+	// 删除服务
+	DeleteApp(context.Context, *DeleteAppReq) (*DeleteAppResp, error)
+	// 获取服务
+	GetApp(context.Context, *GetAppReq) (*app.App, error)
+	// 按服务名称获取
+	GetAppByName(context.Context, *GetAppByNameReq) (*app.App, error)
+	// 获取用户有权限的 spaces 所有的 apps
+	ListAppsRest(context.Context, *ListAppsRestReq) (*ListAppsResp, error)
+	// 按 space 查询 app 信息
+	ListAppsBySpaceRest(context.Context, *ListAppsBySpaceRestReq) (*ListAppsResp, error)
+	// 克隆服务
+	CloneApp(context.Context, *CloneAppReq) (*CreateAppResp, error)
+	// 创建文件配置项
+	CreateConfigItem(context.Context, *CreateConfigItemReq) (*CreateConfigItemResp, error)
+	// 批量创建或更新文件配置项
+	BatchUpsertConfigItems(context.Context, *BatchUpsertConfigItemsReq) (*BatchUpsertConfigItemsResp, error)
+	// 更新文件配置项
+	UpdateConfigItem(context.Context, *UpdateConfigItemReq) (*UpdateConfigItemResp, error)
+	// 删除文件配置项
+	DeleteConfigItem(context.Context, *DeleteConfigItemReq) (*DeleteConfigItemResp, error)
+	// 批量删除文件配置项
+	BatchDeleteConfigItems(context.Context, *BatchDeleteAppResourcesReq) (*BatchDeleteResp, error)
+	// 恢复文件配置项
+	UnDeleteConfigItem(context.Context, *UnDeleteConfigItemReq) (*UnDeleteConfigItemResp, error)
+	// 批量恢复文件配置项
+	BatchUnDeleteConfigItem(context.Context, *BatchUnDeleteConfigItemReq) (*BatchUnDeleteConfigItemResp, error)
+	// 撤销文件配置项
+	UndoConfigItem(context.Context, *UndoConfigItemReq) (*UndoConfigItemResp, error)
+	// 获取文件配置项
+	GetConfigItem(context.Context, *GetConfigItemReq) (*GetConfigItemResp, error)
+	// 获取已发布文件配置项
 	GetReleasedConfigItem(context.Context, *GetReleasedConfigItemReq) (*GetReleasedConfigItemResp, error)
 	// 获取文件配置项列表
 	ListConfigItems(context.Context, *ListConfigItemsReq) (*ListConfigItemsResp, error)
@@ -2724,6 +3276,8 @@ type ConfigServer interface {
 	DeleteHookRevision(context.Context, *DeleteHookRevisionReq) (*DeleteHookRevisionResp, error)
 	// 上线脚本版本
 	PublishHookRevision(context.Context, *PublishHookRevisionReq) (*PublishHookRevisionResp, error)
+	// 在沙箱中试运行脚本版本
+	TestHookRevision(context.Context, *TestHookRevisionReq) (*TestHookRevisionResp, error)
 	// 获取脚本版本
 	GetHookRevision(context.Context, *GetHookRevisionReq) (*hook_revision.HookRevision, error)
 	// 更新脚本版本
@@ -2742,6 +3296,12 @@ type ConfigServer interface {
 	UpdateTemplateSpace(context.Context, *UpdateTemplateSpaceReq) (*UpdateTemplateSpaceResp, error)
 	// 获取模板空间列表
 	ListTemplateSpaces(context.Context, *ListTemplateSpacesReq) (*ListTemplateSpacesResp, error)
+	// 导入脚本到模板空间脚本库
+	ImportTemplateSpaceHooks(context.Context, *ImportTemplateSpaceHooksReq) (*ImportTemplateSpaceHooksResp, error)
+	// 获取模板空间脚本库列表
+	ListTemplateSpaceHooks(context.Context, *ListTemplateSpaceHooksReq) (*ListTemplateSpaceHooksResp, error)
+	// 从模板空间脚本库移除脚本
+	DeleteTemplateSpaceHook(context.Context, *DeleteTemplateSpaceHookReq) (*DeleteTemplateSpaceHookResp, error)
 	// GetAllBizsOfTmplSpaces called only by system itself
 	GetAllBizsOfTmplSpaces(context.Context, *base.EmptyReq) (*GetAllBizsOfTmplSpacesResp, error)
 	// 创建模板默认空间(仅由系统本身调用)
@@ -2880,6 +3440,12 @@ type ConfigServer interface {
 	ListAppTmplVariables(context.Context, *ListAppTmplVariablesReq) (*ListAppTmplVariablesResp, error)
 	// 获取服务版本的模板变量列表
 	ListReleasedAppTmplVariables(context.Context, *ListReleasedAppTmplVariablesReq) (*ListReleasedAppTmplVariablesResp, error)
+	// 更新分组覆盖的服务模板变量
+	UpdateGroupTmplVariables(context.Context, *UpdateGroupTmplVariablesReq) (*UpdateGroupTmplVariablesResp, error)
+	// 获取分组覆盖的服务模板变量
+	ListGroupTmplVariables(context.Context, *ListGroupTmplVariablesReq) (*ListGroupTmplVariablesResp, error)
+	// 追踪服务模板变量在业务、服务、分组各层的取值及最终来源
+	TraceAppTmplVariables(context.Context, *TraceAppTmplVariablesReq) (*TraceAppTmplVariablesResp, error)
 	// 创建分组
 	CreateGroup(context.Context, *CreateGroupReq) (*CreateGroupResp, error)
 	// 删除分组
@@ -2904,6 +3470,16 @@ type ConfigServer interface {
 	GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error)
 	// 上线服务版本
 	SubmitPublishApprove(context.Context, *SubmitPublishApproveReq) (*PublishResp, error)
+	// 回滚服务版本，处于该版本的分组恢复为之前上线的版本
+	RollbackRelease(context.Context, *RollbackReleaseReq) (*RollbackReleaseResp, error)
+	// 固定分组的服务版本，固定期间分组内的客户端只会拉取该版本
+	PinGroupRelease(context.Context, *PinGroupReleaseReq) (*PinGroupReleaseResp, error)
+	// 解除分组的服务版本固定
+	UnpinGroupRelease(context.Context, *UnpinGroupReleaseReq) (*UnpinGroupReleaseResp, error)
+	// 修改服务版本的标签
+	UpdateReleaseTags(context.Context, *UpdateReleaseTagsReq) (*UpdateReleaseTagsResp, error)
+	// 将服务版本晋级到同业务下的其他服务，如从测试服务晋级到正式服务，dry_run 时只返回变更预览
+	PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error)
 	// 审批同步，其中v2版本中itsm也是复用这个接口进行回调
 	Approve(context.Context, *ApproveReq) (*ApproveResp, error)
 	// itsm v4 回调接口
@@ -2920,6 +3496,8 @@ type ConfigServer interface {
 	DeleteCredential(context.Context, *DeleteCredentialsReq) (*DeleteCredentialsResp, error)
 	// 更新客户端密钥
 	UpdateCredential(context.Context, *UpdateCredentialsReq) (*UpdateCredentialsResp, error)
+	// 轮换客户端密钥
+	RotateCredential(context.Context, *RotateCredentialReq) (*RotateCredentialResp, error)
 	// 检测客户端密钥名称
 	CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error)
 	// 获取客户端密钥关联服务的列表
@@ -2982,6 +3560,70 @@ type ConfigServer interface {
 	ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error)
 	// 获取客户端失败原因
 	ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	// 客户端版本变更阶段耗时统计
+	ClientStageStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	// 创建客户端告警规则
+	CreateClientAlertRule(context.Context, *CreateClientAlertRuleReq) (*CreateClientAlertRuleResp, error)
+	// 更新客户端告警规则
+	UpdateClientAlertRule(context.Context, *UpdateClientAlertRuleReq) (*UpdateClientAlertRuleResp, error)
+	// 删除客户端告警规则
+	DeleteClientAlertRule(context.Context, *DeleteClientAlertRuleReq) (*DeleteClientAlertRuleResp, error)
+	// 获取客户端告警规则列表
+	ListClientAlertRules(context.Context, *ListClientAlertRulesReq) (*ListClientAlertRulesResp, error)
+	// 静默客户端告警规则
+	SilenceClientAlertRule(context.Context, *SilenceClientAlertRuleReq) (*SilenceClientAlertRuleResp, error)
+	// 确认客户端告警
+	AckClientAlert(context.Context, *AckClientAlertReq) (*AckClientAlertResp, error)
+	// 获取客户端告警历史
+	ListClientAlertHistories(context.Context, *ListClientAlertHistoriesReq) (*ListClientAlertHistoriesResp, error)
+	// 获取客户端资源和拉取指标的历史趋势
+	ListClientMetricTrends(context.Context, *ListClientMetricTrendsReq) (*ListClientMetricTrendsResp, error)
+	// 创建 git 仓库关联
+	CreateGitRepoLink(context.Context, *CreateGitRepoLinkReq) (*CreateGitRepoLinkResp, error)
+	// 更新 git 仓库关联
+	UpdateGitRepoLink(context.Context, *UpdateGitRepoLinkReq) (*UpdateGitRepoLinkResp, error)
+	// 删除 git 仓库关联
+	DeleteGitRepoLink(context.Context, *DeleteGitRepoLinkReq) (*DeleteGitRepoLinkResp, error)
+	// 获取服务或模板空间的 git 仓库关联列表
+	ListGitRepoLinks(context.Context, *ListGitRepoLinksReq) (*ListGitRepoLinksResp, error)
+	// 同步 git 仓库关联，携带 webhook_token 时由 webhook 触发，不校验用户权限
+	SyncGitRepoLink(context.Context, *SyncGitRepoLinkReq) (*SyncGitRepoLinkResp, error)
+	// 创建变更冻结窗口
+	CreateChangeFreeze(context.Context, *CreateChangeFreezeReq) (*CreateChangeFreezeResp, error)
+	// 更新变更冻结窗口
+	UpdateChangeFreeze(context.Context, *UpdateChangeFreezeReq) (*UpdateChangeFreezeResp, error)
+	// 删除变更冻结窗口
+	DeleteChangeFreeze(context.Context, *DeleteChangeFreezeReq) (*DeleteChangeFreezeResp, error)
+	// 获取业务或服务的变更冻结窗口列表
+	ListChangeFreezes(context.Context, *ListChangeFreezesReq) (*ListChangeFreezesResp, error)
+	// 获取当前生效的变更冻结窗口，以及当前用户是否可以强制变更
+	GetChangeFreezeStatus(context.Context, *GetChangeFreezeStatusReq) (*GetChangeFreezeStatusResp, error)
+	// 创建事件订阅
+	CreateWebhook(context.Context, *CreateWebhookReq) (*CreateWebhookResp, error)
+	// 更新事件订阅
+	UpdateWebhook(context.Context, *UpdateWebhookReq) (*UpdateWebhookResp, error)
+	// 删除事件订阅及其投递记录
+	DeleteWebhook(context.Context, *DeleteWebhookReq) (*DeleteWebhookResp, error)
+	// 获取业务或服务的事件订阅列表
+	ListWebhooks(context.Context, *ListWebhooksReq) (*ListWebhooksResp, error)
+	// 获取事件订阅的投递记录
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesReq) (*ListWebhookDeliveriesResp, error)
+	// 重新投递事件
+	RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryReq) (*RedeliverWebhookDeliveryResp, error)
+	// 设置服务的内置审批流程
+	SetApprovalFlow(context.Context, *SetApprovalFlowReq) (*SetApprovalFlowResp, error)
+	// 获取服务的内置审批流程
+	GetApprovalFlow(context.Context, *GetApprovalFlowReq) (*GetApprovalFlowResp, error)
+	// 获取版本上线的内置审批单及审批记录
+	GetApprovalTicket(context.Context, *GetApprovalTicketReq) (*GetApprovalTicketResp, error)
+	// 将当前阶段的审批转给其他人
+	DelegateApproval(context.Context, *DelegateApprovalReq) (*DelegateApprovalResp, error)
+	// 评论内置审批单
+	CommentApproval(context.Context, *CommentApprovalReq) (*CommentApprovalResp, error)
+	// 导出服务包清单
+	ExportAppBundle(context.Context, *ExportAppBundleReq) (*ExportAppBundleResp, error)
+	// 导入服务包清单
+	ImportAppBundle(context.Context, *ImportAppBundleReq) (*ImportAppBundleResp, error)
 	// 文件服务以及和其他服务版本之间对比
 	CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error)
 	// 键值服务以及和其他服务版本之间对比
@@ -3033,6 +3675,8 @@ type ConfigServer interface {
 	OperateGenerateConfig(context.Context, *OperateGenerateConfigReq) (*OperateGenerateConfigResp, error)
 	// 配置下发
 	PushConfig(context.Context, *PushConfigReq) (*PushConfigResp, error)
+	// 漂移配置重新下发
+	RepushDriftedConfig(context.Context, *RepushDriftedConfigReq) (*RepushDriftedConfigResp, error)
 	// 查看配置生成结果
 	GetConfigRenderResult(context.Context, *GetConfigRenderResultReq) (*GetConfigRenderResultResp, error)
 	// 配置模板列表
@@ -3202,6 +3846,9 @@ func (UnimplementedConfigServer) DeleteHookRevision(context.Context, *DeleteHook
 func (UnimplementedConfigServer) PublishHookRevision(context.Context, *PublishHookRevisionReq) (*PublishHookRevisionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishHookRevision not implemented")
 }
+func (UnimplementedConfigServer) TestHookRevision(context.Context, *TestHookRevisionReq) (*TestHookRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestHookRevision not implemented")
+}
 func (UnimplementedConfigServer) GetHookRevision(context.Context, *GetHookRevisionReq) (*hook_revision.HookRevision, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHookRevision not implemented")
 }
@@ -3229,6 +3876,15 @@ func (UnimplementedConfigServer) UpdateTemplateSpace(context.Context, *UpdateTem
 func (UnimplementedConfigServer) ListTemplateSpaces(context.Context, *ListTemplateSpacesReq) (*ListTemplateSpacesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSpaces not implemented")
 }
+func (UnimplementedConfigServer) ImportTemplateSpaceHooks(context.Context, *ImportTemplateSpaceHooksReq) (*ImportTemplateSpaceHooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTemplateSpaceHooks not implemented")
+}
+func (UnimplementedConfigServer) ListTemplateSpaceHooks(context.Context, *ListTemplateSpaceHooksReq) (*ListTemplateSpaceHooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSpaceHooks not implemented")
+}
+func (UnimplementedConfigServer) DeleteTemplateSpaceHook(context.Context, *DeleteTemplateSpaceHookReq) (*DeleteTemplateSpaceHookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateSpaceHook not implemented")
+}
 func (UnimplementedConfigServer) GetAllBizsOfTmplSpaces(context.Context, *base.EmptyReq) (*GetAllBizsOfTmplSpacesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllBizsOfTmplSpaces not implemented")
 }
@@ -3439,6 +4095,15 @@ func (UnimplementedConfigServer) ListAppTmplVariables(context.Context, *ListAppT
 func (UnimplementedConfigServer) ListReleasedAppTmplVariables(context.Context, *ListReleasedAppTmplVariablesReq) (*ListReleasedAppTmplVariablesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedAppTmplVariables not implemented")
 }
+func (UnimplementedConfigServer) UpdateGroupTmplVariables(context.Context, *UpdateGroupTmplVariablesReq) (*UpdateGroupTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupTmplVariables not implemented")
+}
+func (UnimplementedConfigServer) ListGroupTmplVariables(context.Context, *ListGroupTmplVariablesReq) (*ListGroupTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupTmplVariables not implemented")
+}
+func (UnimplementedConfigServer) TraceAppTmplVariables(context.Context, *TraceAppTmplVariablesReq) (*TraceAppTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceAppTmplVariables not implemented")
+}
 func (UnimplementedConfigServer) CreateGroup(context.Context, *CreateGroupReq) (*CreateGroupResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
 }
@@ -3475,6 +4140,21 @@ func (UnimplementedConfigServer) GenerateReleaseAndPublish(context.Context, *Gen
 func (UnimplementedConfigServer) SubmitPublishApprove(context.Context, *SubmitPublishApproveReq) (*PublishResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitPublishApprove not implemented")
 }
+func (UnimplementedConfigServer) RollbackRelease(context.Context, *RollbackReleaseReq) (*RollbackReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackRelease not implemented")
+}
+func (UnimplementedConfigServer) PinGroupRelease(context.Context, *PinGroupReleaseReq) (*PinGroupReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinGroupRelease not implemented")
+}
+func (UnimplementedConfigServer) UnpinGroupRelease(context.Context, *UnpinGroupReleaseReq) (*UnpinGroupReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinGroupRelease not implemented")
+}
+func (UnimplementedConfigServer) UpdateReleaseTags(context.Context, *UpdateReleaseTagsReq) (*UpdateReleaseTagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReleaseTags not implemented")
+}
+func (UnimplementedConfigServer) PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRelease not implemented")
+}
 func (UnimplementedConfigServer) Approve(context.Context, *ApproveReq) (*ApproveResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
 }
@@ -3505,6 +4185,9 @@ func (UnimplementedConfigServer) DeleteCredential(context.Context, *DeleteCreden
 func (UnimplementedConfigServer) UpdateCredential(context.Context, *UpdateCredentialsReq) (*UpdateCredentialsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
 }
+func (UnimplementedConfigServer) RotateCredential(context.Context, *RotateCredentialReq) (*RotateCredentialResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCredential not implemented")
+}
 func (UnimplementedConfigServer) CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckCredentialName not implemented")
 }
@@ -3553,3362 +4236,4271 @@ func (UnimplementedConfigServer) FindNearExpiryCertKvs(context.Context, *FindNea
 func (UnimplementedConfigServer) ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
 }
-func (UnimplementedConfigServer) ListClientEvents(context.Context, *ListClientEventsReq) (*ListClientEventsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientEvents not implemented")
+func (UnimplementedConfigServer) ListClientEvents(context.Context, *ListClientEventsReq) (*ListClientEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientEvents not implemented")
+}
+func (UnimplementedConfigServer) RetryClients(context.Context, *RetryClientsReq) (*RetryClientsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryClients not implemented")
+}
+func (UnimplementedConfigServer) ListClientQuerys(context.Context, *ListClientQuerysReq) (*ListClientQuerysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuerys not implemented")
+}
+func (UnimplementedConfigServer) CreateClientQuery(context.Context, *CreateClientQueryReq) (*CreateClientQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClientQuery not implemented")
+}
+func (UnimplementedConfigServer) UpdateClientQuery(context.Context, *UpdateClientQueryReq) (*UpdateClientQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientQuery not implemented")
+}
+func (UnimplementedConfigServer) DeleteClientQuery(context.Context, *DeleteClientQueryReq) (*DeleteClientQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientQuery not implemented")
+}
+func (UnimplementedConfigServer) CheckClientQueryName(context.Context, *CheckClientQueryNameReq) (*CheckClientQueryNameResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckClientQueryName not implemented")
+}
+func (UnimplementedConfigServer) ClientConfigVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientConfigVersionStatistics not implemented")
+}
+func (UnimplementedConfigServer) ClientPullTrendStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientPullTrendStatistics not implemented")
+}
+func (UnimplementedConfigServer) ClientPullStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientPullStatistics not implemented")
+}
+func (UnimplementedConfigServer) ClientLabelStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientLabelStatistics not implemented")
+}
+func (UnimplementedConfigServer) ClientAnnotationStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientAnnotationStatistics not implemented")
+}
+func (UnimplementedConfigServer) ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientVersionStatistics not implemented")
+}
+func (UnimplementedConfigServer) ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientLabelAndAnnotation not implemented")
+}
+func (UnimplementedConfigServer) ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientSpecificFailedReason not implemented")
+}
+func (UnimplementedConfigServer) ClientStageStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientStageStatistics not implemented")
+}
+func (UnimplementedConfigServer) CreateClientAlertRule(context.Context, *CreateClientAlertRuleReq) (*CreateClientAlertRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClientAlertRule not implemented")
+}
+func (UnimplementedConfigServer) UpdateClientAlertRule(context.Context, *UpdateClientAlertRuleReq) (*UpdateClientAlertRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientAlertRule not implemented")
+}
+func (UnimplementedConfigServer) DeleteClientAlertRule(context.Context, *DeleteClientAlertRuleReq) (*DeleteClientAlertRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientAlertRule not implemented")
+}
+func (UnimplementedConfigServer) ListClientAlertRules(context.Context, *ListClientAlertRulesReq) (*ListClientAlertRulesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientAlertRules not implemented")
+}
+func (UnimplementedConfigServer) SilenceClientAlertRule(context.Context, *SilenceClientAlertRuleReq) (*SilenceClientAlertRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SilenceClientAlertRule not implemented")
+}
+func (UnimplementedConfigServer) AckClientAlert(context.Context, *AckClientAlertReq) (*AckClientAlertResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckClientAlert not implemented")
+}
+func (UnimplementedConfigServer) ListClientAlertHistories(context.Context, *ListClientAlertHistoriesReq) (*ListClientAlertHistoriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientAlertHistories not implemented")
+}
+func (UnimplementedConfigServer) ListClientMetricTrends(context.Context, *ListClientMetricTrendsReq) (*ListClientMetricTrendsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientMetricTrends not implemented")
+}
+func (UnimplementedConfigServer) CreateGitRepoLink(context.Context, *CreateGitRepoLinkReq) (*CreateGitRepoLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGitRepoLink not implemented")
+}
+func (UnimplementedConfigServer) UpdateGitRepoLink(context.Context, *UpdateGitRepoLinkReq) (*UpdateGitRepoLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGitRepoLink not implemented")
+}
+func (UnimplementedConfigServer) DeleteGitRepoLink(context.Context, *DeleteGitRepoLinkReq) (*DeleteGitRepoLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGitRepoLink not implemented")
+}
+func (UnimplementedConfigServer) ListGitRepoLinks(context.Context, *ListGitRepoLinksReq) (*ListGitRepoLinksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGitRepoLinks not implemented")
+}
+func (UnimplementedConfigServer) SyncGitRepoLink(context.Context, *SyncGitRepoLinkReq) (*SyncGitRepoLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncGitRepoLink not implemented")
+}
+func (UnimplementedConfigServer) CreateChangeFreeze(context.Context, *CreateChangeFreezeReq) (*CreateChangeFreezeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChangeFreeze not implemented")
+}
+func (UnimplementedConfigServer) UpdateChangeFreeze(context.Context, *UpdateChangeFreezeReq) (*UpdateChangeFreezeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChangeFreeze not implemented")
+}
+func (UnimplementedConfigServer) DeleteChangeFreeze(context.Context, *DeleteChangeFreezeReq) (*DeleteChangeFreezeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChangeFreeze not implemented")
+}
+func (UnimplementedConfigServer) ListChangeFreezes(context.Context, *ListChangeFreezesReq) (*ListChangeFreezesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChangeFreezes not implemented")
+}
+func (UnimplementedConfigServer) GetChangeFreezeStatus(context.Context, *GetChangeFreezeStatusReq) (*GetChangeFreezeStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangeFreezeStatus not implemented")
+}
+func (UnimplementedConfigServer) CreateWebhook(context.Context, *CreateWebhookReq) (*CreateWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedConfigServer) UpdateWebhook(context.Context, *UpdateWebhookReq) (*UpdateWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedConfigServer) DeleteWebhook(context.Context, *DeleteWebhookReq) (*DeleteWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedConfigServer) ListWebhooks(context.Context, *ListWebhooksReq) (*ListWebhooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedConfigServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesReq) (*ListWebhookDeliveriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedConfigServer) RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryReq) (*RedeliverWebhookDeliveryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhookDelivery not implemented")
+}
+func (UnimplementedConfigServer) SetApprovalFlow(context.Context, *SetApprovalFlowReq) (*SetApprovalFlowResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApprovalFlow not implemented")
+}
+func (UnimplementedConfigServer) GetApprovalFlow(context.Context, *GetApprovalFlowReq) (*GetApprovalFlowResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApprovalFlow not implemented")
+}
+func (UnimplementedConfigServer) GetApprovalTicket(context.Context, *GetApprovalTicketReq) (*GetApprovalTicketResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApprovalTicket not implemented")
+}
+func (UnimplementedConfigServer) DelegateApproval(context.Context, *DelegateApprovalReq) (*DelegateApprovalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateApproval not implemented")
+}
+func (UnimplementedConfigServer) CommentApproval(context.Context, *CommentApprovalReq) (*CommentApprovalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentApproval not implemented")
+}
+func (UnimplementedConfigServer) ExportAppBundle(context.Context, *ExportAppBundleReq) (*ExportAppBundleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAppBundle not implemented")
+}
+func (UnimplementedConfigServer) ImportAppBundle(context.Context, *ImportAppBundleReq) (*ImportAppBundleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAppBundle not implemented")
+}
+func (UnimplementedConfigServer) CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareConfigItemConflicts not implemented")
+}
+func (UnimplementedConfigServer) CompareKvConflicts(context.Context, *CompareKvConflictsReq) (*CompareKvConflictsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareKvConflicts not implemented")
+}
+func (UnimplementedConfigServer) GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateAndNonTemplateCICount not implemented")
+}
+func (UnimplementedConfigServer) ListProcess(context.Context, *ListProcessReq) (*ListProcessResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcess not implemented")
+}
+func (UnimplementedConfigServer) ListProcessInnerIPs(context.Context, *ListProcessInnerIPsReq) (*ListProcessInnerIPsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcessInnerIPs not implemented")
+}
+func (UnimplementedConfigServer) OperateProcess(context.Context, *OperateProcessReq) (*OperateProcessResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateProcess not implemented")
+}
+func (UnimplementedConfigServer) ProcessFilterOptions(context.Context, *ProcessFilterOptionsReq) (*ProcessFilterOptionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessFilterOptions not implemented")
+}
+func (UnimplementedConfigServer) ListTaskBatch(context.Context, *ListTaskBatchReq) (*ListTaskBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskBatch not implemented")
+}
+func (UnimplementedConfigServer) GetTaskBatchDetail(context.Context, *GetTaskBatchDetailReq) (*GetTaskBatchDetailResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskBatchDetail not implemented")
+}
+func (UnimplementedConfigServer) SyncCmdbGseStatus(context.Context, *SyncCmdbGseStatusReq) (*SyncCmdbGseStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncCmdbGseStatus not implemented")
+}
+func (UnimplementedConfigServer) CmdbGseStatus(context.Context, *CmdbGseStatusReq) (*CmdbGseStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CmdbGseStatus not implemented")
+}
+func (UnimplementedConfigServer) RetryTasks(context.Context, *RetryTasksReq) (*RetryTasksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryTasks not implemented")
+}
+func (UnimplementedConfigServer) BizTopo(context.Context, *BizTopoReq) (*BizTopoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BizTopo not implemented")
+}
+func (UnimplementedConfigServer) ServiceTemplate(context.Context, *ServiceTemplateReq) (*ServiceTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceTemplate not implemented")
+}
+func (UnimplementedConfigServer) ProcessTemplate(context.Context, *ProcessTemplateReq) (*ProcessTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessTemplate not implemented")
+}
+func (UnimplementedConfigServer) ListConfigInstances(context.Context, *ListConfigInstancesReq) (*ListConfigInstancesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigInstances not implemented")
+}
+func (UnimplementedConfigServer) ConfigGenerateStatus(context.Context, *ConfigGenerateStatusReq) (*ConfigGenerateStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigGenerateStatus not implemented")
+}
+func (UnimplementedConfigServer) PreviewConfig(context.Context, *PreviewConfigReq) (*PreviewConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewConfig not implemented")
+}
+func (UnimplementedConfigServer) CompareConfig(context.Context, *CompareConfigReq) (*CompareConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareConfig not implemented")
+}
+func (UnimplementedConfigServer) GenerateConfig(context.Context, *GenerateConfigReq) (*GenerateConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfig not implemented")
+}
+func (UnimplementedConfigServer) CheckConfig(context.Context, *CheckConfigReq) (*CheckConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConfig not implemented")
+}
+func (UnimplementedConfigServer) GetConfigDiff(context.Context, *GetConfigDiffReq) (*GetConfigDiffResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigDiff not implemented")
+}
+func (UnimplementedConfigServer) GetConfigView(context.Context, *GetConfigViewReq) (*GetConfigViewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigView not implemented")
+}
+func (UnimplementedConfigServer) OperateGenerateConfig(context.Context, *OperateGenerateConfigReq) (*OperateGenerateConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateGenerateConfig not implemented")
+}
+func (UnimplementedConfigServer) PushConfig(context.Context, *PushConfigReq) (*PushConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushConfig not implemented")
+}
+func (UnimplementedConfigServer) RepushDriftedConfig(context.Context, *RepushDriftedConfigReq) (*RepushDriftedConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepushDriftedConfig not implemented")
+}
+func (UnimplementedConfigServer) GetConfigRenderResult(context.Context, *GetConfigRenderResultReq) (*GetConfigRenderResultResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigRenderResult not implemented")
+}
+func (UnimplementedConfigServer) ListConfigTemplate(context.Context, *ListConfigTemplateReq) (*ListConfigTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigTemplate not implemented")
+}
+func (UnimplementedConfigServer) CreateConfigTemplate(context.Context, *CreateConfigTemplateReq) (*CreateConfigTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigTemplate not implemented")
+}
+func (UnimplementedConfigServer) UpdateConfigTemplate(context.Context, *UpdateConfigTemplateReq) (*UpdateConfigTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigTemplate not implemented")
+}
+func (UnimplementedConfigServer) GetConfigTemplate(context.Context, *GetConfigTemplateReq) (*GetConfigTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigTemplate not implemented")
+}
+func (UnimplementedConfigServer) ConfigTemplateVariable(context.Context, *ConfigTemplateVariableReq) (*ConfigTemplateVariableResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigTemplateVariable not implemented")
 }
-func (UnimplementedConfigServer) RetryClients(context.Context, *RetryClientsReq) (*RetryClientsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryClients not implemented")
+func (UnimplementedConfigServer) BindProcessInstance(context.Context, *BindProcessInstanceReq) (*BindProcessInstanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindProcessInstance not implemented")
 }
-func (UnimplementedConfigServer) ListClientQuerys(context.Context, *ListClientQuerysReq) (*ListClientQuerysResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuerys not implemented")
+func (UnimplementedConfigServer) PreviewBindProcessInstance(context.Context, *PreviewBindProcessInstanceReq) (*PreviewBindProcessInstanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewBindProcessInstance not implemented")
 }
-func (UnimplementedConfigServer) CreateClientQuery(context.Context, *CreateClientQueryReq) (*CreateClientQueryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateClientQuery not implemented")
+func (UnimplementedConfigServer) ProcessInstance(context.Context, *ProcessInstanceReq) (*ProcessInstanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessInstance not implemented")
 }
-func (UnimplementedConfigServer) UpdateClientQuery(context.Context, *UpdateClientQueryReq) (*UpdateClientQueryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientQuery not implemented")
+func (UnimplementedConfigServer) ServiceInstance(context.Context, *ServiceInstanceReq) (*ServiceInstanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceInstance not implemented")
 }
-func (UnimplementedConfigServer) DeleteClientQuery(context.Context, *DeleteClientQueryReq) (*DeleteClientQueryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientQuery not implemented")
+func (UnimplementedConfigServer) DeleteConfigTemplate(context.Context, *DeleteConfigTemplateReq) (*DeleteConfigTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigTemplate not implemented")
 }
-func (UnimplementedConfigServer) CheckClientQueryName(context.Context, *CheckClientQueryNameReq) (*CheckClientQueryNameResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckClientQueryName not implemented")
+func (UnimplementedConfigServer) GetProcessInstanceTopo(context.Context, *GetProcessInstanceTopoReq) (*GetProcessInstanceTopoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessInstanceTopo not implemented")
 }
-func (UnimplementedConfigServer) ClientConfigVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientConfigVersionStatistics not implemented")
+func (UnimplementedConfigServer) ManageConfigKV(context.Context, *ManageConfigKVReq) (*ManageConfigKVResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManageConfigKV not implemented")
 }
-func (UnimplementedConfigServer) ClientPullTrendStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientPullTrendStatistics not implemented")
+func (UnimplementedConfigServer) GetProcessConfigView(context.Context, *GetProcessConfigViewReq) (*GetProcessConfigViewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessConfigView not implemented")
 }
-func (UnimplementedConfigServer) ClientPullStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientPullStatistics not implemented")
+
+// UnsafeConfigServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigServer will
+// result in compilation errors.
+type UnsafeConfigServer interface {
+	mustEmbedUnimplementedConfigServer()
 }
-func (UnimplementedConfigServer) ClientLabelStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientLabelStatistics not implemented")
+
+func RegisterConfigServer(s grpc.ServiceRegistrar, srv ConfigServer) {
+	s.RegisterService(&Config_ServiceDesc, srv)
 }
-func (UnimplementedConfigServer) ClientAnnotationStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientAnnotationStatistics not implemented")
+
+func _Config_CreateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateApp(ctx, req.(*CreateAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientVersionStatistics not implemented")
+
+func _Config_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateApp(ctx, req.(*UpdateAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientLabelAndAnnotation not implemented")
+
+func _Config_DeleteApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteApp(ctx, req.(*DeleteAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientSpecificFailedReason not implemented")
+
+func _Config_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetApp(ctx, req.(*GetAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompareConfigItemConflicts not implemented")
+
+func _Config_GetAppByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppByNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetAppByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetAppByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetAppByName(ctx, req.(*GetAppByNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CompareKvConflicts(context.Context, *CompareKvConflictsReq) (*CompareKvConflictsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompareKvConflicts not implemented")
+
+func _Config_ListAppsRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppsRestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListAppsRest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListAppsRest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListAppsRest(ctx, req.(*ListAppsRestReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateAndNonTemplateCICount not implemented")
+
+func _Config_ListAppsBySpaceRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppsBySpaceRestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListAppsBySpaceRest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListAppsBySpaceRest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListAppsBySpaceRest(ctx, req.(*ListAppsBySpaceRestReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListProcess(context.Context, *ListProcessReq) (*ListProcessResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProcess not implemented")
+
+func _Config_CloneApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CloneApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CloneApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CloneApp(ctx, req.(*CloneAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListProcessInnerIPs(context.Context, *ListProcessInnerIPsReq) (*ListProcessInnerIPsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProcessInnerIPs not implemented")
+
+func _Config_CreateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateConfigItem(ctx, req.(*CreateConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) OperateProcess(context.Context, *OperateProcessReq) (*OperateProcessResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateProcess not implemented")
+
+func _Config_BatchUpsertConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BatchUpsertConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BatchUpsertConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BatchUpsertConfigItems(ctx, req.(*BatchUpsertConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ProcessFilterOptions(context.Context, *ProcessFilterOptionsReq) (*ProcessFilterOptionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessFilterOptions not implemented")
+
+func _Config_UpdateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateConfigItem(ctx, req.(*UpdateConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTaskBatch(context.Context, *ListTaskBatchReq) (*ListTaskBatchResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTaskBatch not implemented")
+
+func _Config_DeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteConfigItem(ctx, req.(*DeleteConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetTaskBatchDetail(context.Context, *GetTaskBatchDetailReq) (*GetTaskBatchDetailResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTaskBatchDetail not implemented")
+
+func _Config_BatchDeleteConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteAppResourcesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BatchDeleteConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BatchDeleteConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BatchDeleteConfigItems(ctx, req.(*BatchDeleteAppResourcesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) SyncCmdbGseStatus(context.Context, *SyncCmdbGseStatusReq) (*SyncCmdbGseStatusResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SyncCmdbGseStatus not implemented")
+
+func _Config_UnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnDeleteConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UnDeleteConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UnDeleteConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UnDeleteConfigItem(ctx, req.(*UnDeleteConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CmdbGseStatus(context.Context, *CmdbGseStatusReq) (*CmdbGseStatusResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CmdbGseStatus not implemented")
+
+func _Config_BatchUnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUnDeleteConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BatchUnDeleteConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BatchUnDeleteConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BatchUnDeleteConfigItem(ctx, req.(*BatchUnDeleteConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) RetryTasks(context.Context, *RetryTasksReq) (*RetryTasksResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryTasks not implemented")
+
+func _Config_UndoConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UndoConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UndoConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UndoConfigItem(ctx, req.(*UndoConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) BizTopo(context.Context, *BizTopoReq) (*BizTopoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BizTopo not implemented")
+
+func _Config_GetConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetConfigItem(ctx, req.(*GetConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ServiceTemplate(context.Context, *ServiceTemplateReq) (*ServiceTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceTemplate not implemented")
+
+func _Config_GetReleasedConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetReleasedConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetReleasedConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetReleasedConfigItem(ctx, req.(*GetReleasedConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ProcessTemplate(context.Context, *ProcessTemplateReq) (*ProcessTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessTemplate not implemented")
+
+func _Config_ListConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListConfigItems(ctx, req.(*ListConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListConfigInstances(context.Context, *ListConfigInstancesReq) (*ListConfigInstancesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigInstances not implemented")
+
+func _Config_ListReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListReleasedConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListReleasedConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListReleasedConfigItems(ctx, req.(*ListReleasedConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ConfigGenerateStatus(context.Context, *ConfigGenerateStatusReq) (*ConfigGenerateStatusResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfigGenerateStatus not implemented")
+
+func _Config_ListConfigItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListConfigItemCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListConfigItemCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListConfigItemCount(ctx, req.(*ListConfigItemCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) PreviewConfig(context.Context, *PreviewConfigReq) (*PreviewConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PreviewConfig not implemented")
+
+func _Config_ListConfigItemByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemByTupleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListConfigItemByTuple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListConfigItemByTuple_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListConfigItemByTuple(ctx, req.(*ListConfigItemByTupleReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CompareConfig(context.Context, *CompareConfigReq) (*CompareConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompareConfig not implemented")
+
+func _Config_GetReleasedKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedKvReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetReleasedKv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetReleasedKv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetReleasedKv(ctx, req.(*GetReleasedKvReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GenerateConfig(context.Context, *GenerateConfigReq) (*GenerateConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfig not implemented")
+
+func _Config_ListReleasedKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedKvsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListReleasedKvs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListReleasedKvs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListReleasedKvs(ctx, req.(*ListReleasedKvsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CheckConfig(context.Context, *CheckConfigReq) (*CheckConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckConfig not implemented")
+
+func _Config_ListAllReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllReleasedConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListAllReleasedConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListAllReleasedConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListAllReleasedConfigItems(ctx, req.(*ListAllReleasedConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetConfigDiff(context.Context, *GetConfigDiffReq) (*GetConfigDiffResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigDiff not implemented")
+
+func _Config_UpdateConfigHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateConfigHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateConfigHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateConfigHook(ctx, req.(*UpdateConfigHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetConfigView(context.Context, *GetConfigViewReq) (*GetConfigViewResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigView not implemented")
+
+func _Config_CreateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateRelease(ctx, req.(*CreateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) OperateGenerateConfig(context.Context, *OperateGenerateConfigReq) (*OperateGenerateConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateGenerateConfig not implemented")
+
+func _Config_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListReleases(ctx, req.(*ListReleasesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) PushConfig(context.Context, *PushConfigReq) (*PushConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PushConfig not implemented")
+
+func _Config_GetReleaseByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseByNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetReleaseByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetReleaseByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetReleaseByName(ctx, req.(*GetReleaseByNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetConfigRenderResult(context.Context, *GetConfigRenderResultReq) (*GetConfigRenderResultResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigRenderResult not implemented")
+
+func _Config_GetRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetRelease(ctx, req.(*GetReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListConfigTemplate(context.Context, *ListConfigTemplateReq) (*ListConfigTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigTemplate not implemented")
+
+func _Config_DeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeprecateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeprecateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeprecateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeprecateRelease(ctx, req.(*DeprecateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CreateConfigTemplate(context.Context, *CreateConfigTemplateReq) (*CreateConfigTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigTemplate not implemented")
+
+func _Config_UnDeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnDeprecateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UnDeprecateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UnDeprecateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UnDeprecateRelease(ctx, req.(*UnDeprecateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UpdateConfigTemplate(context.Context, *UpdateConfigTemplateReq) (*UpdateConfigTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigTemplate not implemented")
+
+func _Config_DeleteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteRelease(ctx, req.(*DeleteReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetConfigTemplate(context.Context, *GetConfigTemplateReq) (*GetConfigTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigTemplate not implemented")
+
+func _Config_CheckReleaseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckReleaseNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CheckReleaseName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CheckReleaseName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CheckReleaseName(ctx, req.(*CheckReleaseNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ConfigTemplateVariable(context.Context, *ConfigTemplateVariableReq) (*ConfigTemplateVariableResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfigTemplateVariable not implemented")
+
+func _Config_CreateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateHook(ctx, req.(*CreateHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) BindProcessInstance(context.Context, *BindProcessInstanceReq) (*BindProcessInstanceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BindProcessInstance not implemented")
+
+func _Config_DeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteHook(ctx, req.(*DeleteHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) PreviewBindProcessInstance(context.Context, *PreviewBindProcessInstanceReq) (*PreviewBindProcessInstanceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PreviewBindProcessInstance not implemented")
+
+func _Config_BatchDeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BatchDeleteHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BatchDeleteHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BatchDeleteHook(ctx, req.(*BatchDeleteHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ProcessInstance(context.Context, *ProcessInstanceReq) (*ProcessInstanceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessInstance not implemented")
+
+func _Config_UpdateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateHook(ctx, req.(*UpdateHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ServiceInstance(context.Context, *ServiceInstanceReq) (*ServiceInstanceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceInstance not implemented")
+
+func _Config_ListHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListHooks(ctx, req.(*ListHooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) DeleteConfigTemplate(context.Context, *DeleteConfigTemplateReq) (*DeleteConfigTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigTemplate not implemented")
+
+func _Config_ListHookTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListHookTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListHookTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListHookTags(ctx, req.(*ListHookTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetProcessInstanceTopo(context.Context, *GetProcessInstanceTopoReq) (*GetProcessInstanceTopoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProcessInstanceTopo not implemented")
+
+func _Config_GetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetHook(ctx, req.(*GetHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ManageConfigKV(context.Context, *ManageConfigKVReq) (*ManageConfigKVResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ManageConfigKV not implemented")
+
+func _Config_CreateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateHookRevision(ctx, req.(*CreateHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetProcessConfigView(context.Context, *GetProcessConfigViewReq) (*GetProcessConfigViewResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProcessConfigView not implemented")
+
+func _Config_ListHookRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListHookRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListHookRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListHookRevisions(ctx, req.(*ListHookRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeConfigServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ConfigServer will
-// result in compilation errors.
-type UnsafeConfigServer interface {
-	mustEmbedUnimplementedConfigServer()
+func _Config_DeleteHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteHookRevision(ctx, req.(*DeleteHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterConfigServer(s grpc.ServiceRegistrar, srv ConfigServer) {
-	s.RegisterService(&Config_ServiceDesc, srv)
+func _Config_PublishHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).PublishHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_PublishHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).PublishHookRevision(ctx, req.(*PublishHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAppReq)
+func _Config_TestHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestHookRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateApp(ctx, in)
+		return srv.(ConfigServer).TestHookRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateApp_FullMethodName,
+		FullMethod: Config_TestHookRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateApp(ctx, req.(*CreateAppReq))
+		return srv.(ConfigServer).TestHookRevision(ctx, req.(*TestHookRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppReq)
+func _Config_GetHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateApp(ctx, in)
+		return srv.(ConfigServer).GetHookRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateApp_FullMethodName,
+		FullMethod: Config_GetHookRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateApp(ctx, req.(*UpdateAppReq))
+		return srv.(ConfigServer).GetHookRevision(ctx, req.(*GetHookRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAppReq)
+func _Config_UpdateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHookRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteApp(ctx, in)
+		return srv.(ConfigServer).UpdateHookRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteApp_FullMethodName,
+		FullMethod: Config_UpdateHookRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteApp(ctx, req.(*DeleteAppReq))
+		return srv.(ConfigServer).UpdateHookRevision(ctx, req.(*UpdateHookRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppReq)
+func _Config_ListHookReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookReferencesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetApp(ctx, in)
+		return srv.(ConfigServer).ListHookReferences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetApp_FullMethodName,
+		FullMethod: Config_ListHookReferences_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetApp(ctx, req.(*GetAppReq))
+		return srv.(ConfigServer).ListHookReferences(ctx, req.(*ListHookReferencesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetAppByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppByNameReq)
+func _Config_ListHookRevisionReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookRevisionReferencesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetAppByName(ctx, in)
+		return srv.(ConfigServer).ListHookRevisionReferences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetAppByName_FullMethodName,
+		FullMethod: Config_ListHookRevisionReferences_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetAppByName(ctx, req.(*GetAppByNameReq))
+		return srv.(ConfigServer).ListHookRevisionReferences(ctx, req.(*ListHookRevisionReferencesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAppsRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppsRestReq)
+func _Config_GetReleaseHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseHookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAppsRest(ctx, in)
+		return srv.(ConfigServer).GetReleaseHook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAppsRest_FullMethodName,
+		FullMethod: Config_GetReleaseHook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAppsRest(ctx, req.(*ListAppsRestReq))
+		return srv.(ConfigServer).GetReleaseHook(ctx, req.(*GetReleaseHookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAppsBySpaceRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppsBySpaceRestReq)
+func _Config_CreateTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateSpaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAppsBySpaceRest(ctx, in)
+		return srv.(ConfigServer).CreateTemplateSpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAppsBySpaceRest_FullMethodName,
+		FullMethod: Config_CreateTemplateSpace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAppsBySpaceRest(ctx, req.(*ListAppsBySpaceRestReq))
+		return srv.(ConfigServer).CreateTemplateSpace(ctx, req.(*CreateTemplateSpaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CloneApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloneAppReq)
+func _Config_DeleteTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateSpaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CloneApp(ctx, in)
+		return srv.(ConfigServer).DeleteTemplateSpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CloneApp_FullMethodName,
+		FullMethod: Config_DeleteTemplateSpace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CloneApp(ctx, req.(*CloneAppReq))
+		return srv.(ConfigServer).DeleteTemplateSpace(ctx, req.(*DeleteTemplateSpaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConfigItemReq)
+func _Config_UpdateTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateSpaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateConfigItem(ctx, in)
+		return srv.(ConfigServer).UpdateTemplateSpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateConfigItem_FullMethodName,
+		FullMethod: Config_UpdateTemplateSpace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateConfigItem(ctx, req.(*CreateConfigItemReq))
+		return srv.(ConfigServer).UpdateTemplateSpace(ctx, req.(*UpdateTemplateSpaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchUpsertConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpsertConfigItemsReq)
+func _Config_ListTemplateSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSpacesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchUpsertConfigItems(ctx, in)
+		return srv.(ConfigServer).ListTemplateSpaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchUpsertConfigItems_FullMethodName,
+		FullMethod: Config_ListTemplateSpaces_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchUpsertConfigItems(ctx, req.(*BatchUpsertConfigItemsReq))
+		return srv.(ConfigServer).ListTemplateSpaces(ctx, req.(*ListTemplateSpacesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConfigItemReq)
+func _Config_ImportTemplateSpaceHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTemplateSpaceHooksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateConfigItem(ctx, in)
+		return srv.(ConfigServer).ImportTemplateSpaceHooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateConfigItem_FullMethodName,
+		FullMethod: Config_ImportTemplateSpaceHooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateConfigItem(ctx, req.(*UpdateConfigItemReq))
+		return srv.(ConfigServer).ImportTemplateSpaceHooks(ctx, req.(*ImportTemplateSpaceHooksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteConfigItemReq)
+func _Config_ListTemplateSpaceHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSpaceHooksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteConfigItem(ctx, in)
+		return srv.(ConfigServer).ListTemplateSpaceHooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteConfigItem_FullMethodName,
+		FullMethod: Config_ListTemplateSpaceHooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteConfigItem(ctx, req.(*DeleteConfigItemReq))
+		return srv.(ConfigServer).ListTemplateSpaceHooks(ctx, req.(*ListTemplateSpaceHooksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchDeleteConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchDeleteAppResourcesReq)
+func _Config_DeleteTemplateSpaceHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateSpaceHookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchDeleteConfigItems(ctx, in)
+		return srv.(ConfigServer).DeleteTemplateSpaceHook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchDeleteConfigItems_FullMethodName,
+		FullMethod: Config_DeleteTemplateSpaceHook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchDeleteConfigItems(ctx, req.(*BatchDeleteAppResourcesReq))
+		return srv.(ConfigServer).DeleteTemplateSpaceHook(ctx, req.(*DeleteTemplateSpaceHookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnDeleteConfigItemReq)
+func _Config_GetAllBizsOfTmplSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(base.EmptyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UnDeleteConfigItem(ctx, in)
+		return srv.(ConfigServer).GetAllBizsOfTmplSpaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UnDeleteConfigItem_FullMethodName,
+		FullMethod: Config_GetAllBizsOfTmplSpaces_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UnDeleteConfigItem(ctx, req.(*UnDeleteConfigItemReq))
+		return srv.(ConfigServer).GetAllBizsOfTmplSpaces(ctx, req.(*base.EmptyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchUnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUnDeleteConfigItemReq)
+func _Config_CreateDefaultTmplSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDefaultTmplSpaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchUnDeleteConfigItem(ctx, in)
+		return srv.(ConfigServer).CreateDefaultTmplSpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchUnDeleteConfigItem_FullMethodName,
+		FullMethod: Config_CreateDefaultTmplSpace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchUnDeleteConfigItem(ctx, req.(*BatchUnDeleteConfigItemReq))
+		return srv.(ConfigServer).CreateDefaultTmplSpace(ctx, req.(*CreateDefaultTmplSpaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UndoConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UndoConfigItemReq)
+func _Config_ListTmplSpacesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplSpacesByIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UndoConfigItem(ctx, in)
+		return srv.(ConfigServer).ListTmplSpacesByIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UndoConfigItem_FullMethodName,
+		FullMethod: Config_ListTmplSpacesByIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UndoConfigItem(ctx, req.(*UndoConfigItemReq))
+		return srv.(ConfigServer).ListTmplSpacesByIDs(ctx, req.(*ListTmplSpacesByIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigItemReq)
+func _Config_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetConfigItem(ctx, in)
+		return srv.(ConfigServer).CreateTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetConfigItem_FullMethodName,
+		FullMethod: Config_CreateTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetConfigItem(ctx, req.(*GetConfigItemReq))
+		return srv.(ConfigServer).CreateTemplate(ctx, req.(*CreateTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleasedConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedConfigItemReq)
+func _Config_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleasedConfigItem(ctx, in)
+		return srv.(ConfigServer).DeleteTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleasedConfigItem_FullMethodName,
+		FullMethod: Config_DeleteTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleasedConfigItem(ctx, req.(*GetReleasedConfigItemReq))
+		return srv.(ConfigServer).DeleteTemplate(ctx, req.(*DeleteTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemsReq)
+func _Config_BatchDeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListConfigItems(ctx, in)
+		return srv.(ConfigServer).BatchDeleteTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListConfigItems_FullMethodName,
+		FullMethod: Config_BatchDeleteTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListConfigItems(ctx, req.(*ListConfigItemsReq))
+		return srv.(ConfigServer).BatchDeleteTemplate(ctx, req.(*BatchDeleteTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedConfigItemsReq)
+func _Config_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListReleasedConfigItems(ctx, in)
+		return srv.(ConfigServer).UpdateTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListReleasedConfigItems_FullMethodName,
+		FullMethod: Config_UpdateTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListReleasedConfigItems(ctx, req.(*ListReleasedConfigItemsReq))
+		return srv.(ConfigServer).UpdateTemplate(ctx, req.(*UpdateTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListConfigItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemCountReq)
+func _Config_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListConfigItemCount(ctx, in)
+		return srv.(ConfigServer).ListTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListConfigItemCount_FullMethodName,
+		FullMethod: Config_ListTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListConfigItemCount(ctx, req.(*ListConfigItemCountReq))
+		return srv.(ConfigServer).ListTemplates(ctx, req.(*ListTemplatesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListConfigItemByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemByTupleReq)
+func _Config_BatchUpsertTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertTemplatesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListConfigItemByTuple(ctx, in)
+		return srv.(ConfigServer).BatchUpsertTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListConfigItemByTuple_FullMethodName,
+		FullMethod: Config_BatchUpsertTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListConfigItemByTuple(ctx, req.(*ListConfigItemByTupleReq))
+		return srv.(ConfigServer).BatchUpsertTemplates(ctx, req.(*BatchUpsertTemplatesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleasedKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedKvReq)
+func _Config_BatchUpdateTemplatePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTemplatePermissionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleasedKv(ctx, in)
+		return srv.(ConfigServer).BatchUpdateTemplatePermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleasedKv_FullMethodName,
+		FullMethod: Config_BatchUpdateTemplatePermissions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleasedKv(ctx, req.(*GetReleasedKvReq))
+		return srv.(ConfigServer).BatchUpdateTemplatePermissions(ctx, req.(*BatchUpdateTemplatePermissionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListReleasedKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedKvsReq)
+func _Config_AddTmplsToTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTmplsToTmplSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListReleasedKvs(ctx, in)
+		return srv.(ConfigServer).AddTmplsToTmplSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListReleasedKvs_FullMethodName,
+		FullMethod: Config_AddTmplsToTmplSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListReleasedKvs(ctx, req.(*ListReleasedKvsReq))
+		return srv.(ConfigServer).AddTmplsToTmplSets(ctx, req.(*AddTmplsToTmplSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAllReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllReleasedConfigItemsReq)
+func _Config_DeleteTmplsFromTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTmplsFromTmplSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAllReleasedConfigItems(ctx, in)
+		return srv.(ConfigServer).DeleteTmplsFromTmplSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAllReleasedConfigItems_FullMethodName,
+		FullMethod: Config_DeleteTmplsFromTmplSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAllReleasedConfigItems(ctx, req.(*ListAllReleasedConfigItemsReq))
+		return srv.(ConfigServer).DeleteTmplsFromTmplSets(ctx, req.(*DeleteTmplsFromTmplSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateConfigHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConfigHookReq)
+func _Config_ListTemplatesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesByIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateConfigHook(ctx, in)
+		return srv.(ConfigServer).ListTemplatesByIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateConfigHook_FullMethodName,
+		FullMethod: Config_ListTemplatesByIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateConfigHook(ctx, req.(*UpdateConfigHookReq))
+		return srv.(ConfigServer).ListTemplatesByIDs(ctx, req.(*ListTemplatesByIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReleaseReq)
+func _Config_ListTemplatesNotBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesNotBoundReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateRelease(ctx, in)
+		return srv.(ConfigServer).ListTemplatesNotBound(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateRelease_FullMethodName,
+		FullMethod: Config_ListTemplatesNotBound_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateRelease(ctx, req.(*CreateReleaseReq))
+		return srv.(ConfigServer).ListTemplatesNotBound(ctx, req.(*ListTemplatesNotBoundReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasesReq)
+func _Config_ListTemplateByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateByTupleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListReleases(ctx, in)
+		return srv.(ConfigServer).ListTemplateByTuple(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListReleases_FullMethodName,
+		FullMethod: Config_ListTemplateByTuple_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListReleases(ctx, req.(*ListReleasesReq))
+		return srv.(ConfigServer).ListTemplateByTuple(ctx, req.(*ListTemplateByTupleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleaseByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseByNameReq)
+func _Config_ListTmplsOfTmplSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplsOfTmplSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleaseByName(ctx, in)
+		return srv.(ConfigServer).ListTmplsOfTmplSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleaseByName_FullMethodName,
+		FullMethod: Config_ListTmplsOfTmplSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleaseByName(ctx, req.(*GetReleaseByNameReq))
+		return srv.(ConfigServer).ListTmplsOfTmplSet(ctx, req.(*ListTmplsOfTmplSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseReq)
+func _Config_ListTemplateSetsAndRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSetsAndRevisionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetRelease(ctx, in)
+		return srv.(ConfigServer).ListTemplateSetsAndRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetRelease_FullMethodName,
+		FullMethod: Config_ListTemplateSetsAndRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetRelease(ctx, req.(*GetReleaseReq))
+		return srv.(ConfigServer).ListTemplateSetsAndRevisions(ctx, req.(*ListTemplateSetsAndRevisionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeprecateReleaseReq)
+func _Config_CreateTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeprecateRelease(ctx, in)
+		return srv.(ConfigServer).CreateTemplateRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeprecateRelease_FullMethodName,
+		FullMethod: Config_CreateTemplateRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeprecateRelease(ctx, req.(*DeprecateReleaseReq))
+		return srv.(ConfigServer).CreateTemplateRevision(ctx, req.(*CreateTemplateRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UnDeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnDeprecateReleaseReq)
+func _Config_UpdateTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UnDeprecateRelease(ctx, in)
+		return srv.(ConfigServer).UpdateTemplateRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UnDeprecateRelease_FullMethodName,
+		FullMethod: Config_UpdateTemplateRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UnDeprecateRelease(ctx, req.(*UnDeprecateReleaseReq))
+		return srv.(ConfigServer).UpdateTemplateRevision(ctx, req.(*UpdateTemplateRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReleaseReq)
+func _Config_ListTemplateRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateRevisionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteRelease(ctx, in)
+		return srv.(ConfigServer).ListTemplateRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteRelease_FullMethodName,
+		FullMethod: Config_ListTemplateRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteRelease(ctx, req.(*DeleteReleaseReq))
+		return srv.(ConfigServer).ListTemplateRevisions(ctx, req.(*ListTemplateRevisionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CheckReleaseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckReleaseNameReq)
+func _Config_GetTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CheckReleaseName(ctx, in)
+		return srv.(ConfigServer).GetTemplateRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CheckReleaseName_FullMethodName,
+		FullMethod: Config_GetTemplateRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CheckReleaseName(ctx, req.(*CheckReleaseNameReq))
+		return srv.(ConfigServer).GetTemplateRevision(ctx, req.(*GetTemplateRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHookReq)
+func _Config_ListTemplateRevisionsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateRevisionsByIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateHook(ctx, in)
+		return srv.(ConfigServer).ListTemplateRevisionsByIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateHook_FullMethodName,
+		FullMethod: Config_ListTemplateRevisionsByIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateHook(ctx, req.(*CreateHookReq))
+		return srv.(ConfigServer).ListTemplateRevisionsByIDs(ctx, req.(*ListTemplateRevisionsByIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHookReq)
+func _Config_ListTmplRevisionNamesByTmplIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplRevisionNamesByTmplIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteHook(ctx, in)
+		return srv.(ConfigServer).ListTmplRevisionNamesByTmplIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteHook_FullMethodName,
+		FullMethod: Config_ListTmplRevisionNamesByTmplIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteHook(ctx, req.(*DeleteHookReq))
+		return srv.(ConfigServer).ListTmplRevisionNamesByTmplIDs(ctx, req.(*ListTmplRevisionNamesByTmplIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchDeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchDeleteHookReq)
+func _Config_CreateTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchDeleteHook(ctx, in)
+		return srv.(ConfigServer).CreateTemplateSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchDeleteHook_FullMethodName,
+		FullMethod: Config_CreateTemplateSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchDeleteHook(ctx, req.(*BatchDeleteHookReq))
+		return srv.(ConfigServer).CreateTemplateSet(ctx, req.(*CreateTemplateSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHookReq)
+func _Config_DeleteTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateHook(ctx, in)
+		return srv.(ConfigServer).DeleteTemplateSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateHook_FullMethodName,
+		FullMethod: Config_DeleteTemplateSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateHook(ctx, req.(*UpdateHookReq))
+		return srv.(ConfigServer).DeleteTemplateSet(ctx, req.(*DeleteTemplateSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHooksReq)
+func _Config_UpdateTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListHooks(ctx, in)
+		return srv.(ConfigServer).UpdateTemplateSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListHooks_FullMethodName,
+		FullMethod: Config_UpdateTemplateSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListHooks(ctx, req.(*ListHooksReq))
+		return srv.(ConfigServer).UpdateTemplateSet(ctx, req.(*UpdateTemplateSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
-
-func _Config_ListHookTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookTagsReq)
+
+func _Config_ListTemplateSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListHookTags(ctx, in)
+		return srv.(ConfigServer).ListTemplateSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListHookTags_FullMethodName,
+		FullMethod: Config_ListTemplateSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListHookTags(ctx, req.(*ListHookTagsReq))
+		return srv.(ConfigServer).ListTemplateSets(ctx, req.(*ListTemplateSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHookReq)
+func _Config_GetLatestTemplateVersionsInSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestTemplateVersionsInSpaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetHook(ctx, in)
+		return srv.(ConfigServer).GetLatestTemplateVersionsInSpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetHook_FullMethodName,
+		FullMethod: Config_GetLatestTemplateVersionsInSpace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetHook(ctx, req.(*GetHookReq))
+		return srv.(ConfigServer).GetLatestTemplateVersionsInSpace(ctx, req.(*GetLatestTemplateVersionsInSpaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHookRevisionReq)
+func _Config_ListAppTemplateSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppTemplateSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateHookRevision(ctx, in)
+		return srv.(ConfigServer).ListAppTemplateSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateHookRevision_FullMethodName,
+		FullMethod: Config_ListAppTemplateSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateHookRevision(ctx, req.(*CreateHookRevisionReq))
+		return srv.(ConfigServer).ListAppTemplateSets(ctx, req.(*ListAppTemplateSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListHookRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookRevisionsReq)
+func _Config_ListTemplateSetsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSetsByIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListHookRevisions(ctx, in)
+		return srv.(ConfigServer).ListTemplateSetsByIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListHookRevisions_FullMethodName,
+		FullMethod: Config_ListTemplateSetsByIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListHookRevisions(ctx, req.(*ListHookRevisionsReq))
+		return srv.(ConfigServer).ListTemplateSetsByIDs(ctx, req.(*ListTemplateSetsByIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHookRevisionReq)
+func _Config_ListTmplSetsOfBiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplSetsOfBizReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteHookRevision(ctx, in)
+		return srv.(ConfigServer).ListTmplSetsOfBiz(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteHookRevision_FullMethodName,
+		FullMethod: Config_ListTmplSetsOfBiz_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteHookRevision(ctx, req.(*DeleteHookRevisionReq))
+		return srv.(ConfigServer).ListTmplSetsOfBiz(ctx, req.(*ListTmplSetsOfBizReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_PublishHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishHookRevisionReq)
+func _Config_CreateAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppTemplateBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).PublishHookRevision(ctx, in)
+		return srv.(ConfigServer).CreateAppTemplateBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_PublishHookRevision_FullMethodName,
+		FullMethod: Config_CreateAppTemplateBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).PublishHookRevision(ctx, req.(*PublishHookRevisionReq))
+		return srv.(ConfigServer).CreateAppTemplateBinding(ctx, req.(*CreateAppTemplateBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHookRevisionReq)
+func _Config_DeleteAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppTemplateBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetHookRevision(ctx, in)
+		return srv.(ConfigServer).DeleteAppTemplateBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetHookRevision_FullMethodName,
+		FullMethod: Config_DeleteAppTemplateBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetHookRevision(ctx, req.(*GetHookRevisionReq))
+		return srv.(ConfigServer).DeleteAppTemplateBinding(ctx, req.(*DeleteAppTemplateBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHookRevisionReq)
+func _Config_UpdateAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppTemplateBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateHookRevision(ctx, in)
+		return srv.(ConfigServer).UpdateAppTemplateBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateHookRevision_FullMethodName,
+		FullMethod: Config_UpdateAppTemplateBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateHookRevision(ctx, req.(*UpdateHookRevisionReq))
+		return srv.(ConfigServer).UpdateAppTemplateBinding(ctx, req.(*UpdateAppTemplateBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListHookReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookReferencesReq)
+func _Config_ListAppTemplateBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppTemplateBindingsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListHookReferences(ctx, in)
+		return srv.(ConfigServer).ListAppTemplateBindings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListHookReferences_FullMethodName,
+		FullMethod: Config_ListAppTemplateBindings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListHookReferences(ctx, req.(*ListHookReferencesReq))
+		return srv.(ConfigServer).ListAppTemplateBindings(ctx, req.(*ListAppTemplateBindingsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListHookRevisionReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookRevisionReferencesReq)
+func _Config_ListAppBoundTmplRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppBoundTmplRevisionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListHookRevisionReferences(ctx, in)
+		return srv.(ConfigServer).ListAppBoundTmplRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListHookRevisionReferences_FullMethodName,
+		FullMethod: Config_ListAppBoundTmplRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListHookRevisionReferences(ctx, req.(*ListHookRevisionReferencesReq))
+		return srv.(ConfigServer).ListAppBoundTmplRevisions(ctx, req.(*ListAppBoundTmplRevisionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleaseHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseHookReq)
+func _Config_ListReleasedAppBoundTmplRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedAppBoundTmplRevisionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleaseHook(ctx, in)
+		return srv.(ConfigServer).ListReleasedAppBoundTmplRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleaseHook_FullMethodName,
+		FullMethod: Config_ListReleasedAppBoundTmplRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleaseHook(ctx, req.(*GetReleaseHookReq))
+		return srv.(ConfigServer).ListReleasedAppBoundTmplRevisions(ctx, req.(*ListReleasedAppBoundTmplRevisionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateSpaceReq)
+func _Config_GetReleasedAppBoundTmplRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedAppBoundTmplRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateTemplateSpace(ctx, in)
+		return srv.(ConfigServer).GetReleasedAppBoundTmplRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateTemplateSpace_FullMethodName,
+		FullMethod: Config_GetReleasedAppBoundTmplRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateTemplateSpace(ctx, req.(*CreateTemplateSpaceReq))
+		return srv.(ConfigServer).GetReleasedAppBoundTmplRevision(ctx, req.(*GetReleasedAppBoundTmplRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTemplateSpaceReq)
+func _Config_UpdateAppBoundTmplRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppBoundTmplRevisionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteTemplateSpace(ctx, in)
+		return srv.(ConfigServer).UpdateAppBoundTmplRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteTemplateSpace_FullMethodName,
+		FullMethod: Config_UpdateAppBoundTmplRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteTemplateSpace(ctx, req.(*DeleteTemplateSpaceReq))
+		return srv.(ConfigServer).UpdateAppBoundTmplRevisions(ctx, req.(*UpdateAppBoundTmplRevisionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateSpaceReq)
+func _Config_DeleteAppBoundTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppBoundTmplSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateTemplateSpace(ctx, in)
+		return srv.(ConfigServer).DeleteAppBoundTmplSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateTemplateSpace_FullMethodName,
+		FullMethod: Config_DeleteAppBoundTmplSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateTemplateSpace(ctx, req.(*UpdateTemplateSpaceReq))
+		return srv.(ConfigServer).DeleteAppBoundTmplSets(ctx, req.(*DeleteAppBoundTmplSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplateSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateSpacesReq)
+func _Config_RemoveAppBoundTmplSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAppBoundTmplSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplateSpaces(ctx, in)
+		return srv.(ConfigServer).RemoveAppBoundTmplSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplateSpaces_FullMethodName,
+		FullMethod: Config_RemoveAppBoundTmplSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplateSpaces(ctx, req.(*ListTemplateSpacesReq))
+		return srv.(ConfigServer).RemoveAppBoundTmplSet(ctx, req.(*RemoveAppBoundTmplSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetAllBizsOfTmplSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(base.EmptyReq)
+func _Config_CheckAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAppTemplateBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetAllBizsOfTmplSpaces(ctx, in)
+		return srv.(ConfigServer).CheckAppTemplateBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetAllBizsOfTmplSpaces_FullMethodName,
+		FullMethod: Config_CheckAppTemplateBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetAllBizsOfTmplSpaces(ctx, req.(*base.EmptyReq))
+		return srv.(ConfigServer).CheckAppTemplateBinding(ctx, req.(*CheckAppTemplateBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateDefaultTmplSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDefaultTmplSpaceReq)
+func _Config_ImportFromTemplateSetToApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFromTemplateSetToAppReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateDefaultTmplSpace(ctx, in)
+		return srv.(ConfigServer).ImportFromTemplateSetToApp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateDefaultTmplSpace_FullMethodName,
+		FullMethod: Config_ImportFromTemplateSetToApp_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateDefaultTmplSpace(ctx, req.(*CreateDefaultTmplSpaceReq))
+		return srv.(ConfigServer).ImportFromTemplateSetToApp(ctx, req.(*ImportFromTemplateSetToAppReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplSpacesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplSpacesByIDsReq)
+func _Config_ListTmplBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplBoundCountsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplSpacesByIDs(ctx, in)
+		return srv.(ConfigServer).ListTmplBoundCounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplSpacesByIDs_FullMethodName,
+		FullMethod: Config_ListTmplBoundCounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplSpacesByIDs(ctx, req.(*ListTmplSpacesByIDsReq))
+		return srv.(ConfigServer).ListTmplBoundCounts(ctx, req.(*ListTmplBoundCountsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateReq)
+func _Config_ListTmplRevisionBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplRevisionBoundCountsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateTemplate(ctx, in)
+		return srv.(ConfigServer).ListTmplRevisionBoundCounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateTemplate_FullMethodName,
+		FullMethod: Config_ListTmplRevisionBoundCounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateTemplate(ctx, req.(*CreateTemplateReq))
+		return srv.(ConfigServer).ListTmplRevisionBoundCounts(ctx, req.(*ListTmplRevisionBoundCountsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTemplateReq)
+func _Config_ListTmplSetBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplSetBoundCountsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteTemplate(ctx, in)
+		return srv.(ConfigServer).ListTmplSetBoundCounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteTemplate_FullMethodName,
+		FullMethod: Config_ListTmplSetBoundCounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteTemplate(ctx, req.(*DeleteTemplateReq))
+		return srv.(ConfigServer).ListTmplSetBoundCounts(ctx, req.(*ListTmplSetBoundCountsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchDeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchDeleteTemplateReq)
+func _Config_ListTmplBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplBoundUnnamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchDeleteTemplate(ctx, in)
+		return srv.(ConfigServer).ListTmplBoundUnnamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchDeleteTemplate_FullMethodName,
+		FullMethod: Config_ListTmplBoundUnnamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchDeleteTemplate(ctx, req.(*BatchDeleteTemplateReq))
+		return srv.(ConfigServer).ListTmplBoundUnnamedApps(ctx, req.(*ListTmplBoundUnnamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateReq)
+func _Config_ListTmplBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplBoundNamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateTemplate(ctx, in)
+		return srv.(ConfigServer).ListTmplBoundNamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateTemplate_FullMethodName,
+		FullMethod: Config_ListTmplBoundNamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateTemplate(ctx, req.(*UpdateTemplateReq))
+		return srv.(ConfigServer).ListTmplBoundNamedApps(ctx, req.(*ListTmplBoundNamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplatesReq)
+func _Config_ListTmplBoundTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplBoundTmplSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplates(ctx, in)
+		return srv.(ConfigServer).ListTmplBoundTmplSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplates_FullMethodName,
+		FullMethod: Config_ListTmplBoundTmplSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplates(ctx, req.(*ListTemplatesReq))
+		return srv.(ConfigServer).ListTmplBoundTmplSets(ctx, req.(*ListTmplBoundTmplSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchUpsertTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpsertTemplatesReq)
+func _Config_ListMultiTmplBoundTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMultiTmplBoundTmplSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchUpsertTemplates(ctx, in)
+		return srv.(ConfigServer).ListMultiTmplBoundTmplSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchUpsertTemplates_FullMethodName,
+		FullMethod: Config_ListMultiTmplBoundTmplSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchUpsertTemplates(ctx, req.(*BatchUpsertTemplatesReq))
+		return srv.(ConfigServer).ListMultiTmplBoundTmplSets(ctx, req.(*ListMultiTmplBoundTmplSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchUpdateTemplatePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpdateTemplatePermissionsReq)
+func _Config_ListTmplRevisionBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplRevisionBoundUnnamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchUpdateTemplatePermissions(ctx, in)
+		return srv.(ConfigServer).ListTmplRevisionBoundUnnamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchUpdateTemplatePermissions_FullMethodName,
+		FullMethod: Config_ListTmplRevisionBoundUnnamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchUpdateTemplatePermissions(ctx, req.(*BatchUpdateTemplatePermissionsReq))
+		return srv.(ConfigServer).ListTmplRevisionBoundUnnamedApps(ctx, req.(*ListTmplRevisionBoundUnnamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_AddTmplsToTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTmplsToTmplSetsReq)
+func _Config_ListTmplRevisionBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplRevisionBoundNamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).AddTmplsToTmplSets(ctx, in)
+		return srv.(ConfigServer).ListTmplRevisionBoundNamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_AddTmplsToTmplSets_FullMethodName,
+		FullMethod: Config_ListTmplRevisionBoundNamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).AddTmplsToTmplSets(ctx, req.(*AddTmplsToTmplSetsReq))
+		return srv.(ConfigServer).ListTmplRevisionBoundNamedApps(ctx, req.(*ListTmplRevisionBoundNamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteTmplsFromTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTmplsFromTmplSetsReq)
+func _Config_ListTmplSetBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplSetBoundUnnamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteTmplsFromTmplSets(ctx, in)
+		return srv.(ConfigServer).ListTmplSetBoundUnnamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteTmplsFromTmplSets_FullMethodName,
+		FullMethod: Config_ListTmplSetBoundUnnamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteTmplsFromTmplSets(ctx, req.(*DeleteTmplsFromTmplSetsReq))
+		return srv.(ConfigServer).ListTmplSetBoundUnnamedApps(ctx, req.(*ListTmplSetBoundUnnamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplatesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplatesByIDsReq)
+func _Config_ListMultiTmplSetBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMultiTmplSetBoundUnnamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplatesByIDs(ctx, in)
+		return srv.(ConfigServer).ListMultiTmplSetBoundUnnamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplatesByIDs_FullMethodName,
+		FullMethod: Config_ListMultiTmplSetBoundUnnamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplatesByIDs(ctx, req.(*ListTemplatesByIDsReq))
+		return srv.(ConfigServer).ListMultiTmplSetBoundUnnamedApps(ctx, req.(*ListMultiTmplSetBoundUnnamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplatesNotBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplatesNotBoundReq)
+func _Config_CheckTemplateSetReferencesApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTemplateSetReferencesAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplatesNotBound(ctx, in)
+		return srv.(ConfigServer).CheckTemplateSetReferencesApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplatesNotBound_FullMethodName,
+		FullMethod: Config_CheckTemplateSetReferencesApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplatesNotBound(ctx, req.(*ListTemplatesNotBoundReq))
+		return srv.(ConfigServer).CheckTemplateSetReferencesApps(ctx, req.(*CheckTemplateSetReferencesAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplateByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateByTupleReq)
+func _Config_ListTmplSetBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplSetBoundNamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplateByTuple(ctx, in)
+		return srv.(ConfigServer).ListTmplSetBoundNamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplateByTuple_FullMethodName,
+		FullMethod: Config_ListTmplSetBoundNamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplateByTuple(ctx, req.(*ListTemplateByTupleReq))
+		return srv.(ConfigServer).ListTmplSetBoundNamedApps(ctx, req.(*ListTmplSetBoundNamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplsOfTmplSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplsOfTmplSetReq)
+func _Config_ListLatestTmplBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLatestTmplBoundUnnamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplsOfTmplSet(ctx, in)
+		return srv.(ConfigServer).ListLatestTmplBoundUnnamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplsOfTmplSet_FullMethodName,
+		FullMethod: Config_ListLatestTmplBoundUnnamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplsOfTmplSet(ctx, req.(*ListTmplsOfTmplSetReq))
+		return srv.(ConfigServer).ListLatestTmplBoundUnnamedApps(ctx, req.(*ListLatestTmplBoundUnnamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplateSetsAndRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateSetsAndRevisionsReq)
+func _Config_CreateTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateVariableReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplateSetsAndRevisions(ctx, in)
+		return srv.(ConfigServer).CreateTemplateVariable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplateSetsAndRevisions_FullMethodName,
+		FullMethod: Config_CreateTemplateVariable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplateSetsAndRevisions(ctx, req.(*ListTemplateSetsAndRevisionsReq))
+		return srv.(ConfigServer).CreateTemplateVariable(ctx, req.(*CreateTemplateVariableReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateRevisionReq)
+func _Config_DeleteTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateVariableReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateTemplateRevision(ctx, in)
+		return srv.(ConfigServer).DeleteTemplateVariable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateTemplateRevision_FullMethodName,
+		FullMethod: Config_DeleteTemplateVariable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateTemplateRevision(ctx, req.(*CreateTemplateRevisionReq))
+		return srv.(ConfigServer).DeleteTemplateVariable(ctx, req.(*DeleteTemplateVariableReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateRevisionReq)
+func _Config_BatchDeleteTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteBizResourcesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateTemplateRevision(ctx, in)
+		return srv.(ConfigServer).BatchDeleteTemplateVariable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateTemplateRevision_FullMethodName,
+		FullMethod: Config_BatchDeleteTemplateVariable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateTemplateRevision(ctx, req.(*UpdateTemplateRevisionReq))
+		return srv.(ConfigServer).BatchDeleteTemplateVariable(ctx, req.(*BatchDeleteBizResourcesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplateRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateRevisionsReq)
+func _Config_UpdateTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateVariableReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplateRevisions(ctx, in)
+		return srv.(ConfigServer).UpdateTemplateVariable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplateRevisions_FullMethodName,
+		FullMethod: Config_UpdateTemplateVariable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplateRevisions(ctx, req.(*ListTemplateRevisionsReq))
+		return srv.(ConfigServer).UpdateTemplateVariable(ctx, req.(*UpdateTemplateVariableReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTemplateRevisionReq)
+func _Config_ListTemplateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetTemplateRevision(ctx, in)
+		return srv.(ConfigServer).ListTemplateVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetTemplateRevision_FullMethodName,
+		FullMethod: Config_ListTemplateVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetTemplateRevision(ctx, req.(*GetTemplateRevisionReq))
+		return srv.(ConfigServer).ListTemplateVariables(ctx, req.(*ListTemplateVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplateRevisionsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateRevisionsByIDsReq)
+func _Config_ImportTemplateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTemplateVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplateRevisionsByIDs(ctx, in)
+		return srv.(ConfigServer).ImportTemplateVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplateRevisionsByIDs_FullMethodName,
+		FullMethod: Config_ImportTemplateVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplateRevisionsByIDs(ctx, req.(*ListTemplateRevisionsByIDsReq))
+		return srv.(ConfigServer).ImportTemplateVariables(ctx, req.(*ImportTemplateVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplRevisionNamesByTmplIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplRevisionNamesByTmplIDsReq)
+func _Config_ImportOtherFormatTemplateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOtherFormatTemplateVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplRevisionNamesByTmplIDs(ctx, in)
+		return srv.(ConfigServer).ImportOtherFormatTemplateVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplRevisionNamesByTmplIDs_FullMethodName,
+		FullMethod: Config_ImportOtherFormatTemplateVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplRevisionNamesByTmplIDs(ctx, req.(*ListTmplRevisionNamesByTmplIDsReq))
+		return srv.(ConfigServer).ImportOtherFormatTemplateVariables(ctx, req.(*ImportOtherFormatTemplateVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateSetReq)
+func _Config_ExtractAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractAppTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateTemplateSet(ctx, in)
+		return srv.(ConfigServer).ExtractAppTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateTemplateSet_FullMethodName,
+		FullMethod: Config_ExtractAppTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateTemplateSet(ctx, req.(*CreateTemplateSetReq))
+		return srv.(ConfigServer).ExtractAppTmplVariables(ctx, req.(*ExtractAppTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTemplateSetReq)
+func _Config_GetAppTmplVariableRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppTmplVariableRefsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteTemplateSet(ctx, in)
+		return srv.(ConfigServer).GetAppTmplVariableRefs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteTemplateSet_FullMethodName,
+		FullMethod: Config_GetAppTmplVariableRefs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteTemplateSet(ctx, req.(*DeleteTemplateSetReq))
+		return srv.(ConfigServer).GetAppTmplVariableRefs(ctx, req.(*GetAppTmplVariableRefsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateSetReq)
+func _Config_GetReleasedAppTmplVariableRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedAppTmplVariableRefsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateTemplateSet(ctx, in)
+		return srv.(ConfigServer).GetReleasedAppTmplVariableRefs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateTemplateSet_FullMethodName,
+		FullMethod: Config_GetReleasedAppTmplVariableRefs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateTemplateSet(ctx, req.(*UpdateTemplateSetReq))
+		return srv.(ConfigServer).GetReleasedAppTmplVariableRefs(ctx, req.(*GetReleasedAppTmplVariableRefsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplateSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateSetsReq)
+func _Config_UpdateAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplateSets(ctx, in)
+		return srv.(ConfigServer).UpdateAppTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplateSets_FullMethodName,
+		FullMethod: Config_UpdateAppTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplateSets(ctx, req.(*ListTemplateSetsReq))
+		return srv.(ConfigServer).UpdateAppTmplVariables(ctx, req.(*UpdateAppTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetLatestTemplateVersionsInSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLatestTemplateVersionsInSpaceReq)
+func _Config_ListAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetLatestTemplateVersionsInSpace(ctx, in)
+		return srv.(ConfigServer).ListAppTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetLatestTemplateVersionsInSpace_FullMethodName,
+		FullMethod: Config_ListAppTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetLatestTemplateVersionsInSpace(ctx, req.(*GetLatestTemplateVersionsInSpaceReq))
+		return srv.(ConfigServer).ListAppTmplVariables(ctx, req.(*ListAppTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAppTemplateSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppTemplateSetsReq)
+func _Config_ListReleasedAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedAppTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAppTemplateSets(ctx, in)
+		return srv.(ConfigServer).ListReleasedAppTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAppTemplateSets_FullMethodName,
+		FullMethod: Config_ListReleasedAppTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAppTemplateSets(ctx, req.(*ListAppTemplateSetsReq))
+		return srv.(ConfigServer).ListReleasedAppTmplVariables(ctx, req.(*ListReleasedAppTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplateSetsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateSetsByIDsReq)
+func _Config_UpdateGroupTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplateSetsByIDs(ctx, in)
+		return srv.(ConfigServer).UpdateGroupTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplateSetsByIDs_FullMethodName,
+		FullMethod: Config_UpdateGroupTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplateSetsByIDs(ctx, req.(*ListTemplateSetsByIDsReq))
+		return srv.(ConfigServer).UpdateGroupTmplVariables(ctx, req.(*UpdateGroupTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplSetsOfBiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplSetsOfBizReq)
+func _Config_ListGroupTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplSetsOfBiz(ctx, in)
+		return srv.(ConfigServer).ListGroupTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplSetsOfBiz_FullMethodName,
+		FullMethod: Config_ListGroupTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplSetsOfBiz(ctx, req.(*ListTmplSetsOfBizReq))
+		return srv.(ConfigServer).ListGroupTmplVariables(ctx, req.(*ListGroupTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAppTemplateBindingReq)
+func _Config_TraceAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceAppTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateAppTemplateBinding(ctx, in)
+		return srv.(ConfigServer).TraceAppTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateAppTemplateBinding_FullMethodName,
+		FullMethod: Config_TraceAppTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateAppTemplateBinding(ctx, req.(*CreateAppTemplateBindingReq))
+		return srv.(ConfigServer).TraceAppTmplVariables(ctx, req.(*TraceAppTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAppTemplateBindingReq)
+func _Config_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteAppTemplateBinding(ctx, in)
+		return srv.(ConfigServer).CreateGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteAppTemplateBinding_FullMethodName,
+		FullMethod: Config_CreateGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteAppTemplateBinding(ctx, req.(*DeleteAppTemplateBindingReq))
+		return srv.(ConfigServer).CreateGroup(ctx, req.(*CreateGroupReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppTemplateBindingReq)
+func _Config_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateAppTemplateBinding(ctx, in)
+		return srv.(ConfigServer).DeleteGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateAppTemplateBinding_FullMethodName,
+		FullMethod: Config_DeleteGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateAppTemplateBinding(ctx, req.(*UpdateAppTemplateBindingReq))
+		return srv.(ConfigServer).DeleteGroup(ctx, req.(*DeleteGroupReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAppTemplateBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppTemplateBindingsReq)
+func _Config_BatchDeleteGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteBizResourcesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAppTemplateBindings(ctx, in)
+		return srv.(ConfigServer).BatchDeleteGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAppTemplateBindings_FullMethodName,
+		FullMethod: Config_BatchDeleteGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAppTemplateBindings(ctx, req.(*ListAppTemplateBindingsReq))
+		return srv.(ConfigServer).BatchDeleteGroups(ctx, req.(*BatchDeleteBizResourcesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAppBoundTmplRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppBoundTmplRevisionsReq)
+func _Config_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAppBoundTmplRevisions(ctx, in)
+		return srv.(ConfigServer).UpdateGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAppBoundTmplRevisions_FullMethodName,
+		FullMethod: Config_UpdateGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAppBoundTmplRevisions(ctx, req.(*ListAppBoundTmplRevisionsReq))
+		return srv.(ConfigServer).UpdateGroup(ctx, req.(*UpdateGroupReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListReleasedAppBoundTmplRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedAppBoundTmplRevisionsReq)
+func _Config_ListAllGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllGroupsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListReleasedAppBoundTmplRevisions(ctx, in)
+		return srv.(ConfigServer).ListAllGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListReleasedAppBoundTmplRevisions_FullMethodName,
+		FullMethod: Config_ListAllGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListReleasedAppBoundTmplRevisions(ctx, req.(*ListReleasedAppBoundTmplRevisionsReq))
+		return srv.(ConfigServer).ListAllGroups(ctx, req.(*ListAllGroupsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleasedAppBoundTmplRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedAppBoundTmplRevisionReq)
+func _Config_ListAppGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppGroupsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleasedAppBoundTmplRevision(ctx, in)
+		return srv.(ConfigServer).ListAppGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleasedAppBoundTmplRevision_FullMethodName,
+		FullMethod: Config_ListAppGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleasedAppBoundTmplRevision(ctx, req.(*GetReleasedAppBoundTmplRevisionReq))
+		return srv.(ConfigServer).ListAppGroups(ctx, req.(*ListAppGroupsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateAppBoundTmplRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppBoundTmplRevisionsReq)
+func _Config_ListGroupReleasedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupReleasedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateAppBoundTmplRevisions(ctx, in)
+		return srv.(ConfigServer).ListGroupReleasedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateAppBoundTmplRevisions_FullMethodName,
+		FullMethod: Config_ListGroupReleasedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateAppBoundTmplRevisions(ctx, req.(*UpdateAppBoundTmplRevisionsReq))
+		return srv.(ConfigServer).ListGroupReleasedApps(ctx, req.(*ListGroupReleasedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteAppBoundTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAppBoundTmplSetsReq)
+func _Config_GetGroupByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupByNameReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteAppBoundTmplSets(ctx, in)
+		return srv.(ConfigServer).GetGroupByName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteAppBoundTmplSets_FullMethodName,
+		FullMethod: Config_GetGroupByName_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteAppBoundTmplSets(ctx, req.(*DeleteAppBoundTmplSetsReq))
+		return srv.(ConfigServer).GetGroupByName(ctx, req.(*GetGroupByNameReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_RemoveAppBoundTmplSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveAppBoundTmplSetReq)
+func _Config_ListGroupSelector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupSelectorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).RemoveAppBoundTmplSet(ctx, in)
+		return srv.(ConfigServer).ListGroupSelector(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_RemoveAppBoundTmplSet_FullMethodName,
+		FullMethod: Config_ListGroupSelector_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).RemoveAppBoundTmplSet(ctx, req.(*RemoveAppBoundTmplSetReq))
+		return srv.(ConfigServer).ListGroupSelector(ctx, req.(*ListGroupSelectorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CheckAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckAppTemplateBindingReq)
+func _Config_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CheckAppTemplateBinding(ctx, in)
+		return srv.(ConfigServer).Publish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CheckAppTemplateBinding_FullMethodName,
+		FullMethod: Config_Publish_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CheckAppTemplateBinding(ctx, req.(*CheckAppTemplateBindingReq))
+		return srv.(ConfigServer).Publish(ctx, req.(*PublishReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ImportFromTemplateSetToApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportFromTemplateSetToAppReq)
+func _Config_GenerateReleaseAndPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateReleaseAndPublishReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ImportFromTemplateSetToApp(ctx, in)
+		return srv.(ConfigServer).GenerateReleaseAndPublish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ImportFromTemplateSetToApp_FullMethodName,
+		FullMethod: Config_GenerateReleaseAndPublish_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ImportFromTemplateSetToApp(ctx, req.(*ImportFromTemplateSetToAppReq))
+		return srv.(ConfigServer).GenerateReleaseAndPublish(ctx, req.(*GenerateReleaseAndPublishReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplBoundCountsReq)
+func _Config_SubmitPublishApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitPublishApproveReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplBoundCounts(ctx, in)
+		return srv.(ConfigServer).SubmitPublishApprove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplBoundCounts_FullMethodName,
+		FullMethod: Config_SubmitPublishApprove_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplBoundCounts(ctx, req.(*ListTmplBoundCountsReq))
+		return srv.(ConfigServer).SubmitPublishApprove(ctx, req.(*SubmitPublishApproveReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplRevisionBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplRevisionBoundCountsReq)
+func _Config_RollbackRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackReleaseReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplRevisionBoundCounts(ctx, in)
+		return srv.(ConfigServer).RollbackRelease(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplRevisionBoundCounts_FullMethodName,
+		FullMethod: Config_RollbackRelease_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplRevisionBoundCounts(ctx, req.(*ListTmplRevisionBoundCountsReq))
+		return srv.(ConfigServer).RollbackRelease(ctx, req.(*RollbackReleaseReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplSetBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplSetBoundCountsReq)
+func _Config_PinGroupRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinGroupReleaseReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplSetBoundCounts(ctx, in)
+		return srv.(ConfigServer).PinGroupRelease(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplSetBoundCounts_FullMethodName,
+		FullMethod: Config_PinGroupRelease_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplSetBoundCounts(ctx, req.(*ListTmplSetBoundCountsReq))
+		return srv.(ConfigServer).PinGroupRelease(ctx, req.(*PinGroupReleaseReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplBoundUnnamedAppsReq)
+func _Config_UnpinGroupRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinGroupReleaseReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplBoundUnnamedApps(ctx, in)
+		return srv.(ConfigServer).UnpinGroupRelease(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplBoundUnnamedApps_FullMethodName,
+		FullMethod: Config_UnpinGroupRelease_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplBoundUnnamedApps(ctx, req.(*ListTmplBoundUnnamedAppsReq))
+		return srv.(ConfigServer).UnpinGroupRelease(ctx, req.(*UnpinGroupReleaseReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplBoundNamedAppsReq)
+func _Config_UpdateReleaseTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReleaseTagsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplBoundNamedApps(ctx, in)
+		return srv.(ConfigServer).UpdateReleaseTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplBoundNamedApps_FullMethodName,
+		FullMethod: Config_UpdateReleaseTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplBoundNamedApps(ctx, req.(*ListTmplBoundNamedAppsReq))
+		return srv.(ConfigServer).UpdateReleaseTags(ctx, req.(*UpdateReleaseTagsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplBoundTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplBoundTmplSetsReq)
+func _Config_PromoteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteReleaseReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplBoundTmplSets(ctx, in)
+		return srv.(ConfigServer).PromoteRelease(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplBoundTmplSets_FullMethodName,
+		FullMethod: Config_PromoteRelease_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplBoundTmplSets(ctx, req.(*ListTmplBoundTmplSetsReq))
+		return srv.(ConfigServer).PromoteRelease(ctx, req.(*PromoteReleaseReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListMultiTmplBoundTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMultiTmplBoundTmplSetsReq)
+func _Config_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListMultiTmplBoundTmplSets(ctx, in)
+		return srv.(ConfigServer).Approve(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListMultiTmplBoundTmplSets_FullMethodName,
+		FullMethod: Config_Approve_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListMultiTmplBoundTmplSets(ctx, req.(*ListMultiTmplBoundTmplSetsReq))
+		return srv.(ConfigServer).Approve(ctx, req.(*ApproveReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplRevisionBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplRevisionBoundUnnamedAppsReq)
+func _Config_ApprovalCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovalCallbackReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplRevisionBoundUnnamedApps(ctx, in)
+		return srv.(ConfigServer).ApprovalCallback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplRevisionBoundUnnamedApps_FullMethodName,
+		FullMethod: Config_ApprovalCallback_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplRevisionBoundUnnamedApps(ctx, req.(*ListTmplRevisionBoundUnnamedAppsReq))
+		return srv.(ConfigServer).ApprovalCallback(ctx, req.(*ApprovalCallbackReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplRevisionBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplRevisionBoundNamedAppsReq)
+func _Config_GetLastSelect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastSelectReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplRevisionBoundNamedApps(ctx, in)
+		return srv.(ConfigServer).GetLastSelect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplRevisionBoundNamedApps_FullMethodName,
+		FullMethod: Config_GetLastSelect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplRevisionBoundNamedApps(ctx, req.(*ListTmplRevisionBoundNamedAppsReq))
+		return srv.(ConfigServer).GetLastSelect(ctx, req.(*GetLastSelectReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplSetBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplSetBoundUnnamedAppsReq)
+func _Config_GetLastPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastPublishReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplSetBoundUnnamedApps(ctx, in)
+		return srv.(ConfigServer).GetLastPublish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplSetBoundUnnamedApps_FullMethodName,
+		FullMethod: Config_GetLastPublish_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplSetBoundUnnamedApps(ctx, req.(*ListTmplSetBoundUnnamedAppsReq))
+		return srv.(ConfigServer).GetLastPublish(ctx, req.(*GetLastPublishReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListMultiTmplSetBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMultiTmplSetBoundUnnamedAppsReq)
+func _Config_GetReleasesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasesStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListMultiTmplSetBoundUnnamedApps(ctx, in)
+		return srv.(ConfigServer).GetReleasesStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListMultiTmplSetBoundUnnamedApps_FullMethodName,
+		FullMethod: Config_GetReleasesStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListMultiTmplSetBoundUnnamedApps(ctx, req.(*ListMultiTmplSetBoundUnnamedAppsReq))
+		return srv.(ConfigServer).GetReleasesStatus(ctx, req.(*GetReleasesStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CheckTemplateSetReferencesApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckTemplateSetReferencesAppsReq)
+func _Config_ListAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CheckTemplateSetReferencesApps(ctx, in)
+		return srv.(ConfigServer).ListAudits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CheckTemplateSetReferencesApps_FullMethodName,
+		FullMethod: Config_ListAudits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CheckTemplateSetReferencesApps(ctx, req.(*CheckTemplateSetReferencesAppsReq))
+		return srv.(ConfigServer).ListAudits(ctx, req.(*ListAuditsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTmplSetBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplSetBoundNamedAppsReq)
+func _Config_CreateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTmplSetBoundNamedApps(ctx, in)
+		return srv.(ConfigServer).CreateCredentials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTmplSetBoundNamedApps_FullMethodName,
+		FullMethod: Config_CreateCredentials_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTmplSetBoundNamedApps(ctx, req.(*ListTmplSetBoundNamedAppsReq))
+		return srv.(ConfigServer).CreateCredentials(ctx, req.(*CreateCredentialReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListLatestTmplBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLatestTmplBoundUnnamedAppsReq)
+func _Config_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListLatestTmplBoundUnnamedApps(ctx, in)
+		return srv.(ConfigServer).ListCredentials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListLatestTmplBoundUnnamedApps_FullMethodName,
+		FullMethod: Config_ListCredentials_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListLatestTmplBoundUnnamedApps(ctx, req.(*ListLatestTmplBoundUnnamedAppsReq))
+		return srv.(ConfigServer).ListCredentials(ctx, req.(*ListCredentialsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateVariableReq)
+func _Config_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateTemplateVariable(ctx, in)
+		return srv.(ConfigServer).DeleteCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateTemplateVariable_FullMethodName,
+		FullMethod: Config_DeleteCredential_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateTemplateVariable(ctx, req.(*CreateTemplateVariableReq))
+		return srv.(ConfigServer).DeleteCredential(ctx, req.(*DeleteCredentialsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTemplateVariableReq)
+func _Config_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteTemplateVariable(ctx, in)
+		return srv.(ConfigServer).UpdateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteTemplateVariable_FullMethodName,
+		FullMethod: Config_UpdateCredential_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteTemplateVariable(ctx, req.(*DeleteTemplateVariableReq))
+		return srv.(ConfigServer).UpdateCredential(ctx, req.(*UpdateCredentialsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchDeleteTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchDeleteBizResourcesReq)
+func _Config_RotateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCredentialReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchDeleteTemplateVariable(ctx, in)
+		return srv.(ConfigServer).RotateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchDeleteTemplateVariable_FullMethodName,
+		FullMethod: Config_RotateCredential_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchDeleteTemplateVariable(ctx, req.(*BatchDeleteBizResourcesReq))
+		return srv.(ConfigServer).RotateCredential(ctx, req.(*RotateCredentialReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateVariableReq)
+func _Config_CheckCredentialName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCredentialNameReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateTemplateVariable(ctx, in)
+		return srv.(ConfigServer).CheckCredentialName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateTemplateVariable_FullMethodName,
+		FullMethod: Config_CheckCredentialName_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateTemplateVariable(ctx, req.(*UpdateTemplateVariableReq))
+		return srv.(ConfigServer).CheckCredentialName(ctx, req.(*CheckCredentialNameReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListTemplateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateVariablesReq)
+func _Config_ListCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialScopesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListTemplateVariables(ctx, in)
+		return srv.(ConfigServer).ListCredentialScopes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListTemplateVariables_FullMethodName,
+		FullMethod: Config_ListCredentialScopes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListTemplateVariables(ctx, req.(*ListTemplateVariablesReq))
+		return srv.(ConfigServer).ListCredentialScopes(ctx, req.(*ListCredentialScopesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ImportTemplateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportTemplateVariablesReq)
+func _Config_UpdateCredentialScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialScopeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ImportTemplateVariables(ctx, in)
+		return srv.(ConfigServer).UpdateCredentialScope(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ImportTemplateVariables_FullMethodName,
+		FullMethod: Config_UpdateCredentialScope_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ImportTemplateVariables(ctx, req.(*ImportTemplateVariablesReq))
+		return srv.(ConfigServer).UpdateCredentialScope(ctx, req.(*UpdateCredentialScopeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ImportOtherFormatTemplateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportOtherFormatTemplateVariablesReq)
+func _Config_CredentialScopePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CredentialScopePreviewReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ImportOtherFormatTemplateVariables(ctx, in)
+		return srv.(ConfigServer).CredentialScopePreview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ImportOtherFormatTemplateVariables_FullMethodName,
+		FullMethod: Config_CredentialScopePreview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ImportOtherFormatTemplateVariables(ctx, req.(*ImportOtherFormatTemplateVariablesReq))
+		return srv.(ConfigServer).CredentialScopePreview(ctx, req.(*CredentialScopePreviewReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ExtractAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExtractAppTmplVariablesReq)
+func _Config_CreateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ExtractAppTmplVariables(ctx, in)
+		return srv.(ConfigServer).CreateKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ExtractAppTmplVariables_FullMethodName,
+		FullMethod: Config_CreateKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ExtractAppTmplVariables(ctx, req.(*ExtractAppTmplVariablesReq))
+		return srv.(ConfigServer).CreateKv(ctx, req.(*CreateKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetAppTmplVariableRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppTmplVariableRefsReq)
+func _Config_UpdateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetAppTmplVariableRefs(ctx, in)
+		return srv.(ConfigServer).UpdateKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetAppTmplVariableRefs_FullMethodName,
+		FullMethod: Config_UpdateKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetAppTmplVariableRefs(ctx, req.(*GetAppTmplVariableRefsReq))
+		return srv.(ConfigServer).UpdateKv(ctx, req.(*UpdateKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleasedAppTmplVariableRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedAppTmplVariableRefsReq)
+func _Config_ListKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKvsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleasedAppTmplVariableRefs(ctx, in)
+		return srv.(ConfigServer).ListKvs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleasedAppTmplVariableRefs_FullMethodName,
+		FullMethod: Config_ListKvs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleasedAppTmplVariableRefs(ctx, req.(*GetReleasedAppTmplVariableRefsReq))
+		return srv.(ConfigServer).ListKvs(ctx, req.(*ListKvsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppTmplVariablesReq)
+func _Config_DeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateAppTmplVariables(ctx, in)
+		return srv.(ConfigServer).DeleteKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateAppTmplVariables_FullMethodName,
+		FullMethod: Config_DeleteKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateAppTmplVariables(ctx, req.(*UpdateAppTmplVariablesReq))
+		return srv.(ConfigServer).DeleteKv(ctx, req.(*DeleteKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppTmplVariablesReq)
+func _Config_BatchDeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteAppResourcesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAppTmplVariables(ctx, in)
+		return srv.(ConfigServer).BatchDeleteKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAppTmplVariables_FullMethodName,
+		FullMethod: Config_BatchDeleteKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAppTmplVariables(ctx, req.(*ListAppTmplVariablesReq))
+		return srv.(ConfigServer).BatchDeleteKv(ctx, req.(*BatchDeleteAppResourcesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListReleasedAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedAppTmplVariablesReq)
+func _Config_BatchUpsertKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertKvsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListReleasedAppTmplVariables(ctx, in)
+		return srv.(ConfigServer).BatchUpsertKvs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListReleasedAppTmplVariables_FullMethodName,
+		FullMethod: Config_BatchUpsertKvs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListReleasedAppTmplVariables(ctx, req.(*ListReleasedAppTmplVariablesReq))
+		return srv.(ConfigServer).BatchUpsertKvs(ctx, req.(*BatchUpsertKvsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGroupReq)
+func _Config_UnDeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnDeleteKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateGroup(ctx, in)
+		return srv.(ConfigServer).UnDeleteKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateGroup_FullMethodName,
+		FullMethod: Config_UnDeleteKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateGroup(ctx, req.(*CreateGroupReq))
+		return srv.(ConfigServer).UnDeleteKv(ctx, req.(*UnDeleteKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGroupReq)
+func _Config_BatchUnDeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUnDeleteKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteGroup(ctx, in)
+		return srv.(ConfigServer).BatchUnDeleteKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteGroup_FullMethodName,
+		FullMethod: Config_BatchUnDeleteKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteGroup(ctx, req.(*DeleteGroupReq))
+		return srv.(ConfigServer).BatchUnDeleteKv(ctx, req.(*BatchUnDeleteKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchDeleteGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchDeleteBizResourcesReq)
+func _Config_UndoKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchDeleteGroups(ctx, in)
+		return srv.(ConfigServer).UndoKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchDeleteGroups_FullMethodName,
+		FullMethod: Config_UndoKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchDeleteGroups(ctx, req.(*BatchDeleteBizResourcesReq))
+		return srv.(ConfigServer).UndoKv(ctx, req.(*UndoKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGroupReq)
+func _Config_ImportKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportKvsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateGroup(ctx, in)
+		return srv.(ConfigServer).ImportKvs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateGroup_FullMethodName,
+		FullMethod: Config_ImportKvs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateGroup(ctx, req.(*UpdateGroupReq))
+		return srv.(ConfigServer).ImportKvs(ctx, req.(*ImportKvsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAllGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllGroupsReq)
+func _Config_FindNearExpiryCertKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearExpiryCertKvsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAllGroups(ctx, in)
+		return srv.(ConfigServer).FindNearExpiryCertKvs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAllGroups_FullMethodName,
+		FullMethod: Config_FindNearExpiryCertKvs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAllGroups(ctx, req.(*ListAllGroupsReq))
+		return srv.(ConfigServer).FindNearExpiryCertKvs(ctx, req.(*FindNearExpiryCertKvsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAppGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppGroupsReq)
+func _Config_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAppGroups(ctx, in)
+		return srv.(ConfigServer).ListClients(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAppGroups_FullMethodName,
+		FullMethod: Config_ListClients_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAppGroups(ctx, req.(*ListAppGroupsReq))
+		return srv.(ConfigServer).ListClients(ctx, req.(*ListClientsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListGroupReleasedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupReleasedAppsReq)
+func _Config_ListClientEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientEventsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListGroupReleasedApps(ctx, in)
+		return srv.(ConfigServer).ListClientEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListGroupReleasedApps_FullMethodName,
+		FullMethod: Config_ListClientEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListGroupReleasedApps(ctx, req.(*ListGroupReleasedAppsReq))
+		return srv.(ConfigServer).ListClientEvents(ctx, req.(*ListClientEventsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetGroupByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupByNameReq)
+func _Config_RetryClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryClientsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetGroupByName(ctx, in)
+		return srv.(ConfigServer).RetryClients(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetGroupByName_FullMethodName,
+		FullMethod: Config_RetryClients_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetGroupByName(ctx, req.(*GetGroupByNameReq))
+		return srv.(ConfigServer).RetryClients(ctx, req.(*RetryClientsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListGroupSelector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupSelectorReq)
+func _Config_ListClientQuerys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientQuerysReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListGroupSelector(ctx, in)
+		return srv.(ConfigServer).ListClientQuerys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListGroupSelector_FullMethodName,
+		FullMethod: Config_ListClientQuerys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListGroupSelector(ctx, req.(*ListGroupSelectorReq))
+		return srv.(ConfigServer).ListClientQuerys(ctx, req.(*ListClientQuerysReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishReq)
+func _Config_CreateClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientQueryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).Publish(ctx, in)
+		return srv.(ConfigServer).CreateClientQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_Publish_FullMethodName,
+		FullMethod: Config_CreateClientQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).Publish(ctx, req.(*PublishReq))
+		return srv.(ConfigServer).CreateClientQuery(ctx, req.(*CreateClientQueryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GenerateReleaseAndPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateReleaseAndPublishReq)
+func _Config_UpdateClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientQueryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GenerateReleaseAndPublish(ctx, in)
+		return srv.(ConfigServer).UpdateClientQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GenerateReleaseAndPublish_FullMethodName,
+		FullMethod: Config_UpdateClientQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GenerateReleaseAndPublish(ctx, req.(*GenerateReleaseAndPublishReq))
+		return srv.(ConfigServer).UpdateClientQuery(ctx, req.(*UpdateClientQueryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_SubmitPublishApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitPublishApproveReq)
+func _Config_DeleteClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClientQueryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).SubmitPublishApprove(ctx, in)
+		return srv.(ConfigServer).DeleteClientQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_SubmitPublishApprove_FullMethodName,
+		FullMethod: Config_DeleteClientQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).SubmitPublishApprove(ctx, req.(*SubmitPublishApproveReq))
+		return srv.(ConfigServer).DeleteClientQuery(ctx, req.(*DeleteClientQueryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApproveReq)
+func _Config_CheckClientQueryName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckClientQueryNameReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).Approve(ctx, in)
+		return srv.(ConfigServer).CheckClientQueryName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_Approve_FullMethodName,
+		FullMethod: Config_CheckClientQueryName_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).Approve(ctx, req.(*ApproveReq))
+		return srv.(ConfigServer).CheckClientQueryName(ctx, req.(*CheckClientQueryNameReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ApprovalCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApprovalCallbackReq)
+func _Config_ClientConfigVersionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ApprovalCallback(ctx, in)
+		return srv.(ConfigServer).ClientConfigVersionStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ApprovalCallback_FullMethodName,
+		FullMethod: Config_ClientConfigVersionStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ApprovalCallback(ctx, req.(*ApprovalCallbackReq))
+		return srv.(ConfigServer).ClientConfigVersionStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetLastSelect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLastSelectReq)
+func _Config_ClientPullTrendStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetLastSelect(ctx, in)
+		return srv.(ConfigServer).ClientPullTrendStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetLastSelect_FullMethodName,
+		FullMethod: Config_ClientPullTrendStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetLastSelect(ctx, req.(*GetLastSelectReq))
+		return srv.(ConfigServer).ClientPullTrendStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetLastPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLastPublishReq)
+func _Config_ClientPullStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetLastPublish(ctx, in)
+		return srv.(ConfigServer).ClientPullStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetLastPublish_FullMethodName,
+		FullMethod: Config_ClientPullStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetLastPublish(ctx, req.(*GetLastPublishReq))
+		return srv.(ConfigServer).ClientPullStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleasesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasesStatusReq)
+func _Config_ClientLabelStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleasesStatus(ctx, in)
+		return srv.(ConfigServer).ClientLabelStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleasesStatus_FullMethodName,
+		FullMethod: Config_ClientLabelStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleasesStatus(ctx, req.(*GetReleasesStatusReq))
+		return srv.(ConfigServer).ClientLabelStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAuditsReq)
+func _Config_ClientAnnotationStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAudits(ctx, in)
+		return srv.(ConfigServer).ClientAnnotationStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAudits_FullMethodName,
+		FullMethod: Config_ClientAnnotationStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAudits(ctx, req.(*ListAuditsReq))
+		return srv.(ConfigServer).ClientAnnotationStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCredentialReq)
+func _Config_ClientVersionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateCredentials(ctx, in)
+		return srv.(ConfigServer).ClientVersionStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateCredentials_FullMethodName,
+		FullMethod: Config_ClientVersionStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateCredentials(ctx, req.(*CreateCredentialReq))
+		return srv.(ConfigServer).ClientVersionStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCredentialsReq)
+func _Config_ListClientLabelAndAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientLabelAndAnnotationReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListCredentials(ctx, in)
+		return srv.(ConfigServer).ListClientLabelAndAnnotation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListCredentials_FullMethodName,
+		FullMethod: Config_ListClientLabelAndAnnotation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListCredentials(ctx, req.(*ListCredentialsReq))
+		return srv.(ConfigServer).ListClientLabelAndAnnotation(ctx, req.(*ListClientLabelAndAnnotationReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCredentialsReq)
+func _Config_ClientSpecificFailedReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteCredential(ctx, in)
+		return srv.(ConfigServer).ClientSpecificFailedReason(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteCredential_FullMethodName,
+		FullMethod: Config_ClientSpecificFailedReason_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteCredential(ctx, req.(*DeleteCredentialsReq))
+		return srv.(ConfigServer).ClientSpecificFailedReason(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCredentialsReq)
+func _Config_ClientStageStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateCredential(ctx, in)
+		return srv.(ConfigServer).ClientStageStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateCredential_FullMethodName,
+		FullMethod: Config_ClientStageStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateCredential(ctx, req.(*UpdateCredentialsReq))
+		return srv.(ConfigServer).ClientStageStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CheckCredentialName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckCredentialNameReq)
+func _Config_CreateClientAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CheckCredentialName(ctx, in)
+		return srv.(ConfigServer).CreateClientAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CheckCredentialName_FullMethodName,
+		FullMethod: Config_CreateClientAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CheckCredentialName(ctx, req.(*CheckCredentialNameReq))
+		return srv.(ConfigServer).CreateClientAlertRule(ctx, req.(*CreateClientAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCredentialScopesReq)
+func _Config_UpdateClientAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListCredentialScopes(ctx, in)
+		return srv.(ConfigServer).UpdateClientAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListCredentialScopes_FullMethodName,
+		FullMethod: Config_UpdateClientAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListCredentialScopes(ctx, req.(*ListCredentialScopesReq))
+		return srv.(ConfigServer).UpdateClientAlertRule(ctx, req.(*UpdateClientAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateCredentialScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCredentialScopeReq)
+func _Config_DeleteClientAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClientAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateCredentialScope(ctx, in)
+		return srv.(ConfigServer).DeleteClientAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateCredentialScope_FullMethodName,
+		FullMethod: Config_DeleteClientAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateCredentialScope(ctx, req.(*UpdateCredentialScopeReq))
+		return srv.(ConfigServer).DeleteClientAlertRule(ctx, req.(*DeleteClientAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CredentialScopePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CredentialScopePreviewReq)
+func _Config_ListClientAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientAlertRulesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CredentialScopePreview(ctx, in)
+		return srv.(ConfigServer).ListClientAlertRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CredentialScopePreview_FullMethodName,
+		FullMethod: Config_ListClientAlertRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CredentialScopePreview(ctx, req.(*CredentialScopePreviewReq))
+		return srv.(ConfigServer).ListClientAlertRules(ctx, req.(*ListClientAlertRulesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateKvReq)
+func _Config_SilenceClientAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SilenceClientAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateKv(ctx, in)
+		return srv.(ConfigServer).SilenceClientAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateKv_FullMethodName,
+		FullMethod: Config_SilenceClientAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateKv(ctx, req.(*CreateKvReq))
+		return srv.(ConfigServer).SilenceClientAlertRule(ctx, req.(*SilenceClientAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateKvReq)
+func _Config_AckClientAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckClientAlertReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateKv(ctx, in)
+		return srv.(ConfigServer).AckClientAlert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateKv_FullMethodName,
+		FullMethod: Config_AckClientAlert_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateKv(ctx, req.(*UpdateKvReq))
+		return srv.(ConfigServer).AckClientAlert(ctx, req.(*AckClientAlertReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListKvsReq)
+func _Config_ListClientAlertHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientAlertHistoriesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListKvs(ctx, in)
+		return srv.(ConfigServer).ListClientAlertHistories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListKvs_FullMethodName,
+		FullMethod: Config_ListClientAlertHistories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListKvs(ctx, req.(*ListKvsReq))
+		return srv.(ConfigServer).ListClientAlertHistories(ctx, req.(*ListClientAlertHistoriesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteKvReq)
+func _Config_ListClientMetricTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientMetricTrendsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteKv(ctx, in)
+		return srv.(ConfigServer).ListClientMetricTrends(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteKv_FullMethodName,
+		FullMethod: Config_ListClientMetricTrends_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteKv(ctx, req.(*DeleteKvReq))
+		return srv.(ConfigServer).ListClientMetricTrends(ctx, req.(*ListClientMetricTrendsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchDeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchDeleteAppResourcesReq)
+func _Config_CreateGitRepoLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGitRepoLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchDeleteKv(ctx, in)
+		return srv.(ConfigServer).CreateGitRepoLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchDeleteKv_FullMethodName,
+		FullMethod: Config_CreateGitRepoLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchDeleteKv(ctx, req.(*BatchDeleteAppResourcesReq))
+		return srv.(ConfigServer).CreateGitRepoLink(ctx, req.(*CreateGitRepoLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchUpsertKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpsertKvsReq)
+func _Config_UpdateGitRepoLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGitRepoLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchUpsertKvs(ctx, in)
+		return srv.(ConfigServer).UpdateGitRepoLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchUpsertKvs_FullMethodName,
+		FullMethod: Config_UpdateGitRepoLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchUpsertKvs(ctx, req.(*BatchUpsertKvsReq))
+		return srv.(ConfigServer).UpdateGitRepoLink(ctx, req.(*UpdateGitRepoLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UnDeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnDeleteKvReq)
+func _Config_DeleteGitRepoLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGitRepoLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UnDeleteKv(ctx, in)
+		return srv.(ConfigServer).DeleteGitRepoLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UnDeleteKv_FullMethodName,
+		FullMethod: Config_DeleteGitRepoLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UnDeleteKv(ctx, req.(*UnDeleteKvReq))
+		return srv.(ConfigServer).DeleteGitRepoLink(ctx, req.(*DeleteGitRepoLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchUnDeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUnDeleteKvReq)
+func _Config_ListGitRepoLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGitRepoLinksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchUnDeleteKv(ctx, in)
+		return srv.(ConfigServer).ListGitRepoLinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchUnDeleteKv_FullMethodName,
+		FullMethod: Config_ListGitRepoLinks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchUnDeleteKv(ctx, req.(*BatchUnDeleteKvReq))
+		return srv.(ConfigServer).ListGitRepoLinks(ctx, req.(*ListGitRepoLinksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UndoKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UndoKvReq)
+func _Config_SyncGitRepoLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncGitRepoLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UndoKv(ctx, in)
+		return srv.(ConfigServer).SyncGitRepoLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UndoKv_FullMethodName,
+		FullMethod: Config_SyncGitRepoLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UndoKv(ctx, req.(*UndoKvReq))
+		return srv.(ConfigServer).SyncGitRepoLink(ctx, req.(*SyncGitRepoLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ImportKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportKvsReq)
+func _Config_CreateChangeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChangeFreezeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ImportKvs(ctx, in)
+		return srv.(ConfigServer).CreateChangeFreeze(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ImportKvs_FullMethodName,
+		FullMethod: Config_CreateChangeFreeze_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ImportKvs(ctx, req.(*ImportKvsReq))
+		return srv.(ConfigServer).CreateChangeFreeze(ctx, req.(*CreateChangeFreezeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_FindNearExpiryCertKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindNearExpiryCertKvsReq)
+func _Config_UpdateChangeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChangeFreezeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).FindNearExpiryCertKvs(ctx, in)
+		return srv.(ConfigServer).UpdateChangeFreeze(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_FindNearExpiryCertKvs_FullMethodName,
+		FullMethod: Config_UpdateChangeFreeze_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).FindNearExpiryCertKvs(ctx, req.(*FindNearExpiryCertKvsReq))
+		return srv.(ConfigServer).UpdateChangeFreeze(ctx, req.(*UpdateChangeFreezeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListClientsReq)
+func _Config_DeleteChangeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChangeFreezeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListClients(ctx, in)
+		return srv.(ConfigServer).DeleteChangeFreeze(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListClients_FullMethodName,
+		FullMethod: Config_DeleteChangeFreeze_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListClients(ctx, req.(*ListClientsReq))
+		return srv.(ConfigServer).DeleteChangeFreeze(ctx, req.(*DeleteChangeFreezeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListClientEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListClientEventsReq)
+func _Config_ListChangeFreezes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangeFreezesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListClientEvents(ctx, in)
+		return srv.(ConfigServer).ListChangeFreezes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListClientEvents_FullMethodName,
+		FullMethod: Config_ListChangeFreezes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListClientEvents(ctx, req.(*ListClientEventsReq))
+		return srv.(ConfigServer).ListChangeFreezes(ctx, req.(*ListChangeFreezesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_RetryClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryClientsReq)
+func _Config_GetChangeFreezeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangeFreezeStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).RetryClients(ctx, in)
+		return srv.(ConfigServer).GetChangeFreezeStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_RetryClients_FullMethodName,
+		FullMethod: Config_GetChangeFreezeStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).RetryClients(ctx, req.(*RetryClientsReq))
+		return srv.(ConfigServer).GetChangeFreezeStatus(ctx, req.(*GetChangeFreezeStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListClientQuerys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListClientQuerysReq)
+func _Config_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListClientQuerys(ctx, in)
+		return srv.(ConfigServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListClientQuerys_FullMethodName,
+		FullMethod: Config_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListClientQuerys(ctx, req.(*ListClientQuerysReq))
+		return srv.(ConfigServer).CreateWebhook(ctx, req.(*CreateWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateClientQueryReq)
+func _Config_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateClientQuery(ctx, in)
+		return srv.(ConfigServer).UpdateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateClientQuery_FullMethodName,
+		FullMethod: Config_UpdateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateClientQuery(ctx, req.(*CreateClientQueryReq))
+		return srv.(ConfigServer).UpdateWebhook(ctx, req.(*UpdateWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateClientQueryReq)
+func _Config_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateClientQuery(ctx, in)
+		return srv.(ConfigServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateClientQuery_FullMethodName,
+		FullMethod: Config_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateClientQuery(ctx, req.(*UpdateClientQueryReq))
+		return srv.(ConfigServer).DeleteWebhook(ctx, req.(*DeleteWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteClientQueryReq)
+func _Config_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteClientQuery(ctx, in)
+		return srv.(ConfigServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteClientQuery_FullMethodName,
+		FullMethod: Config_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteClientQuery(ctx, req.(*DeleteClientQueryReq))
+		return srv.(ConfigServer).ListWebhooks(ctx, req.(*ListWebhooksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CheckClientQueryName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckClientQueryNameReq)
+func _Config_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CheckClientQueryName(ctx, in)
+		return srv.(ConfigServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CheckClientQueryName_FullMethodName,
+		FullMethod: Config_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CheckClientQueryName(ctx, req.(*CheckClientQueryNameReq))
+		return srv.(ConfigServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ClientConfigVersionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Config_RedeliverWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookDeliveryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ClientConfigVersionStatistics(ctx, in)
+		return srv.(ConfigServer).RedeliverWebhookDelivery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ClientConfigVersionStatistics_FullMethodName,
+		FullMethod: Config_RedeliverWebhookDelivery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ClientConfigVersionStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(ConfigServer).RedeliverWebhookDelivery(ctx, req.(*RedeliverWebhookDeliveryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ClientPullTrendStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Config_SetApprovalFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApprovalFlowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ClientPullTrendStatistics(ctx, in)
+		return srv.(ConfigServer).SetApprovalFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ClientPullTrendStatistics_FullMethodName,
+		FullMethod: Config_SetApprovalFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ClientPullTrendStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(ConfigServer).SetApprovalFlow(ctx, req.(*SetApprovalFlowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ClientPullStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Config_GetApprovalFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalFlowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ClientPullStatistics(ctx, in)
+		return srv.(ConfigServer).GetApprovalFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ClientPullStatistics_FullMethodName,
+		FullMethod: Config_GetApprovalFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ClientPullStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(ConfigServer).GetApprovalFlow(ctx, req.(*GetApprovalFlowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ClientLabelStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Config_GetApprovalTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalTicketReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ClientLabelStatistics(ctx, in)
+		return srv.(ConfigServer).GetApprovalTicket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ClientLabelStatistics_FullMethodName,
+		FullMethod: Config_GetApprovalTicket_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ClientLabelStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(ConfigServer).GetApprovalTicket(ctx, req.(*GetApprovalTicketReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ClientAnnotationStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Config_DelegateApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateApprovalReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ClientAnnotationStatistics(ctx, in)
+		return srv.(ConfigServer).DelegateApproval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ClientAnnotationStatistics_FullMethodName,
+		FullMethod: Config_DelegateApproval_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ClientAnnotationStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(ConfigServer).DelegateApproval(ctx, req.(*DelegateApprovalReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ClientVersionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Config_CommentApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentApprovalReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ClientVersionStatistics(ctx, in)
+		return srv.(ConfigServer).CommentApproval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ClientVersionStatistics_FullMethodName,
+		FullMethod: Config_CommentApproval_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ClientVersionStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(ConfigServer).CommentApproval(ctx, req.(*CommentApprovalReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListClientLabelAndAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListClientLabelAndAnnotationReq)
+func _Config_ExportAppBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAppBundleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListClientLabelAndAnnotation(ctx, in)
+		return srv.(ConfigServer).ExportAppBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListClientLabelAndAnnotation_FullMethodName,
+		FullMethod: Config_ExportAppBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListClientLabelAndAnnotation(ctx, req.(*ListClientLabelAndAnnotationReq))
+		return srv.(ConfigServer).ExportAppBundle(ctx, req.(*ExportAppBundleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ClientSpecificFailedReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Config_ImportAppBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAppBundleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ClientSpecificFailedReason(ctx, in)
+		return srv.(ConfigServer).ImportAppBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ClientSpecificFailedReason_FullMethodName,
+		FullMethod: Config_ImportAppBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ClientSpecificFailedReason(ctx, req.(*client.ClientCommonReq))
+		return srv.(ConfigServer).ImportAppBundle(ctx, req.(*ImportAppBundleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7363,6 +8955,24 @@ func _Config_PushConfig_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Config_RepushDriftedConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepushDriftedConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).RepushDriftedConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_RepushDriftedConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).RepushDriftedConfig(ctx, req.(*RepushDriftedConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Config_GetConfigRenderResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetConfigRenderResultReq)
 	if err := dec(in); err != nil {
@@ -7802,6 +9412,10 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublishHookRevision",
 			Handler:    _Config_PublishHookRevision_Handler,
 		},
+		{
+			MethodName: "TestHookRevision",
+			Handler:    _Config_TestHookRevision_Handler,
+		},
 		{
 			MethodName: "GetHookRevision",
 			Handler:    _Config_GetHookRevision_Handler,
@@ -7838,6 +9452,18 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTemplateSpaces",
 			Handler:    _Config_ListTemplateSpaces_Handler,
 		},
+		{
+			MethodName: "ImportTemplateSpaceHooks",
+			Handler:    _Config_ImportTemplateSpaceHooks_Handler,
+		},
+		{
+			MethodName: "ListTemplateSpaceHooks",
+			Handler:    _Config_ListTemplateSpaceHooks_Handler,
+		},
+		{
+			MethodName: "DeleteTemplateSpaceHook",
+			Handler:    _Config_DeleteTemplateSpaceHook_Handler,
+		},
 		{
 			MethodName: "GetAllBizsOfTmplSpaces",
 			Handler:    _Config_GetAllBizsOfTmplSpaces_Handler,
@@ -8118,6 +9744,18 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReleasedAppTmplVariables",
 			Handler:    _Config_ListReleasedAppTmplVariables_Handler,
 		},
+		{
+			MethodName: "UpdateGroupTmplVariables",
+			Handler:    _Config_UpdateGroupTmplVariables_Handler,
+		},
+		{
+			MethodName: "ListGroupTmplVariables",
+			Handler:    _Config_ListGroupTmplVariables_Handler,
+		},
+		{
+			MethodName: "TraceAppTmplVariables",
+			Handler:    _Config_TraceAppTmplVariables_Handler,
+		},
 		{
 			MethodName: "CreateGroup",
 			Handler:    _Config_CreateGroup_Handler,
@@ -8166,6 +9804,26 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitPublishApprove",
 			Handler:    _Config_SubmitPublishApprove_Handler,
 		},
+		{
+			MethodName: "RollbackRelease",
+			Handler:    _Config_RollbackRelease_Handler,
+		},
+		{
+			MethodName: "PinGroupRelease",
+			Handler:    _Config_PinGroupRelease_Handler,
+		},
+		{
+			MethodName: "UnpinGroupRelease",
+			Handler:    _Config_UnpinGroupRelease_Handler,
+		},
+		{
+			MethodName: "UpdateReleaseTags",
+			Handler:    _Config_UpdateReleaseTags_Handler,
+		},
+		{
+			MethodName: "PromoteRelease",
+			Handler:    _Config_PromoteRelease_Handler,
+		},
 		{
 			MethodName: "Approve",
 			Handler:    _Config_Approve_Handler,
@@ -8206,6 +9864,10 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCredential",
 			Handler:    _Config_UpdateCredential_Handler,
 		},
+		{
+			MethodName: "RotateCredential",
+			Handler:    _Config_RotateCredential_Handler,
+		},
 		{
 			MethodName: "CheckCredentialName",
 			Handler:    _Config_CheckCredentialName_Handler,
@@ -8330,6 +9992,134 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClientSpecificFailedReason",
 			Handler:    _Config_ClientSpecificFailedReason_Handler,
 		},
+		{
+			MethodName: "ClientStageStatistics",
+			Handler:    _Config_ClientStageStatistics_Handler,
+		},
+		{
+			MethodName: "CreateClientAlertRule",
+			Handler:    _Config_CreateClientAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateClientAlertRule",
+			Handler:    _Config_UpdateClientAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteClientAlertRule",
+			Handler:    _Config_DeleteClientAlertRule_Handler,
+		},
+		{
+			MethodName: "ListClientAlertRules",
+			Handler:    _Config_ListClientAlertRules_Handler,
+		},
+		{
+			MethodName: "SilenceClientAlertRule",
+			Handler:    _Config_SilenceClientAlertRule_Handler,
+		},
+		{
+			MethodName: "AckClientAlert",
+			Handler:    _Config_AckClientAlert_Handler,
+		},
+		{
+			MethodName: "ListClientAlertHistories",
+			Handler:    _Config_ListClientAlertHistories_Handler,
+		},
+		{
+			MethodName: "ListClientMetricTrends",
+			Handler:    _Config_ListClientMetricTrends_Handler,
+		},
+		{
+			MethodName: "CreateGitRepoLink",
+			Handler:    _Config_CreateGitRepoLink_Handler,
+		},
+		{
+			MethodName: "UpdateGitRepoLink",
+			Handler:    _Config_UpdateGitRepoLink_Handler,
+		},
+		{
+			MethodName: "DeleteGitRepoLink",
+			Handler:    _Config_DeleteGitRepoLink_Handler,
+		},
+		{
+			MethodName: "ListGitRepoLinks",
+			Handler:    _Config_ListGitRepoLinks_Handler,
+		},
+		{
+			MethodName: "SyncGitRepoLink",
+			Handler:    _Config_SyncGitRepoLink_Handler,
+		},
+		{
+			MethodName: "CreateChangeFreeze",
+			Handler:    _Config_CreateChangeFreeze_Handler,
+		},
+		{
+			MethodName: "UpdateChangeFreeze",
+			Handler:    _Config_UpdateChangeFreeze_Handler,
+		},
+		{
+			MethodName: "DeleteChangeFreeze",
+			Handler:    _Config_DeleteChangeFreeze_Handler,
+		},
+		{
+			MethodName: "ListChangeFreezes",
+			Handler:    _Config_ListChangeFreezes_Handler,
+		},
+		{
+			MethodName: "GetChangeFreezeStatus",
+			Handler:    _Config_GetChangeFreezeStatus_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Config_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _Config_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Config_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Config_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Config_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhookDelivery",
+			Handler:    _Config_RedeliverWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "SetApprovalFlow",
+			Handler:    _Config_SetApprovalFlow_Handler,
+		},
+		{
+			MethodName: "GetApprovalFlow",
+			Handler:    _Config_GetApprovalFlow_Handler,
+		},
+		{
+			MethodName: "GetApprovalTicket",
+			Handler:    _Config_GetApprovalTicket_Handler,
+		},
+		{
+			MethodName: "DelegateApproval",
+			Handler:    _Config_DelegateApproval_Handler,
+		},
+		{
+			MethodName: "CommentApproval",
+			Handler:    _Config_CommentApproval_Handler,
+		},
+		{
+			MethodName: "ExportAppBundle",
+			Handler:    _Config_ExportAppBundle_Handler,
+		},
+		{
+			MethodName: "ImportAppBundle",
+			Handler:    _Config_ImportAppBundle_Handler,
+		},
 		{
 			MethodName: "CompareConfigItemConflicts",
 			Handler:    _Config_CompareConfigItemConflicts_Handler,
@@ -8430,6 +10220,10 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PushConfig",
 			Handler:    _Config_PushConfig_Handler,
 		},
+		{
+			MethodName: "RepushDriftedConfig",
+			Handler:    _Config_RepushDriftedConfig_Handler,
+		},
 		{
 			MethodName: "GetConfigRenderResult",
 			Handler:    _Config_GetConfigRenderResult_Handler,
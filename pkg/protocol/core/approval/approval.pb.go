@@ -0,0 +1,940 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: approval.proto
+
+package pbapproval
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ApprovalFlow source resource reference: pkg/dal/table/approval.go
+type ApprovalFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *ApprovalFlowSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *ApprovalFlowAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision          `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *ApprovalFlow) Reset() {
+	*x = ApprovalFlow{}
+	mi := &file_approval_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalFlow) ProtoMessage() {}
+
+func (x *ApprovalFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalFlow.ProtoReflect.Descriptor instead.
+func (*ApprovalFlow) Descriptor() ([]byte, []int) {
+	return file_approval_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApprovalFlow) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApprovalFlow) GetSpec() *ApprovalFlowSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ApprovalFlow) GetAttachment() *ApprovalFlowAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *ApprovalFlow) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// ApprovalFlowSpec source resource reference: pkg/dal/table/approval.go
+type ApprovalFlowSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool             `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Stages  []*ApprovalStage `protobuf:"bytes,2,rep,name=stages,proto3" json:"stages,omitempty"`
+	Memo    string           `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *ApprovalFlowSpec) Reset() {
+	*x = ApprovalFlowSpec{}
+	mi := &file_approval_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalFlowSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalFlowSpec) ProtoMessage() {}
+
+func (x *ApprovalFlowSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalFlowSpec.ProtoReflect.Descriptor instead.
+func (*ApprovalFlowSpec) Descriptor() ([]byte, []int) {
+	return file_approval_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApprovalFlowSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ApprovalFlowSpec) GetStages() []*ApprovalStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *ApprovalFlowSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ApprovalFlowAttachment source resource reference: pkg/dal/table/approval.go
+type ApprovalFlowAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *ApprovalFlowAttachment) Reset() {
+	*x = ApprovalFlowAttachment{}
+	mi := &file_approval_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalFlowAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalFlowAttachment) ProtoMessage() {}
+
+func (x *ApprovalFlowAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalFlowAttachment.ProtoReflect.Descriptor instead.
+func (*ApprovalFlowAttachment) Descriptor() ([]byte, []int) {
+	return file_approval_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApprovalFlowAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ApprovalFlowAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+// ApprovalStage source resource reference: pkg/dal/table/approval.go
+type ApprovalStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Approvers      []string `protobuf:"bytes,2,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	Quorum         uint32   `protobuf:"varint,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	TimeoutMinutes uint32   `protobuf:"varint,4,opt,name=timeout_minutes,json=timeoutMinutes,proto3" json:"timeout_minutes,omitempty"`
+}
+
+func (x *ApprovalStage) Reset() {
+	*x = ApprovalStage{}
+	mi := &file_approval_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStage) ProtoMessage() {}
+
+func (x *ApprovalStage) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalStage.ProtoReflect.Descriptor instead.
+func (*ApprovalStage) Descriptor() ([]byte, []int) {
+	return file_approval_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApprovalStage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApprovalStage) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *ApprovalStage) GetQuorum() uint32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *ApprovalStage) GetTimeoutMinutes() uint32 {
+	if x != nil {
+		return x.TimeoutMinutes
+	}
+	return 0
+}
+
+// ApprovalTicket source resource reference: pkg/dal/table/approval.go
+type ApprovalTicket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *ApprovalTicketSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *ApprovalTicketAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision            `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *ApprovalTicket) Reset() {
+	*x = ApprovalTicket{}
+	mi := &file_approval_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalTicket) ProtoMessage() {}
+
+func (x *ApprovalTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalTicket.ProtoReflect.Descriptor instead.
+func (*ApprovalTicket) Descriptor() ([]byte, []int) {
+	return file_approval_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApprovalTicket) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApprovalTicket) GetSpec() *ApprovalTicketSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ApprovalTicket) GetAttachment() *ApprovalTicketAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *ApprovalTicket) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// ApprovalTicketSpec source resource reference: pkg/dal/table/approval.go
+type ApprovalTicketSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stages        []*ApprovalStage       `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+	CurrentStage  uint32                 `protobuf:"varint,2,opt,name=current_stage,json=currentStage,proto3" json:"current_stage,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	StageDeadline *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=stage_deadline,json=stageDeadline,proto3" json:"stage_deadline,omitempty"`
+}
+
+func (x *ApprovalTicketSpec) Reset() {
+	*x = ApprovalTicketSpec{}
+	mi := &file_approval_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalTicketSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalTicketSpec) ProtoMessage() {}
+
+func (x *ApprovalTicketSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalTicketSpec.ProtoReflect.Descriptor instead.
+func (*ApprovalTicketSpec) Descriptor() ([]byte, []int) {
+	return file_approval_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApprovalTicketSpec) GetStages() []*ApprovalStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *ApprovalTicketSpec) GetCurrentStage() uint32 {
+	if x != nil {
+		return x.CurrentStage
+	}
+	return 0
+}
+
+func (x *ApprovalTicketSpec) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApprovalTicketSpec) GetStageDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StageDeadline
+	}
+	return nil
+}
+
+// ApprovalTicketAttachment source resource reference: pkg/dal/table/approval.go
+type ApprovalTicketAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId      uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId      uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	ReleaseId  uint32 `protobuf:"varint,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	StrategyId uint32 `protobuf:"varint,4,opt,name=strategy_id,json=strategyId,proto3" json:"strategy_id,omitempty"`
+}
+
+func (x *ApprovalTicketAttachment) Reset() {
+	*x = ApprovalTicketAttachment{}
+	mi := &file_approval_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalTicketAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalTicketAttachment) ProtoMessage() {}
+
+func (x *ApprovalTicketAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalTicketAttachment.ProtoReflect.Descriptor instead.
+func (*ApprovalTicketAttachment) Descriptor() ([]byte, []int) {
+	return file_approval_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApprovalTicketAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ApprovalTicketAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *ApprovalTicketAttachment) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *ApprovalTicketAttachment) GetStrategyId() uint32 {
+	if x != nil {
+		return x.StrategyId
+	}
+	return 0
+}
+
+// ApprovalAction source resource reference: pkg/dal/table/approval.go
+type ApprovalAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *ApprovalActionSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *ApprovalActionAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.CreatedRevision     `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *ApprovalAction) Reset() {
+	*x = ApprovalAction{}
+	mi := &file_approval_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalAction) ProtoMessage() {}
+
+func (x *ApprovalAction) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalAction.ProtoReflect.Descriptor instead.
+func (*ApprovalAction) Descriptor() ([]byte, []int) {
+	return file_approval_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApprovalAction) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApprovalAction) GetSpec() *ApprovalActionSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ApprovalAction) GetAttachment() *ApprovalActionAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *ApprovalAction) GetRevision() *base.CreatedRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// ApprovalActionSpec source resource reference: pkg/dal/table/approval.go
+type ApprovalActionSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stage      uint32 `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Action     string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	DelegateTo string `protobuf:"bytes,3,opt,name=delegate_to,json=delegateTo,proto3" json:"delegate_to,omitempty"`
+	Comment    string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *ApprovalActionSpec) Reset() {
+	*x = ApprovalActionSpec{}
+	mi := &file_approval_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalActionSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalActionSpec) ProtoMessage() {}
+
+func (x *ApprovalActionSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalActionSpec.ProtoReflect.Descriptor instead.
+func (*ApprovalActionSpec) Descriptor() ([]byte, []int) {
+	return file_approval_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ApprovalActionSpec) GetStage() uint32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *ApprovalActionSpec) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ApprovalActionSpec) GetDelegateTo() string {
+	if x != nil {
+		return x.DelegateTo
+	}
+	return ""
+}
+
+func (x *ApprovalActionSpec) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// ApprovalActionAttachment source resource reference: pkg/dal/table/approval.go
+type ApprovalActionAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId    uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId    uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	TicketId uint32 `protobuf:"varint,3,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+}
+
+func (x *ApprovalActionAttachment) Reset() {
+	*x = ApprovalActionAttachment{}
+	mi := &file_approval_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalActionAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalActionAttachment) ProtoMessage() {}
+
+func (x *ApprovalActionAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalActionAttachment.ProtoReflect.Descriptor instead.
+func (*ApprovalActionAttachment) Descriptor() ([]byte, []int) {
+	return file_approval_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ApprovalActionAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ApprovalActionAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *ApprovalActionAttachment) GetTicketId() uint32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+var File_approval_proto protoreflect.FileDescriptor
+
+var file_approval_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0a, 0x70, 0x62, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xd7, 0x01, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x6c, 0x6f,
+	0x77, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92,
+	0x41, 0x10, 0x32, 0x0e, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe6, 0xb5, 0x81, 0xe7, 0xa8, 0x8b,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x42, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70,
+	0x62, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x01, 0x0a, 0x10, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x66, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x4c, 0x92, 0x41, 0x49, 0x32, 0x47, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x90, 0xaf,
+	0xe7, 0x94, 0xa8, 0xe5, 0x86, 0x85, 0xe7, 0xbd, 0xae, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xef,
+	0xbc, 0x8c, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe5, 0x90, 0x8e, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a,
+	0xa1, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe4, 0xb8, 0x8d,
+	0xe5, 0x86, 0x8d, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0x20, 0x49, 0x54, 0x53, 0x4d, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x5c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe9,
+	0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xef, 0xbc, 0x8c, 0xe6, 0x8c, 0x89, 0xe9, 0xa1, 0xba, 0xe5, 0xba,
+	0x8f, 0xe9, 0x80, 0x90, 0xe7, 0xba, 0xa7, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0,
+	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x64, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x77, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52,
+	0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d,
+	0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0xca, 0x02, 0x0a,
+	0x0d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x25,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5,
+	0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe4, 0xba, 0xba, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x12, 0x61, 0x0a, 0x06, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x49, 0x92, 0x41, 0x46, 0x32, 0x44,
+	0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0xac, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe6,
+	0x89, 0x80, 0xe9, 0x9c, 0x80, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe4, 0xba,
+	0xba, 0xe6, 0x95, 0xb0, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe5,
+	0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe4, 0xba, 0xba, 0xe9, 0x80,
+	0x9a, 0xe8, 0xbf, 0x87, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x7b, 0x0a, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x52, 0x92, 0x41, 0x4f, 0x32, 0x4d, 0xe6, 0x9c, 0xac, 0xe9,
+	0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe6, 0x97,
+	0xb6, 0xe9, 0x99, 0x90, 0xef, 0xbc, 0x88, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0xef, 0xbc, 0x89,
+	0xef, 0xbc, 0x8c, 0xe8, 0xb6, 0x85, 0xe6, 0x97, 0xb6, 0xe8, 0x87, 0xaa, 0xe5, 0x8a, 0xa8, 0xe9,
+	0xa9, 0xb3, 0xe5, 0x9b, 0x9e, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba,
+	0xe4, 0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x0e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe5,
+	0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe5, 0x8d, 0x95, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32,
+	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70,
+	0x62, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x12, 0x44, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x62, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xab, 0x03, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x56, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x62, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x67, 0x65, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe6,
+	0x8f, 0x90, 0xe4, 0xba, 0xa4, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe6, 0x97, 0xb6, 0xe7, 0x9a,
+	0x84, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x2f, 0x92, 0x41,
+	0x2c, 0x32, 0x2a, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe9,
+	0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe7, 0x9a, 0x84, 0xe5, 0xba, 0x8f, 0xe5, 0x8f, 0xb7, 0xef, 0xbc,
+	0x8c, 0xe4, 0xbb, 0x8e, 0x20, 0x30, 0x20, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0x52, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x7c, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x64, 0x92, 0x41, 0x61,
+	0x32, 0x5f, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe5, 0x8d, 0x95, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
+	0x81, 0xef, 0xbc, 0x9a, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0xe5, 0xae, 0xa1, 0xe6,
+	0x89, 0xb9, 0xe4, 0xb8, 0xad, 0xef, 0xbc, 0x8c, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x2d, 0xe5,
+	0xb7, 0xb2, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xef, 0xbc, 0x8c, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x2d, 0xe5, 0xb7, 0xb2, 0xe9, 0xa9, 0xb3, 0xe5, 0x9b, 0x9e, 0xef, 0xbc, 0x8c,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x2d, 0xe5, 0xb7, 0xb2, 0xe6, 0x92, 0xa4, 0xe9, 0x94,
+	0x80, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x69, 0x0a, 0x0e, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x26, 0x92,
+	0x41, 0x23, 0x32, 0x21, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5,
+	0xe7, 0x9a, 0x84, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe6, 0x88, 0xaa, 0xe6, 0xad, 0xa2, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44,
+	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x2c, 0x0a,
+	0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x49, 0x44,
+	0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf, 0xe7, 0xad, 0x96,
+	0xe7, 0x95, 0xa5, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49,
+	0x64, 0x22, 0xe4, 0x01, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe8, 0xae, 0xb0,
+	0xe5, 0xbd, 0x95, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x44, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x70, 0x62, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcd, 0x02, 0x0a, 0x12, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a,
+	0x92, 0x41, 0x17, 0x32, 0x15, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe9, 0x98, 0xb6, 0xe6, 0xae,
+	0xb5, 0xe7, 0x9a, 0x84, 0xe5, 0xba, 0x8f, 0xe5, 0x8f, 0xb7, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x94, 0x01, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x7c, 0x92, 0x41, 0x79, 0x32, 0x77, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7,
+	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x2d,
+	0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xef, 0xbc, 0x8c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x2d,
+	0xe9, 0xa9, 0xb3, 0xe5, 0x9b, 0x9e, 0xef, 0xbc, 0x8c, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x2d, 0xe8, 0xbd, 0xac, 0xe5, 0xae, 0xa1, 0xef, 0xbc, 0x8c, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x2d, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xef, 0xbc, 0x8c, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x2d, 0xe8, 0xb6, 0x85, 0xe6, 0x97, 0xb6, 0xe9, 0xa9, 0xb3, 0xe5, 0x9b, 0x9e,
+	0xef, 0xbc, 0x8c, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2d, 0xe6, 0x92, 0xa4, 0xe9, 0x94, 0x80,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92,
+	0x41, 0x14, 0x32, 0x12, 0xe8, 0xbd, 0xac, 0xe5, 0xae, 0xa1, 0xe7, 0xbb, 0x99, 0xe7, 0x9a, 0x84,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9,
+	0xe6, 0x84, 0x8f, 0xe8, 0xa7, 0x81, 0xe6, 0x88, 0x96, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x18, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5,
+	0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61,
+	0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe5, 0xae, 0xa1, 0xe6, 0x89,
+	0xb9, 0xe5, 0x8d, 0x95, 0x49, 0x44, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54,
+	0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62,
+	0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x3b, 0x70, 0x62, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_approval_proto_rawDescOnce sync.Once
+	file_approval_proto_rawDescData = file_approval_proto_rawDesc
+)
+
+func file_approval_proto_rawDescGZIP() []byte {
+	file_approval_proto_rawDescOnce.Do(func() {
+		file_approval_proto_rawDescData = protoimpl.X.CompressGZIP(file_approval_proto_rawDescData)
+	})
+	return file_approval_proto_rawDescData
+}
+
+var file_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_approval_proto_goTypes = []any{
+	(*ApprovalFlow)(nil),             // 0: pbapproval.ApprovalFlow
+	(*ApprovalFlowSpec)(nil),         // 1: pbapproval.ApprovalFlowSpec
+	(*ApprovalFlowAttachment)(nil),   // 2: pbapproval.ApprovalFlowAttachment
+	(*ApprovalStage)(nil),            // 3: pbapproval.ApprovalStage
+	(*ApprovalTicket)(nil),           // 4: pbapproval.ApprovalTicket
+	(*ApprovalTicketSpec)(nil),       // 5: pbapproval.ApprovalTicketSpec
+	(*ApprovalTicketAttachment)(nil), // 6: pbapproval.ApprovalTicketAttachment
+	(*ApprovalAction)(nil),           // 7: pbapproval.ApprovalAction
+	(*ApprovalActionSpec)(nil),       // 8: pbapproval.ApprovalActionSpec
+	(*ApprovalActionAttachment)(nil), // 9: pbapproval.ApprovalActionAttachment
+	(*base.Revision)(nil),            // 10: pbbase.Revision
+	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
+	(*base.CreatedRevision)(nil),     // 12: pbbase.CreatedRevision
+}
+var file_approval_proto_depIdxs = []int32{
+	1,  // 0: pbapproval.ApprovalFlow.spec:type_name -> pbapproval.ApprovalFlowSpec
+	2,  // 1: pbapproval.ApprovalFlow.attachment:type_name -> pbapproval.ApprovalFlowAttachment
+	10, // 2: pbapproval.ApprovalFlow.revision:type_name -> pbbase.Revision
+	3,  // 3: pbapproval.ApprovalFlowSpec.stages:type_name -> pbapproval.ApprovalStage
+	5,  // 4: pbapproval.ApprovalTicket.spec:type_name -> pbapproval.ApprovalTicketSpec
+	6,  // 5: pbapproval.ApprovalTicket.attachment:type_name -> pbapproval.ApprovalTicketAttachment
+	10, // 6: pbapproval.ApprovalTicket.revision:type_name -> pbbase.Revision
+	3,  // 7: pbapproval.ApprovalTicketSpec.stages:type_name -> pbapproval.ApprovalStage
+	11, // 8: pbapproval.ApprovalTicketSpec.stage_deadline:type_name -> google.protobuf.Timestamp
+	8,  // 9: pbapproval.ApprovalAction.spec:type_name -> pbapproval.ApprovalActionSpec
+	9,  // 10: pbapproval.ApprovalAction.attachment:type_name -> pbapproval.ApprovalActionAttachment
+	12, // 11: pbapproval.ApprovalAction.revision:type_name -> pbbase.CreatedRevision
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_approval_proto_init() }
+func file_approval_proto_init() {
+	if File_approval_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_approval_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_approval_proto_goTypes,
+		DependencyIndexes: file_approval_proto_depIdxs,
+		MessageInfos:      file_approval_proto_msgTypes,
+	}.Build()
+	File_approval_proto = out.File
+	file_approval_proto_rawDesc = nil
+	file_approval_proto_goTypes = nil
+	file_approval_proto_depIdxs = nil
+}
@@ -0,0 +1,164 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pbapproval
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// ApprovalFlowSpec convert pb ApprovalFlowSpec to table ApprovalFlowSpec
+func (s *ApprovalFlowSpec) ApprovalFlowSpec() *table.ApprovalFlowSpec {
+	if s == nil {
+		return nil
+	}
+
+	return &table.ApprovalFlowSpec{
+		Enabled: s.Enabled,
+		Stages:  ApprovalStages(s.Stages),
+		Memo:    s.Memo,
+	}
+}
+
+// ApprovalStages convert pb ApprovalStage to table ApprovalStages
+func ApprovalStages(stages []*ApprovalStage) table.ApprovalStages {
+	result := make(table.ApprovalStages, 0, len(stages))
+	for _, one := range stages {
+		if one == nil {
+			continue
+		}
+		result = append(result, &table.ApprovalStage{
+			Name:           one.Name,
+			Approvers:      one.Approvers,
+			Quorum:         one.Quorum,
+			TimeoutMinutes: one.TimeoutMinutes,
+		})
+	}
+
+	return result
+}
+
+// PbApprovalStages convert table ApprovalStages to pb ApprovalStage
+func PbApprovalStages(stages table.ApprovalStages) []*ApprovalStage {
+	result := make([]*ApprovalStage, 0, len(stages))
+	for _, one := range stages {
+		result = append(result, &ApprovalStage{
+			Name:           one.Name,
+			Approvers:      one.Approvers,
+			Quorum:         one.Quorum,
+			TimeoutMinutes: one.TimeoutMinutes,
+		})
+	}
+
+	return result
+}
+
+// PbApprovalFlow convert table ApprovalFlow to pb ApprovalFlow
+func PbApprovalFlow(f *table.ApprovalFlow) *ApprovalFlow {
+	if f == nil {
+		return nil
+	}
+
+	result := &ApprovalFlow{
+		Id:       f.ID,
+		Revision: pbbase.PbRevision(f.Revision),
+	}
+	if f.Spec != nil {
+		result.Spec = &ApprovalFlowSpec{
+			Enabled: f.Spec.Enabled,
+			Stages:  PbApprovalStages(f.Spec.Stages),
+			Memo:    f.Spec.Memo,
+		}
+	}
+	if f.Attachment != nil {
+		result.Attachment = &ApprovalFlowAttachment{
+			BizId: f.Attachment.BizID,
+			AppId: f.Attachment.AppID,
+		}
+	}
+
+	return result
+}
+
+// PbApprovalTicket convert table ApprovalTicket to pb ApprovalTicket
+func PbApprovalTicket(t *table.ApprovalTicket) *ApprovalTicket {
+	if t == nil {
+		return nil
+	}
+
+	result := &ApprovalTicket{
+		Id:       t.ID,
+		Revision: pbbase.PbRevision(t.Revision),
+	}
+	if t.Spec != nil {
+		result.Spec = &ApprovalTicketSpec{
+			Stages:       PbApprovalStages(t.Spec.Stages),
+			CurrentStage: t.Spec.CurrentStage,
+			Status:       string(t.Spec.Status),
+		}
+		if t.Spec.StageDeadline != nil {
+			result.Spec.StageDeadline = timestamppb.New(*t.Spec.StageDeadline)
+		}
+	}
+	if t.Attachment != nil {
+		result.Attachment = &ApprovalTicketAttachment{
+			BizId:      t.Attachment.BizID,
+			AppId:      t.Attachment.AppID,
+			ReleaseId:  t.Attachment.ReleaseID,
+			StrategyId: t.Attachment.StrategyID,
+		}
+	}
+
+	return result
+}
+
+// PbApprovalActions convert table ApprovalAction to pb ApprovalAction
+func PbApprovalActions(actions []*table.ApprovalAction) []*ApprovalAction {
+	result := make([]*ApprovalAction, 0, len(actions))
+	for _, one := range actions {
+		result = append(result, PbApprovalAction(one))
+	}
+
+	return result
+}
+
+// PbApprovalAction convert table ApprovalAction to pb ApprovalAction
+func PbApprovalAction(a *table.ApprovalAction) *ApprovalAction {
+	if a == nil {
+		return nil
+	}
+
+	result := &ApprovalAction{
+		Id:       a.ID,
+		Revision: pbbase.PbCreatedRevision(a.Revision),
+	}
+	if a.Spec != nil {
+		result.Spec = &ApprovalActionSpec{
+			Stage:      a.Spec.Stage,
+			Action:     string(a.Spec.Action),
+			DelegateTo: a.Spec.DelegateTo,
+			Comment:    a.Spec.Comment,
+		}
+	}
+	if a.Attachment != nil {
+		result.Attachment = &ApprovalActionAttachment{
+			BizId:    a.Attachment.BizID,
+			AppId:    a.Attachment.AppID,
+			TicketId: a.Attachment.TicketID,
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: change_freeze.proto
+
+package pbcf
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChangeFreeze source resource reference: pkg/dal/table/change_freeze.go
+type ChangeFreeze struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *ChangeFreezeSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *ChangeFreezeAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision          `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *ChangeFreeze) Reset() {
+	*x = ChangeFreeze{}
+	mi := &file_change_freeze_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeFreeze) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeFreeze) ProtoMessage() {}
+
+func (x *ChangeFreeze) ProtoReflect() protoreflect.Message {
+	mi := &file_change_freeze_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeFreeze.ProtoReflect.Descriptor instead.
+func (*ChangeFreeze) Descriptor() ([]byte, []int) {
+	return file_change_freeze_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChangeFreeze) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeFreeze) GetSpec() *ChangeFreezeSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ChangeFreeze) GetAttachment() *ChangeFreezeAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *ChangeFreeze) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// ChangeFreezeSpec source resource reference: pkg/dal/table/change_freeze.go
+type ChangeFreezeSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind            string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Timezone        string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Weekdays        []uint32               `protobuf:"varint,4,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	StartTime       string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	StartAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	BreakGlassUsers []string               `protobuf:"bytes,9,rep,name=break_glass_users,json=breakGlassUsers,proto3" json:"break_glass_users,omitempty"`
+	Enabled         bool                   `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Memo            string                 `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *ChangeFreezeSpec) Reset() {
+	*x = ChangeFreezeSpec{}
+	mi := &file_change_freeze_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeFreezeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeFreezeSpec) ProtoMessage() {}
+
+func (x *ChangeFreezeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_change_freeze_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeFreezeSpec.ProtoReflect.Descriptor instead.
+func (*ChangeFreezeSpec) Descriptor() ([]byte, []int) {
+	return file_change_freeze_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChangeFreezeSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChangeFreezeSpec) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChangeFreezeSpec) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ChangeFreezeSpec) GetWeekdays() []uint32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *ChangeFreezeSpec) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ChangeFreezeSpec) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ChangeFreezeSpec) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *ChangeFreezeSpec) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *ChangeFreezeSpec) GetBreakGlassUsers() []string {
+	if x != nil {
+		return x.BreakGlassUsers
+	}
+	return nil
+}
+
+func (x *ChangeFreezeSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ChangeFreezeSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ChangeFreezeAttachment source resource reference: pkg/dal/table/change_freeze.go
+type ChangeFreezeAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *ChangeFreezeAttachment) Reset() {
+	*x = ChangeFreezeAttachment{}
+	mi := &file_change_freeze_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeFreezeAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeFreezeAttachment) ProtoMessage() {}
+
+func (x *ChangeFreezeAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_change_freeze_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeFreezeAttachment.ProtoReflect.Descriptor instead.
+func (*ChangeFreezeAttachment) Descriptor() ([]byte, []int) {
+	return file_change_freeze_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChangeFreezeAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ChangeFreezeAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+var File_change_freeze_proto protoreflect.FileDescriptor
+
+var file_change_freeze_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x63, 0x66, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xcb, 0x01, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41,
+	0x10, 0x32, 0x0e, 0xe5, 0x86, 0xbb, 0xe7, 0xbb, 0x93, 0xe7, 0xaa, 0x97, 0xe5, 0x8f, 0xa3, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x66, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x12, 0x3c, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x63, 0x66, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x07,
+	0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x5b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x47, 0x92, 0x41, 0x44, 0x32, 0x42, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc,
+	0x9a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0xe6, 0xaf, 0x8f, 0xe5, 0x91,
+	0xa8, 0xe9, 0x87, 0x8d, 0xe5, 0xa4, 0x8d, 0xef, 0xbc, 0x8c, 0x6f, 0x6e, 0x63, 0x65, 0x2d, 0xe4,
+	0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe6, 0x80, 0xa7, 0xef, 0xbc, 0x88, 0xe5, 0xa6, 0x82, 0xe8, 0x8a,
+	0x82, 0xe5, 0x81, 0x87, 0xe6, 0x97, 0xa5, 0xef, 0xbc, 0x89, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x48, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x27, 0xe6, 0x97, 0xb6, 0xe5, 0x8c, 0xba, 0xef,
+	0xbc, 0x8c, 0xe5, 0xa6, 0x82, 0x20, 0x41, 0x73, 0x69, 0x61, 0x2f, 0x53, 0x68, 0x61, 0x6e, 0x67,
+	0x68, 0x61, 0x69, 0xef, 0xbc, 0x8c, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x20, 0x55, 0x54, 0x43,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x5c, 0x0a, 0x08, 0x77, 0x65,
+	0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x40, 0x92, 0x41,
+	0x3d, 0x32, 0x3b, 0xe6, 0xaf, 0x8f, 0xe5, 0x91, 0xa8, 0xe9, 0x87, 0x8d, 0xe5, 0xa4, 0x8d, 0xe7,
+	0x9a, 0x84, 0xe6, 0x98, 0x9f, 0xe6, 0x9c, 0x9f, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8,
+	0xe7, 0xa4, 0xba, 0xe5, 0x91, 0xa8, 0xe6, 0x97, 0xa5, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7,
+	0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0xaf, 0x8f, 0xe5, 0xa4, 0xa9, 0x52, 0x08,
+	0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41,
+	0x23, 0x32, 0x21, 0xe6, 0xaf, 0x8f, 0xe5, 0x91, 0xa8, 0xe9, 0x87, 0x8d, 0xe5, 0xa4, 0x8d, 0xe7,
+	0x9a, 0x84, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x48,
+	0x48, 0x3a, 0x4d, 0x4d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x83, 0x01, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x68, 0x92, 0x41, 0x65, 0x32, 0x63, 0xe6, 0xaf, 0x8f, 0xe5, 0x91, 0xa8, 0xe9,
+	0x87, 0x8d, 0xe5, 0xa4, 0x8d, 0xe7, 0x9a, 0x84, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x48, 0x48, 0x3a, 0x4d, 0x4d, 0xef, 0xbc, 0x8c, 0xe6, 0x97, 0xa9,
+	0xe4, 0xba, 0x8e, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe8,
+	0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe8, 0xb7, 0xa8, 0xe5, 0xa4, 0xa9, 0xef, 0xbc, 0x8c, 0xe7, 0xad,
+	0x89, 0xe4, 0xba, 0x8e, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe5, 0x85, 0xa8, 0xe5, 0xa4, 0xa9, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1,
+	0xe6, 0x80, 0xa7, 0xe7, 0xaa, 0x97, 0xe5, 0x8f, 0xa3, 0xe7, 0x9a, 0x84, 0xe5, 0xbc, 0x80, 0xe5,
+	0xa7, 0x8b, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x74, 0x12, 0x56, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x23, 0x92,
+	0x41, 0x20, 0x32, 0x1e, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe6, 0x80, 0xa7, 0xe7, 0xaa, 0x97,
+	0xe5, 0x8f, 0xa3, 0xe7, 0x9a, 0x84, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x7c, 0x0a, 0x11, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x5f, 0x67, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32, 0x4b, 0xe5, 0x86, 0xbb, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9c, 0x9f, 0xe9, 0x97, 0xb4, 0xe4, 0xbb, 0x8d, 0xe5, 0x8f, 0xaf, 0xe5, 0xbc, 0xba,
+	0xe5, 0x88, 0xb6, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0xef, 0xbc, 0x8c, 0xe5, 0xbc, 0xba, 0xe5, 0x88, 0xb6, 0xe5, 0x8f, 0x98, 0xe6, 0x9b,
+	0xb4, 0xe4, 0xbc, 0x9a, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c,
+	0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0x52, 0x0f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x47, 0x6c, 0x61,
+	0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6,
+	0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x81, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52,
+	0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x2a, 0x92, 0x41, 0x27, 0x32, 0x25, 0xe6, 0x9c, 0x8d,
+	0xe5, 0x8a, 0xa1, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba,
+	0xe5, 0x86, 0xbb, 0xe7, 0xbb, 0x93, 0xe6, 0x95, 0xb4, 0xe4, 0xb8, 0xaa, 0xe4, 0xb8, 0x9a, 0xe5,
+	0x8a, 0xa1, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42,
+	0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2d, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x3b,
+	0x70, 0x62, 0x63, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_change_freeze_proto_rawDescOnce sync.Once
+	file_change_freeze_proto_rawDescData = file_change_freeze_proto_rawDesc
+)
+
+func file_change_freeze_proto_rawDescGZIP() []byte {
+	file_change_freeze_proto_rawDescOnce.Do(func() {
+		file_change_freeze_proto_rawDescData = protoimpl.X.CompressGZIP(file_change_freeze_proto_rawDescData)
+	})
+	return file_change_freeze_proto_rawDescData
+}
+
+var file_change_freeze_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_change_freeze_proto_goTypes = []any{
+	(*ChangeFreeze)(nil),           // 0: pbcf.ChangeFreeze
+	(*ChangeFreezeSpec)(nil),       // 1: pbcf.ChangeFreezeSpec
+	(*ChangeFreezeAttachment)(nil), // 2: pbcf.ChangeFreezeAttachment
+	(*base.Revision)(nil),          // 3: pbbase.Revision
+	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
+}
+var file_change_freeze_proto_depIdxs = []int32{
+	1, // 0: pbcf.ChangeFreeze.spec:type_name -> pbcf.ChangeFreezeSpec
+	2, // 1: pbcf.ChangeFreeze.attachment:type_name -> pbcf.ChangeFreezeAttachment
+	3, // 2: pbcf.ChangeFreeze.revision:type_name -> pbbase.Revision
+	4, // 3: pbcf.ChangeFreezeSpec.start_at:type_name -> google.protobuf.Timestamp
+	4, // 4: pbcf.ChangeFreezeSpec.end_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_change_freeze_proto_init() }
+func file_change_freeze_proto_init() {
+	if File_change_freeze_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_change_freeze_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_change_freeze_proto_goTypes,
+		DependencyIndexes: file_change_freeze_proto_depIdxs,
+		MessageInfos:      file_change_freeze_proto_msgTypes,
+	}.Build()
+	File_change_freeze_proto = out.File
+	file_change_freeze_proto_rawDesc = nil
+	file_change_freeze_proto_goTypes = nil
+	file_change_freeze_proto_depIdxs = nil
+}
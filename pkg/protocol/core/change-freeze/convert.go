@@ -0,0 +1,136 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pbcf
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/types"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// ChangeFreezeSpec convert pb ChangeFreezeSpec to table ChangeFreezeSpec
+func (c *ChangeFreezeSpec) ChangeFreezeSpec() *table.ChangeFreezeSpec {
+	if c == nil {
+		return nil
+	}
+
+	return &table.ChangeFreezeSpec{
+		Name:            c.Name,
+		Kind:            table.ChangeFreezeKind(c.Kind),
+		Timezone:        c.Timezone,
+		Weekdays:        types.Uint32Slice(c.Weekdays),
+		StartTime:       c.StartTime,
+		EndTime:         c.EndTime,
+		StartAt:         tableTime(c.StartAt),
+		EndAt:           tableTime(c.EndAt),
+		BreakGlassUsers: types.StringSlice(c.BreakGlassUsers),
+		Enabled:         c.Enabled,
+		Memo:            c.Memo,
+	}
+}
+
+// PbChangeFreezes convert table ChangeFreeze to pb ChangeFreeze
+func PbChangeFreezes(freezes []*table.ChangeFreeze) []*ChangeFreeze {
+	result := make([]*ChangeFreeze, 0, len(freezes))
+	for _, one := range freezes {
+		result = append(result, PbChangeFreeze(one))
+	}
+
+	return result
+}
+
+// PbChangeFreeze convert table ChangeFreeze to pb ChangeFreeze
+func PbChangeFreeze(c *table.ChangeFreeze) *ChangeFreeze {
+	if c == nil {
+		return nil
+	}
+
+	return &ChangeFreeze{
+		Id:         c.ID,
+		Spec:       PbChangeFreezeSpec(c.Spec),
+		Attachment: PbChangeFreezeAttachment(c.Attachment),
+		Revision:   pbbase.PbRevision(c.Revision),
+	}
+}
+
+// PbChangeFreezeSpec convert table ChangeFreezeSpec to pb ChangeFreezeSpec
+func PbChangeFreezeSpec(spec *table.ChangeFreezeSpec) *ChangeFreezeSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &ChangeFreezeSpec{
+		Name:            spec.Name,
+		Kind:            string(spec.Kind),
+		Timezone:        spec.Timezone,
+		Weekdays:        spec.Weekdays,
+		StartTime:       spec.StartTime,
+		EndTime:         spec.EndTime,
+		StartAt:         pbTime(spec.StartAt),
+		EndAt:           pbTime(spec.EndAt),
+		BreakGlassUsers: spec.BreakGlassUsers,
+		Enabled:         spec.Enabled,
+		Memo:            spec.Memo,
+	}
+}
+
+// PbChangeFreezeAttachment convert table ChangeFreezeAttachment to pb ChangeFreezeAttachment
+func PbChangeFreezeAttachment(at *table.ChangeFreezeAttachment) *ChangeFreezeAttachment {
+	if at == nil {
+		return nil
+	}
+
+	return &ChangeFreezeAttachment{
+		BizId: at.BizID,
+		AppId: at.AppID,
+	}
+}
+
+// ChangeFreezes convert pb ChangeFreeze to table ChangeFreeze
+func ChangeFreezes(freezes []*ChangeFreeze) []*table.ChangeFreeze {
+	result := make([]*table.ChangeFreeze, 0, len(freezes))
+	for _, one := range freezes {
+		if one == nil {
+			continue
+		}
+
+		c := &table.ChangeFreeze{ID: one.Id, Spec: one.Spec.ChangeFreezeSpec()}
+		if one.Attachment != nil {
+			c.Attachment = &table.ChangeFreezeAttachment{BizID: one.Attachment.BizId, AppID: one.Attachment.AppId}
+		}
+		result = append(result, c)
+	}
+
+	return result
+}
+
+func pbTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func tableTime(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	one := t.AsTime()
+	return &one
+}
@@ -0,0 +1,716 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: client_alert.proto
+
+package pbcalert
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ClientAlertRule source resource reference: pkg/dal/table/client_alert.go
+type ClientAlertRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *ClientAlertRuleSpec   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *ClientAlertAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision         `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *ClientAlertRule) Reset() {
+	*x = ClientAlertRule{}
+	mi := &file_client_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientAlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientAlertRule) ProtoMessage() {}
+
+func (x *ClientAlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_client_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientAlertRule.ProtoReflect.Descriptor instead.
+func (*ClientAlertRule) Descriptor() ([]byte, []int) {
+	return file_client_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClientAlertRule) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ClientAlertRule) GetSpec() *ClientAlertRuleSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ClientAlertRule) GetAttachment() *ClientAlertAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *ClientAlertRule) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// ClientAlertRuleSpec source resource reference: pkg/dal/table/client_alert.go
+type ClientAlertRuleSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RuleType      string                 `protobuf:"bytes,2,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	ReleaseId     uint32                 `protobuf:"varint,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ClientVersion string                 `protobuf:"bytes,5,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	Notifiers     []string               `protobuf:"bytes,6,rep,name=notifiers,proto3" json:"notifiers,omitempty"`
+	WebhookUrl    string                 `protobuf:"bytes,7,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	Receivers     []string               `protobuf:"bytes,8,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	Enabled       bool                   `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	SilencedUntil *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=silenced_until,json=silencedUntil,proto3" json:"silenced_until,omitempty"`
+	Memo          string                 `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *ClientAlertRuleSpec) Reset() {
+	*x = ClientAlertRuleSpec{}
+	mi := &file_client_alert_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientAlertRuleSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientAlertRuleSpec) ProtoMessage() {}
+
+func (x *ClientAlertRuleSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_client_alert_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientAlertRuleSpec.ProtoReflect.Descriptor instead.
+func (*ClientAlertRuleSpec) Descriptor() ([]byte, []int) {
+	return file_client_alert_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClientAlertRuleSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClientAlertRuleSpec) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *ClientAlertRuleSpec) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *ClientAlertRuleSpec) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *ClientAlertRuleSpec) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *ClientAlertRuleSpec) GetNotifiers() []string {
+	if x != nil {
+		return x.Notifiers
+	}
+	return nil
+}
+
+func (x *ClientAlertRuleSpec) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *ClientAlertRuleSpec) GetReceivers() []string {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *ClientAlertRuleSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ClientAlertRuleSpec) GetSilencedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SilencedUntil
+	}
+	return nil
+}
+
+func (x *ClientAlertRuleSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ClientAlertAttachment source resource reference: pkg/dal/table/client_alert.go
+type ClientAlertAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *ClientAlertAttachment) Reset() {
+	*x = ClientAlertAttachment{}
+	mi := &file_client_alert_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientAlertAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientAlertAttachment) ProtoMessage() {}
+
+func (x *ClientAlertAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_client_alert_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientAlertAttachment.ProtoReflect.Descriptor instead.
+func (*ClientAlertAttachment) Descriptor() ([]byte, []int) {
+	return file_client_alert_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClientAlertAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ClientAlertAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+// ClientAlertHistory source resource reference: pkg/dal/table/client_alert.go
+type ClientAlertHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *ClientAlertHistorySpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *ClientAlertAttachment  `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision          `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *ClientAlertHistory) Reset() {
+	*x = ClientAlertHistory{}
+	mi := &file_client_alert_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientAlertHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientAlertHistory) ProtoMessage() {}
+
+func (x *ClientAlertHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_client_alert_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientAlertHistory.ProtoReflect.Descriptor instead.
+func (*ClientAlertHistory) Descriptor() ([]byte, []int) {
+	return file_client_alert_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClientAlertHistory) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ClientAlertHistory) GetSpec() *ClientAlertHistorySpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ClientAlertHistory) GetAttachment() *ClientAlertAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *ClientAlertHistory) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// ClientAlertHistorySpec source resource reference: pkg/dal/table/client_alert.go
+type ClientAlertHistorySpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId       uint32                 `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName     string                 `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	RuleType     string                 `protobuf:"bytes,3,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	Status       string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Value        float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	ClientCount  uint32                 `protobuf:"varint,6,opt,name=client_count,json=clientCount,proto3" json:"client_count,omitempty"`
+	Message      string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Silenced     bool                   `protobuf:"varint,8,opt,name=silenced,proto3" json:"silenced,omitempty"`
+	NotifyResult string                 `protobuf:"bytes,9,opt,name=notify_result,json=notifyResult,proto3" json:"notify_result,omitempty"`
+	FiredAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=fired_at,json=firedAt,proto3" json:"fired_at,omitempty"`
+	AckedBy      string                 `protobuf:"bytes,11,opt,name=acked_by,json=ackedBy,proto3" json:"acked_by,omitempty"`
+	AckedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=acked_at,json=ackedAt,proto3" json:"acked_at,omitempty"`
+	ResolvedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+}
+
+func (x *ClientAlertHistorySpec) Reset() {
+	*x = ClientAlertHistorySpec{}
+	mi := &file_client_alert_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientAlertHistorySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientAlertHistorySpec) ProtoMessage() {}
+
+func (x *ClientAlertHistorySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_client_alert_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientAlertHistorySpec.ProtoReflect.Descriptor instead.
+func (*ClientAlertHistorySpec) Descriptor() ([]byte, []int) {
+	return file_client_alert_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClientAlertHistorySpec) GetRuleId() uint32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *ClientAlertHistorySpec) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *ClientAlertHistorySpec) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *ClientAlertHistorySpec) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ClientAlertHistorySpec) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ClientAlertHistorySpec) GetClientCount() uint32 {
+	if x != nil {
+		return x.ClientCount
+	}
+	return 0
+}
+
+func (x *ClientAlertHistorySpec) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ClientAlertHistorySpec) GetSilenced() bool {
+	if x != nil {
+		return x.Silenced
+	}
+	return false
+}
+
+func (x *ClientAlertHistorySpec) GetNotifyResult() string {
+	if x != nil {
+		return x.NotifyResult
+	}
+	return ""
+}
+
+func (x *ClientAlertHistorySpec) GetFiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FiredAt
+	}
+	return nil
+}
+
+func (x *ClientAlertHistorySpec) GetAckedBy() string {
+	if x != nil {
+		return x.AckedBy
+	}
+	return ""
+}
+
+func (x *ClientAlertHistorySpec) GetAckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AckedAt
+	}
+	return nil
+}
+
+func (x *ClientAlertHistorySpec) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+var File_client_alert_proto protoreflect.FileDescriptor
+
+var file_client_alert_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x62, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xd8, 0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe8,
+	0xa7, 0x84, 0xe5, 0x88, 0x99, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3f,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x99, 0x07,
+	0x0a, 0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99,
+	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xaf, 0x01, 0x0a,
+	0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x91, 0x01, 0x92, 0x41, 0x8d, 0x01, 0x32, 0x8a, 0x01, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x2d, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x8f, 0x98, 0xe6,
+	0x9b, 0xb4, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe6, 0xaf, 0x94, 0xe4, 0xbe, 0x8b, 0xef, 0xbc,
+	0x8c, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2d, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0xa6, 0xbb, 0xe7, 0xba,
+	0xbf, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf, 0xef, 0xbc, 0x8c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x65, 0x6c, 0x6f, 0x77, 0x2d, 0xe5,
+	0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe8, 0xbf,
+	0x87, 0xe4, 0xbd, 0x8e, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63,
+	0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x44, 0x92, 0x41, 0x41, 0x32, 0x3f, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x49,
+	0x44, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x85, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x20, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0xe4, 0xbd, 0xbf, 0xe7, 0x94,
+	0xa8, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0x89, 0x80, 0xe6,
+	0x9c, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x6e, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32, 0x4b, 0xe9, 0x98, 0x88,
+	0xe5, 0x80, 0xbc, 0xef, 0xbc, 0x8c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x20, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0xe4, 0xb8, 0xba, 0xe7, 0x99, 0xbe, 0xe5,
+	0x88, 0x86, 0xe6, 0xaf, 0x94, 0xef, 0xbc, 0x8c, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0xe4,
+	0xb8, 0xba, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x63, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0x92, 0x41, 0x39,
+	0x32, 0x37, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0x89, 0x88, 0xe6, 0x9c,
+	0xac, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x85, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x65, 0x6c, 0x6f, 0x77, 0x20, 0xe8, 0xa7, 0x84,
+	0xe5, 0x88, 0x99, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2a, 0x92, 0x41, 0x27,
+	0x32, 0x25, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe6, 0xb8, 0xa0, 0xe9, 0x81, 0x93, 0xef, 0xbc,
+	0x9a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0xe3, 0x80, 0x81, 0x70, 0x75, 0x73, 0x68, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe5, 0x9c, 0xb0, 0xe5,
+	0x9d, 0x80, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x3f,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x32, 0x1c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x20, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe6, 0x8e, 0xa5, 0xe6, 0x94,
+	0xb6, 0xe4, 0xba, 0xba, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12,
+	0x2b, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x90, 0xaf,
+	0xe7, 0x94, 0xa8, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x5a, 0x0a, 0x0e,
+	0x73, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe9, 0x9d, 0x99, 0xe9, 0xbb, 0x98, 0xe6, 0x88, 0xaa,
+	0xe6, 0xad, 0xa2, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0d, 0x73, 0x69, 0x6c, 0x65, 0x6e,
+	0x63, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f, 0x8f,
+	0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x63, 0x0a, 0x15, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49,
+	0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6,
+	0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0xd8,
+	0x01, 0x0a, 0x12, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3f, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x70, 0x62, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd5, 0x06, 0x0a, 0x16, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0x91, 0x8a, 0xe8,
+	0xad, 0xa6, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x49, 0x44, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xa7, 0x84, 0xe5,
+	0x88, 0x99, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xa7, 0x84, 0xe5,
+	0x88, 0x99, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x63, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x4b, 0x92, 0x41, 0x48, 0x32, 0x46, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe7,
+	0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a, 0x66, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0xe5,
+	0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe4, 0xb8, 0xad, 0xef, 0xbc, 0x8c, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x2d, 0xe5, 0xb7, 0xb2, 0xe7, 0xa1, 0xae, 0xe8, 0xae, 0xa4, 0xef, 0xbc, 0x8c, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x2d, 0xe5, 0xb7, 0xb2, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe5, 0x91, 0x8a,
+	0xe8, 0xad, 0xa6, 0xe8, 0xa7, 0xa6, 0xe5, 0x8f, 0x91, 0xe6, 0x97, 0xb6, 0xe7, 0x9a, 0x84, 0xe5,
+	0x80, 0xbc, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe5, 0x91, 0xbd, 0xe4, 0xb8, 0xad, 0xe8, 0xa7, 0x84, 0xe5,
+	0x88, 0x99, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x95,
+	0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe5,
+	0x86, 0x85, 0xe5, 0xae, 0xb9, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x48,
+	0x0a, 0x08, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x27, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x9c, 0xa8,
+	0xe9, 0x9d, 0x99, 0xe9, 0xbb, 0x98, 0xe6, 0x9c, 0x9f, 0xe5, 0x86, 0x85, 0xe8, 0xa7, 0xa6, 0xe5,
+	0x8f, 0x91, 0xef, 0xbc, 0x8c, 0xe6, 0x9c, 0xaa, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0x52, 0x08,
+	0x73, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe5, 0x90, 0x84, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe6,
+	0xb8, 0xa0, 0xe9, 0x81, 0x93, 0xe7, 0x9a, 0x84, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x48, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xa7, 0xa6, 0xe5, 0x8f, 0x91, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0x52, 0x07, 0x66, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x08,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
+	0x92, 0x41, 0x0b, 0x32, 0x09, 0xe7, 0xa1, 0xae, 0xe8, 0xae, 0xa4, 0xe4, 0xba, 0xba, 0x52, 0x07,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x12, 0x48, 0x0a, 0x08, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0xa1, 0xae, 0xe8,
+	0xae, 0xa4, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x07, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f,
+	0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2d, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x3b, 0x70, 0x62, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_client_alert_proto_rawDescOnce sync.Once
+	file_client_alert_proto_rawDescData = file_client_alert_proto_rawDesc
+)
+
+func file_client_alert_proto_rawDescGZIP() []byte {
+	file_client_alert_proto_rawDescOnce.Do(func() {
+		file_client_alert_proto_rawDescData = protoimpl.X.CompressGZIP(file_client_alert_proto_rawDescData)
+	})
+	return file_client_alert_proto_rawDescData
+}
+
+var file_client_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_client_alert_proto_goTypes = []any{
+	(*ClientAlertRule)(nil),        // 0: pbcalert.ClientAlertRule
+	(*ClientAlertRuleSpec)(nil),    // 1: pbcalert.ClientAlertRuleSpec
+	(*ClientAlertAttachment)(nil),  // 2: pbcalert.ClientAlertAttachment
+	(*ClientAlertHistory)(nil),     // 3: pbcalert.ClientAlertHistory
+	(*ClientAlertHistorySpec)(nil), // 4: pbcalert.ClientAlertHistorySpec
+	(*base.Revision)(nil),          // 5: pbbase.Revision
+	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
+}
+var file_client_alert_proto_depIdxs = []int32{
+	1,  // 0: pbcalert.ClientAlertRule.spec:type_name -> pbcalert.ClientAlertRuleSpec
+	2,  // 1: pbcalert.ClientAlertRule.attachment:type_name -> pbcalert.ClientAlertAttachment
+	5,  // 2: pbcalert.ClientAlertRule.revision:type_name -> pbbase.Revision
+	6,  // 3: pbcalert.ClientAlertRuleSpec.silenced_until:type_name -> google.protobuf.Timestamp
+	4,  // 4: pbcalert.ClientAlertHistory.spec:type_name -> pbcalert.ClientAlertHistorySpec
+	2,  // 5: pbcalert.ClientAlertHistory.attachment:type_name -> pbcalert.ClientAlertAttachment
+	5,  // 6: pbcalert.ClientAlertHistory.revision:type_name -> pbbase.Revision
+	6,  // 7: pbcalert.ClientAlertHistorySpec.fired_at:type_name -> google.protobuf.Timestamp
+	6,  // 8: pbcalert.ClientAlertHistorySpec.acked_at:type_name -> google.protobuf.Timestamp
+	6,  // 9: pbcalert.ClientAlertHistorySpec.resolved_at:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_client_alert_proto_init() }
+func file_client_alert_proto_init() {
+	if File_client_alert_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_client_alert_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_client_alert_proto_goTypes,
+		DependencyIndexes: file_client_alert_proto_depIdxs,
+		MessageInfos:      file_client_alert_proto_msgTypes,
+	}.Build()
+	File_client_alert_proto = out.File
+	file_client_alert_proto_rawDesc = nil
+	file_client_alert_proto_goTypes = nil
+	file_client_alert_proto_depIdxs = nil
+}
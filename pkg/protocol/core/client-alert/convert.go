@@ -0,0 +1,172 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbcalert provides client alert core protocol struct and convert functions.
+package pbcalert
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// ClientAlertRuleSpec convert pb ClientAlertRuleSpec to table ClientAlertRuleSpec
+func (c *ClientAlertRuleSpec) ClientAlertRuleSpec() *table.ClientAlertRuleSpec {
+	if c == nil {
+		return nil
+	}
+
+	return &table.ClientAlertRuleSpec{
+		Name:          c.Name,
+		RuleType:      table.ClientAlertRuleType(c.RuleType),
+		ReleaseID:     c.ReleaseId,
+		Threshold:     c.Threshold,
+		ClientVersion: c.ClientVersion,
+		Notifiers:     strings.Join(c.Notifiers, ","),
+		WebhookURL:    c.WebhookUrl,
+		Receivers:     strings.Join(c.Receivers, ","),
+		Enabled:       c.Enabled,
+		Memo:          c.Memo,
+	}
+}
+
+// PbClientAlertRules convert table ClientAlertRule to pb ClientAlertRule
+func PbClientAlertRules(rules []*table.ClientAlertRule) []*ClientAlertRule {
+	result := make([]*ClientAlertRule, 0, len(rules))
+	for _, one := range rules {
+		result = append(result, PbClientAlertRule(one))
+	}
+
+	return result
+}
+
+// PbClientAlertRule convert table ClientAlertRule to pb ClientAlertRule
+func PbClientAlertRule(r *table.ClientAlertRule) *ClientAlertRule {
+	if r == nil {
+		return nil
+	}
+
+	return &ClientAlertRule{
+		Id:         r.ID,
+		Spec:       PbClientAlertRuleSpec(r.Spec),
+		Attachment: PbClientAlertAttachment(r.Attachment),
+		Revision:   pbbase.PbRevision(r.Revision),
+	}
+}
+
+// PbClientAlertRuleSpec convert table ClientAlertRuleSpec to pb ClientAlertRuleSpec
+func PbClientAlertRuleSpec(spec *table.ClientAlertRuleSpec) *ClientAlertRuleSpec {
+	if spec == nil {
+		return nil
+	}
+
+	notifiers := make([]string, 0)
+	for _, n := range spec.NotifierList() {
+		notifiers = append(notifiers, string(n))
+	}
+
+	return &ClientAlertRuleSpec{
+		Name:          spec.Name,
+		RuleType:      string(spec.RuleType),
+		ReleaseId:     spec.ReleaseID,
+		Threshold:     spec.Threshold,
+		ClientVersion: spec.ClientVersion,
+		Notifiers:     notifiers,
+		WebhookUrl:    spec.WebhookURL,
+		Receivers:     splitReceivers(spec.Receivers),
+		Enabled:       spec.Enabled,
+		SilencedUntil: pbTime(spec.SilencedUntil),
+		Memo:          spec.Memo,
+	}
+}
+
+// PbClientAlertAttachment convert table ClientAlertRuleAttachment to pb ClientAlertAttachment
+func PbClientAlertAttachment(at *table.ClientAlertRuleAttachment) *ClientAlertAttachment {
+	if at == nil {
+		return nil
+	}
+
+	return &ClientAlertAttachment{
+		BizId: at.BizID,
+		AppId: at.AppID,
+	}
+}
+
+// PbClientAlertHistories convert table ClientAlertHistory to pb ClientAlertHistory
+func PbClientAlertHistories(histories []*table.ClientAlertHistory) []*ClientAlertHistory {
+	result := make([]*ClientAlertHistory, 0, len(histories))
+	for _, one := range histories {
+		result = append(result, PbClientAlertHistory(one))
+	}
+
+	return result
+}
+
+// PbClientAlertHistory convert table ClientAlertHistory to pb ClientAlertHistory
+func PbClientAlertHistory(h *table.ClientAlertHistory) *ClientAlertHistory {
+	if h == nil {
+		return nil
+	}
+
+	return &ClientAlertHistory{
+		Id:         h.ID,
+		Spec:       PbClientAlertHistorySpec(h.Spec),
+		Attachment: PbClientAlertAttachment(h.Attachment),
+		Revision:   pbbase.PbRevision(h.Revision),
+	}
+}
+
+// PbClientAlertHistorySpec convert table ClientAlertHistorySpec to pb ClientAlertHistorySpec
+func PbClientAlertHistorySpec(spec *table.ClientAlertHistorySpec) *ClientAlertHistorySpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &ClientAlertHistorySpec{
+		RuleId:       spec.RuleID,
+		RuleName:     spec.RuleName,
+		RuleType:     string(spec.RuleType),
+		Status:       string(spec.Status),
+		Value:        spec.Value,
+		ClientCount:  spec.ClientCount,
+		Message:      spec.Message,
+		Silenced:     spec.Silenced,
+		NotifyResult: spec.NotifyResult,
+		FiredAt:      timestamppb.New(spec.FiredAt),
+		AckedBy:      spec.AckedBy,
+		AckedAt:      pbTime(spec.AckedAt),
+		ResolvedAt:   pbTime(spec.ResolvedAt),
+	}
+}
+
+func pbTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func splitReceivers(receivers string) []string {
+	result := make([]string, 0)
+	for _, one := range strings.Split(receivers, ",") {
+		if one = strings.TrimSpace(one); one != "" {
+			result = append(result, one)
+		}
+	}
+
+	return result
+}
@@ -135,6 +135,8 @@ type ClientEventSpec struct {
 	ReleaseChangeFailedReason string                 `protobuf:"bytes,11,opt,name=release_change_failed_reason,json=releaseChangeFailedReason,proto3" json:"release_change_failed_reason,omitempty"`
 	FailedDetailReason        string                 `protobuf:"bytes,12,opt,name=failed_detail_reason,json=failedDetailReason,proto3" json:"failed_detail_reason,omitempty"`
 	SpecificFailedReason      string                 `protobuf:"bytes,13,opt,name=specific_failed_reason,json=specificFailedReason,proto3" json:"specific_failed_reason,omitempty"`
+	CurrentStage              string                 `protobuf:"bytes,14,opt,name=current_stage,json=currentStage,proto3" json:"current_stage,omitempty"`
+	Stages                    []*ClientEventStage    `protobuf:"bytes,15,rep,name=stages,proto3" json:"stages,omitempty"`
 }
 
 func (x *ClientEventSpec) Reset() {
@@ -258,6 +260,98 @@ func (x *ClientEventSpec) GetSpecificFailedReason() string {
 	return ""
 }
 
+func (x *ClientEventSpec) GetCurrentStage() string {
+	if x != nil {
+		return x.CurrentStage
+	}
+	return ""
+}
+
+func (x *ClientEventSpec) GetStages() []*ClientEventStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+// ClientEventStage source resource reference: pkg/dal/table/client-event.go
+type ClientEventStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stage     string                 `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Status    string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Seconds   float64                `protobuf:"fixed64,5,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (x *ClientEventStage) Reset() {
+	*x = ClientEventStage{}
+	mi := &file_client_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientEventStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientEventStage) ProtoMessage() {}
+
+func (x *ClientEventStage) ProtoReflect() protoreflect.Message {
+	mi := &file_client_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientEventStage.ProtoReflect.Descriptor instead.
+func (*ClientEventStage) Descriptor() ([]byte, []int) {
+	return file_client_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClientEventStage) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *ClientEventStage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ClientEventStage) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ClientEventStage) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ClientEventStage) GetSeconds() float64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
 // ClientEventAttachment source resource reference: pkg/dal/table/client-event.go
 type ClientEventAttachment struct {
 	state         protoimpl.MessageState
@@ -274,7 +368,7 @@ type ClientEventAttachment struct {
 
 func (x *ClientEventAttachment) Reset() {
 	*x = ClientEventAttachment{}
-	mi := &file_client_event_proto_msgTypes[2]
+	mi := &file_client_event_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +380,7 @@ func (x *ClientEventAttachment) String() string {
 func (*ClientEventAttachment) ProtoMessage() {}
 
 func (x *ClientEventAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_client_event_proto_msgTypes[2]
+	mi := &file_client_event_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +393,7 @@ func (x *ClientEventAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientEventAttachment.ProtoReflect.Descriptor instead.
 func (*ClientEventAttachment) Descriptor() ([]byte, []int) {
-	return file_client_event_proto_rawDescGZIP(), []int{2}
+	return file_client_event_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ClientEventAttachment) GetClientId() uint32 {
@@ -376,7 +470,7 @@ var file_client_event_proto_rawDesc = []byte{
 	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
 	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb4, 0x07, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc7, 0x08, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
 	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x40, 0x0a, 0x13, 0x6f, 0x72, 0x69,
 	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe6, 0xba, 0x90,
@@ -435,31 +529,64 @@ var file_client_event_proto_rawDesc = []byte{
 	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92,
 	0x41, 0x14, 0x32, 0x12, 0xe5, 0x85, 0xb7, 0xe4, 0xbd, 0x93, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5,
 	0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x14, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
-	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa6, 0x02, 0x0a,
-	0x15, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b,
-	0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7,
-	0xab, 0xaf, 0x55, 0x49, 0x44, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69,
-	0x7a, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
-	0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64,
-	0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52,
-	0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d,
-	0x32, 0x1b, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0xb9, 0xe5, 0xbc, 0x8f, 0xef, 0xbc,
-	0x9a, 0x70, 0x75, 0x6c, 0x6c, 0xe3, 0x80, 0x81, 0x77, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x63, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
-	0x0a, 0x32, 0x08, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x08, 0x63, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x49, 0x64, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b,
-	0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x2d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x3b, 0x70, 0x62, 0x63, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d,
+	0xe6, 0x89, 0x80, 0xe5, 0xa4, 0x84, 0xe7, 0x9a, 0x84, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe9,
+	0x98, 0xb6, 0xe6, 0xae, 0xb5, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x42, 0x1a, 0x92, 0x41, 0x17,
+	0x32, 0x15, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0xe7, 0xba, 0xbf, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x22,
+	0xfc, 0x02, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x12, 0x6c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x56, 0x92, 0x41, 0x53, 0x32, 0x51, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4,
+	0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xef, 0xbc, 0x9a, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0xe3, 0x80, 0x81, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0xe3,
+	0x80, 0x81, 0x70, 0x72, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0xe3, 0x80, 0x81, 0x77, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0xe3, 0x80, 0x81, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x6f, 0x6b,
+	0xe3, 0x80, 0x81, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe7,
+	0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x17, 0x92,
+	0x41, 0x14, 0x32, 0x12, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b,
+	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x4e, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe7, 0xbb, 0x93, 0xe6,
+	0x9d, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe8,
+	0x80, 0x97, 0xe6, 0x97, 0xb6, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xa6,
+	0x02, 0x0a, 0x15, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x10, 0x92, 0x41, 0x0d,
+	0x32, 0x0b, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x49, 0x44, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0xa2, 0xe6, 0x88,
+	0xb7, 0xe7, 0xab, 0xaf, 0x55, 0x49, 0x44, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x06,
+	0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49,
+	0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92,
+	0x41, 0x1d, 0x32, 0x1b, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0xb9, 0xe5, 0xbc, 0x8f,
+	0xef, 0xbc, 0x9a, 0x70, 0x75, 0x6c, 0x6c, 0xe3, 0x80, 0x81, 0x77, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75,
+	0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x3b, 0x70, 0x62, 0x63,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -474,24 +601,28 @@ func file_client_event_proto_rawDescGZIP() []byte {
 	return file_client_event_proto_rawDescData
 }
 
-var file_client_event_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_client_event_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_client_event_proto_goTypes = []any{
 	(*ClientEvent)(nil),           // 0: pbce.ClientEvent
 	(*ClientEventSpec)(nil),       // 1: pbce.ClientEventSpec
-	(*ClientEventAttachment)(nil), // 2: pbce.ClientEventAttachment
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*ClientEventStage)(nil),      // 2: pbce.ClientEventStage
+	(*ClientEventAttachment)(nil), // 3: pbce.ClientEventAttachment
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
 }
 var file_client_event_proto_depIdxs = []int32{
 	1, // 0: pbce.ClientEvent.spec:type_name -> pbce.ClientEventSpec
-	2, // 1: pbce.ClientEvent.attachment:type_name -> pbce.ClientEventAttachment
-	3, // 2: pbce.ClientEvent.heartbeat_time:type_name -> google.protobuf.Timestamp
-	3, // 3: pbce.ClientEventSpec.start_time:type_name -> google.protobuf.Timestamp
-	3, // 4: pbce.ClientEventSpec.end_time:type_name -> google.protobuf.Timestamp
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	3, // 1: pbce.ClientEvent.attachment:type_name -> pbce.ClientEventAttachment
+	4, // 2: pbce.ClientEvent.heartbeat_time:type_name -> google.protobuf.Timestamp
+	4, // 3: pbce.ClientEventSpec.start_time:type_name -> google.protobuf.Timestamp
+	4, // 4: pbce.ClientEventSpec.end_time:type_name -> google.protobuf.Timestamp
+	2, // 5: pbce.ClientEventSpec.stages:type_name -> pbce.ClientEventStage
+	4, // 6: pbce.ClientEventStage.start_time:type_name -> google.protobuf.Timestamp
+	4, // 7: pbce.ClientEventStage.end_time:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_client_event_proto_init() }
@@ -505,7 +636,7 @@ func file_client_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_client_event_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
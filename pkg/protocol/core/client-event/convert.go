@@ -39,9 +39,45 @@ func (c *ClientEventSpec) ClientEventSpec() *table.ClientEventSpec {
 		ReleaseChangeFailedReason: c.ReleaseChangeFailedReason,
 		FailedDetailReason:        c.FailedDetailReason,
 		SpecificFailedReason:      c.SpecificFailedReason,
+		CurrentStage:              c.CurrentStage,
+		Stages:                    ClientEventStages(c.Stages),
 	}
 }
 
+// ClientEventStages convert pb ClientEventStage to table ClientEventStages
+func ClientEventStages(stages []*ClientEventStage) table.ClientEventStages {
+	if len(stages) == 0 {
+		return nil
+	}
+
+	result := make(table.ClientEventStages, 0, len(stages))
+	for _, v := range stages {
+		result = append(result, &table.ClientEventStage{
+			Stage:     v.Stage,
+			Status:    table.Status(v.Status),
+			StartTime: v.StartTime.AsTime().UTC(),
+			EndTime:   v.EndTime.AsTime().UTC(),
+			Seconds:   v.Seconds,
+		})
+	}
+	return result
+}
+
+// PbClientEventStages convert table ClientEventStages to pb ClientEventStage
+func PbClientEventStages(stages table.ClientEventStages) []*ClientEventStage {
+	result := make([]*ClientEventStage, 0, len(stages))
+	for _, v := range stages {
+		result = append(result, &ClientEventStage{
+			Stage:     v.Stage,
+			Status:    string(v.Status),
+			StartTime: timestamppb.New(v.StartTime.UTC()),
+			EndTime:   timestamppb.New(v.EndTime.UTC()),
+			Seconds:   v.Seconds,
+		})
+	}
+	return result
+}
+
 // PbClientEventSpec convert table ClientEventSpec to pb ClientEventSpec
 func PbClientEventSpec(spec *table.ClientEventSpec) *ClientEventSpec { //nolint:revive
 	if spec == nil {
@@ -62,6 +98,8 @@ func PbClientEventSpec(spec *table.ClientEventSpec) *ClientEventSpec { //nolint:
 		ReleaseChangeFailedReason: spec.ReleaseChangeFailedReason,
 		SpecificFailedReason:      spec.SpecificFailedReason,
 		FailedDetailReason:        spec.FailedDetailReason,
+		CurrentStage:              spec.CurrentStage,
+		Stages:                    PbClientEventStages(spec.Stages),
 	}
 }
 
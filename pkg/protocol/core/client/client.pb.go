@@ -439,6 +439,124 @@ func (x *ClientResource) GetMemoryAvgUsage() uint64 {
 	return 0
 }
 
+// ClientMetricTrend source resource reference: pkg/types/client.go
+type ClientMetricTrend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BucketTime      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket_time,json=bucketTime,proto3" json:"bucket_time,omitempty"`
+	ClientVersion   string                 `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	ClientCount     uint32                 `protobuf:"varint,3,opt,name=client_count,json=clientCount,proto3" json:"client_count,omitempty"`
+	CpuAvgUsage     float64                `protobuf:"fixed64,4,opt,name=cpu_avg_usage,json=cpuAvgUsage,proto3" json:"cpu_avg_usage,omitempty"`
+	CpuMaxUsage     float64                `protobuf:"fixed64,5,opt,name=cpu_max_usage,json=cpuMaxUsage,proto3" json:"cpu_max_usage,omitempty"`
+	MemoryAvgUsage  uint64                 `protobuf:"varint,6,opt,name=memory_avg_usage,json=memoryAvgUsage,proto3" json:"memory_avg_usage,omitempty"`
+	MemoryMaxUsage  uint64                 `protobuf:"varint,7,opt,name=memory_max_usage,json=memoryMaxUsage,proto3" json:"memory_max_usage,omitempty"`
+	PullCount       uint64                 `protobuf:"varint,8,opt,name=pull_count,json=pullCount,proto3" json:"pull_count,omitempty"`
+	PullFailedCount uint64                 `protobuf:"varint,9,opt,name=pull_failed_count,json=pullFailedCount,proto3" json:"pull_failed_count,omitempty"`
+	PullAvgSeconds  float64                `protobuf:"fixed64,10,opt,name=pull_avg_seconds,json=pullAvgSeconds,proto3" json:"pull_avg_seconds,omitempty"`
+}
+
+func (x *ClientMetricTrend) Reset() {
+	*x = ClientMetricTrend{}
+	mi := &file_client_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientMetricTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientMetricTrend) ProtoMessage() {}
+
+func (x *ClientMetricTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientMetricTrend.ProtoReflect.Descriptor instead.
+func (*ClientMetricTrend) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClientMetricTrend) GetBucketTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BucketTime
+	}
+	return nil
+}
+
+func (x *ClientMetricTrend) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *ClientMetricTrend) GetClientCount() uint32 {
+	if x != nil {
+		return x.ClientCount
+	}
+	return 0
+}
+
+func (x *ClientMetricTrend) GetCpuAvgUsage() float64 {
+	if x != nil {
+		return x.CpuAvgUsage
+	}
+	return 0
+}
+
+func (x *ClientMetricTrend) GetCpuMaxUsage() float64 {
+	if x != nil {
+		return x.CpuMaxUsage
+	}
+	return 0
+}
+
+func (x *ClientMetricTrend) GetMemoryAvgUsage() uint64 {
+	if x != nil {
+		return x.MemoryAvgUsage
+	}
+	return 0
+}
+
+func (x *ClientMetricTrend) GetMemoryMaxUsage() uint64 {
+	if x != nil {
+		return x.MemoryMaxUsage
+	}
+	return 0
+}
+
+func (x *ClientMetricTrend) GetPullCount() uint64 {
+	if x != nil {
+		return x.PullCount
+	}
+	return 0
+}
+
+func (x *ClientMetricTrend) GetPullFailedCount() uint64 {
+	if x != nil {
+		return x.PullFailedCount
+	}
+	return 0
+}
+
+func (x *ClientMetricTrend) GetPullAvgSeconds() float64 {
+	if x != nil {
+		return x.PullAvgSeconds
+	}
+	return 0
+}
+
 // ClientQueryCondition 客户端查询条件
 type ClientQueryCondition struct {
 	state         protoimpl.MessageState
@@ -463,7 +581,7 @@ type ClientQueryCondition struct {
 
 func (x *ClientQueryCondition) Reset() {
 	*x = ClientQueryCondition{}
-	mi := &file_client_proto_msgTypes[4]
+	mi := &file_client_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -475,7 +593,7 @@ func (x *ClientQueryCondition) String() string {
 func (*ClientQueryCondition) ProtoMessage() {}
 
 func (x *ClientQueryCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[4]
+	mi := &file_client_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +606,7 @@ func (x *ClientQueryCondition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientQueryCondition.ProtoReflect.Descriptor instead.
 func (*ClientQueryCondition) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{4}
+	return file_client_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ClientQueryCondition) GetUid() string {
@@ -607,7 +725,7 @@ type ClientCommonReq struct {
 
 func (x *ClientCommonReq) Reset() {
 	*x = ClientCommonReq{}
-	mi := &file_client_proto_msgTypes[5]
+	mi := &file_client_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -619,7 +737,7 @@ func (x *ClientCommonReq) String() string {
 func (*ClientCommonReq) ProtoMessage() {}
 
 func (x *ClientCommonReq) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[5]
+	mi := &file_client_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,7 +750,7 @@ func (x *ClientCommonReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientCommonReq.ProtoReflect.Descriptor instead.
 func (*ClientCommonReq) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{5}
+	return file_client_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ClientCommonReq) GetBizId() uint32 {
@@ -837,102 +955,146 @@ var file_client_proto_rawDesc = []byte{
 	0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0x92, 0x41,
 	0x17, 0x32, 0x15, 0xe5, 0xb9, 0xb3, 0xe5, 0x9d, 0x87, 0xe5, 0x86, 0x85, 0xe5, 0xad, 0x98, 0xe4,
 	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe7, 0x8e, 0x87, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x41, 0x76, 0x67, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9a, 0x07, 0x0a, 0x14, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x23, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
-	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x55, 0x49,
-	0x44, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7,
-	0xab, 0xaf, 0x49, 0x50, 0x52, 0x02, 0x69, 0x70, 0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae,
-	0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x52, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x12, 0x43, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
-	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7,
-	0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x13, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x9b, 0xae,
-	0xe6, 0xa0, 0x87, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x78, 0x0a, 0x15,
-	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x44, 0x92, 0x41, 0x41,
-	0x32, 0x3f, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe6, 0x8b,
-	0x89, 0xe5, 0x8f, 0x96, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0xe3, 0x80, 0x81, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0xe3, 0x80, 0x81,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0xe3, 0x80, 0x81, 0x53, 0x6b, 0x69,
-	0x70, 0x52, 0x13, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4c, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x99, 0x84, 0xe5, 0x8a,
-	0xa0, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x24, 0x92, 0x41, 0x21,
-	0x32, 0x1f, 0xe5, 0x9c, 0xa8, 0xe7, 0xba, 0xbf, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc,
-	0x9a, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0xe3, 0x80, 0x81, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
-	0x65, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe5, 0xae,
-	0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0xbb, 0x84, 0xe4, 0xbb, 0xb6, 0xe7, 0x89, 0x88,
-	0xe6, 0x9c, 0xac, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe5, 0xae,
-	0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0xbb, 0x84, 0xe4, 0xbb, 0xb6, 0xe7, 0xb1, 0xbb,
-	0xe5, 0x9e, 0x8b, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x45, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe5, 0xae, 0xa2,
-	0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe4, 0xb8, 0xbb, 0xe7,
-	0xb1, 0xbb, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe6, 0x8b, 0x89, 0xe5,
-	0x8f, 0x96, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50,
-	0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x70,
-	0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17,
-	0x92, 0x41, 0x14, 0x32, 0x12, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x8b, 0x89, 0xe5, 0x8f,
-	0x96, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x50, 0x75, 0x6c, 0x6c,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe5,
-	0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x49, 0x44, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0xfa, 0x03, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
-	0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12,
-	0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42,
-	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05,
-	0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x90, 0x9c, 0xe7,
-	0xb4, 0xa2, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x12, 0x41, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92,
-	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xbf, 0x83, 0xe8, 0xb7, 0xb3, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
-	0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x8b, 0x89,
-	0xe5, 0x8f, 0x96, 0xe8, 0xb6, 0x8b, 0xe5, 0x8a, 0xbf, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52,
-	0x08, 0x70, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x69, 0x73, 0x5f,
-	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe8, 0xb6, 0x8b,
-	0xe5, 0x8a, 0xbf, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8e, 0xbb, 0xe9, 0x87, 0x8d, 0x52,
-	0x0c, 0x69, 0x73, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a,
-	0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0x8c, 0xba, 0xe5, 0x88, 0x86, 0xe6,
-	0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0xbb, 0xe7, 0xbb, 0xb4, 0xe5, 0xba,
-	0xa6, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x53, 0x0a,
-	0x0c, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x17, 0x92, 0x41,
-	0x14, 0x32, 0x12, 0xe5, 0x89, 0xaf, 0xe7, 0xbb, 0xb4, 0xe5, 0xba, 0xa6, 0xe7, 0x9a, 0x84, 0xe6,
-	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65,
-	0x79, 0x73, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67,
-	0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x3b, 0x70, 0x62, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x41, 0x76, 0x67, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc0, 0x05, 0x0a, 0x11, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x57,
+	0x0a, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0xa1, 0xb6, 0xe5,
+	0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7,
+	0x89, 0x88, 0xe6, 0x9c, 0xac, 0xef, 0xbc, 0x8c, 0xe6, 0x8c, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c,
+	0xac, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe6, 0x97, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x37, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0xa2, 0xe6,
+	0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x0b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x70, 0x75, 0x5f,
+	0x61, 0x76, 0x67, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0xb9, 0xb3, 0xe5, 0x9d, 0x87, 0x63, 0x70, 0x75, 0xe4,
+	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe7, 0x8e, 0x87, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x41, 0x76, 0x67,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x70, 0x75, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0x92, 0x41,
+	0x14, 0x32, 0x12, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0x63, 0x70, 0x75, 0xe4, 0xbd, 0xbf, 0xe7,
+	0x94, 0xa8, 0xe7, 0x8e, 0x87, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x61, 0x76, 0x67,
+	0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0x92, 0x41,
+	0x14, 0x32, 0x12, 0xe5, 0xb9, 0xb3, 0xe5, 0x9d, 0x87, 0xe5, 0x86, 0x85, 0xe5, 0xad, 0x98, 0xe4,
+	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x41, 0x76, 0x67,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe5, 0x86, 0x85, 0xe5,
+	0xad, 0x98, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x61, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x75, 0x6c, 0x6c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0, 0x52,
+	0x09, 0x70, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x11, 0x70, 0x75,
+	0x6c, 0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x8b, 0x89, 0xe5,
+	0x8f, 0x96, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0, 0x52, 0x0f,
+	0x70, 0x75, 0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x4a, 0x0a, 0x10, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b,
+	0xe5, 0xb9, 0xb3, 0xe5, 0x9d, 0x87, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe8, 0x80, 0x97, 0xe6,
+	0x97, 0xb6, 0xef, 0xbc, 0x88, 0xe7, 0xa7, 0x92, 0xef, 0xbc, 0x89, 0x52, 0x0e, 0x70, 0x75, 0x6c,
+	0x6c, 0x41, 0x76, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x9a, 0x07, 0x0a, 0x14,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab,
+	0xaf, 0x55, 0x49, 0x44, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x02, 0x69, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe5, 0xae, 0xa2, 0xe6,
+	0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x49, 0x50, 0x52, 0x02, 0x69, 0x70, 0x12, 0x2a, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32,
+	0x0f, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x43, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xbd, 0x93, 0xe5,
+	0x89, 0x8d, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x13,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x11, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x78, 0x0a, 0x15, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x44,
+	0x92, 0x41, 0x41, 0x32, 0x3f, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac,
+	0xa1, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0xe3, 0x80, 0x81, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0xe3, 0x80, 0x81, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0xe3, 0x80, 0x81,
+	0x53, 0x6b, 0x69, 0x70, 0x52, 0x13, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4c, 0x0a, 0x0b, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x99,
+	0x84, 0xe5, 0x8a, 0xa0, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x24,
+	0x92, 0x41, 0x21, 0x32, 0x1f, 0xe5, 0x9c, 0xa8, 0xe7, 0xba, 0xbf, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
+	0x81, 0xef, 0xbc, 0x9a, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0xe3, 0x80, 0x81, 0x6f, 0x66, 0x66,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32,
+	0x15, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0xbb, 0x84, 0xe4, 0xbb, 0xb6,
+	0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32,
+	0x15, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0xbb, 0x84, 0xe4, 0xbb, 0xb6,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b,
+	0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe4,
+	0xb8, 0xbb, 0xe7, 0xb1, 0xbb, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x0c, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe6,
+	0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0d, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x65, 0x6e,
+	0x64, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x8b,
+	0x89, 0xe5, 0x8f, 0x96, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x50,
+	0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x10, 0x92, 0x41, 0x0d,
+	0x32, 0x0b, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x49, 0x44, 0x52, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0xfa, 0x03, 0x0a, 0x0f, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x06,
+	0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49,
+	0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6,
+	0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0x52, 0x06, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x41, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xbf, 0x83, 0xe8, 0xb7, 0xb3, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12,
+	0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe8, 0xb6, 0x8b, 0xe5, 0x8a, 0xbf, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0x52, 0x08, 0x70, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0d,
+	0x69, 0x73, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96,
+	0xe8, 0xb6, 0x8b, 0xe5, 0x8a, 0xbf, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8e, 0xbb, 0xe9,
+	0x87, 0x8d, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x3e, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0x8c, 0xba, 0xe5,
+	0x88, 0x86, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0xbb, 0xe7, 0xbb,
+	0xb4, 0xe5, 0xba, 0xa6, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79,
+	0x12, 0x53, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0x89, 0xaf, 0xe7, 0xbb, 0xb4, 0xe5, 0xba, 0xa6, 0xe7,
+	0x9a, 0x84, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b,
+	0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x3b, 0x70, 0x62, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -947,31 +1109,33 @@ func file_client_proto_rawDescGZIP() []byte {
 	return file_client_proto_rawDescData
 }
 
-var file_client_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_client_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_client_proto_goTypes = []any{
 	(*Client)(nil),                // 0: pbclient.Client
 	(*ClientSpec)(nil),            // 1: pbclient.ClientSpec
 	(*ClientAttachment)(nil),      // 2: pbclient.ClientAttachment
 	(*ClientResource)(nil),        // 3: pbclient.ClientResource
-	(*ClientQueryCondition)(nil),  // 4: pbclient.ClientQueryCondition
-	(*ClientCommonReq)(nil),       // 5: pbclient.ClientCommonReq
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),       // 7: google.protobuf.Struct
+	(*ClientMetricTrend)(nil),     // 4: pbclient.ClientMetricTrend
+	(*ClientQueryCondition)(nil),  // 5: pbclient.ClientQueryCondition
+	(*ClientCommonReq)(nil),       // 6: pbclient.ClientCommonReq
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 8: google.protobuf.Struct
 }
 var file_client_proto_depIdxs = []int32{
 	1, // 0: pbclient.Client.spec:type_name -> pbclient.ClientSpec
 	2, // 1: pbclient.Client.attachment:type_name -> pbclient.ClientAttachment
-	6, // 2: pbclient.ClientSpec.first_connect_time:type_name -> google.protobuf.Timestamp
-	6, // 3: pbclient.ClientSpec.last_heartbeat_time:type_name -> google.protobuf.Timestamp
+	7, // 2: pbclient.ClientSpec.first_connect_time:type_name -> google.protobuf.Timestamp
+	7, // 3: pbclient.ClientSpec.last_heartbeat_time:type_name -> google.protobuf.Timestamp
 	3, // 4: pbclient.ClientSpec.resource:type_name -> pbclient.ClientResource
-	7, // 5: pbclient.ClientQueryCondition.annotations:type_name -> google.protobuf.Struct
-	4, // 6: pbclient.ClientCommonReq.search:type_name -> pbclient.ClientQueryCondition
-	7, // 7: pbclient.ClientCommonReq.foreign_keys:type_name -> google.protobuf.Struct
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	7, // 5: pbclient.ClientMetricTrend.bucket_time:type_name -> google.protobuf.Timestamp
+	8, // 6: pbclient.ClientQueryCondition.annotations:type_name -> google.protobuf.Struct
+	5, // 7: pbclient.ClientCommonReq.search:type_name -> pbclient.ClientQueryCondition
+	8, // 8: pbclient.ClientCommonReq.foreign_keys:type_name -> google.protobuf.Struct
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_client_proto_init() }
@@ -985,7 +1149,7 @@ func file_client_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_client_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -14,6 +14,8 @@
 package pbcin
 
 import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 )
@@ -64,6 +66,11 @@ func PbConfigInstanceWithDetails(ci *table.ConfigInstance, configTemplateName st
 		ConfigVersionMemo:        configVersionMemo,
 		Revision:                 pbbase.PbRevision(ci.Revision),
 		LatestTemplateRevisionId: configVersionID,
+		DriftStatus:              string(ci.Attachment.DriftStatus),
+	}
+
+	if ci.Attachment.DriftCheckedAt != nil {
+		pbCI.DriftCheckedAt = timestamppb.New(*ci.Attachment.DriftCheckedAt)
 	}
 
 	// Fill process related fields
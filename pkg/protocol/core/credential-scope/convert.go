@@ -14,8 +14,12 @@
 package pbcrs
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/credential"
 )
 
 // CredentialAttachment convert pb CredentialAttachment to table CredentialScopeAttachment
@@ -79,11 +83,57 @@ func PbCredentialScopeSpec(spec *table.CredentialScopeSpec) (*CredentialScopeSpe
 	}
 
 	return &CredentialScopeSpec{
-		App:   app,
-		Scope: scope,
+		App:         app,
+		Scope:       scope,
+		Restriction: PbScopeRestriction(spec),
 	}, nil
 }
 
+// PbScopeRestriction convert table CredentialScopeSpec to pb ScopeRestriction
+func PbScopeRestriction(spec *table.CredentialScopeSpec) *ScopeRestriction {
+	if spec == nil {
+		return nil
+	}
+
+	r := &ScopeRestriction{
+		KvKeyPattern:   spec.KvKeyPattern,
+		KvKeyMatchType: string(spec.KvKeyMatchType),
+		Labels:         spec.Labels,
+		Permission:     string(spec.Permission),
+	}
+	if spec.ExpiredAt != nil {
+		r.ExpiredAt = spec.ExpiredAt.UTC().Format(time.RFC3339)
+	}
+
+	return r
+}
+
+// ApplyTo set the restriction to table CredentialScopeSpec
+func (m *ScopeRestriction) ApplyTo(spec *table.CredentialScopeSpec) error {
+	spec.Permission = credential.Watch
+	if m == nil {
+		return nil
+	}
+
+	spec.KvKeyPattern = m.KvKeyPattern
+	spec.KvKeyMatchType = credential.MatchType(m.KvKeyMatchType)
+	spec.Labels = m.Labels
+	if m.Permission != "" {
+		spec.Permission = credential.Permission(m.Permission)
+	}
+
+	if m.ExpiredAt != "" {
+		expiredAt, err := time.Parse(time.RFC3339, m.ExpiredAt)
+		if err != nil {
+			return fmt.Errorf("invalid expired_at %s, should be RFC3339 format, err: %v", m.ExpiredAt, err)
+		}
+		expiredAt = expiredAt.UTC()
+		spec.ExpiredAt = &expiredAt
+	}
+
+	return nil
+}
+
 // PbCredentialScopeAttachment convert table CredentialScopeAttachment to pb CredentialScopeAttachment
 func PbCredentialScopeAttachment(at *table.CredentialScopeAttachment) *CredentialScopeAttachment {
 	if at == nil {
@@ -149,8 +149,9 @@ type CredentialScopeSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	App   string `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
-	Scope string `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	App         string            `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	Scope       string            `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	Restriction *ScopeRestriction `protobuf:"bytes,3,opt,name=restriction,proto3" json:"restriction,omitempty"`
 }
 
 func (x *CredentialScopeSpec) Reset() {
@@ -197,19 +198,104 @@ func (x *CredentialScopeSpec) GetScope() string {
 	return ""
 }
 
+func (x *CredentialScopeSpec) GetRestriction() *ScopeRestriction {
+	if x != nil {
+		return x.Restriction
+	}
+	return nil
+}
+
+type ScopeRestriction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KvKeyPattern   string            `protobuf:"bytes,1,opt,name=kv_key_pattern,json=kvKeyPattern,proto3" json:"kv_key_pattern,omitempty"`
+	KvKeyMatchType string            `protobuf:"bytes,2,opt,name=kv_key_match_type,json=kvKeyMatchType,proto3" json:"kv_key_match_type,omitempty"`
+	Labels         map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Permission     string            `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	ExpiredAt      string            `protobuf:"bytes,5,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+}
+
+func (x *ScopeRestriction) Reset() {
+	*x = ScopeRestriction{}
+	mi := &file_credential_scope_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScopeRestriction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScopeRestriction) ProtoMessage() {}
+
+func (x *ScopeRestriction) ProtoReflect() protoreflect.Message {
+	mi := &file_credential_scope_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScopeRestriction.ProtoReflect.Descriptor instead.
+func (*ScopeRestriction) Descriptor() ([]byte, []int) {
+	return file_credential_scope_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScopeRestriction) GetKvKeyPattern() string {
+	if x != nil {
+		return x.KvKeyPattern
+	}
+	return ""
+}
+
+func (x *ScopeRestriction) GetKvKeyMatchType() string {
+	if x != nil {
+		return x.KvKeyMatchType
+	}
+	return ""
+}
+
+func (x *ScopeRestriction) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ScopeRestriction) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ScopeRestriction) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
 type UpdateScopeSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	App   string `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
-	Scope string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	Id          uint32            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	App         string            `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+	Scope       string            `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	Restriction *ScopeRestriction `protobuf:"bytes,4,opt,name=restriction,proto3" json:"restriction,omitempty"`
 }
 
 func (x *UpdateScopeSpec) Reset() {
 	*x = UpdateScopeSpec{}
-	mi := &file_credential_scope_proto_msgTypes[3]
+	mi := &file_credential_scope_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -221,7 +307,7 @@ func (x *UpdateScopeSpec) String() string {
 func (*UpdateScopeSpec) ProtoMessage() {}
 
 func (x *UpdateScopeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_credential_scope_proto_msgTypes[3]
+	mi := &file_credential_scope_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,7 +320,7 @@ func (x *UpdateScopeSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateScopeSpec.ProtoReflect.Descriptor instead.
 func (*UpdateScopeSpec) Descriptor() ([]byte, []int) {
-	return file_credential_scope_proto_rawDescGZIP(), []int{3}
+	return file_credential_scope_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateScopeSpec) GetId() uint32 {
@@ -258,6 +344,13 @@ func (x *UpdateScopeSpec) GetScope() string {
 	return ""
 }
 
+func (x *UpdateScopeSpec) GetRestriction() *ScopeRestriction {
+	if x != nil {
+		return x.Restriction
+	}
+	return nil
+}
+
 var File_credential_scope_proto protoreflect.FileDescriptor
 
 var file_credential_scope_proto_rawDesc = []byte{
@@ -290,28 +383,69 @@ var file_credential_scope_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
 	0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x63,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5,
-	0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x63,
-	0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x05, 0x73, 0x63,
-	0x6f, 0x70, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63,
-	0x6f, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x32, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x1d, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7,
-	0xe7, 0xab, 0xaf, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe6,
-	0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x61,
-	0x70, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6,
-	0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x03, 0x61, 0x70, 0x70,
-	0x12, 0x27, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe8, 0xa7, 0x84, 0xe5,
-	0x88, 0x99, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x4d, 0x5a, 0x4b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42,
-	0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72,
-	0x65, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x2d, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x3b, 0x70, 0x62, 0x63, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9e,
+	0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1,
+	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x63, 0x72,
+	0x73, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x87, 0x04, 0x0a, 0x10, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x0e, 0x6b, 0x76, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0x92, 0x41,
+	0x32, 0x32, 0x30, 0x6b, 0x76, 0x20, 0x6b, 0x65, 0x79, 0x20, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d,
+	0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x2c, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97,
+	0xb6, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe8, 0xa7, 0x84,
+	0xe5, 0x88, 0x99, 0x52, 0x0c, 0x6b, 0x76, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x12, 0x50, 0x0a, 0x11, 0x6b, 0x76, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x92, 0x41,
+	0x22, 0x32, 0x20, 0x6b, 0x76, 0x20, 0x6b, 0x65, 0x79, 0x20, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d,
+	0xe6, 0x96, 0xb9, 0xe5, 0xbc, 0x8f, 0x3a, 0x20, 0x67, 0x6c, 0x6f, 0x62, 0x2c, 0x20, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x52, 0x0e, 0x6b, 0x76, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x71, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x63, 0x72, 0x73, 0x2e, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x34, 0x92, 0x41, 0x31, 0x32, 0x2f, 0xe5,
+	0x85, 0x81, 0xe8, 0xae, 0xb8, 0xe5, 0xa3, 0xb0, 0xe6, 0x98, 0x8e, 0xe7, 0x9a, 0x84, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbe, 0x8b, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x2c, 0x20, 0xe5, 0x80, 0xbc, 0xe4,
+	0xb8, 0xba, 0x20, 0x67, 0x6c, 0x6f, 0x62, 0x20, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32,
+	0x18, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0x3a, 0x20, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x2c, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x33,
+	0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x2c, 0x20, 0x52, 0x46,
+	0x43, 0x33, 0x33, 0x33, 0x39, 0x20, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0x2c, 0x20, 0xe4, 0xb8,
+	0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe6, 0xb0, 0xb8, 0xe4, 0xb8, 0x8d, 0xe8, 0xbf, 0x87,
+	0xe6, 0x9c, 0x9f, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x39,
+	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xce, 0x01, 0x0a, 0x0f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x32, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x1d,
+	0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe5,
+	0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
+	0xb0, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x85, 0xb3, 0xe8,
+	0x81, 0x94, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x39, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x63, 0x72, 0x73, 0x2e, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x4d, 0x5a, 0x4b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x2d, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x3b, 0x70, 0x62, 0x63, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -326,23 +460,28 @@ func file_credential_scope_proto_rawDescGZIP() []byte {
 	return file_credential_scope_proto_rawDescData
 }
 
-var file_credential_scope_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_credential_scope_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_credential_scope_proto_goTypes = []any{
 	(*CredentialScopeAttachment)(nil), // 0: pbcrs.CredentialScopeAttachment
 	(*CredentialScopeList)(nil),       // 1: pbcrs.CredentialScopeList
 	(*CredentialScopeSpec)(nil),       // 2: pbcrs.CredentialScopeSpec
-	(*UpdateScopeSpec)(nil),           // 3: pbcrs.UpdateScopeSpec
-	(*base.Revision)(nil),             // 4: pbbase.Revision
+	(*ScopeRestriction)(nil),          // 3: pbcrs.ScopeRestriction
+	(*UpdateScopeSpec)(nil),           // 4: pbcrs.UpdateScopeSpec
+	nil,                               // 5: pbcrs.ScopeRestriction.LabelsEntry
+	(*base.Revision)(nil),             // 6: pbbase.Revision
 }
 var file_credential_scope_proto_depIdxs = []int32{
 	2, // 0: pbcrs.CredentialScopeList.spec:type_name -> pbcrs.CredentialScopeSpec
 	0, // 1: pbcrs.CredentialScopeList.attachment:type_name -> pbcrs.CredentialScopeAttachment
-	4, // 2: pbcrs.CredentialScopeList.revision:type_name -> pbbase.Revision
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	6, // 2: pbcrs.CredentialScopeList.revision:type_name -> pbbase.Revision
+	3, // 3: pbcrs.CredentialScopeSpec.restriction:type_name -> pbcrs.ScopeRestriction
+	5, // 4: pbcrs.ScopeRestriction.labels:type_name -> pbcrs.ScopeRestriction.LabelsEntry
+	3, // 5: pbcrs.UpdateScopeSpec.restriction:type_name -> pbcrs.ScopeRestriction
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_credential_scope_proto_init() }
@@ -356,7 +495,7 @@ func file_credential_scope_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_credential_scope_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -14,6 +14,9 @@
 package pbcredential
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 )
@@ -34,6 +37,21 @@ func (c *CredentialSpec) CredentialSpec() *table.CredentialSpec {
 	}
 }
 
+// ParseExpiredAt parse the RFC3339 expiry time of the credential, empty means never expired.
+func (c *CredentialSpec) ParseExpiredAt() (*time.Time, error) {
+	if c == nil || c.ExpiredAt == "" {
+		return nil, nil
+	}
+
+	expiredAt, err := time.Parse(time.RFC3339, c.ExpiredAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expired_at %s, should be RFC3339 format, err: %v", c.ExpiredAt, err)
+	}
+	expiredAt = expiredAt.UTC()
+
+	return &expiredAt, nil
+}
+
 // CredentialAttachment convert pb CredentialAttachment to table CredentialAttachment
 func (m *CredentialAttachment) CredentialAttachment() *table.CredentialAttachment {
 	if m == nil {
@@ -80,13 +98,25 @@ func PbCredentialSpec(spec *table.CredentialSpec) *CredentialSpec { //nolint:rev
 	}
 
 	return &CredentialSpec{
-		CredentialType: string(spec.CredentialType),
-		EncCredential:  spec.EncCredential,
-		EncAlgorithm:   spec.EncAlgorithm,
-		Enable:         spec.Enable,
-		Memo:           spec.Memo,
-		Name:           spec.Name,
+		CredentialType:    string(spec.CredentialType),
+		EncCredential:     spec.EncCredential,
+		EncAlgorithm:      spec.EncAlgorithm,
+		Enable:            spec.Enable,
+		Memo:              spec.Memo,
+		Name:              spec.Name,
+		ExpiredAt:         formatTime(spec.ExpiredAt),
+		PreviousExpiredAt: formatTime(spec.PreviousExpiredAt),
+		RotatedAt:         formatTime(spec.RotatedAt),
+		LastUsedAt:        formatTime(spec.LastUsedAt),
+	}
+}
+
+// formatTime format the optional time in RFC3339, nil is formatted to empty string.
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
 	}
+	return t.UTC().Format(time.RFC3339)
 }
 
 // PbCredentialAttachment convert table CredentialAttachment to pb CredentialAttachment
@@ -32,6 +32,7 @@ type CredentialList struct {
 	Attachment       *CredentialAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
 	Revision         *base.Revision        `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
 	CredentialScopes []string              `protobuf:"bytes,5,rep,name=credential_scopes,json=credentialScopes,proto3" json:"credential_scopes,omitempty"`
+	ClientCount      uint32                `protobuf:"varint,6,opt,name=client_count,json=clientCount,proto3" json:"client_count,omitempty"`
 }
 
 func (x *CredentialList) Reset() {
@@ -99,17 +100,28 @@ func (x *CredentialList) GetCredentialScopes() []string {
 	return nil
 }
 
+func (x *CredentialList) GetClientCount() uint32 {
+	if x != nil {
+		return x.ClientCount
+	}
+	return 0
+}
+
 type CredentialSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CredentialType string `protobuf:"bytes,1,opt,name=credential_type,json=credentialType,proto3" json:"credential_type,omitempty"`
-	EncCredential  string `protobuf:"bytes,2,opt,name=enc_credential,json=encCredential,proto3" json:"enc_credential,omitempty"`
-	EncAlgorithm   string `protobuf:"bytes,3,opt,name=enc_algorithm,json=encAlgorithm,proto3" json:"enc_algorithm,omitempty"`
-	Name           string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Memo           string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
-	Enable         bool   `protobuf:"varint,6,opt,name=enable,proto3" json:"enable,omitempty"`
+	CredentialType    string `protobuf:"bytes,1,opt,name=credential_type,json=credentialType,proto3" json:"credential_type,omitempty"`
+	EncCredential     string `protobuf:"bytes,2,opt,name=enc_credential,json=encCredential,proto3" json:"enc_credential,omitempty"`
+	EncAlgorithm      string `protobuf:"bytes,3,opt,name=enc_algorithm,json=encAlgorithm,proto3" json:"enc_algorithm,omitempty"`
+	Name              string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Memo              string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Enable            bool   `protobuf:"varint,6,opt,name=enable,proto3" json:"enable,omitempty"`
+	ExpiredAt         string `protobuf:"bytes,7,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	PreviousExpiredAt string `protobuf:"bytes,8,opt,name=previous_expired_at,json=previousExpiredAt,proto3" json:"previous_expired_at,omitempty"`
+	RotatedAt         string `protobuf:"bytes,9,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+	LastUsedAt        string `protobuf:"bytes,10,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 }
 
 func (x *CredentialSpec) Reset() {
@@ -184,6 +196,34 @@ func (x *CredentialSpec) GetEnable() bool {
 	return false
 }
 
+func (x *CredentialSpec) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+func (x *CredentialSpec) GetPreviousExpiredAt() string {
+	if x != nil {
+		return x.PreviousExpiredAt
+	}
+	return ""
+}
+
+func (x *CredentialSpec) GetRotatedAt() string {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return ""
+}
+
+func (x *CredentialSpec) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
 type CredentialAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -284,7 +324,7 @@ var file_credential_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
 	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x02, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x02, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
 	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0d, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7,
 	0xab, 0xaf, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30,
@@ -302,41 +342,68 @@ var file_credential_proto_rawDesc = []byte{
 	0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x92,
 	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99,
 	0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x73, 0x22, 0xd6, 0x02, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x53, 0x70, 0x65, 0x63, 0x12, 0x48, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f,
-	0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe5, 0x87, 0xad, 0xe8, 0xaf, 0x81, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
-	0x8b, 0xef, 0xbc, 0x9a, 0x28, 0x62, 0x65, 0x61, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x29, 0x52,
-	0x0e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x35, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e,
-	0x63, 0xe5, 0x87, 0xad, 0xe8, 0xaf, 0x81, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x5f, 0x61, 0x6c,
-	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92,
-	0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x63, 0xe7, 0xae, 0x97, 0xe6, 0xb3, 0x95, 0x52, 0x0c, 0x65,
-	0x6e, 0x63, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x25, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe8, 0xaf, 0xb4,
-	0xe6, 0x98, 0x8e, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x40, 0x0a, 0x06, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23,
-	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xef, 0xbc, 0x9a, 0xe6,
-	0x98, 0xaf, 0x3d, 0x74, 0x72, 0x75, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0xa6, 0x3d, 0x66, 0x61,
-	0x6c, 0x73, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x3c, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1,
-	0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x32, 0x0c, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x05,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b,
-	0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x3b, 0x70, 0x62, 0x63, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x12, 0x49, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe4,
+	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe8, 0xaf, 0xa5, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe7, 0x9a,
+	0x84, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
+	0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb7, 0x05,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x48, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a,
+	0xe5, 0x87, 0xad, 0xe8, 0xaf, 0x81, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x28,
+	0x62, 0x65, 0x61, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x29, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x0e, 0x65, 0x6e,
+	0x63, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x63, 0xe5, 0x87, 0xad, 0xe8,
+	0xaf, 0x81, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x33, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65,
+	0x6e, 0x63, 0xe7, 0xae, 0x97, 0xe6, 0xb3, 0x95, 0x52, 0x0c, 0x65, 0x6e, 0x63, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xaf, 0x86, 0xe9, 0x92,
+	0xa5, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x32, 0x0c, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe8, 0xaf, 0xb4, 0xe6, 0x98, 0x8e, 0x52, 0x04,
+	0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x40, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+	0xa6, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xef, 0xbc, 0x9a, 0xe6, 0x98, 0xaf, 0x3d, 0x74, 0x72,
+	0x75, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0xa6, 0x3d, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x06,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x32,
+	0x37, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c,
+	0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x8c,
+	0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0xb0, 0xb8, 0xe4,
+	0xb8, 0x8d, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x63, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e, 0xe8, 0xbd, 0xae, 0xe6, 0x8d, 0xa2, 0xe5, 0x89, 0x8d,
+	0xe7, 0x9a, 0x84, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0xe6,
+	0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41,
+	0x2a, 0x32, 0x28, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe8,
+	0xbd, 0xae, 0xe6, 0x8d, 0xa2, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0x52, 0x46,
+	0x43, 0x33, 0x33, 0x33, 0x39, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0x52, 0x09, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4f, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41,
+	0x2a, 0x32, 0x28, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe4,
+	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0x52, 0x46,
+	0x43, 0x33, 0x33, 0x33, 0x39, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0x52, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3c, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05,
+	0x62, 0x69, 0x7a, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x85,
+	0xb3, 0xe8, 0x81, 0x94, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f,
+	0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x3b, 0x70, 0x62, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
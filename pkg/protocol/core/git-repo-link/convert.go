@@ -0,0 +1,117 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbgrl provides git repository link core protocol struct and convert functions.
+package pbgrl
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// GitRepoLinkSpec convert pb GitRepoLinkSpec to table GitRepoLinkSpec
+func (g *GitRepoLinkSpec) GitRepoLinkSpec() *table.GitRepoLinkSpec {
+	if g == nil {
+		return nil
+	}
+
+	return &table.GitRepoLinkSpec{
+		RepoURL:      g.RepoUrl,
+		Ref:          g.Ref,
+		SubPath:      g.SubPath,
+		Username:     g.Username,
+		SyncInterval: g.SyncInterval,
+		ReplaceAll:   g.ReplaceAll,
+		Memo:         g.Memo,
+	}
+}
+
+// PbGitRepoLinks convert table GitRepoLink to pb GitRepoLink
+func PbGitRepoLinks(links []*table.GitRepoLink) []*GitRepoLink {
+	result := make([]*GitRepoLink, 0, len(links))
+	for _, one := range links {
+		result = append(result, PbGitRepoLink(one))
+	}
+
+	return result
+}
+
+// PbGitRepoLink convert table GitRepoLink to pb GitRepoLink
+func PbGitRepoLink(l *table.GitRepoLink) *GitRepoLink {
+	if l == nil {
+		return nil
+	}
+
+	return &GitRepoLink{
+		Id:         l.ID,
+		Spec:       PbGitRepoLinkSpec(l.Spec),
+		Status:     PbGitRepoLinkStatus(l.Status),
+		Attachment: PbGitRepoLinkAttachment(l.Attachment),
+		Revision:   pbbase.PbRevision(l.Revision),
+	}
+}
+
+// PbGitRepoLinkSpec convert table GitRepoLinkSpec to pb GitRepoLinkSpec
+func PbGitRepoLinkSpec(spec *table.GitRepoLinkSpec) *GitRepoLinkSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &GitRepoLinkSpec{
+		RepoUrl:      spec.RepoURL,
+		Ref:          spec.Ref,
+		SubPath:      spec.SubPath,
+		Username:     spec.Username,
+		SyncInterval: spec.SyncInterval,
+		ReplaceAll:   spec.ReplaceAll,
+		Memo:         spec.Memo,
+	}
+}
+
+// PbGitRepoLinkStatus convert table GitRepoLinkStatus to pb GitRepoLinkStatus
+func PbGitRepoLinkStatus(status *table.GitRepoLinkStatus) *GitRepoLinkStatus {
+	if status == nil {
+		return nil
+	}
+
+	return &GitRepoLinkStatus{
+		SyncStatus:  string(status.SyncStatus),
+		SyncedSha:   status.SyncedSHA,
+		SyncMessage: status.SyncMessage,
+		SyncedAt:    pbTime(status.SyncedAt),
+	}
+}
+
+// PbGitRepoLinkAttachment convert table GitRepoLinkAttachment to pb GitRepoLinkAttachment
+func PbGitRepoLinkAttachment(at *table.GitRepoLinkAttachment) *GitRepoLinkAttachment {
+	if at == nil {
+		return nil
+	}
+
+	return &GitRepoLinkAttachment{
+		BizId:           at.BizID,
+		AppId:           at.AppID,
+		TemplateSpaceId: at.TemplateSpaceID,
+	}
+}
+
+func pbTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,492 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: git_repo_link.proto
+
+package pbgrl
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GitRepoLink source resource reference: pkg/dal/table/git_repo_link.go
+type GitRepoLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *GitRepoLinkSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status     *GitRepoLinkStatus     `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Attachment *GitRepoLinkAttachment `protobuf:"bytes,4,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision         `protobuf:"bytes,5,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *GitRepoLink) Reset() {
+	*x = GitRepoLink{}
+	mi := &file_git_repo_link_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepoLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepoLink) ProtoMessage() {}
+
+func (x *GitRepoLink) ProtoReflect() protoreflect.Message {
+	mi := &file_git_repo_link_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRepoLink.ProtoReflect.Descriptor instead.
+func (*GitRepoLink) Descriptor() ([]byte, []int) {
+	return file_git_repo_link_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GitRepoLink) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GitRepoLink) GetSpec() *GitRepoLinkSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *GitRepoLink) GetStatus() *GitRepoLinkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GitRepoLink) GetAttachment() *GitRepoLinkAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *GitRepoLink) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// GitRepoLinkSpec source resource reference: pkg/dal/table/git_repo_link.go
+type GitRepoLinkSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepoUrl      string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	Ref          string `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	SubPath      string `protobuf:"bytes,3,opt,name=sub_path,json=subPath,proto3" json:"sub_path,omitempty"`
+	Username     string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	SyncInterval uint32 `protobuf:"varint,5,opt,name=sync_interval,json=syncInterval,proto3" json:"sync_interval,omitempty"`
+	ReplaceAll   bool   `protobuf:"varint,6,opt,name=replace_all,json=replaceAll,proto3" json:"replace_all,omitempty"`
+	Memo         string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *GitRepoLinkSpec) Reset() {
+	*x = GitRepoLinkSpec{}
+	mi := &file_git_repo_link_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepoLinkSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepoLinkSpec) ProtoMessage() {}
+
+func (x *GitRepoLinkSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_git_repo_link_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRepoLinkSpec.ProtoReflect.Descriptor instead.
+func (*GitRepoLinkSpec) Descriptor() ([]byte, []int) {
+	return file_git_repo_link_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GitRepoLinkSpec) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GitRepoLinkSpec) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *GitRepoLinkSpec) GetSubPath() string {
+	if x != nil {
+		return x.SubPath
+	}
+	return ""
+}
+
+func (x *GitRepoLinkSpec) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GitRepoLinkSpec) GetSyncInterval() uint32 {
+	if x != nil {
+		return x.SyncInterval
+	}
+	return 0
+}
+
+func (x *GitRepoLinkSpec) GetReplaceAll() bool {
+	if x != nil {
+		return x.ReplaceAll
+	}
+	return false
+}
+
+func (x *GitRepoLinkSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// GitRepoLinkStatus source resource reference: pkg/dal/table/git_repo_link.go
+type GitRepoLinkStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SyncStatus  string                 `protobuf:"bytes,1,opt,name=sync_status,json=syncStatus,proto3" json:"sync_status,omitempty"`
+	SyncedSha   string                 `protobuf:"bytes,2,opt,name=synced_sha,json=syncedSha,proto3" json:"synced_sha,omitempty"`
+	SyncMessage string                 `protobuf:"bytes,3,opt,name=sync_message,json=syncMessage,proto3" json:"sync_message,omitempty"`
+	SyncedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=synced_at,json=syncedAt,proto3" json:"synced_at,omitempty"`
+}
+
+func (x *GitRepoLinkStatus) Reset() {
+	*x = GitRepoLinkStatus{}
+	mi := &file_git_repo_link_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepoLinkStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepoLinkStatus) ProtoMessage() {}
+
+func (x *GitRepoLinkStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_git_repo_link_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRepoLinkStatus.ProtoReflect.Descriptor instead.
+func (*GitRepoLinkStatus) Descriptor() ([]byte, []int) {
+	return file_git_repo_link_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GitRepoLinkStatus) GetSyncStatus() string {
+	if x != nil {
+		return x.SyncStatus
+	}
+	return ""
+}
+
+func (x *GitRepoLinkStatus) GetSyncedSha() string {
+	if x != nil {
+		return x.SyncedSha
+	}
+	return ""
+}
+
+func (x *GitRepoLinkStatus) GetSyncMessage() string {
+	if x != nil {
+		return x.SyncMessage
+	}
+	return ""
+}
+
+func (x *GitRepoLinkStatus) GetSyncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SyncedAt
+	}
+	return nil
+}
+
+// GitRepoLinkAttachment source resource reference: pkg/dal/table/git_repo_link.go
+type GitRepoLinkAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId           uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId           uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	TemplateSpaceId uint32 `protobuf:"varint,3,opt,name=template_space_id,json=templateSpaceId,proto3" json:"template_space_id,omitempty"`
+}
+
+func (x *GitRepoLinkAttachment) Reset() {
+	*x = GitRepoLinkAttachment{}
+	mi := &file_git_repo_link_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepoLinkAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepoLinkAttachment) ProtoMessage() {}
+
+func (x *GitRepoLinkAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_git_repo_link_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRepoLinkAttachment.ProtoReflect.Descriptor instead.
+func (*GitRepoLinkAttachment) Descriptor() ([]byte, []int) {
+	return file_git_repo_link_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GitRepoLinkAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *GitRepoLinkAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *GitRepoLinkAttachment) GetTemplateSpaceId() uint32 {
+	if x != nil {
+		return x.TemplateSpaceId
+	}
+	return 0
+}
+
+var File_git_repo_link_proto protoreflect.FileDescriptor
+
+var file_git_repo_link_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x67, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x62, 0x67, 0x72, 0x6c, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xf6, 0x01, 0x0a, 0x0b, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2a, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x62, 0x67, 0x72, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e,
+	0x6b, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x30, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62,
+	0x67, 0x72, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x67, 0x72, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc0, 0x04, 0x0a, 0x0f, 0x47, 0x69,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x12, 0x40, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x9c, 0xb0, 0xe5,
+	0x9d, 0x80, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x85, 0xe6, 0x94, 0xaf, 0xe6, 0x8c, 0x81, 0x20, 0x68,
+	0x74, 0x74, 0x70, 0x28, 0x73, 0x29, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12,
+	0x57, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0x92, 0x41,
+	0x42, 0x32, 0x40, 0xe5, 0x88, 0x86, 0xe6, 0x94, 0xaf, 0xe6, 0x88, 0x96, 0xe6, 0xa0, 0x87, 0xe7,
+	0xad, 0xbe, 0xef, 0xbc, 0x8c, 0xe4, 0xb9, 0x9f, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb, 0xa5, 0xe6, 0x98,
+	0xaf, 0xe4, 0xbb, 0xa5, 0x20, 0x72, 0x65, 0x66, 0x73, 0x2f, 0x20, 0xe5, 0xbc, 0x80, 0xe5, 0xa4,
+	0xb4, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x8c, 0xe6, 0x95, 0xb4, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8,
+	0xe5, 0x90, 0x8d, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32,
+	0x2a, 0xe5, 0xaf, 0xbc, 0xe5, 0x85, 0xa5, 0xe7, 0x9a, 0x84, 0xe5, 0xad, 0x90, 0xe7, 0x9b, 0xae,
+	0xe5, 0xbd, 0x95, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7,
+	0xa4, 0xba, 0xe6, 0xa0, 0xb9, 0xe7, 0x9b, 0xae, 0xe5, 0xbd, 0x95, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe8, 0xae, 0xbf,
+	0xe9, 0x97, 0xae, 0xe7, 0xa7, 0x81, 0xe6, 0x9c, 0x89, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe7,
+	0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x74, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x4f, 0x92, 0x41,
+	0x4c, 0x32, 0x4a, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe9,
+	0x97, 0xb4, 0xe9, 0x9a, 0x94, 0xef, 0xbc, 0x88, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0xef, 0xbc,
+	0x89, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xbb, 0x85, 0xe6,
+	0x89, 0x8b, 0xe5, 0x8a, 0xa8, 0xe6, 0x88, 0x96, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x20, 0xe8, 0xa7, 0xa6, 0xe5, 0x8f, 0x91, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0x52, 0x0c, 0x73,
+	0x79, 0x6e, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x6e, 0x0a, 0x0b, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x4d, 0x92, 0x41, 0x4a, 0x32, 0x48, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe6, 0x97, 0xb6,
+	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe4, 0xbb, 0x93, 0xe5,
+	0xba, 0x93, 0xe4, 0xb8, 0xad, 0xe4, 0xb8, 0x8d, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0xe7, 0x9a,
+	0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x85,
+	0xe5, 0xaf, 0xb9, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x1f, 0x0a, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06,
+	0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0xf2, 0x02, 0x0a,
+	0x11, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x76, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x55, 0x92, 0x41, 0x52, 0x32, 0x50, 0xe6, 0x9c,
+	0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5,
+	0xe7, 0x9a, 0x84, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a, 0x73, 0x79, 0x6e, 0x63,
+	0x69, 0x6e, 0x67, 0x2d, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe4, 0xb8, 0xad, 0xef, 0xbc, 0x8c,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2d, 0xe6, 0x88, 0x90, 0xe5, 0x8a, 0x9f, 0xef, 0xbc,
+	0x8c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x2d, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0x52, 0x0a,
+	0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x79,
+	0x6e, 0x63, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20,
+	0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac,
+	0xa1, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe7, 0x9a, 0x84, 0xe6, 0x8f, 0x90, 0xe4, 0xba, 0xa4,
+	0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x53, 0x68, 0x61, 0x12, 0x49, 0x0a, 0x0c, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8,
+	0x80, 0xe6, 0xac, 0xa1, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe7, 0x9a, 0x84, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9e, 0x9c, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x59, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe6, 0x9c, 0x80, 0xe8,
+	0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe7, 0x9a,
+	0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xa4, 0x01, 0x0a, 0x15, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4c, 0x69, 0x6e,
+	0x6b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62,
+	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44,
+	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x11, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe7,
+	0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x49, 0x44, 0x52, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c,
+	0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x67, 0x69, 0x74, 0x2d, 0x72, 0x65, 0x70, 0x6f, 0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x3b, 0x70,
+	0x62, 0x67, 0x72, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_git_repo_link_proto_rawDescOnce sync.Once
+	file_git_repo_link_proto_rawDescData = file_git_repo_link_proto_rawDesc
+)
+
+func file_git_repo_link_proto_rawDescGZIP() []byte {
+	file_git_repo_link_proto_rawDescOnce.Do(func() {
+		file_git_repo_link_proto_rawDescData = protoimpl.X.CompressGZIP(file_git_repo_link_proto_rawDescData)
+	})
+	return file_git_repo_link_proto_rawDescData
+}
+
+var file_git_repo_link_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_git_repo_link_proto_goTypes = []any{
+	(*GitRepoLink)(nil),           // 0: pbgrl.GitRepoLink
+	(*GitRepoLinkSpec)(nil),       // 1: pbgrl.GitRepoLinkSpec
+	(*GitRepoLinkStatus)(nil),     // 2: pbgrl.GitRepoLinkStatus
+	(*GitRepoLinkAttachment)(nil), // 3: pbgrl.GitRepoLinkAttachment
+	(*base.Revision)(nil),         // 4: pbbase.Revision
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_git_repo_link_proto_depIdxs = []int32{
+	1, // 0: pbgrl.GitRepoLink.spec:type_name -> pbgrl.GitRepoLinkSpec
+	2, // 1: pbgrl.GitRepoLink.status:type_name -> pbgrl.GitRepoLinkStatus
+	3, // 2: pbgrl.GitRepoLink.attachment:type_name -> pbgrl.GitRepoLinkAttachment
+	4, // 3: pbgrl.GitRepoLink.revision:type_name -> pbbase.Revision
+	5, // 4: pbgrl.GitRepoLinkStatus.synced_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_git_repo_link_proto_init() }
+func file_git_repo_link_proto_init() {
+	if File_git_repo_link_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_git_repo_link_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_git_repo_link_proto_goTypes,
+		DependencyIndexes: file_git_repo_link_proto_depIdxs,
+		MessageInfos:      file_git_repo_link_proto_msgTypes,
+	}.Build()
+	File_git_repo_link_proto = out.File
+	file_git_repo_link_proto_rawDesc = nil
+	file_git_repo_link_proto_goTypes = nil
+	file_git_repo_link_proto_depIdxs = nil
+}
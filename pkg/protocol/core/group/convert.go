@@ -55,11 +55,12 @@ func (m *GroupSpec) GroupSpec() (*table.GroupSpec, error) {
 	}
 
 	return &table.GroupSpec{
-		Name:     m.Name,
-		Public:   m.Public,
-		Mode:     table.GroupMode(m.Mode),
-		Selector: selector,
-		UID:      m.Uid,
+		Name:          m.Name,
+		Public:        m.Public,
+		Mode:          table.GroupMode(m.Mode),
+		Selector:      selector,
+		UID:           m.Uid,
+		ClientQueryID: m.ClientQueryId,
 	}, nil
 }
 
@@ -79,11 +80,12 @@ func PbGroupSpec(spec *table.GroupSpec) (*GroupSpec, error) { //nolint:revive
 	}
 
 	return &GroupSpec{
-		Name:     spec.Name,
-		Public:   spec.Public,
-		Mode:     string(spec.Mode),
-		Selector: sel,
-		Uid:      spec.UID,
+		Name:          spec.Name,
+		Public:        spec.Public,
+		Mode:          string(spec.Mode),
+		Selector:      sel,
+		Uid:           spec.UID,
+		ClientQueryId: spec.ClientQueryID,
 	}, nil
 }
 
@@ -99,12 +99,13 @@ type GroupSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Public   bool             `protobuf:"varint,2,opt,name=public,proto3" json:"public,omitempty"`
-	BindApps []uint32         `protobuf:"varint,3,rep,packed,name=bind_apps,json=bindApps,proto3" json:"bind_apps,omitempty"`
-	Mode     string           `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
-	Selector *structpb.Struct `protobuf:"bytes,5,opt,name=selector,proto3" json:"selector,omitempty"`
-	Uid      string           `protobuf:"bytes,6,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Public        bool             `protobuf:"varint,2,opt,name=public,proto3" json:"public,omitempty"`
+	BindApps      []uint32         `protobuf:"varint,3,rep,packed,name=bind_apps,json=bindApps,proto3" json:"bind_apps,omitempty"`
+	Mode          string           `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Selector      *structpb.Struct `protobuf:"bytes,5,opt,name=selector,proto3" json:"selector,omitempty"`
+	Uid           string           `protobuf:"bytes,6,opt,name=uid,proto3" json:"uid,omitempty"`
+	ClientQueryId uint32           `protobuf:"varint,7,opt,name=client_query_id,json=clientQueryId,proto3" json:"client_query_id,omitempty"`
 }
 
 func (x *GroupSpec) Reset() {
@@ -179,6 +180,13 @@ func (x *GroupSpec) GetUid() string {
 	return ""
 }
 
+func (x *GroupSpec) GetClientQueryId() uint32 {
+	if x != nil {
+		return x.ClientQueryId
+	}
+	return 0
+}
+
 // GroupAttachment source resource reference: pkg/dal/table/group.go
 type GroupAttachment struct {
 	state         protoimpl.MessageState
@@ -248,7 +256,7 @@ var file_group_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65,
 	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x97, 0x02, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x70, 0x65, 0x63, 0x12, 0x22,
+	0x22, 0xec, 0x02, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x70, 0x65, 0x63, 0x12, 0x22,
 	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41,
 	0x0b, 0x32, 0x09, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe5, 0x90, 0x8d, 0x52, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x12, 0x58, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
@@ -265,16 +273,21 @@ var file_group_proto_rawDesc = []byte{
 	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08,
 	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x0f, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a,
-	0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92,
-	0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69,
-	0x7a, 0x49, 0x64, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e,
-	0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x3b, 0x70, 0x62, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x53, 0x0a, 0x0f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x2b, 0x92, 0x41, 0x28, 0x32, 0x26, 0xe5, 0x8a, 0xa8, 0xe6, 0x80, 0x81,
+	0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe7, 0x9a, 0x84, 0xe5,
+	0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0x49, 0x44,
+	0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x22,
+	0x37, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49,
+	0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c,
+	0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x3b, 0x70, 0x62, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
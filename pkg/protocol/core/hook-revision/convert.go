@@ -18,6 +18,7 @@ import (
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbtv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/template-variable"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
 )
 
@@ -45,10 +46,16 @@ func (m *HookRevisionSpec) HookRevisionSpec() (*table.HookRevisionSpec, error) {
 		return nil, nil
 	}
 
+	params := make(table.AppVariables, 0, len(m.Params))
+	for _, p := range m.Params {
+		params = append(params, p.TemplateVariableSpec())
+	}
+
 	return &table.HookRevisionSpec{
 		Name:    m.Name,
 		Content: m.Content,
 		Memo:    m.Memo,
+		Params:  params,
 	}, nil
 }
 
@@ -63,6 +70,7 @@ func PbHookRevisionSpec(spec *table.HookRevisionSpec) *HookRevisionSpec {
 		Content: spec.Content,
 		State:   spec.State.String(),
 		Memo:    spec.Memo,
+		Params:  pbtv.PbTemplateVariableSpecs(spec.Params),
 	}
 }
 
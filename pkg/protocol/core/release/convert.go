@@ -25,10 +25,13 @@ func (m *ReleaseSpec) ReleaseSpec() *table.ReleaseSpec {
 	}
 
 	return &table.ReleaseSpec{
-		Name:       m.Name,
-		Memo:       m.Memo,
-		Deprecated: m.Deprecated,
-		PublishNum: m.PublishNum,
+		Name:            m.Name,
+		Memo:            m.Memo,
+		Deprecated:      m.Deprecated,
+		PublishNum:      m.PublishNum,
+		Tags:            m.Tags,
+		SourceAppID:     m.SourceAppId,
+		SourceReleaseID: m.SourceReleaseId,
 	}
 }
 
@@ -41,10 +44,13 @@ func PbReleaseSpec(spec *table.ReleaseSpec) *ReleaseSpec {
 	}
 
 	return &ReleaseSpec{
-		Name:       spec.Name,
-		Memo:       spec.Memo,
-		Deprecated: spec.Deprecated,
-		PublishNum: spec.PublishNum,
+		Name:            spec.Name,
+		Memo:            spec.Memo,
+		Deprecated:      spec.Deprecated,
+		PublishNum:      spec.PublishNum,
+		Tags:            spec.Tags,
+		SourceAppId:     spec.SourceAppID,
+		SourceReleaseId: spec.SourceReleaseID,
 	}
 }
 
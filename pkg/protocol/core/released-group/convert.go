@@ -101,5 +101,41 @@ func CacheReleasedGroup(s *table.ReleasedGroup) *types.ReleasedGroupCache {
 		UID:        s.UID,
 		BizID:      s.BizID,
 		UpdatedAt:  s.UpdatedAt,
+
+		PinnedReleaseID: s.PinnedReleaseID,
+		PinnedUntil:     s.PinnedUntil,
+	}
+}
+
+// CacheReleaseIDs returns the published and pinned releases of the released group caches without duplicates.
+func CacheReleaseIDs(list []*types.ReleasedGroupCache) []uint32 {
+	ids := make([]uint32, 0, len(list))
+	exists := make(map[uint32]struct{}, len(list))
+	for _, one := range list {
+		for _, id := range []uint32{one.ReleaseID, one.PinnedReleaseID} {
+			if _, ok := exists[id]; ok || id == 0 {
+				continue
+			}
+			exists[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// FillCacheReleaseTags fill the tags of the published and pinned releases of the released group caches, which are
+// used by feed server to check the release constraint declared by clients.
+func FillCacheReleaseTags(list []*types.ReleasedGroupCache, releases []*table.Release) {
+	tags := make(map[uint32][]string, len(releases))
+	for _, one := range releases {
+		tags[one.ID] = one.Spec.Tags
+	}
+
+	for _, one := range list {
+		one.ReleaseTags = tags[one.ReleaseID]
+		if one.PinnedReleaseID != 0 {
+			one.PinnedReleaseTags = tags[one.PinnedReleaseID]
+		}
 	}
 }
@@ -0,0 +1,139 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbrole provides role core protocol struct and convert functions.
+package pbrole
+
+import (
+	"strings"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// RoleSpec convert pb RoleSpec to table RoleSpec
+func (r *RoleSpec) RoleSpec() *table.RoleSpec {
+	if r == nil {
+		return nil
+	}
+
+	return &table.RoleSpec{
+		Name:        r.Name,
+		Permissions: strings.Join(r.Permissions, ","),
+		Memo:        r.Memo,
+	}
+}
+
+// PbRoles convert table Role to pb Role
+func PbRoles(roles []*table.Role) []*Role {
+	result := make([]*Role, 0, len(roles))
+	for _, one := range roles {
+		result = append(result, PbRole(one))
+	}
+
+	return result
+}
+
+// PbRole convert table Role to pb Role
+func PbRole(r *table.Role) *Role {
+	if r == nil {
+		return nil
+	}
+
+	return &Role{
+		Id:         r.ID,
+		Spec:       PbRoleSpec(r.Spec),
+		Attachment: PbRoleAttachment(r.Attachment),
+		Revision:   pbbase.PbRevision(r.Revision),
+	}
+}
+
+// PbRoleSpec convert table RoleSpec to pb RoleSpec
+func PbRoleSpec(spec *table.RoleSpec) *RoleSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &RoleSpec{
+		Name:        spec.Name,
+		Permissions: spec.PermissionList(),
+		Memo:        spec.Memo,
+	}
+}
+
+// PbRoleAttachment convert table RoleAttachment to pb RoleAttachment
+func PbRoleAttachment(at *table.RoleAttachment) *RoleAttachment {
+	if at == nil {
+		return nil
+	}
+
+	return &RoleAttachment{
+		BizId: at.BizID,
+	}
+}
+
+// RoleBindingSpec convert pb RoleBindingSpec to table RoleBindingSpec
+func (r *RoleBindingSpec) RoleBindingSpec() *table.RoleBindingSpec {
+	if r == nil {
+		return nil
+	}
+
+	return &table.RoleBindingSpec{
+		RoleID:    r.RoleId,
+		Subject:   r.Subject,
+		ScopeType: table.RoleScopeType(r.ScopeType),
+		ScopeID:   r.ScopeId,
+	}
+}
+
+// PbRoleBindings convert table RoleBinding to pb RoleBinding
+func PbRoleBindings(bindings []*table.RoleBinding) []*RoleBinding {
+	result := make([]*RoleBinding, 0, len(bindings))
+	for _, one := range bindings {
+		result = append(result, PbRoleBinding(one))
+	}
+
+	return result
+}
+
+// PbRoleBinding convert table RoleBinding to pb RoleBinding
+func PbRoleBinding(b *table.RoleBinding) *RoleBinding {
+	if b == nil {
+		return nil
+	}
+
+	var attachment *RoleAttachment
+	if b.Attachment != nil {
+		attachment = &RoleAttachment{BizId: b.Attachment.BizID}
+	}
+
+	return &RoleBinding{
+		Id:         b.ID,
+		Spec:       PbRoleBindingSpec(b.Spec),
+		Attachment: attachment,
+		Revision:   pbbase.PbRevision(b.Revision),
+	}
+}
+
+// PbRoleBindingSpec convert table RoleBindingSpec to pb RoleBindingSpec
+func PbRoleBindingSpec(spec *table.RoleBindingSpec) *RoleBindingSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &RoleBindingSpec{
+		RoleId:    spec.RoleID,
+		Subject:   spec.Subject,
+		ScopeType: string(spec.ScopeType),
+		ScopeId:   spec.ScopeID,
+	}
+}
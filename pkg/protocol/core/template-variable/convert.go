@@ -46,7 +46,36 @@ func (m *TemplateVariableSpec) TemplateVariableSpec() *table.TemplateVariableSpe
 			}
 			return m.DefaultVal
 		}(),
-		Memo: m.Memo,
+		Memo:        m.Memo,
+		Constraints: m.Constraints.VariableConstraints(),
+	}
+}
+
+// VariableConstraints convert pb VariableConstraints to table VariableConstraints
+func (m *VariableConstraints) VariableConstraints() *table.VariableConstraints {
+	if m == nil {
+		return nil
+	}
+
+	return &table.VariableConstraints{
+		Pattern: m.Pattern,
+		Min:     m.Min,
+		Max:     m.Max,
+		Options: m.Options,
+	}
+}
+
+// PbVariableConstraints convert table VariableConstraints to pb VariableConstraints
+func PbVariableConstraints(c *table.VariableConstraints) *VariableConstraints {
+	if c == nil {
+		return nil
+	}
+
+	return &VariableConstraints{
+		Pattern: c.Pattern,
+		Min:     c.Min,
+		Max:     c.Max,
+		Options: c.Options,
 	}
 }
 
@@ -71,10 +100,11 @@ func PbTemplateVariableSpec(spec *table.TemplateVariableSpec) *TemplateVariableS
 	}
 
 	return &TemplateVariableSpec{
-		Name:       spec.Name,
-		Type:       string(spec.Type),
-		DefaultVal: spec.DefaultVal,
-		Memo:       spec.Memo,
+		Name:        spec.Name,
+		Type:        string(spec.Type),
+		DefaultVal:  spec.DefaultVal,
+		Memo:        spec.Memo,
+		Constraints: PbVariableConstraints(spec.Constraints),
 	}
 }
 
@@ -98,10 +98,11 @@ type TemplateVariableSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type       string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	DefaultVal string `protobuf:"bytes,3,opt,name=default_val,json=defaultVal,proto3" json:"default_val,omitempty"`
-	Memo       string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	Name        string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DefaultVal  string               `protobuf:"bytes,3,opt,name=default_val,json=defaultVal,proto3" json:"default_val,omitempty"`
+	Memo        string               `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	Constraints *VariableConstraints `protobuf:"bytes,5,opt,name=constraints,proto3" json:"constraints,omitempty"`
 }
 
 func (x *TemplateVariableSpec) Reset() {
@@ -162,6 +163,83 @@ func (x *TemplateVariableSpec) GetMemo() string {
 	return ""
 }
 
+func (x *TemplateVariableSpec) GetConstraints() *VariableConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+// VariableConstraints source resource reference: pkg/dal/table/template_variable.go
+type VariableConstraints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pattern string   `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Min     *float64 `protobuf:"fixed64,2,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max     *float64 `protobuf:"fixed64,3,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Options []string `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *VariableConstraints) Reset() {
+	*x = VariableConstraints{}
+	mi := &file_template_variable_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableConstraints) ProtoMessage() {}
+
+func (x *VariableConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_template_variable_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableConstraints.ProtoReflect.Descriptor instead.
+func (*VariableConstraints) Descriptor() ([]byte, []int) {
+	return file_template_variable_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VariableConstraints) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *VariableConstraints) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *VariableConstraints) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *VariableConstraints) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
 // TemplateVariableAttachment source resource reference: pkg/dal/table/template_variable.go
 type TemplateVariableAttachment struct {
 	state         protoimpl.MessageState
@@ -173,7 +251,7 @@ type TemplateVariableAttachment struct {
 
 func (x *TemplateVariableAttachment) Reset() {
 	*x = TemplateVariableAttachment{}
-	mi := &file_template_variable_proto_msgTypes[2]
+	mi := &file_template_variable_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -185,7 +263,7 @@ func (x *TemplateVariableAttachment) String() string {
 func (*TemplateVariableAttachment) ProtoMessage() {}
 
 func (x *TemplateVariableAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_template_variable_proto_msgTypes[2]
+	mi := &file_template_variable_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +276,7 @@ func (x *TemplateVariableAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateVariableAttachment.ProtoReflect.Descriptor instead.
 func (*TemplateVariableAttachment) Descriptor() ([]byte, []int) {
-	return file_template_variable_proto_rawDescGZIP(), []int{2}
+	return file_template_variable_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TemplateVariableAttachment) GetBizId() uint32 {
@@ -231,30 +309,51 @@ var file_template_variable_proto_rawDesc = []byte{
 	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
 	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62,
 	0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xce, 0x01, 0x0a, 0x14, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcd, 0x02, 0x0a, 0x14, 0x54, 0x65, 0x6d, 0x70, 0x6c,
 	0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12,
 	0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
 	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x98, 0xe9, 0x87, 0x8f, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe5, 0x8f, 0x98, 0xe9, 0x87,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x63, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x4f, 0x92, 0x41, 0x4c, 0x32, 0x4a, 0xe5, 0x8f, 0x98, 0xe9, 0x87,
 	0x8f, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0xe3, 0x80, 0x81, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x2f, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe9, 0xbb, 0x98, 0xe8, 0xae,
-	0xa4, 0xe5, 0x80, 0xbc, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c,
-	0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
-	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x98, 0xe9, 0x87, 0x8f, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf,
-	0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x42, 0x0a, 0x1a, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5,
-	0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x42, 0x4d, 0x5a, 0x4b, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e,
-	0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63,
-	0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63,
-	0x6f, 0x72, 0x65, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x76, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x3b, 0x70, 0x62, 0x74, 0x76, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0xe3, 0x80, 0x81, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0xe3, 0x80, 0x81, 0x74, 0x65, 0x78, 0x74,
+	0xe3, 0x80, 0x81, 0x62, 0x6f, 0x6f, 0x6c, 0xe3, 0x80, 0x81, 0x65, 0x6e, 0x75, 0x6d, 0xe3, 0x80,
+	0x81, 0x6c, 0x69, 0x73, 0x74, 0xe3, 0x80, 0x81, 0x6a, 0x73, 0x6f, 0x6e, 0xe3, 0x80, 0x81, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe5, 0x80, 0xbc,
+	0x52, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x04,
+	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe5, 0x8f, 0x98, 0xe9, 0x87, 0x8f, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x12, 0x51, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x74, 0x76, 0x2e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x73, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0x8f, 0x98, 0xe9, 0x87, 0x8f,
+	0xe5, 0x80, 0xbc, 0xe7, 0xba, 0xa6, 0xe6, 0x9d, 0x9f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2b,
+	0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xad, 0xa3, 0xe5, 0x88, 0x99, 0xe7, 0xba, 0xa6, 0xe6,
+	0x9d, 0x9f, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x25, 0x0a, 0x03, 0x6d,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6,
+	0x9c, 0x80, 0xe5, 0xb0, 0x8f, 0xe5, 0x80, 0xbc, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x25, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe5, 0x80, 0xbc, 0x48,
+	0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32,
+	0x0f, 0xe6, 0x9e, 0x9a, 0xe4, 0xb8, 0xbe, 0xe5, 0x8f, 0xaf, 0xe9, 0x80, 0x89, 0xe5, 0x80, 0xbc,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x69,
+	0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x42, 0x0a, 0x1a, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8,
+	0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x42, 0x4d, 0x5a,
+	0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62,
+	0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x3b, 0x70, 0x62, 0x74, 0x76, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -269,22 +368,24 @@ func file_template_variable_proto_rawDescGZIP() []byte {
 	return file_template_variable_proto_rawDescData
 }
 
-var file_template_variable_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_template_variable_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_template_variable_proto_goTypes = []any{
 	(*TemplateVariable)(nil),           // 0: pbtv.TemplateVariable
 	(*TemplateVariableSpec)(nil),       // 1: pbtv.TemplateVariableSpec
-	(*TemplateVariableAttachment)(nil), // 2: pbtv.TemplateVariableAttachment
-	(*base.Revision)(nil),              // 3: pbbase.Revision
+	(*VariableConstraints)(nil),        // 2: pbtv.VariableConstraints
+	(*TemplateVariableAttachment)(nil), // 3: pbtv.TemplateVariableAttachment
+	(*base.Revision)(nil),              // 4: pbbase.Revision
 }
 var file_template_variable_proto_depIdxs = []int32{
 	1, // 0: pbtv.TemplateVariable.spec:type_name -> pbtv.TemplateVariableSpec
-	2, // 1: pbtv.TemplateVariable.attachment:type_name -> pbtv.TemplateVariableAttachment
-	3, // 2: pbtv.TemplateVariable.revision:type_name -> pbbase.Revision
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 1: pbtv.TemplateVariable.attachment:type_name -> pbtv.TemplateVariableAttachment
+	4, // 2: pbtv.TemplateVariable.revision:type_name -> pbbase.Revision
+	2, // 3: pbtv.TemplateVariableSpec.constraints:type_name -> pbtv.VariableConstraints
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_template_variable_proto_init() }
@@ -292,13 +393,14 @@ func file_template_variable_proto_init() {
 	if File_template_variable_proto != nil {
 		return
 	}
+	file_template_variable_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_template_variable_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
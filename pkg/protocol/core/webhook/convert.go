@@ -0,0 +1,146 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pbwh
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/types"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// WebhookSpec convert pb WebhookSpec to table WebhookSpec
+func (w *WebhookSpec) WebhookSpec() *table.WebhookSpec {
+	if w == nil {
+		return nil
+	}
+
+	return &table.WebhookSpec{
+		Name:       w.Name,
+		URL:        w.Url,
+		EventTypes: types.StringSlice(w.EventTypes),
+		Enabled:    w.Enabled,
+		Memo:       w.Memo,
+	}
+}
+
+// PbWebhooks convert table Webhook to pb Webhook
+func PbWebhooks(webhooks []*table.Webhook) []*Webhook {
+	result := make([]*Webhook, 0, len(webhooks))
+	for _, one := range webhooks {
+		result = append(result, PbWebhook(one))
+	}
+
+	return result
+}
+
+// PbWebhook convert table Webhook to pb Webhook
+func PbWebhook(w *table.Webhook) *Webhook {
+	if w == nil {
+		return nil
+	}
+
+	return &Webhook{
+		Id:         w.ID,
+		Spec:       PbWebhookSpec(w.Spec),
+		Attachment: PbWebhookAttachment(w.Attachment),
+		Revision:   pbbase.PbRevision(w.Revision),
+	}
+}
+
+// PbWebhookSpec convert table WebhookSpec to pb WebhookSpec
+func PbWebhookSpec(spec *table.WebhookSpec) *WebhookSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &WebhookSpec{
+		Name:       spec.Name,
+		Url:        spec.URL,
+		EventTypes: spec.EventTypes,
+		Enabled:    spec.Enabled,
+		Memo:       spec.Memo,
+	}
+}
+
+// PbWebhookAttachment convert table WebhookAttachment to pb WebhookAttachment
+func PbWebhookAttachment(at *table.WebhookAttachment) *WebhookAttachment {
+	if at == nil {
+		return nil
+	}
+
+	return &WebhookAttachment{
+		BizId: at.BizID,
+		AppId: at.AppID,
+	}
+}
+
+// PbWebhookDeliveries convert table WebhookDelivery to pb WebhookDelivery
+func PbWebhookDeliveries(deliveries []*table.WebhookDelivery) []*WebhookDelivery {
+	result := make([]*WebhookDelivery, 0, len(deliveries))
+	for _, one := range deliveries {
+		result = append(result, PbWebhookDelivery(one))
+	}
+
+	return result
+}
+
+// PbWebhookDelivery convert table WebhookDelivery to pb WebhookDelivery
+func PbWebhookDelivery(d *table.WebhookDelivery) *WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+
+	result := &WebhookDelivery{
+		Id:       d.ID,
+		Revision: pbbase.PbRevision(d.Revision),
+	}
+	if d.Spec != nil {
+		result.Spec = &WebhookDeliverySpec{
+			EventType: string(d.Spec.EventType),
+			EventId:   d.Spec.EventID,
+			Payload:   d.Spec.Payload,
+		}
+	}
+	if d.Status != nil {
+		result.Status = &WebhookDeliveryStatus{
+			Status:        string(d.Status.Status),
+			Attempts:      d.Status.Attempts,
+			NextAttemptAt: pbTime(d.Status.NextAttemptAt),
+			ResponseCode:  d.Status.ResponseCode,
+			ResponseBody:  d.Status.ResponseBody,
+			LastError:     d.Status.LastError,
+			DeliveredAt:   pbTime(d.Status.DeliveredAt),
+		}
+	}
+	if d.Attachment != nil {
+		result.Attachment = &WebhookDeliveryAttachment{
+			BizId:     d.Attachment.BizID,
+			AppId:     d.Attachment.AppID,
+			WebhookId: d.Attachment.WebhookID,
+		}
+	}
+
+	return result
+}
+
+func pbTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
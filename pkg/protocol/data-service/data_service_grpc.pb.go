@@ -9,17 +9,21 @@ package pbds
 import (
 	context "context"
 	app "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app"
+	approval "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/approval"
 	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	change_freeze "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/change-freeze"
 	client "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
 	commit "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/commit"
 	config_item "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
 	content "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
+	git_repo_link "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/git-repo-link"
 	group "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/group"
 	hook_revision "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook-revision"
 	release "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/release"
 	released_ci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/released-ci"
 	released_kv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/released-kv"
 	strategy "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/strategy"
+	webhook "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/webhook"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -65,6 +69,8 @@ const (
 	Data_UnDeprecateRelease_FullMethodName                = "/pbds.Data/UnDeprecateRelease"
 	Data_DeleteRelease_FullMethodName                     = "/pbds.Data/DeleteRelease"
 	Data_CheckReleaseName_FullMethodName                  = "/pbds.Data/CheckReleaseName"
+	Data_UpdateReleaseTags_FullMethodName                 = "/pbds.Data/UpdateReleaseTags"
+	Data_PromoteRelease_FullMethodName                    = "/pbds.Data/PromoteRelease"
 	Data_GetReleasedConfigItem_FullMethodName             = "/pbds.Data/GetReleasedConfigItem"
 	Data_GetReleasedKv_FullMethodName                     = "/pbds.Data/GetReleasedKv"
 	Data_ListReleasedKvs_FullMethodName                   = "/pbds.Data/ListReleasedKvs"
@@ -87,6 +93,9 @@ const (
 	Data_UpdateHookRevision_FullMethodName                = "/pbds.Data/UpdateHookRevision"
 	Data_ListHookRevisionReferences_FullMethodName        = "/pbds.Data/ListHookRevisionReferences"
 	Data_GetReleaseHook_FullMethodName                    = "/pbds.Data/GetReleaseHook"
+	Data_ImportTemplateSpaceHooks_FullMethodName          = "/pbds.Data/ImportTemplateSpaceHooks"
+	Data_ListTemplateSpaceHooks_FullMethodName            = "/pbds.Data/ListTemplateSpaceHooks"
+	Data_DeleteTemplateSpaceHook_FullMethodName           = "/pbds.Data/DeleteTemplateSpaceHook"
 	Data_CreateTemplateSpace_FullMethodName               = "/pbds.Data/CreateTemplateSpace"
 	Data_ListTemplateSpaces_FullMethodName                = "/pbds.Data/ListTemplateSpaces"
 	Data_UpdateTemplateSpace_FullMethodName               = "/pbds.Data/UpdateTemplateSpace"
@@ -139,6 +148,9 @@ const (
 	Data_UpdateAppTmplVariables_FullMethodName            = "/pbds.Data/UpdateAppTmplVariables"
 	Data_ListAppTmplVariables_FullMethodName              = "/pbds.Data/ListAppTmplVariables"
 	Data_ListReleasedAppTmplVariables_FullMethodName      = "/pbds.Data/ListReleasedAppTmplVariables"
+	Data_UpdateGroupTmplVariables_FullMethodName          = "/pbds.Data/UpdateGroupTmplVariables"
+	Data_ListGroupTmplVariables_FullMethodName            = "/pbds.Data/ListGroupTmplVariables"
+	Data_TraceAppTmplVariables_FullMethodName             = "/pbds.Data/TraceAppTmplVariables"
 	Data_ListTmplBoundCounts_FullMethodName               = "/pbds.Data/ListTmplBoundCounts"
 	Data_ListTmplRevisionBoundCounts_FullMethodName       = "/pbds.Data/ListTmplRevisionBoundCounts"
 	Data_ListTmplSetBoundCounts_FullMethodName            = "/pbds.Data/ListTmplSetBoundCounts"
@@ -177,6 +189,9 @@ const (
 	Data_GetLastPublish_FullMethodName                    = "/pbds.Data/GetLastPublish"
 	Data_GetReleasesStatus_FullMethodName                 = "/pbds.Data/GetReleasesStatus"
 	Data_GenerateReleaseAndPublish_FullMethodName         = "/pbds.Data/GenerateReleaseAndPublish"
+	Data_RollbackRelease_FullMethodName                   = "/pbds.Data/RollbackRelease"
+	Data_PinGroupRelease_FullMethodName                   = "/pbds.Data/PinGroupRelease"
+	Data_UnpinGroupRelease_FullMethodName                 = "/pbds.Data/UnpinGroupRelease"
 	Data_ApprovalCallback_FullMethodName                  = "/pbds.Data/ApprovalCallback"
 	Data_ListAudits_FullMethodName                        = "/pbds.Data/ListAudits"
 	Data_CreateCredential_FullMethodName                  = "/pbds.Data/CreateCredential"
@@ -184,6 +199,8 @@ const (
 	Data_DeleteCredential_FullMethodName                  = "/pbds.Data/DeleteCredential"
 	Data_UpdateCredential_FullMethodName                  = "/pbds.Data/UpdateCredential"
 	Data_CheckCredentialName_FullMethodName               = "/pbds.Data/CheckCredentialName"
+	Data_RotateCredential_FullMethodName                  = "/pbds.Data/RotateCredential"
+	Data_BatchUpdateCredentialUsage_FullMethodName        = "/pbds.Data/BatchUpdateCredentialUsage"
 	Data_ListCredentialScopes_FullMethodName              = "/pbds.Data/ListCredentialScopes"
 	Data_UpdateCredentialScopes_FullMethodName            = "/pbds.Data/UpdateCredentialScopes"
 	Data_CredentialScopePreview_FullMethodName            = "/pbds.Data/CredentialScopePreview"
@@ -213,6 +230,49 @@ const (
 	Data_ClientVersionStatistics_FullMethodName           = "/pbds.Data/ClientVersionStatistics"
 	Data_ListClientLabelAndAnnotation_FullMethodName      = "/pbds.Data/ListClientLabelAndAnnotation"
 	Data_ClientSpecificFailedReason_FullMethodName        = "/pbds.Data/ClientSpecificFailedReason"
+	Data_ClientStageStatistics_FullMethodName             = "/pbds.Data/ClientStageStatistics"
+	Data_CreateClientAlertRule_FullMethodName             = "/pbds.Data/CreateClientAlertRule"
+	Data_UpdateClientAlertRule_FullMethodName             = "/pbds.Data/UpdateClientAlertRule"
+	Data_DeleteClientAlertRule_FullMethodName             = "/pbds.Data/DeleteClientAlertRule"
+	Data_ListClientAlertRules_FullMethodName              = "/pbds.Data/ListClientAlertRules"
+	Data_SilenceClientAlertRule_FullMethodName            = "/pbds.Data/SilenceClientAlertRule"
+	Data_AckClientAlert_FullMethodName                    = "/pbds.Data/AckClientAlert"
+	Data_ListClientAlertHistories_FullMethodName          = "/pbds.Data/ListClientAlertHistories"
+	Data_ListClientMetricTrends_FullMethodName            = "/pbds.Data/ListClientMetricTrends"
+	Data_CreateGitRepoLink_FullMethodName                 = "/pbds.Data/CreateGitRepoLink"
+	Data_UpdateGitRepoLink_FullMethodName                 = "/pbds.Data/UpdateGitRepoLink"
+	Data_DeleteGitRepoLink_FullMethodName                 = "/pbds.Data/DeleteGitRepoLink"
+	Data_GetGitRepoLink_FullMethodName                    = "/pbds.Data/GetGitRepoLink"
+	Data_ListGitRepoLinks_FullMethodName                  = "/pbds.Data/ListGitRepoLinks"
+	Data_SyncGitRepoLink_FullMethodName                   = "/pbds.Data/SyncGitRepoLink"
+	Data_CreateChangeFreeze_FullMethodName                = "/pbds.Data/CreateChangeFreeze"
+	Data_UpdateChangeFreeze_FullMethodName                = "/pbds.Data/UpdateChangeFreeze"
+	Data_DeleteChangeFreeze_FullMethodName                = "/pbds.Data/DeleteChangeFreeze"
+	Data_GetChangeFreeze_FullMethodName                   = "/pbds.Data/GetChangeFreeze"
+	Data_ListChangeFreezes_FullMethodName                 = "/pbds.Data/ListChangeFreezes"
+	Data_RecordChangeFreezeBreakGlass_FullMethodName      = "/pbds.Data/RecordChangeFreezeBreakGlass"
+	Data_CreateWebhook_FullMethodName                     = "/pbds.Data/CreateWebhook"
+	Data_UpdateWebhook_FullMethodName                     = "/pbds.Data/UpdateWebhook"
+	Data_DeleteWebhook_FullMethodName                     = "/pbds.Data/DeleteWebhook"
+	Data_GetWebhook_FullMethodName                        = "/pbds.Data/GetWebhook"
+	Data_ListWebhooks_FullMethodName                      = "/pbds.Data/ListWebhooks"
+	Data_ListWebhookDeliveries_FullMethodName             = "/pbds.Data/ListWebhookDeliveries"
+	Data_RedeliverWebhookDelivery_FullMethodName          = "/pbds.Data/RedeliverWebhookDelivery"
+	Data_SetApprovalFlow_FullMethodName                   = "/pbds.Data/SetApprovalFlow"
+	Data_GetApprovalFlow_FullMethodName                   = "/pbds.Data/GetApprovalFlow"
+	Data_GetApprovalTicket_FullMethodName                 = "/pbds.Data/GetApprovalTicket"
+	Data_DelegateApproval_FullMethodName                  = "/pbds.Data/DelegateApproval"
+	Data_CommentApproval_FullMethodName                   = "/pbds.Data/CommentApproval"
+	Data_ExportAppBundle_FullMethodName                   = "/pbds.Data/ExportAppBundle"
+	Data_ImportAppBundle_FullMethodName                   = "/pbds.Data/ImportAppBundle"
+	Data_CreateRole_FullMethodName                        = "/pbds.Data/CreateRole"
+	Data_UpdateRole_FullMethodName                        = "/pbds.Data/UpdateRole"
+	Data_DeleteRole_FullMethodName                        = "/pbds.Data/DeleteRole"
+	Data_ListRoles_FullMethodName                         = "/pbds.Data/ListRoles"
+	Data_CreateRoleBinding_FullMethodName                 = "/pbds.Data/CreateRoleBinding"
+	Data_DeleteRoleBinding_FullMethodName                 = "/pbds.Data/DeleteRoleBinding"
+	Data_ListRoleBindings_FullMethodName                  = "/pbds.Data/ListRoleBindings"
+	Data_ListRolePolicies_FullMethodName                  = "/pbds.Data/ListRolePolicies"
 	Data_ListInstances_FullMethodName                     = "/pbds.Data/ListInstances"
 	Data_FetchInstanceInfo_FullMethodName                 = "/pbds.Data/FetchInstanceInfo"
 	Data_Ping_FullMethodName                              = "/pbds.Data/Ping"
@@ -250,6 +310,7 @@ const (
 	Data_GetConfigGenerateResult_FullMethodName           = "/pbds.Data/GetConfigGenerateResult"
 	Data_PreviewConfig_FullMethodName                     = "/pbds.Data/PreviewConfig"
 	Data_PushConfig_FullMethodName                        = "/pbds.Data/PushConfig"
+	Data_RepushDriftedConfig_FullMethodName               = "/pbds.Data/RepushDriftedConfig"
 	Data_DeleteConfigTemplate_FullMethodName              = "/pbds.Data/DeleteConfigTemplate"
 	Data_OperateGenerateConfig_FullMethodName             = "/pbds.Data/OperateGenerateConfig"
 	Data_GetProcessInstanceTopo_FullMethodName            = "/pbds.Data/GetProcessInstanceTopo"
@@ -300,6 +361,8 @@ type DataClient interface {
 	UnDeprecateRelease(ctx context.Context, in *UnDeprecateReleaseReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	DeleteRelease(ctx context.Context, in *DeleteReleaseReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	CheckReleaseName(ctx context.Context, in *CheckReleaseNameReq, opts ...grpc.CallOption) (*CheckReleaseNameResp, error)
+	UpdateReleaseTags(ctx context.Context, in *UpdateReleaseTagsReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error)
 	// released config item related interface.
 	GetReleasedConfigItem(ctx context.Context, in *GetReleasedCIReq, opts ...grpc.CallOption) (*released_ci.ReleasedConfigItem, error)
 	// released kv related interface.
@@ -326,6 +389,10 @@ type DataClient interface {
 	UpdateHookRevision(ctx context.Context, in *UpdateHookRevisionReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	ListHookRevisionReferences(ctx context.Context, in *ListHookRevisionReferencesReq, opts ...grpc.CallOption) (*ListHookRevisionReferencesResp, error)
 	GetReleaseHook(ctx context.Context, in *GetReleaseHookReq, opts ...grpc.CallOption) (*GetReleaseHookResp, error)
+	// template space hook library related interface.
+	ImportTemplateSpaceHooks(ctx context.Context, in *ImportTemplateSpaceHooksReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListTemplateSpaceHooks(ctx context.Context, in *ListTemplateSpaceHooksReq, opts ...grpc.CallOption) (*ListTemplateSpaceHooksResp, error)
+	DeleteTemplateSpaceHook(ctx context.Context, in *DeleteTemplateSpaceHookReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	// template space related interface.
 	CreateTemplateSpace(ctx context.Context, in *CreateTemplateSpaceReq, opts ...grpc.CallOption) (*CreateResp, error)
 	ListTemplateSpaces(ctx context.Context, in *ListTemplateSpacesReq, opts ...grpc.CallOption) (*ListTemplateSpacesResp, error)
@@ -384,6 +451,9 @@ type DataClient interface {
 	UpdateAppTmplVariables(ctx context.Context, in *UpdateAppTmplVariablesReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	ListAppTmplVariables(ctx context.Context, in *ListAppTmplVariablesReq, opts ...grpc.CallOption) (*ListAppTmplVariablesResp, error)
 	ListReleasedAppTmplVariables(ctx context.Context, in *ListReleasedAppTmplVariablesReq, opts ...grpc.CallOption) (*ListReleasedAppTmplVariablesResp, error)
+	UpdateGroupTmplVariables(ctx context.Context, in *UpdateGroupTmplVariablesReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListGroupTmplVariables(ctx context.Context, in *ListGroupTmplVariablesReq, opts ...grpc.CallOption) (*ListGroupTmplVariablesResp, error)
+	TraceAppTmplVariables(ctx context.Context, in *TraceAppTmplVariablesReq, opts ...grpc.CallOption) (*TraceAppTmplVariablesResp, error)
 	// template binding relation related interface.
 	ListTmplBoundCounts(ctx context.Context, in *ListTmplBoundCountsReq, opts ...grpc.CallOption) (*ListTmplBoundCountsResp, error)
 	ListTmplRevisionBoundCounts(ctx context.Context, in *ListTmplRevisionBoundCountsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundCountsResp, error)
@@ -427,6 +497,9 @@ type DataClient interface {
 	GetLastPublish(ctx context.Context, in *GetLastPublishReq, opts ...grpc.CallOption) (*GetLastPublishResp, error)
 	GetReleasesStatus(ctx context.Context, in *GetReleasesStatusReq, opts ...grpc.CallOption) (*strategy.Strategy, error)
 	GenerateReleaseAndPublish(ctx context.Context, in *GenerateReleaseAndPublishReq, opts ...grpc.CallOption) (*PublishResp, error)
+	RollbackRelease(ctx context.Context, in *RollbackReleaseReq, opts ...grpc.CallOption) (*RollbackReleaseResp, error)
+	PinGroupRelease(ctx context.Context, in *PinGroupReleaseReq, opts ...grpc.CallOption) (*PinGroupReleaseResp, error)
+	UnpinGroupRelease(ctx context.Context, in *UnpinGroupReleaseReq, opts ...grpc.CallOption) (*UnpinGroupReleaseResp, error)
 	ApprovalCallback(ctx context.Context, in *ApprovalCallbackReq, opts ...grpc.CallOption) (*ApprovalCallbackResp, error)
 	// audit related interface.
 	ListAudits(ctx context.Context, in *ListAuditsReq, opts ...grpc.CallOption) (*ListAuditsResp, error)
@@ -436,6 +509,8 @@ type DataClient interface {
 	DeleteCredential(ctx context.Context, in *DeleteCredentialReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	UpdateCredential(ctx context.Context, in *UpdateCredentialReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	CheckCredentialName(ctx context.Context, in *CheckCredentialNameReq, opts ...grpc.CallOption) (*CheckCredentialNameResp, error)
+	RotateCredential(ctx context.Context, in *RotateCredentialReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	BatchUpdateCredentialUsage(ctx context.Context, in *BatchUpdateCredentialUsageReq, opts ...grpc.CallOption) (*BatchUpdateCredentialUsageResp, error)
 	// credential scope related interface
 	ListCredentialScopes(ctx context.Context, in *ListCredentialScopesReq, opts ...grpc.CallOption) (*ListCredentialScopesResp, error)
 	UpdateCredentialScopes(ctx context.Context, in *UpdateCredentialScopesReq, opts ...grpc.CallOption) (*UpdateCredentialScopesResp, error)
@@ -471,6 +546,56 @@ type DataClient interface {
 	ClientVersionStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error)
 	ListClientLabelAndAnnotation(ctx context.Context, in *ListClientLabelAndAnnotationReq, opts ...grpc.CallOption) (*structpb.Struct, error)
 	ClientSpecificFailedReason(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error)
+	ClientStageStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error)
+	// client alert related interface
+	CreateClientAlertRule(ctx context.Context, in *CreateClientAlertRuleReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateClientAlertRule(ctx context.Context, in *UpdateClientAlertRuleReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteClientAlertRule(ctx context.Context, in *DeleteClientAlertRuleReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListClientAlertRules(ctx context.Context, in *ListClientAlertRulesReq, opts ...grpc.CallOption) (*ListClientAlertRulesResp, error)
+	SilenceClientAlertRule(ctx context.Context, in *SilenceClientAlertRuleReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	AckClientAlert(ctx context.Context, in *AckClientAlertReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListClientAlertHistories(ctx context.Context, in *ListClientAlertHistoriesReq, opts ...grpc.CallOption) (*ListClientAlertHistoriesResp, error)
+	ListClientMetricTrends(ctx context.Context, in *ListClientMetricTrendsReq, opts ...grpc.CallOption) (*ListClientMetricTrendsResp, error)
+	// git repository link related interface
+	CreateGitRepoLink(ctx context.Context, in *CreateGitRepoLinkReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateGitRepoLink(ctx context.Context, in *UpdateGitRepoLinkReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteGitRepoLink(ctx context.Context, in *DeleteGitRepoLinkReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetGitRepoLink(ctx context.Context, in *GetGitRepoLinkReq, opts ...grpc.CallOption) (*git_repo_link.GitRepoLink, error)
+	ListGitRepoLinks(ctx context.Context, in *ListGitRepoLinksReq, opts ...grpc.CallOption) (*ListGitRepoLinksResp, error)
+	SyncGitRepoLink(ctx context.Context, in *SyncGitRepoLinkReq, opts ...grpc.CallOption) (*SyncGitRepoLinkResp, error)
+	// change freeze related interface
+	CreateChangeFreeze(ctx context.Context, in *CreateChangeFreezeReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateChangeFreeze(ctx context.Context, in *UpdateChangeFreezeReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteChangeFreeze(ctx context.Context, in *DeleteChangeFreezeReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetChangeFreeze(ctx context.Context, in *GetChangeFreezeReq, opts ...grpc.CallOption) (*change_freeze.ChangeFreeze, error)
+	ListChangeFreezes(ctx context.Context, in *ListChangeFreezesReq, opts ...grpc.CallOption) (*ListChangeFreezesResp, error)
+	RecordChangeFreezeBreakGlass(ctx context.Context, in *RecordChangeFreezeBreakGlassReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// webhook related interface
+	CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetWebhook(ctx context.Context, in *GetWebhookReq, opts ...grpc.CallOption) (*webhook.Webhook, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksReq, opts ...grpc.CallOption) (*ListWebhooksResp, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (*ListWebhookDeliveriesResp, error)
+	RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryReq, opts ...grpc.CallOption) (*CreateResp, error)
+	// builtin approval related interface
+	SetApprovalFlow(ctx context.Context, in *SetApprovalFlowReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetApprovalFlow(ctx context.Context, in *GetApprovalFlowReq, opts ...grpc.CallOption) (*approval.ApprovalFlow, error)
+	GetApprovalTicket(ctx context.Context, in *GetApprovalTicketReq, opts ...grpc.CallOption) (*GetApprovalTicketResp, error)
+	DelegateApproval(ctx context.Context, in *DelegateApprovalReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	CommentApproval(ctx context.Context, in *CommentApprovalReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// app bundle related interface
+	ExportAppBundle(ctx context.Context, in *ExportAppBundleReq, opts ...grpc.CallOption) (*ExportAppBundleResp, error)
+	ImportAppBundle(ctx context.Context, in *ImportAppBundleReq, opts ...grpc.CallOption) (*ImportAppBundleResp, error)
+	// 内置 rbac 权限角色相关接口
+	CreateRole(ctx context.Context, in *CreateRoleReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListRoles(ctx context.Context, in *ListRolesReq, opts ...grpc.CallOption) (*ListRolesResp, error)
+	CreateRoleBinding(ctx context.Context, in *CreateRoleBindingReq, opts ...grpc.CallOption) (*CreateResp, error)
+	DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListRoleBindings(ctx context.Context, in *ListRoleBindingsReq, opts ...grpc.CallOption) (*ListRoleBindingsResp, error)
+	ListRolePolicies(ctx context.Context, in *ListRolePoliciesReq, opts ...grpc.CallOption) (*ListRolePoliciesResp, error)
 	// used iam pull resource callback.
 	ListInstances(ctx context.Context, in *ListInstancesReq, opts ...grpc.CallOption) (*ListInstancesResp, error)
 	FetchInstanceInfo(ctx context.Context, in *FetchInstanceInfoReq, opts ...grpc.CallOption) (*FetchInstanceInfoResp, error)
@@ -531,6 +656,8 @@ type DataClient interface {
 	PreviewConfig(ctx context.Context, in *PreviewConfigReq, opts ...grpc.CallOption) (*PreviewConfigResp, error)
 	// 配置下发
 	PushConfig(ctx context.Context, in *PushConfigReq, opts ...grpc.CallOption) (*PushConfigResp, error)
+	// 漂移配置重新下发
+	RepushDriftedConfig(ctx context.Context, in *RepushDriftedConfigReq, opts ...grpc.CallOption) (*RepushDriftedConfigResp, error)
 	// 删除模板配置
 	DeleteConfigTemplate(ctx context.Context, in *DeleteConfigTemplateReq, opts ...grpc.CallOption) (*DeleteConfigTemplateResp, error)
 	OperateGenerateConfig(ctx context.Context, in *OperateGenerateConfigReq, opts ...grpc.CallOption) (*OperateGenerateConfigResp, error)
@@ -846,6 +973,24 @@ func (c *dataClient) CheckReleaseName(ctx context.Context, in *CheckReleaseNameR
 	return out, nil
 }
 
+func (c *dataClient) UpdateReleaseTags(ctx context.Context, in *UpdateReleaseTagsReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateReleaseTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error) {
+	out := new(PromoteReleaseResp)
+	err := c.cc.Invoke(ctx, Data_PromoteRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) GetReleasedConfigItem(ctx context.Context, in *GetReleasedCIReq, opts ...grpc.CallOption) (*released_ci.ReleasedConfigItem, error) {
 	out := new(released_ci.ReleasedConfigItem)
 	err := c.cc.Invoke(ctx, Data_GetReleasedConfigItem_FullMethodName, in, out, opts...)
@@ -1044,6 +1189,33 @@ func (c *dataClient) GetReleaseHook(ctx context.Context, in *GetReleaseHookReq,
 	return out, nil
 }
 
+func (c *dataClient) ImportTemplateSpaceHooks(ctx context.Context, in *ImportTemplateSpaceHooksReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_ImportTemplateSpaceHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListTemplateSpaceHooks(ctx context.Context, in *ListTemplateSpaceHooksReq, opts ...grpc.CallOption) (*ListTemplateSpaceHooksResp, error) {
+	out := new(ListTemplateSpaceHooksResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplateSpaceHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) DeleteTemplateSpaceHook(ctx context.Context, in *DeleteTemplateSpaceHookReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteTemplateSpaceHook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) CreateTemplateSpace(ctx context.Context, in *CreateTemplateSpaceReq, opts ...grpc.CallOption) (*CreateResp, error) {
 	out := new(CreateResp)
 	err := c.cc.Invoke(ctx, Data_CreateTemplateSpace_FullMethodName, in, out, opts...)
@@ -1512,6 +1684,33 @@ func (c *dataClient) ListReleasedAppTmplVariables(ctx context.Context, in *ListR
 	return out, nil
 }
 
+func (c *dataClient) UpdateGroupTmplVariables(ctx context.Context, in *UpdateGroupTmplVariablesReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateGroupTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListGroupTmplVariables(ctx context.Context, in *ListGroupTmplVariablesReq, opts ...grpc.CallOption) (*ListGroupTmplVariablesResp, error) {
+	out := new(ListGroupTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Data_ListGroupTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) TraceAppTmplVariables(ctx context.Context, in *TraceAppTmplVariablesReq, opts ...grpc.CallOption) (*TraceAppTmplVariablesResp, error) {
+	out := new(TraceAppTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Data_TraceAppTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) ListTmplBoundCounts(ctx context.Context, in *ListTmplBoundCountsReq, opts ...grpc.CallOption) (*ListTmplBoundCountsResp, error) {
 	out := new(ListTmplBoundCountsResp)
 	err := c.cc.Invoke(ctx, Data_ListTmplBoundCounts_FullMethodName, in, out, opts...)
@@ -1854,6 +2053,33 @@ func (c *dataClient) GenerateReleaseAndPublish(ctx context.Context, in *Generate
 	return out, nil
 }
 
+func (c *dataClient) RollbackRelease(ctx context.Context, in *RollbackReleaseReq, opts ...grpc.CallOption) (*RollbackReleaseResp, error) {
+	out := new(RollbackReleaseResp)
+	err := c.cc.Invoke(ctx, Data_RollbackRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) PinGroupRelease(ctx context.Context, in *PinGroupReleaseReq, opts ...grpc.CallOption) (*PinGroupReleaseResp, error) {
+	out := new(PinGroupReleaseResp)
+	err := c.cc.Invoke(ctx, Data_PinGroupRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) UnpinGroupRelease(ctx context.Context, in *UnpinGroupReleaseReq, opts ...grpc.CallOption) (*UnpinGroupReleaseResp, error) {
+	out := new(UnpinGroupReleaseResp)
+	err := c.cc.Invoke(ctx, Data_UnpinGroupRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) ApprovalCallback(ctx context.Context, in *ApprovalCallbackReq, opts ...grpc.CallOption) (*ApprovalCallbackResp, error) {
 	out := new(ApprovalCallbackResp)
 	err := c.cc.Invoke(ctx, Data_ApprovalCallback_FullMethodName, in, out, opts...)
@@ -1917,6 +2143,24 @@ func (c *dataClient) CheckCredentialName(ctx context.Context, in *CheckCredentia
 	return out, nil
 }
 
+func (c *dataClient) RotateCredential(ctx context.Context, in *RotateCredentialReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_RotateCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) BatchUpdateCredentialUsage(ctx context.Context, in *BatchUpdateCredentialUsageReq, opts ...grpc.CallOption) (*BatchUpdateCredentialUsageResp, error) {
+	out := new(BatchUpdateCredentialUsageResp)
+	err := c.cc.Invoke(ctx, Data_BatchUpdateCredentialUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) ListCredentialScopes(ctx context.Context, in *ListCredentialScopesReq, opts ...grpc.CallOption) (*ListCredentialScopesResp, error) {
 	out := new(ListCredentialScopesResp)
 	err := c.cc.Invoke(ctx, Data_ListCredentialScopes_FullMethodName, in, out, opts...)
@@ -2178,406 +2422,802 @@ func (c *dataClient) ClientSpecificFailedReason(ctx context.Context, in *client.
 	return out, nil
 }
 
-func (c *dataClient) ListInstances(ctx context.Context, in *ListInstancesReq, opts ...grpc.CallOption) (*ListInstancesResp, error) {
-	out := new(ListInstancesResp)
-	err := c.cc.Invoke(ctx, Data_ListInstances_FullMethodName, in, out, opts...)
+func (c *dataClient) ClientStageStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Data_ClientStageStatistics_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) FetchInstanceInfo(ctx context.Context, in *FetchInstanceInfoReq, opts ...grpc.CallOption) (*FetchInstanceInfoResp, error) {
-	out := new(FetchInstanceInfoResp)
-	err := c.cc.Invoke(ctx, Data_FetchInstanceInfo_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateClientAlertRule(ctx context.Context, in *CreateClientAlertRuleReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateClientAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) Ping(ctx context.Context, in *PingMsg, opts ...grpc.CallOption) (*PingMsg, error) {
-	out := new(PingMsg)
-	err := c.cc.Invoke(ctx, Data_Ping_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateClientAlertRule(ctx context.Context, in *UpdateClientAlertRuleReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateClientAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) BatchUpsertClientMetrics(ctx context.Context, in *BatchUpsertClientMetricsReq, opts ...grpc.CallOption) (*BatchUpsertClientMetricsResp, error) {
-	out := new(BatchUpsertClientMetricsResp)
-	err := c.cc.Invoke(ctx, Data_BatchUpsertClientMetrics_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteClientAlertRule(ctx context.Context, in *DeleteClientAlertRuleReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteClientAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CompareConfigItemConflicts(ctx context.Context, in *CompareConfigItemConflictsReq, opts ...grpc.CallOption) (*CompareConfigItemConflictsResp, error) {
-	out := new(CompareConfigItemConflictsResp)
-	err := c.cc.Invoke(ctx, Data_CompareConfigItemConflicts_FullMethodName, in, out, opts...)
+func (c *dataClient) ListClientAlertRules(ctx context.Context, in *ListClientAlertRulesReq, opts ...grpc.CallOption) (*ListClientAlertRulesResp, error) {
+	out := new(ListClientAlertRulesResp)
+	err := c.cc.Invoke(ctx, Data_ListClientAlertRules_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetTemplateAndNonTemplateCICount(ctx context.Context, in *GetTemplateAndNonTemplateCICountReq, opts ...grpc.CallOption) (*GetTemplateAndNonTemplateCICountResp, error) {
-	out := new(GetTemplateAndNonTemplateCICountResp)
-	err := c.cc.Invoke(ctx, Data_GetTemplateAndNonTemplateCICount_FullMethodName, in, out, opts...)
+func (c *dataClient) SilenceClientAlertRule(ctx context.Context, in *SilenceClientAlertRuleReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_SilenceClientAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) BatchUpdateLastConsumedTime(ctx context.Context, in *BatchUpdateLastConsumedTimeReq, opts ...grpc.CallOption) (*BatchUpdateLastConsumedTimeResp, error) {
-	out := new(BatchUpdateLastConsumedTimeResp)
-	err := c.cc.Invoke(ctx, Data_BatchUpdateLastConsumedTime_FullMethodName, in, out, opts...)
+func (c *dataClient) AckClientAlert(ctx context.Context, in *AckClientAlertReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_AckClientAlert_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListProcess(ctx context.Context, in *ListProcessReq, opts ...grpc.CallOption) (*ListProcessResp, error) {
-	out := new(ListProcessResp)
-	err := c.cc.Invoke(ctx, Data_ListProcess_FullMethodName, in, out, opts...)
+func (c *dataClient) ListClientAlertHistories(ctx context.Context, in *ListClientAlertHistoriesReq, opts ...grpc.CallOption) (*ListClientAlertHistoriesResp, error) {
+	out := new(ListClientAlertHistoriesResp)
+	err := c.cc.Invoke(ctx, Data_ListClientAlertHistories_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListProcessInnerIPs(ctx context.Context, in *ListProcessInnerIPsReq, opts ...grpc.CallOption) (*ListProcessInnerIPsResp, error) {
-	out := new(ListProcessInnerIPsResp)
-	err := c.cc.Invoke(ctx, Data_ListProcessInnerIPs_FullMethodName, in, out, opts...)
+func (c *dataClient) ListClientMetricTrends(ctx context.Context, in *ListClientMetricTrendsReq, opts ...grpc.CallOption) (*ListClientMetricTrendsResp, error) {
+	out := new(ListClientMetricTrendsResp)
+	err := c.cc.Invoke(ctx, Data_ListClientMetricTrends_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) OperateProcess(ctx context.Context, in *OperateProcessReq, opts ...grpc.CallOption) (*OperateProcessResp, error) {
-	out := new(OperateProcessResp)
-	err := c.cc.Invoke(ctx, Data_OperateProcess_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateGitRepoLink(ctx context.Context, in *CreateGitRepoLinkReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateGitRepoLink_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ProcessFilterOptions(ctx context.Context, in *ProcessFilterOptionsReq, opts ...grpc.CallOption) (*ProcessFilterOptionsResp, error) {
-	out := new(ProcessFilterOptionsResp)
-	err := c.cc.Invoke(ctx, Data_ProcessFilterOptions_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateGitRepoLink(ctx context.Context, in *UpdateGitRepoLinkReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateGitRepoLink_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) SyncCmdbGseStatus(ctx context.Context, in *SyncCmdbGseStatusReq, opts ...grpc.CallOption) (*SyncCmdbGseStatusResp, error) {
-	out := new(SyncCmdbGseStatusResp)
-	err := c.cc.Invoke(ctx, Data_SyncCmdbGseStatus_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteGitRepoLink(ctx context.Context, in *DeleteGitRepoLinkReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteGitRepoLink_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTaskBatch(ctx context.Context, in *ListTaskBatchReq, opts ...grpc.CallOption) (*ListTaskBatchResp, error) {
-	out := new(ListTaskBatchResp)
-	err := c.cc.Invoke(ctx, Data_ListTaskBatch_FullMethodName, in, out, opts...)
+func (c *dataClient) GetGitRepoLink(ctx context.Context, in *GetGitRepoLinkReq, opts ...grpc.CallOption) (*git_repo_link.GitRepoLink, error) {
+	out := new(git_repo_link.GitRepoLink)
+	err := c.cc.Invoke(ctx, Data_GetGitRepoLink_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetTaskBatchDetail(ctx context.Context, in *GetTaskBatchDetailReq, opts ...grpc.CallOption) (*GetTaskBatchDetailResp, error) {
-	out := new(GetTaskBatchDetailResp)
-	err := c.cc.Invoke(ctx, Data_GetTaskBatchDetail_FullMethodName, in, out, opts...)
+func (c *dataClient) ListGitRepoLinks(ctx context.Context, in *ListGitRepoLinksReq, opts ...grpc.CallOption) (*ListGitRepoLinksResp, error) {
+	out := new(ListGitRepoLinksResp)
+	err := c.cc.Invoke(ctx, Data_ListGitRepoLinks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) RetryTasks(ctx context.Context, in *RetryTasksReq, opts ...grpc.CallOption) (*RetryTasksResp, error) {
-	out := new(RetryTasksResp)
-	err := c.cc.Invoke(ctx, Data_RetryTasks_FullMethodName, in, out, opts...)
+func (c *dataClient) SyncGitRepoLink(ctx context.Context, in *SyncGitRepoLinkReq, opts ...grpc.CallOption) (*SyncGitRepoLinkResp, error) {
+	out := new(SyncGitRepoLinkResp)
+	err := c.cc.Invoke(ctx, Data_SyncGitRepoLink_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CmdbGseStatus(ctx context.Context, in *CmdbGseStatusReq, opts ...grpc.CallOption) (*CmdbGseStatusResp, error) {
-	out := new(CmdbGseStatusResp)
-	err := c.cc.Invoke(ctx, Data_CmdbGseStatus_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateChangeFreeze(ctx context.Context, in *CreateChangeFreezeReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateChangeFreeze_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) BizTopo(ctx context.Context, in *BizTopoReq, opts ...grpc.CallOption) (*BizTopoResp, error) {
-	out := new(BizTopoResp)
-	err := c.cc.Invoke(ctx, Data_BizTopo_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateChangeFreeze(ctx context.Context, in *UpdateChangeFreezeReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateChangeFreeze_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ServiceTemplate(ctx context.Context, in *ServiceTemplateReq, opts ...grpc.CallOption) (*ServiceTemplateResp, error) {
-	out := new(ServiceTemplateResp)
-	err := c.cc.Invoke(ctx, Data_ServiceTemplate_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteChangeFreeze(ctx context.Context, in *DeleteChangeFreezeReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteChangeFreeze_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ProcessTemplate(ctx context.Context, in *ProcessTemplateReq, opts ...grpc.CallOption) (*ProcessTemplateResp, error) {
-	out := new(ProcessTemplateResp)
-	err := c.cc.Invoke(ctx, Data_ProcessTemplate_FullMethodName, in, out, opts...)
+func (c *dataClient) GetChangeFreeze(ctx context.Context, in *GetChangeFreezeReq, opts ...grpc.CallOption) (*change_freeze.ChangeFreeze, error) {
+	out := new(change_freeze.ChangeFreeze)
+	err := c.cc.Invoke(ctx, Data_GetChangeFreeze_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ServiceInstance(ctx context.Context, in *ServiceInstanceReq, opts ...grpc.CallOption) (*ServiceInstanceResp, error) {
-	out := new(ServiceInstanceResp)
-	err := c.cc.Invoke(ctx, Data_ServiceInstance_FullMethodName, in, out, opts...)
+func (c *dataClient) ListChangeFreezes(ctx context.Context, in *ListChangeFreezesReq, opts ...grpc.CallOption) (*ListChangeFreezesResp, error) {
+	out := new(ListChangeFreezesResp)
+	err := c.cc.Invoke(ctx, Data_ListChangeFreezes_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ProcessInstance(ctx context.Context, in *ProcessInstanceReq, opts ...grpc.CallOption) (*ProcessInstanceResp, error) {
-	out := new(ProcessInstanceResp)
-	err := c.cc.Invoke(ctx, Data_ProcessInstance_FullMethodName, in, out, opts...)
+func (c *dataClient) RecordChangeFreezeBreakGlass(ctx context.Context, in *RecordChangeFreezeBreakGlassReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_RecordChangeFreezeBreakGlass_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListConfigTemplate(ctx context.Context, in *ListConfigTemplateReq, opts ...grpc.CallOption) (*ListConfigTemplateResp, error) {
-	out := new(ListConfigTemplateResp)
-	err := c.cc.Invoke(ctx, Data_ListConfigTemplate_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateWebhook_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateConfigTemplate(ctx context.Context, in *CreateConfigTemplateReq, opts ...grpc.CallOption) (*CreateConfigTemplateResp, error) {
-	out := new(CreateConfigTemplateResp)
-	err := c.cc.Invoke(ctx, Data_CreateConfigTemplate_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateWebhook_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateConfigTemplate(ctx context.Context, in *UpdateConfigTemplateReq, opts ...grpc.CallOption) (*UpdateConfigTemplateResp, error) {
-	out := new(UpdateConfigTemplateResp)
-	err := c.cc.Invoke(ctx, Data_UpdateConfigTemplate_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteWebhook_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetConfigTemplate(ctx context.Context, in *GetConfigTemplateReq, opts ...grpc.CallOption) (*GetConfigTemplateResp, error) {
-	out := new(GetConfigTemplateResp)
-	err := c.cc.Invoke(ctx, Data_GetConfigTemplate_FullMethodName, in, out, opts...)
+func (c *dataClient) GetWebhook(ctx context.Context, in *GetWebhookReq, opts ...grpc.CallOption) (*webhook.Webhook, error) {
+	out := new(webhook.Webhook)
+	err := c.cc.Invoke(ctx, Data_GetWebhook_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ConfigTemplateVariable(ctx context.Context, in *ConfigTemplateVariableReq, opts ...grpc.CallOption) (*ConfigTemplateVariableResp, error) {
-	out := new(ConfigTemplateVariableResp)
-	err := c.cc.Invoke(ctx, Data_ConfigTemplateVariable_FullMethodName, in, out, opts...)
+func (c *dataClient) ListWebhooks(ctx context.Context, in *ListWebhooksReq, opts ...grpc.CallOption) (*ListWebhooksResp, error) {
+	out := new(ListWebhooksResp)
+	err := c.cc.Invoke(ctx, Data_ListWebhooks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) BindProcessInstance(ctx context.Context, in *BindProcessInstanceReq, opts ...grpc.CallOption) (*BindProcessInstanceResp, error) {
-	out := new(BindProcessInstanceResp)
-	err := c.cc.Invoke(ctx, Data_BindProcessInstance_FullMethodName, in, out, opts...)
+func (c *dataClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (*ListWebhookDeliveriesResp, error) {
+	out := new(ListWebhookDeliveriesResp)
+	err := c.cc.Invoke(ctx, Data_ListWebhookDeliveries_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) PreviewBindProcessInstance(ctx context.Context, in *PreviewBindProcessInstanceReq, opts ...grpc.CallOption) (*PreviewBindProcessInstanceResp, error) {
-	out := new(PreviewBindProcessInstanceResp)
-	err := c.cc.Invoke(ctx, Data_PreviewBindProcessInstance_FullMethodName, in, out, opts...)
+func (c *dataClient) RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_RedeliverWebhookDelivery_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListConfigInstances(ctx context.Context, in *ListConfigInstancesReq, opts ...grpc.CallOption) (*ListConfigInstancesResp, error) {
-	out := new(ListConfigInstancesResp)
-	err := c.cc.Invoke(ctx, Data_ListConfigInstances_FullMethodName, in, out, opts...)
+func (c *dataClient) SetApprovalFlow(ctx context.Context, in *SetApprovalFlowReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_SetApprovalFlow_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GenerateConfig(ctx context.Context, in *GenerateConfigReq, opts ...grpc.CallOption) (*GenerateConfigResp, error) {
-	out := new(GenerateConfigResp)
-	err := c.cc.Invoke(ctx, Data_GenerateConfig_FullMethodName, in, out, opts...)
+func (c *dataClient) GetApprovalFlow(ctx context.Context, in *GetApprovalFlowReq, opts ...grpc.CallOption) (*approval.ApprovalFlow, error) {
+	out := new(approval.ApprovalFlow)
+	err := c.cc.Invoke(ctx, Data_GetApprovalFlow_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CheckConfig(ctx context.Context, in *CheckConfigReq, opts ...grpc.CallOption) (*CheckConfigResp, error) {
-	out := new(CheckConfigResp)
-	err := c.cc.Invoke(ctx, Data_CheckConfig_FullMethodName, in, out, opts...)
+func (c *dataClient) GetApprovalTicket(ctx context.Context, in *GetApprovalTicketReq, opts ...grpc.CallOption) (*GetApprovalTicketResp, error) {
+	out := new(GetApprovalTicketResp)
+	err := c.cc.Invoke(ctx, Data_GetApprovalTicket_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetConfigDiff(ctx context.Context, in *GetConfigDiffReq, opts ...grpc.CallOption) (*GetConfigDiffResp, error) {
-	out := new(GetConfigDiffResp)
-	err := c.cc.Invoke(ctx, Data_GetConfigDiff_FullMethodName, in, out, opts...)
+func (c *dataClient) DelegateApproval(ctx context.Context, in *DelegateApprovalReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DelegateApproval_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetConfigView(ctx context.Context, in *GetConfigViewReq, opts ...grpc.CallOption) (*GetConfigViewResp, error) {
-	out := new(GetConfigViewResp)
-	err := c.cc.Invoke(ctx, Data_GetConfigView_FullMethodName, in, out, opts...)
+func (c *dataClient) CommentApproval(ctx context.Context, in *CommentApprovalReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_CommentApproval_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ConfigGenerateStatus(ctx context.Context, in *ConfigGenerateStatusReq, opts ...grpc.CallOption) (*ConfigGenerateStatusResp, error) {
-	out := new(ConfigGenerateStatusResp)
-	err := c.cc.Invoke(ctx, Data_ConfigGenerateStatus_FullMethodName, in, out, opts...)
+func (c *dataClient) ExportAppBundle(ctx context.Context, in *ExportAppBundleReq, opts ...grpc.CallOption) (*ExportAppBundleResp, error) {
+	out := new(ExportAppBundleResp)
+	err := c.cc.Invoke(ctx, Data_ExportAppBundle_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetConfigGenerateResult(ctx context.Context, in *GetConfigGenerateResultReq, opts ...grpc.CallOption) (*GetConfigGenerateResultResp, error) {
-	out := new(GetConfigGenerateResultResp)
-	err := c.cc.Invoke(ctx, Data_GetConfigGenerateResult_FullMethodName, in, out, opts...)
+func (c *dataClient) ImportAppBundle(ctx context.Context, in *ImportAppBundleReq, opts ...grpc.CallOption) (*ImportAppBundleResp, error) {
+	out := new(ImportAppBundleResp)
+	err := c.cc.Invoke(ctx, Data_ImportAppBundle_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) PreviewConfig(ctx context.Context, in *PreviewConfigReq, opts ...grpc.CallOption) (*PreviewConfigResp, error) {
-	out := new(PreviewConfigResp)
-	err := c.cc.Invoke(ctx, Data_PreviewConfig_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateRole(ctx context.Context, in *CreateRoleReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateRole_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) PushConfig(ctx context.Context, in *PushConfigReq, opts ...grpc.CallOption) (*PushConfigResp, error) {
-	out := new(PushConfigResp)
-	err := c.cc.Invoke(ctx, Data_PushConfig_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateRole(ctx context.Context, in *UpdateRoleReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateRole_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteConfigTemplate(ctx context.Context, in *DeleteConfigTemplateReq, opts ...grpc.CallOption) (*DeleteConfigTemplateResp, error) {
-	out := new(DeleteConfigTemplateResp)
-	err := c.cc.Invoke(ctx, Data_DeleteConfigTemplate_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteRole(ctx context.Context, in *DeleteRoleReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteRole_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) OperateGenerateConfig(ctx context.Context, in *OperateGenerateConfigReq, opts ...grpc.CallOption) (*OperateGenerateConfigResp, error) {
-	out := new(OperateGenerateConfigResp)
-	err := c.cc.Invoke(ctx, Data_OperateGenerateConfig_FullMethodName, in, out, opts...)
+func (c *dataClient) ListRoles(ctx context.Context, in *ListRolesReq, opts ...grpc.CallOption) (*ListRolesResp, error) {
+	out := new(ListRolesResp)
+	err := c.cc.Invoke(ctx, Data_ListRoles_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetProcessInstanceTopo(ctx context.Context, in *GetProcessInstanceTopoReq, opts ...grpc.CallOption) (*GetProcessInstanceTopoResp, error) {
-	out := new(GetProcessInstanceTopoResp)
-	err := c.cc.Invoke(ctx, Data_GetProcessInstanceTopo_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateRoleBinding(ctx context.Context, in *CreateRoleBindingReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateRoleBinding_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ManageConfigKV(ctx context.Context, in *ManageConfigKVReq, opts ...grpc.CallOption) (*ManageConfigKVResp, error) {
-	out := new(ManageConfigKVResp)
-	err := c.cc.Invoke(ctx, Data_ManageConfigKV_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteRoleBinding_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetProcessConfigView(ctx context.Context, in *GetProcessConfigViewReq, opts ...grpc.CallOption) (*GetProcessConfigViewResp, error) {
-	out := new(GetProcessConfigViewResp)
-	err := c.cc.Invoke(ctx, Data_GetProcessConfigView_FullMethodName, in, out, opts...)
+func (c *dataClient) ListRoleBindings(ctx context.Context, in *ListRoleBindingsReq, opts ...grpc.CallOption) (*ListRoleBindingsResp, error) {
+	out := new(ListRoleBindingsResp)
+	err := c.cc.Invoke(ctx, Data_ListRoleBindings_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// DataServer is the server API for Data service.
-// All implementations should embed UnimplementedDataServer
-// for forward compatibility
-type DataServer interface {
-	// app related interface.
-	CreateApp(context.Context, *CreateAppReq) (*CreateResp, error)
-	UpdateApp(context.Context, *UpdateAppReq) (*app.App, error)
-	DeleteApp(context.Context, *DeleteAppReq) (*base.EmptyResp, error)
-	GetApp(context.Context, *GetAppReq) (*app.App, error)
-	GetAppByID(context.Context, *GetAppByIDReq) (*app.App, error)
-	GetAppByName(context.Context, *GetAppByNameReq) (*app.App, error)
-	ListAppsRest(context.Context, *ListAppsRestReq) (*ListAppsResp, error)
-	ListAppsByIDs(context.Context, *ListAppsByIDsReq) (*ListAppsByIDsResp, error)
-	CloneApp(context.Context, *CloneAppReq) (*CreateResp, error)
-	// config item related interface.
-	CreateConfigItem(context.Context, *CreateConfigItemReq) (*CreateResp, error)
-	BatchUpsertConfigItems(context.Context, *BatchUpsertConfigItemsReq) (*BatchUpsertConfigItemsResp, error)
-	UpdateConfigItem(context.Context, *UpdateConfigItemReq) (*base.EmptyResp, error)
-	DeleteConfigItem(context.Context, *DeleteConfigItemReq) (*base.EmptyResp, error)
-	UnDeleteConfigItem(context.Context, *UnDeleteConfigItemReq) (*base.EmptyResp, error)
-	UndoConfigItem(context.Context, *UndoConfigItemReq) (*base.EmptyResp, error)
-	GetConfigItem(context.Context, *GetConfigItemReq) (*config_item.ConfigItem, error)
+func (c *dataClient) ListRolePolicies(ctx context.Context, in *ListRolePoliciesReq, opts ...grpc.CallOption) (*ListRolePoliciesResp, error) {
+	out := new(ListRolePoliciesResp)
+	err := c.cc.Invoke(ctx, Data_ListRolePolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListInstances(ctx context.Context, in *ListInstancesReq, opts ...grpc.CallOption) (*ListInstancesResp, error) {
+	out := new(ListInstancesResp)
+	err := c.cc.Invoke(ctx, Data_ListInstances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) FetchInstanceInfo(ctx context.Context, in *FetchInstanceInfoReq, opts ...grpc.CallOption) (*FetchInstanceInfoResp, error) {
+	out := new(FetchInstanceInfoResp)
+	err := c.cc.Invoke(ctx, Data_FetchInstanceInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) Ping(ctx context.Context, in *PingMsg, opts ...grpc.CallOption) (*PingMsg, error) {
+	out := new(PingMsg)
+	err := c.cc.Invoke(ctx, Data_Ping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) BatchUpsertClientMetrics(ctx context.Context, in *BatchUpsertClientMetricsReq, opts ...grpc.CallOption) (*BatchUpsertClientMetricsResp, error) {
+	out := new(BatchUpsertClientMetricsResp)
+	err := c.cc.Invoke(ctx, Data_BatchUpsertClientMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) CompareConfigItemConflicts(ctx context.Context, in *CompareConfigItemConflictsReq, opts ...grpc.CallOption) (*CompareConfigItemConflictsResp, error) {
+	out := new(CompareConfigItemConflictsResp)
+	err := c.cc.Invoke(ctx, Data_CompareConfigItemConflicts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetTemplateAndNonTemplateCICount(ctx context.Context, in *GetTemplateAndNonTemplateCICountReq, opts ...grpc.CallOption) (*GetTemplateAndNonTemplateCICountResp, error) {
+	out := new(GetTemplateAndNonTemplateCICountResp)
+	err := c.cc.Invoke(ctx, Data_GetTemplateAndNonTemplateCICount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) BatchUpdateLastConsumedTime(ctx context.Context, in *BatchUpdateLastConsumedTimeReq, opts ...grpc.CallOption) (*BatchUpdateLastConsumedTimeResp, error) {
+	out := new(BatchUpdateLastConsumedTimeResp)
+	err := c.cc.Invoke(ctx, Data_BatchUpdateLastConsumedTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListProcess(ctx context.Context, in *ListProcessReq, opts ...grpc.CallOption) (*ListProcessResp, error) {
+	out := new(ListProcessResp)
+	err := c.cc.Invoke(ctx, Data_ListProcess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListProcessInnerIPs(ctx context.Context, in *ListProcessInnerIPsReq, opts ...grpc.CallOption) (*ListProcessInnerIPsResp, error) {
+	out := new(ListProcessInnerIPsResp)
+	err := c.cc.Invoke(ctx, Data_ListProcessInnerIPs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) OperateProcess(ctx context.Context, in *OperateProcessReq, opts ...grpc.CallOption) (*OperateProcessResp, error) {
+	out := new(OperateProcessResp)
+	err := c.cc.Invoke(ctx, Data_OperateProcess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ProcessFilterOptions(ctx context.Context, in *ProcessFilterOptionsReq, opts ...grpc.CallOption) (*ProcessFilterOptionsResp, error) {
+	out := new(ProcessFilterOptionsResp)
+	err := c.cc.Invoke(ctx, Data_ProcessFilterOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) SyncCmdbGseStatus(ctx context.Context, in *SyncCmdbGseStatusReq, opts ...grpc.CallOption) (*SyncCmdbGseStatusResp, error) {
+	out := new(SyncCmdbGseStatusResp)
+	err := c.cc.Invoke(ctx, Data_SyncCmdbGseStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListTaskBatch(ctx context.Context, in *ListTaskBatchReq, opts ...grpc.CallOption) (*ListTaskBatchResp, error) {
+	out := new(ListTaskBatchResp)
+	err := c.cc.Invoke(ctx, Data_ListTaskBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetTaskBatchDetail(ctx context.Context, in *GetTaskBatchDetailReq, opts ...grpc.CallOption) (*GetTaskBatchDetailResp, error) {
+	out := new(GetTaskBatchDetailResp)
+	err := c.cc.Invoke(ctx, Data_GetTaskBatchDetail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) RetryTasks(ctx context.Context, in *RetryTasksReq, opts ...grpc.CallOption) (*RetryTasksResp, error) {
+	out := new(RetryTasksResp)
+	err := c.cc.Invoke(ctx, Data_RetryTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) CmdbGseStatus(ctx context.Context, in *CmdbGseStatusReq, opts ...grpc.CallOption) (*CmdbGseStatusResp, error) {
+	out := new(CmdbGseStatusResp)
+	err := c.cc.Invoke(ctx, Data_CmdbGseStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) BizTopo(ctx context.Context, in *BizTopoReq, opts ...grpc.CallOption) (*BizTopoResp, error) {
+	out := new(BizTopoResp)
+	err := c.cc.Invoke(ctx, Data_BizTopo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ServiceTemplate(ctx context.Context, in *ServiceTemplateReq, opts ...grpc.CallOption) (*ServiceTemplateResp, error) {
+	out := new(ServiceTemplateResp)
+	err := c.cc.Invoke(ctx, Data_ServiceTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ProcessTemplate(ctx context.Context, in *ProcessTemplateReq, opts ...grpc.CallOption) (*ProcessTemplateResp, error) {
+	out := new(ProcessTemplateResp)
+	err := c.cc.Invoke(ctx, Data_ProcessTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ServiceInstance(ctx context.Context, in *ServiceInstanceReq, opts ...grpc.CallOption) (*ServiceInstanceResp, error) {
+	out := new(ServiceInstanceResp)
+	err := c.cc.Invoke(ctx, Data_ServiceInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ProcessInstance(ctx context.Context, in *ProcessInstanceReq, opts ...grpc.CallOption) (*ProcessInstanceResp, error) {
+	out := new(ProcessInstanceResp)
+	err := c.cc.Invoke(ctx, Data_ProcessInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListConfigTemplate(ctx context.Context, in *ListConfigTemplateReq, opts ...grpc.CallOption) (*ListConfigTemplateResp, error) {
+	out := new(ListConfigTemplateResp)
+	err := c.cc.Invoke(ctx, Data_ListConfigTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) CreateConfigTemplate(ctx context.Context, in *CreateConfigTemplateReq, opts ...grpc.CallOption) (*CreateConfigTemplateResp, error) {
+	out := new(CreateConfigTemplateResp)
+	err := c.cc.Invoke(ctx, Data_CreateConfigTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) UpdateConfigTemplate(ctx context.Context, in *UpdateConfigTemplateReq, opts ...grpc.CallOption) (*UpdateConfigTemplateResp, error) {
+	out := new(UpdateConfigTemplateResp)
+	err := c.cc.Invoke(ctx, Data_UpdateConfigTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetConfigTemplate(ctx context.Context, in *GetConfigTemplateReq, opts ...grpc.CallOption) (*GetConfigTemplateResp, error) {
+	out := new(GetConfigTemplateResp)
+	err := c.cc.Invoke(ctx, Data_GetConfigTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ConfigTemplateVariable(ctx context.Context, in *ConfigTemplateVariableReq, opts ...grpc.CallOption) (*ConfigTemplateVariableResp, error) {
+	out := new(ConfigTemplateVariableResp)
+	err := c.cc.Invoke(ctx, Data_ConfigTemplateVariable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) BindProcessInstance(ctx context.Context, in *BindProcessInstanceReq, opts ...grpc.CallOption) (*BindProcessInstanceResp, error) {
+	out := new(BindProcessInstanceResp)
+	err := c.cc.Invoke(ctx, Data_BindProcessInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) PreviewBindProcessInstance(ctx context.Context, in *PreviewBindProcessInstanceReq, opts ...grpc.CallOption) (*PreviewBindProcessInstanceResp, error) {
+	out := new(PreviewBindProcessInstanceResp)
+	err := c.cc.Invoke(ctx, Data_PreviewBindProcessInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListConfigInstances(ctx context.Context, in *ListConfigInstancesReq, opts ...grpc.CallOption) (*ListConfigInstancesResp, error) {
+	out := new(ListConfigInstancesResp)
+	err := c.cc.Invoke(ctx, Data_ListConfigInstances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GenerateConfig(ctx context.Context, in *GenerateConfigReq, opts ...grpc.CallOption) (*GenerateConfigResp, error) {
+	out := new(GenerateConfigResp)
+	err := c.cc.Invoke(ctx, Data_GenerateConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) CheckConfig(ctx context.Context, in *CheckConfigReq, opts ...grpc.CallOption) (*CheckConfigResp, error) {
+	out := new(CheckConfigResp)
+	err := c.cc.Invoke(ctx, Data_CheckConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetConfigDiff(ctx context.Context, in *GetConfigDiffReq, opts ...grpc.CallOption) (*GetConfigDiffResp, error) {
+	out := new(GetConfigDiffResp)
+	err := c.cc.Invoke(ctx, Data_GetConfigDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetConfigView(ctx context.Context, in *GetConfigViewReq, opts ...grpc.CallOption) (*GetConfigViewResp, error) {
+	out := new(GetConfigViewResp)
+	err := c.cc.Invoke(ctx, Data_GetConfigView_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ConfigGenerateStatus(ctx context.Context, in *ConfigGenerateStatusReq, opts ...grpc.CallOption) (*ConfigGenerateStatusResp, error) {
+	out := new(ConfigGenerateStatusResp)
+	err := c.cc.Invoke(ctx, Data_ConfigGenerateStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetConfigGenerateResult(ctx context.Context, in *GetConfigGenerateResultReq, opts ...grpc.CallOption) (*GetConfigGenerateResultResp, error) {
+	out := new(GetConfigGenerateResultResp)
+	err := c.cc.Invoke(ctx, Data_GetConfigGenerateResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) PreviewConfig(ctx context.Context, in *PreviewConfigReq, opts ...grpc.CallOption) (*PreviewConfigResp, error) {
+	out := new(PreviewConfigResp)
+	err := c.cc.Invoke(ctx, Data_PreviewConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) PushConfig(ctx context.Context, in *PushConfigReq, opts ...grpc.CallOption) (*PushConfigResp, error) {
+	out := new(PushConfigResp)
+	err := c.cc.Invoke(ctx, Data_PushConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) RepushDriftedConfig(ctx context.Context, in *RepushDriftedConfigReq, opts ...grpc.CallOption) (*RepushDriftedConfigResp, error) {
+	out := new(RepushDriftedConfigResp)
+	err := c.cc.Invoke(ctx, Data_RepushDriftedConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) DeleteConfigTemplate(ctx context.Context, in *DeleteConfigTemplateReq, opts ...grpc.CallOption) (*DeleteConfigTemplateResp, error) {
+	out := new(DeleteConfigTemplateResp)
+	err := c.cc.Invoke(ctx, Data_DeleteConfigTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) OperateGenerateConfig(ctx context.Context, in *OperateGenerateConfigReq, opts ...grpc.CallOption) (*OperateGenerateConfigResp, error) {
+	out := new(OperateGenerateConfigResp)
+	err := c.cc.Invoke(ctx, Data_OperateGenerateConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetProcessInstanceTopo(ctx context.Context, in *GetProcessInstanceTopoReq, opts ...grpc.CallOption) (*GetProcessInstanceTopoResp, error) {
+	out := new(GetProcessInstanceTopoResp)
+	err := c.cc.Invoke(ctx, Data_GetProcessInstanceTopo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ManageConfigKV(ctx context.Context, in *ManageConfigKVReq, opts ...grpc.CallOption) (*ManageConfigKVResp, error) {
+	out := new(ManageConfigKVResp)
+	err := c.cc.Invoke(ctx, Data_ManageConfigKV_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetProcessConfigView(ctx context.Context, in *GetProcessConfigViewReq, opts ...grpc.CallOption) (*GetProcessConfigViewResp, error) {
+	out := new(GetProcessConfigViewResp)
+	err := c.cc.Invoke(ctx, Data_GetProcessConfigView_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataServer is the server API for Data service.
+// All implementations should embed UnimplementedDataServer
+// for forward compatibility
+type DataServer interface {
+	// app related interface.
+	CreateApp(context.Context, *CreateAppReq) (*CreateResp, error)
+	UpdateApp(context.Context, *UpdateAppReq) (*app.App, error)
+	DeleteApp(context.Context, *DeleteAppReq) (*base.EmptyResp, error)
+	GetApp(context.Context, *GetAppReq) (*app.App, error)
+	GetAppByID(context.Context, *GetAppByIDReq) (*app.App, error)
+	GetAppByName(context.Context, *GetAppByNameReq) (*app.App, error)
+	ListAppsRest(context.Context, *ListAppsRestReq) (*ListAppsResp, error)
+	ListAppsByIDs(context.Context, *ListAppsByIDsReq) (*ListAppsByIDsResp, error)
+	CloneApp(context.Context, *CloneAppReq) (*CreateResp, error)
+	// config item related interface.
+	CreateConfigItem(context.Context, *CreateConfigItemReq) (*CreateResp, error)
+	BatchUpsertConfigItems(context.Context, *BatchUpsertConfigItemsReq) (*BatchUpsertConfigItemsResp, error)
+	UpdateConfigItem(context.Context, *UpdateConfigItemReq) (*base.EmptyResp, error)
+	DeleteConfigItem(context.Context, *DeleteConfigItemReq) (*base.EmptyResp, error)
+	UnDeleteConfigItem(context.Context, *UnDeleteConfigItemReq) (*base.EmptyResp, error)
+	UndoConfigItem(context.Context, *UndoConfigItemReq) (*base.EmptyResp, error)
+	GetConfigItem(context.Context, *GetConfigItemReq) (*config_item.ConfigItem, error)
 	ListConfigItems(context.Context, *ListConfigItemsReq) (*ListConfigItemsResp, error)
 	ListReleasedConfigItems(context.Context, *ListReleasedConfigItemsReq) (*ListReleasedConfigItemsResp, error)
 	ListConfigItemCount(context.Context, *ListConfigItemCountReq) (*ListConfigItemCountResp, error)
@@ -2599,6 +3239,8 @@ type DataServer interface {
 	UnDeprecateRelease(context.Context, *UnDeprecateReleaseReq) (*base.EmptyResp, error)
 	DeleteRelease(context.Context, *DeleteReleaseReq) (*base.EmptyResp, error)
 	CheckReleaseName(context.Context, *CheckReleaseNameReq) (*CheckReleaseNameResp, error)
+	UpdateReleaseTags(context.Context, *UpdateReleaseTagsReq) (*base.EmptyResp, error)
+	PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error)
 	// released config item related interface.
 	GetReleasedConfigItem(context.Context, *GetReleasedCIReq) (*released_ci.ReleasedConfigItem, error)
 	// released kv related interface.
@@ -2625,6 +3267,10 @@ type DataServer interface {
 	UpdateHookRevision(context.Context, *UpdateHookRevisionReq) (*base.EmptyResp, error)
 	ListHookRevisionReferences(context.Context, *ListHookRevisionReferencesReq) (*ListHookRevisionReferencesResp, error)
 	GetReleaseHook(context.Context, *GetReleaseHookReq) (*GetReleaseHookResp, error)
+	// template space hook library related interface.
+	ImportTemplateSpaceHooks(context.Context, *ImportTemplateSpaceHooksReq) (*base.EmptyResp, error)
+	ListTemplateSpaceHooks(context.Context, *ListTemplateSpaceHooksReq) (*ListTemplateSpaceHooksResp, error)
+	DeleteTemplateSpaceHook(context.Context, *DeleteTemplateSpaceHookReq) (*base.EmptyResp, error)
 	// template space related interface.
 	CreateTemplateSpace(context.Context, *CreateTemplateSpaceReq) (*CreateResp, error)
 	ListTemplateSpaces(context.Context, *ListTemplateSpacesReq) (*ListTemplateSpacesResp, error)
@@ -2683,6 +3329,9 @@ type DataServer interface {
 	UpdateAppTmplVariables(context.Context, *UpdateAppTmplVariablesReq) (*base.EmptyResp, error)
 	ListAppTmplVariables(context.Context, *ListAppTmplVariablesReq) (*ListAppTmplVariablesResp, error)
 	ListReleasedAppTmplVariables(context.Context, *ListReleasedAppTmplVariablesReq) (*ListReleasedAppTmplVariablesResp, error)
+	UpdateGroupTmplVariables(context.Context, *UpdateGroupTmplVariablesReq) (*base.EmptyResp, error)
+	ListGroupTmplVariables(context.Context, *ListGroupTmplVariablesReq) (*ListGroupTmplVariablesResp, error)
+	TraceAppTmplVariables(context.Context, *TraceAppTmplVariablesReq) (*TraceAppTmplVariablesResp, error)
 	// template binding relation related interface.
 	ListTmplBoundCounts(context.Context, *ListTmplBoundCountsReq) (*ListTmplBoundCountsResp, error)
 	ListTmplRevisionBoundCounts(context.Context, *ListTmplRevisionBoundCountsReq) (*ListTmplRevisionBoundCountsResp, error)
@@ -2726,6 +3375,9 @@ type DataServer interface {
 	GetLastPublish(context.Context, *GetLastPublishReq) (*GetLastPublishResp, error)
 	GetReleasesStatus(context.Context, *GetReleasesStatusReq) (*strategy.Strategy, error)
 	GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error)
+	RollbackRelease(context.Context, *RollbackReleaseReq) (*RollbackReleaseResp, error)
+	PinGroupRelease(context.Context, *PinGroupReleaseReq) (*PinGroupReleaseResp, error)
+	UnpinGroupRelease(context.Context, *UnpinGroupReleaseReq) (*UnpinGroupReleaseResp, error)
 	ApprovalCallback(context.Context, *ApprovalCallbackReq) (*ApprovalCallbackResp, error)
 	// audit related interface.
 	ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error)
@@ -2735,6 +3387,8 @@ type DataServer interface {
 	DeleteCredential(context.Context, *DeleteCredentialReq) (*base.EmptyResp, error)
 	UpdateCredential(context.Context, *UpdateCredentialReq) (*base.EmptyResp, error)
 	CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error)
+	RotateCredential(context.Context, *RotateCredentialReq) (*base.EmptyResp, error)
+	BatchUpdateCredentialUsage(context.Context, *BatchUpdateCredentialUsageReq) (*BatchUpdateCredentialUsageResp, error)
 	// credential scope related interface
 	ListCredentialScopes(context.Context, *ListCredentialScopesReq) (*ListCredentialScopesResp, error)
 	UpdateCredentialScopes(context.Context, *UpdateCredentialScopesReq) (*UpdateCredentialScopesResp, error)
@@ -2770,6 +3424,56 @@ type DataServer interface {
 	ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
 	ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error)
 	ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	ClientStageStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	// client alert related interface
+	CreateClientAlertRule(context.Context, *CreateClientAlertRuleReq) (*CreateResp, error)
+	UpdateClientAlertRule(context.Context, *UpdateClientAlertRuleReq) (*base.EmptyResp, error)
+	DeleteClientAlertRule(context.Context, *DeleteClientAlertRuleReq) (*base.EmptyResp, error)
+	ListClientAlertRules(context.Context, *ListClientAlertRulesReq) (*ListClientAlertRulesResp, error)
+	SilenceClientAlertRule(context.Context, *SilenceClientAlertRuleReq) (*base.EmptyResp, error)
+	AckClientAlert(context.Context, *AckClientAlertReq) (*base.EmptyResp, error)
+	ListClientAlertHistories(context.Context, *ListClientAlertHistoriesReq) (*ListClientAlertHistoriesResp, error)
+	ListClientMetricTrends(context.Context, *ListClientMetricTrendsReq) (*ListClientMetricTrendsResp, error)
+	// git repository link related interface
+	CreateGitRepoLink(context.Context, *CreateGitRepoLinkReq) (*CreateResp, error)
+	UpdateGitRepoLink(context.Context, *UpdateGitRepoLinkReq) (*base.EmptyResp, error)
+	DeleteGitRepoLink(context.Context, *DeleteGitRepoLinkReq) (*base.EmptyResp, error)
+	GetGitRepoLink(context.Context, *GetGitRepoLinkReq) (*git_repo_link.GitRepoLink, error)
+	ListGitRepoLinks(context.Context, *ListGitRepoLinksReq) (*ListGitRepoLinksResp, error)
+	SyncGitRepoLink(context.Context, *SyncGitRepoLinkReq) (*SyncGitRepoLinkResp, error)
+	// change freeze related interface
+	CreateChangeFreeze(context.Context, *CreateChangeFreezeReq) (*CreateResp, error)
+	UpdateChangeFreeze(context.Context, *UpdateChangeFreezeReq) (*base.EmptyResp, error)
+	DeleteChangeFreeze(context.Context, *DeleteChangeFreezeReq) (*base.EmptyResp, error)
+	GetChangeFreeze(context.Context, *GetChangeFreezeReq) (*change_freeze.ChangeFreeze, error)
+	ListChangeFreezes(context.Context, *ListChangeFreezesReq) (*ListChangeFreezesResp, error)
+	RecordChangeFreezeBreakGlass(context.Context, *RecordChangeFreezeBreakGlassReq) (*base.EmptyResp, error)
+	// webhook related interface
+	CreateWebhook(context.Context, *CreateWebhookReq) (*CreateResp, error)
+	UpdateWebhook(context.Context, *UpdateWebhookReq) (*base.EmptyResp, error)
+	DeleteWebhook(context.Context, *DeleteWebhookReq) (*base.EmptyResp, error)
+	GetWebhook(context.Context, *GetWebhookReq) (*webhook.Webhook, error)
+	ListWebhooks(context.Context, *ListWebhooksReq) (*ListWebhooksResp, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesReq) (*ListWebhookDeliveriesResp, error)
+	RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryReq) (*CreateResp, error)
+	// builtin approval related interface
+	SetApprovalFlow(context.Context, *SetApprovalFlowReq) (*base.EmptyResp, error)
+	GetApprovalFlow(context.Context, *GetApprovalFlowReq) (*approval.ApprovalFlow, error)
+	GetApprovalTicket(context.Context, *GetApprovalTicketReq) (*GetApprovalTicketResp, error)
+	DelegateApproval(context.Context, *DelegateApprovalReq) (*base.EmptyResp, error)
+	CommentApproval(context.Context, *CommentApprovalReq) (*base.EmptyResp, error)
+	// app bundle related interface
+	ExportAppBundle(context.Context, *ExportAppBundleReq) (*ExportAppBundleResp, error)
+	ImportAppBundle(context.Context, *ImportAppBundleReq) (*ImportAppBundleResp, error)
+	// 内置 rbac 权限角色相关接口
+	CreateRole(context.Context, *CreateRoleReq) (*CreateResp, error)
+	UpdateRole(context.Context, *UpdateRoleReq) (*base.EmptyResp, error)
+	DeleteRole(context.Context, *DeleteRoleReq) (*base.EmptyResp, error)
+	ListRoles(context.Context, *ListRolesReq) (*ListRolesResp, error)
+	CreateRoleBinding(context.Context, *CreateRoleBindingReq) (*CreateResp, error)
+	DeleteRoleBinding(context.Context, *DeleteRoleBindingReq) (*base.EmptyResp, error)
+	ListRoleBindings(context.Context, *ListRoleBindingsReq) (*ListRoleBindingsResp, error)
+	ListRolePolicies(context.Context, *ListRolePoliciesReq) (*ListRolePoliciesResp, error)
 	// used iam pull resource callback.
 	ListInstances(context.Context, *ListInstancesReq) (*ListInstancesResp, error)
 	FetchInstanceInfo(context.Context, *FetchInstanceInfoReq) (*FetchInstanceInfoResp, error)
@@ -2830,6 +3534,8 @@ type DataServer interface {
 	PreviewConfig(context.Context, *PreviewConfigReq) (*PreviewConfigResp, error)
 	// 配置下发
 	PushConfig(context.Context, *PushConfigReq) (*PushConfigResp, error)
+	// 漂移配置重新下发
+	RepushDriftedConfig(context.Context, *RepushDriftedConfigReq) (*RepushDriftedConfigResp, error)
 	// 删除模板配置
 	DeleteConfigTemplate(context.Context, *DeleteConfigTemplateReq) (*DeleteConfigTemplateResp, error)
 	OperateGenerateConfig(context.Context, *OperateGenerateConfigReq) (*OperateGenerateConfigResp, error)
@@ -2943,6 +3649,12 @@ func (UnimplementedDataServer) DeleteRelease(context.Context, *DeleteReleaseReq)
 func (UnimplementedDataServer) CheckReleaseName(context.Context, *CheckReleaseNameReq) (*CheckReleaseNameResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckReleaseName not implemented")
 }
+func (UnimplementedDataServer) UpdateReleaseTags(context.Context, *UpdateReleaseTagsReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReleaseTags not implemented")
+}
+func (UnimplementedDataServer) PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRelease not implemented")
+}
 func (UnimplementedDataServer) GetReleasedConfigItem(context.Context, *GetReleasedCIReq) (*released_ci.ReleasedConfigItem, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedConfigItem not implemented")
 }
@@ -3009,6 +3721,15 @@ func (UnimplementedDataServer) ListHookRevisionReferences(context.Context, *List
 func (UnimplementedDataServer) GetReleaseHook(context.Context, *GetReleaseHookReq) (*GetReleaseHookResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseHook not implemented")
 }
+func (UnimplementedDataServer) ImportTemplateSpaceHooks(context.Context, *ImportTemplateSpaceHooksReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTemplateSpaceHooks not implemented")
+}
+func (UnimplementedDataServer) ListTemplateSpaceHooks(context.Context, *ListTemplateSpaceHooksReq) (*ListTemplateSpaceHooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSpaceHooks not implemented")
+}
+func (UnimplementedDataServer) DeleteTemplateSpaceHook(context.Context, *DeleteTemplateSpaceHookReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateSpaceHook not implemented")
+}
 func (UnimplementedDataServer) CreateTemplateSpace(context.Context, *CreateTemplateSpaceReq) (*CreateResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateSpace not implemented")
 }
@@ -3165,6 +3886,15 @@ func (UnimplementedDataServer) ListAppTmplVariables(context.Context, *ListAppTmp
 func (UnimplementedDataServer) ListReleasedAppTmplVariables(context.Context, *ListReleasedAppTmplVariablesReq) (*ListReleasedAppTmplVariablesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedAppTmplVariables not implemented")
 }
+func (UnimplementedDataServer) UpdateGroupTmplVariables(context.Context, *UpdateGroupTmplVariablesReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupTmplVariables not implemented")
+}
+func (UnimplementedDataServer) ListGroupTmplVariables(context.Context, *ListGroupTmplVariablesReq) (*ListGroupTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupTmplVariables not implemented")
+}
+func (UnimplementedDataServer) TraceAppTmplVariables(context.Context, *TraceAppTmplVariablesReq) (*TraceAppTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceAppTmplVariables not implemented")
+}
 func (UnimplementedDataServer) ListTmplBoundCounts(context.Context, *ListTmplBoundCountsReq) (*ListTmplBoundCountsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundCounts not implemented")
 }
@@ -3279,3506 +4009,4661 @@ func (UnimplementedDataServer) GetReleasesStatus(context.Context, *GetReleasesSt
 func (UnimplementedDataServer) GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateReleaseAndPublish not implemented")
 }
+func (UnimplementedDataServer) RollbackRelease(context.Context, *RollbackReleaseReq) (*RollbackReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackRelease not implemented")
+}
+func (UnimplementedDataServer) PinGroupRelease(context.Context, *PinGroupReleaseReq) (*PinGroupReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinGroupRelease not implemented")
+}
+func (UnimplementedDataServer) UnpinGroupRelease(context.Context, *UnpinGroupReleaseReq) (*UnpinGroupReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinGroupRelease not implemented")
+}
 func (UnimplementedDataServer) ApprovalCallback(context.Context, *ApprovalCallbackReq) (*ApprovalCallbackResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApprovalCallback not implemented")
 }
 func (UnimplementedDataServer) ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAudits not implemented")
 }
-func (UnimplementedDataServer) CreateCredential(context.Context, *CreateCredentialReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+func (UnimplementedDataServer) CreateCredential(context.Context, *CreateCredentialReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (UnimplementedDataServer) ListCredentials(context.Context, *ListCredentialReq) (*ListCredentialResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentials not implemented")
+}
+func (UnimplementedDataServer) DeleteCredential(context.Context, *DeleteCredentialReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (UnimplementedDataServer) UpdateCredential(context.Context, *UpdateCredentialReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (UnimplementedDataServer) CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCredentialName not implemented")
+}
+func (UnimplementedDataServer) RotateCredential(context.Context, *RotateCredentialReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCredential not implemented")
+}
+func (UnimplementedDataServer) BatchUpdateCredentialUsage(context.Context, *BatchUpdateCredentialUsageReq) (*BatchUpdateCredentialUsageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateCredentialUsage not implemented")
+}
+func (UnimplementedDataServer) ListCredentialScopes(context.Context, *ListCredentialScopesReq) (*ListCredentialScopesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialScopes not implemented")
+}
+func (UnimplementedDataServer) UpdateCredentialScopes(context.Context, *UpdateCredentialScopesReq) (*UpdateCredentialScopesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialScopes not implemented")
+}
+func (UnimplementedDataServer) CredentialScopePreview(context.Context, *CredentialScopePreviewReq) (*CredentialScopePreviewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialScopePreview not implemented")
+}
+func (UnimplementedDataServer) CreateKv(context.Context, *CreateKvReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKv not implemented")
+}
+func (UnimplementedDataServer) UpdateKv(context.Context, *UpdateKvReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKv not implemented")
+}
+func (UnimplementedDataServer) ListKvs(context.Context, *ListKvsReq) (*ListKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKvs not implemented")
+}
+func (UnimplementedDataServer) DeleteKv(context.Context, *DeleteKvReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKv not implemented")
+}
+func (UnimplementedDataServer) BatchUpsertKvs(context.Context, *BatchUpsertKvsReq) (*BatchUpsertKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertKvs not implemented")
+}
+func (UnimplementedDataServer) UnDeleteKv(context.Context, *UnDeleteKvReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnDeleteKv not implemented")
+}
+func (UnimplementedDataServer) UndoKv(context.Context, *UndoKvReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoKv not implemented")
+}
+func (UnimplementedDataServer) KvFetchIDsExcluding(context.Context, *KvFetchIDsExcludingReq) (*KvFetchIDsExcludingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KvFetchIDsExcluding not implemented")
+}
+func (UnimplementedDataServer) KvFetchKeysExcluding(context.Context, *KvFetchKeysExcludingReq) (*KvFetchKeysExcludingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KvFetchKeysExcluding not implemented")
+}
+func (UnimplementedDataServer) FindNearExpiryCertKvs(context.Context, *FindNearExpiryCertKvsReq) (*FindNearExpiryCertKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearExpiryCertKvs not implemented")
+}
+func (UnimplementedDataServer) ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
+func (UnimplementedDataServer) RetryClients(context.Context, *RetryClientsReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryClients not implemented")
+}
+func (UnimplementedDataServer) ListClientEvents(context.Context, *ListClientEventsReq) (*ListClientEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientEvents not implemented")
+}
+func (UnimplementedDataServer) ListClientQuerys(context.Context, *ListClientQuerysReq) (*ListClientQuerysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuerys not implemented")
+}
+func (UnimplementedDataServer) CreateClientQuery(context.Context, *CreateClientQueryReq) (*CreateClientQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClientQuery not implemented")
+}
+func (UnimplementedDataServer) UpdateClientQuery(context.Context, *UpdateClientQueryReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientQuery not implemented")
+}
+func (UnimplementedDataServer) DeleteClientQuery(context.Context, *DeleteClientQueryReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientQuery not implemented")
+}
+func (UnimplementedDataServer) CheckClientQueryName(context.Context, *CheckClientQueryNameReq) (*CheckClientQueryNameResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckClientQueryName not implemented")
+}
+func (UnimplementedDataServer) ClientConfigVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientConfigVersionStatistics not implemented")
+}
+func (UnimplementedDataServer) ClientPullTrendStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientPullTrendStatistics not implemented")
+}
+func (UnimplementedDataServer) ClientPullStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientPullStatistics not implemented")
+}
+func (UnimplementedDataServer) ClientLabelStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientLabelStatistics not implemented")
+}
+func (UnimplementedDataServer) ClientAnnotationStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientAnnotationStatistics not implemented")
+}
+func (UnimplementedDataServer) ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientVersionStatistics not implemented")
+}
+func (UnimplementedDataServer) ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientLabelAndAnnotation not implemented")
+}
+func (UnimplementedDataServer) ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientSpecificFailedReason not implemented")
+}
+func (UnimplementedDataServer) ClientStageStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientStageStatistics not implemented")
+}
+func (UnimplementedDataServer) CreateClientAlertRule(context.Context, *CreateClientAlertRuleReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClientAlertRule not implemented")
+}
+func (UnimplementedDataServer) UpdateClientAlertRule(context.Context, *UpdateClientAlertRuleReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientAlertRule not implemented")
+}
+func (UnimplementedDataServer) DeleteClientAlertRule(context.Context, *DeleteClientAlertRuleReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientAlertRule not implemented")
+}
+func (UnimplementedDataServer) ListClientAlertRules(context.Context, *ListClientAlertRulesReq) (*ListClientAlertRulesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientAlertRules not implemented")
+}
+func (UnimplementedDataServer) SilenceClientAlertRule(context.Context, *SilenceClientAlertRuleReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SilenceClientAlertRule not implemented")
+}
+func (UnimplementedDataServer) AckClientAlert(context.Context, *AckClientAlertReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckClientAlert not implemented")
+}
+func (UnimplementedDataServer) ListClientAlertHistories(context.Context, *ListClientAlertHistoriesReq) (*ListClientAlertHistoriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientAlertHistories not implemented")
+}
+func (UnimplementedDataServer) ListClientMetricTrends(context.Context, *ListClientMetricTrendsReq) (*ListClientMetricTrendsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientMetricTrends not implemented")
+}
+func (UnimplementedDataServer) CreateGitRepoLink(context.Context, *CreateGitRepoLinkReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGitRepoLink not implemented")
+}
+func (UnimplementedDataServer) UpdateGitRepoLink(context.Context, *UpdateGitRepoLinkReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGitRepoLink not implemented")
+}
+func (UnimplementedDataServer) DeleteGitRepoLink(context.Context, *DeleteGitRepoLinkReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGitRepoLink not implemented")
+}
+func (UnimplementedDataServer) GetGitRepoLink(context.Context, *GetGitRepoLinkReq) (*git_repo_link.GitRepoLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGitRepoLink not implemented")
+}
+func (UnimplementedDataServer) ListGitRepoLinks(context.Context, *ListGitRepoLinksReq) (*ListGitRepoLinksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGitRepoLinks not implemented")
+}
+func (UnimplementedDataServer) SyncGitRepoLink(context.Context, *SyncGitRepoLinkReq) (*SyncGitRepoLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncGitRepoLink not implemented")
+}
+func (UnimplementedDataServer) CreateChangeFreeze(context.Context, *CreateChangeFreezeReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChangeFreeze not implemented")
+}
+func (UnimplementedDataServer) UpdateChangeFreeze(context.Context, *UpdateChangeFreezeReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChangeFreeze not implemented")
+}
+func (UnimplementedDataServer) DeleteChangeFreeze(context.Context, *DeleteChangeFreezeReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChangeFreeze not implemented")
+}
+func (UnimplementedDataServer) GetChangeFreeze(context.Context, *GetChangeFreezeReq) (*change_freeze.ChangeFreeze, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangeFreeze not implemented")
+}
+func (UnimplementedDataServer) ListChangeFreezes(context.Context, *ListChangeFreezesReq) (*ListChangeFreezesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChangeFreezes not implemented")
+}
+func (UnimplementedDataServer) RecordChangeFreezeBreakGlass(context.Context, *RecordChangeFreezeBreakGlassReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordChangeFreezeBreakGlass not implemented")
+}
+func (UnimplementedDataServer) CreateWebhook(context.Context, *CreateWebhookReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedDataServer) UpdateWebhook(context.Context, *UpdateWebhookReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedDataServer) DeleteWebhook(context.Context, *DeleteWebhookReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedDataServer) GetWebhook(context.Context, *GetWebhookReq) (*webhook.Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedDataServer) ListWebhooks(context.Context, *ListWebhooksReq) (*ListWebhooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedDataServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesReq) (*ListWebhookDeliveriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedDataServer) RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhookDelivery not implemented")
+}
+func (UnimplementedDataServer) SetApprovalFlow(context.Context, *SetApprovalFlowReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApprovalFlow not implemented")
+}
+func (UnimplementedDataServer) GetApprovalFlow(context.Context, *GetApprovalFlowReq) (*approval.ApprovalFlow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApprovalFlow not implemented")
+}
+func (UnimplementedDataServer) GetApprovalTicket(context.Context, *GetApprovalTicketReq) (*GetApprovalTicketResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApprovalTicket not implemented")
+}
+func (UnimplementedDataServer) DelegateApproval(context.Context, *DelegateApprovalReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateApproval not implemented")
+}
+func (UnimplementedDataServer) CommentApproval(context.Context, *CommentApprovalReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentApproval not implemented")
+}
+func (UnimplementedDataServer) ExportAppBundle(context.Context, *ExportAppBundleReq) (*ExportAppBundleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAppBundle not implemented")
+}
+func (UnimplementedDataServer) ImportAppBundle(context.Context, *ImportAppBundleReq) (*ImportAppBundleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAppBundle not implemented")
+}
+func (UnimplementedDataServer) CreateRole(context.Context, *CreateRoleReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedDataServer) UpdateRole(context.Context, *UpdateRoleReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedDataServer) DeleteRole(context.Context, *DeleteRoleReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedDataServer) ListRoles(context.Context, *ListRolesReq) (*ListRolesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedDataServer) CreateRoleBinding(context.Context, *CreateRoleBindingReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleBinding not implemented")
+}
+func (UnimplementedDataServer) DeleteRoleBinding(context.Context, *DeleteRoleBindingReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleBinding not implemented")
+}
+func (UnimplementedDataServer) ListRoleBindings(context.Context, *ListRoleBindingsReq) (*ListRoleBindingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleBindings not implemented")
+}
+func (UnimplementedDataServer) ListRolePolicies(context.Context, *ListRolePoliciesReq) (*ListRolePoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRolePolicies not implemented")
+}
+func (UnimplementedDataServer) ListInstances(context.Context, *ListInstancesReq) (*ListInstancesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+}
+func (UnimplementedDataServer) FetchInstanceInfo(context.Context, *FetchInstanceInfoReq) (*FetchInstanceInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchInstanceInfo not implemented")
+}
+func (UnimplementedDataServer) Ping(context.Context, *PingMsg) (*PingMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedDataServer) BatchUpsertClientMetrics(context.Context, *BatchUpsertClientMetricsReq) (*BatchUpsertClientMetricsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertClientMetrics not implemented")
+}
+func (UnimplementedDataServer) CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareConfigItemConflicts not implemented")
+}
+func (UnimplementedDataServer) GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateAndNonTemplateCICount not implemented")
+}
+func (UnimplementedDataServer) BatchUpdateLastConsumedTime(context.Context, *BatchUpdateLastConsumedTimeReq) (*BatchUpdateLastConsumedTimeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateLastConsumedTime not implemented")
+}
+func (UnimplementedDataServer) ListProcess(context.Context, *ListProcessReq) (*ListProcessResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcess not implemented")
+}
+func (UnimplementedDataServer) ListProcessInnerIPs(context.Context, *ListProcessInnerIPsReq) (*ListProcessInnerIPsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcessInnerIPs not implemented")
+}
+func (UnimplementedDataServer) OperateProcess(context.Context, *OperateProcessReq) (*OperateProcessResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateProcess not implemented")
+}
+func (UnimplementedDataServer) ProcessFilterOptions(context.Context, *ProcessFilterOptionsReq) (*ProcessFilterOptionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessFilterOptions not implemented")
+}
+func (UnimplementedDataServer) SyncCmdbGseStatus(context.Context, *SyncCmdbGseStatusReq) (*SyncCmdbGseStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncCmdbGseStatus not implemented")
+}
+func (UnimplementedDataServer) ListTaskBatch(context.Context, *ListTaskBatchReq) (*ListTaskBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskBatch not implemented")
+}
+func (UnimplementedDataServer) GetTaskBatchDetail(context.Context, *GetTaskBatchDetailReq) (*GetTaskBatchDetailResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskBatchDetail not implemented")
+}
+func (UnimplementedDataServer) RetryTasks(context.Context, *RetryTasksReq) (*RetryTasksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryTasks not implemented")
+}
+func (UnimplementedDataServer) CmdbGseStatus(context.Context, *CmdbGseStatusReq) (*CmdbGseStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CmdbGseStatus not implemented")
+}
+func (UnimplementedDataServer) BizTopo(context.Context, *BizTopoReq) (*BizTopoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BizTopo not implemented")
+}
+func (UnimplementedDataServer) ServiceTemplate(context.Context, *ServiceTemplateReq) (*ServiceTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceTemplate not implemented")
+}
+func (UnimplementedDataServer) ProcessTemplate(context.Context, *ProcessTemplateReq) (*ProcessTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessTemplate not implemented")
+}
+func (UnimplementedDataServer) ServiceInstance(context.Context, *ServiceInstanceReq) (*ServiceInstanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceInstance not implemented")
+}
+func (UnimplementedDataServer) ProcessInstance(context.Context, *ProcessInstanceReq) (*ProcessInstanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessInstance not implemented")
+}
+func (UnimplementedDataServer) ListConfigTemplate(context.Context, *ListConfigTemplateReq) (*ListConfigTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigTemplate not implemented")
+}
+func (UnimplementedDataServer) CreateConfigTemplate(context.Context, *CreateConfigTemplateReq) (*CreateConfigTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigTemplate not implemented")
+}
+func (UnimplementedDataServer) UpdateConfigTemplate(context.Context, *UpdateConfigTemplateReq) (*UpdateConfigTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigTemplate not implemented")
 }
-func (UnimplementedDataServer) ListCredentials(context.Context, *ListCredentialReq) (*ListCredentialResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCredentials not implemented")
+func (UnimplementedDataServer) GetConfigTemplate(context.Context, *GetConfigTemplateReq) (*GetConfigTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigTemplate not implemented")
 }
-func (UnimplementedDataServer) DeleteCredential(context.Context, *DeleteCredentialReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+func (UnimplementedDataServer) ConfigTemplateVariable(context.Context, *ConfigTemplateVariableReq) (*ConfigTemplateVariableResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigTemplateVariable not implemented")
 }
-func (UnimplementedDataServer) UpdateCredential(context.Context, *UpdateCredentialReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+func (UnimplementedDataServer) BindProcessInstance(context.Context, *BindProcessInstanceReq) (*BindProcessInstanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindProcessInstance not implemented")
 }
-func (UnimplementedDataServer) CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckCredentialName not implemented")
+func (UnimplementedDataServer) PreviewBindProcessInstance(context.Context, *PreviewBindProcessInstanceReq) (*PreviewBindProcessInstanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewBindProcessInstance not implemented")
 }
-func (UnimplementedDataServer) ListCredentialScopes(context.Context, *ListCredentialScopesReq) (*ListCredentialScopesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialScopes not implemented")
+func (UnimplementedDataServer) ListConfigInstances(context.Context, *ListConfigInstancesReq) (*ListConfigInstancesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigInstances not implemented")
 }
-func (UnimplementedDataServer) UpdateCredentialScopes(context.Context, *UpdateCredentialScopesReq) (*UpdateCredentialScopesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialScopes not implemented")
+func (UnimplementedDataServer) GenerateConfig(context.Context, *GenerateConfigReq) (*GenerateConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfig not implemented")
 }
-func (UnimplementedDataServer) CredentialScopePreview(context.Context, *CredentialScopePreviewReq) (*CredentialScopePreviewResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CredentialScopePreview not implemented")
+func (UnimplementedDataServer) CheckConfig(context.Context, *CheckConfigReq) (*CheckConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConfig not implemented")
 }
-func (UnimplementedDataServer) CreateKv(context.Context, *CreateKvReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateKv not implemented")
+func (UnimplementedDataServer) GetConfigDiff(context.Context, *GetConfigDiffReq) (*GetConfigDiffResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigDiff not implemented")
 }
-func (UnimplementedDataServer) UpdateKv(context.Context, *UpdateKvReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateKv not implemented")
+func (UnimplementedDataServer) GetConfigView(context.Context, *GetConfigViewReq) (*GetConfigViewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigView not implemented")
 }
-func (UnimplementedDataServer) ListKvs(context.Context, *ListKvsReq) (*ListKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListKvs not implemented")
+func (UnimplementedDataServer) ConfigGenerateStatus(context.Context, *ConfigGenerateStatusReq) (*ConfigGenerateStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigGenerateStatus not implemented")
 }
-func (UnimplementedDataServer) DeleteKv(context.Context, *DeleteKvReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteKv not implemented")
+func (UnimplementedDataServer) GetConfigGenerateResult(context.Context, *GetConfigGenerateResultReq) (*GetConfigGenerateResultResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigGenerateResult not implemented")
 }
-func (UnimplementedDataServer) BatchUpsertKvs(context.Context, *BatchUpsertKvsReq) (*BatchUpsertKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertKvs not implemented")
+func (UnimplementedDataServer) PreviewConfig(context.Context, *PreviewConfigReq) (*PreviewConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewConfig not implemented")
 }
-func (UnimplementedDataServer) UnDeleteKv(context.Context, *UnDeleteKvReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnDeleteKv not implemented")
+func (UnimplementedDataServer) PushConfig(context.Context, *PushConfigReq) (*PushConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushConfig not implemented")
 }
-func (UnimplementedDataServer) UndoKv(context.Context, *UndoKvReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndoKv not implemented")
+func (UnimplementedDataServer) RepushDriftedConfig(context.Context, *RepushDriftedConfigReq) (*RepushDriftedConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepushDriftedConfig not implemented")
 }
-func (UnimplementedDataServer) KvFetchIDsExcluding(context.Context, *KvFetchIDsExcludingReq) (*KvFetchIDsExcludingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KvFetchIDsExcluding not implemented")
+func (UnimplementedDataServer) DeleteConfigTemplate(context.Context, *DeleteConfigTemplateReq) (*DeleteConfigTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigTemplate not implemented")
 }
-func (UnimplementedDataServer) KvFetchKeysExcluding(context.Context, *KvFetchKeysExcludingReq) (*KvFetchKeysExcludingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KvFetchKeysExcluding not implemented")
+func (UnimplementedDataServer) OperateGenerateConfig(context.Context, *OperateGenerateConfigReq) (*OperateGenerateConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateGenerateConfig not implemented")
 }
-func (UnimplementedDataServer) FindNearExpiryCertKvs(context.Context, *FindNearExpiryCertKvsReq) (*FindNearExpiryCertKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindNearExpiryCertKvs not implemented")
+func (UnimplementedDataServer) GetProcessInstanceTopo(context.Context, *GetProcessInstanceTopoReq) (*GetProcessInstanceTopoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessInstanceTopo not implemented")
 }
-func (UnimplementedDataServer) ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+func (UnimplementedDataServer) ManageConfigKV(context.Context, *ManageConfigKVReq) (*ManageConfigKVResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManageConfigKV not implemented")
 }
-func (UnimplementedDataServer) RetryClients(context.Context, *RetryClientsReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryClients not implemented")
+func (UnimplementedDataServer) GetProcessConfigView(context.Context, *GetProcessConfigViewReq) (*GetProcessConfigViewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessConfigView not implemented")
 }
-func (UnimplementedDataServer) ListClientEvents(context.Context, *ListClientEventsReq) (*ListClientEventsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientEvents not implemented")
+
+// UnsafeDataServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DataServer will
+// result in compilation errors.
+type UnsafeDataServer interface {
+	mustEmbedUnimplementedDataServer()
 }
-func (UnimplementedDataServer) ListClientQuerys(context.Context, *ListClientQuerysReq) (*ListClientQuerysResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuerys not implemented")
+
+func RegisterDataServer(s grpc.ServiceRegistrar, srv DataServer) {
+	s.RegisterService(&Data_ServiceDesc, srv)
 }
-func (UnimplementedDataServer) CreateClientQuery(context.Context, *CreateClientQueryReq) (*CreateClientQueryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateClientQuery not implemented")
+
+func _Data_CreateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateApp(ctx, req.(*CreateAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) UpdateClientQuery(context.Context, *UpdateClientQueryReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientQuery not implemented")
+
+func _Data_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateApp(ctx, req.(*UpdateAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) DeleteClientQuery(context.Context, *DeleteClientQueryReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientQuery not implemented")
+
+func _Data_DeleteApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteApp(ctx, req.(*DeleteAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CheckClientQueryName(context.Context, *CheckClientQueryNameReq) (*CheckClientQueryNameResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckClientQueryName not implemented")
+
+func _Data_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetApp(ctx, req.(*GetAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientConfigVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientConfigVersionStatistics not implemented")
+
+func _Data_GetAppByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppByIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetAppByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetAppByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetAppByID(ctx, req.(*GetAppByIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientPullTrendStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientPullTrendStatistics not implemented")
+
+func _Data_GetAppByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppByNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetAppByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetAppByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetAppByName(ctx, req.(*GetAppByNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientPullStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientPullStatistics not implemented")
+
+func _Data_ListAppsRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppsRestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListAppsRest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListAppsRest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListAppsRest(ctx, req.(*ListAppsRestReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientLabelStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientLabelStatistics not implemented")
+
+func _Data_ListAppsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppsByIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListAppsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListAppsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListAppsByIDs(ctx, req.(*ListAppsByIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientAnnotationStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientAnnotationStatistics not implemented")
+
+func _Data_CloneApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CloneApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CloneApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CloneApp(ctx, req.(*CloneAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientVersionStatistics not implemented")
+
+func _Data_CreateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateConfigItem(ctx, req.(*CreateConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientLabelAndAnnotation not implemented")
+
+func _Data_BatchUpsertConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).BatchUpsertConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_BatchUpsertConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).BatchUpsertConfigItems(ctx, req.(*BatchUpsertConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientSpecificFailedReason not implemented")
+
+func _Data_UpdateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateConfigItem(ctx, req.(*UpdateConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListInstances(context.Context, *ListInstancesReq) (*ListInstancesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+
+func _Data_DeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteConfigItem(ctx, req.(*DeleteConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) FetchInstanceInfo(context.Context, *FetchInstanceInfoReq) (*FetchInstanceInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FetchInstanceInfo not implemented")
+
+func _Data_UnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnDeleteConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UnDeleteConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UnDeleteConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UnDeleteConfigItem(ctx, req.(*UnDeleteConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) Ping(context.Context, *PingMsg) (*PingMsg, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+
+func _Data_UndoConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UndoConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UndoConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UndoConfigItem(ctx, req.(*UndoConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) BatchUpsertClientMetrics(context.Context, *BatchUpsertClientMetricsReq) (*BatchUpsertClientMetricsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertClientMetrics not implemented")
+
+func _Data_GetConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetConfigItem(ctx, req.(*GetConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompareConfigItemConflicts not implemented")
+
+func _Data_ListConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListConfigItems(ctx, req.(*ListConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateAndNonTemplateCICount not implemented")
+
+func _Data_ListReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListReleasedConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListReleasedConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListReleasedConfigItems(ctx, req.(*ListReleasedConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) BatchUpdateLastConsumedTime(context.Context, *BatchUpdateLastConsumedTimeReq) (*BatchUpdateLastConsumedTimeResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateLastConsumedTime not implemented")
+
+func _Data_ListConfigItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListConfigItemCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListConfigItemCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListConfigItemCount(ctx, req.(*ListConfigItemCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListProcess(context.Context, *ListProcessReq) (*ListProcessResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProcess not implemented")
+
+func _Data_ListConfigItemByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemByTupleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListConfigItemByTuple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListConfigItemByTuple_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListConfigItemByTuple(ctx, req.(*ListConfigItemByTupleReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListProcessInnerIPs(context.Context, *ListProcessInnerIPsReq) (*ListProcessInnerIPsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProcessInnerIPs not implemented")
+
+func _Data_UpdateConfigHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateConfigHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateConfigHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateConfigHook(ctx, req.(*UpdateConfigHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) OperateProcess(context.Context, *OperateProcessReq) (*OperateProcessResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateProcess not implemented")
+
+func _Data_CreateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateContent(ctx, req.(*CreateContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ProcessFilterOptions(context.Context, *ProcessFilterOptionsReq) (*ProcessFilterOptionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessFilterOptions not implemented")
+
+func _Data_GetContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetContent(ctx, req.(*GetContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) SyncCmdbGseStatus(context.Context, *SyncCmdbGseStatusReq) (*SyncCmdbGseStatusResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SyncCmdbGseStatus not implemented")
+
+func _Data_CreateCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateCommit(ctx, req.(*CreateCommitReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListTaskBatch(context.Context, *ListTaskBatchReq) (*ListTaskBatchResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTaskBatch not implemented")
+
+func _Data_GetLatestCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestCommitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetLatestCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetLatestCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetLatestCommit(ctx, req.(*GetLatestCommitReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetTaskBatchDetail(context.Context, *GetTaskBatchDetailReq) (*GetTaskBatchDetailResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTaskBatchDetail not implemented")
+
+func _Data_CreateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateRelease(ctx, req.(*CreateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) RetryTasks(context.Context, *RetryTasksReq) (*RetryTasksResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryTasks not implemented")
+
+func _Data_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListReleases(ctx, req.(*ListReleasesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CmdbGseStatus(context.Context, *CmdbGseStatusReq) (*CmdbGseStatusResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CmdbGseStatus not implemented")
+
+func _Data_GetReleaseByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseByNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetReleaseByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetReleaseByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetReleaseByName(ctx, req.(*GetReleaseByNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) BizTopo(context.Context, *BizTopoReq) (*BizTopoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BizTopo not implemented")
+
+func _Data_GetRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetRelease(ctx, req.(*GetReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ServiceTemplate(context.Context, *ServiceTemplateReq) (*ServiceTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceTemplate not implemented")
+
+func _Data_DeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeprecateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeprecateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeprecateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeprecateRelease(ctx, req.(*DeprecateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ProcessTemplate(context.Context, *ProcessTemplateReq) (*ProcessTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessTemplate not implemented")
+
+func _Data_UnDeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnDeprecateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UnDeprecateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UnDeprecateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UnDeprecateRelease(ctx, req.(*UnDeprecateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ServiceInstance(context.Context, *ServiceInstanceReq) (*ServiceInstanceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceInstance not implemented")
+
+func _Data_DeleteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteRelease(ctx, req.(*DeleteReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ProcessInstance(context.Context, *ProcessInstanceReq) (*ProcessInstanceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessInstance not implemented")
+
+func _Data_CheckReleaseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckReleaseNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CheckReleaseName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CheckReleaseName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CheckReleaseName(ctx, req.(*CheckReleaseNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListConfigTemplate(context.Context, *ListConfigTemplateReq) (*ListConfigTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigTemplate not implemented")
+
+func _Data_UpdateReleaseTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReleaseTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateReleaseTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateReleaseTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateReleaseTags(ctx, req.(*UpdateReleaseTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CreateConfigTemplate(context.Context, *CreateConfigTemplateReq) (*CreateConfigTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigTemplate not implemented")
+
+func _Data_PromoteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).PromoteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_PromoteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).PromoteRelease(ctx, req.(*PromoteReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) UpdateConfigTemplate(context.Context, *UpdateConfigTemplateReq) (*UpdateConfigTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigTemplate not implemented")
+
+func _Data_GetReleasedConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedCIReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetReleasedConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetReleasedConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetReleasedConfigItem(ctx, req.(*GetReleasedCIReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetConfigTemplate(context.Context, *GetConfigTemplateReq) (*GetConfigTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigTemplate not implemented")
+
+func _Data_GetReleasedKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedKvReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetReleasedKv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetReleasedKv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetReleasedKv(ctx, req.(*GetReleasedKvReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ConfigTemplateVariable(context.Context, *ConfigTemplateVariableReq) (*ConfigTemplateVariableResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfigTemplateVariable not implemented")
+
+func _Data_ListReleasedKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedKvReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListReleasedKvs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListReleasedKvs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListReleasedKvs(ctx, req.(*ListReleasedKvReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) BindProcessInstance(context.Context, *BindProcessInstanceReq) (*BindProcessInstanceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BindProcessInstance not implemented")
+
+func _Data_ListAllReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllReleasedConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListAllReleasedConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListAllReleasedConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListAllReleasedConfigItems(ctx, req.(*ListAllReleasedConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) PreviewBindProcessInstance(context.Context, *PreviewBindProcessInstanceReq) (*PreviewBindProcessInstanceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PreviewBindProcessInstance not implemented")
+
+func _Data_CreateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateHook(ctx, req.(*CreateHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListConfigInstances(context.Context, *ListConfigInstancesReq) (*ListConfigInstancesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigInstances not implemented")
+
+func _Data_ListHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHooks(ctx, req.(*ListHooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GenerateConfig(context.Context, *GenerateConfigReq) (*GenerateConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfig not implemented")
+
+func _Data_DeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteHook(ctx, req.(*DeleteHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CheckConfig(context.Context, *CheckConfigReq) (*CheckConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckConfig not implemented")
+
+func _Data_UpdateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateHook(ctx, req.(*UpdateHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetConfigDiff(context.Context, *GetConfigDiffReq) (*GetConfigDiffResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigDiff not implemented")
+
+func _Data_ListHookTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHookTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHookTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHookTags(ctx, req.(*ListHookTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetConfigView(context.Context, *GetConfigViewReq) (*GetConfigViewResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigView not implemented")
+
+func _Data_ListHookReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookReferencesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHookReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHookReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHookReferences(ctx, req.(*ListHookReferencesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ConfigGenerateStatus(context.Context, *ConfigGenerateStatusReq) (*ConfigGenerateStatusResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfigGenerateStatus not implemented")
+
+func _Data_GetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetHook(ctx, req.(*GetHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetConfigGenerateResult(context.Context, *GetConfigGenerateResultReq) (*GetConfigGenerateResultResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigGenerateResult not implemented")
+
+func _Data_HookFetchIDsExcluding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HookFetchIDsExcludingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).HookFetchIDsExcluding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_HookFetchIDsExcluding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).HookFetchIDsExcluding(ctx, req.(*HookFetchIDsExcludingReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) PreviewConfig(context.Context, *PreviewConfigReq) (*PreviewConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PreviewConfig not implemented")
+
+func _Data_GetHookReferencedIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookReferencedIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetHookReferencedIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetHookReferencedIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetHookReferencedIDs(ctx, req.(*GetHookReferencedIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) PushConfig(context.Context, *PushConfigReq) (*PushConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PushConfig not implemented")
+
+func _Data_CreateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateHookRevision(ctx, req.(*CreateHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) DeleteConfigTemplate(context.Context, *DeleteConfigTemplateReq) (*DeleteConfigTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigTemplate not implemented")
+
+func _Data_ListHookRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHookRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHookRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHookRevisions(ctx, req.(*ListHookRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) OperateGenerateConfig(context.Context, *OperateGenerateConfigReq) (*OperateGenerateConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateGenerateConfig not implemented")
+
+func _Data_GetHookRevisionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookRevisionByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetHookRevisionByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetHookRevisionByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetHookRevisionByID(ctx, req.(*GetHookRevisionByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetProcessInstanceTopo(context.Context, *GetProcessInstanceTopoReq) (*GetProcessInstanceTopoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProcessInstanceTopo not implemented")
+
+func _Data_DeleteHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteHookRevision(ctx, req.(*DeleteHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ManageConfigKV(context.Context, *ManageConfigKVReq) (*ManageConfigKVResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ManageConfigKV not implemented")
+
+func _Data_PublishHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).PublishHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_PublishHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).PublishHookRevision(ctx, req.(*PublishHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetProcessConfigView(context.Context, *GetProcessConfigViewReq) (*GetProcessConfigViewResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProcessConfigView not implemented")
+
+func _Data_GetHookRevisionByPubState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByPubStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetHookRevisionByPubState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetHookRevisionByPubState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetHookRevisionByPubState(ctx, req.(*GetByPubStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeDataServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DataServer will
-// result in compilation errors.
-type UnsafeDataServer interface {
-	mustEmbedUnimplementedDataServer()
+func _Data_UpdateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateHookRevision(ctx, req.(*UpdateHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDataServer(s grpc.ServiceRegistrar, srv DataServer) {
-	s.RegisterService(&Data_ServiceDesc, srv)
+func _Data_ListHookRevisionReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookRevisionReferencesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHookRevisionReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHookRevisionReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHookRevisionReferences(ctx, req.(*ListHookRevisionReferencesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAppReq)
+func _Data_GetReleaseHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseHookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateApp(ctx, in)
+		return srv.(DataServer).GetReleaseHook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateApp_FullMethodName,
+		FullMethod: Data_GetReleaseHook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateApp(ctx, req.(*CreateAppReq))
+		return srv.(DataServer).GetReleaseHook(ctx, req.(*GetReleaseHookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppReq)
+func _Data_ImportTemplateSpaceHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTemplateSpaceHooksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateApp(ctx, in)
+		return srv.(DataServer).ImportTemplateSpaceHooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateApp_FullMethodName,
+		FullMethod: Data_ImportTemplateSpaceHooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateApp(ctx, req.(*UpdateAppReq))
+		return srv.(DataServer).ImportTemplateSpaceHooks(ctx, req.(*ImportTemplateSpaceHooksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAppReq)
+func _Data_ListTemplateSpaceHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSpaceHooksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteApp(ctx, in)
+		return srv.(DataServer).ListTemplateSpaceHooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteApp_FullMethodName,
+		FullMethod: Data_ListTemplateSpaceHooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteApp(ctx, req.(*DeleteAppReq))
+		return srv.(DataServer).ListTemplateSpaceHooks(ctx, req.(*ListTemplateSpaceHooksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppReq)
+func _Data_DeleteTemplateSpaceHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateSpaceHookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetApp(ctx, in)
+		return srv.(DataServer).DeleteTemplateSpaceHook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetApp_FullMethodName,
+		FullMethod: Data_DeleteTemplateSpaceHook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetApp(ctx, req.(*GetAppReq))
+		return srv.(DataServer).DeleteTemplateSpaceHook(ctx, req.(*DeleteTemplateSpaceHookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetAppByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppByIDReq)
+func _Data_CreateTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateSpaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetAppByID(ctx, in)
+		return srv.(DataServer).CreateTemplateSpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetAppByID_FullMethodName,
+		FullMethod: Data_CreateTemplateSpace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetAppByID(ctx, req.(*GetAppByIDReq))
+		return srv.(DataServer).CreateTemplateSpace(ctx, req.(*CreateTemplateSpaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetAppByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppByNameReq)
+func _Data_ListTemplateSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSpacesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetAppByName(ctx, in)
+		return srv.(DataServer).ListTemplateSpaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetAppByName_FullMethodName,
+		FullMethod: Data_ListTemplateSpaces_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetAppByName(ctx, req.(*GetAppByNameReq))
+		return srv.(DataServer).ListTemplateSpaces(ctx, req.(*ListTemplateSpacesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAppsRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppsRestReq)
+func _Data_UpdateTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateSpaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAppsRest(ctx, in)
+		return srv.(DataServer).UpdateTemplateSpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAppsRest_FullMethodName,
+		FullMethod: Data_UpdateTemplateSpace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAppsRest(ctx, req.(*ListAppsRestReq))
+		return srv.(DataServer).UpdateTemplateSpace(ctx, req.(*UpdateTemplateSpaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAppsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppsByIDsReq)
+func _Data_DeleteTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateSpaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAppsByIDs(ctx, in)
+		return srv.(DataServer).DeleteTemplateSpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAppsByIDs_FullMethodName,
+		FullMethod: Data_DeleteTemplateSpace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAppsByIDs(ctx, req.(*ListAppsByIDsReq))
+		return srv.(DataServer).DeleteTemplateSpace(ctx, req.(*DeleteTemplateSpaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CloneApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloneAppReq)
+func _Data_GetAllBizsOfTmplSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(base.EmptyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CloneApp(ctx, in)
+		return srv.(DataServer).GetAllBizsOfTmplSpaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CloneApp_FullMethodName,
+		FullMethod: Data_GetAllBizsOfTmplSpaces_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CloneApp(ctx, req.(*CloneAppReq))
+		return srv.(DataServer).GetAllBizsOfTmplSpaces(ctx, req.(*base.EmptyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConfigItemReq)
+func _Data_CreateDefaultTmplSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDefaultTmplSpaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateConfigItem(ctx, in)
+		return srv.(DataServer).CreateDefaultTmplSpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateConfigItem_FullMethodName,
+		FullMethod: Data_CreateDefaultTmplSpace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateConfigItem(ctx, req.(*CreateConfigItemReq))
+		return srv.(DataServer).CreateDefaultTmplSpace(ctx, req.(*CreateDefaultTmplSpaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_BatchUpsertConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpsertConfigItemsReq)
+func _Data_ListTmplSpacesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplSpacesByIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).BatchUpsertConfigItems(ctx, in)
+		return srv.(DataServer).ListTmplSpacesByIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_BatchUpsertConfigItems_FullMethodName,
+		FullMethod: Data_ListTmplSpacesByIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).BatchUpsertConfigItems(ctx, req.(*BatchUpsertConfigItemsReq))
+		return srv.(DataServer).ListTmplSpacesByIDs(ctx, req.(*ListTmplSpacesByIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConfigItemReq)
+func _Data_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateConfigItem(ctx, in)
+		return srv.(DataServer).CreateTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateConfigItem_FullMethodName,
+		FullMethod: Data_CreateTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateConfigItem(ctx, req.(*UpdateConfigItemReq))
+		return srv.(DataServer).CreateTemplate(ctx, req.(*CreateTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteConfigItemReq)
+func _Data_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteConfigItem(ctx, in)
+		return srv.(DataServer).ListTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteConfigItem_FullMethodName,
+		FullMethod: Data_ListTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteConfigItem(ctx, req.(*DeleteConfigItemReq))
+		return srv.(DataServer).ListTemplates(ctx, req.(*ListTemplatesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnDeleteConfigItemReq)
+func _Data_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UnDeleteConfigItem(ctx, in)
+		return srv.(DataServer).UpdateTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UnDeleteConfigItem_FullMethodName,
+		FullMethod: Data_UpdateTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UnDeleteConfigItem(ctx, req.(*UnDeleteConfigItemReq))
+		return srv.(DataServer).UpdateTemplate(ctx, req.(*UpdateTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UndoConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UndoConfigItemReq)
+func _Data_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UndoConfigItem(ctx, in)
+		return srv.(DataServer).DeleteTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UndoConfigItem_FullMethodName,
+		FullMethod: Data_DeleteTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UndoConfigItem(ctx, req.(*UndoConfigItemReq))
+		return srv.(DataServer).DeleteTemplate(ctx, req.(*DeleteTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigItemReq)
+func _Data_BatchDeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetConfigItem(ctx, in)
+		return srv.(DataServer).BatchDeleteTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetConfigItem_FullMethodName,
+		FullMethod: Data_BatchDeleteTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetConfigItem(ctx, req.(*GetConfigItemReq))
+		return srv.(DataServer).BatchDeleteTemplate(ctx, req.(*BatchDeleteTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemsReq)
+func _Data_AddTmplsToTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTmplsToTmplSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListConfigItems(ctx, in)
+		return srv.(DataServer).AddTmplsToTmplSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListConfigItems_FullMethodName,
+		FullMethod: Data_AddTmplsToTmplSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListConfigItems(ctx, req.(*ListConfigItemsReq))
+		return srv.(DataServer).AddTmplsToTmplSets(ctx, req.(*AddTmplsToTmplSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedConfigItemsReq)
+func _Data_DeleteTmplsFromTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTmplsFromTmplSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListReleasedConfigItems(ctx, in)
+		return srv.(DataServer).DeleteTmplsFromTmplSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListReleasedConfigItems_FullMethodName,
+		FullMethod: Data_DeleteTmplsFromTmplSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListReleasedConfigItems(ctx, req.(*ListReleasedConfigItemsReq))
+		return srv.(DataServer).DeleteTmplsFromTmplSets(ctx, req.(*DeleteTmplsFromTmplSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListConfigItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemCountReq)
+func _Data_ListTemplatesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesByIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListConfigItemCount(ctx, in)
+		return srv.(DataServer).ListTemplatesByIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListConfigItemCount_FullMethodName,
+		FullMethod: Data_ListTemplatesByIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListConfigItemCount(ctx, req.(*ListConfigItemCountReq))
+		return srv.(DataServer).ListTemplatesByIDs(ctx, req.(*ListTemplatesByIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListConfigItemByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemByTupleReq)
+func _Data_ListTemplatesNotBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesNotBoundReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListConfigItemByTuple(ctx, in)
+		return srv.(DataServer).ListTemplatesNotBound(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListConfigItemByTuple_FullMethodName,
+		FullMethod: Data_ListTemplatesNotBound_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListConfigItemByTuple(ctx, req.(*ListConfigItemByTupleReq))
+		return srv.(DataServer).ListTemplatesNotBound(ctx, req.(*ListTemplatesNotBoundReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateConfigHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConfigHookReq)
+func _Data_ListTmplsOfTmplSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplsOfTmplSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateConfigHook(ctx, in)
+		return srv.(DataServer).ListTmplsOfTmplSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateConfigHook_FullMethodName,
+		FullMethod: Data_ListTmplsOfTmplSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateConfigHook(ctx, req.(*UpdateConfigHookReq))
+		return srv.(DataServer).ListTmplsOfTmplSet(ctx, req.(*ListTmplsOfTmplSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateContentReq)
+func _Data_ListTemplateSetsAndRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSetsAndRevisionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateContent(ctx, in)
+		return srv.(DataServer).ListTemplateSetsAndRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateContent_FullMethodName,
+		FullMethod: Data_ListTemplateSetsAndRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateContent(ctx, req.(*CreateContentReq))
+		return srv.(DataServer).ListTemplateSetsAndRevisions(ctx, req.(*ListTemplateSetsAndRevisionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetContentReq)
+func _Data_ListTemplateByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateByTupleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetContent(ctx, in)
+		return srv.(DataServer).ListTemplateByTuple(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetContent_FullMethodName,
+		FullMethod: Data_ListTemplateByTuple_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetContent(ctx, req.(*GetContentReq))
+		return srv.(DataServer).ListTemplateByTuple(ctx, req.(*ListTemplateByTupleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommitReq)
+func _Data_BatchUpsertTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertTemplatesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateCommit(ctx, in)
+		return srv.(DataServer).BatchUpsertTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateCommit_FullMethodName,
+		FullMethod: Data_BatchUpsertTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateCommit(ctx, req.(*CreateCommitReq))
+		return srv.(DataServer).BatchUpsertTemplates(ctx, req.(*BatchUpsertTemplatesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetLatestCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLatestCommitReq)
+func _Data_BatchUpdateTemplatePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTemplatePermissionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetLatestCommit(ctx, in)
+		return srv.(DataServer).BatchUpdateTemplatePermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetLatestCommit_FullMethodName,
+		FullMethod: Data_BatchUpdateTemplatePermissions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetLatestCommit(ctx, req.(*GetLatestCommitReq))
+		return srv.(DataServer).BatchUpdateTemplatePermissions(ctx, req.(*BatchUpdateTemplatePermissionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReleaseReq)
+func _Data_CreateTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateRelease(ctx, in)
+		return srv.(DataServer).CreateTemplateRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateRelease_FullMethodName,
+		FullMethod: Data_CreateTemplateRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateRelease(ctx, req.(*CreateReleaseReq))
+		return srv.(DataServer).CreateTemplateRevision(ctx, req.(*CreateTemplateRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasesReq)
+func _Data_UpdateTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListReleases(ctx, in)
+		return srv.(DataServer).UpdateTemplateRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListReleases_FullMethodName,
+		FullMethod: Data_UpdateTemplateRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListReleases(ctx, req.(*ListReleasesReq))
+		return srv.(DataServer).UpdateTemplateRevision(ctx, req.(*UpdateTemplateRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleaseByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseByNameReq)
+func _Data_ListTemplateRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateRevisionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleaseByName(ctx, in)
+		return srv.(DataServer).ListTemplateRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleaseByName_FullMethodName,
+		FullMethod: Data_ListTemplateRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleaseByName(ctx, req.(*GetReleaseByNameReq))
+		return srv.(DataServer).ListTemplateRevisions(ctx, req.(*ListTemplateRevisionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseReq)
+func _Data_GetTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetRelease(ctx, in)
+		return srv.(DataServer).GetTemplateRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetRelease_FullMethodName,
+		FullMethod: Data_GetTemplateRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetRelease(ctx, req.(*GetReleaseReq))
+		return srv.(DataServer).GetTemplateRevision(ctx, req.(*GetTemplateRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeprecateReleaseReq)
+func _Data_DeleteTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeprecateRelease(ctx, in)
+		return srv.(DataServer).DeleteTemplateRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeprecateRelease_FullMethodName,
+		FullMethod: Data_DeleteTemplateRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeprecateRelease(ctx, req.(*DeprecateReleaseReq))
+		return srv.(DataServer).DeleteTemplateRevision(ctx, req.(*DeleteTemplateRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UnDeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnDeprecateReleaseReq)
+func _Data_ListTemplateRevisionsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateRevisionsByIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UnDeprecateRelease(ctx, in)
+		return srv.(DataServer).ListTemplateRevisionsByIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UnDeprecateRelease_FullMethodName,
+		FullMethod: Data_ListTemplateRevisionsByIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UnDeprecateRelease(ctx, req.(*UnDeprecateReleaseReq))
+		return srv.(DataServer).ListTemplateRevisionsByIDs(ctx, req.(*ListTemplateRevisionsByIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReleaseReq)
+func _Data_ListTmplRevisionNamesByTmplIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplRevisionNamesByTmplIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteRelease(ctx, in)
+		return srv.(DataServer).ListTmplRevisionNamesByTmplIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteRelease_FullMethodName,
+		FullMethod: Data_ListTmplRevisionNamesByTmplIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteRelease(ctx, req.(*DeleteReleaseReq))
+		return srv.(DataServer).ListTmplRevisionNamesByTmplIDs(ctx, req.(*ListTmplRevisionNamesByTmplIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CheckReleaseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckReleaseNameReq)
+func _Data_CreateTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CheckReleaseName(ctx, in)
+		return srv.(DataServer).CreateTemplateSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CheckReleaseName_FullMethodName,
+		FullMethod: Data_CreateTemplateSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CheckReleaseName(ctx, req.(*CheckReleaseNameReq))
+		return srv.(DataServer).CreateTemplateSet(ctx, req.(*CreateTemplateSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleasedConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedCIReq)
+func _Data_ListTemplateSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleasedConfigItem(ctx, in)
+		return srv.(DataServer).ListTemplateSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleasedConfigItem_FullMethodName,
+		FullMethod: Data_ListTemplateSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleasedConfigItem(ctx, req.(*GetReleasedCIReq))
+		return srv.(DataServer).ListTemplateSets(ctx, req.(*ListTemplateSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleasedKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedKvReq)
+func _Data_UpdateTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleasedKv(ctx, in)
+		return srv.(DataServer).UpdateTemplateSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleasedKv_FullMethodName,
+		FullMethod: Data_UpdateTemplateSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleasedKv(ctx, req.(*GetReleasedKvReq))
+		return srv.(DataServer).UpdateTemplateSet(ctx, req.(*UpdateTemplateSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListReleasedKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedKvReq)
+func _Data_DeleteTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListReleasedKvs(ctx, in)
+		return srv.(DataServer).DeleteTemplateSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListReleasedKvs_FullMethodName,
+		FullMethod: Data_DeleteTemplateSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListReleasedKvs(ctx, req.(*ListReleasedKvReq))
+		return srv.(DataServer).DeleteTemplateSet(ctx, req.(*DeleteTemplateSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAllReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllReleasedConfigItemsReq)
+func _Data_ListAppTemplateSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppTemplateSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAllReleasedConfigItems(ctx, in)
+		return srv.(DataServer).ListAppTemplateSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAllReleasedConfigItems_FullMethodName,
+		FullMethod: Data_ListAppTemplateSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAllReleasedConfigItems(ctx, req.(*ListAllReleasedConfigItemsReq))
+		return srv.(DataServer).ListAppTemplateSets(ctx, req.(*ListAppTemplateSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHookReq)
+func _Data_ListTemplateSetsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSetsByIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateHook(ctx, in)
+		return srv.(DataServer).ListTemplateSetsByIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateHook_FullMethodName,
+		FullMethod: Data_ListTemplateSetsByIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateHook(ctx, req.(*CreateHookReq))
+		return srv.(DataServer).ListTemplateSetsByIDs(ctx, req.(*ListTemplateSetsByIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHooksReq)
+func _Data_ListTemplateSetBriefInfoByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateSetBriefInfoByIDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListHooks(ctx, in)
+		return srv.(DataServer).ListTemplateSetBriefInfoByIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListHooks_FullMethodName,
+		FullMethod: Data_ListTemplateSetBriefInfoByIDs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListHooks(ctx, req.(*ListHooksReq))
+		return srv.(DataServer).ListTemplateSetBriefInfoByIDs(ctx, req.(*ListTemplateSetBriefInfoByIDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHookReq)
+func _Data_ListTmplSetsOfBiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplSetsOfBizReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteHook(ctx, in)
+		return srv.(DataServer).ListTmplSetsOfBiz(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteHook_FullMethodName,
+		FullMethod: Data_ListTmplSetsOfBiz_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteHook(ctx, req.(*DeleteHookReq))
+		return srv.(DataServer).ListTmplSetsOfBiz(ctx, req.(*ListTmplSetsOfBizReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHookReq)
+func _Data_GetLatestTemplateVersionsInSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestTemplateVersionsInSpaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateHook(ctx, in)
+		return srv.(DataServer).GetLatestTemplateVersionsInSpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateHook_FullMethodName,
+		FullMethod: Data_GetLatestTemplateVersionsInSpace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateHook(ctx, req.(*UpdateHookReq))
+		return srv.(DataServer).GetLatestTemplateVersionsInSpace(ctx, req.(*GetLatestTemplateVersionsInSpaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListHookTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookTagReq)
+func _Data_CreateAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppTemplateBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListHookTags(ctx, in)
+		return srv.(DataServer).CreateAppTemplateBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListHookTags_FullMethodName,
+		FullMethod: Data_CreateAppTemplateBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListHookTags(ctx, req.(*ListHookTagReq))
+		return srv.(DataServer).CreateAppTemplateBinding(ctx, req.(*CreateAppTemplateBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListHookReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookReferencesReq)
+func _Data_ListAppTemplateBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppTemplateBindingsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListHookReferences(ctx, in)
+		return srv.(DataServer).ListAppTemplateBindings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListHookReferences_FullMethodName,
+		FullMethod: Data_ListAppTemplateBindings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListHookReferences(ctx, req.(*ListHookReferencesReq))
+		return srv.(DataServer).ListAppTemplateBindings(ctx, req.(*ListAppTemplateBindingsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHookReq)
+func _Data_UpdateAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppTemplateBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetHook(ctx, in)
+		return srv.(DataServer).UpdateAppTemplateBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetHook_FullMethodName,
+		FullMethod: Data_UpdateAppTemplateBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetHook(ctx, req.(*GetHookReq))
+		return srv.(DataServer).UpdateAppTemplateBinding(ctx, req.(*UpdateAppTemplateBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_HookFetchIDsExcluding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HookFetchIDsExcludingReq)
+func _Data_DeleteAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppTemplateBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).HookFetchIDsExcluding(ctx, in)
+		return srv.(DataServer).DeleteAppTemplateBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_HookFetchIDsExcluding_FullMethodName,
+		FullMethod: Data_DeleteAppTemplateBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).HookFetchIDsExcluding(ctx, req.(*HookFetchIDsExcludingReq))
+		return srv.(DataServer).DeleteAppTemplateBinding(ctx, req.(*DeleteAppTemplateBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetHookReferencedIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHookReferencedIDsReq)
+func _Data_ListAppBoundTmplRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppBoundTmplRevisionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetHookReferencedIDs(ctx, in)
+		return srv.(DataServer).ListAppBoundTmplRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetHookReferencedIDs_FullMethodName,
+		FullMethod: Data_ListAppBoundTmplRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetHookReferencedIDs(ctx, req.(*GetHookReferencedIDsReq))
+		return srv.(DataServer).ListAppBoundTmplRevisions(ctx, req.(*ListAppBoundTmplRevisionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHookRevisionReq)
+func _Data_ListReleasedAppBoundTmplRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedAppBoundTmplRevisionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateHookRevision(ctx, in)
+		return srv.(DataServer).ListReleasedAppBoundTmplRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateHookRevision_FullMethodName,
+		FullMethod: Data_ListReleasedAppBoundTmplRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateHookRevision(ctx, req.(*CreateHookRevisionReq))
+		return srv.(DataServer).ListReleasedAppBoundTmplRevisions(ctx, req.(*ListReleasedAppBoundTmplRevisionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListHookRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookRevisionsReq)
+func _Data_GetReleasedAppBoundTmplRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedAppBoundTmplRevisionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListHookRevisions(ctx, in)
+		return srv.(DataServer).GetReleasedAppBoundTmplRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListHookRevisions_FullMethodName,
+		FullMethod: Data_GetReleasedAppBoundTmplRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListHookRevisions(ctx, req.(*ListHookRevisionsReq))
+		return srv.(DataServer).GetReleasedAppBoundTmplRevision(ctx, req.(*GetReleasedAppBoundTmplRevisionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetHookRevisionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHookRevisionByIdReq)
+func _Data_CheckAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAppTemplateBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetHookRevisionByID(ctx, in)
+		return srv.(DataServer).CheckAppTemplateBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetHookRevisionByID_FullMethodName,
+		FullMethod: Data_CheckAppTemplateBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetHookRevisionByID(ctx, req.(*GetHookRevisionByIdReq))
+		return srv.(DataServer).CheckAppTemplateBinding(ctx, req.(*CheckAppTemplateBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHookRevisionReq)
+func _Data_ImportFromTemplateSetToApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFromTemplateSetToAppReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteHookRevision(ctx, in)
+		return srv.(DataServer).ImportFromTemplateSetToApp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteHookRevision_FullMethodName,
+		FullMethod: Data_ImportFromTemplateSetToApp_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteHookRevision(ctx, req.(*DeleteHookRevisionReq))
+		return srv.(DataServer).ImportFromTemplateSetToApp(ctx, req.(*ImportFromTemplateSetToAppReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_PublishHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishHookRevisionReq)
+func _Data_ExtractAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractAppTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).PublishHookRevision(ctx, in)
+		return srv.(DataServer).ExtractAppTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_PublishHookRevision_FullMethodName,
+		FullMethod: Data_ExtractAppTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).PublishHookRevision(ctx, req.(*PublishHookRevisionReq))
+		return srv.(DataServer).ExtractAppTmplVariables(ctx, req.(*ExtractAppTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetHookRevisionByPubState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByPubStateReq)
+func _Data_GetAppTmplVariableRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppTmplVariableRefsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetHookRevisionByPubState(ctx, in)
+		return srv.(DataServer).GetAppTmplVariableRefs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetHookRevisionByPubState_FullMethodName,
+		FullMethod: Data_GetAppTmplVariableRefs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetHookRevisionByPubState(ctx, req.(*GetByPubStateReq))
+		return srv.(DataServer).GetAppTmplVariableRefs(ctx, req.(*GetAppTmplVariableRefsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHookRevisionReq)
+func _Data_GetReleasedAppTmplVariableRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedAppTmplVariableRefsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateHookRevision(ctx, in)
+		return srv.(DataServer).GetReleasedAppTmplVariableRefs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateHookRevision_FullMethodName,
+		FullMethod: Data_GetReleasedAppTmplVariableRefs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateHookRevision(ctx, req.(*UpdateHookRevisionReq))
+		return srv.(DataServer).GetReleasedAppTmplVariableRefs(ctx, req.(*GetReleasedAppTmplVariableRefsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListHookRevisionReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookRevisionReferencesReq)
+func _Data_UpdateAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListHookRevisionReferences(ctx, in)
+		return srv.(DataServer).UpdateAppTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListHookRevisionReferences_FullMethodName,
+		FullMethod: Data_UpdateAppTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListHookRevisionReferences(ctx, req.(*ListHookRevisionReferencesReq))
+		return srv.(DataServer).UpdateAppTmplVariables(ctx, req.(*UpdateAppTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleaseHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseHookReq)
+func _Data_ListAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleaseHook(ctx, in)
+		return srv.(DataServer).ListAppTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleaseHook_FullMethodName,
+		FullMethod: Data_ListAppTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleaseHook(ctx, req.(*GetReleaseHookReq))
+		return srv.(DataServer).ListAppTmplVariables(ctx, req.(*ListAppTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateSpaceReq)
+func _Data_ListReleasedAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedAppTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateTemplateSpace(ctx, in)
+		return srv.(DataServer).ListReleasedAppTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateTemplateSpace_FullMethodName,
+		FullMethod: Data_ListReleasedAppTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateTemplateSpace(ctx, req.(*CreateTemplateSpaceReq))
+		return srv.(DataServer).ListReleasedAppTmplVariables(ctx, req.(*ListReleasedAppTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplateSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateSpacesReq)
+func _Data_UpdateGroupTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplateSpaces(ctx, in)
+		return srv.(DataServer).UpdateGroupTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplateSpaces_FullMethodName,
+		FullMethod: Data_UpdateGroupTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplateSpaces(ctx, req.(*ListTemplateSpacesReq))
+		return srv.(DataServer).UpdateGroupTmplVariables(ctx, req.(*UpdateGroupTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateSpaceReq)
+func _Data_ListGroupTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateTemplateSpace(ctx, in)
+		return srv.(DataServer).ListGroupTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateTemplateSpace_FullMethodName,
+		FullMethod: Data_ListGroupTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateTemplateSpace(ctx, req.(*UpdateTemplateSpaceReq))
+		return srv.(DataServer).ListGroupTmplVariables(ctx, req.(*ListGroupTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteTemplateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTemplateSpaceReq)
+func _Data_TraceAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceAppTmplVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteTemplateSpace(ctx, in)
+		return srv.(DataServer).TraceAppTmplVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteTemplateSpace_FullMethodName,
+		FullMethod: Data_TraceAppTmplVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteTemplateSpace(ctx, req.(*DeleteTemplateSpaceReq))
+		return srv.(DataServer).TraceAppTmplVariables(ctx, req.(*TraceAppTmplVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetAllBizsOfTmplSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(base.EmptyReq)
+func _Data_ListTmplBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplBoundCountsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetAllBizsOfTmplSpaces(ctx, in)
+		return srv.(DataServer).ListTmplBoundCounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetAllBizsOfTmplSpaces_FullMethodName,
+		FullMethod: Data_ListTmplBoundCounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetAllBizsOfTmplSpaces(ctx, req.(*base.EmptyReq))
+		return srv.(DataServer).ListTmplBoundCounts(ctx, req.(*ListTmplBoundCountsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateDefaultTmplSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDefaultTmplSpaceReq)
+func _Data_ListTmplRevisionBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplRevisionBoundCountsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateDefaultTmplSpace(ctx, in)
+		return srv.(DataServer).ListTmplRevisionBoundCounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateDefaultTmplSpace_FullMethodName,
+		FullMethod: Data_ListTmplRevisionBoundCounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateDefaultTmplSpace(ctx, req.(*CreateDefaultTmplSpaceReq))
+		return srv.(DataServer).ListTmplRevisionBoundCounts(ctx, req.(*ListTmplRevisionBoundCountsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplSpacesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplSpacesByIDsReq)
+func _Data_ListTmplSetBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplSetBoundCountsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplSpacesByIDs(ctx, in)
+		return srv.(DataServer).ListTmplSetBoundCounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplSpacesByIDs_FullMethodName,
+		FullMethod: Data_ListTmplSetBoundCounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplSpacesByIDs(ctx, req.(*ListTmplSpacesByIDsReq))
+		return srv.(DataServer).ListTmplSetBoundCounts(ctx, req.(*ListTmplSetBoundCountsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateReq)
+func _Data_ListTmplBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplBoundUnnamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateTemplate(ctx, in)
+		return srv.(DataServer).ListTmplBoundUnnamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateTemplate_FullMethodName,
+		FullMethod: Data_ListTmplBoundUnnamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateTemplate(ctx, req.(*CreateTemplateReq))
+		return srv.(DataServer).ListTmplBoundUnnamedApps(ctx, req.(*ListTmplBoundUnnamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplatesReq)
+func _Data_ListTmplBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplBoundNamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplates(ctx, in)
+		return srv.(DataServer).ListTmplBoundNamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplates_FullMethodName,
+		FullMethod: Data_ListTmplBoundNamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplates(ctx, req.(*ListTemplatesReq))
+		return srv.(DataServer).ListTmplBoundNamedApps(ctx, req.(*ListTmplBoundNamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateReq)
+func _Data_ListTmplBoundTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplBoundTmplSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateTemplate(ctx, in)
+		return srv.(DataServer).ListTmplBoundTmplSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateTemplate_FullMethodName,
+		FullMethod: Data_ListTmplBoundTmplSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateTemplate(ctx, req.(*UpdateTemplateReq))
+		return srv.(DataServer).ListTmplBoundTmplSets(ctx, req.(*ListTmplBoundTmplSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTemplateReq)
+func _Data_ListMultiTmplBoundTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMultiTmplBoundTmplSetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteTemplate(ctx, in)
+		return srv.(DataServer).ListMultiTmplBoundTmplSets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteTemplate_FullMethodName,
+		FullMethod: Data_ListMultiTmplBoundTmplSets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteTemplate(ctx, req.(*DeleteTemplateReq))
+		return srv.(DataServer).ListMultiTmplBoundTmplSets(ctx, req.(*ListMultiTmplBoundTmplSetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_BatchDeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchDeleteTemplateReq)
+func _Data_ListTmplRevisionBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplRevisionBoundUnnamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).BatchDeleteTemplate(ctx, in)
+		return srv.(DataServer).ListTmplRevisionBoundUnnamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_BatchDeleteTemplate_FullMethodName,
+		FullMethod: Data_ListTmplRevisionBoundUnnamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).BatchDeleteTemplate(ctx, req.(*BatchDeleteTemplateReq))
+		return srv.(DataServer).ListTmplRevisionBoundUnnamedApps(ctx, req.(*ListTmplRevisionBoundUnnamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_AddTmplsToTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTmplsToTmplSetsReq)
+func _Data_ListTmplRevisionBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplRevisionBoundNamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).AddTmplsToTmplSets(ctx, in)
+		return srv.(DataServer).ListTmplRevisionBoundNamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_AddTmplsToTmplSets_FullMethodName,
+		FullMethod: Data_ListTmplRevisionBoundNamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).AddTmplsToTmplSets(ctx, req.(*AddTmplsToTmplSetsReq))
+		return srv.(DataServer).ListTmplRevisionBoundNamedApps(ctx, req.(*ListTmplRevisionBoundNamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteTmplsFromTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTmplsFromTmplSetsReq)
+func _Data_ListTmplSetBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplSetBoundUnnamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteTmplsFromTmplSets(ctx, in)
+		return srv.(DataServer).ListTmplSetBoundUnnamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteTmplsFromTmplSets_FullMethodName,
+		FullMethod: Data_ListTmplSetBoundUnnamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteTmplsFromTmplSets(ctx, req.(*DeleteTmplsFromTmplSetsReq))
+		return srv.(DataServer).ListTmplSetBoundUnnamedApps(ctx, req.(*ListTmplSetBoundUnnamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplatesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplatesByIDsReq)
+func _Data_ListMultiTmplSetBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMultiTmplSetBoundUnnamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplatesByIDs(ctx, in)
+		return srv.(DataServer).ListMultiTmplSetBoundUnnamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplatesByIDs_FullMethodName,
+		FullMethod: Data_ListMultiTmplSetBoundUnnamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplatesByIDs(ctx, req.(*ListTemplatesByIDsReq))
+		return srv.(DataServer).ListMultiTmplSetBoundUnnamedApps(ctx, req.(*ListMultiTmplSetBoundUnnamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplatesNotBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplatesNotBoundReq)
+func _Data_ListTmplSetBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplSetBoundNamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplatesNotBound(ctx, in)
+		return srv.(DataServer).ListTmplSetBoundNamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplatesNotBound_FullMethodName,
+		FullMethod: Data_ListTmplSetBoundNamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplatesNotBound(ctx, req.(*ListTemplatesNotBoundReq))
+		return srv.(DataServer).ListTmplSetBoundNamedApps(ctx, req.(*ListTmplSetBoundNamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplsOfTmplSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplsOfTmplSetReq)
+func _Data_ListLatestTmplBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLatestTmplBoundUnnamedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplsOfTmplSet(ctx, in)
+		return srv.(DataServer).ListLatestTmplBoundUnnamedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplsOfTmplSet_FullMethodName,
+		FullMethod: Data_ListLatestTmplBoundUnnamedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplsOfTmplSet(ctx, req.(*ListTmplsOfTmplSetReq))
+		return srv.(DataServer).ListLatestTmplBoundUnnamedApps(ctx, req.(*ListLatestTmplBoundUnnamedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplateSetsAndRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateSetsAndRevisionsReq)
+func _Data_RemoveAppBoundTmplSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAppBoundTmplSetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplateSetsAndRevisions(ctx, in)
+		return srv.(DataServer).RemoveAppBoundTmplSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplateSetsAndRevisions_FullMethodName,
+		FullMethod: Data_RemoveAppBoundTmplSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplateSetsAndRevisions(ctx, req.(*ListTemplateSetsAndRevisionsReq))
+		return srv.(DataServer).RemoveAppBoundTmplSet(ctx, req.(*RemoveAppBoundTmplSetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplateByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateByTupleReq)
+func _Data_CheckTemplateSetReferencesApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTemplateSetReferencesAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplateByTuple(ctx, in)
+		return srv.(DataServer).CheckTemplateSetReferencesApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplateByTuple_FullMethodName,
+		FullMethod: Data_CheckTemplateSetReferencesApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplateByTuple(ctx, req.(*ListTemplateByTupleReq))
+		return srv.(DataServer).CheckTemplateSetReferencesApps(ctx, req.(*CheckTemplateSetReferencesAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_BatchUpsertTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpsertTemplatesReq)
+func _Data_CreateTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateVariableReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).BatchUpsertTemplates(ctx, in)
+		return srv.(DataServer).CreateTemplateVariable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_BatchUpsertTemplates_FullMethodName,
+		FullMethod: Data_CreateTemplateVariable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).BatchUpsertTemplates(ctx, req.(*BatchUpsertTemplatesReq))
+		return srv.(DataServer).CreateTemplateVariable(ctx, req.(*CreateTemplateVariableReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_BatchUpdateTemplatePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpdateTemplatePermissionsReq)
+func _Data_ListTemplateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).BatchUpdateTemplatePermissions(ctx, in)
+		return srv.(DataServer).ListTemplateVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_BatchUpdateTemplatePermissions_FullMethodName,
+		FullMethod: Data_ListTemplateVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).BatchUpdateTemplatePermissions(ctx, req.(*BatchUpdateTemplatePermissionsReq))
+		return srv.(DataServer).ListTemplateVariables(ctx, req.(*ListTemplateVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateRevisionReq)
+func _Data_UpdateTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateVariableReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateTemplateRevision(ctx, in)
+		return srv.(DataServer).UpdateTemplateVariable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateTemplateRevision_FullMethodName,
+		FullMethod: Data_UpdateTemplateVariable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateTemplateRevision(ctx, req.(*CreateTemplateRevisionReq))
+		return srv.(DataServer).UpdateTemplateVariable(ctx, req.(*UpdateTemplateVariableReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateRevisionReq)
+func _Data_DeleteTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateVariableReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateTemplateRevision(ctx, in)
+		return srv.(DataServer).DeleteTemplateVariable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateTemplateRevision_FullMethodName,
+		FullMethod: Data_DeleteTemplateVariable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateTemplateRevision(ctx, req.(*UpdateTemplateRevisionReq))
+		return srv.(DataServer).DeleteTemplateVariable(ctx, req.(*DeleteTemplateVariableReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplateRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateRevisionsReq)
+func _Data_TemplateVariableFetchIDsExcluding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateVariableFetchIDsExcludingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplateRevisions(ctx, in)
+		return srv.(DataServer).TemplateVariableFetchIDsExcluding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplateRevisions_FullMethodName,
+		FullMethod: Data_TemplateVariableFetchIDsExcluding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplateRevisions(ctx, req.(*ListTemplateRevisionsReq))
+		return srv.(DataServer).TemplateVariableFetchIDsExcluding(ctx, req.(*TemplateVariableFetchIDsExcludingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTemplateRevisionReq)
+func _Data_ImportTemplateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTemplateVariablesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetTemplateRevision(ctx, in)
+		return srv.(DataServer).ImportTemplateVariables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetTemplateRevision_FullMethodName,
+		FullMethod: Data_ImportTemplateVariables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetTemplateRevision(ctx, req.(*GetTemplateRevisionReq))
+		return srv.(DataServer).ImportTemplateVariables(ctx, req.(*ImportTemplateVariablesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTemplateRevisionReq)
+func _Data_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteTemplateRevision(ctx, in)
+		return srv.(DataServer).CreateGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteTemplateRevision_FullMethodName,
+		FullMethod: Data_CreateGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteTemplateRevision(ctx, req.(*DeleteTemplateRevisionReq))
+		return srv.(DataServer).CreateGroup(ctx, req.(*CreateGroupReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplateRevisionsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateRevisionsByIDsReq)
+func _Data_ListAllGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllGroupsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplateRevisionsByIDs(ctx, in)
+		return srv.(DataServer).ListAllGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplateRevisionsByIDs_FullMethodName,
+		FullMethod: Data_ListAllGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplateRevisionsByIDs(ctx, req.(*ListTemplateRevisionsByIDsReq))
+		return srv.(DataServer).ListAllGroups(ctx, req.(*ListAllGroupsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplRevisionNamesByTmplIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplRevisionNamesByTmplIDsReq)
+func _Data_ListAppGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppGroupsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplRevisionNamesByTmplIDs(ctx, in)
+		return srv.(DataServer).ListAppGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplRevisionNamesByTmplIDs_FullMethodName,
+		FullMethod: Data_ListAppGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplRevisionNamesByTmplIDs(ctx, req.(*ListTmplRevisionNamesByTmplIDsReq))
+		return srv.(DataServer).ListAppGroups(ctx, req.(*ListAppGroupsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateSetReq)
+func _Data_GetGroupByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupByNameReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateTemplateSet(ctx, in)
+		return srv.(DataServer).GetGroupByName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateTemplateSet_FullMethodName,
+		FullMethod: Data_GetGroupByName_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateTemplateSet(ctx, req.(*CreateTemplateSetReq))
+		return srv.(DataServer).GetGroupByName(ctx, req.(*GetGroupByNameReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplateSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateSetsReq)
+func _Data_GetGroupByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupByIDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplateSets(ctx, in)
+		return srv.(DataServer).GetGroupByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplateSets_FullMethodName,
+		FullMethod: Data_GetGroupByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplateSets(ctx, req.(*ListTemplateSetsReq))
+		return srv.(DataServer).GetGroupByID(ctx, req.(*GetGroupByIDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateSetReq)
+func _Data_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateTemplateSet(ctx, in)
+		return srv.(DataServer).UpdateGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateTemplateSet_FullMethodName,
+		FullMethod: Data_UpdateGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateTemplateSet(ctx, req.(*UpdateTemplateSetReq))
+		return srv.(DataServer).UpdateGroup(ctx, req.(*UpdateGroupReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteTemplateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTemplateSetReq)
+func _Data_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteTemplateSet(ctx, in)
+		return srv.(DataServer).DeleteGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteTemplateSet_FullMethodName,
+		FullMethod: Data_DeleteGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteTemplateSet(ctx, req.(*DeleteTemplateSetReq))
+		return srv.(DataServer).DeleteGroup(ctx, req.(*DeleteGroupReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAppTemplateSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppTemplateSetsReq)
+func _Data_ListGroupSelector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupSelectorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAppTemplateSets(ctx, in)
+		return srv.(DataServer).ListGroupSelector(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAppTemplateSets_FullMethodName,
+		FullMethod: Data_ListGroupSelector_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAppTemplateSets(ctx, req.(*ListAppTemplateSetsReq))
+		return srv.(DataServer).ListGroupSelector(ctx, req.(*ListGroupSelectorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplateSetsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateSetsByIDsReq)
+func _Data_CountGroupsReleasedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountGroupsReleasedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplateSetsByIDs(ctx, in)
+		return srv.(DataServer).CountGroupsReleasedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplateSetsByIDs_FullMethodName,
+		FullMethod: Data_CountGroupsReleasedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplateSetsByIDs(ctx, req.(*ListTemplateSetsByIDsReq))
+		return srv.(DataServer).CountGroupsReleasedApps(ctx, req.(*CountGroupsReleasedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplateSetBriefInfoByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateSetBriefInfoByIDsReq)
+func _Data_ListGroupReleasedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupReleasedAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplateSetBriefInfoByIDs(ctx, in)
+		return srv.(DataServer).ListGroupReleasedApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplateSetBriefInfoByIDs_FullMethodName,
+		FullMethod: Data_ListGroupReleasedApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplateSetBriefInfoByIDs(ctx, req.(*ListTemplateSetBriefInfoByIDsReq))
+		return srv.(DataServer).ListGroupReleasedApps(ctx, req.(*ListGroupReleasedAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplSetsOfBiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplSetsOfBizReq)
+func _Data_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplSetsOfBiz(ctx, in)
+		return srv.(DataServer).Publish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplSetsOfBiz_FullMethodName,
+		FullMethod: Data_Publish_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplSetsOfBiz(ctx, req.(*ListTmplSetsOfBizReq))
+		return srv.(DataServer).Publish(ctx, req.(*PublishReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetLatestTemplateVersionsInSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLatestTemplateVersionsInSpaceReq)
+func _Data_SubmitPublishApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitPublishApproveReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetLatestTemplateVersionsInSpace(ctx, in)
+		return srv.(DataServer).SubmitPublishApprove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetLatestTemplateVersionsInSpace_FullMethodName,
+		FullMethod: Data_SubmitPublishApprove_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetLatestTemplateVersionsInSpace(ctx, req.(*GetLatestTemplateVersionsInSpaceReq))
+		return srv.(DataServer).SubmitPublishApprove(ctx, req.(*SubmitPublishApproveReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAppTemplateBindingReq)
+func _Data_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateAppTemplateBinding(ctx, in)
+		return srv.(DataServer).Approve(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateAppTemplateBinding_FullMethodName,
+		FullMethod: Data_Approve_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateAppTemplateBinding(ctx, req.(*CreateAppTemplateBindingReq))
+		return srv.(DataServer).Approve(ctx, req.(*ApproveReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAppTemplateBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppTemplateBindingsReq)
+func _Data_GetLastSelect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastSelectReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAppTemplateBindings(ctx, in)
+		return srv.(DataServer).GetLastSelect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAppTemplateBindings_FullMethodName,
+		FullMethod: Data_GetLastSelect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAppTemplateBindings(ctx, req.(*ListAppTemplateBindingsReq))
+		return srv.(DataServer).GetLastSelect(ctx, req.(*GetLastSelectReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppTemplateBindingReq)
+func _Data_GetLastPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastPublishReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateAppTemplateBinding(ctx, in)
+		return srv.(DataServer).GetLastPublish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateAppTemplateBinding_FullMethodName,
+		FullMethod: Data_GetLastPublish_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateAppTemplateBinding(ctx, req.(*UpdateAppTemplateBindingReq))
+		return srv.(DataServer).GetLastPublish(ctx, req.(*GetLastPublishReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAppTemplateBindingReq)
+func _Data_GetReleasesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasesStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteAppTemplateBinding(ctx, in)
+		return srv.(DataServer).GetReleasesStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteAppTemplateBinding_FullMethodName,
+		FullMethod: Data_GetReleasesStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteAppTemplateBinding(ctx, req.(*DeleteAppTemplateBindingReq))
+		return srv.(DataServer).GetReleasesStatus(ctx, req.(*GetReleasesStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAppBoundTmplRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppBoundTmplRevisionsReq)
+func _Data_GenerateReleaseAndPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateReleaseAndPublishReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAppBoundTmplRevisions(ctx, in)
+		return srv.(DataServer).GenerateReleaseAndPublish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAppBoundTmplRevisions_FullMethodName,
+		FullMethod: Data_GenerateReleaseAndPublish_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAppBoundTmplRevisions(ctx, req.(*ListAppBoundTmplRevisionsReq))
+		return srv.(DataServer).GenerateReleaseAndPublish(ctx, req.(*GenerateReleaseAndPublishReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListReleasedAppBoundTmplRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedAppBoundTmplRevisionsReq)
+func _Data_RollbackRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackReleaseReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListReleasedAppBoundTmplRevisions(ctx, in)
+		return srv.(DataServer).RollbackRelease(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListReleasedAppBoundTmplRevisions_FullMethodName,
+		FullMethod: Data_RollbackRelease_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListReleasedAppBoundTmplRevisions(ctx, req.(*ListReleasedAppBoundTmplRevisionsReq))
+		return srv.(DataServer).RollbackRelease(ctx, req.(*RollbackReleaseReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleasedAppBoundTmplRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedAppBoundTmplRevisionReq)
+func _Data_PinGroupRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinGroupReleaseReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleasedAppBoundTmplRevision(ctx, in)
+		return srv.(DataServer).PinGroupRelease(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleasedAppBoundTmplRevision_FullMethodName,
+		FullMethod: Data_PinGroupRelease_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleasedAppBoundTmplRevision(ctx, req.(*GetReleasedAppBoundTmplRevisionReq))
+		return srv.(DataServer).PinGroupRelease(ctx, req.(*PinGroupReleaseReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CheckAppTemplateBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckAppTemplateBindingReq)
+func _Data_UnpinGroupRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinGroupReleaseReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CheckAppTemplateBinding(ctx, in)
+		return srv.(DataServer).UnpinGroupRelease(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CheckAppTemplateBinding_FullMethodName,
+		FullMethod: Data_UnpinGroupRelease_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CheckAppTemplateBinding(ctx, req.(*CheckAppTemplateBindingReq))
+		return srv.(DataServer).UnpinGroupRelease(ctx, req.(*UnpinGroupReleaseReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ImportFromTemplateSetToApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportFromTemplateSetToAppReq)
+func _Data_ApprovalCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovalCallbackReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ImportFromTemplateSetToApp(ctx, in)
+		return srv.(DataServer).ApprovalCallback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ImportFromTemplateSetToApp_FullMethodName,
+		FullMethod: Data_ApprovalCallback_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ImportFromTemplateSetToApp(ctx, req.(*ImportFromTemplateSetToAppReq))
+		return srv.(DataServer).ApprovalCallback(ctx, req.(*ApprovalCallbackReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ExtractAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExtractAppTmplVariablesReq)
+func _Data_ListAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ExtractAppTmplVariables(ctx, in)
+		return srv.(DataServer).ListAudits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ExtractAppTmplVariables_FullMethodName,
+		FullMethod: Data_ListAudits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ExtractAppTmplVariables(ctx, req.(*ExtractAppTmplVariablesReq))
+		return srv.(DataServer).ListAudits(ctx, req.(*ListAuditsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetAppTmplVariableRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppTmplVariableRefsReq)
+func _Data_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetAppTmplVariableRefs(ctx, in)
+		return srv.(DataServer).CreateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetAppTmplVariableRefs_FullMethodName,
+		FullMethod: Data_CreateCredential_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetAppTmplVariableRefs(ctx, req.(*GetAppTmplVariableRefsReq))
+		return srv.(DataServer).CreateCredential(ctx, req.(*CreateCredentialReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleasedAppTmplVariableRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedAppTmplVariableRefsReq)
+func _Data_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleasedAppTmplVariableRefs(ctx, in)
+		return srv.(DataServer).ListCredentials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleasedAppTmplVariableRefs_FullMethodName,
+		FullMethod: Data_ListCredentials_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleasedAppTmplVariableRefs(ctx, req.(*GetReleasedAppTmplVariableRefsReq))
+		return srv.(DataServer).ListCredentials(ctx, req.(*ListCredentialReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppTmplVariablesReq)
+func _Data_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateAppTmplVariables(ctx, in)
+		return srv.(DataServer).DeleteCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateAppTmplVariables_FullMethodName,
+		FullMethod: Data_DeleteCredential_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateAppTmplVariables(ctx, req.(*UpdateAppTmplVariablesReq))
+		return srv.(DataServer).DeleteCredential(ctx, req.(*DeleteCredentialReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppTmplVariablesReq)
+func _Data_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAppTmplVariables(ctx, in)
+		return srv.(DataServer).UpdateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAppTmplVariables_FullMethodName,
+		FullMethod: Data_UpdateCredential_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAppTmplVariables(ctx, req.(*ListAppTmplVariablesReq))
+		return srv.(DataServer).UpdateCredential(ctx, req.(*UpdateCredentialReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListReleasedAppTmplVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedAppTmplVariablesReq)
+func _Data_CheckCredentialName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCredentialNameReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListReleasedAppTmplVariables(ctx, in)
+		return srv.(DataServer).CheckCredentialName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListReleasedAppTmplVariables_FullMethodName,
+		FullMethod: Data_CheckCredentialName_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListReleasedAppTmplVariables(ctx, req.(*ListReleasedAppTmplVariablesReq))
+		return srv.(DataServer).CheckCredentialName(ctx, req.(*CheckCredentialNameReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplBoundCountsReq)
+func _Data_RotateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCredentialReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplBoundCounts(ctx, in)
+		return srv.(DataServer).RotateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplBoundCounts_FullMethodName,
+		FullMethod: Data_RotateCredential_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplBoundCounts(ctx, req.(*ListTmplBoundCountsReq))
+		return srv.(DataServer).RotateCredential(ctx, req.(*RotateCredentialReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplRevisionBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplRevisionBoundCountsReq)
+func _Data_BatchUpdateCredentialUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateCredentialUsageReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplRevisionBoundCounts(ctx, in)
+		return srv.(DataServer).BatchUpdateCredentialUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplRevisionBoundCounts_FullMethodName,
+		FullMethod: Data_BatchUpdateCredentialUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplRevisionBoundCounts(ctx, req.(*ListTmplRevisionBoundCountsReq))
+		return srv.(DataServer).BatchUpdateCredentialUsage(ctx, req.(*BatchUpdateCredentialUsageReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplSetBoundCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplSetBoundCountsReq)
+func _Data_ListCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialScopesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplSetBoundCounts(ctx, in)
+		return srv.(DataServer).ListCredentialScopes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplSetBoundCounts_FullMethodName,
+		FullMethod: Data_ListCredentialScopes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplSetBoundCounts(ctx, req.(*ListTmplSetBoundCountsReq))
+		return srv.(DataServer).ListCredentialScopes(ctx, req.(*ListCredentialScopesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplBoundUnnamedAppsReq)
+func _Data_UpdateCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialScopesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplBoundUnnamedApps(ctx, in)
+		return srv.(DataServer).UpdateCredentialScopes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplBoundUnnamedApps_FullMethodName,
+		FullMethod: Data_UpdateCredentialScopes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplBoundUnnamedApps(ctx, req.(*ListTmplBoundUnnamedAppsReq))
+		return srv.(DataServer).UpdateCredentialScopes(ctx, req.(*UpdateCredentialScopesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplBoundNamedAppsReq)
+func _Data_CredentialScopePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CredentialScopePreviewReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplBoundNamedApps(ctx, in)
+		return srv.(DataServer).CredentialScopePreview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplBoundNamedApps_FullMethodName,
+		FullMethod: Data_CredentialScopePreview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplBoundNamedApps(ctx, req.(*ListTmplBoundNamedAppsReq))
+		return srv.(DataServer).CredentialScopePreview(ctx, req.(*CredentialScopePreviewReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplBoundTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplBoundTmplSetsReq)
+func _Data_CreateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplBoundTmplSets(ctx, in)
+		return srv.(DataServer).CreateKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplBoundTmplSets_FullMethodName,
+		FullMethod: Data_CreateKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplBoundTmplSets(ctx, req.(*ListTmplBoundTmplSetsReq))
+		return srv.(DataServer).CreateKv(ctx, req.(*CreateKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListMultiTmplBoundTmplSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMultiTmplBoundTmplSetsReq)
+func _Data_UpdateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListMultiTmplBoundTmplSets(ctx, in)
+		return srv.(DataServer).UpdateKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListMultiTmplBoundTmplSets_FullMethodName,
+		FullMethod: Data_UpdateKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListMultiTmplBoundTmplSets(ctx, req.(*ListMultiTmplBoundTmplSetsReq))
+		return srv.(DataServer).UpdateKv(ctx, req.(*UpdateKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplRevisionBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplRevisionBoundUnnamedAppsReq)
+func _Data_ListKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKvsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplRevisionBoundUnnamedApps(ctx, in)
+		return srv.(DataServer).ListKvs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplRevisionBoundUnnamedApps_FullMethodName,
+		FullMethod: Data_ListKvs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplRevisionBoundUnnamedApps(ctx, req.(*ListTmplRevisionBoundUnnamedAppsReq))
+		return srv.(DataServer).ListKvs(ctx, req.(*ListKvsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplRevisionBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplRevisionBoundNamedAppsReq)
+func _Data_DeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplRevisionBoundNamedApps(ctx, in)
+		return srv.(DataServer).DeleteKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplRevisionBoundNamedApps_FullMethodName,
+		FullMethod: Data_DeleteKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplRevisionBoundNamedApps(ctx, req.(*ListTmplRevisionBoundNamedAppsReq))
+		return srv.(DataServer).DeleteKv(ctx, req.(*DeleteKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplSetBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplSetBoundUnnamedAppsReq)
+func _Data_BatchUpsertKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertKvsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplSetBoundUnnamedApps(ctx, in)
+		return srv.(DataServer).BatchUpsertKvs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplSetBoundUnnamedApps_FullMethodName,
+		FullMethod: Data_BatchUpsertKvs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplSetBoundUnnamedApps(ctx, req.(*ListTmplSetBoundUnnamedAppsReq))
+		return srv.(DataServer).BatchUpsertKvs(ctx, req.(*BatchUpsertKvsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListMultiTmplSetBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMultiTmplSetBoundUnnamedAppsReq)
+func _Data_UnDeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnDeleteKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListMultiTmplSetBoundUnnamedApps(ctx, in)
+		return srv.(DataServer).UnDeleteKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListMultiTmplSetBoundUnnamedApps_FullMethodName,
+		FullMethod: Data_UnDeleteKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListMultiTmplSetBoundUnnamedApps(ctx, req.(*ListMultiTmplSetBoundUnnamedAppsReq))
+		return srv.(DataServer).UnDeleteKv(ctx, req.(*UnDeleteKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTmplSetBoundNamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTmplSetBoundNamedAppsReq)
+func _Data_UndoKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTmplSetBoundNamedApps(ctx, in)
+		return srv.(DataServer).UndoKv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTmplSetBoundNamedApps_FullMethodName,
+		FullMethod: Data_UndoKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTmplSetBoundNamedApps(ctx, req.(*ListTmplSetBoundNamedAppsReq))
+		return srv.(DataServer).UndoKv(ctx, req.(*UndoKvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListLatestTmplBoundUnnamedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLatestTmplBoundUnnamedAppsReq)
+func _Data_KvFetchIDsExcluding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KvFetchIDsExcludingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListLatestTmplBoundUnnamedApps(ctx, in)
+		return srv.(DataServer).KvFetchIDsExcluding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListLatestTmplBoundUnnamedApps_FullMethodName,
+		FullMethod: Data_KvFetchIDsExcluding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListLatestTmplBoundUnnamedApps(ctx, req.(*ListLatestTmplBoundUnnamedAppsReq))
+		return srv.(DataServer).KvFetchIDsExcluding(ctx, req.(*KvFetchIDsExcludingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_RemoveAppBoundTmplSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveAppBoundTmplSetReq)
+func _Data_KvFetchKeysExcluding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KvFetchKeysExcludingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).RemoveAppBoundTmplSet(ctx, in)
+		return srv.(DataServer).KvFetchKeysExcluding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_RemoveAppBoundTmplSet_FullMethodName,
+		FullMethod: Data_KvFetchKeysExcluding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).RemoveAppBoundTmplSet(ctx, req.(*RemoveAppBoundTmplSetReq))
+		return srv.(DataServer).KvFetchKeysExcluding(ctx, req.(*KvFetchKeysExcludingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CheckTemplateSetReferencesApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckTemplateSetReferencesAppsReq)
+func _Data_FindNearExpiryCertKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearExpiryCertKvsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CheckTemplateSetReferencesApps(ctx, in)
+		return srv.(DataServer).FindNearExpiryCertKvs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CheckTemplateSetReferencesApps_FullMethodName,
+		FullMethod: Data_FindNearExpiryCertKvs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CheckTemplateSetReferencesApps(ctx, req.(*CheckTemplateSetReferencesAppsReq))
+		return srv.(DataServer).FindNearExpiryCertKvs(ctx, req.(*FindNearExpiryCertKvsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTemplateVariableReq)
+func _Data_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateTemplateVariable(ctx, in)
+		return srv.(DataServer).ListClients(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateTemplateVariable_FullMethodName,
+		FullMethod: Data_ListClients_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateTemplateVariable(ctx, req.(*CreateTemplateVariableReq))
+		return srv.(DataServer).ListClients(ctx, req.(*ListClientsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListTemplateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplateVariablesReq)
+func _Data_RetryClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryClientsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListTemplateVariables(ctx, in)
+		return srv.(DataServer).RetryClients(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListTemplateVariables_FullMethodName,
+		FullMethod: Data_RetryClients_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListTemplateVariables(ctx, req.(*ListTemplateVariablesReq))
+		return srv.(DataServer).RetryClients(ctx, req.(*RetryClientsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTemplateVariableReq)
+func _Data_ListClientEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientEventsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateTemplateVariable(ctx, in)
+		return srv.(DataServer).ListClientEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateTemplateVariable_FullMethodName,
+		FullMethod: Data_ListClientEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateTemplateVariable(ctx, req.(*UpdateTemplateVariableReq))
+		return srv.(DataServer).ListClientEvents(ctx, req.(*ListClientEventsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteTemplateVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTemplateVariableReq)
+func _Data_ListClientQuerys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientQuerysReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteTemplateVariable(ctx, in)
+		return srv.(DataServer).ListClientQuerys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteTemplateVariable_FullMethodName,
+		FullMethod: Data_ListClientQuerys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteTemplateVariable(ctx, req.(*DeleteTemplateVariableReq))
+		return srv.(DataServer).ListClientQuerys(ctx, req.(*ListClientQuerysReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_TemplateVariableFetchIDsExcluding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TemplateVariableFetchIDsExcludingReq)
+func _Data_CreateClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientQueryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).TemplateVariableFetchIDsExcluding(ctx, in)
+		return srv.(DataServer).CreateClientQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_TemplateVariableFetchIDsExcluding_FullMethodName,
+		FullMethod: Data_CreateClientQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).TemplateVariableFetchIDsExcluding(ctx, req.(*TemplateVariableFetchIDsExcludingReq))
+		return srv.(DataServer).CreateClientQuery(ctx, req.(*CreateClientQueryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ImportTemplateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportTemplateVariablesReq)
+func _Data_UpdateClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientQueryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ImportTemplateVariables(ctx, in)
+		return srv.(DataServer).UpdateClientQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ImportTemplateVariables_FullMethodName,
+		FullMethod: Data_UpdateClientQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ImportTemplateVariables(ctx, req.(*ImportTemplateVariablesReq))
+		return srv.(DataServer).UpdateClientQuery(ctx, req.(*UpdateClientQueryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGroupReq)
+func _Data_DeleteClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClientQueryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateGroup(ctx, in)
+		return srv.(DataServer).DeleteClientQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateGroup_FullMethodName,
+		FullMethod: Data_DeleteClientQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateGroup(ctx, req.(*CreateGroupReq))
+		return srv.(DataServer).DeleteClientQuery(ctx, req.(*DeleteClientQueryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAllGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllGroupsReq)
+func _Data_CheckClientQueryName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckClientQueryNameReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAllGroups(ctx, in)
+		return srv.(DataServer).CheckClientQueryName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAllGroups_FullMethodName,
+		FullMethod: Data_CheckClientQueryName_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAllGroups(ctx, req.(*ListAllGroupsReq))
+		return srv.(DataServer).CheckClientQueryName(ctx, req.(*CheckClientQueryNameReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAppGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppGroupsReq)
+func _Data_ClientConfigVersionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAppGroups(ctx, in)
+		return srv.(DataServer).ClientConfigVersionStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAppGroups_FullMethodName,
+		FullMethod: Data_ClientConfigVersionStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAppGroups(ctx, req.(*ListAppGroupsReq))
+		return srv.(DataServer).ClientConfigVersionStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetGroupByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupByNameReq)
+func _Data_ClientPullTrendStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetGroupByName(ctx, in)
+		return srv.(DataServer).ClientPullTrendStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetGroupByName_FullMethodName,
+		FullMethod: Data_ClientPullTrendStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetGroupByName(ctx, req.(*GetGroupByNameReq))
+		return srv.(DataServer).ClientPullTrendStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetGroupByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupByIDReq)
+func _Data_ClientPullStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetGroupByID(ctx, in)
+		return srv.(DataServer).ClientPullStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetGroupByID_FullMethodName,
+		FullMethod: Data_ClientPullStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetGroupByID(ctx, req.(*GetGroupByIDReq))
+		return srv.(DataServer).ClientPullStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGroupReq)
+func _Data_ClientLabelStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateGroup(ctx, in)
+		return srv.(DataServer).ClientLabelStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateGroup_FullMethodName,
+		FullMethod: Data_ClientLabelStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateGroup(ctx, req.(*UpdateGroupReq))
+		return srv.(DataServer).ClientLabelStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGroupReq)
+func _Data_ClientAnnotationStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteGroup(ctx, in)
+		return srv.(DataServer).ClientAnnotationStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteGroup_FullMethodName,
+		FullMethod: Data_ClientAnnotationStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteGroup(ctx, req.(*DeleteGroupReq))
+		return srv.(DataServer).ClientAnnotationStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListGroupSelector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupSelectorReq)
+func _Data_ClientVersionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListGroupSelector(ctx, in)
+		return srv.(DataServer).ClientVersionStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListGroupSelector_FullMethodName,
+		FullMethod: Data_ClientVersionStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListGroupSelector(ctx, req.(*ListGroupSelectorReq))
+		return srv.(DataServer).ClientVersionStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CountGroupsReleasedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountGroupsReleasedAppsReq)
+func _Data_ListClientLabelAndAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientLabelAndAnnotationReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CountGroupsReleasedApps(ctx, in)
+		return srv.(DataServer).ListClientLabelAndAnnotation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CountGroupsReleasedApps_FullMethodName,
+		FullMethod: Data_ListClientLabelAndAnnotation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CountGroupsReleasedApps(ctx, req.(*CountGroupsReleasedAppsReq))
+		return srv.(DataServer).ListClientLabelAndAnnotation(ctx, req.(*ListClientLabelAndAnnotationReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListGroupReleasedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupReleasedAppsReq)
+func _Data_ClientSpecificFailedReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListGroupReleasedApps(ctx, in)
+		return srv.(DataServer).ClientSpecificFailedReason(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListGroupReleasedApps_FullMethodName,
+		FullMethod: Data_ClientSpecificFailedReason_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListGroupReleasedApps(ctx, req.(*ListGroupReleasedAppsReq))
+		return srv.(DataServer).ClientSpecificFailedReason(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishReq)
+func _Data_ClientStageStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(client.ClientCommonReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).Publish(ctx, in)
+		return srv.(DataServer).ClientStageStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_Publish_FullMethodName,
+		FullMethod: Data_ClientStageStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).Publish(ctx, req.(*PublishReq))
+		return srv.(DataServer).ClientStageStatistics(ctx, req.(*client.ClientCommonReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_SubmitPublishApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitPublishApproveReq)
+func _Data_CreateClientAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).SubmitPublishApprove(ctx, in)
+		return srv.(DataServer).CreateClientAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_SubmitPublishApprove_FullMethodName,
+		FullMethod: Data_CreateClientAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).SubmitPublishApprove(ctx, req.(*SubmitPublishApproveReq))
+		return srv.(DataServer).CreateClientAlertRule(ctx, req.(*CreateClientAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApproveReq)
+func _Data_UpdateClientAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).Approve(ctx, in)
+		return srv.(DataServer).UpdateClientAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_Approve_FullMethodName,
+		FullMethod: Data_UpdateClientAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).Approve(ctx, req.(*ApproveReq))
+		return srv.(DataServer).UpdateClientAlertRule(ctx, req.(*UpdateClientAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetLastSelect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLastSelectReq)
+func _Data_DeleteClientAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClientAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetLastSelect(ctx, in)
+		return srv.(DataServer).DeleteClientAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetLastSelect_FullMethodName,
+		FullMethod: Data_DeleteClientAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetLastSelect(ctx, req.(*GetLastSelectReq))
+		return srv.(DataServer).DeleteClientAlertRule(ctx, req.(*DeleteClientAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetLastPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLastPublishReq)
+func _Data_ListClientAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientAlertRulesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetLastPublish(ctx, in)
+		return srv.(DataServer).ListClientAlertRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetLastPublish_FullMethodName,
+		FullMethod: Data_ListClientAlertRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetLastPublish(ctx, req.(*GetLastPublishReq))
+		return srv.(DataServer).ListClientAlertRules(ctx, req.(*ListClientAlertRulesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleasesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasesStatusReq)
+func _Data_SilenceClientAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SilenceClientAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleasesStatus(ctx, in)
+		return srv.(DataServer).SilenceClientAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleasesStatus_FullMethodName,
+		FullMethod: Data_SilenceClientAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleasesStatus(ctx, req.(*GetReleasesStatusReq))
+		return srv.(DataServer).SilenceClientAlertRule(ctx, req.(*SilenceClientAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GenerateReleaseAndPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateReleaseAndPublishReq)
+func _Data_AckClientAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckClientAlertReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GenerateReleaseAndPublish(ctx, in)
+		return srv.(DataServer).AckClientAlert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GenerateReleaseAndPublish_FullMethodName,
+		FullMethod: Data_AckClientAlert_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GenerateReleaseAndPublish(ctx, req.(*GenerateReleaseAndPublishReq))
+		return srv.(DataServer).AckClientAlert(ctx, req.(*AckClientAlertReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ApprovalCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApprovalCallbackReq)
+func _Data_ListClientAlertHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientAlertHistoriesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ApprovalCallback(ctx, in)
+		return srv.(DataServer).ListClientAlertHistories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ApprovalCallback_FullMethodName,
+		FullMethod: Data_ListClientAlertHistories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ApprovalCallback(ctx, req.(*ApprovalCallbackReq))
+		return srv.(DataServer).ListClientAlertHistories(ctx, req.(*ListClientAlertHistoriesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAuditsReq)
+func _Data_ListClientMetricTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientMetricTrendsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAudits(ctx, in)
+		return srv.(DataServer).ListClientMetricTrends(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAudits_FullMethodName,
+		FullMethod: Data_ListClientMetricTrends_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAudits(ctx, req.(*ListAuditsReq))
+		return srv.(DataServer).ListClientMetricTrends(ctx, req.(*ListClientMetricTrendsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCredentialReq)
+func _Data_CreateGitRepoLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGitRepoLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateCredential(ctx, in)
+		return srv.(DataServer).CreateGitRepoLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateCredential_FullMethodName,
+		FullMethod: Data_CreateGitRepoLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateCredential(ctx, req.(*CreateCredentialReq))
+		return srv.(DataServer).CreateGitRepoLink(ctx, req.(*CreateGitRepoLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCredentialReq)
+func _Data_UpdateGitRepoLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGitRepoLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListCredentials(ctx, in)
+		return srv.(DataServer).UpdateGitRepoLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListCredentials_FullMethodName,
+		FullMethod: Data_UpdateGitRepoLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListCredentials(ctx, req.(*ListCredentialReq))
+		return srv.(DataServer).UpdateGitRepoLink(ctx, req.(*UpdateGitRepoLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCredentialReq)
+func _Data_DeleteGitRepoLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGitRepoLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteCredential(ctx, in)
+		return srv.(DataServer).DeleteGitRepoLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteCredential_FullMethodName,
+		FullMethod: Data_DeleteGitRepoLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteCredential(ctx, req.(*DeleteCredentialReq))
+		return srv.(DataServer).DeleteGitRepoLink(ctx, req.(*DeleteGitRepoLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCredentialReq)
+func _Data_GetGitRepoLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGitRepoLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateCredential(ctx, in)
+		return srv.(DataServer).GetGitRepoLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateCredential_FullMethodName,
+		FullMethod: Data_GetGitRepoLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateCredential(ctx, req.(*UpdateCredentialReq))
+		return srv.(DataServer).GetGitRepoLink(ctx, req.(*GetGitRepoLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CheckCredentialName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckCredentialNameReq)
+func _Data_ListGitRepoLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGitRepoLinksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CheckCredentialName(ctx, in)
+		return srv.(DataServer).ListGitRepoLinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CheckCredentialName_FullMethodName,
+		FullMethod: Data_ListGitRepoLinks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CheckCredentialName(ctx, req.(*CheckCredentialNameReq))
+		return srv.(DataServer).ListGitRepoLinks(ctx, req.(*ListGitRepoLinksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCredentialScopesReq)
+func _Data_SyncGitRepoLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncGitRepoLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListCredentialScopes(ctx, in)
+		return srv.(DataServer).SyncGitRepoLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListCredentialScopes_FullMethodName,
+		FullMethod: Data_SyncGitRepoLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListCredentialScopes(ctx, req.(*ListCredentialScopesReq))
+		return srv.(DataServer).SyncGitRepoLink(ctx, req.(*SyncGitRepoLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCredentialScopesReq)
+func _Data_CreateChangeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChangeFreezeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateCredentialScopes(ctx, in)
+		return srv.(DataServer).CreateChangeFreeze(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateCredentialScopes_FullMethodName,
+		FullMethod: Data_CreateChangeFreeze_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateCredentialScopes(ctx, req.(*UpdateCredentialScopesReq))
+		return srv.(DataServer).CreateChangeFreeze(ctx, req.(*CreateChangeFreezeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CredentialScopePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CredentialScopePreviewReq)
+func _Data_UpdateChangeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChangeFreezeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CredentialScopePreview(ctx, in)
+		return srv.(DataServer).UpdateChangeFreeze(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CredentialScopePreview_FullMethodName,
+		FullMethod: Data_UpdateChangeFreeze_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CredentialScopePreview(ctx, req.(*CredentialScopePreviewReq))
+		return srv.(DataServer).UpdateChangeFreeze(ctx, req.(*UpdateChangeFreezeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
-
-func _Data_CreateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateKvReq)
+
+func _Data_DeleteChangeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChangeFreezeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateKv(ctx, in)
+		return srv.(DataServer).DeleteChangeFreeze(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateKv_FullMethodName,
+		FullMethod: Data_DeleteChangeFreeze_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateKv(ctx, req.(*CreateKvReq))
+		return srv.(DataServer).DeleteChangeFreeze(ctx, req.(*DeleteChangeFreezeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateKvReq)
+func _Data_GetChangeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangeFreezeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateKv(ctx, in)
+		return srv.(DataServer).GetChangeFreeze(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateKv_FullMethodName,
+		FullMethod: Data_GetChangeFreeze_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateKv(ctx, req.(*UpdateKvReq))
+		return srv.(DataServer).GetChangeFreeze(ctx, req.(*GetChangeFreezeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListKvsReq)
+func _Data_ListChangeFreezes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangeFreezesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListKvs(ctx, in)
+		return srv.(DataServer).ListChangeFreezes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListKvs_FullMethodName,
+		FullMethod: Data_ListChangeFreezes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListKvs(ctx, req.(*ListKvsReq))
+		return srv.(DataServer).ListChangeFreezes(ctx, req.(*ListChangeFreezesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteKvReq)
+func _Data_RecordChangeFreezeBreakGlass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordChangeFreezeBreakGlassReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteKv(ctx, in)
+		return srv.(DataServer).RecordChangeFreezeBreakGlass(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteKv_FullMethodName,
+		FullMethod: Data_RecordChangeFreezeBreakGlass_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteKv(ctx, req.(*DeleteKvReq))
+		return srv.(DataServer).RecordChangeFreezeBreakGlass(ctx, req.(*RecordChangeFreezeBreakGlassReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_BatchUpsertKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpsertKvsReq)
+func _Data_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).BatchUpsertKvs(ctx, in)
+		return srv.(DataServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_BatchUpsertKvs_FullMethodName,
+		FullMethod: Data_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).BatchUpsertKvs(ctx, req.(*BatchUpsertKvsReq))
+		return srv.(DataServer).CreateWebhook(ctx, req.(*CreateWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UnDeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnDeleteKvReq)
+func _Data_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UnDeleteKv(ctx, in)
+		return srv.(DataServer).UpdateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UnDeleteKv_FullMethodName,
+		FullMethod: Data_UpdateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UnDeleteKv(ctx, req.(*UnDeleteKvReq))
+		return srv.(DataServer).UpdateWebhook(ctx, req.(*UpdateWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UndoKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UndoKvReq)
+func _Data_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UndoKv(ctx, in)
+		return srv.(DataServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UndoKv_FullMethodName,
+		FullMethod: Data_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UndoKv(ctx, req.(*UndoKvReq))
+		return srv.(DataServer).DeleteWebhook(ctx, req.(*DeleteWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_KvFetchIDsExcluding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KvFetchIDsExcludingReq)
+func _Data_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).KvFetchIDsExcluding(ctx, in)
+		return srv.(DataServer).GetWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_KvFetchIDsExcluding_FullMethodName,
+		FullMethod: Data_GetWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).KvFetchIDsExcluding(ctx, req.(*KvFetchIDsExcludingReq))
+		return srv.(DataServer).GetWebhook(ctx, req.(*GetWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_KvFetchKeysExcluding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KvFetchKeysExcludingReq)
+func _Data_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).KvFetchKeysExcluding(ctx, in)
+		return srv.(DataServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_KvFetchKeysExcluding_FullMethodName,
+		FullMethod: Data_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).KvFetchKeysExcluding(ctx, req.(*KvFetchKeysExcludingReq))
+		return srv.(DataServer).ListWebhooks(ctx, req.(*ListWebhooksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_FindNearExpiryCertKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindNearExpiryCertKvsReq)
+func _Data_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).FindNearExpiryCertKvs(ctx, in)
+		return srv.(DataServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_FindNearExpiryCertKvs_FullMethodName,
+		FullMethod: Data_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).FindNearExpiryCertKvs(ctx, req.(*FindNearExpiryCertKvsReq))
+		return srv.(DataServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListClientsReq)
+func _Data_RedeliverWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookDeliveryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListClients(ctx, in)
+		return srv.(DataServer).RedeliverWebhookDelivery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListClients_FullMethodName,
+		FullMethod: Data_RedeliverWebhookDelivery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListClients(ctx, req.(*ListClientsReq))
+		return srv.(DataServer).RedeliverWebhookDelivery(ctx, req.(*RedeliverWebhookDeliveryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_RetryClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryClientsReq)
+func _Data_SetApprovalFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApprovalFlowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).RetryClients(ctx, in)
+		return srv.(DataServer).SetApprovalFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_RetryClients_FullMethodName,
+		FullMethod: Data_SetApprovalFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).RetryClients(ctx, req.(*RetryClientsReq))
+		return srv.(DataServer).SetApprovalFlow(ctx, req.(*SetApprovalFlowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListClientEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListClientEventsReq)
+func _Data_GetApprovalFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalFlowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListClientEvents(ctx, in)
+		return srv.(DataServer).GetApprovalFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListClientEvents_FullMethodName,
+		FullMethod: Data_GetApprovalFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListClientEvents(ctx, req.(*ListClientEventsReq))
+		return srv.(DataServer).GetApprovalFlow(ctx, req.(*GetApprovalFlowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListClientQuerys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListClientQuerysReq)
+func _Data_GetApprovalTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalTicketReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListClientQuerys(ctx, in)
+		return srv.(DataServer).GetApprovalTicket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListClientQuerys_FullMethodName,
+		FullMethod: Data_GetApprovalTicket_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListClientQuerys(ctx, req.(*ListClientQuerysReq))
+		return srv.(DataServer).GetApprovalTicket(ctx, req.(*GetApprovalTicketReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateClientQueryReq)
+func _Data_DelegateApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateApprovalReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateClientQuery(ctx, in)
+		return srv.(DataServer).DelegateApproval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateClientQuery_FullMethodName,
+		FullMethod: Data_DelegateApproval_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateClientQuery(ctx, req.(*CreateClientQueryReq))
+		return srv.(DataServer).DelegateApproval(ctx, req.(*DelegateApprovalReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateClientQueryReq)
+func _Data_CommentApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentApprovalReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateClientQuery(ctx, in)
+		return srv.(DataServer).CommentApproval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateClientQuery_FullMethodName,
+		FullMethod: Data_CommentApproval_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateClientQuery(ctx, req.(*UpdateClientQueryReq))
+		return srv.(DataServer).CommentApproval(ctx, req.(*CommentApprovalReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteClientQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteClientQueryReq)
+func _Data_ExportAppBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAppBundleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteClientQuery(ctx, in)
+		return srv.(DataServer).ExportAppBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteClientQuery_FullMethodName,
+		FullMethod: Data_ExportAppBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteClientQuery(ctx, req.(*DeleteClientQueryReq))
+		return srv.(DataServer).ExportAppBundle(ctx, req.(*ExportAppBundleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CheckClientQueryName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckClientQueryNameReq)
+func _Data_ImportAppBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAppBundleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CheckClientQueryName(ctx, in)
+		return srv.(DataServer).ImportAppBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CheckClientQueryName_FullMethodName,
+		FullMethod: Data_ImportAppBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CheckClientQueryName(ctx, req.(*CheckClientQueryNameReq))
+		return srv.(DataServer).ImportAppBundle(ctx, req.(*ImportAppBundleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ClientConfigVersionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Data_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ClientConfigVersionStatistics(ctx, in)
+		return srv.(DataServer).CreateRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ClientConfigVersionStatistics_FullMethodName,
+		FullMethod: Data_CreateRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ClientConfigVersionStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(DataServer).CreateRole(ctx, req.(*CreateRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ClientPullTrendStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Data_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ClientPullTrendStatistics(ctx, in)
+		return srv.(DataServer).UpdateRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ClientPullTrendStatistics_FullMethodName,
+		FullMethod: Data_UpdateRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ClientPullTrendStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(DataServer).UpdateRole(ctx, req.(*UpdateRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ClientPullStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Data_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ClientPullStatistics(ctx, in)
+		return srv.(DataServer).DeleteRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ClientPullStatistics_FullMethodName,
+		FullMethod: Data_DeleteRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ClientPullStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(DataServer).DeleteRole(ctx, req.(*DeleteRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ClientLabelStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Data_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ClientLabelStatistics(ctx, in)
+		return srv.(DataServer).ListRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ClientLabelStatistics_FullMethodName,
+		FullMethod: Data_ListRoles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ClientLabelStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(DataServer).ListRoles(ctx, req.(*ListRolesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ClientAnnotationStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Data_CreateRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ClientAnnotationStatistics(ctx, in)
+		return srv.(DataServer).CreateRoleBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ClientAnnotationStatistics_FullMethodName,
+		FullMethod: Data_CreateRoleBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ClientAnnotationStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(DataServer).CreateRoleBinding(ctx, req.(*CreateRoleBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ClientVersionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Data_DeleteRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ClientVersionStatistics(ctx, in)
+		return srv.(DataServer).DeleteRoleBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ClientVersionStatistics_FullMethodName,
+		FullMethod: Data_DeleteRoleBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ClientVersionStatistics(ctx, req.(*client.ClientCommonReq))
+		return srv.(DataServer).DeleteRoleBinding(ctx, req.(*DeleteRoleBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListClientLabelAndAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListClientLabelAndAnnotationReq)
+func _Data_ListRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleBindingsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListClientLabelAndAnnotation(ctx, in)
+		return srv.(DataServer).ListRoleBindings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListClientLabelAndAnnotation_FullMethodName,
+		FullMethod: Data_ListRoleBindings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListClientLabelAndAnnotation(ctx, req.(*ListClientLabelAndAnnotationReq))
+		return srv.(DataServer).ListRoleBindings(ctx, req.(*ListRoleBindingsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ClientSpecificFailedReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(client.ClientCommonReq)
+func _Data_ListRolePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolePoliciesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ClientSpecificFailedReason(ctx, in)
+		return srv.(DataServer).ListRolePolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ClientSpecificFailedReason_FullMethodName,
+		FullMethod: Data_ListRolePolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ClientSpecificFailedReason(ctx, req.(*client.ClientCommonReq))
+		return srv.(DataServer).ListRolePolicies(ctx, req.(*ListRolePoliciesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7449,6 +9334,24 @@ func _Data_PushConfig_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_RepushDriftedConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepushDriftedConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).RepushDriftedConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_RepushDriftedConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).RepushDriftedConfig(ctx, req.(*RepushDriftedConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_DeleteConfigTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteConfigTemplateReq)
 	if err := dec(in); err != nil {
@@ -7678,6 +9581,14 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckReleaseName",
 			Handler:    _Data_CheckReleaseName_Handler,
 		},
+		{
+			MethodName: "UpdateReleaseTags",
+			Handler:    _Data_UpdateReleaseTags_Handler,
+		},
+		{
+			MethodName: "PromoteRelease",
+			Handler:    _Data_PromoteRelease_Handler,
+		},
 		{
 			MethodName: "GetReleasedConfigItem",
 			Handler:    _Data_GetReleasedConfigItem_Handler,
@@ -7766,6 +9677,18 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReleaseHook",
 			Handler:    _Data_GetReleaseHook_Handler,
 		},
+		{
+			MethodName: "ImportTemplateSpaceHooks",
+			Handler:    _Data_ImportTemplateSpaceHooks_Handler,
+		},
+		{
+			MethodName: "ListTemplateSpaceHooks",
+			Handler:    _Data_ListTemplateSpaceHooks_Handler,
+		},
+		{
+			MethodName: "DeleteTemplateSpaceHook",
+			Handler:    _Data_DeleteTemplateSpaceHook_Handler,
+		},
 		{
 			MethodName: "CreateTemplateSpace",
 			Handler:    _Data_CreateTemplateSpace_Handler,
@@ -7974,6 +9897,18 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReleasedAppTmplVariables",
 			Handler:    _Data_ListReleasedAppTmplVariables_Handler,
 		},
+		{
+			MethodName: "UpdateGroupTmplVariables",
+			Handler:    _Data_UpdateGroupTmplVariables_Handler,
+		},
+		{
+			MethodName: "ListGroupTmplVariables",
+			Handler:    _Data_ListGroupTmplVariables_Handler,
+		},
+		{
+			MethodName: "TraceAppTmplVariables",
+			Handler:    _Data_TraceAppTmplVariables_Handler,
+		},
 		{
 			MethodName: "ListTmplBoundCounts",
 			Handler:    _Data_ListTmplBoundCounts_Handler,
@@ -8126,6 +10061,18 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateReleaseAndPublish",
 			Handler:    _Data_GenerateReleaseAndPublish_Handler,
 		},
+		{
+			MethodName: "RollbackRelease",
+			Handler:    _Data_RollbackRelease_Handler,
+		},
+		{
+			MethodName: "PinGroupRelease",
+			Handler:    _Data_PinGroupRelease_Handler,
+		},
+		{
+			MethodName: "UnpinGroupRelease",
+			Handler:    _Data_UnpinGroupRelease_Handler,
+		},
 		{
 			MethodName: "ApprovalCallback",
 			Handler:    _Data_ApprovalCallback_Handler,
@@ -8154,6 +10101,14 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckCredentialName",
 			Handler:    _Data_CheckCredentialName_Handler,
 		},
+		{
+			MethodName: "RotateCredential",
+			Handler:    _Data_RotateCredential_Handler,
+		},
+		{
+			MethodName: "BatchUpdateCredentialUsage",
+			Handler:    _Data_BatchUpdateCredentialUsage_Handler,
+		},
 		{
 			MethodName: "ListCredentialScopes",
 			Handler:    _Data_ListCredentialScopes_Handler,
@@ -8270,6 +10225,178 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClientSpecificFailedReason",
 			Handler:    _Data_ClientSpecificFailedReason_Handler,
 		},
+		{
+			MethodName: "ClientStageStatistics",
+			Handler:    _Data_ClientStageStatistics_Handler,
+		},
+		{
+			MethodName: "CreateClientAlertRule",
+			Handler:    _Data_CreateClientAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateClientAlertRule",
+			Handler:    _Data_UpdateClientAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteClientAlertRule",
+			Handler:    _Data_DeleteClientAlertRule_Handler,
+		},
+		{
+			MethodName: "ListClientAlertRules",
+			Handler:    _Data_ListClientAlertRules_Handler,
+		},
+		{
+			MethodName: "SilenceClientAlertRule",
+			Handler:    _Data_SilenceClientAlertRule_Handler,
+		},
+		{
+			MethodName: "AckClientAlert",
+			Handler:    _Data_AckClientAlert_Handler,
+		},
+		{
+			MethodName: "ListClientAlertHistories",
+			Handler:    _Data_ListClientAlertHistories_Handler,
+		},
+		{
+			MethodName: "ListClientMetricTrends",
+			Handler:    _Data_ListClientMetricTrends_Handler,
+		},
+		{
+			MethodName: "CreateGitRepoLink",
+			Handler:    _Data_CreateGitRepoLink_Handler,
+		},
+		{
+			MethodName: "UpdateGitRepoLink",
+			Handler:    _Data_UpdateGitRepoLink_Handler,
+		},
+		{
+			MethodName: "DeleteGitRepoLink",
+			Handler:    _Data_DeleteGitRepoLink_Handler,
+		},
+		{
+			MethodName: "GetGitRepoLink",
+			Handler:    _Data_GetGitRepoLink_Handler,
+		},
+		{
+			MethodName: "ListGitRepoLinks",
+			Handler:    _Data_ListGitRepoLinks_Handler,
+		},
+		{
+			MethodName: "SyncGitRepoLink",
+			Handler:    _Data_SyncGitRepoLink_Handler,
+		},
+		{
+			MethodName: "CreateChangeFreeze",
+			Handler:    _Data_CreateChangeFreeze_Handler,
+		},
+		{
+			MethodName: "UpdateChangeFreeze",
+			Handler:    _Data_UpdateChangeFreeze_Handler,
+		},
+		{
+			MethodName: "DeleteChangeFreeze",
+			Handler:    _Data_DeleteChangeFreeze_Handler,
+		},
+		{
+			MethodName: "GetChangeFreeze",
+			Handler:    _Data_GetChangeFreeze_Handler,
+		},
+		{
+			MethodName: "ListChangeFreezes",
+			Handler:    _Data_ListChangeFreezes_Handler,
+		},
+		{
+			MethodName: "RecordChangeFreezeBreakGlass",
+			Handler:    _Data_RecordChangeFreezeBreakGlass_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Data_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _Data_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Data_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _Data_GetWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Data_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Data_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhookDelivery",
+			Handler:    _Data_RedeliverWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "SetApprovalFlow",
+			Handler:    _Data_SetApprovalFlow_Handler,
+		},
+		{
+			MethodName: "GetApprovalFlow",
+			Handler:    _Data_GetApprovalFlow_Handler,
+		},
+		{
+			MethodName: "GetApprovalTicket",
+			Handler:    _Data_GetApprovalTicket_Handler,
+		},
+		{
+			MethodName: "DelegateApproval",
+			Handler:    _Data_DelegateApproval_Handler,
+		},
+		{
+			MethodName: "CommentApproval",
+			Handler:    _Data_CommentApproval_Handler,
+		},
+		{
+			MethodName: "ExportAppBundle",
+			Handler:    _Data_ExportAppBundle_Handler,
+		},
+		{
+			MethodName: "ImportAppBundle",
+			Handler:    _Data_ImportAppBundle_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _Data_CreateRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _Data_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _Data_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _Data_ListRoles_Handler,
+		},
+		{
+			MethodName: "CreateRoleBinding",
+			Handler:    _Data_CreateRoleBinding_Handler,
+		},
+		{
+			MethodName: "DeleteRoleBinding",
+			Handler:    _Data_DeleteRoleBinding_Handler,
+		},
+		{
+			MethodName: "ListRoleBindings",
+			Handler:    _Data_ListRoleBindings_Handler,
+		},
+		{
+			MethodName: "ListRolePolicies",
+			Handler:    _Data_ListRolePolicies_Handler,
+		},
 		{
 			MethodName: "ListInstances",
 			Handler:    _Data_ListInstances_Handler,
@@ -8418,6 +10545,10 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PushConfig",
 			Handler:    _Data_PushConfig_Handler,
 		},
+		{
+			MethodName: "RepushDriftedConfig",
+			Handler:    _Data_RepushDriftedConfig_Handler,
+		},
 		{
 			MethodName: "DeleteConfigTemplate",
 			Handler:    _Data_DeleteConfigTemplate_Handler,
@@ -0,0 +1,276 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credential
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gobwas/glob"
+)
+
+// Permission defines what a credential can do with the matched configs.
+type Permission string
+
+const (
+	// ReadOnly the credential can only pull the configs.
+	ReadOnly Permission = "read_only"
+	// Watch the credential can pull the configs and watch the config changes.
+	Watch Permission = "watch"
+)
+
+// Validate the permission is valid or not, empty permission means watch.
+func (p Permission) Validate() error {
+	switch p {
+	case "", ReadOnly, Watch:
+		return nil
+	default:
+		return fmt.Errorf("unsupported credential permission %s", p)
+	}
+}
+
+// MatchType defines how the kv key pattern is matched.
+type MatchType string
+
+const (
+	// GlobMatch match the kv key with glob pattern.
+	GlobMatch MatchType = "glob"
+	// RegexMatch match the kv key with regular expression.
+	RegexMatch MatchType = "regex"
+)
+
+// Validate the match type is valid or not, empty match type means glob.
+func (m MatchType) Validate() error {
+	switch m {
+	case "", GlobMatch, RegexMatch:
+		return nil
+	default:
+		return fmt.Errorf("unsupported kv key match type %s", m)
+	}
+}
+
+// LabelSelector defines the instance labels a credential may claim, the key is the label key and
+// the value is a glob pattern of the label value. The labels not in the selector are not restricted.
+type LabelSelector map[string]string
+
+// Validate the label selector is valid or not.
+func (ls LabelSelector) Validate() error {
+	for k, v := range ls {
+		if k == "" {
+			return errors.New("label key of credential scope is empty")
+		}
+
+		if _, err := compileGlob(v); err != nil {
+			return fmt.Errorf("invalid label %s value pattern %s, err: %v", k, v, err)
+		}
+	}
+
+	return nil
+}
+
+// Match returns whether the labels claimed by the instance are allowed.
+func (ls LabelSelector) Match(labels map[string]string) bool {
+	for k, v := range labels {
+		pattern, ok := ls[k]
+		if !ok {
+			continue
+		}
+
+		g, err := compileGlob(pattern)
+		if err != nil || !g.Match(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Value implements the driver.Valuer interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (ls LabelSelector) Value() (driver.Value, error) {
+	if ls == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(ls)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (ls *LabelSelector) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		if len(v) == 0 {
+			return nil
+		}
+		return json.Unmarshal(v, ls)
+	case string:
+		if v == "" {
+			return nil
+		}
+		return json.Unmarshal([]byte(v), ls)
+	default:
+		return fmt.Errorf("unsupported Scan type for LabelSelector")
+	}
+}
+
+// Rule is a fine-grained credential scope, besides the app and config item scope, it can restrict
+// the kv keys, the instance labels, the permission and the expiry time.
+type Rule struct {
+	Scope Scope `json:"scope"`
+	// KvKeyPattern restricts the kv keys, the config item part of the scope is used if it is empty.
+	KvKeyPattern   string        `json:"kv_key_pattern,omitempty"`
+	KvKeyMatchType MatchType     `json:"kv_key_match_type,omitempty"`
+	Labels         LabelSelector `json:"labels,omitempty"`
+	Permission     Permission    `json:"permission,omitempty"`
+	// ExpiredAt is the expiry time of the rule, nil means never expired.
+	ExpiredAt *time.Time `json:"expired_at,omitempty"`
+}
+
+// Validate the credential rule is valid or not.
+func (r *Rule) Validate() error {
+	if err := r.Scope.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.KvKeyMatchType.Validate(); err != nil {
+		return err
+	}
+
+	if r.KvKeyPattern != "" {
+		if _, err := compileKvPattern(r.KvKeyMatchType, r.KvKeyPattern); err != nil {
+			return fmt.Errorf("invalid kv key pattern %s, err: %v", r.KvKeyPattern, err)
+		}
+	}
+
+	if err := r.Labels.Validate(); err != nil {
+		return err
+	}
+
+	return r.Permission.Validate()
+}
+
+// IsExpired returns whether the rule is expired at the time.
+func (r *Rule) IsExpired(now time.Time) bool {
+	return r.ExpiredAt != nil && !r.ExpiredAt.IsZero() && !now.Before(*r.ExpiredAt)
+}
+
+// CanWatch returns whether the rule allows to watch the config changes.
+func (r *Rule) CanWatch() bool {
+	return r.Permission != ReadOnly
+}
+
+// MatchApp checks if the rule matches the app name.
+func (r *Rule) MatchApp(name string) bool {
+	ok, _ := r.Scope.MatchApp(name)
+	return ok
+}
+
+// MatchConfigItem checks if the rule matches the config item.
+func (r *Rule) MatchConfigItem(path, name string) bool {
+	ok, _ := r.Scope.MatchConfigItem(path, name)
+	return ok
+}
+
+// MatchKv checks if the rule matches the kv key.
+func (r *Rule) MatchKv(key string) bool {
+	matchType, pattern := r.KvKeyMatchType, r.KvKeyPattern
+	if pattern == "" {
+		// 兼容未设置 kv key 规则的关联规则, 使用配置项部分的 glob 匹配
+		_, scope, err := r.Scope.Split()
+		if err != nil {
+			return false
+		}
+		matchType, pattern = GlobMatch, strings.TrimPrefix(scope, "/")
+	}
+
+	m, err := compileKvPattern(matchType, pattern)
+	if err != nil {
+		return false
+	}
+
+	return m.Match(key)
+}
+
+// MatchLabels checks if the instance labels are allowed by the rule.
+func (r *Rule) MatchLabels(labels map[string]string) bool {
+	return r.Labels.Match(labels)
+}
+
+// matcher is the compiled pattern.
+type matcher interface {
+	Match(s string) bool
+}
+
+// regexpMatcher adapts regexp to matcher.
+type regexpMatcher struct {
+	re *regexp.Regexp
+}
+
+// Match implements matcher.
+func (m regexpMatcher) Match(s string) bool {
+	return m.re.MatchString(s)
+}
+
+// patterns caches the compiled patterns, the patterns are configured by users and the count is limited.
+var patterns sync.Map
+
+// compileKvPattern compile the kv key pattern with cache, the regex must match the whole key.
+func compileKvPattern(matchType MatchType, pattern string) (matcher, error) {
+	if matchType != RegexMatch {
+		return compileGlob(pattern)
+	}
+
+	key := string(RegexMatch) + ":" + pattern
+	if m, ok := patterns.Load(key); ok {
+		return m.(matcher), nil
+	}
+
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, err
+	}
+	m := regexpMatcher{re: re}
+	patterns.Store(key, m)
+
+	return m, nil
+}
+
+// compileGlob compile the glob pattern with cache.
+func compileGlob(pattern string) (matcher, error) {
+	key := string(GlobMatch) + ":" + pattern
+	if m, ok := patterns.Load(key); ok {
+		return m.(matcher), nil
+	}
+
+	g, err := glob.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns.Store(key, g)
+
+	return g, nil
+}
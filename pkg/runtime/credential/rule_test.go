@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package credential
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleValidation(t *testing.T) {
+	testCases := []struct {
+		rule      Rule
+		expectErr bool
+	}{
+		{Rule{Scope: "mysql/*"}, false},
+		{Rule{Scope: "mysql/*", KvKeyPattern: "db.*", KvKeyMatchType: GlobMatch}, false},
+		{Rule{Scope: "mysql/*", KvKeyPattern: "^db\\.[a-z]+$", KvKeyMatchType: RegexMatch}, false},
+		{Rule{Scope: "mysql/*", KvKeyPattern: "db.(", KvKeyMatchType: RegexMatch}, true},
+		{Rule{Scope: "mysql/*", KvKeyMatchType: "prefix"}, true},
+		{Rule{Scope: "mysql/*", Permission: ReadOnly}, false},
+		{Rule{Scope: "mysql/*", Permission: "write"}, true},
+		{Rule{Scope: "mysql/*", Labels: LabelSelector{"env": "prod-*"}}, false},
+		{Rule{Scope: "mysql/*", Labels: LabelSelector{"": "prod"}}, true},
+		{Rule{Scope: "invalid_scope"}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.rule.Validate()
+		if tc.expectErr {
+			assert.Error(t, err, fmt.Sprintf("rule: %+v", tc.rule))
+		} else {
+			assert.NoError(t, err, fmt.Sprintf("rule: %+v", tc.rule))
+		}
+	}
+}
+
+func TestRuleMatchKv(t *testing.T) {
+	testCases := []struct {
+		rule        Rule
+		key         string
+		expectMatch bool
+	}{
+		{Rule{Scope: "mysql/*"}, "db.host", true},
+		{Rule{Scope: "mysql/db*"}, "db.host", true},
+		{Rule{Scope: "mysql/db*"}, "cache.host", false},
+		{Rule{Scope: "mysql/*", KvKeyPattern: "db.*"}, "db.host", true},
+		{Rule{Scope: "mysql/*", KvKeyPattern: "db.*"}, "cache.host", false},
+		{Rule{Scope: "mysql/*", KvKeyPattern: "^db\\.[a-z]+$", KvKeyMatchType: RegexMatch}, "db.host", true},
+		{Rule{Scope: "mysql/*", KvKeyPattern: "^db\\.[a-z]+$", KvKeyMatchType: RegexMatch}, "db.host1", false},
+		{Rule{Scope: "mysql/*", KvKeyPattern: "prod", KvKeyMatchType: RegexMatch}, "prod", true},
+		{Rule{Scope: "mysql/*", KvKeyPattern: "prod", KvKeyMatchType: RegexMatch}, "nonprod_secret", false},
+	}
+
+	for _, tc := range testCases {
+		match := tc.rule.MatchKv(tc.key)
+		assert.Equal(t, tc.expectMatch, match, fmt.Sprintf("rule: %+v, key: %s", tc.rule, tc.key))
+	}
+}
+
+func TestRuleMatchLabels(t *testing.T) {
+	testCases := []struct {
+		selector    LabelSelector
+		labels      map[string]string
+		expectMatch bool
+	}{
+		{nil, map[string]string{"env": "prod"}, true},
+		{LabelSelector{"env": "prod-*"}, map[string]string{"env": "prod-sh"}, true},
+		{LabelSelector{"env": "prod-*"}, map[string]string{"env": "test"}, false},
+		{LabelSelector{"env": "prod-*"}, map[string]string{"env": "prod-sh", "zone": "a"}, true},
+		{LabelSelector{"env": "prod-*"}, map[string]string{"zone": "a"}, true},
+	}
+
+	for _, tc := range testCases {
+		r := Rule{Scope: "mysql/*", Labels: tc.selector}
+		match := r.MatchLabels(tc.labels)
+		assert.Equal(t, tc.expectMatch, match, fmt.Sprintf("selector: %v, labels: %v", tc.selector, tc.labels))
+	}
+}
+
+func TestRuleExpiredAndPermission(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	assert.False(t, (&Rule{Scope: "mysql/*"}).IsExpired(now))
+	assert.True(t, (&Rule{Scope: "mysql/*", ExpiredAt: &past}).IsExpired(now))
+	assert.False(t, (&Rule{Scope: "mysql/*", ExpiredAt: &future}).IsExpired(now))
+
+	assert.True(t, (&Rule{Scope: "mysql/*"}).CanWatch())
+	assert.True(t, (&Rule{Scope: "mysql/*", Permission: Watch}).CanWatch())
+	assert.False(t, (&Rule{Scope: "mysql/*", Permission: ReadOnly}).CanWatch())
+}
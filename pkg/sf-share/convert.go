@@ -71,6 +71,7 @@ func (v *VersionChangePayload) PbClientEventMetric() (*pbce.ClientEvent, error)
 	if v == nil {
 		return nil, errors.New("VersionChangePayload is nil, can not be convert to proto")
 	}
+	stages := pbStageEvents(v.Application.Stages)
 	data := &pbce.ClientEvent{
 		Spec: &pbce.ClientEventSpec{
 			OriginalReleaseId:         v.Application.CurrentReleaseID,
@@ -86,6 +87,8 @@ func (v *VersionChangePayload) PbClientEventMetric() (*pbce.ClientEvent, error)
 			ReleaseChangeFailedReason: v.Application.FailedReason.String(),
 			FailedDetailReason:        v.Application.FailedDetailReason,
 			SpecificFailedReason:      v.Application.SpecificFailedReason.String(),
+			CurrentStage:              currentStage(stages),
+			Stages:                    stages,
 		},
 		Attachment: &pbce.ClientEventAttachment{
 			Uid:        v.Application.Uid,
@@ -168,6 +171,32 @@ func (h *HeartbeatItem) PbClientEventMetric() (*pbce.ClientEvent, error) {
 	return data, nil
 }
 
+// currentStage 时间线中最后一个阶段即为客户端当前所处的阶段
+func currentStage(stages []*pbce.ClientEventStage) string {
+	if len(stages) == 0 {
+		return ""
+	}
+	return stages[len(stages)-1].Stage
+}
+
+// pbStageEvents convert stage events to client event stage proto
+func pbStageEvents(stages []StageEvent) []*pbce.ClientEventStage {
+	result := make([]*pbce.ClientEventStage, 0, len(stages))
+	for _, v := range stages {
+		if err := v.Stage.Validate(); err != nil {
+			continue
+		}
+		result = append(result, &pbce.ClientEventStage{
+			Stage:     v.Stage.String(),
+			Status:    v.Status.String(),
+			StartTime: timestamppb.New(v.StartTime),
+			EndTime:   timestamppb.New(v.EndTime),
+			Seconds:   v.Seconds(),
+		})
+	}
+	return result
+}
+
 func toString(label interface{}) string {
 	marshal, err := jsoni.Marshal(label)
 	if err != nil {
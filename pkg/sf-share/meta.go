@@ -22,6 +22,7 @@ import (
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/uuid"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
 )
@@ -31,6 +32,8 @@ import (
 type SidecarMetaHeader struct {
 	BizID       uint32 `json:"bid"`
 	Fingerprint string `json:"fpt"`
+	// Constraint is the constraint of the releases which the sidecar accepts, nil means accepts all the releases.
+	Constraint *ReleaseConstraint `json:"rc,omitempty"`
 }
 
 // Validate the sidecar meta header is valid or not.
@@ -43,9 +46,52 @@ func (sm SidecarMetaHeader) Validate() error {
 		return errors.New("invalid fingerprint")
 	}
 
+	if sm.Constraint != nil {
+		if err := sm.Constraint.Validate(); err != nil {
+			return fmt.Errorf("invalid release constraint, err: %v", err)
+		}
+	}
+
 	return nil
 }
 
+// ReleaseConstraint defines the constraint of the releases which the client accepts, it is declared by the client
+// with the sidecar metadata, which is the only source of the constraint for the watches and the pulls. The client
+// stays on its current release if the matched release does not satisfy it, the watch is not notified and the pull
+// fails with the errf.ReleaseConstraintNotSatisfied code.
+type ReleaseConstraint struct {
+	// Tags the release should have all the tags, e.g. only the releases tagged with stable are accepted.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Validate the release constraint is valid or not.
+func (rc *ReleaseConstraint) Validate() error {
+	return table.ValidateReleaseTags(rc.Tags)
+}
+
+// IsEmpty returns whether the release constraint accepts all the releases.
+func (rc *ReleaseConstraint) IsEmpty() bool {
+	return rc == nil || len(rc.Tags) == 0
+}
+
+// Satisfied returns whether the release with the tags satisfies the constraint.
+func (rc *ReleaseConstraint) Satisfied(tags []string) bool {
+	if rc.IsEmpty() {
+		return true
+	}
+
+	return table.ContainsAllTags(tags, rc.Tags)
+}
+
+// String returns the release constraint's description.
+func (rc *ReleaseConstraint) String() string {
+	if rc.IsEmpty() {
+		return "none"
+	}
+
+	return "tags: " + strings.Join(rc.Tags, ",")
+}
+
 // IncomingMeta defines metadata parsed from incoming request from sidecar.
 type IncomingMeta struct {
 	Kit  *kit.Kit
@@ -0,0 +1,62 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+)
+
+func TestReleaseConstraint(t *testing.T) {
+	cases := []struct {
+		name       string
+		constraint *ReleaseConstraint
+		tags       []string
+		satisfied  bool
+	}{
+		{name: "no constraint", constraint: nil, tags: nil, satisfied: true},
+		{name: "empty constraint", constraint: &ReleaseConstraint{}, tags: []string{"beta"}, satisfied: true},
+		{name: "has all tags", constraint: &ReleaseConstraint{Tags: []string{"stable", "lts"}},
+			tags: []string{"lts", "beta", "stable"}, satisfied: true},
+		{name: "lack of tag", constraint: &ReleaseConstraint{Tags: []string{"stable", "lts"}},
+			tags: []string{"stable"}, satisfied: false},
+		{name: "release without tags", constraint: &ReleaseConstraint{Tags: []string{"stable"}},
+			tags: nil, satisfied: false},
+	}
+
+	for _, c := range cases {
+		if got := c.constraint.Satisfied(c.tags); got != c.satisfied {
+			t.Errorf("%s: expect satisfied %v, got %v", c.name, c.satisfied, got)
+		}
+	}
+}
+
+func TestSidecarMetaHeaderConstraint(t *testing.T) {
+	sm := new(SidecarMetaHeader)
+	if err := jsoni.UnmarshalFromString(`{"bid":2,"fpt":"fp","rc":{"tags":["stable"]}}`, sm); err != nil {
+		t.Fatalf("unmarshal sidecar meta header failed, err: %v", err)
+	}
+
+	if err := sm.Validate(); err != nil {
+		t.Errorf("validate sidecar meta header failed, err: %v", err)
+	}
+	if !sm.Constraint.Satisfied([]string{"stable"}) || sm.Constraint.Satisfied([]string{"beta"}) {
+		t.Errorf("unexpected constraint: %s", sm.Constraint)
+	}
+
+	sm.Constraint.Tags = []string{"stable", "stable"}
+	if err := sm.Validate(); err == nil {
+		t.Errorf("expect duplicated tags of the constraint are invalid")
+	}
+}
@@ -161,6 +161,8 @@ type SideAppMeta struct {
 	StartTime            time.Time            `json:"startTime"`
 	EndTime              time.Time            `json:"endTime"`
 	TotalSeconds         float64              `json:"totalSeconds"`
+	// Stages 版本变更各阶段的时间线，按阶段发生的先后顺序排列
+	Stages []StageEvent `json:"stages,omitempty"`
 }
 
 // Validate the sidecar's app meta is valid or not.
@@ -235,6 +237,8 @@ type InstanceSpec struct {
 	// Match is app config item's match conditions
 	Match      []string         `json:"match"`
 	ConfigType table.ConfigType `json:"configType"`
+	// Constraint is the constraint of the releases which the instance accepts
+	Constraint *ReleaseConstraint `json:"constraint,omitempty"`
 }
 
 // Validate the instance spec is valid or not
@@ -423,6 +427,9 @@ func (rc *ReleaseChangePayload) Encode() ([]byte, error) {
 type SidecarHandshakePayload struct {
 	ServiceInfo   *ServiceInfo          `json:"serviceInfo"`
 	RuntimeOption *SidecarRuntimeOption `json:"runtimeOption"`
+	// ReleaseConstraint is the release constraint declared by the sidecar and accepted by the upstream server,
+	// it is nil if the sidecar does not declare it.
+	ReleaseConstraint *ReleaseConstraint `json:"releaseConstraint,omitempty"`
 }
 
 // SidecarRuntimeOption defines the sidecar's runtime options delivered from the
@@ -698,6 +705,63 @@ func (cm ClientMode) String() string {
 	}
 }
 
+// ReleaseChangeStage 客户端版本变更阶段
+type ReleaseChangeStage string
+
+const (
+	// Notified 收到版本变更通知
+	Notified ReleaseChangeStage = "notified"
+	// Downloading 下载配置文件
+	Downloading ReleaseChangeStage = "downloading"
+	// PreHook 执行前置脚本
+	PreHook ReleaseChangeStage = "pre_hook"
+	// Written 配置写入本地
+	Written ReleaseChangeStage = "written"
+	// PostHook 执行后置脚本
+	PostHook ReleaseChangeStage = "post_hook"
+	// Reloaded 业务进程重载配置
+	Reloaded ReleaseChangeStage = "reloaded"
+)
+
+// ReleaseChangeStages 版本变更的全部阶段，按先后顺序排列
+var ReleaseChangeStages = []ReleaseChangeStage{Notified, Downloading, PreHook, Written, PostHook, Reloaded}
+
+// Validate the release change stage is valid or not.
+func (rs ReleaseChangeStage) Validate() error {
+	switch rs {
+	case Notified:
+	case Downloading:
+	case PreHook:
+	case Written:
+	case PostHook:
+	case Reloaded:
+	default:
+		return fmt.Errorf("unknown %s sidecar release change stage", rs)
+	}
+	return nil
+}
+
+// String return the corresponding string type
+func (rs ReleaseChangeStage) String() string {
+	return string(rs)
+}
+
+// StageEvent 版本变更中单个阶段的事件
+type StageEvent struct {
+	Stage     ReleaseChangeStage `json:"stage"`
+	Status    Status             `json:"status"`
+	StartTime time.Time          `json:"startTime"`
+	EndTime   time.Time          `json:"endTime"`
+}
+
+// Seconds 阶段耗时，阶段未结束时返回0
+func (se StageEvent) Seconds() float64 {
+	if se.EndTime.IsZero() || se.EndTime.Before(se.StartTime) {
+		return 0
+	}
+	return se.EndTime.Sub(se.StartTime).Seconds()
+}
+
 // ClientMetricData feed-server 和 cache-service 通信的结构体
 type ClientMetricData struct {
 	MessagingType uint32
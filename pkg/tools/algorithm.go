@@ -130,3 +130,46 @@ func AesDecrypt(crypted string, key []byte) (string, error) {
 	origData = PKCS7UnPadding(origData)
 	return string(origData), nil
 }
+
+// AesGcmEncrypt AES加密,GCM, 随机的 nonce 放在密文之前
+func AesGcmEncrypt(origData, key []byte) (string, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+	crypted := gcm.Seal(nonce, nonce, origData, nil)
+	return base64.StdEncoding.EncodeToString(crypted), nil
+}
+
+// AesGcmDecrypt AES解密,GCM, 密钥错误或密文被篡改时返回错误
+func AesGcmDecrypt(crypted string, key []byte) (string, error) {
+	b64Byte, err := base64.StdEncoding.DecodeString(crypted)
+	if err != nil {
+		return "", err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+	if len(b64Byte) < gcm.NonceSize() {
+		return "", fmt.Errorf("invalid ciphertext")
+	}
+	nonce, data := b64Byte[:gcm.NonceSize()], b64Byte[gcm.NonceSize():]
+	origData, err := gcm.Open(nil, nonce, data, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(origData), nil
+}
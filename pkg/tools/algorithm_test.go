@@ -74,3 +74,34 @@ func TestEnDecryptCredential(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestAesGcmEnDecrytion(t *testing.T) {
+	priKey, err := randStr(32)
+	if err != nil {
+		t.Errorf("randStr err: %s\n", err.Error())
+	}
+	oriStr, err := randStr(32)
+	if err != nil {
+		t.Errorf("randStr err: %s\n", err.Error())
+	}
+	encrypted, err := AesGcmEncrypt([]byte(oriStr), []byte(priKey))
+	if err != nil {
+		t.Errorf("encrypt err: %s\n", err.Error())
+	}
+
+	original, err := AesGcmDecrypt(encrypted, []byte(priKey))
+	if err != nil {
+		t.Errorf("decrypt err: %s\n", err.Error())
+	}
+	if original != oriStr {
+		t.Errorf("Decryption Error, old: %s, new: %s", oriStr, original)
+	}
+
+	otherKey, err := randStr(32)
+	if err != nil {
+		t.Errorf("randStr err: %s\n", err.Error())
+	}
+	if _, err = AesGcmDecrypt(encrypted, []byte(otherKey)); err == nil {
+		t.Errorf("decrypt with wrong key should fail")
+	}
+}
@@ -0,0 +1,290 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+const (
+	// AppBundleVersion is the version of the app bundle format, the bundle of other versions is refused to import.
+	AppBundleVersion = "v1"
+	// AppBundleManifestName is the file name of the manifest in the app bundle archive.
+	AppBundleManifestName = "bundle.json"
+	// AppBundleContentDir is the directory of the config item contents in the app bundle archive,
+	// each content is named by its sha256 signature.
+	AppBundleContentDir = "contents"
+
+	// appBundleSecretCheck is encrypted into the bundle to verify the passphrase before import.
+	appBundleSecretCheck = "bk-bscp-app-bundle"
+	// appBundleKdfIterations is the iterations of pbkdf2 to derive the key of secret values from the passphrase.
+	appBundleKdfIterations = 600000
+	appBundleSaltSize      = 16
+)
+
+// AppBundle is the manifest of the exported app, which contains everything to rebuild the app in
+// another biz or environment. The ids in the bundle are the ids of the source, they are remapped to
+// the resources matched or created by name when imported.
+type AppBundle struct {
+	Version    string    `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	// SecretCheck is present when the secret values are included, which is used to check the passphrase.
+	SecretCheck string `json:"secret_check,omitempty"`
+	// SecretSalt is the base64 encoded random salt to derive the key of the secret values from the passphrase.
+	SecretSalt       string                      `json:"secret_salt,omitempty"`
+	App              *AppBundleApp               `json:"app"`
+	ConfigItems      []*AppBundleConfigItem      `json:"config_items,omitempty"`
+	Kvs              []*AppBundleKv              `json:"kvs,omitempty"`
+	Hooks            []*AppBundleHook            `json:"hooks,omitempty"`
+	TemplateBindings []*AppBundleTemplateBinding `json:"template_bindings,omitempty"`
+	Variables        []*AppBundleVariable        `json:"variables,omitempty"`
+	Groups           []*AppBundleGroup           `json:"groups,omitempty"`
+
+	// secretKey is the key derived from the passphrase, which is set by SealSecrets or CheckPassphrase.
+	secretKey []byte
+}
+
+// AppBundleApp is the exported app.
+type AppBundleApp struct {
+	ID    uint32         `json:"id"`
+	BizID uint32         `json:"biz_id"`
+	Spec  *table.AppSpec `json:"spec"`
+}
+
+// AppBundleConfigItem is the exported config item with its permission and the spec of the content.
+type AppBundleConfigItem struct {
+	ID      uint32                `json:"id"`
+	Spec    *table.ConfigItemSpec `json:"spec"`
+	Content *table.ContentSpec    `json:"content"`
+}
+
+// AppBundleKv is the exported kv, the value of secret kv is only kept encrypted.
+type AppBundleKv struct {
+	ID             uint32        `json:"id"`
+	Spec           *table.KvSpec `json:"spec"`
+	Value          string        `json:"value,omitempty"`
+	EncryptedValue string        `json:"encrypted_value,omitempty"`
+}
+
+// AppBundleHook is the hook bound by the app with all of its revisions.
+type AppBundleHook struct {
+	ID        uint32                    `json:"id"`
+	HookType  table.HookType            `json:"hook_type"`
+	Spec      *table.HookSpec           `json:"spec"`
+	Revisions []*table.HookRevisionSpec `json:"revisions"`
+	// Deployed is the name of the revision used by the app.
+	Deployed string `json:"deployed"`
+	// Params are the param values bound by the app.
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// AppBundleTemplateBinding is the template set bound by the app, which is referenced by name
+// because the template space is shared by the apps of the biz and is not exported.
+type AppBundleTemplateBinding struct {
+	TemplateSpace string               `json:"template_space"`
+	TemplateSet   string               `json:"template_set"`
+	Templates     []*AppBundleTemplate `json:"templates"`
+}
+
+// AppBundleTemplate is the template bound in the template set.
+type AppBundleTemplate struct {
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	Revision string `json:"revision"`
+	IsLatest bool   `json:"is_latest"`
+}
+
+// AppBundleVariable is the template variable overridden by the app or its group, the value of secret
+// variable is only kept encrypted.
+type AppBundleVariable struct {
+	Spec           *table.TemplateVariableSpec `json:"spec"`
+	EncryptedValue string                      `json:"encrypted_value,omitempty"`
+}
+
+// AppBundleGroup is the group which the app can be released to, with the variables overridden by it.
+type AppBundleGroup struct {
+	ID        uint32               `json:"id"`
+	Spec      *table.GroupSpec     `json:"spec"`
+	Variables []*AppBundleVariable `json:"variables,omitempty"`
+}
+
+// Validate is used to validate the effectiveness of the AppBundle structure.
+func (b *AppBundle) Validate() error {
+	if b.Version != AppBundleVersion {
+		return fmt.Errorf("unsupported bundle version %s, should be %s", b.Version, AppBundleVersion)
+	}
+
+	if b.App == nil || b.App.Spec == nil {
+		return errors.New("app of the bundle is required")
+	}
+
+	for _, ci := range b.ConfigItems {
+		if ci.Spec == nil || ci.Content == nil || ci.Content.Signature == "" {
+			return errors.New("spec and content of the config item are required")
+		}
+	}
+
+	for _, kv := range b.Kvs {
+		if kv.Spec == nil {
+			return errors.New("spec of the kv is required")
+		}
+	}
+
+	for _, h := range b.Hooks {
+		if h.Spec == nil {
+			return errors.New("spec of the hook is required")
+		}
+	}
+
+	for _, g := range b.Groups {
+		if g.Spec == nil {
+			return errors.New("spec of the group is required")
+		}
+	}
+
+	return nil
+}
+
+// SealSecrets prepares the bundle to carry the secret values encrypted by the key derived from the
+// passphrase with a random salt.
+func (b *AppBundle) SealSecrets(passphrase string) error {
+	if passphrase == "" {
+		return errors.New("passphrase is required to encrypt the secret values")
+	}
+
+	salt := make([]byte, appBundleSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	key, err := deriveBundleKey(passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	check, err := tools.AesGcmEncrypt([]byte(appBundleSecretCheck), key)
+	if err != nil {
+		return err
+	}
+	b.SecretSalt = base64.StdEncoding.EncodeToString(salt)
+	b.SecretCheck = check
+	b.secretKey = key
+	return nil
+}
+
+// CheckPassphrase checks whether the passphrase is the one which the secret values are encrypted with,
+// the secret values can be decrypted after it passes.
+func (b *AppBundle) CheckPassphrase(passphrase string) error {
+	if b.SecretCheck == "" || b.SecretSalt == "" {
+		return errors.New("the bundle does not include secret values")
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(b.SecretSalt)
+	if err != nil || len(salt) == 0 {
+		return errors.New("the secret salt of the bundle is invalid")
+	}
+	key, err := deriveBundleKey(passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	check, err := tools.AesGcmDecrypt(b.SecretCheck, key)
+	if err != nil || check != appBundleSecretCheck {
+		return errors.New("the passphrase of the bundle is wrong")
+	}
+	b.secretKey = key
+
+	return nil
+}
+
+// EncryptSecret encrypts the secret value in the app bundle, the bundle should be sealed before.
+func (b *AppBundle) EncryptSecret(value string) (string, error) {
+	if len(b.secretKey) == 0 {
+		return "", errors.New("the bundle is not sealed to carry the secret values")
+	}
+
+	return tools.AesGcmEncrypt([]byte(value), b.secretKey)
+}
+
+// DecryptSecret decrypts the secret value in the app bundle, the passphrase should be checked before.
+func (b *AppBundle) DecryptSecret(encrypted string) (string, error) {
+	if len(b.secretKey) == 0 {
+		return "", errors.New("passphrase is required to decrypt the secret values")
+	}
+
+	return tools.AesGcmDecrypt(encrypted, b.secretKey)
+}
+
+// deriveBundleKey derives the aes-256 key of the secret values from the passphrase with pbkdf2.
+func deriveBundleKey(passphrase string, salt []byte) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errors.New("passphrase is required to derive the key of the secret values")
+	}
+
+	return pbkdf2.Key(sha256.New, passphrase, salt, appBundleKdfIterations, 32)
+}
+
+// BundleConflictPolicy defines how to handle the resource of the bundle which already exists when imported.
+type BundleConflictPolicy string
+
+const (
+	// BundleConflictSkip keeps the existing resource unchanged.
+	BundleConflictSkip BundleConflictPolicy = "skip"
+	// BundleConflictOverwrite overwrites the existing resource with the one in the bundle.
+	BundleConflictOverwrite BundleConflictPolicy = "overwrite"
+	// BundleConflictRename creates the resource with a new name which does not exist.
+	BundleConflictRename BundleConflictPolicy = "rename"
+)
+
+// Validate the bundle conflict policy is valid or not.
+func (p BundleConflictPolicy) Validate() error {
+	switch p {
+	case BundleConflictSkip, BundleConflictOverwrite, BundleConflictRename:
+	default:
+		return fmt.Errorf("unsupported conflict policy: %s", p)
+	}
+
+	return nil
+}
+
+// BundleImportAction defines what is done to the resource of the bundle when imported.
+type BundleImportAction string
+
+const (
+	// BundleImportCreate means the resource is created.
+	BundleImportCreate BundleImportAction = "create"
+	// BundleImportOverwrite means the existing resource is overwritten.
+	BundleImportOverwrite BundleImportAction = "overwrite"
+	// BundleImportRename means the resource is created with a new name.
+	BundleImportRename BundleImportAction = "rename"
+	// BundleImportSkip means the resource is not imported.
+	BundleImportSkip BundleImportAction = "skip"
+)
+
+// RenameForBundle returns the first name with the numeric suffix which does not exist.
+func RenameForBundle(name string, exists func(string) bool) string {
+	for i := 1; ; i++ {
+		renamed := fmt.Sprintf("%s_%d", name, i)
+		if !exists(renamed) {
+			return renamed
+		}
+	}
+}
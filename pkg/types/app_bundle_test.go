@@ -0,0 +1,83 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"testing"
+)
+
+func TestAppBundleSecrets(t *testing.T) {
+	b := &AppBundle{Version: AppBundleVersion}
+	if err := b.CheckPassphrase("pass"); err == nil {
+		t.Error("check passphrase of the bundle without secrets, an error should be occurred.")
+		return
+	}
+
+	if err := b.SealSecrets("pass"); err != nil {
+		t.Errorf("seal secrets failed, err: %v", err)
+		return
+	}
+	if err := b.CheckPassphrase("pass"); err != nil {
+		t.Errorf("check the right passphrase failed, err: %v", err)
+		return
+	}
+	if err := b.CheckPassphrase("wrong"); err == nil {
+		t.Error("check the wrong passphrase, an error should be occurred.")
+		return
+	}
+
+	encrypted, err := b.EncryptSecret("secret value")
+	if err != nil {
+		t.Errorf("encrypt secret failed, err: %v", err)
+		return
+	}
+
+	// the bundle is imported from the manifest, the key is derived again with the salt in it.
+	imported := &AppBundle{Version: AppBundleVersion, SecretCheck: b.SecretCheck, SecretSalt: b.SecretSalt}
+	if _, err = imported.DecryptSecret(encrypted); err == nil {
+		t.Error("decrypt secret before checking the passphrase, an error should be occurred.")
+		return
+	}
+	if err = imported.CheckPassphrase("pass"); err != nil {
+		t.Errorf("check the right passphrase of the imported bundle failed, err: %v", err)
+		return
+	}
+	val, err := imported.DecryptSecret(encrypted)
+	if err != nil || val != "secret value" {
+		t.Errorf("decrypt secret got %s, err: %v", val, err)
+		return
+	}
+
+	// the salt is random, the same passphrase derives different keys for different bundles.
+	another := &AppBundle{Version: AppBundleVersion}
+	if err = another.SealSecrets("pass"); err != nil {
+		t.Errorf("seal secrets failed, err: %v", err)
+		return
+	}
+	if another.SecretSalt == b.SecretSalt {
+		t.Error("the salts of different bundles should be different")
+		return
+	}
+	if _, err = another.DecryptSecret(encrypted); err == nil {
+		t.Error("decrypt secret with the key of another bundle, an error should be occurred.")
+		return
+	}
+}
+
+func TestRenameForBundle(t *testing.T) {
+	existing := map[string]bool{"app": true, "app_1": true}
+	got := RenameForBundle("app", func(name string) bool { return existing[name] })
+	if got != "app_2" {
+		t.Errorf("rename for bundle, expect app_2, but got %s", got)
+	}
+}
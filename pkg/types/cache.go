@@ -13,16 +13,13 @@
 package types
 
 import (
-	"fmt"
-	"strings"
 	"time"
 
-	"github.com/gobwas/glob"
 	"github.com/samber/lo"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/credential"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
-	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 )
 
 // AppCacheMeta defines app's basic meta info
@@ -46,6 +43,22 @@ type ReleasedGroupCache struct {
 	UID        string             `db:"uid" json:"uid"`
 	BizID      uint32             `db:"biz_id" json:"biz_id"`
 	UpdatedAt  time.Time          `db:"updated_at" json:"updated_at"`
+	// PinnedReleaseID 分组被固定的版本，0 表示未固定
+	PinnedReleaseID uint32     `db:"pinned_release_id" json:"pinned_release_id"`
+	PinnedUntil     *time.Time `db:"pinned_until" json:"pinned_until"`
+	// ReleaseTags 和 PinnedReleaseTags 分别为发布的版本和固定的版本的标签，用于校验客户端声明的版本约束
+	ReleaseTags       []string `json:"release_tags,omitempty"`
+	PinnedReleaseTags []string `json:"pinned_release_tags,omitempty"`
+}
+
+// EffectiveRelease returns the release and its tags which the clients in the group should use at the given time,
+// it's the pinned release if the group is pinned, otherwise the published release.
+func (r *ReleasedGroupCache) EffectiveRelease(now time.Time) (uint32, []string) {
+	if r.PinnedReleaseID != 0 && (r.PinnedUntil == nil || r.PinnedUntil.After(now)) {
+		return r.PinnedReleaseID, r.PinnedReleaseTags
+	}
+
+	return r.ReleaseID, r.ReleaseTags
 }
 
 // EventMeta is an event's meta info which is used by feed server to gc cache.
@@ -66,6 +79,17 @@ type ReleaseCICache struct {
 	ConfigItemSpec *ConfigItemSpecCache        `json:"ispec"`
 	Attachment     *table.ConfigItemAttachment `json:"am"`
 	Revision       *table.Revision             `json:"revision"`
+	// GroupCommitSpecs is group id => the content rendered with the template variables overridden by the group
+	GroupCommitSpecs map[uint32]*CommitSpecCache `json:"gcspec,omitempty"`
+}
+
+// CommitSpecOf returns the commit spec of the config item delivered to the instances matched by the group,
+// which is the content rendered with the group's template variables if it exists.
+func (r *ReleaseCICache) CommitSpecOf(groupID uint32) *CommitSpecCache {
+	if spec, ok := r.GroupCommitSpecs[groupID]; ok {
+		return spec
+	}
+	return r.CommitSpec
 }
 
 // ReleaseKvCache is the release kv info which will be stored in cache.
@@ -129,88 +153,110 @@ type FilePermissionCache struct {
 
 // CredentialCache cache struct.
 type CredentialCache struct {
-	Enabled      bool     `json:"enabled"`
-	Scope        []string `json:"scope"`
-	scopeMap     map[string][]string
-	isPreprocess bool
+	ID      uint32 `json:"id"`
+	Enabled bool   `json:"enabled"`
+	// ExpiredAt is the expiry of the token, nil means never expires.
+	ExpiredAt *time.Time `json:"expired_at"`
+	// Scope is the app and config item scopes, it is kept for the credentials cached by the old version.
+	Scope []string `json:"scope"`
+	// Rules is the fine-grained scopes of the credential.
+	Rules []*credential.Rule `json:"rules"`
 }
 
-// preprocess 预处理数据结构, 格式化为app:scope, 方便鉴权处理
-func (c *CredentialCache) preprocess() {
-	if c.isPreprocess {
-		return
+// NewCredentialCache build the credential cache with the credential and its scopes.
+func NewCredentialCache(cred *table.Credential, scopes []*table.CredentialScope) *CredentialCache {
+	c := &CredentialCache{
+		ID:        cred.ID,
+		Enabled:   cred.Spec.Enable,
+		ExpiredAt: cred.Spec.ExpiredAt,
+		Scope:     make([]string, 0, len(scopes)),
+		Rules:     make([]*credential.Rule, 0, len(scopes)),
+	}
+	for _, one := range scopes {
+		c.Scope = append(c.Scope, string(one.Spec.CredentialScope))
+		c.Rules = append(c.Rules, one.Spec.Rule())
 	}
 
-	c.scopeMap = map[string][]string{}
-	for _, v := range c.Scope {
-		index := strings.Index(v, "/")
-		if index == -1 {
-			panic(fmt.Errorf("invalid scope %s", v))
-		}
+	return c
+}
 
-		app := v[:index]
-		scope := v[index+1:]
-		_, ok := c.scopeMap[app]
-		if !ok {
-			c.scopeMap[app] = []string{scope}
-		} else {
-			c.scopeMap[app] = append(c.scopeMap[app], scope)
+// IsExpired 凭证是否已过期, 轮换后旧密钥在宽限期结束后同样视为过期
+func (c *CredentialCache) IsExpired(now time.Time) bool {
+	return c.ExpiredAt != nil && !now.Before(*c.ExpiredAt)
+}
+
+// activeRules 未过期且匹配 App 的关联规则, 旧版本缓存的凭证只有 Scope
+func (c *CredentialCache) activeRules(app string) []*credential.Rule {
+	rules := c.Rules
+	if len(rules) == 0 {
+		for _, s := range c.Scope {
+			rules = append(rules, &credential.Rule{Scope: credential.Scope(s)})
 		}
 	}
 
-	c.isPreprocess = true
+	now := time.Now()
+	return lo.Filter(rules, func(r *credential.Rule, _ int) bool {
+		return !r.IsExpired(now) && r.MatchApp(app)
+	})
 }
 
 // MatchApp 是否匹配 App
 func (c *CredentialCache) MatchApp(app string) bool {
-	c.preprocess()
-
-	_, ok := c.scopeMap[app]
-	return ok
+	return len(c.activeRules(app)) != 0
 }
 
 // MatchKv 是否匹配kv
 func (c *CredentialCache) MatchKv(app, key string) bool {
-	scopes, ok := c.scopeMap[app]
-	if !ok {
-		return false
-	}
-
-	for _, s := range scopes {
-		g, err := glob.Compile(s)
-		if err != nil {
-			continue
-		}
-		if g.Match(key) {
-			return true
-		}
-	}
-
-	return false
+	return lo.SomeBy(c.activeRules(app), func(r *credential.Rule) bool {
+		return r.MatchKv(key)
+	})
 }
 
 // MatchConfigItem 是否匹配配置文件
 func (c *CredentialCache) MatchConfigItem(app, path, name string) bool {
-	scopes, ok := c.scopeMap[app]
-	if !ok {
-		return false
-	}
+	return lo.SomeBy(c.activeRules(app), func(r *credential.Rule) bool {
+		return r.MatchConfigItem(path, name)
+	})
+}
 
-	for _, scope := range scopes {
-		ok, _ := tools.MatchConfigItem(scope, path, name)
-		if ok {
-			return true
-		}
-	}
+// MatchLabels 实例声明的标签是否被允许
+func (c *CredentialCache) MatchLabels(app string, labels map[string]string) bool {
+	return lo.SomeBy(c.activeRules(app), func(r *credential.Rule) bool {
+		return r.MatchLabels(labels)
+	})
+}
 
-	return false
+// CanWatch 是否允许监听配置变更, 只读的凭证只能拉取配置
+func (c *CredentialCache) CanWatch(app string) bool {
+	return lo.SomeBy(c.activeRules(app), func(r *credential.Rule) bool {
+		return r.CanWatch()
+	})
 }
 
-// ReleaseCICaches convert ReleasedConfigItem to ReleaseCICache.
-func ReleaseCICaches(rs []*table.ReleasedConfigItem, releaseName string) []*ReleaseCICache {
+// ReleaseCICaches convert ReleasedConfigItem to ReleaseCICache, the group config items are the contents of
+// the released config items rendered with the template variables overridden by the groups.
+func ReleaseCICaches(rs []*table.ReleasedConfigItem, groupCIs []*table.ReleasedGroupConfigItem,
+	releaseName string) []*ReleaseCICache {
+	groupSpecs := make(map[uint32]map[uint32]*CommitSpecCache)
+	for _, one := range groupCIs {
+		specs, ok := groupSpecs[one.Attachment.ReleasedConfigItemID]
+		if !ok {
+			specs = make(map[uint32]*CommitSpecCache)
+			groupSpecs[one.Attachment.ReleasedConfigItemID] = specs
+		}
+		specs[one.Attachment.GroupID] = &CommitSpecCache{
+			Signature: one.Spec.Signature,
+			ByteSize:  one.Spec.ByteSize,
+			Md5:       one.Spec.Md5,
+		}
+	}
+
 	list := make([]*ReleaseCICache, len(rs))
 
 	for index, one := range rs {
+		for _, spec := range groupSpecs[one.ID] {
+			spec.ContentID = one.CommitSpec.ContentID
+		}
 		list[index] = &ReleaseCICache{
 			ID:           one.ID,
 			ReleaseID:    one.ReleaseID,
@@ -234,8 +280,9 @@ func ReleaseCICaches(rs []*table.ReleasedConfigItem, releaseName string) []*Rele
 					Privilege: one.ConfigItemSpec.Permission.Privilege,
 				},
 			},
-			Attachment: one.Attachment,
-			Revision:   one.Revision,
+			Attachment:       one.Attachment,
+			Revision:         one.Revision,
+			GroupCommitSpecs: groupSpecs[one.ID],
 		}
 	}
 
@@ -38,6 +38,12 @@ type TargetConfigVersionChart struct {
 	Count           int    `json:"count"`
 }
 
+// OfflineClientStat 离线客户端统计
+type OfflineClientStat struct {
+	Count                 uint32     `json:"count"`
+	EarliestHeartbeatTime *time.Time `json:"earliest_heartbeat_time"`
+}
+
 // ChangeStatusChart 客户端变更状态图表
 type ChangeStatusChart struct {
 	ReleaseChangeStatus string `json:"release_change_status"`
@@ -80,3 +86,30 @@ type PrimaryAndForeign struct {
 	PrimaryVal, ForeignVal string
 	Count                  int
 }
+
+// ListClientMetricTrendsOption 查询客户端指标趋势的条件
+type ListClientMetricTrendsOption struct {
+	BizID       uint32
+	AppID       uint32
+	UID         string
+	Granularity string
+	StartTime   time.Time
+	EndTime     time.Time
+	// ByVersion 是否按客户端版本分别统计趋势
+	ByVersion bool
+}
+
+// ClientMetricTrend 客户端指标趋势中的一个时间点，cpu 和内存为累加值，平均值需除以采样次数
+type ClientMetricTrend struct {
+	BucketTime      time.Time `json:"bucket_time"`
+	ClientVersion   string    `json:"client_version"`
+	ClientCount     uint32    `json:"client_count"`
+	SampleCount     uint64    `json:"sample_count"`
+	CpuSum          float64   `json:"cpu_sum"`
+	CpuMax          float64   `json:"cpu_max"`
+	MemorySum       uint64    `json:"memory_sum"`
+	MemoryMax       uint64    `json:"memory_max"`
+	PullCount       uint64    `json:"pull_count"`
+	PullFailedCount uint64    `json:"pull_failed_count"`
+	PullSeconds     float64   `json:"pull_seconds"`
+}
@@ -13,6 +13,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/filter"
@@ -92,6 +94,11 @@ type ListGroupReleasedAppsData struct {
 	ReleaseID   uint32 `gorm:"column:release_id" json:"release_id"`
 	ReleaseName string `gorm:"column:release_name" json:"release_name"`
 	Edited      bool   `gorm:"column:edited" json:"edited"`
+	// PinnedReleaseID is the release which the group is pinned to, 0 means not pinned.
+	PinnedReleaseID   uint32     `gorm:"column:pinned_release_id" json:"pinned_release_id"`
+	PinnedReleaseName string     `gorm:"column:pinned_release_name" json:"pinned_release_name"`
+	PinnedUntil       *time.Time `gorm:"column:pinned_until" json:"pinned_until"`
+	PinMemo           string     `gorm:"column:pin_memo" json:"pin_memo"`
 }
 
 // ListGroupReleasedAppsDetails defines the response details of requested ListGroupReleasedAppsOption.
@@ -89,6 +89,8 @@ type ListHookReferencesDetail struct {
 	ReleaseName      string `gorm:"column:release_name" json:"release_name"`
 	HookType         string `gorm:"column:hook_type" json:"hook_type"`
 	Deprecated       bool   `gorm:"column:deprecated" json:"deprecated"`
+	// Params are the hook param values bound by the app
+	Params table.AppVariables `gorm:"column:params" json:"params"`
 }
 
 // HookTagCount defines the response details of requested CountHookTag.
@@ -64,3 +64,17 @@ type ListReleasesStrategies struct {
 	FullyReleased     bool        `gorm:"column:fully_released" json:"fully_released"`
 	FinalApprovalTime time.Time   `gorm:"column:final_approval_time" json:"final_approval_time"`
 }
+
+// PromoteChangeAction defines what is changed to the resource of the target app when a release is promoted.
+type PromoteChangeAction string
+
+const (
+	// PromoteAdd means the resource is added to the target app.
+	PromoteAdd PromoteChangeAction = "add"
+	// PromoteUpdate means the resource of the target app is updated.
+	PromoteUpdate PromoteChangeAction = "update"
+	// PromoteDelete means the resource of the target app is deleted.
+	PromoteDelete PromoteChangeAction = "delete"
+	// PromoteUnchanged means the resource of the target app is the same as the source release.
+	PromoteUnchanged PromoteChangeAction = "unchanged"
+)
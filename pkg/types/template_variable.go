@@ -0,0 +1,44 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"errors"
+)
+
+// SecretVariableOption defines options to operate the value of secret template variable in vault,
+// the variable belongs to the biz when the app id is 0, otherwise it's the value overridden by the app,
+// or by the group of the app when the group id is set.
+type SecretVariableOption struct {
+	BizID   uint32
+	AppID   uint32
+	GroupID uint32
+	Name    string
+}
+
+// Validate is used to validate the effectiveness of the SecretVariableOption structure.
+func (o *SecretVariableOption) Validate() error {
+	if o.BizID <= 0 {
+		return errors.New("invalid biz id, should >= 1")
+	}
+
+	if o.GroupID > 0 && o.AppID <= 0 {
+		return errors.New("app id is required for the variable overridden by group")
+	}
+
+	if o.Name == "" {
+		return errors.New("variable name is required")
+	}
+
+	return nil
+}
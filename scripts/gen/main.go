@@ -70,6 +70,22 @@ func main() {
 		table.BizHost{},
 		table.ConfigTemplate{},
 		table.ConfigInstance{},
+		table.ClientAlertRule{},
+		table.ClientAlertHistory{},
+		table.ClientMetric{},
+		table.Role{},
+		table.RoleBinding{},
+		table.CredentialClient{},
+		table.TemplateSpaceHook{},
+		table.GroupTemplateVariable{},
+		table.ReleasedGroupConfigItem{},
+		table.GitRepoLink{},
+		table.ChangeFreeze{},
+		table.Webhook{},
+		table.WebhookDelivery{},
+		table.ApprovalFlow{},
+		table.ApprovalTicket{},
+		table.ApprovalAction{},
 	)
 
 	g.Execute()
@@ -0,0 +1,207 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package postgres runs the migrations and the dialect sensitive gorm-gen queries against a local postgresql.
+// it is skipped unless a data-service config whose admin database driver is postgres is given, e.g.
+//
+//	go test ./test/suite/cases/postgres/ -args -data-service-config=/path/to/data_service.yaml
+package postgres
+
+import (
+	"flag"
+	"os"
+	"testing"
+	"time"
+
+	// run the init function to add migrations
+	_ "github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrations"
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+const testBizID = 99999
+
+var configFile string
+
+func TestMain(m *testing.M) {
+	flag.StringVar(&configFile, "data-service-config", "", "data-service config file whose database driver is postgres")
+	flag.Parse()
+
+	if configFile != "" {
+		cc.InitService(cc.DataServiceName)
+		if err := cc.LoadSettings(&cc.SysOption{ConfigFiles: []string{configFile}}); err != nil {
+			panic(err)
+		}
+	}
+	os.Exit(m.Run())
+}
+
+func requirePostgres(t *testing.T) {
+	if configFile == "" {
+		t.Skip("data-service-config is not set, skip postgresql integration test")
+	}
+	if cc.DataService().Sharding.AdminDatabase.Driver != cc.PostgresDriver {
+		t.Skip("admin database driver is not postgres, skip postgresql integration test")
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	requirePostgres(t)
+
+	db, err := migrator.NewDB(false)
+	if err != nil {
+		t.Fatalf("open db failed, err: %v", err)
+	}
+	mig, err := migrator.Init(db)
+	if err != nil {
+		t.Fatalf("init migrator failed, err: %v", err)
+	}
+	if err = mig.Up(0); err != nil {
+		t.Fatalf("run up migrations failed, err: %v", err)
+	}
+
+	var applied int64
+	if err = db.Table("schema_migrations").Count(&applied).Error; err != nil {
+		t.Fatalf("count applied migrations failed, err: %v", err)
+	}
+	if int(applied) != len(mig.Versions) {
+		t.Errorf("applied migrations %d, want %d", applied, len(mig.Versions))
+	}
+
+	// the latest migration should be able to roll back and forth.
+	if err = mig.Down(1); err != nil {
+		t.Fatalf("run down migration failed, err: %v", err)
+	}
+	if err = mig.Up(0); err != nil {
+		t.Fatalf("rerun up migration failed, err: %v", err)
+	}
+}
+
+func newDaoSet(t *testing.T) dao.Set {
+	requirePostgres(t)
+
+	set, err := dao.NewDaoSet(cc.DataService().Sharding, cc.DataService().Credential, cc.DataService().Gorm)
+	if err != nil {
+		t.Fatalf("new dao set failed, err: %v", err)
+	}
+	return set
+}
+
+func TestClientMetric(t *testing.T) {
+	set := newDaoSet(t)
+	kt := kit.New()
+
+	bucket := time.Now().UTC().Truncate(time.Hour)
+	newMetric := func() *table.ClientMetric {
+		return &table.ClientMetric{
+			Attachment: &table.ClientMetricAttachment{BizID: testBizID, AppID: 1, UID: "postgres-test"},
+			Spec: &table.ClientMetricSpec{
+				Granularity: table.ClientMetricHour,
+				BucketTime:  bucket,
+				SampleCount: 1,
+				CpuSum:      1.5,
+				CpuMax:      1.5,
+				PullCount:   1,
+			},
+		}
+	}
+	// the second accumulation hits the unique key and goes to the on conflict branch.
+	for i := 0; i < 2; i++ {
+		if err := set.ClientMetric().BatchAccumulate(kt, []*table.ClientMetric{newMetric()}); err != nil {
+			t.Fatalf("accumulate client metrics failed, err: %v", err)
+		}
+	}
+
+	m := set.GenQuery().ClientMetric
+	got, err := m.WithContext(kt.Ctx).Where(m.BizID.Eq(testBizID), m.UID.Eq("postgres-test"),
+		m.Granularity.Eq(string(table.ClientMetricHour))).Take()
+	if err != nil {
+		t.Fatalf("get client metric failed, err: %v", err)
+	}
+	if got.Spec.SampleCount != 2 || got.Spec.PullCount != 2 {
+		t.Errorf("accumulated sample count %d, pull count %d, want 2", got.Spec.SampleCount, got.Spec.PullCount)
+	}
+
+	if err = set.ClientMetric().RollupDay(kt, bucket); err != nil {
+		t.Fatalf("rollup client metrics failed, err: %v", err)
+	}
+	for _, granularity := range []table.ClientMetricGranularity{table.ClientMetricHour, table.ClientMetricDay} {
+		if _, err = set.ClientMetric().DeleteBefore(kt, granularity, bucket.Add(48*time.Hour)); err != nil {
+			t.Fatalf("delete client metrics failed, err: %v", err)
+		}
+	}
+}
+
+func TestTemplateSetJSONArray(t *testing.T) {
+	set := newDaoSet(t)
+	kt := kit.New()
+
+	tmplSet := &table.TemplateSet{
+		Spec:       &table.TemplateSetSpec{Name: "postgres_test", TemplateIDs: []uint32{1}, BoundApps: []uint32{}},
+		Attachment: &table.TemplateSetAttachment{BizID: testBizID, TemplateSpaceID: 1},
+		Revision:   &table.Revision{Creator: "test", Reviser: "test", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+	id, err := set.ID().One(kt, table.Name(tmplSet.TableName()))
+	if err != nil {
+		t.Fatalf("generate template set id failed, err: %v", err)
+	}
+	tmplSet.ID = id
+	q := set.GenQuery()
+	if err = q.TemplateSet.WithContext(kt.Ctx).Create(tmplSet); err != nil {
+		t.Fatalf("create template set failed, err: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = q.TemplateSet.WithContext(kt.Ctx).Where(q.TemplateSet.ID.Eq(id)).Delete()
+	})
+
+	tx := q.Begin()
+	if err = set.TemplateSet().AddTmplsToTmplSetsWithTx(kt, tx, []uint32{1, 2}, []uint32{id}); err != nil {
+		_ = tx.Rollback()
+		t.Fatalf("add templates to template set failed, err: %v", err)
+	}
+	if err = set.TemplateSet().DeleteTmplFromAllTmplSetsWithTx(kt, tx, testBizID, 1); err != nil {
+		_ = tx.Rollback()
+		t.Fatalf("delete template from template sets failed, err: %v", err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatalf("commit failed, err: %v", err)
+	}
+
+	got, err := q.TemplateSet.WithContext(kt.Ctx).Where(q.TemplateSet.ID.Eq(id)).Take()
+	if err != nil {
+		t.Fatalf("get template set failed, err: %v", err)
+	}
+	if len(got.Spec.TemplateIDs) != 1 || got.Spec.TemplateIDs[0] != 2 {
+		t.Errorf("template ids %v, want [2]", got.Spec.TemplateIDs)
+	}
+}
+
+func TestListQueries(t *testing.T) {
+	set := newDaoSet(t)
+	kt := kit.New()
+
+	// the queries only need to be accepted by postgresql, the result is not cared about.
+	if _, err := set.Hook().CountHookTag(kt, testBizID); err != nil {
+		t.Errorf("count hook tag failed, err: %v", err)
+	}
+	if _, _, err := set.Credential().List(kt, testBizID, "postgres", &types.BasePage{Limit: 10}, nil, "",
+		nil); err != nil {
+		t.Errorf("list credentials failed, err: %v", err)
+	}
+	if _, err := set.TemplateSet().ListAppTmplSets(kt, testBizID, 1); err != nil {
+		t.Errorf("list app template sets failed, err: %v", err)
+	}
+}